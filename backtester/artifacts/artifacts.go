@@ -0,0 +1,226 @@
+package artifacts
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// NewID returns a unique run ID which sorts by the time it was created
+func NewID() (string, error) {
+	id, err := uuid.NewV4()
+	if err != nil {
+		return "", err
+	}
+	return time.Now().UTC().Format(idTimeFormat) + "-" + id.String()[:8], nil
+}
+
+// Create makes the directory of a new run within the output path
+func Create(outputPath, id string) (*Run, error) {
+	if outputPath == "" {
+		return nil, errOutputPathUnset
+	}
+	if id == "" {
+		return nil, errRunIDUnset
+	}
+	err := os.MkdirAll(outputPath, 0770)
+	if err != nil {
+		return nil, err
+	}
+	r := &Run{
+		ID:      id,
+		Status:  StatusRunning,
+		Started: time.Now(),
+		Path:    filepath.Join(outputPath, id),
+	}
+	err = os.Mkdir(r.Path, 0770)
+	if err != nil {
+		if os.IsExist(err) {
+			return nil, fmt.Errorf("%w %v", errRunExists, r.Path)
+		}
+		return nil, err
+	}
+	return r, r.writeMetadata()
+}
+
+// SaveConfig writes a copy of the config to the run's directory so the run can
+// be reproduced. Credentials and database passwords are redacted from the copy
+func (r *Run) SaveConfig(cfg *config.Config) error {
+	if cfg == nil {
+		return errNilConfig
+	}
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return err
+	}
+	cp, err := config.LoadConfig(data)
+	if err != nil {
+		return err
+	}
+	redactConfig(cp)
+	data, err = json.MarshalIndent(cp, "", " ")
+	if err != nil {
+		return err
+	}
+	err = ioutil.WriteFile(filepath.Join(r.Path, ConfigFileName), data, 0600)
+	if err != nil {
+		return err
+	}
+	r.Nickname = cfg.Nickname
+	r.Strategy = cfg.StrategySettings.Name
+	return r.writeMetadata()
+}
+
+// redactConfig removes credentials and passwords from a config
+func redactConfig(cfg *config.Config) {
+	for i := range cfg.CurrencySettings {
+		creds := cfg.CurrencySettings[i].Credentials
+		if creds == nil {
+			continue
+		}
+		redact(&creds.APIKey)
+		redact(&creds.APISecret)
+		redact(&creds.APIClientID)
+		redact(&creds.API2FA)
+	}
+	if live := cfg.DataSettings.LiveData; live != nil {
+		redact(&live.APIKeyOverride)
+		redact(&live.APISecretOverride)
+		redact(&live.APIClientIDOverride)
+		redact(&live.API2FAOverride)
+	}
+	if cfg.DataSettings.DatabaseData != nil {
+		redactDatabase(cfg.DataSettings.DatabaseData.ConfigOverride)
+	}
+	if cfg.DataSettings.FallbackData != nil {
+		redactDatabase(cfg.DataSettings.FallbackData.ConfigOverride)
+	}
+}
+
+func redactDatabase(cfg *database.Config) {
+	if cfg != nil {
+		redact(&cfg.Password)
+	}
+}
+
+func redact(s *string) {
+	if *s != "" {
+		*s = redacted
+	}
+}
+
+// StartLog copies every log written until the run finishes to the run's log
+// file
+func (r *Run) StartLog() error {
+	if r.logFile != nil {
+		return errLogAlreadyActive
+	}
+	f, err := os.OpenFile(filepath.Join(r.Path, LogFileName), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	r.logFile = f
+	log.AddWriter(f)
+	return nil
+}
+
+// Finish records the outcome of the run and stops copying logs to the run's
+// log file
+func (r *Run) Finish(runErr error) error {
+	if r.Status != StatusRunning {
+		return fmt.Errorf("%w %v", errRunFinished, r.ID)
+	}
+	r.Ended = time.Now()
+	r.Status = StatusComplete
+	if runErr != nil {
+		r.Status = StatusFailed
+		r.Error = runErr.Error()
+	}
+	if r.logFile != nil {
+		log.RemoveWriter(r.logFile)
+		err := r.logFile.Close()
+		if err != nil {
+			return err
+		}
+		r.logFile = nil
+	}
+	return r.writeMetadata()
+}
+
+func (r *Run) writeMetadata() error {
+	data, err := json.MarshalIndent(r, "", " ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(r.Path, MetadataFileName), data, 0600)
+}
+
+// List returns every run within the output path, newest first. Directories
+// without run metadata are ignored
+func List(outputPath string) ([]Run, error) {
+	dirs, err := ioutil.ReadDir(outputPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var resp []Run
+	for i := range dirs {
+		if !dirs[i].IsDir() {
+			continue
+		}
+		path := filepath.Join(outputPath, dirs[i].Name())
+		data, err := ioutil.ReadFile(filepath.Join(path, MetadataFileName))
+		if err != nil {
+			continue
+		}
+		var r Run
+		if json.Unmarshal(data, &r) != nil {
+			continue
+		}
+		r.Path = path
+		resp = append(resp, r)
+	}
+	sort.SliceStable(resp, func(i, j int) bool {
+		return resp[i].Started.After(resp[j].Started)
+	})
+	return resp, nil
+}
+
+// Cleanup removes the runs within the output path which fall outside of the
+// retention policy, returning the IDs of the runs removed
+func Cleanup(outputPath string, retention Retention) ([]string, error) {
+	if retention.MaxRuns <= 0 && retention.MaxAge <= 0 {
+		return nil, nil
+	}
+	runs, err := List(outputPath)
+	if err != nil {
+		return nil, err
+	}
+	var removed []string
+	for i := range runs {
+		keep := retention.MaxRuns <= 0 || i < retention.MaxRuns
+		if keep && retention.MaxAge > 0 && time.Since(runs[i].Started) > retention.MaxAge {
+			keep = false
+		}
+		if keep {
+			continue
+		}
+		err = os.RemoveAll(runs[i].Path)
+		if err != nil {
+			return removed, err
+		}
+		removed = append(removed, runs[i].ID)
+	}
+	return removed, nil
+}
@@ -0,0 +1,219 @@
+package artifacts
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+func TestNewID(t *testing.T) {
+	t.Parallel()
+	id, err := NewID()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	id2, err := NewID()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if id == id2 {
+		t.Errorf("received '%v' expected unique IDs", id)
+	}
+	if _, err = time.Parse(idTimeFormat, id[:len(idTimeFormat)]); err != nil {
+		t.Errorf("received '%v' expected ID to start with its time", err)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	t.Parallel()
+	_, err := Create("", "test")
+	if !errors.Is(err, errOutputPathUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errOutputPathUnset)
+	}
+	dir := t.TempDir()
+	_, err = Create(dir, "")
+	if !errors.Is(err, errRunIDUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errRunIDUnset)
+	}
+	r, err := Create(filepath.Join(dir, "results"), "test")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if r.Status != StatusRunning || r.Path != filepath.Join(dir, "results", "test") {
+		t.Errorf("received '%v' '%v' expected running run in its own directory", r.Status, r.Path)
+	}
+	if _, err = os.Stat(filepath.Join(r.Path, MetadataFileName)); err != nil {
+		t.Error(err)
+	}
+	_, err = Create(filepath.Join(dir, "results"), "test")
+	if !errors.Is(err, errRunExists) {
+		t.Errorf("received '%v' expected '%v'", err, errRunExists)
+	}
+}
+
+func TestSaveConfig(t *testing.T) {
+	t.Parallel()
+	r, err := Create(t.TempDir(), "test")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = r.SaveConfig(nil)
+	if !errors.Is(err, errNilConfig) {
+		t.Errorf("received '%v' expected '%v'", err, errNilConfig)
+	}
+	cfg := &config.Config{
+		Nickname: "nick",
+		StrategySettings: config.StrategySettings{
+			Name: "dollarcostaverage",
+		},
+		CurrencySettings: []config.CurrencySettings{{
+			ExchangeName: "binance",
+			Credentials: &config.Credentials{
+				APIKey:    "key",
+				APISecret: "secret",
+			},
+		}},
+		DataSettings: config.DataSettings{
+			DatabaseData: &config.DatabaseData{
+				ConfigOverride: &database.Config{
+					ConnectionDetails: drivers.ConnectionDetails{Password: "password"},
+				},
+			},
+		},
+	}
+	err = r.SaveConfig(cfg)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if cfg.CurrencySettings[0].Credentials.APIKey != "key" {
+		t.Error("expected the run's config to be left unchanged")
+	}
+	saved, err := config.ReadConfigFromFile(filepath.Join(r.Path, ConfigFileName))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if saved.Nickname != "nick" || saved.CurrencySettings[0].ExchangeName != "binance" {
+		t.Errorf("received '%+v' expected copy of config", saved)
+	}
+	if saved.CurrencySettings[0].Credentials.APISecret != redacted ||
+		saved.CurrencySettings[0].Credentials.APIClientID != "" ||
+		saved.DataSettings.DatabaseData.ConfigOverride.Password != redacted {
+		t.Error("expected credentials to be redacted")
+	}
+	if r.Nickname != "nick" || r.Strategy != "dollarcostaverage" {
+		t.Errorf("received '%v' '%v' expected run metadata from config", r.Nickname, r.Strategy)
+	}
+}
+
+func TestLogAndFinish(t *testing.T) {
+	t.Parallel()
+	r, err := Create(t.TempDir(), "test")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = r.StartLog()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = r.StartLog()
+	if !errors.Is(err, errLogAlreadyActive) {
+		t.Errorf("received '%v' expected '%v'", err, errLogAlreadyActive)
+	}
+	log.Info(log.BackTester, "artifacts test log")
+	runErr := errors.New("run error")
+	err = r.Finish(runErr)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = r.Finish(nil)
+	if !errors.Is(err, errRunFinished) {
+		t.Errorf("received '%v' expected '%v'", err, errRunFinished)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(r.Path, MetadataFileName))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	var saved Run
+	err = json.Unmarshal(data, &saved)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if saved.Status != StatusFailed || saved.Error != runErr.Error() || saved.Ended.IsZero() {
+		t.Errorf("received '%+v' expected failed run", saved)
+	}
+	// the log is only written when logging is enabled
+	data, err = ioutil.ReadFile(filepath.Join(r.Path, LogFileName))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(data) > 0 && !strings.Contains(string(data), "artifacts test log") {
+		t.Errorf("received '%s' expected test log", data)
+	}
+}
+
+func TestCleanup(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	removed, err := Cleanup(filepath.Join(dir, "missing"), Retention{MaxRuns: 1})
+	if !errors.Is(err, nil) || len(removed) != 0 {
+		t.Errorf("received '%v' '%v' expected nothing removed", err, removed)
+	}
+	now := time.Now()
+	for i, id := range []string{"old", "older", "new"} {
+		r, err := Create(dir, id)
+		if !errors.Is(err, nil) {
+			t.Fatalf("received '%v' expected '%v'", err, nil)
+		}
+		r.Started = now.Add(-time.Hour * 24 * time.Duration(2-i))
+		if id == "older" {
+			r.Started = now.Add(-time.Hour * 24 * 3)
+		}
+		err = r.writeMetadata()
+		if !errors.Is(err, nil) {
+			t.Fatalf("received '%v' expected '%v'", err, nil)
+		}
+	}
+	err = os.Mkdir(filepath.Join(dir, "not-a-run"), 0770)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	removed, err = Cleanup(dir, Retention{})
+	if !errors.Is(err, nil) || len(removed) != 0 {
+		t.Errorf("received '%v' '%v' expected nothing removed without a policy", err, removed)
+	}
+	removed, err = Cleanup(dir, Retention{MaxAge: time.Hour*24*2 + time.Hour})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(removed) != 1 || removed[0] != "older" {
+		t.Errorf("received '%v' expected '%v'", removed, []string{"older"})
+	}
+	removed, err = Cleanup(dir, Retention{MaxRuns: 1})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(removed) != 1 || removed[0] != "old" {
+		t.Errorf("received '%v' expected '%v'", removed, []string{"old"})
+	}
+	runs, err := List(dir)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(runs) != 1 || runs[0].ID != "new" {
+		t.Errorf("received '%+v' expected only the newest run", runs)
+	}
+	if _, err = os.Stat(filepath.Join(dir, "not-a-run")); err != nil {
+		t.Error("expected directories which are not runs to be kept")
+	}
+}
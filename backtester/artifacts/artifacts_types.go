@@ -0,0 +1,60 @@
+package artifacts
+
+import (
+	"errors"
+	"os"
+	"time"
+)
+
+const (
+	// ConfigFileName is the copy of the config the run used
+	ConfigFileName = "config.strat"
+	// LogFileName holds every log written while the run was active
+	LogFileName = "run.log"
+	// MetadataFileName describes the run and identifies its directory as a
+	// run directory which can be cleaned up
+	MetadataFileName = "run.json"
+
+	// StatusRunning is a run which has not finished, or whose process
+	// ended before it could finish
+	StatusRunning = "running"
+	// StatusComplete is a run which finished successfully
+	StatusComplete = "complete"
+	// StatusFailed is a run which finished with an error
+	StatusFailed = "failed"
+
+	idTimeFormat = "20060102-150405"
+	redacted     = "redacted"
+)
+
+var (
+	errOutputPathUnset  = errors.New("output path unset")
+	errRunIDUnset       = errors.New("run ID unset")
+	errRunExists        = errors.New("run directory already exists")
+	errNilConfig        = errors.New("nil config")
+	errLogAlreadyActive = errors.New("run log already active")
+	errRunFinished      = errors.New("run already finished")
+)
+
+// Run is a uniquely identified directory holding everything a single run
+// produces, such as a copy of its config, its logs, report and exports
+type Run struct {
+	ID       string    `json:"id"`
+	Nickname string    `json:"nickname,omitempty"`
+	Strategy string    `json:"strategy,omitempty"`
+	Status   string    `json:"status"`
+	Error    string    `json:"error,omitempty"`
+	Started  time.Time `json:"started"`
+	Ended    time.Time `json:"ended,omitempty"`
+	// Path is the run's directory
+	Path    string `json:"-"`
+	logFile *os.File
+}
+
+// Retention determines which previous runs are removed from an output path.
+// Runs beyond the newest MaxRuns and runs started more than MaxAge ago are
+// removed. Zero values do not limit runs
+type Retention struct {
+	MaxRuns int
+	MaxAge  time.Duration
+}
@@ -2,14 +2,22 @@ package backtest
 
 import (
 	"context"
+	gocsv "encoding/csv"
 	"errors"
 	"fmt"
+	"io"
+	"math/rand"
+	"os"
 	"path/filepath"
 	"runtime"
+	"sort"
+	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/clock"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/config"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
@@ -17,7 +25,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline/api"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline/csv"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline/database"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline/fallback"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline/live"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/spread"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/eventholder"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange/slippage"
@@ -29,8 +39,12 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/size"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics/currencystatistics"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics/regime"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/universe"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventlog"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
@@ -41,6 +55,7 @@ import (
 	gctdatabase "github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/engine"
 	gctexchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
@@ -50,18 +65,25 @@ import (
 // New returns a new BackTest instance
 func New() *BackTest {
 	return &BackTest{
-		shutdown: make(chan struct{}),
+		shutdown:         make(chan struct{}),
+		Clock:            &clock.Event{},
+		progressInterval: DefaultProgressInterval,
 	}
 }
 
 // Reset BackTest values to default
 func (bt *BackTest) Reset() {
+	bt.requeued = nil
+	bt.rejections = nil
 	bt.EventQueue.Reset()
 	bt.Datas.Reset()
 	bt.Portfolio.Reset()
 	bt.Statistic.Reset()
 	bt.Exchange.Reset()
 	bt.Funding.Reset()
+	if bt.Universe != nil {
+		bt.Universe.Reset()
+	}
 	bt.Bot = nil
 }
 
@@ -83,21 +105,35 @@ func NewFromConfig(cfg *config.Config, templatePath, output string, bot *engine.
 		OutputPath:   output,
 	}
 	bt.Reports = reports
-
+	bt.auditLogPath = cfg.PortfolioSettings.AuditLogPath
+	if bt.auditLogPath != "" && !filepath.IsAbs(bt.auditLogPath) {
+		// relative exports belong with the rest of the run's output
+		bt.auditLogPath = filepath.Join(output, bt.auditLogPath)
+	}
 	err := bt.setupBot(cfg, bot)
 	if err != nil {
 		return nil, err
 	}
+	if cfg.DataSettings.Replay != nil {
+		bt.Clock, err = clock.NewReplay(cfg.DataSettings.Replay.Speed)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	buyRule := config.MinMax{
-		MinimumSize:  cfg.PortfolioSettings.BuySide.MinimumSize,
-		MaximumSize:  cfg.PortfolioSettings.BuySide.MaximumSize,
-		MaximumTotal: cfg.PortfolioSettings.BuySide.MaximumTotal,
+		MinimumSize:   cfg.PortfolioSettings.BuySide.MinimumSize,
+		MaximumSize:   cfg.PortfolioSettings.BuySide.MaximumSize,
+		MaximumTotal:  cfg.PortfolioSettings.BuySide.MaximumTotal,
+		NotionalSize:  cfg.PortfolioSettings.BuySide.NotionalSize,
+		EquityPercent: cfg.PortfolioSettings.BuySide.EquityPercent,
 	}
 	sellRule := config.MinMax{
-		MinimumSize:  cfg.PortfolioSettings.SellSide.MinimumSize,
-		MaximumSize:  cfg.PortfolioSettings.SellSide.MaximumSize,
-		MaximumTotal: cfg.PortfolioSettings.SellSide.MaximumTotal,
+		MinimumSize:   cfg.PortfolioSettings.SellSide.MinimumSize,
+		MaximumSize:   cfg.PortfolioSettings.SellSide.MaximumSize,
+		MaximumTotal:  cfg.PortfolioSettings.SellSide.MaximumTotal,
+		NotionalSize:  cfg.PortfolioSettings.SellSide.NotionalSize,
+		EquityPercent: cfg.PortfolioSettings.SellSide.EquityPercent,
 	}
 	sizeManager := &size.Size{
 		BuySide:  buyRule,
@@ -114,12 +150,33 @@ func NewFromConfig(cfg *config.Config, templatePath, output string, bot *engine.
 				return nil, err
 			}
 			cq := currency.NewCode(cfg.StrategySettings.ExchangeLevelFunding[i].Currency)
+			var initialFunds decimal.Decimal
+			initialFunds, err = convertInitialFunds(cfg,
+				cfg.StrategySettings.ExchangeLevelFunding[i].InitialFunds,
+				cfg.StrategySettings.ExchangeLevelFunding[i].InitialFundsCurrency,
+				cfg.StrategySettings.ExchangeLevelFunding[i].Currency)
+			if err != nil {
+				return nil, err
+			}
+			transferFee := cfg.StrategySettings.ExchangeLevelFunding[i].TransferFee
+			if cfg.StrategySettings.ExchangeLevelFunding[i].UseExchangeTransferFee {
+				var exch gctexchange.IBotExchange
+				exch, err = bot.ExchangeManager.GetExchangeByName(cfg.StrategySettings.ExchangeLevelFunding[i].InstanceName())
+				if err != nil {
+					return nil, err
+				}
+				transferFee = getTransferFee(context.TODO(), exch, cq, transferFee)
+			}
 			var item *funding.Item
-			item, err = funding.CreateItem(cfg.StrategySettings.ExchangeLevelFunding[i].ExchangeName,
+			item, err = funding.CreateItem(cfg.StrategySettings.ExchangeLevelFunding[i].InstanceName(),
 				a,
 				cq,
-				cfg.StrategySettings.ExchangeLevelFunding[i].InitialFunds,
-				cfg.StrategySettings.ExchangeLevelFunding[i].TransferFee)
+				initialFunds,
+				transferFee)
+			if err != nil {
+				return nil, err
+			}
+			err = item.SetConfirmationDelay(cfg.StrategySettings.ExchangeLevelFunding[i].DepositConfirmationDelay)
 			if err != nil {
 				return nil, err
 			}
@@ -134,8 +191,9 @@ func NewFromConfig(cfg *config.Config, templatePath, output string, bot *engine.
 		CurrencySettings: make(map[string]map[asset.Item]map[currency.Pair]*risk.CurrencySettings),
 	}
 	for i := range cfg.CurrencySettings {
-		if portfolioRisk.CurrencySettings[cfg.CurrencySettings[i].ExchangeName] == nil {
-			portfolioRisk.CurrencySettings[cfg.CurrencySettings[i].ExchangeName] = make(map[asset.Item]map[currency.Pair]*risk.CurrencySettings)
+		exchangeName := cfg.CurrencySettings[i].InstanceName()
+		if portfolioRisk.CurrencySettings[exchangeName] == nil {
+			portfolioRisk.CurrencySettings[exchangeName] = make(map[asset.Item]map[currency.Pair]*risk.CurrencySettings)
 		}
 		var a asset.Item
 		a, err = asset.New(cfg.CurrencySettings[i].Asset)
@@ -143,13 +201,13 @@ func NewFromConfig(cfg *config.Config, templatePath, output string, bot *engine.
 			return nil, fmt.Errorf(
 				"%w for %v %v %v. Err %v",
 				errInvalidConfigAsset,
-				cfg.CurrencySettings[i].ExchangeName,
+				exchangeName,
 				cfg.CurrencySettings[i].Asset,
 				cfg.CurrencySettings[i].Base+cfg.CurrencySettings[i].Quote,
 				err)
 		}
-		if portfolioRisk.CurrencySettings[cfg.CurrencySettings[i].ExchangeName][a] == nil {
-			portfolioRisk.CurrencySettings[cfg.CurrencySettings[i].ExchangeName][a] = make(map[currency.Pair]*risk.CurrencySettings)
+		if portfolioRisk.CurrencySettings[exchangeName][a] == nil {
+			portfolioRisk.CurrencySettings[exchangeName][a] = make(map[currency.Pair]*risk.CurrencySettings)
 		}
 		var curr currency.Pair
 		var b, q currency.Code
@@ -157,7 +215,7 @@ func NewFromConfig(cfg *config.Config, templatePath, output string, bot *engine.
 		q = currency.NewCode(cfg.CurrencySettings[i].Quote)
 		curr = currency.NewPair(b, q)
 		var exch gctexchange.IBotExchange
-		exch, err = bot.ExchangeManager.GetExchangeByName(cfg.CurrencySettings[i].ExchangeName)
+		exch, err = bot.ExchangeManager.GetExchangeByName(exchangeName)
 		if err != nil {
 			return nil, err
 		}
@@ -172,12 +230,12 @@ func NewFromConfig(cfg *config.Config, templatePath, output string, bot *engine.
 		if err != nil && !errors.Is(err, currency.ErrPairAlreadyEnabled) {
 			return nil, fmt.Errorf(
 				"could not enable currency %v %v %v. Err %w",
-				cfg.CurrencySettings[i].ExchangeName,
+				exchangeName,
 				cfg.CurrencySettings[i].Asset,
 				cfg.CurrencySettings[i].Base+cfg.CurrencySettings[i].Quote,
 				err)
 		}
-		portfolioRisk.CurrencySettings[cfg.CurrencySettings[i].ExchangeName][a][curr] = &risk.CurrencySettings{
+		portfolioRisk.CurrencySettings[exchangeName][a][curr] = &risk.CurrencySettings{
 			MaximumOrdersWithLeverageRatio: cfg.CurrencySettings[i].Leverage.MaximumOrdersWithLeverageRatio,
 			MaxLeverageRate:                cfg.CurrencySettings[i].Leverage.MaximumLeverageRate,
 			MaximumHoldingRatio:            cfg.CurrencySettings[i].MaximumHoldingsRatio,
@@ -191,7 +249,7 @@ func NewFromConfig(cfg *config.Config, templatePath, output string, bot *engine.
 		var baseItem, quoteItem *funding.Item
 		if useExchangeLevelFunding {
 			// add any remaining currency items that have no funding data in the strategy config
-			baseItem, err = funding.CreateItem(cfg.CurrencySettings[i].ExchangeName,
+			baseItem, err = funding.CreateItem(exchangeName,
 				a,
 				b,
 				decimal.Zero,
@@ -199,7 +257,7 @@ func NewFromConfig(cfg *config.Config, templatePath, output string, bot *engine.
 			if err != nil {
 				return nil, err
 			}
-			quoteItem, err = funding.CreateItem(cfg.CurrencySettings[i].ExchangeName,
+			quoteItem, err = funding.CreateItem(exchangeName,
 				a,
 				q,
 				decimal.Zero,
@@ -221,10 +279,16 @@ func NewFromConfig(cfg *config.Config, templatePath, output string, bot *engine.
 				bFunds = *cfg.CurrencySettings[i].InitialBaseFunds
 			}
 			if cfg.CurrencySettings[i].InitialQuoteFunds != nil {
-				qFunds = *cfg.CurrencySettings[i].InitialQuoteFunds
+				qFunds, err = convertInitialFunds(cfg,
+					*cfg.CurrencySettings[i].InitialQuoteFunds,
+					cfg.CurrencySettings[i].InitialFundsCurrency,
+					cfg.CurrencySettings[i].Quote)
+				if err != nil {
+					return nil, err
+				}
 			}
 			baseItem, err = funding.CreateItem(
-				cfg.CurrencySettings[i].ExchangeName,
+				exchangeName,
 				a,
 				curr.Base,
 				bFunds,
@@ -233,7 +297,7 @@ func NewFromConfig(cfg *config.Config, templatePath, output string, bot *engine.
 				return nil, err
 			}
 			quoteItem, err = funding.CreateItem(
-				cfg.CurrencySettings[i].ExchangeName,
+				exchangeName,
 				a,
 				curr.Quote,
 				qFunds,
@@ -253,23 +317,46 @@ func NewFromConfig(cfg *config.Config, templatePath, output string, bot *engine.
 		}
 	}
 	bt.Funding = funds
+	err = bt.setupFundingConversions(cfg, funds)
+	if err != nil {
+		return nil, err
+	}
 	var p *portfolio.Portfolio
 	p, err = portfolio.Setup(sizeManager, portfolioRisk, cfg.StatisticSettings.RiskFreeRate)
 	if err != nil {
 		return nil, err
 	}
+	p.SetUsingExchangeLevelFunding(useExchangeLevelFunding)
+	if cfg.PortfolioSettings.EquityScaling != nil {
+		p.SetEquityScaling(cfg.PortfolioSettings.EquityScaling.DrawdownMultiplier, cfg.PortfolioSettings.EquityScaling.MinimumScale)
+	}
 
 	bt.Strategy, err = strategies.LoadStrategyByName(cfg.StrategySettings.Name, cfg.StrategySettings.SimultaneousSignalProcessing)
 	if err != nil {
 		return nil, err
 	}
 	bt.Strategy.SetDefaults()
+	if cfg.StrategySettings.UniverseSelection != nil {
+		bt.Universe, err = universe.Setup(cfg.StrategySettings.UniverseSelection)
+		if err != nil {
+			return nil, err
+		}
+	}
 	if cfg.StrategySettings.CustomSettings != nil {
 		err = bt.Strategy.SetCustomSettings(cfg.StrategySettings.CustomSettings)
 		if err != nil && !errors.Is(err, base.ErrCustomSettingsUnsupported) {
 			return nil, err
 		}
 	}
+	// a freshly loaded strategy provides the defaults the run's custom
+	// settings are reported against
+	defaultStrategy, err := strategies.LoadStrategyByName(cfg.StrategySettings.Name, cfg.StrategySettings.SimultaneousSignalProcessing)
+	if err != nil {
+		return nil, err
+	}
+	defaultStrategy.SetDefaults()
+	reports.StrategyDefaults = defaultStrategy.CustomSettings()
+	reports.StrategySettings = bt.Strategy.CustomSettings()
 	stats := &statistics.Statistic{
 		StrategyName:                bt.Strategy.Name(),
 		StrategyNickname:            cfg.Nickname,
@@ -277,6 +364,36 @@ func NewFromConfig(cfg *config.Config, templatePath, output string, bot *engine.
 		StrategyGoal:                cfg.Goal,
 		ExchangeAssetPairStatistics: make(map[string]map[asset.Item]map[currency.Pair]*currencystatistics.CurrencyStatistic),
 		RiskFreeRate:                cfg.StatisticSettings.RiskFreeRate,
+		DrawdownEpisodeThreshold:    cfg.StatisticSettings.DrawdownEpisodeThreshold,
+	}
+	switch {
+	case len(cfg.StatisticSettings.RiskFreeRateByYear) > 0:
+		stats.RiskFreeRateCurve = currencystatistics.RiskFreeRateCurveFromYears(cfg.StatisticSettings.RiskFreeRateByYear)
+	case cfg.StatisticSettings.RiskFreeRateCSVPath != "":
+		stats.RiskFreeRateCurve, err = currencystatistics.LoadRiskFreeRateCurve(cfg.StatisticSettings.RiskFreeRateCSVPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if cfg.PortfolioSettings.StressTest != nil {
+		stats.StressTestInterval = cfg.PortfolioSettings.StressTest.Interval
+		for i := range cfg.PortfolioSettings.StressTest.Shocks {
+			var code currency.Code
+			if cfg.PortfolioSettings.StressTest.Shocks[i].Currency != "" {
+				code = currency.NewCode(cfg.PortfolioSettings.StressTest.Shocks[i].Currency)
+			}
+			stats.StressTestShocks = append(stats.StressTestShocks, risk.Shock{
+				Name:          cfg.PortfolioSettings.StressTest.Shocks[i].Name,
+				Currency:      code,
+				PercentChange: cfg.PortfolioSettings.StressTest.Shocks[i].PercentChange,
+			})
+		}
+	}
+	if cfg.StatisticSettings.RegimeClassification != nil {
+		stats.RegimeClassifier, err = regime.Setup(cfg.StatisticSettings.RegimeClassification)
+		if err != nil {
+			return nil, err
+		}
 	}
 	bt.Statistic = stats
 	reports.Statistics = stats
@@ -302,19 +419,65 @@ func NewFromConfig(cfg *config.Config, templatePath, output string, bot *engine.
 		}
 	}
 	bt.Portfolio = p
+	if handler, isHandler := bt.Strategy.(strategies.PositionHandler); isHandler {
+		handler.SetPositionReader(p)
+	}
+	// opened last so a failed setup does not leave the file open
+	err = bt.setupEventLog(cfg, output)
+	if err != nil {
+		return nil, err
+	}
 
 	cfg.PrintSetting()
 
 	return bt, nil
 }
 
+// setupEventLog opens the event log when enabled. Relative paths are placed
+// within the run's output directory
+func (bt *BackTest) setupEventLog(cfg *config.Config, output string) error {
+	if cfg.EventLog == nil {
+		return nil
+	}
+	level, err := eventlog.ParseLevel(cfg.EventLog.Level)
+	if err != nil {
+		return err
+	}
+	path := cfg.EventLog.Path
+	if path == "" {
+		path = defaultEventLogFileName
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(output, path)
+	}
+	bt.eventLog, err = eventlog.New(path, level)
+	return err
+}
+
+// convertInitialFunds converts initial funds denominated in another currency
+// into the currency being funded using the configured conversion rates
+func convertInitialFunds(cfg *config.Config, amount decimal.Decimal, from, to string) (decimal.Decimal, error) {
+	converted, err := cfg.StrategySettings.ConvertFunds(amount, from, to)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	if from != "" && !strings.EqualFold(from, to) {
+		log.Infof(log.BackTester, "converted initial funds of %v %v to %v %v",
+			amount.Round(8),
+			strings.ToUpper(from),
+			converted.Round(8),
+			strings.ToUpper(to))
+	}
+	return converted, nil
+}
+
 func (bt *BackTest) setupExchangeSettings(cfg *config.Config) (exchange.Exchange, error) {
 	log.Infoln(log.BackTester, "setting exchange settings...")
 	resp := exchange.Exchange{}
 
 	for i := range cfg.CurrencySettings {
 		exch, pair, a, err := bt.loadExchangePairAssetBase(
-			cfg.CurrencySettings[i].ExchangeName,
+			cfg.CurrencySettings[i].InstanceName(),
 			cfg.CurrencySettings[i].Base,
 			cfg.CurrencySettings[i].Quote,
 			cfg.CurrencySettings[i].Asset)
@@ -324,11 +487,22 @@ func (bt *BackTest) setupExchangeSettings(cfg *config.Config) (exchange.Exchange
 
 		exchangeName := strings.ToLower(exch.GetName())
 		bt.Datas.Setup()
-		klineData, err := bt.loadData(cfg, exch, pair, a)
+		pairCfg := cfg
+		if cfg.CurrencySettings[i].Interval > 0 {
+			// the pair's data is loaded at its own interval, the event loop
+			// aligns pairs with different intervals by candle close time
+			c := *cfg
+			c.DataSettings.Interval = cfg.CurrencySettings[i].Interval
+			pairCfg = &c
+		}
+		klineData, err := bt.loadData(pairCfg, exch, pair, a)
 		if err != nil {
 			return resp, err
 		}
 		bt.Datas.SetDataForCurrency(exchangeName, a, pair, klineData)
+		if cfg.CurrencySettings[i].PopulateFromExchange {
+			populateFromExchange(context.TODO(), exch, &cfg.CurrencySettings[i], a, pair)
+		}
 		var makerFee, takerFee decimal.Decimal
 		if cfg.CurrencySettings[i].MakerFee.GreaterThan(decimal.Zero) {
 			makerFee = cfg.CurrencySettings[i].MakerFee
@@ -338,7 +512,7 @@ func (bt *BackTest) setupExchangeSettings(cfg *config.Config) (exchange.Exchange
 		}
 		if makerFee.IsZero() || takerFee.IsZero() {
 			var apiMakerFee, apiTakerFee decimal.Decimal
-			apiMakerFee, apiTakerFee = getFees(context.TODO(), exch, pair)
+			apiMakerFee, apiTakerFee = getFees(context.TODO(), exch, pair, gctexchange.OfflineTradeFee)
 			if makerFee.IsZero() {
 				makerFee = apiMakerFee
 			}
@@ -369,26 +543,39 @@ func (bt *BackTest) setupExchangeSettings(cfg *config.Config) (exchange.Exchange
 			cfg.CurrencySettings[i].MaximumSlippagePercent = slippage.DefaultMaximumSlippagePercent
 		}
 
-		realOrders := false
+		realOrders, useOrderRouter := false, false
 		if cfg.DataSettings.LiveData != nil {
 			realOrders = cfg.DataSettings.LiveData.RealOrders
+			useOrderRouter = realOrders && cfg.DataSettings.LiveData.UseOrderRouter
 		}
 
 		buyRule := config.MinMax{
-			MinimumSize:  cfg.CurrencySettings[i].BuySide.MinimumSize,
-			MaximumSize:  cfg.CurrencySettings[i].BuySide.MaximumSize,
-			MaximumTotal: cfg.CurrencySettings[i].BuySide.MaximumTotal,
+			MinimumSize:   cfg.CurrencySettings[i].BuySide.MinimumSize,
+			MaximumSize:   cfg.CurrencySettings[i].BuySide.MaximumSize,
+			MaximumTotal:  cfg.CurrencySettings[i].BuySide.MaximumTotal,
+			NotionalSize:  cfg.CurrencySettings[i].BuySide.NotionalSize,
+			EquityPercent: cfg.CurrencySettings[i].BuySide.EquityPercent,
 		}
 		sellRule := config.MinMax{
-			MinimumSize:  cfg.CurrencySettings[i].SellSide.MinimumSize,
-			MaximumSize:  cfg.CurrencySettings[i].SellSide.MaximumSize,
-			MaximumTotal: cfg.CurrencySettings[i].SellSide.MaximumTotal,
+			MinimumSize:   cfg.CurrencySettings[i].SellSide.MinimumSize,
+			MaximumSize:   cfg.CurrencySettings[i].SellSide.MaximumSize,
+			MaximumTotal:  cfg.CurrencySettings[i].SellSide.MaximumTotal,
+			NotionalSize:  cfg.CurrencySettings[i].SellSide.NotionalSize,
+			EquityPercent: cfg.CurrencySettings[i].SellSide.EquityPercent,
 		}
 
 		limits, err := exch.GetOrderExecutionLimits(a, pair)
 		if err != nil && !errors.Is(err, gctorder.ErrExchangeLimitNotLoaded) {
 			return resp, err
 		}
+		configuredLimits := cfg.CurrencySettings[i].OrderLimits != nil
+		if configuredLimits {
+			limits, err = loadConfiguredLimits(cfg.CurrencySettings[i].OrderLimits, a, pair)
+			if err != nil {
+				return resp, err
+			}
+			cfg.CurrencySettings[i].CanUseExchangeLimits = true
+		}
 
 		if limits != nil {
 			if !cfg.CurrencySettings[i].CanUseExchangeLimits {
@@ -399,8 +586,12 @@ func (bt *BackTest) setupExchangeSettings(cfg *config.Config) (exchange.Exchange
 				cfg.CurrencySettings[i].ShowExchangeOrderLimitWarning = true
 			}
 		}
+		spreads, err := loadSpreads(cfg, cfg.CurrencySettings[i].ExchangeName, a, pair)
+		if err != nil {
+			return resp, err
+		}
 		resp.CurrencySettings = append(resp.CurrencySettings, exchange.Settings{
-			ExchangeName:        cfg.CurrencySettings[i].ExchangeName,
+			ExchangeName:        cfg.CurrencySettings[i].InstanceName(),
 			MinimumSlippageRate: cfg.CurrencySettings[i].MinimumSlippagePercent,
 			MaximumSlippageRate: cfg.CurrencySettings[i].MaximumSlippagePercent,
 			CurrencyPair:        pair,
@@ -409,6 +600,7 @@ func (bt *BackTest) setupExchangeSettings(cfg *config.Config) (exchange.Exchange
 			MakerFee:            takerFee,
 			TakerFee:            makerFee,
 			UseRealOrders:       realOrders,
+			UseOrderRouter:      useOrderRouter,
 			BuySide:             buyRule,
 			SellSide:            sellRule,
 			Leverage: config.Leverage{
@@ -416,15 +608,202 @@ func (bt *BackTest) setupExchangeSettings(cfg *config.Config) (exchange.Exchange
 				MaximumLeverageRate:            cfg.CurrencySettings[i].Leverage.MaximumLeverageRate,
 				MaximumOrdersWithLeverageRatio: cfg.CurrencySettings[i].Leverage.MaximumOrdersWithLeverageRatio,
 			},
-			Limits:                  limits,
-			SkipCandleVolumeFitting: cfg.CurrencySettings[i].SkipCandleVolumeFitting,
-			CanUseExchangeLimits:    cfg.CurrencySettings[i].CanUseExchangeLimits,
+			Limits:                limits,
+			VolumeFitting:         cfg.CurrencySettings[i].VolumeFitting,
+			CanUseExchangeLimits:  cfg.CurrencySettings[i].CanUseExchangeLimits,
+			UsingConfiguredLimits: configuredLimits,
+			FillPrice:             cfg.CurrencySettings[i].FillPrice,
+			FillPriceRand:         rand.New(rand.NewSource(cfg.CurrencySettings[i].FillPriceSeed)), // nolint:gosec // reproducible fill prices are desired
+			Spread:                spreads,
+			Rejection:             loadRejection(cfg.CurrencySettings[i].OrderRejection),
 		})
 	}
 
+	err := bt.setupFeeCurrencies(cfg, resp.CurrencySettings)
+	if err != nil {
+		return resp, err
+	}
+
 	return resp, nil
 }
 
+// setupFeeCurrencies links currency settings which pay fees in a third
+// currency to that currency's funding and, when loaded, the data of its pair
+// against the quote currency to convert fees with
+func (bt *BackTest) setupFeeCurrencies(cfg *config.Config, settings []exchange.Settings) error {
+	for i := range cfg.CurrencySettings {
+		if cfg.CurrencySettings[i].FeeCurrency == nil {
+			continue
+		}
+		cs := &settings[i]
+		code := currency.NewCode(cfg.CurrencySettings[i].FeeCurrency.Currency)
+		funds, err := bt.Funding.GetFundingForEAC(cs.ExchangeName, cs.AssetType, code)
+		if err != nil {
+			return fmt.Errorf("%v %v %v fee currency %w", cs.ExchangeName, cs.AssetType, cs.CurrencyPair, err)
+		}
+		fc := &exchange.FeeCurrency{
+			Currency:       code,
+			DiscountRate:   cfg.CurrencySettings[i].FeeCurrency.DiscountPercent.Div(decimal.NewFromInt(100)),
+			Funds:          funds,
+			ConversionRate: cfg.CurrencySettings[i].FeeCurrency.ConversionRate,
+		}
+		for j := range settings {
+			if settings[j].ExchangeName != cs.ExchangeName || settings[j].AssetType != cs.AssetType {
+				continue
+			}
+			p := settings[j].CurrencyPair
+			switch {
+			case p.Base.Match(code) && p.Quote.Match(cs.CurrencyPair.Quote):
+			case p.Base.Match(cs.CurrencyPair.Quote) && p.Quote.Match(code):
+				fc.InvertConversion = true
+			default:
+				continue
+			}
+			fc.ConversionData = bt.Datas.GetDataForCurrency(strings.ToLower(settings[j].ExchangeName), settings[j].AssetType, p)
+			break
+		}
+		cs.FeeCurrency = fc
+	}
+	return nil
+}
+
+// loadRejection converts the order rejection settings of a currency into
+// the simulated exchange's rejection settings
+func loadRejection(cfg *config.OrderRejection) *exchange.Rejection {
+	if cfg == nil {
+		return nil
+	}
+	return &exchange.Rejection{
+		Rate:               cfg.Percent.Div(decimal.NewFromInt(100)),
+		Rand:               rand.New(rand.NewSource(cfg.Seed)), // nolint:gosec // reproducible rejections are desired
+		MaxRequeues:        cfg.MaxRequeues,
+		BackoffCandles:     cfg.BackoffCandles,
+		ExponentialBackoff: cfg.ExponentialBackoff,
+	}
+}
+
+// loadConfiguredLimits creates order execution limits for an exchange asset
+// pair from the config, allowing simulated orders to follow exchange rules
+// which cannot be fetched, such as historic lot sizes
+func loadConfiguredLimits(o *config.OrderLimits, a asset.Item, pair currency.Pair) (*gctorder.Limits, error) {
+	var executionLimits gctorder.ExecutionLimits
+	err := executionLimits.LoadLimits([]gctorder.MinMaxLevel{{
+		Pair:        pair,
+		Asset:       a,
+		MinAmount:   o.MinimumAmount.InexactFloat64(),
+		MaxAmount:   o.MaximumAmount.InexactFloat64(),
+		StepAmount:  o.AmountStepSize.InexactFloat64(),
+		StepPrice:   o.PriceTickSize.InexactFloat64(),
+		MinNotional: o.MinimumNotional.InexactFloat64(),
+	}})
+	if err != nil {
+		return nil, err
+	}
+	return executionLimits.GetOrderExecutionLimits(a, pair)
+}
+
+// populateFromExchange queries the exchange for its current fees and order
+// limits, populating any the currency settings leave unset. Configured values
+// are kept, with a warning when they differ significantly from the exchange's
+func populateFromExchange(ctx context.Context, exch gctexchange.IBotExchange, cs *config.CurrencySettings, a asset.Item, pair currency.Pair) {
+	name := fmt.Sprintf("%v %v %v", cs.InstanceName(), a, pair)
+	// authenticated exchanges return the account's fee tier, others fall
+	// back to their standard fees
+	makerFee, takerFee := getFees(ctx, exch, pair, gctexchange.CryptocurrencyTradeFee)
+	cs.MakerFee = populateValue(name, "maker fee", cs.MakerFee, makerFee)
+	cs.TakerFee = populateValue(name, "taker fee", cs.TakerFee, takerFee)
+
+	err := exch.UpdateOrderExecutionLimits(ctx, a)
+	if err != nil {
+		log.Warnf(log.BackTester, "%v could not update order limits from exchange: %v", name, err)
+	}
+	limits, err := exch.GetOrderExecutionLimits(a, pair)
+	if err != nil {
+		log.Warnf(log.BackTester, "%v could not retrieve order limits from exchange: %v", name, err)
+		return
+	}
+	populateLimits(name, cs, limits.GetMinMaxLevel())
+}
+
+// populateLimits populates the unset order limits of the currency settings
+// from the exchange's. Order limits are only created when the exchange
+// enforces any
+func populateLimits(name string, cs *config.CurrencySettings, level gctorder.MinMaxLevel) {
+	live := config.OrderLimits{
+		MinimumAmount:   decimal.NewFromFloat(level.MinAmount),
+		MaximumAmount:   decimal.NewFromFloat(level.MaxAmount),
+		AmountStepSize:  decimal.NewFromFloat(level.StepAmount),
+		PriceTickSize:   decimal.NewFromFloat(level.StepPrice),
+		MinimumNotional: decimal.NewFromFloat(level.MinNotional),
+	}
+	if level.MinAmount == 0 &&
+		level.MaxAmount == 0 &&
+		level.StepAmount == 0 &&
+		level.StepPrice == 0 &&
+		level.MinNotional == 0 {
+		return
+	}
+	if cs.OrderLimits == nil {
+		cs.OrderLimits = &config.OrderLimits{}
+	}
+	cs.OrderLimits.MinimumAmount = populateValue(name, "minimum amount", cs.OrderLimits.MinimumAmount, live.MinimumAmount)
+	cs.OrderLimits.MaximumAmount = populateValue(name, "maximum amount", cs.OrderLimits.MaximumAmount, live.MaximumAmount)
+	cs.OrderLimits.AmountStepSize = populateValue(name, "amount step size", cs.OrderLimits.AmountStepSize, live.AmountStepSize)
+	cs.OrderLimits.PriceTickSize = populateValue(name, "price tick size", cs.OrderLimits.PriceTickSize, live.PriceTickSize)
+	cs.OrderLimits.MinimumNotional = populateValue(name, "minimum notional", cs.OrderLimits.MinimumNotional, live.MinimumNotional)
+}
+
+// populateValue returns the live value when the configured value is unset,
+// otherwise the configured value is returned and a warning is logged when it
+// differs from the live value by more than significantDifferencePercent
+func populateValue(name, field string, configured, live decimal.Decimal) decimal.Decimal {
+	if live.IsZero() {
+		return configured
+	}
+	if configured.IsZero() {
+		log.Infof(log.BackTester, "%v %v set to exchange value of %v", name, field, live)
+		return live
+	}
+	difference := configured.Sub(live).Abs().Div(live).Mul(decimal.NewFromInt(100))
+	if difference.GreaterThan(significantDifferencePercent) {
+		log.Warnf(log.BackTester, "%v configured %v of %v differs from exchange value of %v by %v%%, live results may differ",
+			name,
+			field,
+			configured,
+			live,
+			difference.Round(2))
+	}
+	return configured
+}
+
+// loadSpreads creates the spread series for an exchange asset pair from the
+// spread settings, returning nil when spread costs are not modelled
+func loadSpreads(cfg *config.Config, exchangeName string, a asset.Item, pair currency.Pair) (*spread.Series, error) {
+	if cfg.DataSettings.Spread == nil {
+		return nil, nil
+	}
+	series, err := spread.NewSeries(cfg.DataSettings.Spread.StaticSpreadPercent)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.DataSettings.Spread.CSVPath == "" {
+		return series, nil
+	}
+	spreads, err := spread.LoadCSV(cfg.DataSettings.Spread.CSVPath, exchangeName, a, pair)
+	if err != nil {
+		return nil, err
+	}
+	if len(spreads) == 0 {
+		log.Warnf(log.BackTester, "no spreads found in %v for %v %v %v, using static spread of %v%%",
+			cfg.DataSettings.Spread.CSVPath,
+			exchangeName,
+			a,
+			pair,
+			cfg.DataSettings.Spread.StaticSpreadPercent)
+	}
+	return series, series.Add(spreads...)
+}
+
 func (bt *BackTest) loadExchangePairAssetBase(exch, base, quote, ass string) (gctexchange.IBotExchange, currency.Pair, asset.Item, error) {
 	e, err := bt.Bot.GetExchangeByName(exch)
 	if err != nil {
@@ -462,7 +841,11 @@ func (bt *BackTest) setupBot(cfg *config.Config, bot *engine.Engine) error {
 	bt.Bot = bot
 	bt.Bot.ExchangeManager = engine.SetupExchangeManager()
 	for i := range cfg.CurrencySettings {
-		err = bt.Bot.LoadExchange(cfg.CurrencySettings[i].ExchangeName, nil)
+		if cfg.CurrencySettings[i].Account != "" {
+			err = bt.loadAccountExchange(&cfg.CurrencySettings[i])
+		} else {
+			err = bt.Bot.LoadExchange(cfg.CurrencySettings[i].ExchangeName, nil)
+		}
 		if err != nil && !errors.Is(err, engine.ErrExchangeAlreadyLoaded) {
 			return err
 		}
@@ -481,14 +864,93 @@ func (bt *BackTest) setupBot(cfg *config.Config, bot *engine.Engine) error {
 			return err
 		}
 	}
+	if cfg.DataSettings.LiveData != nil &&
+		cfg.DataSettings.LiveData.UseOrderRouter &&
+		!bt.Bot.OrderRouter.IsRunning() {
+		bt.Bot.OrderRouter, err = engine.SetupOrderRouter(
+			bt.Bot.ExchangeManager,
+			bt.Bot.OrderManager,
+			bot.Settings.Verbose)
+		if err != nil {
+			return err
+		}
+		err = bt.Bot.OrderRouter.Start()
+		if err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
 
+// loadAccountExchange loads a separate instance of the currency settings'
+// exchange for its account, named after the exchange and account. Funding,
+// orders and credentials are tracked against the instance's name, keeping
+// them apart from other accounts on the same exchange
+func (bt *BackTest) loadAccountExchange(cs *config.CurrencySettings) error {
+	name := cs.InstanceName()
+	if exch, _ := bt.Bot.ExchangeManager.GetExchangeByName(name); exch != nil {
+		return fmt.Errorf("%s %w", name, engine.ErrExchangeAlreadyLoaded)
+	}
+	exchCfg, err := bt.Bot.Config.GetExchangeConfig(cs.ExchangeName)
+	if err != nil {
+		return err
+	}
+	// a new exchange manager creates the instance even when the exchange is
+	// already loaded for other currency settings
+	exch, err := engine.SetupExchangeManager().NewExchangeByName(cs.ExchangeName)
+	if err != nil {
+		return err
+	}
+	exch.SetDefaults()
+	accountCfg := *exchCfg
+	accountCfg.Enabled = true
+	err = exch.Setup(&accountCfg)
+	if err != nil {
+		return err
+	}
+	base := exch.GetBase()
+	base.Name = name
+	if cs.Credentials != nil {
+		applyCredentials(base, cs.Credentials)
+		base.API.AuthenticatedSupport = base.ValidateAPICredentials()
+	}
+	bt.Bot.ExchangeManager.Add(exch)
+	return nil
+}
+
+// applyCredentials overrides an exchange's API credentials with those set
+func applyCredentials(base *gctexchange.Base, creds *config.Credentials) {
+	if creds.APIKey != "" {
+		base.API.Credentials.Key = creds.APIKey
+	}
+	if creds.APISecret != "" {
+		base.API.Credentials.Secret = creds.APISecret
+	}
+	if creds.APIClientID != "" {
+		base.API.Credentials.ClientID = creds.APIClientID
+	}
+	if creds.API2FA != "" {
+		base.API.Credentials.PEMKey = creds.API2FA
+	}
+	if creds.APISubAccount != "" {
+		base.API.Credentials.Subaccount = creds.APISubAccount
+	}
+}
+
+// dataExchangeName returns the name an exchange's data is stored under, which
+// for account instances is the name of the exchange they were loaded from
+func dataExchangeName(exch gctexchange.IBotExchange) string {
+	if base := exch.GetBase(); base != nil && base.Config != nil && base.Config.Name != "" {
+		return base.Config.Name
+	}
+	return exch.GetName()
+}
+
 // getFees will return an exchange's fee rate from GCT's wrapper function
-func getFees(ctx context.Context, exch gctexchange.IBotExchange, fPair currency.Pair) (makerFee, takerFee decimal.Decimal) {
+func getFees(ctx context.Context, exch gctexchange.IBotExchange, fPair currency.Pair, feeType gctexchange.FeeType) (makerFee, takerFee decimal.Decimal) {
 	fTakerFee, err := exch.GetFeeByType(ctx,
-		&gctexchange.FeeBuilder{FeeType: gctexchange.OfflineTradeFee,
+		&gctexchange.FeeBuilder{FeeType: feeType,
 			Pair:          fPair,
 			IsMaker:       false,
 			PurchasePrice: 1,
@@ -500,7 +962,7 @@ func getFees(ctx context.Context, exch gctexchange.IBotExchange, fPair currency.
 
 	fMakerFee, err := exch.GetFeeByType(ctx,
 		&gctexchange.FeeBuilder{
-			FeeType:       gctexchange.OfflineTradeFee,
+			FeeType:       feeType,
 			Pair:          fPair,
 			IsMaker:       true,
 			PurchasePrice: 1,
@@ -513,6 +975,78 @@ func getFees(ctx context.Context, exch gctexchange.IBotExchange, fPair currency.
 	return decimal.NewFromFloat(fMakerFee), decimal.NewFromFloat(fTakerFee)
 }
 
+// setupFundingConversions allows the exchange level funding of each funding
+// conversion's To currency to be topped up from its From currency
+func (bt *BackTest) setupFundingConversions(cfg *config.Config, funds *funding.FundManager) error {
+	for i := range cfg.StrategySettings.FundingConversions {
+		fc := cfg.StrategySettings.FundingConversions[i]
+		a, err := asset.New(fc.Asset)
+		if err != nil {
+			return err
+		}
+		exchangeName := fc.InstanceName()
+		from, err := funds.GetFundingForEAC(exchangeName, a, currency.NewCode(fc.From))
+		if err != nil {
+			return fmt.Errorf("funding conversion %v %v %v %w", exchangeName, a, fc.From, err)
+		}
+		to, err := funds.GetFundingForEAC(exchangeName, a, currency.NewCode(fc.To))
+		if err != nil {
+			return fmt.Errorf("funding conversion %v %v %v %w", exchangeName, a, fc.To, err)
+		}
+		err = funds.AddConversion(from, to, fc.Fee, bt.conversionRate(cfg, exchangeName, a, fc.From, fc.To))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// conversionRate returns the rate one unit of the from currency converts to
+// the to currency at, using the latest close price of a loaded pair of the two
+// currencies in either direction, or the configured conversion rates when no
+// such pair has data yet
+func (bt *BackTest) conversionRate(cfg *config.Config, exchangeName string, a asset.Item, from, to string) funding.RateFunc {
+	return func() (decimal.Decimal, error) {
+		for exch, assetMap := range bt.Datas.GetAllData() {
+			if !strings.EqualFold(exch, exchangeName) {
+				continue
+			}
+			for p, d := range assetMap[a] {
+				latest := d.Latest()
+				if latest == nil || !latest.ClosePrice().IsPositive() {
+					continue
+				}
+				if strings.EqualFold(p.Base.String(), from) && strings.EqualFold(p.Quote.String(), to) {
+					return latest.ClosePrice(), nil
+				}
+				if strings.EqualFold(p.Base.String(), to) && strings.EqualFold(p.Quote.String(), from) {
+					return decimal.NewFromInt(1).Div(latest.ClosePrice()), nil
+				}
+			}
+		}
+		return cfg.StrategySettings.ConvertFunds(decimal.NewFromInt(1), from, to)
+	}
+}
+
+// getTransferFee returns an exchange's network fee for withdrawing a
+// currency, falling back to the configured fee when the exchange does not
+// provide one
+func getTransferFee(ctx context.Context, exch gctexchange.IBotExchange, c currency.Code, configured decimal.Decimal) decimal.Decimal {
+	fee, err := exch.GetFeeByType(ctx, &gctexchange.FeeBuilder{
+		FeeType: gctexchange.CryptocurrencyWithdrawalFee,
+		Pair:    currency.Pair{Base: c},
+		Amount:  1,
+	})
+	if err != nil {
+		log.Errorf(log.BackTester, "Could not retrieve %v transfer fee for %v. %v", c, exch.GetName(), err)
+		return configured
+	}
+	if fee <= 0 {
+		return configured
+	}
+	return populateValue(exch.GetName()+" "+c.String(), "transfer fee", configured, decimal.NewFromFloat(fee))
+}
+
 // loadData will create kline data from the sources defined in start config files. It can exist from databases, csv or API endpoints
 // it can also be generated from trade data which will be converted into kline data
 func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange, fPair currency.Pair, a asset.Item) (*kline.DataFromKline, error) {
@@ -520,18 +1054,22 @@ func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange,
 		return nil, engine.ErrExchangeNotFound
 	}
 	b := exch.GetBase()
-	if cfg.DataSettings.DatabaseData == nil &&
-		cfg.DataSettings.LiveData == nil &&
-		cfg.DataSettings.APIData == nil &&
-		cfg.DataSettings.CSVData == nil {
+	var sources int
+	for _, set := range []bool{
+		cfg.DataSettings.DatabaseData != nil,
+		cfg.DataSettings.LiveData != nil,
+		cfg.DataSettings.APIData != nil,
+		cfg.DataSettings.CSVData != nil,
+		cfg.DataSettings.FallbackData != nil,
+	} {
+		if set {
+			sources++
+		}
+	}
+	if sources == 0 {
 		return nil, errNoDataSource
 	}
-	if (cfg.DataSettings.APIData != nil && cfg.DataSettings.DatabaseData != nil) ||
-		(cfg.DataSettings.APIData != nil && cfg.DataSettings.LiveData != nil) ||
-		(cfg.DataSettings.APIData != nil && cfg.DataSettings.CSVData != nil) ||
-		(cfg.DataSettings.DatabaseData != nil && cfg.DataSettings.LiveData != nil) ||
-		(cfg.DataSettings.CSVData != nil && cfg.DataSettings.LiveData != nil) ||
-		(cfg.DataSettings.CSVData != nil && cfg.DataSettings.DatabaseData != nil) {
+	if sources > 1 {
 		return nil, errAmbiguousDataSource
 	}
 
@@ -557,8 +1095,10 @@ func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange,
 		if err != nil {
 			return nil, fmt.Errorf("%v. Please check your GoCryptoTrader configuration", err)
 		}
-		resp.Item.RemoveDuplicates()
-		resp.Item.SortCandlesByTimestamp(false)
+		err = repairCandles(&resp.Item)
+		if err != nil {
+			return nil, err
+		}
 		resp.RangeHolder, err = gctkline.CalculateCandleDateRanges(
 			resp.Item.Candles[0].Time,
 			resp.Item.Candles[len(resp.Item.Candles)-1].Time.Add(cfg.DataSettings.Interval),
@@ -577,36 +1117,21 @@ func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange,
 		if cfg.DataSettings.DatabaseData.InclusiveEndDate {
 			cfg.DataSettings.DatabaseData.EndDate = cfg.DataSettings.DatabaseData.EndDate.Add(cfg.DataSettings.Interval)
 		}
-		if cfg.DataSettings.DatabaseData.ConfigOverride != nil {
-			bt.Bot.Config.Database = *cfg.DataSettings.DatabaseData.ConfigOverride
-			gctdatabase.DB.DataPath = filepath.Join(gctcommon.GetDefaultDataDir(runtime.GOOS), "database")
-			err = gctdatabase.DB.SetConfig(cfg.DataSettings.DatabaseData.ConfigOverride)
-			if err != nil {
-				return nil, err
-			}
-		}
-		bt.Bot.DatabaseManager, err = engine.SetupDatabaseConnectionManager(gctdatabase.DB.GetConfig())
-		if err != nil {
-			return nil, err
-		}
-
-		err = bt.Bot.DatabaseManager.Start(&bt.Bot.ServicesWG)
+		err = bt.startDatabaseManager(cfg.DataSettings.DatabaseData.ConfigOverride)
 		if err != nil {
 			return nil, err
 		}
-		defer func() {
-			stopErr := bt.Bot.DatabaseManager.Stop()
-			if stopErr != nil {
-				log.Error(log.BackTester, stopErr)
-			}
-		}()
-		resp, err = loadDatabaseData(cfg, exch.GetName(), fPair, a, dataType)
+		defer bt.stopDatabaseManager()
+		resp, err = loadDatabaseData(cfg, dataExchangeName(exch), fPair, a, dataType)
 		if err != nil {
 			return nil, fmt.Errorf("unable to retrieve data from GoCryptoTrader database. Error: %v. Please ensure the database is setup correctly and has data before use", err)
 		}
+		resp.Item.Exchange = strings.ToLower(exch.GetName())
 
-		resp.Item.RemoveDuplicates()
-		resp.Item.SortCandlesByTimestamp(false)
+		err = repairCandles(&resp.Item)
+		if err != nil {
+			return nil, err
+		}
 		resp.RangeHolder, err = gctkline.CalculateCandleDateRanges(
 			cfg.DataSettings.DatabaseData.StartDate,
 			cfg.DataSettings.DatabaseData.EndDate,
@@ -635,6 +1160,46 @@ func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange,
 		if err != nil {
 			return resp, err
 		}
+	case cfg.DataSettings.FallbackData != nil:
+		if cfg.DataSettings.Interval <= 0 {
+			return nil, errIntervalUnset
+		}
+		if cfg.DataSettings.FallbackData.InclusiveEndDate {
+			cfg.DataSettings.FallbackData.EndDate = cfg.DataSettings.FallbackData.EndDate.Add(cfg.DataSettings.Interval)
+		}
+		details := fallback.Details{
+			Exchange: strings.ToLower(dataExchangeName(exch)),
+			Pair:     fPair,
+			Asset:    a,
+			Interval: gctkline.Interval(cfg.DataSettings.Interval),
+		}
+		sources := make([]fallback.Source, len(cfg.DataSettings.FallbackData.Sources))
+		for i := range cfg.DataSettings.FallbackData.Sources {
+			switch cfg.DataSettings.FallbackData.Sources[i] {
+			case config.DataSourceDatabase:
+				err = bt.startDatabaseManager(cfg.DataSettings.FallbackData.ConfigOverride)
+				if err != nil {
+					return nil, err
+				}
+				defer bt.stopDatabaseManager()
+				sources[i] = &fallback.DatabaseSource{Details: details}
+			case config.DataSourceCache:
+				sources[i] = &fallback.CacheSource{Details: details, Directory: cfg.DataSettings.FallbackData.CacheDirectory}
+			case config.DataSourceAPI:
+				sources[i] = &fallback.APISource{Details: details, Exch: exch}
+			default:
+				return nil, fmt.Errorf("%w '%v'", errUnknownFallbackSource, cfg.DataSettings.FallbackData.Sources[i])
+			}
+		}
+		resp, err = fallback.LoadData(sources,
+			details,
+			cfg.DataSettings.FallbackData.StartDate,
+			cfg.DataSettings.FallbackData.EndDate)
+		if err != nil {
+			return nil, err
+		}
+		resp.Item.Exchange = strings.ToLower(exch.GetName())
+		removeTrailingEmptyCandles(&resp.Item)
 	case cfg.DataSettings.LiveData != nil:
 		if len(cfg.CurrencySettings) > 1 {
 			return nil, errors.New("live data simulation only supports one currency")
@@ -643,6 +1208,9 @@ func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange,
 		if err != nil {
 			return nil, err
 		}
+		if cfg.DataSettings.LiveData.RealOrders {
+			bt.reconciliation = cfg.DataSettings.LiveData.Reconciliation
+		}
 		go bt.loadLiveDataLoop(
 			resp,
 			cfg,
@@ -671,6 +1239,33 @@ func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange,
 	return resp, nil
 }
 
+// startDatabaseManager connects to the GoCryptoTrader database, using the
+// config override when one is provided
+func (bt *BackTest) startDatabaseManager(override *gctdatabase.Config) error {
+	var err error
+	if override != nil {
+		bt.Bot.Config.Database = *override
+		gctdatabase.DB.DataPath = filepath.Join(gctcommon.GetDefaultDataDir(runtime.GOOS), "database")
+		err = gctdatabase.DB.SetConfig(override)
+		if err != nil {
+			return err
+		}
+	}
+	bt.Bot.DatabaseManager, err = engine.SetupDatabaseConnectionManager(gctdatabase.DB.GetConfig())
+	if err != nil {
+		return err
+	}
+	return bt.Bot.DatabaseManager.Start(&bt.Bot.ServicesWG)
+}
+
+// stopDatabaseManager disconnects from the GoCryptoTrader database
+func (bt *BackTest) stopDatabaseManager() {
+	err := bt.Bot.DatabaseManager.Stop()
+	if err != nil {
+		log.Error(log.BackTester, err)
+	}
+}
+
 func loadDatabaseData(cfg *config.Config, name string, fPair currency.Pair, a asset.Item, dataType int64) (*kline.DataFromKline, error) {
 	if cfg == nil || cfg.DataSettings.DatabaseData == nil {
 		return nil, errors.New("nil config data received")
@@ -689,6 +1284,20 @@ func loadDatabaseData(cfg *config.Config, name string, fPair currency.Pair, a as
 		a)
 }
 
+// repairCandles sorts candles and removes duplicates, then warns of any
+// remaining integrity issues as they cannot be repaired without altering the
+// data's prices or timestamps
+func repairCandles(item *gctkline.Item) error {
+	err := item.Repair(gctkline.UnorderedCandles | gctkline.DuplicateCandles)
+	if err != nil {
+		return err
+	}
+	if err = item.Validate(); err != nil {
+		log.Warnln(log.BackTester, err)
+	}
+	return nil
+}
+
 func loadAPIData(cfg *config.Config, exch gctexchange.IBotExchange, fPair currency.Pair, a asset.Item, resultLimit uint32, dataType int64) (*kline.DataFromKline, error) {
 	if cfg.DataSettings.Interval <= 0 {
 		return nil, errIntervalUnset
@@ -712,6 +1321,10 @@ func loadAPIData(cfg *config.Config, exch gctexchange.IBotExchange, fPair curren
 	if err != nil {
 		return nil, fmt.Errorf("%v. Please check your GoCryptoTrader configuration", err)
 	}
+	err = repairCandles(candles)
+	if err != nil {
+		return nil, err
+	}
 	dates.SetHasDataFromCandles(candles.Candles)
 	summary := dates.DataSummary(false)
 	if len(summary) > 0 {
@@ -719,12 +1332,37 @@ func loadAPIData(cfg *config.Config, exch gctexchange.IBotExchange, fPair curren
 	}
 	candles.FillMissingDataWithEmptyEntries(dates)
 	candles.RemoveOutsideRange(cfg.DataSettings.APIData.StartDate, cfg.DataSettings.APIData.EndDate)
+	removeTrailingEmptyCandles(candles)
 	return &kline.DataFromKline{
 		Item:        *candles,
 		RangeHolder: dates,
 	}, nil
 }
 
+// removeTrailingEmptyCandles removes the empty candles filled in after a pair's
+// final candle so that a pair whose data ends early, such as from delisting, is
+// terminated at its final candle rather than valued at zero until the end date
+func removeTrailingEmptyCandles(k *gctkline.Item) {
+	last := len(k.Candles)
+	for last > 0 {
+		c := k.Candles[last-1]
+		if c.Open != 0 || c.High != 0 || c.Low != 0 || c.Close != 0 || c.Volume != 0 {
+			break
+		}
+		last--
+	}
+	if last == 0 || last == len(k.Candles) {
+		return
+	}
+	log.Warnf(log.BackTester, "%v %v %v data ends at %v, %v empty candles removed and the pair will be treated as delisted",
+		k.Exchange,
+		k.Asset,
+		k.Pair,
+		k.Candles[last-1].Time.Format(gctcommon.SimpleTimeFormat),
+		len(k.Candles)-last)
+	k.Candles = k.Candles[:last]
+}
+
 func loadLiveData(cfg *config.Config, base *gctexchange.Base) error {
 	if cfg == nil || base == nil || cfg.DataSettings.LiveData == nil {
 		return common.ErrNilArguments
@@ -761,21 +1399,75 @@ func loadLiveData(cfg *config.Config, base *gctexchange.Base) error {
 // save them and then handle the event based on its type
 func (bt *BackTest) Run() error {
 	log.Info(log.BackTester, "running backtester against pre-defined data")
+	defer bt.closeEventLog()
+	defer bt.closeStrategy()
+	defer bt.exportAuditLog()
+	bt.setEventsTotal()
+	defer bt.logProgress(true)
+	terminated := make(map[data.Handler]bool)
+	// timerTime is the time of the latest data events, which the strategy's
+	// timers are checked against once those events have been processed
+	var timerTime time.Time
 dataLoadingIssue:
 	for ev := bt.EventQueue.NextEvent(); ; ev = bt.EventQueue.NextEvent() {
+		if ev == nil && !timerTime.IsZero() {
+			appended, err := bt.fireTimers(timerTime)
+			if err != nil {
+				return err
+			}
+			timerTime = time.Time{}
+			if appended {
+				continue
+			}
+		}
 		if ev == nil {
+			var hasData bool
+			var closeTime, eventTime time.Time
+			var ended []data.Handler
 			dataHandlerMap := bt.Datas.GetAllData()
 			for exchangeName, exchangeMap := range dataHandlerMap {
 				for assetItem, assetMap := range exchangeMap {
-					var hasProcessedData bool
 					for currencyPair, dataHandler := range assetMap {
-						d := dataHandler.Next()
-						if d == nil {
+						if terminated[dataHandler] {
+							continue
+						}
+						upcoming := dataHandler.List()
+						if len(upcoming) == 0 {
 							if !bt.hasHandledEvent {
 								log.Errorf(log.BackTester, "Unable to perform `Next` for %v %v %v", exchangeName, assetItem, currencyPair)
+								break dataLoadingIssue
 							}
-							break dataLoadingIssue
+							ended = append(ended, dataHandler)
+							continue
 						}
+						if !hasData || candleCloseTime(upcoming[0]).Before(closeTime) {
+							closeTime = candleCloseTime(upcoming[0])
+							eventTime = upcoming[0].GetTime()
+						}
+						hasData = true
+					}
+				}
+			}
+			if !hasData {
+				break dataLoadingIssue
+			}
+			// pairs can use different intervals, so only the pairs whose
+			// next candle closes first are advanced. Pairs with longer
+			// intervals keep their latest closed candle until their next
+			// candle closes
+			for _, exchangeMap := range dataHandlerMap {
+				for _, assetMap := range exchangeMap {
+					var hasProcessedData bool
+					for _, dataHandler := range assetMap {
+						if terminated[dataHandler] {
+							continue
+						}
+						upcoming := dataHandler.List()
+						if len(upcoming) == 0 || candleCloseTime(upcoming[0]).After(closeTime) {
+							continue
+						}
+						d := dataHandler.Next()
+						atomic.AddInt64(&bt.eventsProcessed, 1)
 						if bt.Strategy.UsingSimultaneousProcessing() && hasProcessedData {
 							continue
 						}
@@ -784,6 +1476,22 @@ dataLoadingIssue:
 					}
 				}
 			}
+			bt.logProgress(false)
+			if !bt.waitForEventTime(eventTime) {
+				log.Info(log.BackTester, "shutdown received, stopping run")
+				break dataLoadingIssue
+			}
+			timerTime = eventTime
+			// the data of these pairs ended before the rest of the run,
+			// such as from delisting, so their positions are closed and
+			// the run continues without them
+			for i := range ended {
+				terminated[ended[i]] = true
+				err := bt.terminatePair(ended[i].Latest())
+				if err != nil {
+					return err
+				}
+			}
 		}
 		if ev != nil {
 			err := bt.handleEvent(ev)
@@ -799,6 +1507,273 @@ dataLoadingIssue:
 	return nil
 }
 
+// candleCloseTime returns the time a data event's candle closes, which is
+// used to align pairs with different intervals
+func candleCloseTime(d common.DataEventHandler) time.Time {
+	return d.GetTime().Add(d.GetInterval().Duration())
+}
+
+// waitForEventTime waits for the clock to reach the time of the next data
+// events, returning false when the run has been stopped
+func (bt *BackTest) waitForEventTime(t time.Time) bool {
+	if bt.Clock == nil {
+		return true
+	}
+	return bt.Clock.WaitUntil(t, bt.shutdown)
+}
+
+// fireTimers calls the strategy's timers which are due at the time, appending
+// the signals they return to the event queue. It returns whether any signals
+// were appended
+func (bt *BackTest) fireTimers(t time.Time) (bool, error) {
+	handler, isHandler := bt.Strategy.(strategies.TimerHandler)
+	if !isHandler {
+		return false, nil
+	}
+	timers := handler.Timers()
+	if len(timers) == 0 {
+		return false, nil
+	}
+	var dataHandlers []data.Handler
+	for _, exchangeMap := range bt.Datas.GetAllData() {
+		for _, assetMap := range exchangeMap {
+			for _, dataHandler := range assetMap {
+				if dataHandler.Latest() == nil {
+					continue
+				}
+				dataHandlers = append(dataHandlers, dataHandler)
+			}
+		}
+	}
+	if len(dataHandlers) == 0 {
+		// timers cannot signal until there is data to signal against
+		return false, nil
+	}
+	var appended bool
+	for i := range timers {
+		scheduled, due := timers[i].Due(t)
+		if !due {
+			continue
+		}
+		signals, err := timers[i].OnTimer(scheduled, dataHandlers, bt.Funding)
+		bt.applySettingsChanges()
+		if err != nil {
+			return appended, fmt.Errorf("timer %v scheduled at %v: %w", timers[i].Name, scheduled, err)
+		}
+		for j := range signals {
+			if signals[j] == nil {
+				continue
+			}
+			bt.EventQueue.AppendEvent(signals[j])
+			appended = true
+		}
+	}
+	return appended, nil
+}
+
+// applySettingsChanges applies the custom settings changes the strategy has
+// requested since it last signalled, recording each change in the compliance
+// log of the pair it was requested against and in the report's settings
+// timeline. A change which the strategy rejects is logged and its settings
+// restored
+func (bt *BackTest) applySettingsChanges() {
+	handler, isHandler := bt.Strategy.(strategies.SettingsAdjustmentHandler)
+	if !isHandler {
+		return
+	}
+	changes := handler.PendingSettingsChanges()
+	for i := range changes {
+		previous := bt.Strategy.CustomSettings()
+		merged := make(map[string]interface{}, len(previous)+len(changes[i].Settings))
+		for k, v := range previous {
+			merged[k] = v
+		}
+		var changed []compliance.SettingChange
+		for k, v := range changes[i].Settings {
+			merged[k] = v
+			changed = append(changed, compliance.SettingChange{
+				Setting:  k,
+				Previous: previous[k],
+				Value:    v,
+			})
+		}
+		sort.Slice(changed, func(x, y int) bool {
+			return changed[x].Setting < changed[y].Setting
+		})
+		err := bt.Strategy.SetCustomSettings(merged)
+		if err != nil {
+			log.Errorf(log.BackTester, "could not change strategy custom settings %v: %v", changes[i].Settings, err)
+			if previous != nil {
+				err = bt.Strategy.SetCustomSettings(previous)
+				if err != nil {
+					log.Errorf(log.BackTester, "could not restore strategy custom settings: %v", err)
+				}
+			}
+			continue
+		}
+		ev := changes[i].Event
+		log.Infof(log.BackTester, "%v %v %v strategy custom settings changed %v, %v", ev.GetExchange(), ev.GetAssetType(), ev.Pair(), changes[i].Settings, changes[i].Reason)
+		cm, err := bt.Portfolio.GetComplianceManager(ev.GetExchange(), ev.GetAssetType(), ev.Pair())
+		if err != nil {
+			log.Error(log.BackTester, err)
+			continue
+		}
+		err = cm.AddSettingsChange(ev, changes[i].Reason, changed)
+		if err != nil {
+			log.Error(log.BackTester, err)
+			continue
+		}
+		if bt.Reports != nil {
+			bt.Reports.AddSettingsChange(&cm.Transitions[len(cm.Transitions)-1])
+		}
+	}
+}
+
+// terminatePair handles a pair whose data has ended before the rest of the
+// run by marking it as terminated in the statistics and closing its position
+// at the final candle
+func (bt *BackTest) terminatePair(latest common.DataEventHandler) error {
+	if latest == nil {
+		return errNilData
+	}
+	log.Warnf(log.BackTester, "%v %v %v data ended at %v before the end of the run, closing positions",
+		latest.GetExchange(),
+		latest.GetAssetType(),
+		latest.Pair(),
+		latest.GetTime().Format(gctcommon.SimpleTimeFormat))
+	err := bt.Statistic.SetPairTerminated(latest)
+	if err != nil {
+		log.Error(log.BackTester, err)
+	}
+	if bt.Funding.IsUsingExchangeLevelFunding() {
+		// exchange level funds are shared with other pairs and must not be
+		// sold on behalf of a single pair
+		log.Warnf(log.BackTester, "%v %v %v exchange level funding is in use, funds will remain available to other pairs",
+			latest.GetExchange(),
+			latest.GetAssetType(),
+			latest.Pair())
+		return nil
+	}
+	funds, err := bt.Funding.GetFundingForEAP(latest.GetExchange(), latest.GetAssetType(), latest.Pair())
+	if err != nil {
+		return err
+	}
+	amount := funds.BaseAvailable()
+	if !amount.IsPositive() {
+		return nil
+	}
+	err = funds.Reserve(amount, gctorder.Sell)
+	if err != nil {
+		return err
+	}
+	o := &order.Order{
+		Base: event.Base{
+			Offset:       latest.GetOffset(),
+			Exchange:     latest.GetExchange(),
+			Time:         latest.GetTime(),
+			CurrencyPair: latest.Pair(),
+			AssetType:    latest.GetAssetType(),
+			Interval:     latest.GetInterval(),
+			Reason:       "data ended before the end of the run, closing position",
+		},
+		Direction:      gctorder.Sell,
+		Price:          latest.ClosePrice(),
+		Amount:         amount,
+		AllocatedFunds: amount,
+		OrderType:      gctorder.Market,
+	}
+	err = bt.Statistic.SetEventForOffset(o)
+	if err != nil {
+		log.Error(log.BackTester, err)
+	}
+	bt.EventQueue.AppendEvent(o)
+	return nil
+}
+
+// setEventsTotal sets the amount of data events a run will process
+func (bt *BackTest) setEventsTotal() {
+	var total int64
+	for _, exchangeMap := range bt.Datas.GetAllData() {
+		for _, assetMap := range exchangeMap {
+			for _, dataHandler := range assetMap {
+				total += int64(len(dataHandler.GetStream()))
+			}
+		}
+	}
+	atomic.StoreInt64(&bt.eventsProcessed, 0)
+	atomic.StoreInt64(&bt.eventsTotal, total)
+	atomic.StoreInt64(&bt.runStarted, time.Now().UnixNano())
+	bt.lastProgressLog = time.Now()
+}
+
+// Progress returns the amount of data events processed and the total amount
+// of data events in the run, it is safe to call while a run is in progress
+func (bt *BackTest) Progress() (processed, total int64) {
+	return atomic.LoadInt64(&bt.eventsProcessed), atomic.LoadInt64(&bt.eventsTotal)
+}
+
+// ProgressReport returns the progress of the run along with its processing
+// rate and estimated time remaining, it is safe to call while a run is in
+// progress
+func (bt *BackTest) ProgressReport() ProgressReport {
+	var resp ProgressReport
+	resp.Processed, resp.Total = bt.Progress()
+	if resp.Total > 0 {
+		resp.Percent = float64(resp.Processed) / float64(resp.Total) * 100
+	}
+	started := atomic.LoadInt64(&bt.runStarted)
+	if started == 0 {
+		return resp
+	}
+	resp.Elapsed = time.Since(time.Unix(0, started))
+	if resp.Processed == 0 || resp.Elapsed <= 0 {
+		return resp
+	}
+	resp.EventsPerSecond = float64(resp.Processed) / resp.Elapsed.Seconds()
+	if remaining := resp.Total - resp.Processed; remaining > 0 {
+		resp.ETA = time.Duration(float64(remaining) / resp.EventsPerSecond * float64(time.Second))
+	}
+	return resp
+}
+
+// SetProgressInterval sets how often the progress of a run is logged, a zero
+// interval disables progress logging
+func (bt *BackTest) SetProgressInterval(interval time.Duration) {
+	bt.progressInterval = interval
+}
+
+// logProgress logs the progress of the run once the progress interval has
+// passed since it was last logged, or regardless when final is set
+func (bt *BackTest) logProgress(final bool) {
+	if bt.progressInterval <= 0 || (!final && time.Since(bt.lastProgressLog) < bt.progressInterval) {
+		return
+	}
+	bt.lastProgressLog = time.Now()
+	log.Infof(log.BackTester, "progress %v", bt.ProgressReport())
+}
+
+// String returns the progress as a progress bar followed by the amount of
+// data events processed, the processing rate and estimated time remaining
+func (p ProgressReport) String() string {
+	filled := int(p.Percent / 100 * progressBarWidth)
+	if filled > progressBarWidth {
+		filled = progressBarWidth
+	}
+	eta := "unknown"
+	if p.EventsPerSecond > 0 {
+		eta = p.ETA.Round(time.Second).String()
+	}
+	return fmt.Sprintf("[%s%s] %.2f%% %v/%v candles, %.2f events/sec, elapsed %v, ETA %v",
+		strings.Repeat("#", filled),
+		strings.Repeat("-", progressBarWidth-filled),
+		p.Percent,
+		p.Processed,
+		p.Total,
+		p.EventsPerSecond,
+		p.Elapsed.Round(time.Second),
+		eta)
+}
+
 // handleEvent is the main processor of data for the backtester
 // after data has been loaded and Run has appended a data event to the queue,
 // handle event will process events and add further events to the queue if they
@@ -806,19 +1781,27 @@ dataLoadingIssue:
 func (bt *BackTest) handleEvent(ev common.EventHandler) error {
 	funds, err := bt.Funding.GetFundingForEvent(ev)
 	if err != nil {
+		bt.eventLog.RecordError(ev, err)
 		return err
 	}
 	switch eType := ev.(type) {
 	case common.DataEventHandler:
+		bt.Funding.ConfirmTransfers(eType.GetTime())
+		bt.resubmitRequeuedOrders()
 		if bt.Strategy.UsingSimultaneousProcessing() {
+			// each pair's data event is recorded as it is processed
 			return bt.processSimultaneousDataEvents()
 		}
+		bt.eventLog.Record(eType)
 		return bt.processSingleDataEvent(eType, funds)
 	case signal.Event:
+		bt.eventLog.Record(eType)
 		bt.processSignalEvent(eType, funds)
 	case order.Event:
+		bt.eventLog.Record(eType)
 		bt.processOrderEvent(eType, funds)
 	case fill.Event:
+		bt.eventLog.Record(eType)
 		bt.processFillEvent(eType, funds)
 	default:
 		return fmt.Errorf("%w %v received, could not process",
@@ -836,7 +1819,9 @@ func (bt *BackTest) processSingleDataEvent(ev common.DataEventHandler, funds fun
 	}
 	d := bt.Datas.GetDataForCurrency(ev.GetExchange(), ev.GetAssetType(), ev.Pair())
 	s, err := bt.Strategy.OnSignal(d, bt.Funding)
+	bt.applySettingsChanges()
 	if err != nil {
+		bt.eventLog.RecordError(ev, err)
 		if errors.Is(err, base.ErrTooMuchBadData) {
 			// too much bad data is a severe error and backtesting must cease
 			return err
@@ -868,6 +1853,11 @@ func (bt *BackTest) processSimultaneousDataEvents() error {
 		for _, assetMap := range exchangeMap {
 			for _, dataHandler := range assetMap {
 				latestData := dataHandler.Latest()
+				if latestData == nil {
+					// pairs with longer intervals have no data until
+					// their first candle closes
+					continue
+				}
 				funds, err := bt.Funding.GetFundingForEAP(latestData.GetExchange(), latestData.GetAssetType(), latestData.Pair())
 				if err != nil {
 					return err
@@ -876,19 +1866,42 @@ func (bt *BackTest) processSimultaneousDataEvents() error {
 				if err != nil && err == statistics.ErrAlreadyProcessed {
 					continue
 				}
+				bt.eventLog.Record(latestData)
 				dataEvents = append(dataEvents, dataHandler)
 			}
 		}
 	}
+	var unselectedSignals []signal.Event
+	if bt.Universe != nil {
+		var unselected []data.Handler
+		var err error
+		dataEvents, unselected, err = bt.Universe.Select(dataEvents)
+		if err != nil {
+			return err
+		}
+		for i := range unselected {
+			var s signal.Event
+			s, err = bt.createUnselectedSignal(unselected[i])
+			if err != nil {
+				return err
+			}
+			unselectedSignals = append(unselectedSignals, s)
+		}
+	}
 	signals, err := bt.Strategy.OnSimultaneousSignals(dataEvents, bt.Funding)
+	bt.applySettingsChanges()
 	if err != nil {
+		for i := range dataEvents {
+			bt.eventLog.RecordError(dataEvents[i].Latest(), err)
+		}
 		if errors.Is(err, base.ErrTooMuchBadData) {
 			// too much bad data is a severe error and backtesting must cease
 			return err
 		}
 		log.Error(log.BackTester, err)
-		return nil
+		signals = nil
 	}
+	signals = append(signals, unselectedSignals...)
 	for i := range signals {
 		err = bt.Statistic.SetEventForOffset(signals[i])
 		if err != nil {
@@ -899,6 +1912,45 @@ func (bt *BackTest) processSimultaneousDataEvents() error {
 	return nil
 }
 
+// createUnselectedSignal creates a signal for a pair which is not in the
+// current universe selection, selling any holdings so that funds are only
+// allocated to selected pairs
+func (bt *BackTest) createUnselectedSignal(d data.Handler) (signal.Event, error) {
+	latest := d.Latest()
+	if latest == nil {
+		return nil, common.ErrNilEvent
+	}
+	s := &signal.Signal{
+		Base: event.Base{
+			Offset:       latest.GetOffset(),
+			Exchange:     latest.GetExchange(),
+			Time:         latest.GetTime(),
+			CurrencyPair: latest.Pair(),
+			AssetType:    latest.GetAssetType(),
+			Interval:     latest.GetInterval(),
+			Reason:       "not in universe selection",
+		},
+		OpenPrice:  latest.OpenPrice(),
+		HighPrice:  latest.HighPrice(),
+		LowPrice:   latest.LowPrice(),
+		ClosePrice: latest.ClosePrice(),
+		Direction:  common.DoNothing,
+	}
+	if !d.HasDataAtTime(latest.GetTime()) {
+		s.SetDirection(common.MissingData)
+		return s, nil
+	}
+	funds, err := bt.Funding.GetFundingForEvent(s)
+	if err != nil {
+		return nil, err
+	}
+	if funds.BaseAvailable().IsPositive() {
+		s.SetDirection(gctorder.Sell)
+		s.AppendReason("selling holdings")
+	}
+	return s, nil
+}
+
 // updateStatsForDataEvent makes various systems aware of price movements from
 // data events
 func (bt *BackTest) updateStatsForDataEvent(ev common.DataEventHandler, funds funding.IPairReader) error {
@@ -922,12 +1974,14 @@ func (bt *BackTest) updateStatsForDataEvent(ev common.DataEventHandler, funds fu
 func (bt *BackTest) processSignalEvent(ev signal.Event, funds funding.IPairReserver) {
 	cs, err := bt.Exchange.GetCurrencySettings(ev.GetExchange(), ev.GetAssetType(), ev.Pair())
 	if err != nil {
+		bt.eventLog.RecordError(ev, err)
 		log.Error(log.BackTester, err)
 		return
 	}
 	var o *order.Order
 	o, err = bt.Portfolio.OnSignal(ev, &cs, funds)
 	if err != nil {
+		bt.eventLog.RecordError(ev, err)
 		log.Error(log.BackTester, err)
 		return
 	}
@@ -941,24 +1995,161 @@ func (bt *BackTest) processSignalEvent(ev signal.Event, funds funding.IPairReser
 
 func (bt *BackTest) processOrderEvent(ev order.Event, funds funding.IPairReleaser) {
 	d := bt.Datas.GetDataForCurrency(ev.GetExchange(), ev.GetAssetType(), ev.Pair())
+	submitted := ev.GetDirection() == gctorder.Buy || ev.GetDirection() == gctorder.Sell
+	if submitted {
+		bt.recordTransition(compliance.StageSubmitted, ev, ev.GetDirection(), ev.GetPrice(), ev.GetAmount())
+	}
 	f, err := bt.Exchange.ExecuteOrder(ev, d, bt.Bot, funds)
 	if err != nil {
+		bt.eventLog.RecordError(ev, err)
 		if f == nil {
 			log.Errorf(log.BackTester, "fill event should always be returned, please fix, %v", err)
 			return
 		}
 		log.Errorf(log.BackTester, "%v %v %v %v", f.GetExchange(), f.GetAssetType(), f.Pair(), err)
 	}
+	rejected := errors.Is(err, exchange.ErrOrderRejected)
+	if submitted {
+		stage := compliance.StageFilled
+		if f.GetDirection() != gctorder.Buy && f.GetDirection() != gctorder.Sell {
+			stage = compliance.StageRejected
+		}
+		bt.recordTransition(stage, f, f.GetDirection(), f.GetPurchasePrice(), f.GetAmount())
+	}
 	err = bt.Statistic.SetEventForOffset(f)
 	if err != nil {
 		log.Error(log.BackTester, err)
 	}
 	bt.EventQueue.AppendEvent(f)
+	if rejected {
+		bt.handleRejection(ev, d)
+	} else if o, ok := ev.(*order.Order); ok {
+		delete(bt.rejections, o)
+	}
+}
+
+// handleRejection notifies the strategy of a rejected order and requeues the
+// order when the currency settings allow another attempt and the strategy has
+// not cancelled it
+func (bt *BackTest) handleRejection(ev order.Event, d data.Handler) {
+	rejection := &order.Rejection{Order: ev}
+	o, ok := ev.(*order.Order)
+	if ok {
+		if bt.rejections == nil {
+			bt.rejections = make(map[*order.Order]int64)
+		}
+		bt.rejections[o]++
+		rejection.Attempt = bt.rejections[o]
+	}
+	cs, err := bt.Exchange.GetCurrencySettings(ev.GetExchange(), ev.GetAssetType(), ev.Pair())
+	if err != nil {
+		log.Error(log.BackTester, err)
+	} else if ok && cs.Rejection != nil && rejection.Attempt <= cs.Rejection.MaxRequeues {
+		rejection.Requeued = true
+		rejection.RetryAfter = cs.Rejection.Backoff(rejection.Attempt)
+	}
+	if handler, isHandler := bt.Strategy.(strategies.OrderRejectionHandler); isHandler && !handler.OnOrderRejected(rejection) {
+		rejection.Requeued = false
+	}
+	if !rejection.Requeued {
+		delete(bt.rejections, o)
+		return
+	}
+	latest := d.Latest()
+	log.Infof(log.BackTester, "%v %v %v order rejected %v times, resubmitting in %v candles",
+		ev.GetExchange(),
+		ev.GetAssetType(),
+		ev.Pair(),
+		rejection.Attempt,
+		rejection.RetryAfter)
+	bt.requeued = append(bt.requeued, requeuedOrder{
+		order:   o,
+		retryAt: latest.GetTime().Add(time.Duration(rejection.RetryAfter) * latest.GetInterval().Duration()),
+	})
+	if rejection.RetryAfter == 0 {
+		bt.resubmitRequeuedOrders()
+	}
+}
+
+// resubmitRequeuedOrders reserves funds for and appends each requeued order
+// whose pair's data has reached its retry time to the event queue. Orders
+// take the time of their pair's latest data event and are dropped when their
+// funds are no longer available
+func (bt *BackTest) resubmitRequeuedOrders() {
+	remaining := bt.requeued[:0]
+	for i := range bt.requeued {
+		o := bt.requeued[i].order
+		d := bt.Datas.GetDataForCurrency(o.GetExchange(), o.GetAssetType(), o.Pair())
+		if d == nil {
+			delete(bt.rejections, o)
+			continue
+		}
+		latest := d.Latest()
+		if latest == nil || latest.GetTime().Before(bt.requeued[i].retryAt) {
+			remaining = append(remaining, bt.requeued[i])
+			continue
+		}
+		funds, err := bt.Funding.GetFundingForEAP(o.GetExchange(), o.GetAssetType(), o.Pair())
+		if err == nil {
+			err = funds.Reserve(o.GetAllocatedFunds(), o.GetDirection())
+		}
+		if err != nil {
+			log.Errorf(log.BackTester, "%v %v %v could not resubmit rejected order, %v", o.GetExchange(), o.GetAssetType(), o.Pair(), err)
+			delete(bt.rejections, o)
+			continue
+		}
+		o.Offset = latest.GetOffset()
+		o.Time = latest.GetTime()
+		o.AppendReason(fmt.Sprintf("resubmitted after %v rejections", bt.rejections[o]))
+		err = bt.Statistic.SetEventForOffset(o)
+		if err != nil {
+			log.Error(log.BackTester, err)
+		}
+		bt.EventQueue.AppendEvent(o)
+	}
+	bt.requeued = remaining
+}
+
+// recordTransition adds the stage an event's order has reached to the audit
+// log of its compliance manager
+func (bt *BackTest) recordTransition(stage string, ev common.EventHandler, direction gctorder.Side, price, amount decimal.Decimal) {
+	cm, err := bt.Portfolio.GetComplianceManager(ev.GetExchange(), ev.GetAssetType(), ev.Pair())
+	if err != nil {
+		log.Error(log.BackTester, err)
+		return
+	}
+	err = cm.AddTransition(stage, ev, direction, price, amount)
+	if err != nil {
+		log.Error(log.BackTester, err)
+	}
+}
+
+// exportAuditLog writes every stage of each order's lifecycle to the
+// configured audit log as JSON lines
+func (bt *BackTest) exportAuditLog() {
+	if bt.auditLogPath == "" {
+		return
+	}
+	f, err := os.OpenFile(bt.auditLogPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		log.Errorf(log.BackTester, "could not export order audit log, %v", err)
+		return
+	}
+	err = compliance.WriteAuditLog(f, bt.Portfolio.GetAuditLog())
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		log.Errorf(log.BackTester, "could not export order audit log, %v", err)
+		return
+	}
+	log.Infof(log.BackTester, "order audit log exported to %v", bt.auditLogPath)
 }
 
 func (bt *BackTest) processFillEvent(ev fill.Event, funds funding.IPairReader) {
 	t, err := bt.Portfolio.OnFill(ev, funds)
 	if err != nil {
+		bt.eventLog.RecordError(ev, err)
 		log.Error(log.BackTester, err)
 		return
 	}
@@ -997,18 +2188,47 @@ func (bt *BackTest) processFillEvent(ev fill.Event, funds funding.IPairReader) {
 // once new data is processed. It will run until application close event has been received
 func (bt *BackTest) RunLive() error {
 	log.Info(log.BackTester, "running backtester against live data")
+	defer bt.closeEventLog()
+	defer bt.closeStrategy()
+	defer bt.exportAuditLog()
 	timeoutTimer := time.NewTimer(time.Minute * 5)
 	// a frequent timer so that when a new candle is released by an exchange
 	// that it can be processed quickly
 	processEventTicker := time.NewTicker(time.Second)
+	var reconcileTicker <-chan time.Time
+	if bt.reconciliation != nil {
+		// records the balances to compare against before any orders are placed
+		err := bt.reconcile()
+		if err != nil {
+			log.Errorf(log.BackTester, "could not reconcile live funding, %v", err)
+		}
+		t := time.NewTicker(bt.reconciliation.Interval)
+		defer t.Stop()
+		reconcileTicker = t.C
+	}
 	doneARun := false
 	for {
 		select {
 		case <-bt.shutdown:
 			return nil
 		case <-timeoutTimer.C:
+			if atomic.LoadInt32(&bt.liveDataPaused) == 1 {
+				// no data is expected while the exchange is under maintenance
+				timeoutTimer.Reset(time.Minute * 5)
+				continue
+			}
 			return errLiveDataTimeout
+		case <-reconcileTicker:
+			err := bt.reconcile()
+			if err != nil {
+				log.Errorf(log.BackTester, "could not reconcile live funding, %v", err)
+			}
 		case <-processEventTicker.C:
+			// live timers fire by the wall clock rather than by candles
+			_, err := bt.fireTimers(time.Now())
+			if err != nil {
+				return err
+			}
 			for e := bt.EventQueue.NextEvent(); ; e = bt.EventQueue.NextEvent() {
 				if e == nil {
 					// as live only supports singular currency, just get the proper reference manually
@@ -1042,6 +2262,80 @@ func (bt *BackTest) RunLive() error {
 	}
 }
 
+// reconcile compares real exchange balances and orders against the
+// backtester's funding and fills, warning of any drift so that a live run does
+// not silently diverge from its model. Funding is corrected to match the
+// exchange when auto correction is enabled
+func (bt *BackTest) reconcile() error {
+	if bt.reconciliation == nil {
+		return nil
+	}
+	drifts, err := bt.Funding.Reconcile(bt.getLiveHoldings,
+		bt.reconciliation.TolerancePercent,
+		bt.reconciliation.AutoCorrect)
+	for i := range drifts {
+		log.Warnf(log.BackTester, "%v %v %v funding has drifted from the exchange by %v (%v%%). Funding changed by %v while the exchange balance changed by %v. Corrected: %v",
+			drifts[i].Exchange,
+			drifts[i].Asset,
+			drifts[i].Currency,
+			drifts[i].Difference,
+			drifts[i].DifferencePercent.Round(2),
+			drifts[i].ExpectedChange,
+			drifts[i].ActualChange,
+			drifts[i].Corrected)
+	}
+	bt.reconcileOrders()
+	return err
+}
+
+// getLiveHoldings retrieves the real account balances of an exchange asset
+func (bt *BackTest) getLiveHoldings(exchName string, a asset.Item) (*account.Holdings, error) {
+	if bt.Bot == nil {
+		return nil, errNilBot
+	}
+	exch, err := bt.Bot.GetExchangeByName(exchName)
+	if err != nil {
+		return nil, err
+	}
+	h, err := exch.UpdateAccountInfo(context.TODO(), a)
+	if err != nil {
+		return nil, err
+	}
+	return &h, nil
+}
+
+// reconcileOrders warns of finished real orders which the exchange did not
+// fully fill, as the backtester fills each order it places in full
+func (bt *BackTest) reconcileOrders() {
+	if bt.Bot == nil || bt.Bot.OrderManager == nil {
+		return
+	}
+	if bt.reconciledOrders == nil {
+		bt.reconciledOrders = make(map[string]bool)
+	}
+	ords, _ := bt.Bot.OrderManager.GetOrdersSnapshot("")
+	for i := range ords {
+		if bt.reconciledOrders[ords[i].ID] || !ords[i].IsInactive() {
+			continue
+		}
+		bt.reconciledOrders[ords[i].ID] = true
+		// not every exchange reports the executed amount of filled orders
+		reportedFill := ords[i].ExecutedAmount > 0 ||
+			(ords[i].Status != gctorder.Filled && ords[i].Status != gctorder.Closed)
+		if !reportedFill || ords[i].ExecutedAmount >= ords[i].Amount {
+			continue
+		}
+		log.Warnf(log.BackTester, "%v %v %v order %v finished as %v having executed %v of the %v filled by the backtester",
+			ords[i].Exchange,
+			ords[i].AssetType,
+			ords[i].Pair,
+			ords[i].ID,
+			ords[i].Status,
+			ords[i].ExecutedAmount,
+			ords[i].Amount)
+	}
+}
+
 // loadLiveDataLoop is an incomplete function to continuously retrieve exchange data on a loop
 // from live. Its purpose is to be able to perform strategy analysis against current data
 func (bt *BackTest) loadLiveDataLoop(resp *kline.DataFromKline, cfg *config.Config, exch gctexchange.IBotExchange, fPair currency.Pair, a asset.Item, dataType int64) {
@@ -1068,6 +2362,20 @@ func (bt *BackTest) loadLiveDataLoop(resp *kline.DataFromKline, cfg *config.Conf
 	dates.SetHasDataFromCandles(candles.Candles)
 	resp.RangeHolder = dates
 	resp.Item = *candles
+	if cfg.DataSettings.LiveData != nil && cfg.DataSettings.LiveData.RecordCSVPath != "" {
+		err = bt.recordLiveCandles(cfg.DataSettings.LiveData.RecordCSVPath, candles.Candles)
+		if err != nil {
+			log.Errorf(log.BackTester, "could not record candles, %v", err)
+		}
+	}
+
+	var liveStream *live.Stream
+	if cfg.DataSettings.LiveData != nil && cfg.DataSettings.LiveData.UseWebsocket {
+		liveStream, err = bt.subscribeLiveStream(exch, fPair, a, dataType, cfg.DataSettings.Interval)
+		if err != nil {
+			log.Warnf(log.BackTester, "could not stream %v %v %v data via websocket, falling back to REST polling. %v", exch.GetName(), a, fPair, err)
+		}
+	}
 
 	loadNewDataTimer := time.NewTimer(time.Second * 5)
 	for {
@@ -1075,9 +2383,18 @@ func (bt *BackTest) loadLiveDataLoop(resp *kline.DataFromKline, cfg *config.Conf
 		case <-bt.shutdown:
 			return
 		case <-loadNewDataTimer.C:
-			log.Infof(log.BackTester, "fetching data for %v %v %v %v", exch.GetName(), a, fPair, cfg.DataSettings.Interval)
-			loadNewDataTimer.Reset(time.Second * 15)
-			err = bt.loadLiveData(resp, cfg, exch, fPair, a, dataType)
+			if !bt.liveDataAvailable(exch, a) {
+				loadNewDataTimer.Reset(livePollInterval)
+				continue
+			}
+			if liveStream != nil && time.Since(liveStream.LastUpdate()) < liveStreamStaleTimeout {
+				loadNewDataTimer.Reset(liveStreamCheckInterval)
+				err = bt.appendLiveCandles(resp, cfg, exch, fPair, a, liveStream.Closed(time.Now()))
+			} else {
+				log.Infof(log.BackTester, "fetching data for %v %v %v %v", exch.GetName(), a, fPair, cfg.DataSettings.Interval)
+				loadNewDataTimer.Reset(livePollInterval)
+				err = bt.loadLiveData(resp, cfg, exch, fPair, a, dataType)
+			}
 			if err != nil {
 				log.Error(log.BackTester, err)
 				return
@@ -1086,6 +2403,69 @@ func (bt *BackTest) loadLiveDataLoop(resp *kline.DataFromKline, cfg *config.Conf
 	}
 }
 
+// liveDataAvailable returns whether the exchange's system status allows live
+// data to be requested. Live data is paused while the exchange announces
+// maintenance and the run's timeout is held until it resumes
+func (bt *BackTest) liveDataAvailable(exch gctexchange.IBotExchange, a asset.Item) bool {
+	status, err := gctexchange.CheckSystemStatus(context.TODO(), exch, a)
+	if err != nil || status.AllowsMarketData() {
+		if atomic.CompareAndSwapInt32(&bt.liveDataPaused, 1, 0) {
+			log.Infof(log.BackTester, "%v %v system status is %v, resuming live data", exch.GetName(), a, status)
+		}
+		return true
+	}
+	if atomic.CompareAndSwapInt32(&bt.liveDataPaused, 0, 1) {
+		log.Warnf(log.BackTester, "%v %v system status is %v, pausing live data until it ends", exch.GetName(), a, status)
+	}
+	return false
+}
+
+// subscribeLiveStream connects the exchange websocket and routes its data to a
+// live stream which collects candles as they close. The exchange's default
+// subscriptions must include klines, or trades for trade data, for the pair.
+// A stream which receives no data is replaced by REST polling
+func (bt *BackTest) subscribeLiveStream(exch gctexchange.IBotExchange, fPair currency.Pair, a asset.Item, dataType int64, interval time.Duration) (*live.Stream, error) {
+	if exch == nil {
+		return nil, errNilExchange
+	}
+	if !exch.SupportsWebsocket() {
+		return nil, fmt.Errorf("%v %w", exch.GetName(), errWebsocketUnsupported)
+	}
+	ws, err := exch.GetWebsocket()
+	if err != nil {
+		return nil, err
+	}
+	liveStream, err := live.NewStream(exch.GetName(), dataType, interval, fPair, a)
+	if err != nil {
+		return nil, err
+	}
+	if liveStream.Builder() != nil {
+		err = ws.Trade.AddCandleBuilder(liveStream.Builder())
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !ws.IsEnabled() {
+		err = ws.Enable()
+	} else if !ws.IsConnected() {
+		err = ws.Connect()
+	}
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		for {
+			select {
+			case <-bt.shutdown:
+				return
+			case d := <-ws.ToRoutine:
+				liveStream.Process(d)
+			}
+		}
+	}()
+	return liveStream, nil
+}
+
 func (bt *BackTest) loadLiveData(resp *kline.DataFromKline, cfg *config.Config, exch gctexchange.IBotExchange, fPair currency.Pair, a asset.Item, dataType int64) error {
 	if resp == nil {
 		return errNilData
@@ -1105,16 +2485,138 @@ func (bt *BackTest) loadLiveData(resp *kline.DataFromKline, cfg *config.Config,
 	if err != nil {
 		return err
 	}
-	if len(candles.Candles) == 0 {
+	err = bt.appendLiveCandles(resp, cfg, exch, fPair, a, candles)
+	if err != nil {
+		return err
+	}
+	log.Info(log.BackTester, "sleeping for 30 seconds before checking for new candle data")
+	return nil
+}
+
+// appendLiveCandles adds newly retrieved live candles to the data stream and
+// report, recording them and updating the spread when configured
+func (bt *BackTest) appendLiveCandles(resp *kline.DataFromKline, cfg *config.Config, exch gctexchange.IBotExchange, fPair currency.Pair, a asset.Item, candles *gctkline.Item) error {
+	if resp == nil {
+		return errNilData
+	}
+	if cfg == nil {
+		return errNilConfig
+	}
+	if exch == nil {
+		return errNilExchange
+	}
+	if candles == nil || len(candles.Candles) == 0 {
 		return nil
 	}
 	resp.AppendResults(candles)
 	bt.Reports.UpdateItem(&resp.Item)
-	log.Info(log.BackTester, "sleeping for 30 seconds before checking for new candle data")
+	if cfg.DataSettings.LiveData != nil && cfg.DataSettings.LiveData.RecordCSVPath != "" {
+		err := bt.recordLiveCandles(cfg.DataSettings.LiveData.RecordCSVPath, candles.Candles)
+		if err != nil {
+			log.Errorf(log.BackTester, "could not record candles, %v", err)
+		}
+	}
+	if cfg.DataSettings.Spread != nil && cfg.DataSettings.Spread.UseLiveTickers {
+		err := bt.updateLiveSpread(cfg, exch, fPair, a, candles.Candles[len(candles.Candles)-1].Time)
+		if err != nil {
+			log.Errorf(log.BackTester, "could not update %v %v %v spread, %v", exch.GetName(), a, fPair, err)
+		}
+	}
 	return nil
 }
 
+// recordLiveCandles appends candles newer than those already recorded to a
+// csv file in the format loaded by csv data, allowing a live session to be
+// replayed later. The latest candle is not recorded as it may not be complete
+func (bt *BackTest) recordLiveCandles(path string, candles []gctkline.Candle) error {
+	if len(candles) < 2 {
+		return nil
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = f.Close()
+		if err != nil {
+			log.Errorln(log.BackTester, err)
+		}
+	}()
+	w := gocsv.NewWriter(f)
+	for i := range candles[:len(candles)-1] {
+		if !candles[i].Time.After(bt.liveRecordedUntil) {
+			continue
+		}
+		err = w.Write([]string{
+			strconv.FormatInt(candles[i].Time.Unix(), 10),
+			strconv.FormatFloat(candles[i].Volume, 'f', -1, 64),
+			strconv.FormatFloat(candles[i].Open, 'f', -1, 64),
+			strconv.FormatFloat(candles[i].High, 'f', -1, 64),
+			strconv.FormatFloat(candles[i].Low, 'f', -1, 64),
+			strconv.FormatFloat(candles[i].Close, 'f', -1, 64),
+		})
+		if err != nil {
+			return err
+		}
+		bt.liveRecordedUntil = candles[i].Time
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// updateLiveSpread stores the current ticker bid/ask against the latest candle
+// so that fills on the candle pay the live spread
+func (bt *BackTest) updateLiveSpread(cfg *config.Config, exch gctexchange.IBotExchange, fPair currency.Pair, a asset.Item, t time.Time) error {
+	exchangeName := exch.GetName()
+	for i := range cfg.CurrencySettings {
+		if strings.EqualFold(cfg.CurrencySettings[i].InstanceName(), exchangeName) {
+			exchangeName = cfg.CurrencySettings[i].InstanceName()
+			break
+		}
+	}
+	cs, err := bt.Exchange.GetCurrencySettings(exchangeName, a, fPair)
+	if err != nil {
+		return err
+	}
+	if cs.Spread == nil {
+		return nil
+	}
+	tick, err := exch.FetchTicker(context.TODO(), fPair, a)
+	if err != nil {
+		return err
+	}
+	return cs.Spread.Add(spread.Spread{
+		Time: t,
+		Bid:  decimal.NewFromFloat(tick.Bid),
+		Ask:  decimal.NewFromFloat(tick.Ask),
+	})
+}
+
 // Stop shuts down the live data loop
 func (bt *BackTest) Stop() {
 	close(bt.shutdown)
 }
+
+// closeStrategy releases any resources held by the strategy once a run
+// has finished, such as a connection to an external strategy process
+func (bt *BackTest) closeStrategy() {
+	closer, ok := bt.Strategy.(io.Closer)
+	if !ok {
+		return
+	}
+	if err := closer.Close(); err != nil {
+		log.Errorf(log.BackTester, "could not close strategy %v: %v", bt.Strategy.Name(), err)
+	}
+}
+
+// SetRunID sets the ID of the run recorded in each event log entry
+func (bt *BackTest) SetRunID(id string) {
+	bt.eventLog.SetRunID(id)
+}
+
+// closeEventLog closes the event log once a run ends
+func (bt *BackTest) closeEventLog() {
+	if err := bt.eventLog.Close(); err != nil {
+		log.Errorf(log.BackTester, "could not close event log: %v", err)
+	}
+}
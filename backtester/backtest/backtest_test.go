@@ -1,11 +1,15 @@
 package backtest
 
 import (
+	"context"
 	"errors"
+	"io/ioutil"
 	"log"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -14,9 +18,11 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/config"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline/csv"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/eventholder"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/compliance"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/risk"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/size"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
@@ -24,6 +30,11 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/dollarcostaverage"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/rsi"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	evkline "github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
 	"github.com/thrasher-corp/gocryptotrader/backtester/report"
 	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
@@ -36,6 +47,7 @@ import (
 	gctexchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
 
 const testExchange = "Bitstamp"
@@ -411,6 +423,93 @@ func TestLoadDataLive(t *testing.T) {
 	bt.Stop()
 }
 
+func TestLoadAccountExchange(t *testing.T) {
+	t.Parallel()
+	bt := BackTest{Bot: newBotWithExchange()}
+	cs := &config.CurrencySettings{
+		ExchangeName: testExchange,
+		Account:      "sub1",
+		Credentials: &config.Credentials{
+			APIKey:        "key",
+			APISecret:     "secret",
+			APIClientID:   "id",
+			APISubAccount: "sub1",
+		},
+	}
+	err := bt.loadAccountExchange(cs)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	exch, err := bt.Bot.ExchangeManager.GetExchangeByName(cs.InstanceName())
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if exch.GetName() != "bitstamp-sub1" {
+		t.Errorf("received '%v' expected '%v'", exch.GetName(), "bitstamp-sub1")
+	}
+	if dataExchangeName(exch) != testExchange {
+		t.Errorf("received '%v' expected '%v'", dataExchangeName(exch), testExchange)
+	}
+	b := exch.GetBase()
+	if b.API.Credentials.Key != "key" || b.API.Credentials.Subaccount != "sub1" {
+		t.Errorf("received '%+v' expected account credentials", b.API.Credentials)
+	}
+	original, err := bt.Bot.ExchangeManager.GetExchangeByName(testExchange)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if original == exch || original.GetBase().API.Credentials.Key != "" {
+		t.Error("expected the account to not share the exchange's instance or credentials")
+	}
+
+	err = bt.loadAccountExchange(cs)
+	if !errors.Is(err, engine.ErrExchangeAlreadyLoaded) {
+		t.Errorf("received '%v' expected '%v'", err, engine.ErrExchangeAlreadyLoaded)
+	}
+	cs.Account = "sub2"
+	cs.ExchangeName = "fake"
+	err = bt.loadAccountExchange(cs)
+	if err == nil {
+		t.Error("expected an error for an exchange without a config")
+	}
+}
+
+type maintenanceExchange struct {
+	gctexchange.IBotExchange
+	base   *gctexchange.Base
+	status gctexchange.SystemStatus
+}
+
+func (m *maintenanceExchange) GetBase() *gctexchange.Base { return m.base }
+
+func (m *maintenanceExchange) GetName() string { return testExchange }
+
+func (m *maintenanceExchange) GetSystemStatus(context.Context, asset.Item) (*gctexchange.SystemStatusResponse, error) {
+	return &gctexchange.SystemStatusResponse{Status: m.status}, nil
+}
+
+func TestLiveDataAvailable(t *testing.T) {
+	t.Parallel()
+	bt := &BackTest{}
+	exch := &maintenanceExchange{
+		base:   &gctexchange.Base{},
+		status: gctexchange.SystemStatusMaintenance,
+	}
+	if bt.liveDataAvailable(exch, asset.Spot) {
+		t.Error("expected live data to be paused during maintenance")
+	}
+	if atomic.LoadInt32(&bt.liveDataPaused) != 1 {
+		t.Error("expected live data to be flagged as paused")
+	}
+	exch.status = gctexchange.SystemStatusOperational
+	if !bt.liveDataAvailable(exch, asset.Margin) {
+		t.Error("expected live data to be available")
+	}
+	if atomic.LoadInt32(&bt.liveDataPaused) != 0 {
+		t.Error("expected live data to resume")
+	}
+}
+
 func TestLoadLiveData(t *testing.T) {
 	t.Parallel()
 	err := loadLiveData(nil, nil)
@@ -597,6 +696,45 @@ func TestFullCycle(t *testing.T) {
 	if err != nil {
 		t.Error(err)
 	}
+	processed, total := bt.Progress()
+	if processed != 1 || total != 1 {
+		t.Errorf("received '%v/%v' expected '%v/%v'", processed, total, 1, 1)
+	}
+}
+
+func TestProgressReport(t *testing.T) {
+	t.Parallel()
+	bt := New()
+	if resp := bt.ProgressReport(); resp.Percent != 0 || resp.Elapsed != 0 {
+		t.Errorf("unexpected progress before run %+v", resp)
+	}
+	if !strings.Contains(bt.ProgressReport().String(), "ETA unknown") {
+		t.Errorf("unexpected progress %v", bt.ProgressReport())
+	}
+	bt.eventsTotal = 4
+	bt.eventsProcessed = 1
+	bt.runStarted = time.Now().Add(-time.Second * 2).UnixNano()
+	resp := bt.ProgressReport()
+	if resp.Percent != 25 {
+		t.Errorf("received '%v' expected '%v'", resp.Percent, 25)
+	}
+	if resp.EventsPerSecond <= 0 || resp.EventsPerSecond > 0.5 {
+		t.Errorf("received '%v' expected at most '%v'", resp.EventsPerSecond, 0.5)
+	}
+	if resp.ETA < time.Second*6 {
+		t.Errorf("received '%v' expected at least '%v'", resp.ETA, time.Second*6)
+	}
+	if s := resp.String(); !strings.HasPrefix(s, "[#####---------------] 25.00% 1/4 candles") {
+		t.Errorf("unexpected progress %v", s)
+	}
+	bt.eventsProcessed = 4
+	if resp = bt.ProgressReport(); resp.ETA != 0 || resp.Percent != 100 {
+		t.Errorf("unexpected completed progress %+v", resp)
+	}
+	bt.SetProgressInterval(0)
+	bt.logProgress(true)
+	bt.SetProgressInterval(time.Hour)
+	bt.logProgress(true)
 }
 
 func TestStop(t *testing.T) {
@@ -710,3 +848,1199 @@ func TestFullCycleMulti(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestRunMixedIntervals(t *testing.T) {
+	t.Parallel()
+	ex := testExchange
+	a := asset.Spot
+	fast := currency.NewPair(currency.BTC, currency.USD)
+	slow := currency.NewPair(currency.ETH, currency.USD)
+	tt := time.Now().Truncate(time.Hour)
+
+	port, err := portfolio.Setup(&size.Size{}, &risk.Risk{}, decimal.Zero)
+	if err != nil {
+		t.Error(err)
+	}
+	f := &funding.FundManager{}
+	bt := BackTest{
+		Bot:        newBotWithExchange(),
+		Datas:      &data.HandlerPerCurrency{},
+		Strategy:   &dollarcostaverage.Strategy{},
+		Portfolio:  port,
+		Exchange:   &exchange.Exchange{},
+		Statistic:  &statistics.Statistic{},
+		EventQueue: &eventholder.Holder{},
+		Reports:    &report.Data{},
+		Funding:    f,
+	}
+	bt.Datas.Setup()
+	for _, pairInterval := range []struct {
+		pair     currency.Pair
+		interval gctkline.Interval
+	}{{fast, gctkline.FifteenMin}, {slow, gctkline.OneHour}} {
+		_, err = port.SetupCurrencySettingsMap(ex, a, pairInterval.pair)
+		if err != nil {
+			t.Error(err)
+		}
+		var b, quote *funding.Item
+		b, err = funding.CreateItem(ex, a, pairInterval.pair.Base, decimal.Zero, decimal.Zero)
+		if err != nil {
+			t.Error(err)
+		}
+		quote, err = funding.CreateItem(ex, a, pairInterval.pair.Quote, decimal.NewFromInt(1337), decimal.Zero)
+		if err != nil {
+			t.Error(err)
+		}
+		var pair *funding.Pair
+		pair, err = funding.CreatePair(b, quote)
+		if err != nil {
+			t.Error(err)
+		}
+		err = f.AddPair(pair)
+		if err != nil {
+			t.Error(err)
+		}
+		k := &kline.DataFromKline{
+			Item: gctkline.Item{
+				Exchange: ex,
+				Pair:     pairInterval.pair,
+				Asset:    a,
+				Interval: pairInterval.interval,
+			},
+		}
+		for c := tt; c.Before(tt.Add(time.Hour)); c = c.Add(pairInterval.interval.Duration()) {
+			k.Item.Candles = append(k.Item.Candles, gctkline.Candle{
+				Time:   c,
+				Open:   1337,
+				High:   1337,
+				Low:    1337,
+				Close:  1337,
+				Volume: 1337,
+			})
+		}
+		k.RangeHolder, err = gctkline.CalculateCandleDateRanges(tt, tt.Add(time.Hour), pairInterval.interval, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		k.RangeHolder.SetHasDataFromCandles(k.Item.Candles)
+		err = k.Load()
+		if err != nil {
+			t.Error(err)
+		}
+		bt.Datas.SetDataForCurrency(ex, a, pairInterval.pair, k)
+	}
+
+	err = bt.Run()
+	if err != nil {
+		t.Error(err)
+	}
+	processed, total := bt.Progress()
+	if processed != 5 || total != 5 {
+		t.Errorf("received '%v/%v' expected '%v/%v'", processed, total, 5, 5)
+	}
+	stats := bt.Statistic.(*statistics.Statistic)
+	if len(stats.ExchangeAssetPairStatistics[ex][a][fast].Events) != 4 {
+		t.Errorf("received '%v' expected '%v'", len(stats.ExchangeAssetPairStatistics[ex][a][fast].Events), 4)
+	}
+	// the slow pair's only candle closes with the fast pair's final candle
+	// so its data does not end early
+	if stats.ExchangeAssetPairStatistics[ex][a][slow].IsTerminated {
+		t.Error("expected slow pair to not be terminated")
+	}
+}
+
+func TestCandleCloseTime(t *testing.T) {
+	t.Parallel()
+	tt := time.Now()
+	ev := &evkline.Kline{
+		Base: event.Base{
+			Time:     tt,
+			Interval: gctkline.OneHour,
+		},
+	}
+	if ct := candleCloseTime(ev); !ct.Equal(tt.Add(time.Hour)) {
+		t.Errorf("received '%v' expected '%v'", ct, tt.Add(time.Hour))
+	}
+}
+
+func TestRemoveTrailingEmptyCandles(t *testing.T) {
+	t.Parallel()
+	tt := time.Now()
+	k := &gctkline.Item{
+		Exchange: testExchange,
+		Pair:     currency.NewPair(currency.BTC, currency.USD),
+		Asset:    asset.Spot,
+		Interval: gctkline.OneDay,
+	}
+	removeTrailingEmptyCandles(k)
+	if len(k.Candles) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(k.Candles), 0)
+	}
+	k.Candles = []gctkline.Candle{
+		{Time: tt, Open: 1337, High: 1337, Low: 1337, Close: 1337, Volume: 1337},
+		{Time: tt.Add(gctkline.OneDay.Duration())},
+		{Time: tt.Add(gctkline.OneDay.Duration() * 2), Open: 1337, High: 1337, Low: 1337, Close: 1337, Volume: 1337},
+		{Time: tt.Add(gctkline.OneDay.Duration() * 3)},
+		{Time: tt.Add(gctkline.OneDay.Duration() * 4)},
+	}
+	removeTrailingEmptyCandles(k)
+	if len(k.Candles) != 3 {
+		t.Errorf("received '%v' expected '%v'", len(k.Candles), 3)
+	}
+	k.Candles = []gctkline.Candle{{Time: tt}, {Time: tt.Add(gctkline.OneDay.Duration())}}
+	removeTrailingEmptyCandles(k)
+	if len(k.Candles) != 2 {
+		t.Errorf("received '%v' expected '%v'", len(k.Candles), 2)
+	}
+}
+
+func TestTerminatePair(t *testing.T) {
+	t.Parallel()
+	ex := testExchange
+	cp := currency.NewPair(currency.BTC, currency.USD)
+	a := asset.Spot
+	tt := time.Now()
+
+	bt := BackTest{
+		Statistic:  &statistics.Statistic{},
+		EventQueue: &eventholder.Holder{},
+	}
+	err := bt.terminatePair(nil)
+	if !errors.Is(err, errNilData) {
+		t.Errorf("received '%v' expected '%v'", err, errNilData)
+	}
+
+	f := &funding.FundManager{}
+	b, err := funding.CreateItem(ex, a, cp.Base, decimal.NewFromInt(2), decimal.Zero)
+	if err != nil {
+		t.Error(err)
+	}
+	quote, err := funding.CreateItem(ex, a, cp.Quote, decimal.Zero, decimal.Zero)
+	if err != nil {
+		t.Error(err)
+	}
+	pair, err := funding.CreatePair(b, quote)
+	if err != nil {
+		t.Error(err)
+	}
+	err = f.AddPair(pair)
+	if err != nil {
+		t.Error(err)
+	}
+	bt.Funding = f
+
+	ev := &evkline.Kline{
+		Base: event.Base{
+			Offset:       1,
+			Exchange:     ex,
+			Time:         tt,
+			Interval:     gctkline.OneDay,
+			CurrencyPair: cp,
+			AssetType:    a,
+		},
+		Open:   decimal.NewFromInt(1337),
+		Close:  decimal.NewFromInt(1337),
+		Low:    decimal.NewFromInt(1337),
+		High:   decimal.NewFromInt(1337),
+		Volume: decimal.NewFromInt(1337),
+	}
+	err = bt.Statistic.SetupEventForTime(ev)
+	if err != nil {
+		t.Error(err)
+	}
+	err = bt.terminatePair(ev)
+	if err != nil {
+		t.Error(err)
+	}
+	stats := bt.Statistic.(*statistics.Statistic)
+	if !stats.ExchangeAssetPairStatistics[ex][a][cp].IsTerminated {
+		t.Error("expected terminated")
+	}
+	o, ok := bt.EventQueue.NextEvent().(*order.Order)
+	if !ok {
+		t.Fatal("expected order event")
+	}
+	if o.Direction != gctorder.Sell {
+		t.Errorf("received '%v' expected '%v'", o.Direction, gctorder.Sell)
+	}
+	if !o.Amount.Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received '%v' expected '%v'", o.Amount, 2)
+	}
+	if !pair.BaseAvailable().IsZero() {
+		t.Errorf("received '%v' expected '%v'", pair.BaseAvailable(), 0)
+	}
+
+	// nothing left to sell
+	err = bt.terminatePair(ev)
+	if err != nil {
+		t.Error(err)
+	}
+	if bt.EventQueue.NextEvent() != nil {
+		t.Error("expected no event")
+	}
+}
+
+func TestCreateUnselectedSignal(t *testing.T) {
+	t.Parallel()
+	ex := testExchange
+	cp := currency.NewPair(currency.BTC, currency.USD)
+	a := asset.Spot
+	tt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	f := &funding.FundManager{}
+	b, err := funding.CreateItem(ex, a, cp.Base, decimal.Zero, decimal.Zero)
+	if err != nil {
+		t.Error(err)
+	}
+	quote, err := funding.CreateItem(ex, a, cp.Quote, decimal.NewFromInt(1337), decimal.Zero)
+	if err != nil {
+		t.Error(err)
+	}
+	pair, err := funding.CreatePair(b, quote)
+	if err != nil {
+		t.Error(err)
+	}
+	err = f.AddPair(pair)
+	if err != nil {
+		t.Error(err)
+	}
+	bt := BackTest{Funding: f}
+
+	d := &kline.DataFromKline{
+		Item: gctkline.Item{
+			Exchange: ex,
+			Pair:     cp,
+			Asset:    a,
+			Interval: gctkline.OneDay,
+			Candles: []gctkline.Candle{{
+				Time:   tt,
+				Open:   1337,
+				High:   1337,
+				Low:    1337,
+				Close:  1337,
+				Volume: 1337,
+			}},
+		},
+	}
+	err = d.Load()
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = bt.createUnselectedSignal(d)
+	if !errors.Is(err, common.ErrNilEvent) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrNilEvent)
+	}
+	d.Next()
+	s, err := bt.createUnselectedSignal(d)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if s.GetDirection() != common.MissingData {
+		t.Errorf("received '%v' expected '%v'", s.GetDirection(), common.MissingData)
+	}
+
+	d.RangeHolder, err = gctkline.CalculateCandleDateRanges(tt, tt.Add(gctkline.OneDay.Duration()), gctkline.OneDay, 100000)
+	if err != nil {
+		t.Error(err)
+	}
+	d.RangeHolder.SetHasDataFromCandles(d.Item.Candles)
+	s, err = bt.createUnselectedSignal(d)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if s.GetDirection() != common.DoNothing {
+		t.Errorf("received '%v' expected '%v'", s.GetDirection(), common.DoNothing)
+	}
+
+	pair.Base.IncreaseAvailable(decimal.NewFromInt(1))
+	s, err = bt.createUnselectedSignal(d)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if s.GetDirection() != gctorder.Sell {
+		t.Errorf("received '%v' expected '%v'", s.GetDirection(), gctorder.Sell)
+	}
+}
+
+func TestFireTimers(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewPair(currency.BTC, currency.USD)
+	tt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	strat := &dollarcostaverage.Strategy{}
+	bt := BackTest{
+		Strategy:   strat,
+		EventQueue: &eventholder.Holder{},
+		Datas:      &data.HandlerPerCurrency{},
+	}
+	appended, err := bt.fireTimers(tt)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if appended {
+		t.Error("expected no signals without timers")
+	}
+
+	var fired []time.Time
+	err = strat.AddTimer(&base.Timer{
+		Name:     "rebalance",
+		Interval: gctkline.OneDay.Duration(),
+		OnTimer: func(scheduled time.Time, d []data.Handler, _ funding.IFundTransferer) ([]signal.Event, error) {
+			fired = append(fired, scheduled)
+			es, err := strat.GetBaseData(d[0])
+			if err != nil {
+				return nil, err
+			}
+			return []signal.Event{&es}, nil
+		},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	d := &kline.DataFromKline{
+		Item: gctkline.Item{
+			Exchange: testExchange,
+			Pair:     cp,
+			Asset:    asset.Spot,
+			Interval: gctkline.OneHour,
+		},
+	}
+	for i := 0; i < 26; i++ {
+		d.Item.Candles = append(d.Item.Candles, gctkline.Candle{
+			Time:  tt.Add(time.Hour * time.Duration(i)),
+			Close: 1337,
+		})
+	}
+	err = d.Load()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	bt.Datas.Setup()
+	bt.Datas.SetDataForCurrency(testExchange, asset.Spot, cp, d)
+	appended, err = bt.fireTimers(tt)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if appended || len(fired) != 0 {
+		t.Error("expected timers to wait for data")
+	}
+
+	// the timer fires at midnight each day and not on the hours between
+	for i := 0; i < 26; i++ {
+		latest := d.Next()
+		appended, err = bt.fireTimers(latest.GetTime())
+		if !errors.Is(err, nil) {
+			t.Fatalf("received '%v' expected '%v'", err, nil)
+		}
+		if appended != latest.GetTime().Equal(latest.GetTime().Truncate(gctkline.OneDay.Duration())) {
+			t.Errorf("%v received '%v' appended signals", latest.GetTime(), appended)
+		}
+	}
+	if len(fired) != 2 || !fired[0].Equal(tt) || !fired[1].Equal(tt.AddDate(0, 0, 1)) {
+		t.Errorf("received '%v' expected fires at '%v' and '%v'", fired, tt, tt.AddDate(0, 0, 1))
+	}
+	if ev := bt.EventQueue.NextEvent(); ev == nil {
+		t.Error("expected timer signal in event queue")
+	}
+
+	errTimer := errors.New("timer error")
+	err = strat.AddTimer(&base.Timer{
+		Name:     "rebalance",
+		Interval: time.Hour,
+		OnTimer: func(time.Time, []data.Handler, funding.IFundTransferer) ([]signal.Event, error) {
+			return nil, errTimer
+		},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = bt.fireTimers(tt.AddDate(0, 0, 2))
+	if !errors.Is(err, errTimer) {
+		t.Errorf("received '%v' expected '%v'", err, errTimer)
+	}
+}
+
+func TestApplySettingsChanges(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewPair(currency.BTC, currency.USD)
+	strat := &rsi.Strategy{}
+	strat.SetDefaults()
+	port, err := portfolio.Setup(&size.Size{}, &risk.Risk{}, decimal.Zero)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = port.SetupCurrencySettingsMap(testExchange, asset.Spot, cp)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	reports := &report.Data{}
+	bt := BackTest{
+		Strategy:  strat,
+		Portfolio: port,
+		Reports:   reports,
+	}
+
+	d := &kline.DataFromKline{
+		Item: gctkline.Item{
+			Exchange: testExchange,
+			Pair:     cp,
+			Asset:    asset.Spot,
+			Interval: gctkline.OneHour,
+			Candles: []gctkline.Candle{{
+				Time:  time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				Close: 1337,
+			}},
+		},
+	}
+	err = d.Load()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	d.Next()
+
+	err = strat.AdjustCustomSettings(d, map[string]interface{}{"rsi-high": "bad"}, "invalid")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = strat.AdjustCustomSettings(d, map[string]interface{}{"rsi-high": 80.0, "rsi-low": 20.0}, "volatility increased")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	bt.applySettingsChanges()
+
+	settings := strat.CustomSettings()
+	if settings["rsi-high"] != 80.0 || settings["rsi-low"] != 20.0 || settings["rsi-period"] != 14.0 {
+		t.Errorf("unexpected custom settings %v", settings)
+	}
+	auditLog := port.GetAuditLog()
+	if len(auditLog) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(auditLog), 1)
+	}
+	if auditLog[0].Stage != compliance.StageSettingsChanged || auditLog[0].Reason != "volatility increased" {
+		t.Errorf("unexpected audit log entry %+v", auditLog[0])
+	}
+	if len(auditLog[0].Settings) != 2 || auditLog[0].Settings[0].Setting != "rsi-high" || auditLog[0].Settings[0].Previous != 70.0 {
+		t.Errorf("unexpected settings changes %+v", auditLog[0].Settings)
+	}
+	if len(reports.SettingsTimeline) != 1 {
+		t.Errorf("received '%v' expected '%v'", len(reports.SettingsTimeline), 1)
+	}
+	if changes := strat.PendingSettingsChanges(); changes != nil {
+		t.Errorf("received '%v' expected '%v'", changes, nil)
+	}
+}
+
+// rejectionStrategy records the order rejections it is notified of and
+// returns requeue to allow or cancel requeues
+type rejectionStrategy struct {
+	dollarcostaverage.Strategy
+	requeue    bool
+	rejections []order.Rejection
+}
+
+func (r *rejectionStrategy) OnOrderRejected(rej *order.Rejection) bool {
+	r.rejections = append(r.rejections, *rej)
+	return r.requeue
+}
+
+func TestHandleRejection(t *testing.T) {
+	t.Parallel()
+	ex := strings.ToLower(testExchange)
+	cp := currency.NewPair(currency.BTC, currency.USD)
+	a := asset.Spot
+	tt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	f := &funding.FundManager{}
+	b, err := funding.CreateItem(ex, a, cp.Base, decimal.Zero, decimal.Zero)
+	if err != nil {
+		t.Error(err)
+	}
+	quote, err := funding.CreateItem(ex, a, cp.Quote, decimal.NewFromInt(1337), decimal.Zero)
+	if err != nil {
+		t.Error(err)
+	}
+	pair, err := funding.CreatePair(b, quote)
+	if err != nil {
+		t.Error(err)
+	}
+	err = f.AddPair(pair)
+	if err != nil {
+		t.Error(err)
+	}
+	d := &kline.DataFromKline{
+		Item: gctkline.Item{
+			Exchange: ex,
+			Pair:     cp,
+			Asset:    a,
+			Interval: gctkline.OneDay,
+			Candles: []gctkline.Candle{
+				{Time: tt, Open: 1337, High: 1337, Low: 1337, Close: 1337, Volume: 1337},
+				{Time: tt.Add(gctkline.OneDay.Duration()), Open: 1337, High: 1337, Low: 1337, Close: 1337, Volume: 1337},
+			},
+		},
+	}
+	err = d.Load()
+	if err != nil {
+		t.Error(err)
+	}
+	d.Next()
+	datas := &data.HandlerPerCurrency{}
+	datas.Setup()
+	datas.SetDataForCurrency(ex, a, cp, d)
+	e := &exchange.Exchange{}
+	e.SetExchangeAssetCurrencySettings(ex, a, cp, &exchange.Settings{
+		ExchangeName: ex,
+		AssetType:    a,
+		CurrencyPair: cp,
+		Rejection:    &exchange.Rejection{MaxRequeues: 1, BackoffCandles: 1},
+	})
+	strat := &rejectionStrategy{requeue: true}
+	bt := BackTest{
+		Funding:    f,
+		Datas:      datas,
+		Exchange:   e,
+		Strategy:   strat,
+		Statistic:  &statistics.Statistic{},
+		EventQueue: &eventholder.Holder{},
+	}
+	o := &order.Order{
+		Base: event.Base{
+			Offset:       1,
+			Exchange:     ex,
+			Time:         tt,
+			Interval:     gctkline.OneDay,
+			CurrencyPair: cp,
+			AssetType:    a,
+		},
+		Direction:      gctorder.Buy,
+		Amount:         decimal.NewFromInt(1),
+		AllocatedFunds: decimal.NewFromInt(100),
+	}
+
+	bt.handleRejection(o, d)
+	if len(strat.rejections) != 1 || !strat.rejections[0].Requeued || strat.rejections[0].Attempt != 1 || strat.rejections[0].RetryAfter != 1 {
+		t.Fatalf("unexpected rejections %+v", strat.rejections)
+	}
+	bt.resubmitRequeuedOrders()
+	if len(bt.requeued) != 1 || bt.EventQueue.NextEvent() != nil {
+		t.Fatal("expected order to wait for the next candle")
+	}
+
+	d.Next()
+	bt.resubmitRequeuedOrders()
+	if len(bt.requeued) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(bt.requeued), 0)
+	}
+	resubmitted, ok := bt.EventQueue.NextEvent().(*order.Order)
+	if !ok || resubmitted != o {
+		t.Fatal("expected order to be resubmitted")
+	}
+	if o.Offset != 2 || !o.Time.Equal(tt.Add(gctkline.OneDay.Duration())) {
+		t.Errorf("expected order to take the latest candle's offset and time, received %v %v", o.Offset, o.Time)
+	}
+	if !pair.QuoteAvailable().Equal(decimal.NewFromInt(1237)) {
+		t.Errorf("received '%v' expected '%v'", pair.QuoteAvailable(), 1237)
+	}
+
+	// the order has used its only requeue
+	bt.handleRejection(o, d)
+	if len(strat.rejections) != 2 || strat.rejections[1].Requeued || strat.rejections[1].Attempt != 2 {
+		t.Errorf("unexpected rejections %+v", strat.rejections)
+	}
+	if len(bt.requeued) != 0 || len(bt.rejections) != 0 {
+		t.Error("expected order to be dropped")
+	}
+
+	// strategies can cancel requeues
+	strat.requeue = false
+	bt.handleRejection(&order.Order{Base: o.Base, Direction: gctorder.Buy}, d)
+	if len(strat.rejections) != 3 || len(bt.requeued) != 0 {
+		t.Error("expected strategy to cancel requeue")
+	}
+}
+
+func TestLoadSpreads(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	cfg := &config.Config{}
+	s, err := loadSpreads(cfg, testExchange, asset.Spot, cp)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if s != nil {
+		t.Error("expected nil spread series")
+	}
+	cfg.DataSettings.Spread = &config.Spread{StaticSpreadPercent: decimal.NewFromFloat(0.2)}
+	s, err = loadSpreads(cfg, testExchange, asset.Spot, cp)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !s.GetPercent(time.Now()).Equal(decimal.NewFromFloat(0.2)) {
+		t.Errorf("received '%v' expected '%v'", s.GetPercent(time.Now()), 0.2)
+	}
+	cfg.DataSettings.Spread.CSVPath = "non-existent.csv"
+	_, err = loadSpreads(cfg, testExchange, asset.Spot, cp)
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestWaitForEventTime(t *testing.T) {
+	t.Parallel()
+	bt := BackTest{}
+	if !bt.waitForEventTime(time.Now()) {
+		t.Error("expected wait to complete")
+	}
+	bt = *New()
+	tt := time.Now()
+	if !bt.waitForEventTime(tt) {
+		t.Error("expected wait to complete")
+	}
+	if !bt.Clock.Now().Equal(tt) {
+		t.Errorf("received '%v' expected '%v'", bt.Clock.Now(), tt)
+	}
+	bt.Stop()
+	if bt.waitForEventTime(tt.Add(time.Hour)) {
+		t.Error("expected shutdown")
+	}
+}
+
+func TestRecordLiveCandles(t *testing.T) {
+	t.Parallel()
+	tempDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err = os.RemoveAll(tempDir); err != nil {
+			t.Error(err)
+		}
+	}()
+	path := filepath.Join(tempDir, "recording.csv")
+	tt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	candles := []gctkline.Candle{
+		{Time: tt, Open: 1, High: 2, Low: 0.5, Close: 1.5, Volume: 10},
+		{Time: tt.Add(time.Minute), Open: 1.5, High: 3, Low: 1, Close: 2, Volume: 20},
+		{Time: tt.Add(time.Minute * 2), Open: 2, High: 2, Low: 2, Close: 2, Volume: 1},
+	}
+	bt := BackTest{}
+	err = bt.recordLiveCandles(path, candles[:1])
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	err = bt.recordLiveCandles(path, candles[:2])
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	// overlapping candles are only recorded once
+	err = bt.recordLiveCandles(path, candles)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	resp, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "1609459200,10,1,2,0.5,1.5\n1609459260,20,1.5,3,1,2\n"
+	if string(resp) != expected {
+		t.Errorf("received '%v' expected '%v'", string(resp), expected)
+	}
+
+	d, err := csv.LoadData(common.DataCandle, path, testExchange, gctkline.OneMin.Duration(), currency.NewPair(currency.BTC, currency.USDT), asset.Spot)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if len(d.Item.Candles) != 2 {
+		t.Errorf("received '%v' expected '%v'", len(d.Item.Candles), 2)
+	}
+}
+
+func TestAppendLiveCandles(t *testing.T) {
+	t.Parallel()
+	bt := BackTest{Reports: &report.Data{}}
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	em := engine.SetupExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = bt.appendLiveCandles(nil, nil, nil, p, asset.Spot, nil)
+	if !errors.Is(err, errNilData) {
+		t.Errorf("received '%v' expected '%v'", err, errNilData)
+	}
+	resp := &kline.DataFromKline{RangeHolder: &gctkline.IntervalRangeHolder{}}
+	err = bt.appendLiveCandles(resp, nil, nil, p, asset.Spot, nil)
+	if !errors.Is(err, errNilConfig) {
+		t.Errorf("received '%v' expected '%v'", err, errNilConfig)
+	}
+	cfg := &config.Config{}
+	err = bt.appendLiveCandles(resp, cfg, nil, p, asset.Spot, nil)
+	if !errors.Is(err, errNilExchange) {
+		t.Errorf("received '%v' expected '%v'", err, errNilExchange)
+	}
+	err = bt.appendLiveCandles(resp, cfg, exch, p, asset.Spot, nil)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	tt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	err = bt.appendLiveCandles(resp, cfg, exch, p, asset.Spot, &gctkline.Item{
+		Exchange: testExchange,
+		Pair:     p,
+		Asset:    asset.Spot,
+		Interval: gctkline.OneMin,
+		Candles:  []gctkline.Candle{{Time: tt, Open: 1, High: 1, Low: 1, Close: 1, Volume: 1}},
+	})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp.GetStream()) != 1 {
+		t.Errorf("received '%v' expected '%v'", len(resp.GetStream()), 1)
+	}
+}
+
+func TestSubscribeLiveStream(t *testing.T) {
+	t.Parallel()
+	bt := BackTest{shutdown: make(chan struct{})}
+	_, err := bt.subscribeLiveStream(nil, currency.NewPair(currency.BTC, currency.USDT), asset.Spot, common.DataCandle, time.Minute)
+	if !errors.Is(err, errNilExchange) {
+		t.Errorf("received '%v' expected '%v'", err, errNilExchange)
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	t.Parallel()
+	bt := BackTest{}
+	err := bt.reconcile()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	f := funding.SetupFundingManager(true)
+	item, err := funding.CreateItem(testExchange, asset.Spot, currency.BTC, decimal.NewFromInt(1), decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = f.AddItem(item)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bt.Funding = f
+	bt.reconciliation = &config.Reconciliation{Interval: time.Minute}
+	err = bt.reconcile()
+	if !errors.Is(err, errNilBot) {
+		t.Errorf("received '%v' expected '%v'", err, errNilBot)
+	}
+	bt.Bot = &engine.Engine{ExchangeManager: engine.SetupExchangeManager()}
+	err = bt.reconcile()
+	if !errors.Is(err, engine.ErrExchangeNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, engine.ErrExchangeNotFound)
+	}
+}
+
+func TestReconcileOrders(t *testing.T) {
+	t.Parallel()
+	bt := BackTest{}
+	bt.reconcileOrders()
+	em := engine.SetupExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exch.SetDefaults()
+	em.Add(exch)
+	var wg sync.WaitGroup
+	om, err := engine.SetupOrderManager(em, &engine.CommunicationManager{}, &wg, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = om.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err = om.Stop(); err != nil {
+			t.Error(err)
+		}
+	}()
+	bt.Bot = &engine.Engine{ExchangeManager: em, OrderManager: om}
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	ords := []gctorder.Detail{
+		{ID: "filled", Status: gctorder.Filled, Amount: 1, ExecutedAmount: 1},
+		{ID: "unreported", Status: gctorder.Filled, Amount: 1},
+		{ID: "open", Status: gctorder.Open, Amount: 1},
+		{ID: "rejected", Status: gctorder.Rejected, Amount: 1},
+		{ID: "partial", Status: gctorder.PartiallyCancelled, Amount: 1, ExecutedAmount: 0.5},
+	}
+	for i := range ords {
+		ords[i].Exchange = testExchange
+		ords[i].Pair = p
+		ords[i].AssetType = asset.Spot
+		err = om.Add(&ords[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	bt.reconcileOrders()
+	for id, expected := range map[string]bool{
+		"filled":     true,
+		"unreported": true,
+		"open":       false,
+		"rejected":   true,
+		"partial":    true,
+	} {
+		if bt.reconciledOrders[id] != expected {
+			t.Errorf("received '%v' expected '%v' for order %v", bt.reconciledOrders[id], expected, id)
+		}
+	}
+}
+
+func TestSetupFeeCurrencies(t *testing.T) {
+	t.Parallel()
+	exch := strings.ToLower(testExchange)
+	btcPair := currency.NewPair(currency.BTC, currency.USDT)
+	bnbPair := currency.NewPair(currency.BNB, currency.USDT)
+	cfg := &config.Config{
+		CurrencySettings: []config.CurrencySettings{
+			{
+				FeeCurrency: &config.FeeCurrency{
+					Currency:        currency.BNB.String(),
+					DiscountPercent: decimal.NewFromInt(25),
+				},
+			},
+			{},
+		},
+	}
+	settings := []exchange.Settings{
+		{ExchangeName: exch, AssetType: asset.Spot, CurrencyPair: btcPair},
+		{ExchangeName: exch, AssetType: asset.Spot, CurrencyPair: bnbPair},
+	}
+	bt := BackTest{
+		Funding: funding.SetupFundingManager(true),
+		Datas:   &data.HandlerPerCurrency{},
+	}
+	err := bt.setupFeeCurrencies(cfg, settings)
+	if !errors.Is(err, funding.ErrFundsNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, funding.ErrFundsNotFound)
+	}
+
+	item, err := funding.CreateItem(exch, asset.Spot, currency.BNB, decimal.NewFromInt(1), decimal.Zero)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = bt.Funding.(*funding.FundManager).AddItem(item)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	bt.Datas.Setup()
+	bnbData := &kline.DataFromKline{}
+	bt.Datas.SetDataForCurrency(exch, asset.Spot, bnbPair, bnbData)
+	err = bt.setupFeeCurrencies(cfg, settings)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	fc := settings[0].FeeCurrency
+	if fc == nil {
+		t.Fatal("expected fee currency to be set")
+	}
+	if fc.Funds != item {
+		t.Error("expected fee currency funding item to be set")
+	}
+	if fc.ConversionData != bnbData {
+		t.Error("expected fee currency conversion data to be set")
+	}
+	if fc.InvertConversion {
+		t.Error("expected conversion to not be inverted")
+	}
+	if !fc.DiscountRate.Equal(decimal.NewFromFloat(0.25)) {
+		t.Errorf("received '%v' expected '%v'", fc.DiscountRate, 0.25)
+	}
+	if settings[1].FeeCurrency != nil {
+		t.Error("expected no fee currency")
+	}
+}
+
+func TestLoadConfiguredLimits(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	_, err := loadConfiguredLimits(&config.OrderLimits{}, asset.Item("lol"), p)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v' expected '%v'", err, asset.ErrNotSupported)
+	}
+	limits, err := loadConfiguredLimits(&config.OrderLimits{
+		MinimumAmount:   decimal.NewFromFloat(0.001),
+		AmountStepSize:  decimal.NewFromFloat(0.001),
+		PriceTickSize:   decimal.NewFromFloat(0.01),
+		MinimumNotional: decimal.NewFromInt(10),
+	}, asset.Spot, p)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if amount := limits.ConformToDecimalAmount(decimal.NewFromFloat(0.0125)); !amount.Equal(decimal.NewFromFloat(0.012)) {
+		t.Errorf("received '%v' expected '%v'", amount, 0.012)
+	}
+	if price := limits.ConformToDecimalPrice(decimal.NewFromFloat(100.005), gctorder.Sell); !price.Equal(decimal.NewFromInt(100)) {
+		t.Errorf("received '%v' expected '%v'", price, 100)
+	}
+	err = limits.Conforms(100, 0.05, gctorder.Limit)
+	if !errors.Is(err, gctorder.ErrNotionalValue) {
+		t.Errorf("received '%v' expected '%v'", err, gctorder.ErrNotionalValue)
+	}
+}
+
+func TestPopulateValue(t *testing.T) {
+	t.Parallel()
+	one := decimal.NewFromInt(1)
+	if v := populateValue("test", "fee", one, decimal.Zero); !v.Equal(one) {
+		t.Errorf("received '%v' expected '%v'", v, one)
+	}
+	live := decimal.NewFromFloat(0.001)
+	if v := populateValue("test", "fee", decimal.Zero, live); !v.Equal(live) {
+		t.Errorf("received '%v' expected '%v'", v, live)
+	}
+	configured := decimal.NewFromFloat(0.002)
+	if v := populateValue("test", "fee", configured, live); !v.Equal(configured) {
+		t.Errorf("received '%v' expected '%v'", v, configured)
+	}
+}
+
+func TestGetTransferFee(t *testing.T) {
+	t.Parallel()
+	em := engine.SetupExchangeManager()
+	exch, err := em.NewExchangeByName("binance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	exch.SetDefaults()
+	live := decimal.NewFromFloat(0.0005)
+	if fee := getTransferFee(context.Background(), exch, currency.BTC, decimal.Zero); !fee.Equal(live) {
+		t.Errorf("received '%v' expected '%v'", fee, live)
+	}
+	configured := decimal.NewFromInt(1)
+	if fee := getTransferFee(context.Background(), exch, currency.BTC, configured); !fee.Equal(configured) {
+		t.Errorf("received '%v' expected '%v'", fee, configured)
+	}
+	if fee := getTransferFee(context.Background(), exch, currency.NewCode("NOTACOIN"), configured); !fee.Equal(configured) {
+		t.Errorf("received '%v' expected '%v'", fee, configured)
+	}
+}
+
+func TestPopulateLimits(t *testing.T) {
+	t.Parallel()
+	cs := &config.CurrencySettings{}
+	populateLimits("test", cs, gctorder.MinMaxLevel{})
+	if cs.OrderLimits != nil {
+		t.Error("expected no order limits to be created")
+	}
+	populateLimits("test", cs, gctorder.MinMaxLevel{
+		MinAmount:   0.001,
+		StepAmount:  0.0001,
+		StepPrice:   0.01,
+		MinNotional: 10,
+	})
+	if cs.OrderLimits == nil {
+		t.Fatal("expected order limits to be populated")
+	}
+	if !cs.OrderLimits.MinimumNotional.Equal(decimal.NewFromInt(10)) {
+		t.Errorf("received '%v' expected '%v'", cs.OrderLimits.MinimumNotional, 10)
+	}
+	if !cs.OrderLimits.MaximumAmount.IsZero() {
+		t.Errorf("received '%v' expected '%v'", cs.OrderLimits.MaximumAmount, 0)
+	}
+
+	cs.OrderLimits = &config.OrderLimits{MinimumNotional: decimal.NewFromInt(5)}
+	populateLimits("test", cs, gctorder.MinMaxLevel{
+		StepPrice:   0.01,
+		MinNotional: 10,
+	})
+	if !cs.OrderLimits.MinimumNotional.Equal(decimal.NewFromInt(5)) {
+		t.Errorf("received '%v' expected '%v'", cs.OrderLimits.MinimumNotional, 5)
+	}
+	if !cs.OrderLimits.PriceTickSize.Equal(decimal.NewFromFloat(0.01)) {
+		t.Errorf("received '%v' expected '%v'", cs.OrderLimits.PriceTickSize, 0.01)
+	}
+}
+
+func TestConvertInitialFunds(t *testing.T) {
+	t.Parallel()
+	cfg := &config.Config{
+		StrategySettings: config.StrategySettings{
+			ConversionRates: []config.ConversionRate{{From: "USD", To: "USDT", Rate: decimal.NewFromFloat(0.5)}},
+		},
+	}
+	leet := decimal.NewFromInt(1337)
+	resp, err := convertInitialFunds(cfg, leet, "", "USDT")
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !resp.Equal(leet) {
+		t.Errorf("received '%v' expected '%v'", resp, leet)
+	}
+	resp, err = convertInitialFunds(cfg, leet, "USD", "USDT")
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !resp.Equal(decimal.NewFromFloat(668.5)) {
+		t.Errorf("received '%v' expected '%v'", resp, 668.5)
+	}
+	_, err = convertInitialFunds(cfg, leet, "EUR", "USDT")
+	if err == nil {
+		t.Error("expected error for missing conversion rate")
+	}
+}
+
+func TestSetupFundingConversions(t *testing.T) {
+	t.Parallel()
+	exch := strings.ToLower(testExchange)
+	tt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	cfg := &config.Config{
+		StrategySettings: config.StrategySettings{
+			ConversionRates: []config.ConversionRate{{From: "ETH", To: "USDT", Rate: decimal.NewFromInt(10)}},
+			FundingConversions: []config.FundingConversion{
+				{ExchangeName: exch, Asset: asset.Spot.String(), From: "BTC", To: "USDT"},
+				{ExchangeName: exch, Asset: asset.Spot.String(), From: "ETH", To: "USDT"},
+			},
+		},
+	}
+	funds := funding.SetupFundingManager(true)
+	bt := BackTest{
+		Funding: funds,
+		Datas:   &data.HandlerPerCurrency{},
+	}
+	err := bt.setupFundingConversions(cfg, funds)
+	if !errors.Is(err, funding.ErrFundsNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, funding.ErrFundsNotFound)
+	}
+	for _, c := range []currency.Code{currency.BTC, currency.ETH, currency.USDT, currency.LTC} {
+		var item *funding.Item
+		item, err = funding.CreateItem(exch, asset.Spot, c, decimal.NewFromInt(1), decimal.Zero)
+		if !errors.Is(err, nil) {
+			t.Fatalf("received '%v' expected '%v'", err, nil)
+		}
+		err = funds.AddItem(item)
+		if !errors.Is(err, nil) {
+			t.Fatalf("received '%v' expected '%v'", err, nil)
+		}
+	}
+	err = bt.setupFundingConversions(cfg, funds)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	// BTC converts at the latest USDT-BTC close, ETH at the configured rate
+	d := &kline.DataFromKline{
+		Item: gctkline.Item{
+			Exchange: exch,
+			Pair:     currency.NewPair(currency.USDT, currency.BTC),
+			Asset:    asset.Spot,
+			Interval: gctkline.OneDay,
+			Candles:  []gctkline.Candle{{Time: tt, Open: 0.01, High: 0.01, Low: 0.01, Close: 0.01, Volume: 1}},
+		},
+	}
+	err = d.Load()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	d.Next()
+	bt.Datas.SetDataForCurrency(exch, asset.Spot, d.Item.Pair, d)
+	fundingPair, err := funds.GetFundingForEAP(exch, asset.Spot, currency.NewPair(currency.LTC, currency.USDT))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !fundingPair.ConvertibleQuote().Equal(decimal.NewFromInt(110)) {
+		t.Errorf("received '%v' expected '%v'", fundingPair.ConvertibleQuote(), 110)
+	}
+}
+
+func TestValidateConfig(t *testing.T) {
+	t.Parallel()
+	_, err := ValidateConfig(nil, nil)
+	if !errors.Is(err, errNilConfig) {
+		t.Errorf("received '%v' expected '%v'", err, errNilConfig)
+	}
+	cfg := &config.Config{
+		Nickname: "validation",
+		StrategySettings: config.StrategySettings{
+			Name:           rsi.Name,
+			CustomSettings: map[string]interface{}{"hello": "moto"},
+		},
+		CurrencySettings: []config.CurrencySettings{
+			{
+				ExchangeName:      testExchange,
+				Asset:             asset.Spot.String(),
+				Base:              currency.BTC.String(),
+				Quote:             currency.USD.String(),
+				InitialQuoteFunds: leet,
+			},
+		},
+		DataSettings: config.DataSettings{
+			Interval: gctkline.OneDay.Duration(),
+			DataType: common.CandleStr,
+			CSVData: &config.CSVData{
+				FullPath: filepath.Join("..", "..", "testdata", "binance_BTCUSDT_24h_2019_01_01_2020_01_01.csv"),
+			},
+		},
+	}
+	_, err = ValidateConfig(cfg, nil)
+	if !errors.Is(err, errNilBot) {
+		t.Errorf("received '%v' expected '%v'", err, errNilBot)
+	}
+	r, err := ValidateConfig(cfg, newBotWithExchange())
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if r.Passed() {
+		t.Error("expected unrecognised custom settings to fail validation")
+	}
+	statuses := make(map[string]string)
+	for i := range r.Checks {
+		statuses[r.Checks[i].Name] = r.Checks[i].Status
+	}
+	if statuses["strategy"] != ValidationPassed || statuses["custom settings"] != ValidationFailed {
+		t.Errorf("unexpected checks %+v", r.Checks)
+	}
+	if statuses["data "+strings.ToLower(testExchange)+" spot BTC-USD"] != ValidationPassed {
+		t.Errorf("unexpected checks %+v", r.Checks)
+	}
+	if !strings.Contains(r.String(), "config is invalid") {
+		t.Errorf("unexpected report %v", r)
+	}
+
+	cfg.StrategySettings.CustomSettings = nil
+	cfg.StrategySettings.Name = "fake"
+	r, err = ValidateConfig(cfg, newBotWithExchange())
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if r.Checks[1].Name != "strategy" || r.Checks[1].Status != ValidationFailed {
+		t.Errorf("unexpected checks %+v", r.Checks)
+	}
+}
+
+func TestSetupEventLog(t *testing.T) {
+	t.Parallel()
+	bt := BackTest{}
+	cfg := &config.Config{}
+	err := bt.setupEventLog(cfg, t.TempDir())
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if bt.eventLog != nil {
+		t.Error("expected no event log when unset")
+	}
+
+	dir := t.TempDir()
+	cfg.EventLog = &config.EventLogSettings{Level: "debug"}
+	err = bt.setupEventLog(cfg, dir)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	bt.SetRunID("test")
+	bt.eventLog.Record(&evkline.Kline{Base: event.Base{Exchange: testExchange}})
+	bt.closeEventLog()
+	data, err := ioutil.ReadFile(filepath.Join(dir, defaultEventLogFileName))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !strings.Contains(string(data), `"run-id":"test"`) {
+		t.Errorf("received '%s' expected entry for the run", data)
+	}
+}
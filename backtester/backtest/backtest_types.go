@@ -2,29 +2,69 @@ package backtest
 
 import (
 	"errors"
+	"time"
 
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/clock"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/eventholder"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/universe"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventlog"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
 	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
 	"github.com/thrasher-corp/gocryptotrader/backtester/report"
 	"github.com/thrasher-corp/gocryptotrader/engine"
 )
 
+const (
+	// livePollInterval is how often live data is requested from the
+	// exchange's REST API
+	livePollInterval = time.Second * 15
+	// liveStreamCheckInterval is how often closed candles are collected from
+	// a live websocket stream
+	liveStreamCheckInterval = time.Second
+	// liveStreamStaleTimeout is how long a live websocket stream can go
+	// without data before REST polling is used in its place
+	liveStreamStaleTimeout = time.Minute
+	// defaultEventLogFileName is the file the event log is written to
+	// within the run's output directory when no path is configured
+	defaultEventLogFileName = "events.jsonl"
+	// DefaultProgressInterval is how often the progress of a run is logged
+	// unless set otherwise
+	DefaultProgressInterval = time.Second * 5
+	// progressBarWidth is the amount of characters in a logged progress bar
+	progressBarWidth = 20
+)
+
+// Statuses of a config validation check
+const (
+	ValidationPassed  = "passed"
+	ValidationWarning = "warning"
+	ValidationFailed  = "failed"
+)
+
 var (
-	errNilConfig           = errors.New("unable to setup backtester with nil config")
-	errNilBot              = errors.New("unable to setup backtester without a loaded GoCryptoTrader bot")
-	errInvalidConfigAsset  = errors.New("invalid asset in config")
-	errAmbiguousDataSource = errors.New("ambiguous settings received. Only one data type can be set")
-	errNoDataSource        = errors.New("no data settings set in config")
-	errIntervalUnset       = errors.New("candle interval unset")
-	errUnhandledDatatype   = errors.New("unhandled datatype")
-	errLiveDataTimeout     = errors.New("no data returned in 5 minutes, shutting down")
-	errNilData             = errors.New("nil data received")
-	errNilExchange         = errors.New("nil exchange received")
+	errNilConfig             = errors.New("unable to setup backtester with nil config")
+	errNilBot                = errors.New("unable to setup backtester without a loaded GoCryptoTrader bot")
+	errInvalidConfigAsset    = errors.New("invalid asset in config")
+	errAmbiguousDataSource   = errors.New("ambiguous settings received. Only one data type can be set")
+	errNoDataSource          = errors.New("no data settings set in config")
+	errUnknownFallbackSource = errors.New("unknown fallback data source")
+	errIntervalUnset         = errors.New("candle interval unset")
+	errUnhandledDatatype     = errors.New("unhandled datatype")
+	errLiveDataTimeout       = errors.New("no data returned in 5 minutes, shutting down")
+	errNilData               = errors.New("nil data received")
+	errNilExchange           = errors.New("nil exchange received")
+	errWebsocketUnsupported  = errors.New("websocket not supported")
+
+	// significantDifferencePercent is how far a configured fee or order
+	// limit can differ from the exchange's before a warning is logged
+	significantDifferencePercent = decimal.NewFromInt(10)
 )
 
 // BackTest is the main holder of all backtesting functionality
@@ -40,4 +80,72 @@ type BackTest struct {
 	EventQueue      eventholder.EventHolder
 	Reports         report.Handler
 	Funding         funding.IFundingManager
+	Universe        *universe.Selector
+	Clock           clock.Clock
+	// eventsProcessed and eventsTotal track the amount of data events
+	// processed during a run and are accessed atomically
+	eventsProcessed int64
+	eventsTotal     int64
+	// runStarted is when the run began processing data in unix nanoseconds
+	// and is accessed atomically
+	runStarted int64
+	// progressInterval is how often progress is logged during a run, with
+	// lastProgressLog the time it was last logged
+	progressInterval time.Duration
+	lastProgressLog  time.Time
+	// liveRecordedUntil is the time of the latest live candle recorded
+	liveRecordedUntil time.Time
+	// reconciliation is set when real orders are placed and compared
+	// against the exchange's balances and orders
+	reconciliation *config.Reconciliation
+	// reconciledOrders holds the IDs of finished real orders which have
+	// been compared against their simulated fill
+	reconciledOrders map[string]bool
+	// auditLogPath is where the order lifecycle audit log is exported to
+	// once a run ends
+	auditLogPath string
+	// requeued holds rejected orders waiting to be resubmitted and
+	// rejections counts how many times each of them has been rejected
+	requeued   []requeuedOrder
+	rejections map[*order.Order]int64
+	// eventLog records each event processed when the event log is enabled
+	eventLog *eventlog.Logger
+	// liveDataPaused is set while the exchange's system status does not
+	// allow live data to be requested, such as during maintenance
+	liveDataPaused int32
+}
+
+// requeuedOrder is a rejected order which is resubmitted once the data of
+// its pair reaches retryAt
+type requeuedOrder struct {
+	order   *order.Order
+	retryAt time.Time
+}
+
+// ProgressReport details how far through its data a run is and estimates
+// how long the rest of the run will take
+type ProgressReport struct {
+	Processed       int64
+	Total           int64
+	Percent         float64
+	EventsPerSecond float64
+	Elapsed         time.Duration
+	// ETA is the estimated time remaining, which is zero until data has
+	// been processed
+	ETA time.Duration
+}
+
+// ValidationReport holds the outcome of each check performed when
+// validating a config without running it
+type ValidationReport struct {
+	Nickname string
+	Strategy string
+	Checks   []ValidationCheck
+}
+
+// ValidationCheck is the outcome of validating one part of a config
+type ValidationCheck struct {
+	Name   string
+	Status string
+	Detail string
 }
@@ -0,0 +1,178 @@
+package backtest
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+	"github.com/thrasher-corp/gocryptotrader/backtester/report"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+)
+
+// ValidateConfig performs a dry run of a config without executing it. The
+// config's settings are validated, its strategy and custom settings are
+// loaded and each currency's data is retrieved from its source for the
+// configured date range. Every check is run so that all problems with a
+// config are reported at once
+func ValidateConfig(cfg *config.Config, bot *engine.Engine) (*ValidationReport, error) {
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	if bot == nil {
+		return nil, errNilBot
+	}
+	r := &ValidationReport{
+		Nickname: cfg.Nickname,
+		Strategy: cfg.StrategySettings.Name,
+	}
+	r.add("config", cfg.Validate(), "all settings are valid")
+	r.validateStrategy(cfg)
+
+	bt := New()
+	bt.Reports = &report.Data{Config: cfg}
+	err := bt.setupBot(cfg, bot)
+	r.add("exchanges", err, "all exchanges loaded")
+	if err != nil {
+		return r, nil
+	}
+	defer func() {
+		if bt.Bot.OrderManager.IsRunning() {
+			if stopErr := bt.Bot.OrderManager.Stop(); stopErr != nil {
+				r.add("exchanges", stopErr, "")
+			}
+		}
+	}()
+	for i := range cfg.CurrencySettings {
+		r.Checks = append(r.Checks, bt.validateData(cfg, &cfg.CurrencySettings[i]))
+	}
+	return r, nil
+}
+
+// validateStrategy ensures the config's strategy is registered, supports the
+// configured signal processing and accepts its custom settings
+func (r *ValidationReport) validateStrategy(cfg *config.Config) {
+	strat, err := strategies.LoadStrategyByName(cfg.StrategySettings.Name, cfg.StrategySettings.SimultaneousSignalProcessing)
+	if err != nil {
+		r.add("strategy", err, "")
+		r.add("custom settings", errors.New("unable to check custom settings without a strategy"), "")
+		return
+	}
+	r.add("strategy", nil, fmt.Sprintf("'%v' is registered", strat.Name()))
+	strat.SetDefaults()
+	if len(cfg.StrategySettings.CustomSettings) == 0 {
+		r.add("custom settings", nil, "none set, strategy defaults are used")
+		return
+	}
+	err = strat.SetCustomSettings(cfg.StrategySettings.CustomSettings)
+	switch {
+	case errors.Is(err, base.ErrCustomSettingsUnsupported):
+		r.Checks = append(r.Checks, ValidationCheck{
+			Name:   "custom settings",
+			Status: ValidationWarning,
+			Detail: fmt.Sprintf("'%v' does not support custom settings, they will be ignored", strat.Name()),
+		})
+	case err != nil:
+		r.add("custom settings", err, "")
+	default:
+		r.add("custom settings", nil, fmt.Sprintf("applied %v", strat.CustomSettings()))
+	}
+}
+
+// validateData ensures a currency's data can be retrieved from its source
+// for the configured date range and warns of any periods missing data
+func (bt *BackTest) validateData(cfg *config.Config, cs *config.CurrencySettings) ValidationCheck {
+	check := ValidationCheck{
+		Name:   fmt.Sprintf("data %v %v %v-%v", cs.InstanceName(), cs.Asset, cs.Base, cs.Quote),
+		Status: ValidationFailed,
+	}
+	if cfg.DataSettings.LiveData != nil {
+		check.Status = ValidationWarning
+		check.Detail = "live data is only available once the run starts"
+		return check
+	}
+	exch, pair, a, err := bt.loadExchangePairAssetBase(cs.InstanceName(), cs.Base, cs.Quote, cs.Asset)
+	if err != nil {
+		check.Detail = err.Error()
+		return check
+	}
+	pairCfg := cfg
+	if cs.Interval > 0 {
+		c := *cfg
+		c.DataSettings.Interval = cs.Interval
+		pairCfg = &c
+	}
+	d, err := bt.loadData(pairCfg, exch, pair, a)
+	if err != nil {
+		check.Detail = err.Error()
+		return check
+	}
+	if d == nil || len(d.Item.Candles) == 0 {
+		check.Detail = errNilData.Error()
+		return check
+	}
+	check.Status = ValidationPassed
+	check.Detail = fmt.Sprintf("%v candles from %v to %v",
+		len(d.Item.Candles),
+		d.Item.Candles[0].Time.Format(gctcommon.SimpleTimeFormat),
+		d.Item.Candles[len(d.Item.Candles)-1].Time.Format(gctcommon.SimpleTimeFormat))
+	if d.RangeHolder != nil {
+		if summary := d.RangeHolder.DataSummary(false); len(summary) > 0 {
+			check.Status = ValidationWarning
+			check.Detail += ". " + strings.Join(summary, ". ")
+		}
+	}
+	return check
+}
+
+// add records a check which passes when err is nil
+func (r *ValidationReport) add(name string, err error, detail string) {
+	check := ValidationCheck{
+		Name:   name,
+		Status: ValidationPassed,
+		Detail: detail,
+	}
+	if err != nil {
+		check.Status = ValidationFailed
+		check.Detail = err.Error()
+	}
+	r.Checks = append(r.Checks, check)
+}
+
+// Passed returns whether no check failed. Warnings do not prevent a config
+// from being run
+func (r *ValidationReport) Passed() bool {
+	for i := range r.Checks {
+		if r.Checks[i].Status == ValidationFailed {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the report in a readable format, with each check on its own
+// line
+func (r *ValidationReport) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "validation of strategy '%v'", r.Strategy)
+	if r.Nickname != "" {
+		fmt.Fprintf(&sb, " nicknamed '%v'", r.Nickname)
+	}
+	sb.WriteString("\n")
+	for i := range r.Checks {
+		fmt.Fprintf(&sb, "[%v] %v", strings.ToUpper(r.Checks[i].Status), r.Checks[i].Name)
+		if r.Checks[i].Detail != "" {
+			fmt.Fprintf(&sb, ": %v", r.Checks[i].Detail)
+		}
+		sb.WriteString("\n")
+	}
+	if r.Passed() {
+		sb.WriteString("config is valid and ready to run\n")
+	} else {
+		sb.WriteString("config is invalid, please resolve the failed checks\n")
+	}
+	return sb.String()
+}
@@ -0,0 +1,356 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package btrpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// BacktesterServiceClient is the client API for BacktesterService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BacktesterServiceClient interface {
+	ExecuteStrategyFromConfig(ctx context.Context, in *ExecuteStrategyFromConfigRequest, opts ...grpc.CallOption) (*ExecuteStrategyResponse, error)
+	ListAllRuns(ctx context.Context, in *ListAllRunsRequest, opts ...grpc.CallOption) (*ListAllRunsResponse, error)
+	GetRunProgress(ctx context.Context, in *GetRunProgressRequest, opts ...grpc.CallOption) (BacktesterService_GetRunProgressClient, error)
+	GetRunReport(ctx context.Context, in *GetRunReportRequest, opts ...grpc.CallOption) (*GetRunReportResponse, error)
+}
+
+type backtesterServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBacktesterServiceClient(cc grpc.ClientConnInterface) BacktesterServiceClient {
+	return &backtesterServiceClient{cc}
+}
+
+func (c *backtesterServiceClient) ExecuteStrategyFromConfig(ctx context.Context, in *ExecuteStrategyFromConfigRequest, opts ...grpc.CallOption) (*ExecuteStrategyResponse, error) {
+	out := new(ExecuteStrategyResponse)
+	err := c.cc.Invoke(ctx, "/btrpc.BacktesterService/ExecuteStrategyFromConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backtesterServiceClient) ListAllRuns(ctx context.Context, in *ListAllRunsRequest, opts ...grpc.CallOption) (*ListAllRunsResponse, error) {
+	out := new(ListAllRunsResponse)
+	err := c.cc.Invoke(ctx, "/btrpc.BacktesterService/ListAllRuns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backtesterServiceClient) GetRunProgress(ctx context.Context, in *GetRunProgressRequest, opts ...grpc.CallOption) (BacktesterService_GetRunProgressClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BacktesterService_ServiceDesc.Streams[0], "/btrpc.BacktesterService/GetRunProgress", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &backtesterServiceGetRunProgressClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BacktesterService_GetRunProgressClient interface {
+	Recv() (*RunSummary, error)
+	grpc.ClientStream
+}
+
+type backtesterServiceGetRunProgressClient struct {
+	grpc.ClientStream
+}
+
+func (x *backtesterServiceGetRunProgressClient) Recv() (*RunSummary, error) {
+	m := new(RunSummary)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *backtesterServiceClient) GetRunReport(ctx context.Context, in *GetRunReportRequest, opts ...grpc.CallOption) (*GetRunReportResponse, error) {
+	out := new(GetRunReportResponse)
+	err := c.cc.Invoke(ctx, "/btrpc.BacktesterService/GetRunReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BacktesterServiceServer is the server API for BacktesterService service.
+// All implementations must embed UnimplementedBacktesterServiceServer
+// for forward compatibility
+type BacktesterServiceServer interface {
+	ExecuteStrategyFromConfig(context.Context, *ExecuteStrategyFromConfigRequest) (*ExecuteStrategyResponse, error)
+	ListAllRuns(context.Context, *ListAllRunsRequest) (*ListAllRunsResponse, error)
+	GetRunProgress(*GetRunProgressRequest, BacktesterService_GetRunProgressServer) error
+	GetRunReport(context.Context, *GetRunReportRequest) (*GetRunReportResponse, error)
+	mustEmbedUnimplementedBacktesterServiceServer()
+}
+
+// UnimplementedBacktesterServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedBacktesterServiceServer struct {
+}
+
+func (UnimplementedBacktesterServiceServer) ExecuteStrategyFromConfig(context.Context, *ExecuteStrategyFromConfigRequest) (*ExecuteStrategyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteStrategyFromConfig not implemented")
+}
+func (UnimplementedBacktesterServiceServer) ListAllRuns(context.Context, *ListAllRunsRequest) (*ListAllRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAllRuns not implemented")
+}
+func (UnimplementedBacktesterServiceServer) GetRunProgress(*GetRunProgressRequest, BacktesterService_GetRunProgressServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetRunProgress not implemented")
+}
+func (UnimplementedBacktesterServiceServer) GetRunReport(context.Context, *GetRunReportRequest) (*GetRunReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRunReport not implemented")
+}
+func (UnimplementedBacktesterServiceServer) mustEmbedUnimplementedBacktesterServiceServer() {}
+
+// UnsafeBacktesterServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BacktesterServiceServer will
+// result in compilation errors.
+type UnsafeBacktesterServiceServer interface {
+	mustEmbedUnimplementedBacktesterServiceServer()
+}
+
+func RegisterBacktesterServiceServer(s grpc.ServiceRegistrar, srv BacktesterServiceServer) {
+	s.RegisterService(&BacktesterService_ServiceDesc, srv)
+}
+
+func _BacktesterService_ExecuteStrategyFromConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteStrategyFromConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BacktesterServiceServer).ExecuteStrategyFromConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/btrpc.BacktesterService/ExecuteStrategyFromConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BacktesterServiceServer).ExecuteStrategyFromConfig(ctx, req.(*ExecuteStrategyFromConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BacktesterService_ListAllRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BacktesterServiceServer).ListAllRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/btrpc.BacktesterService/ListAllRuns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BacktesterServiceServer).ListAllRuns(ctx, req.(*ListAllRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BacktesterService_GetRunProgress_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetRunProgressRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BacktesterServiceServer).GetRunProgress(m, &backtesterServiceGetRunProgressServer{stream})
+}
+
+type BacktesterService_GetRunProgressServer interface {
+	Send(*RunSummary) error
+	grpc.ServerStream
+}
+
+type backtesterServiceGetRunProgressServer struct {
+	grpc.ServerStream
+}
+
+func (x *backtesterServiceGetRunProgressServer) Send(m *RunSummary) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BacktesterService_GetRunReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRunReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BacktesterServiceServer).GetRunReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/btrpc.BacktesterService/GetRunReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BacktesterServiceServer).GetRunReport(ctx, req.(*GetRunReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BacktesterService_ServiceDesc is the grpc.ServiceDesc for BacktesterService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BacktesterService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "btrpc.BacktesterService",
+	HandlerType: (*BacktesterServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExecuteStrategyFromConfig",
+			Handler:    _BacktesterService_ExecuteStrategyFromConfig_Handler,
+		},
+		{
+			MethodName: "ListAllRuns",
+			Handler:    _BacktesterService_ListAllRuns_Handler,
+		},
+		{
+			MethodName: "GetRunReport",
+			Handler:    _BacktesterService_GetRunReport_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetRunProgress",
+			Handler:       _BacktesterService_GetRunProgress_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "btrpc.proto",
+}
+
+// ExternalStrategyServiceClient is the client API for ExternalStrategyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ExternalStrategyServiceClient interface {
+	ProcessEvents(ctx context.Context, opts ...grpc.CallOption) (ExternalStrategyService_ProcessEventsClient, error)
+}
+
+type externalStrategyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewExternalStrategyServiceClient(cc grpc.ClientConnInterface) ExternalStrategyServiceClient {
+	return &externalStrategyServiceClient{cc}
+}
+
+func (c *externalStrategyServiceClient) ProcessEvents(ctx context.Context, opts ...grpc.CallOption) (ExternalStrategyService_ProcessEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ExternalStrategyService_ServiceDesc.Streams[0], "/btrpc.ExternalStrategyService/ProcessEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &externalStrategyServiceProcessEventsClient{stream}
+	return x, nil
+}
+
+type ExternalStrategyService_ProcessEventsClient interface {
+	Send(*ProcessEventsRequest) error
+	Recv() (*ProcessEventsResponse, error)
+	grpc.ClientStream
+}
+
+type externalStrategyServiceProcessEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *externalStrategyServiceProcessEventsClient) Send(m *ProcessEventsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *externalStrategyServiceProcessEventsClient) Recv() (*ProcessEventsResponse, error) {
+	m := new(ProcessEventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ExternalStrategyServiceServer is the server API for ExternalStrategyService service.
+// All implementations must embed UnimplementedExternalStrategyServiceServer
+// for forward compatibility
+type ExternalStrategyServiceServer interface {
+	ProcessEvents(ExternalStrategyService_ProcessEventsServer) error
+	mustEmbedUnimplementedExternalStrategyServiceServer()
+}
+
+// UnimplementedExternalStrategyServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedExternalStrategyServiceServer struct {
+}
+
+func (UnimplementedExternalStrategyServiceServer) ProcessEvents(ExternalStrategyService_ProcessEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ProcessEvents not implemented")
+}
+func (UnimplementedExternalStrategyServiceServer) mustEmbedUnimplementedExternalStrategyServiceServer() {
+}
+
+// UnsafeExternalStrategyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ExternalStrategyServiceServer will
+// result in compilation errors.
+type UnsafeExternalStrategyServiceServer interface {
+	mustEmbedUnimplementedExternalStrategyServiceServer()
+}
+
+func RegisterExternalStrategyServiceServer(s grpc.ServiceRegistrar, srv ExternalStrategyServiceServer) {
+	s.RegisterService(&ExternalStrategyService_ServiceDesc, srv)
+}
+
+func _ExternalStrategyService_ProcessEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ExternalStrategyServiceServer).ProcessEvents(&externalStrategyServiceProcessEventsServer{stream})
+}
+
+type ExternalStrategyService_ProcessEventsServer interface {
+	Send(*ProcessEventsResponse) error
+	Recv() (*ProcessEventsRequest, error)
+	grpc.ServerStream
+}
+
+type externalStrategyServiceProcessEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *externalStrategyServiceProcessEventsServer) Send(m *ProcessEventsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *externalStrategyServiceProcessEventsServer) Recv() (*ProcessEventsRequest, error) {
+	m := new(ProcessEventsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ExternalStrategyService_ServiceDesc is the grpc.ServiceDesc for ExternalStrategyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ExternalStrategyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "btrpc.ExternalStrategyService",
+	HandlerType: (*ExternalStrategyServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ProcessEvents",
+			Handler:       _ExternalStrategyService_ProcessEvents_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "btrpc.proto",
+}
@@ -0,0 +1,81 @@
+package clock
+
+import (
+	"fmt"
+	"time"
+)
+
+// Now returns the time of the latest event
+func (e *Event) Now() time.Time {
+	e.m.Lock()
+	defer e.m.Unlock()
+	return e.now
+}
+
+// WaitUntil advances the clock to the time provided without waiting
+func (e *Event) WaitUntil(t time.Time, shutdown <-chan struct{}) bool {
+	select {
+	case <-shutdown:
+		return false
+	default:
+	}
+	e.m.Lock()
+	if t.After(e.now) {
+		e.now = t
+	}
+	e.m.Unlock()
+	return true
+}
+
+// NewReplay creates a replay clock running at the speed provided, where 1 is
+// real time and 60 replays a minute of events every second
+func NewReplay(speed float64) (*Replay, error) {
+	if speed <= 0 {
+		return nil, fmt.Errorf("%w received %v", errInvalidSpeed, speed)
+	}
+	return &Replay{speed: speed}, nil
+}
+
+// Now returns the replayed time, which advances from the first event time at
+// the replay speed
+func (r *Replay) Now() time.Time {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.wallStart.IsZero() {
+		return time.Time{}
+	}
+	return r.eventStart.Add(r.scale(time.Since(r.wallStart)))
+}
+
+// WaitUntil blocks until the replayed time reaches the time provided. The
+// first call anchors the replay to the wall clock and does not wait
+func (r *Replay) WaitUntil(t time.Time, shutdown <-chan struct{}) bool {
+	r.m.Lock()
+	if r.wallStart.IsZero() {
+		r.eventStart = t
+		r.wallStart = time.Now()
+	}
+	wait := time.Duration(float64(t.Sub(r.eventStart))/r.speed) - time.Since(r.wallStart)
+	r.m.Unlock()
+	if wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		select {
+		case <-shutdown:
+			return false
+		case <-timer.C:
+		}
+	} else {
+		select {
+		case <-shutdown:
+			return false
+		default:
+		}
+	}
+	return true
+}
+
+// scale converts a wall clock duration into replayed time
+func (r *Replay) scale(d time.Duration) time.Duration {
+	return time.Duration(float64(d) * r.speed)
+}
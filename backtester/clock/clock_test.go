@@ -0,0 +1,88 @@
+package clock
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestEventWaitUntil(t *testing.T) {
+	t.Parallel()
+	e := &Event{}
+	if !e.Now().IsZero() {
+		t.Errorf("received '%v' expected '%v'", e.Now(), time.Time{})
+	}
+	tt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	if !e.WaitUntil(tt, nil) {
+		t.Error("expected wait to complete")
+	}
+	if !e.Now().Equal(tt) {
+		t.Errorf("received '%v' expected '%v'", e.Now(), tt)
+	}
+	// the clock does not move backwards
+	if !e.WaitUntil(tt.Add(-time.Hour), nil) {
+		t.Error("expected wait to complete")
+	}
+	if !e.Now().Equal(tt) {
+		t.Errorf("received '%v' expected '%v'", e.Now(), tt)
+	}
+	shutdown := make(chan struct{})
+	close(shutdown)
+	if e.WaitUntil(tt.Add(time.Hour), shutdown) {
+		t.Error("expected shutdown")
+	}
+}
+
+func TestNewReplay(t *testing.T) {
+	t.Parallel()
+	_, err := NewReplay(0)
+	if !errors.Is(err, errInvalidSpeed) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidSpeed)
+	}
+	r, err := NewReplay(60)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !r.Now().IsZero() {
+		t.Errorf("received '%v' expected '%v'", r.Now(), time.Time{})
+	}
+}
+
+func TestReplayWaitUntil(t *testing.T) {
+	t.Parallel()
+	// an hour of events every 100 milliseconds
+	r, err := NewReplay(36000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	start := time.Now()
+	if !r.WaitUntil(tt, nil) {
+		t.Error("expected wait to complete")
+	}
+	if time.Since(start) > time.Millisecond*50 {
+		t.Error("expected the first event to not wait")
+	}
+	if !r.WaitUntil(tt.Add(time.Hour), nil) {
+		t.Error("expected wait to complete")
+	}
+	if elapsed := time.Since(start); elapsed < time.Millisecond*100 {
+		t.Errorf("received '%v' expected at least '%v'", elapsed, time.Millisecond*100)
+	}
+	if r.Now().Before(tt.Add(time.Hour)) {
+		t.Errorf("received '%v' expected at least '%v'", r.Now(), tt.Add(time.Hour))
+	}
+
+	shutdown := make(chan struct{})
+	close(shutdown)
+	start = time.Now()
+	if r.WaitUntil(tt.Add(time.Hour*24*365), shutdown) {
+		t.Error("expected shutdown")
+	}
+	if time.Since(start) > time.Second {
+		t.Error("expected shutdown to interrupt the wait")
+	}
+	if r.WaitUntil(tt, shutdown) {
+		t.Error("expected shutdown")
+	}
+}
@@ -0,0 +1,35 @@
+package clock
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+var errInvalidSpeed = errors.New("replay speed must be positive")
+
+// Clock controls how time passes in the event loop relative to the time of
+// the events being processed
+type Clock interface {
+	// Now returns the clock's current time
+	Now() time.Time
+	// WaitUntil blocks until the clock reaches the time provided, returning
+	// false if shutdown is received first
+	WaitUntil(t time.Time, shutdown <-chan struct{}) bool
+}
+
+// Event is a clock which follows the time of the events being processed.
+// It never waits, allowing a backtest to run as fast as possible
+type Event struct {
+	m   sync.Mutex
+	now time.Time
+}
+
+// Replay is a clock which paces events against the wall clock, allowing
+// recorded data to be replayed in real time or at an accelerated speed
+type Replay struct {
+	m          sync.Mutex
+	speed      float64
+	eventStart time.Time
+	wallStart  time.Time
+}
@@ -8,8 +8,10 @@ import (
 	"strings"
 
 	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventlog"
 	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/file"
 	"github.com/thrasher-corp/gocryptotrader/log"
@@ -53,17 +55,52 @@ func (c *Config) PrintSetting() {
 	}
 	log.Infof(log.BackTester, "Simultaneous Signal Processing: %v", c.StrategySettings.SimultaneousSignalProcessing)
 	log.Infof(log.BackTester, "Use Exchange Level Funding: %v", c.StrategySettings.UseExchangeLevelFunding)
+	if c.StrategySettings.UniverseSelection != nil {
+		log.Infof(log.BackTester, "Universe selection: top %v pairs by %v over %v candles every %v",
+			c.StrategySettings.UniverseSelection.TopN,
+			c.StrategySettings.UniverseSelection.Metric,
+			c.StrategySettings.UniverseSelection.LookbackCandles,
+			c.StrategySettings.UniverseSelection.Interval)
+	}
 	if c.StrategySettings.UseExchangeLevelFunding && c.StrategySettings.SimultaneousSignalProcessing {
 		log.Info(log.BackTester, "-------------------------------------------------------------")
 		log.Info(log.BackTester, "------------------Funding Settings---------------------------")
 		for i := range c.StrategySettings.ExchangeLevelFunding {
 			log.Infof(log.BackTester, "Initial funds for %v %v %v: %v",
-				c.StrategySettings.ExchangeLevelFunding[i].ExchangeName,
+				c.StrategySettings.ExchangeLevelFunding[i].InstanceName(),
 				c.StrategySettings.ExchangeLevelFunding[i].Asset,
 				c.StrategySettings.ExchangeLevelFunding[i].Currency,
 				c.StrategySettings.ExchangeLevelFunding[i].InitialFunds.Round(8))
+			if c.StrategySettings.ExchangeLevelFunding[i].InitialFundsCurrency != "" {
+				log.Infof(log.BackTester, "Initial funds currency: %v",
+					c.StrategySettings.ExchangeLevelFunding[i].InitialFundsCurrency)
+			}
+			if c.StrategySettings.ExchangeLevelFunding[i].TransferFee.IsPositive() ||
+				c.StrategySettings.ExchangeLevelFunding[i].UseExchangeTransferFee {
+				log.Infof(log.BackTester, "Transfer fee: %v, use exchange transfer fee: %v",
+					c.StrategySettings.ExchangeLevelFunding[i].TransferFee,
+					c.StrategySettings.ExchangeLevelFunding[i].UseExchangeTransferFee)
+			}
+			if c.StrategySettings.ExchangeLevelFunding[i].DepositConfirmationDelay > 0 {
+				log.Infof(log.BackTester, "Deposit confirmation delay: %v",
+					c.StrategySettings.ExchangeLevelFunding[i].DepositConfirmationDelay)
+			}
 		}
 	}
+	for i := range c.StrategySettings.ConversionRates {
+		log.Infof(log.BackTester, "Conversion rate: 1 %v = %v %v",
+			c.StrategySettings.ConversionRates[i].From,
+			c.StrategySettings.ConversionRates[i].Rate,
+			c.StrategySettings.ConversionRates[i].To)
+	}
+	for i := range c.StrategySettings.FundingConversions {
+		log.Infof(log.BackTester, "Funding conversion: %v %v %v to %v, fee %v",
+			c.StrategySettings.FundingConversions[i].InstanceName(),
+			c.StrategySettings.FundingConversions[i].Asset,
+			c.StrategySettings.FundingConversions[i].From,
+			c.StrategySettings.FundingConversions[i].To,
+			c.StrategySettings.FundingConversions[i].Fee)
+	}
 
 	for i := range c.CurrencySettings {
 		log.Info(log.BackTester, "-------------------------------------------------------------")
@@ -74,6 +111,9 @@ func (c *Config) PrintSetting() {
 		log.Infof(log.BackTester, currStr[:61])
 		log.Info(log.BackTester, "-------------------------------------------------------------")
 		log.Infof(log.BackTester, "Exchange: %v", c.CurrencySettings[i].ExchangeName)
+		if c.CurrencySettings[i].Account != "" {
+			log.Infof(log.BackTester, "Account: %v", c.CurrencySettings[i].Account)
+		}
 		if !c.StrategySettings.UseExchangeLevelFunding {
 			if c.CurrencySettings[i].InitialBaseFunds != nil {
 				log.Infof(log.BackTester, "Initial base funds: %v %v",
@@ -81,9 +121,13 @@ func (c *Config) PrintSetting() {
 					c.CurrencySettings[i].Base)
 			}
 			if c.CurrencySettings[i].InitialQuoteFunds != nil {
+				fundsCurrency := c.CurrencySettings[i].Quote
+				if c.CurrencySettings[i].InitialFundsCurrency != "" {
+					fundsCurrency = c.CurrencySettings[i].InitialFundsCurrency
+				}
 				log.Infof(log.BackTester, "Initial quote funds: %v %v",
 					c.CurrencySettings[i].InitialQuoteFunds.Round(8),
-					c.CurrencySettings[i].Quote)
+					fundsCurrency)
 			}
 		}
 		log.Infof(log.BackTester, "Maker fee: %v", c.CurrencySettings[i].TakerFee.Round(8))
@@ -94,6 +138,27 @@ func (c *Config) PrintSetting() {
 		log.Infof(log.BackTester, "Sell rules: %+v", c.CurrencySettings[i].SellSide)
 		log.Infof(log.BackTester, "Leverage rules: %+v", c.CurrencySettings[i].Leverage)
 		log.Infof(log.BackTester, "Can use exchange defined order execution limits: %+v", c.CurrencySettings[i].CanUseExchangeLimits)
+		if c.CurrencySettings[i].Interval > 0 {
+			log.Infof(log.BackTester, "Interval: %v", c.CurrencySettings[i].Interval)
+		}
+		log.Infof(log.BackTester, "Fill price: %v", c.CurrencySettings[i].FillPrice)
+		if c.CurrencySettings[i].FillPrice == FillPriceRandom {
+			log.Infof(log.BackTester, "Fill price seed: %v", c.CurrencySettings[i].FillPriceSeed)
+		}
+		if c.CurrencySettings[i].OrderLimits != nil {
+			log.Infof(log.BackTester, "Order limits: %+v", *c.CurrencySettings[i].OrderLimits)
+		}
+		if c.CurrencySettings[i].OrderRejection != nil {
+			log.Infof(log.BackTester, "Order rejection: %+v", *c.CurrencySettings[i].OrderRejection)
+		}
+		if c.CurrencySettings[i].FeeCurrency != nil {
+			log.Infof(log.BackTester, "Fee currency: %v with %v%% discount",
+				c.CurrencySettings[i].FeeCurrency.Currency,
+				c.CurrencySettings[i].FeeCurrency.DiscountPercent)
+			if !c.CurrencySettings[i].FeeCurrency.ConversionRate.IsZero() {
+				log.Infof(log.BackTester, "Fee currency conversion rate: %v", c.CurrencySettings[i].FeeCurrency.ConversionRate)
+			}
+		}
 	}
 
 	log.Info(log.BackTester, "-------------------------------------------------------------")
@@ -102,6 +167,9 @@ func (c *Config) PrintSetting() {
 	log.Infof(log.BackTester, "Buy rules: %+v", c.PortfolioSettings.BuySide)
 	log.Infof(log.BackTester, "Sell rules: %+v", c.PortfolioSettings.SellSide)
 	log.Infof(log.BackTester, "Leverage rules: %+v", c.PortfolioSettings.Leverage)
+	if c.PortfolioSettings.EquityScaling != nil {
+		log.Infof(log.BackTester, "Equity scaling: %+v", *c.PortfolioSettings.EquityScaling)
+	}
 	if c.DataSettings.LiveData != nil {
 		log.Info(log.BackTester, "-------------------------------------------------------------")
 		log.Info(log.BackTester, "------------------Live Settings------------------------------")
@@ -109,7 +177,14 @@ func (c *Config) PrintSetting() {
 		log.Infof(log.BackTester, "Data type: %v", c.DataSettings.DataType)
 		log.Infof(log.BackTester, "Interval: %v", c.DataSettings.Interval)
 		log.Infof(log.BackTester, "REAL ORDERS: %v", c.DataSettings.LiveData.RealOrders)
+		log.Infof(log.BackTester, "USE ORDER ROUTER: %v", c.DataSettings.LiveData.UseOrderRouter)
 		log.Infof(log.BackTester, "Overriding GCT API settings: %v", c.DataSettings.LiveData.APIClientIDOverride != "")
+		if c.DataSettings.LiveData.RecordCSVPath != "" {
+			log.Infof(log.BackTester, "Recording candles to: %v", c.DataSettings.LiveData.RecordCSVPath)
+		}
+		if c.DataSettings.LiveData.Reconciliation != nil {
+			log.Infof(log.BackTester, "Reconciliation: %+v", *c.DataSettings.LiveData.Reconciliation)
+		}
 	}
 	if c.DataSettings.APIData != nil {
 		log.Info(log.BackTester, "-------------------------------------------------------------")
@@ -137,6 +212,41 @@ func (c *Config) PrintSetting() {
 		log.Infof(log.BackTester, "Start date: %v", c.DataSettings.DatabaseData.StartDate.Format(gctcommon.SimpleTimeFormat))
 		log.Infof(log.BackTester, "End date: %v", c.DataSettings.DatabaseData.EndDate.Format(gctcommon.SimpleTimeFormat))
 	}
+	if c.DataSettings.FallbackData != nil {
+		log.Info(log.BackTester, "-------------------------------------------------------------")
+		log.Info(log.BackTester, "------------------Fallback Data Settings---------------------")
+		log.Info(log.BackTester, "-------------------------------------------------------------")
+		log.Infof(log.BackTester, "Data type: %v", c.DataSettings.DataType)
+		log.Infof(log.BackTester, "Interval: %v", c.DataSettings.Interval)
+		log.Infof(log.BackTester, "Start date: %v", c.DataSettings.FallbackData.StartDate.Format(gctcommon.SimpleTimeFormat))
+		log.Infof(log.BackTester, "End date: %v", c.DataSettings.FallbackData.EndDate.Format(gctcommon.SimpleTimeFormat))
+		log.Infof(log.BackTester, "Sources: %v", strings.Join(c.DataSettings.FallbackData.Sources, " -> "))
+		if c.DataSettings.FallbackData.CacheDirectory != "" {
+			log.Infof(log.BackTester, "Cache directory: %v", c.DataSettings.FallbackData.CacheDirectory)
+		}
+	}
+	if c.DataSettings.Replay != nil {
+		log.Infof(log.BackTester, "Replay speed: %vx", c.DataSettings.Replay.Speed)
+	}
+	if c.DataSettings.Spread != nil {
+		log.Info(log.BackTester, "-------------------------------------------------------------")
+		log.Info(log.BackTester, "------------------Spread Settings----------------------------")
+		log.Info(log.BackTester, "-------------------------------------------------------------")
+		log.Infof(log.BackTester, "Static spread percent: %v", c.DataSettings.Spread.StaticSpreadPercent)
+		if c.DataSettings.Spread.CSVPath != "" {
+			log.Infof(log.BackTester, "Spread CSV file: %v", c.DataSettings.Spread.CSVPath)
+		}
+		log.Infof(log.BackTester, "Use live tickers: %v", c.DataSettings.Spread.UseLiveTickers)
+	}
+	if c.ReportSettings != nil && c.ReportSettings.MaxChartCandles > 0 {
+		log.Infof(log.BackTester, "Report candles per chart page: %v", c.ReportSettings.MaxChartCandles)
+	}
+	if c.EventLog != nil {
+		log.Infof(log.BackTester, "Event log level: %v", c.EventLog.Level)
+		if c.EventLog.Path != "" {
+			log.Infof(log.BackTester, "Event log path: %v", c.EventLog.Path)
+		}
+	}
 	log.Info(log.BackTester, "-------------------------------------------------------------\n\n")
 }
 
@@ -154,10 +264,572 @@ func (c *Config) Validate() error {
 	if err != nil {
 		return err
 	}
+	err = c.validateAccounts()
+	if err != nil {
+		return err
+	}
+	err = c.validateFeeCurrencies()
+	if err != nil {
+		return err
+	}
+	err = c.validateFundingConversions()
+	if err != nil {
+		return err
+	}
+	err = c.validateSpread()
+	if err != nil {
+		return err
+	}
+	err = c.validateReplay()
+	if err != nil {
+		return err
+	}
+	err = c.validateReconciliation()
+	if err != nil {
+		return err
+	}
+	err = c.validateReportSettings()
+	if err != nil {
+		return err
+	}
+	err = c.validateEventLog()
+	if err != nil {
+		return err
+	}
+	err = c.validateFallbackData()
+	if err != nil {
+		return err
+	}
+	err = c.validateStatisticSettings()
+	if err != nil {
+		return err
+	}
+	err = c.validateRegimeClassification()
+	if err != nil {
+		return err
+	}
+	err = c.validateStressTest()
+	if err != nil {
+		return err
+	}
+	err = c.validateEquityScaling()
+	if err != nil {
+		return err
+	}
 	return c.validateMinMaxes()
 }
 
+// InstanceName returns the name of the exchange instance trading for an
+// account. Accounts are loaded as separate exchange instances, named after the
+// exchange and account, while no account uses the exchange itself
+func InstanceName(exchangeName, account string) string {
+	if account == "" {
+		return exchangeName
+	}
+	return strings.ToLower(exchangeName + "-" + account)
+}
+
+// InstanceName returns the name of the exchange instance the currency
+// settings trade on
+func (c *CurrencySettings) InstanceName() string {
+	return InstanceName(c.ExchangeName, c.Account)
+}
+
+// InstanceName returns the name of the exchange instance the funding is
+// held on
+func (e *ExchangeLevelFunding) InstanceName() string {
+	return InstanceName(e.ExchangeName, e.Account)
+}
+
+// InstanceName returns the name of the exchange instance the conversion
+// happens on
+func (f *FundingConversion) InstanceName() string {
+	return InstanceName(f.ExchangeName, f.Account)
+}
+
+// equal checks whether both credentials are unset or hold the same values
+func (c *Credentials) equal(o *Credentials) bool {
+	if c == nil || o == nil {
+		return c == o
+	}
+	return *c == *o
+}
+
+// hasCredentialOverrides checks whether live data overrides the exchange
+// config's API credentials
+func (l *LiveData) hasCredentialOverrides() bool {
+	return l.APIKeyOverride != "" ||
+		l.APISecretOverride != "" ||
+		l.APIClientIDOverride != "" ||
+		l.API2FAOverride != "" ||
+		l.APISubAccountOverride != ""
+}
+
+// validateAccounts ensures credentials are only set for accounts, that each
+// account uses one set of credentials and that accounts funded at the
+// exchange level have currency settings
+func (c *Config) validateAccounts() error {
+	credentials := make(map[string]*Credentials)
+	for i := range c.CurrencySettings {
+		cs := &c.CurrencySettings[i]
+		if cs.Account == "" {
+			if cs.Credentials != nil {
+				return fmt.Errorf("%w %v %v %v-%v credentials require an account",
+					errBadAccount,
+					cs.ExchangeName,
+					cs.Asset,
+					cs.Base,
+					cs.Quote)
+			}
+			continue
+		}
+		if cs.Credentials != nil &&
+			c.DataSettings.LiveData != nil &&
+			c.DataSettings.LiveData.hasCredentialOverrides() {
+			return fmt.Errorf("%w account '%v' credentials cannot be used with live data credential overrides",
+				errBadAccount,
+				cs.Account)
+		}
+		name := cs.InstanceName()
+		existing, ok := credentials[name]
+		if !ok {
+			credentials[name] = cs.Credentials
+			continue
+		}
+		if !existing.equal(cs.Credentials) {
+			return fmt.Errorf("%w account '%v' on %v has conflicting credentials",
+				errBadAccount,
+				cs.Account,
+				cs.ExchangeName)
+		}
+	}
+	for i := range c.StrategySettings.ExchangeLevelFunding {
+		f := &c.StrategySettings.ExchangeLevelFunding[i]
+		if f.Account == "" {
+			continue
+		}
+		if _, ok := credentials[f.InstanceName()]; !ok {
+			return fmt.Errorf("%w funding for account '%v' on %v has no currency settings",
+				errBadAccount,
+				f.Account,
+				f.ExchangeName)
+		}
+	}
+	return nil
+}
+
+// validateFeeCurrencies ensures fees charged in a third currency can be
+// deducted from funding and converted from the pair's quote currency
+func (c *Config) validateFeeCurrencies() error {
+	for i := range c.CurrencySettings {
+		fc := c.CurrencySettings[i].FeeCurrency
+		if fc == nil {
+			continue
+		}
+		if !c.StrategySettings.UseExchangeLevelFunding {
+			return fmt.Errorf("%w %v %v %v-%v fee currency requires exchange level funding",
+				errBadFeeCurrency,
+				c.CurrencySettings[i].ExchangeName,
+				c.CurrencySettings[i].Asset,
+				c.CurrencySettings[i].Base,
+				c.CurrencySettings[i].Quote)
+		}
+		if fc.Currency == "" ||
+			strings.EqualFold(fc.Currency, c.CurrencySettings[i].Base) ||
+			strings.EqualFold(fc.Currency, c.CurrencySettings[i].Quote) {
+			return fmt.Errorf("%w fee currency '%v' must differ from the pair %v-%v",
+				errBadFeeCurrency,
+				fc.Currency,
+				c.CurrencySettings[i].Base,
+				c.CurrencySettings[i].Quote)
+		}
+		if fc.DiscountPercent.IsNegative() || fc.DiscountPercent.GreaterThanOrEqual(decimal.NewFromInt(100)) {
+			return fmt.Errorf("%w discount percent %v must be at least 0 and below 100",
+				errBadFeeCurrency,
+				fc.DiscountPercent)
+		}
+		if fc.ConversionRate.IsNegative() {
+			return fmt.Errorf("%w conversion rate %v cannot be negative",
+				errBadFeeCurrency,
+				fc.ConversionRate)
+		}
+		if fc.ConversionRate.IsZero() && !c.hasConversionPair(i, fc.Currency) {
+			return fmt.Errorf("%w no %v-%v currency settings to convert fees with, set a conversion rate",
+				errBadFeeCurrency,
+				fc.Currency,
+				c.CurrencySettings[i].Quote)
+		}
+	}
+	return nil
+}
+
+// validateFundingConversions ensures funding conversions convert between two
+// different exchange level funded currencies with a fee rate below 1
+func (c *Config) validateFundingConversions() error {
+	for i := range c.StrategySettings.FundingConversions {
+		fc := c.StrategySettings.FundingConversions[i]
+		if !c.StrategySettings.UseExchangeLevelFunding {
+			return fmt.Errorf("%w %v %v %v to %v requires exchange level funding",
+				errBadFundingConversion, fc.ExchangeName, fc.Asset, fc.From, fc.To)
+		}
+		if fc.ExchangeName == "" || fc.Asset == "" || fc.From == "" || fc.To == "" {
+			return fmt.Errorf("%w exchange, asset, from and to must be set", errBadFundingConversion)
+		}
+		if strings.EqualFold(fc.From, fc.To) {
+			return fmt.Errorf("%w %v %v cannot convert %v to itself",
+				errBadFundingConversion, fc.ExchangeName, fc.Asset, fc.From)
+		}
+		if fc.Fee.IsNegative() || fc.Fee.GreaterThanOrEqual(decimal.NewFromInt(1)) {
+			return fmt.Errorf("%w %v %v %v to %v fee %v must be at least 0 and below 1",
+				errBadFundingConversion, fc.ExchangeName, fc.Asset, fc.From, fc.To, fc.Fee)
+		}
+	}
+	return nil
+}
+
+// hasConversionPair checks whether the currency is configured against the
+// quote currency of the currency settings on the same exchange and asset
+func (c *Config) hasConversionPair(i int, code string) bool {
+	cs := c.CurrencySettings[i]
+	for j := range c.CurrencySettings {
+		if !strings.EqualFold(c.CurrencySettings[j].InstanceName(), cs.InstanceName()) ||
+			!strings.EqualFold(c.CurrencySettings[j].Asset, cs.Asset) {
+			continue
+		}
+		if (strings.EqualFold(c.CurrencySettings[j].Base, code) && strings.EqualFold(c.CurrencySettings[j].Quote, cs.Quote)) ||
+			(strings.EqualFold(c.CurrencySettings[j].Base, cs.Quote) && strings.EqualFold(c.CurrencySettings[j].Quote, code)) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateSpread ensures spread settings can be used with the data settings
+func (c *Config) validateSpread() error {
+	if c.DataSettings.Spread == nil {
+		return nil
+	}
+	if c.DataSettings.Spread.StaticSpreadPercent.IsNegative() {
+		return fmt.Errorf("%w static spread percent %v cannot be negative",
+			errBadSpread,
+			c.DataSettings.Spread.StaticSpreadPercent)
+	}
+	if c.DataSettings.Spread.UseLiveTickers && c.DataSettings.LiveData == nil {
+		return fmt.Errorf("%w live tickers can only be used with live data", errBadSpread)
+	}
+	return nil
+}
+
+// validateReplay ensures recorded data can be replayed
+func (c *Config) validateReplay() error {
+	if c.DataSettings.Replay == nil {
+		return nil
+	}
+	if c.DataSettings.Replay.Speed <= 0 {
+		return fmt.Errorf("%w speed %v must be positive", errBadReplay, c.DataSettings.Replay.Speed)
+	}
+	if c.DataSettings.LiveData != nil {
+		return fmt.Errorf("%w live data cannot be replayed, record it first", errBadReplay)
+	}
+	return nil
+}
+
+// validateReportSettings ensures report charts can render at least one candle
+// per page
+func (c *Config) validateReportSettings() error {
+	if c.ReportSettings == nil {
+		return nil
+	}
+	if c.ReportSettings.MaxChartCandles < 0 {
+		return fmt.Errorf("%w max chart candles %v cannot be negative", errBadReportSettings, c.ReportSettings.MaxChartCandles)
+	}
+	return nil
+}
+
+// validateEventLog ensures the event log level is recognised
+func (c *Config) validateEventLog() error {
+	if c.EventLog == nil {
+		return nil
+	}
+	if _, err := eventlog.ParseLevel(c.EventLog.Level); err != nil {
+		return fmt.Errorf("%w %v", errBadEventLogSettings, err)
+	}
+	return nil
+}
+
+// validateReconciliation ensures reconciliation is only used when placing
+// real orders and has a usable interval and tolerance
+func (c *Config) validateReconciliation() error {
+	if c.DataSettings.LiveData == nil || c.DataSettings.LiveData.Reconciliation == nil {
+		return nil
+	}
+	r := c.DataSettings.LiveData.Reconciliation
+	if !c.DataSettings.LiveData.RealOrders {
+		return fmt.Errorf("%w reconciliation requires real orders", errBadReconciliation)
+	}
+	if r.Interval <= 0 {
+		return fmt.Errorf("%w interval %v must be positive", errBadReconciliation, r.Interval)
+	}
+	if r.TolerancePercent.IsNegative() {
+		return fmt.Errorf("%w tolerance percent %v cannot be negative", errBadReconciliation, r.TolerancePercent)
+	}
+	return nil
+}
+
+// ConvertFunds converts an amount of initial funds from one currency to
+// another using the configured conversion rates. Amounts are returned
+// unchanged when no source currency is set or the currencies match
+func (s *StrategySettings) ConvertFunds(amount decimal.Decimal, from, to string) (decimal.Decimal, error) {
+	if from == "" || strings.EqualFold(from, to) {
+		return amount, nil
+	}
+	for i := range s.ConversionRates {
+		if !s.ConversionRates[i].Rate.IsPositive() {
+			continue
+		}
+		if strings.EqualFold(s.ConversionRates[i].From, from) &&
+			strings.EqualFold(s.ConversionRates[i].To, to) {
+			return amount.Mul(s.ConversionRates[i].Rate), nil
+		}
+		if strings.EqualFold(s.ConversionRates[i].From, to) &&
+			strings.EqualFold(s.ConversionRates[i].To, from) {
+			return amount.Div(s.ConversionRates[i].Rate), nil
+		}
+	}
+	return decimal.Zero, fmt.Errorf("%w from %v to %v", errNoConversionRate, from, to)
+}
+
+// validateStatisticSettings ensures the drawdown episode threshold is a
+// positive percentage and the risk free rate curve has a single source of
+// positive rates
+func (c *Config) validateStatisticSettings() error {
+	if c.StatisticSettings.DrawdownEpisodeThreshold.IsNegative() {
+		return errBadDrawdownThreshold
+	}
+	if c.StatisticSettings.RiskFreeRate.IsNegative() {
+		return errBadRiskFreeRate
+	}
+	if len(c.StatisticSettings.RiskFreeRateByYear) > 0 && c.StatisticSettings.RiskFreeRateCSVPath != "" {
+		return errAmbiguousRiskFreeRate
+	}
+	for year, rate := range c.StatisticSettings.RiskFreeRateByYear {
+		if rate.IsNegative() {
+			return fmt.Errorf("%w year %v rate %v", errBadRiskFreeRate, year, rate)
+		}
+	}
+	return nil
+}
+
+// validateRegimeClassification ensures regime classification uses known
+// indicators with positive periods and thresholds, setting the defaults of
+// each indicator when unset
+func (c *Config) validateRegimeClassification() error {
+	r := c.StatisticSettings.RegimeClassification
+	if r == nil {
+		return nil
+	}
+	if r.TrendPeriod < 0 || r.VolatilityPeriod < 0 {
+		return fmt.Errorf("%w periods cannot be negative", errInvalidRegimeClassification)
+	}
+	if r.TrendThreshold.IsNegative() || r.VolatilityThreshold.IsNegative() {
+		return fmt.Errorf("%w thresholds cannot be negative", errInvalidRegimeClassification)
+	}
+	r.TrendIndicator = strings.ToLower(r.TrendIndicator)
+	trendPeriod, trendThreshold := int64(20), decimal.NewFromFloat(0.3)
+	switch r.TrendIndicator {
+	case "":
+		r.TrendIndicator = RegimeTrendEfficiencyRatio
+	case RegimeTrendEfficiencyRatio:
+	case RegimeTrendMovingAverage:
+		trendThreshold = decimal.NewFromInt(2)
+	default:
+		return fmt.Errorf("%w unrecognised trend indicator %v", errInvalidRegimeClassification, r.TrendIndicator)
+	}
+	if r.TrendIndicator == RegimeTrendEfficiencyRatio && r.TrendThreshold.GreaterThan(decimal.NewFromInt(1)) {
+		return fmt.Errorf("%w efficiency ratio threshold %v cannot be greater than one", errInvalidRegimeClassification, r.TrendThreshold)
+	}
+	r.VolatilityIndicator = strings.ToLower(r.VolatilityIndicator)
+	volatilityPeriod, volatilityThreshold := int64(14), decimal.NewFromInt(3)
+	switch r.VolatilityIndicator {
+	case "":
+		r.VolatilityIndicator = RegimeVolatilityATR
+	case RegimeVolatilityATR:
+	case RegimeVolatilityBollingerWidth:
+		volatilityPeriod, volatilityThreshold = 20, decimal.NewFromInt(10)
+	default:
+		return fmt.Errorf("%w unrecognised volatility indicator %v", errInvalidRegimeClassification, r.VolatilityIndicator)
+	}
+	if r.TrendPeriod == 0 {
+		r.TrendPeriod = trendPeriod
+	}
+	if r.TrendThreshold.IsZero() {
+		r.TrendThreshold = trendThreshold
+	}
+	if r.VolatilityPeriod == 0 {
+		r.VolatilityPeriod = volatilityPeriod
+	}
+	if r.VolatilityThreshold.IsZero() {
+		r.VolatilityThreshold = volatilityThreshold
+	}
+	return nil
+}
+
+// validateStressTest ensures each shock is uniquely named and cannot lower a
+// price below zero
+func (c *Config) validateStressTest() error {
+	st := c.PortfolioSettings.StressTest
+	if st == nil {
+		return nil
+	}
+	if st.Interval < 0 {
+		return fmt.Errorf("%w interval %v cannot be negative", errBadStressTest, st.Interval)
+	}
+	if len(st.Shocks) == 0 {
+		return fmt.Errorf("%w no shocks set", errBadStressTest)
+	}
+	names := make(map[string]bool, len(st.Shocks))
+	for i := range st.Shocks {
+		if st.Shocks[i].Name == "" {
+			return fmt.Errorf("%w shock name unset", errBadStressTest)
+		}
+		if names[st.Shocks[i].Name] {
+			return fmt.Errorf("%w duplicate shock %v", errBadStressTest, st.Shocks[i].Name)
+		}
+		names[st.Shocks[i].Name] = true
+		if st.Shocks[i].PercentChange.IsZero() || st.Shocks[i].PercentChange.LessThan(decimal.NewFromInt(-100)) {
+			return fmt.Errorf("%w shock %v percent change %v must be non-zero and at least -100", errBadStressTest, st.Shocks[i].Name, st.Shocks[i].PercentChange)
+		}
+	}
+	return nil
+}
+
+// validateEquityScaling ensures orders are scaled down by drawdowns and the
+// minimum scale is a percentage of order size
+func (c *Config) validateEquityScaling() error {
+	es := c.PortfolioSettings.EquityScaling
+	if es == nil {
+		return nil
+	}
+	if !es.DrawdownMultiplier.IsPositive() {
+		return fmt.Errorf("%w drawdown multiplier %v must be positive", errBadEquityScaling, es.DrawdownMultiplier)
+	}
+	if es.MinimumScale.IsNegative() || es.MinimumScale.GreaterThanOrEqual(decimal.NewFromInt(100)) {
+		return fmt.Errorf("%w minimum scale %v must be at least 0 and less than 100", errBadEquityScaling, es.MinimumScale)
+	}
+	return nil
+}
+
+// validateFallbackData ensures the fallback chain only declares known sources
+// once each and sets their names to lower case
+func (c *Config) validateFallbackData() error {
+	f := c.DataSettings.FallbackData
+	if f == nil {
+		return nil
+	}
+	if c.DataSettings.DataType != common.CandleStr {
+		return fmt.Errorf("%w only candle data is supported", errBadFallbackData)
+	}
+	if len(f.Sources) == 0 {
+		return fmt.Errorf("%w no sources declared", errBadFallbackData)
+	}
+	seen := make(map[string]bool, len(f.Sources))
+	for i := range f.Sources {
+		f.Sources[i] = strings.ToLower(f.Sources[i])
+		switch f.Sources[i] {
+		case DataSourceDatabase, DataSourceAPI:
+		case DataSourceCache:
+			if f.CacheDirectory == "" {
+				return fmt.Errorf("%w cache directory unset", errBadFallbackData)
+			}
+		default:
+			return fmt.Errorf("%w unknown source '%v'", errBadFallbackData, f.Sources[i])
+		}
+		if seen[f.Sources[i]] {
+			return fmt.Errorf("%w source '%v' declared more than once", errBadFallbackData, f.Sources[i])
+		}
+		seen[f.Sources[i]] = true
+	}
+	return nil
+}
+
+// validateUniverseSelection ensures universe selection settings can be used
+// and sets the default metric and lookback period when unset
+func (c *Config) validateUniverseSelection() error {
+	u := c.StrategySettings.UniverseSelection
+	if u == nil {
+		return nil
+	}
+	if !c.StrategySettings.SimultaneousSignalProcessing {
+		return errUniverseSimultaneousProcessing
+	}
+	if u.Interval <= 0 {
+		return fmt.Errorf("%w interval %v must be positive", errInvalidUniverseSelection, u.Interval)
+	}
+	if u.Interval < c.DataSettings.Interval {
+		return fmt.Errorf("%w interval %v cannot be shorter than the data interval %v",
+			errInvalidUniverseSelection,
+			u.Interval,
+			c.DataSettings.Interval)
+	}
+	if u.TopN <= 0 || u.TopN > int64(len(c.CurrencySettings)) {
+		return fmt.Errorf("%w top-n %v must be between 1 and the %v configured currency settings",
+			errInvalidUniverseSelection,
+			u.TopN,
+			len(c.CurrencySettings))
+	}
+	u.Metric = strings.ToLower(u.Metric)
+	switch u.Metric {
+	case "":
+		u.Metric = UniverseMetricQuoteVolume
+	case UniverseMetricVolume, UniverseMetricQuoteVolume:
+	default:
+		return fmt.Errorf("%w unrecognised metric %v", errInvalidUniverseSelection, u.Metric)
+	}
+	if u.LookbackCandles < 0 {
+		return fmt.Errorf("%w lookback-candles %v cannot be negative", errInvalidUniverseSelection, u.LookbackCandles)
+	}
+	if u.LookbackCandles == 0 && c.DataSettings.Interval > 0 {
+		// rank over every candle since the previous selection
+		u.LookbackCandles = int64(u.Interval / c.DataSettings.Interval)
+	}
+	if u.LookbackCandles == 0 {
+		u.LookbackCandles = 1
+	}
+	return nil
+}
+
 // validate ensures no one sets bad config values on purpose
+// validate ensures order limits are not negative and the minimum amount does
+// not exceed the maximum
+func (o *OrderLimits) validate() error {
+	if o.MinimumAmount.IsNegative() ||
+		o.MaximumAmount.IsNegative() ||
+		o.AmountStepSize.IsNegative() ||
+		o.PriceTickSize.IsNegative() ||
+		o.MinimumNotional.IsNegative() {
+		return fmt.Errorf("%w values cannot be negative", errBadOrderLimits)
+	}
+	if !o.MaximumAmount.IsZero() && o.MinimumAmount.GreaterThan(o.MaximumAmount) {
+		return fmt.Errorf("%w minimum amount %v exceeds maximum amount %v",
+			errBadOrderLimits,
+			o.MinimumAmount,
+			o.MaximumAmount)
+	}
+	return nil
+}
+
+// validate ensures the rejection percent is within 0 and 100 and requeue
+// settings are not negative
+func (o *OrderRejection) validate() error {
+	if o.Percent.IsNegative() || o.Percent.GreaterThan(decimal.NewFromInt(100)) {
+		return fmt.Errorf("%w percent %v must be between 0 and 100", errBadOrderRejection, o.Percent)
+	}
+	if o.MaxRequeues < 0 || o.BackoffCandles < 0 {
+		return fmt.Errorf("%w requeue values cannot be negative", errBadOrderRejection)
+	}
+	return nil
+}
+
 func (m *MinMax) validate() error {
 	if m.MaximumSize.IsNegative() {
 		return fmt.Errorf("invalid maximum size %w", errSizeLessThanZero)
@@ -179,6 +851,18 @@ func (m *MinMax) validate() error {
 			errMinMaxEqual,
 			m.MinimumSize)
 	}
+	if m.NotionalSize.IsNegative() {
+		return fmt.Errorf("invalid notional size %w", errSizeLessThanZero)
+	}
+	if m.EquityPercent.IsNegative() {
+		return fmt.Errorf("invalid equity percent %w", errSizeLessThanZero)
+	}
+	if m.EquityPercent.GreaterThan(decimal.NewFromInt(100)) {
+		return fmt.Errorf("%w received %v", errBadEquityPercent, m.EquityPercent)
+	}
+	if !m.NotionalSize.IsZero() && !m.EquityPercent.IsZero() {
+		return errAmbiguousSizing
+	}
 
 	return nil
 }
@@ -215,6 +899,18 @@ func (c *Config) validateStrategySettings() error {
 	if c.StrategySettings.UseExchangeLevelFunding && len(c.StrategySettings.ExchangeLevelFunding) == 0 {
 		return errExchangeLevelFundingDataRequired
 	}
+	for i := range c.StrategySettings.ConversionRates {
+		if c.StrategySettings.ConversionRates[i].From == "" ||
+			c.StrategySettings.ConversionRates[i].To == "" ||
+			strings.EqualFold(c.StrategySettings.ConversionRates[i].From, c.StrategySettings.ConversionRates[i].To) ||
+			!c.StrategySettings.ConversionRates[i].Rate.IsPositive() {
+			return fmt.Errorf("%w from '%v' to '%v' at rate '%v'",
+				errBadConversionRate,
+				c.StrategySettings.ConversionRates[i].From,
+				c.StrategySettings.ConversionRates[i].To,
+				c.StrategySettings.ConversionRates[i].Rate)
+		}
+	}
 	if c.StrategySettings.UseExchangeLevelFunding {
 		for i := range c.StrategySettings.ExchangeLevelFunding {
 			if c.StrategySettings.ExchangeLevelFunding[i].InitialFunds.IsNegative() {
@@ -225,8 +921,39 @@ func (c *Config) validateStrategySettings() error {
 					c.StrategySettings.ExchangeLevelFunding[i].Currency,
 				)
 			}
+			if c.StrategySettings.ExchangeLevelFunding[i].TransferFee.IsNegative() {
+				return fmt.Errorf("%w transfer fee %v for %v %v %v cannot be negative",
+					errBadTransferSettings,
+					c.StrategySettings.ExchangeLevelFunding[i].TransferFee,
+					c.StrategySettings.ExchangeLevelFunding[i].ExchangeName,
+					c.StrategySettings.ExchangeLevelFunding[i].Asset,
+					c.StrategySettings.ExchangeLevelFunding[i].Currency)
+			}
+			if c.StrategySettings.ExchangeLevelFunding[i].DepositConfirmationDelay < 0 {
+				return fmt.Errorf("%w deposit confirmation delay %v for %v %v %v cannot be negative",
+					errBadTransferSettings,
+					c.StrategySettings.ExchangeLevelFunding[i].DepositConfirmationDelay,
+					c.StrategySettings.ExchangeLevelFunding[i].ExchangeName,
+					c.StrategySettings.ExchangeLevelFunding[i].Asset,
+					c.StrategySettings.ExchangeLevelFunding[i].Currency)
+			}
+			_, err := c.StrategySettings.ConvertFunds(
+				c.StrategySettings.ExchangeLevelFunding[i].InitialFunds,
+				c.StrategySettings.ExchangeLevelFunding[i].InitialFundsCurrency,
+				c.StrategySettings.ExchangeLevelFunding[i].Currency)
+			if err != nil {
+				return fmt.Errorf("%v %v %v %w",
+					c.StrategySettings.ExchangeLevelFunding[i].ExchangeName,
+					c.StrategySettings.ExchangeLevelFunding[i].Asset,
+					c.StrategySettings.ExchangeLevelFunding[i].Currency,
+					err)
+			}
 		}
 	}
+	err := c.validateUniverseSelection()
+	if err != nil {
+		return err
+	}
 	strats := strategies.GetStrategies()
 	for i := range strats {
 		if strings.EqualFold(strats[i].Name(), c.StrategySettings.Name) {
@@ -259,6 +986,16 @@ func (c *Config) validateDate() error {
 			return errBadDate
 		}
 	}
+	if c.DataSettings.FallbackData != nil {
+		if c.DataSettings.FallbackData.StartDate.IsZero() ||
+			c.DataSettings.FallbackData.EndDate.IsZero() {
+			return errStartEndUnset
+		}
+		if c.DataSettings.FallbackData.StartDate.After(c.DataSettings.FallbackData.EndDate) ||
+			c.DataSettings.FallbackData.StartDate.Equal(c.DataSettings.FallbackData.EndDate) {
+			return errBadDate
+		}
+	}
 	return nil
 }
 
@@ -317,6 +1054,68 @@ func (c *Config) validateCurrencySettings() error {
 			c.CurrencySettings[i].MinimumSlippagePercent.GreaterThan(c.CurrencySettings[i].MaximumSlippagePercent) {
 			return errBadSlippageRates
 		}
+		if c.CurrencySettings[i].Interval < 0 {
+			return errBadCurrencyInterval
+		}
+		if c.CurrencySettings[i].InitialQuoteFunds != nil {
+			_, err := c.StrategySettings.ConvertFunds(
+				*c.CurrencySettings[i].InitialQuoteFunds,
+				c.CurrencySettings[i].InitialFundsCurrency,
+				c.CurrencySettings[i].Quote)
+			if err != nil {
+				return fmt.Errorf("%v %v %v-%v %w",
+					c.CurrencySettings[i].ExchangeName,
+					c.CurrencySettings[i].Asset,
+					c.CurrencySettings[i].Base,
+					c.CurrencySettings[i].Quote,
+					err)
+			}
+		}
+		if c.CurrencySettings[i].OrderLimits != nil {
+			err := c.CurrencySettings[i].OrderLimits.validate()
+			if err != nil {
+				return fmt.Errorf("%v %v %v-%v %w",
+					c.CurrencySettings[i].ExchangeName,
+					c.CurrencySettings[i].Asset,
+					c.CurrencySettings[i].Base,
+					c.CurrencySettings[i].Quote,
+					err)
+			}
+		}
+		if c.CurrencySettings[i].OrderRejection != nil {
+			err := c.CurrencySettings[i].OrderRejection.validate()
+			if err != nil {
+				return fmt.Errorf("%v %v %v-%v %w",
+					c.CurrencySettings[i].ExchangeName,
+					c.CurrencySettings[i].Asset,
+					c.CurrencySettings[i].Base,
+					c.CurrencySettings[i].Quote,
+					err)
+			}
+		}
+		switch strings.ToLower(c.CurrencySettings[i].FillPrice) {
+		case "":
+			c.CurrencySettings[i].FillPrice = FillPriceClose
+		case FillPriceClose, FillPriceNextOpen, FillPriceOHLCAverage, FillPriceRandom:
+			c.CurrencySettings[i].FillPrice = strings.ToLower(c.CurrencySettings[i].FillPrice)
+		default:
+			return fmt.Errorf("%w '%v'", errInvalidFillPrice, c.CurrencySettings[i].FillPrice)
+		}
+		switch strings.ToLower(c.CurrencySettings[i].VolumeFitting) {
+		case "":
+			c.CurrencySettings[i].VolumeFitting = VolumeFittingUniform
+			if c.CurrencySettings[i].SkipCandleVolumeFitting {
+				c.CurrencySettings[i].VolumeFitting = VolumeFittingNone
+			}
+		case VolumeFittingUniform, VolumeFittingVWAP, VolumeFittingNone:
+			c.CurrencySettings[i].VolumeFitting = strings.ToLower(c.CurrencySettings[i].VolumeFitting)
+			if c.CurrencySettings[i].SkipCandleVolumeFitting && c.CurrencySettings[i].VolumeFitting != VolumeFittingNone {
+				return fmt.Errorf("%w '%v' cannot be used with skip candle volume fitting", errInvalidVolumeFitting, c.CurrencySettings[i].VolumeFitting)
+			}
+		default:
+			return fmt.Errorf("%w '%v'", errInvalidVolumeFitting, c.CurrencySettings[i].VolumeFitting)
+		}
+		c.CurrencySettings[i].SkipCandleVolumeFitting = false
 		c.CurrencySettings[i].ExchangeName = strings.ToLower(c.CurrencySettings[i].ExchangeName)
 	}
 	return nil
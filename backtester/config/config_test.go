@@ -708,6 +708,71 @@ func TestGenerateConfigForDCACSVCandles(t *testing.T) {
 	}
 }
 
+func TestGenerateConfigForDynamicDCACSVCandles(t *testing.T) {
+	fp := filepath.Join("..", "testdata", "binance_BTCUSDT_24h_2019_01_01_2020_01_01.csv")
+	cfg := Config{
+		Nickname: "ExampleStrategyDynamicDCACSVCandles",
+		Goal:     "To demonstrate the dynamic DCA strategy scaling purchases below a moving average using CSV candle data",
+		StrategySettings: StrategySettings{
+			Name: "dynamicdca",
+			CustomSettings: map[string]interface{}{
+				"buy-amount":        100.0,
+				"ma-period":         50.0,
+				"scale-per-percent": 0.1,
+				"minimum-scale":     1.0,
+				"maximum-scale":     3.0,
+			},
+		},
+		CurrencySettings: []CurrencySettings{
+			{
+				ExchangeName:      testExchange,
+				Asset:             asset.Spot.String(),
+				Base:              currency.BTC.String(),
+				Quote:             currency.USDT.String(),
+				InitialQuoteFunds: initialQuoteFunds2,
+				BuySide:           minMax,
+				SellSide:          minMax,
+				Leverage: Leverage{
+					CanUseLeverage: false,
+				},
+				MakerFee: makerFee,
+				TakerFee: takerFee,
+			},
+		},
+		DataSettings: DataSettings{
+			Interval: kline.OneDay.Duration(),
+			DataType: common.CandleStr,
+			CSVData: &CSVData{
+				FullPath: fp,
+			},
+		},
+		PortfolioSettings: PortfolioSettings{
+			BuySide:  minMax,
+			SellSide: minMax,
+			Leverage: Leverage{
+				CanUseLeverage: false,
+			},
+		},
+		StatisticSettings: StatisticSettings{
+			RiskFreeRate: decimal.NewFromFloat(0.03),
+		},
+	}
+	if saveConfig {
+		result, err := json.MarshalIndent(cfg, "", " ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := os.Getwd()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ioutil.WriteFile(filepath.Join(p, "examples", "dynamicdca-csv-candles.strat"), result, 0770)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
+
 func TestGenerateConfigForDCACSVTrades(t *testing.T) {
 	fp := filepath.Join("..", "testdata", "binance_BTCUSDT_24h-trades_2020_11_16.csv")
 	cfg := Config{
@@ -1050,6 +1115,64 @@ func TestValidateCurrencySettings(t *testing.T) {
 	if !errors.Is(err, errBadSlippageRates) {
 		t.Errorf("received: %v, expected: %v", err, errBadSlippageRates)
 	}
+	c.CurrencySettings[0].MaximumSlippagePercent = decimal.NewFromInt(2)
+	c.CurrencySettings[0].Interval = -time.Minute
+	err = c.validateCurrencySettings()
+	if !errors.Is(err, errBadCurrencyInterval) {
+		t.Errorf("received: %v, expected: %v", err, errBadCurrencyInterval)
+	}
+	c.CurrencySettings[0].Interval = time.Hour
+	c.CurrencySettings[0].FillPrice = "bad"
+	err = c.validateCurrencySettings()
+	if !errors.Is(err, errInvalidFillPrice) {
+		t.Errorf("received: %v, expected: %v", err, errInvalidFillPrice)
+	}
+	c.CurrencySettings[0].FillPrice = "Next-Open"
+	err = c.validateCurrencySettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	if c.CurrencySettings[0].FillPrice != FillPriceNextOpen {
+		t.Errorf("received: %v, expected: %v", c.CurrencySettings[0].FillPrice, FillPriceNextOpen)
+	}
+	c.CurrencySettings[0].FillPrice = ""
+	err = c.validateCurrencySettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	if c.CurrencySettings[0].FillPrice != FillPriceClose {
+		t.Errorf("received: %v, expected: %v", c.CurrencySettings[0].FillPrice, FillPriceClose)
+	}
+	if c.CurrencySettings[0].VolumeFitting != VolumeFittingUniform {
+		t.Errorf("received: %v, expected: %v", c.CurrencySettings[0].VolumeFitting, VolumeFittingUniform)
+	}
+	c.CurrencySettings[0].VolumeFitting = "bad"
+	err = c.validateCurrencySettings()
+	if !errors.Is(err, errInvalidVolumeFitting) {
+		t.Errorf("received: %v, expected: %v", err, errInvalidVolumeFitting)
+	}
+	c.CurrencySettings[0].VolumeFitting = "VWAP"
+	c.CurrencySettings[0].SkipCandleVolumeFitting = true
+	err = c.validateCurrencySettings()
+	if !errors.Is(err, errInvalidVolumeFitting) {
+		t.Errorf("received: %v, expected: %v", err, errInvalidVolumeFitting)
+	}
+	c.CurrencySettings[0].VolumeFitting = ""
+	err = c.validateCurrencySettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	if c.CurrencySettings[0].VolumeFitting != VolumeFittingNone || c.CurrencySettings[0].SkipCandleVolumeFitting {
+		t.Errorf("received: %v %v, expected: %v %v", c.CurrencySettings[0].VolumeFitting, c.CurrencySettings[0].SkipCandleVolumeFitting, VolumeFittingNone, false)
+	}
+	c.CurrencySettings[0].VolumeFitting = "VWAP"
+	err = c.validateCurrencySettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	if c.CurrencySettings[0].VolumeFitting != VolumeFittingVWAP {
+		t.Errorf("received: %v, expected: %v", c.CurrencySettings[0].VolumeFitting, VolumeFittingVWAP)
+	}
 }
 
 func TestValidateMinMaxes(t *testing.T) {
@@ -1121,6 +1244,23 @@ func TestValidateMinMaxes(t *testing.T) {
 		t.Errorf("received %v expected %v", err, errMinMaxEqual)
 	}
 
+	for _, tc := range []struct {
+		minMax   MinMax
+		expected error
+	}{
+		{MinMax{NotionalSize: decimal.NewFromInt(-1)}, errSizeLessThanZero},
+		{MinMax{EquityPercent: decimal.NewFromInt(-1)}, errSizeLessThanZero},
+		{MinMax{EquityPercent: decimal.NewFromInt(101)}, errBadEquityPercent},
+		{MinMax{NotionalSize: decimal.NewFromInt(100), EquityPercent: decimal.NewFromInt(10)}, errAmbiguousSizing},
+		{MinMax{EquityPercent: decimal.NewFromInt(10)}, nil},
+	} {
+		c.CurrencySettings = []CurrencySettings{{SellSide: tc.minMax}}
+		err = c.validateMinMaxes()
+		if !errors.Is(err, tc.expected) {
+			t.Errorf("received %v expected %v", err, tc.expected)
+		}
+	}
+
 	c.CurrencySettings = []CurrencySettings{
 		{
 			BuySide: MinMax{
@@ -1181,6 +1321,19 @@ func TestValidateStrategySettings(t *testing.T) {
 	if !errors.Is(err, errBadInitialFunds) {
 		t.Errorf("received %v expected %v", err, errBadInitialFunds)
 	}
+	c.StrategySettings.ExchangeLevelFunding[0].InitialFunds = decimal.NewFromInt(1)
+	c.StrategySettings.ExchangeLevelFunding[0].TransferFee = decimal.NewFromInt(-1)
+	err = c.validateStrategySettings()
+	if !errors.Is(err, errBadTransferSettings) {
+		t.Errorf("received %v expected %v", err, errBadTransferSettings)
+	}
+	c.StrategySettings.ExchangeLevelFunding[0].TransferFee = decimal.Zero
+	c.StrategySettings.ExchangeLevelFunding[0].DepositConfirmationDelay = -time.Minute
+	err = c.validateStrategySettings()
+	if !errors.Is(err, errBadTransferSettings) {
+		t.Errorf("received %v expected %v", err, errBadTransferSettings)
+	}
+	c.StrategySettings.ExchangeLevelFunding[0].DepositConfirmationDelay = time.Minute
 	c.StrategySettings.UseExchangeLevelFunding = false
 	err = c.validateStrategySettings()
 	if !errors.Is(err, errExchangeLevelFundingRequired) {
@@ -1188,6 +1341,382 @@ func TestValidateStrategySettings(t *testing.T) {
 	}
 }
 
+func TestValidateReportSettings(t *testing.T) {
+	t.Parallel()
+	c := &Config{}
+	err := c.validateReportSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	c.ReportSettings = &ReportSettings{MaxChartCandles: -1}
+	err = c.validateReportSettings()
+	if !errors.Is(err, errBadReportSettings) {
+		t.Errorf("received %v expected %v", err, errBadReportSettings)
+	}
+	c.ReportSettings.MaxChartCandles = 500
+	err = c.validateReportSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+}
+
+func TestValidateEventLog(t *testing.T) {
+	t.Parallel()
+	c := &Config{}
+	err := c.validateEventLog()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	c.EventLog = &EventLogSettings{Level: "verbose"}
+	err = c.validateEventLog()
+	if !errors.Is(err, errBadEventLogSettings) {
+		t.Errorf("received %v expected %v", err, errBadEventLogSettings)
+	}
+	c.EventLog.Level = "debug"
+	err = c.validateEventLog()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+}
+
+func TestValidateReplay(t *testing.T) {
+	t.Parallel()
+	c := &Config{}
+	err := c.validateReplay()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	c.DataSettings.Replay = &Replay{}
+	err = c.validateReplay()
+	if !errors.Is(err, errBadReplay) {
+		t.Errorf("received %v expected %v", err, errBadReplay)
+	}
+	c.DataSettings.Replay.Speed = 60
+	c.DataSettings.LiveData = &LiveData{}
+	err = c.validateReplay()
+	if !errors.Is(err, errBadReplay) {
+		t.Errorf("received %v expected %v", err, errBadReplay)
+	}
+	c.DataSettings.LiveData = nil
+	err = c.validateReplay()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+}
+
+func TestValidateReconciliation(t *testing.T) {
+	t.Parallel()
+	c := &Config{}
+	err := c.validateReconciliation()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	c.DataSettings.LiveData = &LiveData{Reconciliation: &Reconciliation{}}
+	err = c.validateReconciliation()
+	if !errors.Is(err, errBadReconciliation) {
+		t.Errorf("received %v expected %v", err, errBadReconciliation)
+	}
+	c.DataSettings.LiveData.RealOrders = true
+	err = c.validateReconciliation()
+	if !errors.Is(err, errBadReconciliation) {
+		t.Errorf("received %v expected %v", err, errBadReconciliation)
+	}
+	c.DataSettings.LiveData.Reconciliation.Interval = time.Minute
+	c.DataSettings.LiveData.Reconciliation.TolerancePercent = decimal.NewFromInt(-1)
+	err = c.validateReconciliation()
+	if !errors.Is(err, errBadReconciliation) {
+		t.Errorf("received %v expected %v", err, errBadReconciliation)
+	}
+	c.DataSettings.LiveData.Reconciliation.TolerancePercent = decimal.NewFromInt(1)
+	err = c.validateReconciliation()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+}
+
+func TestValidateStressTest(t *testing.T) {
+	t.Parallel()
+	c := &Config{}
+	err := c.validateStressTest()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	c.PortfolioSettings.StressTest = &StressTest{}
+	err = c.validateStressTest()
+	if !errors.Is(err, errBadStressTest) {
+		t.Errorf("received %v expected %v", err, errBadStressTest)
+	}
+	c.PortfolioSettings.StressTest.Interval = -time.Hour
+	c.PortfolioSettings.StressTest.Shocks = []Shock{{Name: "crash", PercentChange: decimal.NewFromInt(-20)}}
+	err = c.validateStressTest()
+	if !errors.Is(err, errBadStressTest) {
+		t.Errorf("received %v expected %v", err, errBadStressTest)
+	}
+	c.PortfolioSettings.StressTest.Interval = time.Hour
+	c.PortfolioSettings.StressTest.Shocks = append(c.PortfolioSettings.StressTest.Shocks, Shock{Name: "crash", PercentChange: decimal.NewFromInt(-10)})
+	err = c.validateStressTest()
+	if !errors.Is(err, errBadStressTest) {
+		t.Errorf("received %v expected %v", err, errBadStressTest)
+	}
+	c.PortfolioSettings.StressTest.Shocks[1] = Shock{Name: "depeg", Currency: "USDT", PercentChange: decimal.NewFromInt(-101)}
+	err = c.validateStressTest()
+	if !errors.Is(err, errBadStressTest) {
+		t.Errorf("received %v expected %v", err, errBadStressTest)
+	}
+	c.PortfolioSettings.StressTest.Shocks[1].PercentChange = decimal.Zero
+	err = c.validateStressTest()
+	if !errors.Is(err, errBadStressTest) {
+		t.Errorf("received %v expected %v", err, errBadStressTest)
+	}
+	c.PortfolioSettings.StressTest.Shocks[1].Name = ""
+	c.PortfolioSettings.StressTest.Shocks[1].PercentChange = decimal.NewFromInt(-5)
+	err = c.validateStressTest()
+	if !errors.Is(err, errBadStressTest) {
+		t.Errorf("received %v expected %v", err, errBadStressTest)
+	}
+	c.PortfolioSettings.StressTest.Shocks[1].Name = "depeg"
+	err = c.validateStressTest()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+}
+
+func TestValidateEquityScaling(t *testing.T) {
+	t.Parallel()
+	c := &Config{}
+	err := c.validateEquityScaling()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	c.PortfolioSettings.EquityScaling = &EquityScaling{}
+	err = c.validateEquityScaling()
+	if !errors.Is(err, errBadEquityScaling) {
+		t.Errorf("received %v expected %v", err, errBadEquityScaling)
+	}
+	c.PortfolioSettings.EquityScaling.DrawdownMultiplier = decimal.NewFromInt(2)
+	c.PortfolioSettings.EquityScaling.MinimumScale = decimal.NewFromInt(100)
+	err = c.validateEquityScaling()
+	if !errors.Is(err, errBadEquityScaling) {
+		t.Errorf("received %v expected %v", err, errBadEquityScaling)
+	}
+	c.PortfolioSettings.EquityScaling.MinimumScale = decimal.NewFromInt(-1)
+	err = c.validateEquityScaling()
+	if !errors.Is(err, errBadEquityScaling) {
+		t.Errorf("received %v expected %v", err, errBadEquityScaling)
+	}
+	c.PortfolioSettings.EquityScaling.MinimumScale = decimal.NewFromInt(25)
+	err = c.validateEquityScaling()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+}
+
+func TestInstanceName(t *testing.T) {
+	t.Parallel()
+	cs := &CurrencySettings{ExchangeName: testExchange}
+	if cs.InstanceName() != testExchange {
+		t.Errorf("received %v expected %v", cs.InstanceName(), testExchange)
+	}
+	cs.Account = "Sub1"
+	if cs.InstanceName() != "binance-sub1" {
+		t.Errorf("received %v expected %v", cs.InstanceName(), "binance-sub1")
+	}
+	f := &ExchangeLevelFunding{ExchangeName: testExchange, Account: "sub1"}
+	if f.InstanceName() != cs.InstanceName() {
+		t.Errorf("received %v expected %v", f.InstanceName(), cs.InstanceName())
+	}
+}
+
+func TestValidateAccounts(t *testing.T) {
+	t.Parallel()
+	c := &Config{
+		CurrencySettings: []CurrencySettings{
+			{ExchangeName: testExchange, Credentials: &Credentials{APIKey: "1"}},
+		},
+	}
+	err := c.validateAccounts()
+	if !errors.Is(err, errBadAccount) {
+		t.Errorf("received %v expected %v", err, errBadAccount)
+	}
+	c.CurrencySettings[0].Account = "sub1"
+	c.CurrencySettings = append(c.CurrencySettings,
+		CurrencySettings{ExchangeName: testExchange, Account: "sub1", Credentials: &Credentials{APIKey: "2"}})
+	err = c.validateAccounts()
+	if !errors.Is(err, errBadAccount) {
+		t.Errorf("received %v expected %v", err, errBadAccount)
+	}
+	c.CurrencySettings[1].Account = "sub2"
+	err = c.validateAccounts()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	c.DataSettings.LiveData = &LiveData{APIKeyOverride: "3"}
+	err = c.validateAccounts()
+	if !errors.Is(err, errBadAccount) {
+		t.Errorf("received %v expected %v", err, errBadAccount)
+	}
+	c.DataSettings.LiveData = nil
+	c.StrategySettings.ExchangeLevelFunding = []ExchangeLevelFunding{
+		{ExchangeName: testExchange, Account: "sub3"},
+	}
+	err = c.validateAccounts()
+	if !errors.Is(err, errBadAccount) {
+		t.Errorf("received %v expected %v", err, errBadAccount)
+	}
+	c.StrategySettings.ExchangeLevelFunding[0].Account = "sub2"
+	err = c.validateAccounts()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+}
+
+func TestValidateSpread(t *testing.T) {
+	t.Parallel()
+	c := &Config{}
+	err := c.validateSpread()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	c.DataSettings.Spread = &Spread{StaticSpreadPercent: decimal.NewFromInt(-1)}
+	err = c.validateSpread()
+	if !errors.Is(err, errBadSpread) {
+		t.Errorf("received %v expected %v", err, errBadSpread)
+	}
+	c.DataSettings.Spread = &Spread{UseLiveTickers: true}
+	err = c.validateSpread()
+	if !errors.Is(err, errBadSpread) {
+		t.Errorf("received %v expected %v", err, errBadSpread)
+	}
+	c.DataSettings.LiveData = &LiveData{}
+	err = c.validateSpread()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+}
+
+func TestValidateRegimeClassification(t *testing.T) {
+	t.Parallel()
+	c := &Config{}
+	err := c.validateRegimeClassification()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	c.StatisticSettings.RegimeClassification = &RegimeClassification{TrendPeriod: -1}
+	err = c.validateRegimeClassification()
+	if !errors.Is(err, errInvalidRegimeClassification) {
+		t.Errorf("received %v expected %v", err, errInvalidRegimeClassification)
+	}
+	c.StatisticSettings.RegimeClassification = &RegimeClassification{VolatilityThreshold: decimal.NewFromInt(-1)}
+	err = c.validateRegimeClassification()
+	if !errors.Is(err, errInvalidRegimeClassification) {
+		t.Errorf("received %v expected %v", err, errInvalidRegimeClassification)
+	}
+	c.StatisticSettings.RegimeClassification = &RegimeClassification{TrendIndicator: "adx"}
+	err = c.validateRegimeClassification()
+	if !errors.Is(err, errInvalidRegimeClassification) {
+		t.Errorf("received %v expected %v", err, errInvalidRegimeClassification)
+	}
+	c.StatisticSettings.RegimeClassification = &RegimeClassification{VolatilityIndicator: "vix"}
+	err = c.validateRegimeClassification()
+	if !errors.Is(err, errInvalidRegimeClassification) {
+		t.Errorf("received %v expected %v", err, errInvalidRegimeClassification)
+	}
+	c.StatisticSettings.RegimeClassification = &RegimeClassification{TrendThreshold: decimal.NewFromInt(2)}
+	err = c.validateRegimeClassification()
+	if !errors.Is(err, errInvalidRegimeClassification) {
+		t.Errorf("received %v expected %v", err, errInvalidRegimeClassification)
+	}
+
+	c.StatisticSettings.RegimeClassification = &RegimeClassification{}
+	err = c.validateRegimeClassification()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received %v expected %v", err, nil)
+	}
+	r := c.StatisticSettings.RegimeClassification
+	if r.TrendIndicator != RegimeTrendEfficiencyRatio || r.TrendPeriod != 20 || !r.TrendThreshold.Equal(decimal.NewFromFloat(0.3)) {
+		t.Errorf("unexpected trend defaults %+v", r)
+	}
+	if r.VolatilityIndicator != RegimeVolatilityATR || r.VolatilityPeriod != 14 || !r.VolatilityThreshold.Equal(decimal.NewFromInt(3)) {
+		t.Errorf("unexpected volatility defaults %+v", r)
+	}
+
+	c.StatisticSettings.RegimeClassification = &RegimeClassification{
+		TrendIndicator:      "Moving-Average",
+		VolatilityIndicator: RegimeVolatilityBollingerWidth,
+		VolatilityPeriod:    10,
+	}
+	err = c.validateRegimeClassification()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received %v expected %v", err, nil)
+	}
+	r = c.StatisticSettings.RegimeClassification
+	if r.TrendIndicator != RegimeTrendMovingAverage || !r.TrendThreshold.Equal(decimal.NewFromInt(2)) {
+		t.Errorf("unexpected trend defaults %+v", r)
+	}
+	if r.VolatilityPeriod != 10 || !r.VolatilityThreshold.Equal(decimal.NewFromInt(10)) {
+		t.Errorf("unexpected volatility defaults %+v", r)
+	}
+}
+
+func TestValidateUniverseSelection(t *testing.T) {
+	t.Parallel()
+	c := &Config{}
+	err := c.validateUniverseSelection()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	c.StrategySettings.UniverseSelection = &UniverseSelection{}
+	err = c.validateUniverseSelection()
+	if !errors.Is(err, errUniverseSimultaneousProcessing) {
+		t.Errorf("received %v expected %v", err, errUniverseSimultaneousProcessing)
+	}
+	c.StrategySettings.SimultaneousSignalProcessing = true
+	err = c.validateUniverseSelection()
+	if !errors.Is(err, errInvalidUniverseSelection) {
+		t.Errorf("received %v expected %v", err, errInvalidUniverseSelection)
+	}
+	c.DataSettings.Interval = kline.OneDay.Duration()
+	c.StrategySettings.UniverseSelection.Interval = kline.OneHour.Duration()
+	err = c.validateUniverseSelection()
+	if !errors.Is(err, errInvalidUniverseSelection) {
+		t.Errorf("received %v expected %v", err, errInvalidUniverseSelection)
+	}
+	c.StrategySettings.UniverseSelection.Interval = kline.OneMonth.Duration()
+	err = c.validateUniverseSelection()
+	if !errors.Is(err, errInvalidUniverseSelection) {
+		t.Errorf("received %v expected %v", err, errInvalidUniverseSelection)
+	}
+	c.CurrencySettings = []CurrencySettings{{}, {}, {}}
+	c.StrategySettings.UniverseSelection.TopN = 4
+	err = c.validateUniverseSelection()
+	if !errors.Is(err, errInvalidUniverseSelection) {
+		t.Errorf("received %v expected %v", err, errInvalidUniverseSelection)
+	}
+	c.StrategySettings.UniverseSelection.TopN = 2
+	c.StrategySettings.UniverseSelection.Metric = "market-cap"
+	err = c.validateUniverseSelection()
+	if !errors.Is(err, errInvalidUniverseSelection) {
+		t.Errorf("received %v expected %v", err, errInvalidUniverseSelection)
+	}
+	c.StrategySettings.UniverseSelection.Metric = ""
+	c.StrategySettings.UniverseSelection.LookbackCandles = -1
+	err = c.validateUniverseSelection()
+	if !errors.Is(err, errInvalidUniverseSelection) {
+		t.Errorf("received %v expected %v", err, errInvalidUniverseSelection)
+	}
+	c.StrategySettings.UniverseSelection.LookbackCandles = 0
+	err = c.validateUniverseSelection()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	if c.StrategySettings.UniverseSelection.Metric != UniverseMetricQuoteVolume {
+		t.Errorf("received %v expected %v", c.StrategySettings.UniverseSelection.Metric, UniverseMetricQuoteVolume)
+	}
+	if c.StrategySettings.UniverseSelection.LookbackCandles != 31 {
+		t.Errorf("received %v expected %v", c.StrategySettings.UniverseSelection.LookbackCandles, 31)
+	}
+}
+
 func TestValidate(t *testing.T) {
 	t.Parallel()
 	c := &Config{
@@ -1212,3 +1741,369 @@ func TestValidate(t *testing.T) {
 		t.Errorf("received %v expected %v", err, nil)
 	}
 }
+
+func TestValidateFeeCurrencies(t *testing.T) {
+	t.Parallel()
+	c := &Config{
+		CurrencySettings: []CurrencySettings{
+			{
+				ExchangeName: testExchange,
+				Asset:        asset.Spot.String(),
+				Base:         currency.BTC.String(),
+				Quote:        currency.USDT.String(),
+			},
+		},
+	}
+	err := c.validateFeeCurrencies()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	c.CurrencySettings[0].FeeCurrency = &FeeCurrency{Currency: "BNB"}
+	err = c.validateFeeCurrencies()
+	if !errors.Is(err, errBadFeeCurrency) {
+		t.Errorf("received %v expected %v", err, errBadFeeCurrency)
+	}
+	c.StrategySettings.UseExchangeLevelFunding = true
+	c.CurrencySettings[0].FeeCurrency.Currency = currency.USDT.String()
+	err = c.validateFeeCurrencies()
+	if !errors.Is(err, errBadFeeCurrency) {
+		t.Errorf("received %v expected %v", err, errBadFeeCurrency)
+	}
+	c.CurrencySettings[0].FeeCurrency.Currency = "BNB"
+	c.CurrencySettings[0].FeeCurrency.DiscountPercent = decimal.NewFromInt(100)
+	err = c.validateFeeCurrencies()
+	if !errors.Is(err, errBadFeeCurrency) {
+		t.Errorf("received %v expected %v", err, errBadFeeCurrency)
+	}
+	c.CurrencySettings[0].FeeCurrency.DiscountPercent = decimal.NewFromInt(25)
+	c.CurrencySettings[0].FeeCurrency.ConversionRate = decimal.NewFromInt(-1)
+	err = c.validateFeeCurrencies()
+	if !errors.Is(err, errBadFeeCurrency) {
+		t.Errorf("received %v expected %v", err, errBadFeeCurrency)
+	}
+	c.CurrencySettings[0].FeeCurrency.ConversionRate = decimal.Zero
+	err = c.validateFeeCurrencies()
+	if !errors.Is(err, errBadFeeCurrency) {
+		t.Errorf("received %v expected %v", err, errBadFeeCurrency)
+	}
+	c.CurrencySettings[0].FeeCurrency.ConversionRate = decimal.NewFromInt(300)
+	err = c.validateFeeCurrencies()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	c.CurrencySettings[0].FeeCurrency.ConversionRate = decimal.Zero
+	c.CurrencySettings = append(c.CurrencySettings, CurrencySettings{
+		ExchangeName: testExchange,
+		Asset:        asset.Spot.String(),
+		Base:         "BNB",
+		Quote:        currency.USDT.String(),
+	})
+	err = c.validateFeeCurrencies()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+}
+
+func TestValidateFundingConversions(t *testing.T) {
+	t.Parallel()
+	c := &Config{
+		StrategySettings: StrategySettings{
+			FundingConversions: []FundingConversion{
+				{
+					ExchangeName: testExchange,
+					Asset:        asset.Spot.String(),
+					From:         currency.BTC.String(),
+					To:           currency.USDT.String(),
+					Fee:          decimal.NewFromFloat(0.001),
+				},
+			},
+		},
+	}
+	err := c.validateFundingConversions()
+	if !errors.Is(err, errBadFundingConversion) {
+		t.Errorf("received %v expected %v", err, errBadFundingConversion)
+	}
+	c.StrategySettings.UseExchangeLevelFunding = true
+	err = c.validateFundingConversions()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	c.StrategySettings.FundingConversions[0].To = "btc"
+	err = c.validateFundingConversions()
+	if !errors.Is(err, errBadFundingConversion) {
+		t.Errorf("received %v expected %v", err, errBadFundingConversion)
+	}
+	c.StrategySettings.FundingConversions[0].To = ""
+	err = c.validateFundingConversions()
+	if !errors.Is(err, errBadFundingConversion) {
+		t.Errorf("received %v expected %v", err, errBadFundingConversion)
+	}
+	c.StrategySettings.FundingConversions[0].To = currency.USDT.String()
+	c.StrategySettings.FundingConversions[0].Fee = decimal.NewFromInt(1)
+	err = c.validateFundingConversions()
+	if !errors.Is(err, errBadFundingConversion) {
+		t.Errorf("received %v expected %v", err, errBadFundingConversion)
+	}
+}
+
+func TestOrderLimitsValidate(t *testing.T) {
+	t.Parallel()
+	o := &OrderLimits{}
+	err := o.validate()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	o.PriceTickSize = decimal.NewFromInt(-1)
+	err = o.validate()
+	if !errors.Is(err, errBadOrderLimits) {
+		t.Errorf("received %v expected %v", err, errBadOrderLimits)
+	}
+	o.PriceTickSize = decimal.NewFromFloat(0.01)
+	o.MinimumAmount = decimal.NewFromInt(2)
+	o.MaximumAmount = decimal.NewFromInt(1)
+	err = o.validate()
+	if !errors.Is(err, errBadOrderLimits) {
+		t.Errorf("received %v expected %v", err, errBadOrderLimits)
+	}
+	o.MaximumAmount = decimal.Zero
+	err = o.validate()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+
+	c := &Config{
+		CurrencySettings: []CurrencySettings{
+			{
+				ExchangeName:      testExchange,
+				Asset:             asset.Spot.String(),
+				Base:              currency.BTC.String(),
+				Quote:             currency.USDT.String(),
+				InitialQuoteFunds: initialQuoteFunds1,
+				OrderLimits:       &OrderLimits{MinimumNotional: decimal.NewFromInt(-1)},
+			},
+		},
+	}
+	err = c.validateCurrencySettings()
+	if !errors.Is(err, errBadOrderLimits) {
+		t.Errorf("received %v expected %v", err, errBadOrderLimits)
+	}
+}
+
+func TestOrderRejectionValidate(t *testing.T) {
+	t.Parallel()
+	o := &OrderRejection{Percent: decimal.NewFromInt(10), MaxRequeues: 2, BackoffCandles: 1}
+	err := o.validate()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	o.Percent = decimal.NewFromInt(101)
+	err = o.validate()
+	if !errors.Is(err, errBadOrderRejection) {
+		t.Errorf("received %v expected %v", err, errBadOrderRejection)
+	}
+	o.Percent = decimal.NewFromInt(100)
+	o.BackoffCandles = -1
+	err = o.validate()
+	if !errors.Is(err, errBadOrderRejection) {
+		t.Errorf("received %v expected %v", err, errBadOrderRejection)
+	}
+
+	c := &Config{
+		CurrencySettings: []CurrencySettings{
+			{
+				ExchangeName:      testExchange,
+				Asset:             asset.Spot.String(),
+				Base:              currency.BTC.String(),
+				Quote:             currency.USDT.String(),
+				InitialQuoteFunds: initialQuoteFunds1,
+				OrderRejection:    &OrderRejection{Percent: decimal.NewFromInt(-1)},
+			},
+		},
+	}
+	err = c.validateCurrencySettings()
+	if !errors.Is(err, errBadOrderRejection) {
+		t.Errorf("received %v expected %v", err, errBadOrderRejection)
+	}
+}
+
+func TestValidateFallbackData(t *testing.T) {
+	t.Parallel()
+	c := &Config{}
+	err := c.validateFallbackData()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	c.DataSettings.FallbackData = &FallbackData{}
+	err = c.validateFallbackData()
+	if !errors.Is(err, errBadFallbackData) {
+		t.Errorf("received %v expected %v", err, errBadFallbackData)
+	}
+	c.DataSettings.DataType = common.CandleStr
+	err = c.validateFallbackData()
+	if !errors.Is(err, errBadFallbackData) {
+		t.Errorf("received %v expected %v", err, errBadFallbackData)
+	}
+	c.DataSettings.FallbackData.Sources = []string{"DATABASE", "cache", "api"}
+	err = c.validateFallbackData()
+	if !errors.Is(err, errBadFallbackData) {
+		t.Errorf("received %v expected %v", err, errBadFallbackData)
+	}
+	c.DataSettings.FallbackData.CacheDirectory = "cache"
+	err = c.validateFallbackData()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	if c.DataSettings.FallbackData.Sources[0] != DataSourceDatabase {
+		t.Errorf("received %v expected %v", c.DataSettings.FallbackData.Sources[0], DataSourceDatabase)
+	}
+	c.DataSettings.FallbackData.Sources = []string{"api", "api"}
+	err = c.validateFallbackData()
+	if !errors.Is(err, errBadFallbackData) {
+		t.Errorf("received %v expected %v", err, errBadFallbackData)
+	}
+	c.DataSettings.FallbackData.Sources = []string{"smoke signals"}
+	err = c.validateFallbackData()
+	if !errors.Is(err, errBadFallbackData) {
+		t.Errorf("received %v expected %v", err, errBadFallbackData)
+	}
+}
+
+func TestConvertFunds(t *testing.T) {
+	t.Parallel()
+	s := &StrategySettings{}
+	leet := decimal.NewFromInt(1337)
+	resp, err := s.ConvertFunds(leet, "", "USDT")
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	if !resp.Equal(leet) {
+		t.Errorf("received %v expected %v", resp, leet)
+	}
+	resp, err = s.ConvertFunds(leet, "usdt", "USDT")
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	if !resp.Equal(leet) {
+		t.Errorf("received %v expected %v", resp, leet)
+	}
+	_, err = s.ConvertFunds(leet, "USD", "USDT")
+	if !errors.Is(err, errNoConversionRate) {
+		t.Errorf("received %v expected %v", err, errNoConversionRate)
+	}
+	s.ConversionRates = []ConversionRate{{From: "USD", To: "USDT", Rate: decimal.NewFromInt(2)}}
+	resp, err = s.ConvertFunds(leet, "usd", "usdt")
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	if !resp.Equal(decimal.NewFromInt(2674)) {
+		t.Errorf("received %v expected %v", resp, 2674)
+	}
+	resp, err = s.ConvertFunds(decimal.NewFromInt(2674), "USDT", "USD")
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	if !resp.Equal(leet) {
+		t.Errorf("received %v expected %v", resp, leet)
+	}
+}
+
+func TestValidateConversionRates(t *testing.T) {
+	t.Parallel()
+	c := &Config{
+		StrategySettings: StrategySettings{
+			Name:            dca,
+			ConversionRates: []ConversionRate{{From: "USD", To: "USD", Rate: decimal.NewFromInt(1)}},
+		},
+	}
+	err := c.validateStrategySettings()
+	if !errors.Is(err, errBadConversionRate) {
+		t.Errorf("received %v expected %v", err, errBadConversionRate)
+	}
+	c.StrategySettings.ConversionRates[0].To = "USDT"
+	c.StrategySettings.ConversionRates[0].Rate = decimal.Zero
+	err = c.validateStrategySettings()
+	if !errors.Is(err, errBadConversionRate) {
+		t.Errorf("received %v expected %v", err, errBadConversionRate)
+	}
+	c.StrategySettings.ConversionRates[0].Rate = decimal.NewFromInt(1)
+	err = c.validateStrategySettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	c.StrategySettings.SimultaneousSignalProcessing = true
+	c.StrategySettings.UseExchangeLevelFunding = true
+	c.StrategySettings.ExchangeLevelFunding = []ExchangeLevelFunding{{
+		ExchangeName:         testExchange,
+		Asset:                asset.Spot.String(),
+		Currency:             "USDT",
+		InitialFunds:         decimal.NewFromInt(1337),
+		InitialFundsCurrency: "EUR",
+	}}
+	err = c.validateStrategySettings()
+	if !errors.Is(err, errNoConversionRate) {
+		t.Errorf("received %v expected %v", err, errNoConversionRate)
+	}
+	c.StrategySettings.ExchangeLevelFunding[0].InitialFundsCurrency = "USD"
+	err = c.validateStrategySettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+
+	leet := decimal.NewFromInt(1337)
+	c.StrategySettings.UseExchangeLevelFunding = false
+	c.StrategySettings.ExchangeLevelFunding = nil
+	c.CurrencySettings = []CurrencySettings{{
+		ExchangeName:         testExchange,
+		Asset:                asset.Spot.String(),
+		Base:                 "BTC",
+		Quote:                "USDT",
+		InitialQuoteFunds:    &leet,
+		InitialFundsCurrency: "EUR",
+	}}
+	err = c.validateCurrencySettings()
+	if !errors.Is(err, errNoConversionRate) {
+		t.Errorf("received %v expected %v", err, errNoConversionRate)
+	}
+	c.CurrencySettings[0].InitialFundsCurrency = "USD"
+	err = c.validateCurrencySettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+}
+
+func TestValidateStatisticSettings(t *testing.T) {
+	t.Parallel()
+	c := &Config{}
+	err := c.validateStatisticSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	c.StatisticSettings.DrawdownEpisodeThreshold = decimal.NewFromInt(-1)
+	err = c.validateStatisticSettings()
+	if !errors.Is(err, errBadDrawdownThreshold) {
+		t.Errorf("received %v expected %v", err, errBadDrawdownThreshold)
+	}
+	c.StatisticSettings.DrawdownEpisodeThreshold = decimal.Zero
+	c.StatisticSettings.RiskFreeRate = decimal.NewFromInt(-1)
+	err = c.validateStatisticSettings()
+	if !errors.Is(err, errBadRiskFreeRate) {
+		t.Errorf("received %v expected %v", err, errBadRiskFreeRate)
+	}
+	c.StatisticSettings.RiskFreeRate = decimal.Zero
+	c.StatisticSettings.RiskFreeRateByYear = map[int]decimal.Decimal{2020: decimal.NewFromFloat(-0.01)}
+	err = c.validateStatisticSettings()
+	if !errors.Is(err, errBadRiskFreeRate) {
+		t.Errorf("received %v expected %v", err, errBadRiskFreeRate)
+	}
+	c.StatisticSettings.RiskFreeRateCSVPath = "rates.csv"
+	err = c.validateStatisticSettings()
+	if !errors.Is(err, errAmbiguousRiskFreeRate) {
+		t.Errorf("received %v expected %v", err, errAmbiguousRiskFreeRate)
+	}
+	c.StatisticSettings.RiskFreeRateByYear[2020] = decimal.NewFromFloat(0.01)
+	c.StatisticSettings.RiskFreeRateCSVPath = ""
+	err = c.validateStatisticSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+}
@@ -8,6 +8,71 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database"
 )
 
+// Fill prices which simulated orders can be filled around
+const (
+	// FillPriceClose fills orders around the close price of the candle
+	FillPriceClose = "close"
+	// FillPriceNextOpen fills orders around the open price of the next
+	// candle, falling back to the close price on the final candle
+	FillPriceNextOpen = "next-open"
+	// FillPriceOHLCAverage fills orders around the average of the candle's
+	// open, high, low and close prices
+	FillPriceOHLCAverage = "ohlc-average"
+	// FillPriceRandom fills orders around a random price between the
+	// candle's low and high prices
+	FillPriceRandom = "random"
+)
+
+// Volume fitting algorithms which shrink simulated orders to fit the candle
+const (
+	// VolumeFittingUniform keeps the fill price within the candle's high and
+	// low and shrinks orders to the candle's volume
+	VolumeFittingUniform = "uniform"
+	// VolumeFittingVWAP fits orders like VolumeFittingUniform, then moves
+	// the fill price toward the candle's volume weighted average price by
+	// the share of the candle's volume the order takes
+	VolumeFittingVWAP = "vwap"
+	// VolumeFittingNone fills orders at their full size and price
+	VolumeFittingNone = "none"
+)
+
+// Metrics which universe selection can rank pairs by
+const (
+	// UniverseMetricVolume ranks pairs by their traded base currency volume
+	UniverseMetricVolume = "volume"
+	// UniverseMetricQuoteVolume ranks pairs by their traded volume valued
+	// at the close price, allowing pairs with different bases to be compared
+	UniverseMetricQuoteVolume = "quote-volume"
+)
+
+// Indicators which regime classification can label candles by
+const (
+	// RegimeTrendEfficiencyRatio measures trend strength as the net price
+	// change over the trend period divided by the sum of each candle's
+	// absolute price change, from zero for noise to one for a straight line
+	RegimeTrendEfficiencyRatio = "efficiency-ratio"
+	// RegimeTrendMovingAverage measures trend strength as the percentage
+	// distance of the close price from its simple moving average
+	RegimeTrendMovingAverage = "moving-average"
+	// RegimeVolatilityATR measures volatility as the average true range over
+	// the volatility period as a percentage of the close price
+	RegimeVolatilityATR = "atr"
+	// RegimeVolatilityBollingerWidth measures volatility as the width of
+	// Bollinger bands two standard deviations either side of the moving
+	// average, as a percentage of the moving average
+	RegimeVolatilityBollingerWidth = "bollinger-width"
+)
+
+// Data sources which can be declared in a fallback chain
+const (
+	// DataSourceDatabase loads candles from the GoCryptoTrader database
+	DataSourceDatabase = "database"
+	// DataSourceCache loads candles from CSV files in the cache directory
+	DataSourceCache = "cache"
+	// DataSourceAPI loads candles from the exchange's API
+	DataSourceAPI = "api"
+)
+
 // Errors for config validation
 var (
 	errBadDate                          = errors.New("start date >= end date, please check your config")
@@ -17,10 +82,37 @@ var (
 	errUnsetAsset                       = errors.New("asset unset for currency settings, please check your config")
 	errUnsetCurrency                    = errors.New("currency unset for currency settings, please check your config")
 	errBadSlippageRates                 = errors.New("invalid slippage rates in currency settings, please check your config")
+	errBadCurrencyInterval              = errors.New("invalid candle interval in currency settings, please check your config")
+	errBadConversionRate                = errors.New("invalid conversion rate, please check your config")
+	errNoConversionRate                 = errors.New("no conversion rate set")
+	errBadDrawdownThreshold             = errors.New("drawdown episode threshold cannot be negative, please check your config")
+	errBadRiskFreeRate                  = errors.New("risk free rate cannot be negative, please check your config")
+	errAmbiguousRiskFreeRate            = errors.New("risk free rate can only be set by year or by csv file, please check your config")
+	errInvalidFillPrice                 = errors.New("invalid fill price in currency settings, please check your config")
+	errInvalidVolumeFitting             = errors.New("invalid volume fitting in currency settings, please check your config")
 	errStartEndUnset                    = errors.New("data start and end dates are invalid, please check your config")
 	errSimultaneousProcessingRequired   = errors.New("exchange level funding requires simultaneous processing, please check your config and view funding readme for details")
 	errExchangeLevelFundingRequired     = errors.New("invalid config, funding details set while exchange level funding is disabled")
 	errExchangeLevelFundingDataRequired = errors.New("invalid config, exchange level funding enabled with no funding data set")
+	errUniverseSimultaneousProcessing   = errors.New("universe selection requires simultaneous processing, please check your config")
+	errInvalidUniverseSelection         = errors.New("invalid universe selection settings, please check your config")
+	errInvalidRegimeClassification      = errors.New("invalid regime classification settings, please check your config")
+	errBadSpread                        = errors.New("invalid spread settings, please check your config")
+	errBadReplay                        = errors.New("invalid replay settings, please check your config")
+	errBadTransferSettings              = errors.New("invalid transfer settings, please check your config")
+	errBadReportSettings                = errors.New("invalid report settings, please check your config")
+	errBadEventLogSettings              = errors.New("invalid event log settings, please check your config")
+	errBadReconciliation                = errors.New("invalid reconciliation settings, please check your config")
+	errBadFallbackData                  = errors.New("invalid fallback data settings, please check your config")
+	errBadFeeCurrency                   = errors.New("invalid fee currency settings, please check your config")
+	errBadFundingConversion             = errors.New("invalid funding conversion settings, please check your config")
+	errBadOrderLimits                   = errors.New("invalid order limits, please check your config")
+	errBadAccount                       = errors.New("invalid account settings, please check your config")
+	errBadStressTest                    = errors.New("invalid stress test settings, please check your config")
+	errBadOrderRejection                = errors.New("invalid order rejection settings, please check your config")
+	errBadEquityScaling                 = errors.New("invalid equity scaling settings, please check your config")
+	errAmbiguousSizing                  = errors.New("only one of notional size or equity percent can be set")
+	errBadEquityPercent                 = errors.New("equity percent must be no greater than 100")
 	errSizeLessThanZero                 = errors.New("size less than zero")
 	errMaxSizeMinSizeMismatch           = errors.New("maximum size must be greater to minimum size")
 	errMinMaxEqual                      = errors.New("minimum and maximum limits cannot be equal")
@@ -35,9 +127,29 @@ type Config struct {
 	DataSettings             DataSettings       `json:"data-settings"`
 	PortfolioSettings        PortfolioSettings  `json:"portfolio-settings"`
 	StatisticSettings        StatisticSettings  `json:"statistic-settings"`
+	ReportSettings           *ReportSettings    `json:"report-settings,omitempty"`
+	EventLog                 *EventLogSettings  `json:"event-log,omitempty"`
 	GoCryptoTraderConfigPath string             `json:"gocryptotrader-config-path"`
 }
 
+// ReportSettings customises the generated HTML report
+type ReportSettings struct {
+	// MaxChartCandles is the number of candles rendered per chart page.
+	// Candles beyond it are split into further pages which are only rendered
+	// when viewed. Defaults to the most candles a chart can render
+	MaxChartCandles int `json:"max-chart-candles"`
+}
+
+// EventLogSettings writes each event processed in a run as a line of JSON,
+// allowing a run to be traced event by event
+type EventLogSettings struct {
+	// Level is error, warn, info or debug and defaults to info
+	Level string `json:"level,omitempty"`
+	// Path is the file the event log is written to. Relative paths are
+	// written within the run's output directory. Defaults to events.jsonl
+	Path string `json:"path,omitempty"`
+}
+
 // DataSettings is a container for each type of data retrieval setting.
 // Only ONE can be populated per config
 type DataSettings struct {
@@ -47,6 +159,26 @@ type DataSettings struct {
 	DatabaseData *DatabaseData `json:"database-data,omitempty"`
 	LiveData     *LiveData     `json:"live-data,omitempty"`
 	CSVData      *CSVData      `json:"csv-data,omitempty"`
+	FallbackData *FallbackData `json:"fallback-data,omitempty"`
+	Spread       *Spread       `json:"spread,omitempty"`
+	Replay       *Replay       `json:"replay,omitempty"`
+}
+
+// Replay paces data events against the wall clock rather than processing
+// them as fast as possible, allowing recorded live sessions to be replayed
+// in real time or at an accelerated speed
+type Replay struct {
+	Speed float64 `json:"speed"`
+}
+
+// Spread models the cost of crossing the bid/ask spread, with simulated fills
+// paying half of the spread on top of fees and slippage. Spreads are taken
+// from the CSV file or live tickers when known, otherwise the static spread
+// percent is used
+type Spread struct {
+	StaticSpreadPercent decimal.Decimal `json:"static-spread-percent"`
+	CSVPath             string          `json:"csv-path,omitempty"`
+	UseLiveTickers      bool            `json:"use-live-tickers"`
 }
 
 // StrategySettings contains what strategy to load, along with custom settings map
@@ -57,9 +189,23 @@ type StrategySettings struct {
 	SimultaneousSignalProcessing bool                   `json:"use-simultaneous-signal-processing"`
 	UseExchangeLevelFunding      bool                   `json:"use-exchange-level-funding"`
 	ExchangeLevelFunding         []ExchangeLevelFunding `json:"exchange-level-funding,omitempty"`
+	ConversionRates              []ConversionRate       `json:"conversion-rates,omitempty"`
+	FundingConversions           []FundingConversion    `json:"funding-conversions,omitempty"`
+	UniverseSelection            *UniverseSelection     `json:"universe-selection,omitempty"`
 	CustomSettings               map[string]interface{} `json:"custom-settings,omitempty"`
 }
 
+// UniverseSelection periodically narrows the currency settings down to the
+// top pairs ranked by traded volume, allowing rotation strategies to run over
+// a large list of candidate pairs. Only selected pairs are passed to the
+// strategy, and holdings of pairs which drop out of the selection are sold
+type UniverseSelection struct {
+	Interval        time.Duration `json:"interval"`
+	TopN            int64         `json:"top-n"`
+	Metric          string        `json:"metric"`
+	LookbackCandles int64         `json:"lookback-candles"`
+}
+
 // ExchangeLevelFunding allows the portfolio manager to access
 // a shared pool. For example, The base currencies BTC and LTC can both
 // access the same USDT funding to make purchasing decisions
@@ -69,16 +215,79 @@ type StrategySettings struct {
 // will have dibs
 type ExchangeLevelFunding struct {
 	ExchangeName string          `json:"exchange-name"`
+	Account      string          `json:"account,omitempty"`
 	Asset        string          `json:"asset"`
 	Currency     string          `json:"currency"`
 	InitialFunds decimal.Decimal `json:"initial-funds"`
 	TransferFee  decimal.Decimal `json:"transfer-fee"`
+	// InitialFundsCurrency is the currency InitialFunds are denominated in
+	// when it differs from Currency. The funds are converted to Currency
+	// using the strategy's conversion rates at the start of a run
+	InitialFundsCurrency string `json:"initial-funds-currency,omitempty"`
+	// UseExchangeTransferFee replaces TransferFee, the network fee paid
+	// when withdrawing the currency to another exchange, with the
+	// exchange's withdrawal fee for the currency when it is available
+	UseExchangeTransferFee bool `json:"use-exchange-transfer-fee,omitempty"`
+	// DepositConfirmationDelay is how long funds transferred to the
+	// exchange take to be confirmed before they can be used
+	DepositConfirmationDelay time.Duration `json:"deposit-confirmation-delay,omitempty"`
+}
+
+// ConversionRate is the rate used to convert initial funds between
+// currencies at the start of a run. One unit of From is worth Rate units of
+// To. Rates are also used in reverse to convert To into From
+type ConversionRate struct {
+	From string          `json:"from"`
+	To   string          `json:"to"`
+	Rate decimal.Decimal `json:"rate"`
+}
+
+// FundingConversion allows buy orders short of exchange level funds in the To
+// currency to convert the shortfall from the From currency's funds on the same
+// exchange and asset. Conversions are simulated trades at the latest price of
+// a loaded From and To pair, or the conversion rates when neither is loaded,
+// with Fee the rate charged on the amount received. eg 0.001 is 0.1%
+type FundingConversion struct {
+	ExchangeName string          `json:"exchange-name"`
+	Account      string          `json:"account,omitempty"`
+	Asset        string          `json:"asset"`
+	From         string          `json:"from"`
+	To           string          `json:"to"`
+	Fee          decimal.Decimal `json:"fee"`
 }
 
 // StatisticSettings adjusts ratios where
 // proper data is currently lacking
 type StatisticSettings struct {
 	RiskFreeRate decimal.Decimal `json:"risk-free-rate"`
+	// DrawdownEpisodeThreshold is the minimum drawdown percentage, eg 5 for
+	// 5%, for a drawdown episode to be recorded. All drawdowns are recorded
+	// when unset
+	DrawdownEpisodeThreshold decimal.Decimal `json:"drawdown-episode-threshold"`
+	// RiskFreeRateByYear replaces RiskFreeRate with a rate for each calendar
+	// year, eg {"2020": 0.01}. Years before the first set year use its rate
+	// and later unset years use the most recent rate
+	RiskFreeRateByYear map[int]decimal.Decimal `json:"risk-free-rate-by-year,omitempty"`
+	// RiskFreeRateCSVPath replaces RiskFreeRate with rates loaded from a CSV
+	// file of dates and the annual rate from that date onward
+	RiskFreeRateCSVPath string `json:"risk-free-rate-csv-path,omitempty"`
+	// RegimeClassification labels each candle with a market regime and
+	// breaks down the statistics of each pair by regime
+	RegimeClassification *RegimeClassification `json:"regime-classification,omitempty"`
+}
+
+// RegimeClassification labels each candle of a pair as high volatility when
+// its volatility indicator is at or above the volatility threshold, otherwise
+// as trending when its trend indicator is at or above the trend threshold and
+// as ranging when it is not. Periods are in candles and indicators, periods
+// and thresholds are set to defaults when unset
+type RegimeClassification struct {
+	TrendIndicator      string          `json:"trend-indicator"`
+	TrendPeriod         int64           `json:"trend-period"`
+	TrendThreshold      decimal.Decimal `json:"trend-threshold"`
+	VolatilityIndicator string          `json:"volatility-indicator"`
+	VolatilityPeriod    int64           `json:"volatility-period"`
+	VolatilityThreshold decimal.Decimal `json:"volatility-threshold"`
 }
 
 // PortfolioSettings act as a global protector for strategies
@@ -88,6 +297,42 @@ type PortfolioSettings struct {
 	Leverage Leverage `json:"leverage"`
 	BuySide  MinMax   `json:"buy-side"`
 	SellSide MinMax   `json:"sell-side"`
+	// AuditLogPath is the file every stage of each order's lifecycle is
+	// exported to as JSON lines once a run ends
+	AuditLogPath string `json:"audit-log-path,omitempty"`
+	// StressTest applies hypothetical price shocks to holdings, reporting
+	// the losses in the statistics of each currency
+	StressTest *StressTest `json:"stress-test,omitempty"`
+	// EquityScaling scales buy orders down while equity is in drawdown from
+	// its high-water mark and back up as it recovers
+	EquityScaling *EquityScaling `json:"equity-scaling,omitempty"`
+}
+
+// EquityScaling sizes buy orders by the drawdown of equity in the order's
+// quote currency from its highest value. Orders are scaled to 100% less the
+// drawdown percentage multiplied by the drawdown multiplier, but no lower than
+// the minimum scale, eg a multiplier of 2 scales orders to 80% of their size
+// at a 10% drawdown. Sell orders are not scaled so positions can always be
+// reduced
+type EquityScaling struct {
+	DrawdownMultiplier decimal.Decimal `json:"drawdown-multiplier"`
+	MinimumScale       decimal.Decimal `json:"minimum-scale"`
+}
+
+// StressTest defines the price shocks applied to holdings at the end of a run
+// and, when an interval is set, periodically throughout it
+type StressTest struct {
+	Interval time.Duration `json:"interval"`
+	Shocks   []Shock       `json:"shocks"`
+}
+
+// Shock is a hypothetical percentage price change of a currency, eg -20 for a
+// 20% crash. A shock without a currency applies to the base currency of every
+// pair, while a quote currency, eg USDT, shocks the quote funds held
+type Shock struct {
+	Name          string          `json:"name"`
+	Currency      string          `json:"currency,omitempty"`
+	PercentChange decimal.Decimal `json:"percent-change"`
 }
 
 // Leverage rules are used to allow or limit the use of leverage in orders
@@ -103,6 +348,11 @@ type MinMax struct {
 	MinimumSize  decimal.Decimal `json:"minimum-size"` // will not place an order if under this amount
 	MaximumSize  decimal.Decimal `json:"maximum-size"` // can only place an order up to this amount
 	MaximumTotal decimal.Decimal `json:"maximum-total"`
+	// NotionalSize sizes each order to a fixed value in the quote currency
+	NotionalSize decimal.Decimal `json:"notional-size,omitempty"`
+	// EquityPercent sizes each order to a percentage of the current equity
+	// of all holdings quoted in the same currency, eg 10 for 10%
+	EquityPercent decimal.Decimal `json:"equity-percent,omitempty"`
 }
 
 // CurrencySettings stores pair based variables
@@ -115,9 +365,23 @@ type CurrencySettings struct {
 	Base         string `json:"base"`
 	Quote        string `json:"quote"`
 
+	// Account loads a separate instance of the exchange for the currency
+	// settings, keeping its credentials, funding and orders apart from
+	// other currency settings on the same exchange, such as sub-accounts
+	Account     string       `json:"account,omitempty"`
+	Credentials *Credentials `json:"credentials,omitempty"`
+
+	// Interval overrides the data settings candle interval for this pair,
+	// allowing strategies to mix fast and slow markets
+	Interval time.Duration `json:"interval,omitempty"`
+
 	InitialBaseFunds   *decimal.Decimal `json:"initial-base-funds,omitempty"`
 	InitialQuoteFunds  *decimal.Decimal `json:"initial-quote-funds,omitempty"`
 	InitialLegacyFunds float64          `json:"initial-funds,omitempty"`
+	// InitialFundsCurrency is the currency InitialQuoteFunds are
+	// denominated in when it differs from Quote. The funds are converted to
+	// Quote using the strategy's conversion rates at the start of a run
+	InitialFundsCurrency string `json:"initial-funds-currency,omitempty"`
 
 	Leverage Leverage `json:"leverage"`
 	BuySide  MinMax   `json:"buy-side"`
@@ -131,9 +395,80 @@ type CurrencySettings struct {
 
 	MaximumHoldingsRatio decimal.Decimal `json:"maximum-holdings-ratio"`
 
-	CanUseExchangeLimits          bool `json:"use-exchange-order-limits"`
+	CanUseExchangeLimits bool `json:"use-exchange-order-limits"`
+	// SkipCandleVolumeFitting is a legacy field, migrated to VolumeFitting
+	// set to VolumeFittingNone when present in your strat config
 	SkipCandleVolumeFitting       bool `json:"skip-candle-volume-fitting"`
 	ShowExchangeOrderLimitWarning bool `json:"-"`
+	// VolumeFitting selects how simulated orders are fit to the candle
+	// they are filled in, defaulting to VolumeFittingUniform
+	VolumeFitting string `json:"volume-fitting,omitempty"`
+
+	// FillPrice selects the candle price simulated orders are filled
+	// around. FillPriceSeed seeds the random fill price so runs can be
+	// reproduced
+	FillPrice     string `json:"fill-price,omitempty"`
+	FillPriceSeed int64  `json:"fill-price-seed,omitempty"`
+
+	FeeCurrency *FeeCurrency `json:"fee-currency,omitempty"`
+
+	// OrderLimits sets the exchange order rules simulated orders must
+	// conform to, replacing any fetched from the exchange
+	OrderLimits *OrderLimits `json:"order-limits,omitempty"`
+
+	// PopulateFromExchange queries the exchange at the start of a run for
+	// its current fees and order limits. Unset fee overrides and order
+	// limits are populated from the live values, and a warning is logged
+	// when configured values differ significantly from them
+	PopulateFromExchange bool `json:"populate-from-exchange,omitempty"`
+
+	// OrderRejection rejects a percentage of simulated orders to test how
+	// strategies handle orders failing on a live exchange
+	OrderRejection *OrderRejection `json:"order-rejection,omitempty"`
+}
+
+// OrderRejection defines the percentage of simulated orders which are
+// rejected and how rejected orders are requeued. Rejections are drawn from a
+// random source seeded by Seed so runs can be reproduced. A rejected order is
+// resubmitted up to MaxRequeues times, waiting BackoffCandles candles before
+// each attempt. When ExponentialBackoff is set the wait doubles after every
+// rejection of the same order
+type OrderRejection struct {
+	Percent            decimal.Decimal `json:"percent"`
+	Seed               int64           `json:"seed"`
+	MaxRequeues        int64           `json:"max-requeues"`
+	BackoffCandles     int64           `json:"backoff-candles"`
+	ExponentialBackoff bool            `json:"exponential-backoff"`
+}
+
+// Credentials override the API credentials of the exchange config for an
+// account's exchange instance. Unset values use the exchange config's
+type Credentials struct {
+	APIKey        string `json:"api-key"`
+	APISecret     string `json:"api-secret"`
+	APIClientID   string `json:"api-client-id"`
+	API2FA        string `json:"api-2fa"`
+	APISubAccount string `json:"api-sub-account"`
+}
+
+// OrderLimits defines the lot size, price tick and minimum order rules of an
+// exchange. Unset values are not enforced
+type OrderLimits struct {
+	MinimumAmount   decimal.Decimal `json:"minimum-amount"`
+	MaximumAmount   decimal.Decimal `json:"maximum-amount"`
+	AmountStepSize  decimal.Decimal `json:"amount-step-size"`
+	PriceTickSize   decimal.Decimal `json:"price-tick-size"`
+	MinimumNotional decimal.Decimal `json:"minimum-notional"`
+}
+
+// FeeCurrency charges a pair's exchange fees in a third currency, such as
+// BNB, at a discounted rate. The fee is converted using the latest price of
+// the fee currency against the pair's quote currency when that pair is loaded,
+// otherwise the ConversionRate is used
+type FeeCurrency struct {
+	Currency        string          `json:"currency"`
+	DiscountPercent decimal.Decimal `json:"discount-percent"`
+	ConversionRate  decimal.Decimal `json:"conversion-rate,omitempty"`
 }
 
 // APIData defines all fields to configure API based data
@@ -156,6 +491,19 @@ type DatabaseData struct {
 	InclusiveEndDate bool             `json:"inclusive-end-date"`
 }
 
+// FallbackData defines an ordered chain of data sources to load candles from.
+// Runs prefer the earlier, local, sources and only the ranges they are
+// missing are requested from later sources. Candles found by a later source
+// are stored back to the database and cache sources declared before it
+type FallbackData struct {
+	StartDate        time.Time        `json:"start-date"`
+	EndDate          time.Time        `json:"end-date"`
+	InclusiveEndDate bool             `json:"inclusive-end-date"`
+	Sources          []string         `json:"sources"`
+	CacheDirectory   string           `json:"cache-directory,omitempty"`
+	ConfigOverride   *database.Config `json:"config-override,omitempty"`
+}
+
 // LiveData defines all fields to configure live data
 type LiveData struct {
 	APIKeyOverride        string `json:"api-key-override"`
@@ -164,4 +512,19 @@ type LiveData struct {
 	API2FAOverride        string `json:"api-2fa-override"`
 	APISubAccountOverride string `json:"api-sub-account-override"`
 	RealOrders            bool   `json:"real-orders"`
+	UseOrderRouter        bool   `json:"use-order-router"`
+	RecordCSVPath         string `json:"record-csv-path,omitempty"`
+	UseWebsocket          bool   `json:"use-websocket"`
+	// Reconciliation compares exchange balances and orders against the
+	// backtester's funding while placing real orders
+	Reconciliation *Reconciliation `json:"reconciliation,omitempty"`
+}
+
+// Reconciliation defines how often real exchange balances are compared to
+// the backtester's funding, the percentage difference tolerated and whether
+// funding is corrected to match the exchange when it drifts further
+type Reconciliation struct {
+	Interval         time.Duration   `json:"interval"`
+	TolerancePercent decimal.Decimal `json:"tolerance-percent"`
+	AutoCorrect      bool            `json:"auto-correct"`
 }
@@ -209,7 +209,98 @@ func parseDataSettings(cfg *config.Config, reader *bufio.Reader) error {
 	case "Live":
 		parseLive(reader, cfg)
 	}
-	return err
+	if err != nil {
+		return err
+	}
+	fmt.Println("Will fills pay the bid/ask spread? y/n")
+	yn := quickParse(reader)
+	if yn == y || yn == yes {
+		cfg.DataSettings.Spread, err = parseSpread(reader, cfg.DataSettings.LiveData != nil)
+		if err != nil {
+			return err
+		}
+	}
+	if cfg.DataSettings.LiveData != nil {
+		return nil
+	}
+	fmt.Println("Will the data be replayed against the wall clock? y/n")
+	yn = quickParse(reader)
+	if yn == y || yn == yes {
+		fmt.Println("What is the replay speed? 1 is real time, 60 replays a minute of data every second")
+		var speed float64
+		speed, err = strconv.ParseFloat(quickParse(reader), 64)
+		if err != nil {
+			return err
+		}
+		cfg.DataSettings.Replay = &config.Replay{Speed: speed}
+	}
+	return nil
+}
+
+func parseSpread(reader *bufio.Reader, usingLiveData bool) (*config.Spread, error) {
+	resp := &config.Spread{}
+	var err error
+	fmt.Println("What is the static spread percent to use when no spread is known? eg 0.1")
+	resp.StaticSpreadPercent, err = decimal.NewFromString(quickParse(reader))
+	if err != nil {
+		return nil, err
+	}
+	fmt.Println("Enter the path to a spread csv file. Leave blank to not use one")
+	resp.CSVPath = quickParse(reader)
+	if usingLiveData {
+		fmt.Println("Will the spread be taken from live tickers? y/n")
+		yn := quickParse(reader)
+		resp.UseLiveTickers = yn == y || yn == yes
+	}
+	return resp, nil
+}
+
+func parseOrderLimits(reader *bufio.Reader) (*config.OrderLimits, error) {
+	resp := &config.OrderLimits{}
+	fields := []struct {
+		prompt string
+		value  *decimal.Decimal
+	}{
+		{"What is the minimum order amount? Leave blank for none. eg 0.0001", &resp.MinimumAmount},
+		{"What is the maximum order amount? Leave blank for none. eg 9000", &resp.MaximumAmount},
+		{"What is the amount step, or lot, size? Leave blank for none. eg 0.0001", &resp.AmountStepSize},
+		{"What is the price tick size? Leave blank for none. eg 0.01", &resp.PriceTickSize},
+		{"What is the minimum notional value of an order? Leave blank for none. eg 10", &resp.MinimumNotional},
+	}
+	for i := range fields {
+		fmt.Println(fields[i].prompt)
+		input := quickParse(reader)
+		if input == "" {
+			continue
+		}
+		value, err := decimal.NewFromString(input)
+		if err != nil {
+			return nil, err
+		}
+		*fields[i].value = value
+	}
+	return resp, nil
+}
+
+func parseFeeCurrency(reader *bufio.Reader) (*config.FeeCurrency, error) {
+	resp := &config.FeeCurrency{}
+	var err error
+	fmt.Println("What currency are fees paid in? eg BNB")
+	resp.Currency = quickParse(reader)
+	fmt.Println("What percent are fees discounted by when paid in this currency? eg 25")
+	resp.DiscountPercent, err = decimal.NewFromString(quickParse(reader))
+	if err != nil {
+		return nil, err
+	}
+	fmt.Println("What is the price of the fee currency in the quote currency? Leave blank to use a configured pair's price. eg 300")
+	rate := quickParse(reader)
+	if rate != "" {
+		resp.ConversionRate, err = decimal.NewFromString(rate)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
 }
 
 func parsePortfolioSettings(reader *bufio.Reader, cfg *config.Config) error {
@@ -251,6 +342,41 @@ func parseExchangeSettings(reader *bufio.Reader, cfg *config.Config) error {
 	return nil
 }
 
+func parseUniverseSelection(reader *bufio.Reader) (*config.UniverseSelection, error) {
+	resp := &config.UniverseSelection{}
+	fmt.Println("How often should pairs be selected? eg 720h")
+	interval, err := time.ParseDuration(quickParse(reader))
+	if err != nil {
+		return nil, err
+	}
+	resp.Interval = interval
+	fmt.Println("How many pairs should be selected?")
+	resp.TopN, err = strconv.ParseInt(quickParse(reader), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	fmt.Printf("What should pairs be ranked by? Leave blank to use \"%v\"\n", config.UniverseMetricQuoteVolume)
+	fmt.Printf("1. %v\n", config.UniverseMetricQuoteVolume)
+	fmt.Printf("2. %v\n", config.UniverseMetricVolume)
+	switch response := quickParse(reader); response {
+	case "", "1":
+		resp.Metric = config.UniverseMetricQuoteVolume
+	case "2":
+		resp.Metric = config.UniverseMetricVolume
+	default:
+		resp.Metric = response
+	}
+	fmt.Println("How many candles should pairs be ranked over? Leave blank to use every candle in the selection interval")
+	lookback := quickParse(reader)
+	if lookback != "" {
+		resp.LookbackCandles, err = strconv.ParseInt(lookback, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
 func parseStrategySettings(cfg *config.Config, reader *bufio.Reader) error {
 	fmt.Println("Firstly, please select which strategy you wish to use")
 	strats := strategies.GetStrategies()
@@ -280,6 +406,15 @@ func parseStrategySettings(cfg *config.Config, reader *bufio.Reader) error {
 	if !cfg.StrategySettings.SimultaneousSignalProcessing {
 		return nil
 	}
+	fmt.Println("Will this strategy trade a subset of pairs selected by volume? y/n")
+	yn = quickParse(reader)
+	if strings.Contains(yn, y) {
+		var err error
+		cfg.StrategySettings.UniverseSelection, err = parseUniverseSelection(reader)
+		if err != nil {
+			return err
+		}
+	}
 	fmt.Println("Will this strategy be able to share funds at an exchange level? y/n")
 	yn = quickParse(reader)
 	cfg.StrategySettings.UseExchangeLevelFunding = strings.Contains(yn, y)
@@ -421,7 +556,7 @@ func parseDatabase(reader *bufio.Reader, cfg *config.Config) error {
 		input = quickParse(reader)
 		cfg.DataSettings.DatabaseData.ConfigOverride.Verbose = input == y || input == yes
 
-		fmt.Printf("What database driver to use? %v %v or %v\n", database.DBPostgreSQL, database.DBSQLite, database.DBSQLite3)
+		fmt.Printf("What database driver to use? %v %v %v or %v\n", database.DBPostgreSQL, database.DBTimescaleDB, database.DBSQLite, database.DBSQLite3)
 		cfg.DataSettings.DatabaseData.ConfigOverride.Driver = quickParse(reader)
 
 		fmt.Println("What is the database host?")
@@ -436,7 +571,8 @@ func parseDatabase(reader *bufio.Reader, cfg *config.Config) error {
 		fmt.Println("What is the database? eg database.db")
 		cfg.DataSettings.DatabaseData.ConfigOverride.Database = quickParse(reader)
 
-		if cfg.DataSettings.DatabaseData.ConfigOverride.Driver == database.DBPostgreSQL {
+		if cfg.DataSettings.DatabaseData.ConfigOverride.Driver == database.DBPostgreSQL ||
+			cfg.DataSettings.DatabaseData.ConfigOverride.Driver == database.DBTimescaleDB {
 			fmt.Println("What is the database SSLMode? eg disable")
 			cfg.DataSettings.DatabaseData.ConfigOverride.SSLMode = quickParse(reader)
 		}
@@ -454,7 +590,8 @@ func parseDatabase(reader *bufio.Reader, cfg *config.Config) error {
 		if err != nil {
 			return fmt.Errorf("database failed to set config: %w", err)
 		}
-		if cfg.DataSettings.DatabaseData.ConfigOverride.Driver == database.DBPostgreSQL {
+		if cfg.DataSettings.DatabaseData.ConfigOverride.Driver == database.DBPostgreSQL ||
+			cfg.DataSettings.DatabaseData.ConfigOverride.Driver == database.DBTimescaleDB {
 			_, err = dbPSQL.Connect(cfg.DataSettings.DatabaseData.ConfigOverride)
 			if err != nil {
 				return fmt.Errorf("database failed to connect: %v", err)
@@ -492,6 +629,11 @@ func parseLive(reader *bufio.Reader, cfg *config.Config) {
 			cfg.DataSettings.LiveData.APISubAccountOverride = quickParse(reader)
 		}
 	}
+	fmt.Println("Enter a csv file path to record candles to for replaying later. Leave blank to not record")
+	cfg.DataSettings.LiveData.RecordCSVPath = quickParse(reader)
+	fmt.Println("Do you want to receive data via the exchange websocket instead of polling its API? y/n")
+	input = quickParse(reader)
+	cfg.DataSettings.LiveData.UseWebsocket = input == y || input == yes
 }
 
 func parseDataChoice(reader *bufio.Reader, multiCurrency bool) (string, error) {
@@ -647,6 +789,16 @@ func addCurrencySetting(reader *bufio.Reader, usingExchangeLevelFunding bool) (*
 		}
 		setting.TakerFee = decimal.NewFromFloat(f)
 	}
+	if usingExchangeLevelFunding {
+		fmt.Println("Will fees be paid in another currency, such as BNB? y/n")
+		yn := quickParse(reader)
+		if yn == y || yn == yes {
+			setting.FeeCurrency, err = parseFeeCurrency(reader)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
 
 	fmt.Println("Will there be buy-side limits? y/n")
 	yn := quickParse(reader)
@@ -669,12 +821,23 @@ func addCurrencySetting(reader *bufio.Reader, usingExchangeLevelFunding bool) (*
 	if yn == y || yn == yes {
 		setting.CanUseExchangeLimits = true
 	}
-
-	fmt.Println("Should order size shrink to fit within candle volume? y/n")
+	fmt.Println("Do you wish to set the exchange order limits, such as lot size and price tick, rather than fetch them? y/n")
 	yn = quickParse(reader)
 	if yn == y || yn == yes {
-		setting.SkipCandleVolumeFitting = true
+		setting.OrderLimits, err = parseOrderLimits(reader)
+		if err != nil {
+			return nil, err
+		}
 	}
+	fmt.Println("Do you wish to populate unset fees and order limits from the exchange at the start of a run? y/n")
+	yn = quickParse(reader)
+	if yn == y || yn == yes {
+		setting.PopulateFromExchange = true
+	}
+
+	fmt.Printf("How should orders be fit to candle volume? Options are '%v', '%v' or '%v'. Leave blank for '%v'\n",
+		config.VolumeFittingUniform, config.VolumeFittingVWAP, config.VolumeFittingNone, config.VolumeFittingUniform)
+	setting.VolumeFitting = quickParse(reader)
 
 	fmt.Println("Do you wish to include slippage? y/n")
 	yn = quickParse(reader)
@@ -698,6 +861,21 @@ func addCurrencySetting(reader *bufio.Reader, usingExchangeLevelFunding bool) (*
 		setting.MaximumSlippagePercent = decimal.NewFromFloat(f)
 	}
 
+	fmt.Printf("What price should simulated orders be filled around? %v, %v, %v or %v. Leave blank for %v\n",
+		config.FillPriceClose,
+		config.FillPriceNextOpen,
+		config.FillPriceOHLCAverage,
+		config.FillPriceRandom,
+		config.FillPriceClose)
+	setting.FillPrice = quickParse(reader)
+	if strings.EqualFold(setting.FillPrice, config.FillPriceRandom) {
+		fmt.Println("What seed should be used to generate random fill prices? eg 1337")
+		setting.FillPriceSeed, err = strconv.ParseInt(quickParse(reader), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &setting, nil
 }
 
@@ -730,6 +908,25 @@ func minMaxParse(buySell string, reader *bufio.Reader) (config.MinMax, error) {
 		}
 		resp.MaximumTotal = decimal.NewFromFloat(f)
 	}
+	fmt.Printf("What is the fixed notional %s size in the quote currency? Leave blank to size by available funds or equity percent eg 500\n", buySell)
+	parseNum = quickParse(reader)
+	if parseNum != "" {
+		f, err := strconv.ParseFloat(parseNum, 64)
+		if err != nil {
+			return resp, err
+		}
+		resp.NotionalSize = decimal.NewFromFloat(f)
+		return resp, nil
+	}
+	fmt.Printf("What percentage of equity should each %s be sized to? Leave blank to size by available funds eg 10\n", buySell)
+	parseNum = quickParse(reader)
+	if parseNum != "" {
+		f, err := strconv.ParseFloat(parseNum, 64)
+		if err != nil {
+			return resp, err
+		}
+		resp.EquityPercent = decimal.NewFromFloat(f)
+	}
 
 	return resp, nil
 }
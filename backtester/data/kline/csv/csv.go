@@ -148,6 +148,10 @@ func LoadData(dataType int64, filepath, exchangeName string, interval time.Durat
 		if err != nil {
 			return nil, fmt.Errorf("could not read csv trade data for %v %v %v, %v", exchangeName, a, fPair, err)
 		}
+		resp.Footprints, err = trade.ConvertTradesToFootprints(kline.Interval(interval), 0, trades...)
+		if err != nil {
+			return nil, fmt.Errorf("could not generate trade footprints for %v %v %v, %v", exchangeName, a, fPair, err)
+		}
 	default:
 		return nil, fmt.Errorf("could not process csv data for %v %v %v, %w", exchangeName, a, fPair, common.ErrInvalidDataType)
 	}
@@ -158,3 +162,34 @@ func LoadData(dataType int64, filepath, exchangeName string, interval time.Durat
 
 	return resp, nil
 }
+
+// WriteCandles writes candles to a csv file in the format read by LoadData,
+// replacing any existing file
+func WriteCandles(filepath string, candles []kline.Candle) (err error) {
+	f, err := os.OpenFile(filepath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		closeErr := f.Close()
+		if err == nil {
+			err = closeErr
+		}
+	}()
+	w := csv.NewWriter(f)
+	for i := range candles {
+		err = w.Write([]string{
+			strconv.FormatInt(candles[i].Time.Unix(), 10),
+			strconv.FormatFloat(candles[i].Volume, 'f', -1, 64),
+			strconv.FormatFloat(candles[i].Open, 'f', -1, 64),
+			strconv.FormatFloat(candles[i].High, 'f', -1, 64),
+			strconv.FormatFloat(candles[i].Low, 'f', -1, 64),
+			strconv.FormatFloat(candles[i].Close, 'f', -1, 64),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
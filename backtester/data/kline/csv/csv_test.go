@@ -4,6 +4,7 @@ import (
 	"errors"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -60,3 +61,26 @@ func TestLoadDataInvalid(t *testing.T) {
 		t.Errorf("received: %v, expected: %v", err, common.ErrInvalidDataType)
 	}
 }
+
+func TestWriteCandles(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "candles.csv")
+	tt := time.Unix(1609459200, 0)
+	err := WriteCandles(path, []gctkline.Candle{
+		{Time: tt, Open: 1, High: 2, Low: 0.5, Close: 1.5, Volume: 1337},
+		{Time: tt.Add(time.Hour), Open: 1.5, High: 3, Low: 1, Close: 2, Volume: 1},
+	})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	resp, err := LoadData(common.DataCandle, path, testExchange, gctkline.OneHour.Duration(), currency.NewPair(currency.BTC, currency.USDT), asset.Spot)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp.Item.Candles) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(resp.Item.Candles), 2)
+	}
+	if !resp.Item.Candles[1].Time.Equal(tt.Add(time.Hour)) || resp.Item.Candles[0].Volume != 1337 {
+		t.Errorf("unexpected candle %+v", resp.Item.Candles[1])
+	}
+}
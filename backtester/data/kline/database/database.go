@@ -53,6 +53,10 @@ func LoadData(startDate, endDate time.Time, interval time.Duration, exchangeName
 			return nil, fmt.Errorf("could not retrieve database trade data for %v %v %v, %v", exchangeName, a, fPair, err)
 		}
 		resp.Item = klineItem
+		resp.Footprints, err = trade.ConvertTradesToFootprints(gctkline.Interval(interval), 0, trades...)
+		if err != nil {
+			return nil, fmt.Errorf("could not generate trade footprints for %v %v %v, %v", exchangeName, a, fPair, err)
+		}
 	default:
 		return nil, fmt.Errorf("could not retrieve database data for %v %v %v, %w", exchangeName, a, fPair, common.ErrInvalidDataType)
 	}
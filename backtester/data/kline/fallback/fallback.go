@@ -0,0 +1,215 @@
+package fallback
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline/api"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline/csv"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// LoadData loads candles between the start and end dates from each source in
+// order, with each source only being asked for the ranges which earlier
+// sources did not have. Candles found by a source are stored back to the
+// sources before it so that future runs can be served by them
+func LoadData(sources []Source, d Details, start, end time.Time) (*kline.DataFromKline, error) {
+	if len(sources) == 0 {
+		return nil, errNoSources
+	}
+	holder, err := gctkline.CalculateCandleDateRanges(start, end, d.Interval, 0)
+	if err != nil {
+		return nil, err
+	}
+	item := gctkline.Item{
+		Exchange: strings.ToLower(d.Exchange),
+		Pair:     d.Pair,
+		Asset:    d.Asset,
+		Interval: d.Interval,
+	}
+	for i := range sources {
+		if sources[i] == nil {
+			return nil, errNilSource
+		}
+		missing := missingSpans(holder)
+		if len(missing) == 0 {
+			break
+		}
+		found := gctkline.Item{
+			Exchange: item.Exchange,
+			Pair:     item.Pair,
+			Asset:    item.Asset,
+			Interval: item.Interval,
+		}
+		for j := range missing {
+			var loaded *gctkline.Item
+			loaded, err = sources[i].Load(missing[j].start, missing[j].end)
+			if err != nil {
+				log.Warnf(log.BackTester, "unable to load %v %v %v data from %v between %v and %v: %v",
+					d.Exchange, d.Asset, d.Pair, sources[i].Name(),
+					missing[j].start, missing[j].end, err)
+				continue
+			}
+			loaded.RemoveOutsideRange(missing[j].start, missing[j].end)
+			found.Candles = append(found.Candles, loaded.Candles...)
+		}
+		if len(found.Candles) == 0 {
+			continue
+		}
+		err = found.Repair(gctkline.UnorderedCandles | gctkline.DuplicateCandles)
+		if err != nil {
+			return nil, err
+		}
+		log.Infof(log.BackTester, "loaded %v %v %v %v candles from %v",
+			len(found.Candles), d.Exchange, d.Asset, d.Pair, sources[i].Name())
+		err = item.Merge(&found)
+		if err != nil {
+			return nil, err
+		}
+		holder.SetHasDataFromCandles(item.Candles)
+		for j := 0; j < i; j++ {
+			err = sources[j].Store(&found)
+			if err != nil {
+				log.Errorf(log.BackTester, "unable to store %v %v %v data to %v: %v",
+					d.Exchange, d.Asset, d.Pair, sources[j].Name(), err)
+			}
+		}
+	}
+	if len(item.Candles) == 0 {
+		return nil, fmt.Errorf("%w for %v %v %v", errNoDataLoaded, d.Exchange, d.Asset, d.Pair)
+	}
+	summary := holder.DataSummary(false)
+	if len(summary) > 0 {
+		log.Warnf(log.BackTester, "%v", summary)
+	}
+	item.FillMissingDataWithEmptyEntries(holder)
+	item.RemoveOutsideRange(start, end)
+	return &kline.DataFromKline{
+		Item:        item,
+		RangeHolder: holder,
+	}, nil
+}
+
+// missingSpans groups consecutive intervals without data into date ranges
+func missingSpans(h *gctkline.IntervalRangeHolder) []span {
+	var spans []span
+	var current *span
+	for x := range h.Ranges {
+		for y := range h.Ranges[x].Intervals {
+			interval := h.Ranges[x].Intervals[y]
+			if interval.HasData {
+				current = nil
+				continue
+			}
+			if current == nil {
+				spans = append(spans, span{start: interval.Start.Time, end: interval.End.Time})
+				current = &spans[len(spans)-1]
+				continue
+			}
+			current.end = interval.End.Time
+		}
+	}
+	return spans
+}
+
+// Name returns the name of the source
+func (d *DatabaseSource) Name() string {
+	return "database"
+}
+
+// Load returns the stored candles between the start and end dates
+func (d *DatabaseSource) Load(start, end time.Time) (*gctkline.Item, error) {
+	item, err := gctkline.LoadFromDatabase(strings.ToLower(d.Exchange), d.Pair, d.Asset, d.Interval, start, end)
+	if err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// Store saves candles to the database
+func (d *DatabaseSource) Store(item *gctkline.Item) error {
+	_, err := gctkline.StoreInDatabase(item, false)
+	return err
+}
+
+// Name returns the name of the source
+func (c *CacheSource) Name() string {
+	return "cache"
+}
+
+// path returns the CSV file candles are cached in
+func (c *CacheSource) path() string {
+	return filepath.Join(c.Directory, strings.ToLower(fmt.Sprintf("%s-%s-%s-%s.csv",
+		c.Exchange,
+		c.Asset,
+		c.Pair.Format("", false),
+		c.Interval.Short())))
+}
+
+// Load returns the cached candles between the start and end dates
+func (c *CacheSource) Load(start, end time.Time) (*gctkline.Item, error) {
+	if c.Directory == "" {
+		return nil, errCacheDirUnset
+	}
+	resp, err := csv.LoadData(common.DataCandle, c.path(), c.Exchange, c.Interval.Duration(), c.Pair, c.Asset)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return &gctkline.Item{}, nil
+		}
+		return nil, err
+	}
+	resp.Item.RemoveOutsideRange(start, end)
+	return &resp.Item, nil
+}
+
+// Store merges candles with those already cached and rewrites the cache file
+func (c *CacheSource) Store(item *gctkline.Item) error {
+	if c.Directory == "" {
+		return errCacheDirUnset
+	}
+	cached := gctkline.Item{
+		Exchange: item.Exchange,
+		Pair:     item.Pair,
+		Asset:    item.Asset,
+		Interval: item.Interval,
+	}
+	resp, err := csv.LoadData(common.DataCandle, c.path(), c.Exchange, c.Interval.Duration(), c.Pair, c.Asset)
+	switch {
+	case err == nil:
+		cached.Candles = resp.Item.Candles
+	case !errors.Is(err, os.ErrNotExist):
+		return err
+	}
+	err = cached.Merge(item)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(c.Directory, 0770)
+	if err != nil {
+		return err
+	}
+	return csv.WriteCandles(c.path(), cached.Candles)
+}
+
+// Name returns the name of the source
+func (a *APISource) Name() string {
+	return "api"
+}
+
+// Load retrieves candles between the start and end dates from the exchange
+func (a *APISource) Load(start, end time.Time) (*gctkline.Item, error) {
+	return api.LoadData(context.TODO(), common.DataCandle, start, end, a.Interval.Duration(), a.Exch, a.Pair, a.Asset)
+}
+
+// Store does nothing as candles cannot be stored to an exchange
+func (a *APISource) Store(*gctkline.Item) error {
+	return nil
+}
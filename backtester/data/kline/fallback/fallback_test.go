@@ -0,0 +1,160 @@
+package fallback
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+var (
+	testDetails = Details{
+		Exchange: "binance",
+		Pair:     currency.NewPair(currency.BTC, currency.USDT),
+		Asset:    asset.Spot,
+		Interval: gctkline.OneHour,
+	}
+	testStart = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// fakeSource serves candles from memory and records requested ranges
+type fakeSource struct {
+	name      string
+	candles   []gctkline.Candle
+	requested []span
+	stored    []gctkline.Candle
+	loadErr   error
+}
+
+func (f *fakeSource) Name() string {
+	return f.name
+}
+
+func (f *fakeSource) Load(start, end time.Time) (*gctkline.Item, error) {
+	f.requested = append(f.requested, span{start: start, end: end})
+	if f.loadErr != nil {
+		return nil, f.loadErr
+	}
+	item := &gctkline.Item{Candles: append([]gctkline.Candle(nil), f.candles...)}
+	item.RemoveOutsideRange(start, end)
+	return item, nil
+}
+
+func (f *fakeSource) Store(item *gctkline.Item) error {
+	f.stored = append(f.stored, item.Candles...)
+	return nil
+}
+
+func candlesFor(hours ...int) []gctkline.Candle {
+	resp := make([]gctkline.Candle, len(hours))
+	for i := range hours {
+		resp[i] = gctkline.Candle{
+			Time:  testStart.Add(time.Duration(hours[i]) * time.Hour),
+			Open:  1,
+			High:  1,
+			Low:   1,
+			Close: 1,
+		}
+	}
+	return resp
+}
+
+func TestLoadData(t *testing.T) {
+	t.Parallel()
+	_, err := LoadData(nil, testDetails, testStart, testStart.Add(time.Hour*4))
+	if !errors.Is(err, errNoSources) {
+		t.Errorf("received '%v' expected '%v'", err, errNoSources)
+	}
+	_, err = LoadData([]Source{nil}, testDetails, testStart, testStart.Add(time.Hour*4))
+	if !errors.Is(err, errNilSource) {
+		t.Errorf("received '%v' expected '%v'", err, errNilSource)
+	}
+
+	db := &fakeSource{name: "db", candles: candlesFor(0, 1)}
+	cache := &fakeSource{name: "cache", loadErr: errors.New("cache unavailable")}
+	api := &fakeSource{name: "api", candles: candlesFor(0, 1, 2, 3)}
+	resp, err := LoadData([]Source{db, cache, api}, testDetails, testStart, testStart.Add(time.Hour*4))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp.Item.Candles) != 4 {
+		t.Fatalf("received '%v' expected '%v'", len(resp.Item.Candles), 4)
+	}
+	if len(api.requested) != 1 ||
+		!api.requested[0].start.Equal(testStart.Add(time.Hour*2)) ||
+		!api.requested[0].end.Equal(testStart.Add(time.Hour*4)) {
+		t.Errorf("api should only be asked for the missing range, received %+v", api.requested)
+	}
+	if len(db.stored) != 2 || len(cache.stored) != 2 {
+		t.Errorf("received '%v' '%v' expected '%v'", len(db.stored), len(cache.stored), 2)
+	}
+
+	db = &fakeSource{name: "db", candles: candlesFor(0, 1, 2, 3)}
+	api = &fakeSource{name: "api"}
+	_, err = LoadData([]Source{db, api}, testDetails, testStart, testStart.Add(time.Hour*4))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(api.requested) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(api.requested), 0)
+	}
+
+	_, err = LoadData([]Source{&fakeSource{name: "empty"}}, testDetails, testStart, testStart.Add(time.Hour*4))
+	if !errors.Is(err, errNoDataLoaded) {
+		t.Errorf("received '%v' expected '%v'", err, errNoDataLoaded)
+	}
+}
+
+func TestMissingSpans(t *testing.T) {
+	t.Parallel()
+	h, err := gctkline.CalculateCandleDateRanges(testStart, testStart.Add(time.Hour*5), gctkline.OneHour, 0)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	h.SetHasDataFromCandles(candlesFor(1, 2))
+	spans := missingSpans(h)
+	if len(spans) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(spans), 2)
+	}
+	if !spans[0].start.Equal(testStart) || !spans[0].end.Equal(testStart.Add(time.Hour)) {
+		t.Errorf("unexpected span %+v", spans[0])
+	}
+	if !spans[1].start.Equal(testStart.Add(time.Hour*3)) || !spans[1].end.Equal(testStart.Add(time.Hour*5)) {
+		t.Errorf("unexpected span %+v", spans[1])
+	}
+}
+
+func TestCacheSource(t *testing.T) {
+	t.Parallel()
+	c := &CacheSource{Details: testDetails}
+	_, err := c.Load(testStart, testStart.Add(time.Hour))
+	if !errors.Is(err, errCacheDirUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errCacheDirUnset)
+	}
+	c.Directory = t.TempDir()
+	item, err := c.Load(testStart, testStart.Add(time.Hour*4))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(item.Candles) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(item.Candles), 0)
+	}
+	err = c.Store(&gctkline.Item{Exchange: "binance", Pair: testDetails.Pair, Asset: asset.Spot, Interval: gctkline.OneHour, Candles: candlesFor(0, 1)})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = c.Store(&gctkline.Item{Exchange: "binance", Pair: testDetails.Pair, Asset: asset.Spot, Interval: gctkline.OneHour, Candles: candlesFor(2)})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	item, err = c.Load(testStart, testStart.Add(time.Hour*4))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(item.Candles) != 3 {
+		t.Errorf("received '%v' expected '%v'", len(item.Candles), 3)
+	}
+}
@@ -0,0 +1,59 @@
+package fallback
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+var (
+	errNoSources     = errors.New("no data sources provided")
+	errNilSource     = errors.New("nil data source")
+	errNoDataLoaded  = errors.New("no data could be loaded from any data source")
+	errCacheDirUnset = errors.New("cache directory unset")
+)
+
+// Source retrieves candles for a date range. Candles which are only found by
+// later sources in the chain are stored back to earlier sources
+type Source interface {
+	Name() string
+	Load(start, end time.Time) (*gctkline.Item, error)
+	Store(*gctkline.Item) error
+}
+
+// Details are the exchange, pair, asset and interval of the candles loaded
+type Details struct {
+	Exchange string
+	Pair     currency.Pair
+	Asset    asset.Item
+	Interval gctkline.Interval
+}
+
+// DatabaseSource loads and stores candles in the GoCryptoTrader database
+type DatabaseSource struct {
+	Details
+}
+
+// CacheSource loads and stores candles in a CSV file per exchange, asset,
+// pair and interval within a directory
+type CacheSource struct {
+	Details
+	Directory string
+}
+
+// APISource loads candles from the exchange's API. Candles are never stored
+// to the API
+type APISource struct {
+	Details
+	Exch exchange.IBotExchange
+}
+
+// span is a range of intervals missing data
+type span struct {
+	start time.Time
+	end   time.Time
+}
@@ -1,6 +1,7 @@
 package kline
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/shopspring/decimal"
@@ -8,6 +9,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
@@ -20,6 +22,34 @@ func (d *DataFromKline) HasDataAtTime(t time.Time) bool {
 	return d.RangeHolder.HasDataAtDate(t)
 }
 
+// FootprintAtTime returns the trade derived order flow for the candle at the
+// time provided
+func (d *DataFromKline) FootprintAtTime(t time.Time) (*trade.Footprint, error) {
+	if len(d.Footprints) == 0 {
+		return nil, fmt.Errorf("%w %v", errNoFootprintAtTime, t)
+	}
+	if d.indexedFootprint != &d.Footprints[0] || d.indexedFootprints != len(d.Footprints) {
+		// the footprints have been set or replaced since they were indexed
+		d.indexFootprints()
+	}
+	i, ok := d.footprintIndex[t.UnixNano()]
+	if !ok {
+		return nil, fmt.Errorf("%w %v", errNoFootprintAtTime, t)
+	}
+	return &d.Footprints[i], nil
+}
+
+// indexFootprints maps each footprint's candle time to its position so that
+// footprints can be looked up for every candle without scanning them
+func (d *DataFromKline) indexFootprints() {
+	d.footprintIndex = make(map[int64]int, len(d.Footprints))
+	for i := range d.Footprints {
+		d.footprintIndex[d.Footprints[i].Time.UnixNano()] = i
+	}
+	d.indexedFootprint = &d.Footprints[0]
+	d.indexedFootprints = len(d.Footprints)
+}
+
 // Load sets the candle data to the stream for processing
 func (d *DataFromKline) Load() error {
 	d.addedTimes = make(map[time.Time]bool)
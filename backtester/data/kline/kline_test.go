@@ -12,6 +12,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 )
 
 const testExchange = "binance"
@@ -295,3 +296,39 @@ func TestStreamLow(t *testing.T) {
 		t.Error("expected low")
 	}
 }
+
+func TestFootprintAtTime(t *testing.T) {
+	t.Parallel()
+	tt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	d := DataFromKline{}
+	_, err := d.FootprintAtTime(tt)
+	if !errors.Is(err, errNoFootprintAtTime) {
+		t.Errorf("received: %v, expected: %v", err, errNoFootprintAtTime)
+	}
+	d.Footprints = []trade.Footprint{{Time: tt, Delta: 1}}
+	fp, err := d.FootprintAtTime(tt)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if fp.Delta != 1 {
+		t.Errorf("received: %v, expected: %v", fp.Delta, 1)
+	}
+	_, err = d.FootprintAtTime(tt.Add(time.Hour))
+	if !errors.Is(err, errNoFootprintAtTime) {
+		t.Errorf("received: %v, expected: %v", err, errNoFootprintAtTime)
+	}
+
+	// replaced footprints are reindexed
+	d.Footprints = []trade.Footprint{{Time: tt.Add(time.Hour), Delta: 2}}
+	fp, err = d.FootprintAtTime(tt.Add(time.Hour))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if fp.Delta != 2 {
+		t.Errorf("received: %v, expected: %v", fp.Delta, 2)
+	}
+	_, err = d.FootprintAtTime(tt)
+	if !errors.Is(err, errNoFootprintAtTime) {
+		t.Errorf("received: %v, expected: %v", err, errNoFootprintAtTime)
+	}
+}
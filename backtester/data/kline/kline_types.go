@@ -6,9 +6,13 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 )
 
-var errNoCandleData = errors.New("no candle data provided")
+var (
+	errNoCandleData      = errors.New("no candle data provided")
+	errNoFootprintAtTime = errors.New("no trade footprint at time")
+)
 
 // DataFromKline is a struct which implements the data.Streamer interface
 // It holds candle data for a specified range with helper functions
@@ -17,4 +21,13 @@ type DataFromKline struct {
 	addedTimes  map[time.Time]bool
 	Item        gctkline.Item
 	RangeHolder *gctkline.IntervalRangeHolder
+	// Footprints holds the buy/sell order flow per candle when the data
+	// was derived from trades
+	Footprints []trade.Footprint
+	// footprintIndex maps each footprint's candle time in unix nanoseconds to
+	// its position in Footprints. The first footprint and length indexed are
+	// kept so that replaced footprints are indexed again
+	footprintIndex    map[int64]int
+	indexedFootprint  *trade.Footprint
+	indexedFootprints int
 }
@@ -0,0 +1,190 @@
+package live
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
+)
+
+// Stream collects candles from an exchange's websocket kline stream, or its
+// trade stream via a candle builder, so that live data is processed as soon
+// as a candle closes rather than on the next REST poll
+type Stream struct {
+	exchange   string
+	pair       currency.Pair
+	asset      asset.Item
+	interval   kline.Interval
+	dataType   int64
+	builder    *kline.Builder
+	current    kline.Candle
+	hasCurrent bool
+	lastClosed time.Time
+	closed     []kline.Candle
+	lastUpdate time.Time
+	m          sync.Mutex
+}
+
+// NewStream returns a stream which collects candles of the interval for the
+// exchange, pair and asset. Candle data is taken from kline updates while
+// trade data is converted to candles by the stream's candle builder
+func NewStream(exchangeName string, dataType int64, interval time.Duration, p currency.Pair, a asset.Item) (*Stream, error) {
+	s := &Stream{
+		exchange: exchangeName,
+		pair:     p,
+		asset:    a,
+		interval: kline.Interval(interval),
+		dataType: dataType,
+	}
+	switch dataType {
+	case common.DataCandle:
+		if interval <= 0 {
+			return nil, kline.ErrUnsetInterval
+		}
+	case common.DataTrade:
+		var err error
+		s.builder, err = kline.NewBuilder(exchangeName, p, a, s.interval)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("could not stream live data for %v %v %v, %w", exchangeName, a, p, common.ErrInvalidDataType)
+	}
+	return s, nil
+}
+
+// Builder returns the candle builder for trade streams, which is to be
+// registered with the exchange websocket's trade processor
+func (s *Stream) Builder() *kline.Builder {
+	if s == nil {
+		return nil
+	}
+	return s.builder
+}
+
+// Process handles data received from an exchange websocket, ignoring any
+// which is not a candle for the stream's exchange, pair, asset and interval
+func (s *Stream) Process(data interface{}) {
+	if s == nil {
+		return
+	}
+	switch d := data.(type) {
+	case stream.KlineData:
+		s.processKline(&d)
+	case *stream.KlineData:
+		s.processKline(d)
+	case []kline.CandleUpdate:
+		for i := range d {
+			s.processCandleUpdate(&d[i])
+		}
+	}
+}
+
+// processKline updates the current candle from a kline update. Kline updates
+// carry an exchange specific interval name, so the interval is matched by the
+// update's start and close times, with updates lacking them ignored
+func (s *Stream) processKline(d *stream.KlineData) {
+	if s.dataType != common.DataCandle ||
+		!strings.EqualFold(d.Exchange, s.exchange) ||
+		!d.Pair.Equal(s.pair) ||
+		d.AssetType != s.asset ||
+		d.StartTime.IsZero() ||
+		d.CloseTime.IsZero() ||
+		d.CloseTime.Sub(d.StartTime).Round(time.Second) != s.interval.Duration() {
+		return
+	}
+	c := kline.Candle{
+		Time:   d.StartTime.UTC(),
+		Open:   d.OpenPrice,
+		High:   d.HighPrice,
+		Low:    d.LowPrice,
+		Close:  d.ClosePrice,
+		Volume: d.Volume,
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	if !s.lastClosed.IsZero() && !c.Time.After(s.lastClosed) {
+		return
+	}
+	if s.hasCurrent && c.Time.After(s.current.Time) {
+		s.close(s.current)
+	}
+	s.current = c
+	s.hasCurrent = true
+	s.lastUpdate = time.Now()
+}
+
+// processCandleUpdate stores candles closed by the stream's candle builder
+func (s *Stream) processCandleUpdate(u *kline.CandleUpdate) {
+	if s.builder == nil || !s.builder.Matches(u.Exchange, u.Pair, u.Asset) || u.Interval != s.interval {
+		return
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.lastUpdate = time.Now()
+	s.closeCandleUpdate(u)
+}
+
+// closeCandleUpdate must be called with the lock held
+func (s *Stream) closeCandleUpdate(u *kline.CandleUpdate) {
+	if !u.Closed || (!s.lastClosed.IsZero() && !u.Candle.Time.After(s.lastClosed)) {
+		return
+	}
+	s.close(u.Candle)
+}
+
+// close must be called with the lock held
+func (s *Stream) close(c kline.Candle) {
+	s.closed = append(s.closed, c)
+	s.lastClosed = c.Time
+}
+
+// Closed returns the candles closed since it was last called, closing the
+// current candle when its interval has ended by the supplied time. Nil is
+// returned when there are no new candles
+func (s *Stream) Closed(tt time.Time) *kline.Item {
+	if s == nil {
+		return nil
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	// flushing closes trade candles when no trades have been received, which
+	// does not count as an update from the stream
+	updates := s.builder.Flush(tt)
+	for i := range updates {
+		s.closeCandleUpdate(&updates[i])
+	}
+	if s.hasCurrent && !s.current.Time.Add(s.interval.Duration()).After(tt) {
+		s.close(s.current)
+		s.hasCurrent = false
+	}
+	if len(s.closed) == 0 {
+		return nil
+	}
+	resp := &kline.Item{
+		Exchange: strings.ToLower(s.exchange),
+		Pair:     s.pair,
+		Asset:    s.asset,
+		Interval: s.interval,
+		Candles:  s.closed,
+	}
+	s.closed = nil
+	return resp
+}
+
+// LastUpdate returns when the stream last received data for its candles,
+// allowing a stalled stream to be replaced by polling
+func (s *Stream) LastUpdate() time.Time {
+	if s == nil {
+		return time.Time{}
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	return s.lastUpdate
+}
@@ -0,0 +1,158 @@
+package live
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+func TestNewStream(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	_, err := NewStream(testExchange, 1337, time.Minute, p, asset.Spot)
+	if !errors.Is(err, common.ErrInvalidDataType) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrInvalidDataType)
+	}
+	_, err = NewStream(testExchange, common.DataCandle, 0, p, asset.Spot)
+	if !errors.Is(err, gctkline.ErrUnsetInterval) {
+		t.Errorf("received '%v' expected '%v'", err, gctkline.ErrUnsetInterval)
+	}
+	s, err := NewStream(testExchange, common.DataCandle, time.Minute, p, asset.Spot)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if s.Builder() != nil {
+		t.Error("expected no candle builder for candle data")
+	}
+	s, err = NewStream(testExchange, common.DataTrade, time.Minute, p, asset.Spot)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if s.Builder() == nil {
+		t.Error("expected candle builder for trade data")
+	}
+}
+
+func TestStreamKlines(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	s, err := NewStream(testExchange, common.DataCandle, time.Minute, p, asset.Spot)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !s.LastUpdate().IsZero() {
+		t.Error("expected no updates")
+	}
+	tt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	kline := func(start time.Time, interval time.Duration, price float64) stream.KlineData {
+		return stream.KlineData{
+			Exchange:   testExchange,
+			Pair:       p,
+			AssetType:  asset.Spot,
+			StartTime:  start,
+			CloseTime:  start.Add(interval - time.Millisecond),
+			OpenPrice:  price,
+			HighPrice:  price,
+			LowPrice:   price,
+			ClosePrice: price,
+			Volume:     1,
+		}
+	}
+	// other intervals, pairs and data are ignored
+	s.Process(kline(tt, time.Hour, 1))
+	wrongPair := kline(tt, time.Minute, 1)
+	wrongPair.Pair = currency.NewPair(currency.ETH, currency.USDT)
+	s.Process(wrongPair)
+	s.Process("hello moto")
+	if !s.LastUpdate().IsZero() {
+		t.Error("expected no updates")
+	}
+
+	s.Process(kline(tt, time.Minute, 1))
+	update := kline(tt, time.Minute, 2)
+	s.Process(&update)
+	if s.LastUpdate().IsZero() {
+		t.Error("expected an update")
+	}
+	if resp := s.Closed(tt.Add(time.Second * 30)); resp != nil {
+		t.Errorf("received '%v' expected '%v'", resp, nil)
+	}
+	// the next candle closes the previous
+	s.Process(kline(tt.Add(time.Minute), time.Minute, 3))
+	resp := s.Closed(tt.Add(time.Minute + time.Second))
+	if resp == nil || len(resp.Candles) != 1 {
+		t.Fatalf("received '%v' expected '%v'", resp, 1)
+	}
+	if resp.Candles[0].Close != 2 || !resp.Candles[0].Time.Equal(tt) {
+		t.Errorf("received '%+v' expected close of 2 at %v", resp.Candles[0], tt)
+	}
+	if resp.Interval != gctkline.OneMin || resp.Exchange != testExchange {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", resp.Interval, resp.Exchange, gctkline.OneMin, testExchange)
+	}
+	// late updates for a closed candle are ignored
+	s.Process(kline(tt, time.Minute, 4))
+	if resp = s.Closed(tt.Add(time.Minute + time.Second)); resp != nil {
+		t.Errorf("received '%v' expected '%v'", resp, nil)
+	}
+	// the current candle closes once its interval has passed
+	resp = s.Closed(tt.Add(time.Minute * 2))
+	if resp == nil || len(resp.Candles) != 1 || resp.Candles[0].Close != 3 {
+		t.Errorf("received '%v' expected a candle closing at 3", resp)
+	}
+}
+
+func TestStreamTrades(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	s, err := NewStream(testExchange, common.DataTrade, time.Minute, p, asset.Spot)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	tt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	// kline updates are ignored for trade data
+	s.Process(stream.KlineData{
+		Exchange:  testExchange,
+		Pair:      p,
+		AssetType: asset.Spot,
+		StartTime: tt,
+		CloseTime: tt.Add(time.Minute),
+	})
+	if !s.LastUpdate().IsZero() {
+		t.Error("expected no updates")
+	}
+
+	updates, err := trade.UpdateCandleBuilder(s.Builder(),
+		trade.Data{Exchange: testExchange, CurrencyPair: p, AssetType: asset.Spot, Price: 1, Amount: 1, Timestamp: tt},
+		trade.Data{Exchange: testExchange, CurrencyPair: p, AssetType: asset.Spot, Price: 2, Amount: 1, Timestamp: tt.Add(time.Minute)})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	s.Process(updates)
+	if s.LastUpdate().IsZero() {
+		t.Error("expected an update")
+	}
+	resp := s.Closed(tt.Add(time.Minute))
+	if resp == nil || len(resp.Candles) != 1 || resp.Candles[0].Close != 1 {
+		t.Fatalf("received '%v' expected a candle closing at 1", resp)
+	}
+	lastUpdate := s.LastUpdate()
+	// candles without trades are closed by flushing the builder, which is not
+	// an update from the stream
+	resp = s.Closed(tt.Add(time.Minute * 3))
+	if resp == nil || len(resp.Candles) != 2 {
+		t.Fatalf("received '%v' expected '%v'", resp, 2)
+	}
+	if resp.Candles[0].Close != 2 || resp.Candles[1].Close != 2 {
+		t.Errorf("received '%+v' expected candles closing at 2", resp.Candles)
+	}
+	if !s.LastUpdate().Equal(lastUpdate) {
+		t.Error("expected flushing to not update the stream")
+	}
+}
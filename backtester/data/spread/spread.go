@@ -0,0 +1,140 @@
+package spread
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// NewSeries creates a spread series which falls back to the static spread
+// percent when no bid/ask spread is known
+func NewSeries(staticPercent decimal.Decimal) (*Series, error) {
+	if staticPercent.IsNegative() {
+		return nil, fmt.Errorf("%w received %v", errNegativeStaticSpread, staticPercent)
+	}
+	return &Series{staticPercent: staticPercent}, nil
+}
+
+// Percent returns the spread as a percentage of the mid price
+func (s *Spread) Percent() decimal.Decimal {
+	mid := s.Bid.Add(s.Ask).Div(decimal.NewFromInt(2))
+	if mid.IsZero() {
+		return decimal.Zero
+	}
+	return s.Ask.Sub(s.Bid).Div(mid).Mul(decimal.NewFromInt(100))
+}
+
+// Add validates and stores spreads, keeping the series ordered by time.
+// A spread at an existing time replaces the previous spread
+func (s *Series) Add(spreads ...Spread) error {
+	for i := range spreads {
+		if !spreads[i].Bid.IsPositive() || spreads[i].Ask.LessThan(spreads[i].Bid) {
+			return fmt.Errorf("%w bid %v ask %v at %v",
+				errInvalidBidAsk,
+				spreads[i].Bid,
+				spreads[i].Ask,
+				spreads[i].Time)
+		}
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	for i := range spreads {
+		idx := sort.Search(len(s.spreads), func(j int) bool {
+			return !s.spreads[j].Time.Before(spreads[i].Time)
+		})
+		if idx < len(s.spreads) && s.spreads[idx].Time.Equal(spreads[i].Time) {
+			s.spreads[idx] = spreads[i]
+			continue
+		}
+		s.spreads = append(s.spreads, Spread{})
+		copy(s.spreads[idx+1:], s.spreads[idx:])
+		s.spreads[idx] = spreads[i]
+	}
+	return nil
+}
+
+// GetPercent returns the spread percent of the latest spread at or before
+// the time provided, or the static spread percent when there is none
+func (s *Series) GetPercent(t time.Time) decimal.Decimal {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	idx := sort.Search(len(s.spreads), func(i int) bool {
+		return s.spreads[i].Time.After(t)
+	})
+	if idx == 0 {
+		return s.staticPercent
+	}
+	return s.spreads[idx-1].Percent()
+}
+
+// LoadCSV reads the spreads of an exchange asset pair from a csv file with
+// rows of timestamp,exchange,asset,pair,bid,ask where the timestamp is in
+// unix seconds. Rows for other exchange asset pairs are ignored
+func LoadCSV(path, exchangeName string, a asset.Item, p currency.Pair) ([]Spread, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = f.Close()
+		if err != nil {
+			log.Errorln(log.BackTester, err)
+		}
+	}()
+
+	reader := csv.NewReader(f)
+	var resp []Spread
+	for row := 1; ; row++ {
+		record, errCSV := reader.Read()
+		if errCSV != nil {
+			if errCSV == io.EOF {
+				break
+			}
+			return nil, errCSV
+		}
+		if len(record) != 6 {
+			return nil, fmt.Errorf("%w %v expected 6 fields, received %v", errInvalidCSVRow, row, len(record))
+		}
+		if !strings.EqualFold(record[1], exchangeName) || !strings.EqualFold(record[2], a.String()) {
+			continue
+		}
+		var rowPair currency.Pair
+		rowPair, err = currency.NewPairFromString(record[3])
+		if err != nil {
+			return nil, fmt.Errorf("%w %v %v", errInvalidCSVRow, row, err)
+		}
+		if !rowPair.Equal(p) {
+			continue
+		}
+		var ts int64
+		ts, err = strconv.ParseInt(record[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w %v timestamp %v", errInvalidCSVRow, row, err)
+		}
+		var bid, ask decimal.Decimal
+		bid, err = decimal.NewFromString(record[4])
+		if err != nil {
+			return nil, fmt.Errorf("%w %v bid %v", errInvalidCSVRow, row, err)
+		}
+		ask, err = decimal.NewFromString(record[5])
+		if err != nil {
+			return nil, fmt.Errorf("%w %v ask %v", errInvalidCSVRow, row, err)
+		}
+		resp = append(resp, Spread{
+			Time: time.Unix(ts, 0).UTC(),
+			Bid:  bid,
+			Ask:  ask,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,150 @@
+package spread
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+func TestNewSeries(t *testing.T) {
+	t.Parallel()
+	_, err := NewSeries(decimal.NewFromInt(-1))
+	if !errors.Is(err, errNegativeStaticSpread) {
+		t.Errorf("received '%v' expected '%v'", err, errNegativeStaticSpread)
+	}
+	s, err := NewSeries(decimal.NewFromFloat(0.1))
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !s.GetPercent(time.Now()).Equal(decimal.NewFromFloat(0.1)) {
+		t.Errorf("received '%v' expected '%v'", s.GetPercent(time.Now()), 0.1)
+	}
+}
+
+func TestPercent(t *testing.T) {
+	t.Parallel()
+	s := Spread{}
+	if !s.Percent().IsZero() {
+		t.Errorf("received '%v' expected '%v'", s.Percent(), 0)
+	}
+	s.Bid = decimal.NewFromInt(99)
+	s.Ask = decimal.NewFromInt(101)
+	if !s.Percent().Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received '%v' expected '%v'", s.Percent(), 2)
+	}
+}
+
+func TestAddAndGetPercent(t *testing.T) {
+	t.Parallel()
+	tt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	s, err := NewSeries(decimal.NewFromInt(5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = s.Add(Spread{Time: tt, Bid: decimal.Zero, Ask: decimal.NewFromInt(1)})
+	if !errors.Is(err, errInvalidBidAsk) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidBidAsk)
+	}
+	err = s.Add(Spread{Time: tt, Bid: decimal.NewFromInt(2), Ask: decimal.NewFromInt(1)})
+	if !errors.Is(err, errInvalidBidAsk) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidBidAsk)
+	}
+	err = s.Add(
+		Spread{Time: tt.Add(time.Hour * 2), Bid: decimal.NewFromInt(98), Ask: decimal.NewFromInt(102)},
+		Spread{Time: tt, Bid: decimal.NewFromInt(99), Ask: decimal.NewFromInt(101)},
+	)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if len(s.spreads) != 2 || !s.spreads[0].Time.Equal(tt) {
+		t.Error("expected spreads to be ordered by time")
+	}
+
+	if p := s.GetPercent(tt.Add(-time.Hour)); !p.Equal(decimal.NewFromInt(5)) {
+		t.Errorf("received '%v' expected '%v'", p, 5)
+	}
+	if p := s.GetPercent(tt.Add(time.Hour)); !p.Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received '%v' expected '%v'", p, 2)
+	}
+	if p := s.GetPercent(tt.Add(time.Hour * 2)); !p.Equal(decimal.NewFromInt(4)) {
+		t.Errorf("received '%v' expected '%v'", p, 4)
+	}
+
+	// replaces the existing spread at the same time
+	err = s.Add(Spread{Time: tt, Bid: decimal.NewFromInt(100), Ask: decimal.NewFromInt(100)})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if len(s.spreads) != 2 {
+		t.Errorf("received '%v' expected '%v'", len(s.spreads), 2)
+	}
+	if p := s.GetPercent(tt); !p.IsZero() {
+		t.Errorf("received '%v' expected '%v'", p, 0)
+	}
+}
+
+func TestLoadCSV(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	_, err := LoadCSV("non-existent.csv", "binance", asset.Spot, p)
+	if err == nil {
+		t.Error("expected error")
+	}
+
+	tempDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err = os.RemoveAll(tempDir); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	path := filepath.Join(tempDir, "spreads.csv")
+	err = ioutil.WriteFile(path, []byte("1609459200,binance,spot,BTC-USDT,29000,29010\n"+
+		"1609459200,binance,spot,ETH-USDT,700,701\n"+
+		"1609459200,ftx,spot,BTC-USDT,29000,29020\n"+
+		"1609462800,Binance,spot,BTC-USDT,29100,29105\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spreads, err := LoadCSV(path, "binance", asset.Spot, p)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if len(spreads) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(spreads), 2)
+	}
+	if !spreads[0].Time.Equal(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("received '%v' expected '%v'", spreads[0].Time, time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+	}
+	if !spreads[1].Ask.Equal(decimal.NewFromInt(29105)) {
+		t.Errorf("received '%v' expected '%v'", spreads[1].Ask, 29105)
+	}
+
+	err = ioutil.WriteFile(path, []byte("1609459200,binance,spot,BTC-USDT,29000\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = LoadCSV(path, "binance", asset.Spot, p)
+	if !errors.Is(err, errInvalidCSVRow) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidCSVRow)
+	}
+
+	err = ioutil.WriteFile(path, []byte("1609459200,binance,spot,BTC-USDT,bid,29010\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = LoadCSV(path, "binance", asset.Spot, p)
+	if !errors.Is(err, errInvalidCSVRow) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidCSVRow)
+	}
+}
@@ -0,0 +1,30 @@
+package spread
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	errNegativeStaticSpread = errors.New("static spread percent cannot be negative")
+	errInvalidBidAsk        = errors.New("invalid bid/ask")
+	errInvalidCSVRow        = errors.New("invalid spread csv row")
+)
+
+// Spread is the best bid and ask of a pair at a point in time
+type Spread struct {
+	Time time.Time
+	Bid  decimal.Decimal
+	Ask  decimal.Decimal
+}
+
+// Series holds the bid/ask spreads of a pair over time, falling back to a
+// static spread when no spread is known at a given time
+type Series struct {
+	m             sync.RWMutex
+	staticPercent decimal.Decimal
+	spreads       []Spread
+}
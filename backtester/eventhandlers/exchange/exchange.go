@@ -53,14 +53,19 @@ func (e *Exchange) ExecuteOrder(o order.Event, data data.Handler, bot *engine.En
 	if o.GetDirection() != gctorder.Buy && o.GetDirection() != gctorder.Sell {
 		return f, nil
 	}
-	highStr := data.StreamHigh()
-	high := highStr[len(highStr)-1]
-
-	lowStr := data.StreamLow()
-	low := lowStr[len(lowStr)-1]
-
-	volStr := data.StreamVol()
-	volume := volStr[len(volStr)-1]
+	if !cs.UseRealOrders && cs.Rejection.reject() {
+		fundErr := funds.Release(eventFunds, eventFunds, f.GetDirection())
+		if fundErr != nil {
+			f.AppendReason(fundErr.Error())
+		}
+		if f.GetDirection() == gctorder.Buy {
+			f.SetDirection(common.CouldNotBuy)
+		} else {
+			f.SetDirection(common.CouldNotSell)
+		}
+		f.AppendReason(ErrOrderRejected.Error())
+		return f, ErrOrderRejected
+	}
 	var adjustedPrice, amount decimal.Decimal
 
 	if cs.UseRealOrders {
@@ -70,11 +75,29 @@ func (e *Exchange) ExecuteOrder(o order.Event, data data.Handler, bot *engine.En
 		if err != nil {
 			return f, err
 		}
-		// calculate an estimated slippage rate
-		adjustedPrice, amount = slippage.CalculateSlippageByOrderbook(ob, o.GetDirection(), eventFunds, f.ExchangeFee)
-		f.Slippage = adjustedPrice.Sub(f.ClosePrice).Div(f.ClosePrice).Mul(decimal.NewFromInt(100))
+		// walk the orderbook's depth with the allocated funds to size the
+		// order and estimate its average fill price
+		adjustedPrice, amount, f.Slippage, err = slippage.CalculateSlippageByDepth(ob, o.GetDirection(), eventFunds, f.ExchangeFee)
+		if err != nil {
+			if f.GetDirection() == gctorder.Buy {
+				f.SetDirection(common.CouldNotBuy)
+			} else {
+				f.SetDirection(common.CouldNotSell)
+			}
+			f.AppendReason(err.Error())
+			return f, err
+		}
 	} else {
-		adjustedPrice, amount, err = e.sizeOfflineOrder(high, low, volume, &cs, f)
+		var price, high, low, volume decimal.Decimal
+		price, high, low, volume, err = getFillPrice(&cs, data, f)
+		if err == nil {
+			// the VWAP is only needed to fit orders to it
+			vwap := decimal.Zero
+			if cs.VolumeFitting == config.VolumeFittingVWAP {
+				vwap = getVWAP(data, high, low)
+			}
+			adjustedPrice, amount, err = e.sizeOfflineOrder(price, high, low, volume, vwap, &cs, f)
+		}
 		if err != nil {
 			switch f.GetDirection() {
 			case gctorder.Buy:
@@ -89,6 +112,16 @@ func (e *Exchange) ExecuteOrder(o order.Event, data data.Handler, bot *engine.En
 		}
 	}
 
+	if cs.CanUseExchangeLimits && !cs.UseRealOrders {
+		// conform the price to the exchange's price tick before sizing so the
+		// order still fits within the portfolio's funds
+		conformedPrice := cs.Limits.ConformToDecimalPrice(adjustedPrice, f.GetDirection())
+		if !conformedPrice.Equal(adjustedPrice) {
+			f.AppendReason(fmt.Sprintf("Price conformed from %v to %v to match exchange price tick", adjustedPrice, conformedPrice))
+			adjustedPrice = conformedPrice
+		}
+	}
+
 	portfolioLimitedAmount := reduceAmountToFitPortfolioLimit(adjustedPrice, amount, eventFunds, f.GetDirection())
 	if !portfolioLimitedAmount.Equal(amount) {
 		f.AppendReason(fmt.Sprintf("Order size shrunk from %v to %v to remain within portfolio limits", amount, portfolioLimitedAmount))
@@ -109,9 +142,21 @@ func (e *Exchange) ExecuteOrder(o order.Event, data data.Handler, bot *engine.En
 	if err != nil {
 		return f, err
 	}
+	if cs.CanUseExchangeLimits && !cs.UseRealOrders {
+		err = verifyOrderWithinExchangeLimits(f, adjustedPrice, limitReducedAmount, &cs)
+		if err != nil {
+			fundErr := funds.Release(eventFunds, eventFunds, f.GetDirection())
+			if fundErr != nil {
+				f.AppendReason(fundErr.Error())
+			}
+			return f, err
+		}
+	}
 	f.ExchangeFee = calculateExchangeFee(adjustedPrice, limitReducedAmount, cs.ExchangeFee)
 
-	orderID, err := e.placeOrder(context.TODO(), adjustedPrice, limitReducedAmount, cs.UseRealOrders, cs.CanUseExchangeLimits, f, bot)
+	// configured limits are checked above rather than against the exchange's own
+	checkExchangeLimits := cs.CanUseExchangeLimits && !cs.UsingConfiguredLimits
+	orderID, err := e.placeOrder(context.TODO(), adjustedPrice, limitReducedAmount, cs.UseRealOrders, cs.UseOrderRouter, checkExchangeLimits, f, bot)
 	if err != nil {
 		fundErr := funds.Release(eventFunds, eventFunds, f.GetDirection())
 		if fundErr != nil {
@@ -138,6 +183,7 @@ func (e *Exchange) ExecuteOrder(o order.Event, data data.Handler, bot *engine.En
 		}
 		funds.IncreaseAvailable(limitReducedAmount.Mul(adjustedPrice), f.GetDirection())
 	}
+	chargeFee(f, &cs)
 
 	ords, _ := bot.OrderManager.GetOrdersSnapshot("")
 	for i := range ords {
@@ -159,6 +205,26 @@ func (e *Exchange) ExecuteOrder(o order.Event, data data.Handler, bot *engine.En
 	return f, nil
 }
 
+// reject returns whether the next simulated order is rejected
+func (r *Rejection) reject() bool {
+	if r == nil || r.Rand == nil || !r.Rate.IsPositive() {
+		return false
+	}
+	return decimal.NewFromFloat(r.Rand.Float64()).LessThan(r.Rate)
+}
+
+// Backoff returns how many candles an order waits before it is resubmitted
+// after being rejected for the given attempt, starting at 1
+func (r *Rejection) Backoff(attempt int64) int64 {
+	if r == nil || attempt < 1 {
+		return 0
+	}
+	if !r.ExponentialBackoff {
+		return r.BackoffCandles
+	}
+	return r.BackoffCandles << (attempt - 1)
+}
+
 // verifyOrderWithinLimits conforms the amount to fall into the minimum size and maximum size limit after reduced
 func verifyOrderWithinLimits(f *fill.Fill, limitReducedAmount decimal.Decimal, cs *Settings) error {
 	if f == nil {
@@ -205,6 +271,30 @@ func verifyOrderWithinLimits(f *fill.Fill, limitReducedAmount decimal.Decimal, c
 	return nil
 }
 
+// verifyOrderWithinExchangeLimits rejects simulated orders the exchange would
+// not accept, such as those below its minimum amount or notional value
+func verifyOrderWithinExchangeLimits(f *fill.Fill, price, amount decimal.Decimal, cs *Settings) error {
+	if f == nil {
+		return common.ErrNilEvent
+	}
+	if cs == nil {
+		return errNilCurrencySettings
+	}
+	// limit orders are checked as market orders skip price and notional rules
+	err := cs.Limits.Conforms(price.InexactFloat64(), amount.InexactFloat64(), gctorder.Limit)
+	if err == nil {
+		return nil
+	}
+	switch f.GetDirection() {
+	case gctorder.Buy:
+		f.SetDirection(common.CouldNotBuy)
+	case gctorder.Sell:
+		f.SetDirection(common.CouldNotSell)
+	}
+	f.AppendReason(err.Error())
+	return fmt.Errorf("%w %v", errExceededExchangeLimit, err)
+}
+
 func reduceAmountToFitPortfolioLimit(adjustedPrice, amount, sizedPortfolioTotal decimal.Decimal, side gctorder.Side) decimal.Decimal {
 	switch side {
 	case gctorder.Buy:
@@ -221,7 +311,7 @@ func reduceAmountToFitPortfolioLimit(adjustedPrice, amount, sizedPortfolioTotal
 	return amount
 }
 
-func (e *Exchange) placeOrder(ctx context.Context, price, amount decimal.Decimal, useRealOrders, useExchangeLimits bool, f *fill.Fill, bot *engine.Engine) (string, error) {
+func (e *Exchange) placeOrder(ctx context.Context, price, amount decimal.Decimal, useRealOrders, useOrderRouter, useExchangeLimits bool, f *fill.Fill, bot *engine.Engine) (string, error) {
 	if f == nil {
 		return "", common.ErrNilEvent
 	}
@@ -247,7 +337,24 @@ func (e *Exchange) placeOrder(ctx context.Context, price, amount decimal.Decimal
 		Type:        gctorder.Market,
 	}
 
-	if useRealOrders {
+	switch {
+	case useRealOrders && useOrderRouter:
+		// the order is routed whole to a single exchange so the fill maps
+		// to exactly one order in the order manager
+		plan, err := bot.OrderRouter.RouteOrder(ctx, &engine.RouteRequest{
+			Pair:    o.Pair,
+			Asset:   o.AssetType,
+			Side:    o.Side,
+			Amount:  o.Amount,
+			Execute: true,
+		})
+		if plan != nil && len(plan.Allocations) > 0 {
+			orderID = plan.Allocations[0].OrderID
+		}
+		if err != nil {
+			return orderID, err
+		}
+	case useRealOrders:
 		resp, err := bot.OrderManager.Submit(ctx, o)
 		if resp != nil {
 			orderID = resp.OrderID
@@ -255,7 +362,7 @@ func (e *Exchange) placeOrder(ctx context.Context, price, amount decimal.Decimal
 		if err != nil {
 			return orderID, err
 		}
-	} else {
+	default:
 		rate, _ := f.Amount.Float64()
 		submitResponse := gctorder.SubmitResponse{
 			IsOrderPlaced: true,
@@ -276,26 +383,107 @@ func (e *Exchange) placeOrder(ctx context.Context, price, amount decimal.Decimal
 	return orderID, nil
 }
 
-func (e *Exchange) sizeOfflineOrder(high, low, volume decimal.Decimal, cs *Settings, f *fill.Fill) (adjustedPrice, adjustedAmount decimal.Decimal, err error) {
+// getFillPrice returns the price a simulated order is filled around, along with
+// the high, low and volume of the candle the order must fit within
+func getFillPrice(cs *Settings, d data.Handler, f *fill.Fill) (price, high, low, volume decimal.Decimal, err error) {
+	if cs == nil || d == nil || f == nil {
+		return decimal.Zero, decimal.Zero, decimal.Zero, decimal.Zero, common.ErrNilArguments
+	}
+	highStr := d.StreamHigh()
+	high = highStr[len(highStr)-1]
+
+	lowStr := d.StreamLow()
+	low = lowStr[len(lowStr)-1]
+
+	volStr := d.StreamVol()
+	volume = volStr[len(volStr)-1]
+
+	latest := d.Latest()
+	switch cs.FillPrice {
+	case "", config.FillPriceClose:
+		price = latest.ClosePrice()
+	case config.FillPriceNextOpen:
+		next := d.List()
+		if len(next) == 0 {
+			f.AppendReason("No next candle to fill at open price, using close price")
+			price = latest.ClosePrice()
+			break
+		}
+		price = next[0].OpenPrice()
+		high = next[0].HighPrice()
+		low = next[0].LowPrice()
+	case config.FillPriceOHLCAverage:
+		price = latest.OpenPrice().
+			Add(latest.HighPrice()).
+			Add(latest.LowPrice()).
+			Add(latest.ClosePrice()).
+			Div(decimal.NewFromInt(4))
+	case config.FillPriceRandom:
+		if cs.FillPriceRand == nil {
+			return decimal.Zero, decimal.Zero, decimal.Zero, decimal.Zero, fmt.Errorf("%w %v, random source unset", errInvalidFillPrice, cs.FillPrice)
+		}
+		price = low.Add(high.Sub(low).Mul(decimal.NewFromFloat(cs.FillPriceRand.Float64())))
+	default:
+		return decimal.Zero, decimal.Zero, decimal.Zero, decimal.Zero, fmt.Errorf("%w %v", errInvalidFillPrice, cs.FillPrice)
+	}
+	return price, high, low, volume, nil
+}
+
+// getVWAP returns the volume weighted average price of the latest candle.
+// Candles derived from trades use the traded volume at each price, while
+// other candles estimate it with the typical price of the high, low and close.
+// The price is kept within the high and low the order must fit within
+func getVWAP(d data.Handler, high, low decimal.Decimal) decimal.Decimal {
+	latest := d.Latest()
+	vwap := latest.HighPrice().Add(latest.LowPrice()).Add(latest.ClosePrice()).Div(decimal.NewFromInt(3))
+	if fp, ok := d.(footprinter); ok {
+		footprint, err := fp.FootprintAtTime(latest.GetTime())
+		if err == nil && footprint.Profile.TotalVolume > 0 {
+			var notional float64
+			for i := range footprint.Profile.Levels {
+				notional += footprint.Profile.Levels[i].Price * footprint.Profile.Levels[i].Volume
+			}
+			vwap = decimal.NewFromFloat(notional / footprint.Profile.TotalVolume)
+		}
+	}
+	if vwap.LessThan(low) {
+		vwap = low
+	}
+	if vwap.GreaterThan(high) {
+		vwap = high
+	}
+	return vwap
+}
+
+func (e *Exchange) sizeOfflineOrder(price, high, low, volume, vwap decimal.Decimal, cs *Settings, f *fill.Fill) (adjustedPrice, adjustedAmount decimal.Decimal, err error) {
 	if cs == nil || f == nil {
 		return decimal.Zero, decimal.Zero, common.ErrNilArguments
 	}
 	// provide history and estimate volatility
 	slippageRate := slippage.EstimateSlippagePercentage(cs.MinimumSlippageRate, cs.MaximumSlippageRate)
-	if cs.SkipCandleVolumeFitting {
-		f.VolumeAdjustedPrice = f.ClosePrice
+	switch cs.VolumeFitting {
+	case config.VolumeFittingNone:
+		f.VolumeAdjustedPrice = price
 		adjustedAmount = f.Amount
-	} else {
-		f.VolumeAdjustedPrice, adjustedAmount = ensureOrderFitsWithinHLV(f.ClosePrice, f.Amount, high, low, volume)
-		if !adjustedAmount.Equal(f.Amount) {
-			f.AppendReason(fmt.Sprintf("Order size shrunk from %v to %v to fit candle", f.Amount, adjustedAmount))
-		}
+	case "", config.VolumeFittingUniform:
+		f.VolumeAdjustedPrice, adjustedAmount = ensureOrderFitsWithinHLV(price, f.Amount, high, low, volume)
+	case config.VolumeFittingVWAP:
+		f.VolumeAdjustedPrice, adjustedAmount = fitOrderToVWAP(price, f.Amount, high, low, volume, vwap)
+	default:
+		return decimal.Zero, decimal.Zero, fmt.Errorf("%w %v", errInvalidVolumeFitting, cs.VolumeFitting)
+	}
+	if !adjustedAmount.Equal(f.Amount) {
+		f.AppendReason(fmt.Sprintf("Order size shrunk from %v to %v to fit candle", f.Amount, adjustedAmount))
 	}
 
 	if adjustedAmount.LessThanOrEqual(decimal.Zero) && f.Amount.GreaterThan(decimal.Zero) {
 		return decimal.Zero, decimal.Zero, fmt.Errorf("amount set to 0, %w", errDataMayBeIncorrect)
 	}
 	adjustedPrice = applySlippageToPrice(f.GetDirection(), f.GetVolumeAdjustedPrice(), slippageRate)
+	if cs.Spread != nil {
+		f.Spread = cs.Spread.GetPercent(f.GetTime()).Div(decimal.NewFromInt(2))
+		adjustedPrice = applySpreadToPrice(f.GetDirection(), adjustedPrice, f.Spread)
+	}
 
 	f.Slippage = slippageRate.Mul(decimal.NewFromInt(100)).Sub(decimal.NewFromInt(100))
 	f.ExchangeFee = calculateExchangeFee(adjustedPrice, adjustedAmount, cs.TakerFee)
@@ -312,6 +500,18 @@ func applySlippageToPrice(direction gctorder.Side, price, slippageRate decimal.D
 	return adjustedPrice
 }
 
+// applySpreadToPrice makes buys more expensive and sells less valuable by
+// the percentage of the spread paid to cross it
+func applySpreadToPrice(direction gctorder.Side, price, spreadPercent decimal.Decimal) decimal.Decimal {
+	rate := spreadPercent.Div(decimal.NewFromInt(100))
+	if direction == gctorder.Buy {
+		return price.Add(price.Mul(rate))
+	} else if direction == gctorder.Sell {
+		return price.Sub(price.Mul(rate))
+	}
+	return price
+}
+
 // SetExchangeAssetCurrencySettings sets the settings for an exchange, asset, currency
 func (e *Exchange) SetExchangeAssetCurrencySettings(exch string, a asset.Item, cp currency.Pair, c *Settings) {
 	if c.ExchangeName == "" ||
@@ -370,6 +570,74 @@ func ensureOrderFitsWithinHLV(slippagePrice, amount, high, low, volume decimal.D
 	return adjustedPrice, adjustedAmount
 }
 
+// fitOrderToVWAP fits the order within the candle's high, low and volume, then
+// moves the price toward the candle's volume weighted average price by the
+// share of the candle's volume the order takes. Small orders fill close to
+// the price while an order taking the whole candle fills at the VWAP
+func fitOrderToVWAP(price, amount, high, low, volume, vwap decimal.Decimal) (adjustedPrice, adjustedAmount decimal.Decimal) {
+	adjustedPrice, adjustedAmount = ensureOrderFitsWithinHLV(price, amount, high, low, volume)
+	if volume.LessThanOrEqual(decimal.Zero) || vwap.LessThanOrEqual(decimal.Zero) {
+		return adjustedPrice, adjustedAmount
+	}
+	participation := adjustedAmount.Mul(adjustedPrice).Div(volume)
+	if participation.GreaterThan(decimal.NewFromInt(1)) {
+		participation = decimal.NewFromInt(1)
+	}
+	adjustedPrice = adjustedPrice.Add(vwap.Sub(adjustedPrice).Mul(participation))
+	return adjustedPrice, adjustedAmount
+}
+
+// chargeFee records the currency the fill's exchange fee is paid in. When a
+// fee currency is set, the discounted fee is converted and deducted from its
+// funding, falling back to the quote currency if it cannot be paid
+func chargeFee(f *fill.Fill, cs *Settings) {
+	f.FeeCurrency = f.CurrencyPair.Quote
+	f.FeeAmount = f.ExchangeFee
+	if cs.FeeCurrency == nil || f.ExchangeFee.IsZero() {
+		return
+	}
+	discountedFee := f.ExchangeFee.Mul(decimal.NewFromInt(1).Sub(cs.FeeCurrency.DiscountRate))
+	rate, err := cs.FeeCurrency.conversionRate()
+	if err != nil {
+		f.AppendReason(fmt.Sprintf("Fee charged in %v, %v", f.FeeCurrency, err))
+		return
+	}
+	feeAmount := discountedFee.Div(rate)
+	if cs.FeeCurrency.Funds == nil {
+		f.AppendReason(fmt.Sprintf("Fee charged in %v, no %v funding", f.FeeCurrency, cs.FeeCurrency.Currency))
+		return
+	}
+	err = cs.FeeCurrency.Funds.Reserve(feeAmount)
+	if err != nil {
+		f.AppendReason(fmt.Sprintf("Fee charged in %v, %v", f.FeeCurrency, err))
+		return
+	}
+	err = cs.FeeCurrency.Funds.Release(feeAmount, decimal.Zero)
+	if err != nil {
+		f.AppendReason(fmt.Sprintf("Fee charged in %v, %v", f.FeeCurrency, err))
+		return
+	}
+	f.ExchangeFee = discountedFee
+	f.FeeCurrency = cs.FeeCurrency.Currency
+	f.FeeAmount = feeAmount
+}
+
+// conversionRate returns the price of the fee currency in the quote currency
+func (fc *FeeCurrency) conversionRate() (decimal.Decimal, error) {
+	if fc.ConversionData != nil {
+		if latest := fc.ConversionData.Latest(); latest != nil && latest.ClosePrice().GreaterThan(decimal.Zero) {
+			if fc.InvertConversion {
+				return decimal.NewFromInt(1).Div(latest.ClosePrice()), nil
+			}
+			return latest.ClosePrice(), nil
+		}
+	}
+	if fc.ConversionRate.GreaterThan(decimal.Zero) {
+		return fc.ConversionRate, nil
+	}
+	return decimal.Zero, fmt.Errorf("%w for %v", errNoFeeConversionRate, fc.Currency)
+}
+
 func calculateExchangeFee(price, amount, fee decimal.Decimal) decimal.Decimal {
 	return fee.Mul(price).Mul(amount)
 }
@@ -3,6 +3,7 @@ package exchange
 import (
 	"context"
 	"errors"
+	"math/rand"
 	"strings"
 	"testing"
 	"time"
@@ -11,15 +12,20 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/config"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/spread"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
+	eventkline "github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/engine"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 )
 
 const testExchange = "binance"
@@ -111,7 +117,7 @@ func TestCalculateExchangeFee(t *testing.T) {
 func TestSizeOrder(t *testing.T) {
 	t.Parallel()
 	e := Exchange{}
-	_, _, err := e.sizeOfflineOrder(decimal.Zero, decimal.Zero, decimal.Zero, nil, nil)
+	_, _, err := e.sizeOfflineOrder(decimal.Zero, decimal.Zero, decimal.Zero, decimal.Zero, decimal.Zero, nil, nil)
 	if !errors.Is(err, common.ErrNilArguments) {
 		t.Error(err)
 	}
@@ -120,12 +126,12 @@ func TestSizeOrder(t *testing.T) {
 		ClosePrice: decimal.NewFromInt(1337),
 		Amount:     decimal.NewFromInt(1),
 	}
-	_, _, err = e.sizeOfflineOrder(decimal.Zero, decimal.Zero, decimal.Zero, cs, f)
+	_, _, err = e.sizeOfflineOrder(f.ClosePrice, decimal.Zero, decimal.Zero, decimal.Zero, decimal.Zero, cs, f)
 	if !errors.Is(err, errDataMayBeIncorrect) {
 		t.Errorf("received: %v, expected: %v", err, errDataMayBeIncorrect)
 	}
 	var p, a decimal.Decimal
-	p, a, err = e.sizeOfflineOrder(decimal.NewFromInt(10), decimal.NewFromInt(2), decimal.NewFromInt(10), cs, f)
+	p, a, err = e.sizeOfflineOrder(f.ClosePrice, decimal.NewFromInt(10), decimal.NewFromInt(2), decimal.NewFromInt(10), decimal.Zero, cs, f)
 	if err != nil {
 		t.Error(err)
 	}
@@ -135,6 +141,158 @@ func TestSizeOrder(t *testing.T) {
 	if !a.Equal(decimal.NewFromInt(1)) {
 		t.Error("expected 1")
 	}
+
+	cs.Spread, err = spread.NewSeries(decimal.NewFromInt(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cs.VolumeFitting = config.VolumeFittingNone
+	f.Direction = gctorder.Buy
+	p, _, err = e.sizeOfflineOrder(decimal.NewFromInt(100), decimal.NewFromInt(100), decimal.NewFromInt(100), decimal.NewFromInt(10), decimal.Zero, cs, f)
+	if err != nil {
+		t.Error(err)
+	}
+	if !p.Equal(decimal.NewFromInt(101)) {
+		t.Errorf("received: %v, expected: %v", p, 101)
+	}
+	if !f.Spread.Equal(decimal.NewFromInt(1)) {
+		t.Errorf("received: %v, expected: %v", f.Spread, 1)
+	}
+}
+
+func TestFitOrderToVWAP(t *testing.T) {
+	t.Parallel()
+	// an order taking half the candle's volume fills halfway to the VWAP
+	adjustedPrice, adjustedAmount := fitOrderToVWAP(decimal.NewFromInt(100), decimal.NewFromInt(5), decimal.NewFromInt(110), decimal.NewFromInt(90), decimal.NewFromInt(1000), decimal.NewFromInt(96))
+	if !adjustedAmount.Equal(decimal.NewFromInt(5)) {
+		t.Errorf("received: %v, expected: %v", adjustedAmount, 5)
+	}
+	if !adjustedPrice.Equal(decimal.NewFromInt(98)) {
+		t.Errorf("received: %v, expected: %v", adjustedPrice, 98)
+	}
+
+	adjustedPrice, _ = fitOrderToVWAP(decimal.NewFromInt(100), decimal.NewFromInt(50), decimal.NewFromInt(110), decimal.NewFromInt(90), decimal.NewFromInt(1000), decimal.NewFromInt(96))
+	if !adjustedPrice.Round(4).Equal(decimal.NewFromInt(96)) {
+		t.Errorf("received: %v, expected: %v", adjustedPrice, 96)
+	}
+
+	adjustedPrice, _ = fitOrderToVWAP(decimal.NewFromInt(100), decimal.NewFromInt(5), decimal.NewFromInt(110), decimal.NewFromInt(90), decimal.NewFromInt(1000), decimal.Zero)
+	if !adjustedPrice.Equal(decimal.NewFromInt(100)) {
+		t.Errorf("received: %v, expected: %v", adjustedPrice, 100)
+	}
+}
+
+func TestGetVWAP(t *testing.T) {
+	t.Parallel()
+	d := &kline.DataFromKline{
+		Item: gctkline.Item{
+			Candles: []gctkline.Candle{
+				{Time: time.Unix(0, 0), Open: 10, High: 16, Low: 8, Close: 15, Volume: 100},
+			},
+		},
+	}
+	err := d.Load()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	d.Next()
+	if vwap := getVWAP(d, decimal.NewFromInt(16), decimal.NewFromInt(8)); !vwap.Equal(decimal.NewFromInt(13)) {
+		t.Errorf("received '%v' expected '%v'", vwap, 13)
+	}
+	if vwap := getVWAP(d, decimal.NewFromInt(12), decimal.NewFromInt(8)); !vwap.Equal(decimal.NewFromInt(12)) {
+		t.Errorf("received '%v' expected '%v'", vwap, 12)
+	}
+	d.Footprints = []trade.Footprint{
+		{
+			Time: time.Unix(0, 0),
+			Profile: trade.VolumeProfile{
+				Levels: []trade.VolumeLevel{
+					{Price: 10, Volume: 3},
+					{Price: 14, Volume: 1},
+				},
+				TotalVolume: 4,
+			},
+		},
+	}
+	if vwap := getVWAP(d, decimal.NewFromInt(16), decimal.NewFromInt(8)); !vwap.Equal(decimal.NewFromInt(11)) {
+		t.Errorf("received '%v' expected '%v'", vwap, 11)
+	}
+}
+
+func TestGetFillPrice(t *testing.T) {
+	t.Parallel()
+	_, _, _, _, err := getFillPrice(nil, nil, nil)
+	if !errors.Is(err, common.ErrNilArguments) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrNilArguments)
+	}
+	d := &kline.DataFromKline{
+		Item: gctkline.Item{
+			Candles: []gctkline.Candle{
+				{Time: time.Unix(0, 0), Open: 10, High: 16, Low: 8, Close: 14, Volume: 100},
+				{Time: time.Unix(60, 0), Open: 15, High: 20, Low: 12, Close: 18, Volume: 50},
+			},
+		},
+	}
+	err = d.Load()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	d.Next()
+	f := &fill.Fill{}
+
+	for _, tc := range []struct {
+		fillPrice, expected, high, low string
+	}{
+		{"", "14", "16", "8"},
+		{config.FillPriceClose, "14", "16", "8"},
+		{config.FillPriceOHLCAverage, "12", "16", "8"},
+		{config.FillPriceNextOpen, "15", "20", "12"},
+	} {
+		price, high, low, volume, err := getFillPrice(&Settings{FillPrice: tc.fillPrice}, d, f)
+		if !errors.Is(err, nil) {
+			t.Fatalf("%v received '%v' expected '%v'", tc.fillPrice, err, nil)
+		}
+		if price.String() != tc.expected || high.String() != tc.high || low.String() != tc.low {
+			t.Errorf("%v received '%v %v %v' expected '%v %v %v'", tc.fillPrice, price, high, low, tc.expected, tc.high, tc.low)
+		}
+		if !volume.Equal(decimal.NewFromInt(100)) {
+			t.Errorf("%v received '%v' expected '%v'", tc.fillPrice, volume, 100)
+		}
+	}
+
+	_, _, _, _, err = getFillPrice(&Settings{FillPrice: config.FillPriceRandom}, d, f)
+	if !errors.Is(err, errInvalidFillPrice) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidFillPrice)
+	}
+	_, _, _, _, err = getFillPrice(&Settings{FillPrice: "bad"}, d, f)
+	if !errors.Is(err, errInvalidFillPrice) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidFillPrice)
+	}
+	cs := &Settings{FillPrice: config.FillPriceRandom, FillPriceRand: rand.New(rand.NewSource(1337))} // nolint:gosec // reproducible fill prices are desired
+	first, _, _, _, err := getFillPrice(cs, d, f)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if first.LessThan(decimal.NewFromInt(8)) || first.GreaterThan(decimal.NewFromInt(16)) {
+		t.Errorf("received '%v' expected a price between the candle low and high", first)
+	}
+	cs.FillPriceRand = rand.New(rand.NewSource(1337)) // nolint:gosec // reproducible fill prices are desired
+	second, _, _, _, err := getFillPrice(cs, d, f)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !first.Equal(second) {
+		t.Errorf("received '%v' expected '%v' from the same seed", second, first)
+	}
+
+	d.Next()
+	price, _, _, _, err := getFillPrice(&Settings{FillPrice: config.FillPriceNextOpen}, d, f)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !price.Equal(decimal.NewFromInt(18)) {
+		t.Errorf("received '%v' expected '%v'", price, 18)
+	}
 }
 
 func TestPlaceOrder(t *testing.T) {
@@ -166,33 +324,38 @@ func TestPlaceOrder(t *testing.T) {
 		t.Error(err)
 	}
 	e := Exchange{}
-	_, err = e.placeOrder(context.Background(), decimal.NewFromInt(1), decimal.NewFromInt(1), false, true, nil, nil)
+	_, err = e.placeOrder(context.Background(), decimal.NewFromInt(1), decimal.NewFromInt(1), false, false, true, nil, nil)
 	if !errors.Is(err, common.ErrNilEvent) {
 		t.Errorf("received: %v, expected: %v", err, common.ErrNilEvent)
 	}
 	f := &fill.Fill{}
-	_, err = e.placeOrder(context.Background(), decimal.NewFromInt(1), decimal.NewFromInt(1), false, true, f, bot)
+	_, err = e.placeOrder(context.Background(), decimal.NewFromInt(1), decimal.NewFromInt(1), false, false, true, f, bot)
 	if err != nil && err.Error() != "order exchange name must be specified" {
 		t.Error(err)
 	}
 
 	f.Exchange = testExchange
-	_, err = e.placeOrder(context.Background(), decimal.NewFromInt(1), decimal.NewFromInt(1), false, true, f, bot)
+	_, err = e.placeOrder(context.Background(), decimal.NewFromInt(1), decimal.NewFromInt(1), false, false, true, f, bot)
 	if !errors.Is(err, gctorder.ErrPairIsEmpty) {
 		t.Errorf("received: %v, expected: %v", err, gctorder.ErrPairIsEmpty)
 	}
 	f.CurrencyPair = currency.NewPair(currency.BTC, currency.USDT)
 	f.AssetType = asset.Spot
 	f.Direction = gctorder.Buy
-	_, err = e.placeOrder(context.Background(), decimal.NewFromInt(1), decimal.NewFromInt(1), false, true, f, bot)
+	_, err = e.placeOrder(context.Background(), decimal.NewFromInt(1), decimal.NewFromInt(1), false, false, true, f, bot)
 	if err != nil {
 		t.Error(err)
 	}
 
-	_, err = e.placeOrder(context.Background(), decimal.NewFromInt(1), decimal.NewFromInt(1), true, true, f, bot)
+	_, err = e.placeOrder(context.Background(), decimal.NewFromInt(1), decimal.NewFromInt(1), true, false, true, f, bot)
 	if err != nil && !strings.Contains(err.Error(), "unset/default API keys") {
 		t.Error(err)
 	}
+
+	_, err = e.placeOrder(context.Background(), decimal.NewFromInt(1), decimal.NewFromInt(1), true, true, true, f, bot)
+	if !errors.Is(err, engine.ErrSubSystemNotStarted) {
+		t.Errorf("received: %v, expected: %v", err, engine.ErrSubSystemNotStarted)
+	}
 }
 
 func TestExecuteOrder(t *testing.T) {
@@ -299,6 +462,95 @@ func TestExecuteOrder(t *testing.T) {
 	}
 }
 
+func TestExecuteOrderRejection(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	cs := Settings{
+		ExchangeName: testExchange,
+		CurrencyPair: p,
+		AssetType:    asset.Spot,
+		Rejection: &Rejection{
+			Rate: decimal.NewFromInt(1),
+			Rand: rand.New(rand.NewSource(1)), // nolint:gosec // reproducible rejections are desired
+		},
+	}
+	e := Exchange{CurrencySettings: []Settings{cs}}
+	o := &order.Order{
+		Base: event.Base{
+			Exchange:     testExchange,
+			Time:         time.Now(),
+			Interval:     gctkline.FifteenMin,
+			CurrencyPair: p,
+			AssetType:    asset.Spot,
+		},
+		Direction:      gctorder.Buy,
+		Amount:         decimal.NewFromInt(1),
+		AllocatedFunds: decimal.NewFromInt(1337),
+	}
+	d := &kline.DataFromKline{
+		Item: gctkline.Item{
+			Candles: []gctkline.Candle{{Close: 1, High: 1, Low: 1, Volume: 1}},
+		},
+	}
+	err := d.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.Next()
+
+	f, err := e.ExecuteOrder(o, d, nil, &fakeFund{})
+	if !errors.Is(err, ErrOrderRejected) {
+		t.Fatalf("received '%v' expected '%v'", err, ErrOrderRejected)
+	}
+	if f.GetDirection() != common.CouldNotBuy {
+		t.Errorf("received '%v' expected '%v'", f.GetDirection(), common.CouldNotBuy)
+	}
+	o.Direction = gctorder.Sell
+	f, err = e.ExecuteOrder(o, d, nil, &fakeFund{})
+	if !errors.Is(err, ErrOrderRejected) {
+		t.Fatalf("received '%v' expected '%v'", err, ErrOrderRejected)
+	}
+	if f.GetDirection() != common.CouldNotSell {
+		t.Errorf("received '%v' expected '%v'", f.GetDirection(), common.CouldNotSell)
+	}
+}
+
+func TestRejection(t *testing.T) {
+	t.Parallel()
+	var r *Rejection
+	if r.reject() {
+		t.Error("expected nil rejection to never reject")
+	}
+	if b := r.Backoff(1); b != 0 {
+		t.Errorf("received '%v' expected '%v'", b, 0)
+	}
+	r = &Rejection{
+		Rate:           decimal.NewFromFloat(0.25),
+		Rand:           rand.New(rand.NewSource(1337)), // nolint:gosec // reproducible rejections are desired
+		BackoffCandles: 2,
+	}
+	var rejected int
+	for i := 0; i < 1000; i++ {
+		if r.reject() {
+			rejected++
+		}
+	}
+	if rejected < 200 || rejected > 300 {
+		t.Errorf("expected around 250 rejections, received %v", rejected)
+	}
+	if b := r.Backoff(3); b != 2 {
+		t.Errorf("received '%v' expected '%v'", b, 2)
+	}
+	r.ExponentialBackoff = true
+	if b := r.Backoff(3); b != 8 {
+		t.Errorf("received '%v' expected '%v'", b, 8)
+	}
+	r.Rate = decimal.Zero
+	if r.reject() {
+		t.Error("expected zero rate to never reject")
+	}
+}
+
 func TestExecuteOrderBuySellSizeLimit(t *testing.T) {
 	t.Parallel()
 	bot := &engine.Engine{}
@@ -474,6 +726,169 @@ func TestExecuteOrderBuySellSizeLimit(t *testing.T) {
 	}
 }
 
+func TestApplySpreadToPrice(t *testing.T) {
+	t.Parallel()
+	resp := applySpreadToPrice(gctorder.Buy, decimal.NewFromInt(100), decimal.NewFromFloat(0.5))
+	if !resp.Equal(decimal.NewFromFloat(100.5)) {
+		t.Errorf("received: %v, expected: %v", resp, decimal.NewFromFloat(100.5))
+	}
+	resp = applySpreadToPrice(gctorder.Sell, decimal.NewFromInt(100), decimal.NewFromFloat(0.5))
+	if !resp.Equal(decimal.NewFromFloat(99.5)) {
+		t.Errorf("received: %v, expected: %v", resp, decimal.NewFromFloat(99.5))
+	}
+	resp = applySpreadToPrice(common.DoNothing, decimal.NewFromInt(100), decimal.NewFromFloat(0.5))
+	if !resp.Equal(decimal.NewFromInt(100)) {
+		t.Errorf("received: %v, expected: %v", resp, decimal.NewFromInt(100))
+	}
+}
+
+func TestVerifyOrderWithinExchangeLimits(t *testing.T) {
+	t.Parallel()
+	err := verifyOrderWithinExchangeLimits(nil, decimal.Zero, decimal.Zero, nil)
+	if !errors.Is(err, common.ErrNilEvent) {
+		t.Errorf("received: %v, expected: %v", err, common.ErrNilEvent)
+	}
+	f := &fill.Fill{Direction: gctorder.Buy}
+	err = verifyOrderWithinExchangeLimits(f, decimal.Zero, decimal.Zero, nil)
+	if !errors.Is(err, errNilCurrencySettings) {
+		t.Errorf("received: %v, expected: %v", err, errNilCurrencySettings)
+	}
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	var el gctorder.ExecutionLimits
+	err = el.LoadLimits([]gctorder.MinMaxLevel{{
+		Pair:        p,
+		Asset:       asset.Spot,
+		MinAmount:   0.001,
+		StepAmount:  0.001,
+		StepPrice:   0.01,
+		MinNotional: 10,
+	}})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	limits, err := el.GetOrderExecutionLimits(asset.Spot, p)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	cs := &Settings{Limits: limits}
+	err = verifyOrderWithinExchangeLimits(f, decimal.NewFromInt(1000), decimal.NewFromFloat(0.02), cs)
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	err = verifyOrderWithinExchangeLimits(f, decimal.NewFromInt(1000), decimal.NewFromFloat(0.005), cs)
+	if !errors.Is(err, errExceededExchangeLimit) {
+		t.Errorf("received: %v, expected: %v", err, errExceededExchangeLimit)
+	}
+	if f.GetDirection() != common.CouldNotBuy {
+		t.Errorf("received: %v, expected: %v", f.GetDirection(), common.CouldNotBuy)
+	}
+	f.Direction = gctorder.Sell
+	err = verifyOrderWithinExchangeLimits(f, decimal.NewFromInt(1000), decimal.NewFromFloat(0.0005), cs)
+	if !errors.Is(err, errExceededExchangeLimit) {
+		t.Errorf("received: %v, expected: %v", err, errExceededExchangeLimit)
+	}
+	if f.GetDirection() != common.CouldNotSell {
+		t.Errorf("received: %v, expected: %v", f.GetDirection(), common.CouldNotSell)
+	}
+}
+
+func TestChargeFee(t *testing.T) {
+	t.Parallel()
+	f := &fill.Fill{
+		Base: event.Base{
+			CurrencyPair: currency.NewPair(currency.BTC, currency.USDT),
+		},
+		ExchangeFee: decimal.NewFromInt(10),
+	}
+	cs := &Settings{}
+	chargeFee(f, cs)
+	if !f.FeeCurrency.Match(currency.USDT) {
+		t.Errorf("received: %v, expected: %v", f.FeeCurrency, currency.USDT)
+	}
+	if !f.FeeAmount.Equal(decimal.NewFromInt(10)) {
+		t.Errorf("received: %v, expected: %v", f.FeeAmount, 10)
+	}
+
+	item, err := funding.CreateItem(testExchange, asset.Spot, currency.BNB, decimal.NewFromInt(1), decimal.Zero)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	cs.FeeCurrency = &FeeCurrency{
+		Currency:     currency.BNB,
+		DiscountRate: decimal.NewFromFloat(0.25),
+		Funds:        item,
+	}
+	chargeFee(f, cs)
+	if !f.FeeCurrency.Match(currency.USDT) {
+		t.Errorf("received: %v, expected: %v", f.FeeCurrency, currency.USDT)
+	}
+	if !strings.Contains(f.Reason, errNoFeeConversionRate.Error()) {
+		t.Errorf("received: %v, expected: %v", f.Reason, errNoFeeConversionRate)
+	}
+
+	cs.FeeCurrency.ConversionRate = decimal.NewFromInt(5)
+	chargeFee(f, cs)
+	if !f.FeeCurrency.Match(currency.USDT) {
+		t.Errorf("received: %v, expected: %v", f.FeeCurrency, currency.USDT)
+	}
+
+	cs.FeeCurrency.ConversionRate = decimal.NewFromInt(300)
+	chargeFee(f, cs)
+	if !f.FeeCurrency.Match(currency.BNB) {
+		t.Errorf("received: %v, expected: %v", f.FeeCurrency, currency.BNB)
+	}
+	if !f.ExchangeFee.Equal(decimal.NewFromFloat(7.5)) {
+		t.Errorf("received: %v, expected: %v", f.ExchangeFee, 7.5)
+	}
+	if !f.FeeAmount.Equal(decimal.NewFromFloat(0.025)) {
+		t.Errorf("received: %v, expected: %v", f.FeeAmount, 0.025)
+	}
+	err = item.Reserve(decimal.NewFromFloat(0.975))
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	if item.CanPlaceOrder() {
+		t.Error("expected fee to be deducted from fee currency funds")
+	}
+}
+
+func TestConversionRate(t *testing.T) {
+	t.Parallel()
+	fc := &FeeCurrency{Currency: currency.BNB}
+	_, err := fc.conversionRate()
+	if !errors.Is(err, errNoFeeConversionRate) {
+		t.Errorf("received: %v, expected: %v", err, errNoFeeConversionRate)
+	}
+	fc.ConversionRate = decimal.NewFromInt(300)
+	rate, err := fc.conversionRate()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	if !rate.Equal(decimal.NewFromInt(300)) {
+		t.Errorf("received: %v, expected: %v", rate, 300)
+	}
+
+	d := &kline.DataFromKline{}
+	d.SetStream([]common.DataEventHandler{&eventkline.Kline{Close: decimal.NewFromInt(400)}})
+	d.Next()
+	fc.ConversionData = d
+	rate, err = fc.conversionRate()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	if !rate.Equal(decimal.NewFromInt(400)) {
+		t.Errorf("received: %v, expected: %v", rate, 400)
+	}
+	fc.InvertConversion = true
+	rate, err = fc.conversionRate()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	if !rate.Equal(decimal.NewFromFloat(0.0025)) {
+		t.Errorf("received: %v, expected: %v", rate, 0.0025)
+	}
+}
+
 func TestApplySlippageToPrice(t *testing.T) {
 	t.Parallel()
 	resp := applySlippageToPrice(gctorder.Buy, decimal.NewFromInt(1), decimal.NewFromFloat(0.9))
@@ -561,3 +976,56 @@ func TestVerifyOrderWithinLimits(t *testing.T) {
 		t.Errorf("received %v expected %v", err, errExceededPortfolioLimit)
 	}
 }
+
+func TestExecuteOrderInsufficientDepth(t *testing.T) {
+	t.Parallel()
+	const exch = "depthexchange"
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	book := &orderbook.Base{
+		Exchange: exch,
+		Pair:     p,
+		Asset:    asset.Spot,
+		Bids:     orderbook.Items{{Price: 99, Amount: 1}},
+		Asks:     orderbook.Items{{Price: 100, Amount: 1}},
+	}
+	err := book.Process()
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := Exchange{CurrencySettings: []Settings{{
+		ExchangeName:  exch,
+		CurrencyPair:  p,
+		AssetType:     asset.Spot,
+		UseRealOrders: true,
+	}}}
+	o := &order.Order{
+		Base: event.Base{
+			Exchange:     exch,
+			Time:         time.Now(),
+			Interval:     gctkline.FifteenMin,
+			CurrencyPair: p,
+			AssetType:    asset.Spot,
+		},
+		Direction:      gctorder.Buy,
+		Amount:         decimal.NewFromInt(2),
+		AllocatedFunds: decimal.NewFromInt(200),
+	}
+	d := &kline.DataFromKline{
+		Item: gctkline.Item{
+			Candles: []gctkline.Candle{{Close: 100, High: 100, Low: 100, Volume: 1}},
+		},
+	}
+	err = d.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.Next()
+
+	f, err := e.ExecuteOrder(o, d, nil, &fakeFund{})
+	if err == nil {
+		t.Fatal("expected an error filling beyond the orderbook's depth")
+	}
+	if f.GetDirection() != common.CouldNotBuy {
+		t.Errorf("received '%v' expected '%v'", f.GetDirection(), common.CouldNotBuy)
+	}
+}
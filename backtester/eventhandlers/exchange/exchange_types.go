@@ -2,10 +2,13 @@ package exchange
 
 import (
 	"errors"
+	"math/rand"
+	"time"
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/config"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/spread"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
 	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
@@ -13,6 +16,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/engine"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 )
 
 var (
@@ -20,8 +24,22 @@ var (
 	errExceededPortfolioLimit = errors.New("exceeded portfolio limit")
 	errNilCurrencySettings    = errors.New("received nil currency settings")
 	errInvalidDirection       = errors.New("received invalid order direction")
+	errInvalidFillPrice       = errors.New("invalid fill price")
+	errInvalidVolumeFitting   = errors.New("invalid volume fitting")
+	errNoFeeConversionRate    = errors.New("no fee currency conversion rate")
+	errExceededExchangeLimit  = errors.New("order does not conform to exchange limits")
+
+	// ErrOrderRejected is returned when a simulated order is rejected by the
+	// currency settings' order rejection probability
+	ErrOrderRejected = errors.New("order rejected by simulated exchange")
 )
 
+// footprinter is implemented by data derived from trades, providing the
+// volume traded at each price of a candle
+type footprinter interface {
+	FootprintAtTime(time.Time) (*trade.Footprint, error)
+}
+
 // ExecutionHandler interface dictates what functions are required to submit an order
 type ExecutionHandler interface {
 	SetExchangeAssetCurrencySettings(string, asset.Item, currency.Pair, *Settings)
@@ -39,6 +57,9 @@ type Exchange struct {
 type Settings struct {
 	ExchangeName  string
 	UseRealOrders bool
+	// UseOrderRouter routes real orders to the strategy exchange offering
+	// the best execution for the pair via the engine's order router
+	UseOrderRouter bool
 
 	CurrencyPair currency.Pair
 	AssetType    asset.Item
@@ -55,7 +76,59 @@ type Settings struct {
 	MinimumSlippageRate decimal.Decimal
 	MaximumSlippageRate decimal.Decimal
 
-	Limits                  *gctorder.Limits
-	CanUseExchangeLimits    bool
-	SkipCandleVolumeFitting bool
+	Limits               *gctorder.Limits
+	CanUseExchangeLimits bool
+	// UsingConfiguredLimits is set when Limits are defined by the config
+	// rather than fetched from the exchange
+	UsingConfiguredLimits bool
+	// VolumeFitting selects how simulated orders are fit to the candle they
+	// are filled in, defaulting to config.VolumeFittingUniform
+	VolumeFitting string
+
+	// FillPrice selects the candle price simulated orders are filled around,
+	// defaulting to the close price. FillPriceRand provides the seeded
+	// random source for config.FillPriceRandom
+	FillPrice     string
+	FillPriceRand *rand.Rand
+
+	// Spread provides the pair's bid/ask spread, half of which is paid by
+	// simulated fills. Spread costs are not modelled when nil
+	Spread *spread.Series
+
+	// FeeCurrency charges exchange fees in a third currency. Fees are paid
+	// in the quote currency when nil
+	FeeCurrency *FeeCurrency
+
+	// Rejection rejects a share of simulated orders. Orders are never
+	// rejected when nil
+	Rejection *Rejection
+}
+
+// Rejection rejects a share of simulated orders using a seeded random source
+// and defines how rejected orders are requeued
+type Rejection struct {
+	// Rate is the probability of an order being rejected, between 0 and 1
+	Rate decimal.Decimal
+	Rand *rand.Rand
+	// MaxRequeues is how many times a rejected order is resubmitted
+	MaxRequeues int64
+	// BackoffCandles is how many candles a rejected order waits before it
+	// is resubmitted, doubling after each rejection when ExponentialBackoff
+	// is set
+	BackoffCandles     int64
+	ExponentialBackoff bool
+}
+
+// FeeCurrency charges exchange fees in a third currency, such as BNB, at a
+// discounted rate, deducting them from the currency's funding
+type FeeCurrency struct {
+	Currency     currency.Code
+	DiscountRate decimal.Decimal
+	Funds        *funding.Item
+	// ConversionData is the fee currency's pair against the quote
+	// currency. When InvertConversion is set, the pair is quoted in the fee
+	// currency. ConversionRate is used when there is no conversion data
+	ConversionData   data.Handler
+	InvertConversion bool
+	ConversionRate   decimal.Decimal
 }
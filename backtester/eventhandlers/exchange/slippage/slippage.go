@@ -1,6 +1,8 @@
 package slippage
 
 import (
+	"errors"
+	"fmt"
 	"math/rand"
 
 	"github.com/shopspring/decimal"
@@ -8,6 +10,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 )
 
+var errInsufficientDepth = errors.New("insufficient orderbook depth")
+
 // EstimateSlippagePercentage takes in an int range of numbers
 // turns it into a percentage
 func EstimateSlippagePercentage(maximumSlippageRate, minimumSlippageRate decimal.Decimal) decimal.Decimal {
@@ -29,14 +33,34 @@ func EstimateSlippagePercentage(maximumSlippageRate, minimumSlippageRate decimal
 	return decimal.NewFromInt(1)
 }
 
-// CalculateSlippageByOrderbook will analyse a provided orderbook and return the result of attempting to
-// place the order on there
-func CalculateSlippageByOrderbook(ob *orderbook.Base, side gctorder.Side, amountOfFunds, feeRate decimal.Decimal) (price, amount decimal.Decimal) {
+// CalculateSlippageByDepth walks the provided orderbook's depth to size an
+// order from the allocated funds, quote funds for buys and base funds for
+// sells. It returns the volume weighted average execution price, the order
+// amount less fees and the slippage percentage against top of book. An error
+// is returned when the orderbook lacks the depth to fill the order
+func CalculateSlippageByDepth(ob *orderbook.Base, side gctorder.Side, amountOfFunds, feeRate decimal.Decimal) (price, amount, slippagePercent decimal.Decimal, err error) {
 	funds, _ := amountOfFunds.Float64()
 	fee, _ := feeRate.Float64()
-	result := ob.SimulateOrder(funds, side == gctorder.Buy)
-	rate := (result.MinimumPrice - result.MaximumPrice) / result.MaximumPrice
-	price = decimal.NewFromFloat(result.MinimumPrice * (rate + 1))
-	amount = decimal.NewFromFloat(result.Amount * (1 - fee))
-	return
+	size := funds
+	if side == gctorder.Buy {
+		var depth float64
+		for i := range ob.Asks {
+			depth += ob.Asks[i].Price * ob.Asks[i].Amount
+		}
+		if funds > depth {
+			return decimal.Zero, decimal.Zero, decimal.Zero, fmt.Errorf("%w, can fill %v of %v funds", errInsufficientDepth, depth, funds)
+		}
+		size = ob.SimulateOrder(funds, true).Amount
+	}
+	result, err := ob.SimulateMarketOrder(size, side)
+	if err != nil {
+		return decimal.Zero, decimal.Zero, decimal.Zero, err
+	}
+	if !result.SufficientDepth {
+		return decimal.Zero, decimal.Zero, decimal.Zero, fmt.Errorf("%w, can fill %v of %v", errInsufficientDepth, result.FilledAmount, result.Amount)
+	}
+	return decimal.NewFromFloat(result.AveragePrice),
+		decimal.NewFromFloat(size * (1 - fee)),
+		decimal.NewFromFloat(result.SlippagePercentage),
+		nil
 }
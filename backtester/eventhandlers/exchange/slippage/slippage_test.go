@@ -1,14 +1,12 @@
 package slippage
 
 import (
-	"context"
+	"errors"
 	"testing"
 
 	"github.com/shopspring/decimal"
-	"github.com/thrasher-corp/gocryptotrader/currency"
-	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
-	"github.com/thrasher-corp/gocryptotrader/exchanges/bitstamp"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 )
 
 func TestRandomSlippage(t *testing.T) {
@@ -19,19 +17,35 @@ func TestRandomSlippage(t *testing.T) {
 	}
 }
 
-func TestCalculateSlippageByOrderbook(t *testing.T) {
+func TestCalculateSlippageByDepth(t *testing.T) {
 	t.Parallel()
-	b := bitstamp.Bitstamp{}
-	b.SetDefaults()
-	cp := currency.NewPair(currency.BTC, currency.USD)
-	ob, err := b.FetchOrderbook(context.Background(), cp, asset.Spot)
-	if err != nil {
-		t.Fatal(err)
-	}
-	amountOfFunds := decimal.NewFromInt(1000)
-	feeRate := decimal.NewFromFloat(0.03)
-	price, amount := CalculateSlippageByOrderbook(ob, gctorder.Buy, amountOfFunds, feeRate)
-	if price.Mul(amount).Add(price.Mul(amount).Mul(feeRate)).GreaterThan(amountOfFunds) {
+	ob := &orderbook.Base{
+		Asks: orderbook.Items{{Price: 100, Amount: 1}, {Price: 102, Amount: 1}},
+		Bids: orderbook.Items{{Price: 99, Amount: 1}},
+	}
+	feeRate := decimal.NewFromFloat(0.01)
+	price, amount, slip, err := CalculateSlippageByDepth(ob, gctorder.Buy, decimal.NewFromInt(202), feeRate)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if !price.Equal(decimal.NewFromInt(101)) {
+		t.Errorf("received '%v', expected '%v'", price, 101)
+	}
+	if !amount.Equal(decimal.NewFromFloat(1.98)) {
+		t.Errorf("received '%v', expected '%v'", amount, 1.98)
+	}
+	if !slip.Equal(decimal.NewFromInt(1)) {
+		t.Errorf("received '%v', expected '%v'", slip, 1)
+	}
+	if price.Mul(amount).Add(price.Mul(amount).Mul(feeRate)).GreaterThan(decimal.NewFromInt(202)) {
 		t.Error("order size must be less than funds")
 	}
+	_, _, _, err = CalculateSlippageByDepth(ob, gctorder.Buy, decimal.NewFromInt(300), feeRate)
+	if !errors.Is(err, errInsufficientDepth) {
+		t.Errorf("received '%v', expected '%v'", err, errInsufficientDepth)
+	}
+	_, _, _, err = CalculateSlippageByDepth(ob, gctorder.Sell, decimal.NewFromInt(2), feeRate)
+	if !errors.Is(err, errInsufficientDepth) {
+		t.Errorf("received '%v', expected '%v'", err, errInsufficientDepth)
+	}
 }
@@ -1,8 +1,15 @@
 package compliance
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
+	"sort"
 	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
 
 // AddSnapshot creates a snapshot in time of the orders placed to allow for finer detail tracking
@@ -49,3 +56,69 @@ func (m *Manager) GetLatestSnapshot() Snapshot {
 
 	return m.Snapshots[len(m.Snapshots)-1]
 }
+
+// AddTransition records the event's order reaching a stage of its lifecycle
+func (m *Manager) AddTransition(stage string, ev common.EventHandler, direction order.Side, price, amount decimal.Decimal) error {
+	if ev == nil {
+		return common.ErrNilEvent
+	}
+	m.Transitions = append(m.Transitions, Transition{
+		Stage:      stage,
+		Time:       ev.GetTime(),
+		RecordedAt: time.Now(),
+		Offset:     ev.GetOffset(),
+		Exchange:   ev.GetExchange(),
+		Asset:      ev.GetAssetType(),
+		Pair:       ev.Pair(),
+		Direction:  direction,
+		Price:      price,
+		Amount:     amount,
+		Reason:     ev.GetReason(),
+	})
+	return nil
+}
+
+// AddSettingsChange records the strategy changing its custom settings for the
+// reason given at the time of the event
+func (m *Manager) AddSettingsChange(ev common.EventHandler, reason string, settings []SettingChange) error {
+	if ev == nil {
+		return common.ErrNilEvent
+	}
+	if len(settings) == 0 {
+		return errNoSettingsChanged
+	}
+	m.Transitions = append(m.Transitions, Transition{
+		Stage:      StageSettingsChanged,
+		Time:       ev.GetTime(),
+		RecordedAt: time.Now(),
+		Offset:     ev.GetOffset(),
+		Exchange:   ev.GetExchange(),
+		Asset:      ev.GetAssetType(),
+		Pair:       ev.Pair(),
+		Reason:     reason,
+		Settings:   settings,
+	})
+	return nil
+}
+
+// SortTransitions orders transitions by the time of their events, keeping
+// the order transitions occurred in for events at the same time
+func SortTransitions(transitions []Transition) {
+	sort.SliceStable(transitions, func(i, j int) bool {
+		if transitions[i].Time.Equal(transitions[j].Time) {
+			return transitions[i].RecordedAt.Before(transitions[j].RecordedAt)
+		}
+		return transitions[i].Time.Before(transitions[j].Time)
+	})
+}
+
+// WriteAuditLog writes transitions as JSON lines, one transition per line
+func WriteAuditLog(w io.Writer, transitions []Transition) error {
+	enc := json.NewEncoder(w)
+	for i := range transitions {
+		if err := enc.Encode(&transitions[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -1,11 +1,18 @@
 package compliance
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
 
 func TestAddSnapshot(t *testing.T) {
@@ -93,3 +100,122 @@ func TestGetLatestSnapshot(t *testing.T) {
 		t.Errorf("expected %v", tt.Add(time.Hour))
 	}
 }
+
+func TestAddTransition(t *testing.T) {
+	t.Parallel()
+	m := Manager{}
+	err := m.AddTransition(StageSignal, nil, order.Buy, decimal.NewFromInt(1337), decimal.Zero)
+	if !errors.Is(err, common.ErrNilEvent) {
+		t.Errorf("received: %v, expected: %v", err, common.ErrNilEvent)
+	}
+
+	tt := time.Now()
+	ev := &event.Base{
+		Offset:   1,
+		Exchange: "binance",
+		Time:     tt,
+		Reason:   "because",
+	}
+	err = m.AddTransition(StageSized, ev, order.Buy, decimal.NewFromInt(1337), decimal.NewFromInt(1))
+	if err != nil {
+		t.Error(err)
+	}
+	if len(m.Transitions) != 1 {
+		t.Fatalf("expected 1 transition, received %v", len(m.Transitions))
+	}
+	if m.Transitions[0].Stage != StageSized {
+		t.Errorf("expected %v, received %v", StageSized, m.Transitions[0].Stage)
+	}
+	if !m.Transitions[0].Time.Equal(tt) {
+		t.Errorf("expected %v, received %v", tt, m.Transitions[0].Time)
+	}
+	if m.Transitions[0].Reason != "because" {
+		t.Errorf("expected because, received %v", m.Transitions[0].Reason)
+	}
+}
+
+func TestAddSettingsChange(t *testing.T) {
+	t.Parallel()
+	m := Manager{}
+	settings := []SettingChange{{Setting: "rsi-high", Previous: 70.0, Value: 80.0}}
+	err := m.AddSettingsChange(nil, "volatile", settings)
+	if !errors.Is(err, common.ErrNilEvent) {
+		t.Errorf("received: %v, expected: %v", err, common.ErrNilEvent)
+	}
+
+	tt := time.Now()
+	ev := &event.Base{
+		Offset:   1,
+		Exchange: "binance",
+		Time:     tt,
+		Reason:   "candle",
+	}
+	err = m.AddSettingsChange(ev, "volatile", nil)
+	if !errors.Is(err, errNoSettingsChanged) {
+		t.Errorf("received: %v, expected: %v", err, errNoSettingsChanged)
+	}
+	err = m.AddSettingsChange(ev, "volatile", settings)
+	if err != nil {
+		t.Error(err)
+	}
+	if len(m.Transitions) != 1 {
+		t.Fatalf("expected 1 transition, received %v", len(m.Transitions))
+	}
+	if m.Transitions[0].Stage != StageSettingsChanged {
+		t.Errorf("expected %v, received %v", StageSettingsChanged, m.Transitions[0].Stage)
+	}
+	if m.Transitions[0].Reason != "volatile" {
+		t.Errorf("expected volatile, received %v", m.Transitions[0].Reason)
+	}
+	if len(m.Transitions[0].Settings) != 1 || m.Transitions[0].Settings[0].Value != 80.0 {
+		t.Errorf("expected %v, received %v", settings, m.Transitions[0].Settings)
+	}
+}
+
+func TestSortTransitions(t *testing.T) {
+	t.Parallel()
+	tt := time.Now()
+	transitions := []Transition{
+		{Stage: StageFilled, Time: tt.Add(time.Hour), RecordedAt: tt},
+		{Stage: StageSized, Time: tt, RecordedAt: tt.Add(time.Second)},
+		{Stage: StageSignal, Time: tt, RecordedAt: tt},
+	}
+	SortTransitions(transitions)
+	if transitions[0].Stage != StageSignal ||
+		transitions[1].Stage != StageSized ||
+		transitions[2].Stage != StageFilled {
+		t.Errorf("unexpected order %v %v %v", transitions[0].Stage, transitions[1].Stage, transitions[2].Stage)
+	}
+}
+
+func TestWriteAuditLog(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	err := WriteAuditLog(&buf, nil)
+	if err != nil {
+		t.Error(err)
+	}
+	if buf.Len() != 0 {
+		t.Error("expected empty audit log")
+	}
+
+	err = WriteAuditLog(&buf, []Transition{
+		{Stage: StageSignal, Pair: currency.NewPair(currency.BTC, currency.USDT), Direction: order.Buy},
+		{Stage: StageRejected, Pair: currency.NewPair(currency.BTC, currency.USDT), Direction: common.CouldNotBuy, Reason: "no funds"},
+	})
+	if err != nil {
+		t.Error(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, received %v", len(lines))
+	}
+	var resp Transition
+	err = json.Unmarshal([]byte(lines[1]), &resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Stage != StageRejected || resp.Reason != "no funds" {
+		t.Errorf("unexpected transition %+v", resp)
+	}
+}
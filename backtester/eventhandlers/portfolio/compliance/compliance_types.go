@@ -5,17 +5,70 @@ import (
 	"time"
 
 	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
 
+// Stages of an order's lifecycle recorded in the audit log
+const (
+	// StageSignal is a strategy signalling to buy or sell
+	StageSignal = "signal"
+	// StageSized is the portfolio sizing the signal into an order
+	StageSized = "sized"
+	// StageRiskChecked is the order passing the risk manager's evaluation
+	StageRiskChecked = "risk-checked"
+	// StageSubmitted is the order being sent to the exchange handler
+	StageSubmitted = "submitted"
+	// StageFilled is the exchange handler filling the order
+	StageFilled = "filled"
+	// StageRejected is the order being stopped at any stage, its reason
+	// detailing why
+	StageRejected = "rejected"
+	// StageSettingsChanged is not an order stage, it records the strategy
+	// adjusting its custom settings mid-run so that the orders which follow
+	// can be attributed to the settings in effect
+	StageSettingsChanged = "settings-changed"
+)
+
 var (
-	errSnapshotNotFound = errors.New("snapshot not found")
+	errSnapshotNotFound  = errors.New("snapshot not found")
+	errNoSettingsChanged = errors.New("no settings changed")
 )
 
 // Manager holds a snapshot of all orders at each timeperiod, allowing
-// study of all changes across time
+// study of all changes across time, along with each stage orders passed
+// through to reach them
 type Manager struct {
-	Snapshots []Snapshot
+	Snapshots   []Snapshot
+	Transitions []Transition
+}
+
+// Transition records an order reaching a stage of its lifecycle. Time is the
+// time of the event while RecordedAt is when the transition occurred, with
+// Reason holding every reason given for the order up to the stage
+type Transition struct {
+	Stage      string          `json:"stage"`
+	Time       time.Time       `json:"time"`
+	RecordedAt time.Time       `json:"recorded-at"`
+	Offset     int64           `json:"offset"`
+	Exchange   string          `json:"exchange"`
+	Asset      asset.Item      `json:"asset"`
+	Pair       currency.Pair   `json:"pair"`
+	Direction  order.Side      `json:"direction"`
+	Price      decimal.Decimal `json:"price"`
+	Amount     decimal.Decimal `json:"amount"`
+	Reason     string          `json:"reason,omitempty"`
+	// Settings are only set for settings changes
+	Settings []SettingChange `json:"settings,omitempty"`
+}
+
+// SettingChange is a custom setting of the strategy changing from its
+// previous value
+type SettingChange struct {
+	Setting  string      `json:"setting"`
+	Previous interface{} `json:"previous"`
+	Value    interface{} `json:"value"`
 }
 
 // Snapshot consists of the timestamp the snapshot is from, along with all orders made
@@ -46,3 +46,14 @@ type Holding struct {
 
 	RiskFreeRate decimal.Decimal `json:"risk-free-rate"`
 }
+
+// Position contains the latest holdings for an exchange asset pair along
+// with the base currency bought by filled orders which has not yet been sold.
+// EntryPrice is the average cost of the position, including fees. Sells reduce
+// the size at the average cost and the entry resets once the size reaches zero
+type Position struct {
+	Holding    Holding         `json:"holding"`
+	Size       decimal.Decimal `json:"size"`
+	EntryPrice decimal.Decimal `json:"entry-price"`
+	EntryTime  time.Time       `json:"entry-time"`
+}
@@ -3,6 +3,8 @@ package portfolio
 import (
 	"errors"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
@@ -41,9 +43,26 @@ func Setup(sh SizeHandler, r risk.Handler, riskFreeRate decimal.Decimal) (*Portf
 	return p, nil
 }
 
+// SetUsingExchangeLevelFunding sets whether pairs on the same exchange and
+// asset share their quote funds, so that shared funds are only counted once
+// when valuing equity
+func (p *Portfolio) SetUsingExchangeLevelFunding(b bool) {
+	p.usingExchangeLevelFunding = b
+}
+
+// SetEquityScaling scales buy orders by the drawdown of equity in their quote
+// currency from its high-water mark. Orders are scaled to 100% less the
+// drawdown percentage multiplied by the drawdown multiplier, but no lower than
+// the minimum scale percentage
+func (p *Portfolio) SetEquityScaling(drawdownMultiplier, minimumScale decimal.Decimal) {
+	p.drawdownMultiplier = drawdownMultiplier
+	p.minimumEquityScale = minimumScale
+}
+
 // Reset returns the portfolio manager to its default state
 func (p *Portfolio) Reset() {
 	p.exchangeAssetPairSettings = nil
+	p.equityHighWaterMarks = nil
 }
 
 // OnSignal receives the event from the strategy on whether it has signalled to buy, do nothing or sell
@@ -96,6 +115,7 @@ func (p *Portfolio) OnSignal(ev signal.Event, cs *exchange.Settings, funds fundi
 		return o, nil
 	}
 
+	p.recordTransition(compliance.StageSignal, ev, ev.GetDirection(), ev.GetPrice(), decimal.Zero)
 	if !funds.CanPlaceOrder(ev.GetDirection()) {
 		if ev.GetDirection() == gctorder.Sell {
 			o.AppendReason("no holdings to sell")
@@ -105,6 +125,7 @@ func (p *Portfolio) OnSignal(ev signal.Event, cs *exchange.Settings, funds fundi
 			o.SetDirection(common.CouldNotBuy)
 		}
 		ev.SetDirection(o.Direction)
+		p.recordTransition(compliance.StageRejected, o, o.Direction, o.Price, o.Amount)
 		return o, nil
 	}
 
@@ -116,11 +137,127 @@ func (p *Portfolio) OnSignal(ev signal.Event, cs *exchange.Settings, funds fundi
 	if ev.GetDirection() == gctorder.Sell {
 		sizingFunds = funds.BaseAvailable()
 	} else {
-		sizingFunds = funds.QuoteAvailable()
+		// funds which can be converted to the quote are converted when
+		// the order is reserved
+		sizingFunds = funds.QuoteAvailable().Add(funds.ConvertibleQuote())
+	}
+	sizedOrder := p.sizeOrder(ev, cs, o, sizingFunds, p.equity(ev, funds), funds)
+	sized := isPlaceable(sizedOrder.Direction)
+	if sized {
+		p.recordTransition(compliance.StageSized, sizedOrder, sizedOrder.Direction, sizedOrder.Price, sizedOrder.Amount)
+	} else {
+		p.recordTransition(compliance.StageRejected, sizedOrder, sizedOrder.Direction, sizedOrder.Price, sizedOrder.Amount)
+	}
+
+	evaluatedOrder, err := p.evaluateOrder(ev, o, sizedOrder)
+	if err != nil {
+		return nil, err
+	}
+	if sized {
+		stage := compliance.StageRiskChecked
+		if !isPlaceable(evaluatedOrder.Direction) {
+			stage = compliance.StageRejected
+		}
+		p.recordTransition(stage, evaluatedOrder, evaluatedOrder.Direction, evaluatedOrder.Price, evaluatedOrder.Amount)
+	}
+	return evaluatedOrder, nil
+}
+
+// isPlaceable returns whether an order in the direction can be placed
+func isPlaceable(direction gctorder.Side) bool {
+	return direction == gctorder.Buy || direction == gctorder.Sell
+}
+
+// recordTransition adds the stage an event's order has reached to the audit
+// log of its compliance manager
+func (p *Portfolio) recordTransition(stage string, ev common.EventHandler, direction gctorder.Side, price, amount decimal.Decimal) {
+	cm, err := p.GetComplianceManager(ev.GetExchange(), ev.GetAssetType(), ev.Pair())
+	if err != nil {
+		log.Error(log.BackTester, err)
+		return
+	}
+	err = cm.AddTransition(stage, ev, direction, price, amount)
+	if err != nil {
+		log.Error(log.BackTester, err)
+	}
+}
+
+// equity returns the total value of the latest holdings of every pair quoted
+// in the same currency as the event. When no holdings have been recorded, the
+// event's available funds are valued at its price instead
+func (p *Portfolio) equity(ev signal.Event, funds funding.IPairReader) decimal.Decimal {
+	total := p.quoteEquity(ev.Pair().Quote)
+	if total.IsZero() {
+		total = funds.QuoteAvailable().Add(funds.BaseAvailable().Mul(ev.GetPrice()))
+	}
+	return total
+}
+
+// quoteEquity returns the total value of the latest holdings of every pair
+// quoted in the currency
+func (p *Portfolio) quoteEquity(quote currency.Code) decimal.Decimal {
+	var total decimal.Decimal
+	counted := make(map[string]bool)
+	for exch, assetMap := range p.exchangeAssetPairSettings {
+		for a, pairMap := range assetMap {
+			for cp, lookup := range pairMap {
+				if !cp.Quote.Match(quote) {
+					continue
+				}
+				h := lookup.GetLatestHoldings()
+				if h.Timestamp.IsZero() {
+					continue
+				}
+				total = total.Add(h.BaseValue)
+				if p.usingExchangeLevelFunding {
+					key := exch + a.String() + cp.Quote.String()
+					if counted[key] {
+						continue
+					}
+					counted[key] = true
+				}
+				total = total.Add(h.QuoteSize)
+			}
+		}
+	}
+	return total
+}
+
+// updateHighWaterMark records equity as the highest equity of its quote
+// currency when it exceeds it, returning the high-water mark
+func (p *Portfolio) updateHighWaterMark(quote currency.Code, equity decimal.Decimal) decimal.Decimal {
+	if p.equityHighWaterMarks == nil {
+		p.equityHighWaterMarks = make(map[*currency.Item]decimal.Decimal)
+	}
+	hwm := p.equityHighWaterMarks[quote.Item]
+	if equity.GreaterThan(hwm) {
+		hwm = equity
+		p.equityHighWaterMarks[quote.Item] = hwm
 	}
-	sizedOrder := p.sizeOrder(ev, cs, o, sizingFunds, funds)
+	return hwm
+}
 
-	return p.evaluateOrder(ev, o, sizedOrder)
+// scaleOrder scales a buy order's amount by the drawdown of equity from its
+// high-water mark, recording the scale applied and the amount before it
+func (p *Portfolio) scaleOrder(o *order.Order, equity decimal.Decimal) {
+	hwm := p.updateHighWaterMark(o.Pair().Quote, equity)
+	if !hwm.IsPositive() {
+		return
+	}
+	oneHundred := decimal.NewFromInt(100)
+	drawdown := hwm.Sub(equity).Div(hwm).Mul(oneHundred)
+	scale := decimal.NewFromInt(1).Sub(drawdown.Mul(p.drawdownMultiplier).Div(oneHundred))
+	if minimum := p.minimumEquityScale.Div(oneHundred); scale.LessThan(minimum) {
+		scale = minimum
+	}
+	o.EquityScale = scale
+	o.UnscaledAmount = o.Amount
+	o.Amount = o.Amount.Mul(scale).Round(8)
+	if scale.LessThan(decimal.NewFromInt(1)) {
+		o.AppendReason(fmt.Sprintf("scaled to %v%% of size at %v%% equity drawdown",
+			scale.Mul(oneHundred).Round(2),
+			drawdown.Round(2)))
+	}
 }
 
 func (p *Portfolio) evaluateOrder(d common.Directioner, originalOrderSignal, sizedOrder *order.Order) (*order.Order, error) {
@@ -149,8 +286,8 @@ func (p *Portfolio) evaluateOrder(d common.Directioner, originalOrderSignal, siz
 	return evaluatedOrder, nil
 }
 
-func (p *Portfolio) sizeOrder(d common.Directioner, cs *exchange.Settings, originalOrderSignal *order.Order, sizingFunds decimal.Decimal, funds funding.IPairReserver) *order.Order {
-	sizedOrder, err := p.sizeManager.SizeOrder(originalOrderSignal, sizingFunds, cs)
+func (p *Portfolio) sizeOrder(d common.Directioner, cs *exchange.Settings, originalOrderSignal *order.Order, sizingFunds, equity decimal.Decimal, funds funding.IPairReserver) *order.Order {
+	sizedOrder, err := p.sizeManager.SizeOrder(originalOrderSignal, sizingFunds, equity, cs)
 	if err != nil {
 		originalOrderSignal.AppendReason(err.Error())
 		switch originalOrderSignal.Direction {
@@ -165,6 +302,9 @@ func (p *Portfolio) sizeOrder(d common.Directioner, cs *exchange.Settings, origi
 		return originalOrderSignal
 	}
 
+	if p.drawdownMultiplier.IsPositive() && sizedOrder.Direction == gctorder.Buy {
+		p.scaleOrder(sizedOrder, equity)
+	}
 	if sizedOrder.Amount.IsZero() {
 		switch originalOrderSignal.Direction {
 		case gctorder.Buy:
@@ -287,6 +427,21 @@ func (p *Portfolio) GetComplianceManager(exchangeName string, a asset.Item, cp c
 	return &lookup.ComplianceManager, nil
 }
 
+// GetAuditLog returns the order lifecycle transitions of every exchange,
+// asset and pair, ordered by the time of their events
+func (p *Portfolio) GetAuditLog() []compliance.Transition {
+	var resp []compliance.Transition
+	for _, assetMap := range p.exchangeAssetPairSettings {
+		for _, pairMap := range assetMap {
+			for _, lookup := range pairMap {
+				resp = append(resp, lookup.ComplianceManager.Transitions...)
+			}
+		}
+	}
+	compliance.SortTransitions(resp)
+	return resp
+}
+
 // SetFee sets the fee rate
 func (p *Portfolio) SetFee(exch string, a asset.Item, cp currency.Pair, fee decimal.Decimal) {
 	lookup := p.exchangeAssetPairSettings[exch][a][cp]
@@ -334,7 +489,13 @@ func (p *Portfolio) UpdateHoldings(ev common.DataEventHandler, funds funding.IPa
 	if errors.Is(err, errNoHoldings) {
 		err = p.setHoldingsForOffset(&h, false)
 	}
-	return err
+	if err != nil {
+		return err
+	}
+	if p.drawdownMultiplier.IsPositive() {
+		p.updateHighWaterMark(ev.Pair().Quote, p.quoteEquity(ev.Pair().Quote))
+	}
+	return nil
 }
 
 // GetLatestHoldingsForAllCurrencies will return the current holdings for all loaded currencies
@@ -403,6 +564,109 @@ func (p *Portfolio) ViewHoldingAtTimePeriod(ev common.EventHandler) (*holdings.H
 	return nil, fmt.Errorf("%w for %v %v %v at %v", errNoHoldings, ev.GetExchange(), ev.GetAssetType(), ev.Pair(), ev.GetTime())
 }
 
+// GetHoldingsAtTime returns the holdings for an exchange, asset and currency pair
+// as they stood at the provided time. The latest snapshot at or before the time
+// is returned, allowing holdings to be queried between events
+func (p *Portfolio) GetHoldingsAtTime(exch string, a asset.Item, cp currency.Pair, t time.Time) (*holdings.Holding, error) {
+	lookup := p.exchangeAssetPairSettings[exch][a][cp]
+	if lookup == nil || len(lookup.HoldingsSnapshots) == 0 {
+		return nil, fmt.Errorf("%w for %v %v %v", errNoHoldings, exch, a, cp)
+	}
+	// snapshots are stored in event order, find the first one after the time
+	i := sort.Search(len(lookup.HoldingsSnapshots), func(i int) bool {
+		return lookup.HoldingsSnapshots[i].Timestamp.After(t)
+	})
+	if i == 0 {
+		return nil, fmt.Errorf("%w for %v %v %v at %v, first holdings at %v",
+			errNoHoldings,
+			exch,
+			a,
+			cp,
+			t,
+			lookup.HoldingsSnapshots[0].Timestamp)
+	}
+	h := lookup.HoldingsSnapshots[i-1]
+	return &h, nil
+}
+
+// GetAllHoldingsAtTime returns the holdings for all loaded currencies as they
+// stood at the provided time. Currencies without holdings at the time are skipped
+func (p *Portfolio) GetAllHoldingsAtTime(t time.Time) []holdings.Holding {
+	var resp []holdings.Holding
+	for exch, x := range p.exchangeAssetPairSettings {
+		for a, y := range x {
+			for cp := range y {
+				h, err := p.GetHoldingsAtTime(exch, a, cp, t)
+				if err != nil {
+					continue
+				}
+				resp = append(resp, *h)
+			}
+		}
+	}
+	return resp
+}
+
+// GetHoldingsTimeline returns a copy of every holdings snapshot for an exchange,
+// asset and currency pair in event order
+func (p *Portfolio) GetHoldingsTimeline(exch string, a asset.Item, cp currency.Pair) ([]holdings.Holding, error) {
+	lookup := p.exchangeAssetPairSettings[exch][a][cp]
+	if lookup == nil || len(lookup.HoldingsSnapshots) == 0 {
+		return nil, fmt.Errorf("%w for %v %v %v", errNoHoldings, exch, a, cp)
+	}
+	resp := make([]holdings.Holding, len(lookup.HoldingsSnapshots))
+	copy(resp, lookup.HoldingsSnapshots)
+	return resp, nil
+}
+
+// GetPosition returns the latest holdings for an exchange, asset and currency
+// pair along with the open position built from its filled orders, allowing
+// strategies to base exit and pyramiding decisions on their entries
+func (p *Portfolio) GetPosition(exch string, a asset.Item, cp currency.Pair) (*holdings.Position, error) {
+	lookup := p.exchangeAssetPairSettings[exch][a][cp]
+	if lookup == nil {
+		return nil, fmt.Errorf("%w for %v %v %v could not retrieve position", errNoPortfolioSettings, exch, a, cp)
+	}
+	resp := &holdings.Position{
+		Holding: lookup.GetLatestHoldings(),
+	}
+	var cost decimal.Decimal
+	orders := lookup.ComplianceManager.GetLatestSnapshot().Orders
+	for i := range orders {
+		if orders[i].Detail == nil {
+			continue
+		}
+		amount := decimal.NewFromFloat(orders[i].Amount)
+		if !amount.IsPositive() {
+			continue
+		}
+		switch orders[i].Side {
+		case gctorder.Buy, gctorder.Bid:
+			if resp.Size.IsZero() {
+				resp.EntryTime = orders[i].Date
+			}
+			cost = cost.Add(orders[i].CostBasis)
+			resp.Size = resp.Size.Add(amount)
+		case gctorder.Sell, gctorder.Ask:
+			if !resp.Size.IsPositive() {
+				continue
+			}
+			if amount.GreaterThanOrEqual(resp.Size) {
+				resp.Size = decimal.Zero
+				resp.EntryTime = time.Time{}
+				cost = decimal.Zero
+				continue
+			}
+			cost = cost.Sub(cost.Div(resp.Size).Mul(amount))
+			resp.Size = resp.Size.Sub(amount)
+		}
+	}
+	if resp.Size.IsPositive() {
+		resp.EntryPrice = cost.Div(resp.Size)
+	}
+	return resp, nil
+}
+
 // SetupCurrencySettingsMap ensures a map is created and no panics happen
 func (p *Portfolio) SetupCurrencySettingsMap(exch string, a asset.Item, cp currency.Pair) (*settings.Settings, error) {
 	if exch == "" {
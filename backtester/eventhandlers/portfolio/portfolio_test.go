@@ -223,6 +223,212 @@ func TestViewHoldingAtTimePeriod(t *testing.T) {
 	}
 }
 
+func TestGetHoldingsAtTime(t *testing.T) {
+	t.Parallel()
+	p := Portfolio{}
+	tt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	cp := currency.NewPair(currency.BTC, currency.USD)
+	_, err := p.GetHoldingsAtTime(testExchange, asset.Spot, cp, tt)
+	if !errors.Is(err, errNoHoldings) {
+		t.Errorf("received: %v, expected: %v", err, errNoHoldings)
+	}
+	for i := int64(0); i < 3; i++ {
+		err = p.setHoldingsForOffset(&holdings.Holding{
+			Offset:    i + 1,
+			Exchange:  testExchange,
+			Asset:     asset.Spot,
+			Pair:      cp,
+			Timestamp: tt.Add(time.Hour * time.Duration(i)),
+			QuoteSize: decimal.NewFromInt(i)}, false)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+
+	_, err = p.GetHoldingsAtTime(testExchange, asset.Spot, cp, tt.Add(-time.Minute))
+	if !errors.Is(err, errNoHoldings) {
+		t.Errorf("received: %v, expected: %v", err, errNoHoldings)
+	}
+
+	h, err := p.GetHoldingsAtTime(testExchange, asset.Spot, cp, tt.Add(time.Hour))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if h.Offset != 2 {
+		t.Errorf("received: %v, expected: %v", h.Offset, 2)
+	}
+
+	h, err = p.GetHoldingsAtTime(testExchange, asset.Spot, cp, tt.Add(time.Minute*90))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if !h.QuoteSize.Equal(decimal.NewFromInt(1)) {
+		t.Errorf("received: %v, expected: %v", h.QuoteSize, 1)
+	}
+
+	h, err = p.GetHoldingsAtTime(testExchange, asset.Spot, cp, tt.Add(time.Hour*24))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if h.Offset != 3 {
+		t.Errorf("received: %v, expected: %v", h.Offset, 3)
+	}
+
+	h.QuoteSize = decimal.NewFromInt(1337)
+	h, err = p.GetHoldingsAtTime(testExchange, asset.Spot, cp, tt.Add(time.Hour*24))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if h.QuoteSize.Equal(decimal.NewFromInt(1337)) {
+		t.Error("expected snapshot to be unaffected by changes to the returned holding")
+	}
+}
+
+func TestGetAllHoldingsAtTime(t *testing.T) {
+	t.Parallel()
+	p := Portfolio{}
+	tt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	if resp := p.GetAllHoldingsAtTime(tt); len(resp) != 0 {
+		t.Errorf("received: %v, expected: %v", len(resp), 0)
+	}
+	err := p.setHoldingsForOffset(&holdings.Holding{
+		Offset:    1,
+		Exchange:  testExchange,
+		Asset:     asset.Spot,
+		Pair:      currency.NewPair(currency.BTC, currency.USD),
+		Timestamp: tt}, false)
+	if err != nil {
+		t.Error(err)
+	}
+	err = p.setHoldingsForOffset(&holdings.Holding{
+		Offset:    1,
+		Exchange:  testExchange,
+		Asset:     asset.Spot,
+		Pair:      currency.NewPair(currency.LTC, currency.USD),
+		Timestamp: tt.Add(time.Hour)}, false)
+	if err != nil {
+		t.Error(err)
+	}
+	if resp := p.GetAllHoldingsAtTime(tt); len(resp) != 1 {
+		t.Errorf("received: %v, expected: %v", len(resp), 1)
+	}
+	if resp := p.GetAllHoldingsAtTime(tt.Add(time.Hour)); len(resp) != 2 {
+		t.Errorf("received: %v, expected: %v", len(resp), 2)
+	}
+}
+
+func TestGetHoldingsTimeline(t *testing.T) {
+	t.Parallel()
+	p := Portfolio{}
+	cp := currency.NewPair(currency.BTC, currency.USD)
+	_, err := p.GetHoldingsTimeline(testExchange, asset.Spot, cp)
+	if !errors.Is(err, errNoHoldings) {
+		t.Errorf("received: %v, expected: %v", err, errNoHoldings)
+	}
+	err = p.setHoldingsForOffset(&holdings.Holding{
+		Offset:    1,
+		Exchange:  testExchange,
+		Asset:     asset.Spot,
+		Pair:      cp,
+		Timestamp: time.Now()}, false)
+	if err != nil {
+		t.Error(err)
+	}
+	resp, err := p.GetHoldingsTimeline(testExchange, asset.Spot, cp)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if len(resp) != 1 {
+		t.Fatalf("received: %v, expected: %v", len(resp), 1)
+	}
+	resp[0].Offset = 1337
+	resp, err = p.GetHoldingsTimeline(testExchange, asset.Spot, cp)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if resp[0].Offset != 1 {
+		t.Errorf("received: %v, expected: %v", resp[0].Offset, 1)
+	}
+}
+
+func TestGetPosition(t *testing.T) {
+	t.Parallel()
+	p := Portfolio{}
+	cp := currency.NewPair(currency.BTC, currency.USD)
+	_, err := p.GetPosition(testExchange, asset.Spot, cp)
+	if !errors.Is(err, errNoPortfolioSettings) {
+		t.Errorf("received: %v, expected: %v", err, errNoPortfolioSettings)
+	}
+	_, err = p.SetupCurrencySettingsMap(testExchange, asset.Spot, cp)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	pos, err := p.GetPosition(testExchange, asset.Spot, cp)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if !pos.Size.IsZero() || !pos.EntryPrice.IsZero() {
+		t.Errorf("received: %v %v, expected: empty position", pos.Size, pos.EntryPrice)
+	}
+
+	tt := time.Now()
+	for i, o := range []gctorder.Detail{
+		{Side: gctorder.Buy, Amount: 1, Price: 100, Fee: 1},
+		{Side: gctorder.Buy, Amount: 1, Price: 200, Fee: 1},
+		{Side: gctorder.Sell, Amount: 1, Price: 300},
+	} {
+		o := o
+		o.Date = tt.Add(time.Hour * time.Duration(i))
+		err = p.addComplianceSnapshot(&fill.Fill{
+			Base: event.Base{
+				Offset:       int64(i + 1),
+				Exchange:     testExchange,
+				CurrencyPair: cp,
+				AssetType:    asset.Spot,
+				Time:         o.Date,
+			},
+			Order: &o,
+		})
+		if !errors.Is(err, nil) {
+			t.Fatalf("received: %v, expected: %v", err, nil)
+		}
+	}
+	pos, err = p.GetPosition(testExchange, asset.Spot, cp)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	// selling half the position keeps the average cost of 151
+	if !pos.Size.Equal(decimal.NewFromInt(1)) {
+		t.Errorf("received: %v, expected: %v", pos.Size, 1)
+	}
+	if !pos.EntryPrice.Equal(decimal.NewFromInt(151)) {
+		t.Errorf("received: %v, expected: %v", pos.EntryPrice, 151)
+	}
+	if !pos.EntryTime.Equal(tt) {
+		t.Errorf("received: %v, expected: %v", pos.EntryTime, tt)
+	}
+
+	err = p.addComplianceSnapshot(&fill.Fill{
+		Base: event.Base{
+			Offset:       4,
+			Exchange:     testExchange,
+			CurrencyPair: cp,
+			AssetType:    asset.Spot,
+		},
+		Order: &gctorder.Detail{Side: gctorder.Sell, Amount: 1, Price: 300},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	pos, err = p.GetPosition(testExchange, asset.Spot, cp)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if !pos.Size.IsZero() || !pos.EntryPrice.IsZero() || !pos.EntryTime.IsZero() {
+		t.Errorf("received: %v %v %v, expected: closed position", pos.Size, pos.EntryPrice, pos.EntryTime)
+	}
+}
+
 func TestUpdate(t *testing.T) {
 	t.Parallel()
 	p := Portfolio{}
@@ -518,3 +724,152 @@ func TestOnSignal(t *testing.T) {
 		t.Error("expected an amount to be sized")
 	}
 }
+
+func TestEquity(t *testing.T) {
+	t.Parallel()
+	p := &Portfolio{}
+	b, err := funding.CreateItem(testExchange, asset.Spot, currency.BTC, decimal.NewFromInt(2), decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q, err := funding.CreateItem(testExchange, asset.Spot, currency.USDT, decimal.NewFromInt(1000), decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pair, err := funding.CreatePair(b, q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &signal.Signal{
+		Base: event.Base{
+			Exchange:     testExchange,
+			CurrencyPair: currency.NewPair(currency.BTC, currency.USDT),
+			AssetType:    asset.Spot,
+		},
+		ClosePrice: decimal.NewFromInt(100),
+	}
+	// without holdings, the event's funds are valued instead
+	if e := p.equity(s, pair); !e.Equal(decimal.NewFromInt(1200)) {
+		t.Errorf("received: %v, expected: %v", e, 1200)
+	}
+
+	tt := time.Now()
+	for _, h := range []holdings.Holding{
+		{Pair: currency.NewPair(currency.BTC, currency.USDT), BaseValue: decimal.NewFromInt(300), QuoteSize: decimal.NewFromInt(1000)},
+		{Pair: currency.NewPair(currency.LTC, currency.USDT), BaseValue: decimal.NewFromInt(200), QuoteSize: decimal.NewFromInt(1000)},
+		{Pair: currency.NewPair(currency.LTC, currency.BTC), BaseValue: decimal.NewFromInt(5), QuoteSize: decimal.NewFromInt(1)},
+	} {
+		_, err = p.SetupCurrencySettingsMap(testExchange, asset.Spot, h.Pair)
+		if err != nil {
+			t.Fatal(err)
+		}
+		h.Offset = 1
+		h.Exchange = testExchange
+		h.Asset = asset.Spot
+		h.Timestamp = tt
+		err = p.setHoldingsForOffset(&h, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if e := p.equity(s, pair); !e.Equal(decimal.NewFromInt(2500)) {
+		t.Errorf("received: %v, expected: %v", e, 2500)
+	}
+	p.SetUsingExchangeLevelFunding(true)
+	if e := p.equity(s, pair); !e.Equal(decimal.NewFromInt(1500)) {
+		t.Errorf("received: %v, expected: %v", e, 1500)
+	}
+}
+
+func TestScaleOrder(t *testing.T) {
+	t.Parallel()
+	p := &Portfolio{}
+	p.SetEquityScaling(decimal.NewFromInt(2), decimal.NewFromInt(50))
+	o := &order.Order{
+		Base: event.Base{
+			CurrencyPair: currency.NewPair(currency.BTC, currency.USDT),
+		},
+		Direction: gctorder.Buy,
+		Amount:    decimal.NewFromInt(10),
+	}
+	// the first equity sets the high-water mark so the order is not scaled
+	p.scaleOrder(o, decimal.NewFromInt(1000))
+	if !o.Amount.Equal(decimal.NewFromInt(10)) || !o.EquityScale.Equal(decimal.NewFromInt(1)) {
+		t.Errorf("received: %v scale %v, expected: %v scale %v", o.Amount, o.EquityScale, 10, 1)
+	}
+
+	// a 10% drawdown with a multiplier of 2 scales orders to 80%
+	o.Amount = decimal.NewFromInt(10)
+	p.scaleOrder(o, decimal.NewFromInt(900))
+	if !o.Amount.Equal(decimal.NewFromInt(8)) || !o.UnscaledAmount.Equal(decimal.NewFromInt(10)) {
+		t.Errorf("received: %v unscaled %v, expected: %v unscaled %v", o.Amount, o.UnscaledAmount, 8, 10)
+	}
+	if o.Reason == "" {
+		t.Error("expected scaling reason")
+	}
+
+	// scaling cannot fall below the minimum scale
+	o.Amount = decimal.NewFromInt(10)
+	p.scaleOrder(o, decimal.NewFromInt(500))
+	if !o.Amount.Equal(decimal.NewFromInt(5)) {
+		t.Errorf("received: %v, expected: %v", o.Amount, 5)
+	}
+
+	// recovering equity scales orders back up and a new high resets the
+	// high-water mark
+	o.Amount = decimal.NewFromInt(10)
+	p.scaleOrder(o, decimal.NewFromInt(1100))
+	if !o.Amount.Equal(decimal.NewFromInt(10)) {
+		t.Errorf("received: %v, expected: %v", o.Amount, 10)
+	}
+	if hwm := p.equityHighWaterMarks[currency.USDT.Item]; !hwm.Equal(decimal.NewFromInt(1100)) {
+		t.Errorf("received: %v, expected: %v", hwm, 1100)
+	}
+
+	p.Reset()
+	if p.equityHighWaterMarks != nil {
+		t.Error("expected high-water marks to be reset")
+	}
+}
+
+func TestUpdateHoldingsHighWaterMark(t *testing.T) {
+	t.Parallel()
+	p := &Portfolio{}
+	p.SetEquityScaling(decimal.NewFromInt(1), decimal.Zero)
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	_, err := p.SetupCurrencySettingsMap(testExchange, asset.Spot, cp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := funding.CreateItem(testExchange, asset.Spot, currency.BTC, decimal.NewFromInt(1), decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q, err := funding.CreateItem(testExchange, asset.Spot, currency.USDT, decimal.NewFromInt(1000), decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pair, err := funding.CreatePair(b, q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tt := time.Now()
+	for i, price := range []int64{100, 300, 200} {
+		err = p.UpdateHoldings(&kline.Kline{
+			Base: event.Base{
+				Offset:       int64(i + 1),
+				Exchange:     testExchange,
+				Time:         tt.Add(time.Hour * time.Duration(i)),
+				CurrencyPair: cp,
+				AssetType:    asset.Spot,
+			},
+			Close: decimal.NewFromInt(price),
+		}, pair)
+		if !errors.Is(err, nil) {
+			t.Fatalf("received: %v, expected: %v", err, nil)
+		}
+	}
+	if hwm := p.equityHighWaterMarks[currency.USDT.Item]; !hwm.Equal(decimal.NewFromInt(1300)) {
+		t.Errorf("received: %v, expected: %v", hwm, 1300)
+	}
+}
@@ -2,6 +2,7 @@ package portfolio
 
 import (
 	"errors"
+	"time"
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
@@ -38,7 +39,13 @@ type Portfolio struct {
 	riskFreeRate              decimal.Decimal
 	sizeManager               SizeHandler
 	riskManager               risk.Handler
+	usingExchangeLevelFunding bool
 	exchangeAssetPairSettings map[string]map[asset.Item]map[currency.Pair]*settings.Settings
+	// equity scaling settings and the highest equity reached in each quote
+	// currency, a zero multiplier disables scaling
+	drawdownMultiplier   decimal.Decimal
+	minimumEquityScale   decimal.Decimal
+	equityHighWaterMarks map[*currency.Item]decimal.Decimal
 }
 
 // Handler contains all functions expected to operate a portfolio manager
@@ -47,10 +54,15 @@ type Handler interface {
 	OnFill(fill.Event, funding.IPairReader) (*fill.Fill, error)
 
 	ViewHoldingAtTimePeriod(common.EventHandler) (*holdings.Holding, error)
+	GetHoldingsAtTime(string, asset.Item, currency.Pair, time.Time) (*holdings.Holding, error)
+	GetAllHoldingsAtTime(time.Time) []holdings.Holding
+	GetHoldingsTimeline(string, asset.Item, currency.Pair) ([]holdings.Holding, error)
+	GetPosition(string, asset.Item, currency.Pair) (*holdings.Position, error)
 	setHoldingsForOffset(*holdings.Holding, bool) error
 	UpdateHoldings(common.DataEventHandler, funding.IPairReader) error
 
 	GetComplianceManager(string, asset.Item, currency.Pair) (*compliance.Manager, error)
+	GetAuditLog() []compliance.Transition
 
 	SetFee(string, asset.Item, currency.Pair, decimal.Decimal)
 	GetFee(string, asset.Item, currency.Pair) decimal.Decimal
@@ -60,5 +72,5 @@ type Handler interface {
 
 // SizeHandler is the interface to help size orders
 type SizeHandler interface {
-	SizeOrder(order.Event, decimal.Decimal, *exchange.Settings) (*order.Order, error)
+	SizeOrder(order.Event, decimal.Decimal, decimal.Decimal, *exchange.Settings) (*order.Order, error)
 }
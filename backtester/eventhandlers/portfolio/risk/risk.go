@@ -66,6 +66,35 @@ func existingLeverageRatio(s compliance.Snapshot) decimal.Decimal {
 	return ordersWithLeverage.Div(decimal.NewFromInt(int64(len(s.Orders))))
 }
 
+// StressTest applies each shock to a holding, returning the hypothetical loss
+// of its total value for each shock
+func StressTest(h *holdings.Holding, shocks []Shock) ([]ShockResult, error) {
+	if h == nil {
+		return nil, common.ErrNilArguments
+	}
+	oneHundred := decimal.NewFromInt(100)
+	resp := make([]ShockResult, len(shocks))
+	for i := range shocks {
+		change := decimal.Zero
+		if shocks[i].Currency.IsEmpty() || shocks[i].Currency.Match(h.Pair.Base) {
+			change = change.Add(h.BaseValue.Mul(shocks[i].PercentChange).Div(oneHundred))
+		}
+		if !shocks[i].Currency.IsEmpty() && shocks[i].Currency.Match(h.Pair.Quote) {
+			change = change.Add(h.QuoteSize.Mul(shocks[i].PercentChange).Div(oneHundred))
+		}
+		resp[i] = ShockResult{
+			Name:       shocks[i].Name,
+			Time:       h.Timestamp,
+			TotalValue: h.TotalValue,
+			Loss:       change.Neg(),
+		}
+		if !h.TotalValue.IsZero() {
+			resp[i].LossPercent = resp[i].Loss.Div(h.TotalValue).Mul(oneHundred)
+		}
+	}
+	return resp, nil
+}
+
 func assessHoldingsRatio(c currency.Pair, h []holdings.Holding) decimal.Decimal {
 	resp := make(map[currency.Pair]decimal.Decimal)
 	totalPosition := decimal.Zero
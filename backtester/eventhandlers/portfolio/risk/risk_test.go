@@ -140,3 +140,45 @@ func TestEvaluateOrder(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestStressTest(t *testing.T) {
+	t.Parallel()
+	_, err := StressTest(nil, nil)
+	if !errors.Is(err, common.ErrNilArguments) {
+		t.Errorf("received: %v, expected: %v", err, common.ErrNilArguments)
+	}
+
+	h := &holdings.Holding{
+		Pair:       currency.NewPair(currency.BTC, currency.USDT),
+		BaseValue:  decimal.NewFromInt(600),
+		QuoteSize:  decimal.NewFromInt(400),
+		TotalValue: decimal.NewFromInt(1000),
+	}
+	resp, err := StressTest(h, []Shock{
+		{Name: "crash", PercentChange: decimal.NewFromInt(-20)},
+		{Name: "depeg", Currency: currency.USDT, PercentChange: decimal.NewFromInt(-10)},
+		{Name: "ltc", Currency: currency.LTC, PercentChange: decimal.NewFromInt(-50)},
+		{Name: "rally", Currency: currency.BTC, PercentChange: decimal.NewFromInt(10)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 4 {
+		t.Fatalf("expected 4 results, received %v", len(resp))
+	}
+	for i, expected := range []struct {
+		loss, percent int64
+	}{
+		{120, 12},
+		{40, 4},
+		{0, 0},
+		{-60, -6},
+	} {
+		if !resp[i].Loss.Equal(decimal.NewFromInt(expected.loss)) {
+			t.Errorf("%v expected loss %v, received %v", resp[i].Name, expected.loss, resp[i].Loss)
+		}
+		if !resp[i].LossPercent.Equal(decimal.NewFromInt(expected.percent)) {
+			t.Errorf("%v expected loss percent %v, received %v", resp[i].Name, expected.percent, resp[i].LossPercent)
+		}
+	}
+}
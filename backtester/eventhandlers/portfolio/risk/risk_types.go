@@ -2,6 +2,7 @@ package risk
 
 import (
 	"errors"
+	"time"
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/compliance"
@@ -35,3 +36,24 @@ type CurrencySettings struct {
 	MaxLeverageRate                decimal.Decimal
 	MaximumHoldingRatio            decimal.Decimal
 }
+
+// Shock is a hypothetical percentage change in the price of a currency, eg
+// -20 for a 20% crash. A shock without a currency applies to the base
+// currency of every pair, while a shock to a quote currency, eg USDT
+// depegging, applies to the quote funds held
+type Shock struct {
+	Name          string
+	Currency      currency.Code
+	PercentChange decimal.Decimal
+}
+
+// ShockResult is the hypothetical loss of a holding had a shock occurred at
+// the time, valued in the same currency as the holding's value before the
+// shock. A negative loss is a gain
+type ShockResult struct {
+	Name        string          `json:"name"`
+	Time        time.Time       `json:"time"`
+	TotalValue  decimal.Decimal `json:"total-value"`
+	Loss        decimal.Decimal `json:"loss"`
+	LossPercent decimal.Decimal `json:"loss-percent"`
+}
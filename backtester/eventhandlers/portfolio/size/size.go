@@ -11,8 +11,10 @@ import (
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
 
-// SizeOrder is responsible for ensuring that the order size is within config limits
-func (s *Size) SizeOrder(o order.Event, amountAvailable decimal.Decimal, cs *exchange.Settings) (*order.Order, error) {
+// SizeOrder is responsible for ensuring that the order size is within config limits.
+// Equity is the current value of the portfolio in the order's quote currency,
+// used by rules sizing orders to a percentage of equity
+func (s *Size) SizeOrder(o order.Event, amountAvailable, equity decimal.Decimal, cs *exchange.Settings) (*order.Order, error) {
 	if o == nil || cs == nil {
 		return nil, common.ErrNilArguments
 	}
@@ -28,13 +30,13 @@ func (s *Size) SizeOrder(o order.Event, amountAvailable decimal.Decimal, cs *exc
 	switch retOrder.GetDirection() {
 	case gctorder.Buy:
 		// check size against currency specific settings
-		amount, err = s.calculateBuySize(retOrder.Price, amountAvailable, cs.ExchangeFee, o.GetBuyLimit(), cs.BuySide)
+		amount, err = s.calculateBuySize(retOrder.Price, amountAvailable, equity, cs.ExchangeFee, o.GetBuyLimit(), cs.BuySide)
 		if err != nil {
 			return nil, err
 		}
 		// check size against portfolio specific settings
 		var portfolioSize decimal.Decimal
-		portfolioSize, err = s.calculateBuySize(retOrder.Price, amountAvailable, cs.ExchangeFee, o.GetBuyLimit(), s.BuySide)
+		portfolioSize, err = s.calculateBuySize(retOrder.Price, amountAvailable, equity, cs.ExchangeFee, o.GetBuyLimit(), s.BuySide)
 		if err != nil {
 			return nil, err
 		}
@@ -45,12 +47,12 @@ func (s *Size) SizeOrder(o order.Event, amountAvailable decimal.Decimal, cs *exc
 
 	case gctorder.Sell:
 		// check size against currency specific settings
-		amount, err = s.calculateSellSize(retOrder.Price, amountAvailable, cs.ExchangeFee, o.GetSellLimit(), cs.SellSide)
+		amount, err = s.calculateSellSize(retOrder.Price, amountAvailable, equity, cs.ExchangeFee, o.GetSellLimit(), cs.SellSide)
 		if err != nil {
 			return nil, err
 		}
 		// check size against portfolio specific settings
-		portfolioSize, err := s.calculateSellSize(retOrder.Price, amountAvailable, cs.ExchangeFee, o.GetSellLimit(), s.SellSide)
+		portfolioSize, err := s.calculateSellSize(retOrder.Price, amountAvailable, equity, cs.ExchangeFee, o.GetSellLimit(), s.SellSide)
 		if err != nil {
 			return nil, err
 		}
@@ -72,14 +74,18 @@ func (s *Size) SizeOrder(o order.Event, amountAvailable decimal.Decimal, cs *exc
 // that is allowed to be spent/sold for an event.
 // As fee calculation occurs during the actual ordering process
 // this can only attempt to factor the potential fee to remain under the max rules
-func (s *Size) calculateBuySize(price, availableFunds, feeRate, buyLimit decimal.Decimal, minMaxSettings config.MinMax) (decimal.Decimal, error) {
+func (s *Size) calculateBuySize(price, availableFunds, equity, feeRate, buyLimit decimal.Decimal, minMaxSettings config.MinMax) (decimal.Decimal, error) {
 	if availableFunds.LessThanOrEqual(decimal.Zero) {
 		return decimal.Zero, errNoFunds
 	}
 	if price.IsZero() {
 		return decimal.Zero, nil
 	}
-	amount := availableFunds.Mul(decimal.NewFromInt(1).Sub(feeRate)).Div(price)
+	spend := availableFunds
+	if target := targetNotional(minMaxSettings, equity); target.GreaterThan(decimal.Zero) && target.LessThan(spend) {
+		spend = target
+	}
+	amount := spend.Mul(decimal.NewFromInt(1).Sub(feeRate)).Div(price)
 	if !buyLimit.IsZero() &&
 		buyLimit.GreaterThanOrEqual(minMaxSettings.MinimumSize) &&
 		(buyLimit.LessThanOrEqual(minMaxSettings.MaximumSize) || minMaxSettings.MaximumSize.IsZero()) &&
@@ -104,7 +110,7 @@ func (s *Size) calculateBuySize(price, availableFunds, feeRate, buyLimit decimal
 // eg BTC-USD baseAmount will be BTC to be sold
 // As fee calculation occurs during the actual ordering process
 // this can only attempt to factor the potential fee to remain under the max rules
-func (s *Size) calculateSellSize(price, baseAmount, feeRate, sellLimit decimal.Decimal, minMaxSettings config.MinMax) (decimal.Decimal, error) {
+func (s *Size) calculateSellSize(price, baseAmount, equity, feeRate, sellLimit decimal.Decimal, minMaxSettings config.MinMax) (decimal.Decimal, error) {
 	if baseAmount.LessThanOrEqual(decimal.Zero) {
 		return decimal.Zero, errNoFunds
 	}
@@ -112,7 +118,11 @@ func (s *Size) calculateSellSize(price, baseAmount, feeRate, sellLimit decimal.D
 		return decimal.Zero, nil
 	}
 	oneMFeeRate := decimal.NewFromInt(1).Sub(feeRate)
-	amount := baseAmount.Mul(oneMFeeRate)
+	amount := baseAmount
+	if target := targetNotional(minMaxSettings, equity); target.GreaterThan(decimal.Zero) && target.Div(price).LessThan(amount) {
+		amount = target.Div(price)
+	}
+	amount = amount.Mul(oneMFeeRate)
 	if !sellLimit.IsZero() &&
 		sellLimit.GreaterThanOrEqual(minMaxSettings.MinimumSize) &&
 		(sellLimit.LessThanOrEqual(minMaxSettings.MaximumSize) || minMaxSettings.MaximumSize.IsZero()) &&
@@ -131,3 +141,15 @@ func (s *Size) calculateSellSize(price, baseAmount, feeRate, sellLimit decimal.D
 
 	return amount, nil
 }
+
+// targetNotional returns the quote currency value an order is sized to by
+// the notional size or equity percent rules, or zero when neither is set
+func targetNotional(minMaxSettings config.MinMax, equity decimal.Decimal) decimal.Decimal {
+	if minMaxSettings.NotionalSize.GreaterThan(decimal.Zero) {
+		return minMaxSettings.NotionalSize
+	}
+	if minMaxSettings.EquityPercent.GreaterThan(decimal.Zero) {
+		return equity.Mul(minMaxSettings.EquityPercent).Div(decimal.NewFromInt(100))
+	}
+	return decimal.Zero
+}
@@ -27,7 +27,7 @@ func TestSizingAccuracy(t *testing.T) {
 	availableFunds := decimal.NewFromInt(11)
 	feeRate := decimal.NewFromFloat(0.02)
 	buyLimit := decimal.NewFromInt(1)
-	amountWithoutFee, err := sizer.calculateBuySize(price, availableFunds, feeRate, buyLimit, globalMinMax)
+	amountWithoutFee, err := sizer.calculateBuySize(price, availableFunds, decimal.Zero, feeRate, buyLimit, globalMinMax)
 	if err != nil {
 		t.Error(err)
 	}
@@ -52,7 +52,7 @@ func TestSizingOverMaxSize(t *testing.T) {
 	availableFunds := decimal.NewFromInt(1338)
 	feeRate := decimal.NewFromFloat(0.02)
 	buyLimit := decimal.NewFromInt(1)
-	amount, err := sizer.calculateBuySize(price, availableFunds, feeRate, buyLimit, globalMinMax)
+	amount, err := sizer.calculateBuySize(price, availableFunds, decimal.Zero, feeRate, buyLimit, globalMinMax)
 	if err != nil {
 		t.Error(err)
 	}
@@ -76,7 +76,7 @@ func TestSizingUnderMinSize(t *testing.T) {
 	availableFunds := decimal.NewFromInt(1338)
 	feeRate := decimal.NewFromFloat(0.02)
 	buyLimit := decimal.NewFromInt(1)
-	_, err := sizer.calculateBuySize(price, availableFunds, feeRate, buyLimit, globalMinMax)
+	_, err := sizer.calculateBuySize(price, availableFunds, decimal.Zero, feeRate, buyLimit, globalMinMax)
 	if !errors.Is(err, errLessThanMinimum) {
 		t.Errorf("received: %v, expected: %v", err, errLessThanMinimum)
 	}
@@ -97,7 +97,7 @@ func TestMaximumBuySizeEqualZero(t *testing.T) {
 	availableFunds := decimal.NewFromInt(13380)
 	feeRate := decimal.NewFromFloat(0.02)
 	buyLimit := decimal.NewFromInt(1)
-	amount, err := sizer.calculateBuySize(price, availableFunds, feeRate, buyLimit, globalMinMax)
+	amount, err := sizer.calculateBuySize(price, availableFunds, decimal.Zero, feeRate, buyLimit, globalMinMax)
 	if amount != buyLimit || err != nil {
 		t.Errorf("expected: %v, received %v, err: %+v", buyLimit, amount, err)
 	}
@@ -117,7 +117,7 @@ func TestMaximumSellSizeEqualZero(t *testing.T) {
 	availableFunds := decimal.NewFromInt(13380)
 	feeRate := decimal.NewFromFloat(0.02)
 	sellLimit := decimal.NewFromInt(1)
-	amount, err := sizer.calculateSellSize(price, availableFunds, feeRate, sellLimit, globalMinMax)
+	amount, err := sizer.calculateSellSize(price, availableFunds, decimal.Zero, feeRate, sellLimit, globalMinMax)
 	if amount != sellLimit || err != nil {
 		t.Errorf("expected: %v, received %v, err: %+v", sellLimit, amount, err)
 	}
@@ -138,7 +138,7 @@ func TestSizingErrors(t *testing.T) {
 	availableFunds := decimal.Zero
 	feeRate := decimal.NewFromFloat(0.02)
 	buyLimit := decimal.NewFromInt(1)
-	_, err := sizer.calculateBuySize(price, availableFunds, feeRate, buyLimit, globalMinMax)
+	_, err := sizer.calculateBuySize(price, availableFunds, decimal.Zero, feeRate, buyLimit, globalMinMax)
 	if !errors.Is(err, errNoFunds) {
 		t.Errorf("received: %v, expected: %v", err, errNoFunds)
 	}
@@ -159,18 +159,18 @@ func TestCalculateSellSize(t *testing.T) {
 	availableFunds := decimal.Zero
 	feeRate := decimal.NewFromFloat(0.02)
 	sellLimit := decimal.NewFromInt(1)
-	_, err := sizer.calculateSellSize(price, availableFunds, feeRate, sellLimit, globalMinMax)
+	_, err := sizer.calculateSellSize(price, availableFunds, decimal.Zero, feeRate, sellLimit, globalMinMax)
 	if !errors.Is(err, errNoFunds) {
 		t.Errorf("received: %v, expected: %v", err, errNoFunds)
 	}
 	availableFunds = decimal.NewFromInt(1337)
-	_, err = sizer.calculateSellSize(price, availableFunds, feeRate, sellLimit, globalMinMax)
+	_, err = sizer.calculateSellSize(price, availableFunds, decimal.Zero, feeRate, sellLimit, globalMinMax)
 	if !errors.Is(err, errLessThanMinimum) {
 		t.Errorf("received: %v, expected: %v", err, errLessThanMinimum)
 	}
 	price = decimal.NewFromInt(12)
 	availableFunds = decimal.NewFromInt(1339)
-	_, err = sizer.calculateSellSize(price, availableFunds, feeRate, sellLimit, globalMinMax)
+	_, err = sizer.calculateSellSize(price, availableFunds, decimal.Zero, feeRate, sellLimit, globalMinMax)
 	if err != nil {
 		t.Error(err)
 	}
@@ -179,18 +179,18 @@ func TestCalculateSellSize(t *testing.T) {
 func TestSizeOrder(t *testing.T) {
 	t.Parallel()
 	s := Size{}
-	_, err := s.SizeOrder(nil, decimal.Zero, nil)
+	_, err := s.SizeOrder(nil, decimal.Zero, decimal.Zero, nil)
 	if !errors.Is(err, common.ErrNilArguments) {
 		t.Error(err)
 	}
 	o := &order.Order{}
 	cs := &exchange.Settings{}
-	_, err = s.SizeOrder(o, decimal.Zero, cs)
+	_, err = s.SizeOrder(o, decimal.Zero, decimal.Zero, cs)
 	if !errors.Is(err, errNoFunds) {
 		t.Errorf("received: %v, expected: %v", err, errNoFunds)
 	}
 
-	_, err = s.SizeOrder(o, decimal.NewFromInt(1337), cs)
+	_, err = s.SizeOrder(o, decimal.NewFromInt(1337), decimal.Zero, cs)
 	if !errors.Is(err, errCannotAllocate) {
 		t.Errorf("received: %v, expected: %v", err, errCannotAllocate)
 	}
@@ -199,21 +199,79 @@ func TestSizeOrder(t *testing.T) {
 	o.Price = decimal.NewFromInt(1)
 	s.BuySide.MaximumSize = decimal.NewFromInt(1)
 	s.BuySide.MinimumSize = decimal.NewFromInt(1)
-	_, err = s.SizeOrder(o, decimal.NewFromInt(1337), cs)
+	_, err = s.SizeOrder(o, decimal.NewFromInt(1337), decimal.Zero, cs)
 	if err != nil {
 		t.Error(err)
 	}
 
 	o.Direction = gctorder.Sell
-	_, err = s.SizeOrder(o, decimal.NewFromInt(1337), cs)
+	_, err = s.SizeOrder(o, decimal.NewFromInt(1337), decimal.Zero, cs)
 	if err != nil {
 		t.Error(err)
 	}
 
 	s.SellSide.MaximumSize = decimal.NewFromInt(1)
 	s.SellSide.MinimumSize = decimal.NewFromInt(1)
-	_, err = s.SizeOrder(o, decimal.NewFromInt(1337), cs)
+	_, err = s.SizeOrder(o, decimal.NewFromInt(1337), decimal.Zero, cs)
 	if err != nil {
 		t.Error(err)
 	}
 }
+
+func TestSizingToNotional(t *testing.T) {
+	t.Parallel()
+	sizer := Size{}
+	price := decimal.NewFromInt(100)
+	minMax := config.MinMax{NotionalSize: decimal.NewFromInt(500)}
+	amount, err := sizer.calculateBuySize(price, decimal.NewFromInt(10000), decimal.Zero, decimal.Zero, decimal.Zero, minMax)
+	if err != nil {
+		t.Error(err)
+	}
+	if !amount.Equal(decimal.NewFromInt(5)) {
+		t.Errorf("expected %v received %v", 5, amount)
+	}
+	// the notional cannot exceed available funds
+	amount, err = sizer.calculateBuySize(price, decimal.NewFromInt(200), decimal.Zero, decimal.Zero, decimal.Zero, minMax)
+	if err != nil {
+		t.Error(err)
+	}
+	if !amount.Equal(decimal.NewFromInt(2)) {
+		t.Errorf("expected %v received %v", 2, amount)
+	}
+	amount, err = sizer.calculateSellSize(price, decimal.NewFromInt(10), decimal.Zero, decimal.Zero, decimal.Zero, minMax)
+	if err != nil {
+		t.Error(err)
+	}
+	if !amount.Equal(decimal.NewFromInt(5)) {
+		t.Errorf("expected %v received %v", 5, amount)
+	}
+}
+
+func TestSizingToEquityPercent(t *testing.T) {
+	t.Parallel()
+	sizer := Size{}
+	price := decimal.NewFromInt(100)
+	minMax := config.MinMax{EquityPercent: decimal.NewFromInt(10)}
+	amount, err := sizer.calculateBuySize(price, decimal.NewFromInt(10000), decimal.NewFromInt(20000), decimal.Zero, decimal.Zero, minMax)
+	if err != nil {
+		t.Error(err)
+	}
+	if !amount.Equal(decimal.NewFromInt(20)) {
+		t.Errorf("expected %v received %v", 20, amount)
+	}
+	// sizing follows equity as it changes
+	amount, err = sizer.calculateBuySize(price, decimal.NewFromInt(10000), decimal.NewFromInt(5000), decimal.Zero, decimal.Zero, minMax)
+	if err != nil {
+		t.Error(err)
+	}
+	if !amount.Equal(decimal.NewFromInt(5)) {
+		t.Errorf("expected %v received %v", 5, amount)
+	}
+	amount, err = sizer.calculateSellSize(price, decimal.NewFromInt(3), decimal.NewFromInt(5000), decimal.Zero, decimal.Zero, minMax)
+	if err != nil {
+		t.Error(err)
+	}
+	if !amount.Equal(decimal.NewFromInt(3)) {
+		t.Errorf("expected %v received %v", 3, amount)
+	}
+}
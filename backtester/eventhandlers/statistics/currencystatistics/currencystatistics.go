@@ -1,13 +1,20 @@
 package currencystatistics
 
 import (
+	"encoding/csv"
 	"errors"
 	"fmt"
+	"math"
+	"os"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/risk"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics/regime"
 	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
 	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
 	gctmath "github.com/thrasher-corp/gocryptotrader/common/math"
@@ -21,7 +28,6 @@ import (
 // CalculateResults calculates all statistics for the exchange, asset, currency pair
 func (c *CurrencyStatistic) CalculateResults(f funding.IPairReader) error {
 	var errs gctcommon.Errors
-	var err error
 	first := c.Events[0]
 	sep := fmt.Sprintf("%v %v %v |\t", first.DataEvent.GetExchange(), first.DataEvent.GetAssetType(), first.DataEvent.Pair())
 
@@ -45,29 +51,38 @@ func (c *CurrencyStatistic) CalculateResults(f funding.IPairReader) error {
 		}
 	}
 
+	events, err := c.valuedEvents()
+	if err != nil {
+		return err
+	}
+	first = events[0]
+	last = events[len(events)-1]
+	firstPrice = first.DataEvent.ClosePrice()
+	lastPrice = last.DataEvent.ClosePrice()
+
 	oneHundred := decimal.NewFromInt(100)
 	c.MarketMovement = lastPrice.Sub(firstPrice).Div(firstPrice).Mul(oneHundred)
 	if first.Holdings.TotalValue.GreaterThan(decimal.Zero) {
 		c.StrategyMovement = last.Holdings.TotalValue.Sub(first.Holdings.TotalValue).Div(first.Holdings.TotalValue).Mul(oneHundred)
 	}
-	c.calculateHighestCommittedFunds()
+	c.calculateHighestCommittedFunds(events)
 	c.RiskFreeRate = last.Holdings.RiskFreeRate.Mul(oneHundred)
-	returnPerCandle := make([]decimal.Decimal, len(c.Events))
-	benchmarkRates := make([]decimal.Decimal, len(c.Events))
+	returnPerCandle := make([]decimal.Decimal, len(events))
+	benchmarkRates := make([]decimal.Decimal, len(events))
 
 	var allDataEvents []common.DataEventHandler
-	for i := range c.Events {
-		returnPerCandle[i] = c.Events[i].Holdings.ChangeInTotalValuePercent
-		allDataEvents = append(allDataEvents, c.Events[i].DataEvent)
+	for i := range events {
+		returnPerCandle[i] = events[i].Holdings.ChangeInTotalValuePercent
+		allDataEvents = append(allDataEvents, events[i].DataEvent)
 		if i == 0 {
 			continue
 		}
-		if c.Events[i].SignalEvent != nil && c.Events[i].SignalEvent.GetDirection() == common.MissingData {
+		if events[i].SignalEvent != nil && events[i].SignalEvent.GetDirection() == common.MissingData {
 			c.ShowMissingDataWarning = true
 		}
-		benchmarkRates[i] = c.Events[i].DataEvent.ClosePrice().Sub(
-			c.Events[i-1].DataEvent.ClosePrice()).Div(
-			c.Events[i-1].DataEvent.ClosePrice())
+		benchmarkRates[i] = events[i].DataEvent.ClosePrice().Sub(
+			events[i-1].DataEvent.ClosePrice()).Div(
+			events[i-1].DataEvent.ClosePrice())
 	}
 
 	// remove the first entry as its zero and impacts
@@ -91,6 +106,16 @@ func (c *CurrencyStatistic) CalculateResults(f funding.IPairReader) error {
 
 	riskFreeRatePerCandle := first.Holdings.RiskFreeRate.Div(decimal.NewFromFloat(intervalsPerYear))
 	riskFreeRateForPeriod := riskFreeRatePerCandle.Mul(decimal.NewFromInt(int64(len(benchmarkRates))))
+	// ratioReturns, ratioRiskFreeRate and ratioOffset allow the sharpe and
+	// sortino ratios to use either a constant rate or the rate of each
+	// candle's period, the latter being subtracted from each return
+	ratioReturns, ratioRiskFreeRate, ratioOffset := returnPerCandle, riskFreeRatePerCandle, decimal.Zero
+	if len(c.RiskFreeRateCurve) > 0 {
+		ratioReturns, riskFreeRateForPeriod = c.RiskFreeRateCurve.excessReturns(events[1:], returnPerCandle, decimal.NewFromFloat(intervalsPerYear))
+		riskFreeRatePerCandle = riskFreeRateForPeriod.Div(decimal.NewFromInt(int64(len(returnPerCandle))))
+		ratioRiskFreeRate, ratioOffset = decimal.Zero, riskFreeRatePerCandle
+		c.RiskFreeRate = riskFreeRatePerCandle.Mul(decimal.NewFromFloat(intervalsPerYear)).Mul(oneHundred)
+	}
 
 	var arithmeticReturnsPerCandle, geometricReturnsPerCandle, arithmeticSharpe, arithmeticSortino,
 		arithmeticInformation, arithmeticCalmar, geomSharpe, geomSortino, geomInformation, geomCalmar decimal.Decimal
@@ -104,11 +129,11 @@ func (c *CurrencyStatistic) CalculateResults(f funding.IPairReader) error {
 		errs = append(errs, err)
 	}
 
-	arithmeticSharpe, err = gctmath.DecimalSharpeRatio(returnPerCandle, riskFreeRatePerCandle, arithmeticReturnsPerCandle)
+	arithmeticSharpe, err = gctmath.DecimalSharpeRatio(ratioReturns, ratioRiskFreeRate, arithmeticReturnsPerCandle.Sub(ratioOffset))
 	if err != nil {
 		errs = append(errs, err)
 	}
-	arithmeticSortino, err = gctmath.DecimalSortinoRatio(returnPerCandle, riskFreeRatePerCandle, arithmeticReturnsPerCandle)
+	arithmeticSortino, err = gctmath.DecimalSortinoRatio(ratioReturns, ratioRiskFreeRate, arithmeticReturnsPerCandle.Sub(ratioOffset))
 	if err != nil && !errors.Is(err, gctmath.ErrNoNegativeResults) {
 		if errors.Is(err, gctmath.ErrInexactConversion) {
 			log.Warnf(log.BackTester, "%v arithmetic sortino ratio %v", sep, err)
@@ -141,11 +166,11 @@ func (c *CurrencyStatistic) CalculateResults(f funding.IPairReader) error {
 		c.ArithmeticRatios.CalmarRatio = arithmeticCalmar
 	}
 
-	geomSharpe, err = gctmath.DecimalSharpeRatio(returnPerCandle, riskFreeRatePerCandle, geometricReturnsPerCandle)
+	geomSharpe, err = gctmath.DecimalSharpeRatio(ratioReturns, ratioRiskFreeRate, geometricReturnsPerCandle.Sub(ratioOffset))
 	if err != nil {
 		errs = append(errs, err)
 	}
-	geomSortino, err = gctmath.DecimalSortinoRatio(returnPerCandle, riskFreeRatePerCandle, geometricReturnsPerCandle)
+	geomSortino, err = gctmath.DecimalSortinoRatio(ratioReturns, ratioRiskFreeRate, geometricReturnsPerCandle.Sub(ratioOffset))
 	if err != nil && !errors.Is(err, gctmath.ErrNoNegativeResults) {
 		if errors.Is(err, gctmath.ErrInexactConversion) {
 			log.Warnf(log.BackTester, "%v geometric sortino ratio %v", sep, err)
@@ -180,7 +205,7 @@ func (c *CurrencyStatistic) CalculateResults(f funding.IPairReader) error {
 			last.Holdings.QuoteInitialFunds,
 			last.Holdings.TotalValue,
 			decimal.NewFromFloat(intervalsPerYear),
-			decimal.NewFromInt(int64(len(c.Events))),
+			decimal.NewFromInt(int64(len(events))),
 		)
 		if err != nil {
 			errs = append(errs, err)
@@ -189,6 +214,37 @@ func (c *CurrencyStatistic) CalculateResults(f funding.IPairReader) error {
 			c.CompoundAnnualGrowthRate = cagr
 		}
 	}
+	c.CalendarReturns = calculateCalendarReturns(events)
+	c.DrawdownEpisodes = calculateDrawdownEpisodes(events, c.DrawdownEpisodeThreshold)
+	c.RoundTrips = calculateRoundTrips(c.Events)
+	if len(c.RoundTrips) > 0 {
+		adverse := make([]decimal.Decimal, len(c.RoundTrips))
+		favourable := make([]decimal.Decimal, len(c.RoundTrips))
+		for i := range c.RoundTrips {
+			adverse[i] = c.RoundTrips[i].MaximumAdverseExcursion
+			favourable[i] = c.RoundTrips[i].MaximumFavourableExcursion
+		}
+		c.AdverseExcursions = calculateExcursionDistribution(adverse)
+		c.FavourableExcursions = calculateExcursionDistribution(favourable)
+	}
+	c.Turnover = calculateTurnover(events)
+	c.MonthlyProfits = calculateMonthlyProfits(events)
+	c.EquityScaling = calculateEquityScaling(c.Events)
+	c.StressTests, c.PeriodicStressTests, err = calculateStressTests(events, c.StressTestShocks, c.StressTestInterval)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	if c.RegimeClassifier != nil {
+		var labels []string
+		labels, err = c.RegimeClassifier.Classify(allDataEvents)
+		if err != nil {
+			errs = append(errs, err)
+		} else {
+			c.RegimeStatistics = calculateRegimeStatistics(events, labels)
+			c.RegimePeriods = calculateRegimePeriods(events, labels)
+		}
+	}
+	c.ReturnDistribution = calculateReturnDistribution(returnPerCandle)
 	c.IsStrategyProfitable = last.Holdings.TotalValue.GreaterThan(first.Holdings.TotalValue)
 	c.DoesPerformanceBeatTheMarket = c.StrategyMovement.GreaterThan(c.MarketMovement)
 	if len(errs) > 0 {
@@ -214,6 +270,12 @@ func (c *CurrencyStatistic) PrintResults(e string, a asset.Item, p currency.Pair
 	log.Infof(log.BackTester, currStr[:61])
 	log.Infof(log.BackTester, "%s Initial base funds: %v", sep, f.BaseInitialFunds())
 	log.Infof(log.BackTester, "%s Initial base quote: %v", sep, f.QuoteInitialFunds())
+	if !c.ValuationCurrency.IsEmpty() {
+		log.Infof(log.BackTester, "%s Returns, drawdowns and ratios valued in %v via %v", sep, c.ValuationCurrency, c.ConversionPair)
+	}
+	if c.IsTerminated {
+		log.Warnf(log.BackTester, "%s Data ended at %v before the end of the run, positions were closed at the final candle", sep, c.TerminatedAt)
+	}
 	log.Infof(log.BackTester, "%s Highest committed funds: %v at %v\n\n", sep, c.HighestCommittedFunds.Value.Round(8), c.HighestCommittedFunds.Time)
 
 	log.Infof(log.BackTester, "%s Buy orders: %d", sep, c.BuyOrders)
@@ -274,6 +336,130 @@ func (c *CurrencyStatistic) PrintResults(e string, a asset.Item, p currency.Pair
 	log.Infof(log.BackTester, "%s Strategy movement: %v%%", sep, c.StrategyMovement.Round(2))
 	log.Infof(log.BackTester, "%s Did it beat the market: %v", sep, c.StrategyMovement.GreaterThan(c.MarketMovement))
 
+	if len(c.CalendarReturns) > 0 {
+		log.Info(log.BackTester, "------------------Calendar Returns---------------------------")
+		for i := range c.CalendarReturns {
+			months := make([]string, 0, len(c.CalendarReturns[i].Months))
+			for j := range c.CalendarReturns[i].Months {
+				if c.CalendarReturns[i].Months[j] == nil {
+					continue
+				}
+				months = append(months, fmt.Sprintf("%v: %v%%", time.Month(j + 1).String()[:3], c.CalendarReturns[i].Months[j].Round(2)))
+			}
+			annual := "-"
+			if c.CalendarReturns[i].Annual != nil {
+				annual = c.CalendarReturns[i].Annual.Round(2).String() + "%"
+			}
+			log.Infof(log.BackTester, "%s %v: %v | %v", sep, c.CalendarReturns[i].Year, annual, strings.Join(months, " "))
+		}
+		log.Info(log.BackTester, "")
+	}
+
+	if len(c.DrawdownEpisodes) > 0 {
+		log.Info(log.BackTester, "------------------Drawdown Episodes--------------------------")
+		for i := range c.DrawdownEpisodes {
+			recovery := "not recovered"
+			if c.DrawdownEpisodes[i].IsRecovered {
+				recovery = fmt.Sprintf("recovered at %v after %d candles", c.DrawdownEpisodes[i].RecoveredAt, c.DrawdownEpisodes[i].RecoveryIntervals)
+			}
+			log.Infof(log.BackTester, "%s %v%% from %v to %v, %d candles underwater, %v",
+				sep,
+				c.DrawdownEpisodes[i].DrawdownPercent.Round(2),
+				c.DrawdownEpisodes[i].Peak.Time,
+				c.DrawdownEpisodes[i].Trough.Time,
+				c.DrawdownEpisodes[i].DurationIntervals,
+				recovery)
+		}
+		log.Info(log.BackTester, "")
+	}
+
+	if c.AdverseExcursions != nil && c.FavourableExcursions != nil {
+		log.Info(log.BackTester, "------------------Excursions---------------------------------")
+		log.Infof(log.BackTester, "%s Round trips: %d", sep, len(c.RoundTrips))
+		for _, d := range []struct {
+			name         string
+			distribution *ExcursionDistribution
+		}{
+			{"Maximum adverse excursion", c.AdverseExcursions},
+			{"Maximum favourable excursion", c.FavourableExcursions},
+		} {
+			log.Infof(log.BackTester, "%s %v: min %v%% 25th %v%% median %v%% 75th %v%% 90th %v%% max %v%% mean %v%%",
+				sep,
+				d.name,
+				d.distribution.Minimum.Round(2),
+				d.distribution.LowerQuartile.Round(2),
+				d.distribution.Median.Round(2),
+				d.distribution.UpperQuartile.Round(2),
+				d.distribution.NinetiethPercentile.Round(2),
+				d.distribution.Maximum.Round(2),
+				d.distribution.Mean.Round(2))
+		}
+		log.Info(log.BackTester, "")
+	}
+
+	if len(c.StressTests) > 0 {
+		log.Info(log.BackTester, "------------------Stress Tests-------------------------------")
+		for i := range c.StressTests {
+			log.Infof(log.BackTester, "%s %v: final loss %v (%v%%)",
+				sep,
+				c.StressTests[i].Name,
+				c.StressTests[i].Loss.Round(2),
+				c.StressTests[i].LossPercent.Round(2))
+			if worst := c.WorstStressTest(c.StressTests[i].Name); worst != nil {
+				log.Infof(log.BackTester, "%s %v: worst periodic loss %v (%v%%) at %v",
+					sep,
+					worst.Name,
+					worst.Loss.Round(2),
+					worst.LossPercent.Round(2),
+					worst.Time)
+			}
+		}
+		log.Info(log.BackTester, "")
+	}
+
+	if c.ReturnDistribution != nil {
+		log.Info(log.BackTester, "------------------Return Distribution------------------------")
+		log.Infof(log.BackTester, "%s Returns per candle: mean %v%% standard deviation %v%% over %d candles", sep, c.ReturnDistribution.Mean.Round(4), c.ReturnDistribution.StandardDeviation.Round(4), c.ReturnDistribution.Candles)
+		log.Infof(log.BackTester, "%s Range: %v%% to %v%%", sep, c.ReturnDistribution.Minimum.Round(4), c.ReturnDistribution.Maximum.Round(4))
+		log.Infof(log.BackTester, "%s Skewness: %v", sep, c.ReturnDistribution.Skewness.Round(4))
+		log.Infof(log.BackTester, "%s Excess kurtosis: %v\n\n", sep, c.ReturnDistribution.ExcessKurtosis.Round(4))
+	}
+
+	if len(c.RegimeStatistics) > 0 {
+		log.Info(log.BackTester, "------------------Market Regimes-----------------------------")
+		for i := range c.RegimeStatistics {
+			log.Infof(log.BackTester, "%s %v: %d candles (%v%%) over %d periods, strategy return %v%%, market return %v%%, win rate %v%% over %d orders",
+				sep,
+				c.RegimeStatistics[i].Regime,
+				c.RegimeStatistics[i].Candles,
+				c.RegimeStatistics[i].CandlesPercent.Round(2),
+				c.RegimeStatistics[i].Periods,
+				c.RegimeStatistics[i].StrategyReturn.Round(2),
+				c.RegimeStatistics[i].MarketReturn.Round(2),
+				c.RegimeStatistics[i].WinRate.Round(2),
+				c.RegimeStatistics[i].Orders)
+		}
+		log.Info(log.BackTester, "")
+	}
+
+	if c.Turnover != nil {
+		log.Info(log.BackTester, "------------------Turnover-----------------------------------")
+		log.Infof(log.BackTester, "%s Traded volume: %v over %d orders", sep, c.Turnover.TradedVolume.Round(8), c.Turnover.Orders)
+		log.Infof(log.BackTester, "%s Average equity: %v", sep, c.Turnover.AverageEquity.Round(8))
+		log.Infof(log.BackTester, "%s Turnover ratio: %v", sep, c.Turnover.TurnoverRatio.Round(4))
+		log.Infof(log.BackTester, "%s Exposure: average %v%% maximum %v%%", sep, c.Turnover.AverageExposurePercent.Round(2), c.Turnover.MaximumExposurePercent.Round(2))
+		log.Infof(log.BackTester, "%s Average fee: %v", sep, c.Turnover.AverageFee.Round(8))
+		log.Infof(log.BackTester, "%s Fees as a percentage of profit before fees: %v%%\n\n", sep, c.Turnover.FeesPercentOfProfit.Round(2))
+	}
+
+	if c.EquityScaling != nil {
+		log.Info(log.BackTester, "------------------Equity Scaling-----------------------------")
+		log.Infof(log.BackTester, "%s Orders scaled: %d of %d", sep, c.EquityScaling.ScaledOrders, c.EquityScaling.Orders)
+		log.Infof(log.BackTester, "%s Scale: average %v%% minimum %v%%", sep, c.EquityScaling.AverageScale.Round(2), c.EquityScaling.MinimumScale.Round(2))
+		log.Infof(log.BackTester, "%s Value ordered: %v of %v unscaled", sep, c.EquityScaling.ScaledValue.Round(8), c.EquityScaling.UnscaledValue.Round(8))
+		log.Infof(log.BackTester, "%s Value reduced by scaling: %v\n\n", sep, c.EquityScaling.ReducedValue.Round(8))
+	}
+
 	log.Infof(log.BackTester, "%s Value lost to volume sizing: %v", sep, last.Holdings.TotalValueLostToVolumeSizing.Round(2))
 	log.Infof(log.BackTester, "%s Value lost to slippage: %v", sep, last.Holdings.TotalValueLostToSlippage.Round(2))
 	log.Infof(log.BackTester, "%s Total Value lost: %v", sep, last.Holdings.TotalValueLost.Round(2))
@@ -387,11 +573,784 @@ func calculateMaxDrawdown(closePrices []common.DataEventHandler) Swing {
 	return maxDrawdown
 }
 
-func (c *CurrencyStatistic) calculateHighestCommittedFunds() {
+func (c *CurrencyStatistic) calculateHighestCommittedFunds(events []EventStore) {
+	for i := range events {
+		if events[i].Holdings.BaseSize.Mul(events[i].DataEvent.ClosePrice()).GreaterThan(c.HighestCommittedFunds.Value) {
+			c.HighestCommittedFunds.Value = events[i].Holdings.BaseSize.Mul(events[i].DataEvent.ClosePrice())
+			c.HighestCommittedFunds.Time = events[i].Holdings.Timestamp
+		}
+	}
+}
+
+// SetConversion sets the pair and its prices used to value a pair which is
+// quoted in a cryptocurrency, such as ETH/BTC, in the quote currency of the
+// conversion pair, such as BTC/USDT. This allows returns and drawdowns of
+// pairs with different quote currencies to be compared
+func (c *CurrencyStatistic) SetConversion(conversionPair currency.Pair, events []common.DataEventHandler) error {
+	if len(c.Events) == 0 || c.Events[0].DataEvent == nil {
+		return errNoDataEvents
+	}
+	quote := c.Events[0].DataEvent.Pair().Quote
+	if !conversionPair.Base.Match(quote) {
+		return fmt.Errorf("%w %v, received %v", errInvalidConversionPair, quote, conversionPair)
+	}
+	if len(events) == 0 {
+		return fmt.Errorf("%w for %v", errNoConversionRate, conversionPair)
+	}
+	c.ConversionPair = conversionPair
+	c.ValuationCurrency = conversionPair.Quote
+	c.ConversionEvents = events
+	return nil
+}
+
+// valuedEvents returns copies of the events with prices and holdings valued in
+// the valuation currency. Events are returned as is when no conversion is set
+func (c *CurrencyStatistic) valuedEvents() ([]EventStore, error) {
+	if len(c.ConversionEvents) == 0 {
+		return c.Events, nil
+	}
+	rates := make([]common.DataEventHandler, len(c.ConversionEvents))
+	copy(rates, c.ConversionEvents)
+	sort.Slice(rates, func(i, j int) bool {
+		return rates[i].GetTime().Before(rates[j].GetTime())
+	})
+
+	events := make([]EventStore, len(c.Events))
+	var initialRate decimal.Decimal
 	for i := range c.Events {
-		if c.Events[i].Holdings.BaseSize.Mul(c.Events[i].DataEvent.ClosePrice()).GreaterThan(c.HighestCommittedFunds.Value) {
-			c.HighestCommittedFunds.Value = c.Events[i].Holdings.BaseSize.Mul(c.Events[i].DataEvent.ClosePrice())
-			c.HighestCommittedFunds.Time = c.Events[i].Holdings.Timestamp
+		t := c.Events[i].DataEvent.GetTime()
+		// use the latest rate at or before the event's time
+		j := sort.Search(len(rates), func(k int) bool {
+			return rates[k].GetTime().After(t)
+		}) - 1
+		if j < 0 || !rates[j].ClosePrice().IsPositive() {
+			return nil, fmt.Errorf("%w for %v at %v", errNoConversionRate, c.ConversionPair, t)
+		}
+		rate := rates[j].ClosePrice()
+		if i == 0 {
+			initialRate = rate
+		}
+		events[i] = c.Events[i]
+		events[i].DataEvent = &convertedEvent{DataEventHandler: c.Events[i].DataEvent, rate: rate}
+		h := &events[i].Holdings
+		h.QuoteInitialFunds = h.QuoteInitialFunds.Mul(initialRate)
+		h.QuoteSize = h.QuoteSize.Mul(rate)
+		h.BaseValue = h.BaseValue.Mul(rate)
+		h.TotalValue = h.TotalValue.Mul(rate)
+		h.ChangeInTotalValuePercent = decimal.Zero
+		if i > 0 && events[i-1].Holdings.TotalValue.IsPositive() {
+			prev := events[i-1].Holdings.TotalValue
+			h.ChangeInTotalValuePercent = h.TotalValue.Sub(prev).Div(prev)
 		}
 	}
+	return events, nil
+}
+
+// ClosePrice returns the close price in the valuation currency
+func (e *convertedEvent) ClosePrice() decimal.Decimal {
+	return e.DataEventHandler.ClosePrice().Mul(e.rate)
+}
+
+// HighPrice returns the high price in the valuation currency
+func (e *convertedEvent) HighPrice() decimal.Decimal {
+	return e.DataEventHandler.HighPrice().Mul(e.rate)
+}
+
+// LowPrice returns the low price in the valuation currency
+func (e *convertedEvent) LowPrice() decimal.Decimal {
+	return e.DataEventHandler.LowPrice().Mul(e.rate)
+}
+
+// OpenPrice returns the open price in the valuation currency
+func (e *convertedEvent) OpenPrice() decimal.Decimal {
+	return e.DataEventHandler.OpenPrice().Mul(e.rate)
+}
+
+// calculateTurnover calculates the traded volume, equity, exposure and fees
+// of valued events. Holdings track volume and fees cumulatively in the quote
+// currency, so each event's change is valued at that event's conversion rate
+func calculateTurnover(events []EventStore) *Turnover {
+	if len(events) == 0 {
+		return nil
+	}
+	t := &Turnover{}
+	oneHundred := decimal.NewFromInt(100)
+	var totalEquity, totalExposure, exposurePercentSum decimal.Decimal
+	var exposureEvents int64
+	var previous holdings.Holding
+	for i := range events {
+		h := events[i].Holdings
+		rate := decimal.NewFromInt(1)
+		if converted, ok := events[i].DataEvent.(*convertedEvent); ok {
+			rate = converted.rate
+		}
+		volume := h.BoughtValue.Sub(previous.BoughtValue).Add(h.SoldValue.Sub(previous.SoldValue))
+		t.TradedVolume = t.TradedVolume.Add(volume.Mul(rate))
+		t.TotalFees = t.TotalFees.Add(h.TotalFees.Sub(previous.TotalFees).Mul(rate))
+		previous = events[i].Holdings
+		if events[i].FillEvent != nil &&
+			(events[i].FillEvent.GetDirection() == gctorder.Buy || events[i].FillEvent.GetDirection() == gctorder.Sell) {
+			t.Orders++
+		}
+
+		totalEquity = totalEquity.Add(h.TotalValue)
+		totalExposure = totalExposure.Add(h.BaseValue)
+		if h.TotalValue.IsPositive() {
+			exposure := h.BaseValue.Div(h.TotalValue).Mul(oneHundred)
+			exposurePercentSum = exposurePercentSum.Add(exposure)
+			exposureEvents++
+			if exposure.GreaterThan(t.MaximumExposurePercent) {
+				t.MaximumExposurePercent = exposure
+			}
+		}
+	}
+	count := decimal.NewFromInt(int64(len(events)))
+	t.AverageEquity = totalEquity.Div(count)
+	t.AverageExposure = totalExposure.Div(count)
+	if exposureEvents > 0 {
+		t.AverageExposurePercent = exposurePercentSum.Div(decimal.NewFromInt(exposureEvents))
+	}
+	t.ProfitBeforeFees = events[len(events)-1].Holdings.TotalValue.Sub(events[0].Holdings.TotalValue).Add(t.TotalFees)
+	t.setRatios()
+	return t
+}
+
+// calculateEquityScaling summarises the scale equity scaling applied to
+// orders and the value it removed from them, returning nil when no orders
+// were scaled
+func calculateEquityScaling(events []EventStore) *EquityScaling {
+	var es *EquityScaling
+	var scaleSum decimal.Decimal
+	oneHundred := decimal.NewFromInt(100)
+	for i := range events {
+		o := events[i].OrderEvent
+		if o == nil || !o.GetUnscaledAmount().IsPositive() {
+			continue
+		}
+		if es == nil {
+			es = &EquityScaling{MinimumScale: oneHundred}
+		}
+		scale := o.GetEquityScale().Mul(oneHundred)
+		es.Orders++
+		if scale.LessThan(oneHundred) {
+			es.ScaledOrders++
+		}
+		if scale.LessThan(es.MinimumScale) {
+			es.MinimumScale = scale
+		}
+		scaleSum = scaleSum.Add(scale)
+		es.UnscaledValue = es.UnscaledValue.Add(o.GetUnscaledAmount().Mul(o.GetPrice()))
+		es.ScaledValue = es.ScaledValue.Add(o.GetAmount().Mul(o.GetPrice()))
+	}
+	if es == nil {
+		return nil
+	}
+	es.AverageScale = scaleSum.Div(decimal.NewFromInt(es.Orders))
+	es.ReducedValue = es.UnscaledValue.Sub(es.ScaledValue)
+	return es
+}
+
+// setRatios sets the turnover ratio, average fee and fees as a percentage of
+// profit from the totals
+func (t *Turnover) setRatios() {
+	if t.AverageEquity.IsPositive() {
+		t.TurnoverRatio = t.TradedVolume.Div(t.AverageEquity)
+	}
+	if t.Orders > 0 {
+		t.AverageFee = t.TotalFees.Div(decimal.NewFromInt(t.Orders))
+	}
+	if t.ProfitBeforeFees.IsPositive() {
+		t.FeesPercentOfProfit = t.TotalFees.Div(t.ProfitBeforeFees).Mul(decimal.NewFromInt(100))
+	}
+}
+
+// CombineTurnover combines the turnover of pairs valued in the same currency
+// into portfolio-wide turnover. Averages of equity and exposure are summed,
+// treating the pairs as held alongside each other
+func CombineTurnover(turnovers []*Turnover) *Turnover {
+	combined := &Turnover{}
+	for i := range turnovers {
+		if turnovers[i] == nil {
+			continue
+		}
+		combined.Orders += turnovers[i].Orders
+		combined.TradedVolume = combined.TradedVolume.Add(turnovers[i].TradedVolume)
+		combined.AverageEquity = combined.AverageEquity.Add(turnovers[i].AverageEquity)
+		combined.AverageExposure = combined.AverageExposure.Add(turnovers[i].AverageExposure)
+		combined.TotalFees = combined.TotalFees.Add(turnovers[i].TotalFees)
+		combined.ProfitBeforeFees = combined.ProfitBeforeFees.Add(turnovers[i].ProfitBeforeFees)
+	}
+	if combined.AverageEquity.IsPositive() {
+		combined.AverageExposurePercent = combined.AverageExposure.Div(combined.AverageEquity).Mul(decimal.NewFromInt(100))
+	}
+	combined.setRatios()
+	return combined
+}
+
+// calculateCalendarReturns calculates the percentage change in total value
+// for each calendar month and year of the run in UTC. Each period's return is
+// measured from the final total value of the previous period, or from the
+// first total value of the run for the first period
+func calculateCalendarReturns(events []EventStore) []YearlyReturns {
+	if len(events) == 0 {
+		return nil
+	}
+	oneHundred := decimal.NewFromInt(100)
+	change := func(from, to decimal.Decimal) *decimal.Decimal {
+		if !from.IsPositive() {
+			return nil
+		}
+		resp := to.Sub(from).Div(from).Mul(oneHundred)
+		return &resp
+	}
+	var resp []YearlyReturns
+	current := events[0].DataEvent.GetTime().UTC()
+	monthStart := events[0].Holdings.TotalValue
+	yearStart := monthStart
+	lastValue := monthStart
+	year := YearlyReturns{Year: current.Year()}
+	for i := range events {
+		t := events[i].DataEvent.GetTime().UTC()
+		if t.Year() != current.Year() || t.Month() != current.Month() {
+			year.Months[current.Month()-1] = change(monthStart, lastValue)
+			monthStart = lastValue
+			if t.Year() != current.Year() {
+				year.Annual = change(yearStart, lastValue)
+				resp = append(resp, year)
+				year = YearlyReturns{Year: t.Year()}
+				yearStart = lastValue
+			}
+			current = t
+		}
+		lastValue = events[i].Holdings.TotalValue
+	}
+	year.Months[current.Month()-1] = change(monthStart, lastValue)
+	year.Annual = change(yearStart, lastValue)
+	return append(resp, year)
+}
+
+// calculateRegimeStatistics summarises the returns and orders of valued
+// events by the regime each event's candle is labelled with. Unclassified
+// candles and the first candle, which has no return, are excluded
+func calculateRegimeStatistics(events []EventStore, labels []string) []RegimeStatistic {
+	if len(events) != len(labels) {
+		return nil
+	}
+	type regimeTotals struct {
+		candles, periods, wins, orders int64
+		strategyGrowth, marketGrowth   decimal.Decimal
+		returnSum                      decimal.Decimal
+	}
+	one := decimal.NewFromInt(1)
+	oneHundred := decimal.NewFromInt(100)
+	totals := make(map[string]*regimeTotals)
+	var classified int64
+	for i := 1; i < len(events); i++ {
+		if labels[i] == regime.Unclassified {
+			continue
+		}
+		t, ok := totals[labels[i]]
+		if !ok {
+			t = &regimeTotals{strategyGrowth: one, marketGrowth: one}
+			totals[labels[i]] = t
+		}
+		if i == 1 || labels[i] != labels[i-1] {
+			t.periods++
+		}
+		t.candles++
+		classified++
+		if prev := events[i-1].Holdings.TotalValue; prev.IsPositive() {
+			r := events[i].Holdings.TotalValue.Sub(prev).Div(prev)
+			t.strategyGrowth = t.strategyGrowth.Mul(one.Add(r))
+			t.returnSum = t.returnSum.Add(r)
+			if r.IsPositive() {
+				t.wins++
+			}
+		}
+		if prev := events[i-1].DataEvent.ClosePrice(); prev.IsPositive() {
+			t.marketGrowth = t.marketGrowth.Mul(events[i].DataEvent.ClosePrice().Div(prev))
+		}
+		if events[i].FillEvent != nil &&
+			(events[i].FillEvent.GetDirection() == gctorder.Buy || events[i].FillEvent.GetDirection() == gctorder.Sell) {
+			t.orders++
+		}
+	}
+	var resp []RegimeStatistic
+	regimes := regime.Regimes()
+	for i := range regimes {
+		t, ok := totals[regimes[i]]
+		if !ok {
+			continue
+		}
+		candles := decimal.NewFromInt(t.candles)
+		resp = append(resp, RegimeStatistic{
+			Regime:         regimes[i],
+			Candles:        t.candles,
+			CandlesPercent: candles.Div(decimal.NewFromInt(classified)).Mul(oneHundred),
+			Periods:        t.periods,
+			StrategyReturn: t.strategyGrowth.Sub(one).Mul(oneHundred),
+			MarketReturn:   t.marketGrowth.Sub(one).Mul(oneHundred),
+			AverageReturn:  t.returnSum.Div(candles).Mul(oneHundred),
+			WinRate:        decimal.NewFromInt(t.wins).Div(candles).Mul(oneHundred),
+			Orders:         t.orders,
+		})
+	}
+	return resp
+}
+
+// calculateRegimePeriods groups consecutive events labelled with the same
+// regime into periods, excluding unclassified candles
+func calculateRegimePeriods(events []EventStore, labels []string) []RegimePeriod {
+	if len(events) != len(labels) {
+		return nil
+	}
+	var resp []RegimePeriod
+	for i := range events {
+		if labels[i] == regime.Unclassified {
+			continue
+		}
+		t := events[i].DataEvent.GetTime()
+		if i > 0 && labels[i] == labels[i-1] {
+			resp[len(resp)-1].End = t
+			resp[len(resp)-1].Candles++
+			continue
+		}
+		resp = append(resp, RegimePeriod{
+			Regime:  labels[i],
+			Start:   t,
+			End:     t,
+			Candles: 1,
+		})
+	}
+	return resp
+}
+
+// calculateMonthlyProfits calculates the profit and fees of valued events for
+// each calendar month of the run in UTC. As with turnover, each event's fees
+// are valued at that event's conversion rate
+func calculateMonthlyProfits(events []EventStore) []PeriodProfit {
+	if len(events) == 0 {
+		return nil
+	}
+	monthOf := func(t time.Time) time.Time {
+		t = t.UTC()
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+	var resp []PeriodProfit
+	current := PeriodProfit{
+		Start:      monthOf(events[0].DataEvent.GetTime()),
+		StartValue: events[0].Holdings.TotalValue,
+	}
+	lastValue := current.StartValue
+	var previousFees decimal.Decimal
+	for i := range events {
+		month := monthOf(events[i].DataEvent.GetTime())
+		if !month.Equal(current.Start) {
+			current.Profit = lastValue.Sub(current.StartValue)
+			resp = append(resp, current)
+			current = PeriodProfit{Start: month, StartValue: lastValue}
+		}
+		rate := decimal.NewFromInt(1)
+		if converted, ok := events[i].DataEvent.(*convertedEvent); ok {
+			rate = converted.rate
+		}
+		current.Fees = current.Fees.Add(events[i].Holdings.TotalFees.Sub(previousFees).Mul(rate))
+		previousFees = events[i].Holdings.TotalFees
+		lastValue = events[i].Holdings.TotalValue
+	}
+	current.Profit = lastValue.Sub(current.StartValue)
+	return append(resp, current)
+}
+
+// calculateDrawdownEpisodes records each period the total value spent below
+// its previous peak where the drawdown is at least the threshold percentage.
+// Episodes are sorted from the deepest drawdown
+func calculateDrawdownEpisodes(events []EventStore, threshold decimal.Decimal) []DrawdownEpisode {
+	if len(events) == 0 {
+		return nil
+	}
+	var resp []DrawdownEpisode
+	oneHundred := decimal.NewFromInt(100)
+	peakIndex, troughIndex := 0, 0
+	record := func(end int, recovered bool) {
+		peak := events[peakIndex].Holdings.TotalValue
+		if troughIndex == peakIndex || !peak.IsPositive() {
+			return
+		}
+		trough := events[troughIndex].Holdings.TotalValue
+		drawdown := trough.Sub(peak).Div(peak).Mul(oneHundred)
+		if drawdown.Abs().LessThan(threshold) {
+			return
+		}
+		episode := DrawdownEpisode{
+			Peak: Iteration{
+				Time:  events[peakIndex].DataEvent.GetTime(),
+				Price: peak,
+			},
+			Trough: Iteration{
+				Time:  events[troughIndex].DataEvent.GetTime(),
+				Price: trough,
+			},
+			IsRecovered:       recovered,
+			DrawdownPercent:   drawdown,
+			DurationIntervals: int64(end - peakIndex),
+			RecoveryIntervals: int64(end - troughIndex),
+		}
+		if recovered {
+			episode.RecoveredAt = events[end].DataEvent.GetTime()
+		}
+		resp = append(resp, episode)
+	}
+	for i := range events {
+		value := events[i].Holdings.TotalValue
+		if value.GreaterThanOrEqual(events[peakIndex].Holdings.TotalValue) {
+			record(i, true)
+			peakIndex, troughIndex = i, i
+			continue
+		}
+		if value.LessThan(events[troughIndex].Holdings.TotalValue) {
+			troughIndex = i
+		}
+	}
+	record(len(events)-1, false)
+	sort.SliceStable(resp, func(i, j int) bool {
+		return resp[i].DrawdownPercent.LessThan(resp[j].DrawdownPercent)
+	})
+	return resp
+}
+
+// calculateStressTests applies the shocks to the holdings of the final event
+// and, when an interval is set, to the holdings of the first event and every
+// interval after it
+func calculateStressTests(events []EventStore, shocks []risk.Shock, interval time.Duration) (final, periodic []risk.ShockResult, err error) {
+	if len(shocks) == 0 || len(events) == 0 {
+		return nil, nil, nil
+	}
+	if interval > 0 {
+		var next time.Time
+		for i := range events {
+			t := events[i].DataEvent.GetTime()
+			if t.Before(next) {
+				continue
+			}
+			var results []risk.ShockResult
+			results, err = risk.StressTest(&events[i].Holdings, shocks)
+			if err != nil {
+				return nil, nil, err
+			}
+			periodic = append(periodic, results...)
+			next = t.Add(interval)
+		}
+	}
+	final, err = risk.StressTest(&events[len(events)-1].Holdings, shocks)
+	if err != nil {
+		return nil, nil, err
+	}
+	return final, periodic, nil
+}
+
+// WorstStressTest returns the periodic stress test of the named shock with
+// the largest loss, or nil when no periodic stress tests were run
+func (c *CurrencyStatistic) WorstStressTest(name string) *risk.ShockResult {
+	var worst *risk.ShockResult
+	for i := range c.PeriodicStressTests {
+		if c.PeriodicStressTests[i].Name != name {
+			continue
+		}
+		if worst == nil || c.PeriodicStressTests[i].Loss.GreaterThan(worst.Loss) {
+			worst = &c.PeriodicStressTests[i]
+		}
+	}
+	return worst
+}
+
+// calculateRoundTrips pairs the buy and sell fills of each position from when
+// it is opened until it is fully closed, measuring how far the price moved
+// against and in favour of the average entry price while it was held.
+// Positions still open at the end of the run are not included
+func calculateRoundTrips(events []EventStore) []RoundTrip {
+	var resp []RoundTrip
+	oneHundred := decimal.NewFromInt(100)
+	var position, entryCost, exitAmount, exitValue decimal.Decimal
+	var trip *RoundTrip
+	for i := range events {
+		if trip != nil {
+			entry := entryCost.Div(position.Add(exitAmount))
+			if low := events[i].DataEvent.LowPrice(); low.IsPositive() {
+				adverse := entry.Sub(low).Div(entry).Mul(oneHundred)
+				if adverse.GreaterThan(trip.MaximumAdverseExcursion) {
+					trip.MaximumAdverseExcursion = adverse
+				}
+			}
+			favourable := events[i].DataEvent.HighPrice().Sub(entry).Div(entry).Mul(oneHundred)
+			if favourable.GreaterThan(trip.MaximumFavourableExcursion) {
+				trip.MaximumFavourableExcursion = favourable
+			}
+		}
+		f := events[i].FillEvent
+		if f == nil || !f.GetAmount().IsPositive() || !f.GetPurchasePrice().IsPositive() {
+			continue
+		}
+		switch f.GetDirection() {
+		case gctorder.Buy:
+			if trip == nil {
+				trip = &RoundTrip{EntryTime: f.GetTime()}
+				entryCost, exitAmount, exitValue = decimal.Zero, decimal.Zero, decimal.Zero
+			}
+			position = position.Add(f.GetAmount())
+			entryCost = entryCost.Add(f.GetAmount().Mul(f.GetPurchasePrice()))
+		case gctorder.Sell:
+			if trip == nil {
+				continue
+			}
+			amount := decimal.Min(f.GetAmount(), position)
+			position = position.Sub(amount)
+			exitAmount = exitAmount.Add(amount)
+			exitValue = exitValue.Add(amount.Mul(f.GetPurchasePrice()))
+			if position.IsPositive() {
+				continue
+			}
+			trip.ExitTime = f.GetTime()
+			trip.EntryPrice = entryCost.Div(exitAmount)
+			trip.ExitPrice = exitValue.Div(exitAmount)
+			trip.ReturnPercent = trip.ExitPrice.Sub(trip.EntryPrice).Div(trip.EntryPrice).Mul(oneHundred)
+			resp = append(resp, *trip)
+			trip = nil
+			position = decimal.Zero
+		}
+	}
+	return resp
+}
+
+// calculateExcursionDistribution summarises excursions by their range,
+// quartiles, ninetieth percentile and mean
+func calculateExcursionDistribution(excursions []decimal.Decimal) *ExcursionDistribution {
+	if len(excursions) == 0 {
+		return nil
+	}
+	sorted := make([]decimal.Decimal, len(excursions))
+	copy(sorted, excursions)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].LessThan(sorted[j])
+	})
+	// percentile linearly interpolates between the closest ranks
+	percentile := func(p float64) decimal.Decimal {
+		rank := decimal.NewFromFloat(p).Mul(decimal.NewFromInt(int64(len(sorted) - 1)))
+		lower := rank.Floor()
+		i := lower.IntPart()
+		if i+1 >= int64(len(sorted)) {
+			return sorted[len(sorted)-1]
+		}
+		return sorted[i].Add(sorted[i+1].Sub(sorted[i]).Mul(rank.Sub(lower)))
+	}
+	return &ExcursionDistribution{
+		Minimum:             sorted[0],
+		LowerQuartile:       percentile(0.25),
+		Median:              percentile(0.5),
+		UpperQuartile:       percentile(0.75),
+		NinetiethPercentile: percentile(0.9),
+		Maximum:             sorted[len(sorted)-1],
+		Mean:                decimal.Sum(sorted[0], sorted[1:]...).Div(decimal.NewFromInt(int64(len(sorted)))),
+	}
+}
+
+// calculateReturnDistribution describes the shape of the returns per candle
+// as percentages. Skewness and excess kurtosis use population moments and
+// returns are bucketed by Sturges' rule into buckets of equal width between
+// the lowest and highest return. Quantile points are taken at up to
+// maxQuantilePoints evenly spaced probabilities
+func calculateReturnDistribution(returns []decimal.Decimal) *ReturnDistribution {
+	if len(returns) < 2 {
+		return nil
+	}
+	n := float64(len(returns))
+	sorted := make([]float64, len(returns))
+	var mean float64
+	for i := range returns {
+		sorted[i] = returns[i].InexactFloat64() * 100
+		mean += sorted[i]
+	}
+	sort.Float64s(sorted)
+	mean /= n
+	var m2, m3, m4 float64
+	for i := range sorted {
+		d := sorted[i] - mean
+		m2 += d * d
+		m3 += d * d * d
+		m4 += d * d * d * d
+	}
+	m2, m3, m4 = m2/n, m3/n, m4/n
+	stdDev := math.Sqrt(m2)
+	resp := &ReturnDistribution{
+		Candles:           int64(len(sorted)),
+		Mean:              decimal.NewFromFloat(mean),
+		StandardDeviation: decimal.NewFromFloat(stdDev),
+		Minimum:           decimal.NewFromFloat(sorted[0]),
+		Maximum:           decimal.NewFromFloat(sorted[len(sorted)-1]),
+	}
+	if m2 > 0 {
+		resp.Skewness = decimal.NewFromFloat(m3 / math.Pow(m2, 1.5))
+		resp.ExcessKurtosis = decimal.NewFromFloat(m4/(m2*m2) - 3)
+	}
+	// normalCDF and normalQuantile are of a normal distribution with the
+	// same mean and standard deviation as the returns
+	normalCDF := func(x float64) float64 {
+		return 0.5 * (1 + math.Erf((x-mean)/(stdDev*math.Sqrt2)))
+	}
+	normalQuantile := func(p float64) float64 {
+		return mean + stdDev*math.Sqrt2*math.Erfinv(2*p-1)
+	}
+
+	buckets := int(math.Ceil(math.Log2(n))) + 1
+	width := (sorted[len(sorted)-1] - sorted[0]) / float64(buckets)
+	if width == 0 {
+		buckets = 1
+	}
+	counts := make([]int64, buckets)
+	for i := range sorted {
+		b := buckets - 1
+		if width > 0 {
+			b = int((sorted[i] - sorted[0]) / width)
+		}
+		if b >= buckets {
+			b = buckets - 1
+		}
+		counts[b]++
+	}
+	resp.Buckets = make([]ReturnBucket, buckets)
+	for i := range counts {
+		lower := sorted[0] + width*float64(i)
+		upper := lower + width
+		if i == buckets-1 {
+			upper = sorted[len(sorted)-1]
+		}
+		// when every return is the same, all are expected in the only bucket
+		normalCount := n
+		if stdDev > 0 {
+			normalCount = (normalCDF(upper) - normalCDF(lower)) * n
+		}
+		resp.Buckets[i] = ReturnBucket{
+			Lower:       decimal.NewFromFloat(lower),
+			Upper:       decimal.NewFromFloat(upper),
+			Count:       counts[i],
+			NormalCount: decimal.NewFromFloat(normalCount),
+		}
+	}
+
+	points := len(sorted)
+	if points > maxQuantilePoints {
+		points = maxQuantilePoints
+	}
+	resp.QuantilePoints = make([]QuantilePoint, points)
+	for i := range resp.QuantilePoints {
+		p := (float64(i) + 0.5) / float64(points)
+		// observed linearly interpolates between the closest ranks
+		rank := p * (n - 1)
+		lower := int(rank)
+		observed := sorted[lower]
+		if lower+1 < len(sorted) {
+			observed += (sorted[lower+1] - sorted[lower]) * (rank - float64(lower))
+		}
+		resp.QuantilePoints[i] = QuantilePoint{
+			Probability: decimal.NewFromFloat(p),
+			Normal:      decimal.NewFromFloat(normalQuantile(p)),
+			Observed:    decimal.NewFromFloat(observed),
+		}
+	}
+	return resp
+}
+
+// RiskFreeRateCurveFromYears creates a risk free rate curve where each year's
+// rate starts from the first of January UTC
+func RiskFreeRateCurveFromYears(rates map[int]decimal.Decimal) RiskFreeRateCurve {
+	resp := make(RiskFreeRateCurve, 0, len(rates))
+	for year, rate := range rates {
+		resp = append(resp, RiskFreeRatePoint{
+			Start: time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Rate:  rate,
+		})
+	}
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].Start.Before(resp[j].Start)
+	})
+	return resp
+}
+
+// LoadRiskFreeRateCurve reads a risk free rate curve from a CSV file where
+// each row is a date in 2006-01-02 format and the annual rate from that date,
+// eg 2020-01-01,0.015. A header row is skipped
+func LoadRiskFreeRateCurve(path string) (RiskFreeRateCurve, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = f.Close()
+		if err != nil {
+			log.Error(log.BackTester, err)
+		}
+	}()
+	rows, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	var resp RiskFreeRateCurve
+	for i := range rows {
+		if len(rows[i]) < 2 {
+			return nil, fmt.Errorf("%v row %v: expected date and rate", path, i+1)
+		}
+		var start time.Time
+		start, err = time.Parse("2006-01-02", strings.TrimSpace(rows[i][0]))
+		if err != nil {
+			if i == 0 {
+				continue
+			}
+			return nil, fmt.Errorf("%v row %v: %w", path, i+1, err)
+		}
+		var rate decimal.Decimal
+		rate, err = decimal.NewFromString(strings.TrimSpace(rows[i][1]))
+		if err != nil {
+			return nil, fmt.Errorf("%v row %v: %w", path, i+1, err)
+		}
+		if rate.IsNegative() {
+			return nil, fmt.Errorf("%v row %v: %w", path, i+1, errNegativeRiskFreeRate)
+		}
+		resp = append(resp, RiskFreeRatePoint{Start: start, Rate: rate})
+	}
+	if len(resp) == 0 {
+		return nil, fmt.Errorf("%v: %w", path, errNoRiskFreeRates)
+	}
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].Start.Before(resp[j].Start)
+	})
+	return resp, nil
+}
+
+// RateAt returns the annual risk free rate at the time. Times before the
+// curve starts use its earliest rate
+func (r RiskFreeRateCurve) RateAt(t time.Time) decimal.Decimal {
+	if len(r) == 0 {
+		return decimal.Zero
+	}
+	rate := r[0].Rate
+	for i := range r {
+		if r[i].Start.After(t) {
+			break
+		}
+		rate = r[i].Rate
+	}
+	return rate
+}
+
+// excessReturns subtracts each candle's share of the annual risk free rate of
+// its period from its return, also returning the rate for all candles
+func (r RiskFreeRateCurve) excessReturns(events []EventStore, returns []decimal.Decimal, intervalsPerYear decimal.Decimal) ([]decimal.Decimal, decimal.Decimal) {
+	resp := make([]decimal.Decimal, len(returns))
+	total := decimal.Zero
+	for i := range returns {
+		ratePerCandle := r.RateAt(events[i].DataEvent.GetTime()).Div(intervalsPerYear)
+		total = total.Add(ratePerCandle)
+		resp[i] = returns[i].Sub(ratePerCandle)
+	}
+	return resp, total
 }
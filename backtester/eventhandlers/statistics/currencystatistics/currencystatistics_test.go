@@ -1,6 +1,10 @@
 package currencystatistics
 
 import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -8,8 +12,12 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/compliance"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/risk"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics/regime"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
+	evtorder "github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -334,7 +342,7 @@ func TestCalculateMaxDrawdown(t *testing.T) {
 func TestCalculateHighestCommittedFunds(t *testing.T) {
 	t.Parallel()
 	c := CurrencyStatistic{}
-	c.calculateHighestCommittedFunds()
+	c.calculateHighestCommittedFunds(c.Events)
 	if !c.HighestCommittedFunds.Time.IsZero() {
 		t.Error("expected no time with not committed funds")
 	}
@@ -346,8 +354,790 @@ func TestCalculateHighestCommittedFunds(t *testing.T) {
 		EventStore{DataEvent: &kline.Kline{Close: decimal.NewFromInt(1338)}, Holdings: holdings.Holding{Timestamp: tt2, BaseSize: decimal.NewFromInt(1337)}},
 		EventStore{DataEvent: &kline.Kline{Close: decimal.NewFromInt(1339)}, Holdings: holdings.Holding{Timestamp: tt3, BaseSize: decimal.NewFromInt(11)}},
 	)
-	c.calculateHighestCommittedFunds()
+	c.calculateHighestCommittedFunds(c.Events)
 	if c.HighestCommittedFunds.Time != tt2 {
 		t.Errorf("expected %v, received %v", tt2, c.HighestCommittedFunds.Time)
 	}
 }
+
+func TestCalculateResultsWithConversion(t *testing.T) {
+	t.Parallel()
+	tt1 := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	tt2 := tt1.Add(gctkline.OneDay.Duration())
+	p := currency.NewPair(currency.ETH, currency.BTC)
+	conversionPair := currency.NewPair(currency.BTC, currency.USDT)
+	newKline := func(cp currency.Pair, tt time.Time, price int64) *kline.Kline {
+		return &kline.Kline{
+			Base: event.Base{
+				Exchange:     testExchange,
+				Time:         tt,
+				Interval:     gctkline.OneDay,
+				CurrencyPair: cp,
+				AssetType:    asset.Spot,
+			},
+			Open:  decimal.NewFromInt(price),
+			Close: decimal.NewFromInt(price),
+			Low:   decimal.NewFromInt(price),
+			High:  decimal.NewFromInt(price),
+		}
+	}
+	cs := CurrencyStatistic{
+		Events: []EventStore{
+			{
+				DataEvent: newKline(p, tt1, 2),
+				Holdings: holdings.Holding{
+					Timestamp:         tt1,
+					QuoteInitialFunds: decimal.NewFromInt(10),
+					TotalValue:        decimal.NewFromInt(10),
+					RiskFreeRate:      decimal.NewFromInt(1),
+				},
+			},
+			{
+				DataEvent: newKline(p, tt2, 2),
+				Holdings: holdings.Holding{
+					Timestamp:         tt2,
+					QuoteInitialFunds: decimal.NewFromInt(10),
+					TotalValue:        decimal.NewFromInt(10),
+					RiskFreeRate:      decimal.NewFromInt(1),
+				},
+			},
+		},
+	}
+
+	err := cs.SetConversion(currency.NewPair(currency.ETH, currency.USDT), nil)
+	if !errors.Is(err, errInvalidConversionPair) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidConversionPair)
+	}
+	err = cs.SetConversion(conversionPair, nil)
+	if !errors.Is(err, errNoConversionRate) {
+		t.Errorf("received '%v' expected '%v'", err, errNoConversionRate)
+	}
+	err = cs.SetConversion(conversionPair, []common.DataEventHandler{
+		newKline(conversionPair, tt2, 30000),
+		newKline(conversionPair, tt1, 20000),
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !cs.ValuationCurrency.Match(currency.USDT) {
+		t.Errorf("received '%v' expected '%v'", cs.ValuationCurrency, currency.USDT)
+	}
+
+	b, err := funding.CreateItem(testExchange, asset.Spot, currency.ETH, decimal.Zero, decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q, err := funding.CreateItem(testExchange, asset.Spot, currency.BTC, decimal.NewFromInt(10), decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pair, err := funding.CreatePair(b, q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = cs.CalculateResults(pair)
+	if err != nil {
+		t.Error(err)
+	}
+	// ETH/BTC is flat, but BTC gained 50% against USDT
+	fifty := decimal.NewFromInt(50)
+	if !cs.MarketMovement.Equal(fifty) {
+		t.Errorf("received '%v' expected '%v'", cs.MarketMovement, fifty)
+	}
+	if !cs.StrategyMovement.Equal(fifty) {
+		t.Errorf("received '%v' expected '%v'", cs.StrategyMovement, fifty)
+	}
+	if !cs.HighestClosePrice.Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received '%v' expected '%v'", cs.HighestClosePrice, 2)
+	}
+
+	cs.ConversionEvents = []common.DataEventHandler{newKline(conversionPair, tt2, 30000)}
+	err = cs.CalculateResults(pair)
+	if !errors.Is(err, errNoConversionRate) {
+		t.Errorf("received '%v' expected '%v'", err, errNoConversionRate)
+	}
+}
+
+func TestCalculateCalendarReturns(t *testing.T) {
+	t.Parallel()
+	if resp := calculateCalendarReturns(nil); resp != nil {
+		t.Errorf("received '%v' expected '%v'", resp, nil)
+	}
+	eventAt := func(tt time.Time, value int64) EventStore {
+		return EventStore{
+			DataEvent: &kline.Kline{Base: event.Base{Time: tt}},
+			Holdings:  holdings.Holding{TotalValue: decimal.NewFromInt(value)},
+		}
+	}
+	resp := calculateCalendarReturns([]EventStore{
+		eventAt(time.Date(2020, 11, 1, 0, 0, 0, 0, time.UTC), 100),
+		eventAt(time.Date(2020, 11, 30, 0, 0, 0, 0, time.UTC), 110),
+		eventAt(time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC), 121),
+		eventAt(time.Date(2021, 1, 15, 0, 0, 0, 0, time.UTC), 110),
+		eventAt(time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC), 132),
+	})
+	if len(resp) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(resp), 2)
+	}
+	if resp[0].Year != 2020 || resp[1].Year != 2021 {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", resp[0].Year, resp[1].Year, 2020, 2021)
+	}
+	for i, expected := range map[int]int64{10: 10, 11: 10} {
+		if resp[0].Months[i] == nil || !resp[0].Months[i].Equal(decimal.NewFromInt(expected)) {
+			t.Errorf("received '%v' expected '%v' for month %v", resp[0].Months[i], expected, i+1)
+		}
+	}
+	if resp[0].Months[0] != nil {
+		t.Errorf("received '%v' expected '%v'", resp[0].Months[0], nil)
+	}
+	if resp[0].Annual == nil || !resp[0].Annual.Equal(decimal.NewFromInt(21)) {
+		t.Errorf("received '%v' expected '%v'", resp[0].Annual, 21)
+	}
+	if resp[1].Months[1] != nil {
+		t.Errorf("received '%v' expected '%v'", resp[1].Months[1], nil)
+	}
+	if resp[1].Months[2] == nil || !resp[1].Months[2].Equal(decimal.NewFromInt(20)) {
+		t.Errorf("received '%v' expected '%v'", resp[1].Months[2], 20)
+	}
+	if resp[1].Annual == nil || !resp[1].Annual.Round(4).Equal(decimal.NewFromFloat(9.0909)) {
+		t.Errorf("received '%v' expected '%v'", resp[1].Annual, 9.0909)
+	}
+}
+
+func TestCalculateRegimeStatistics(t *testing.T) {
+	t.Parallel()
+	if resp := calculateRegimeStatistics([]EventStore{{}}, nil); resp != nil {
+		t.Errorf("received '%v' expected '%v'", resp, nil)
+	}
+	tt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	eventAt := func(i int, price, value int64) EventStore {
+		return EventStore{
+			DataEvent: &kline.Kline{
+				Base:  event.Base{Time: tt.Add(time.Hour * time.Duration(i))},
+				Close: decimal.NewFromInt(price),
+			},
+			Holdings: holdings.Holding{TotalValue: decimal.NewFromInt(value)},
+		}
+	}
+	events := []EventStore{
+		eventAt(0, 100, 1000),
+		eventAt(1, 110, 1100),
+		eventAt(2, 121, 1210),
+		eventAt(3, 121, 1089),
+		eventAt(4, 133, 1089),
+		eventAt(5, 100, 1089),
+	}
+	events[2].FillEvent = &fill.Fill{Direction: order.Buy}
+	events[4].FillEvent = &fill.Fill{Direction: common.DoNothing}
+	labels := []string{regime.Unclassified, regime.Trending, regime.Trending, regime.Ranging, regime.Trending, regime.HighVolatility}
+	resp := calculateRegimeStatistics(events, labels)
+	if len(resp) != 3 {
+		t.Fatalf("received '%v' expected '%v'", len(resp), 3)
+	}
+	trending, ranging, volatile := resp[0], resp[1], resp[2]
+	if trending.Regime != regime.Trending || trending.Candles != 3 || trending.Periods != 2 || trending.Orders != 1 {
+		t.Errorf("unexpected trending statistics %+v", trending)
+	}
+	if !trending.CandlesPercent.Equal(decimal.NewFromInt(60)) {
+		t.Errorf("received '%v' expected '%v'", trending.CandlesPercent, 60)
+	}
+	if !trending.StrategyReturn.Equal(decimal.NewFromInt(21)) {
+		t.Errorf("received '%v' expected '%v'", trending.StrategyReturn, 21)
+	}
+	if !trending.MarketReturn.Round(2).Equal(decimal.NewFromInt(33)) {
+		t.Errorf("received '%v' expected '%v'", trending.MarketReturn, 33)
+	}
+	if !trending.WinRate.Round(2).Equal(decimal.NewFromFloat(66.67)) {
+		t.Errorf("received '%v' expected '%v'", trending.WinRate, 66.67)
+	}
+	if ranging.Regime != regime.Ranging || !ranging.StrategyReturn.Equal(decimal.NewFromInt(-10)) || !ranging.WinRate.IsZero() {
+		t.Errorf("unexpected ranging statistics %+v", ranging)
+	}
+	if volatile.Regime != regime.HighVolatility || !volatile.StrategyReturn.IsZero() || !volatile.MarketReturn.Round(2).Equal(decimal.NewFromFloat(-24.81)) {
+		t.Errorf("unexpected high volatility statistics %+v", volatile)
+	}
+}
+
+func TestCalculateRegimePeriods(t *testing.T) {
+	t.Parallel()
+	if resp := calculateRegimePeriods([]EventStore{{}}, nil); resp != nil {
+		t.Errorf("received '%v' expected '%v'", resp, nil)
+	}
+	tt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	var events []EventStore
+	for i := 0; i < 5; i++ {
+		events = append(events, EventStore{DataEvent: &kline.Kline{Base: event.Base{Time: tt.Add(time.Hour * time.Duration(i))}}})
+	}
+	resp := calculateRegimePeriods(events, []string{regime.Unclassified, regime.Trending, regime.Trending, regime.Ranging, regime.Ranging})
+	if len(resp) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(resp), 2)
+	}
+	if resp[0].Regime != regime.Trending || resp[0].Candles != 2 || !resp[0].Start.Equal(tt.Add(time.Hour)) || !resp[0].End.Equal(tt.Add(time.Hour*2)) {
+		t.Errorf("unexpected period %+v", resp[0])
+	}
+	if resp[1].Regime != regime.Ranging || resp[1].Candles != 2 {
+		t.Errorf("unexpected period %+v", resp[1])
+	}
+}
+
+func TestCalculateMonthlyProfits(t *testing.T) {
+	t.Parallel()
+	if resp := calculateMonthlyProfits(nil); resp != nil {
+		t.Errorf("received '%v' expected '%v'", resp, nil)
+	}
+	eventAt := func(tt time.Time, value, fees int64) EventStore {
+		return EventStore{
+			DataEvent: &kline.Kline{Base: event.Base{Time: tt}},
+			Holdings: holdings.Holding{
+				TotalValue: decimal.NewFromInt(value),
+				TotalFees:  decimal.NewFromInt(fees),
+			},
+		}
+	}
+	events := []EventStore{
+		eventAt(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), 100, 0),
+		eventAt(time.Date(2021, 1, 20, 0, 0, 0, 0, time.UTC), 110, 1),
+		eventAt(time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC), 99, 4),
+	}
+	resp := calculateMonthlyProfits(events)
+	if len(resp) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(resp), 2)
+	}
+	if !resp[0].Start.Equal(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)) ||
+		!resp[1].Start.Equal(time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected months %v %v", resp[0].Start, resp[1].Start)
+	}
+	if !resp[0].StartValue.Equal(decimal.NewFromInt(100)) || !resp[0].Profit.Equal(decimal.NewFromInt(10)) || !resp[0].Fees.Equal(decimal.NewFromInt(1)) {
+		t.Errorf("unexpected first month %+v", resp[0])
+	}
+	if !resp[1].StartValue.Equal(decimal.NewFromInt(110)) || !resp[1].Profit.Equal(decimal.NewFromInt(-11)) || !resp[1].Fees.Equal(decimal.NewFromInt(3)) {
+		t.Errorf("unexpected second month %+v", resp[1])
+	}
+
+	events[2].DataEvent = &convertedEvent{DataEventHandler: events[2].DataEvent, rate: decimal.NewFromInt(2)}
+	resp = calculateMonthlyProfits(events)
+	if !resp[1].Fees.Equal(decimal.NewFromInt(6)) {
+		t.Errorf("received '%v' expected '%v'", resp[1].Fees, 6)
+	}
+}
+
+func TestCalculateDrawdownEpisodes(t *testing.T) {
+	t.Parallel()
+	if resp := calculateDrawdownEpisodes(nil, decimal.Zero); resp != nil {
+		t.Errorf("received '%v' expected '%v'", resp, nil)
+	}
+	tt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	var events []EventStore
+	for i, value := range []int64{100, 90, 95, 101, 99, 101, 120, 60, 80} {
+		events = append(events, EventStore{
+			DataEvent: &kline.Kline{Base: event.Base{Time: tt.Add(time.Hour * time.Duration(i))}},
+			Holdings:  holdings.Holding{TotalValue: decimal.NewFromInt(value)},
+		})
+	}
+	resp := calculateDrawdownEpisodes(events, decimal.Zero)
+	if len(resp) != 3 {
+		t.Fatalf("received '%v' expected '%v'", len(resp), 3)
+	}
+	// the deepest drawdown has not recovered by the end of the run
+	if !resp[0].DrawdownPercent.Equal(decimal.NewFromInt(-50)) || resp[0].IsRecovered {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", resp[0].DrawdownPercent, resp[0].IsRecovered, -50, false)
+	}
+	if resp[0].DurationIntervals != 2 || resp[0].RecoveryIntervals != 1 {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", resp[0].DurationIntervals, resp[0].RecoveryIntervals, 2, 1)
+	}
+	if !resp[1].DrawdownPercent.Equal(decimal.NewFromInt(-10)) || !resp[1].IsRecovered {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", resp[1].DrawdownPercent, resp[1].IsRecovered, -10, true)
+	}
+	if !resp[1].RecoveredAt.Equal(tt.Add(time.Hour*3)) || resp[1].DurationIntervals != 3 || resp[1].RecoveryIntervals != 2 {
+		t.Errorf("received '%v' '%v' '%v' expected '%v' '%v' '%v'", resp[1].RecoveredAt, resp[1].DurationIntervals, resp[1].RecoveryIntervals, tt.Add(time.Hour*3), 3, 2)
+	}
+	resp = calculateDrawdownEpisodes(events, decimal.NewFromInt(5))
+	if len(resp) != 2 {
+		t.Errorf("received '%v' expected '%v'", len(resp), 2)
+	}
+}
+
+func TestCalculateStressTests(t *testing.T) {
+	t.Parallel()
+	final, periodic, err := calculateStressTests(nil, nil, 0)
+	if err != nil || final != nil || periodic != nil {
+		t.Errorf("received '%v' '%v' '%v' expected nil results", final, periodic, err)
+	}
+	tt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	var events []EventStore
+	for i, value := range []int64{100, 200, 50, 100} {
+		events = append(events, EventStore{
+			DataEvent: &kline.Kline{Base: event.Base{Time: tt.Add(time.Hour * time.Duration(i))}},
+			Holdings: holdings.Holding{
+				Pair:       currency.NewPair(currency.BTC, currency.USDT),
+				Timestamp:  tt.Add(time.Hour * time.Duration(i)),
+				BaseValue:  decimal.NewFromInt(value),
+				TotalValue: decimal.NewFromInt(value),
+			},
+		})
+	}
+	shocks := []risk.Shock{{Name: "crash", PercentChange: decimal.NewFromInt(-10)}}
+	final, periodic, err = calculateStressTests(events, shocks, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(final) != 1 || len(periodic) != 0 {
+		t.Fatalf("received '%v' '%v' expected '%v' '%v'", len(final), len(periodic), 1, 0)
+	}
+	if !final[0].Loss.Equal(decimal.NewFromInt(10)) {
+		t.Errorf("received '%v' expected '%v'", final[0].Loss, 10)
+	}
+
+	final, periodic, err = calculateStressTests(events, shocks, time.Hour*2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(final) != 1 || len(periodic) != 2 {
+		t.Fatalf("received '%v' '%v' expected '%v' '%v'", len(final), len(periodic), 1, 2)
+	}
+	if !periodic[1].Time.Equal(tt.Add(time.Hour * 2)) {
+		t.Errorf("received '%v' expected '%v'", periodic[1].Time, tt.Add(time.Hour*2))
+	}
+
+	c := CurrencyStatistic{PeriodicStressTests: periodic}
+	if worst := c.WorstStressTest("depeg"); worst != nil {
+		t.Errorf("received '%v' expected '%v'", worst, nil)
+	}
+	worst := c.WorstStressTest("crash")
+	if worst == nil || !worst.Loss.Equal(decimal.NewFromInt(10)) {
+		t.Errorf("received '%v' expected loss '%v'", worst, 10)
+	}
+}
+
+func TestCalculateRoundTrips(t *testing.T) {
+	t.Parallel()
+	if resp := calculateRoundTrips(nil); resp != nil {
+		t.Errorf("received '%v' expected '%v'", resp, nil)
+	}
+	tt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	candles := []struct {
+		low, high int64
+		side      order.Side
+		amount    int64
+		price     int64
+	}{
+		{100, 100, order.Buy, 1, 100},
+		{95, 110, "", 0, 0},
+		{98, 104, order.Sell, 1, 102},
+		// the entry candle's range occurs before the fill and is ignored
+		{40, 60, order.Buy, 2, 50},
+		{45, 55, order.Sell, 1, 52},
+		{49, 51, order.Sell, 2, 48},
+		// positions still open are not round trips
+		{50, 50, order.Buy, 1, 50},
+		{10, 90, "", 0, 0},
+	}
+	var events []EventStore
+	for i := range candles {
+		ev := EventStore{
+			DataEvent: &kline.Kline{
+				Base: event.Base{Time: tt.Add(time.Hour * time.Duration(i))},
+				Low:  decimal.NewFromInt(candles[i].low),
+				High: decimal.NewFromInt(candles[i].high),
+			},
+		}
+		if candles[i].side != "" {
+			ev.FillEvent = &fill.Fill{
+				Base:          event.Base{Time: tt.Add(time.Hour * time.Duration(i))},
+				Direction:     candles[i].side,
+				Amount:        decimal.NewFromInt(candles[i].amount),
+				PurchasePrice: decimal.NewFromInt(candles[i].price),
+			}
+		}
+		events = append(events, ev)
+	}
+	resp := calculateRoundTrips(events)
+	if len(resp) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(resp), 2)
+	}
+	if !resp[0].MaximumAdverseExcursion.Equal(decimal.NewFromInt(5)) || !resp[0].MaximumFavourableExcursion.Equal(decimal.NewFromInt(10)) {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", resp[0].MaximumAdverseExcursion, resp[0].MaximumFavourableExcursion, 5, 10)
+	}
+	if !resp[0].ReturnPercent.Equal(decimal.NewFromInt(2)) || !resp[0].ExitTime.Equal(tt.Add(time.Hour*2)) {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", resp[0].ReturnPercent, resp[0].ExitTime, 2, tt.Add(time.Hour*2))
+	}
+	if !resp[1].EntryPrice.Equal(decimal.NewFromInt(50)) || !resp[1].ExitPrice.Equal(decimal.NewFromInt(50)) {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", resp[1].EntryPrice, resp[1].ExitPrice, 50, 50)
+	}
+	if !resp[1].MaximumAdverseExcursion.Equal(decimal.NewFromInt(10)) || !resp[1].MaximumFavourableExcursion.Equal(decimal.NewFromInt(10)) {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", resp[1].MaximumAdverseExcursion, resp[1].MaximumFavourableExcursion, 10, 10)
+	}
+}
+
+func TestCalculateExcursionDistribution(t *testing.T) {
+	t.Parallel()
+	if resp := calculateExcursionDistribution(nil); resp != nil {
+		t.Errorf("received '%v' expected '%v'", resp, nil)
+	}
+	resp := calculateExcursionDistribution([]decimal.Decimal{decimal.NewFromInt(10), decimal.NewFromInt(5)})
+	for _, v := range []struct {
+		received, expected decimal.Decimal
+	}{
+		{resp.Minimum, decimal.NewFromInt(5)},
+		{resp.LowerQuartile, decimal.NewFromFloat(6.25)},
+		{resp.Median, decimal.NewFromFloat(7.5)},
+		{resp.UpperQuartile, decimal.NewFromFloat(8.75)},
+		{resp.NinetiethPercentile, decimal.NewFromFloat(9.5)},
+		{resp.Maximum, decimal.NewFromInt(10)},
+		{resp.Mean, decimal.NewFromFloat(7.5)},
+	} {
+		if !v.received.Equal(v.expected) {
+			t.Errorf("received '%v' expected '%v'", v.received, v.expected)
+		}
+	}
+}
+
+func TestCalculateReturnDistribution(t *testing.T) {
+	t.Parallel()
+	if resp := calculateReturnDistribution([]decimal.Decimal{decimal.NewFromInt(1)}); resp != nil {
+		t.Errorf("received '%v' expected '%v'", resp, nil)
+	}
+	resp := calculateReturnDistribution([]decimal.Decimal{
+		decimal.NewFromFloat(0.01),
+		decimal.NewFromFloat(0.1),
+		decimal.NewFromFloat(0.02),
+		decimal.NewFromFloat(0.03),
+	})
+	if resp.Candles != 4 {
+		t.Errorf("received '%v' expected '%v'", resp.Candles, 4)
+	}
+	for _, v := range []struct {
+		received, expected decimal.Decimal
+	}{
+		{resp.Mean, decimal.NewFromInt(4)},
+		{resp.StandardDeviation, decimal.NewFromFloat(3.5355)},
+		{resp.Skewness, decimal.NewFromFloat(1.0182)},
+		{resp.ExcessKurtosis, decimal.NewFromFloat(-0.7696)},
+		{resp.Minimum, decimal.NewFromInt(1)},
+		{resp.Maximum, decimal.NewFromInt(10)},
+	} {
+		if !v.received.Round(4).Equal(v.expected) {
+			t.Errorf("received '%v' expected '%v'", v.received, v.expected)
+		}
+	}
+	if len(resp.Buckets) != 3 {
+		t.Fatalf("received '%v' expected '%v'", len(resp.Buckets), 3)
+	}
+	for i, expected := range []int64{3, 0, 1} {
+		if resp.Buckets[i].Count != expected {
+			t.Errorf("received '%v' expected '%v'", resp.Buckets[i].Count, expected)
+		}
+	}
+	if !resp.Buckets[2].Lower.Round(4).Equal(decimal.NewFromInt(7)) || !resp.Buckets[2].Upper.Round(4).Equal(decimal.NewFromInt(10)) {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", resp.Buckets[2].Lower, resp.Buckets[2].Upper, 7, 10)
+	}
+	if len(resp.QuantilePoints) != 4 {
+		t.Fatalf("received '%v' expected '%v'", len(resp.QuantilePoints), 4)
+	}
+	if !resp.QuantilePoints[0].Probability.Equal(decimal.NewFromFloat(0.125)) || !resp.QuantilePoints[0].Observed.Equal(decimal.NewFromFloat(1.375)) {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", resp.QuantilePoints[0].Probability, resp.QuantilePoints[0].Observed, 0.125, 1.375)
+	}
+	if !resp.QuantilePoints[0].Normal.LessThan(resp.Mean) || !resp.QuantilePoints[3].Normal.GreaterThan(resp.Mean) {
+		t.Errorf("received '%v' '%v' expected either side of '%v'", resp.QuantilePoints[0].Normal, resp.QuantilePoints[3].Normal, resp.Mean)
+	}
+
+	resp = calculateReturnDistribution([]decimal.Decimal{decimal.NewFromFloat(0.01), decimal.NewFromFloat(0.01)})
+	if len(resp.Buckets) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(resp.Buckets), 1)
+	}
+	if resp.Buckets[0].Count != 2 || !resp.Buckets[0].NormalCount.Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", resp.Buckets[0].Count, resp.Buckets[0].NormalCount, 2, 2)
+	}
+	if !resp.Skewness.IsZero() || !resp.ExcessKurtosis.IsZero() {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", resp.Skewness, resp.ExcessKurtosis, 0, 0)
+	}
+}
+
+func TestRiskFreeRateCurve(t *testing.T) {
+	t.Parallel()
+	var r RiskFreeRateCurve
+	if rate := r.RateAt(time.Now()); !rate.IsZero() {
+		t.Errorf("received '%v' expected '%v'", rate, 0)
+	}
+	r = RiskFreeRateCurveFromYears(map[int]decimal.Decimal{
+		2021: decimal.NewFromFloat(0.02),
+		2020: decimal.NewFromFloat(0.01),
+	})
+	for _, v := range []struct {
+		tt   time.Time
+		rate decimal.Decimal
+	}{
+		{time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC), decimal.NewFromFloat(0.01)},
+		{time.Date(2020, 12, 31, 23, 0, 0, 0, time.UTC), decimal.NewFromFloat(0.01)},
+		{time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), decimal.NewFromFloat(0.02)},
+		{time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC), decimal.NewFromFloat(0.02)},
+	} {
+		if rate := r.RateAt(v.tt); !rate.Equal(v.rate) {
+			t.Errorf("received '%v' expected '%v'", rate, v.rate)
+		}
+	}
+}
+
+func TestLoadRiskFreeRateCurve(t *testing.T) {
+	t.Parallel()
+	tempDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err = os.RemoveAll(tempDir); err != nil {
+			t.Error(err)
+		}
+	}()
+	_, err = LoadRiskFreeRateCurve(filepath.Join(tempDir, "missing.csv"))
+	if err == nil {
+		t.Error("expected error for missing file")
+	}
+	for _, v := range []struct {
+		data string
+		err  error
+	}{
+		{"date,rate\n", errNoRiskFreeRates},
+		{"date,rate\n2020-01-01,-0.01\n", errNegativeRiskFreeRate},
+		{"date,rate\n2021-01-01,0.02\n2020-01-01,0.01\n", nil},
+	} {
+		path := filepath.Join(tempDir, "rates.csv")
+		err = ioutil.WriteFile(path, []byte(v.data), 0600)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var r RiskFreeRateCurve
+		r, err = LoadRiskFreeRateCurve(path)
+		if !errors.Is(err, v.err) {
+			t.Errorf("received '%v' expected '%v'", err, v.err)
+		}
+		if v.err != nil {
+			continue
+		}
+		if len(r) != 2 || !r[0].Rate.Equal(decimal.NewFromFloat(0.01)) {
+			t.Errorf("received '%v' expected '%v'", r, "sorted rates")
+		}
+	}
+}
+
+func TestCalculateResultsRiskFreeRateCurve(t *testing.T) {
+	t.Parallel()
+	tt := time.Date(2020, 12, 30, 0, 0, 0, 0, time.UTC)
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	newStats := func() *CurrencyStatistic {
+		cs := &CurrencyStatistic{}
+		for i, v := range []float64{0, 0.02, -0.01, 0.03, -0.02} {
+			even := event.Base{
+				Exchange:     testExchange,
+				Time:         tt.Add(gctkline.OneDay.Duration() * time.Duration(i)),
+				Interval:     gctkline.OneDay,
+				CurrencyPair: p,
+				AssetType:    asset.Spot,
+			}
+			price := decimal.NewFromInt(int64(1000 + i*10))
+			cs.Events = append(cs.Events, EventStore{
+				Holdings: holdings.Holding{
+					ChangeInTotalValuePercent: decimal.NewFromFloat(v),
+					Timestamp:                 even.Time,
+					QuoteInitialFunds:         decimal.NewFromInt(1000),
+					TotalValue:                decimal.NewFromInt(1000),
+					RiskFreeRate:              decimal.NewFromFloat(0.5),
+				},
+				DataEvent: &kline.Kline{Base: even, Open: price, Close: price, Low: price, High: price},
+			})
+		}
+		return cs
+	}
+	b, err := funding.CreateItem(testExchange, asset.Spot, currency.BTC, decimal.NewFromInt(1), decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q, err := funding.CreateItem(testExchange, asset.Spot, currency.USDT, decimal.NewFromInt(1000), decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pair, err := funding.CreatePair(b, q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	constant := newStats()
+	err = constant.CalculateResults(pair)
+	if err != nil {
+		t.Error(err)
+	}
+	// a flat curve matches the constant rate
+	flat := newStats()
+	flat.RiskFreeRateCurve = RiskFreeRateCurveFromYears(map[int]decimal.Decimal{2020: decimal.NewFromFloat(0.5)})
+	err = flat.CalculateResults(pair)
+	if err != nil {
+		t.Error(err)
+	}
+	if !flat.ArithmeticRatios.SharpeRatio.Round(8).Equal(constant.ArithmeticRatios.SharpeRatio.Round(8)) {
+		t.Errorf("received '%v' expected '%v'", flat.ArithmeticRatios.SharpeRatio, constant.ArithmeticRatios.SharpeRatio)
+	}
+	if !flat.ArithmeticRatios.SortinoRatio.Round(8).Equal(constant.ArithmeticRatios.SortinoRatio.Round(8)) {
+		t.Errorf("received '%v' expected '%v'", flat.ArithmeticRatios.SortinoRatio, constant.ArithmeticRatios.SortinoRatio)
+	}
+	// a higher rate from 2021 reduces the excess returns of later candles
+	rising := newStats()
+	rising.RiskFreeRateCurve = RiskFreeRateCurveFromYears(map[int]decimal.Decimal{
+		2020: decimal.NewFromFloat(0.5),
+		2021: decimal.NewFromInt(5),
+	})
+	err = rising.CalculateResults(pair)
+	if err != nil {
+		t.Error(err)
+	}
+	if !rising.ArithmeticRatios.SharpeRatio.LessThan(constant.ArithmeticRatios.SharpeRatio) {
+		t.Errorf("received '%v' expected less than '%v'", rising.ArithmeticRatios.SharpeRatio, constant.ArithmeticRatios.SharpeRatio)
+	}
+	if !rising.RiskFreeRate.GreaterThan(constant.RiskFreeRate) {
+		t.Errorf("received '%v' expected greater than '%v'", rising.RiskFreeRate, constant.RiskFreeRate)
+	}
+}
+
+func TestCalculateTurnover(t *testing.T) {
+	t.Parallel()
+	if resp := calculateTurnover(nil); resp != nil {
+		t.Errorf("received '%v' expected '%v'", resp, nil)
+	}
+	tt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	events := []EventStore{
+		{
+			DataEvent: &kline.Kline{Base: event.Base{Time: tt}},
+			Holdings:  holdings.Holding{TotalValue: decimal.NewFromInt(100)},
+		},
+		{
+			DataEvent: &kline.Kline{Base: event.Base{Time: tt.Add(time.Hour)}},
+			FillEvent: &fill.Fill{Direction: order.Buy},
+			Holdings: holdings.Holding{
+				BoughtValue: decimal.NewFromInt(50),
+				TotalFees:   decimal.NewFromInt(1),
+				BaseValue:   decimal.NewFromInt(48),
+				TotalValue:  decimal.NewFromInt(98),
+			},
+		},
+		{
+			DataEvent: &kline.Kline{Base: event.Base{Time: tt.Add(time.Hour * 2)}},
+			FillEvent: &fill.Fill{Direction: order.Sell},
+			Holdings: holdings.Holding{
+				BoughtValue: decimal.NewFromInt(50),
+				SoldValue:   decimal.NewFromInt(60),
+				TotalFees:   decimal.NewFromInt(2),
+				TotalValue:  decimal.NewFromInt(108),
+			},
+		},
+	}
+	resp := calculateTurnover(events)
+	if resp.Orders != 2 {
+		t.Errorf("received '%v' expected '%v'", resp.Orders, 2)
+	}
+	if !resp.TradedVolume.Equal(decimal.NewFromInt(110)) {
+		t.Errorf("received '%v' expected '%v'", resp.TradedVolume, 110)
+	}
+	if !resp.AverageEquity.Equal(decimal.NewFromInt(102)) {
+		t.Errorf("received '%v' expected '%v'", resp.AverageEquity, 102)
+	}
+	if !resp.TurnoverRatio.Equal(resp.TradedVolume.Div(resp.AverageEquity)) {
+		t.Errorf("received '%v' expected '%v'", resp.TurnoverRatio, resp.TradedVolume.Div(resp.AverageEquity))
+	}
+	if !resp.AverageExposure.Equal(decimal.NewFromInt(16)) {
+		t.Errorf("received '%v' expected '%v'", resp.AverageExposure, 16)
+	}
+	if !resp.AverageFee.Equal(decimal.NewFromInt(1)) {
+		t.Errorf("received '%v' expected '%v'", resp.AverageFee, 1)
+	}
+	if !resp.ProfitBeforeFees.Equal(decimal.NewFromInt(10)) {
+		t.Errorf("received '%v' expected '%v'", resp.ProfitBeforeFees, 10)
+	}
+	if !resp.FeesPercentOfProfit.Equal(decimal.NewFromInt(20)) {
+		t.Errorf("received '%v' expected '%v'", resp.FeesPercentOfProfit, 20)
+	}
+
+	// converted events value volume and fees at each event's rate
+	for i := range events {
+		events[i].DataEvent = &convertedEvent{DataEventHandler: events[i].DataEvent, rate: decimal.NewFromInt(2)}
+	}
+	resp = calculateTurnover(events)
+	if !resp.TradedVolume.Equal(decimal.NewFromInt(220)) {
+		t.Errorf("received '%v' expected '%v'", resp.TradedVolume, 220)
+	}
+	if !resp.TotalFees.Equal(decimal.NewFromInt(4)) {
+		t.Errorf("received '%v' expected '%v'", resp.TotalFees, 4)
+	}
+}
+
+func TestCombineTurnover(t *testing.T) {
+	t.Parallel()
+	resp := CombineTurnover([]*Turnover{
+		{
+			Orders:           2,
+			TradedVolume:     decimal.NewFromInt(100),
+			AverageEquity:    decimal.NewFromInt(50),
+			AverageExposure:  decimal.NewFromInt(10),
+			TotalFees:        decimal.NewFromInt(2),
+			ProfitBeforeFees: decimal.NewFromInt(5),
+		},
+		nil,
+		{
+			Orders:           2,
+			TradedVolume:     decimal.NewFromInt(300),
+			AverageEquity:    decimal.NewFromInt(150),
+			AverageExposure:  decimal.NewFromInt(40),
+			TotalFees:        decimal.NewFromInt(6),
+			ProfitBeforeFees: decimal.NewFromInt(15),
+		},
+	})
+	if resp.Orders != 4 {
+		t.Errorf("received '%v' expected '%v'", resp.Orders, 4)
+	}
+	if !resp.TurnoverRatio.Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received '%v' expected '%v'", resp.TurnoverRatio, 2)
+	}
+	if !resp.AverageExposurePercent.Equal(decimal.NewFromInt(25)) {
+		t.Errorf("received '%v' expected '%v'", resp.AverageExposurePercent, 25)
+	}
+	if !resp.AverageFee.Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received '%v' expected '%v'", resp.AverageFee, 2)
+	}
+	if !resp.FeesPercentOfProfit.Equal(decimal.NewFromInt(40)) {
+		t.Errorf("received '%v' expected '%v'", resp.FeesPercentOfProfit, 40)
+	}
+}
+
+func TestCalculateEquityScaling(t *testing.T) {
+	t.Parallel()
+	events := []EventStore{
+		{OrderEvent: &evtorder.Order{Price: decimal.NewFromInt(10), Amount: decimal.NewFromInt(5)}},
+		{},
+	}
+	if resp := calculateEquityScaling(events); resp != nil {
+		t.Errorf("received '%v' expected '%v'", resp, nil)
+	}
+	events = append(events,
+		EventStore{OrderEvent: &evtorder.Order{
+			Price:          decimal.NewFromInt(10),
+			Amount:         decimal.NewFromInt(5),
+			UnscaledAmount: decimal.NewFromInt(5),
+			EquityScale:    decimal.NewFromInt(1),
+		}},
+		EventStore{OrderEvent: &evtorder.Order{
+			Price:          decimal.NewFromInt(10),
+			Amount:         decimal.NewFromInt(3),
+			UnscaledAmount: decimal.NewFromInt(5),
+			EquityScale:    decimal.NewFromFloat(0.6),
+		}})
+	resp := calculateEquityScaling(events)
+	if resp == nil {
+		t.Fatal("expected equity scaling statistics")
+	}
+	if resp.Orders != 2 || resp.ScaledOrders != 1 {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", resp.Orders, resp.ScaledOrders, 2, 1)
+	}
+	if !resp.AverageScale.Equal(decimal.NewFromInt(80)) || !resp.MinimumScale.Equal(decimal.NewFromInt(60)) {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", resp.AverageScale, resp.MinimumScale, 80, 60)
+	}
+	if !resp.UnscaledValue.Equal(decimal.NewFromInt(100)) || !resp.ReducedValue.Equal(decimal.NewFromInt(20)) {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", resp.UnscaledValue, resp.ReducedValue, 100, 20)
+	}
+}
@@ -1,15 +1,31 @@
 package currencystatistics
 
 import (
+	"errors"
 	"time"
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/compliance"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/risk"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics/regime"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+// maxQuantilePoints is the most quantile points calculated for a return
+// distribution, keeping the results of long runs a reasonable size
+const maxQuantilePoints = 100
+
+var (
+	errInvalidConversionPair = errors.New("conversion pair must be based in the quote currency")
+	errNoConversionRate      = errors.New("no conversion rate available")
+	errNoDataEvents          = errors.New("no data events")
+	errNoRiskFreeRates       = errors.New("no risk free rates")
+	errNegativeRiskFreeRate  = errors.New("risk free rate cannot be negative")
 )
 
 // CurrencyStats defines what is expected in order to
@@ -35,28 +51,218 @@ type EventStore struct {
 
 // CurrencyStatistic Holds all events and statistics relevant to an exchange, asset type and currency pair
 type CurrencyStatistic struct {
-	Events                       []EventStore          `json:"-"`
-	MaxDrawdown                  Swing                 `json:"max-drawdown,omitempty"`
-	StartingClosePrice           decimal.Decimal       `json:"starting-close-price"`
-	EndingClosePrice             decimal.Decimal       `json:"ending-close-price"`
-	LowestClosePrice             decimal.Decimal       `json:"lowest-close-price"`
-	HighestClosePrice            decimal.Decimal       `json:"highest-close-price"`
-	MarketMovement               decimal.Decimal       `json:"market-movement"`
-	StrategyMovement             decimal.Decimal       `json:"strategy-movement"`
-	HighestCommittedFunds        HighestCommittedFunds `json:"highest-committed-funds"`
-	RiskFreeRate                 decimal.Decimal       `json:"risk-free-rate"`
-	BuyOrders                    int64                 `json:"buy-orders"`
-	GeometricRatios              Ratios                `json:"geometric-ratios"`
-	ArithmeticRatios             Ratios                `json:"arithmetic-ratios"`
-	CompoundAnnualGrowthRate     decimal.Decimal       `json:"compound-annual-growth-rate"`
-	SellOrders                   int64                 `json:"sell-orders"`
-	TotalOrders                  int64                 `json:"total-orders"`
-	InitialHoldings              holdings.Holding      `json:"initial-holdings-holdings"`
-	FinalHoldings                holdings.Holding      `json:"final-holdings"`
-	FinalOrders                  compliance.Snapshot   `json:"final-orders"`
-	ShowMissingDataWarning       bool                  `json:"-"`
-	IsStrategyProfitable         bool                  `json:"is-strategy-profitable"`
-	DoesPerformanceBeatTheMarket bool                  `json:"does-performance-beat-the-market"`
+	Events                       []EventStore              `json:"-"`
+	ConversionEvents             []common.DataEventHandler `json:"-"`
+	MaxDrawdown                  Swing                     `json:"max-drawdown,omitempty"`
+	StartingClosePrice           decimal.Decimal           `json:"starting-close-price"`
+	EndingClosePrice             decimal.Decimal           `json:"ending-close-price"`
+	LowestClosePrice             decimal.Decimal           `json:"lowest-close-price"`
+	HighestClosePrice            decimal.Decimal           `json:"highest-close-price"`
+	MarketMovement               decimal.Decimal           `json:"market-movement"`
+	StrategyMovement             decimal.Decimal           `json:"strategy-movement"`
+	HighestCommittedFunds        HighestCommittedFunds     `json:"highest-committed-funds"`
+	RiskFreeRate                 decimal.Decimal           `json:"risk-free-rate"`
+	BuyOrders                    int64                     `json:"buy-orders"`
+	GeometricRatios              Ratios                    `json:"geometric-ratios"`
+	ArithmeticRatios             Ratios                    `json:"arithmetic-ratios"`
+	CompoundAnnualGrowthRate     decimal.Decimal           `json:"compound-annual-growth-rate"`
+	SellOrders                   int64                     `json:"sell-orders"`
+	TotalOrders                  int64                     `json:"total-orders"`
+	InitialHoldings              holdings.Holding          `json:"initial-holdings-holdings"`
+	FinalHoldings                holdings.Holding          `json:"final-holdings"`
+	FinalOrders                  compliance.Snapshot       `json:"final-orders"`
+	ShowMissingDataWarning       bool                      `json:"-"`
+	IsStrategyProfitable         bool                      `json:"is-strategy-profitable"`
+	DoesPerformanceBeatTheMarket bool                      `json:"does-performance-beat-the-market"`
+	ValuationCurrency            currency.Code             `json:"valuation-currency,omitempty"`
+	ConversionPair               currency.Pair             `json:"conversion-pair,omitempty"`
+	IsTerminated                 bool                      `json:"is-terminated"`
+	TerminatedAt                 time.Time                 `json:"terminated-at,omitempty"`
+	CalendarReturns              []YearlyReturns           `json:"calendar-returns,omitempty"`
+	DrawdownEpisodeThreshold     decimal.Decimal           `json:"-"`
+	RiskFreeRateCurve            RiskFreeRateCurve         `json:"-"`
+	DrawdownEpisodes             []DrawdownEpisode         `json:"drawdown-episodes,omitempty"`
+	RoundTrips                   []RoundTrip               `json:"round-trips,omitempty"`
+	AdverseExcursions            *ExcursionDistribution    `json:"adverse-excursions,omitempty"`
+	FavourableExcursions         *ExcursionDistribution    `json:"favourable-excursions,omitempty"`
+	StressTestShocks             []risk.Shock              `json:"-"`
+	StressTestInterval           time.Duration             `json:"-"`
+	StressTests                  []risk.ShockResult        `json:"stress-tests,omitempty"`
+	PeriodicStressTests          []risk.ShockResult        `json:"periodic-stress-tests,omitempty"`
+	Turnover                     *Turnover                 `json:"turnover,omitempty"`
+	MonthlyProfits               []PeriodProfit            `json:"monthly-profits,omitempty"`
+	EquityScaling                *EquityScaling            `json:"equity-scaling,omitempty"`
+	RegimeClassifier             *regime.Classifier        `json:"-"`
+	RegimeStatistics             []RegimeStatistic         `json:"regime-statistics,omitempty"`
+	RegimePeriods                []RegimePeriod            `json:"regime-periods,omitempty"`
+	ReturnDistribution           *ReturnDistribution       `json:"return-distribution,omitempty"`
+}
+
+// ReturnDistribution describes the shape of a pair's percentage returns per
+// candle. Skewness and excess kurtosis are zero for normally distributed
+// returns, with negative skewness and positive excess kurtosis showing larger
+// and more frequent losses than ratios based on the mean and standard
+// deviation assume
+type ReturnDistribution struct {
+	Candles           int64           `json:"candles"`
+	Mean              decimal.Decimal `json:"mean"`
+	StandardDeviation decimal.Decimal `json:"standard-deviation"`
+	Skewness          decimal.Decimal `json:"skewness"`
+	ExcessKurtosis    decimal.Decimal `json:"excess-kurtosis"`
+	Minimum           decimal.Decimal `json:"minimum"`
+	Maximum           decimal.Decimal `json:"maximum"`
+	Buckets           []ReturnBucket  `json:"buckets"`
+	QuantilePoints    []QuantilePoint `json:"quantile-points"`
+}
+
+// ReturnBucket is a histogram bucket counting the returns from its lower bound
+// up to but not including its upper bound, with the last bucket including its
+// upper bound. NormalCount is the number of returns expected in the bucket
+// were they normally distributed with the same mean and standard deviation
+type ReturnBucket struct {
+	Lower       decimal.Decimal `json:"lower"`
+	Upper       decimal.Decimal `json:"upper"`
+	Count       int64           `json:"count"`
+	NormalCount decimal.Decimal `json:"normal-count"`
+}
+
+// QuantilePoint pairs the observed return at a probability with the return
+// expected at the same probability of a normal distribution with the same
+// mean and standard deviation. Plotted against each other, the points of
+// normally distributed returns form a straight line, with fat tails curving
+// away from it at either end
+type QuantilePoint struct {
+	Probability decimal.Decimal `json:"probability"`
+	Normal      decimal.Decimal `json:"normal"`
+	Observed    decimal.Decimal `json:"observed"`
+}
+
+// RegimeStatistic breaks down a pair's results over the candles labelled with
+// a market regime. Each candle's return is the change in total value, or in
+// close price for the market, since the previous candle, with returns
+// compounded over every candle of the regime. Periods are the number of
+// separate times the regime occurred and the win rate is the percentage of
+// the regime's candles with a positive strategy return
+type RegimeStatistic struct {
+	Regime         string          `json:"regime"`
+	Candles        int64           `json:"candles"`
+	CandlesPercent decimal.Decimal `json:"candles-percent"`
+	Periods        int64           `json:"periods"`
+	StrategyReturn decimal.Decimal `json:"strategy-return"`
+	MarketReturn   decimal.Decimal `json:"market-return"`
+	AverageReturn  decimal.Decimal `json:"average-return"`
+	WinRate        decimal.Decimal `json:"win-rate"`
+	Orders         int64           `json:"orders"`
+}
+
+// RegimePeriod is a run of consecutive candles labelled with the same market
+// regime, from the time of its first candle to the time of its last
+type RegimePeriod struct {
+	Regime  string    `json:"regime"`
+	Start   time.Time `json:"start"`
+	End     time.Time `json:"end"`
+	Candles int64     `json:"candles"`
+}
+
+// EquityScaling isolates the effect of equity high-water mark scaling on a
+// pair's buy orders. Scales are percentages of the sized amount and values
+// are the order amounts valued at the order price in the quote currency, so
+// the reduced value is how much less was ordered due to drawdowns
+type EquityScaling struct {
+	Orders        int64           `json:"orders"`
+	ScaledOrders  int64           `json:"scaled-orders"`
+	AverageScale  decimal.Decimal `json:"average-scale"`
+	MinimumScale  decimal.Decimal `json:"minimum-scale"`
+	UnscaledValue decimal.Decimal `json:"unscaled-value"`
+	ScaledValue   decimal.Decimal `json:"scaled-value"`
+	ReducedValue  decimal.Decimal `json:"reduced-value"`
+}
+
+// PeriodProfit is the change in total value over a calendar month in UTC
+// along with the exchange fees paid during it, valued in the valuation
+// currency. The profit is after fees, so the profit before fees is the profit
+// plus the fees. StartValue is the final total value of the previous month,
+// or the first total value of the run for the first month
+type PeriodProfit struct {
+	Start      time.Time       `json:"start"`
+	StartValue decimal.Decimal `json:"start-value"`
+	Profit     decimal.Decimal `json:"profit"`
+	Fees       decimal.Decimal `json:"fees"`
+}
+
+// Turnover measures how much a strategy trades and holds relative to its
+// equity, valued in the valuation currency. The turnover ratio is the traded
+// volume divided by the average equity, exposure is the base currency value
+// held as a percentage of equity and fees are compared against the profit
+// made before fees, showing how much of a strategy's edge is lost to churn.
+// MaximumExposurePercent is only set for individual pairs
+type Turnover struct {
+	Orders                 int64           `json:"orders"`
+	TradedVolume           decimal.Decimal `json:"traded-volume"`
+	AverageEquity          decimal.Decimal `json:"average-equity"`
+	TurnoverRatio          decimal.Decimal `json:"turnover-ratio"`
+	AverageExposure        decimal.Decimal `json:"average-exposure"`
+	AverageExposurePercent decimal.Decimal `json:"average-exposure-percent"`
+	MaximumExposurePercent decimal.Decimal `json:"maximum-exposure-percent,omitempty"`
+	TotalFees              decimal.Decimal `json:"total-fees"`
+	AverageFee             decimal.Decimal `json:"average-fee"`
+	ProfitBeforeFees       decimal.Decimal `json:"profit-before-fees"`
+	// FeesPercentOfProfit is zero when there is no profit before fees
+	FeesPercentOfProfit decimal.Decimal `json:"fees-percent-of-profit"`
+}
+
+// RoundTrip is a position from when it is opened until it is closed. The
+// maximum adverse excursion is the furthest percentage the price moved
+// against the average entry price and the maximum favourable excursion the
+// furthest it moved in favour, using the highs and lows of the candles after
+// the position was opened up to and including the candle it was closed
+type RoundTrip struct {
+	EntryTime                  time.Time       `json:"entry-time"`
+	ExitTime                   time.Time       `json:"exit-time"`
+	EntryPrice                 decimal.Decimal `json:"entry-price"`
+	ExitPrice                  decimal.Decimal `json:"exit-price"`
+	ReturnPercent              decimal.Decimal `json:"return"`
+	MaximumAdverseExcursion    decimal.Decimal `json:"maximum-adverse-excursion"`
+	MaximumFavourableExcursion decimal.Decimal `json:"maximum-favourable-excursion"`
+}
+
+// ExcursionDistribution summarises the excursions of all round trips
+type ExcursionDistribution struct {
+	Minimum             decimal.Decimal `json:"minimum"`
+	LowerQuartile       decimal.Decimal `json:"lower-quartile"`
+	Median              decimal.Decimal `json:"median"`
+	UpperQuartile       decimal.Decimal `json:"upper-quartile"`
+	NinetiethPercentile decimal.Decimal `json:"ninetieth-percentile"`
+	Maximum             decimal.Decimal `json:"maximum"`
+	Mean                decimal.Decimal `json:"mean"`
+}
+
+// DrawdownEpisode is a period where the total value fell below its previous
+// peak, lasting from the peak until the total value recovered to it, or until
+// the end of the run when it did not recover. Durations are in candles
+type DrawdownEpisode struct {
+	Peak              Iteration       `json:"peak"`
+	Trough            Iteration       `json:"trough"`
+	RecoveredAt       time.Time       `json:"recovered-at,omitempty"`
+	IsRecovered       bool            `json:"is-recovered"`
+	DrawdownPercent   decimal.Decimal `json:"drawdown"`
+	DurationIntervals int64           `json:"duration-intervals"`
+	RecoveryIntervals int64           `json:"recovery-intervals"`
+}
+
+// YearlyReturns holds the percentage change in total value for each month of
+// a year and for the year as a whole. Months outside of the run, or periods
+// which started without any value, are nil
+type YearlyReturns struct {
+	Year   int                  `json:"year"`
+	Months [12]*decimal.Decimal `json:"months"`
+	Annual *decimal.Decimal     `json:"annual"`
+}
+
+// convertedEvent values a data event's prices in the valuation currency
+type convertedEvent struct {
+	common.DataEventHandler
+	rate decimal.Decimal
 }
 
 // Ratios stores all the ratios used for statistics
@@ -86,3 +292,14 @@ type HighestCommittedFunds struct {
 	Time  time.Time       `json:"time"`
 	Value decimal.Decimal `json:"value"`
 }
+
+// RiskFreeRatePoint is the annual risk free rate from its start until the
+// start of the next point in a RiskFreeRateCurve
+type RiskFreeRatePoint struct {
+	Start time.Time       `json:"start"`
+	Rate  decimal.Decimal `json:"rate"`
+}
+
+// RiskFreeRateCurve holds risk free rates sorted by start time, allowing
+// multi-year backtests to use the rate of each period rather than a constant
+type RiskFreeRateCurve []RiskFreeRatePoint
@@ -0,0 +1,163 @@
+package regime
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/thrasher-corp/gct-ta/indicators"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+)
+
+// Setup creates a classifier from the regime classification settings
+func Setup(cfg *config.RegimeClassification) (*Classifier, error) {
+	if cfg == nil {
+		return nil, errNilSettings
+	}
+	if cfg.TrendPeriod <= 0 || cfg.VolatilityPeriod <= 0 {
+		return nil, fmt.Errorf("%w received %v and %v", errInvalidPeriod, cfg.TrendPeriod, cfg.VolatilityPeriod)
+	}
+	if !cfg.TrendThreshold.IsPositive() || !cfg.VolatilityThreshold.IsPositive() {
+		return nil, fmt.Errorf("%w received %v and %v", errInvalidThreshold, cfg.TrendThreshold, cfg.VolatilityThreshold)
+	}
+	trend := strings.ToLower(cfg.TrendIndicator)
+	switch trend {
+	case config.RegimeTrendEfficiencyRatio, config.RegimeTrendMovingAverage:
+	default:
+		return nil, fmt.Errorf("%w %v", errInvalidIndicator, cfg.TrendIndicator)
+	}
+	volatility := strings.ToLower(cfg.VolatilityIndicator)
+	switch volatility {
+	case config.RegimeVolatilityATR, config.RegimeVolatilityBollingerWidth:
+	default:
+		return nil, fmt.Errorf("%w %v", errInvalidIndicator, cfg.VolatilityIndicator)
+	}
+	return &Classifier{
+		trendIndicator:      trend,
+		trendPeriod:         int(cfg.TrendPeriod),
+		trendThreshold:      cfg.TrendThreshold.InexactFloat64(),
+		volatilityIndicator: volatility,
+		volatilityPeriod:    int(cfg.VolatilityPeriod),
+		volatilityThreshold: cfg.VolatilityThreshold.InexactFloat64(),
+	}, nil
+}
+
+// Classify returns the regime of each candle, using the indicators'
+// values as of the candle's close. Candles before both indicators have
+// enough data are Unclassified
+func (c *Classifier) Classify(candles []common.DataEventHandler) ([]string, error) {
+	closes := make([]float64, len(candles))
+	highs := make([]float64, len(candles))
+	lows := make([]float64, len(candles))
+	for i := range candles {
+		if candles[i] == nil {
+			return nil, common.ErrNilEvent
+		}
+		closes[i] = candles[i].ClosePrice().InexactFloat64()
+		highs[i] = candles[i].HighPrice().InexactFloat64()
+		lows[i] = candles[i].LowPrice().InexactFloat64()
+	}
+	var trend, volatility indicatorValues
+	switch c.trendIndicator {
+	case config.RegimeTrendEfficiencyRatio:
+		trend = efficiencyRatio(closes, c.trendPeriod)
+	case config.RegimeTrendMovingAverage:
+		trend = movingAverageDistance(closes, c.trendPeriod)
+	default:
+		return nil, fmt.Errorf("%w %v", errInvalidIndicator, c.trendIndicator)
+	}
+	switch c.volatilityIndicator {
+	case config.RegimeVolatilityATR:
+		volatility = atrPercent(highs, lows, closes, c.volatilityPeriod)
+	case config.RegimeVolatilityBollingerWidth:
+		volatility = bollingerWidth(closes, c.volatilityPeriod)
+	default:
+		return nil, fmt.Errorf("%w %v", errInvalidIndicator, c.volatilityIndicator)
+	}
+
+	labels := make([]string, len(candles))
+	ready := trend.ready
+	if volatility.ready > ready {
+		ready = volatility.ready
+	}
+	for i := ready; i < len(candles); i++ {
+		switch {
+		case volatility.values[i] >= c.volatilityThreshold:
+			labels[i] = HighVolatility
+		case trend.values[i] >= c.trendThreshold:
+			labels[i] = Trending
+		default:
+			labels[i] = Ranging
+		}
+	}
+	return labels, nil
+}
+
+// efficiencyRatio returns the net change in close price over the period
+// divided by the sum of the absolute changes of each candle within it. Flat
+// periods have a ratio of zero
+func efficiencyRatio(closes []float64, period int) indicatorValues {
+	resp := indicatorValues{values: make([]float64, len(closes)), ready: period}
+	for i := period; i < len(closes); i++ {
+		var path float64
+		for j := i - period + 1; j <= i; j++ {
+			path += math.Abs(closes[j] - closes[j-1])
+		}
+		if path == 0 {
+			continue
+		}
+		resp.values[i] = math.Abs(closes[i]-closes[i-period]) / path
+	}
+	return resp
+}
+
+// movingAverageDistance returns the percentage distance of the close price
+// from its simple moving average
+func movingAverageDistance(closes []float64, period int) indicatorValues {
+	resp := indicatorValues{values: make([]float64, len(closes)), ready: period - 1}
+	sma := indicators.SMA(closes, period)
+	for i := resp.ready; i < len(closes); i++ {
+		if sma[i] == 0 {
+			continue
+		}
+		resp.values[i] = math.Abs(closes[i]-sma[i]) / sma[i] * 100
+	}
+	return resp
+}
+
+// atrPercent returns the average true range as a percentage of the close
+// price
+func atrPercent(highs, lows, closes []float64, period int) indicatorValues {
+	resp := indicatorValues{values: make([]float64, len(closes)), ready: period}
+	if len(closes) <= period {
+		return resp
+	}
+	atr := indicators.ATR(highs, lows, closes, period)
+	for i := resp.ready; i < len(closes); i++ {
+		if closes[i] == 0 {
+			continue
+		}
+		resp.values[i] = atr[i] / closes[i] * 100
+	}
+	return resp
+}
+
+// bollingerWidth returns the width of Bollinger bands two standard deviations
+// either side of the simple moving average, as a percentage of the moving
+// average
+func bollingerWidth(closes []float64, period int) indicatorValues {
+	resp := indicatorValues{values: make([]float64, len(closes)), ready: period - 1}
+	if len(closes) < period {
+		// not enough candles for the bands to be calculated
+		return resp
+	}
+	upper, middle, lower := indicators.BBANDS(closes, period, 2, 2, indicators.Sma)
+	for i := resp.ready; i < len(closes); i++ {
+		if middle[i] == 0 {
+			continue
+		}
+		resp.values[i] = (upper[i] - lower[i]) / middle[i] * 100
+	}
+	return resp
+}
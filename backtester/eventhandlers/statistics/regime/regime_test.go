@@ -0,0 +1,162 @@
+package regime
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
+)
+
+// createCandles creates candles with the closes, their highs and lows spread
+// either side of the close by the percentage range
+func createCandles(closes []float64, rangePercent float64) []common.DataEventHandler {
+	tt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	resp := make([]common.DataEventHandler, len(closes))
+	for i := range closes {
+		spread := closes[i] * rangePercent / 200
+		resp[i] = &kline.Kline{
+			Base:  event.Base{Time: tt.Add(time.Hour * time.Duration(i))},
+			Close: decimal.NewFromFloat(closes[i]),
+			High:  decimal.NewFromFloat(closes[i] + spread),
+			Low:   decimal.NewFromFloat(closes[i] - spread),
+		}
+	}
+	return resp
+}
+
+func testSettings() *config.RegimeClassification {
+	return &config.RegimeClassification{
+		TrendIndicator:      config.RegimeTrendEfficiencyRatio,
+		TrendPeriod:         5,
+		TrendThreshold:      decimal.NewFromFloat(0.5),
+		VolatilityIndicator: config.RegimeVolatilityATR,
+		VolatilityPeriod:    3,
+		VolatilityThreshold: decimal.NewFromInt(5),
+	}
+}
+
+func TestSetup(t *testing.T) {
+	t.Parallel()
+	_, err := Setup(nil)
+	if !errors.Is(err, errNilSettings) {
+		t.Errorf("received '%v' expected '%v'", err, errNilSettings)
+	}
+	cfg := testSettings()
+	cfg.TrendPeriod = 0
+	_, err = Setup(cfg)
+	if !errors.Is(err, errInvalidPeriod) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidPeriod)
+	}
+	cfg = testSettings()
+	cfg.VolatilityThreshold = decimal.Zero
+	_, err = Setup(cfg)
+	if !errors.Is(err, errInvalidThreshold) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidThreshold)
+	}
+	cfg = testSettings()
+	cfg.TrendIndicator = "adx"
+	_, err = Setup(cfg)
+	if !errors.Is(err, errInvalidIndicator) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidIndicator)
+	}
+	cfg = testSettings()
+	cfg.VolatilityIndicator = "vix"
+	_, err = Setup(cfg)
+	if !errors.Is(err, errInvalidIndicator) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidIndicator)
+	}
+	_, err = Setup(testSettings())
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+}
+
+func TestClassify(t *testing.T) {
+	t.Parallel()
+	c, err := Setup(testSettings())
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = c.Classify([]common.DataEventHandler{nil})
+	if !errors.Is(err, common.ErrNilEvent) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrNilEvent)
+	}
+
+	labels, err := c.Classify(createCandles([]float64{100, 101}, 1))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	for i := range labels {
+		if labels[i] != Unclassified {
+			t.Errorf("received '%v' expected candle %v to be unclassified", labels[i], i)
+		}
+	}
+
+	rising := []float64{100, 101, 102, 103, 104, 105, 106, 107}
+	labels, err = c.Classify(createCandles(rising, 1))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if labels[4] != Unclassified || labels[5] != Trending || labels[7] != Trending {
+		t.Errorf("unexpected trending labels %v", labels)
+	}
+
+	choppy := []float64{100, 101, 100, 101, 100, 101, 100, 101}
+	labels, err = c.Classify(createCandles(choppy, 1))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if labels[7] != Ranging {
+		t.Errorf("unexpected ranging labels %v", labels)
+	}
+
+	labels, err = c.Classify(createCandles(rising, 20))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if labels[7] != HighVolatility {
+		t.Errorf("unexpected high volatility labels %v", labels)
+	}
+
+	cfg := testSettings()
+	cfg.TrendIndicator = config.RegimeTrendMovingAverage
+	cfg.TrendThreshold = decimal.NewFromInt(1)
+	cfg.VolatilityIndicator = config.RegimeVolatilityBollingerWidth
+	cfg.VolatilityPeriod = 5
+	cfg.VolatilityThreshold = decimal.NewFromInt(10)
+	c, err = Setup(cfg)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	labels, err = c.Classify(createCandles(rising, 1))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if labels[3] != Unclassified || labels[4] != Trending {
+		t.Errorf("unexpected moving average labels %v", labels)
+	}
+	labels, err = c.Classify(createCandles([]float64{100, 100, 100, 100, 100, 150, 100, 150}, 1))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if labels[4] != Ranging || labels[7] != HighVolatility {
+		t.Errorf("unexpected bollinger width labels %v", labels)
+	}
+}
+
+func TestEfficiencyRatio(t *testing.T) {
+	t.Parallel()
+	er := efficiencyRatio([]float64{100, 102, 101, 103, 103}, 2)
+	if er.ready != 2 || er.values[2] != 1.0/3 || er.values[3] != 1.0/3 || er.values[4] != 1 {
+		t.Errorf("unexpected efficiency ratio %+v", er)
+	}
+	er = efficiencyRatio([]float64{100, 100, 100}, 2)
+	if er.values[2] != 0 {
+		t.Errorf("received '%v' expected '%v'", er.values[2], 0)
+	}
+}
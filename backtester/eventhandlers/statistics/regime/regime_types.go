@@ -0,0 +1,47 @@
+package regime
+
+import (
+	"errors"
+)
+
+// Regimes candles are labelled with. Candles before the classifier's
+// indicators have enough data are unclassified
+const (
+	HighVolatility = "high-volatility"
+	Trending       = "trending"
+	Ranging        = "ranging"
+	Unclassified   = ""
+)
+
+var (
+	errNilSettings      = errors.New("nil regime classification settings received")
+	errInvalidPeriod    = errors.New("regime classification periods must be positive")
+	errInvalidThreshold = errors.New("regime classification thresholds must be positive")
+	errInvalidIndicator = errors.New("unrecognised regime classification indicator")
+)
+
+// Classifier labels candles with the market regime they occurred in using a
+// trend indicator and a volatility indicator. High volatility takes
+// precedence, so a candle is only trending or ranging when its volatility is
+// below the volatility threshold
+type Classifier struct {
+	trendIndicator      string
+	trendPeriod         int
+	trendThreshold      float64
+	volatilityIndicator string
+	volatilityPeriod    int
+	volatilityThreshold float64
+}
+
+// Regimes returns every regime a classified candle can be labelled with, in
+// the order they are reported
+func Regimes() []string {
+	return []string{Trending, Ranging, HighVolatility}
+}
+
+// indicatorValues holds an indicator's value for each candle along with the
+// index of the first candle it has enough data for
+type indicatorValues struct {
+	values []float64
+	ready  int
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/shopspring/decimal"
@@ -154,6 +155,22 @@ func (s *Statistic) AddComplianceSnapshotForTime(c compliance.Snapshot, e fill.E
 	return nil
 }
 
+// SetPairTerminated marks the exchange asset pair of the data event as
+// terminated at the event's time, such as when its data ends early due to
+// delisting
+func (s *Statistic) SetPairTerminated(ev common.DataEventHandler) error {
+	if ev == nil {
+		return common.ErrNilEvent
+	}
+	lookup := s.ExchangeAssetPairStatistics[ev.GetExchange()][ev.GetAssetType()][ev.Pair()]
+	if lookup == nil {
+		return fmt.Errorf("%w for %v %v %v", errCurrencyStatisticsUnset, ev.GetExchange(), ev.GetAssetType(), ev.Pair())
+	}
+	lookup.IsTerminated = true
+	lookup.TerminatedAt = ev.GetTime()
+	return nil
+}
+
 // CalculateAllResults calculates the statistics of all exchange asset pair holdings,
 // orders, ratios and drawdowns
 func (s *Statistic) CalculateAllResults(funds funding.IFundingManager) error {
@@ -163,6 +180,7 @@ func (s *Statistic) CalculateAllResults(funds funding.IFundingManager) error {
 	var finalResults []FinalResultsHolder
 	var err error
 	var startDate, endDate time.Time
+	s.setConversions()
 	for exchangeName, exchangeMap := range s.ExchangeAssetPairStatistics {
 		for assetItem, assetMap := range exchangeMap {
 			for pair, stats := range assetMap {
@@ -184,6 +202,11 @@ func (s *Statistic) CalculateAllResults(funds funding.IFundingManager) error {
 				if err != nil {
 					return err
 				}
+				stats.DrawdownEpisodeThreshold = s.DrawdownEpisodeThreshold
+				stats.RiskFreeRateCurve = s.RiskFreeRateCurve
+				stats.StressTestShocks = s.StressTestShocks
+				stats.StressTestInterval = s.StressTestInterval
+				stats.RegimeClassifier = s.RegimeClassifier
 				err = stats.CalculateResults(f)
 				if err != nil {
 					log.Error(log.BackTester, err)
@@ -212,6 +235,12 @@ func (s *Statistic) CalculateAllResults(funds funding.IFundingManager) error {
 	}
 	s.Funding = funds.GenerateReport(startDate, endDate)
 	s.TotalOrders = s.TotalBuyOrders + s.TotalSellOrders
+	s.calculateFeesByCurrency()
+	s.printFeesByCurrency()
+	s.calculateTurnoverByCurrency()
+	s.printTurnoverByCurrency(funds.IsUsingExchangeLevelFunding())
+	s.calculateContributionsByCurrency()
+	s.printContributionsByCurrency(funds.IsUsingExchangeLevelFunding())
 	if currCount > 1 {
 		s.BiggestDrawdown = s.GetTheBiggestDrawdownAcrossCurrencies(finalResults)
 		s.BestMarketMovement = s.GetBestMarketPerformer(finalResults)
@@ -222,6 +251,307 @@ func (s *Statistic) CalculateAllResults(funds funding.IFundingManager) error {
 	return nil
 }
 
+// calculateFeesByCurrency totals the exchange fees of all fills by the
+// currency they were paid in
+func (s *Statistic) calculateFeesByCurrency() {
+	totals := make(map[string]*FeeTotal)
+	for _, exchangeMap := range s.ExchangeAssetPairStatistics {
+		for _, assetMap := range exchangeMap {
+			for _, stats := range assetMap {
+				for i := range stats.Events {
+					if stats.Events[i].FillEvent == nil ||
+						stats.Events[i].FillEvent.GetFeeAmount().LessThanOrEqual(decimal.Zero) {
+						continue
+					}
+					code := stats.Events[i].FillEvent.GetFeeCurrency()
+					total, ok := totals[code.Upper().String()]
+					if !ok {
+						total = &FeeTotal{Currency: code.Upper()}
+						totals[code.Upper().String()] = total
+					}
+					total.Amount = total.Amount.Add(stats.Events[i].FillEvent.GetFeeAmount())
+				}
+			}
+		}
+	}
+	s.TotalFeesByCurrency = make([]FeeTotal, 0, len(totals))
+	for _, total := range totals {
+		s.TotalFeesByCurrency = append(s.TotalFeesByCurrency, *total)
+	}
+	sort.Slice(s.TotalFeesByCurrency, func(i, j int) bool {
+		return s.TotalFeesByCurrency[i].Currency.String() < s.TotalFeesByCurrency[j].Currency.String()
+	})
+}
+
+// printFeesByCurrency outputs the total exchange fees paid in each currency
+func (s *Statistic) printFeesByCurrency() {
+	if len(s.TotalFeesByCurrency) == 0 {
+		return
+	}
+	log.Info(log.BackTester, "------------------Fees---------------------------------------")
+	for i := range s.TotalFeesByCurrency {
+		log.Infof(log.BackTester, "Total fees paid in %v: %v", s.TotalFeesByCurrency[i].Currency, s.TotalFeesByCurrency[i].Amount.Round(8))
+	}
+	log.Info(log.BackTester, "")
+}
+
+// calculateTurnoverByCurrency combines the turnover of all pairs valued in
+// the same currency, as pairs valued in different currencies cannot be added
+// together
+func (s *Statistic) calculateTurnoverByCurrency() {
+	turnovers := make(map[string][]*currencystatistics.Turnover)
+	codes := make(map[string]currency.Code)
+	for _, exchangeMap := range s.ExchangeAssetPairStatistics {
+		for _, assetMap := range exchangeMap {
+			for pair, stats := range assetMap {
+				if stats.Turnover == nil {
+					continue
+				}
+				code := valuationCurrency(pair, stats)
+				codes[code.String()] = code
+				turnovers[code.String()] = append(turnovers[code.String()], stats.Turnover)
+			}
+		}
+	}
+	s.TurnoverByCurrency = make([]TurnoverTotal, 0, len(turnovers))
+	for key, t := range turnovers {
+		s.TurnoverByCurrency = append(s.TurnoverByCurrency, TurnoverTotal{
+			Currency: codes[key],
+			Pairs:    len(t),
+			Turnover: currencystatistics.CombineTurnover(t),
+		})
+	}
+	sort.Slice(s.TurnoverByCurrency, func(i, j int) bool {
+		return s.TurnoverByCurrency[i].Currency.String() < s.TurnoverByCurrency[j].Currency.String()
+	})
+}
+
+// printTurnoverByCurrency outputs the combined turnover of each valuation
+// currency
+func (s *Statistic) printTurnoverByCurrency(isUsingExchangeLevelFunding bool) {
+	if len(s.TurnoverByCurrency) == 0 {
+		return
+	}
+	log.Info(log.BackTester, "------------------Portfolio Turnover-------------------------")
+	if isUsingExchangeLevelFunding {
+		log.Warn(log.BackTester, "This strategy is using Exchange Level Funding. Combined equity and turnover may be inaccurate")
+	}
+	for i := range s.TurnoverByCurrency {
+		t := s.TurnoverByCurrency[i].Turnover
+		log.Infof(log.BackTester, "%v across %d pairs: traded volume %v, turnover ratio %v, average exposure %v%%, fees %v%% of profit before fees",
+			s.TurnoverByCurrency[i].Currency,
+			s.TurnoverByCurrency[i].Pairs,
+			t.TradedVolume.Round(8),
+			t.TurnoverRatio.Round(4),
+			t.AverageExposurePercent.Round(2),
+			t.FeesPercentOfProfit.Round(2))
+	}
+	log.Info(log.BackTester, "")
+}
+
+// calculateContributionsByCurrency breaks down the combined return of all
+// pairs valued in the same currency by pair and by calendar month
+func (s *Statistic) calculateContributionsByCurrency() {
+	type pairProfits struct {
+		contribution PairContribution
+		months       []currencystatistics.PeriodProfit
+	}
+	groups := make(map[string][]pairProfits)
+	codes := make(map[string]currency.Code)
+	for exchangeName, exchangeMap := range s.ExchangeAssetPairStatistics {
+		for assetItem, assetMap := range exchangeMap {
+			for pair, stats := range assetMap {
+				if len(stats.MonthlyProfits) == 0 {
+					continue
+				}
+				p := pairProfits{
+					contribution: PairContribution{
+						Exchange: exchangeName,
+						Asset:    assetItem,
+						Pair:     pair,
+					},
+					months: stats.MonthlyProfits,
+				}
+				for i := range stats.MonthlyProfits {
+					p.contribution.Profit = p.contribution.Profit.Add(stats.MonthlyProfits[i].Profit)
+					p.contribution.Fees = p.contribution.Fees.Add(stats.MonthlyProfits[i].Fees)
+				}
+				p.contribution.ProfitBeforeFees = p.contribution.Profit.Add(p.contribution.Fees)
+				code := valuationCurrency(pair, stats)
+				codes[code.String()] = code
+				groups[code.String()] = append(groups[code.String()], p)
+			}
+		}
+	}
+	s.ContributionsByCurrency = make([]ContributionTotal, 0, len(groups))
+	for key, group := range groups {
+		sort.Slice(group, func(i, j int) bool {
+			a, b := group[i].contribution, group[j].contribution
+			if a.Exchange != b.Exchange {
+				return a.Exchange < b.Exchange
+			}
+			if a.Asset != b.Asset {
+				return a.Asset < b.Asset
+			}
+			return a.Pair.String() < b.Pair.String()
+		})
+		total := ContributionTotal{
+			Currency: codes[key],
+			Pairs:    make([]PairContribution, len(group)),
+			Total:    ContributionPeriod{Contributions: make([]decimal.Decimal, len(group))},
+		}
+		months := make(map[int64]*ContributionPeriod)
+		for i := range group {
+			total.Pairs[i] = group[i].contribution
+			first := group[i].months[0]
+			if total.Total.Start.IsZero() || first.Start.Before(total.Total.Start) {
+				total.Total.Start = first.Start
+			}
+			total.Total.StartValue = total.Total.StartValue.Add(first.StartValue)
+			total.Total.Profit = total.Total.Profit.Add(group[i].contribution.Profit)
+			total.Total.Fees = total.Total.Fees.Add(group[i].contribution.Fees)
+			total.Total.Contributions[i] = group[i].contribution.ProfitBeforeFees
+			for j := range group[i].months {
+				m := group[i].months[j]
+				period, ok := months[m.Start.Unix()]
+				if !ok {
+					period = &ContributionPeriod{
+						Start:         m.Start,
+						Contributions: make([]decimal.Decimal, len(group)),
+					}
+					months[m.Start.Unix()] = period
+				}
+				period.StartValue = period.StartValue.Add(m.StartValue)
+				period.Profit = period.Profit.Add(m.Profit)
+				period.Fees = period.Fees.Add(m.Fees)
+				period.Contributions[i] = m.Profit.Add(m.Fees)
+			}
+		}
+		total.Total.setPercentages()
+		for _, period := range months {
+			period.setPercentages()
+			total.Months = append(total.Months, *period)
+		}
+		sort.Slice(total.Months, func(i, j int) bool {
+			return total.Months[i].Start.Before(total.Months[j].Start)
+		})
+		s.ContributionsByCurrency = append(s.ContributionsByCurrency, total)
+	}
+	sort.Slice(s.ContributionsByCurrency, func(i, j int) bool {
+		return s.ContributionsByCurrency[i].Currency.String() < s.ContributionsByCurrency[j].Currency.String()
+	})
+}
+
+// setPercentages converts the period's contributions from profits before fees
+// into percentages of its starting value and sets its return and fees as
+// percentages. Periods which started without any value have no percentages
+func (c *ContributionPeriod) setPercentages() {
+	if !c.StartValue.IsPositive() {
+		for i := range c.Contributions {
+			c.Contributions[i] = decimal.Zero
+		}
+		return
+	}
+	oneHundred := decimal.NewFromInt(100)
+	c.ReturnPercent = c.Profit.Div(c.StartValue).Mul(oneHundred)
+	c.FeesPercent = c.Fees.Div(c.StartValue).Mul(oneHundred)
+	for i := range c.Contributions {
+		c.Contributions[i] = c.Contributions[i].Div(c.StartValue).Mul(oneHundred)
+	}
+}
+
+// printContributionsByCurrency outputs the contribution of each pair to the
+// combined return of each valuation currency, for the run and for each month
+func (s *Statistic) printContributionsByCurrency(isUsingExchangeLevelFunding bool) {
+	if len(s.ContributionsByCurrency) == 0 {
+		return
+	}
+	log.Info(log.BackTester, "------------------Return Contributions-----------------------")
+	if isUsingExchangeLevelFunding {
+		log.Warn(log.BackTester, "This strategy is using Exchange Level Funding. Combined returns and contributions may be inaccurate")
+	}
+	for i := range s.ContributionsByCurrency {
+		c := &s.ContributionsByCurrency[i]
+		log.Infof(log.BackTester, "%v return: %v%%, fees: %v%%", c.Currency, c.Total.ReturnPercent.Round(2), c.Total.FeesPercent.Round(2))
+		for j := range c.Pairs {
+			log.Infof(log.BackTester, "%v %v %v %v contributed %v%% before fees, profit %v, fees %v",
+				c.Currency,
+				c.Pairs[j].Exchange,
+				c.Pairs[j].Asset,
+				c.Pairs[j].Pair,
+				c.Total.Contributions[j].Round(2),
+				c.Pairs[j].Profit.Round(8),
+				c.Pairs[j].Fees.Round(8))
+		}
+		for j := range c.Months {
+			contributions := make([]string, len(c.Pairs))
+			for k := range c.Pairs {
+				contributions[k] = fmt.Sprintf("%v %v%%", c.Pairs[k].Pair, c.Months[j].Contributions[k].Round(2))
+			}
+			log.Infof(log.BackTester, "%v %v return: %v%%, fees: %v%%, %v",
+				c.Currency,
+				c.Months[j].Start.Format("2006-01"),
+				c.Months[j].ReturnPercent.Round(2),
+				c.Months[j].FeesPercent.Round(2),
+				strings.Join(contributions, ", "))
+		}
+	}
+	log.Info(log.BackTester, "")
+}
+
+// valuationCurrency returns the currency a pair's statistics are valued in
+func valuationCurrency(pair currency.Pair, stats *currencystatistics.CurrencyStatistic) currency.Code {
+	if !stats.ValuationCurrency.IsEmpty() {
+		return stats.ValuationCurrency.Upper()
+	}
+	return pair.Quote.Upper()
+}
+
+// setConversions values the statistics of each pair quoted in a
+// cryptocurrency, such as ETH/BTC, through a pair on the same exchange and asset
+// which converts its quote currency into a fiat currency or stablecoin, such as
+// BTC/USDT. Without a conversion pair, statistics remain valued in the quote
+// currency and cannot be compared against pairs with other quote currencies
+func (s *Statistic) setConversions() {
+	for exchangeName, exchangeMap := range s.ExchangeAssetPairStatistics {
+		for assetItem, assetMap := range exchangeMap {
+			for pair, stats := range assetMap {
+				if isValuationCurrency(pair.Quote) || len(stats.Events) == 0 {
+					continue
+				}
+				var candidates currency.Pairs
+				for conversionPair := range assetMap {
+					if conversionPair.Base.Match(pair.Quote) && isValuationCurrency(conversionPair.Quote) {
+						candidates = append(candidates, conversionPair)
+					}
+				}
+				if len(candidates) == 0 {
+					log.Warnf(log.BackTester, "%v %v %v no conversion pair found, statistics are valued in %v",
+						exchangeName, assetItem, pair, pair.Quote)
+					continue
+				}
+				sort.Slice(candidates, func(i, j int) bool {
+					return candidates[i].String() < candidates[j].String()
+				})
+				conversionStats := assetMap[candidates[0]]
+				events := make([]common.DataEventHandler, len(conversionStats.Events))
+				for i := range conversionStats.Events {
+					events[i] = conversionStats.Events[i].DataEvent
+				}
+				err := stats.SetConversion(candidates[0], events)
+				if err != nil {
+					log.Errorf(log.BackTester, "%v %v %v %v", exchangeName, assetItem, pair, err)
+				}
+			}
+		}
+	}
+}
+
+// isValuationCurrency returns whether statistics can be valued in the currency
+func isValuationCurrency(c currency.Code) bool {
+	return c.IsFiatCurrency() || c.IsStablecoin()
+}
+
 // PrintTotalResults outputs all results to the CMD
 func (s *Statistic) PrintTotalResults(isUsingExchangeLevelFunding bool) {
 	log.Info(log.BackTester, "------------------Strategy-----------------------------------")
@@ -248,6 +578,12 @@ func (s *Statistic) PrintTotalResults(isUsingExchangeLevelFunding bool) {
 		if s.Funding.Items[i].TransferFee.GreaterThan(decimal.Zero) {
 			log.Infof(log.BackTester, "Transfer fee: %v", s.Funding.Items[i].TransferFee)
 		}
+		if s.Funding.Items[i].TransferFeesPaid.GreaterThan(decimal.Zero) {
+			log.Infof(log.BackTester, "Transfer fees paid: %v", s.Funding.Items[i].TransferFeesPaid)
+		}
+		if s.Funding.Items[i].InTransit.GreaterThan(decimal.Zero) {
+			log.Infof(log.BackTester, "Funds in transit: %v", s.Funding.Items[i].InTransit)
+		}
 		log.Info(log.BackTester, "")
 	}
 	log.Infof(log.BackTester, "Initial total funds in USD: $%v", s.Funding.InitialTotalUSD)
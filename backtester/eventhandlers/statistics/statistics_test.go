@@ -78,6 +78,51 @@ func TestAddDataEventForTime(t *testing.T) {
 	}
 }
 
+func TestSetPairTerminated(t *testing.T) {
+	t.Parallel()
+	tt := time.Now()
+	exch := testExchange
+	a := asset.Spot
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	s := Statistic{}
+	err := s.SetPairTerminated(nil)
+	if !errors.Is(err, common.ErrNilEvent) {
+		t.Errorf("received: %v, expected: %v", err, common.ErrNilEvent)
+	}
+	ev := &kline.Kline{
+		Base: event.Base{
+			Exchange:     exch,
+			Time:         tt,
+			Interval:     gctkline.OneDay,
+			CurrencyPair: p,
+			AssetType:    a,
+		},
+		Open:   eleet,
+		Close:  eleet,
+		Low:    eleet,
+		High:   eleet,
+		Volume: eleet,
+	}
+	err = s.SetPairTerminated(ev)
+	if !errors.Is(err, errCurrencyStatisticsUnset) {
+		t.Errorf("received: %v, expected: %v", err, errCurrencyStatisticsUnset)
+	}
+	err = s.SetupEventForTime(ev)
+	if err != nil {
+		t.Error(err)
+	}
+	err = s.SetPairTerminated(ev)
+	if err != nil {
+		t.Error(err)
+	}
+	if !s.ExchangeAssetPairStatistics[exch][a][p].IsTerminated {
+		t.Error("expected terminated")
+	}
+	if !s.ExchangeAssetPairStatistics[exch][a][p].TerminatedAt.Equal(tt) {
+		t.Errorf("received '%v' expected '%v'", s.ExchangeAssetPairStatistics[exch][a][p].TerminatedAt, tt)
+	}
+}
+
 func TestAddSignalEventForTime(t *testing.T) {
 	t.Parallel()
 	tt := time.Now()
@@ -780,3 +825,183 @@ func TestCalculateTheResults(t *testing.T) {
 		t.Errorf("received '%v' expected '%v'", err, nil)
 	}
 }
+
+func TestSetConversions(t *testing.T) {
+	t.Parallel()
+	tt := time.Now()
+	newStats := func(p currency.Pair) *currencystatistics.CurrencyStatistic {
+		return &currencystatistics.CurrencyStatistic{
+			Events: []currencystatistics.EventStore{{
+				DataEvent: &kline.Kline{
+					Base: event.Base{
+						Exchange:     testExchange,
+						Time:         tt,
+						Interval:     gctkline.OneDay,
+						CurrencyPair: p,
+						AssetType:    asset.Spot,
+					},
+					Close: eleet,
+				},
+			}},
+		}
+	}
+	ethBTC := currency.NewPair(currency.ETH, currency.BTC)
+	ltcBTC := currency.NewPair(currency.LTC, currency.BTC)
+	btcUSDT := currency.NewPair(currency.BTC, currency.USDT)
+	ethLTC := currency.NewPair(currency.ETH, currency.LTC)
+	s := Statistic{
+		ExchangeAssetPairStatistics: map[string]map[asset.Item]map[currency.Pair]*currencystatistics.CurrencyStatistic{
+			testExchange: {
+				asset.Spot: {
+					ethBTC:  newStats(ethBTC),
+					ltcBTC:  newStats(ltcBTC),
+					btcUSDT: newStats(btcUSDT),
+					ethLTC:  newStats(ethLTC),
+				},
+			},
+		},
+	}
+	s.setConversions()
+	stats := s.ExchangeAssetPairStatistics[testExchange][asset.Spot]
+	for _, p := range []currency.Pair{ethBTC, ltcBTC} {
+		if !stats[p].ConversionPair.Equal(btcUSDT) || !stats[p].ValuationCurrency.Match(currency.USDT) {
+			t.Errorf("%v received '%v' expected '%v'", p, stats[p].ConversionPair, btcUSDT)
+		}
+		if len(stats[p].ConversionEvents) != 1 {
+			t.Errorf("%v received '%v' expected '%v'", p, len(stats[p].ConversionEvents), 1)
+		}
+	}
+	if !stats[btcUSDT].ConversionPair.IsEmpty() {
+		t.Errorf("received '%v' expected no conversion pair", stats[btcUSDT].ConversionPair)
+	}
+	if !stats[ethLTC].ConversionPair.IsEmpty() {
+		t.Errorf("received '%v' expected no conversion pair", stats[ethLTC].ConversionPair)
+	}
+}
+
+func TestCalculateFeesByCurrency(t *testing.T) {
+	t.Parallel()
+	btcUSDT := currency.NewPair(currency.BTC, currency.USDT)
+	ethUSDT := currency.NewPair(currency.ETH, currency.USDT)
+	s := Statistic{
+		ExchangeAssetPairStatistics: map[string]map[asset.Item]map[currency.Pair]*currencystatistics.CurrencyStatistic{
+			testExchange: {
+				asset.Spot: {
+					btcUSDT: {
+						Events: []currencystatistics.EventStore{
+							{FillEvent: &fill.Fill{FeeCurrency: currency.BNB, FeeAmount: decimal.NewFromInt(1)}},
+							{FillEvent: &fill.Fill{FeeCurrency: currency.USDT, FeeAmount: decimal.NewFromInt(2)}},
+							{FillEvent: &fill.Fill{}},
+							{},
+						},
+					},
+					ethUSDT: {
+						Events: []currencystatistics.EventStore{
+							{FillEvent: &fill.Fill{FeeCurrency: currency.BNB, FeeAmount: decimal.NewFromInt(3)}},
+						},
+					},
+				},
+			},
+		},
+	}
+	s.calculateFeesByCurrency()
+	if len(s.TotalFeesByCurrency) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(s.TotalFeesByCurrency), 2)
+	}
+	if !s.TotalFeesByCurrency[0].Currency.Match(currency.BNB) ||
+		!s.TotalFeesByCurrency[0].Amount.Equal(decimal.NewFromInt(4)) {
+		t.Errorf("received '%v' expected '%v %v'", s.TotalFeesByCurrency[0], currency.BNB, 4)
+	}
+	if !s.TotalFeesByCurrency[1].Currency.Match(currency.USDT) ||
+		!s.TotalFeesByCurrency[1].Amount.Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received '%v' expected '%v %v'", s.TotalFeesByCurrency[1], currency.USDT, 2)
+	}
+	s.printFeesByCurrency()
+}
+
+func TestCalculateTurnoverByCurrency(t *testing.T) {
+	t.Parallel()
+	btcUSDT := currency.NewPair(currency.BTC, currency.USDT)
+	ethUSDT := currency.NewPair(currency.ETH, currency.USDT)
+	ethBTC := currency.NewPair(currency.ETH, currency.BTC)
+	s := Statistic{
+		ExchangeAssetPairStatistics: map[string]map[asset.Item]map[currency.Pair]*currencystatistics.CurrencyStatistic{
+			testExchange: {
+				asset.Spot: {
+					btcUSDT: {Turnover: &currencystatistics.Turnover{Orders: 1, TradedVolume: decimal.NewFromInt(10)}},
+					ethUSDT: {Turnover: &currencystatistics.Turnover{Orders: 2, TradedVolume: decimal.NewFromInt(20)}},
+					ethBTC:  {Turnover: &currencystatistics.Turnover{Orders: 3}, ValuationCurrency: currency.USD},
+				},
+			},
+		},
+	}
+	s.calculateTurnoverByCurrency()
+	if len(s.TurnoverByCurrency) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(s.TurnoverByCurrency), 2)
+	}
+	if !s.TurnoverByCurrency[0].Currency.Match(currency.USD) || s.TurnoverByCurrency[0].Turnover.Orders != 3 {
+		t.Errorf("received '%v' expected '%v'", s.TurnoverByCurrency[0], currency.USD)
+	}
+	if !s.TurnoverByCurrency[1].Currency.Match(currency.USDT) ||
+		s.TurnoverByCurrency[1].Pairs != 2 ||
+		!s.TurnoverByCurrency[1].Turnover.TradedVolume.Equal(decimal.NewFromInt(30)) {
+		t.Errorf("received '%v' expected '%v'", s.TurnoverByCurrency[1], currency.USDT)
+	}
+	s.printTurnoverByCurrency(true)
+}
+
+func TestCalculateContributionsByCurrency(t *testing.T) {
+	t.Parallel()
+	jan := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	feb := time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)
+	btcUSDT := currency.NewPair(currency.BTC, currency.USDT)
+	ethUSDT := currency.NewPair(currency.ETH, currency.USDT)
+	s := Statistic{
+		ExchangeAssetPairStatistics: map[string]map[asset.Item]map[currency.Pair]*currencystatistics.CurrencyStatistic{
+			testExchange: {
+				asset.Spot: {
+					btcUSDT: {MonthlyProfits: []currencystatistics.PeriodProfit{
+						{Start: jan, StartValue: decimal.NewFromInt(100), Profit: decimal.NewFromInt(10), Fees: decimal.NewFromInt(2)},
+						{Start: feb, StartValue: decimal.NewFromInt(110), Profit: decimal.NewFromInt(-5), Fees: decimal.NewFromInt(1)},
+					}},
+					ethUSDT: {MonthlyProfits: []currencystatistics.PeriodProfit{
+						{Start: feb, StartValue: decimal.NewFromInt(90), Profit: decimal.NewFromInt(15), Fees: decimal.NewFromInt(1)},
+					}},
+					currency.NewPair(currency.LTC, currency.USDT): {},
+				},
+			},
+		},
+	}
+	s.calculateContributionsByCurrency()
+	if len(s.ContributionsByCurrency) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(s.ContributionsByCurrency), 1)
+	}
+	c := s.ContributionsByCurrency[0]
+	if !c.Currency.Match(currency.USDT) || len(c.Pairs) != 2 || !c.Pairs[0].Pair.Equal(btcUSDT) {
+		t.Fatalf("unexpected contributions %+v", c)
+	}
+	if !c.Pairs[0].Profit.Equal(decimal.NewFromInt(5)) || !c.Pairs[0].ProfitBeforeFees.Equal(decimal.NewFromInt(8)) {
+		t.Errorf("unexpected pair contribution %+v", c.Pairs[0])
+	}
+	// the run started with 190 across both pairs and made 20 after 4 in fees
+	if !c.Total.Start.Equal(jan) || !c.Total.StartValue.Equal(decimal.NewFromInt(190)) {
+		t.Errorf("unexpected total %+v", c.Total)
+	}
+	if !c.Total.Contributions[0].Add(c.Total.Contributions[1]).Sub(c.Total.FeesPercent).Equal(c.Total.ReturnPercent) {
+		t.Errorf("expected contributions less fees to equal the return %+v", c.Total)
+	}
+	if len(c.Months) != 2 || !c.Months[0].Start.Equal(jan) || !c.Months[1].Start.Equal(feb) {
+		t.Fatalf("unexpected months %+v", c.Months)
+	}
+	if !c.Months[0].ReturnPercent.Equal(decimal.NewFromInt(10)) || !c.Months[0].Contributions[1].IsZero() {
+		t.Errorf("unexpected first month %+v", c.Months[0])
+	}
+	// february started with 200 across both pairs
+	if !c.Months[1].Contributions[0].Equal(decimal.NewFromInt(-2)) ||
+		!c.Months[1].Contributions[1].Equal(decimal.NewFromInt(8)) ||
+		!c.Months[1].FeesPercent.Equal(decimal.NewFromInt(1)) ||
+		!c.Months[1].ReturnPercent.Equal(decimal.NewFromInt(5)) {
+		t.Errorf("unexpected second month %+v", c.Months[1])
+	}
+	s.printContributionsByCurrency(true)
+}
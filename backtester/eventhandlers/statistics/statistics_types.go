@@ -8,7 +8,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/compliance"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/risk"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics/currencystatistics"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics/regime"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
 	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -32,6 +34,11 @@ type Statistic struct {
 	StrategyGoal                string                                                                            `json:"strategy-goal"`
 	ExchangeAssetPairStatistics map[string]map[asset.Item]map[currency.Pair]*currencystatistics.CurrencyStatistic `json:"-"`
 	RiskFreeRate                decimal.Decimal                                                                   `json:"risk-free-rate"`
+	DrawdownEpisodeThreshold    decimal.Decimal                                                                   `json:"drawdown-episode-threshold"`
+	RiskFreeRateCurve           currencystatistics.RiskFreeRateCurve                                              `json:"risk-free-rate-curve,omitempty"`
+	StressTestShocks            []risk.Shock                                                                      `json:"stress-test-shocks,omitempty"`
+	StressTestInterval          time.Duration                                                                     `json:"stress-test-interval,omitempty"`
+	RegimeClassifier            *regime.Classifier                                                                `json:"-"`
 	TotalBuyOrders              int64                                                                             `json:"total-buy-orders"`
 	TotalSellOrders             int64                                                                             `json:"total-sell-orders"`
 	TotalOrders                 int64                                                                             `json:"total-orders"`
@@ -41,6 +48,59 @@ type Statistic struct {
 	AllStats                    []currencystatistics.CurrencyStatistic                                            `json:"results"` // as ExchangeAssetPairStatistics cannot be rendered via json.Marshall, we append all result to this slice instead
 	WasAnyDataMissing           bool                                                                              `json:"was-any-data-missing"`
 	Funding                     *funding.Report                                                                   `json:"funding"`
+	TotalFeesByCurrency         []FeeTotal                                                                        `json:"total-fees-by-currency"`
+	TurnoverByCurrency          []TurnoverTotal                                                                   `json:"turnover-by-currency,omitempty"`
+	ContributionsByCurrency     []ContributionTotal                                                               `json:"contributions-by-currency,omitempty"`
+}
+
+// ContributionTotal breaks down the combined return of all pairs valued in a
+// currency into the contribution of each pair and the cost of fees, for the
+// whole run and for each calendar month. Contributions are percentages of the
+// combined total value at the start of the period, so a period's pair
+// contributions less its fees add up to its return
+type ContributionTotal struct {
+	Currency currency.Code        `json:"currency"`
+	Pairs    []PairContribution   `json:"pairs"`
+	Total    ContributionPeriod   `json:"total"`
+	Months   []ContributionPeriod `json:"months"`
+}
+
+// PairContribution holds a pair's profit over the whole run. Profit is after
+// fees
+type PairContribution struct {
+	Exchange         string          `json:"exchange"`
+	Asset            asset.Item      `json:"asset"`
+	Pair             currency.Pair   `json:"pair"`
+	Profit           decimal.Decimal `json:"profit"`
+	Fees             decimal.Decimal `json:"fees"`
+	ProfitBeforeFees decimal.Decimal `json:"profit-before-fees"`
+}
+
+// ContributionPeriod is the return of a period and what drove it.
+// Contributions holds the profit before fees of each pair as a percentage of
+// the period's starting value, in the same order as the pairs of its
+// ContributionTotal
+type ContributionPeriod struct {
+	Start         time.Time         `json:"start"`
+	StartValue    decimal.Decimal   `json:"start-value"`
+	Profit        decimal.Decimal   `json:"profit"`
+	Fees          decimal.Decimal   `json:"fees"`
+	ReturnPercent decimal.Decimal   `json:"return"`
+	FeesPercent   decimal.Decimal   `json:"fees-percent"`
+	Contributions []decimal.Decimal `json:"contributions"`
+}
+
+// TurnoverTotal holds the combined turnover of all pairs valued in a currency
+type TurnoverTotal struct {
+	Currency currency.Code                `json:"currency"`
+	Pairs    int                          `json:"pairs"`
+	Turnover *currencystatistics.Turnover `json:"turnover"`
+}
+
+// FeeTotal holds the total exchange fees paid in a currency
+type FeeTotal struct {
+	Currency currency.Code   `json:"currency"`
+	Amount   decimal.Decimal `json:"amount"`
 }
 
 // FinalResultsHolder holds important stats about a currency's performance
@@ -60,6 +120,7 @@ type Handler interface {
 	SetEventForOffset(common.EventHandler) error
 	AddHoldingsForTime(*holdings.Holding) error
 	AddComplianceSnapshotForTime(compliance.Snapshot, fill.Event) error
+	SetPairTerminated(common.DataEventHandler) error
 	CalculateAllResults(funding.IFundingManager) error
 	Reset()
 	Serialise() (string, error)
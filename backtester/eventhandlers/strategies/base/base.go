@@ -1,8 +1,12 @@
 package base
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
 )
@@ -11,6 +15,12 @@ import (
 type Strategy struct {
 	useSimultaneousProcessing bool
 	usingExchangeLevelFunding bool
+	positions                 PositionReader
+	// timers is a pointer so that strategies embedding Strategy remain
+	// comparable
+	timers *[]*Timer
+	// settingsChanges is a pointer for the same reason as timers
+	settingsChanges *[]SettingsChange
 }
 
 // GetBaseData returns the non-interface version of the Handler
@@ -49,6 +59,12 @@ func (s *Strategy) SetSimultaneousProcessing(b bool) {
 	s.useSimultaneousProcessing = b
 }
 
+// CustomSettings returns the strategy's custom settings in the form accepted
+// by SetCustomSettings. Strategies without custom settings return nil
+func (s *Strategy) CustomSettings() map[string]interface{} {
+	return nil
+}
+
 // UsingExchangeLevelFunding returns whether funding is based on currency pairs or individual currencies at the exchange level
 func (s *Strategy) UsingExchangeLevelFunding() bool {
 	return s.usingExchangeLevelFunding
@@ -58,3 +74,136 @@ func (s *Strategy) UsingExchangeLevelFunding() bool {
 func (s *Strategy) SetExchangeLevelFunding(b bool) {
 	s.usingExchangeLevelFunding = b
 }
+
+// SetPositionReader sets where the strategy retrieves its holdings and
+// open positions from
+func (s *Strategy) SetPositionReader(p PositionReader) {
+	s.positions = p
+}
+
+// GetPosition returns the current holdings and open position, including its
+// size and entry price, for the latest data event's exchange asset pair
+func (s *Strategy) GetPosition(d data.Handler) (*holdings.Position, error) {
+	if d == nil {
+		return nil, common.ErrNilArguments
+	}
+	latest := d.Latest()
+	if latest == nil {
+		return nil, common.ErrNilEvent
+	}
+	if s.positions == nil {
+		return nil, ErrPositionsUnavailable
+	}
+	return s.positions.GetPosition(latest.GetExchange(), latest.GetAssetType(), latest.Pair())
+}
+
+// AddTimer schedules a time-based callback for the strategy. Adding a timer
+// with the name of an existing timer replaces it, allowing timers to be set
+// whenever defaults or custom settings are applied
+func (s *Strategy) AddTimer(t *Timer) error {
+	if t == nil {
+		return fmt.Errorf("%w nil timer", ErrInvalidTimer)
+	}
+	if t.Name == "" {
+		return fmt.Errorf("%w name unset", ErrInvalidTimer)
+	}
+	if t.Interval <= 0 {
+		return fmt.Errorf("%w %v interval must be greater than zero", ErrInvalidTimer, t.Name)
+	}
+	if t.Offset < 0 || t.Offset >= t.Interval {
+		return fmt.Errorf("%w %v offset %v must be at least zero and less than its interval %v", ErrInvalidTimer, t.Name, t.Offset, t.Interval)
+	}
+	if t.OnTimer == nil {
+		return fmt.Errorf("%w %v callback unset", ErrInvalidTimer, t.Name)
+	}
+	if s.timers == nil {
+		s.timers = &[]*Timer{}
+	}
+	timers := *s.timers
+	for i := range timers {
+		if timers[i].Name == t.Name {
+			timers[i] = t
+			return nil
+		}
+	}
+	*s.timers = append(timers, t)
+	return nil
+}
+
+// Timers returns the strategy's timers
+func (s *Strategy) Timers() []*Timer {
+	if s.timers == nil {
+		return nil
+	}
+	return *s.timers
+}
+
+// AdjustCustomSettings requests changes to the strategy's custom settings
+// mid-run, such as widening bands during high volatility, for the reason
+// given. Changes are applied by the backtester via SetCustomSettings once the
+// strategy has finished signalling, taking effect from the next data event,
+// and are recorded in the compliance log and report against the data
+// handler's latest event
+func (s *Strategy) AdjustCustomSettings(d data.Handler, settings map[string]interface{}, reason string) error {
+	if d == nil {
+		return common.ErrNilArguments
+	}
+	latest := d.Latest()
+	if latest == nil {
+		return common.ErrNilEvent
+	}
+	if len(settings) == 0 {
+		return fmt.Errorf("%w no settings to change", ErrInvalidSettingsChange)
+	}
+	if reason == "" {
+		return fmt.Errorf("%w reason unset", ErrInvalidSettingsChange)
+	}
+	changed := make(map[string]interface{}, len(settings))
+	for k, v := range settings {
+		changed[k] = v
+	}
+	if s.settingsChanges == nil {
+		s.settingsChanges = &[]SettingsChange{}
+	}
+	*s.settingsChanges = append(*s.settingsChanges, SettingsChange{
+		Event:    latest,
+		Settings: changed,
+		Reason:   reason,
+	})
+	return nil
+}
+
+// PendingSettingsChanges returns the custom settings changes requested since
+// it was last called, in the order they were requested
+func (s *Strategy) PendingSettingsChanges() []SettingsChange {
+	if s.settingsChanges == nil || len(*s.settingsChanges) == 0 {
+		return nil
+	}
+	changes := *s.settingsChanges
+	*s.settingsChanges = nil
+	return changes
+}
+
+// Due returns whether the timer is due to fire at the time along with the
+// time it was scheduled for, moving the timer on to its next interval. When
+// intervals have been missed, such as when candles are longer than the
+// interval, the timer only fires once for its latest scheduled time.
+// A timer is first due at its first scheduled time at or after the first
+// time it is checked
+func (t *Timer) Due(now time.Time) (time.Time, bool) {
+	if t.Interval <= 0 {
+		return time.Time{}, false
+	}
+	scheduled := now.Add(-t.Offset).Truncate(t.Interval).Add(t.Offset)
+	if t.next.IsZero() {
+		t.next = scheduled
+		if t.next.Before(now) {
+			t.next = t.next.Add(t.Interval)
+		}
+	}
+	if now.Before(t.next) {
+		return time.Time{}, false
+	}
+	t.next = scheduled.Add(t.Interval)
+	return scheduled, true
+}
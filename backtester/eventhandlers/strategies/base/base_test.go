@@ -9,8 +9,11 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
 	datakline "github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
@@ -70,3 +73,174 @@ func TestSetSimultaneousProcessing(t *testing.T) {
 		t.Error("expected true")
 	}
 }
+
+func TestCustomSettings(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	if settings := s.CustomSettings(); settings != nil {
+		t.Errorf("received '%v' expected '%v'", settings, nil)
+	}
+}
+
+type fakePositions struct{}
+
+func (f fakePositions) GetPosition(exch string, a asset.Item, cp currency.Pair) (*holdings.Position, error) {
+	return &holdings.Position{
+		Holding: holdings.Holding{Exchange: exch, Asset: a, Pair: cp},
+		Size:    decimal.NewFromInt(1),
+	}, nil
+}
+
+func TestGetPosition(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	_, err := s.GetPosition(nil)
+	if !errors.Is(err, common.ErrNilArguments) {
+		t.Errorf("received: %v, expected: %v", err, common.ErrNilArguments)
+	}
+	_, err = s.GetPosition(&datakline.DataFromKline{})
+	if !errors.Is(err, common.ErrNilEvent) {
+		t.Errorf("received: %v, expected: %v", err, common.ErrNilEvent)
+	}
+
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	d := data.Base{}
+	d.SetStream([]common.DataEventHandler{&kline.Kline{
+		Base: event.Base{
+			Exchange:     "binance",
+			Time:         time.Now(),
+			Interval:     gctkline.OneDay,
+			CurrencyPair: p,
+			AssetType:    asset.Spot,
+		},
+	}})
+	d.Next()
+	da := &datakline.DataFromKline{Base: d}
+	_, err = s.GetPosition(da)
+	if !errors.Is(err, ErrPositionsUnavailable) {
+		t.Errorf("received: %v, expected: %v", err, ErrPositionsUnavailable)
+	}
+
+	s.SetPositionReader(fakePositions{})
+	pos, err := s.GetPosition(da)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if !pos.Holding.Pair.Equal(p) || pos.Holding.Exchange != "binance" || !pos.Size.Equal(decimal.NewFromInt(1)) {
+		t.Errorf("unexpected position %+v", pos)
+	}
+}
+
+func TestAdjustCustomSettings(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	settings := map[string]interface{}{"rsi-high": 80.0}
+	err := s.AdjustCustomSettings(nil, settings, "volatile")
+	if !errors.Is(err, common.ErrNilArguments) {
+		t.Errorf("received: %v, expected: %v", err, common.ErrNilArguments)
+	}
+	err = s.AdjustCustomSettings(&datakline.DataFromKline{}, settings, "volatile")
+	if !errors.Is(err, common.ErrNilEvent) {
+		t.Errorf("received: %v, expected: %v", err, common.ErrNilEvent)
+	}
+
+	d := data.Base{}
+	d.SetStream([]common.DataEventHandler{&kline.Kline{
+		Base: event.Base{
+			Exchange:     "binance",
+			Time:         time.Now(),
+			Interval:     gctkline.OneDay,
+			CurrencyPair: currency.NewPair(currency.BTC, currency.USDT),
+			AssetType:    asset.Spot,
+		},
+	}})
+	d.Next()
+	da := &datakline.DataFromKline{Base: d}
+	err = s.AdjustCustomSettings(da, nil, "volatile")
+	if !errors.Is(err, ErrInvalidSettingsChange) {
+		t.Errorf("received: %v, expected: %v", err, ErrInvalidSettingsChange)
+	}
+	err = s.AdjustCustomSettings(da, settings, "")
+	if !errors.Is(err, ErrInvalidSettingsChange) {
+		t.Errorf("received: %v, expected: %v", err, ErrInvalidSettingsChange)
+	}
+	if changes := s.PendingSettingsChanges(); changes != nil {
+		t.Errorf("received: %v, expected: %v", changes, nil)
+	}
+
+	err = s.AdjustCustomSettings(da, settings, "volatile")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	settings["rsi-high"] = 90.0
+	changes := s.PendingSettingsChanges()
+	if len(changes) != 1 {
+		t.Fatalf("received: %v, expected: %v", len(changes), 1)
+	}
+	if changes[0].Reason != "volatile" || changes[0].Settings["rsi-high"] != 80.0 || changes[0].Event.GetExchange() != "binance" {
+		t.Errorf("unexpected settings change %+v", changes[0])
+	}
+	if changes = s.PendingSettingsChanges(); changes != nil {
+		t.Errorf("received: %v, expected: %v", changes, nil)
+	}
+}
+
+func TestAddTimer(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	onTimer := func(time.Time, []data.Handler, funding.IFundTransferer) ([]signal.Event, error) {
+		return nil, nil
+	}
+	for _, timer := range []*Timer{
+		nil,
+		{Interval: time.Hour, OnTimer: onTimer},
+		{Name: "test", OnTimer: onTimer},
+		{Name: "test", Interval: time.Hour, Offset: time.Hour, OnTimer: onTimer},
+		{Name: "test", Interval: time.Hour},
+	} {
+		err := s.AddTimer(timer)
+		if !errors.Is(err, ErrInvalidTimer) {
+			t.Errorf("received: %v, expected: %v", err, ErrInvalidTimer)
+		}
+	}
+	err := s.AddTimer(&Timer{Name: "test", Interval: time.Hour, OnTimer: onTimer})
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	err = s.AddTimer(&Timer{Name: "test", Interval: time.Minute, OnTimer: onTimer})
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	if timers := s.Timers(); len(timers) != 1 || timers[0].Interval != time.Minute {
+		t.Errorf("received: %v, expected: replaced timer", timers)
+	}
+}
+
+func TestDue(t *testing.T) {
+	t.Parallel()
+	tt := time.Date(2021, 1, 1, 10, 0, 0, 0, time.UTC)
+	timer := Timer{Interval: time.Hour * 24, Offset: time.Hour * 8}
+	if _, due := timer.Due(tt); due {
+		t.Error("expected timer to wait for its first scheduled time")
+	}
+	scheduled, due := timer.Due(tt.Add(time.Hour * 22))
+	if !due || !scheduled.Equal(time.Date(2021, 1, 2, 8, 0, 0, 0, time.UTC)) {
+		t.Errorf("received: %v %v, expected: %v", scheduled, due, time.Date(2021, 1, 2, 8, 0, 0, 0, time.UTC))
+	}
+	if _, due = timer.Due(tt.Add(time.Hour * 23)); due {
+		t.Error("expected timer to fire once per interval")
+	}
+	// missed intervals only fire once for the latest
+	scheduled, due = timer.Due(tt.AddDate(0, 0, 5))
+	if !due || !scheduled.Equal(time.Date(2021, 1, 6, 8, 0, 0, 0, time.UTC)) {
+		t.Errorf("received: %v %v, expected: %v", scheduled, due, time.Date(2021, 1, 6, 8, 0, 0, 0, time.UTC))
+	}
+
+	timer = Timer{Interval: time.Hour * 24}
+	if _, due = timer.Due(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)); !due {
+		t.Error("expected timer checked at its scheduled time to fire")
+	}
+	if _, due = (&Timer{}).Due(tt); due {
+		t.Error("expected timer without an interval to never fire")
+	}
+}
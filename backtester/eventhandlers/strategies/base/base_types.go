@@ -1,6 +1,17 @@
 package base
 
-import "errors"
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
 
 var (
 	// ErrCustomSettingsUnsupported used when custom settings are found in the start config when they shouldn't be
@@ -14,4 +25,48 @@ var (
 	ErrInvalidCustomSettings = errors.New("invalid custom settings in config")
 	// ErrTooMuchBadData used when there is too much missing data
 	ErrTooMuchBadData = errors.New("backtesting cannot continue as there is too much invalid data. Please review your dataset")
+	// ErrPositionsUnavailable used when a strategy requests its position before
+	// a position reader has been set
+	ErrPositionsUnavailable = errors.New("positions unavailable, no position reader set")
+	// ErrInvalidTimer used when a strategy adds a timer which cannot be scheduled
+	ErrInvalidTimer = errors.New("invalid timer")
+	// ErrInvalidSettingsChange used when a strategy requests a change to its
+	// custom settings which is missing its settings or reason
+	ErrInvalidSettingsChange = errors.New("invalid custom settings change")
 )
+
+// PositionReader provides strategies with the latest holdings and open
+// position for an exchange asset pair
+type PositionReader interface {
+	GetPosition(string, asset.Item, currency.Pair) (*holdings.Position, error)
+}
+
+// TimerFunc is called when a timer fires with the time it was scheduled for,
+// the latest data of every loaded exchange asset pair and the funding manager.
+// Returned signals are processed as though the strategy had signalled them
+type TimerFunc func(time.Time, []data.Handler, funding.IFundTransferer) ([]signal.Event, error)
+
+// Timer is a time-based callback which fires once every interval, independent
+// of candles arriving. Intervals are aligned to midnight UTC and moved by the
+// offset, so an interval of 24 hours fires daily at 00:00 UTC and an offset of
+// 8 hours moves it to 08:00 UTC. Backtests fire timers by the time of the data
+// being processed while live runs use the wall clock
+type Timer struct {
+	Name     string
+	Interval time.Duration
+	Offset   time.Duration
+	OnTimer  TimerFunc
+	// next is when the timer is next due, set the first time it is checked
+	next time.Time
+}
+
+// SettingsChange is a strategy's request to adjust its custom settings
+// mid-run. Settings only need to hold the settings being changed, they are
+// merged into the strategy's current custom settings when applied
+type SettingsChange struct {
+	// Event is the latest data event when the change was requested, which
+	// the change is recorded against
+	Event    common.DataEventHandler
+	Settings map[string]interface{}
+	Reason   string
+}
@@ -0,0 +1,215 @@
+package dynamicdca
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+const (
+	// Name is the strategy name
+	Name                   = "dynamicdca"
+	buyAmountKey           = "buy-amount"
+	maPeriodKey            = "ma-period"
+	scalePerPercentKey     = "scale-per-percent"
+	minimumScaleKey        = "minimum-scale"
+	maximumScaleKey        = "maximum-scale"
+	maIndicatorName        = "Moving Average"
+	scaleIndicatorName     = "Buy Scale"
+	description            = `Dynamic dollar-cost averaging purchases on every candle like dollar-cost averaging, but scales the size of each purchase by how far the price is below its long-term moving average. The further the price falls below the average, the more the strategy is confident it is buying at a discount and the more it buys, up to a maximum scale. At or above the average, purchases are made at the minimum scale`
+	defaultMAPeriod        = 200
+	defaultBuyAmount       = 100
+	defaultScalePerPercent = 0.1
+	defaultMinimumScale    = 1
+	defaultMaximumScale    = 3
+)
+
+// Strategy is an implementation of the Handler interface
+type Strategy struct {
+	base.Strategy
+	buyAmount       decimal.Decimal
+	maPeriod        decimal.Decimal
+	scalePerPercent decimal.Decimal
+	minimumScale    decimal.Decimal
+	maximumScale    decimal.Decimal
+}
+
+// Name returns the name
+func (s *Strategy) Name() string {
+	return Name
+}
+
+// Description provides a nice overview of the strategy
+// be it definition of terms or to highlight its purpose
+func (s *Strategy) Description() string {
+	return description
+}
+
+// OnSignal handles a data event and returns what action the strategy believes should occur
+// For dynamicdca, this means returning a buy signal on every event, limited to the
+// buy amount scaled by how far the price is below its moving average
+func (s *Strategy) OnSignal(d data.Handler, _ funding.IFundTransferer) (signal.Event, error) {
+	if d == nil {
+		return nil, common.ErrNilEvent
+	}
+	es, err := s.GetBaseData(d)
+	if err != nil {
+		return nil, err
+	}
+	if !d.HasDataAtTime(d.Latest().GetTime()) {
+		es.SetDirection(common.MissingData)
+		es.AppendReason(fmt.Sprintf("missing data at %v, cannot perform any actions", d.Latest().GetTime()))
+		return &es, nil
+	}
+	price := d.Latest().ClosePrice()
+	es.SetPrice(price)
+
+	scale := s.minimumScale
+	ma, ok := s.movingAverage(d.StreamClose())
+	if ok {
+		es.AddIndicator(maIndicatorName, ma, true)
+		scale = s.buyScale(price, ma)
+		es.AppendReason(fmt.Sprintf("price %v is %v%% below its %v candle moving average %v, buying at %vx",
+			price, decimal.Max(ma.Sub(price), decimal.Zero).Div(ma).Mul(decimal.NewFromInt(100)).Round(2), s.maPeriod, ma.Round(8), scale.Round(4)))
+	} else {
+		es.AppendReason(fmt.Sprintf("not enough data for a %v candle moving average, buying at %vx", s.maPeriod, scale))
+	}
+	es.AddIndicator(scaleIndicatorName, scale, false)
+	if !scale.IsPositive() || !price.IsPositive() {
+		es.SetDirection(common.DoNothing)
+		return &es, nil
+	}
+	es.SetDirection(order.Buy)
+	es.SetBuyLimit(s.buyAmount.Mul(scale).Div(price))
+	return &es, nil
+}
+
+// movingAverage returns the average of the latest closes within the moving
+// average period, ignoring candles with missing data. It cannot be calculated
+// until a full period of candles is available
+func (s *Strategy) movingAverage(closes []decimal.Decimal) (decimal.Decimal, bool) {
+	period := int(s.maPeriod.IntPart())
+	if period <= 0 || len(closes) < period {
+		return decimal.Zero, false
+	}
+	var total decimal.Decimal
+	var count int64
+	for i := len(closes) - period; i < len(closes); i++ {
+		if !closes[i].IsPositive() {
+			continue
+		}
+		total = total.Add(closes[i])
+		count++
+	}
+	if count == 0 {
+		return decimal.Zero, false
+	}
+	return total.Div(decimal.NewFromInt(count)), true
+}
+
+// buyScale returns the minimum scale plus the scale per percent for every
+// percent the price is below the moving average, up to the maximum scale
+func (s *Strategy) buyScale(price, ma decimal.Decimal) decimal.Decimal {
+	if !ma.IsPositive() || price.GreaterThanOrEqual(ma) {
+		return s.minimumScale
+	}
+	belowPercent := ma.Sub(price).Div(ma).Mul(decimal.NewFromInt(100))
+	scale := s.minimumScale.Add(belowPercent.Mul(s.scalePerPercent))
+	if scale.GreaterThan(s.maximumScale) {
+		return s.maximumScale
+	}
+	return scale
+}
+
+// SupportsSimultaneousProcessing highlights whether the strategy can handle multiple currency calculation
+func (s *Strategy) SupportsSimultaneousProcessing() bool {
+	return true
+}
+
+// OnSimultaneousSignals analyses multiple data points simultaneously, allowing flexibility
+// in allowing a strategy to only place an order for X currency if Y currency's price is Z
+// For dynamicdca, each currency is scaled independently, so it uses the OnSignal function
+func (s *Strategy) OnSimultaneousSignals(d []data.Handler, _ funding.IFundTransferer) ([]signal.Event, error) {
+	var resp []signal.Event
+	var errs gctcommon.Errors
+	for i := range d {
+		sigEvent, err := s.OnSignal(d[i], nil)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%v %v %v %w", d[i].Latest().GetExchange(), d[i].Latest().GetAssetType(), d[i].Latest().Pair(), err))
+		} else {
+			resp = append(resp, sigEvent)
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return resp, nil
+}
+
+// SetCustomSettings allows a user to modify the buy amount, moving average
+// period and scaling in their config
+func (s *Strategy) SetCustomSettings(customSettings map[string]interface{}) error {
+	for k, v := range customSettings {
+		value, ok := v.(float64)
+		switch k {
+		case buyAmountKey:
+			if !ok || value <= 0 {
+				return fmt.Errorf("%w provided buy-amount value could not be parsed: %v", base.ErrInvalidCustomSettings, v)
+			}
+			s.buyAmount = decimal.NewFromFloat(value)
+		case maPeriodKey:
+			if !ok || value < 1 {
+				return fmt.Errorf("%w provided ma-period value could not be parsed: %v", base.ErrInvalidCustomSettings, v)
+			}
+			s.maPeriod = decimal.NewFromFloat(value)
+		case scalePerPercentKey:
+			if !ok || value < 0 {
+				return fmt.Errorf("%w provided scale-per-percent value could not be parsed: %v", base.ErrInvalidCustomSettings, v)
+			}
+			s.scalePerPercent = decimal.NewFromFloat(value)
+		case minimumScaleKey:
+			if !ok || value < 0 {
+				return fmt.Errorf("%w provided minimum-scale value could not be parsed: %v", base.ErrInvalidCustomSettings, v)
+			}
+			s.minimumScale = decimal.NewFromFloat(value)
+		case maximumScaleKey:
+			if !ok || value <= 0 {
+				return fmt.Errorf("%w provided maximum-scale value could not be parsed: %v", base.ErrInvalidCustomSettings, v)
+			}
+			s.maximumScale = decimal.NewFromFloat(value)
+		default:
+			return fmt.Errorf("%w unrecognised custom setting key %v with value %v. Cannot apply", base.ErrInvalidCustomSettings, k, v)
+		}
+	}
+	if s.minimumScale.GreaterThan(s.maximumScale) {
+		return fmt.Errorf("%w minimum-scale %v cannot exceed maximum-scale %v", base.ErrInvalidCustomSettings, s.minimumScale, s.maximumScale)
+	}
+	return nil
+}
+
+// CustomSettings returns the custom settings in use, including defaults
+func (s *Strategy) CustomSettings() map[string]interface{} {
+	return map[string]interface{}{
+		buyAmountKey:       s.buyAmount.InexactFloat64(),
+		maPeriodKey:        s.maPeriod.InexactFloat64(),
+		scalePerPercentKey: s.scalePerPercent.InexactFloat64(),
+		minimumScaleKey:    s.minimumScale.InexactFloat64(),
+		maximumScaleKey:    s.maximumScale.InexactFloat64(),
+	}
+}
+
+// SetDefaults sets the custom settings to their default values
+func (s *Strategy) SetDefaults() {
+	s.buyAmount = decimal.NewFromInt(defaultBuyAmount)
+	s.maPeriod = decimal.NewFromInt(defaultMAPeriod)
+	s.scalePerPercent = decimal.NewFromFloat(defaultScalePerPercent)
+	s.minimumScale = decimal.NewFromInt(defaultMinimumScale)
+	s.maximumScale = decimal.NewFromInt(defaultMaximumScale)
+}
@@ -0,0 +1,182 @@
+package dynamicdca
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func TestName(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	if n := s.Name(); n != Name {
+		t.Errorf("expected %v", Name)
+	}
+}
+
+func TestSupportsSimultaneousProcessing(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	if !s.SupportsSimultaneousProcessing() {
+		t.Error("expected true")
+	}
+}
+
+func TestSetCustomSettings(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	s.SetDefaults()
+	err := s.SetCustomSettings(nil)
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	settings := map[string]interface{}{
+		buyAmountKey:       float64(50),
+		maPeriodKey:        float64(20),
+		scalePerPercentKey: 0.2,
+		minimumScaleKey:    0.5,
+		maximumScaleKey:    float64(4),
+	}
+	err = s.SetCustomSettings(settings)
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	if !s.maPeriod.Equal(decimal.NewFromInt(20)) || !s.minimumScale.Equal(decimal.NewFromFloat(0.5)) {
+		t.Errorf("unexpected settings %v", s.CustomSettings())
+	}
+
+	for _, k := range []string{buyAmountKey, maPeriodKey, scalePerPercentKey, minimumScaleKey, maximumScaleKey} {
+		err = s.SetCustomSettings(map[string]interface{}{k: "14"})
+		if !errors.Is(err, base.ErrInvalidCustomSettings) {
+			t.Errorf("%v received: %v, expected: %v", k, err, base.ErrInvalidCustomSettings)
+		}
+	}
+	err = s.SetCustomSettings(map[string]interface{}{minimumScaleKey: float64(5)})
+	if !errors.Is(err, base.ErrInvalidCustomSettings) {
+		t.Errorf("received: %v, expected: %v", err, base.ErrInvalidCustomSettings)
+	}
+	err = s.SetCustomSettings(map[string]interface{}{"lol": float64(14)})
+	if !errors.Is(err, base.ErrInvalidCustomSettings) {
+		t.Errorf("received: %v, expected: %v", err, base.ErrInvalidCustomSettings)
+	}
+}
+
+func TestBuyScale(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	s.SetDefaults()
+	hundred := decimal.NewFromInt(100)
+	if scale := s.buyScale(decimal.NewFromInt(110), hundred); !scale.Equal(decimal.NewFromInt(1)) {
+		t.Errorf("received: %v, expected: %v", scale, 1)
+	}
+	// 10% below the average adds 10 * 0.1
+	if scale := s.buyScale(decimal.NewFromInt(90), hundred); !scale.Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received: %v, expected: %v", scale, 2)
+	}
+	if scale := s.buyScale(decimal.NewFromInt(50), hundred); !scale.Equal(decimal.NewFromInt(3)) {
+		t.Errorf("received: %v, expected: %v", scale, 3)
+	}
+}
+
+func TestOnSignal(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	s.SetDefaults()
+	_, err := s.OnSignal(nil, nil)
+	if !errors.Is(err, common.ErrNilEvent) {
+		t.Errorf("received: %v, expected: %v", err, common.ErrNilEvent)
+	}
+	err = s.SetCustomSettings(map[string]interface{}{maPeriodKey: float64(3)})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+
+	dStart := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	da := &kline.DataFromKline{
+		Item: gctkline.Item{
+			Exchange: "binance",
+			Pair:     p,
+			Asset:    asset.Spot,
+			Interval: gctkline.OneDay,
+		},
+	}
+	for i, price := range []float64{110, 100, 90} {
+		da.Item.Candles = append(da.Item.Candles, gctkline.Candle{
+			Time:   dStart.Add(gctkline.OneDay.Duration() * time.Duration(i)),
+			Open:   price,
+			High:   price,
+			Low:    price,
+			Close:  price,
+			Volume: 1,
+		})
+	}
+	err = da.Load()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	ranger, err := gctkline.CalculateCandleDateRanges(dStart, dStart.AddDate(0, 0, 3), gctkline.OneDay, 100000)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	da.RangeHolder = ranger
+	da.RangeHolder.SetHasDataFromCandles(da.Item.Candles)
+
+	// before a full moving average period, purchases use the minimum scale
+	da.Next()
+	resp, err := s.OnSignal(da, nil)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	expected := decimal.NewFromInt(100).Div(decimal.NewFromInt(110))
+	if resp.GetDirection() != gctorder.Buy || !resp.GetBuyLimit().Equal(expected) {
+		t.Errorf("received %v %v, expected buy limited to %v", resp.GetDirection(), resp.GetBuyLimit(), expected)
+	}
+
+	// 90 is 10% below the average of 100, buying 2x 100 USDT of BTC
+	da.Next()
+	da.Next()
+	var resps []signal.Event
+	resps, err = s.OnSimultaneousSignals([]data.Handler{da}, nil)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	expected = decimal.NewFromInt(200).Div(decimal.NewFromInt(90))
+	if len(resps) != 1 || resps[0].GetDirection() != gctorder.Buy || !resps[0].GetBuyLimit().Equal(expected) {
+		t.Fatalf("unexpected signals %+v", resps)
+	}
+	if indicators := resps[0].GetIndicators(); len(indicators) != 2 || indicators[0].Name != maIndicatorName {
+		t.Errorf("unexpected indicators %+v", indicators)
+	}
+
+	s.minimumScale = decimal.Zero
+	s.maPeriod = decimal.NewFromInt(30)
+	resp, err = s.OnSignal(da, nil)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if resp.GetDirection() != common.DoNothing {
+		t.Errorf("received: %v, expected: %v", resp.GetDirection(), common.DoNothing)
+	}
+}
+
+func TestCustomSettings(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	s.SetDefaults()
+	settings := s.CustomSettings()
+	if settings[maPeriodKey] != float64(defaultMAPeriod) || settings[maximumScaleKey] != float64(defaultMaximumScale) {
+		t.Errorf("unexpected settings %v", settings)
+	}
+}
@@ -0,0 +1,335 @@
+package external
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/btrpc"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Name returns the name of the strategy
+func (s *Strategy) Name() string {
+	return Name
+}
+
+// Description provides a nice overview of the strategy
+// be it definition of terms or to highlight its purpose
+func (s *Strategy) Description() string {
+	return description
+}
+
+// OnSignal sends the data event to the external strategy and returns the
+// signal it responds with
+func (s *Strategy) OnSignal(d data.Handler, f funding.IFundTransferer) (signal.Event, error) {
+	if d == nil {
+		return nil, common.ErrNilEvent
+	}
+	resp, err := s.process([]data.Handler{d}, f)
+	if err != nil {
+		return nil, err
+	}
+	return resp[0], nil
+}
+
+// SupportsSimultaneousProcessing highlights whether the strategy can handle multiple currency calculation
+func (s *Strategy) SupportsSimultaneousProcessing() bool {
+	return true
+}
+
+// OnSimultaneousSignals sends all data events to the external strategy in a
+// single request, allowing it to consider every currency at once
+func (s *Strategy) OnSimultaneousSignals(d []data.Handler, f funding.IFundTransferer) ([]signal.Event, error) {
+	return s.process(d, f)
+}
+
+// process converts the data into a request for the external strategy and
+// applies the signals in its response
+func (s *Strategy) process(d []data.Handler, f funding.IFundTransferer) ([]signal.Event, error) {
+	signals := make([]*signal.Signal, len(d))
+	req := &btrpc.ProcessEventsRequest{}
+	for i := range d {
+		if d[i] == nil {
+			return nil, common.ErrNilEvent
+		}
+		es, err := s.GetBaseData(d[i])
+		if err != nil {
+			return nil, err
+		}
+		latest := d[i].Latest()
+		es.SetPrice(latest.ClosePrice())
+		es.SetDirection(common.DoNothing)
+		signals[i] = &es
+		ev := &btrpc.StrategyDataEvent{
+			Offset:   latest.GetOffset(),
+			Exchange: latest.GetExchange(),
+			Asset:    latest.GetAssetType().String(),
+			Pair:     latest.Pair().String(),
+			Interval: latest.GetInterval().Short(),
+			Time:     latest.GetTime().UTC().Format(time.RFC3339),
+			Open:     latest.OpenPrice().InexactFloat64(),
+			High:     latest.HighPrice().InexactFloat64(),
+			Low:      latest.LowPrice().InexactFloat64(),
+			Close:    latest.ClosePrice().InexactFloat64(),
+			HasData:  d[i].HasDataAtTime(latest.GetTime()),
+		}
+		if vol := d[i].StreamVol(); len(vol) > 0 {
+			ev.Volume = vol[len(vol)-1].InexactFloat64()
+		}
+		if f != nil {
+			var funds *funding.Pair
+			funds, err = f.GetFundingForEvent(&es)
+			if err != nil {
+				return nil, err
+			}
+			ev.BaseAvailable = funds.BaseAvailable().InexactFloat64()
+			ev.QuoteAvailable = funds.QuoteAvailable().InexactFloat64()
+		}
+		var pos *holdings.Position
+		pos, err = s.GetPosition(d[i])
+		if err != nil && !errors.Is(err, base.ErrPositionsUnavailable) {
+			return nil, err
+		}
+		if pos != nil {
+			ev.PositionSize = pos.Size.InexactFloat64()
+			ev.EntryPrice = pos.EntryPrice.InexactFloat64()
+		}
+		req.Events = append(req.Events, ev)
+	}
+
+	resp, err := s.send(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Error != "" {
+		return nil, fmt.Errorf("%w %v", errExternalStrategy, resp.Error)
+	}
+
+	returned := make(map[string]*btrpc.StrategySignal, len(resp.Signals))
+	for i := range resp.Signals {
+		returned[signalKey(resp.Signals[i].Exchange, resp.Signals[i].Asset, resp.Signals[i].Pair)] = resp.Signals[i]
+	}
+	result := make([]signal.Event, len(signals))
+	for i := range signals {
+		result[i] = signals[i]
+		ev := req.Events[i]
+		if !ev.HasData {
+			signals[i].SetDirection(common.MissingData)
+			signals[i].AppendReason(fmt.Sprintf("missing data at %v, cannot perform any actions", ev.Time))
+			continue
+		}
+		sig, ok := returned[signalKey(ev.Exchange, ev.Asset, ev.Pair)]
+		if !ok {
+			signals[i].AppendReason("no signal returned by external strategy")
+			continue
+		}
+		var direction order.Side
+		direction, err = parseDirection(sig.Direction)
+		if err != nil {
+			return nil, fmt.Errorf("%v %v %v %w", ev.Exchange, ev.Asset, ev.Pair, err)
+		}
+		signals[i].SetDirection(direction)
+		if sig.Reason != "" {
+			signals[i].AppendReason(sig.Reason)
+		}
+	}
+	return result, nil
+}
+
+// send streams the request to the external strategy and waits for its
+// response, connecting on first use
+func (s *Strategy) send(req *btrpc.ProcessEventsRequest) (*btrpc.ProcessEventsResponse, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.stream == nil {
+		err := s.connect()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !s.sentSettings {
+		req.CustomSettings = s.customSettings
+	}
+	err := s.stream.Send(req)
+	if err != nil {
+		s.closeAfterFailure()
+		return nil, fmt.Errorf("%w sending events, %v", errExternalStrategy, err)
+	}
+	s.sentSettings = true
+
+	received := make(chan response, 1)
+	go func(stream btrpc.ExternalStrategyService_ProcessEventsClient) {
+		resp, err := stream.Recv()
+		received <- response{resp: resp, err: err}
+	}(s.stream)
+	timeout := s.timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case r := <-received:
+		if r.err != nil {
+			s.closeAfterFailure()
+			return nil, fmt.Errorf("%w receiving signals, %v", errExternalStrategy, r.err)
+		}
+		return r.resp, nil
+	case <-timer.C:
+		s.closeAfterFailure()
+		return nil, fmt.Errorf("%w after %v", errResponseTimeout, timeout)
+	}
+}
+
+// connect dials the external strategy and opens the event stream
+func (s *Strategy) connect() error {
+	if s.address == "" {
+		return errAddressUnset
+	}
+	timeout := s.timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	dialCtx, dialCancel := context.WithTimeout(context.Background(), timeout)
+	defer dialCancel()
+	conn, err := grpc.DialContext(dialCtx,
+		s.address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithBlock())
+	if err != nil {
+		return fmt.Errorf("%w could not connect to %v, %v", errExternalStrategy, s.address, err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := btrpc.NewExternalStrategyServiceClient(conn).ProcessEvents(ctx)
+	if err != nil {
+		cancel()
+		if closeErr := conn.Close(); closeErr != nil {
+			err = fmt.Errorf("%v, %v", err, closeErr)
+		}
+		return fmt.Errorf("%w could not open event stream, %v", errExternalStrategy, err)
+	}
+	s.conn = conn
+	s.stream = stream
+	s.cancel = cancel
+	s.sentSettings = false
+	return nil
+}
+
+// disconnect closes the event stream and connection, a subsequent request
+// will reconnect and resend the custom settings
+func (s *Strategy) disconnect() error {
+	if s.stream == nil {
+		return nil
+	}
+	err := s.stream.CloseSend()
+	s.cancel()
+	if closeErr := s.conn.Close(); closeErr != nil && err == nil {
+		err = closeErr
+	}
+	s.stream = nil
+	s.conn = nil
+	s.cancel = nil
+	return err
+}
+
+// closeAfterFailure disconnects following a failed request, logging any
+// error so the original failure is returned to the caller
+func (s *Strategy) closeAfterFailure() {
+	if err := s.disconnect(); err != nil {
+		log.Errorf(log.BackTester, "could not disconnect from external strategy %v: %v", s.address, err)
+	}
+}
+
+// Close closes the connection to the external strategy
+func (s *Strategy) Close() error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	return s.disconnect()
+}
+
+// SetCustomSettings sets the address of the external strategy and the time
+// to wait for its responses. All other settings are sent to the external
+// strategy as JSON with the first request
+func (s *Strategy) SetCustomSettings(customSettings map[string]interface{}) error {
+	forwarded := make(map[string]interface{})
+	for k, v := range customSettings {
+		switch k {
+		case addressKey:
+			address, ok := v.(string)
+			if !ok || address == "" {
+				return fmt.Errorf("%w provided address value could not be parsed: %v", base.ErrInvalidCustomSettings, v)
+			}
+			s.address = address
+		case timeoutKey:
+			timeout, ok := v.(float64)
+			if !ok || timeout <= 0 {
+				return fmt.Errorf("%w provided timeout value could not be parsed: %v", base.ErrInvalidCustomSettings, v)
+			}
+			s.timeout = time.Duration(timeout * float64(time.Second))
+		default:
+			forwarded[k] = v
+		}
+	}
+	if len(forwarded) == 0 {
+		s.customSettings = nil
+		return nil
+	}
+	var err error
+	s.customSettings, err = json.Marshal(forwarded)
+	if err != nil {
+		return fmt.Errorf("%w %v", base.ErrInvalidCustomSettings, err)
+	}
+	return nil
+}
+
+// CustomSettings returns the custom settings in use, including defaults and
+// the settings forwarded to the external strategy
+func (s *Strategy) CustomSettings() map[string]interface{} {
+	resp := make(map[string]interface{})
+	if len(s.customSettings) > 0 {
+		if err := json.Unmarshal(s.customSettings, &resp); err != nil {
+			log.Errorf(log.BackTester, "%v could not read forwarded custom settings: %v", Name, err)
+		}
+	}
+	resp[addressKey] = s.address
+	resp[timeoutKey] = s.timeout.Seconds()
+	return resp
+}
+
+// SetDefaults sets the custom settings to their default values
+func (s *Strategy) SetDefaults() {
+	s.address = ""
+	s.timeout = defaultTimeout
+	s.customSettings = nil
+}
+
+// parseDirection converts an external signal direction into a signal side
+func parseDirection(direction string) (order.Side, error) {
+	switch strings.ToUpper(direction) {
+	case order.Buy.String():
+		return order.Buy, nil
+	case order.Sell.String():
+		return order.Sell, nil
+	case "", common.DoNothing.String():
+		return common.DoNothing, nil
+	default:
+		return "", fmt.Errorf("%w %v", errUnrecognisedDirection, direction)
+	}
+}
+
+func signalKey(exchange, a, pair string) string {
+	return strings.ToLower(exchange + "|" + a + "|" + pair)
+}
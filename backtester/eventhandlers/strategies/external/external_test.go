@@ -0,0 +1,264 @@
+package external
+
+import (
+	"errors"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/btrpc"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	eventkline "github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"google.golang.org/grpc"
+)
+
+// testServer responds to every event with the configured direction
+type testServer struct {
+	btrpc.UnimplementedExternalStrategyServiceServer
+	direction string
+	errorText string
+	settings  chan []byte
+}
+
+func (t *testServer) ProcessEvents(stream btrpc.ExternalStrategyService_ProcessEventsServer) error {
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if len(req.CustomSettings) > 0 && t.settings != nil {
+			t.settings <- req.CustomSettings
+		}
+		resp := &btrpc.ProcessEventsResponse{Error: t.errorText}
+		for i := range req.Events {
+			resp.Signals = append(resp.Signals, &btrpc.StrategySignal{
+				Exchange:  req.Events[i].Exchange,
+				Asset:     req.Events[i].Asset,
+				Pair:      req.Events[i].Pair,
+				Direction: t.direction,
+				Reason:    "test",
+			})
+		}
+		err = stream.Send(resp)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func startServer(t *testing.T, srv *testServer) string {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := grpc.NewServer()
+	btrpc.RegisterExternalStrategyServiceServer(s, srv)
+	go func() {
+		if err := s.Serve(lis); err != nil {
+			t.Log(err)
+		}
+	}()
+	t.Cleanup(s.Stop)
+	return lis.Addr().String()
+}
+
+func testData(t *testing.T) *kline.DataFromKline {
+	t.Helper()
+	dInsert := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	d := data.Base{}
+	d.SetStream([]common.DataEventHandler{&eventkline.Kline{
+		Base: event.Base{
+			Offset:       1,
+			Exchange:     "binance",
+			Time:         dInsert,
+			Interval:     gctkline.OneDay,
+			CurrencyPair: p,
+			AssetType:    asset.Spot,
+		},
+		Open:   decimal.NewFromInt(1337),
+		Close:  decimal.NewFromInt(1337),
+		Low:    decimal.NewFromInt(1337),
+		High:   decimal.NewFromInt(1337),
+		Volume: decimal.NewFromInt(1337),
+	}})
+	d.Next()
+	ranger, err := gctkline.CalculateCandleDateRanges(dInsert, dInsert.Add(gctkline.OneDay.Duration()), gctkline.OneDay, 100000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ranger.SetHasDataFromCandles([]gctkline.Candle{{Time: dInsert}})
+	return &kline.DataFromKline{
+		Base:        d,
+		RangeHolder: ranger,
+	}
+}
+
+func TestName(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	if n := s.Name(); n != Name {
+		t.Errorf("received '%v' expected '%v'", n, Name)
+	}
+}
+
+func TestSupportsSimultaneousProcessing(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	if !s.SupportsSimultaneousProcessing() {
+		t.Error("expected true")
+	}
+}
+
+func TestSetCustomSettings(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	s.SetDefaults()
+	err := s.SetCustomSettings(map[string]interface{}{addressKey: 1337})
+	if !errors.Is(err, base.ErrInvalidCustomSettings) {
+		t.Errorf("received '%v' expected '%v'", err, base.ErrInvalidCustomSettings)
+	}
+	err = s.SetCustomSettings(map[string]interface{}{addressKey: "localhost:1337", timeoutKey: -1.0})
+	if !errors.Is(err, base.ErrInvalidCustomSettings) {
+		t.Errorf("received '%v' expected '%v'", err, base.ErrInvalidCustomSettings)
+	}
+	err = s.SetCustomSettings(map[string]interface{}{addressKey: "localhost:1337", timeoutKey: 5.0, "period": 14.0})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if s.address != "localhost:1337" {
+		t.Errorf("received '%v' expected '%v'", s.address, "localhost:1337")
+	}
+	if s.timeout != time.Second*5 {
+		t.Errorf("received '%v' expected '%v'", s.timeout, time.Second*5)
+	}
+	if string(s.customSettings) != `{"period":14}` {
+		t.Errorf("received '%s' expected '%v'", s.customSettings, `{"period":14}`)
+	}
+}
+
+func TestOnSignal(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	s.SetDefaults()
+	_, err := s.OnSignal(nil, nil)
+	if !errors.Is(err, common.ErrNilEvent) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrNilEvent)
+	}
+
+	_, err = s.OnSignal(testData(t), nil)
+	if !errors.Is(err, errAddressUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errAddressUnset)
+	}
+
+	srv := &testServer{direction: "buy", settings: make(chan []byte, 1)}
+	err = s.SetCustomSettings(map[string]interface{}{addressKey: startServer(t, srv), "period": 14.0})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	resp, err := s.OnSignal(testData(t), nil)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if resp.GetDirection() != order.Buy {
+		t.Errorf("received '%v' expected '%v'", resp.GetDirection(), order.Buy)
+	}
+	select {
+	case settings := <-srv.settings:
+		if string(settings) != `{"period":14}` {
+			t.Errorf("received '%s' expected '%v'", settings, `{"period":14}`)
+		}
+	default:
+		t.Error("expected custom settings to be sent")
+	}
+
+	srv.direction = "lol"
+	_, err = s.OnSignal(testData(t), nil)
+	if !errors.Is(err, errUnrecognisedDirection) {
+		t.Errorf("received '%v' expected '%v'", err, errUnrecognisedDirection)
+	}
+
+	err = s.Close()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+}
+
+func TestOnSimultaneousSignals(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	s.SetDefaults()
+	_, err := s.OnSimultaneousSignals([]data.Handler{nil}, nil)
+	if !errors.Is(err, common.ErrNilEvent) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrNilEvent)
+	}
+
+	srv := &testServer{errorText: "bad"}
+	s.address = startServer(t, srv)
+	_, err = s.OnSimultaneousSignals([]data.Handler{testData(t)}, nil)
+	if !errors.Is(err, errExternalStrategy) {
+		t.Errorf("received '%v' expected '%v'", err, errExternalStrategy)
+	}
+
+	srv.errorText = ""
+	srv.direction = "do nothing"
+	resp, err := s.OnSimultaneousSignals([]data.Handler{testData(t)}, nil)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp) != 1 || resp[0].GetDirection() != common.DoNothing {
+		t.Errorf("received '%v' expected '%v'", resp, common.DoNothing)
+	}
+	err = s.Close()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+}
+
+func TestParseDirection(t *testing.T) {
+	t.Parallel()
+	d, err := parseDirection("sElL")
+	if !errors.Is(err, nil) || d != order.Sell {
+		t.Errorf("received '%v' '%v' expected '%v'", d, err, order.Sell)
+	}
+	_, err = parseDirection("short")
+	if !errors.Is(err, errUnrecognisedDirection) {
+		t.Errorf("received '%v' expected '%v'", err, errUnrecognisedDirection)
+	}
+}
+
+func TestCustomSettings(t *testing.T) {
+	t.Parallel()
+	s := &Strategy{}
+	s.SetDefaults()
+	settings := s.CustomSettings()
+	if settings[addressKey] != "" || settings[timeoutKey] != defaultTimeout.Seconds() {
+		t.Errorf("received '%v' expected '%v'", settings, "defaults")
+	}
+	err := s.SetCustomSettings(map[string]interface{}{
+		addressKey:  "localhost:9055",
+		timeoutKey:  5.0,
+		"threshold": 1.5,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	settings = s.CustomSettings()
+	if settings[addressKey] != "localhost:9055" || settings[timeoutKey] != 5.0 || settings["threshold"] != 1.5 {
+		t.Errorf("received '%v' expected '%v'", settings, "configured settings")
+	}
+}
@@ -0,0 +1,49 @@
+package external
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/btrpc"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+	"google.golang.org/grpc"
+)
+
+const (
+	// Name is the strategy name
+	Name           = "external"
+	addressKey     = "address"
+	timeoutKey     = "timeout"
+	defaultTimeout = time.Second * 30
+	description    = `Streams data events over gRPC to a strategy running in another process, which can be written in any language that supports gRPC. The external strategy implements the ExternalStrategyService and responds with its signals, allowing it to use the backtester's execution and statistics`
+)
+
+var (
+	errAddressUnset          = errors.New("external strategy address unset")
+	errExternalStrategy      = errors.New("external strategy error")
+	errResponseTimeout       = errors.New("timed out waiting for external strategy response")
+	errUnrecognisedDirection = errors.New("unrecognised signal direction")
+)
+
+// Strategy is an implementation of the Handler interface which forwards
+// data events to an external strategy process
+type Strategy struct {
+	base.Strategy
+	address        string
+	timeout        time.Duration
+	customSettings []byte
+
+	m            sync.Mutex
+	conn         *grpc.ClientConn
+	stream       btrpc.ExternalStrategyService_ProcessEventsClient
+	cancel       context.CancelFunc
+	sentSettings bool
+}
+
+// response holds the result of receiving from the stream
+type response struct {
+	resp *btrpc.ProcessEventsResponse
+	err  error
+}
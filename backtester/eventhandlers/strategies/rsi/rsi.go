@@ -17,11 +17,12 @@ import (
 
 const (
 	// Name is the strategy name
-	Name         = "rsi"
-	rsiPeriodKey = "rsi-period"
-	rsiLowKey    = "rsi-low"
-	rsiHighKey   = "rsi-high"
-	description  = `The relative strength index is a technical indicator used in the analysis of financial markets. It is intended to chart the current and historical strength or weakness of a stock or market based on the closing prices of a recent trading period`
+	Name             = "rsi"
+	rsiPeriodKey     = "rsi-period"
+	rsiLowKey        = "rsi-low"
+	rsiHighKey       = "rsi-high"
+	rsiIndicatorName = "RSI"
+	description      = `The relative strength index is a technical indicator used in the analysis of financial markets. It is intended to chart the current and historical strength or weakness of a stock or market based on the closing prices of a recent trading period`
 )
 
 // Strategy is an implementation of the Handler interface
@@ -85,6 +86,7 @@ func (s *Strategy) OnSignal(d data.Handler, _ funding.IFundTransferer) (signal.E
 		es.SetDirection(common.DoNothing)
 	}
 	es.AppendReason(fmt.Sprintf("RSI at %v", latestRSIValue))
+	es.AddIndicator(rsiIndicatorName, latestRSIValue, false)
 
 	return &es, nil
 }
@@ -146,6 +148,15 @@ func (s *Strategy) SetCustomSettings(customSettings map[string]interface{}) erro
 	return nil
 }
 
+// CustomSettings returns the custom settings in use, including defaults
+func (s *Strategy) CustomSettings() map[string]interface{} {
+	return map[string]interface{}{
+		rsiHighKey:   s.rsiHigh.InexactFloat64(),
+		rsiLowKey:    s.rsiLow.InexactFloat64(),
+		rsiPeriodKey: s.rsiPeriod.InexactFloat64(),
+	}
+}
+
 // SetDefaults sets the custom settings to their default values
 func (s *Strategy) SetDefaults() {
 	s.rsiHigh = decimal.NewFromInt(70)
@@ -165,6 +165,75 @@ func TestOnSignal(t *testing.T) {
 	}
 }
 
+func TestOnSignalIndicators(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	s.SetDefaults()
+	s.rsiPeriod = decimal.NewFromInt(1)
+	exch := "binance"
+	a := asset.Spot
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	dStart := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	var stream []common.DataEventHandler
+	var candles []gctkline.Candle
+	for i := 0; i < 3; i++ {
+		price := decimal.NewFromInt(int64(1337 + i))
+		tt := dStart.AddDate(0, 0, i)
+		stream = append(stream, &eventkline.Kline{
+			Base: event.Base{
+				Offset:       int64(i + 1),
+				Exchange:     exch,
+				Time:         tt,
+				Interval:     gctkline.OneDay,
+				CurrencyPair: p,
+				AssetType:    a,
+			},
+			Open:   price,
+			Close:  price,
+			Low:    price,
+			High:   price,
+			Volume: price,
+		})
+		candles = append(candles, gctkline.Candle{
+			Time:   tt,
+			Open:   price.InexactFloat64(),
+			High:   price.InexactFloat64(),
+			Low:    price.InexactFloat64(),
+			Close:  price.InexactFloat64(),
+			Volume: price.InexactFloat64(),
+		})
+	}
+	d := data.Base{}
+	d.SetStream(stream)
+	for range stream {
+		d.Next()
+	}
+	ranger, err := gctkline.CalculateCandleDateRanges(dStart, dStart.AddDate(0, 0, 3), gctkline.OneDay, 100000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ranger.SetHasDataFromCandles(candles)
+	da := &kline.DataFromKline{
+		Item: gctkline.Item{
+			Exchange: exch,
+			Pair:     p,
+			Asset:    a,
+			Interval: gctkline.OneDay,
+			Candles:  candles,
+		},
+		Base:        d,
+		RangeHolder: ranger,
+	}
+	resp, err := s.OnSignal(da, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	indicators := resp.GetIndicators()
+	if len(indicators) != 1 || indicators[0].Name != rsiIndicatorName || indicators[0].Overlay {
+		t.Errorf("expected a single %v panel indicator, received %+v", rsiIndicatorName, indicators)
+	}
+}
+
 func TestOnSignals(t *testing.T) {
 	t.Parallel()
 	s := Strategy{}
@@ -218,3 +287,23 @@ func TestSetDefaults(t *testing.T) {
 		t.Error("expected 14")
 	}
 }
+
+func TestCustomSettings(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	s.SetDefaults()
+	err := s.SetCustomSettings(map[string]interface{}{rsiHighKey: 80.0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	settings := s.CustomSettings()
+	if settings[rsiHighKey] != 80.0 || settings[rsiLowKey] != 30.0 || settings[rsiPeriodKey] != 14.0 {
+		t.Errorf("received '%v' expected '%v'", settings, "rsi-high 80, rsi-low 30, rsi-period 14")
+	}
+	// the reported settings can be applied to another strategy
+	s2 := Strategy{}
+	err = s2.SetCustomSettings(settings)
+	if err != nil {
+		t.Error(err)
+	}
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/dollarcostaverage"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/dynamicdca"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/external"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/rsi"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/top2bottom2"
 )
@@ -36,6 +38,8 @@ func LoadStrategyByName(name string, useSimultaneousProcessing bool) (Handler, e
 func GetStrategies() []Handler {
 	return []Handler{
 		new(dollarcostaverage.Strategy),
+		new(dynamicdca.Strategy),
+		new(external.Strategy),
 		new(rsi.Strategy),
 		new(top2bottom2.Strategy),
 	}
@@ -2,6 +2,8 @@ package strategies
 
 import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
 )
@@ -16,5 +18,35 @@ type Handler interface {
 	SupportsSimultaneousProcessing() bool
 	SetSimultaneousProcessing(bool)
 	SetCustomSettings(map[string]interface{}) error
+	CustomSettings() map[string]interface{}
 	SetDefaults()
 }
+
+// OrderRejectionHandler is implemented by strategies which want to be told
+// when a simulated order is rejected and whether it will be requeued.
+// Returning false cancels the requeue so the strategy can handle the
+// rejection itself
+type OrderRejectionHandler interface {
+	OnOrderRejected(*order.Rejection) bool
+}
+
+// PositionHandler is implemented by strategies which want to inspect their
+// current holdings and open positions when handling signals. Strategies
+// embedding base.Strategy implement it and can call GetPosition
+type PositionHandler interface {
+	SetPositionReader(base.PositionReader)
+}
+
+// TimerHandler is implemented by strategies which schedule time-based
+// callbacks via base.Strategy's AddTimer. Due timers are fired after the
+// data events for a time have been processed
+type TimerHandler interface {
+	Timers() []*base.Timer
+}
+
+// SettingsAdjustmentHandler is implemented by strategies which adjust their
+// custom settings mid-run via base.Strategy's AdjustCustomSettings. Pending
+// changes are applied through SetCustomSettings after the strategy signals
+type SettingsAdjustmentHandler interface {
+	PendingSettingsChanges() []base.SettingsChange
+}
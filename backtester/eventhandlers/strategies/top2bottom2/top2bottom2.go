@@ -19,11 +19,12 @@ import (
 
 const (
 	// Name is the strategy name
-	Name         = "top2bottom2"
-	mfiPeriodKey = "mfi-period"
-	mfiLowKey    = "mfi-low"
-	mfiHighKey   = "mfi-high"
-	description  = `This is an example strategy to highlight more complex strategy design. All signals are processed and then ranked. Only the top 2 and bottom 2 proceed further`
+	Name             = "top2bottom2"
+	mfiPeriodKey     = "mfi-period"
+	mfiLowKey        = "mfi-low"
+	mfiHighKey       = "mfi-high"
+	mfiIndicatorName = "MFI"
+	description      = `This is an example strategy to highlight more complex strategy design. All signals are processed and then ranked. Only the top 2 and bottom 2 proceed further`
 )
 
 var (
@@ -143,6 +144,7 @@ func (s *Strategy) OnSimultaneousSignals(d []data.Handler, f funding.IFundTransf
 
 		es.SetDirection(common.DoNothing)
 		es.AppendReason(fmt.Sprintf("MFI at %v", latestMFI))
+		es.AddIndicator(mfiIndicatorName, latestMFI, false)
 
 		funds, err := f.GetFundingForEvent(&es)
 		if err != nil {
@@ -220,6 +222,15 @@ func (s *Strategy) SetCustomSettings(customSettings map[string]interface{}) erro
 	return nil
 }
 
+// CustomSettings returns the custom settings in use, including defaults
+func (s *Strategy) CustomSettings() map[string]interface{} {
+	return map[string]interface{}{
+		mfiHighKey:   s.mfiHigh.InexactFloat64(),
+		mfiLowKey:    s.mfiLow.InexactFloat64(),
+		mfiPeriodKey: s.mfiPeriod.InexactFloat64(),
+	}
+}
+
 // SetDefaults sets the custom settings to their default values
 func (s *Strategy) SetDefaults() {
 	s.mfiHigh = decimal.NewFromInt(70)
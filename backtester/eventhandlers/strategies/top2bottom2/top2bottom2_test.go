@@ -228,3 +228,22 @@ func TestSelectTopAndBottomPerformers(t *testing.T) {
 		}
 	}
 }
+
+func TestCustomSettings(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	s.SetDefaults()
+	err := s.SetCustomSettings(map[string]interface{}{mfiLowKey: 20.0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	settings := s.CustomSettings()
+	if settings[mfiHighKey] != 70.0 || settings[mfiLowKey] != 20.0 || settings[mfiPeriodKey] != 14.0 {
+		t.Errorf("received '%v' expected '%v'", settings, "mfi-high 70, mfi-low 20, mfi-period 14")
+	}
+	s2 := Strategy{}
+	err = s2.SetCustomSettings(settings)
+	if err != nil {
+		t.Error(err)
+	}
+}
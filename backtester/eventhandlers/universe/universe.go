@@ -0,0 +1,135 @@
+package universe
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// Setup creates a selector from the universe selection settings
+func Setup(cfg *config.UniverseSelection) (*Selector, error) {
+	if cfg == nil {
+		return nil, errNilSettings
+	}
+	if cfg.Interval <= 0 {
+		return nil, fmt.Errorf("%w received %v", errInvalidInterval, cfg.Interval)
+	}
+	if cfg.TopN <= 0 {
+		return nil, fmt.Errorf("%w received %v", errInvalidTopN, cfg.TopN)
+	}
+	if cfg.LookbackCandles <= 0 {
+		return nil, fmt.Errorf("%w received %v", errInvalidLookback, cfg.LookbackCandles)
+	}
+	metric := strings.ToLower(cfg.Metric)
+	switch metric {
+	case config.UniverseMetricVolume, config.UniverseMetricQuoteVolume:
+	default:
+		return nil, fmt.Errorf("%w %v", errInvalidMetric, cfg.Metric)
+	}
+	return &Selector{
+		interval: cfg.Interval,
+		topN:     int(cfg.TopN),
+		metric:   metric,
+		lookback: int(cfg.LookbackCandles),
+	}, nil
+}
+
+// Select splits the candidate data into the pairs selected for the strategy
+// to trade and those which are not. Pairs are re-ranked once the selection
+// interval has passed, otherwise the previous selection is kept
+func (s *Selector) Select(d []data.Handler) (selected, unselected []data.Handler, err error) {
+	if len(d) == 0 {
+		return nil, nil, nil
+	}
+	if d[0] == nil || d[0].Latest() == nil {
+		return nil, nil, common.ErrNilEvent
+	}
+	t := d[0].Latest().GetTime()
+	if s.selected == nil || !t.Before(s.nextSelection) {
+		err = s.rank(d)
+		if err != nil {
+			return nil, nil, err
+		}
+		s.nextSelection = t.Add(s.interval)
+	}
+	for i := range d {
+		if s.selected[d[i]] {
+			selected = append(selected, d[i])
+		} else {
+			unselected = append(unselected, d[i])
+		}
+	}
+	return selected, unselected, nil
+}
+
+// rank orders the candidates by the selection metric and keeps the top pairs
+func (s *Selector) rank(d []data.Handler) error {
+	ranked := make([]rankedPair, len(d))
+	for i := range d {
+		if d[i] == nil {
+			return common.ErrNilArguments
+		}
+		latest := d[i].Latest()
+		if latest == nil {
+			return common.ErrNilEvent
+		}
+		ranked[i] = rankedPair{
+			handler: d[i],
+			name:    fmt.Sprintf("%v %v %v", latest.GetExchange(), latest.GetAssetType(), latest.Pair()),
+			value:   s.calculateMetric(d[i]),
+		}
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].value.Equal(ranked[j].value) {
+			return ranked[i].name < ranked[j].name
+		}
+		return ranked[i].value.GreaterThan(ranked[j].value)
+	})
+	s.selected = make(map[data.Handler]bool)
+	var names []string
+	for i := 0; i < len(ranked) && i < s.topN; i++ {
+		s.selected[ranked[i].handler] = true
+		names = append(names, ranked[i].name)
+	}
+	log.Infof(log.BackTester, "universe selection at %v selected: %v",
+		d[0].Latest().GetTime().Format(gctcommon.SimpleTimeFormat),
+		strings.Join(names, ", "))
+	return nil
+}
+
+// calculateMetric sums the metric over the lookback period of candles
+// up to and including the latest candle
+func (s *Selector) calculateMetric(d data.Handler) decimal.Decimal {
+	volume := d.StreamVol()
+	var closes []decimal.Decimal
+	if s.metric == config.UniverseMetricQuoteVolume {
+		closes = d.StreamClose()
+	}
+	start := len(volume) - s.lookback
+	if start < 0 {
+		start = 0
+	}
+	var resp decimal.Decimal
+	for i := start; i < len(volume); i++ {
+		if s.metric == config.UniverseMetricQuoteVolume {
+			resp = resp.Add(volume[i].Mul(closes[i]))
+			continue
+		}
+		resp = resp.Add(volume[i])
+	}
+	return resp
+}
+
+// Reset clears the selection so that pairs are re-ranked on the next event
+func (s *Selector) Reset() {
+	s.selected = nil
+	s.nextSelection = time.Time{}
+}
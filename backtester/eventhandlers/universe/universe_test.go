@@ -0,0 +1,180 @@
+package universe
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+const testExchange = "binance"
+
+func createData(t *testing.T, p currency.Pair, tt time.Time, closes, volumes []float64) *kline.DataFromKline {
+	t.Helper()
+	k := &kline.DataFromKline{
+		Item: gctkline.Item{
+			Exchange: testExchange,
+			Pair:     p,
+			Asset:    asset.Spot,
+			Interval: gctkline.OneDay,
+		},
+	}
+	for i := range closes {
+		k.Item.Candles = append(k.Item.Candles, gctkline.Candle{
+			Time:   tt.Add(gctkline.OneDay.Duration() * time.Duration(i)),
+			Open:   closes[i],
+			High:   closes[i],
+			Low:    closes[i],
+			Close:  closes[i],
+			Volume: volumes[i],
+		})
+	}
+	err := k.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return k
+}
+
+func next(d []data.Handler) {
+	for i := range d {
+		d[i].Next()
+	}
+}
+
+func TestSetup(t *testing.T) {
+	t.Parallel()
+	_, err := Setup(nil)
+	if !errors.Is(err, errNilSettings) {
+		t.Errorf("received '%v' expected '%v'", err, errNilSettings)
+	}
+	cfg := &config.UniverseSelection{}
+	_, err = Setup(cfg)
+	if !errors.Is(err, errInvalidInterval) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidInterval)
+	}
+	cfg.Interval = gctkline.OneDay.Duration()
+	_, err = Setup(cfg)
+	if !errors.Is(err, errInvalidTopN) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidTopN)
+	}
+	cfg.TopN = 1
+	_, err = Setup(cfg)
+	if !errors.Is(err, errInvalidLookback) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidLookback)
+	}
+	cfg.LookbackCandles = 1
+	_, err = Setup(cfg)
+	if !errors.Is(err, errInvalidMetric) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidMetric)
+	}
+	cfg.Metric = "Volume"
+	s, err := Setup(cfg)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if s.metric != config.UniverseMetricVolume {
+		t.Errorf("received '%v' expected '%v'", s.metric, config.UniverseMetricVolume)
+	}
+}
+
+func TestSelect(t *testing.T) {
+	t.Parallel()
+	tt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	btc := createData(t, currency.NewPair(currency.BTC, currency.USDT), tt, []float64{100, 100, 100}, []float64{1, 1, 1})
+	ltc := createData(t, currency.NewPair(currency.LTC, currency.USDT), tt, []float64{1, 1, 1}, []float64{50, 50, 500})
+	xrp := createData(t, currency.NewPair(currency.XRP, currency.USDT), tt, []float64{1, 1, 1}, []float64{10, 10, 10})
+	d := []data.Handler{btc, ltc, xrp}
+
+	s, err := Setup(&config.UniverseSelection{
+		Interval:        gctkline.OneDay.Duration() * 2,
+		TopN:            1,
+		Metric:          config.UniverseMetricQuoteVolume,
+		LookbackCandles: 2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	selected, unselected, err := s.Select(nil)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if len(selected) != 0 || len(unselected) != 0 {
+		t.Error("expected no data")
+	}
+	_, _, err = s.Select(d)
+	if !errors.Is(err, common.ErrNilEvent) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrNilEvent)
+	}
+
+	next(d)
+	selected, unselected, err = s.Select(d)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if len(selected) != 1 || selected[0] != btc {
+		t.Errorf("expected %v to be selected by quote volume", btc.Item.Pair)
+	}
+	if len(unselected) != 2 {
+		t.Errorf("received '%v' expected '%v'", len(unselected), 2)
+	}
+
+	// LTC volume overtakes BTC, but the selection interval has not passed
+	next(d)
+	selected, _, err = s.Select(d)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if len(selected) != 1 || selected[0] != btc {
+		t.Errorf("expected %v to remain selected", btc.Item.Pair)
+	}
+
+	next(d)
+	selected, _, err = s.Select(d)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if len(selected) != 1 || selected[0] != ltc {
+		t.Errorf("expected %v to be selected after re-ranking", ltc.Item.Pair)
+	}
+}
+
+func TestCalculateMetric(t *testing.T) {
+	t.Parallel()
+	tt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	d := createData(t, currency.NewPair(currency.BTC, currency.USDT), tt, []float64{2, 3, 4}, []float64{5, 6, 7})
+	d.Next()
+	d.Next()
+	d.Next()
+	s := &Selector{metric: config.UniverseMetricVolume, lookback: 2}
+	if v := s.calculateMetric(d); v.InexactFloat64() != 13 {
+		t.Errorf("received '%v' expected '%v'", v, 13)
+	}
+	s.metric = config.UniverseMetricQuoteVolume
+	if v := s.calculateMetric(d); v.InexactFloat64() != 46 {
+		t.Errorf("received '%v' expected '%v'", v, 46)
+	}
+	s.lookback = 10
+	if v := s.calculateMetric(d); v.InexactFloat64() != 56 {
+		t.Errorf("received '%v' expected '%v'", v, 56)
+	}
+}
+
+func TestReset(t *testing.T) {
+	t.Parallel()
+	s := &Selector{
+		nextSelection: time.Now(),
+		selected:      map[data.Handler]bool{},
+	}
+	s.Reset()
+	if s.selected != nil || !s.nextSelection.IsZero() {
+		t.Error("expected reset selector")
+	}
+}
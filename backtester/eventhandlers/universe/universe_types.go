@@ -0,0 +1,34 @@
+package universe
+
+import (
+	"errors"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+)
+
+var (
+	errNilSettings     = errors.New("nil universe selection settings received")
+	errInvalidInterval = errors.New("universe selection interval must be positive")
+	errInvalidTopN     = errors.New("universe selection top-n must be positive")
+	errInvalidLookback = errors.New("universe selection lookback-candles must be positive")
+	errInvalidMetric   = errors.New("unrecognised universe selection metric")
+)
+
+// Selector ranks candidate pairs by their traded volume on a set interval
+// and selects the top pairs for a strategy to trade
+type Selector struct {
+	interval      time.Duration
+	topN          int
+	metric        string
+	lookback      int
+	nextSelection time.Time
+	selected      map[data.Handler]bool
+}
+
+type rankedPair struct {
+	handler data.Handler
+	name    string
+	value   decimal.Decimal
+}
@@ -0,0 +1,192 @@
+package eventlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// ParseLevel returns the level matching its name, defaulting to LevelInfo
+// when unset
+func ParseLevel(s string) (Level, error) {
+	switch strings.ToLower(s) {
+	case "error":
+		return LevelError, nil
+	case "warn":
+		return LevelWarn, nil
+	case "", "info":
+		return LevelInfo, nil
+	case "debug":
+		return LevelDebug, nil
+	}
+	return 0, fmt.Errorf("%w %q, expected error, warn, info or debug", errInvalidLevel, s)
+}
+
+// String returns the name of the level
+func (l Level) String() string {
+	switch l {
+	case LevelError:
+		return "error"
+	case LevelWarn:
+		return "warn"
+	case LevelInfo:
+		return "info"
+	case LevelDebug:
+		return "debug"
+	}
+	return "unknown"
+}
+
+// New creates an event log writing entries at or below the level to the file
+// at the path, replacing any existing file
+func New(path string, level Level) (*Logger, error) {
+	if path == "" {
+		return nil, errPathUnset
+	}
+	if level > LevelDebug {
+		return nil, fmt.Errorf("%w %v", errInvalidLevel, level)
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return &Logger{
+		level:  level,
+		w:      f,
+		closer: f,
+	}, nil
+}
+
+// SetRunID sets the ID of the run recorded in each entry
+func (l *Logger) SetRunID(id string) {
+	if l == nil {
+		return
+	}
+	l.m.Lock()
+	l.runID = id
+	l.m.Unlock()
+}
+
+// Record writes an event received by the event loop to the log along with
+// the outcome of the stage it represents. A nil logger records nothing
+func (l *Logger) Record(ev common.EventHandler) {
+	if l == nil || ev == nil {
+		return
+	}
+	e := newEntry(ev)
+	level := LevelInfo
+	switch t := ev.(type) {
+	case common.DataEventHandler:
+		level = LevelDebug
+		e.EventType = EventTypeData
+		e.Price = t.ClosePrice()
+		e.Outcome = OutcomeReceived
+	case signal.Event:
+		e.EventType = EventTypeSignal
+		e.Price = t.GetPrice()
+		e.Outcome = OutcomeNoSignal
+		if isPlaceable(t.GetDirection()) {
+			e.Outcome = OutcomeSignal
+		}
+	case order.Event:
+		e.EventType = EventTypeOrder
+		e.Price = t.GetPrice()
+		e.Amount = t.GetAmount()
+		e.Outcome = OutcomeNoOrder
+		if isPlaceable(t.GetDirection()) {
+			e.Outcome = OutcomeOrder
+		}
+	case fill.Event:
+		e.EventType = EventTypeFill
+		e.Price = t.GetPurchasePrice()
+		e.Amount = t.GetAmount()
+		e.Outcome = OutcomeFilled
+		if !isPlaceable(t.GetDirection()) {
+			level = LevelWarn
+			e.Outcome = OutcomeNotFilled
+		}
+	default:
+		return
+	}
+	l.write(level, &e)
+}
+
+// RecordError writes an event which could not be processed to the log
+func (l *Logger) RecordError(ev common.EventHandler, err error) {
+	if l == nil || ev == nil || err == nil {
+		return
+	}
+	e := newEntry(ev)
+	switch ev.(type) {
+	case common.DataEventHandler:
+		e.EventType = EventTypeData
+	case signal.Event:
+		e.EventType = EventTypeSignal
+	case order.Event:
+		e.EventType = EventTypeOrder
+	case fill.Event:
+		e.EventType = EventTypeFill
+	}
+	e.Outcome = OutcomeError
+	e.Error = err.Error()
+	l.write(LevelError, &e)
+}
+
+// Close closes the event log's file
+func (l *Logger) Close() error {
+	if l == nil || l.closer == nil {
+		return nil
+	}
+	l.m.Lock()
+	defer l.m.Unlock()
+	return l.closer.Close()
+}
+
+func (l *Logger) write(level Level, e *Entry) {
+	if level > l.level {
+		return
+	}
+	l.m.Lock()
+	defer l.m.Unlock()
+	e.Level = level.String()
+	e.RunID = l.runID
+	data, err := json.Marshal(e)
+	if err != nil {
+		log.Errorf(log.BackTester, "could not marshal event log entry: %v", err)
+		return
+	}
+	_, err = l.w.Write(append(data, '\n'))
+	if err != nil {
+		log.Errorf(log.BackTester, "could not write event log entry: %v", err)
+	}
+}
+
+func newEntry(ev common.EventHandler) Entry {
+	e := Entry{
+		Time:      time.Now(),
+		EventTime: ev.GetTime(),
+		Offset:    ev.GetOffset(),
+		Exchange:  ev.GetExchange(),
+		Asset:     ev.GetAssetType().String(),
+		Pair:      ev.Pair().String(),
+		Reason:    ev.GetReason(),
+	}
+	if d, ok := ev.(common.Directioner); ok {
+		e.Direction = d.GetDirection().String()
+	}
+	return e
+}
+
+// isPlaceable returns whether an order in the direction can be placed
+func isPlaceable(direction gctorder.Side) bool {
+	return direction == gctorder.Buy || direction == gctorder.Sell
+}
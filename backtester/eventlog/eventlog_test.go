@@ -0,0 +1,196 @@
+package eventlog
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+const testExchange = "binance"
+
+func testBase() event.Base {
+	return event.Base{
+		Offset:       1,
+		Exchange:     testExchange,
+		Time:         time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		CurrencyPair: currency.NewPair(currency.BTC, currency.USDT),
+		AssetType:    asset.Spot,
+	}
+}
+
+func readEntries(t *testing.T, path string) []Entry {
+	t.Helper()
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	var resp []Entry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e Entry
+		err = json.Unmarshal(scanner.Bytes(), &e)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp = append(resp, e)
+	}
+	return resp
+}
+
+func TestParseLevel(t *testing.T) {
+	t.Parallel()
+	for s, expected := range map[string]Level{
+		"":      LevelInfo,
+		"error": LevelError,
+		"WARN":  LevelWarn,
+		"info":  LevelInfo,
+		"debug": LevelDebug,
+	} {
+		l, err := ParseLevel(s)
+		if !errors.Is(err, nil) {
+			t.Errorf("received '%v' expected '%v'", err, nil)
+		}
+		if l != expected {
+			t.Errorf("received '%v' expected '%v'", l, expected)
+		}
+	}
+	_, err := ParseLevel("verbose")
+	if !errors.Is(err, errInvalidLevel) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidLevel)
+	}
+	if Level(10).String() != "unknown" {
+		t.Errorf("received '%v' expected '%v'", Level(10).String(), "unknown")
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+	_, err := New("", LevelInfo)
+	if !errors.Is(err, errPathUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errPathUnset)
+	}
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	_, err = New(path, Level(10))
+	if !errors.Is(err, errInvalidLevel) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidLevel)
+	}
+	l, err := New(path, LevelInfo)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = l.Close()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+}
+
+func TestRecord(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	l, err := New(path, LevelInfo)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	l.SetRunID("test")
+	l.Record(nil)
+	l.Record(&kline.Kline{Base: testBase(), Close: decimal.NewFromInt(1337)})
+	b := testBase()
+	b.Reason = "price above moving average"
+	l.Record(&signal.Signal{Base: b, ClosePrice: decimal.NewFromInt(1337), Direction: common.DoNothing})
+	l.Record(&signal.Signal{Base: testBase(), ClosePrice: decimal.NewFromInt(1337), Direction: gctorder.Buy})
+	l.Record(&order.Order{Base: testBase(), Price: decimal.NewFromInt(1337), Amount: decimal.NewFromInt(1), Direction: gctorder.Buy})
+	l.Record(&fill.Fill{Base: testBase(), PurchasePrice: decimal.NewFromInt(1337), Amount: decimal.NewFromInt(1), Direction: gctorder.Buy})
+	l.Record(&fill.Fill{Base: testBase(), Direction: common.CouldNotBuy})
+	l.RecordError(&order.Order{Base: testBase()}, errors.New("test error"))
+	l.RecordError(&order.Order{Base: testBase()}, nil)
+	err = l.Close()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	entries := readEntries(t, path)
+	expected := []struct {
+		level, eventType, outcome string
+	}{
+		{"info", EventTypeSignal, OutcomeNoSignal},
+		{"info", EventTypeSignal, OutcomeSignal},
+		{"info", EventTypeOrder, OutcomeOrder},
+		{"info", EventTypeFill, OutcomeFilled},
+		{"warn", EventTypeFill, OutcomeNotFilled},
+		{"error", EventTypeOrder, OutcomeError},
+	}
+	if len(entries) != len(expected) {
+		t.Fatalf("received '%v' expected '%v' entries, data events are only logged at debug", len(entries), len(expected))
+	}
+	for i := range expected {
+		if entries[i].Level != expected[i].level ||
+			entries[i].EventType != expected[i].eventType ||
+			entries[i].Outcome != expected[i].outcome {
+			t.Errorf("received '%+v' expected '%+v'", entries[i], expected[i])
+		}
+		if entries[i].RunID != "test" || entries[i].Exchange != testExchange || entries[i].Pair != "BTCUSDT" {
+			t.Errorf("received '%+v' expected entry identifying the run and pair", entries[i])
+		}
+	}
+	if entries[0].Reason != "price above moving average" {
+		t.Errorf("received '%v' expected '%v'", entries[0].Reason, "price above moving average")
+	}
+	if entries[5].Error != "test error" {
+		t.Errorf("received '%v' expected '%v'", entries[5].Error, "test error")
+	}
+}
+
+func TestRecordLevels(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	for level, expected := range map[Level]int{
+		LevelError: 0,
+		LevelWarn:  1,
+		LevelInfo:  2,
+		LevelDebug: 3,
+	} {
+		path := filepath.Join(dir, level.String()+".jsonl")
+		l, err := New(path, level)
+		if !errors.Is(err, nil) {
+			t.Fatalf("received '%v' expected '%v'", err, nil)
+		}
+		l.Record(&kline.Kline{Base: testBase()})
+		l.Record(&signal.Signal{Base: testBase(), Direction: gctorder.Buy})
+		l.Record(&fill.Fill{Base: testBase(), Direction: common.CouldNotSell})
+		err = l.Close()
+		if !errors.Is(err, nil) {
+			t.Fatalf("received '%v' expected '%v'", err, nil)
+		}
+		if entries := readEntries(t, path); len(entries) != expected {
+			t.Errorf("received '%v' expected '%v' entries at level %v", len(entries), expected, level)
+		}
+	}
+}
+
+func TestNilLogger(t *testing.T) {
+	t.Parallel()
+	var l *Logger
+	l.SetRunID("test")
+	l.Record(&kline.Kline{Base: testBase()})
+	l.RecordError(&kline.Kline{Base: testBase()}, errors.New("test error"))
+	err := l.Close()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+}
@@ -0,0 +1,92 @@
+package eventlog
+
+import (
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Level determines which entries are written to the event log. Each level
+// includes the entries of the levels before it
+type Level uint8
+
+// Levels of the event log
+const (
+	// LevelError records events which could not be processed
+	LevelError Level = iota
+	// LevelWarn adds orders which were not filled
+	LevelWarn
+	// LevelInfo adds every signal, order and fill, showing the decision made
+	// at each stage for each candle. This is the default
+	LevelInfo
+	// LevelDebug adds every data event
+	LevelDebug
+)
+
+// Event types recorded in the event log
+const (
+	EventTypeData   = "data"
+	EventTypeSignal = "signal"
+	EventTypeOrder  = "order"
+	EventTypeFill   = "fill"
+)
+
+// Outcomes of an event's processing
+const (
+	// OutcomeReceived is a data event passed to the strategy
+	OutcomeReceived = "received"
+	// OutcomeSignal is a strategy signalling to buy or sell
+	OutcomeSignal = "signal"
+	// OutcomeNoSignal is a strategy deciding not to buy or sell
+	OutcomeNoSignal = "no-signal"
+	// OutcomeOrder is the portfolio sizing a signal into an order
+	OutcomeOrder = "order"
+	// OutcomeNoOrder is the portfolio or risk manager stopping a signal from
+	// becoming an order
+	OutcomeNoOrder = "no-order"
+	// OutcomeFilled is the exchange filling an order
+	OutcomeFilled = "filled"
+	// OutcomeNotFilled is the exchange rejecting or being unable to fill an
+	// order
+	OutcomeNotFilled = "not-filled"
+	// OutcomeError is an event which could not be processed
+	OutcomeError = "error"
+)
+
+var (
+	errInvalidLevel = errors.New("invalid event log level")
+	errPathUnset    = errors.New("event log path unset")
+)
+
+// Logger writes each event processed in a run as a line of JSON, allowing a
+// run to be traced event by event, such as why a candle produced no order
+type Logger struct {
+	m      sync.Mutex
+	level  Level
+	runID  string
+	w      io.Writer
+	closer io.Closer
+}
+
+// Entry is a line of the event log. Time is when the entry was recorded while
+// EventTime is the time of the event
+type Entry struct {
+	Time      time.Time       `json:"time"`
+	Level     string          `json:"level"`
+	RunID     string          `json:"run-id,omitempty"`
+	EventType string          `json:"event-type"`
+	EventTime time.Time       `json:"event-time"`
+	Offset    int64           `json:"offset"`
+	Exchange  string          `json:"exchange"`
+	Asset     string          `json:"asset"`
+	Pair      string          `json:"pair"`
+	Direction string          `json:"direction,omitempty"`
+	Price     decimal.Decimal `json:"price"`
+	Amount    decimal.Decimal `json:"amount"`
+	Outcome   string          `json:"outcome"`
+	Reason    string          `json:"reason,omitempty"`
+	Error     string          `json:"error,omitempty"`
+}
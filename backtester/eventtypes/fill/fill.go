@@ -2,6 +2,7 @@ package fill
 
 import (
 	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
 
@@ -55,6 +56,16 @@ func (f *Fill) SetExchangeFee(fee decimal.Decimal) {
 	f.ExchangeFee = fee
 }
 
+// GetFeeCurrency returns the currency the exchange fee was paid in
+func (f *Fill) GetFeeCurrency() currency.Code {
+	return f.FeeCurrency
+}
+
+// GetFeeAmount returns the exchange fee paid, denominated in the fee currency
+func (f *Fill) GetFeeAmount() decimal.Decimal {
+	return f.FeeAmount
+}
+
 // GetOrder returns the order
 func (f *Fill) GetOrder() *order.Detail {
 	return f.Order
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/currency"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
 
@@ -70,6 +71,20 @@ func TestSetExchangeFee(t *testing.T) {
 	}
 }
 
+func TestGetFeeCurrency(t *testing.T) {
+	t.Parallel()
+	f := Fill{
+		FeeCurrency: currency.BNB,
+		FeeAmount:   decimal.NewFromInt(1337),
+	}
+	if !f.GetFeeCurrency().Match(currency.BNB) {
+		t.Errorf("received '%v' expected '%v'", f.GetFeeCurrency(), currency.BNB)
+	}
+	if !f.GetFeeAmount().Equal(decimal.NewFromInt(1337)) {
+		t.Errorf("received '%v' expected '%v'", f.GetFeeAmount(), 1337)
+	}
+}
+
 func TestGetOrder(t *testing.T) {
 	t.Parallel()
 	f := Fill{
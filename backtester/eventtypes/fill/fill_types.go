@@ -4,6 +4,7 @@ import (
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
 
@@ -18,6 +19,9 @@ type Fill struct {
 	Total               decimal.Decimal `json:"total"`
 	ExchangeFee         decimal.Decimal `json:"exchange-fee"`
 	Slippage            decimal.Decimal `json:"slippage"`
+	Spread              decimal.Decimal `json:"spread"`
+	FeeCurrency         currency.Code   `json:"fee-currency"`
+	FeeAmount           decimal.Decimal `json:"fee-amount"`
 	Order               *order.Detail   `json:"-"`
 }
 
@@ -35,5 +39,7 @@ type Event interface {
 	GetTotal() decimal.Decimal
 	GetExchangeFee() decimal.Decimal
 	SetExchangeFee(decimal.Decimal)
+	GetFeeCurrency() currency.Code
+	GetFeeAmount() decimal.Decimal
 	GetOrder() *order.Detail
 }
@@ -31,6 +31,11 @@ func (o *Order) GetAmount() decimal.Decimal {
 	return o.Amount
 }
 
+// GetPrice returns the price
+func (o *Order) GetPrice() decimal.Decimal {
+	return o.Price
+}
+
 // GetBuyLimit returns the buy limit
 func (o *Order) GetBuyLimit() decimal.Decimal {
 	return o.BuyLimit
@@ -81,3 +86,15 @@ func (o *Order) SetLeverage(l decimal.Decimal) {
 func (o *Order) GetAllocatedFunds() decimal.Decimal {
 	return o.AllocatedFunds
 }
+
+// GetEquityScale returns the multiplier equity scaling applied to the order's
+// amount, zero when it was not scaled
+func (o *Order) GetEquityScale() decimal.Decimal {
+	return o.EquityScale
+}
+
+// GetUnscaledAmount returns the order's amount before equity scaling was
+// applied
+func (o *Order) GetUnscaledAmount() decimal.Decimal {
+	return o.UnscaledAmount
+}
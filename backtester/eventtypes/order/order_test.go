@@ -84,3 +84,17 @@ func TestGetFunds(t *testing.T) {
 		t.Error("expected decimal.NewFromInt(1337)")
 	}
 }
+
+func TestGetEquityScale(t *testing.T) {
+	t.Parallel()
+	o := Order{
+		EquityScale:    decimal.NewFromFloat(0.5),
+		UnscaledAmount: decimal.NewFromInt(2),
+	}
+	if !o.GetEquityScale().Equal(decimal.NewFromFloat(0.5)) {
+		t.Error("expected 0.5")
+	}
+	if !o.GetUnscaledAmount().Equal(decimal.NewFromInt(2)) {
+		t.Error("expected 2")
+	}
+}
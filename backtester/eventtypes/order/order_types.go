@@ -20,6 +20,11 @@ type Order struct {
 	AllocatedFunds decimal.Decimal
 	BuyLimit       decimal.Decimal
 	SellLimit      decimal.Decimal
+	// EquityScale is the multiplier applied to the sized amount by equity
+	// scaling, UnscaledAmount being the amount before it was applied. Both
+	// are zero when the order was not scaled
+	EquityScale    decimal.Decimal
+	UnscaledAmount decimal.Decimal
 }
 
 // Event inherits common event interfaces along with extra functions related to handling orders
@@ -30,10 +35,23 @@ type Event interface {
 	GetSellLimit() decimal.Decimal
 	SetAmount(decimal.Decimal)
 	GetAmount() decimal.Decimal
+	GetPrice() decimal.Decimal
 	IsOrder() bool
 	GetStatus() order.Status
 	SetID(id string)
 	GetID() string
 	IsLeveraged() bool
 	GetAllocatedFunds() decimal.Decimal
+	GetEquityScale() decimal.Decimal
+	GetUnscaledAmount() decimal.Decimal
+}
+
+// Rejection describes a rejected simulated order. Attempt is how many times
+// the order has been rejected and when Requeued is set, the order will be
+// resubmitted after RetryAfter candles
+type Rejection struct {
+	Order      Event
+	Attempt    int64
+	Requeued   bool
+	RetryAfter int64
 }
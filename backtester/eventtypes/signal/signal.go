@@ -55,3 +55,17 @@ func (s *Signal) GetPrice() decimal.Decimal {
 func (s *Signal) SetPrice(f decimal.Decimal) {
 	s.ClosePrice = f
 }
+
+// AddIndicator records an indicator value calculated for the signal's candle
+func (s *Signal) AddIndicator(name string, value decimal.Decimal, overlay bool) {
+	s.Indicators = append(s.Indicators, Indicator{
+		Name:    name,
+		Value:   value,
+		Overlay: overlay,
+	})
+}
+
+// GetIndicators returns the indicator values calculated for the signal's candle
+func (s *Signal) GetIndicators() []Indicator {
+	return s.Indicators
+}
@@ -56,3 +56,23 @@ func TestSetSellLimit(t *testing.T) {
 		t.Errorf("expected 20, received %v", s.GetSellLimit())
 	}
 }
+
+func TestAddIndicator(t *testing.T) {
+	t.Parallel()
+	s := Signal{}
+	if len(s.GetIndicators()) != 0 {
+		t.Error("expected no indicators")
+	}
+	s.AddIndicator("RSI", decimal.NewFromInt(30), false)
+	s.AddIndicator("SMA", decimal.NewFromInt(1337), true)
+	indicators := s.GetIndicators()
+	if len(indicators) != 2 {
+		t.Fatalf("expected 2 indicators, received %v", len(indicators))
+	}
+	if indicators[0].Name != "RSI" || !indicators[0].Value.Equal(decimal.NewFromInt(30)) || indicators[0].Overlay {
+		t.Errorf("unexpected indicator %+v", indicators[0])
+	}
+	if indicators[1].Name != "SMA" || !indicators[1].Value.Equal(decimal.NewFromInt(1337)) || !indicators[1].Overlay {
+		t.Errorf("unexpected indicator %+v", indicators[1])
+	}
+}
@@ -17,6 +17,7 @@ type Event interface {
 	IsSignal() bool
 	GetSellLimit() decimal.Decimal
 	GetBuyLimit() decimal.Decimal
+	GetIndicators() []Indicator
 }
 
 // Signal contains everything needed for a strategy to raise a signal event
@@ -30,4 +31,15 @@ type Signal struct {
 	BuyLimit   decimal.Decimal
 	SellLimit  decimal.Decimal
 	Direction  order.Side
+	Indicators []Indicator
+}
+
+// Indicator is a value a strategy calculated for the candle of a signal,
+// such as an RSI or moving average, which is charted in the report
+type Indicator struct {
+	Name  string
+	Value decimal.Decimal
+	// Overlay draws the indicator over the price chart instead of in its
+	// own panel beneath it
+	Overlay bool
 }
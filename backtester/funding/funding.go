@@ -11,6 +11,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	fbase "github.com/thrasher-corp/gocryptotrader/currency/forexprovider/base"
 	exchangeratehost "github.com/thrasher-corp/gocryptotrader/currency/forexprovider/exchangerate.host"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/log"
@@ -27,6 +28,9 @@ var (
 	errNotEnoughFunds             = errors.New("not enough funds")
 	errCannotTransferToSameFunds  = errors.New("cannot send funds to self")
 	errTransferMustBeSameCurrency = errors.New("cannot transfer to different currency")
+	errConversionUnsupported      = errors.New("funding conversions require exchange level funding")
+	errInvalidConversion          = errors.New("invalid funding conversion")
+	errInvalidConversionRate      = errors.New("invalid funding conversion rate")
 )
 
 // SetupFundingManager creates the funding holder. It carries knowledge about levels of funding
@@ -54,6 +58,16 @@ func CreateItem(exch string, a asset.Item, ci currency.Code, initialFunds, trans
 	}, nil
 }
 
+// SetConfirmationDelay sets how long funds transferred to the item take to
+// be confirmed on the network before they are available
+func (i *Item) SetConfirmationDelay(delay time.Duration) error {
+	if delay < 0 {
+		return fmt.Errorf("%v %v %v %w confirmation delay: %v", i.exchange, i.asset, i.currency, errNegativeAmountReceived, delay)
+	}
+	i.confirmationDelay = delay
+	return nil
+}
+
 // CreatePair adds two funding items and associates them with one another
 // the association allows for the same currency to be used multiple times when
 // usingExchangeLevelFunding is false. eg BTC-USDT and LTC-USDT do not share the same
@@ -99,7 +113,9 @@ func (f *FundManager) GenerateReport(startDate, endDate time.Time) *Report {
 			// calculating totals for shared funding across multiple currency pairs is difficult
 			// converting totals using a free API is better suited as an initial concept
 			// TODO convert currencies without external dependency
-			if strings.Contains(f.items[i].currency.String(), "USD") {
+			if f.items[i].currency.IsCashEquivalent(currency.USD) ||
+				strings.Contains(f.items[i].currency.String(), "USD") {
+				// USD, USD pegged stablecoins and USD named currencies are
 				// not worth converting
 				initialWorthDecimal = f.items[i].initialFunds
 				finalWorthDecimal = f.items[i].available
@@ -129,14 +145,23 @@ func (f *FundManager) GenerateReport(startDate, endDate time.Time) *Report {
 			}
 		}
 		item := ReportItem{
-			Exchange:        f.items[i].exchange,
-			Asset:           f.items[i].asset,
-			Currency:        f.items[i].currency,
-			InitialFunds:    f.items[i].initialFunds,
-			InitialFundsUSD: initialWorthDecimal.Round(2),
-			TransferFee:     f.items[i].transferFee,
-			FinalFunds:      f.items[i].available,
-			FinalFundsUSD:   finalWorthDecimal.Round(2),
+			Exchange:           f.items[i].exchange,
+			Asset:              f.items[i].asset,
+			Currency:           f.items[i].currency,
+			InitialFunds:       f.items[i].initialFunds,
+			InitialFundsUSD:    initialWorthDecimal.Round(2),
+			TransferFee:        f.items[i].transferFee,
+			TransferFeesPaid:   f.items[i].transferFeesPaid,
+			FinalFunds:         f.items[i].available,
+			FinalFundsUSD:      finalWorthDecimal.Round(2),
+			ConvertedIn:        f.items[i].convertedIn,
+			ConvertedOut:       f.items[i].convertedOut,
+			ConversionFeesPaid: f.items[i].conversionFeesPaid,
+		}
+		for j := range f.pendingTransfers {
+			if f.pendingTransfers[j].receiver == f.items[i] {
+				item.InTransit = item.InTransit.Add(f.pendingTransfers[j].amount)
+			}
 		}
 
 		if f.items[i].initialFunds.IsZero() {
@@ -155,9 +180,57 @@ func (f *FundManager) GenerateReport(startDate, endDate time.Time) *Report {
 		report.Difference = report.FinalTotalUSD.Sub(report.InitialTotalUSD).Div(report.InitialTotalUSD).Mul(decimal.NewFromInt(100))
 	}
 	report.Items = items
+	report.Conversions = f.conversionTrades
+	report.CashEquivalents = f.cashEquivalentGroups()
 	return report
 }
 
+// cashEquivalentGroups totals the funds of each exchange and asset's items
+// whose currencies are cash equivalents of one another. Only groups holding
+// more than one currency are returned
+func (f *FundManager) cashEquivalentGroups() []CashEquivalentGroup {
+	var resp []CashEquivalentGroup
+	var grouped []*Item
+	for i := range f.items {
+		if !f.items[i].currency.IsStablecoin() {
+			continue
+		}
+		var isGrouped bool
+		for j := range grouped {
+			if grouped[j].MatchesExchange(f.items[i]) &&
+				grouped[j].asset == f.items[i].asset &&
+				grouped[j].IsCashEquivalent(f.items[i]) {
+				isGrouped = true
+				break
+			}
+		}
+		if isGrouped {
+			continue
+		}
+		grouped = append(grouped, f.items[i])
+		members, err := f.GetCashEquivalentFunding(f.items[i].exchange, f.items[i].asset, f.items[i].currency)
+		if err != nil {
+			continue
+		}
+		group := CashEquivalentGroup{
+			Exchange: f.items[i].exchange,
+			Asset:    f.items[i].asset,
+			Currency: f.items[i].currency.GetCashEquivalent(),
+		}
+		for j := range members {
+			if !group.Currencies.Contains(members[j].currency) {
+				group.Currencies = append(group.Currencies, members[j].currency)
+			}
+			group.InitialFunds = group.InitialFunds.Add(members[j].initialFunds)
+			group.FinalFunds = group.FinalFunds.Add(members[j].available)
+		}
+		if len(group.Currencies) > 1 {
+			resp = append(resp, group)
+		}
+	}
+	return resp
+}
+
 // Transfer allows transferring funds from one pretend exchange to another
 func (f *FundManager) Transfer(amount decimal.Decimal, sender, receiver *Item, inclusiveFee bool) error {
 	if sender == nil || receiver == nil {
@@ -196,10 +269,76 @@ func (f *FundManager) Transfer(amount decimal.Decimal, sender, receiver *Item, i
 	if err != nil {
 		return err
 	}
-	receiver.IncreaseAvailable(receiveAmount)
+	if receiver.confirmationDelay > 0 {
+		f.pendingTransfers = append(f.pendingTransfers, pendingTransfer{
+			receiver:    receiver,
+			amount:      receiveAmount,
+			confirmedAt: f.latestTime.Add(receiver.confirmationDelay),
+		})
+	} else {
+		receiver.IncreaseAvailable(receiveAmount)
+	}
+	sender.transferFeesPaid = sender.transferFeesPaid.Add(sender.transferFee)
 	return sender.Release(sendAmount, decimal.Zero)
 }
 
+// ConfirmTransfers sets the latest time of the run, which transfers are
+// delayed from, and credits the receivers of any pending transfers which
+// have been confirmed by then
+func (f *FundManager) ConfirmTransfers(t time.Time) {
+	if t.After(f.latestTime) {
+		f.latestTime = t
+	}
+	remaining := f.pendingTransfers[:0]
+	for i := range f.pendingTransfers {
+		if f.pendingTransfers[i].confirmedAt.After(f.latestTime) {
+			remaining = append(remaining, f.pendingTransfers[i])
+			continue
+		}
+		f.pendingTransfers[i].receiver.IncreaseAvailable(f.pendingTransfers[i].amount)
+	}
+	f.pendingTransfers = remaining
+}
+
+// AddConversion allows buy orders short of the to item's funds to be funded by
+// converting the from item's funds at the rate returned when the order is
+// placed, less the fee rate. Both items must already be in the manager and
+// share an exchange and asset
+func (f *FundManager) AddConversion(from, to *Item, fee decimal.Decimal, rate RateFunc) error {
+	if from == nil || to == nil || rate == nil {
+		return common.ErrNilArguments
+	}
+	if !f.usingExchangeLevelFunding {
+		return errConversionUnsupported
+	}
+	if !from.MatchesExchange(to) || from.asset != to.asset || from.MatchesItemCurrency(to) {
+		return fmt.Errorf("%w %v %v %v to %v %v %v, must be different currencies on the same exchange and asset",
+			errInvalidConversion, from.exchange, from.asset, from.currency, to.exchange, to.asset, to.currency)
+	}
+	if fee.IsNegative() || fee.GreaterThanOrEqual(decimal.NewFromInt(1)) {
+		return fmt.Errorf("%w %v %v %v to %v fee %v, must be at least 0 and less than 1",
+			errInvalidConversion, from.exchange, from.asset, from.currency, to.currency, fee)
+	}
+	if !f.Exists(from) {
+		return fmt.Errorf("%v %v %v %w", from.exchange, from.asset, from.currency, ErrFundsNotFound)
+	}
+	if !f.Exists(to) {
+		return fmt.Errorf("%v %v %v %w", to.exchange, to.asset, to.currency, ErrFundsNotFound)
+	}
+	for i := range f.conversions {
+		if f.conversions[i].from == from && f.conversions[i].to == to {
+			return fmt.Errorf("%v %v %v to %v conversion %w", from.exchange, from.asset, from.currency, to.currency, ErrAlreadyExists)
+		}
+	}
+	f.conversions = append(f.conversions, &conversion{
+		from: from,
+		to:   to,
+		fee:  fee,
+		rate: rate,
+	})
+	return nil
+}
+
 // AddItem appends a new funding item. Will reject if exists by exchange asset currency
 func (f *FundManager) AddItem(item *Item) error {
 	if f.Exists(item) {
@@ -232,6 +371,110 @@ func (f *FundManager) AddPair(p *Pair) error {
 	return nil
 }
 
+// Reconcile compares the change in each currency's real exchange balance
+// against the change in its funding since reconciliation began, as exchange
+// balances may hold more than the backtester was funded with. Balances are
+// retrieved once per exchange and asset via getHoldings. The first call
+// records the balances to compare against. Currencies which differ by more
+// than the tolerance percentage of their funds are returned and, when correct
+// is set, their available funds are adjusted by the difference
+func (f *FundManager) Reconcile(getHoldings func(string, asset.Item) (*account.Holdings, error), tolerancePercent decimal.Decimal, correct bool) ([]Drift, error) {
+	if getHoldings == nil {
+		return nil, fmt.Errorf("holdings retriever %w", common.ErrNilArguments)
+	}
+	if f.reconcileBaselines == nil {
+		f.reconcileBaselines = make(map[string]decimal.Decimal)
+	}
+	holdings := make(map[string]*account.Holdings)
+	checked := make(map[string]bool)
+	var resp []Drift
+	for i := range f.items {
+		key := fmt.Sprintf("%v %v %v", f.items[i].exchange, f.items[i].asset, f.items[i].currency)
+		if checked[key] {
+			continue
+		}
+		checked[key] = true
+		holdingsKey := fmt.Sprintf("%v %v", f.items[i].exchange, f.items[i].asset)
+		h, ok := holdings[holdingsKey]
+		if !ok {
+			var err error
+			h, err = getHoldings(f.items[i].exchange, f.items[i].asset)
+			if err != nil {
+				return resp, fmt.Errorf("could not reconcile %v %v, %w", f.items[i].exchange, f.items[i].asset, err)
+			}
+			holdings[holdingsKey] = h
+		}
+		balance := holdingsBalance(h, f.items[i].asset, f.items[i].currency)
+
+		// without exchange level funding, a currency can be funded by
+		// multiple items which are reconciled together
+		var funds, initialFunds decimal.Decimal
+		for j := i; j < len(f.items); j++ {
+			if f.items[j].exchange != f.items[i].exchange ||
+				f.items[j].asset != f.items[i].asset ||
+				f.items[j].currency != f.items[i].currency {
+				continue
+			}
+			funds = funds.Add(f.items[j].available).Add(f.items[j].reserved)
+			initialFunds = initialFunds.Add(f.items[j].initialFunds)
+		}
+		expectedChange := funds.Sub(initialFunds)
+		baseline, ok := f.reconcileBaselines[key]
+		if !ok {
+			f.reconcileBaselines[key] = balance.Sub(expectedChange)
+			continue
+		}
+		actualChange := balance.Sub(baseline)
+		difference := actualChange.Sub(expectedChange)
+		if difference.IsZero() {
+			continue
+		}
+		var differencePercent decimal.Decimal
+		if !funds.IsZero() {
+			differencePercent = difference.Div(funds).Mul(decimal.NewFromInt(100))
+			if differencePercent.Abs().LessThanOrEqual(tolerancePercent) {
+				continue
+			}
+		}
+		drift := Drift{
+			Exchange:          f.items[i].exchange,
+			Asset:             f.items[i].asset,
+			Currency:          f.items[i].currency,
+			Funds:             funds,
+			ExpectedChange:    expectedChange,
+			ActualChange:      actualChange,
+			Difference:        difference,
+			DifferencePercent: differencePercent,
+		}
+		if correct {
+			f.items[i].adjustAvailable(difference)
+			drift.Corrected = true
+		}
+		resp = append(resp, drift)
+	}
+	return resp, nil
+}
+
+// holdingsBalance sums the currency's balances across the holdings' accounts
+// of the asset
+func holdingsBalance(h *account.Holdings, a asset.Item, c currency.Code) decimal.Decimal {
+	var resp decimal.Decimal
+	if h == nil {
+		return resp
+	}
+	for i := range h.Accounts {
+		if h.Accounts[i].AssetType != a {
+			continue
+		}
+		for j := range h.Accounts[i].Currencies {
+			if h.Accounts[i].Currencies[j].CurrencyName.Match(c) {
+				resp = resp.Add(decimal.NewFromFloat(h.Accounts[i].Currencies[j].TotalValue))
+			}
+		}
+	}
+	return resp
+}
+
 // IsUsingExchangeLevelFunding returns if using usingExchangeLevelFunding
 func (f *FundManager) IsUsingExchangeLevelFunding() bool {
 	return f.usingExchangeLevelFunding
@@ -270,9 +513,32 @@ func (f *FundManager) GetFundingForEAP(exch string, a asset.Item, p currency.Pai
 	if resp.Quote == nil {
 		return nil, fmt.Errorf("quote %w", ErrFundsNotFound)
 	}
+	for i := range f.conversions {
+		if f.conversions[i].to == resp.Quote {
+			resp.conversions = append(resp.conversions, f.conversions[i])
+		}
+	}
+	resp.manager = f
 	return &resp, nil
 }
 
+// GetCashEquivalentFunding returns all funding items on the exchange and asset
+// whose currency is a cash equivalent of the supplied currency
+func (f *FundManager) GetCashEquivalentFunding(exch string, a asset.Item, c currency.Code) ([]*Item, error) {
+	var resp []*Item
+	for i := range f.items {
+		if f.items[i].exchange == exch &&
+			f.items[i].asset == a &&
+			f.items[i].currency.IsCashEquivalent(c) {
+			resp = append(resp, f.items[i])
+		}
+	}
+	if len(resp) == 0 {
+		return nil, ErrFundsNotFound
+	}
+	return resp, nil
+}
+
 // BaseInitialFunds returns the initial funds
 // from the base in a currency pair
 func (p *Pair) BaseInitialFunds() decimal.Decimal {
@@ -297,12 +563,104 @@ func (p *Pair) QuoteAvailable() decimal.Decimal {
 	return p.Quote.available
 }
 
+// ConvertibleQuote returns the amount of the quote currency which could be
+// received by converting all the funds of the quote's conversions at their
+// current rates, after fees
+func (p *Pair) ConvertibleQuote() decimal.Decimal {
+	var resp decimal.Decimal
+	for i := range p.conversions {
+		received, err := p.conversions[i].receivable(p.conversions[i].from.available)
+		if err != nil {
+			continue
+		}
+		resp = resp.Add(received)
+	}
+	return resp
+}
+
+// convertShortfall converts the funds of the quote's conversions, in the order
+// they were added, until the quote has the amount available. Nothing is
+// converted when the conversions cannot cover the shortfall
+func (p *Pair) convertShortfall(amount decimal.Decimal) error {
+	shortfall := amount.Sub(p.Quote.available)
+	if !shortfall.IsPositive() || len(p.conversions) == 0 {
+		return nil
+	}
+	if p.ConvertibleQuote().LessThan(shortfall) {
+		return fmt.Errorf("%w for %v %v %v. Requested %v Available: %v Convertible: %v",
+			errCannotAllocate,
+			p.Quote.exchange,
+			p.Quote.asset,
+			p.Quote.currency,
+			amount,
+			p.Quote.available,
+			p.ConvertibleQuote())
+	}
+	for i := range p.conversions {
+		if !shortfall.IsPositive() {
+			break
+		}
+		c := p.conversions[i]
+		rate, err := c.rate()
+		if err != nil || !rate.IsPositive() || !c.from.available.IsPositive() {
+			continue
+		}
+		net := rate.Mul(decimal.NewFromInt(1).Sub(c.fee))
+		sold := shortfall.Div(net)
+		received := shortfall
+		if sold.GreaterThan(c.from.available) {
+			sold = c.from.available
+			received = sold.Mul(net)
+		}
+		fee := sold.Mul(rate).Sub(received)
+		c.from.available = c.from.available.Sub(sold)
+		c.from.convertedOut = c.from.convertedOut.Add(sold)
+		c.to.available = c.to.available.Add(received)
+		c.to.convertedIn = c.to.convertedIn.Add(received)
+		c.to.conversionFeesPaid = c.to.conversionFeesPaid.Add(fee)
+		shortfall = shortfall.Sub(received)
+		if p.manager != nil {
+			p.manager.conversionTrades = append(p.manager.conversionTrades, Conversion{
+				Time:     p.manager.latestTime,
+				Exchange: c.from.exchange,
+				Asset:    c.from.asset,
+				From:     c.from.currency,
+				To:       c.to.currency,
+				Sold:     sold,
+				Rate:     rate,
+				Fee:      fee,
+				Received: received,
+			})
+		}
+	}
+	return nil
+}
+
+// receivable returns the amount of the to currency received for converting
+// the amount of the from currency, after fees
+func (c *conversion) receivable(amount decimal.Decimal) (decimal.Decimal, error) {
+	rate, err := c.rate()
+	if err != nil {
+		return decimal.Zero, err
+	}
+	if !rate.IsPositive() {
+		return decimal.Zero, fmt.Errorf("%w %v to %v: %v", errInvalidConversionRate, c.from.currency, c.to.currency, rate)
+	}
+	return amount.Mul(rate).Mul(decimal.NewFromInt(1).Sub(c.fee)), nil
+}
+
 // Reserve allocates an amount of funds to be used at a later time
 // it prevents multiple events from claiming the same resource
-// changes which currency to affect based on the order side
+// changes which currency to affect based on the order side. Buy orders
+// short of quote funds convert the shortfall from the quote's conversions
 func (p *Pair) Reserve(amount decimal.Decimal, side order.Side) error {
 	switch side {
 	case order.Buy:
+		if amount.IsPositive() {
+			if err := p.convertShortfall(amount); err != nil {
+				return err
+			}
+		}
 		return p.Quote.Reserve(amount)
 	case order.Sell:
 		return p.Base.Reserve(amount)
@@ -347,12 +705,12 @@ func (p *Pair) IncreaseAvailable(amount decimal.Decimal, side order.Side) {
 }
 
 // CanPlaceOrder does a > 0 check to see if there are any funds
-// to place an order with
+// to place an order with, including funds which can be converted to the quote
 // changes which currency to affect based on the order side
 func (p *Pair) CanPlaceOrder(side order.Side) bool {
 	switch side {
 	case order.Buy:
-		return p.Quote.CanPlaceOrder()
+		return p.Quote.CanPlaceOrder() || p.ConvertibleQuote().IsPositive()
 	case order.Sell:
 		return p.Base.CanPlaceOrder()
 	}
@@ -410,6 +768,12 @@ func (i *Item) IncreaseAvailable(amount decimal.Decimal) {
 	i.available = i.available.Add(amount)
 }
 
+// adjustAvailable corrects the available amount by a positive or negative
+// amount, which cannot reduce it below zero
+func (i *Item) adjustAvailable(amount decimal.Decimal) {
+	i.available = decimal.Max(i.available.Add(amount), decimal.Zero)
+}
+
 // CanPlaceOrder checks if the item has any funds available
 func (i *Item) CanPlaceOrder() bool {
 	return i.available.GreaterThan(decimal.Zero)
@@ -461,6 +825,13 @@ func (i *Item) MatchesItemCurrency(item *Item) bool {
 	return i != nil && item != nil && i.currency == item.currency
 }
 
+// IsCashEquivalent checks that an item's currency is equal to, or pegged to the
+// same fiat currency as, the supplied item's currency. e.g. USDT and USDC
+// funding items are cash equivalents
+func (i *Item) IsCashEquivalent(item *Item) bool {
+	return i != nil && item != nil && i.currency.IsCashEquivalent(item.currency)
+}
+
 // MatchesExchange checks that an item's exchange is equal
 func (i *Item) MatchesExchange(item *Item) bool {
 	return i != nil && item != nil && i.exchange == item.exchange
@@ -8,6 +8,7 @@ import (
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
@@ -121,6 +122,54 @@ func TestTransfer(t *testing.T) {
 	}
 }
 
+func TestTransferConfirmation(t *testing.T) {
+	t.Parallel()
+	tt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	f := FundManager{}
+	sender := &Item{exchange: "hello", asset: a, currency: base, available: elite, transferFee: one}
+	receiver := &Item{exchange: "moto", asset: a, currency: base}
+	err := receiver.SetConfirmationDelay(-time.Hour)
+	if !errors.Is(err, errNegativeAmountReceived) {
+		t.Errorf("received '%v' expected '%v'", err, errNegativeAmountReceived)
+	}
+	err = receiver.SetConfirmationDelay(time.Hour)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = f.AddItem(receiver)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	f.ConfirmTransfers(tt)
+	err = f.Transfer(elite, sender, receiver, true)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !sender.available.IsZero() || !sender.transferFeesPaid.Equal(one) {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", sender.available, sender.transferFeesPaid, 0, one)
+	}
+	if !receiver.available.IsZero() {
+		t.Errorf("received '%v' expected '%v'", receiver.available, 0)
+	}
+	report := f.GenerateReport(tt, tt)
+	if len(report.Items) != 1 || !report.Items[0].InTransit.Equal(elite.Sub(one)) {
+		t.Errorf("received '%+v' expected in transit '%v'", report.Items, elite.Sub(one))
+	}
+
+	f.ConfirmTransfers(tt.Add(time.Minute))
+	if !receiver.available.IsZero() {
+		t.Errorf("received '%v' expected '%v'", receiver.available, 0)
+	}
+	f.ConfirmTransfers(tt.Add(time.Hour))
+	if !receiver.available.Equal(elite.Sub(one)) {
+		t.Errorf("received '%v' expected '%v'", receiver.available, elite.Sub(one))
+	}
+	if len(f.pendingTransfers) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(f.pendingTransfers), 0)
+	}
+}
+
 func TestAddItem(t *testing.T) {
 	t.Parallel()
 	f := FundManager{}
@@ -620,6 +669,142 @@ func TestCanPlaceOrderPair(t *testing.T) {
 	}
 }
 
+func TestAddConversion(t *testing.T) {
+	t.Parallel()
+	rate := func() (decimal.Decimal, error) { return decimal.NewFromInt(2), nil }
+	f := SetupFundingManager(false)
+	err := f.AddConversion(nil, nil, decimal.Zero, rate)
+	if !errors.Is(err, common.ErrNilArguments) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrNilArguments)
+	}
+	from, err := CreateItem(exch, a, currency.BTC, one, decimal.Zero)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	to, err := CreateItem(exch, a, quote, decimal.Zero, decimal.Zero)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = f.AddConversion(from, to, decimal.Zero, rate)
+	if !errors.Is(err, errConversionUnsupported) {
+		t.Errorf("received '%v' expected '%v'", err, errConversionUnsupported)
+	}
+
+	f = SetupFundingManager(true)
+	err = f.AddConversion(from, from, decimal.Zero, rate)
+	if !errors.Is(err, errInvalidConversion) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidConversion)
+	}
+	err = f.AddConversion(from, to, one, rate)
+	if !errors.Is(err, errInvalidConversion) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidConversion)
+	}
+	err = f.AddConversion(from, to, decimal.Zero, rate)
+	if !errors.Is(err, ErrFundsNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, ErrFundsNotFound)
+	}
+	err = f.AddItem(from)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = f.AddItem(to)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = f.AddConversion(from, to, decimal.Zero, rate)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	err = f.AddConversion(from, to, decimal.Zero, rate)
+	if !errors.Is(err, ErrAlreadyExists) {
+		t.Errorf("received '%v' expected '%v'", err, ErrAlreadyExists)
+	}
+}
+
+func TestReserveConversion(t *testing.T) {
+	t.Parallel()
+	f := SetupFundingManager(true)
+	baseItem, err := CreateItem(exch, a, base, decimal.Zero, decimal.Zero)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	quoteItem, err := CreateItem(exch, a, quote, decimal.NewFromInt(10), decimal.Zero)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	btcItem, err := CreateItem(exch, a, currency.BTC, one, decimal.Zero)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	for _, item := range []*Item{baseItem, quoteItem, btcItem} {
+		if err = f.AddItem(item); !errors.Is(err, nil) {
+			t.Fatalf("received '%v' expected '%v'", err, nil)
+		}
+	}
+	rate := decimal.NewFromInt(100)
+	err = f.AddConversion(btcItem, quoteItem, decimal.NewFromFloat(0.01), func() (decimal.Decimal, error) {
+		return rate, nil
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	tt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	f.ConfirmTransfers(tt)
+
+	pairItems, err := f.GetFundingForEAP(exch, a, pair)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !pairItems.ConvertibleQuote().Equal(decimal.NewFromInt(99)) {
+		t.Errorf("received '%v' expected '%v'", pairItems.ConvertibleQuote(), 99)
+	}
+	err = pairItems.Reserve(decimal.NewFromInt(110), gctorder.Buy)
+	if !errors.Is(err, errCannotAllocate) {
+		t.Errorf("received '%v' expected '%v'", err, errCannotAllocate)
+	}
+	if !btcItem.available.Equal(one) {
+		t.Errorf("expected nothing to be converted, received '%v'", btcItem.available)
+	}
+
+	// 49.5 XRP is converted from 0.5 BTC at 100 less 1%
+	err = pairItems.Reserve(decimal.NewFromFloat(59.5), gctorder.Buy)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !btcItem.available.Equal(decimal.NewFromFloat(0.5)) {
+		t.Errorf("received '%v' expected '%v'", btcItem.available, 0.5)
+	}
+	if !quoteItem.available.IsZero() || !quoteItem.reserved.Equal(decimal.NewFromFloat(59.5)) {
+		t.Errorf("received available '%v' reserved '%v' expected '%v' '%v'", quoteItem.available, quoteItem.reserved, 0, 59.5)
+	}
+	if !quoteItem.conversionFeesPaid.Equal(decimal.NewFromFloat(0.5)) {
+		t.Errorf("received '%v' expected '%v'", quoteItem.conversionFeesPaid, 0.5)
+	}
+
+	report := f.GenerateReport(tt, tt)
+	if len(report.Conversions) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(report.Conversions), 1)
+	}
+	c := report.Conversions[0]
+	if !c.Time.Equal(tt) || !c.From.Match(currency.BTC) || !c.To.Match(quote) ||
+		!c.Sold.Equal(decimal.NewFromFloat(0.5)) || !c.Received.Equal(decimal.NewFromFloat(49.5)) {
+		t.Errorf("unexpected conversion %+v", c)
+	}
+
+	// conversions with no rate are skipped
+	rate = decimal.Zero
+	if !pairItems.ConvertibleQuote().IsZero() {
+		t.Errorf("received '%v' expected '%v'", pairItems.ConvertibleQuote(), 0)
+	}
+	if pairItems.CanPlaceOrder(gctorder.Buy) {
+		t.Error("expected no funds to buy with")
+	}
+	rate = decimal.NewFromInt(100)
+	if !pairItems.CanPlaceOrder(gctorder.Buy) {
+		t.Error("expected convertible funds to buy with")
+	}
+}
+
 func TestIncreaseAvailable(t *testing.T) {
 	t.Parallel()
 	i := Item{}
@@ -819,3 +1004,193 @@ func TestMatchesCurrency(t *testing.T) {
 		t.Error("expected false")
 	}
 }
+
+func TestGetCashEquivalentFunding(t *testing.T) {
+	t.Parallel()
+	f := FundManager{}
+	_, err := f.GetCashEquivalentFunding(exch, a, currency.USD)
+	if !errors.Is(err, ErrFundsNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, ErrFundsNotFound)
+	}
+	usdt, err := CreateItem(exch, a, currency.USDT, elite, decimal.Zero)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	usdc, err := CreateItem(exch, a, currency.USDC, elite, decimal.Zero)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	baseItem, err := CreateItem(exch, a, base, elite, decimal.Zero)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	f.items = append(f.items, usdt, usdc, baseItem)
+	if !usdt.IsCashEquivalent(usdc) {
+		t.Errorf("received '%v' expected '%v'", false, true)
+	}
+	if usdt.IsCashEquivalent(baseItem) {
+		t.Errorf("received '%v' expected '%v'", true, false)
+	}
+	items, err := f.GetCashEquivalentFunding(exch, a, currency.USD)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if len(items) != 2 {
+		t.Errorf("received '%v' expected '%v'", len(items), 2)
+	}
+}
+
+func TestCashEquivalentGroups(t *testing.T) {
+	t.Parallel()
+	f := FundManager{}
+	usdt, err := CreateItem(exch, a, currency.USDT, elite, decimal.Zero)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	baseItem, err := CreateItem(exch, a, base, elite, decimal.Zero)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	f.items = append(f.items, usdt, baseItem)
+	groups := f.cashEquivalentGroups()
+	if len(groups) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(groups), 0)
+	}
+	usd, err := CreateItem(exch, a, currency.USD, elite, decimal.Zero)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	usdc, err := CreateItem(exch, a, currency.USDC, elite, decimal.Zero)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	usdc.available = decimal.NewFromInt(1)
+	f.items = append(f.items, usd, usdc)
+	groups = f.cashEquivalentGroups()
+	if len(groups) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(groups), 1)
+	}
+	if !groups[0].Currency.Match(currency.USD) {
+		t.Errorf("received '%v' expected '%v'", groups[0].Currency, currency.USD)
+	}
+	if len(groups[0].Currencies) != 3 {
+		t.Errorf("received '%v' expected '%v'", len(groups[0].Currencies), 3)
+	}
+	if !groups[0].InitialFunds.Equal(elite.Mul(decimal.NewFromInt(3))) {
+		t.Errorf("received '%v' expected '%v'", groups[0].InitialFunds, elite.Mul(decimal.NewFromInt(3)))
+	}
+	if !groups[0].FinalFunds.Equal(elite.Mul(decimal.NewFromInt(2)).Add(decimal.NewFromInt(1))) {
+		t.Errorf("received '%v' expected '%v'", groups[0].FinalFunds, elite.Mul(decimal.NewFromInt(2)).Add(decimal.NewFromInt(1)))
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	t.Parallel()
+	f := SetupFundingManager(true)
+	_, err := f.Reconcile(nil, decimal.Zero, false)
+	if !errors.Is(err, common.ErrNilArguments) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrNilArguments)
+	}
+	baseItem, err := CreateItem(exch, a, base, elite, decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	quoteItem, err := CreateItem(exch, a, quote, elite, decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := CreatePair(baseItem, quoteItem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = f.AddPair(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the exchange holds more than the backtester was funded with
+	balances := map[currency.Code]float64{base: 2000, quote: 5000}
+	calls := 0
+	getHoldings := func(e string, ai asset.Item) (*account.Holdings, error) {
+		calls++
+		if e != exch || ai != a {
+			t.Errorf("received '%v %v' expected '%v %v'", e, ai, exch, a)
+		}
+		h := &account.Holdings{Exchange: e, Accounts: []account.SubAccount{{AssetType: ai}}}
+		for c, v := range balances {
+			h.Accounts[0].Currencies = append(h.Accounts[0].Currencies, account.Balance{CurrencyName: c, TotalValue: v})
+		}
+		return h, nil
+	}
+	drifts, err := f.Reconcile(getHoldings, one, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(drifts) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(drifts), 0)
+	}
+	if calls != 1 {
+		t.Errorf("received '%v' expected '%v'", calls, 1)
+	}
+
+	// a buy of 10 base for 100 quote which matches the exchange
+	err = p.Reserve(decimal.NewFromInt(100), gctorder.Buy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = p.Release(decimal.NewFromInt(100), decimal.Zero, gctorder.Buy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.IncreaseAvailable(decimal.NewFromInt(10), gctorder.Buy)
+	balances[base] = 2010
+	balances[quote] = 4900
+	drifts, err = f.Reconcile(getHoldings, one, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(drifts) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(drifts), 0)
+	}
+
+	// the order was not filled on the exchange, with the quote difference
+	// remaining within tolerance
+	half := decimal.NewFromFloat(0.5)
+	balances[base] = 2000
+	balances[quote] = 4905
+	drifts, err = f.Reconcile(getHoldings, half, true)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(drifts) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(drifts), 1)
+	}
+	if drifts[0].Currency != base || !drifts[0].Difference.Equal(decimal.NewFromInt(-10)) || !drifts[0].Corrected {
+		t.Errorf("received '%+v' expected a corrected base difference of -10", drifts[0])
+	}
+	if !p.BaseAvailable().Equal(elite) {
+		t.Errorf("received '%v' expected '%v'", p.BaseAvailable(), elite)
+	}
+	// once corrected, the base matches the exchange
+	drifts, err = f.Reconcile(getHoldings, half, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(drifts) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(drifts), 0)
+	}
+	drifts, err = f.Reconcile(getHoldings, decimal.Zero, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(drifts) != 1 || drifts[0].Currency != quote || !drifts[0].Difference.Equal(decimal.NewFromInt(5)) || drifts[0].Corrected {
+		t.Errorf("received '%+v' expected an uncorrected quote difference of 5", drifts)
+	}
+
+	errTest := errors.New("test")
+	_, err = f.Reconcile(func(string, asset.Item) (*account.Holdings, error) {
+		return nil, errTest
+	}, one, false)
+	if !errors.Is(err, errTest) {
+		t.Errorf("received '%v' expected '%v'", err, errTest)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
@@ -15,6 +16,69 @@ import (
 type FundManager struct {
 	usingExchangeLevelFunding bool
 	items                     []*Item
+	// reconcileBaselines holds the exchange balance of each exchange, asset
+	// and currency before any funding changed, once reconciliation has begun
+	reconcileBaselines map[string]decimal.Decimal
+	// pendingTransfers are transfers waiting for deposit confirmation
+	// before they are available to their receiver, confirmed by the latest
+	// time passed to ConfirmTransfers
+	pendingTransfers []pendingTransfer
+	latestTime       time.Time
+	// conversions allow orders to be funded by converting other currencies
+	// on the same exchange and asset, recorded as conversion trades
+	conversions      []*conversion
+	conversionTrades []Conversion
+}
+
+// RateFunc returns the amount of the currency being converted to that one unit
+// of the currency being converted from is worth
+type RateFunc func() (decimal.Decimal, error)
+
+// conversion converts the funds of one currency into another on the same
+// exchange and asset at the rate when funds are converted, less a fee rate
+// paid in the currency converted to
+type conversion struct {
+	from *Item
+	to   *Item
+	fee  decimal.Decimal
+	rate RateFunc
+}
+
+// Conversion is a simulated trade which converted funds from one currency to
+// another to fund an order. Fee is paid in the To currency and Received is
+// after fees
+type Conversion struct {
+	Time     time.Time
+	Exchange string
+	Asset    asset.Item
+	From     currency.Code
+	To       currency.Code
+	Sold     decimal.Decimal
+	Rate     decimal.Decimal
+	Fee      decimal.Decimal
+	Received decimal.Decimal
+}
+
+// pendingTransfer is a transferred amount which is credited to its receiver
+// once confirmed
+type pendingTransfer struct {
+	receiver    *Item
+	amount      decimal.Decimal
+	confirmedAt time.Time
+}
+
+// Drift is the difference between the change in a real exchange balance and
+// the change in the backtester's funding of the currency
+type Drift struct {
+	Exchange          string
+	Asset             asset.Item
+	Currency          currency.Code
+	Funds             decimal.Decimal
+	ExpectedChange    decimal.Decimal
+	ActualChange      decimal.Decimal
+	Difference        decimal.Decimal
+	DifferencePercent decimal.Decimal
+	Corrected         bool
 }
 
 // Report holds all funding data for result reporting
@@ -23,6 +87,20 @@ type Report struct {
 	FinalTotalUSD   decimal.Decimal
 	Difference      decimal.Decimal
 	Items           []ReportItem
+	Conversions     []Conversion
+	CashEquivalents []CashEquivalentGroup
+}
+
+// CashEquivalentGroup totals the funds of an exchange and asset's items whose
+// currencies are cash equivalents of one another, e.g. USD, USDT and USDC
+type CashEquivalentGroup struct {
+	Exchange string
+	Asset    asset.Item
+	// Currency is the fiat currency the group's currencies are pegged to
+	Currency     currency.Code
+	Currencies   currency.Currencies
+	InitialFunds decimal.Decimal
+	FinalFunds   decimal.Decimal
 }
 
 // ReportItem holds reporting fields
@@ -33,11 +111,21 @@ type ReportItem struct {
 	InitialFunds    decimal.Decimal
 	InitialFundsUSD decimal.Decimal
 	TransferFee     decimal.Decimal
-	FinalFunds      decimal.Decimal
-	FinalFundsUSD   decimal.Decimal
-	Difference      decimal.Decimal
-	ShowInfinite    bool
-	PairedWith      currency.Code
+	// TransferFeesPaid is the total of network fees paid sending funds and
+	// InTransit the amount received which was not yet confirmed at the end
+	// of the run
+	TransferFeesPaid decimal.Decimal
+	InTransit        decimal.Decimal
+	// ConvertedIn and ConvertedOut are the funds received and sold by
+	// conversions, with ConversionFeesPaid the fees paid receiving funds
+	ConvertedIn        decimal.Decimal
+	ConvertedOut       decimal.Decimal
+	ConversionFeesPaid decimal.Decimal
+	FinalFunds         decimal.Decimal
+	FinalFundsUSD      decimal.Decimal
+	Difference         decimal.Decimal
+	ShowInfinite       bool
+	PairedWith         currency.Code
 }
 
 // IFundingManager limits funding usage for portfolio event handling
@@ -49,6 +137,8 @@ type IFundingManager interface {
 	GetFundingForEAP(string, asset.Item, currency.Pair) (*Pair, error)
 	Transfer(decimal.Decimal, *Item, *Item, bool) error
 	GenerateReport(startDate, endDate time.Time) *Report
+	ConfirmTransfers(time.Time)
+	Reconcile(func(string, asset.Item) (*account.Holdings, error), decimal.Decimal, bool) ([]Drift, error)
 }
 
 // IFundTransferer allows for funding amounts to be transferred
@@ -73,6 +163,7 @@ type IPairReader interface {
 // IPairReserver limits funding usage for portfolio event handling
 type IPairReserver interface {
 	IPairReader
+	ConvertibleQuote() decimal.Decimal
 	CanPlaceOrder(order.Side) bool
 	Reserve(decimal.Decimal, order.Side) error
 }
@@ -92,11 +183,22 @@ type Item struct {
 	available    decimal.Decimal
 	reserved     decimal.Decimal
 	transferFee  decimal.Decimal
-	pairedWith   *Item
+	// confirmationDelay is how long funds transferred to the item take to
+	// be confirmed and become available
+	confirmationDelay time.Duration
+	transferFeesPaid  decimal.Decimal
+	pairedWith        *Item
+	// converted funds and the fees paid receiving them
+	convertedIn        decimal.Decimal
+	convertedOut       decimal.Decimal
+	conversionFeesPaid decimal.Decimal
 }
 
 // Pair holds two currencies that are associated with each other
 type Pair struct {
 	Base  *Item
 	Quote *Item
+	// conversions which can fund the quote currency when buying
+	conversions []*conversion
+	manager     *FundManager
 }
@@ -5,10 +5,13 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/backtester/artifacts"
 	"github.com/thrasher-corp/gocryptotrader/backtester/backtest"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/rpcserver"
 	gctconfig "github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/engine"
 	gctlog "github.com/thrasher-corp/gocryptotrader/log"
@@ -16,8 +19,10 @@ import (
 )
 
 func main() {
-	var configPath, templatePath, reportOutput string
-	var printLogo, generateReport, darkReport bool
+	var configPath, templatePath, reportOutput, rpcListen string
+	var printLogo, generateReport, darkReport, rpcServer, validate bool
+	var progressInterval, maxRunAge time.Duration
+	var maxRuns int
 	wd, err := os.Getwd()
 	if err != nil {
 		fmt.Printf("Could not get working directory. Error: %v.\n", err)
@@ -39,7 +44,7 @@ func main() {
 			wd,
 			"report",
 			"tpl.gohtml"),
-		"the report template to use")
+		"the report template, or a directory of report templates and partials, to use")
 	flag.BoolVar(
 		&generateReport,
 		"generatereport",
@@ -51,7 +56,17 @@ func main() {
 		filepath.Join(
 			wd,
 			"results"),
-		"the path where to output results")
+		"the path where to output results, each run is given its own directory within it")
+	flag.IntVar(
+		&maxRuns,
+		"maxruns",
+		0,
+		"the number of the newest runs to keep in the output path, older runs are removed once a run finishes. 0 keeps every run")
+	flag.DurationVar(
+		&maxRunAge,
+		"maxrunage",
+		0,
+		"removes runs in the output path started longer ago than the duration once a run finishes. 0 keeps every run")
 	flag.BoolVar(
 		&printLogo,
 		"printlogo",
@@ -62,9 +77,40 @@ func main() {
 		"darkreport",
 		false,
 		"sets the initial rerport to use a dark theme")
+	flag.BoolVar(
+		&rpcServer,
+		"rpcserver",
+		false,
+		"runs the backtester as a gRPC server which accepts strategy runs until interrupted, the configpath is ignored")
+	flag.BoolVar(
+		&validate,
+		"validate",
+		false,
+		"validates the config, its strategy and the availability of its data then exits with a report, without executing the run")
+	flag.DurationVar(
+		&progressInterval,
+		"progressinterval",
+		backtest.DefaultProgressInterval,
+		"how often the progress, speed and estimated time remaining of a run is logged, 0 disables progress logging")
+	flag.StringVar(
+		&rpcListen,
+		"rpclisten",
+		"localhost:9054",
+		"the address the gRPC server listens on")
 	flag.Parse()
 
-	var bt *backtest.BackTest
+	if rpcServer {
+		if printLogo {
+			fmt.Print(common.ASCIILogo)
+		}
+		err = runServer(templatePath, reportOutput, rpcListen)
+		if err != nil {
+			fmt.Printf("Could not run backtester server. Error: %v.\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	var cfg *config.Config
 	fmt.Println("reading config...")
 	cfg, err = config.ReadConfigFromFile(configPath)
@@ -82,57 +128,123 @@ func main() {
 	}
 
 	var bot *engine.Engine
-	flags := map[string]bool{
-		"tickersync":    false,
-		"orderbooksync": false,
-		"tradesync":     false,
-		"ratelimiter":   true,
-		"ordermanager":  false,
-	}
-	bot, err = engine.NewFromSettings(&engine.Settings{
-		ConfigFile:                    path,
-		EnableDryRun:                  true,
-		EnableAllPairs:                true,
-		EnableExchangeHTTPRateLimiter: true,
-	}, flags)
+	bot, err = newBot(path)
 	if err != nil {
 		fmt.Printf("Could not load backtester. Error: %v.\n", err)
 		os.Exit(-1)
 	}
 
+	if validate {
+		var resp *backtest.ValidationReport
+		resp, err = backtest.ValidateConfig(cfg, bot)
+		if err != nil {
+			fmt.Printf("Could not validate config. Error: %v.\n", err)
+			os.Exit(1)
+		}
+		fmt.Print(resp)
+		if !resp.Passed() {
+			os.Exit(1)
+		}
+		return
+	}
+
 	err = cfg.Validate()
 	if err != nil {
 		fmt.Printf("Could not read config. Error: %v.\n", err)
 		os.Exit(1)
 	}
-	bt, err = backtest.NewFromConfig(cfg, templatePath, reportOutput, bot)
+
+	var run *artifacts.Run
+	run, err = createRun(cfg, reportOutput)
+	if err != nil {
+		fmt.Printf("Could not create run directory. Error: %v.\n", err)
+		os.Exit(1)
+	}
+	err = executeRun(cfg, templatePath, run, bot, progressInterval, generateReport, darkReport)
+	if finishErr := run.Finish(err); finishErr != nil {
+		gctlog.Errorf(gctlog.BackTester, "could not finish run %v. Error: %v", run.ID, finishErr)
+	}
+	gctlog.Infof(gctlog.BackTester, "run %v artifacts saved to %v", run.ID, run.Path)
+	removed, cleanupErr := artifacts.Cleanup(reportOutput, artifacts.Retention{
+		MaxRuns: maxRuns,
+		MaxAge:  maxRunAge,
+	})
+	if cleanupErr != nil {
+		gctlog.Errorf(gctlog.BackTester, "could not clean up previous runs. Error: %v", cleanupErr)
+	}
+	if len(removed) > 0 {
+		gctlog.Infof(gctlog.BackTester, "removed %v previous runs outside of the retention policy", len(removed))
+	}
 	if err != nil {
-		fmt.Printf("Could not setup backtester from config. Error: %v.\n", err)
+		fmt.Printf("%v.\n", err)
 		os.Exit(1)
 	}
+}
+
+// createRun makes a uniquely identified directory for the run within the
+// output path holding a copy of the config and the run's logs
+func createRun(cfg *config.Config, outputPath string) (*artifacts.Run, error) {
+	id, err := artifacts.NewID()
+	if err != nil {
+		return nil, err
+	}
+	run, err := artifacts.Create(outputPath, id)
+	if err != nil {
+		return nil, err
+	}
+	err = run.SaveConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return run, run.StartLog()
+}
+
+// executeRun runs the config, calculates its results and generates its report
+// in the run's directory
+func executeRun(cfg *config.Config, templatePath string, run *artifacts.Run, bot *engine.Engine, progressInterval time.Duration, generateReport, darkReport bool) error {
+	bt, err := backtest.NewFromConfig(cfg, templatePath, run.Path, bot)
+	if err != nil {
+		return fmt.Errorf("could not setup backtester from config. Error: %w", err)
+	}
+	bt.SetRunID(run.ID)
+	bt.SetProgressInterval(progressInterval)
 	if cfg.DataSettings.LiveData != nil {
+		liveErr := make(chan error, 1)
+		go func() {
+			liveErr <- bt.RunLive()
+		}()
+		interrupt := make(chan os.Signal, 1)
 		go func() {
-			err = bt.RunLive()
+			interrupt <- signaler.WaitForInterrupt()
+		}()
+		select {
+		case err = <-liveErr:
 			if err != nil {
-				fmt.Printf("Could not complete live run. Error: %v.\n", err)
-				os.Exit(-1)
+				return fmt.Errorf("could not complete live run. Error: %w", err)
 			}
-		}()
-		interrupt := signaler.WaitForInterrupt()
-		gctlog.Infof(gctlog.Global, "Captured %v, shutdown requested.\n", interrupt)
-		bt.Stop()
+		case sig := <-interrupt:
+			gctlog.Infof(gctlog.Global, "Captured %v, shutdown requested.\n", sig)
+			bt.Stop()
+		}
 	} else {
+		if cfg.DataSettings.Replay != nil {
+			// replays can run in real time, allow them to be stopped early
+			// while still calculating results for the replayed events
+			go func() {
+				interrupt := signaler.WaitForInterrupt()
+				gctlog.Infof(gctlog.Global, "Captured %v, shutdown requested.\n", interrupt)
+				bt.Stop()
+			}()
+		}
 		err = bt.Run()
 		if err != nil {
-			fmt.Printf("Could not complete run. Error: %v.\n", err)
-			os.Exit(1)
+			return fmt.Errorf("could not complete run. Error: %w", err)
 		}
 	}
 
 	err = bt.Statistic.CalculateAllResults(bt.Funding)
 	if err != nil {
-		gctlog.Error(gctlog.BackTester, err)
-		os.Exit(1)
+		return err
 	}
 
 	if generateReport {
@@ -142,4 +254,49 @@ func main() {
 			gctlog.Error(gctlog.BackTester, err)
 		}
 	}
+	return nil
+}
+
+// newBot loads the GoCryptoTrader engine used by the backtester to source data
+// and exchange settings
+func newBot(configPath string) (*engine.Engine, error) {
+	flags := map[string]bool{
+		"tickersync":    false,
+		"orderbooksync": false,
+		"tradesync":     false,
+		"ratelimiter":   true,
+		"ordermanager":  false,
+	}
+	return engine.NewFromSettings(&engine.Settings{
+		ConfigFile:                    configPath,
+		EnableDryRun:                  true,
+		EnableAllPairs:                true,
+		EnableExchangeHTTPRateLimiter: true,
+	}, flags)
+}
+
+// runServer serves strategy runs over gRPC until an interrupt is received
+func runServer(templatePath, reportOutput, listenAddress string) error {
+	bot, err := newBot(gctconfig.DefaultFilePath())
+	if err != nil {
+		return err
+	}
+	server, err := rpcserver.NewServer(bot, templatePath, reportOutput)
+	if err != nil {
+		return err
+	}
+	err = server.Start()
+	if err != nil {
+		return err
+	}
+	go func() {
+		serveErr := server.ListenAndServe(listenAddress)
+		if serveErr != nil {
+			gctlog.Errorf(gctlog.BackTester, "backtester gRPC server stopped. Error: %v", serveErr)
+			os.Exit(1)
+		}
+	}()
+	interrupt := signaler.WaitForInterrupt()
+	gctlog.Infof(gctlog.Global, "Captured %v, shutdown requested.\n", interrupt)
+	return server.Stop()
 }
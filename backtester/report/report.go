@@ -1,15 +1,22 @@
 package report
 
 import (
+	"encoding/json"
 	"fmt"
 	"html/template"
 	"os"
 	"path/filepath"
+	"reflect"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/compliance"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics/currencystatistics"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/log"
@@ -37,18 +44,20 @@ func (d *Data) GenerateReport() error {
 			})
 		}
 	}
+	candlesPerPage := d.candlesPerChartPage()
 	for i := range d.EnhancedCandles {
-		if len(d.EnhancedCandles[i].Candles) >= maxChartLimit {
-			d.EnhancedCandles[i].IsOverLimit = true
-			d.EnhancedCandles[i].Candles = d.EnhancedCandles[i].Candles[:maxChartLimit]
-		}
+		d.EnhancedCandles[i].paginate(candlesPerPage)
 	}
 
-	tmpl := template.Must(
-		template.ParseFiles(
-			filepath.Join(d.TemplatePath),
-		),
-	)
+	err = d.resolveConfig()
+	if err != nil {
+		return err
+	}
+
+	tmpl, err := loadTemplate(d.TemplatePath, TemplateName)
+	if err != nil {
+		return err
+	}
 	var nickName string
 	if d.Config.Nickname != "" {
 		nickName = d.Config.Nickname + "-"
@@ -78,16 +87,97 @@ func (d *Data) GenerateReport() error {
 	if err != nil {
 		return err
 	}
+	d.reportFileName = fileName
 	log.Infof(log.BackTester, "successfully saved report to %v\\%v", d.OutputPath, fileName)
 	return nil
 }
 
+// Summary returns the key metrics of the run for the multi-run index page.
+// The report path is the generated report's file name, if one was generated
+func (d *Data) Summary() RunSummary {
+	resp := RunSummary{
+		ReportPath: d.reportFileName,
+	}
+	if d.Config != nil {
+		resp.Nickname = d.Config.Nickname
+	}
+	if d.Statistics == nil {
+		return resp
+	}
+	resp.StrategyName = d.Statistics.StrategyName
+	resp.TotalOrders = d.Statistics.TotalOrders
+	resp.Pairs = len(d.Statistics.AllStats)
+	resp.HasResults = resp.Pairs > 0
+	if d.Statistics.BestStrategyResults != nil {
+		resp.BestStrategyPair = fmt.Sprintf("%v %v %v",
+			d.Statistics.BestStrategyResults.Exchange,
+			d.Statistics.BestStrategyResults.Asset,
+			d.Statistics.BestStrategyResults.Pair)
+		resp.BestStrategyMovement = d.Statistics.BestStrategyResults.StrategyMovement
+	}
+	if d.Statistics.BiggestDrawdown != nil {
+		resp.BiggestDrawdown = d.Statistics.BiggestDrawdown.MaxDrawdown.DrawdownPercent
+	}
+	if resp.Pairs > 0 {
+		total := decimal.Zero
+		for i := range d.Statistics.AllStats {
+			total = total.Add(d.Statistics.AllStats[i].ArithmeticRatios.SharpeRatio)
+		}
+		resp.AverageSharpeRatio = total.Div(decimal.NewFromInt(int64(resp.Pairs)))
+	}
+	if d.Statistics.Funding != nil && !d.Statistics.Funding.InitialTotalUSD.IsZero() {
+		resp.HasFundingDifference = true
+		resp.FundingDifference = d.Statistics.Funding.Difference
+	}
+	return resp
+}
+
+// GenerateIndex creates an index page in the output path listing every run
+// with its key metrics, sortable by column and linking to each run's report
+func GenerateIndex(templatePath, outputPath string, runs []RunSummary, useDarkTheme bool) error {
+	if len(runs) == 0 {
+		return errNoRuns
+	}
+	tmpl, err := loadTemplate(templatePath, IndexTemplateName)
+	if err != nil {
+		return err
+	}
+	// write to a temporary file first so the index is never left half written
+	// while it is being viewed
+	path := filepath.Join(outputPath, IndexFileName)
+	f, err := os.Create(path + ".tmp")
+	if err != nil {
+		return err
+	}
+	err = tmpl.Execute(f, Index{
+		Runs:         runs,
+		UseDarkTheme: useDarkTheme,
+		Generated:    time.Now(),
+	})
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
+
 // AddKlineItem appends a SET of candles for the report to enhance upon
 // generation
 func (d *Data) AddKlineItem(k *kline.Item) {
 	d.OriginalCandles = append(d.OriginalCandles, k)
 }
 
+// AddSettingsChange appends a change the strategy made to its custom settings
+// mid-run to the report's settings timeline
+func (d *Data) AddSettingsChange(t *compliance.Transition) {
+	if t == nil {
+		return
+	}
+	d.SettingsTimeline = append(d.SettingsTimeline, *t)
+}
+
 // UpdateItem updates an existing kline item for LIVE data usage
 func (d *Data) UpdateItem(k *kline.Item) {
 	if len(d.OriginalCandles) == 0 {
@@ -125,6 +215,18 @@ func (d *Data) enhanceCandles() error {
 			continue
 		}
 
+		indicatorsByTime := make(map[int64][]signal.Indicator)
+		for k := range statsForCandles.Events {
+			if statsForCandles.Events[k].SignalEvent == nil {
+				continue
+			}
+			indicators := statsForCandles.Events[k].SignalEvent.GetIndicators()
+			if len(indicators) == 0 {
+				continue
+			}
+			indicatorsByTime[statsForCandles.Events[k].SignalEvent.GetTime().Unix()] = indicators
+		}
+
 		requiresIteration := false
 		if len(statsForCandles.Events) != len(d.OriginalCandles[intVal].Candles) {
 			requiresIteration = true
@@ -183,6 +285,7 @@ func (d *Data) enhanceCandles() error {
 				enhancedCandle.Text = enhancedCandle.OrderDirection.String()
 				break
 			}
+			enhancedKline.addIndicators(indicatorsByTime[d.OriginalCandles[intVal].Candles[j].Time.Unix()], enhancedCandle.Time)
 			enhancedKline.Candles = append(enhancedKline.Candles, enhancedCandle)
 		}
 		d.EnhancedCandles = append(d.EnhancedCandles, enhancedKline)
@@ -191,6 +294,82 @@ func (d *Data) enhanceCandles() error {
 	return nil
 }
 
+// addIndicators appends the indicator values a strategy published for a candle
+// to their series, starting a new series for any indicator not yet seen
+func (d *DetailedKline) addIndicators(indicators []signal.Indicator, candleTime int64) {
+	for i := range indicators {
+		series := -1
+		for j := range d.Indicators {
+			if d.Indicators[j].Name == indicators[i].Name &&
+				d.Indicators[j].Overlay == indicators[i].Overlay {
+				series = j
+				break
+			}
+		}
+		if series == -1 {
+			d.Indicators = append(d.Indicators, IndicatorSeries{
+				Name:    indicators[i].Name,
+				Overlay: indicators[i].Overlay,
+				Colour:  indicatorColours[len(d.Indicators)%len(indicatorColours)],
+			})
+			series = len(d.Indicators) - 1
+			if !indicators[i].Overlay {
+				d.HasPanelIndicators = true
+			}
+		}
+		d.Indicators[series].Points = append(d.Indicators[series].Points, IndicatorPoint{
+			Time:  candleTime,
+			Value: indicators[i].Value,
+		})
+	}
+}
+
+// candlesPerChartPage returns the number of candles rendered per chart page,
+// as configured in the report settings, without exceeding what a chart can
+// render
+func (d *Data) candlesPerChartPage() int {
+	if d.Config == nil ||
+		d.Config.ReportSettings == nil ||
+		d.Config.ReportSettings.MaxChartCandles <= 0 ||
+		d.Config.ReportSettings.MaxChartCandles > maxChartLimit {
+		return maxChartLimit
+	}
+	return d.Config.ReportSettings.MaxChartCandles
+}
+
+// paginate splits the kline's candles into pages of at most candlesPerPage
+// candles, each holding the indicator values published during its candles
+func (d *DetailedKline) paginate(candlesPerPage int) {
+	d.Pages = nil
+	if candlesPerPage <= 0 {
+		candlesPerPage = maxChartLimit
+	}
+	for start := 0; start < len(d.Candles); start += candlesPerPage {
+		end := start + candlesPerPage
+		if end > len(d.Candles) {
+			end = len(d.Candles)
+		}
+		page := ChartPage{Candles: d.Candles[start:end]}
+		first, last := page.Candles[0].Time, page.Candles[len(page.Candles)-1].Time
+		for i := range d.Indicators {
+			series := IndicatorSeries{
+				Name:    d.Indicators[i].Name,
+				Overlay: d.Indicators[i].Overlay,
+				Colour:  d.Indicators[i].Colour,
+			}
+			for j := range d.Indicators[i].Points {
+				if d.Indicators[i].Points[j].Time >= first && d.Indicators[i].Points[j].Time <= last {
+					series.Points = append(series.Points, d.Indicators[i].Points[j])
+				}
+			}
+			if len(series.Points) > 0 {
+				page.Indicators = append(page.Indicators, series)
+			}
+		}
+		d.Pages = append(d.Pages, page)
+	}
+}
+
 func (d *DetailedCandle) copyCloseFromPreviousEvent(enhancedKline *DetailedKline) {
 	// if the data is missing, ensure that all values just continue the previous candle's close price visually
 	d.Open = enhancedKline.Candles[len(enhancedKline.Candles)-1].Close
@@ -209,3 +388,392 @@ func (d *DetailedCandle) copyCloseFromPreviousEvent(enhancedKline *DetailedKline
 func (d *Data) UseDarkMode(use bool) {
 	d.UseDarkTheme = use
 }
+
+// ReturnColour returns the heatmap background colour of a calendar return.
+// Gains are green and losses are red, becoming stronger as the return grows
+// towards 20%
+func (d *Data) ReturnColour(r *decimal.Decimal) template.CSS {
+	if r == nil || r.IsZero() {
+		return ""
+	}
+	strength := r.Abs().Div(decimal.NewFromInt(20))
+	if strength.GreaterThan(decimal.NewFromInt(1)) {
+		strength = decimal.NewFromInt(1)
+	}
+	alpha := decimal.NewFromFloat(0.15).Add(strength.Mul(decimal.NewFromFloat(0.7))).Round(2)
+	if r.IsNegative() {
+		return template.CSS(fmt.Sprintf("background-color: rgba(232, 3, 3, %v)", alpha))
+	}
+	return template.CSS(fmt.Sprintf("background-color: rgba(50, 204, 30, %v)", alpha))
+}
+
+// ContributionLegend returns the colour used for each pair and for fees in
+// the contribution bars of a valuation currency
+func (d *Data) ContributionLegend(c statistics.ContributionTotal) []ContributionLegend {
+	resp := make([]ContributionLegend, 0, len(c.Pairs)+1)
+	for i := range c.Pairs {
+		resp = append(resp, ContributionLegend{
+			Name:   fmt.Sprintf("%v %v %v", c.Pairs[i].Exchange, c.Pairs[i].Asset, c.Pairs[i].Pair),
+			Colour: template.CSS("background-color: " + indicatorColours[i%len(indicatorColours)]),
+		})
+	}
+	return append(resp, ContributionLegend{
+		Name:   "Fees",
+		Colour: template.CSS("background-color: " + feesColour),
+	})
+}
+
+// ContributionBars returns the stacked bars of a valuation currency's
+// contributions for the whole run followed by each month
+func (d *Data) ContributionBars(c statistics.ContributionTotal) []ContributionBar {
+	periods := append([]statistics.ContributionPeriod{c.Total}, c.Months...)
+	// scale is the largest total of gains or of losses and fees of any
+	// period, which fills half of the bar
+	var scale decimal.Decimal
+	for i := range periods {
+		var gains, losses decimal.Decimal
+		for j := range periods[i].Contributions {
+			if periods[i].Contributions[j].IsPositive() {
+				gains = gains.Add(periods[i].Contributions[j])
+			} else {
+				losses = losses.Sub(periods[i].Contributions[j])
+			}
+		}
+		losses = losses.Add(periods[i].FeesPercent)
+		scale = decimal.Max(scale, gains, losses)
+	}
+	fifty := decimal.NewFromInt(50)
+	resp := make([]ContributionBar, len(periods))
+	for i := range periods {
+		resp[i] = ContributionBar{
+			Label:         periods[i].Start.Format("2006-01"),
+			ReturnPercent: periods[i].ReturnPercent,
+			ReturnColour:  d.ReturnColour(&periods[i].ReturnPercent),
+		}
+		if i == 0 {
+			resp[i].Label = "Total"
+		}
+		if !scale.IsPositive() {
+			continue
+		}
+		var gains, losses decimal.Decimal
+		add := func(name, colour string, value decimal.Decimal) {
+			if value.IsZero() {
+				return
+			}
+			width := value.Abs().Div(scale).Mul(fifty)
+			var left decimal.Decimal
+			if value.IsPositive() {
+				left = fifty.Add(gains)
+				gains = gains.Add(width)
+			} else {
+				losses = losses.Add(width)
+				left = fifty.Sub(losses)
+			}
+			resp[i].Segments = append(resp[i].Segments, ContributionSegment{
+				Name:    name,
+				Percent: value,
+				Style: template.CSS(fmt.Sprintf("left: %v%%; width: %v%%; background-color: %v",
+					left.Round(4), width.Round(4), colour)),
+			})
+		}
+		for j := range periods[i].Contributions {
+			if j >= len(c.Pairs) {
+				break
+			}
+			add(c.Pairs[j].Pair.String(), indicatorColours[j%len(indicatorColours)], periods[i].Contributions[j])
+		}
+		add("Fees", feesColour, periods[i].FeesPercent.Neg())
+	}
+	return resp
+}
+
+// ReturnHistogram returns the bars of a return distribution's histogram,
+// scaled against the largest count or normal count of any bucket. Buckets of
+// losses are red and the rest green
+func (d *Data) ReturnHistogram(r *currencystatistics.ReturnDistribution) []HistogramBar {
+	if r == nil {
+		return nil
+	}
+	var scale decimal.Decimal
+	for i := range r.Buckets {
+		scale = decimal.Max(scale, decimal.NewFromInt(r.Buckets[i].Count), r.Buckets[i].NormalCount)
+	}
+	oneHundred := decimal.NewFromInt(100)
+	resp := make([]HistogramBar, len(r.Buckets))
+	for i := range r.Buckets {
+		resp[i] = HistogramBar{
+			Lower:       r.Buckets[i].Lower,
+			Upper:       r.Buckets[i].Upper,
+			Count:       r.Buckets[i].Count,
+			NormalCount: r.Buckets[i].NormalCount,
+		}
+		if !scale.IsPositive() {
+			continue
+		}
+		colour := "rgba(50, 204, 30, 0.7)"
+		if r.Buckets[i].Upper.Add(r.Buckets[i].Lower).IsNegative() {
+			colour = "rgba(232, 3, 3, 0.7)"
+		}
+		resp[i].CountStyle = template.CSS(fmt.Sprintf("height: %v%%; background-color: %v",
+			decimal.NewFromInt(r.Buckets[i].Count).Div(scale).Mul(oneHundred).Round(4), colour))
+		resp[i].NormalStyle = template.CSS(fmt.Sprintf("bottom: %v%%",
+			r.Buckets[i].NormalCount.Div(scale).Mul(oneHundred).Round(4)))
+	}
+	return resp
+}
+
+// QuantilePlot returns the points of a return distribution's QQ plot, with
+// both axes spanning the lowest to highest of the normal and observed returns
+// so that normally distributed returns lie on the plot's diagonal
+func (d *Data) QuantilePlot(r *currencystatistics.ReturnDistribution) []QuantilePlotPoint {
+	if r == nil || len(r.QuantilePoints) == 0 {
+		return nil
+	}
+	lowest, highest := r.QuantilePoints[0].Observed, r.QuantilePoints[0].Observed
+	for i := range r.QuantilePoints {
+		lowest = decimal.Min(lowest, r.QuantilePoints[i].Normal, r.QuantilePoints[i].Observed)
+		highest = decimal.Max(highest, r.QuantilePoints[i].Normal, r.QuantilePoints[i].Observed)
+	}
+	oneHundred := decimal.NewFromInt(100)
+	span := highest.Sub(lowest)
+	resp := make([]QuantilePlotPoint, len(r.QuantilePoints))
+	for i := range r.QuantilePoints {
+		resp[i] = QuantilePlotPoint{
+			Probability: r.QuantilePoints[i].Probability,
+			Normal:      r.QuantilePoints[i].Normal,
+			Observed:    r.QuantilePoints[i].Observed,
+			X:           decimal.NewFromInt(50),
+			Y:           decimal.NewFromInt(50),
+		}
+		if span.IsPositive() {
+			resp[i].X = r.QuantilePoints[i].Normal.Sub(lowest).Div(span).Mul(oneHundred).Round(4)
+			resp[i].Y = oneHundred.Sub(r.QuantilePoints[i].Observed.Sub(lowest).Div(span).Mul(oneHundred)).Round(4)
+		}
+	}
+	return resp
+}
+
+// IndexTemplatePath returns the index template used alongside a report
+// template. A template directory is returned as is, as it holds its own index
+// template
+func IndexTemplatePath(templatePath string) string {
+	if info, err := os.Stat(templatePath); err == nil && info.IsDir() {
+		return templatePath
+	}
+	return filepath.Join(filepath.Dir(templatePath), IndexTemplateName)
+}
+
+// loadTemplate parses a template file with the report helper functions. When
+// the path is a directory, every .gohtml file within it is parsed together so
+// templates can share partials and override blocks, and the named template is
+// returned
+func loadTemplate(path, name string) (*template.Template, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return template.New(filepath.Base(path)).Funcs(templateFuncs).ParseFiles(path)
+	}
+	tmpl, err := template.New("").Funcs(templateFuncs).ParseGlob(filepath.Join(path, "*"+templateExtension))
+	if err != nil {
+		return nil, err
+	}
+	resp := tmpl.Lookup(name)
+	if resp == nil {
+		return nil, fmt.Errorf("%w %v in %v", errNoTemplate, name, path)
+	}
+	return resp, nil
+}
+
+// templateFuncs are the helper functions available to report templates
+var templateFuncs = template.FuncMap{
+	"formatNumber":  formatNumber,
+	"formatPercent": formatPercent,
+	"formatTime":    formatTime,
+}
+
+// formatNumber rounds a number to the decimal places and separates its
+// thousands with commas, eg 1234567.891 to 2 places is 1,234,567.89. Nil
+// values are formatted as an empty string
+func formatNumber(value interface{}, places int32) (string, error) {
+	var d decimal.Decimal
+	switch v := value.(type) {
+	case decimal.Decimal:
+		d = v
+	case *decimal.Decimal:
+		if v == nil {
+			return "", nil
+		}
+		d = *v
+	case float64:
+		d = decimal.NewFromFloat(v)
+	case float32:
+		d = decimal.NewFromFloat32(v)
+	case int:
+		d = decimal.NewFromInt(int64(v))
+	case int64:
+		d = decimal.NewFromInt(v)
+	case int32:
+		d = decimal.NewFromInt32(v)
+	case nil:
+		return "", nil
+	default:
+		return "", fmt.Errorf("%w: %v", errNotANumber, value)
+	}
+	if places < 0 {
+		places = 0
+	}
+	s := d.Abs().StringFixed(places)
+	whole, fraction := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		whole, fraction = s[:i], s[i:]
+	}
+	var sb strings.Builder
+	if d.Round(places).IsNegative() {
+		sb.WriteByte('-')
+	}
+	for i := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteByte(whole[i])
+	}
+	sb.WriteString(fraction)
+	return sb.String(), nil
+}
+
+// formatPercent formats a number already expressed as a percentage, eg 12.5
+// to 1 place is 12.5%
+func formatPercent(value interface{}, places int32) (string, error) {
+	resp, err := formatNumber(value, places)
+	if err != nil || resp == "" {
+		return resp, err
+	}
+	return resp + "%", nil
+}
+
+// formatTime formats a time using a Go time layout, zero times are formatted
+// as an empty string
+func formatTime(t time.Time, layout string) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(layout)
+}
+
+// resolveConfig renders the run's config, with the custom settings the
+// strategy used, so that the report alone is enough to reproduce the run. The
+// settings which differ from their defaults are also listed
+func (d *Data) resolveConfig() error {
+	if d.Config == nil {
+		return nil
+	}
+	cfg := *d.Config
+	if d.StrategySettings != nil {
+		cfg.StrategySettings.CustomSettings = d.StrategySettings
+	}
+	resolved, err := json.MarshalIndent(cfg, "", "\t")
+	if err != nil {
+		return err
+	}
+	d.ResolvedConfig = string(resolved)
+	cfg.StrategySettings.CustomSettings = nil
+	d.ConfigDifferences = nil
+	d.addConfigDifferences(reflect.ValueOf(cfg), "")
+	d.addStrategyDifferences(d.Config.StrategySettings.CustomSettings)
+	return nil
+}
+
+// addConfigDifferences walks a config value, recording every setting which is
+// not its zero value using the settings' json names
+func (d *Data) addConfigDifferences(v reflect.Value, path string) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			d.addConfigDifferences(v.Elem(), path)
+		}
+	case reflect.Struct:
+		switch val := v.Interface().(type) {
+		case decimal.Decimal:
+			if !val.IsZero() {
+				d.ConfigDifferences = append(d.ConfigDifferences, ConfigDifference{Setting: path, Default: "0", Value: val.String()})
+			}
+			return
+		case time.Time:
+			if !val.IsZero() {
+				d.ConfigDifferences = append(d.ConfigDifferences, ConfigDifference{Setting: path, Value: val.String()})
+			}
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if field.PkgPath != "" {
+				continue
+			}
+			name := strings.Split(field.Tag.Get("json"), ",")[0]
+			if name == "-" {
+				continue
+			}
+			if name == "" {
+				name = field.Name
+			}
+			if path != "" {
+				name = path + "." + name
+			}
+			d.addConfigDifferences(v.Field(i), name)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			d.addConfigDifferences(v.Index(i), fmt.Sprintf("%v[%v]", path, i))
+		}
+	case reflect.Map:
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j])
+		})
+		for i := range keys {
+			d.addConfigDifferences(v.MapIndex(keys[i]), fmt.Sprintf("%v.%v", path, keys[i]))
+		}
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer, reflect.Invalid:
+	default:
+		if !v.IsZero() {
+			d.ConfigDifferences = append(d.ConfigDifferences, ConfigDifference{
+				Setting: path,
+				Default: fmt.Sprint(reflect.Zero(v.Type())),
+				Value:   fmt.Sprint(v),
+			})
+		}
+	}
+}
+
+// addStrategyDifferences records the strategy custom settings which differ
+// from the defaults of the strategy, falling back to the config's custom
+// settings when the strategy cannot report its own
+func (d *Data) addStrategyDifferences(configured map[string]interface{}) {
+	settings := d.StrategySettings
+	if settings == nil {
+		settings = configured
+	}
+	keys := make([]string, 0, len(settings))
+	for k := range settings {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for i := range keys {
+		value := fmt.Sprint(settings[keys[i]])
+		def, ok := d.StrategyDefaults[keys[i]]
+		if ok && fmt.Sprint(def) == value {
+			continue
+		}
+		difference := ConfigDifference{
+			Setting: "strategy-settings.custom-settings." + keys[i],
+			Value:   value,
+		}
+		if ok {
+			difference.Default = fmt.Sprint(def)
+		}
+		d.ConfigDifferences = append(d.ConfigDifferences, difference)
+	}
+}
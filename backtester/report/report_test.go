@@ -2,9 +2,11 @@ package report
 
 import (
 	"errors"
+	"html/template"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -12,8 +14,11 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/config"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/compliance"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/risk"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics/currencystatistics"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
@@ -24,6 +29,10 @@ import (
 const testExchange = "binance"
 
 func TestGenerateReport(t *testing.T) {
+	gain, loss := decimal.NewFromInt(5), decimal.NewFromInt(-30)
+	calendarReturns := currencystatistics.YearlyReturns{Year: 2021, Annual: &gain}
+	calendarReturns.Months[0] = &gain
+	calendarReturns.Months[1] = &loss
 	t.Parallel()
 	e := testExchange
 	a := asset.Spot
@@ -64,6 +73,20 @@ func TestGenerateReport(t *testing.T) {
 				Pair:      p,
 				Interval:  gctkline.OneHour,
 				Watermark: "Binance - SPOT - BTC-USDT",
+				Indicators: []IndicatorSeries{
+					{
+						Name:    "SMA",
+						Overlay: true,
+						Colour:  indicatorColours[0],
+						Points:  []IndicatorPoint{{Time: time.Now().Add(-time.Hour * 5).Unix(), Value: decimal.NewFromInt(1337)}},
+					},
+					{
+						Name:   "RSI",
+						Colour: indicatorColours[1],
+						Points: []IndicatorPoint{{Time: time.Now().Add(-time.Hour * 5).Unix(), Value: decimal.NewFromInt(30)}},
+					},
+				},
+				HasPanelIndicators: true,
 				Candles: []DetailedCandle{
 					{
 						Time:           time.Now().Add(-time.Hour * 5).Unix(),
@@ -249,10 +272,57 @@ func TestGenerateReport(t *testing.T) {
 							SellOrders:               1,
 							FinalHoldings:            holdings.Holding{},
 							FinalOrders:              compliance.Snapshot{},
+							CalendarReturns:          []currencystatistics.YearlyReturns{calendarReturns},
+							DrawdownEpisodes: []currencystatistics.DrawdownEpisode{
+								{
+									Peak:              currencystatistics.Iteration{Time: time.Now(), Price: decimal.NewFromInt(1337)},
+									Trough:            currencystatistics.Iteration{Time: time.Now(), Price: decimal.NewFromInt(1000)},
+									DrawdownPercent:   decimal.NewFromFloat(-25.2),
+									DurationIntervals: 2,
+								},
+							},
+							RoundTrips: []currencystatistics.RoundTrip{
+								{
+									EntryTime:                  time.Now(),
+									ExitTime:                   time.Now(),
+									EntryPrice:                 decimal.NewFromInt(1000),
+									ExitPrice:                  decimal.NewFromInt(1100),
+									ReturnPercent:              decimal.NewFromInt(10),
+									MaximumAdverseExcursion:    decimal.NewFromInt(3),
+									MaximumFavourableExcursion: decimal.NewFromInt(12),
+								},
+							},
+							StressTests: []risk.ShockResult{
+								{Name: "crash", Time: time.Now(), TotalValue: decimal.NewFromInt(1337), Loss: decimal.NewFromInt(267), LossPercent: decimal.NewFromInt(20)},
+							},
+							PeriodicStressTests: []risk.ShockResult{
+								{Name: "crash", Time: time.Now(), TotalValue: decimal.NewFromInt(1500), Loss: decimal.NewFromInt(300), LossPercent: decimal.NewFromInt(20)},
+							},
+							ReturnDistribution: &currencystatistics.ReturnDistribution{
+								Candles:        3,
+								Mean:           decimal.NewFromInt(1),
+								Skewness:       decimal.NewFromFloat(-0.5),
+								ExcessKurtosis: decimal.NewFromInt(2),
+								Buckets:        []currencystatistics.ReturnBucket{{Lower: decimal.NewFromInt(-1), Upper: decimal.NewFromInt(3), Count: 3, NormalCount: decimal.NewFromInt(3)}},
+								QuantilePoints: []currencystatistics.QuantilePoint{{Probability: decimal.NewFromFloat(0.5), Normal: decimal.NewFromInt(1), Observed: decimal.NewFromInt(1)}},
+							},
+							RegimeStatistics: []currencystatistics.RegimeStatistic{
+								{Regime: "trending", Candles: 10, CandlesPercent: decimal.NewFromInt(50), Periods: 2, StrategyReturn: decimal.NewFromInt(5), MarketReturn: decimal.NewFromInt(8), Orders: 2},
+							},
+							AdverseExcursions:    &currencystatistics.ExcursionDistribution{Maximum: decimal.NewFromInt(3)},
+							FavourableExcursions: &currencystatistics.ExcursionDistribution{Maximum: decimal.NewFromInt(12)},
 						},
 					},
 				},
 			},
+			ContributionsByCurrency: []statistics.ContributionTotal{
+				{
+					Currency: currency.USDT,
+					Pairs:    []statistics.PairContribution{{Exchange: e, Asset: a, Pair: p, Profit: decimal.NewFromInt(8), Fees: decimal.NewFromInt(2)}},
+					Total:    statistics.ContributionPeriod{ReturnPercent: decimal.NewFromInt(8), FeesPercent: decimal.NewFromInt(2), Contributions: []decimal.Decimal{decimal.NewFromInt(10)}},
+					Months:   []statistics.ContributionPeriod{{Start: time.Now(), ReturnPercent: decimal.NewFromInt(8), FeesPercent: decimal.NewFromInt(2), Contributions: []decimal.Decimal{decimal.NewFromInt(10)}}},
+				},
+			},
 			RiskFreeRate:    decimal.NewFromFloat(0.03),
 			TotalBuyOrders:  1337,
 			TotalSellOrders: 1330,
@@ -314,9 +384,44 @@ func TestGenerateReport(t *testing.T) {
 		},
 	}
 	d.OutputPath = tempDir
+	d.AddSettingsChange(&compliance.Transition{
+		Stage:    compliance.StageSettingsChanged,
+		Time:     time.Now(),
+		Exchange: e,
+		Asset:    a,
+		Pair:     p,
+		Reason:   "volatility increased",
+		Settings: []compliance.SettingChange{{Setting: "rsi-high", Previous: 70.0, Value: 80.0}},
+	})
 	err = d.GenerateReport()
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(tempDir, d.reportFileName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), "volatility increased") {
+		t.Error("expected settings timeline in report")
+	}
+	if !strings.Contains(string(data), "-indicators") {
+		t.Error("expected indicator panel in report")
+	}
+	if !strings.Contains(string(data), "USDT Return Contributions") {
+		t.Error("expected return contributions in report")
+	}
+}
+
+func TestAddSettingsChange(t *testing.T) {
+	t.Parallel()
+	d := Data{}
+	d.AddSettingsChange(nil)
+	if len(d.SettingsTimeline) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(d.SettingsTimeline), 0)
+	}
+	d.AddSettingsChange(&compliance.Transition{Stage: compliance.StageSettingsChanged, Reason: "test"})
+	if len(d.SettingsTimeline) != 1 || d.SettingsTimeline[0].Reason != "test" {
+		t.Errorf("unexpected settings timeline %v", d.SettingsTimeline)
 	}
 }
 
@@ -456,3 +561,525 @@ func TestEnhanceCandles(t *testing.T) {
 		t.Error("expected enhanced candles")
 	}
 }
+
+func TestEnhanceCandlesIndicators(t *testing.T) {
+	t.Parallel()
+	tt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	var events []currencystatistics.EventStore
+	var candles []gctkline.Candle
+	for i := 0; i < 3; i++ {
+		s := &signal.Signal{
+			Base: event.Base{
+				Exchange:     testExchange,
+				Time:         tt.AddDate(0, 0, i),
+				CurrencyPair: p,
+				AssetType:    asset.Spot,
+			},
+		}
+		if i > 0 {
+			s.AddIndicator("RSI", decimal.NewFromInt(int64(i)), false)
+			s.AddIndicator("SMA", decimal.NewFromInt(1337), true)
+		}
+		events = append(events, currencystatistics.EventStore{SignalEvent: s})
+		candles = append(candles, gctkline.Candle{
+			Time:  tt.AddDate(0, 0, i),
+			Open:  1337,
+			High:  1337,
+			Low:   1337,
+			Close: 1337,
+		})
+	}
+	d := Data{
+		Statistics: &statistics.Statistic{
+			ExchangeAssetPairStatistics: map[string]map[asset.Item]map[currency.Pair]*currencystatistics.CurrencyStatistic{
+				testExchange: {
+					asset.Spot: {
+						p: &currencystatistics.CurrencyStatistic{Events: events},
+					},
+				},
+			},
+		},
+	}
+	d.AddKlineItem(&gctkline.Item{
+		Exchange: testExchange,
+		Pair:     p,
+		Asset:    asset.Spot,
+		Interval: gctkline.OneDay,
+		Candles:  candles,
+	})
+	err := d.enhanceCandles()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	k := d.EnhancedCandles[0]
+	if !k.HasPanelIndicators {
+		t.Error("expected panel indicators")
+	}
+	if len(k.Indicators) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(k.Indicators), 2)
+	}
+	if k.Indicators[0].Name != "RSI" || k.Indicators[0].Overlay || k.Indicators[0].Colour != indicatorColours[0] {
+		t.Errorf("unexpected series %+v", k.Indicators[0])
+	}
+	if k.Indicators[1].Name != "SMA" || !k.Indicators[1].Overlay || k.Indicators[1].Colour != indicatorColours[1] {
+		t.Errorf("unexpected series %+v", k.Indicators[1])
+	}
+	if len(k.Indicators[0].Points) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(k.Indicators[0].Points), 2)
+	}
+	if k.Indicators[0].Points[0].Time != k.Candles[1].Time {
+		t.Errorf("received '%v' expected '%v'", k.Indicators[0].Points[0].Time, k.Candles[1].Time)
+	}
+	if !k.Indicators[0].Points[1].Value.Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received '%v' expected '%v'", k.Indicators[0].Points[1].Value, 2)
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	t.Parallel()
+	k := DetailedKline{
+		Indicators: []IndicatorSeries{
+			{Name: "RSI", Points: []IndicatorPoint{{Time: 2}, {Time: 3}}},
+			{Name: "SMA", Overlay: true, Points: []IndicatorPoint{{Time: 5}}},
+		},
+	}
+	for i := int64(1); i <= 5; i++ {
+		k.Candles = append(k.Candles, DetailedCandle{Time: i})
+	}
+	k.paginate(2)
+	if len(k.Pages) != 3 {
+		t.Fatalf("received '%v' expected '%v'", len(k.Pages), 3)
+	}
+	if len(k.Pages[0].Candles) != 2 || len(k.Pages[2].Candles) != 1 || k.Pages[2].Candles[0].Time != 5 {
+		t.Errorf("unexpected pages %+v", k.Pages)
+	}
+	if len(k.Pages[0].Indicators) != 1 || len(k.Pages[0].Indicators[0].Points) != 1 || k.Pages[0].Indicators[0].Name != "RSI" {
+		t.Errorf("unexpected first page indicators %+v", k.Pages[0].Indicators)
+	}
+	if len(k.Pages[2].Indicators) != 1 || k.Pages[2].Indicators[0].Name != "SMA" {
+		t.Errorf("unexpected last page indicators %+v", k.Pages[2].Indicators)
+	}
+
+	k.paginate(0)
+	if len(k.Pages) != 1 || len(k.Pages[0].Candles) != 5 {
+		t.Errorf("unexpected pages %+v", k.Pages)
+	}
+	k.Candles = nil
+	k.paginate(2)
+	if k.Pages != nil {
+		t.Errorf("received '%v' expected '%v'", k.Pages, nil)
+	}
+}
+
+func TestCandlesPerChartPage(t *testing.T) {
+	t.Parallel()
+	d := Data{}
+	if resp := d.candlesPerChartPage(); resp != maxChartLimit {
+		t.Errorf("received '%v' expected '%v'", resp, maxChartLimit)
+	}
+	d.Config = &config.Config{ReportSettings: &config.ReportSettings{MaxChartCandles: 500}}
+	if resp := d.candlesPerChartPage(); resp != 500 {
+		t.Errorf("received '%v' expected '%v'", resp, 500)
+	}
+	d.Config.ReportSettings.MaxChartCandles = maxChartLimit * 2
+	if resp := d.candlesPerChartPage(); resp != maxChartLimit {
+		t.Errorf("received '%v' expected '%v'", resp, maxChartLimit)
+	}
+}
+
+func TestReturnColour(t *testing.T) {
+	t.Parallel()
+	d := Data{}
+	if c := d.ReturnColour(nil); c != "" {
+		t.Errorf("received '%v' expected '%v'", c, "")
+	}
+	gain := decimal.NewFromInt(2)
+	if c := d.ReturnColour(&gain); c != "background-color: rgba(50, 204, 30, 0.22)" {
+		t.Errorf("received '%v' expected '%v'", c, "background-color: rgba(50, 204, 30, 0.22)")
+	}
+	loss := decimal.NewFromInt(-1337)
+	if c := d.ReturnColour(&loss); c != "background-color: rgba(232, 3, 3, 0.85)" {
+		t.Errorf("received '%v' expected '%v'", c, "background-color: rgba(232, 3, 3, 0.85)")
+	}
+}
+
+func TestContributionBars(t *testing.T) {
+	t.Parallel()
+	d := Data{}
+	c := statistics.ContributionTotal{
+		Pairs: []statistics.PairContribution{
+			{Pair: currency.NewPair(currency.BTC, currency.USDT)},
+			{Pair: currency.NewPair(currency.ETH, currency.USDT)},
+		},
+		Total: statistics.ContributionPeriod{
+			Contributions: []decimal.Decimal{decimal.NewFromInt(20), decimal.NewFromInt(-5)},
+			FeesPercent:   decimal.NewFromInt(5),
+		},
+		Months: []statistics.ContributionPeriod{
+			{
+				Start:         time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				Contributions: []decimal.Decimal{decimal.NewFromInt(10), decimal.Zero},
+			},
+		},
+	}
+	if legend := d.ContributionLegend(c); len(legend) != 3 || legend[2].Name != "Fees" {
+		t.Errorf("unexpected legend %+v", legend)
+	}
+	bars := d.ContributionBars(c)
+	if len(bars) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(bars), 2)
+	}
+	if bars[0].Label != "Total" || bars[1].Label != "2021-01" {
+		t.Errorf("unexpected labels %v %v", bars[0].Label, bars[1].Label)
+	}
+	// gains of 20 fill the right half of the bar, with losses and fees of 10
+	// stacked to the left of the centre
+	expected := []template.CSS{
+		"left: 50%; width: 50%; background-color: rgba(255, 193, 7, 1)",
+		"left: 37.5%; width: 12.5%; background-color: rgba(33, 150, 243, 1)",
+		"left: 25%; width: 12.5%; background-color: rgba(158, 158, 158, 1)",
+	}
+	if len(bars[0].Segments) != len(expected) {
+		t.Fatalf("received '%v' expected '%v'", len(bars[0].Segments), len(expected))
+	}
+	for i := range expected {
+		if bars[0].Segments[i].Style != expected[i] {
+			t.Errorf("received '%v' expected '%v'", bars[0].Segments[i].Style, expected[i])
+		}
+	}
+	if len(bars[1].Segments) != 1 || bars[1].Segments[0].Style != "left: 50%; width: 25%; background-color: rgba(255, 193, 7, 1)" {
+		t.Errorf("unexpected segments %+v", bars[1].Segments)
+	}
+	if bars = d.ContributionBars(statistics.ContributionTotal{}); len(bars) != 1 || len(bars[0].Segments) != 0 {
+		t.Errorf("unexpected bars %+v", bars)
+	}
+}
+
+func TestReturnHistogram(t *testing.T) {
+	t.Parallel()
+	d := Data{}
+	if bars := d.ReturnHistogram(nil); bars != nil {
+		t.Errorf("received '%v' expected '%v'", bars, nil)
+	}
+	bars := d.ReturnHistogram(&currencystatistics.ReturnDistribution{
+		Buckets: []currencystatistics.ReturnBucket{
+			{Lower: decimal.NewFromInt(-2), Upper: decimal.NewFromInt(-1), Count: 2, NormalCount: decimal.NewFromInt(1)},
+			{Lower: decimal.NewFromInt(-1), Upper: decimal.NewFromInt(3), Count: 1, NormalCount: decimal.NewFromInt(4)},
+		},
+	})
+	if len(bars) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(bars), 2)
+	}
+	// the normal count of 4 is the histogram's full height
+	for i, expected := range []struct {
+		count, normal template.CSS
+	}{
+		{"height: 50%; background-color: rgba(232, 3, 3, 0.7)", "bottom: 25%"},
+		{"height: 25%; background-color: rgba(50, 204, 30, 0.7)", "bottom: 100%"},
+	} {
+		if bars[i].CountStyle != expected.count || bars[i].NormalStyle != expected.normal {
+			t.Errorf("received '%v' '%v' expected '%v' '%v'", bars[i].CountStyle, bars[i].NormalStyle, expected.count, expected.normal)
+		}
+	}
+}
+
+func TestQuantilePlot(t *testing.T) {
+	t.Parallel()
+	d := Data{}
+	if points := d.QuantilePlot(nil); points != nil {
+		t.Errorf("received '%v' expected '%v'", points, nil)
+	}
+	points := d.QuantilePlot(&currencystatistics.ReturnDistribution{
+		QuantilePoints: []currencystatistics.QuantilePoint{
+			{Probability: decimal.NewFromFloat(0.25), Normal: decimal.NewFromInt(-1), Observed: decimal.NewFromInt(-3)},
+			{Probability: decimal.NewFromFloat(0.75), Normal: decimal.NewFromInt(1), Observed: decimal.NewFromInt(1)},
+		},
+	})
+	if len(points) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(points), 2)
+	}
+	// both axes span -3 to 1
+	if !points[0].X.Equal(decimal.NewFromInt(50)) || !points[0].Y.Equal(decimal.NewFromInt(100)) {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", points[0].X, points[0].Y, 50, 100)
+	}
+	if !points[1].X.Equal(decimal.NewFromInt(100)) || !points[1].Y.IsZero() {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", points[1].X, points[1].Y, 100, 0)
+	}
+}
+
+func TestSummary(t *testing.T) {
+	t.Parallel()
+	d := Data{}
+	if resp := d.Summary(); resp.HasResults {
+		t.Errorf("received '%v' expected '%v'", resp.HasResults, false)
+	}
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	d = Data{
+		Config:         &config.Config{Nickname: "test"},
+		reportFileName: "test.html",
+		Statistics: &statistics.Statistic{
+			StrategyName: "dca",
+			TotalOrders:  3,
+			AllStats: []currencystatistics.CurrencyStatistic{
+				{ArithmeticRatios: currencystatistics.Ratios{SharpeRatio: decimal.NewFromInt(1)}},
+				{ArithmeticRatios: currencystatistics.Ratios{SharpeRatio: decimal.NewFromInt(2)}},
+			},
+			BestStrategyResults: &statistics.FinalResultsHolder{
+				Exchange:         testExchange,
+				Asset:            asset.Spot,
+				Pair:             p,
+				StrategyMovement: decimal.NewFromInt(10),
+			},
+			BiggestDrawdown: &statistics.FinalResultsHolder{
+				MaxDrawdown: currencystatistics.Swing{DrawdownPercent: decimal.NewFromInt(-5)},
+			},
+			Funding: &funding.Report{
+				InitialTotalUSD: decimal.NewFromInt(100),
+				Difference:      decimal.NewFromInt(20),
+			},
+		},
+	}
+	resp := d.Summary()
+	if !resp.HasResults || resp.Pairs != 2 || resp.TotalOrders != 3 || resp.ReportPath != "test.html" || resp.Nickname != "test" {
+		t.Errorf("received '%+v' expected results for two pairs", resp)
+	}
+	if !resp.AverageSharpeRatio.Equal(decimal.NewFromFloat(1.5)) {
+		t.Errorf("received '%v' expected '%v'", resp.AverageSharpeRatio, 1.5)
+	}
+	if !resp.BestStrategyMovement.Equal(decimal.NewFromInt(10)) || !resp.BiggestDrawdown.Equal(decimal.NewFromInt(-5)) {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", resp.BestStrategyMovement, resp.BiggestDrawdown, 10, -5)
+	}
+	if !resp.HasFundingDifference || !resp.FundingDifference.Equal(decimal.NewFromInt(20)) {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", resp.HasFundingDifference, resp.FundingDifference, true, 20)
+	}
+}
+
+func TestGenerateIndex(t *testing.T) {
+	t.Parallel()
+	tempDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err = os.RemoveAll(tempDir); err != nil {
+			t.Error(err)
+		}
+	}()
+	err = GenerateIndex(IndexTemplateName, tempDir, nil, false)
+	if !errors.Is(err, errNoRuns) {
+		t.Errorf("received '%v' expected '%v'", err, errNoRuns)
+	}
+	runs := []RunSummary{
+		{
+			ID:                 "run-one",
+			Nickname:           "first",
+			StrategyName:       "dca",
+			Status:             "complete",
+			Submitted:          time.Now(),
+			Ended:              time.Now(),
+			ReportPath:         "run-one/first-dca.html",
+			HasResults:         true,
+			Pairs:              1,
+			BestStrategyPair:   "binance spot BTC-USDT",
+			AverageSharpeRatio: decimal.NewFromFloat(1.337),
+		},
+		{
+			ID:           "run-two",
+			StrategyName: "rsi",
+			Status:       "queued",
+			Submitted:    time.Now(),
+		},
+	}
+	err = GenerateIndex("bad-path.gohtml", tempDir, runs, false)
+	if err == nil {
+		t.Error("expected error for missing template")
+	}
+	err = GenerateIndex(IndexTemplateName, tempDir, runs, true)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(tempDir, IndexFileName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{"run-one/first-dca.html", "run-two", "mdb.dark.min.css", "1.337"} {
+		if !strings.Contains(string(data), expected) {
+			t.Errorf("expected index to contain '%v'", expected)
+		}
+	}
+}
+
+func TestLoadTemplate(t *testing.T) {
+	t.Parallel()
+	_, err := loadTemplate("bad-path", TemplateName)
+	if err == nil {
+		t.Error("expected error for missing template")
+	}
+	tmpl, err := loadTemplate(TemplateName, TemplateName)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if tmpl.Name() != TemplateName {
+		t.Errorf("received '%v' expected '%v'", tmpl.Name(), TemplateName)
+	}
+
+	tempDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err = os.RemoveAll(tempDir); err != nil {
+			t.Error(err)
+		}
+	}()
+	_, err = loadTemplate(tempDir, TemplateName)
+	if err == nil {
+		t.Error("expected error for empty template directory")
+	}
+	for name, data := range map[string]string{
+		"partial.gohtml": `{{ define "strategy" }}<h1>{{ .Statistics.StrategyName }}</h1>{{ end }}`,
+		"theme.gohtml":   `{{ define "theme" }}<style>branded</style>{{ end }}`,
+		"zfooter.gohtml": `{{ define "footer" }}<footer>{{ formatNumber 1337.5 1 }}</footer>{{ end }}`,
+	} {
+		err = ioutil.WriteFile(filepath.Join(tempDir, name), []byte(data), 0600)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	_, err = loadTemplate(tempDir, TemplateName)
+	if !errors.Is(err, errNoTemplate) {
+		t.Errorf("received '%v' expected '%v'", err, errNoTemplate)
+	}
+	err = ioutil.WriteFile(filepath.Join(tempDir, TemplateName),
+		[]byte(`{{ block "theme" . }}{{ end }}{{ template "strategy" . }}{{ block "footer" . }}{{ end }}`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl, err = loadTemplate(tempDir, TemplateName)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	var sb strings.Builder
+	err = tmpl.Execute(&sb, &Data{Statistics: &statistics.Statistic{StrategyName: "dca"}})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	// partials override the template's empty blocks regardless of file order
+	if expected := "<style>branded</style><h1>dca</h1><footer>1,337.5</footer>"; sb.String() != expected {
+		t.Errorf("received '%v' expected '%v'", sb.String(), expected)
+	}
+	if path := IndexTemplatePath(tempDir); path != tempDir {
+		t.Errorf("received '%v' expected '%v'", path, tempDir)
+	}
+	if path := IndexTemplatePath(filepath.Join(tempDir, TemplateName)); path != filepath.Join(tempDir, IndexTemplateName) {
+		t.Errorf("received '%v' expected '%v'", path, filepath.Join(tempDir, IndexTemplateName))
+	}
+}
+
+func TestFormatNumber(t *testing.T) {
+	t.Parallel()
+	var nilDecimal *decimal.Decimal
+	negative := decimal.NewFromFloat(-1234567.891)
+	for _, v := range []struct {
+		value    interface{}
+		places   int32
+		expected string
+	}{
+		{nil, 2, ""},
+		{nilDecimal, 2, ""},
+		{&negative, 2, "-1,234,567.89"},
+		{decimal.NewFromFloat(999.995), 2, "1,000.00"},
+		{decimal.NewFromFloat(-0.001), 2, "0.00"},
+		{1337.1337, 0, "1,337"},
+		{float32(12.5), 1, "12.5"},
+		{100, 0, "100"},
+		{int64(1234), -1, "1,234"},
+		{int32(-123456), 0, "-123,456"},
+	} {
+		resp, err := formatNumber(v.value, v.places)
+		if !errors.Is(err, nil) {
+			t.Errorf("received '%v' expected '%v'", err, nil)
+		}
+		if resp != v.expected {
+			t.Errorf("received '%v' expected '%v'", resp, v.expected)
+		}
+	}
+	_, err := formatNumber("1337", 2)
+	if !errors.Is(err, errNotANumber) {
+		t.Errorf("received '%v' expected '%v'", err, errNotANumber)
+	}
+	resp, err := formatPercent(decimal.NewFromFloat(12.345), 1)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if resp != "12.3%" {
+		t.Errorf("received '%v' expected '%v'", resp, "12.3%")
+	}
+	if resp, _ = formatPercent(nil, 1); resp != "" {
+		t.Errorf("received '%v' expected '%v'", resp, "")
+	}
+	if resp = formatTime(time.Time{}, "2006"); resp != "" {
+		t.Errorf("received '%v' expected '%v'", resp, "")
+	}
+	if resp = formatTime(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), "2006-01-02"); resp != "2021-01-01" {
+		t.Errorf("received '%v' expected '%v'", resp, "2021-01-01")
+	}
+}
+
+func TestResolveConfig(t *testing.T) {
+	t.Parallel()
+	d := Data{}
+	err := d.resolveConfig()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	d = Data{
+		Config: &config.Config{
+			Nickname: "test",
+			StrategySettings: config.StrategySettings{
+				Name:           "rsi",
+				CustomSettings: map[string]interface{}{"rsi-high": 80.0},
+			},
+			CurrencySettings: []config.CurrencySettings{
+				{
+					ExchangeName: testExchange,
+					Interval:     time.Hour,
+					MakerFee:     decimal.NewFromFloat(0.001),
+				},
+			},
+			StatisticSettings: config.StatisticSettings{
+				RiskFreeRateByYear: map[int]decimal.Decimal{2020: decimal.NewFromFloat(0.01)},
+			},
+		},
+		StrategySettings: map[string]interface{}{"rsi-high": 80.0, "rsi-low": 30.0, "rsi-period": 14.0},
+		StrategyDefaults: map[string]interface{}{"rsi-high": 70.0, "rsi-low": 30.0, "rsi-period": 14.0},
+	}
+	err = d.resolveConfig()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !strings.Contains(d.ResolvedConfig, `"rsi-period": 14`) {
+		t.Errorf("expected resolved config to contain the strategy's default settings, received %v", d.ResolvedConfig)
+	}
+	if d.Config.StrategySettings.CustomSettings["rsi-period"] != nil {
+		t.Error("expected config to be unchanged")
+	}
+	expected := []ConfigDifference{
+		{Setting: "nickname", Value: "test"},
+		{Setting: "strategy-settings.name", Value: "rsi"},
+		{Setting: "currency-settings[0].exchange-name", Value: testExchange},
+		{Setting: "currency-settings[0].interval", Default: "0s", Value: "1h0m0s"},
+		{Setting: "currency-settings[0].maker-fee-override", Default: "0", Value: "0.001"},
+		{Setting: "statistic-settings.risk-free-rate-by-year.2020", Default: "0", Value: "0.01"},
+		{Setting: "strategy-settings.custom-settings.rsi-high", Default: "70", Value: "80"},
+	}
+	if len(d.ConfigDifferences) != len(expected) {
+		t.Fatalf("received '%v' expected '%v'", d.ConfigDifferences, expected)
+	}
+	for i := range expected {
+		if d.ConfigDifferences[i] != expected[i] {
+			t.Errorf("received '%v' expected '%v'", d.ConfigDifferences[i], expected[i])
+		}
+	}
+}
@@ -2,9 +2,12 @@ package report
 
 import (
 	"errors"
+	"html/template"
+	"time"
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/compliance"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
@@ -12,12 +15,42 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
 
-// lightweight charts can ony render 1100 candles
-const maxChartLimit = 1100
+const (
+	// lightweight charts can ony render 1100 candles, so larger datasets are
+	// split into pages of at most this many candles
+	maxChartLimit = 1100
+
+	// TemplateName is the file name of the report template within a template
+	// directory
+	TemplateName = "tpl.gohtml"
+	// IndexTemplateName is the file name of the multi-run index template,
+	// which is expected alongside the report template
+	IndexTemplateName = "index.gohtml"
+	// templateExtension is the extension of the templates and partials parsed
+	// from a template directory
+	templateExtension = ".gohtml"
+	// IndexFileName is the file name of the generated multi-run index
+	IndexFileName = "index.html"
+)
 
 var (
 	errNoCandles       = errors.New("no candles to enhance")
 	errStatisticsUnset = errors.New("unable to proceed with unset Statistics property")
+	errNoRuns          = errors.New("no runs to index")
+	errNoTemplate      = errors.New("template not found")
+	errNotANumber      = errors.New("value is not a number")
+
+	// indicatorColours are cycled through for each indicator series charted
+	// for a kline
+	indicatorColours = []string{
+		"rgba(255, 193, 7, 1)",
+		"rgba(33, 150, 243, 1)",
+		"rgba(156, 39, 176, 1)",
+		"rgba(0, 188, 212, 1)",
+		"rgba(255, 87, 34, 1)",
+	}
+	// feesColour is the colour of the fees segment of contribution bars
+	feesColour = "rgba(158, 158, 158, 1)"
 )
 
 // Handler contains all functions required to generate statistical reporting for backtesting results
@@ -25,7 +58,9 @@ type Handler interface {
 	GenerateReport() error
 	AddKlineItem(*kline.Item)
 	UpdateItem(*kline.Item)
+	AddSettingsChange(*compliance.Transition)
 	UseDarkMode(bool)
+	Summary() RunSummary
 }
 
 // Data holds all statistical information required to output detailed backtesting results
@@ -38,6 +73,100 @@ type Data struct {
 	OutputPath      string
 	Warnings        []Warning
 	UseDarkTheme    bool
+	// StrategySettings are the custom settings used by the strategy,
+	// including any defaults, and StrategyDefaults those of a new strategy
+	StrategySettings  map[string]interface{}
+	StrategyDefaults  map[string]interface{}
+	ResolvedConfig    string
+	ConfigDifferences []ConfigDifference
+	// SettingsTimeline holds every change the strategy made to its custom
+	// settings mid-run, in the order they were applied
+	SettingsTimeline []compliance.Transition
+	reportFileName   string
+}
+
+// ConfigDifference is a config setting of the run which differs from its
+// default value
+type ConfigDifference struct {
+	Setting string
+	Default string
+	Value   string
+}
+
+// Index holds the runs listed on the multi-run index page
+type Index struct {
+	Runs         []RunSummary
+	UseDarkTheme bool
+	Generated    time.Time
+}
+
+// RunSummary holds the key metrics of a run for the multi-run index page.
+// Metrics are only set when HasResults is true
+type RunSummary struct {
+	ID                   string
+	Nickname             string
+	StrategyName         string
+	Status               string
+	Error                string
+	Submitted            time.Time
+	Ended                time.Time
+	ReportPath           string
+	HasResults           bool
+	Pairs                int
+	TotalOrders          int64
+	BestStrategyPair     string
+	BestStrategyMovement decimal.Decimal
+	BiggestDrawdown      decimal.Decimal
+	AverageSharpeRatio   decimal.Decimal
+	HasFundingDifference bool
+	FundingDifference    decimal.Decimal
+}
+
+// ContributionBar is a stacked bar of the pair contributions and fees of a
+// contribution period. Gains are stacked right of the bar's centre and losses
+// and fees to its left, scaled against the largest stack of all periods
+type ContributionBar struct {
+	Label         string
+	ReturnPercent decimal.Decimal
+	ReturnColour  template.CSS
+	Segments      []ContributionSegment
+}
+
+// ContributionSegment is a section of a contribution bar, its style
+// positioning it as a percentage of the bar's width
+type ContributionSegment struct {
+	Name    string
+	Percent decimal.Decimal
+	Style   template.CSS
+}
+
+// ContributionLegend is the colour of a pair or of fees in contribution bars
+type ContributionLegend struct {
+	Name   string
+	Colour template.CSS
+}
+
+// HistogramBar is a bucket of a return distribution's histogram. Its styles
+// set the heights of the bucket's count and of its normal count as
+// percentages of the histogram's height
+type HistogramBar struct {
+	Lower       decimal.Decimal
+	Upper       decimal.Decimal
+	Count       int64
+	NormalCount decimal.Decimal
+	CountStyle  template.CSS
+	NormalStyle template.CSS
+}
+
+// QuantilePlotPoint is a point of a return distribution's QQ plot. X and Y
+// position the normal and observed returns as percentages of the plot's width
+// and height, with Y measured from the top
+type QuantilePlotPoint struct {
+	Probability decimal.Decimal
+	Normal      decimal.Decimal
+	Observed    decimal.Decimal
+	X           decimal.Decimal
+	Y           decimal.Decimal
 }
 
 // Warning holds any candle warnings
@@ -50,30 +179,59 @@ type Warning struct {
 
 // DetailedKline enhances kline details for the purpose of rich reporting results
 type DetailedKline struct {
-	IsOverLimit bool
-	Watermark   string
-	Exchange    string
-	Asset       asset.Item
-	Pair        currency.Pair
-	Interval    kline.Interval
-	Candles     []DetailedCandle
+	Watermark  string
+	Exchange   string
+	Asset      asset.Item
+	Pair       currency.Pair
+	Interval   kline.Interval
+	Candles    []DetailedCandle
+	Indicators []IndicatorSeries
+	// Pages splits Candles and Indicators into the chart pages rendered by
+	// the report, so that only the viewed page is drawn
+	Pages []ChartPage
+	// HasPanelIndicators is set when any indicator is drawn in its own
+	// panel beneath the price chart
+	HasPanelIndicators bool
+}
+
+// ChartPage holds a contiguous range of a kline's candles along with the
+// indicator values published during them. Pages are embedded in the report
+// as JSON and only parsed when viewed
+type ChartPage struct {
+	Candles    []DetailedCandle  `json:"candles"`
+	Indicators []IndicatorSeries `json:"indicators"`
+}
+
+// IndicatorSeries holds the values a strategy published for an indicator
+// across the candles of a kline, to be drawn as a line on the report charts
+type IndicatorSeries struct {
+	Name    string           `json:"name"`
+	Overlay bool             `json:"overlay"`
+	Colour  string           `json:"colour"`
+	Points  []IndicatorPoint `json:"points"`
+}
+
+// IndicatorPoint is an indicator value at a candle's time
+type IndicatorPoint struct {
+	Time  int64           `json:"time"`
+	Value decimal.Decimal `json:"value"`
 }
 
 // DetailedCandle contains extra details to enable rich reporting results
 type DetailedCandle struct {
-	Time           int64
-	Open           decimal.Decimal
-	High           decimal.Decimal
-	Low            decimal.Decimal
-	Close          decimal.Decimal
-	Volume         decimal.Decimal
-	VolumeColour   string
-	MadeOrder      bool
-	OrderDirection order.Side
-	OrderAmount    decimal.Decimal
-	Shape          string
-	Text           string
-	Position       string
-	Colour         string
-	PurchasePrice  decimal.Decimal
+	Time           int64           `json:"time"`
+	Open           decimal.Decimal `json:"open"`
+	High           decimal.Decimal `json:"high"`
+	Low            decimal.Decimal `json:"low"`
+	Close          decimal.Decimal `json:"close"`
+	Volume         decimal.Decimal `json:"volume"`
+	VolumeColour   string          `json:"volumeColour"`
+	MadeOrder      bool            `json:"madeOrder"`
+	OrderDirection order.Side      `json:"orderDirection,omitempty"`
+	OrderAmount    decimal.Decimal `json:"orderAmount"`
+	Shape          string          `json:"shape,omitempty"`
+	Text           string          `json:"text,omitempty"`
+	Position       string          `json:"position,omitempty"`
+	Colour         string          `json:"colour,omitempty"`
+	PurchasePrice  decimal.Decimal `json:"purchasePrice"`
 }
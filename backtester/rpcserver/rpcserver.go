@@ -0,0 +1,442 @@
+package rpcserver
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	grpcauth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	"github.com/thrasher-corp/gocryptotrader/backtester/artifacts"
+	"github.com/thrasher-corp/gocryptotrader/backtester/backtest"
+	"github.com/thrasher-corp/gocryptotrader/backtester/btrpc"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/report"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/crypto"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+)
+
+// NewServer returns a backtester server which stores run output under the
+// supplied output path. When a report template is supplied, an index page
+// listing every run is kept in the output path using the index template
+// alongside it, or within it when it is a template directory
+func NewServer(bot *engine.Engine, templatePath, outputPath string) (*Server, error) {
+	if bot == nil {
+		return nil, errNilBot
+	}
+	s := &Server{
+		bot:              bot,
+		templatePath:     templatePath,
+		outputPath:       outputPath,
+		progressInterval: defaultProgressInterval,
+	}
+	if templatePath != "" {
+		s.indexTemplatePath = report.IndexTemplatePath(templatePath)
+	}
+	s.executor = s.execute
+	return s, nil
+}
+
+// Start begins processing submitted runs
+func (s *Server) Start() error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.shutdown != nil {
+		return errServerAlreadyRunning
+	}
+	s.queue = make(chan *run, maxQueuedRuns)
+	s.shutdown = make(chan struct{})
+	s.wg.Add(1)
+	go s.processRuns(s.queue, s.shutdown)
+	return nil
+}
+
+// Stop stops processing submitted runs, the current run is allowed to finish
+func (s *Server) Stop() error {
+	s.m.Lock()
+	if s.shutdown == nil {
+		s.m.Unlock()
+		return errServerNotRunning
+	}
+	close(s.shutdown)
+	s.shutdown = nil
+	s.m.Unlock()
+	s.wg.Wait()
+	return nil
+}
+
+// ListenAndServe starts a gRPC server with TLS and basic authentication using
+// the GoCryptoTrader TLS certificates and remote control credentials
+func (s *Server) ListenAndServe(listenAddress string) error {
+	targetDir := utils.GetTLSDir(s.bot.Settings.DataDir)
+	if err := engine.CheckCerts(targetDir); err != nil {
+		return err
+	}
+	creds, err := credentials.NewServerTLSFromFile(filepath.Join(targetDir, "cert.pem"), filepath.Join(targetDir, "key.pem"))
+	if err != nil {
+		return fmt.Errorf("could not load TLS keys: %w", err)
+	}
+	lis, err := net.Listen("tcp", listenAddress)
+	if err != nil {
+		return err
+	}
+	server := grpc.NewServer(
+		grpc.Creds(creds),
+		grpc.UnaryInterceptor(grpcauth.UnaryServerInterceptor(s.authenticateClient)),
+		grpc.StreamInterceptor(grpcauth.StreamServerInterceptor(s.authenticateClient)),
+	)
+	btrpc.RegisterBacktesterServiceServer(server, s)
+	log.Infof(log.BackTester, "backtester gRPC server started on https://%v", listenAddress)
+	return server.Serve(lis)
+}
+
+func (s *Server) authenticateClient(ctx context.Context) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx, fmt.Errorf("unable to extract metadata")
+	}
+	authStr, ok := md["authorization"]
+	if !ok {
+		return ctx, fmt.Errorf("authorization header missing")
+	}
+	if !strings.Contains(authStr[0], "Basic") {
+		return ctx, fmt.Errorf("basic not found in authorization header")
+	}
+	decoded, err := crypto.Base64Decode(strings.Split(authStr[0], " ")[1])
+	if err != nil {
+		return ctx, fmt.Errorf("unable to base64 decode authorization header")
+	}
+	credentials := strings.SplitN(string(decoded), ":", 2)
+	if len(credentials) != 2 ||
+		credentials[0] != s.bot.Config.RemoteControl.Username ||
+		credentials[1] != s.bot.Config.RemoteControl.Password {
+		return ctx, fmt.Errorf("username/password mismatch")
+	}
+	return ctx, nil
+}
+
+// ExecuteStrategyFromConfig validates a strategy config and queues it to be
+// run
+func (s *Server) ExecuteStrategyFromConfig(_ context.Context, r *btrpc.ExecuteStrategyFromConfigRequest) (*btrpc.ExecuteStrategyResponse, error) {
+	if r == nil {
+		return nil, errNilRequest
+	}
+	if len(r.Config) == 0 {
+		return nil, errConfigUnset
+	}
+	cfg, err := config.LoadConfig(r.Config)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, errConfigUnset
+	}
+	if cfg.DataSettings.LiveData != nil {
+		return nil, errLiveRunsUnsupported
+	}
+	err = cfg.Validate()
+	if err != nil {
+		return nil, err
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	rn := &run{
+		id:             id,
+		cfg:            cfg,
+		generateReport: r.GenerateReport,
+		darkReport:     r.DarkReport,
+		outputPath:     filepath.Join(s.outputPath, id.String()),
+		status:         StatusQueued,
+		submitted:      time.Now(),
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.shutdown == nil {
+		return nil, errServerNotRunning
+	}
+	select {
+	case s.queue <- rn:
+	default:
+		return nil, errRunQueueFull
+	}
+	s.runs = append(s.runs, rn)
+	return &btrpc.ExecuteStrategyResponse{Run: rn.summary()}, nil
+}
+
+// ListAllRuns returns a summary of every submitted run
+func (s *Server) ListAllRuns(_ context.Context, _ *btrpc.ListAllRunsRequest) (*btrpc.ListAllRunsResponse, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	resp := &btrpc.ListAllRunsResponse{
+		Runs: make([]*btrpc.RunSummary, len(s.runs)),
+	}
+	for i := range s.runs {
+		resp.Runs[i] = s.runs[i].summary()
+	}
+	return resp, nil
+}
+
+// GetRunProgress streams the summary of a run at a regular interval until the
+// run has finished
+func (s *Server) GetRunProgress(r *btrpc.GetRunProgressRequest, stream btrpc.BacktesterService_GetRunProgressServer) error {
+	if r == nil {
+		return errNilRequest
+	}
+	ticker := time.NewTicker(s.progressInterval)
+	defer ticker.Stop()
+	for {
+		s.m.RLock()
+		rn, err := s.getRun(r.Id)
+		if err != nil {
+			s.m.RUnlock()
+			return err
+		}
+		summary := rn.summary()
+		s.m.RUnlock()
+		if err = stream.Send(summary); err != nil {
+			return err
+		}
+		if summary.Status == StatusComplete || summary.Status == StatusFailed {
+			return nil
+		}
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// GetRunReport returns the artifacts generated by a finished run
+func (s *Server) GetRunReport(_ context.Context, r *btrpc.GetRunReportRequest) (*btrpc.GetRunReportResponse, error) {
+	if r == nil {
+		return nil, errNilRequest
+	}
+	s.m.RLock()
+	rn, err := s.getRun(r.Id)
+	if err != nil {
+		s.m.RUnlock()
+		return nil, err
+	}
+	status, outputPath := rn.status, rn.outputPath
+	s.m.RUnlock()
+	if status != StatusComplete && status != StatusFailed {
+		return nil, fmt.Errorf("%w %s status %s", errRunNotFinished, r.Id, status)
+	}
+	files, err := ioutil.ReadDir(outputPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("%w for run %s", errNoReportArtifacts, r.Id)
+		}
+		return nil, err
+	}
+	resp := &btrpc.GetRunReportResponse{}
+	for i := range files {
+		if files[i].IsDir() {
+			continue
+		}
+		data, err := ioutil.ReadFile(filepath.Join(outputPath, files[i].Name()))
+		if err != nil {
+			return nil, err
+		}
+		resp.Artifacts = append(resp.Artifacts, &btrpc.ReportArtifact{
+			Name: files[i].Name(),
+			Data: data,
+		})
+	}
+	if len(resp.Artifacts) == 0 {
+		return nil, fmt.Errorf("%w for run %s", errNoReportArtifacts, r.Id)
+	}
+	sort.Slice(resp.Artifacts, func(i, j int) bool {
+		return resp.Artifacts[i].Name < resp.Artifacts[j].Name
+	})
+	return resp, nil
+}
+
+// getRun returns a run by its ID, the lock must be held by the caller
+func (s *Server) getRun(id string) (*run, error) {
+	for i := range s.runs {
+		if s.runs[i].id.String() == id {
+			return s.runs[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w %s", errRunNotFound, id)
+}
+
+// processRuns executes queued runs one at a time until the server is stopped
+func (s *Server) processRuns(queue <-chan *run, shutdown <-chan struct{}) {
+	defer s.wg.Done()
+	for {
+		select {
+		case <-shutdown:
+			return
+		case rn := <-queue:
+			s.m.Lock()
+			rn.status = StatusRunning
+			rn.started = time.Now()
+			s.m.Unlock()
+			s.updateIndex()
+
+			err := s.executor(rn)
+
+			s.m.Lock()
+			rn.ended = time.Now()
+			rn.err = err
+			rn.status = StatusComplete
+			if err != nil {
+				rn.status = StatusFailed
+			}
+			s.m.Unlock()
+			if err != nil {
+				log.Errorf(log.BackTester, "backtester run %s failed: %v", rn.id, err)
+			}
+			s.updateIndex()
+		}
+	}
+}
+
+// updateIndex regenerates the index page of all runs, logging any failure as
+// it does not affect the runs themselves
+func (s *Server) updateIndex() {
+	if err := s.generateIndex(); err != nil {
+		log.Errorf(log.BackTester, "could not generate backtester run index: %v", err)
+	}
+}
+
+// generateIndex writes an index page to the output path listing every run
+// with its key metrics and a link to its report
+func (s *Server) generateIndex() error {
+	if s.indexTemplatePath == "" {
+		return nil
+	}
+	s.m.RLock()
+	if len(s.runs) == 0 {
+		s.m.RUnlock()
+		return nil
+	}
+	runs := make([]report.RunSummary, len(s.runs))
+	for i := range s.runs {
+		runs[i] = s.runs[i].indexSummary()
+	}
+	useDarkTheme := s.runs[len(s.runs)-1].darkReport
+	s.m.RUnlock()
+	return report.GenerateIndex(s.indexTemplatePath, s.outputPath, runs, useDarkTheme)
+}
+
+// execute runs a strategy config and generates its report
+func (s *Server) execute(rn *run) (err error) {
+	runDir, err := artifacts.Create(s.outputPath, rn.id.String())
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if finishErr := runDir.Finish(err); finishErr != nil {
+			log.Errorf(log.BackTester, "could not finish backtester run %s: %v", rn.id, finishErr)
+		}
+	}()
+	err = runDir.SaveConfig(rn.cfg)
+	if err != nil {
+		return err
+	}
+	// runs are processed one at a time, so only the run's own logs are copied
+	err = runDir.StartLog()
+	if err != nil {
+		return err
+	}
+	bt, err := backtest.NewFromConfig(rn.cfg, s.templatePath, runDir.Path, s.bot)
+	if err != nil {
+		return err
+	}
+	bt.SetRunID(runDir.ID)
+	s.m.Lock()
+	rn.bt = bt
+	s.m.Unlock()
+	err = bt.Run()
+	if err != nil {
+		return err
+	}
+	err = bt.Statistic.CalculateAllResults(bt.Funding)
+	if err != nil {
+		return err
+	}
+	if rn.generateReport {
+		bt.Reports.UseDarkMode(rn.darkReport)
+		err = bt.Reports.GenerateReport()
+	}
+	s.m.Lock()
+	rn.results = bt.Reports.Summary()
+	s.m.Unlock()
+	return err
+}
+
+// summary converts the run to its RPC representation, the lock must be held
+// by the caller
+func (r *run) summary() *btrpc.RunSummary {
+	resp := &btrpc.RunSummary{
+		Id:            r.id.String(),
+		Nickname:      r.cfg.Nickname,
+		StrategyName:  r.cfg.StrategySettings.Name,
+		Status:        r.status,
+		DateSubmitted: r.submitted.Format(common.SimpleTimeFormatWithTimezone),
+	}
+	if r.err != nil {
+		resp.Error = r.err.Error()
+	}
+	if !r.started.IsZero() {
+		resp.DateStarted = r.started.Format(common.SimpleTimeFormatWithTimezone)
+	}
+	if !r.ended.IsZero() {
+		resp.DateEnded = r.ended.Format(common.SimpleTimeFormatWithTimezone)
+	}
+	if r.bt != nil {
+		progress := r.bt.ProgressReport()
+		resp.EventsProcessed, resp.EventsTotal = progress.Processed, progress.Total
+		resp.PercentComplete = progress.Percent
+		resp.EventsPerSecond = progress.EventsPerSecond
+		if progress.Elapsed > 0 {
+			resp.Elapsed = progress.Elapsed.Round(time.Second).String()
+		}
+		if progress.ETA > 0 {
+			resp.Eta = progress.ETA.Round(time.Second).String()
+		}
+	}
+	return resp
+}
+
+// indexSummary converts the run to its index page representation, linking to
+// its report relative to the server output path. The lock must be held by the
+// caller
+func (r *run) indexSummary() report.RunSummary {
+	resp := r.results
+	resp.ID = r.id.String()
+	resp.Nickname = r.cfg.Nickname
+	if resp.StrategyName == "" {
+		resp.StrategyName = r.cfg.StrategySettings.Name
+	}
+	resp.Status = r.status
+	if r.err != nil {
+		resp.Error = r.err.Error()
+	}
+	resp.Submitted = r.submitted
+	resp.Ended = r.ended
+	if resp.ReportPath != "" {
+		resp.ReportPath = path.Join(resp.ID, resp.ReportPath)
+	}
+	return resp
+}
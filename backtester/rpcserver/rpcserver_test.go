@@ -0,0 +1,376 @@
+package rpcserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/btrpc"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/report"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+	"google.golang.org/grpc"
+)
+
+var (
+	errTestRun    = errors.New("test run failure")
+	strategyPath  = filepath.Join("..", "config", "examples", "dca-api-candles.strat")
+	liveStratPath = filepath.Join("..", "config", "examples", "dca-candles-live.strat")
+)
+
+func newTestServer(t *testing.T) *Server {
+	t.Helper()
+	s, err := NewServer(&engine.Engine{}, "", t.TempDir())
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	s.progressInterval = time.Millisecond
+	s.executor = func(r *run) error {
+		if r.cfg.Nickname == "fail" {
+			return errTestRun
+		}
+		if err := os.MkdirAll(r.outputPath, 0770); err != nil {
+			return err
+		}
+		return ioutil.WriteFile(filepath.Join(r.outputPath, "report.html"), []byte("report"), 0770)
+	}
+	return s
+}
+
+func readStrategy(t *testing.T, path string) []byte {
+	t.Helper()
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// waitForStatus blocks until the run reaches a finished status
+func waitForStatus(t *testing.T, s *Server, id string) *btrpc.RunSummary {
+	t.Helper()
+	for i := 0; i < 1000; i++ {
+		s.m.RLock()
+		r, err := s.getRun(id)
+		if err != nil {
+			s.m.RUnlock()
+			t.Fatal(err)
+		}
+		summary := r.summary()
+		s.m.RUnlock()
+		if summary.Status == StatusComplete || summary.Status == StatusFailed {
+			return summary
+		}
+		time.Sleep(time.Millisecond * 5)
+	}
+	t.Fatal("run did not finish")
+	return nil
+}
+
+func TestNewServer(t *testing.T) {
+	t.Parallel()
+	_, err := NewServer(nil, "", "")
+	if !errors.Is(err, errNilBot) {
+		t.Errorf("received '%v' expected '%v'", err, errNilBot)
+	}
+	s, err := NewServer(&engine.Engine{}, "", "")
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if s.executor == nil {
+		t.Error("expected executor to be set")
+	}
+}
+
+func TestStartStop(t *testing.T) {
+	t.Parallel()
+	s := newTestServer(t)
+	err := s.Stop()
+	if !errors.Is(err, errServerNotRunning) {
+		t.Errorf("received '%v' expected '%v'", err, errServerNotRunning)
+	}
+	err = s.Start()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	err = s.Start()
+	if !errors.Is(err, errServerAlreadyRunning) {
+		t.Errorf("received '%v' expected '%v'", err, errServerAlreadyRunning)
+	}
+	err = s.Stop()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+}
+
+func TestExecuteStrategyFromConfig(t *testing.T) {
+	t.Parallel()
+	s := newTestServer(t)
+	_, err := s.ExecuteStrategyFromConfig(context.Background(), nil)
+	if !errors.Is(err, errNilRequest) {
+		t.Errorf("received '%v' expected '%v'", err, errNilRequest)
+	}
+	_, err = s.ExecuteStrategyFromConfig(context.Background(), &btrpc.ExecuteStrategyFromConfigRequest{})
+	if !errors.Is(err, errConfigUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errConfigUnset)
+	}
+	_, err = s.ExecuteStrategyFromConfig(context.Background(), &btrpc.ExecuteStrategyFromConfigRequest{
+		Config: readStrategy(t, liveStratPath),
+	})
+	if !errors.Is(err, errLiveRunsUnsupported) {
+		t.Errorf("received '%v' expected '%v'", err, errLiveRunsUnsupported)
+	}
+	req := &btrpc.ExecuteStrategyFromConfigRequest{
+		Config: readStrategy(t, strategyPath),
+	}
+	_, err = s.ExecuteStrategyFromConfig(context.Background(), req)
+	if !errors.Is(err, errServerNotRunning) {
+		t.Errorf("received '%v' expected '%v'", err, errServerNotRunning)
+	}
+
+	err = s.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	resp, err := s.ExecuteStrategyFromConfig(context.Background(), req)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if resp.Run.Id == "" {
+		t.Error("expected run ID")
+	}
+	summary := waitForStatus(t, s, resp.Run.Id)
+	if summary.Status != StatusComplete {
+		t.Errorf("received '%v' expected '%v'", summary.Status, StatusComplete)
+	}
+	if summary.DateStarted == "" || summary.DateEnded == "" {
+		t.Error("expected run dates to be set")
+	}
+	err = s.Stop()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+}
+
+func TestListAllRuns(t *testing.T) {
+	t.Parallel()
+	s := newTestServer(t)
+	resp, err := s.ListAllRuns(context.Background(), nil)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp.Runs) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(resp.Runs), 0)
+	}
+	err = s.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	req := &btrpc.ExecuteStrategyFromConfigRequest{
+		Config: readStrategy(t, strategyPath),
+	}
+	for i := 0; i < 2; i++ {
+		_, err = s.ExecuteStrategyFromConfig(context.Background(), req)
+		if !errors.Is(err, nil) {
+			t.Fatalf("received '%v' expected '%v'", err, nil)
+		}
+	}
+	resp, err = s.ListAllRuns(context.Background(), nil)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp.Runs) != 2 {
+		t.Errorf("received '%v' expected '%v'", len(resp.Runs), 2)
+	}
+	err = s.Stop()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+}
+
+type progressStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	received []*btrpc.RunSummary
+}
+
+func (p *progressStream) Context() context.Context {
+	return p.ctx
+}
+
+func (p *progressStream) Send(r *btrpc.RunSummary) error {
+	p.received = append(p.received, r)
+	return nil
+}
+
+func TestGetRunProgress(t *testing.T) {
+	t.Parallel()
+	s := newTestServer(t)
+	err := s.GetRunProgress(nil, nil)
+	if !errors.Is(err, errNilRequest) {
+		t.Errorf("received '%v' expected '%v'", err, errNilRequest)
+	}
+	stream := &progressStream{ctx: context.Background()}
+	err = s.GetRunProgress(&btrpc.GetRunProgressRequest{Id: "bad"}, stream)
+	if !errors.Is(err, errRunNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, errRunNotFound)
+	}
+
+	err = s.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	resp, err := s.ExecuteStrategyFromConfig(context.Background(), &btrpc.ExecuteStrategyFromConfigRequest{
+		Config: readStrategy(t, strategyPath),
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = s.GetRunProgress(&btrpc.GetRunProgressRequest{Id: resp.Run.Id}, stream)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(stream.received) == 0 {
+		t.Fatal("expected progress to be streamed")
+	}
+	last := stream.received[len(stream.received)-1]
+	if last.Status != StatusComplete {
+		t.Errorf("received '%v' expected '%v'", last.Status, StatusComplete)
+	}
+	if last.EventsProcessed != last.EventsTotal || (last.EventsTotal > 0 && last.PercentComplete != 100) {
+		t.Errorf("unexpected progress %v/%v %v%%", last.EventsProcessed, last.EventsTotal, last.PercentComplete)
+	}
+	err = s.Stop()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+}
+
+func TestGetRunReport(t *testing.T) {
+	t.Parallel()
+	s := newTestServer(t)
+	_, err := s.GetRunReport(context.Background(), nil)
+	if !errors.Is(err, errNilRequest) {
+		t.Errorf("received '%v' expected '%v'", err, errNilRequest)
+	}
+	_, err = s.GetRunReport(context.Background(), &btrpc.GetRunReportRequest{Id: "bad"})
+	if !errors.Is(err, errRunNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, errRunNotFound)
+	}
+
+	data := readStrategy(t, strategyPath)
+	failCfg, err := config.LoadConfig(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	failCfg.Nickname = "fail"
+	failData, err := json.Marshal(failCfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := &btrpc.ExecuteStrategyFromConfigRequest{Config: data, GenerateReport: true}
+
+	// queue a run without processing it to check unfinished runs are rejected
+	s.m.Lock()
+	s.queue = make(chan *run, 1)
+	s.shutdown = make(chan struct{})
+	s.m.Unlock()
+	resp, err := s.ExecuteStrategyFromConfig(context.Background(), req)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = s.GetRunReport(context.Background(), &btrpc.GetRunReportRequest{Id: resp.Run.Id})
+	if !errors.Is(err, errRunNotFinished) {
+		t.Errorf("received '%v' expected '%v'", err, errRunNotFinished)
+	}
+	_, err = s.ExecuteStrategyFromConfig(context.Background(), req)
+	if !errors.Is(err, errRunQueueFull) {
+		t.Errorf("received '%v' expected '%v'", err, errRunQueueFull)
+	}
+	s.m.Lock()
+	s.shutdown = nil
+	s.m.Unlock()
+
+	err = s.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	resp, err = s.ExecuteStrategyFromConfig(context.Background(), req)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	waitForStatus(t, s, resp.Run.Id)
+	report, err := s.GetRunReport(context.Background(), &btrpc.GetRunReportRequest{Id: resp.Run.Id})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(report.Artifacts) != 1 || report.Artifacts[0].Name != "report.html" {
+		t.Errorf("unexpected artifacts %v", report.Artifacts)
+	}
+
+	resp, err = s.ExecuteStrategyFromConfig(context.Background(), &btrpc.ExecuteStrategyFromConfigRequest{Config: failData})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	summary := waitForStatus(t, s, resp.Run.Id)
+	if summary.Status != StatusFailed || summary.Error != errTestRun.Error() {
+		t.Errorf("received '%v' expected '%v'", summary.Error, errTestRun)
+	}
+	_, err = s.GetRunReport(context.Background(), &btrpc.GetRunReportRequest{Id: resp.Run.Id})
+	if !errors.Is(err, errNoReportArtifacts) {
+		t.Errorf("received '%v' expected '%v'", err, errNoReportArtifacts)
+	}
+	err = s.Stop()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+}
+
+func TestGenerateIndex(t *testing.T) {
+	t.Parallel()
+	s := newTestServer(t)
+	err := s.generateIndex()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	s.indexTemplatePath = filepath.Join("..", "report", report.IndexTemplateName)
+	err = s.generateIndex()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	err = s.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	resp, err := s.ExecuteStrategyFromConfig(context.Background(), &btrpc.ExecuteStrategyFromConfigRequest{
+		Config: readStrategy(t, strategyPath),
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	waitForStatus(t, s, resp.Run.Id)
+	err = s.Stop()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	s.m.Lock()
+	s.runs[0].results.ReportPath = "report.html"
+	s.m.Unlock()
+	err = s.generateIndex()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(s.outputPath, report.IndexFileName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), resp.Run.Id+"/report.html") {
+		t.Errorf("expected index to link to run report %v", resp.Run.Id)
+	}
+}
@@ -0,0 +1,74 @@
+package rpcserver
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/backtester/backtest"
+	"github.com/thrasher-corp/gocryptotrader/backtester/btrpc"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/report"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+)
+
+const (
+	// StatusQueued is a run which is waiting for earlier runs to complete
+	StatusQueued = "queued"
+	// StatusRunning is a run which is being processed
+	StatusRunning = "running"
+	// StatusComplete is a run which completed successfully
+	StatusComplete = "complete"
+	// StatusFailed is a run which could not be completed
+	StatusFailed = "failed"
+
+	defaultProgressInterval = time.Second
+	maxQueuedRuns           = 100
+)
+
+var (
+	errNilBot               = errors.New("unable to setup backtester server without a loaded GoCryptoTrader bot")
+	errNilRequest           = errors.New("nil request received")
+	errConfigUnset          = errors.New("strategy config unset")
+	errLiveRunsUnsupported  = errors.New("live data runs are not supported by the backtester server")
+	errRunQueueFull         = errors.New("run queue is full")
+	errRunNotFound          = errors.New("run not found")
+	errRunNotFinished       = errors.New("run has not finished")
+	errNoReportArtifacts    = errors.New("no report artifacts generated")
+	errServerAlreadyRunning = errors.New("backtester server already running")
+	errServerNotRunning     = errors.New("backtester server not running")
+)
+
+// Server manages strategy runs submitted over gRPC. Runs are processed one at
+// a time in the order they are submitted as they share the GoCryptoTrader bot
+type Server struct {
+	btrpc.UnimplementedBacktesterServiceServer
+	bot               *engine.Engine
+	templatePath      string
+	indexTemplatePath string
+	outputPath        string
+	progressInterval  time.Duration
+	runs              []*run
+	queue             chan *run
+	shutdown          chan struct{}
+	wg                sync.WaitGroup
+	m                 sync.RWMutex
+	executor          func(*run) error
+}
+
+// run holds a submitted strategy run and its progress
+type run struct {
+	id             uuid.UUID
+	cfg            *config.Config
+	generateReport bool
+	darkReport     bool
+	outputPath     string
+	status         string
+	err            error
+	submitted      time.Time
+	started        time.Time
+	ended          time.Time
+	bt             *backtest.BackTest
+	results        report.RunSummary
+}
@@ -1,11 +1,13 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"flag"
 	"fmt"
 	"os"
 	"runtime"
+	"strings"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/config"
@@ -13,6 +15,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database"
 	dbPSQL "github.com/thrasher-corp/gocryptotrader/database/drivers/postgres"
 	dbsqlite3 "github.com/thrasher-corp/gocryptotrader/database/drivers/sqlite3"
+	dbtimescale "github.com/thrasher-corp/gocryptotrader/database/drivers/timescaledb"
 	"github.com/thrasher-corp/gocryptotrader/database/repository"
 	"github.com/thrasher-corp/goose"
 )
@@ -33,6 +36,12 @@ func openDBConnection(cfg *database.Config) (err error) {
 			return fmt.Errorf("database failed to connect: %v, some features that utilise a database will be unavailable", err)
 		}
 		return nil
+	} else if cfg.Driver == database.DBTimescaleDB {
+		dbConn, err = dbtimescale.Connect(cfg)
+		if err != nil {
+			return fmt.Errorf("database failed to connect: %v, some features that utilise a database will be unavailable", err)
+		}
+		return nil
 	} else if cfg.Driver == database.DBSQLite || cfg.Driver == database.DBSQLite3 {
 		dbConn, err = dbsqlite3.Connect(cfg.Database)
 		if err != nil {
@@ -90,5 +99,14 @@ func main() {
 
 	if err = goose.Run(command, dbConn.SQL, drv, migrationDir, args); err != nil {
 		fmt.Println(err)
+		return
+	}
+
+	if conf.Database.Driver == database.DBTimescaleDB && strings.HasPrefix(command, "up") {
+		if err = dbtimescale.EnableHypertables(context.Background(), dbConn); err != nil {
+			fmt.Printf("Unable to convert tables to hypertables: %v\n", err)
+			return
+		}
+		fmt.Println("TimescaleDB hypertables enabled")
 	}
 }
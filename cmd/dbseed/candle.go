@@ -3,13 +3,35 @@ package main
 import (
 	"errors"
 	"log"
-	"os"
 	"strconv"
 
 	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
 	"github.com/urfave/cli/v2"
 )
 
+var candleFlags = []cli.Flag{
+	&cli.StringFlag{
+		Name:  "exchange",
+		Usage: "exchange name of candle data",
+	},
+	&cli.StringFlag{
+		Name:  "base",
+		Usage: "base currency of candle data",
+	},
+	&cli.StringFlag{
+		Name:  "quote",
+		Usage: "quote currency of candle data",
+	},
+	&cli.Int64Flag{
+		Name:  "interval",
+		Usage: "interval in seconds of candle data",
+	},
+	&cli.StringFlag{
+		Name:  "asset",
+		Usage: "asset type of candle data (spot/margin/futures for example)",
+	},
+}
+
 var seedCandleCommand = &cli.Command{
 	Name:  "candle",
 	Usage: "seed candle data",
@@ -17,107 +39,126 @@ var seedCandleCommand = &cli.Command{
 		{
 			Name:  "file",
 			Usage: "seed candle data from a file",
-			Flags: []cli.Flag{
+			Flags: append(candleFlags,
 				&cli.StringFlag{
-					Name:  "exchange",
-					Usage: "exchange name of supplied candle data",
+					Name:      "filename",
+					Usage:     "csv file to load candle data from, .gz files are decompressed (see readme for formatting details)",
+					TakesFile: true,
+					FilePath:  workingDir,
 				},
+			),
+			Action: seedCandleFromFile,
+		},
+		{
+			Name:  "export",
+			Usage: "export candle data to a file",
+			Flags: append(candleFlags,
 				&cli.StringFlag{
-					Name:  "base",
-					Usage: "base currency of supplied candle data",
+					Name:  "start",
+					Usage: "start date of candle data to export",
 				},
 				&cli.StringFlag{
-					Name:  "quote",
-					Usage: "quote currency of supplied candle data",
-				},
-				&cli.Int64Flag{
-					Name:  "interval",
-					Usage: "interval of supplied candle data",
-				},
-				&cli.StringFlag{
-					Name:  "asset",
-					Usage: "asset type of supplied data (spot/margin/futures for example)",
+					Name:  "end",
+					Usage: "end date of candle data to export",
 				},
 				&cli.StringFlag{
 					Name:      "filename",
-					Usage:     "csv file to load candle data from (see readme for formatting details)",
+					Usage:     "csv file to export candle data to, .gz files are compressed",
 					TakesFile: true,
-					FilePath:  workingDir,
 				},
-			},
-			Action: seedCandleFromFile,
+			),
+			Action: exportCandleToFile,
 		},
 	},
 }
 
+func parseCandleArgs(c *cli.Context) (exchangeName, base, quote string, interval int64, asset string, err error) {
+	exchangeName = stringFlagOrArg(c, "exchange", 0)
+	base = stringFlagOrArg(c, "base", 1)
+	quote = stringFlagOrArg(c, "quote", 2)
+	if c.IsSet("interval") {
+		interval = c.Int64("interval")
+	} else if c.Args().Get(3) != "" {
+		interval, err = strconv.ParseInt(c.Args().Get(3), 10, 64)
+		if err != nil {
+			return "", "", "", 0, "", errors.New("failed to convert interval")
+		}
+	}
+	asset = stringFlagOrArg(c, "asset", 4)
+	return exchangeName, base, quote, interval, asset, nil
+}
+
 func seedCandleFromFile(c *cli.Context) error {
 	if c.NumFlags() == 0 && c.NArg() == 0 {
 		return cli.ShowSubcommandHelp(c)
 	}
 
-	var exchangeName string
-	if c.IsSet("exchange") {
-		exchangeName = c.String("exchange")
-	} else if c.Args().Get(0) != "" {
-		exchangeName = c.Args().Get(0)
+	exchangeName, base, quote, interval, asset, err := parseCandleArgs(c)
+	if err != nil {
+		return err
 	}
 
-	var base string
-	if c.IsSet("base") {
-		base = c.String("base")
-	} else if c.Args().Get(1) != "" {
-		base = c.Args().Get(1)
+	r, err := openCSV(stringFlagOrArg(c, "filename", 5))
+	if err != nil {
+		return err
 	}
+	defer func() {
+		if errClose := r.Close(); errClose != nil {
+			log.Println(errClose)
+		}
+	}()
 
-	var quote string
-	if c.IsSet("quote") {
-		quote = c.String("quote")
-	} else if c.Args().Get(2) != "" {
-		quote = c.Args().Get(2)
+	err = load(c)
+	if err != nil {
+		return err
 	}
 
-	var interval int64
-	if c.IsSet("interval") {
-		interval = c.Int64("interval")
-	} else if c.Args().Get(3) != "" {
-		var err error
-		interval, err = strconv.ParseInt(c.Args().Get(3), 10, 64)
-		if err != nil {
-			return errors.New("failed to convert interval")
-		}
+	totalInserted, err := candle.ImportCSV(r, exchangeName, base, quote, interval, asset)
+	if err != nil {
+		return err
 	}
 
-	var asset string
-	if c.IsSet("asset") {
-		asset = c.String("asset")
-	} else if c.Args().Get(4) != "" {
-		asset = c.Args().Get(4)
+	log.Printf("Inserted: %v records", totalInserted)
+	return nil
+}
+
+func exportCandleToFile(c *cli.Context) error {
+	if c.NumFlags() == 0 && c.NArg() == 0 {
+		return cli.ShowSubcommandHelp(c)
 	}
 
-	var fileName string
-	if c.IsSet("filename") {
-		fileName = c.String("filename")
-	} else if c.Args().Get(5) != "" {
-		fileName = c.Args().Get(5)
+	exchangeName, base, quote, interval, asset, err := parseCandleArgs(c)
+	if err != nil {
+		return err
 	}
 
-	_, err := os.Stat(fileName)
+	start, end, err := parseTimeRange(stringFlagOrArg(c, "start", 5), stringFlagOrArg(c, "end", 6))
 	if err != nil {
 		return err
 	}
 
+	fileName := stringFlagOrArg(c, "filename", 7)
+	if fileName == "" {
+		return errFilenameUnset
+	}
+
 	err = load(c)
 	if err != nil {
 		return err
 	}
 
-	totalInserted, err := candle.InsertFromCSV(exchangeName,
-		base, quote, interval, asset,
-		fileName)
+	w, err := createCSV(fileName)
+	if err != nil {
+		return err
+	}
+	totalExported, err := candle.ExportCSV(w, exchangeName, base, quote, interval, asset, start, end)
+	if errClose := w.Close(); err == nil {
+		err = errClose
+	}
 	if err != nil {
 		return err
 	}
 
-	log.Printf("Inserted: %v records", totalInserted)
+	log.Printf("Exported: %v records", totalExported)
 	return nil
 }
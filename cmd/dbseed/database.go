@@ -47,7 +47,7 @@ func openDBConnection(c *cli.Context, cfg *database.Config) (err error) {
 	if c.IsSet("verbose") {
 		boil.DebugMode = true
 	}
-	if cfg.Driver == database.DBPostgreSQL {
+	if cfg.Driver == database.DBPostgreSQL || cfg.Driver == database.DBTimescaleDB {
 		dbConn, err = dbPSQL.Connect(cfg)
 		if err != nil {
 			return fmt.Errorf("database failed to connect: %v, some features that utilise a database will be unavailable", err)
@@ -1,7 +1,9 @@
 package main
 
 import (
+	"errors"
 	"flag"
+	"io"
 	"path/filepath"
 	"testing"
 
@@ -30,6 +32,7 @@ var (
 		Commands: []*cli.Command{
 			seedExchangeCommand,
 			seedCandleCommand,
+			seedTradeCommand,
 		},
 	}
 )
@@ -42,3 +45,51 @@ func TestLoad(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestCSVCompression(t *testing.T) {
+	t.Parallel()
+	for _, name := range []string{"data.csv", "data.csv.gz"} {
+		fileName := filepath.Join(t.TempDir(), name)
+		w, err := createCSV(fileName)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = w.Write([]byte("1546300800,1,2,3,1,2\n")); err != nil {
+			t.Fatal(err)
+		}
+		if err = w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		r, err := openCSV(fileName)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := io.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = r.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != "1546300800,1,2,3,1,2\n" {
+			t.Errorf("%s received '%s'", name, data)
+		}
+	}
+}
+
+func TestParseTimeRange(t *testing.T) {
+	t.Parallel()
+	if _, _, err := parseTimeRange("2020-01-02 00:00:00", "2020-01-01 00:00:00"); !errors.Is(err, errInvalidTimeSpan) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidTimeSpan)
+	}
+	if _, _, err := parseTimeRange("bad", ""); err == nil {
+		t.Error("expected error for invalid start date")
+	}
+	s, e, err := parseTimeRange("2020-01-01 00:00:00", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.IsZero() || !s.Before(e) {
+		t.Errorf("unexpected time range %v %v", s, e)
+	}
+}
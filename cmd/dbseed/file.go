@@ -0,0 +1,110 @@
+package main
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/urfave/cli/v2"
+)
+
+// gzipExtension denotes a gzip compressed file
+const gzipExtension = ".gz"
+
+var (
+	errFilenameUnset   = errors.New("filename unset")
+	errInvalidTimeSpan = errors.New("start date must be before end date")
+)
+
+type gzipReadCloser struct {
+	*gzip.Reader
+	f *os.File
+}
+
+func (g *gzipReadCloser) Close() error {
+	if err := g.Reader.Close(); err != nil {
+		_ = g.f.Close()
+		return err
+	}
+	return g.f.Close()
+}
+
+type gzipWriteCloser struct {
+	*gzip.Writer
+	f *os.File
+}
+
+func (g *gzipWriteCloser) Close() error {
+	if err := g.Writer.Close(); err != nil {
+		_ = g.f.Close()
+		return err
+	}
+	return g.f.Close()
+}
+
+// openCSV opens a CSV file for reading, decompressing it when the file name
+// ends in .gz
+func openCSV(fileName string) (io.ReadCloser, error) {
+	f, err := os.Open(fileName)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasSuffix(strings.ToLower(fileName), gzipExtension) {
+		return f, nil
+	}
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	return &gzipReadCloser{Reader: gz, f: f}, nil
+}
+
+// createCSV creates a CSV file for writing, compressing it when the file name
+// ends in .gz
+func createCSV(fileName string) (io.WriteCloser, error) {
+	f, err := file.Writer(fileName)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasSuffix(strings.ToLower(fileName), gzipExtension) {
+		return f, nil
+	}
+	return &gzipWriteCloser{Writer: gzip.NewWriter(f), f: f}, nil
+}
+
+// stringFlagOrArg returns the value of the named flag or falls back to the
+// positional argument at the supplied index
+func stringFlagOrArg(c *cli.Context, name string, index int) string {
+	if c.IsSet(name) {
+		return c.String(name)
+	}
+	return c.Args().Get(index)
+}
+
+// parseTimeRange parses start and end dates in the common.SimpleTimeFormat,
+// the end date defaults to now when unset
+func parseTimeRange(start, end string) (s, e time.Time, err error) {
+	s, err = time.Parse(common.SimpleTimeFormat, start)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid start date: %w", err)
+	}
+	if end == "" {
+		e = time.Now().UTC()
+	} else {
+		e, err = time.Parse(common.SimpleTimeFormat, end)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid end date: %w", err)
+		}
+	}
+	if !s.Before(e) {
+		return time.Time{}, time.Time{}, errInvalidTimeSpan
+	}
+	return s, e, nil
+}
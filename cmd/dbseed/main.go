@@ -32,6 +32,7 @@ var (
 		Commands: []*cli.Command{
 			seedExchangeCommand,
 			seedCandleCommand,
+			seedTradeCommand,
 		},
 	}
 	workingDir string
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"log"
+
+	"github.com/thrasher-corp/gocryptotrader/database/repository/trade"
+	"github.com/urfave/cli/v2"
+)
+
+var tradeFlags = []cli.Flag{
+	&cli.StringFlag{
+		Name:  "exchange",
+		Usage: "exchange name of trade data",
+	},
+	&cli.StringFlag{
+		Name:  "base",
+		Usage: "base currency of trade data",
+	},
+	&cli.StringFlag{
+		Name:  "quote",
+		Usage: "quote currency of trade data",
+	},
+	&cli.StringFlag{
+		Name:  "asset",
+		Usage: "asset type of trade data (spot/margin/futures for example)",
+	},
+}
+
+var seedTradeCommand = &cli.Command{
+	Name:  "trade",
+	Usage: "seed trade data",
+	Subcommands: []*cli.Command{
+		{
+			Name:  "file",
+			Usage: "seed trade data from a file",
+			Flags: append(tradeFlags,
+				&cli.StringFlag{
+					Name:      "filename",
+					Usage:     "csv file to load trade data from, .gz files are decompressed (see readme for formatting details)",
+					TakesFile: true,
+					FilePath:  workingDir,
+				},
+			),
+			Action: seedTradeFromFile,
+		},
+		{
+			Name:  "export",
+			Usage: "export trade data to a file",
+			Flags: append(tradeFlags,
+				&cli.StringFlag{
+					Name:  "start",
+					Usage: "start date of trade data to export",
+				},
+				&cli.StringFlag{
+					Name:  "end",
+					Usage: "end date of trade data to export",
+				},
+				&cli.StringFlag{
+					Name:      "filename",
+					Usage:     "csv file to export trade data to, .gz files are compressed",
+					TakesFile: true,
+				},
+			),
+			Action: exportTradeToFile,
+		},
+	},
+}
+
+func seedTradeFromFile(c *cli.Context) error {
+	if c.NumFlags() == 0 && c.NArg() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	r, err := openCSV(stringFlagOrArg(c, "filename", 4))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if errClose := r.Close(); errClose != nil {
+			log.Println(errClose)
+		}
+	}()
+
+	err = load(c)
+	if err != nil {
+		return err
+	}
+
+	result, err := trade.ImportCSV(r,
+		stringFlagOrArg(c, "exchange", 0),
+		stringFlagOrArg(c, "asset", 3),
+		stringFlagOrArg(c, "base", 1),
+		stringFlagOrArg(c, "quote", 2))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Inserted: %v records, skipped %v duplicates", result.Inserted, result.Duplicates)
+	return nil
+}
+
+func exportTradeToFile(c *cli.Context) error {
+	if c.NumFlags() == 0 && c.NArg() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	start, end, err := parseTimeRange(stringFlagOrArg(c, "start", 4), stringFlagOrArg(c, "end", 5))
+	if err != nil {
+		return err
+	}
+
+	fileName := stringFlagOrArg(c, "filename", 6)
+	if fileName == "" {
+		return errFilenameUnset
+	}
+
+	err = load(c)
+	if err != nil {
+		return err
+	}
+
+	w, err := createCSV(fileName)
+	if err != nil {
+		return err
+	}
+	totalExported, err := trade.ExportCSV(w,
+		stringFlagOrArg(c, "exchange", 0),
+		stringFlagOrArg(c, "asset", 3),
+		stringFlagOrArg(c, "base", 1),
+		stringFlagOrArg(c, "quote", 2),
+		start,
+		end)
+	if errClose := w.Close(); err == nil {
+		err = errClose
+	}
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Exported: %v records", totalExported)
+	return nil
+}
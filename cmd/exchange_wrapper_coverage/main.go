@@ -134,6 +134,16 @@ func testWrappers(e exchange.IBotExchange) []string {
 		funcs = append(funcs, "GetFundingHistory")
 	}
 
+	_, err = e.GetWithdrawalStatus(context.TODO(), currency.Code{}, "")
+	if errors.Is(err, common.ErrNotYetImplemented) {
+		funcs = append(funcs, "GetWithdrawalStatus")
+	}
+
+	_, err = e.GetDepositStatus(context.TODO(), currency.Code{}, "")
+	if errors.Is(err, common.ErrNotYetImplemented) {
+		funcs = append(funcs, "GetDepositStatus")
+	}
+
 	_, err = e.SubmitOrder(context.TODO(), nil)
 	if errors.Is(err, common.ErrNotYetImplemented) {
 		funcs = append(funcs, "SubmitOrder")
@@ -169,6 +179,11 @@ func testWrappers(e exchange.IBotExchange) []string {
 		funcs = append(funcs, "GetOrderHistory")
 	}
 
+	_, err = e.GetOrderFills(context.TODO(), nil)
+	if errors.Is(err, common.ErrNotYetImplemented) {
+		funcs = append(funcs, "GetOrderFills")
+	}
+
 	_, err = e.GetActiveOrders(context.TODO(), nil)
 	if errors.Is(err, common.ErrNotYetImplemented) {
 		funcs = append(funcs, "GetActiveOrders")
@@ -676,6 +676,25 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 			Response:   jsonifyInterface([]interface{}{getOrderHistoryResponse}),
 		})
 
+		fillsRequest := order.GetFillsRequest{
+			OrderID:   config.OrderSubmission.OrderID,
+			Pair:      p,
+			AssetType: assetTypes[i],
+		}
+		var getOrderFillsResponse []order.Fill
+		getOrderFillsResponse, err = e.GetOrderFills(context.TODO(), &fillsRequest)
+		msg = ""
+		if err != nil {
+			msg = err.Error()
+			responseContainer.ErrorCount++
+		}
+		responseContainer.EndpointResponses = append(responseContainer.EndpointResponses, EndpointResponse{
+			SentParams: jsonifyInterface([]interface{}{fillsRequest}),
+			Function:   "GetOrderFills",
+			Error:      msg,
+			Response:   jsonifyInterface([]interface{}{getOrderFillsResponse}),
+		})
+
 		orderRequest := order.GetOrdersRequest{
 			Type:  testOrderType,
 			Side:  testOrderSide,
@@ -0,0 +1,239 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/btrpc"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/grpc"
+)
+
+var errMissingRunID = errors.New("run id must be set")
+
+var backtesterCommands = &cli.Command{
+	Name:      "backtester",
+	Usage:     "manage strategy runs on a backtester running with the -rpcserver flag",
+	ArgsUsage: "<command> <args>",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "backtesterhost",
+			Value: "localhost:9054",
+			Usage: "the backtester gRPC host to connect to, authentication uses the global rpcuser, rpcpassword and cert flags",
+		},
+	},
+	Subcommands: []*cli.Command{
+		{
+			Name:      "executestrategy",
+			Usage:     "submits a .strat config to be run by the backtester",
+			ArgsUsage: "<path>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "path",
+					Usage: "the path to the .strat config file",
+				},
+				&cli.BoolFlag{
+					Name:  "generatereport",
+					Usage: "whether to generate the report file",
+					Value: true,
+				},
+				&cli.BoolFlag{
+					Name:  "darkreport",
+					Usage: "sets the report to use a dark theme",
+				},
+			},
+			Action: executeStrategyFromConfig,
+		},
+		{
+			Name:   "listruns",
+			Usage:  "lists all queued, running and finished strategy runs",
+			Action: listAllBacktesterRuns,
+		},
+		{
+			Name:      "runprogress",
+			Usage:     "streams the progress of a strategy run until it finishes",
+			ArgsUsage: "<id>",
+			Flags: []cli.Flag{
+				runIDFlag,
+			},
+			Action: getBacktesterRunProgress,
+		},
+		{
+			Name:      "getreport",
+			Usage:     "downloads the report artifacts of a finished strategy run",
+			ArgsUsage: "<id> <output>",
+			Flags: []cli.Flag{
+				runIDFlag,
+				&cli.StringFlag{
+					Name:  "output",
+					Usage: "the directory to write the report artifacts to",
+					Value: ".",
+				},
+			},
+			Action: getBacktesterRunReport,
+		},
+	},
+}
+
+var runIDFlag = &cli.StringFlag{
+	Name:  "id",
+	Usage: "the id of the strategy run",
+}
+
+// setupBacktesterClient connects to the backtester gRPC server rather than
+// the GoCryptoTrader daemon
+func setupBacktesterClient(c *cli.Context) (*grpc.ClientConn, context.CancelFunc, error) {
+	host = c.String("backtesterhost")
+	return setupClient(c)
+}
+
+func executeStrategyFromConfig(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, c.Command.Name)
+	}
+
+	var path string
+	if c.IsSet("path") {
+		path = c.String("path")
+	} else {
+		path = c.Args().First()
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupBacktesterClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := btrpc.NewBacktesterServiceClient(conn)
+	result, err := client.ExecuteStrategyFromConfig(c.Context,
+		&btrpc.ExecuteStrategyFromConfigRequest{
+			Config:         data,
+			GenerateReport: c.Bool("generatereport"),
+			DarkReport:     c.Bool("darkreport"),
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func listAllBacktesterRuns(c *cli.Context) error {
+	conn, cancel, err := setupBacktesterClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := btrpc.NewBacktesterServiceClient(conn)
+	result, err := client.ListAllRuns(c.Context, &btrpc.ListAllRunsRequest{})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getBacktesterRunProgress(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, c.Command.Name)
+	}
+
+	id := c.String("id")
+	if !c.IsSet("id") {
+		id = c.Args().First()
+	}
+	if id == "" {
+		return errMissingRunID
+	}
+
+	conn, cancel, err := setupBacktesterClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := btrpc.NewBacktesterServiceClient(conn)
+	result, err := client.GetRunProgress(c.Context, &btrpc.GetRunProgressRequest{Id: id})
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+
+		err = clearScreen()
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Progress for run %s:\n\n", id)
+		jsonOutput(resp)
+	}
+}
+
+func getBacktesterRunReport(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, c.Command.Name)
+	}
+
+	id := c.String("id")
+	if !c.IsSet("id") {
+		id = c.Args().First()
+	}
+	if id == "" {
+		return errMissingRunID
+	}
+
+	output := c.String("output")
+	if !c.IsSet("output") && c.Args().Get(1) != "" {
+		output = c.Args().Get(1)
+	}
+
+	conn, cancel, err := setupBacktesterClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := btrpc.NewBacktesterServiceClient(conn)
+	result, err := client.GetRunReport(c.Context, &btrpc.GetRunReportRequest{Id: id})
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(output, 0770)
+	if err != nil {
+		return err
+	}
+	for i := range result.Artifacts {
+		// artifact names are reduced to their base name so a server cannot
+		// write outside of the output directory
+		path := filepath.Join(output, filepath.Base(result.Artifacts[i].Name))
+		err = ioutil.WriteFile(path, result.Artifacts[i].Data, 0770)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Saved %s\n", path)
+	}
+	return nil
+}
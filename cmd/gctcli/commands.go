@@ -473,6 +473,120 @@ func getExchangeInfo(c *cli.Context) error {
 	return nil
 }
 
+var getCapabilityGapsCommand = &cli.Command{
+	Name:      "getcapabilitygaps",
+	Usage:     "gets the exchange wrapper functions which are not yet implemented or not supported, for all enabled exchanges if none is specified",
+	ArgsUsage: "<exchange>",
+	Action:    getCapabilityGaps,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the capability gaps for",
+		},
+	},
+}
+
+func getCapabilityGaps(c *cli.Context) error {
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetCapabilityGaps(c.Context,
+		&gctrpc.GetCapabilityGapsRequest{
+			Exchange: exchangeName,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getExchangeClockSkewCommand = &cli.Command{
+	Name:      "getexchangeclockskew",
+	Usage:     "gets how far an exchange server clock is ahead of the local clock, optionally measuring it first",
+	ArgsUsage: "<exchange> <asset> <sync>",
+	Action:    getExchangeClockSkew,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the clock skew for",
+		},
+		&cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset server time to measure against, defaults to spot",
+		},
+		&cli.BoolFlag{
+			Name:  "sync",
+			Usage: "measures the clock skew against the exchange server time before returning it",
+		},
+	},
+}
+
+func getExchangeClockSkew(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "getexchangeclockskew")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(1)
+	}
+
+	var sync bool
+	if c.IsSet("sync") {
+		sync = c.Bool("sync")
+	} else if c.Args().Get(2) != "" {
+		b, err := strconv.ParseBool(c.Args().Get(2))
+		if err != nil {
+			return err
+		}
+		sync = b
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetExchangeClockSkew(c.Context,
+		&gctrpc.GetExchangeClockSkewRequest{
+			Exchange: exchangeName,
+			Asset:    assetType,
+			Sync:     sync,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
 var getTickerCommand = &cli.Command{
 	Name:      "getticker",
 	Usage:     "gets the ticker for a specific currency pair and exchange",
@@ -958,91 +1072,99 @@ func getPortfolioSummary(c *cli.Context) error {
 	return nil
 }
 
-var addPortfolioAddressCommand = &cli.Command{
-	Name:      "addportfolioaddress",
-	Usage:     "adds an address to the portfolio",
-	ArgsUsage: "<address> <coin_type> <description> <balance> <cold_storage> <supported_exchanges> ",
-	Action:    addPortfolioAddress,
+var getPortfolioPnLCommand = &cli.Command{
+	Name:      "getportfoliopnl",
+	Usage:     "gets the realised and unrealised profit and loss of positions tracked by the portfolio analytics manager",
+	ArgsUsage: "<exchange> <asset> <pair>",
+	Action:    getPortfolioPnL,
 	Flags: []cli.Flag{
 		&cli.StringFlag{
-			Name:  "address",
-			Usage: "the address to add to the portfolio",
-		},
-		&cli.StringFlag{
-			Name:  "coin_type",
-			Usage: "the coin type e.g ('BTC')",
+			Name:  "exchange",
+			Usage: "the exchange to filter positions by, optional",
 		},
 		&cli.StringFlag{
-			Name:  "description",
-			Usage: "description of the address",
-		},
-		&cli.Float64Flag{
-			Name:  "balance",
-			Usage: "balance of the address",
-		},
-		&cli.BoolFlag{
-			Name:  "cold_storage",
-			Usage: "true/false if address is cold storage",
+			Name:  "asset",
+			Usage: "the asset type to filter positions by, optional",
 		},
 		&cli.StringFlag{
-			Name:  "supported_exchanges",
-			Usage: "common separated list of exchanges supported by this address for withdrawals",
+			Name:  "pair",
+			Usage: "the currency pair to filter positions by, optional",
 		},
 	},
 }
 
-func addPortfolioAddress(c *cli.Context) error {
-	if c.NArg() == 0 && c.NumFlags() == 0 {
-		return cli.ShowCommandHelp(c, "addportfolioaddress")
+func getPortfolioPnL(c *cli.Context) error {
+	exchangeName := c.String("exchange")
+	if !c.IsSet("exchange") {
+		exchangeName = c.Args().First()
 	}
 
-	var address string
-	var coinType string
-	var description string
-	var balance float64
-	var supportedExchanges string
-	var coldstorage bool
-
-	if c.IsSet("address") {
-		address = c.String("address")
-	} else {
-		address = c.Args().First()
+	assetType := c.String("asset")
+	if !c.IsSet("asset") {
+		assetType = c.Args().Get(1)
 	}
-
-	if c.IsSet("coin_type") {
-		coinType = c.String("coin_type")
-	} else {
-		coinType = c.Args().Get(1)
+	assetType = strings.ToLower(assetType)
+	if assetType != "" && !validAsset(assetType) {
+		return errInvalidAsset
 	}
 
-	if c.IsSet("description") {
-		description = c.String("description")
-	} else {
-		description = c.Args().Get(2)
+	currencyPair := c.String("pair")
+	if !c.IsSet("pair") {
+		currencyPair = c.Args().Get(2)
 	}
-	var err error
-	if c.IsSet("balance") {
-		balance = c.Float64("balance")
-	} else if c.Args().Get(3) != "" {
-		balance, err = strconv.ParseFloat(c.Args().Get(3), 64)
+	var pair *gctrpc.CurrencyPair
+	if currencyPair != "" {
+		if !validPair(currencyPair) {
+			return errInvalidPair
+		}
+		p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
 		if err != nil {
 			return err
 		}
+		pair = &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		}
 	}
 
-	if c.IsSet("cold_storage") {
-		coldstorage = c.Bool("cold_storage")
-	} else {
-		tv, errBool := strconv.ParseBool(c.Args().Get(4))
-		if errBool == nil {
-			coldstorage = tv
-		}
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
 	}
+	defer closeConn(conn, cancel)
 
-	if c.IsSet("supported_exchanges") {
-		supportedExchanges = c.String("supported_exchanges")
-	} else {
-		supportedExchanges = c.Args().Get(5)
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetPortfolioPnL(c.Context, &gctrpc.GetPortfolioPnLRequest{
+		Exchange: exchangeName,
+		Asset:    assetType,
+		Pair:     pair,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getPortfolioPerformanceCommand = &cli.Command{
+	Name:      "getportfolioperformance",
+	Usage:     "gets the profit and loss and win rate of tracked positions grouped by exchange and quote currency",
+	ArgsUsage: "<exchange>",
+	Action:    getPortfolioPerformance,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to filter performance by, optional",
+		},
+	},
+}
+
+func getPortfolioPerformance(c *cli.Context) error {
+	exchangeName := c.String("exchange")
+	if !c.IsSet("exchange") {
+		exchangeName = c.Args().First()
 	}
 
 	conn, cancel, err := setupClient(c)
@@ -1052,17 +1174,9 @@ func addPortfolioAddress(c *cli.Context) error {
 	defer closeConn(conn, cancel)
 
 	client := gctrpc.NewGoCryptoTraderClient(conn)
-	result, err := client.AddPortfolioAddress(c.Context,
-		&gctrpc.AddPortfolioAddressRequest{
-			Address:            address,
-			CoinType:           coinType,
-			Description:        description,
-			Balance:            balance,
-			SupportedExchanges: supportedExchanges,
-			ColdStorage:        coldstorage,
-		},
-	)
-
+	result, err := client.GetPortfolioPerformance(c.Context, &gctrpc.GetPortfolioPerformanceRequest{
+		Exchange: exchangeName,
+	})
 	if err != nil {
 		return err
 	}
@@ -1071,52 +1185,60 @@ func addPortfolioAddress(c *cli.Context) error {
 	return nil
 }
 
-var removePortfolioAddressCommand = &cli.Command{
-	Name:      "removeportfolioaddress",
-	Usage:     "removes an address from the portfolio",
-	ArgsUsage: "<address> <coin_type> <description>",
-	Action:    removePortfolioAddress,
+var getDerivativePositionsCommand = &cli.Command{
+	Name:      "getderivativepositions",
+	Usage:     "gets the live derivative positions, margin ratios and profit and loss tracked by the positions manager",
+	ArgsUsage: "<exchange> <asset> <pair>",
+	Action:    getDerivativePositions,
 	Flags: []cli.Flag{
 		&cli.StringFlag{
-			Name:  "address",
-			Usage: "the address to add to the portfolio",
+			Name:  "exchange",
+			Usage: "the exchange to filter positions by, optional",
 		},
 		&cli.StringFlag{
-			Name:  "coin_type",
-			Usage: "the coin type e.g ('BTC')",
+			Name:  "asset",
+			Usage: "the asset type to filter positions by, optional",
 		},
 		&cli.StringFlag{
-			Name:  "description",
-			Usage: "description of the address",
+			Name:  "pair",
+			Usage: "the currency pair to filter positions by, optional",
 		},
 	},
 }
 
-func removePortfolioAddress(c *cli.Context) error {
-	if c.NArg() == 0 && c.NumFlags() == 0 {
-		return cli.ShowCommandHelp(c, "removeportfolioaddress")
+func getDerivativePositions(c *cli.Context) error {
+	exchangeName := c.String("exchange")
+	if !c.IsSet("exchange") {
+		exchangeName = c.Args().First()
 	}
 
-	var address string
-	var coinType string
-	var description string
-
-	if c.IsSet("address") {
-		address = c.String("address")
-	} else {
-		address = c.Args().First()
+	assetType := c.String("asset")
+	if !c.IsSet("asset") {
+		assetType = c.Args().Get(1)
 	}
-
-	if c.IsSet("coin_type") {
-		coinType = c.String("coin_type")
-	} else {
-		coinType = c.Args().Get(1)
+	assetType = strings.ToLower(assetType)
+	if assetType != "" && !validAsset(assetType) {
+		return errInvalidAsset
 	}
 
-	if c.IsSet("description") {
-		description = c.String("description")
-	} else {
-		description = c.Args().Get(2)
+	currencyPair := c.String("pair")
+	if !c.IsSet("pair") {
+		currencyPair = c.Args().Get(2)
+	}
+	var pair *gctrpc.CurrencyPair
+	if currencyPair != "" {
+		if !validPair(currencyPair) {
+			return errInvalidPair
+		}
+		p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+		if err != nil {
+			return err
+		}
+		pair = &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		}
 	}
 
 	conn, cancel, err := setupClient(c)
@@ -1126,14 +1248,11 @@ func removePortfolioAddress(c *cli.Context) error {
 	defer closeConn(conn, cancel)
 
 	client := gctrpc.NewGoCryptoTraderClient(conn)
-	result, err := client.RemovePortfolioAddress(c.Context,
-		&gctrpc.RemovePortfolioAddressRequest{
-			Address:     address,
-			CoinType:    coinType,
-			Description: description,
-		},
-	)
-
+	result, err := client.GetDerivativePositions(c.Context, &gctrpc.GetDerivativePositionsRequest{
+		Exchange: exchangeName,
+		Asset:    assetType,
+		Pair:     pair,
+	})
 	if err != nil {
 		return err
 	}
@@ -1142,13 +1261,37 @@ func removePortfolioAddress(c *cli.Context) error {
 	return nil
 }
 
-var getForexProvidersCommand = &cli.Command{
-	Name:   "getforexproviders",
-	Usage:  "gets the available forex providers",
-	Action: getForexProviders,
+var getLeverageCommand = &cli.Command{
+	Name:      "getleverage",
+	Usage:     "gets the leverage and margin mode of a derivatives asset and currency pair",
+	ArgsUsage: "<exchange> <asset> <pair>",
+	Action:    getLeverage,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the leverage for",
+		},
+		&cli.StringFlag{
+			Name:  "asset",
+			Usage: "the derivatives asset type of the currency pair",
+		},
+		&cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair to get the leverage for",
+		},
+	},
 }
 
-func getForexProviders(c *cli.Context) error {
+func getLeverage(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "getleverage")
+	}
+
+	exchangeName, assetType, pair, err := parseLeverageArgs(c)
+	if err != nil {
+		return err
+	}
+
 	conn, cancel, err := setupClient(c)
 	if err != nil {
 		return err
@@ -1156,7 +1299,11 @@ func getForexProviders(c *cli.Context) error {
 	defer closeConn(conn, cancel)
 
 	client := gctrpc.NewGoCryptoTraderClient(conn)
-	result, err := client.GetForexProviders(c.Context, &gctrpc.GetForexProvidersRequest{})
+	result, err := client.GetLeverage(c.Context, &gctrpc.GetLeverageRequest{
+		Exchange: exchangeName,
+		Asset:    assetType,
+		Pair:     pair,
+	})
 	if err != nil {
 		return err
 	}
@@ -1165,13 +1312,64 @@ func getForexProviders(c *cli.Context) error {
 	return nil
 }
 
-var getForexRatesCommand = &cli.Command{
-	Name:   "getforexrates",
-	Usage:  "gets forex rates",
-	Action: getForexRates,
+var setLeverageCommand = &cli.Command{
+	Name:      "setleverage",
+	Usage:     "sets the leverage and or margin mode of a derivatives asset and currency pair",
+	ArgsUsage: "<exchange> <asset> <pair> <leverage> <margin_mode>",
+	Action:    setLeverage,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to set the leverage for",
+		},
+		&cli.StringFlag{
+			Name:  "asset",
+			Usage: "the derivatives asset type of the currency pair",
+		},
+		&cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair to set the leverage for",
+		},
+		&cli.Float64Flag{
+			Name:  "leverage",
+			Usage: "the leverage to set, optional if margin_mode is set",
+		},
+		&cli.StringFlag{
+			Name:  "margin_mode",
+			Usage: "the margin mode to set, either cross or isolated, optional if leverage is set",
+		},
+	},
 }
 
-func getForexRates(c *cli.Context) error {
+func setLeverage(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "setleverage")
+	}
+
+	exchangeName, assetType, pair, err := parseLeverageArgs(c)
+	if err != nil {
+		return err
+	}
+
+	var leverage float64
+	if c.IsSet("leverage") {
+		leverage = c.Float64("leverage")
+	} else if c.Args().Get(3) != "" {
+		leverage, err = strconv.ParseFloat(c.Args().Get(3), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	marginMode := c.String("margin_mode")
+	if !c.IsSet("margin_mode") {
+		marginMode = c.Args().Get(4)
+	}
+
+	if leverage <= 0 && marginMode == "" {
+		return errors.New("either --leverage or --margin_mode should be present")
+	}
+
 	conn, cancel, err := setupClient(c)
 	if err != nil {
 		return err
@@ -1179,7 +1377,13 @@ func getForexRates(c *cli.Context) error {
 	defer closeConn(conn, cancel)
 
 	client := gctrpc.NewGoCryptoTraderClient(conn)
-	result, err := client.GetForexRates(c.Context, &gctrpc.GetForexRatesRequest{})
+	result, err := client.SetLeverage(c.Context, &gctrpc.SetLeverageRequest{
+		Exchange:   exchangeName,
+		Asset:      assetType,
+		Pair:       pair,
+		Leverage:   leverage,
+		MarginMode: marginMode,
+	})
 	if err != nil {
 		return err
 	}
@@ -1188,103 +1392,1001 @@ func getForexRates(c *cli.Context) error {
 	return nil
 }
 
-var getOrdersCommand = &cli.Command{
-	Name:      "getorders",
-	Usage:     "gets the open orders",
-	ArgsUsage: "<exchange> <asset> <pair> <start> <end>",
-	Action:    getOrders,
+// parseLeverageArgs parses the exchange, asset and currency pair shared by the
+// leverage commands
+func parseLeverageArgs(c *cli.Context) (string, string, *gctrpc.CurrencyPair, error) {
+	exchangeName := c.String("exchange")
+	if !c.IsSet("exchange") {
+		exchangeName = c.Args().First()
+	}
+
+	assetType := c.String("asset")
+	if !c.IsSet("asset") {
+		assetType = c.Args().Get(1)
+	}
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return "", "", nil, errInvalidAsset
+	}
+
+	currencyPair := c.String("pair")
+	if !c.IsSet("pair") {
+		currencyPair = c.Args().Get(2)
+	}
+	if !validPair(currencyPair) {
+		return "", "", nil, errInvalidPair
+	}
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return "", "", nil, err
+	}
+	return exchangeName, assetType, &gctrpc.CurrencyPair{
+		Delimiter: p.Delimiter,
+		Base:      p.Base.String(),
+		Quote:     p.Quote.String(),
+	}, nil
+}
+
+var addPortfolioAddressCommand = &cli.Command{
+	Name:      "addportfolioaddress",
+	Usage:     "adds an address to the portfolio",
+	ArgsUsage: "<address> <coin_type> <description> <balance> <cold_storage> <supported_exchanges> ",
+	Action:    addPortfolioAddress,
 	Flags: []cli.Flag{
 		&cli.StringFlag{
-			Name:  "exchange",
-			Usage: "the exchange to get orders for",
+			Name:  "address",
+			Usage: "the address to add to the portfolio",
 		},
 		&cli.StringFlag{
-			Name:  "asset",
-			Usage: "the asset type to get orders for",
+			Name:  "coin_type",
+			Usage: "the coin type e.g ('BTC')",
 		},
 		&cli.StringFlag{
-			Name:  "pair",
-			Usage: "the currency pair to get orders for",
+			Name:  "description",
+			Usage: "description of the address",
 		},
-		&cli.StringFlag{
-			Name:        "start",
-			Usage:       "start date, optional. Will filter any results before this date",
-			Value:       time.Now().AddDate(0, -1, 0).Format(common.SimpleTimeFormat),
-			Destination: &startTime,
+		&cli.Float64Flag{
+			Name:  "balance",
+			Usage: "balance of the address",
+		},
+		&cli.BoolFlag{
+			Name:  "cold_storage",
+			Usage: "true/false if address is cold storage",
 		},
 		&cli.StringFlag{
-			Name:        "end",
-			Usage:       "end date, optional. Will filter any results after this date",
-			Value:       time.Now().Format(common.SimpleTimeFormat),
-			Destination: &endTime,
+			Name:  "supported_exchanges",
+			Usage: "common separated list of exchanges supported by this address for withdrawals",
 		},
 	},
 }
 
-func getOrders(c *cli.Context) error {
+func addPortfolioAddress(c *cli.Context) error {
 	if c.NArg() == 0 && c.NumFlags() == 0 {
-		return cli.ShowCommandHelp(c, "getorders")
+		return cli.ShowCommandHelp(c, "addportfolioaddress")
 	}
 
-	var exchangeName string
-	var assetType string
-	var currencyPair string
+	var address string
+	var coinType string
+	var description string
+	var balance float64
+	var supportedExchanges string
+	var coldstorage bool
 
-	if c.IsSet("exchange") {
-		exchangeName = c.String("exchange")
+	if c.IsSet("address") {
+		address = c.String("address")
 	} else {
-		exchangeName = c.Args().First()
+		address = c.Args().First()
 	}
 
-	if c.IsSet("asset") {
-		assetType = c.String("asset")
+	if c.IsSet("coin_type") {
+		coinType = c.String("coin_type")
 	} else {
-		assetType = c.Args().Get(1)
+		coinType = c.Args().Get(1)
 	}
 
-	assetType = strings.ToLower(assetType)
-	if !validAsset(assetType) {
-		return errInvalidAsset
+	if c.IsSet("description") {
+		description = c.String("description")
+	} else {
+		description = c.Args().Get(2)
+	}
+	var err error
+	if c.IsSet("balance") {
+		balance = c.Float64("balance")
+	} else if c.Args().Get(3) != "" {
+		balance, err = strconv.ParseFloat(c.Args().Get(3), 64)
+		if err != nil {
+			return err
+		}
 	}
 
-	if c.IsSet("pair") {
-		currencyPair = c.String("pair")
+	if c.IsSet("cold_storage") {
+		coldstorage = c.Bool("cold_storage")
 	} else {
-		currencyPair = c.Args().Get(2)
+		tv, errBool := strconv.ParseBool(c.Args().Get(4))
+		if errBool == nil {
+			coldstorage = tv
+		}
 	}
 
-	if !validPair(currencyPair) {
-		return errInvalidPair
+	if c.IsSet("supported_exchanges") {
+		supportedExchanges = c.String("supported_exchanges")
+	} else {
+		supportedExchanges = c.Args().Get(5)
 	}
 
-	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	conn, cancel, err := setupClient(c)
 	if err != nil {
 		return err
 	}
+	defer closeConn(conn, cancel)
 
-	if !c.IsSet("start") {
-		if c.Args().Get(3) != "" {
-			startTime = c.Args().Get(3)
-		}
-	}
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.AddPortfolioAddress(c.Context,
+		&gctrpc.AddPortfolioAddressRequest{
+			Address:            address,
+			CoinType:           coinType,
+			Description:        description,
+			Balance:            balance,
+			SupportedExchanges: supportedExchanges,
+			ColdStorage:        coldstorage,
+		},
+	)
 
-	if !c.IsSet("end") {
-		if c.Args().Get(4) != "" {
-			endTime = c.Args().Get(4)
-		}
-	}
-	var s, e time.Time
-	s, err = time.Parse(common.SimpleTimeFormat, startTime)
 	if err != nil {
-		return fmt.Errorf("invalid time format for start: %v", err)
+		return err
 	}
-	e, err = time.Parse(common.SimpleTimeFormat, endTime)
-	if err != nil {
-		return fmt.Errorf("invalid time format for end: %v", err)
+
+	jsonOutput(result)
+	return nil
+}
+
+var removePortfolioAddressCommand = &cli.Command{
+	Name:      "removeportfolioaddress",
+	Usage:     "removes an address from the portfolio",
+	ArgsUsage: "<address> <coin_type> <description>",
+	Action:    removePortfolioAddress,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "address",
+			Usage: "the address to add to the portfolio",
+		},
+		&cli.StringFlag{
+			Name:  "coin_type",
+			Usage: "the coin type e.g ('BTC')",
+		},
+		&cli.StringFlag{
+			Name:  "description",
+			Usage: "description of the address",
+		},
+	},
+}
+
+func removePortfolioAddress(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "removeportfolioaddress")
 	}
 
-	if e.Before(s) {
-		return errors.New("start cannot be after end")
+	var address string
+	var coinType string
+	var description string
+
+	if c.IsSet("address") {
+		address = c.String("address")
+	} else {
+		address = c.Args().First()
+	}
+
+	if c.IsSet("coin_type") {
+		coinType = c.String("coin_type")
+	} else {
+		coinType = c.Args().Get(1)
+	}
+
+	if c.IsSet("description") {
+		description = c.String("description")
+	} else {
+		description = c.Args().Get(2)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.RemovePortfolioAddress(c.Context,
+		&gctrpc.RemovePortfolioAddressRequest{
+			Address:     address,
+			CoinType:    coinType,
+			Description: description,
+		},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getForexProvidersCommand = &cli.Command{
+	Name:   "getforexproviders",
+	Usage:  "gets the available forex providers",
+	Action: getForexProviders,
+}
+
+func getForexProviders(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetForexProviders(c.Context, &gctrpc.GetForexProvidersRequest{})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getForexRatesCommand = &cli.Command{
+	Name:   "getforexrates",
+	Usage:  "gets forex rates",
+	Action: getForexRates,
+}
+
+func getForexRates(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetForexRates(c.Context, &gctrpc.GetForexRatesRequest{})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getOrdersCommand = &cli.Command{
+	Name:      "getorders",
+	Usage:     "gets the open orders",
+	ArgsUsage: "<exchange> <asset> <pair> <start> <end>",
+	Action:    getOrders,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get orders for",
+		},
+		&cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type to get orders for",
+		},
+		&cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair to get orders for",
+		},
+		&cli.StringFlag{
+			Name:        "start",
+			Usage:       "start date, optional. Will filter any results before this date",
+			Value:       time.Now().AddDate(0, -1, 0).Format(common.SimpleTimeFormat),
+			Destination: &startTime,
+		},
+		&cli.StringFlag{
+			Name:        "end",
+			Usage:       "end date, optional. Will filter any results after this date",
+			Value:       time.Now().Format(common.SimpleTimeFormat),
+			Destination: &endTime,
+		},
+	},
+}
+
+func getOrders(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "getorders")
+	}
+
+	var exchangeName string
+	var assetType string
+	var currencyPair string
+
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(1)
+	}
+
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(2)
+	}
+
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	if !c.IsSet("start") {
+		if c.Args().Get(3) != "" {
+			startTime = c.Args().Get(3)
+		}
+	}
+
+	if !c.IsSet("end") {
+		if c.Args().Get(4) != "" {
+			endTime = c.Args().Get(4)
+		}
+	}
+	var s, e time.Time
+	s, err = time.Parse(common.SimpleTimeFormat, startTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+	e, err = time.Parse(common.SimpleTimeFormat, endTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+
+	if e.Before(s) {
+		return errors.New("start cannot be after end")
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetOrders(c.Context, &gctrpc.GetOrdersRequest{
+		Exchange:  exchangeName,
+		AssetType: assetType,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		},
+		StartDate: negateLocalOffset(s),
+		EndDate:   negateLocalOffset(e),
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getManagedOrdersCommand = &cli.Command{
+	Name:      "getmanagedorders",
+	Usage:     "gets the current orders from the order manager",
+	ArgsUsage: "<exchange> <asset> <pair>",
+	Action:    getManagedOrders,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get orders for",
+		},
+		&cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type to get orders for",
+		},
+		&cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair to get orders for",
+		},
+	},
+}
+
+func getManagedOrders(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "getmanagedorders")
+	}
+
+	var exchangeName string
+	var assetType string
+	var currencyPair string
+
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(1)
+	}
+
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(2)
+	}
+
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetManagedOrders(c.Context, &gctrpc.GetOrdersRequest{
+		Exchange:  exchangeName,
+		AssetType: assetType,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getOrderCommand = &cli.Command{
+	Name:      "getorder",
+	Usage:     "gets the specified order info",
+	ArgsUsage: "<exchange> <asset> <pair> <order_id>",
+	Action:    getOrder,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the order for",
+		},
+		&cli.StringFlag{
+			Name:  "asset",
+			Usage: "required asset type",
+		},
+		&cli.StringFlag{
+			Name:  "pair",
+			Usage: "the pair to retrieve",
+		},
+		&cli.StringFlag{
+			Name:  "order_id",
+			Usage: "the order id to retrieve",
+		},
+	},
+}
+
+func getOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "getorder")
+	}
+
+	var exchangeName string
+	var orderID string
+	var currencyPair string
+	var assetType string
+
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(1)
+	}
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(2)
+	}
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	if c.IsSet("order_id") {
+		orderID = c.String("order_id")
+	} else {
+		orderID = c.Args().Get(3)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetOrder(c.Context, &gctrpc.GetOrderRequest{
+		Exchange: exchangeName,
+		OrderId:  orderID,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		},
+		Asset: assetType,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var submitOrderCommand = &cli.Command{
+	Name:      "submitorder",
+	Usage:     "submit order submits an exchange order",
+	ArgsUsage: "<exchange> <pair> <side> <type> <amount> <price> <client_id>",
+	Action:    submitOrder,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to submit the order for",
+		},
+		&cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair",
+		},
+		&cli.StringFlag{
+			Name:  "side",
+			Usage: "the order side to use (BUY OR SELL)",
+		},
+		&cli.StringFlag{
+			Name:  "type",
+			Usage: "the order type (MARKET OR LIMIT)",
+		},
+		&cli.Float64Flag{
+			Name:  "amount",
+			Usage: "the amount for the order",
+		},
+		&cli.Float64Flag{
+			Name:  "price",
+			Usage: "the price for the order",
+		},
+		&cli.StringFlag{
+			Name:  "client_id",
+			Usage: "the optional client order ID",
+		},
+		&cli.StringFlag{
+			Name:  "asset",
+			Usage: "required asset type",
+		},
+	},
+}
+
+func submitOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "submitorder")
+	}
+
+	var exchangeName string
+	var currencyPair string
+	var orderSide string
+	var orderType string
+	var amount float64
+	var price float64
+	var clientID string
+	var assetType string
+
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	if c.IsSet("side") {
+		orderSide = c.String("side")
+	} else {
+		orderSide = c.Args().Get(2)
+	}
+
+	if orderSide == "" {
+		return errors.New("order side must be set")
+	}
+
+	if c.IsSet("type") {
+		orderType = c.String("type")
+	} else {
+		orderType = c.Args().Get(3)
+	}
+
+	if orderType == "" {
+		return errors.New("order type must be set")
+	}
+
+	if c.IsSet("amount") {
+		amount = c.Float64("amount")
+	} else if c.Args().Get(4) != "" {
+		var err error
+		amount, err = strconv.ParseFloat(c.Args().Get(4), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	if amount == 0 {
+		return errors.New("amount must be set")
+	}
+
+	// price is optional for market orders
+	if c.IsSet("price") {
+		price = c.Float64("price")
+	} else if c.Args().Get(5) != "" {
+		var err error
+		price, err = strconv.ParseFloat(c.Args().Get(5), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	if c.IsSet("client_id") {
+		clientID = c.String("client_id")
+	} else {
+		clientID = c.Args().Get(6)
+	}
+
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(7)
+	}
+
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.SubmitOrder(c.Context, &gctrpc.SubmitOrderRequest{
+		Exchange: exchangeName,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		},
+		Side:      orderSide,
+		OrderType: orderType,
+		Amount:    amount,
+		Price:     price,
+		ClientId:  clientID,
+		AssetType: assetType,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var simulateOrderCommand = &cli.Command{
+	Name:      "simulateorder",
+	Usage:     "simulate order simulates an exchange order",
+	ArgsUsage: "<exchange> <pair> <side> <amount>",
+	Action:    simulateOrder,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to simulate the order for",
+		},
+		&cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair",
+		},
+		&cli.StringFlag{
+			Name:  "side",
+			Usage: "the order side to use (BUY OR SELL)",
+		},
+		&cli.Float64Flag{
+			Name:  "amount",
+			Usage: "the amount for the order",
+		},
+	},
+}
+
+func simulateOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "simulateorder")
+	}
+
+	var exchangeName string
+	var currencyPair string
+	var orderSide string
+	var amount float64
+
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	if c.IsSet("side") {
+		orderSide = c.String("side")
+	} else {
+		orderSide = c.Args().Get(2)
+	}
+
+	if orderSide == "" {
+		return errors.New("side must be set")
+	}
+
+	if c.IsSet("amount") {
+		amount = c.Float64("amount")
+	} else if c.Args().Get(3) != "" {
+		var err error
+		amount, err = strconv.ParseFloat(c.Args().Get(3), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	if amount == 0 {
+		return errors.New("amount must be set")
+	}
+
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.SimulateOrder(c.Context, &gctrpc.SimulateOrderRequest{
+		Exchange: exchangeName,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		},
+		Side:   orderSide,
+		Amount: amount,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getConsolidatedOrderbookCommand = &cli.Command{
+	Name:      "getconsolidatedorderbook",
+	Usage:     "gets the orderbook of a currency pair merged across all enabled exchanges",
+	ArgsUsage: "<pair> <asset>",
+	Action:    getConsolidatedOrderbook,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair",
+		},
+		&cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the currency pair",
+		},
+		&cli.StringFlag{
+			Name:  "exchanges",
+			Usage: "an optional comma separated list of exchanges to restrict the orderbook to",
+		},
+		&cli.BoolFlag{
+			Name:  "feeadjusted",
+			Usage: "adjusts each level's price by the exchange's taker fee",
+		},
+		&cli.Float64Flag{
+			Name:  "feeamount",
+			Usage: "the amount of the base currency used when retrieving taker fees, defaults to 1",
+		},
+		&cli.Int64Flag{
+			Name:  "depth",
+			Usage: "the maximum number of levels to return per side, 0 returns all levels",
+		},
+	},
+}
+
+func getConsolidatedOrderbook(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "getconsolidatedorderbook")
+	}
+
+	currencyPair := c.String("pair")
+	if !c.IsSet("pair") {
+		currencyPair = c.Args().First()
+	}
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	assetType := c.String("asset")
+	if !c.IsSet("asset") {
+		assetType = c.Args().Get(1)
+	}
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	var exchanges []string
+	if c.IsSet("exchanges") {
+		exchanges = strings.Split(c.String("exchanges"), ",")
+	}
+
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetConsolidatedOrderbook(c.Context, &gctrpc.GetConsolidatedOrderbookRequest{
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		},
+		AssetType:   assetType,
+		Exchanges:   exchanges,
+		FeeAdjusted: c.Bool("feeadjusted"),
+		FeeAmount:   c.Float64("feeamount"),
+		Depth:       c.Int64("depth"),
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getArbitrageOpportunitiesCommand = &cli.Command{
+	Name:      "getarbitrageopportunities",
+	Usage:     "gets the arbitrage opportunities found by the arbitrage scanner's latest scan",
+	ArgsUsage: "<exchange>",
+	Action:    getArbitrageOpportunities,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "exchange",
+			Usage: "an optional exchange to filter opportunities buying or selling on",
+		},
+	},
+}
+
+func getArbitrageOpportunities(c *cli.Context) error {
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetArbitrageOpportunities(c.Context,
+		&gctrpc.GetArbitrageOpportunitiesRequest{
+			Exchange: exchangeName,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getTriangularArbitrageCommand = &cli.Command{
+	Name:      "gettriangulararbitrage",
+	Usage:     "gets the triangular arbitrage opportunities found by the triangular arbitrage detector's latest scan",
+	ArgsUsage: "<exchange>",
+	Action:    getTriangularArbitrage,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "exchange",
+			Usage: "an optional exchange to filter opportunities by",
+		},
+	},
+}
+
+func getTriangularArbitrage(c *cli.Context) error {
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
 	}
 
 	conn, cancel, err := setupClient(c)
@@ -1294,17 +2396,11 @@ func getOrders(c *cli.Context) error {
 	defer closeConn(conn, cancel)
 
 	client := gctrpc.NewGoCryptoTraderClient(conn)
-	result, err := client.GetOrders(c.Context, &gctrpc.GetOrdersRequest{
-		Exchange:  exchangeName,
-		AssetType: assetType,
-		Pair: &gctrpc.CurrencyPair{
-			Delimiter: p.Delimiter,
-			Base:      p.Base.String(),
-			Quote:     p.Quote.String(),
+	result, err := client.GetTriangularArbitrage(c.Context,
+		&gctrpc.GetTriangularArbitrageRequest{
+			Exchange: exchangeName,
 		},
-		StartDate: negateLocalOffset(s),
-		EndDate:   negateLocalOffset(e),
-	})
+	)
 	if err != nil {
 		return err
 	}
@@ -1313,68 +2409,27 @@ func getOrders(c *cli.Context) error {
 	return nil
 }
 
-var getManagedOrdersCommand = &cli.Command{
-	Name:      "getmanagedorders",
-	Usage:     "gets the current orders from the order manager",
-	ArgsUsage: "<exchange> <asset> <pair>",
-	Action:    getManagedOrders,
+var getTriangularArbitrageStreamCommand = &cli.Command{
+	Name:      "gettriangulararbitragestream",
+	Usage:     "streams the triangular arbitrage opportunities found by the triangular arbitrage detector after every scan",
+	ArgsUsage: "<exchange>",
+	Action:    getTriangularArbitrageStream,
 	Flags: []cli.Flag{
 		&cli.StringFlag{
 			Name:  "exchange",
-			Usage: "the exchange to get orders for",
-		},
-		&cli.StringFlag{
-			Name:  "asset",
-			Usage: "the asset type to get orders for",
-		},
-		&cli.StringFlag{
-			Name:  "pair",
-			Usage: "the currency pair to get orders for",
+			Usage: "an optional exchange to filter opportunities by",
 		},
 	},
 }
 
-func getManagedOrders(c *cli.Context) error {
-	if c.NArg() == 0 && c.NumFlags() == 0 {
-		return cli.ShowCommandHelp(c, "getmanagedorders")
-	}
-
+func getTriangularArbitrageStream(c *cli.Context) error {
 	var exchangeName string
-	var assetType string
-	var currencyPair string
-
 	if c.IsSet("exchange") {
 		exchangeName = c.String("exchange")
 	} else {
 		exchangeName = c.Args().First()
 	}
 
-	if c.IsSet("asset") {
-		assetType = c.String("asset")
-	} else {
-		assetType = c.Args().Get(1)
-	}
-
-	assetType = strings.ToLower(assetType)
-	if !validAsset(assetType) {
-		return errInvalidAsset
-	}
-
-	if c.IsSet("pair") {
-		currencyPair = c.String("pair")
-	} else {
-		currencyPair = c.Args().Get(2)
-	}
-
-	if !validPair(currencyPair) {
-		return errInvalidPair
-	}
-
-	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
-	if err != nil {
-		return err
-	}
-
 	conn, cancel, err := setupClient(c)
 	if err != nil {
 		return err
@@ -1382,66 +2437,76 @@ func getManagedOrders(c *cli.Context) error {
 	defer closeConn(conn, cancel)
 
 	client := gctrpc.NewGoCryptoTraderClient(conn)
-	result, err := client.GetManagedOrders(c.Context, &gctrpc.GetOrdersRequest{
-		Exchange:  exchangeName,
-		AssetType: assetType,
-		Pair: &gctrpc.CurrencyPair{
-			Delimiter: p.Delimiter,
-			Base:      p.Base.String(),
-			Quote:     p.Quote.String(),
+	result, err := client.GetTriangularArbitrageStream(c.Context,
+		&gctrpc.GetTriangularArbitrageRequest{
+			Exchange: exchangeName,
 		},
-	})
+	)
 	if err != nil {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+		jsonOutput(resp)
+	}
 }
 
-var getOrderCommand = &cli.Command{
-	Name:      "getorder",
-	Usage:     "gets the specified order info",
-	ArgsUsage: "<exchange> <asset> <pair> <order_id>",
-	Action:    getOrder,
+var routeOrderCommand = &cli.Command{
+	Name:      "routeorder",
+	Usage:     "routes an order to the enabled exchanges offering the best execution after fees",
+	ArgsUsage: "<pair> <asset> <side> <amount>",
+	Action:    routeOrder,
 	Flags: []cli.Flag{
 		&cli.StringFlag{
-			Name:  "exchange",
-			Usage: "the exchange to get the order for",
+			Name:  "pair",
+			Usage: "the currency pair",
 		},
 		&cli.StringFlag{
 			Name:  "asset",
-			Usage: "required asset type",
+			Usage: "the asset type of the currency pair",
 		},
 		&cli.StringFlag{
-			Name:  "pair",
-			Usage: "the pair to retrieve",
+			Name:  "side",
+			Usage: "the order side to use (BUY OR SELL)",
+		},
+		&cli.Float64Flag{
+			Name:  "amount",
+			Usage: "the amount of the base currency to route",
 		},
 		&cli.StringFlag{
-			Name:  "order_id",
-			Usage: "the order id to retrieve",
+			Name:  "exchanges",
+			Usage: "an optional comma separated list of exchanges to restrict routing to",
+		},
+		&cli.BoolFlag{
+			Name:  "allowsplit",
+			Usage: "allows the order to be split across multiple exchanges",
+		},
+		&cli.BoolFlag{
+			Name:  "execute",
+			Usage: "submits market orders for the route, otherwise only the route is returned",
 		},
 	},
 }
 
-func getOrder(c *cli.Context) error {
+func routeOrder(c *cli.Context) error {
 	if c.NArg() == 0 && c.NumFlags() == 0 {
-		return cli.ShowCommandHelp(c, "getorder")
+		return cli.ShowCommandHelp(c, "routeorder")
 	}
 
-	var exchangeName string
-	var orderID string
-	var currencyPair string
-	var assetType string
-
-	if c.IsSet("exchange") {
-		exchangeName = c.String("exchange")
-	} else {
-		exchangeName = c.Args().First()
+	currencyPair := c.String("pair")
+	if !c.IsSet("pair") {
+		currencyPair = c.Args().First()
 	}
-	if c.IsSet("asset") {
-		assetType = c.String("asset")
-	} else {
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	assetType := c.String("asset")
+	if !c.IsSet("asset") {
 		assetType = c.Args().Get(1)
 	}
 	assetType = strings.ToLower(assetType)
@@ -1449,13 +2514,31 @@ func getOrder(c *cli.Context) error {
 		return errInvalidAsset
 	}
 
-	if c.IsSet("pair") {
-		currencyPair = c.String("pair")
-	} else {
-		currencyPair = c.Args().Get(2)
+	orderSide := c.String("side")
+	if !c.IsSet("side") {
+		orderSide = c.Args().Get(2)
 	}
-	if !validPair(currencyPair) {
-		return errInvalidPair
+	if orderSide == "" {
+		return errors.New("side must be set")
+	}
+
+	var amount float64
+	if c.IsSet("amount") {
+		amount = c.Float64("amount")
+	} else if c.Args().Get(3) != "" {
+		var err error
+		amount, err = strconv.ParseFloat(c.Args().Get(3), 64)
+		if err != nil {
+			return err
+		}
+	}
+	if amount <= 0 {
+		return errors.New("amount must be set")
+	}
+
+	var exchanges []string
+	if c.IsSet("exchanges") {
+		exchanges = strings.Split(c.String("exchanges"), ",")
 	}
 
 	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
@@ -1463,12 +2546,6 @@ func getOrder(c *cli.Context) error {
 		return err
 	}
 
-	if c.IsSet("order_id") {
-		orderID = c.String("order_id")
-	} else {
-		orderID = c.Args().Get(3)
-	}
-
 	conn, cancel, err := setupClient(c)
 	if err != nil {
 		return err
@@ -1476,15 +2553,18 @@ func getOrder(c *cli.Context) error {
 	defer closeConn(conn, cancel)
 
 	client := gctrpc.NewGoCryptoTraderClient(conn)
-	result, err := client.GetOrder(c.Context, &gctrpc.GetOrderRequest{
-		Exchange: exchangeName,
-		OrderId:  orderID,
+	result, err := client.RouteOrder(c.Context, &gctrpc.RouteOrderRequest{
 		Pair: &gctrpc.CurrencyPair{
 			Delimiter: p.Delimiter,
 			Base:      p.Base.String(),
 			Quote:     p.Quote.String(),
 		},
-		Asset: assetType,
+		AssetType:  assetType,
+		Side:       orderSide,
+		Amount:     amount,
+		Exchanges:  exchanges,
+		AllowSplit: c.Bool("allowsplit"),
+		Execute:    c.Bool("execute"),
 	})
 	if err != nil {
 		return err
@@ -1494,20 +2574,32 @@ func getOrder(c *cli.Context) error {
 	return nil
 }
 
-var submitOrderCommand = &cli.Command{
-	Name:      "submitorder",
-	Usage:     "submit order submits an exchange order",
-	ArgsUsage: "<exchange> <pair> <side> <type> <amount> <price> <client_id>",
-	Action:    submitOrder,
+var addConditionalOrderCommand = &cli.Command{
+	Name:      "addconditionalorder",
+	Usage:     "adds an order which is submitted by the order manager once the price crosses the trigger price",
+	ArgsUsage: "<exchange> <pair> <asset> <condition> <trigger_price> <side> <type> <amount> <price>",
+	Action:    addConditionalOrder,
 	Flags: []cli.Flag{
 		&cli.StringFlag{
 			Name:  "exchange",
-			Usage: "the exchange to submit the order for",
+			Usage: "the exchange to submit the order to",
 		},
 		&cli.StringFlag{
 			Name:  "pair",
 			Usage: "the currency pair",
 		},
+		&cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the currency pair",
+		},
+		&cli.StringFlag{
+			Name:  "condition",
+			Usage: "triggers when the price is ABOVE or BELOW the trigger price",
+		},
+		&cli.Float64Flag{
+			Name:  "trigger_price",
+			Usage: "the price which triggers the order",
+		},
 		&cli.StringFlag{
 			Name:  "side",
 			Usage: "the order side to use (BUY OR SELL)",
@@ -1515,6 +2607,7 @@ var submitOrderCommand = &cli.Command{
 		&cli.StringFlag{
 			Name:  "type",
 			Usage: "the order type (MARKET OR LIMIT)",
+			Value: "MARKET",
 		},
 		&cli.Float64Flag{
 			Name:  "amount",
@@ -1522,109 +2615,86 @@ var submitOrderCommand = &cli.Command{
 		},
 		&cli.Float64Flag{
 			Name:  "price",
-			Usage: "the price for the order",
+			Usage: "the price for a limit order",
 		},
 		&cli.StringFlag{
 			Name:  "client_id",
 			Usage: "the optional client order ID",
 		},
-		&cli.StringFlag{
-			Name:  "asset",
-			Usage: "required asset type",
-		},
 	},
 }
 
-func submitOrder(c *cli.Context) error {
+func addConditionalOrder(c *cli.Context) error {
 	if c.NArg() == 0 && c.NumFlags() == 0 {
-		return cli.ShowCommandHelp(c, "submitorder")
+		return cli.ShowCommandHelp(c, "addconditionalorder")
 	}
 
-	var exchangeName string
-	var currencyPair string
-	var orderSide string
-	var orderType string
-	var amount float64
-	var price float64
-	var clientID string
-	var assetType string
-
-	if c.IsSet("exchange") {
-		exchangeName = c.String("exchange")
-	} else {
+	exchangeName := c.String("exchange")
+	if !c.IsSet("exchange") {
 		exchangeName = c.Args().First()
 	}
 
-	if c.IsSet("pair") {
-		currencyPair = c.String("pair")
-	} else {
+	currencyPair := c.String("pair")
+	if !c.IsSet("pair") {
 		currencyPair = c.Args().Get(1)
 	}
-
 	if !validPair(currencyPair) {
 		return errInvalidPair
 	}
 
-	if c.IsSet("side") {
-		orderSide = c.String("side")
-	} else {
-		orderSide = c.Args().Get(2)
+	assetType := c.String("asset")
+	if !c.IsSet("asset") {
+		assetType = c.Args().Get(2)
 	}
-
-	if orderSide == "" {
-		return errors.New("order side must be set")
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
 	}
 
-	if c.IsSet("type") {
-		orderType = c.String("type")
-	} else {
-		orderType = c.Args().Get(3)
+	condition := c.String("condition")
+	if !c.IsSet("condition") {
+		condition = c.Args().Get(3)
 	}
-
-	if orderType == "" {
-		return errors.New("order type must be set")
+	if condition == "" {
+		return errors.New("condition must be set")
 	}
 
-	if c.IsSet("amount") {
-		amount = c.Float64("amount")
-	} else if c.Args().Get(4) != "" {
-		var err error
-		amount, err = strconv.ParseFloat(c.Args().Get(4), 64)
+	var err error
+	triggerPrice := c.Float64("trigger_price")
+	if !c.IsSet("trigger_price") && c.Args().Get(4) != "" {
+		triggerPrice, err = strconv.ParseFloat(c.Args().Get(4), 64)
 		if err != nil {
 			return err
 		}
 	}
 
-	if amount == 0 {
-		return errors.New("amount must be set")
+	orderSide := c.String("side")
+	if !c.IsSet("side") {
+		orderSide = c.Args().Get(5)
 	}
-
-	// price is optional for market orders
-	if c.IsSet("price") {
-		price = c.Float64("price")
-	} else if c.Args().Get(5) != "" {
-		var err error
-		price, err = strconv.ParseFloat(c.Args().Get(5), 64)
-		if err != nil {
-			return err
-		}
+	if orderSide == "" {
+		return errors.New("side must be set")
 	}
 
-	if c.IsSet("client_id") {
-		clientID = c.String("client_id")
-	} else {
-		clientID = c.Args().Get(6)
+	orderType := c.String("type")
+	if !c.IsSet("type") && c.Args().Get(6) != "" {
+		orderType = c.Args().Get(6)
 	}
 
-	if c.IsSet("asset") {
-		assetType = c.String("asset")
-	} else {
-		assetType = c.Args().Get(7)
+	amount := c.Float64("amount")
+	if !c.IsSet("amount") && c.Args().Get(7) != "" {
+		amount, err = strconv.ParseFloat(c.Args().Get(7), 64)
+		if err != nil {
+			return err
+		}
 	}
 
-	assetType = strings.ToLower(assetType)
-	if !validAsset(assetType) {
-		return errInvalidAsset
+	price := c.Float64("price")
+	if !c.IsSet("price") && c.Args().Get(8) != "" {
+		price, err = strconv.ParseFloat(c.Args().Get(8), 64)
+		if err != nil {
+			return err
+		}
 	}
 
 	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
@@ -1639,19 +2709,21 @@ func submitOrder(c *cli.Context) error {
 	defer closeConn(conn, cancel)
 
 	client := gctrpc.NewGoCryptoTraderClient(conn)
-	result, err := client.SubmitOrder(c.Context, &gctrpc.SubmitOrderRequest{
+	result, err := client.AddConditionalOrder(c.Context, &gctrpc.AddConditionalOrderRequest{
 		Exchange: exchangeName,
 		Pair: &gctrpc.CurrencyPair{
 			Delimiter: p.Delimiter,
 			Base:      p.Base.String(),
 			Quote:     p.Quote.String(),
 		},
-		Side:      orderSide,
-		OrderType: orderType,
-		Amount:    amount,
-		Price:     price,
-		ClientId:  clientID,
-		AssetType: assetType,
+		AssetType:    assetType,
+		Condition:    condition,
+		TriggerPrice: triggerPrice,
+		Side:         orderSide,
+		OrderType:    orderType,
+		Amount:       amount,
+		Price:        price,
+		ClientId:     c.String("client_id"),
 	})
 	if err != nil {
 		return err
@@ -1661,86 +2733,103 @@ func submitOrder(c *cli.Context) error {
 	return nil
 }
 
-var simulateOrderCommand = &cli.Command{
-	Name:      "simulateorder",
-	Usage:     "simulate order simulates an exchange order",
-	ArgsUsage: "<exchange> <pair> <side> <amount>",
-	Action:    simulateOrder,
+var getConditionalOrdersCommand = &cli.Command{
+	Name:      "getconditionalorders",
+	Usage:     "gets the conditional orders held by the order manager",
+	ArgsUsage: "<exchange>",
+	Action:    getConditionalOrders,
 	Flags: []cli.Flag{
 		&cli.StringFlag{
 			Name:  "exchange",
-			Usage: "the exchange to simulate the order for",
-		},
-		&cli.StringFlag{
-			Name:  "pair",
-			Usage: "the currency pair",
-		},
-		&cli.StringFlag{
-			Name:  "side",
-			Usage: "the order side to use (BUY OR SELL)",
-		},
-		&cli.Float64Flag{
-			Name:  "amount",
-			Usage: "the amount for the order",
+			Usage: "the exchange to filter conditional orders by, optional",
 		},
 	},
 }
 
-func simulateOrder(c *cli.Context) error {
-	if c.NArg() == 0 && c.NumFlags() == 0 {
-		return cli.ShowCommandHelp(c, "simulateorder")
-	}
-
-	var exchangeName string
-	var currencyPair string
-	var orderSide string
-	var amount float64
-
-	if c.IsSet("exchange") {
-		exchangeName = c.String("exchange")
-	} else {
+func getConditionalOrders(c *cli.Context) error {
+	exchangeName := c.String("exchange")
+	if !c.IsSet("exchange") {
 		exchangeName = c.Args().First()
 	}
 
-	if c.IsSet("pair") {
-		currencyPair = c.String("pair")
-	} else {
-		currencyPair = c.Args().Get(1)
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
 	}
+	defer closeConn(conn, cancel)
 
-	if !validPair(currencyPair) {
-		return errInvalidPair
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetConditionalOrders(c.Context, &gctrpc.GetConditionalOrdersRequest{
+		Exchange: exchangeName,
+	})
+	if err != nil {
+		return err
 	}
 
-	if c.IsSet("side") {
-		orderSide = c.String("side")
-	} else {
-		orderSide = c.Args().Get(2)
-	}
+	jsonOutput(result)
+	return nil
+}
 
-	if orderSide == "" {
-		return errors.New("side must be set")
+var cancelConditionalOrderCommand = &cli.Command{
+	Name:      "cancelconditionalorder",
+	Usage:     "cancels a pending conditional order",
+	ArgsUsage: "<id>",
+	Action:    cancelConditionalOrder,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "id",
+			Usage: "the id of the conditional order",
+		},
+	},
+}
+
+func cancelConditionalOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "cancelconditionalorder")
 	}
 
-	if c.IsSet("amount") {
-		amount = c.Float64("amount")
-	} else if c.Args().Get(3) != "" {
-		var err error
-		amount, err = strconv.ParseFloat(c.Args().Get(3), 64)
-		if err != nil {
-			return err
-		}
+	id := c.String("id")
+	if !c.IsSet("id") {
+		id = c.Args().First()
 	}
 
-	if amount == 0 {
-		return errors.New("amount must be set")
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
 	}
+	defer closeConn(conn, cancel)
 
-	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.CancelConditionalOrder(c.Context, &gctrpc.CancelConditionalOrderRequest{
+		Id: id,
+	})
 	if err != nil {
 		return err
 	}
 
+	jsonOutput(result)
+	return nil
+}
+
+var getPaperBalancesCommand = &cli.Command{
+	Name:      "getpaperbalances",
+	Usage:     "gets the virtual balances tracked while paper trading",
+	ArgsUsage: "<exchange>",
+	Action:    getPaperBalances,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to filter balances by, optional",
+		},
+	},
+}
+
+func getPaperBalances(c *cli.Context) error {
+	exchangeName := c.String("exchange")
+	if !c.IsSet("exchange") {
+		exchangeName = c.Args().First()
+	}
+
 	conn, cancel, err := setupClient(c)
 	if err != nil {
 		return err
@@ -1748,15 +2837,8 @@ func simulateOrder(c *cli.Context) error {
 	defer closeConn(conn, cancel)
 
 	client := gctrpc.NewGoCryptoTraderClient(conn)
-	result, err := client.SimulateOrder(c.Context, &gctrpc.SimulateOrderRequest{
+	result, err := client.GetPaperBalances(c.Context, &gctrpc.GetPaperBalancesRequest{
 		Exchange: exchangeName,
-		Pair: &gctrpc.CurrencyPair{
-			Delimiter: p.Delimiter,
-			Base:      p.Base.String(),
-			Quote:     p.Quote.String(),
-		},
-		Side:   orderSide,
-		Amount: amount,
 	})
 	if err != nil {
 		return err
@@ -3483,6 +4565,100 @@ func getOrderbookStream(c *cli.Context) error {
 	}
 }
 
+var getOrderbookDeltaStreamCommand = &cli.Command{
+	Name:      "getorderbookdeltastream",
+	Usage:     "gets an orderbook snapshot followed by only the changed price levels for a specific currency pair and exchange",
+	ArgsUsage: "<exchange> <pair> <asset>",
+	Action:    getOrderbookDeltaStream,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the orderbook from",
+		},
+		&cli.StringFlag{
+			Name:  "pair",
+			Usage: "currency pair",
+		},
+		&cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the currency pair",
+		},
+	},
+}
+
+func getOrderbookDeltaStream(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "getorderbookdeltastream")
+	}
+
+	var exchangeName string
+	var pair string
+	var assetType string
+
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if c.IsSet("pair") {
+		pair = c.String("pair")
+	} else {
+		pair = c.Args().Get(1)
+	}
+
+	if !validPair(pair) {
+		return errInvalidPair
+	}
+
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+
+	assetType = strings.ToLower(assetType)
+
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	p, err := currency.NewPairDelimiter(pair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetOrderbookDeltaStream(c.Context,
+		&gctrpc.GetOrderbookStreamRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+				Delimiter: p.Delimiter,
+			},
+			AssetType: assetType,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+		jsonOutput(resp)
+	}
+}
+
 var getExchangeOrderbookStreamCommand = &cli.Command{
 	Name:      "getexchangeorderbookstream",
 	Usage:     "gets a stream for all orderbooks associated with an exchange",
@@ -4723,6 +5899,88 @@ func findMissingSavedCandleIntervals(c *cli.Context) error {
 	return nil
 }
 
+var getSavedCandleCoverageCommand = &cli.Command{
+	Name:      "getsavedcandlecoverage",
+	Usage:     "lists the stored candle ranges for every exchange, pair, asset and interval and highlights any gaps",
+	ArgsUsage: "<exchange> <gaps>",
+	Action:    getSavedCandleCoverage,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:    "exchange",
+			Aliases: []string{"e"},
+			Usage:   "the exchange to filter coverage by, leave empty for all exchanges",
+		},
+		&cli.BoolFlag{
+			Name:    "gaps",
+			Aliases: []string{"g"},
+			Usage:   "includes the missing periods for each range",
+		},
+	},
+}
+
+func getSavedCandleCoverage(c *cli.Context) error {
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	var includeGaps bool
+	if c.IsSet("gaps") {
+		includeGaps = c.Bool("gaps")
+	} else if c.Args().Get(1) != "" {
+		var err error
+		includeGaps, err = strconv.ParseBool(c.Args().Get(1))
+		if err != nil {
+			return err
+		}
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetSavedCandleCoverage(c.Context,
+		&gctrpc.GetSavedCandleCoverageRequest{
+			ExchangeName: exchangeName,
+			IncludeGaps:  includeGaps,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getDataSyncStatusCommand = &cli.Command{
+	Name:   "getdatasyncstatus",
+	Usage:  "gets the sync progress of every item configured in the data sync manager",
+	Action: getDataSyncStatus,
+}
+
+func getDataSyncStatus(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetDataSyncStatus(c.Context,
+		&gctrpc.GetDataSyncStatusRequest{})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
 // negateLocalOffset helps negate the offset of time generation
 // when the unix time gets to rpcserver, it no longer is the same time
 // that was sent as it handles it as a UTC value, even though when
@@ -3,6 +3,7 @@ package main
 import (
 	"errors"
 	"fmt"
+	"io"
 	"strings"
 	"time"
 
@@ -65,6 +66,24 @@ var dataHistoryCommands = &cli.Command{
 				nicknameFlag,
 			},
 		},
+		{
+			Name:      "getjobprogress",
+			Usage:     "returns how many of a job's intervals have been processed",
+			ArgsUsage: "<nickname>",
+			Action:    getDataHistoryJobProgress,
+			Flags: []cli.Flag{
+				nicknameFlag,
+			},
+		},
+		{
+			Name:      "getjobprogressstream",
+			Usage:     "streams a job's progress after every run of data history jobs until the job is no longer active or paused",
+			ArgsUsage: "<nickname>",
+			Action:    getDataHistoryJobProgressStream,
+			Flags: []cli.Flag{
+				nicknameFlag,
+			},
+		},
 		dataHistoryJobCommands,
 		{
 			Name:      "deletejob",
@@ -661,6 +680,73 @@ func getDataHistoryJobSummary(c *cli.Context) error {
 	return nil
 }
 
+func getDataHistoryJobProgress(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, c.Command.Name)
+	}
+
+	var nickname string
+	if c.IsSet("nickname") {
+		nickname = c.String("nickname")
+	} else {
+		nickname = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetDataHistoryJobProgress(c.Context, &gctrpc.GetDataHistoryJobDetailsRequest{
+		Nickname: nickname,
+	})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func getDataHistoryJobProgressStream(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, c.Command.Name)
+	}
+
+	var nickname string
+	if c.IsSet("nickname") {
+		nickname = c.String("nickname")
+	} else {
+		nickname = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetDataHistoryJobProgressStream(c.Context, &gctrpc.GetDataHistoryJobDetailsRequest{
+		Nickname: nickname,
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		jsonOutput(resp)
+	}
+}
+
 func setPrerequisiteJob(c *cli.Context) error {
 	if c.NArg() == 0 && c.NumFlags() == 0 {
 		return cli.ShowCommandHelp(c, c.Command.Name)
@@ -114,6 +114,9 @@ func main() {
 		getExchangeOTPCommand,
 		getExchangeOTPsCommand,
 		getExchangeInfoCommand,
+		getCapabilityGapsCommand,
+		getExchangeClockSkewCommand,
+		statusCommand,
 		getTickerCommand,
 		getTickersCommand,
 		getOrderbookCommand,
@@ -124,6 +127,11 @@ func main() {
 		getConfigCommand,
 		getPortfolioCommand,
 		getPortfolioSummaryCommand,
+		getPortfolioPnLCommand,
+		getPortfolioPerformanceCommand,
+		getDerivativePositionsCommand,
+		getLeverageCommand,
+		setLeverageCommand,
 		addPortfolioAddressCommand,
 		removePortfolioAddressCommand,
 		getForexProvidersCommand,
@@ -133,6 +141,15 @@ func main() {
 		getOrderCommand,
 		submitOrderCommand,
 		simulateOrderCommand,
+		getConsolidatedOrderbookCommand,
+		getArbitrageOpportunitiesCommand,
+		getTriangularArbitrageCommand,
+		getTriangularArbitrageStreamCommand,
+		routeOrderCommand,
+		addConditionalOrderCommand,
+		getConditionalOrdersCommand,
+		cancelConditionalOrderCommand,
+		getPaperBalancesCommand,
 		whaleBombCommand,
 		cancelOrderCommand,
 		cancelBatchOrdersCommand,
@@ -147,22 +164,30 @@ func main() {
 		withdrawCryptocurrencyFundsCommand,
 		withdrawFiatFundsCommand,
 		withdrawalRequestCommand,
+		withdrawalAddressBookCommand,
+		withdrawalApprovalCommand,
 		getLoggerDetailsCommand,
 		setLoggerDetailsCommand,
 		exchangePairManagerCommand,
 		getOrderbookStreamCommand,
+		getOrderbookDeltaStreamCommand,
 		getExchangeOrderbookStreamCommand,
 		getTickerStreamCommand,
 		getExchangeTickerStreamCommand,
+		watchCommand,
 		getAuditEventCommand,
 		getHistoricCandlesCommand,
 		getHistoricCandlesExtendedCommand,
 		findMissingSavedCandleIntervalsCommand,
+		getSavedCandleCoverageCommand,
+		getDataSyncStatusCommand,
 		gctScriptCommand,
 		websocketManagerCommand,
 		tradeCommand,
 		dataHistoryCommands,
 		currencyStateManagementCommand,
+		backtesterCommands,
+		shellCommand,
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
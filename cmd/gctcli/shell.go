@@ -0,0 +1,483 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/urfave/cli/v2"
+	"golang.org/x/term"
+)
+
+const (
+	shellHistoryFile  = "gctcli_history"
+	shellHistoryLimit = 1000
+	shellCommandName  = "shell"
+)
+
+var (
+	errUnterminatedQuote   = errors.New("unterminated quote")
+	errUnknownShellContext = errors.New("unknown shell context key, must be exchange, pair or asset")
+
+	// shellContextKeys are the flags which are automatically supplied to
+	// commands from the shell context
+	shellContextKeys = []string{"exchange", "pair", "asset"}
+)
+
+var shellCommand = &cli.Command{
+	Name:  shellCommandName,
+	Usage: "starts an interactive shell with a persistent exchange, pair and asset context",
+	Description: "Commands are entered without the gctcli prefix. Use 'set <exchange|pair|asset> <value>' to store\n" +
+		"context which is supplied to any command accepting that flag when no positional arguments are given.\n" +
+		"Shell commands: set, unset, context, history, !<number>, help, exit",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the initial exchange context",
+		},
+		&cli.StringFlag{
+			Name:  "pair",
+			Usage: "the initial currency pair context",
+		},
+		&cli.StringFlag{
+			Name:  "asset",
+			Usage: "the initial asset context",
+		},
+	},
+	Action: runShell,
+}
+
+// shell holds the state of an interactive gctcli session
+type shell struct {
+	app         *cli.App
+	context     map[string]string
+	history     []string
+	historyPath string
+	out         io.Writer
+}
+
+func runShell(c *cli.Context) error {
+	s := &shell{
+		app:         c.App,
+		context:     make(map[string]string),
+		historyPath: filepath.Join(common.GetDefaultDataDir(runtime.GOOS), shellHistoryFile),
+		out:         os.Stdout,
+	}
+	for i := range shellContextKeys {
+		if c.IsSet(shellContextKeys[i]) {
+			s.context[shellContextKeys[i]] = c.String(shellContextKeys[i])
+		}
+	}
+	s.loadHistory()
+
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		// Input is piped, read commands line by line without line editing
+		scanner := bufio.NewScanner(os.Stdin)
+		for scanner.Scan() {
+			if s.execute(c, scanner.Text()) {
+				return nil
+			}
+		}
+		return scanner.Err()
+	}
+
+	t := term.NewTerminal(struct {
+		io.Reader
+		io.Writer
+	}{os.Stdin, os.Stdout}, s.prompt())
+	t.AutoCompleteCallback = func(line string, pos int, key rune) (string, int, bool) {
+		if key != '\t' || pos != len(line) {
+			return "", 0, false
+		}
+		completed, ok := completeShellLine(s.commands(), line)
+		if !ok {
+			return "", 0, false
+		}
+		return completed, len(completed), true
+	}
+	fmt.Fprintln(s.out, "gctcli interactive shell, type 'help' for commands or 'exit' to quit")
+	for {
+		t.SetPrompt(s.prompt())
+		state, err := term.MakeRaw(fd)
+		if err != nil {
+			return err
+		}
+		line, err := t.ReadLine()
+		if restoreErr := term.Restore(fd, state); restoreErr != nil {
+			return restoreErr
+		}
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if s.execute(c, line) {
+			return nil
+		}
+	}
+}
+
+// execute runs a single line of shell input and returns true when the shell
+// should exit
+func (s *shell) execute(c *cli.Context, line string) bool {
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return false
+	}
+	if strings.HasPrefix(line, "!") {
+		i, err := strconv.Atoi(line[1:])
+		if err != nil || i < 1 || i > len(s.history) {
+			fmt.Fprintf(s.out, "history entry %q not found\n", line[1:])
+			return false
+		}
+		line = s.history[i-1]
+		fmt.Fprintln(s.out, line)
+	}
+	args, err := splitShellArgs(line)
+	if err != nil {
+		fmt.Fprintln(s.out, err)
+		return false
+	}
+	s.addHistory(line)
+
+	switch args[0] {
+	case "exit", "quit":
+		return true
+	case "set":
+		if len(args) != 3 {
+			fmt.Fprintln(s.out, "usage: set <exchange|pair|asset> <value>")
+			return false
+		}
+		if err = s.setContext(args[1], args[2]); err != nil {
+			fmt.Fprintln(s.out, err)
+		}
+		return false
+	case "unset":
+		if len(args) != 2 {
+			fmt.Fprintln(s.out, "usage: unset <exchange|pair|asset>")
+			return false
+		}
+		if err = s.setContext(args[1], ""); err != nil {
+			fmt.Fprintln(s.out, err)
+		}
+		return false
+	case "context":
+		for i := range shellContextKeys {
+			fmt.Fprintf(s.out, "%s: %s\n", shellContextKeys[i], s.context[shellContextKeys[i]])
+		}
+		return false
+	case "history":
+		for i := range s.history {
+			fmt.Fprintf(s.out, "%5d  %s\n", i+1, s.history[i])
+		}
+		return false
+	case shellCommandName:
+		fmt.Fprintln(s.out, "already running an interactive shell")
+		return false
+	}
+
+	args = applyShellContext(s.commands(), args, s.context)
+	err = s.app.RunContext(c.Context, append(s.globalArgs(), args...))
+	if err != nil {
+		fmt.Fprintln(s.out, err)
+	}
+	fmt.Fprintln(s.out)
+	return false
+}
+
+// setContext sets or clears a shell context value
+func (s *shell) setContext(key, value string) error {
+	key = strings.ToLower(key)
+	if !common.StringDataCompare(shellContextKeys, key) {
+		return fmt.Errorf("%w: %s", errUnknownShellContext, key)
+	}
+	if value == "" {
+		delete(s.context, key)
+		return nil
+	}
+	s.context[key] = value
+	return nil
+}
+
+// prompt returns the shell prompt displaying the current context
+func (s *shell) prompt() string {
+	var ctx []string
+	for i := range shellContextKeys {
+		if v, ok := s.context[shellContextKeys[i]]; ok {
+			ctx = append(ctx, v)
+		}
+	}
+	if len(ctx) == 0 {
+		return "gctcli> "
+	}
+	return "gctcli [" + strings.Join(ctx, " ") + "]> "
+}
+
+// globalArgs returns the global flags the shell was started with so they are
+// retained when each command is run
+func (s *shell) globalArgs() []string {
+	return []string{
+		s.app.Name,
+		"--rpchost=" + host,
+		"--rpcuser=" + username,
+		"--rpcpassword=" + password,
+		"--delimiter=" + pairDelimiter,
+		"--cert=" + certPath,
+		"--timeout=" + timeout.String(),
+	}
+}
+
+// commands returns the commands which can be run within the shell
+func (s *shell) commands() []*cli.Command {
+	cmds := make([]*cli.Command, 0, len(s.app.Commands))
+	for i := range s.app.Commands {
+		if s.app.Commands[i].Name == shellCommandName {
+			continue
+		}
+		cmds = append(cmds, s.app.Commands[i])
+	}
+	return cmds
+}
+
+// loadHistory loads previous shell sessions from the history file
+func (s *shell) loadHistory() {
+	data, err := ioutil.ReadFile(s.historyPath)
+	if err != nil {
+		return
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			s.history = append(s.history, line)
+		}
+	}
+	if len(s.history) > shellHistoryLimit {
+		s.history = s.history[len(s.history)-shellHistoryLimit:]
+	}
+}
+
+// addHistory records a line in the session history and appends it to the
+// history file
+func (s *shell) addHistory(line string) {
+	if len(s.history) > 0 && s.history[len(s.history)-1] == line {
+		return
+	}
+	s.history = append(s.history, line)
+	f, err := os.OpenFile(s.historyPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return
+	}
+	_, _ = f.WriteString(line + "\n")
+	_ = f.Close()
+}
+
+// splitShellArgs splits a line of shell input into arguments, respecting
+// single and double quotes
+func splitShellArgs(line string) ([]string, error) {
+	var args []string
+	var current strings.Builder
+	var quote rune
+	inArg := false
+	for _, r := range line {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+				continue
+			}
+			current.WriteRune(r)
+		case r == '"' || r == '\'':
+			quote = r
+			inArg = true
+		case r == ' ' || r == '\t':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+	if quote != 0 {
+		return nil, errUnterminatedQuote
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+	return args, nil
+}
+
+// findShellCommand walks the arguments to find the deepest matching command
+// and returns it along with the number of arguments making up the command path
+func findShellCommand(cmds []*cli.Command, args []string) (*cli.Command, int) {
+	var found *cli.Command
+	depth := 0
+	for depth < len(args) {
+		next := lookupCommand(cmds, args[depth])
+		if next == nil {
+			break
+		}
+		found = next
+		cmds = next.Subcommands
+		depth++
+	}
+	return found, depth
+}
+
+// lookupCommand returns the command matching the name or an alias
+func lookupCommand(cmds []*cli.Command, name string) *cli.Command {
+	for i := range cmds {
+		if cmds[i].HasName(name) {
+			return cmds[i]
+		}
+	}
+	return nil
+}
+
+// applyShellContext inserts the shell context as flags for the command when
+// the command accepts them, they have not been set and no positional
+// arguments were supplied
+func applyShellContext(cmds []*cli.Command, args []string, ctx map[string]string) []string {
+	cmd, depth := findShellCommand(cmds, args)
+	if cmd == nil || len(ctx) == 0 {
+		return args
+	}
+	remaining := args[depth:]
+	for i := 0; i < len(remaining); i++ {
+		if !strings.HasPrefix(remaining[i], "-") {
+			return args
+		}
+		if strings.Contains(remaining[i], "=") {
+			continue
+		}
+		if f := commandFlag(cmd, strings.TrimLeft(remaining[i], "-")); f != nil {
+			if _, isBool := f.(*cli.BoolFlag); !isBool {
+				// skip the flag value
+				i++
+			}
+		}
+	}
+	var inject []string
+	for i := range shellContextKeys {
+		v, ok := ctx[shellContextKeys[i]]
+		if !ok || commandFlag(cmd, shellContextKeys[i]) == nil || flagSupplied(remaining, shellContextKeys[i]) {
+			continue
+		}
+		inject = append(inject, "--"+shellContextKeys[i]+"="+v)
+	}
+	if len(inject) == 0 {
+		return args
+	}
+	resp := make([]string, 0, len(args)+len(inject))
+	resp = append(resp, args[:depth]...)
+	resp = append(resp, inject...)
+	return append(resp, remaining...)
+}
+
+// commandFlag returns the command flag matching the name or an alias
+func commandFlag(cmd *cli.Command, name string) cli.Flag {
+	for i := range cmd.Flags {
+		for _, n := range cmd.Flags[i].Names() {
+			if n == name {
+				return cmd.Flags[i]
+			}
+		}
+	}
+	return nil
+}
+
+// flagSupplied returns whether the flag has been set in the arguments
+func flagSupplied(args []string, name string) bool {
+	for i := range args {
+		a := strings.TrimLeft(args[i], "-")
+		if a == args[i] {
+			continue
+		}
+		if a == name || strings.HasPrefix(a, name+"=") {
+			return true
+		}
+	}
+	return false
+}
+
+// completeShellLine completes the last word of a line with the matching
+// command, subcommand or flag name. When multiple names match the line is
+// completed to their longest common prefix
+func completeShellLine(cmds []*cli.Command, line string) (string, bool) {
+	fields := strings.Fields(line)
+	var prefix string
+	if len(fields) > 0 && !strings.HasSuffix(line, " ") {
+		prefix = fields[len(fields)-1]
+		fields = fields[:len(fields)-1]
+	}
+
+	var candidates []string
+	if strings.HasPrefix(prefix, "-") {
+		cmd, _ := findShellCommand(cmds, fields)
+		if cmd == nil {
+			return "", false
+		}
+		for i := range cmd.Flags {
+			candidates = append(candidates, "--"+cmd.Flags[i].Names()[0])
+		}
+	} else {
+		cmd, depth := findShellCommand(cmds, fields)
+		if depth != len(fields) {
+			return "", false
+		}
+		options := cmds
+		if cmd != nil {
+			options = cmd.Subcommands
+		}
+		for i := range options {
+			candidates = append(candidates, options[i].Name)
+		}
+		if cmd == nil {
+			candidates = append(candidates, "set", "unset", "context", "history", "exit")
+		}
+	}
+
+	var matches []string
+	for i := range candidates {
+		if strings.HasPrefix(candidates[i], prefix) {
+			matches = append(matches, candidates[i])
+		}
+	}
+	if len(matches) == 0 {
+		return "", false
+	}
+	sort.Strings(matches)
+	completion := matches[0]
+	if len(matches) > 1 {
+		completion = commonPrefix(matches)
+		if len(completion) <= len(prefix) {
+			return "", false
+		}
+	} else {
+		completion += " "
+	}
+	return line[:len(line)-len(prefix)] + completion, true
+}
+
+// commonPrefix returns the longest prefix shared by all the strings
+func commonPrefix(s []string) string {
+	prefix := s[0]
+	for i := 1; i < len(s); i++ {
+		for !strings.HasPrefix(s[i], prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	return prefix
+}
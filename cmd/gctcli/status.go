@@ -0,0 +1,157 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var statusCommand = &cli.Command{
+	Name:      "status",
+	Usage:     "renders the health of each loaded exchange: REST reachability, websocket connectivity, credentials, rate limit headroom and the last data received",
+	ArgsUsage: "<exchange>",
+	Action:    getStatus,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the status of, all loaded exchanges when unset",
+		},
+		&cli.BoolFlag{
+			Name:  "verify",
+			Usage: "verifies credentials by requesting account info from each exchange with credentials set",
+		},
+		&cli.BoolFlag{
+			Name:  "json",
+			Usage: "outputs the status as JSON instead of a table",
+		},
+	},
+}
+
+func getStatus(c *cli.Context) error {
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetExchangeHealth(c.Context,
+		&gctrpc.GetExchangeHealthRequest{
+			Exchange:          exchangeName,
+			VerifyCredentials: c.Bool("verify"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	if c.Bool("json") {
+		jsonOutput(result)
+		return nil
+	}
+	return renderStatus(result)
+}
+
+// renderStatus prints a row for each exchange followed by any errors
+// encountered while checking them
+func renderStatus(resp *gctrpc.GetExchangeHealthResponse) error {
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "EXCHANGE\tREST\tLATENCY\tWEBSOCKET\tAUTH\tRATE LIMIT\tLAST TICKER\tLAST ORDERBOOK\t")
+	for _, h := range resp.Exchanges {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t\n",
+			h.Exchange,
+			restStatus(h),
+			latencyStatus(h),
+			websocketStatus(h),
+			authStatus(h),
+			rateLimitStatus(h.RateLimit),
+			orNever(h.LastTickerUpdate),
+			orNever(h.LastOrderbookUpdate))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	for _, h := range resp.Exchanges {
+		if h.RestError != "" {
+			fmt.Printf("%s REST error: %s\n", h.Exchange, h.RestError)
+		}
+		if h.CredentialsError != "" {
+			fmt.Printf("%s credentials error: %s\n", h.Exchange, h.CredentialsError)
+		}
+	}
+	return nil
+}
+
+func restStatus(h *gctrpc.ExchangeHealth) string {
+	if !h.RestReachable {
+		return "unreachable"
+	}
+	return "ok (" + strconv.FormatInt(h.RestStatusCode, 10) + ")"
+}
+
+func latencyStatus(h *gctrpc.ExchangeHealth) string {
+	if !h.RestReachable {
+		return "-"
+	}
+	return strconv.FormatInt(h.RestLatencyMilliseconds, 10) + "ms"
+}
+
+func websocketStatus(h *gctrpc.ExchangeHealth) string {
+	switch {
+	case !h.WebsocketSupported:
+		return "unsupported"
+	case !h.WebsocketEnabled:
+		return "disabled"
+	case h.WebsocketConnected && h.WebsocketAuthenticated:
+		return "connected (auth)"
+	case h.WebsocketConnected:
+		return "connected"
+	case h.WebsocketConnecting:
+		return "connecting"
+	default:
+		return "disconnected"
+	}
+}
+
+func authStatus(h *gctrpc.ExchangeHealth) string {
+	switch {
+	case !h.AuthenticatedSupport:
+		return "disabled"
+	case !h.CredentialsSet:
+		return "no credentials"
+	case h.CredentialsVerified:
+		return "verified"
+	case h.CredentialsError != "":
+		return "invalid"
+	default:
+		return "set"
+	}
+}
+
+func rateLimitStatus(rl *gctrpc.RateLimitHeadroom) string {
+	if rl == nil {
+		return "unmanaged"
+	}
+	if rl.NextRequestInMilliseconds > 0 {
+		return fmt.Sprintf("%.2f/s, wait %dms", rl.RequestsPerSecond, rl.NextRequestInMilliseconds)
+	}
+	return fmt.Sprintf("%.2f/s, available", rl.RequestsPerSecond)
+}
+
+func orNever(timestamp string) string {
+	if timestamp == "" {
+		return "never"
+	}
+	return timestamp
+}
@@ -214,6 +214,53 @@ var tradeCommand = &cli.Command{
 				},
 			},
 		},
+		{
+			Name:      "getfootprint",
+			Usage:     "aggregates stored trade data into a volume profile and per interval buy/sell footprints",
+			ArgsUsage: "<exchange> <pair> <asset> <interval> <bucketsize> <start> <end>",
+			Action:    getTradeFootprint,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    "exchange",
+					Aliases: []string{"e"},
+					Usage:   "the exchange",
+				},
+				&cli.StringFlag{
+					Name:    "pair",
+					Aliases: []string{"p"},
+					Usage:   "the currency pair to get the trades for",
+				},
+				&cli.StringFlag{
+					Name:    "asset",
+					Aliases: []string{"a"},
+					Usage:   "the asset type of the currency pair",
+				},
+				&cli.Int64Flag{
+					Name:        "interval",
+					Aliases:     []string{"i"},
+					Usage:       klineMessage,
+					Value:       3600,
+					Destination: &candleGranularity,
+				},
+				&cli.Float64Flag{
+					Name:    "bucketsize",
+					Aliases: []string{"b"},
+					Usage:   "the price range grouped into a single volume level, 0 groups by exact price",
+				},
+				&cli.StringFlag{
+					Name:        "start",
+					Usage:       "<start>",
+					Value:       time.Now().AddDate(0, 0, -1).Format(common.SimpleTimeFormat),
+					Destination: &startTime,
+				},
+				&cli.StringFlag{
+					Name:        "end",
+					Usage:       "<end>",
+					Value:       time.Now().Format(common.SimpleTimeFormat),
+					Destination: &endTime,
+				},
+			},
+		},
 	},
 }
 
@@ -727,3 +774,115 @@ func convertSavedTradesToCandles(c *cli.Context) error {
 	jsonOutput(result)
 	return nil
 }
+
+func getTradeFootprint(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "getfootprint")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	if c.IsSet("interval") {
+		candleGranularity = c.Int64("interval")
+	} else if c.Args().Get(3) != "" {
+		candleGranularity, err = strconv.ParseInt(c.Args().Get(3), 10, 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	var bucketSize float64
+	if c.IsSet("bucketsize") {
+		bucketSize = c.Float64("bucketsize")
+	} else if c.Args().Get(4) != "" {
+		bucketSize, err = strconv.ParseFloat(c.Args().Get(4), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !c.IsSet("start") {
+		if c.Args().Get(5) != "" {
+			startTime = c.Args().Get(5)
+		}
+	}
+
+	if !c.IsSet("end") {
+		if c.Args().Get(6) != "" {
+			endTime = c.Args().Get(6)
+		}
+	}
+
+	candleInterval := time.Duration(candleGranularity) * time.Second
+	var s, e time.Time
+	s, err = time.Parse(common.SimpleTimeFormat, startTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+	e, err = time.Parse(common.SimpleTimeFormat, endTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+
+	if e.Before(s) {
+		return errors.New("start cannot be after end")
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetTradeFootprint(c.Context,
+		&gctrpc.GetTradeFootprintRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			AssetType:       assetType,
+			Start:           negateLocalOffset(s),
+			End:             negateLocalOffset(e),
+			TimeInterval:    int64(candleInterval),
+			PriceBucketSize: bucketSize,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
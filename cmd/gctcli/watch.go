@@ -0,0 +1,455 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	defaultWatchRefresh = time.Second
+	defaultWatchDepth   = 20
+)
+
+var errInvalidWatchRefresh = errors.New("refresh rate must be greater than zero")
+
+var watchCommand = &cli.Command{
+	Name:      "watch",
+	Usage:     "renders a continuously refreshing view of tickers or an orderbook",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "tickers",
+			Usage:     "watches the tickers for one or more currency pairs on an exchange",
+			ArgsUsage: "<exchange> <pairs> <asset>",
+			Action:    watchTickers,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "exchange",
+					Usage: "the exchange to watch tickers for",
+				},
+				&cli.StringSliceFlag{
+					Name:  "pair",
+					Usage: "the currency pairs to watch, comma separated or the flag repeated",
+				},
+				&cli.StringFlag{
+					Name:  "asset",
+					Usage: "the asset type of the currency pairs",
+				},
+				&cli.DurationFlag{
+					Name:  "refresh",
+					Usage: "how often the view is redrawn",
+					Value: defaultWatchRefresh,
+				},
+			},
+		},
+		{
+			Name:      "orderbook",
+			Usage:     "watches an orderbook ladder for a currency pair on an exchange",
+			ArgsUsage: "<exchange> <pair> <asset>",
+			Action:    watchOrderbook,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "exchange",
+					Usage: "the exchange to watch the orderbook for",
+				},
+				&cli.StringFlag{
+					Name:  "pair",
+					Usage: "the currency pair to watch",
+				},
+				&cli.StringFlag{
+					Name:  "asset",
+					Usage: "the asset type of the currency pair",
+				},
+				&cli.IntFlag{
+					Name:  "depth",
+					Usage: "the amount of price levels displayed on each side of the ladder",
+					Value: defaultWatchDepth,
+				},
+				&cli.DurationFlag{
+					Name:  "refresh",
+					Usage: "how often the view is redrawn",
+					Value: defaultWatchRefresh,
+				},
+			},
+		},
+	},
+}
+
+// watchedTicker holds the latest streamed ticker for a pair
+type watchedTicker struct {
+	pair    string
+	ticker  *gctrpc.TickerResponse
+	open    float64
+	updated time.Time
+	err     error
+}
+
+func watchTickers(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	var pairs []string
+	if c.IsSet("pair") {
+		pairs = c.StringSlice("pair")
+	} else {
+		pairs = strings.Split(c.Args().Get(1), ",")
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	refresh := c.Duration("refresh")
+	if refresh <= 0 {
+		return errInvalidWatchRefresh
+	}
+
+	cps := make([]currency.Pair, len(pairs))
+	for i := range pairs {
+		if !validPair(pairs[i]) {
+			return fmt.Errorf("%w %s", errInvalidPair, pairs[i])
+		}
+		p, err := currency.NewPairDelimiter(pairs[i], pairDelimiter)
+		if err != nil {
+			return err
+		}
+		cps[i] = p
+	}
+
+	// Streams run until interrupted so they must not inherit the request
+	// timeout applied by setupClient
+	streamCtx, streamCancel := context.WithCancel(c.Context)
+	defer streamCancel()
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+
+	var m sync.Mutex
+	tickers := make([]*watchedTicker, len(cps))
+	for i := range cps {
+		tickers[i] = &watchedTicker{pair: cps[i].String()}
+		go func(w *watchedTicker, p currency.Pair) {
+			stream, err := client.GetTickerStream(streamCtx,
+				&gctrpc.GetTickerStreamRequest{
+					Exchange: exchangeName,
+					Pair: &gctrpc.CurrencyPair{
+						Base:      p.Base.String(),
+						Quote:     p.Quote.String(),
+						Delimiter: p.Delimiter,
+					},
+					AssetType: assetType,
+				})
+			if err != nil {
+				m.Lock()
+				w.err = err
+				m.Unlock()
+				return
+			}
+			for {
+				resp, err := stream.Recv()
+				m.Lock()
+				if err != nil {
+					w.err = err
+					m.Unlock()
+					return
+				}
+				if w.open == 0 {
+					w.open = resp.Last
+				}
+				w.ticker = resp
+				w.updated = time.Now()
+				m.Unlock()
+			}
+		}(tickers[i], cps[i])
+	}
+
+	ticker := time.NewTicker(refresh)
+	defer ticker.Stop()
+	for {
+		if err = clearScreen(); err != nil {
+			return err
+		}
+		fmt.Printf("Watching %s %s tickers, refreshing every %v. Press Ctrl+C to exit\n\n",
+			exchangeName,
+			assetType,
+			refresh)
+		tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+		fmt.Fprintln(tw, "PAIR\tLAST\tCHANGE\tBID\tASK\tSPREAD\tHIGH\tLOW\tVOLUME\tAGE\t")
+		m.Lock()
+		for i := range tickers {
+			w := tickers[i]
+			switch {
+			case w.err != nil:
+				fmt.Fprintf(tw, "%s\t%v\t\t\t\t\t\t\t\t\t\n", w.pair, w.err)
+			case w.ticker == nil:
+				fmt.Fprintf(tw, "%s\twaiting\t\t\t\t\t\t\t\t\t\n", w.pair)
+			default:
+				var change, spread float64
+				if w.open > 0 {
+					change = (w.ticker.Last - w.open) / w.open * 100
+				}
+				if w.ticker.Bid > 0 {
+					spread = (w.ticker.Ask - w.ticker.Bid) / w.ticker.Bid * 100
+				}
+				fmt.Fprintf(tw, "%s\t%.8f\t%+.2f%%\t%.8f\t%.8f\t%.4f%%\t%.8f\t%.8f\t%.4f\t%v\t\n",
+					w.pair,
+					w.ticker.Last,
+					change,
+					w.ticker.Bid,
+					w.ticker.Ask,
+					spread,
+					w.ticker.High,
+					w.ticker.Low,
+					w.ticker.Volume,
+					time.Since(w.updated).Truncate(time.Second))
+			}
+		}
+		m.Unlock()
+		if err = tw.Flush(); err != nil {
+			return err
+		}
+		select {
+		case <-streamCtx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// orderbookLevel is a price level key, ID is only set for exchanges which
+// identify individual orders
+type orderbookLevel struct {
+	price float64
+	id    int64
+}
+
+// orderbookLadder maintains an orderbook from a snapshot and delta updates
+type orderbookLadder struct {
+	bids    map[orderbookLevel]float64
+	asks    map[orderbookLevel]float64
+	updates int64
+}
+
+func newOrderbookLadder() *orderbookLadder {
+	return &orderbookLadder{
+		bids: make(map[orderbookLevel]float64),
+		asks: make(map[orderbookLevel]float64),
+	}
+}
+
+// apply applies a snapshot or delta to the ladder, a zero amount removes the
+// level
+func (l *orderbookLadder) apply(resp *gctrpc.OrderbookDeltaResponse) {
+	if resp.Snapshot {
+		l.bids = make(map[orderbookLevel]float64, len(resp.Bids))
+		l.asks = make(map[orderbookLevel]float64, len(resp.Asks))
+	}
+	applyOrderbookLevels(l.bids, resp.Bids)
+	applyOrderbookLevels(l.asks, resp.Asks)
+	l.updates++
+}
+
+func applyOrderbookLevels(side map[orderbookLevel]float64, items []*gctrpc.OrderbookItem) {
+	for i := range items {
+		key := orderbookLevel{price: items[i].Price, id: items[i].Id}
+		if items[i].Amount == 0 {
+			delete(side, key)
+			continue
+		}
+		side[key] = items[i].Amount
+	}
+}
+
+// sortedOrderbookSide returns the best levels of a side up to the depth, bids
+// are ordered by descending price and asks by ascending price
+func sortedOrderbookSide(side map[orderbookLevel]float64, bids bool, depth int) []*gctrpc.OrderbookItem {
+	items := make([]*gctrpc.OrderbookItem, 0, len(side))
+	for k, v := range side {
+		items = append(items, &gctrpc.OrderbookItem{Price: k.price, Id: k.id, Amount: v})
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if bids {
+			return items[i].Price > items[j].Price
+		}
+		return items[i].Price < items[j].Price
+	})
+	if len(items) > depth {
+		items = items[:depth]
+	}
+	return items
+}
+
+func watchOrderbook(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	var pair string
+	if c.IsSet("pair") {
+		pair = c.String("pair")
+	} else {
+		pair = c.Args().Get(1)
+	}
+	if !validPair(pair) {
+		return errInvalidPair
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	depth := c.Int("depth")
+	if depth <= 0 {
+		depth = defaultWatchDepth
+	}
+	refresh := c.Duration("refresh")
+	if refresh <= 0 {
+		return errInvalidWatchRefresh
+	}
+
+	p, err := currency.NewPairDelimiter(pair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	// Streams run until interrupted so they must not inherit the request
+	// timeout applied by setupClient
+	streamCtx, streamCancel := context.WithCancel(c.Context)
+	defer streamCancel()
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	stream, err := client.GetOrderbookDeltaStream(streamCtx,
+		&gctrpc.GetOrderbookStreamRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+				Delimiter: p.Delimiter,
+			},
+			AssetType: assetType,
+		})
+	if err != nil {
+		return err
+	}
+
+	var m sync.Mutex
+	var streamErr error
+	ladder := newOrderbookLadder()
+	go func() {
+		for {
+			resp, err := stream.Recv()
+			m.Lock()
+			if err != nil {
+				streamErr = err
+				m.Unlock()
+				return
+			}
+			ladder.apply(resp)
+			m.Unlock()
+		}
+	}()
+
+	ticker := time.NewTicker(refresh)
+	defer ticker.Stop()
+	for {
+		m.Lock()
+		if streamErr != nil {
+			m.Unlock()
+			return streamErr
+		}
+		bids := sortedOrderbookSide(ladder.bids, true, depth)
+		asks := sortedOrderbookSide(ladder.asks, false, depth)
+		updates := ladder.updates
+		m.Unlock()
+
+		if err = clearScreen(); err != nil {
+			return err
+		}
+		fmt.Printf("Watching %s %s %s orderbook, refreshing every %v. Press Ctrl+C to exit\n",
+			exchangeName,
+			p,
+			assetType,
+			refresh)
+		if len(bids) > 0 && len(asks) > 0 {
+			mid := (bids[0].Price + asks[0].Price) / 2
+			fmt.Printf("Updates: %d Mid: %.8f Spread: %.8f (%.4f%%)\n\n",
+				updates,
+				mid,
+				asks[0].Price-bids[0].Price,
+				(asks[0].Price-bids[0].Price)/mid*100)
+		} else {
+			fmt.Printf("Updates: %d\n\n", updates)
+		}
+
+		tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+		fmt.Fprintf(tw, "BID %s\tBID\t\tASK\tASK %s\t\n", p.Base, p.Base)
+		for i := 0; i < depth && (i < len(bids) || i < len(asks)); i++ {
+			var bidAmount, bidPrice, askPrice, askAmount string
+			if i < len(bids) {
+				bidAmount = fmt.Sprintf("%.8f", bids[i].Amount)
+				bidPrice = fmt.Sprintf("%.8f", bids[i].Price)
+			}
+			if i < len(asks) {
+				askPrice = fmt.Sprintf("%.8f", asks[i].Price)
+				askAmount = fmt.Sprintf("%.8f", asks[i].Amount)
+			}
+			fmt.Fprintf(tw, "%s\t%s\t|\t%s\t%s\t\n", bidAmount, bidPrice, askPrice, askAmount)
+		}
+		if err = tw.Flush(); err != nil {
+			return err
+		}
+		select {
+		case <-streamCtx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
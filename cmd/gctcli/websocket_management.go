@@ -59,6 +59,17 @@ var websocketManagerCommand = &cli.Command{
 			},
 			Action: getSubscriptions,
 		},
+		{
+			Name:  "getmetrics",
+			Usage: "returns message rates, payload bytes and processing lag for an exchange's websocket connections and channels",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "exchange",
+					Usage: "the exchange to act on",
+				},
+			},
+			Action: getWebsocketMetrics,
+		},
 		{
 			Name:  "setproxy",
 			Usage: "sets exchange websocket proxy, flushes and reroutes connection",
@@ -177,6 +188,34 @@ func getSubscriptions(c *cli.Context) error {
 	return nil
 }
 
+func getWebsocketMetrics(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var exchange string
+	if c.IsSet("exchange") {
+		exchange = c.String("exchange")
+	} else {
+		exchange = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.WebsocketGetMetrics(c.Context,
+		&gctrpc.WebsocketGetMetricsRequest{Exchange: exchange})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
 func setProxy(c *cli.Context) error {
 	if c.NArg() == 0 && c.NumFlags() == 0 {
 		return cli.ShowSubcommandHelp(c)
@@ -0,0 +1,432 @@
+package main
+
+import (
+	"strconv"
+
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var withdrawalAddressBookCommand = &cli.Command{
+	Name:      "withdrawaladdressbook",
+	Usage:     "manage the labelled addresses cryptocurrency can be withdrawn to",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "get",
+			Usage:     "gets the address book entries which can be withdrawn to from an exchange",
+			ArgsUsage: "<exchange>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "exchange",
+					Usage: "the exchange to filter addresses by, optional",
+				},
+			},
+			Action: getWithdrawalAddresses,
+		},
+		{
+			Name:      "add",
+			Usage:     "adds a labelled address to the address book",
+			ArgsUsage: "<label> <currency> <address> <addresstag> <chain> <exchange> <whitelisted>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "label",
+					Usage: "the unique label of the address",
+				},
+				&cli.StringFlag{
+					Name:  "currency",
+					Usage: "the cryptocurrency the address receives",
+				},
+				&cli.StringFlag{
+					Name:  "address",
+					Usage: "the address to withdraw to",
+				},
+				&cli.StringFlag{
+					Name:  "addresstag",
+					Usage: "address tag/memo",
+				},
+				&cli.StringFlag{
+					Name:  "chain",
+					Usage: "the chain of the address, empty matches any chain",
+				},
+				&cli.StringFlag{
+					Name:  "exchange",
+					Usage: "the exchange which can withdraw to the address, empty allows any exchange",
+				},
+				&cli.BoolFlag{
+					Name:  "whitelisted",
+					Usage: "whether the address can receive withdrawals",
+				},
+			},
+			Action: addWithdrawalAddress,
+		},
+		{
+			Name:      "remove",
+			Usage:     "removes a labelled address from the address book",
+			ArgsUsage: "<label>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "label",
+					Usage: "the label of the address",
+				},
+			},
+			Action: removeWithdrawalAddress,
+		},
+		{
+			Name:      "whitelist",
+			Usage:     "sets whether a labelled address can receive withdrawals",
+			ArgsUsage: "<label> <whitelisted>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "label",
+					Usage: "the label of the address",
+				},
+				&cli.BoolFlag{
+					Name:  "whitelisted",
+					Usage: "whether the address can receive withdrawals",
+				},
+			},
+			Action: whitelistWithdrawalAddress,
+		},
+		{
+			Name:      "rename",
+			Usage:     "changes the label of an address",
+			ArgsUsage: "<label> <newlabel>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "label",
+					Usage: "the current label of the address",
+				},
+				&cli.StringFlag{
+					Name:  "newlabel",
+					Usage: "the new label of the address",
+				},
+			},
+			Action: renameWithdrawalAddress,
+		},
+	},
+}
+
+var withdrawalApprovalCommand = &cli.Command{
+	Name:      "withdrawalapproval",
+	Usage:     "manage cryptocurrency withdrawals awaiting approval",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:   "get",
+			Usage:  "gets the withdrawals awaiting approval",
+			Action: getPendingWithdrawals,
+		},
+		{
+			Name:      "approve",
+			Usage:     "approves a pending withdrawal and submits it to the exchange",
+			ArgsUsage: "<id> <code>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "id",
+					Usage: "the id of the pending withdrawal",
+				},
+				&cli.StringFlag{
+					Name:  "code",
+					Usage: "the one time password, required when an approval OTP secret is configured",
+				},
+			},
+			Action: approveWithdrawal,
+		},
+		{
+			Name:      "reject",
+			Usage:     "rejects a pending withdrawal without submitting it",
+			ArgsUsage: "<id>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "id",
+					Usage: "the id of the pending withdrawal",
+				},
+			},
+			Action: rejectWithdrawal,
+		},
+	},
+}
+
+func getWithdrawalAddresses(c *cli.Context) error {
+	exchangeName := c.String("exchange")
+	if !c.IsSet("exchange") {
+		exchangeName = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetWithdrawalAddresses(c.Context, &gctrpc.GetWithdrawalAddressesRequest{
+		Exchange: exchangeName,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func addWithdrawalAddress(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var label, cur, address, addressTag, chain, exchangeName string
+	var whitelisted bool
+
+	if c.IsSet("label") {
+		label = c.String("label")
+	} else {
+		label = c.Args().Get(0)
+	}
+
+	if c.IsSet("currency") {
+		cur = c.String("currency")
+	} else {
+		cur = c.Args().Get(1)
+	}
+
+	if c.IsSet("address") {
+		address = c.String("address")
+	} else {
+		address = c.Args().Get(2)
+	}
+
+	if c.IsSet("addresstag") {
+		addressTag = c.String("addresstag")
+	} else {
+		addressTag = c.Args().Get(3)
+	}
+
+	if c.IsSet("chain") {
+		chain = c.String("chain")
+	} else {
+		chain = c.Args().Get(4)
+	}
+
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().Get(5)
+	}
+
+	if c.IsSet("whitelisted") {
+		whitelisted = c.Bool("whitelisted")
+	} else if c.Args().Get(6) != "" {
+		var err error
+		whitelisted, err = strconv.ParseBool(c.Args().Get(6))
+		if err != nil {
+			return err
+		}
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.AddWithdrawalAddress(c.Context, &gctrpc.AddWithdrawalAddressRequest{
+		Label:       label,
+		Exchange:    exchangeName,
+		Currency:    cur,
+		Address:     address,
+		AddressTag:  addressTag,
+		Chain:       chain,
+		Whitelisted: whitelisted,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func removeWithdrawalAddress(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	label := c.String("label")
+	if !c.IsSet("label") {
+		label = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.RemoveWithdrawalAddress(c.Context, &gctrpc.RemoveWithdrawalAddressRequest{
+		Label: label,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func whitelistWithdrawalAddress(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	label := c.String("label")
+	if !c.IsSet("label") {
+		label = c.Args().Get(0)
+	}
+
+	var whitelisted bool
+	if c.IsSet("whitelisted") {
+		whitelisted = c.Bool("whitelisted")
+	} else if c.Args().Get(1) != "" {
+		var err error
+		whitelisted, err = strconv.ParseBool(c.Args().Get(1))
+		if err != nil {
+			return err
+		}
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.WhitelistWithdrawalAddress(c.Context, &gctrpc.WhitelistWithdrawalAddressRequest{
+		Label:       label,
+		Whitelisted: whitelisted,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func renameWithdrawalAddress(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	label := c.String("label")
+	if !c.IsSet("label") {
+		label = c.Args().Get(0)
+	}
+
+	newLabel := c.String("newlabel")
+	if !c.IsSet("newlabel") {
+		newLabel = c.Args().Get(1)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.RenameWithdrawalAddress(c.Context, &gctrpc.RenameWithdrawalAddressRequest{
+		Label:    label,
+		NewLabel: newLabel,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getPendingWithdrawals(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetPendingWithdrawals(c.Context, &gctrpc.GetPendingWithdrawalsRequest{})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func approveWithdrawal(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	id := c.String("id")
+	if !c.IsSet("id") {
+		id = c.Args().Get(0)
+	}
+
+	code := c.String("code")
+	if !c.IsSet("code") {
+		code = c.Args().Get(1)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.ApproveWithdrawal(c.Context, &gctrpc.ApproveWithdrawalRequest{
+		Id:   id,
+		Code: code,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func rejectWithdrawal(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	id := c.String("id")
+	if !c.IsSet("id") {
+		id = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.RejectWithdrawal(c.Context, &gctrpc.RejectWithdrawalRequest{
+		Id: id,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
@@ -1030,6 +1030,12 @@ func (c *Config) CheckExchangeConfigValues() error {
 				publishPeriod := DefaultOrderbookPublishPeriod
 				c.Exchanges[i].Orderbook.PublishPeriod = &publishPeriod
 			}
+			if c.Exchanges[i].Orderbook.MaxDepth < 0 {
+				log.Warnf(log.ConfigMgr,
+					"Exchange %s orderbook max depth value cannot be negative, retaining full depth.",
+					c.Exchanges[i].Name)
+				c.Exchanges[i].Orderbook.MaxDepth = 0
+			}
 			err := c.CheckPairConsistency(c.Exchanges[i].Name)
 			if err != nil {
 				log.Errorf(log.ConfigMgr,
@@ -1428,6 +1434,166 @@ func (c *Config) CheckDataHistoryMonitorConfig() {
 	if c.DataHistoryManager.MaxJobsPerCycle == 0 {
 		c.DataHistoryManager.MaxJobsPerCycle = defaultMaxJobsPerCycle
 	}
+	if c.DataHistoryManager.MaxJobsPerExchange < 0 {
+		c.DataHistoryManager.MaxJobsPerExchange = 0
+	}
+	if c.DataHistoryManager.ExchangeRequestDelay < 0 {
+		c.DataHistoryManager.ExchangeRequestDelay = 0
+	}
+}
+
+// CheckDataSyncManagerConfig ensures the data sync config is valid, or sets
+// default values
+func (c *Config) CheckDataSyncManagerConfig() {
+	m.Lock()
+	defer m.Unlock()
+	if c.DataSyncManager.CheckInterval <= 0 {
+		c.DataSyncManager.CheckInterval = defaultDataSyncCheckInterval
+	}
+	if c.DataSyncManager.MaxRequestsPerCycle <= 0 {
+		c.DataSyncManager.MaxRequestsPerCycle = defaultDataSyncMaxRequestsPerCycle
+	}
+	if c.DataSyncManager.RequestDelay < 0 {
+		c.DataSyncManager.RequestDelay = 0
+	}
+}
+
+// CheckCurrencyPairSyncerConfig ensures the currency pair syncer config is
+// valid, removing any pair settings which cannot be matched
+func (c *Config) CheckCurrencyPairSyncerConfig() {
+	m.Lock()
+	defer m.Unlock()
+	pairs := c.CurrencyPairSyncer.Pairs[:0]
+	for i := range c.CurrencyPairSyncer.Pairs {
+		item := c.CurrencyPairSyncer.Pairs[i]
+		if item.Exchange == "" || item.Pair.IsEmpty() || !item.Asset.IsValid() {
+			log.Warnf(log.ConfigMgr,
+				"Currency pair syncer pair setting %d is missing an exchange, asset or pair and has been removed",
+				i)
+			continue
+		}
+		if item.Interval < 0 {
+			item.Interval = 0
+		}
+		pairs = append(pairs, item)
+	}
+	c.CurrencyPairSyncer.Pairs = pairs
+}
+
+// CheckOrderbookRecorderConfig ensures the orderbook recorder config is valid,
+// or sets default values
+func (c *Config) CheckOrderbookRecorderConfig() {
+	m.Lock()
+	defer m.Unlock()
+	if c.OrderbookRecorder.Interval <= 0 {
+		c.OrderbookRecorder.Interval = defaultOrderbookRecorderInterval
+	}
+	if c.OrderbookRecorder.Depth <= 0 {
+		c.OrderbookRecorder.Depth = defaultOrderbookRecorderDepth
+	}
+}
+
+// CheckDataRetentionManagerConfig ensures the data retention config is valid,
+// or sets default values
+func (c *Config) CheckDataRetentionManagerConfig() {
+	m.Lock()
+	defer m.Unlock()
+	if c.DataRetentionManager.CheckInterval <= 0 {
+		c.DataRetentionManager.CheckInterval = defaultDataRetentionCheckInterval
+	}
+}
+
+// CheckRateLimitBudgetManagerConfig ensures the rate limit budget manager
+// config is valid, removing any budgets which cannot be applied
+func (c *Config) CheckRateLimitBudgetManagerConfig() {
+	m.Lock()
+	defer m.Unlock()
+	budgets := c.RateLimitBudget.Budgets[:0]
+	for i := range c.RateLimitBudget.Budgets {
+		b := c.RateLimitBudget.Budgets[i]
+		if b.Exchange == "" || b.Requests <= 0 || b.Interval <= 0 {
+			log.Warnf(log.ConfigMgr,
+				"Rate limit budget %d is missing an exchange, requests or interval and has been removed",
+				i)
+			continue
+		}
+		if b.BackgroundShare <= 0 || b.BackgroundShare > 1 {
+			b.BackgroundShare = defaultRateLimitBudgetBackground
+		}
+		budgets = append(budgets, b)
+	}
+	c.RateLimitBudget.Budgets = budgets
+}
+
+// CheckWebhookManagerConfig ensures the webhook manager config is valid, or
+// sets default values
+func (c *Config) CheckWebhookManagerConfig() {
+	m.Lock()
+	defer m.Unlock()
+	if c.WebhookManager.Delay <= 0 {
+		c.WebhookManager.Delay = defaultWebhookManagerDelay
+	}
+	if c.WebhookManager.Timeout <= 0 {
+		c.WebhookManager.Timeout = defaultWebhookTimeout
+	}
+	if c.WebhookManager.MaxRetries <= 0 {
+		c.WebhookManager.MaxRetries = defaultWebhookMaxRetries
+	}
+}
+
+// CheckDepositManagerConfig ensures the deposit manager config is valid, or
+// sets default values
+func (c *Config) CheckDepositManagerConfig() {
+	m.Lock()
+	defer m.Unlock()
+	if c.DepositManager.CheckInterval <= 0 {
+		c.DepositManager.CheckInterval = defaultDepositManagerCheckInterval
+	}
+	if c.DepositManager.AddressRefreshInterval <= 0 {
+		c.DepositManager.AddressRefreshInterval = defaultDepositAddressRefreshInterval
+	}
+}
+
+// CheckArbitrageScannerConfig ensures the arbitrage scanner config is valid,
+// or sets default values
+func (c *Config) CheckArbitrageScannerConfig() {
+	m.Lock()
+	defer m.Unlock()
+	if c.ArbitrageScanner.CheckInterval <= 0 {
+		c.ArbitrageScanner.CheckInterval = defaultArbitrageScannerInterval
+	}
+	if c.ArbitrageScanner.Asset == "" {
+		c.ArbitrageScanner.Asset = asset.Spot
+	}
+	if c.ArbitrageScanner.Amount <= 0 {
+		c.ArbitrageScanner.Amount = defaultArbitrageScannerAmount
+	}
+	if c.ArbitrageScanner.MinimumProfitPercent < 0 {
+		c.ArbitrageScanner.MinimumProfitPercent = 0
+	}
+}
+
+// CheckTriangularArbitrageConfig ensures the triangular arbitrage detector
+// config is valid, or sets default values
+func (c *Config) CheckTriangularArbitrageConfig() {
+	m.Lock()
+	defer m.Unlock()
+	if c.TriangularArbitrage.CheckInterval <= 0 {
+		c.TriangularArbitrage.CheckInterval = defaultTriangularArbitrageInterval
+	}
+	if c.TriangularArbitrage.MinimumProfitPercent < 0 {
+		c.TriangularArbitrage.MinimumProfitPercent = 0
+	}
+}
+
+// CheckWithdrawManagerConfig ensures the withdraw manager config is valid, or
+// sets default values
+func (c *Config) CheckWithdrawManagerConfig() {
+	m.Lock()
+	defer m.Unlock()
+	if c.WithdrawManager.ApprovalTimeout <= 0 {
+		c.WithdrawManager.ApprovalTimeout = defaultWithdrawalApprovalTimeout
+	}
 }
 
 // CheckCurrencyStateManager ensures the currency state config is valid, or sets
@@ -1790,6 +1956,16 @@ func (c *Config) CheckConfig() error {
 
 	c.CheckConnectionMonitorConfig()
 	c.CheckDataHistoryMonitorConfig()
+	c.CheckDataSyncManagerConfig()
+	c.CheckCurrencyPairSyncerConfig()
+	c.CheckOrderbookRecorderConfig()
+	c.CheckDataRetentionManagerConfig()
+	c.CheckRateLimitBudgetManagerConfig()
+	c.CheckWebhookManagerConfig()
+	c.CheckDepositManagerConfig()
+	c.CheckArbitrageScannerConfig()
+	c.CheckTriangularArbitrageConfig()
+	c.CheckWithdrawManagerConfig()
 	c.CheckCurrencyStateManager()
 	c.CheckCommunicationsConfig()
 	c.CheckClientBankAccounts()
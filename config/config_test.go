@@ -1828,6 +1828,103 @@ func TestCheckConnectionMonitorConfig(t *testing.T) {
 	}
 }
 
+func TestCheckCurrencyPairSyncerConfig(t *testing.T) {
+	t.Parallel()
+	var c Config
+	c.CurrencyPairSyncer.Pairs = []CurrencyPairSyncItem{
+		{Exchange: "Binance", Asset: asset.Spot, Pair: currency.NewPair(currency.BTC, currency.USDT), Interval: -time.Second},
+		{Exchange: "Binance", Asset: asset.Spot},
+		{Asset: asset.Spot, Pair: currency.NewPair(currency.BTC, currency.USDT)},
+	}
+	c.CheckCurrencyPairSyncerConfig()
+	if len(c.CurrencyPairSyncer.Pairs) != 1 {
+		t.Fatalf("received '%v', expected '%v'", len(c.CurrencyPairSyncer.Pairs), 1)
+	}
+	if c.CurrencyPairSyncer.Pairs[0].Interval != 0 {
+		t.Errorf("received '%v', expected '%v'", c.CurrencyPairSyncer.Pairs[0].Interval, 0)
+	}
+}
+
+func TestCheckRateLimitBudgetManagerConfig(t *testing.T) {
+	t.Parallel()
+	var c Config
+	c.RateLimitBudget.Budgets = []RateLimitBudget{
+		{Exchange: "Binance", Requests: 1200, Interval: time.Minute},
+		{Exchange: "Bitstamp", Requests: 8000, Interval: time.Minute * 10, BackgroundShare: 0.25},
+		{Exchange: "Kraken", Interval: time.Second},
+		{Requests: 10, Interval: time.Second},
+	}
+	c.CheckRateLimitBudgetManagerConfig()
+	if len(c.RateLimitBudget.Budgets) != 2 {
+		t.Fatalf("received '%v', expected '%v'", len(c.RateLimitBudget.Budgets), 2)
+	}
+	if c.RateLimitBudget.Budgets[0].BackgroundShare != defaultRateLimitBudgetBackground {
+		t.Errorf("received '%v', expected '%v'", c.RateLimitBudget.Budgets[0].BackgroundShare, defaultRateLimitBudgetBackground)
+	}
+	if c.RateLimitBudget.Budgets[1].BackgroundShare != 0.25 {
+		t.Errorf("received '%v', expected '%v'", c.RateLimitBudget.Budgets[1].BackgroundShare, 0.25)
+	}
+}
+
+func TestCheckDepositManagerConfig(t *testing.T) {
+	t.Parallel()
+	var c Config
+	c.CheckDepositManagerConfig()
+	if c.DepositManager.CheckInterval != defaultDepositManagerCheckInterval {
+		t.Errorf("received '%v', expected '%v'", c.DepositManager.CheckInterval, defaultDepositManagerCheckInterval)
+	}
+	if c.DepositManager.AddressRefreshInterval != defaultDepositAddressRefreshInterval {
+		t.Errorf("received '%v', expected '%v'", c.DepositManager.AddressRefreshInterval, defaultDepositAddressRefreshInterval)
+	}
+	c.DepositManager.CheckInterval = time.Second
+	c.CheckDepositManagerConfig()
+	if c.DepositManager.CheckInterval != time.Second {
+		t.Errorf("received '%v', expected '%v'", c.DepositManager.CheckInterval, time.Second)
+	}
+}
+
+func TestCheckArbitrageScannerConfig(t *testing.T) {
+	t.Parallel()
+	var c Config
+	c.ArbitrageScanner.MinimumProfitPercent = -1
+	c.CheckArbitrageScannerConfig()
+	if c.ArbitrageScanner.CheckInterval != defaultArbitrageScannerInterval {
+		t.Errorf("received '%v', expected '%v'", c.ArbitrageScanner.CheckInterval, defaultArbitrageScannerInterval)
+	}
+	if c.ArbitrageScanner.Asset != asset.Spot {
+		t.Errorf("received '%v', expected '%v'", c.ArbitrageScanner.Asset, asset.Spot)
+	}
+	if c.ArbitrageScanner.Amount != defaultArbitrageScannerAmount {
+		t.Errorf("received '%v', expected '%v'", c.ArbitrageScanner.Amount, defaultArbitrageScannerAmount)
+	}
+	if c.ArbitrageScanner.MinimumProfitPercent != 0 {
+		t.Errorf("received '%v', expected '%v'", c.ArbitrageScanner.MinimumProfitPercent, 0)
+	}
+	c.ArbitrageScanner.Amount = 5
+	c.CheckArbitrageScannerConfig()
+	if c.ArbitrageScanner.Amount != 5 {
+		t.Errorf("received '%v', expected '%v'", c.ArbitrageScanner.Amount, 5)
+	}
+}
+
+func TestCheckTriangularArbitrageConfig(t *testing.T) {
+	t.Parallel()
+	var c Config
+	c.TriangularArbitrage.MinimumProfitPercent = -1
+	c.CheckTriangularArbitrageConfig()
+	if c.TriangularArbitrage.CheckInterval != defaultTriangularArbitrageInterval {
+		t.Errorf("received '%v', expected '%v'", c.TriangularArbitrage.CheckInterval, defaultTriangularArbitrageInterval)
+	}
+	if c.TriangularArbitrage.MinimumProfitPercent != 0 {
+		t.Errorf("received '%v', expected '%v'", c.TriangularArbitrage.MinimumProfitPercent, 0)
+	}
+	c.TriangularArbitrage.CheckInterval = time.Minute
+	c.CheckTriangularArbitrageConfig()
+	if c.TriangularArbitrage.CheckInterval != time.Minute {
+		t.Errorf("received '%v', expected '%v'", c.TriangularArbitrage.CheckInterval, time.Minute)
+	}
+}
+
 func TestDefaultFilePath(t *testing.T) {
 	// This is tricky to test because we're dealing with a config file stored
 	// in a persons default directory and to properly test it, it would
@@ -8,6 +8,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 	"github.com/thrasher-corp/gocryptotrader/log"
@@ -39,6 +41,21 @@ const (
 	defaultDataHistoryMonitorCheckTimer  = time.Minute
 	defaultCurrencyStateManagerDelay     = time.Minute
 	defaultMaxJobsPerCycle               = 5
+	defaultDataSyncCheckInterval         = time.Minute
+	defaultDataSyncMaxRequestsPerCycle   = 10
+	defaultOrderbookRecorderInterval     = time.Minute
+	defaultOrderbookRecorderDepth        = 20
+	defaultDataRetentionCheckInterval    = time.Hour
+	defaultWebhookManagerDelay           = time.Second * 30
+	defaultWebhookTimeout                = time.Second * 10
+	defaultWebhookMaxRetries             = 3
+	defaultWithdrawalApprovalTimeout     = time.Minute * 15
+	defaultRateLimitBudgetBackground     = 0.5
+	defaultDepositManagerCheckInterval   = time.Minute
+	defaultDepositAddressRefreshInterval = time.Hour
+	defaultArbitrageScannerInterval      = time.Second * 10
+	defaultArbitrageScannerAmount        = 1
+	defaultTriangularArbitrageInterval   = time.Second * 10
 	DefaultOrderbookPublishPeriod        = time.Second * 10
 )
 
@@ -80,9 +97,22 @@ type Config struct {
 	GlobalHTTPTimeout    time.Duration             `json:"globalHTTPTimeout"`
 	Database             database.Config           `json:"database"`
 	Logging              log.Config                `json:"logging"`
+	OrderManager         OrderManagerConfig        `json:"orderManager"`
 	ConnectionMonitor    ConnectionMonitorConfig   `json:"connectionMonitor"`
 	DataHistoryManager   DataHistoryManager        `json:"dataHistoryManager"`
+	DataSyncManager      DataSyncManager           `json:"dataSyncManager"`
+	CurrencyPairSyncer   CurrencyPairSyncer        `json:"currencyPairSyncer"`
+	OrderbookRecorder    OrderbookRecorder         `json:"orderbookRecorder"`
+	DataRetentionManager DataRetentionManager      `json:"dataRetentionManager"`
+	RateLimitBudget      RateLimitBudgetManager    `json:"rateLimitBudgetManager"`
 	CurrencyStateManager CurrencyStateManager      `json:"currencyStateManager"`
+	PaperTrading         PaperTradingConfig        `json:"paperTrading"`
+	OrderPersistence     OrderPersistenceConfig    `json:"orderPersistence"`
+	WebhookManager       WebhookManager            `json:"webhookManager"`
+	DepositManager       DepositManager            `json:"depositManager"`
+	ArbitrageScanner     ArbitrageScanner          `json:"arbitrageScanner"`
+	TriangularArbitrage  TriangularArbitrage       `json:"triangularArbitrage"`
+	WithdrawManager      WithdrawManager           `json:"withdrawManager"`
 	Profiler             Profiler                  `json:"profiler"`
 	NTPClient            NTPClientConfig           `json:"ntpclient"`
 	GCTScript            gctscript.Config          `json:"gctscript"`
@@ -106,11 +136,114 @@ type Config struct {
 
 // DataHistoryManager holds all information required for the data history manager
 type DataHistoryManager struct {
-	Enabled             bool          `json:"enabled"`
-	CheckInterval       time.Duration `json:"checkInterval"`
-	MaxJobsPerCycle     int64         `json:"maxJobsPerCycle"`
-	MaxResultInsertions int64         `json:"maxResultInsertions"`
-	Verbose             bool          `json:"verbose"`
+	Enabled              bool          `json:"enabled"`
+	CheckInterval        time.Duration `json:"checkInterval"`
+	MaxJobsPerCycle      int64         `json:"maxJobsPerCycle"`
+	MaxResultInsertions  int64         `json:"maxResultInsertions"`
+	MaxJobsPerExchange   int64         `json:"maxJobsPerExchange,omitempty"`
+	ExchangeRequestDelay time.Duration `json:"exchangeRequestDelay,omitempty"`
+	Verbose              bool          `json:"verbose"`
+}
+
+// DataSyncManager holds all information required for the data sync manager
+// to keep database candle and trade history up to date
+type DataSyncManager struct {
+	Enabled             bool           `json:"enabled"`
+	CheckInterval       time.Duration  `json:"checkInterval"`
+	RequestDelay        time.Duration  `json:"requestDelay"`
+	MaxRequestsPerCycle int64          `json:"maxRequestsPerCycle"`
+	Verbose             bool           `json:"verbose"`
+	Items               []DataSyncItem `json:"items"`
+}
+
+// DataSyncItem defines an exchange, asset, pair and interval which will be
+// continuously synced to the database. BackfillStart determines how far back
+// missing history is retrieved on startup
+type DataSyncItem struct {
+	Exchange      string         `json:"exchange"`
+	Asset         asset.Item     `json:"asset"`
+	Pair          currency.Pair  `json:"pair"`
+	Interval      kline.Interval `json:"interval"`
+	SyncTrades    bool           `json:"syncTrades"`
+	BackfillStart time.Time      `json:"backfillStart"`
+}
+
+// CurrencyPairSyncer holds per pair settings for the exchange currency pair
+// syncer
+type CurrencyPairSyncer struct {
+	Pairs []CurrencyPairSyncItem `json:"pairs"`
+}
+
+// CurrencyPairSyncItem defines an exchange, asset and pair which is synced
+// with a custom priority and interval. Pairs with a higher priority are
+// synced before others and a non-zero interval replaces the REST sync timeout
+// for the pair
+type CurrencyPairSyncItem struct {
+	Exchange string        `json:"exchange"`
+	Asset    asset.Item    `json:"asset"`
+	Pair     currency.Pair `json:"pair"`
+	Priority int           `json:"priority,omitempty"`
+	Interval time.Duration `json:"interval,omitempty"`
+}
+
+// OrderbookRecorder holds all information required for the orderbook
+// recorder to periodically store orderbook snapshots to the database
+type OrderbookRecorder struct {
+	Enabled  bool                    `json:"enabled"`
+	Interval time.Duration           `json:"interval"`
+	Depth    int                     `json:"depth"`
+	Verbose  bool                    `json:"verbose"`
+	Items    []OrderbookRecorderItem `json:"items"`
+}
+
+// OrderbookRecorderItem defines an exchange, asset and pair to record. Interval
+// and depth override the recorder defaults when set
+type OrderbookRecorderItem struct {
+	Exchange string        `json:"exchange"`
+	Asset    asset.Item    `json:"asset"`
+	Pair     currency.Pair `json:"pair"`
+	Interval time.Duration `json:"interval,omitempty"`
+	Depth    int           `json:"depth,omitempty"`
+}
+
+// DataRetentionManager holds all information required for the data retention
+// manager to prune and downsample old candle and trade data
+type DataRetentionManager struct {
+	Enabled       bool                  `json:"enabled"`
+	CheckInterval time.Duration         `json:"checkInterval"`
+	Verbose       bool                  `json:"verbose"`
+	Policies      []DataRetentionPolicy `json:"policies"`
+}
+
+// DataRetentionPolicy defines how long candles of an interval, or trades, are
+// retained. An empty exchange applies the policy to all exchanges. When
+// DownsampleTo is set, expired data is aggregated into candles of that interval
+// before it is deleted
+type DataRetentionPolicy struct {
+	DataType     string         `json:"dataType"`
+	Exchange     string         `json:"exchange,omitempty"`
+	Interval     kline.Interval `json:"interval,omitempty"`
+	RetainFor    time.Duration  `json:"retainFor"`
+	DownsampleTo kline.Interval `json:"downsampleTo,omitempty"`
+}
+
+// RateLimitBudgetManager holds all information required for the rate limit
+// budget manager to arbitrate exchange REST requests between subsystems
+type RateLimitBudgetManager struct {
+	Enabled bool              `json:"enabled"`
+	Verbose bool              `json:"verbose"`
+	Budgets []RateLimitBudget `json:"budgets"`
+}
+
+// RateLimitBudget defines how many REST requests can be sent to an exchange
+// per interval. BackgroundShare is the fraction of the budget the currency pair
+// syncer and data history subsystems can consume, the remainder is reserved
+// for user RPC calls and order management
+type RateLimitBudget struct {
+	Exchange        string        `json:"exchange"`
+	Requests        int           `json:"requests"`
+	Interval        time.Duration `json:"interval"`
+	BackgroundShare float64       `json:"backgroundShare"`
 }
 
 // CurrencyStateManager defines a set of configuration options for the currency
@@ -120,6 +253,103 @@ type CurrencyStateManager struct {
 	Delay   time.Duration `json:"delay"`
 }
 
+// WebhookManager defines the endpoints which account activity is posted to
+// and how often that activity is checked
+type WebhookManager struct {
+	Enabled    bool              `json:"enabled"`
+	Delay      time.Duration     `json:"delay"`
+	Timeout    time.Duration     `json:"timeout"`
+	MaxRetries int               `json:"maxRetries"`
+	Verbose    bool              `json:"verbose"`
+	Endpoints  []WebhookEndpoint `json:"endpoints"`
+}
+
+// DepositManager defines how often deposit addresses are refreshed and
+// funding history is checked for inbound deposits. When no currencies are set,
+// addresses are fetched for the cryptocurrencies of each exchange's enabled
+// spot pairs
+type DepositManager struct {
+	Enabled                bool          `json:"enabled"`
+	Verbose                bool          `json:"verbose"`
+	CheckInterval          time.Duration `json:"checkInterval"`
+	AddressRefreshInterval time.Duration `json:"addressRefreshInterval"`
+	Currencies             []string      `json:"currencies,omitempty"`
+}
+
+// ArbitrageScanner defines the pairs which are compared across enabled
+// exchanges for arbitrage opportunities. Amount is the base currency amount
+// an opportunity is sized for and only opportunities with a profit after
+// trading and withdrawal fees of at least MinimumProfitPercent are reported
+type ArbitrageScanner struct {
+	Enabled               bool           `json:"enabled"`
+	Verbose               bool           `json:"verbose"`
+	CheckInterval         time.Duration  `json:"checkInterval"`
+	Asset                 asset.Item     `json:"asset"`
+	Pairs                 currency.Pairs `json:"pairs"`
+	Amount                float64        `json:"amount"`
+	MinimumProfitPercent  float64        `json:"minimumProfitPercent"`
+	IncludeWithdrawalFees bool           `json:"includeWithdrawalFees"`
+}
+
+// TriangularArbitrage defines the exchanges whose spot pairs are searched for
+// triangular arbitrage cycles. When no exchanges are set, all enabled
+// exchanges are searched. When start currencies are set, only cycles which
+// begin and end in one of them are reported
+type TriangularArbitrage struct {
+	Enabled              bool          `json:"enabled"`
+	Verbose              bool          `json:"verbose"`
+	CheckInterval        time.Duration `json:"checkInterval"`
+	Exchanges            []string      `json:"exchanges,omitempty"`
+	StartCurrencies      []string      `json:"startCurrencies,omitempty"`
+	MinimumProfitPercent float64       `json:"minimumProfitPercent"`
+}
+
+// WebhookEndpoint defines a URL which receives webhook events. When a secret
+// is set, payloads are signed with it so the receiver can verify them. An
+// empty list of events subscribes the endpoint to all events
+type WebhookEndpoint struct {
+	Name   string   `json:"name"`
+	URL    string   `json:"url"`
+	Secret string   `json:"secret,omitempty"`
+	Events []string `json:"events,omitempty"`
+}
+
+// WithdrawManager defines the address book cryptocurrency withdrawals are
+// checked against and whether they must be approved before being submitted.
+// When an approval OTP secret is set, approvals require a valid one time
+// password generated from it. When a status check interval is set, submitted
+// withdrawals are polled on their exchange until they complete or fail
+type WithdrawManager struct {
+	AddressBookPath     string        `json:"addressBookPath"`
+	EnforceAddressBook  bool          `json:"enforceAddressBook"`
+	RequireApproval     bool          `json:"requireApproval"`
+	ApprovalOTPSecret   string        `json:"approvalOTPSecret,omitempty"`
+	ApprovalTimeout     time.Duration `json:"approvalTimeout"`
+	StatusCheckInterval time.Duration `json:"statusCheckInterval"`
+}
+
+// PaperTradingConfig defines whether orders are simulated against live
+// orderbooks instead of being submitted to exchanges, and the virtual balances
+// to start with
+type PaperTradingConfig struct {
+	Enabled  bool                  `json:"enabled"`
+	Balances []PaperTradingBalance `json:"balances"`
+}
+
+// OrderPersistenceConfig defines whether the order manager stores its active
+// orders in the database so they can be restored after a restart
+type OrderPersistenceConfig struct {
+	Enabled bool `json:"enabled"`
+}
+
+// PaperTradingBalance defines the starting virtual balance of a currency on an
+// exchange
+type PaperTradingBalance struct {
+	Exchange string        `json:"exchange"`
+	Currency currency.Code `json:"currency"`
+	Amount   float64       `json:"amount"`
+}
+
 // ConnectionMonitorConfig defines the connection monitor variables to ensure
 // that there is internet connectivity
 type ConnectionMonitorConfig struct {
@@ -128,6 +358,12 @@ type ConnectionMonitorConfig struct {
 	CheckInterval    time.Duration `json:"checkInterval"`
 }
 
+// OrderManagerConfig defines how the order manager vets orders before they
+// are submitted
+type OrderManagerConfig struct {
+	RejectInsufficientDepth bool `json:"rejectInsufficientDepth"`
+}
+
 // Exchange holds all the information needed for each enabled Exchange.
 type Exchange struct {
 	Name                          string                 `json:"name"`
@@ -258,6 +494,7 @@ type CurrencyConfig struct {
 	FiatDisplayCurrency           currency.Code             `json:"fiatDisplayCurrency"`
 	CurrencyFileUpdateDuration    time.Duration             `json:"currencyFileUpdateDuration"`
 	ForeignExchangeUpdateDuration time.Duration             `json:"foreignExchangeUpdateDuration"`
+	ForeignExchangeCacheDuration  time.Duration             `json:"foreignExchangeCacheDuration,omitempty"`
 }
 
 // CryptocurrencyProvider defines coinmarketcap tools
@@ -342,4 +579,8 @@ type Orderbook struct {
 	// PublishPeriod here is a pointer because we want to distinguish
 	// between zeroed out and missing.
 	PublishPeriod *time.Duration `json:"publishPeriod"`
+	// MaxDepth caps the price levels retained in memory on each side of the
+	// exchange's orderbooks, levels furthest from the spread are removed.
+	// Zero retains full depth
+	MaxDepth int `json:"maxDepth,omitempty"`
 }
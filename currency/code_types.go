@@ -1666,4 +1666,9 @@ var (
 	SRM        = NewCode("SRM")
 	FTT        = NewCode("FTT")
 	UST        = NewCode("UST")
+	GUSD       = NewCode("GUSD")
+	USDP       = NewCode("USDP")
+	HUSD       = NewCode("HUSD")
+	EURT       = NewCode("EURT")
+	EURS       = NewCode("EURS")
 )
@@ -15,6 +15,7 @@ type MainConfiguration struct {
 	FiatDisplayCurrency    Code
 	CurrencyDelay          time.Duration
 	FxRateDelay            time.Duration
+	FxRateCacheTTL         time.Duration
 }
 
 // BotOverrides defines a bot overriding factor for quick running currency
@@ -49,6 +50,9 @@ type FXSettings struct {
 	APIKey           string        `json:"apiKey"`
 	APIKeyLvl        int           `json:"apiKeyLvl"`
 	PrimaryProvider  bool          `json:"primaryProvider"`
+	// Priority defines the failover order of support providers, lower
+	// values are attempted first
+	Priority int `json:"priority,omitempty"`
 }
 
 // File defines a full currency file generated by the currency storage
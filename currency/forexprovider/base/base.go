@@ -36,4 +36,7 @@ type Settings struct {
 	APIKey           string        `json:"apiKey"`
 	APIKeyLvl        int           `json:"apiKeyLvl"`
 	PrimaryProvider  bool          `json:"primaryProvider"`
+	// Priority defines the failover order of support providers, lower
+	// values are attempted first
+	Priority int `json:"priority,omitempty"`
 }
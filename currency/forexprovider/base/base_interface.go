@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/log"
@@ -26,7 +27,12 @@ type IFXProvider interface {
 type FXHandler struct {
 	Primary Provider
 	Support []Provider
-	mtx     sync.Mutex
+	// CacheTTL defines how long a fetched rate is served from cache before a
+	// provider is queried again, zero disables cache hits but stale rates are
+	// still served when every provider fails
+	CacheTTL time.Duration
+	cache    map[string]CachedRate
+	mtx      sync.Mutex
 }
 
 // Provider defines a singular foreign exchange provider with its supported
@@ -36,6 +42,7 @@ type FXHandler struct {
 type Provider struct {
 	Provider            IFXProvider
 	SupportedCurrencies []string
+	Priority            int
 }
 
 // GetNewRate access rates by predetermined logic based on how a provider
@@ -68,7 +75,11 @@ func (p Provider) CheckCurrencies(currencies []string) []string {
 	return spillOver
 }
 
-// GetCurrencyData returns currency data from enabled FX providers
+// GetCurrencyData returns currency data from enabled FX providers. Rates are
+// served from cache while within the cache TTL, otherwise the primary provider
+// is queried with any failures or unsupported currencies falling through to the
+// support providers in priority order. If all providers fail, previously
+// cached rates are returned regardless of age
 func (f *FXHandler) GetCurrencyData(baseCurrency string, currencies []string) (map[string]float64, error) {
 	var fullRange = currencies
 
@@ -83,13 +94,36 @@ func (f *FXHandler) GetCurrencyData(baseCurrency string, currencies []string) (m
 		return nil, errors.New("primary foreign exchange provider details not set")
 	}
 
+	if rates, ok := f.getCachedRates(baseCurrency, currencies, false); ok {
+		return rates, nil
+	}
+
+	rates, err := f.getProviderRates(baseCurrency, currencies, fullRange)
+	if err != nil {
+		cached, ok := f.getCachedRates(baseCurrency, currencies, true)
+		if !ok {
+			return nil, err
+		}
+		log.Warnf(log.Global, "foreign exchange providers failed, using cached rates: %v", err)
+		return cached, nil
+	}
+	f.setCachedRates(rates)
+	return rates, nil
+}
+
+// getProviderRates fetches rates from the primary provider and falls through to
+// the support providers for failures or unsupported currencies
+func (f *FXHandler) getProviderRates(baseCurrency string, currencies, fullRange []string) (map[string]float64, error) {
 	shunt := f.Primary.CheckCurrencies(fullRange)
 	rates, err := f.Primary.GetNewRate(baseCurrency, currencies)
 	if err != nil {
+		log.Warnf(log.Global, "%s failed to update rate map, attempting support providers: %v",
+			f.Primary.Provider.GetName(),
+			err)
 		return f.backupGetRate(baseCurrency, currencies)
 	}
 
-	if len(shunt) != 0 {
+	if len(shunt) == 0 {
 		return rates, nil
 	}
 
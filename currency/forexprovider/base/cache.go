@@ -0,0 +1,101 @@
+package base
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+)
+
+var errNoCachedRates = errors.New("no cached foreign exchange rates")
+
+// CachedRate defines a foreign exchange rate and the time it was retrieved
+type CachedRate struct {
+	Rate    float64   `json:"rate"`
+	Updated time.Time `json:"updated"`
+}
+
+// getCachedRates returns cached rates for every requested currency. When
+// allowStale is false, rates older than the cache TTL are treated as missing.
+// The handler lock must be held by the caller
+func (f *FXHandler) getCachedRates(baseCurrency string, currencies []string, allowStale bool) (map[string]float64, bool) {
+	if len(f.cache) == 0 || len(currencies) == 0 {
+		return nil, false
+	}
+	if !allowStale && f.CacheTTL <= 0 {
+		return nil, false
+	}
+	rates := make(map[string]float64, len(currencies))
+	for i := range currencies {
+		if strings.EqualFold(baseCurrency, currencies[i]) {
+			continue
+		}
+		key := strings.ToUpper(baseCurrency + currencies[i])
+		cached, ok := f.cache[key]
+		if !ok {
+			return nil, false
+		}
+		if !allowStale && time.Since(cached.Updated) > f.CacheTTL {
+			return nil, false
+		}
+		rates[key] = cached.Rate
+	}
+	return rates, true
+}
+
+// setCachedRates stores freshly retrieved rates. The handler lock must be held
+// by the caller
+func (f *FXHandler) setCachedRates(rates map[string]float64) {
+	if f.cache == nil {
+		f.cache = make(map[string]CachedRate, len(rates))
+	}
+	now := time.Now()
+	for k, v := range rates {
+		f.cache[strings.ToUpper(k)] = CachedRate{Rate: v, Updated: now}
+	}
+}
+
+// SaveCache writes the cached foreign exchange rates to disk so they can be
+// reloaded when providers are unavailable on startup
+func (f *FXHandler) SaveCache(path string) error {
+	f.mtx.Lock()
+	if len(f.cache) == 0 {
+		f.mtx.Unlock()
+		return errNoCachedRates
+	}
+	data, err := json.MarshalIndent(f.cache, "", " ")
+	f.mtx.Unlock()
+	if err != nil {
+		return err
+	}
+	return file.Write(path, data)
+}
+
+// LoadCache loads cached foreign exchange rates from disk, retaining their
+// original retrieval times. Existing rates which are more recent are kept
+func (f *FXHandler) LoadCache(path string) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var loaded map[string]CachedRate
+	err = json.Unmarshal(data, &loaded)
+	if err != nil {
+		return err
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	if f.cache == nil {
+		f.cache = make(map[string]CachedRate, len(loaded))
+	}
+	for k, v := range loaded {
+		if existing, ok := f.cache[k]; ok && existing.Updated.After(v.Updated) {
+			continue
+		}
+		f.cache[k] = v
+	}
+	return nil
+}
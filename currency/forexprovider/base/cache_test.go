@@ -0,0 +1,122 @@
+package base
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+var errProviderDown = errors.New("provider down")
+
+type mockProvider struct {
+	name  string
+	rates map[string]float64
+	err   error
+	calls int
+}
+
+func (m *mockProvider) Setup(Settings) error    { return nil }
+func (m *mockProvider) GetName() string         { return m.name }
+func (m *mockProvider) IsEnabled() bool         { return true }
+func (m *mockProvider) IsPrimaryProvider() bool { return false }
+func (m *mockProvider) GetSupportedCurrencies() ([]string, error) {
+	return []string{"USD", "AUD"}, nil
+}
+
+func (m *mockProvider) GetRates(_, _ string) (map[string]float64, error) {
+	m.calls++
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.rates, nil
+}
+
+func TestGetCurrencyDataCache(t *testing.T) {
+	t.Parallel()
+	primary := &mockProvider{name: "primary", rates: map[string]float64{"USDAUD": 1.5}}
+	f := FXHandler{
+		Primary:  Provider{Provider: primary, SupportedCurrencies: []string{"USD", "AUD"}},
+		CacheTTL: time.Hour,
+	}
+	rates, err := f.GetCurrencyData("USD", []string{"USD", "AUD"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rates["USDAUD"] != 1.5 {
+		t.Errorf("received '%v' expected '%v'", rates["USDAUD"], 1.5)
+	}
+	_, err = f.GetCurrencyData("USD", []string{"USD", "AUD"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if primary.calls != 1 {
+		t.Errorf("received '%v' expected '%v'", primary.calls, 1)
+	}
+
+	// Expire the cache and fail the provider, stale rates should be served
+	f.CacheTTL = 0
+	primary.err = errProviderDown
+	rates, err = f.GetCurrencyData("USD", []string{"USD", "AUD"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rates["USDAUD"] != 1.5 {
+		t.Errorf("received '%v' expected '%v'", rates["USDAUD"], 1.5)
+	}
+	_, err = f.GetCurrencyData("USD", []string{"USD", "EUR"})
+	if err == nil {
+		t.Error("expected error when no cached rate is available")
+	}
+}
+
+func TestGetCurrencyDataFailover(t *testing.T) {
+	t.Parallel()
+	primary := &mockProvider{name: "primary", err: errProviderDown}
+	low := &mockProvider{name: "low", rates: map[string]float64{"USDAUD": 2}}
+	high := &mockProvider{name: "high", rates: map[string]float64{"USDAUD": 3}}
+	f := FXHandler{
+		Primary: Provider{Provider: primary, SupportedCurrencies: []string{"USD", "AUD"}},
+		Support: []Provider{
+			{Provider: high, SupportedCurrencies: []string{"USD", "AUD"}, Priority: 1},
+			{Provider: low, SupportedCurrencies: []string{"USD", "AUD"}, Priority: 2},
+		},
+	}
+	rates, err := f.GetCurrencyData("USD", []string{"USD", "AUD"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rates["USDAUD"] != 3 {
+		t.Errorf("received '%v' expected '%v'", rates["USDAUD"], 3)
+	}
+	if low.calls != 0 {
+		t.Errorf("received '%v' expected '%v'", low.calls, 0)
+	}
+}
+
+func TestSaveLoadCache(t *testing.T) {
+	t.Parallel()
+	var f FXHandler
+	path := filepath.Join(t.TempDir(), "forexcache.json")
+	err := f.SaveCache(path)
+	if !errors.Is(err, errNoCachedRates) {
+		t.Errorf("received '%v' expected '%v'", err, errNoCachedRates)
+	}
+	f.setCachedRates(map[string]float64{"usdaud": 1.5})
+	err = f.SaveCache(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var loaded FXHandler
+	err = loaded.LoadCache(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rates, ok := loaded.getCachedRates("USD", []string{"AUD"}, true)
+	if !ok {
+		t.Fatal("expected cached rates")
+	}
+	if rates["USDAUD"] != 1.5 {
+		t.Errorf("received '%v' expected '%v'", rates["USDAUD"], 1.5)
+	}
+}
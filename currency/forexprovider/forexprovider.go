@@ -4,6 +4,7 @@ package forexprovider
 
 import (
 	"errors"
+	"sort"
 
 	"github.com/thrasher-corp/gocryptotrader/currency/forexprovider/base"
 	currencyconverter "github.com/thrasher-corp/gocryptotrader/currency/forexprovider/currencyconverterapi"
@@ -57,8 +58,9 @@ func NewDefaultFXProvider() *ForexProviders {
 	return handler
 }
 
-// SetProvider sets provider to the FX handler
-func (f *ForexProviders) SetProvider(b base.IFXProvider) error {
+// SetProvider sets provider to the FX handler, support providers are ordered
+// by their priority for failover
+func (f *ForexProviders) SetProvider(b base.IFXProvider, priority int) error {
 	currencies, err := b.GetSupportedCurrencies()
 	if err != nil {
 		return err
@@ -67,16 +69,18 @@ func (f *ForexProviders) SetProvider(b base.IFXProvider) error {
 	providerBase := base.Provider{
 		Provider:            b,
 		SupportedCurrencies: currencies,
+		Priority:            priority,
 	}
 
 	if b.IsPrimaryProvider() {
-		f.FXHandler = base.FXHandler{
-			Primary: providerBase,
-		}
+		f.FXHandler.Primary = providerBase
 		return nil
 	}
 
 	f.FXHandler.Support = append(f.FXHandler.Support, providerBase)
+	sort.SliceStable(f.FXHandler.Support, func(i, j int) bool {
+		return f.FXHandler.Support[i].Priority < f.FXHandler.Support[j].Priority
+	})
 	return nil
 }
 
@@ -93,7 +97,7 @@ func StartFXService(fxProviders []base.Settings) (*ForexProviders, error) {
 				return nil, err
 			}
 
-			err = handler.SetProvider(provider)
+			err = handler.SetProvider(provider, fxProviders[i].Priority)
 			if err != nil {
 				return nil, err
 			}
@@ -104,7 +108,7 @@ func StartFXService(fxProviders []base.Settings) (*ForexProviders, error) {
 				return nil, err
 			}
 
-			err = handler.SetProvider(provider)
+			err = handler.SetProvider(provider, fxProviders[i].Priority)
 			if err != nil {
 				return nil, err
 			}
@@ -115,7 +119,7 @@ func StartFXService(fxProviders []base.Settings) (*ForexProviders, error) {
 				return nil, err
 			}
 
-			err = handler.SetProvider(provider)
+			err = handler.SetProvider(provider, fxProviders[i].Priority)
 			if err != nil {
 				return nil, err
 			}
@@ -126,7 +130,7 @@ func StartFXService(fxProviders []base.Settings) (*ForexProviders, error) {
 				return nil, err
 			}
 
-			err = handler.SetProvider(provider)
+			err = handler.SetProvider(provider, fxProviders[i].Priority)
 			if err != nil {
 				return nil, err
 			}
@@ -137,7 +141,7 @@ func StartFXService(fxProviders []base.Settings) (*ForexProviders, error) {
 				return nil, err
 			}
 
-			err = handler.SetProvider(provider)
+			err = handler.SetProvider(provider, fxProviders[i].Priority)
 			if err != nil {
 				return nil, err
 			}
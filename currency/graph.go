@@ -0,0 +1,230 @@
+package currency
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+var (
+	// ErrNoConversionPath is returned when two currencies cannot be converted
+	// between using the pairs of a graph
+	ErrNoConversionPath = errors.New("no conversion path between currencies")
+
+	errConversionCurrencyUnset = errors.New("conversion currency unset")
+	errInvalidConversionSteps  = errors.New("max conversion steps must be greater than zero")
+	errNilConversionRate       = errors.New("conversion rate function cannot be nil")
+)
+
+// PairGraph is a conversion graph of currencies where each pair links its
+// base and quote currency in both directions
+type PairGraph struct {
+	codes map[*Item]Code
+	edges map[*Item]map[*Item]Pair
+}
+
+// ConversionStep defines converting one currency to another by trading a
+// pair. When From is the pair's base currency the pair is sold, otherwise it
+// is bought
+type ConversionStep struct {
+	Pair Pair
+	From Code
+	To   Code
+}
+
+// ConversionPath defines the steps to convert one currency to another and the
+// amount of the final currency received per unit of the first
+type ConversionPath struct {
+	Steps []ConversionStep
+	Rate  float64
+}
+
+// ConversionRate returns the amount of a step's To currency received per unit
+// of its From currency, after any costs. False is returned when the step
+// cannot currently be priced
+type ConversionRate func(ConversionStep) (float64, bool)
+
+// NewPairGraph builds a conversion graph from a set of pairs. Pairs with an
+// empty or identical base and quote currency are ignored
+func NewPairGraph(pairs Pairs) *PairGraph {
+	g := &PairGraph{
+		codes: make(map[*Item]Code),
+		edges: make(map[*Item]map[*Item]Pair),
+	}
+	for i := range pairs {
+		base, quote := pairs[i].Base, pairs[i].Quote
+		if base.IsEmpty() || quote.IsEmpty() || base.Match(quote) {
+			continue
+		}
+		for _, c := range []Code{base, quote} {
+			if _, ok := g.codes[c.Item]; !ok {
+				g.codes[c.Item] = c.Upper()
+				g.edges[c.Item] = make(map[*Item]Pair)
+			}
+		}
+		g.edges[base.Item][quote.Item] = pairs[i]
+		g.edges[quote.Item][base.Item] = pairs[i]
+	}
+	return g
+}
+
+// IsSell returns whether the step sells the pair's base currency
+func (s ConversionStep) IsSell() bool {
+	return s.Pair.Base.Match(s.From)
+}
+
+// Currencies returns every currency in the graph in alphabetical order
+func (g *PairGraph) Currencies() Currencies {
+	resp := make(Currencies, 0, len(g.codes))
+	for _, c := range g.codes {
+		resp = append(resp, c)
+	}
+	sortCodes(resp)
+	return resp
+}
+
+// Neighbours returns the currencies which a currency can be directly
+// converted to in alphabetical order
+func (g *PairGraph) Neighbours(c Code) Currencies {
+	resp := make(Currencies, 0, len(g.edges[c.Item]))
+	for item := range g.edges[c.Item] {
+		resp = append(resp, g.codes[item])
+	}
+	sortCodes(resp)
+	return resp
+}
+
+// GetPair returns the pair which directly converts between two currencies
+func (g *PairGraph) GetPair(from, to Code) (Pair, bool) {
+	p, ok := g.edges[from.Item][to.Item]
+	return p, ok
+}
+
+// Triangles returns every set of three currencies which can each be directly
+// converted to the other two, with each set's currencies in alphabetical
+// order
+func (g *PairGraph) Triangles() [][3]Code {
+	nodes := g.Currencies()
+	var triangles [][3]Code
+	for i := range nodes {
+		for j := i + 1; j < len(nodes); j++ {
+			if _, ok := g.edges[nodes[i].Item][nodes[j].Item]; !ok {
+				continue
+			}
+			for k := j + 1; k < len(nodes); k++ {
+				if _, ok := g.edges[nodes[j].Item][nodes[k].Item]; !ok {
+					continue
+				}
+				if _, ok := g.edges[nodes[i].Item][nodes[k].Item]; ok {
+					triangles = append(triangles, [3]Code{nodes[i], nodes[j], nodes[k]})
+				}
+			}
+		}
+	}
+	return triangles
+}
+
+// ShortestPath returns the conversion steps between two currencies which use
+// the fewest trades
+func (g *PairGraph) ShortestPath(from, to Code) ([]ConversionStep, error) {
+	if from.IsEmpty() || to.IsEmpty() {
+		return nil, errConversionCurrencyUnset
+	}
+	if from.Match(to) {
+		return nil, nil
+	}
+	if _, ok := g.codes[from.Item]; !ok {
+		return nil, fmt.Errorf("%w %s to %s", ErrNoConversionPath, from, to)
+	}
+	previous := map[*Item]*Item{from.Item: nil}
+	queue := []*Item{from.Item}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, next := range g.Neighbours(g.codes[current]) {
+			if _, seen := previous[next.Item]; seen {
+				continue
+			}
+			previous[next.Item] = current
+			if next.Item != to.Item {
+				queue = append(queue, next.Item)
+				continue
+			}
+			var steps []ConversionStep
+			for node := next.Item; previous[node] != nil; node = previous[node] {
+				steps = append([]ConversionStep{{
+					Pair: g.edges[previous[node]][node],
+					From: g.codes[previous[node]],
+					To:   g.codes[node],
+				}}, steps...)
+			}
+			return steps, nil
+		}
+	}
+	return nil, fmt.Errorf("%w %s to %s", ErrNoConversionPath, from, to)
+}
+
+// CheapestPath returns the conversion path between two currencies of at most
+// maxSteps trades which returns the most of the final currency per unit of
+// the first, as priced by the supplied rate function. Paths never visit a
+// currency more than once
+func (g *PairGraph) CheapestPath(from, to Code, maxSteps int, rate ConversionRate) (*ConversionPath, error) {
+	if from.IsEmpty() || to.IsEmpty() {
+		return nil, errConversionCurrencyUnset
+	}
+	if maxSteps <= 0 {
+		return nil, errInvalidConversionSteps
+	}
+	if rate == nil {
+		return nil, errNilConversionRate
+	}
+	if from.Match(to) {
+		return &ConversionPath{Rate: 1}, nil
+	}
+	var best *ConversionPath
+	visited := map[*Item]bool{from.Item: true}
+	var steps []ConversionStep
+	var walk func(current *Item, cumulative float64)
+	walk = func(current *Item, cumulative float64) {
+		for _, next := range g.Neighbours(g.codes[current]) {
+			if visited[next.Item] {
+				continue
+			}
+			step := ConversionStep{
+				Pair: g.edges[current][next.Item],
+				From: g.codes[current],
+				To:   next,
+			}
+			r, ok := rate(step)
+			if !ok || r <= 0 {
+				continue
+			}
+			steps = append(steps, step)
+			if next.Item == to.Item {
+				if best == nil || cumulative*r > best.Rate {
+					best = &ConversionPath{
+						Steps: append([]ConversionStep(nil), steps...),
+						Rate:  cumulative * r,
+					}
+				}
+			} else if len(steps) < maxSteps {
+				visited[next.Item] = true
+				walk(next.Item, cumulative*r)
+				visited[next.Item] = false
+			}
+			steps = steps[:len(steps)-1]
+		}
+	}
+	walk(from.Item, 1)
+	if best == nil {
+		return nil, fmt.Errorf("%w %s to %s", ErrNoConversionPath, from, to)
+	}
+	return best, nil
+}
+
+// sortCodes sorts currencies alphabetically
+func sortCodes(c Currencies) {
+	sort.Slice(c, func(i, j int) bool {
+		return c[i].String() < c[j].String()
+	})
+}
@@ -0,0 +1,147 @@
+package currency
+
+import (
+	"errors"
+	"testing"
+)
+
+var graphPairs = Pairs{
+	NewPair(BTC, USDT),
+	NewPair(ETH, USDT),
+	NewPair(ETH, BTC),
+	NewPair(LTC, BTC),
+	NewPair(LTC, ETH),
+	NewPair(XRP, LTC),
+	NewPair(BTC, BTC),
+}
+
+func TestNewPairGraph(t *testing.T) {
+	t.Parallel()
+	g := NewPairGraph(graphPairs)
+	if c := g.Currencies(); len(c) != 5 || !c[0].Match(BTC) || !c[4].Match(XRP) {
+		t.Errorf("unexpected currencies %v", c)
+	}
+	if n := g.Neighbours(LTC); len(n) != 3 || !n[0].Match(BTC) || !n[1].Match(ETH) || !n[2].Match(XRP) {
+		t.Errorf("unexpected neighbours %v", n)
+	}
+	p, ok := g.GetPair(USDT, ETH)
+	if !ok || !p.Equal(NewPair(ETH, USDT)) {
+		t.Errorf("received '%v' expected '%v'", p, NewPair(ETH, USDT))
+	}
+	if _, ok = g.GetPair(XRP, USDT); ok {
+		t.Error("expected no direct pair")
+	}
+	if _, ok = g.GetPair(BTC, BTC); ok {
+		t.Error("expected pairs with matching currencies to be ignored")
+	}
+}
+
+func TestPairGraphTriangles(t *testing.T) {
+	t.Parallel()
+	triangles := NewPairGraph(graphPairs).Triangles()
+	if len(triangles) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(triangles), 2)
+	}
+	if triangles[0] != [3]Code{BTC, ETH, LTC} || triangles[1] != [3]Code{BTC, ETH, USDT} {
+		t.Errorf("unexpected triangles %v", triangles)
+	}
+}
+
+func TestPairGraphShortestPath(t *testing.T) {
+	t.Parallel()
+	g := NewPairGraph(graphPairs)
+	_, err := g.ShortestPath(Code{}, BTC)
+	if !errors.Is(err, errConversionCurrencyUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errConversionCurrencyUnset)
+	}
+	_, err = g.ShortestPath(AUD, BTC)
+	if !errors.Is(err, ErrNoConversionPath) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNoConversionPath)
+	}
+	_, err = g.ShortestPath(BTC, AUD)
+	if !errors.Is(err, ErrNoConversionPath) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNoConversionPath)
+	}
+	steps, err := g.ShortestPath(BTC, BTC)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(steps) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(steps), 0)
+	}
+	steps, err = g.ShortestPath(USDT, XRP)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(steps) != 3 {
+		t.Fatalf("received '%v' expected '%v'", len(steps), 3)
+	}
+	if !steps[0].From.Match(USDT) || !steps[0].To.Match(BTC) || steps[0].IsSell() {
+		t.Errorf("unexpected first step %+v", steps[0])
+	}
+	if !steps[2].Pair.Equal(NewPair(XRP, LTC)) || !steps[2].To.Match(XRP) || steps[2].IsSell() {
+		t.Errorf("unexpected last step %+v", steps[2])
+	}
+}
+
+func TestPairGraphCheapestPath(t *testing.T) {
+	t.Parallel()
+	g := NewPairGraph(graphPairs)
+	prices := map[Pair]float64{
+		NewPair(BTC, USDT): 100,
+		NewPair(ETH, USDT): 11,
+		NewPair(ETH, BTC):  0.1,
+		NewPair(LTC, BTC):  0.5,
+		NewPair(LTC, ETH):  4,
+	}
+	rate := func(s ConversionStep) (float64, bool) {
+		price, ok := prices[s.Pair]
+		if !ok {
+			return 0, false
+		}
+		if s.IsSell() {
+			return price, true
+		}
+		return 1 / price, true
+	}
+	_, err := g.CheapestPath(Code{}, BTC, 3, rate)
+	if !errors.Is(err, errConversionCurrencyUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errConversionCurrencyUnset)
+	}
+	_, err = g.CheapestPath(USDT, BTC, 0, rate)
+	if !errors.Is(err, errInvalidConversionSteps) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidConversionSteps)
+	}
+	_, err = g.CheapestPath(USDT, BTC, 3, nil)
+	if !errors.Is(err, errNilConversionRate) {
+		t.Errorf("received '%v' expected '%v'", err, errNilConversionRate)
+	}
+	_, err = g.CheapestPath(USDT, XRP, 3, rate)
+	if !errors.Is(err, ErrNoConversionPath) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNoConversionPath)
+	}
+	path, err := g.CheapestPath(USDT, USDT, 3, rate)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if path.Rate != 1 || len(path.Steps) != 0 {
+		t.Errorf("unexpected path %+v", path)
+	}
+
+	// USDT to ETH directly returns 1/11 ETH, whereas routing via BTC returns
+	// 1/100/0.1 = 1/10 ETH
+	path, err = g.CheapestPath(USDT, ETH, 3, rate)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(path.Steps) != 2 || !path.Steps[0].To.Match(BTC) || path.Rate != 1.0/100*(1/0.1) {
+		t.Errorf("unexpected path %+v", path)
+	}
+	path, err = g.CheapestPath(USDT, ETH, 1, rate)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(path.Steps) != 1 || path.Rate != 1.0/11 {
+		t.Errorf("unexpected path %+v", path)
+	}
+}
@@ -0,0 +1,153 @@
+package currency
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+)
+
+var (
+	errStablecoinPegUnset  = errors.New("stablecoin peg currency unset")
+	errStablecoinUnset     = errors.New("stablecoin currency unset")
+	errNotStablecoin       = errors.New("currency is not a registered stablecoin")
+	errInvalidPegPrice     = errors.New("stablecoin price must be greater than zero")
+	errInvalidPegTolerance = errors.New("peg tolerance cannot be negative")
+)
+
+// DefaultPegTolerance defines the default percentage a stablecoin may deviate
+// from its pegged fiat currency before it is considered depegged
+const DefaultPegTolerance = 1.0
+
+// Stablecoin defines a stablecoin and the fiat currency it is pegged to
+type Stablecoin struct {
+	Currency Code
+	Peg      Code
+}
+
+// PegStatus defines a stablecoin price observation relative to its peg
+type PegStatus struct {
+	Stablecoin Code
+	Peg        Code
+	// Price is the stablecoin price denominated in the pegged currency
+	Price float64
+	// Deviation is the percentage difference of the price from parity
+	Deviation float64
+	Depegged  bool
+}
+
+type stablecoins struct {
+	pegs map[*Item]Code
+	m    sync.RWMutex
+}
+
+var stable = stablecoins{
+	pegs: map[*Item]Code{
+		USDT.Item: USD,
+		USDC.Item: USD,
+		BUSD.Item: USD,
+		TUSD.Item: USD,
+		DAI.Item:  USD,
+		PAX.Item:  USD,
+		USDP.Item: USD,
+		GUSD.Item: USD,
+		HUSD.Item: USD,
+		UST.Item:  USD,
+		EURT.Item: EUR,
+		EURS.Item: EUR,
+	},
+}
+
+// RegisterStablecoin classifies a currency as a stablecoin pegged to the
+// supplied fiat currency, overwriting any existing classification
+func RegisterStablecoin(c, peg Code) error {
+	if c.IsEmpty() {
+		return errStablecoinUnset
+	}
+	if peg.IsEmpty() {
+		return errStablecoinPegUnset
+	}
+	stable.m.Lock()
+	stable.pegs[c.Item] = peg.Upper()
+	stable.m.Unlock()
+	return nil
+}
+
+// GetStablecoins returns all registered stablecoins and their pegs
+func GetStablecoins() []Stablecoin {
+	stable.m.RLock()
+	defer stable.m.RUnlock()
+	resp := make([]Stablecoin, 0, len(stable.pegs))
+	for item, peg := range stable.pegs {
+		resp = append(resp, Stablecoin{
+			Currency: Code{Item: item, UpperCase: true},
+			Peg:      peg,
+		})
+	}
+	return resp
+}
+
+// IsStablecoin returns if the currency is a registered stablecoin
+func (c Code) IsStablecoin() bool {
+	_, err := c.GetStablecoinPeg()
+	return err == nil
+}
+
+// GetStablecoinPeg returns the fiat currency the stablecoin is pegged to
+func (c Code) GetStablecoinPeg() (Code, error) {
+	if c.IsEmpty() {
+		return Code{}, errStablecoinUnset
+	}
+	stable.m.RLock()
+	peg, ok := stable.pegs[c.Item]
+	stable.m.RUnlock()
+	if !ok {
+		return Code{}, fmt.Errorf("%s %w", c, errNotStablecoin)
+	}
+	return peg, nil
+}
+
+// GetCashEquivalent returns the fiat currency a stablecoin is pegged to,
+// otherwise the currency itself is returned
+func (c Code) GetCashEquivalent() Code {
+	peg, err := c.GetStablecoinPeg()
+	if err != nil {
+		return c
+	}
+	return peg
+}
+
+// IsCashEquivalent returns if both currencies resolve to the same fiat currency
+// or pegged stablecoin e.g. USD, USDT and USDC are cash equivalents
+func (c Code) IsCashEquivalent(check Code) bool {
+	if c.IsEmpty() || check.IsEmpty() {
+		return false
+	}
+	return c.GetCashEquivalent().Match(check.GetCashEquivalent())
+}
+
+// CheckPeg returns the peg status of a stablecoin using a price denominated in
+// its pegged currency. A tolerance of zero uses the DefaultPegTolerance
+func (c Code) CheckPeg(price, tolerance float64) (PegStatus, error) {
+	peg, err := c.GetStablecoinPeg()
+	if err != nil {
+		return PegStatus{}, err
+	}
+	if price <= 0 {
+		return PegStatus{}, errInvalidPegPrice
+	}
+	if tolerance < 0 {
+		return PegStatus{}, errInvalidPegTolerance
+	}
+	if tolerance == 0 {
+		tolerance = DefaultPegTolerance
+	}
+	deviation := (price - 1) * 100
+	return PegStatus{
+		Stablecoin: c,
+		Peg:        peg,
+		Price:      price,
+		Deviation:  deviation,
+		Depegged:   math.Abs(deviation) > tolerance,
+	}, nil
+}
@@ -0,0 +1,101 @@
+package currency
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRegisterStablecoin(t *testing.T) {
+	t.Parallel()
+	err := RegisterStablecoin(Code{}, USD)
+	if !errors.Is(err, errStablecoinUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errStablecoinUnset)
+	}
+	err = RegisterStablecoin(NewCode("TESTSTABLE"), Code{})
+	if !errors.Is(err, errStablecoinPegUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errStablecoinPegUnset)
+	}
+	err = RegisterStablecoin(NewCode("TESTSTABLE"), AUD)
+	if err != nil {
+		t.Fatal(err)
+	}
+	peg, err := NewCode("TESTSTABLE").GetStablecoinPeg()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !peg.Match(AUD) {
+		t.Errorf("received '%v' expected '%v'", peg, AUD)
+	}
+	var found bool
+	for _, s := range GetStablecoins() {
+		if s.Currency.Match(NewCode("TESTSTABLE")) {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("expected registered stablecoin to be returned")
+	}
+}
+
+func TestIsStablecoin(t *testing.T) {
+	t.Parallel()
+	if !USDT.IsStablecoin() {
+		t.Errorf("received '%v' expected '%v'", false, true)
+	}
+	if BTC.IsStablecoin() {
+		t.Errorf("received '%v' expected '%v'", true, false)
+	}
+	_, err := BTC.GetStablecoinPeg()
+	if !errors.Is(err, errNotStablecoin) {
+		t.Errorf("received '%v' expected '%v'", err, errNotStablecoin)
+	}
+}
+
+func TestIsCashEquivalent(t *testing.T) {
+	t.Parallel()
+	if !USDT.IsCashEquivalent(USDC) {
+		t.Error("expected USDT and USDC to be cash equivalents")
+	}
+	if !USD.IsCashEquivalent(BUSD) {
+		t.Error("expected USD and BUSD to be cash equivalents")
+	}
+	if USDT.IsCashEquivalent(EURT) {
+		t.Error("expected USDT and EURT to not be cash equivalents")
+	}
+	if BTC.IsCashEquivalent(Code{}) {
+		t.Error("expected empty code to not be a cash equivalent")
+	}
+	if !BTC.GetCashEquivalent().Match(BTC) {
+		t.Errorf("received '%v' expected '%v'", BTC.GetCashEquivalent(), BTC)
+	}
+}
+
+func TestCheckPeg(t *testing.T) {
+	t.Parallel()
+	_, err := BTC.CheckPeg(1, 0)
+	if !errors.Is(err, errNotStablecoin) {
+		t.Errorf("received '%v' expected '%v'", err, errNotStablecoin)
+	}
+	_, err = USDT.CheckPeg(0, 0)
+	if !errors.Is(err, errInvalidPegPrice) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidPegPrice)
+	}
+	_, err = USDT.CheckPeg(1, -1)
+	if !errors.Is(err, errInvalidPegTolerance) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidPegTolerance)
+	}
+	status, err := USDT.CheckPeg(0.995, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Depegged {
+		t.Errorf("received '%v' expected '%v'", status.Depegged, false)
+	}
+	status, err = USDT.CheckPeg(0.95, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !status.Depegged || !status.Peg.Match(USD) {
+		t.Errorf("unexpected peg status %+v", status)
+	}
+}
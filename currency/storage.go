@@ -169,6 +169,15 @@ func (s *Storage) RunUpdater(overrides BotOverrides, settings *MainConfiguration
 			"Primary foreign exchange conversion provider %s enabled\n",
 			s.fiatExchangeMarkets.Primary.Provider.GetName())
 
+		s.fiatExchangeMarkets.CacheTTL = forexCacheTTL(settings.FxRateCacheTTL, s.foreignExchangeUpdateDelay)
+		s.fxCachePath = filepath.Join(filePath, DefaultForexCacheFile)
+		if file.Exists(s.fxCachePath) {
+			err = s.fiatExchangeMarkets.LoadCache(s.fxCachePath)
+			if err != nil {
+				log.Warnf(log.Global, "Unable to load foreign exchange rate cache: %v", err)
+			}
+		}
+
 		for i := range s.fiatExchangeMarkets.Support {
 			log.Debugf(log.Global,
 				"Support forex conversion provider %s enabled\n",
@@ -187,6 +196,22 @@ func (s *Storage) RunUpdater(overrides BotOverrides, settings *MainConfiguration
 	return nil
 }
 
+// forexCacheTTL returns how long fetched foreign exchange rates are served
+// from cache. Rates are not cached for longer than the update delay, otherwise
+// rates from before the last update would still be served
+func forexCacheTTL(ttl, updateDelay time.Duration) time.Duration {
+	if ttl == 0 {
+		ttl = DefaultForexCacheTTL
+	}
+	if updateDelay > 0 && ttl > updateDelay {
+		if ttl != DefaultForexCacheTTL {
+			log.Warnf(log.Global, "Foreign exchange cache duration %s exceeds the update delay %s, using %s", ttl, updateDelay, updateDelay)
+		}
+		ttl = updateDelay
+	}
+	return ttl
+}
+
 // SetupConversionRates sets default conversion rate values
 func (s *Storage) SetupConversionRates() {
 	s.fxRates = ConversionRates{
@@ -518,6 +543,12 @@ func (s *Storage) SeedForeignExchangeRates() error {
 	if err != nil {
 		return err
 	}
+	if s.fxCachePath != "" {
+		err = s.fiatExchangeMarkets.SaveCache(s.fxCachePath)
+		if err != nil {
+			log.Warnf(log.Global, "Unable to save foreign exchange rate cache: %v", err)
+		}
+	}
 	return s.updateExchangeRates(rates)
 }
 
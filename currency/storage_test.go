@@ -1,6 +1,9 @@
 package currency
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 func TestRunUpdater(t *testing.T) {
 	var newStorage Storage
@@ -26,3 +29,22 @@ func TestRunUpdater(t *testing.T) {
 		t.Fatal("storage RunUpdater() error", err)
 	}
 }
+
+func TestForexCacheTTL(t *testing.T) {
+	t.Parallel()
+	if DefaultForexCacheTTL > DefaultForeignExchangeDelay {
+		t.Errorf("default cache TTL %v exceeds default update delay %v", DefaultForexCacheTTL, DefaultForeignExchangeDelay)
+	}
+	for _, tt := range []struct {
+		ttl, delay, expected time.Duration
+	}{
+		{0, DefaultForeignExchangeDelay, DefaultForexCacheTTL},
+		{0, time.Second * 30, time.Second * 30},
+		{time.Second * 10, DefaultForeignExchangeDelay, time.Second * 10},
+		{time.Hour, DefaultForeignExchangeDelay, DefaultForeignExchangeDelay},
+	} {
+		if ttl := forexCacheTTL(tt.ttl, tt.delay); ttl != tt.expected {
+			t.Errorf("received '%v' expected '%v'", ttl, tt.expected)
+		}
+	}
+}
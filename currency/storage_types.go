@@ -14,6 +14,8 @@ const (
 	DefaultCurrencyFileDelay    = 168 * time.Hour
 	DefaultForeignExchangeDelay = 1 * time.Minute
 	DefaultStorageFile          = "currency.json"
+	DefaultForexCacheFile       = "forexcache.json"
+	DefaultForexCacheTTL        = DefaultForeignExchangeDelay
 )
 
 // storage is an overarching type that keeps track of and updates currency,
@@ -51,6 +53,8 @@ type Storage struct {
 	currencyAnalysis *coinmarketcap.Coinmarketcap
 	// Path defines the main folder to dump and find currency JSON
 	path string
+	// fxCachePath defines the file foreign exchange rates are cached to
+	fxCachePath string
 	// Update delay variables
 	currencyFileUpdateDelay    time.Duration
 	foreignExchangeUpdateDelay time.Duration
@@ -0,0 +1,118 @@
+package timescaledb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers/postgres"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+var errNilSQL = errors.New("database SQL connection is nil")
+
+// hypertable defines a table to convert to a TimescaleDB hypertable and the
+// statements required to ensure every unique constraint includes the time
+// partitioning column
+type hypertable struct {
+	name          string
+	timeColumn    string
+	chunkInterval string
+	prepare       []string
+}
+
+var hypertables = []hypertable{
+	{
+		name:          "candle",
+		timeColumn:    "timestamp",
+		chunkInterval: "30 days",
+		prepare: []string{
+			"ALTER TABLE candle DROP CONSTRAINT IF EXISTS candle_pkey",
+			"ALTER TABLE candle ADD PRIMARY KEY (id, timestamp)",
+		},
+	},
+	{
+		name:          "trade",
+		timeColumn:    "timestamp",
+		chunkInterval: "1 day",
+		prepare: []string{
+			"ALTER TABLE trade DROP CONSTRAINT IF EXISTS trade_pkey",
+			"ALTER TABLE trade ADD PRIMARY KEY (id, timestamp)",
+			"ALTER TABLE trade DROP CONSTRAINT IF EXISTS uniquetradeid",
+			"ALTER TABLE trade ADD CONSTRAINT uniquetradeid UNIQUE (exchange_name_id, base, quote, asset, tid, timestamp)",
+		},
+	},
+	{
+		name:          "orderbook_snapshot",
+		timeColumn:    "timestamp",
+		chunkInterval: "1 day",
+		prepare: []string{
+			"ALTER TABLE orderbook_snapshot DROP CONSTRAINT IF EXISTS orderbook_snapshot_pkey",
+			"ALTER TABLE orderbook_snapshot ADD PRIMARY KEY (id, timestamp)",
+		},
+	},
+}
+
+// Connect opens a connection to a TimescaleDB database. TimescaleDB is a
+// PostgreSQL extension so the PostgreSQL driver, models and migrations are
+// shared
+func Connect(cfg *database.Config) (*database.Instance, error) {
+	return postgres.Connect(cfg)
+}
+
+// EnableHypertables enables the TimescaleDB extension and converts the candle,
+// trade and orderbook snapshot tables to hypertables partitioned by timestamp. This must be run
+// after the PostgreSQL migrations have been applied and is safe to run
+// repeatedly
+func EnableHypertables(ctx context.Context, db *database.Instance) error {
+	if db == nil {
+		return database.ErrNilInstance
+	}
+	if db.SQL == nil {
+		return errNilSQL
+	}
+	tx, err := db.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if errRB := tx.Rollback(); errRB != nil {
+				log.Errorf(log.DatabaseMgr, "EnableHypertables tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	_, err = tx.ExecContext(ctx, "CREATE EXTENSION IF NOT EXISTS timescaledb")
+	if err != nil {
+		return err
+	}
+	for i := range hypertables {
+		var exists bool
+		err = tx.QueryRowContext(ctx,
+			"SELECT EXISTS (SELECT 1 FROM timescaledb_information.hypertables WHERE hypertable_name = $1)",
+			hypertables[i].name).Scan(&exists)
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+		for j := range hypertables[i].prepare {
+			_, err = tx.ExecContext(ctx, hypertables[i].prepare[j])
+			if err != nil {
+				return fmt.Errorf("%s: %w", hypertables[i].name, err)
+			}
+		}
+		_, err = tx.ExecContext(ctx,
+			"SELECT create_hypertable($1, $2, chunk_time_interval => $3::interval, migrate_data => TRUE)",
+			hypertables[i].name,
+			hypertables[i].timeColumn,
+			hypertables[i].chunkInterval)
+		if err != nil {
+			return fmt.Errorf("%s: %w", hypertables[i].name, err)
+		}
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,33 @@
+package timescaledb
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+)
+
+func TestEnableHypertables(t *testing.T) {
+	t.Parallel()
+	err := EnableHypertables(context.Background(), nil)
+	if !errors.Is(err, database.ErrNilInstance) {
+		t.Errorf("received '%v' expected '%v'", err, database.ErrNilInstance)
+	}
+	err = EnableHypertables(context.Background(), &database.Instance{})
+	if !errors.Is(err, errNilSQL) {
+		t.Errorf("received '%v' expected '%v'", err, errNilSQL)
+	}
+}
+
+func TestConnect(t *testing.T) {
+	t.Parallel()
+	_, err := Connect(nil)
+	if !errors.Is(err, database.ErrNilConfig) {
+		t.Errorf("received '%v' expected '%v'", err, database.ErrNilConfig)
+	}
+	_, err = Connect(&database.Config{})
+	if !errors.Is(err, database.ErrDatabaseSupportDisabled) {
+		t.Errorf("received '%v' expected '%v'", err, database.ErrDatabaseSupportDisabled)
+	}
+}
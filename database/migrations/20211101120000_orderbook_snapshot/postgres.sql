@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS orderbook_snapshot
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    asset varchar NOT NULL,
+    timestamp TIMESTAMPTZ NOT NULL,
+    bids TEXT NOT NULL,
+    asks TEXT NOT NULL,
+    CONSTRAINT uniqueorderbooksnapshot
+        unique(exchange_name_id, base, quote, asset, timestamp)
+);
+-- +goose Down
+DROP TABLE orderbook_snapshot;
@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS orderbook_snapshot
+(
+    id text not null primary key,
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    base text NOT NULL,
+    quote text NOT NULL,
+    asset TEXT NOT NULL,
+    timestamp TIMESTAMP NOT NULL,
+    bids TEXT NOT NULL,
+    asks TEXT NOT NULL,
+    CONSTRAINT uniqueorderbooksnapshot
+        unique(exchange_name_id, base, quote, asset, timestamp) ON CONFLICT IGNORE
+);
+-- +goose Down
+DROP TABLE orderbook_snapshot;
@@ -0,0 +1,22 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS open_order
+(
+    id uuid PRIMARY KEY,
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    order_id varchar NOT NULL,
+    client_order_id varchar NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    asset varchar NOT NULL,
+    side varchar NOT NULL,
+    type varchar NOT NULL,
+    status varchar NOT NULL,
+    price DOUBLE PRECISION NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    executed_amount DOUBLE PRECISION NOT NULL,
+    remaining_amount DOUBLE PRECISION NOT NULL,
+    date TIMESTAMPTZ NOT NULL,
+    last_updated TIMESTAMPTZ NOT NULL
+);
+-- +goose Down
+DROP TABLE open_order;
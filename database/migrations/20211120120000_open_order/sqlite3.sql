@@ -0,0 +1,22 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS open_order
+(
+    id text not null primary key,
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    order_id TEXT NOT NULL,
+    client_order_id TEXT NOT NULL,
+    base text NOT NULL,
+    quote text NOT NULL,
+    asset TEXT NOT NULL,
+    side TEXT NOT NULL,
+    type TEXT NOT NULL,
+    status TEXT NOT NULL,
+    price REAL NOT NULL,
+    amount REAL NOT NULL,
+    executed_amount REAL NOT NULL,
+    remaining_amount REAL NOT NULL,
+    date TIMESTAMP NOT NULL,
+    last_updated TIMESTAMP NOT NULL
+);
+-- +goose Down
+DROP TABLE open_order;
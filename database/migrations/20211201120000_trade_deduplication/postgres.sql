@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE trade DROP CONSTRAINT uniquetradeid;
+
+ALTER TABLE trade ADD CONSTRAINT uniquetradeid
+    unique(exchange_name_id, base, quote, asset, tid, timestamp);
+
+DROP INDEX unique_trade_no_id;
+
+CREATE UNIQUE INDEX unique_trade_no_id ON trade (exchange_name_id,base,quote,asset,price,amount,timestamp)
+    WHERE tid IS NULL;
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE trade DROP CONSTRAINT uniquetradeid;
+
+ALTER TABLE trade ADD CONSTRAINT uniquetradeid
+    unique(exchange_name_id, tid);
+
+DROP INDEX unique_trade_no_id;
+
+CREATE UNIQUE INDEX unique_trade_no_id ON trade (base,quote,asset,price,amount,timestamp)
+    WHERE tid IS NULL;
+-- +goose StatementEnd
@@ -3,11 +3,8 @@ package candle
 import (
 	"context"
 	"database/sql"
-	"encoding/csv"
 	"fmt"
-	"io"
 	"math"
-	"os"
 	"strconv"
 	"strings"
 	"time"
@@ -125,6 +122,38 @@ func DeleteCandles(in *Item) (int64, error) {
 	return deletePostgres(ctx, queries)
 }
 
+// DeleteInRange deletes all candles for the exchange, pair, interval and asset
+// from the start date up until the exclusive end date
+func DeleteInRange(exchangeName, base, quote string, interval int64, asset string, start, end time.Time) (int64, error) {
+	if database.DB.SQL == nil {
+		return 0, database.ErrDatabaseSupportDisabled
+	}
+	if exchangeName == "" || base == "" || quote == "" || asset == "" || interval <= 0 {
+		return 0, errInvalidInput
+	}
+	if !end.After(start) {
+		return 0, errInvalidGapRange
+	}
+	exchangeUUID, err := exchange.UUIDByName(exchangeName)
+	if err != nil {
+		return 0, err
+	}
+	queries := []qm.QueryMod{
+		qm.Where("base = ?", strings.ToUpper(base)),
+		qm.Where("quote = ?", strings.ToUpper(quote)),
+		qm.Where("interval = ?", interval),
+		qm.Where("asset = ?", strings.ToLower(asset)),
+		qm.Where("exchange_name_id = ?", exchangeUUID.String()),
+	}
+	ctx := context.Background()
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		queries = append(queries, qm.Where("timestamp >= ? and timestamp < ?", start.UTC().Format(time.RFC3339), end.UTC().Format(time.RFC3339)))
+		return modelSQLite.Candles(queries...).DeleteAll(ctx, database.DB.SQL)
+	}
+	queries = append(queries, qm.Where("timestamp >= ? and timestamp < ?", start.UTC(), end.UTC()))
+	return modelPSQL.Candles(queries...).DeleteAll(ctx, database.DB.SQL)
+}
+
 func deleteSQLite(ctx context.Context, queries []qm.QueryMod) (int64, error) {
 	retCandle, err := modelSQLite.Candles(queries...).All(context.Background(), database.DB.SQL)
 	if err != nil {
@@ -280,85 +309,3 @@ func insertPostgresSQL(ctx context.Context, tx *sql.Tx, in *Item) (uint64, error
 	}
 	return totalInserted, nil
 }
-
-// InsertFromCSV load a CSV list of candle data and insert into database
-func InsertFromCSV(exchangeName, base, quote string, interval int64, asset, file string) (uint64, error) {
-	csvFile, err := os.Open(file)
-	if err != nil {
-		return 0, err
-	}
-
-	defer func() {
-		err = csvFile.Close()
-		if err != nil {
-			log.Errorln(log.Global, err)
-		}
-	}()
-
-	csvData := csv.NewReader(csvFile)
-
-	exchangeUUID, err := exchange.UUIDByName(exchangeName)
-	if err != nil {
-		return 0, err
-	}
-
-	tempCandle := &Item{
-		ExchangeID: exchangeUUID.String(),
-		Base:       base,
-		Quote:      quote,
-		Interval:   interval,
-		Asset:      asset,
-	}
-
-	for {
-		row, errCSV := csvData.Read()
-		if errCSV != nil {
-			if errCSV == io.EOF {
-				break
-			}
-			return 0, errCSV
-		}
-
-		tempTick := Candle{}
-		v, errParse := strconv.ParseInt(row[0], 10, 32)
-		if errParse != nil {
-			return 0, errParse
-		}
-		tempTick.Timestamp = time.Unix(v, 0).UTC()
-		if tempTick.Timestamp.IsZero() {
-			err = fmt.Errorf("invalid timestamp received on row %v", row)
-			break
-		}
-
-		tempTick.Volume, err = strconv.ParseFloat(row[1], 64)
-		if err != nil {
-			break
-		}
-
-		tempTick.Open, err = strconv.ParseFloat(row[2], 64)
-		if err != nil {
-			break
-		}
-
-		tempTick.High, err = strconv.ParseFloat(row[3], 64)
-		if err != nil {
-			break
-		}
-
-		tempTick.Low, err = strconv.ParseFloat(row[4], 64)
-		if err != nil {
-			break
-		}
-
-		tempTick.Close, err = strconv.ParseFloat(row[5], 64)
-		if err != nil {
-			break
-		}
-		tempCandle.Candles = append(tempCandle.Candles, tempTick)
-	}
-	if err != nil {
-		return 0, err
-	}
-
-	return Insert(tempCandle)
-}
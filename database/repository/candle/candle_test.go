@@ -1,11 +1,13 @@
 package candle
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -260,6 +262,304 @@ func TestSeries(t *testing.T) {
 	}
 }
 
+func TestGetCoverage(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+		seedDB func(includeOHLCVData bool) error
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			seedDB: seedDB,
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if test.seedDB != nil {
+				err = test.seedDB(false)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			exchangeUUID, err := exchange.UUIDByName(testExchanges[1].Name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+			data := Item{
+				ExchangeID: exchangeUUID.String(),
+				Base:       currency.BTC.String(),
+				Quote:      currency.USD.String(),
+				Interval:   3600,
+				Asset:      "spot",
+			}
+			for i := 0; i < 10; i++ {
+				if i == 4 || i == 5 {
+					continue
+				}
+				data.Candles = append(data.Candles, Candle{
+					Timestamp: start.Add(time.Hour * time.Duration(i)),
+					Open:      1,
+					High:      1,
+					Low:       1,
+					Close:     1,
+					Volume:    1,
+				})
+			}
+			_, err = Insert(&data)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			coverage, err := GetCoverage(testExchanges[1].Name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(coverage) != 1 {
+				t.Fatalf("received '%v' expected '%v'", len(coverage), 1)
+			}
+			if coverage[0].Count != 8 || coverage[0].Missing != 2 {
+				t.Errorf("unexpected coverage %+v", coverage[0])
+			}
+			if !coverage[0].Start.Equal(start) || !coverage[0].End.Equal(start.Add(time.Hour*9)) {
+				t.Errorf("unexpected coverage range %v %v", coverage[0].Start, coverage[0].End)
+			}
+
+			gaps, err := FindGaps(testExchanges[1].Name, "BTC", "USD", 3600, "spot", start, start.Add(time.Hour*12))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(gaps) != 2 {
+				t.Fatalf("received '%v' expected '%v'", len(gaps), 2)
+			}
+			if !gaps[0].Start.Equal(start.Add(time.Hour*4)) || !gaps[0].End.Equal(start.Add(time.Hour*6)) {
+				t.Errorf("unexpected gap %+v", gaps[0])
+			}
+
+			_, err = FindGaps(testExchanges[1].Name, "BTC", "USD", 3600, "spot", start, start)
+			if !errors.Is(err, errInvalidGapRange) {
+				t.Errorf("received '%v' expected '%v'", err, errInvalidGapRange)
+			}
+
+			_, err = DeleteCandles(&data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err = testhelpers.CloseDatabase(dbConn); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestImportExportCSV(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+		seedDB func(includeOHLCVData bool) error
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			seedDB: seedDB,
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if test.seedDB != nil {
+				err = test.seedDB(false)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			_, err = ImportCSV(strings.NewReader("1609459200,1,2,1,2,1\n"), testExchanges[1].Name, "BTC", "USD", 3600, "spot")
+			if !errors.Is(err, errInvalidCandleRow) {
+				t.Errorf("received '%v' expected '%v'", err, errInvalidCandleRow)
+			}
+			_, err = ImportCSV(strings.NewReader("1609459200,1,2\n"), testExchanges[1].Name, "BTC", "USD", 3600, "spot")
+			if !errors.Is(err, errInvalidCSVRow) {
+				t.Errorf("received '%v' expected '%v'", err, errInvalidCSVRow)
+			}
+
+			data := "1609459200,10,1,2,0.5,1.5\n1609459200,10,1,2,0.5,1.5\n1609462800,5,1.5,3,1,2\n"
+			count, err := ImportCSV(strings.NewReader(data), testExchanges[1].Name, "BTC", "USD", 3600, "spot")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if count != 2 {
+				t.Errorf("received '%v' expected '%v'", count, 2)
+			}
+			count, err = ImportCSV(strings.NewReader(data), testExchanges[1].Name, "BTC", "USD", 3600, "spot")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if count != 0 {
+				t.Errorf("received '%v' expected '%v'", count, 0)
+			}
+
+			start := time.Unix(1609459200, 0)
+			var buf bytes.Buffer
+			count, err = ExportCSV(&buf, testExchanges[1].Name, "BTC", "USD", 3600, "spot", start, start.Add(time.Hour))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if count != 2 {
+				t.Errorf("received '%v' expected '%v'", count, 2)
+			}
+			if buf.String() != "1609459200,10,1,2,0.5,1.5\n1609462800,5,1.5,3,1,2\n" {
+				t.Errorf("unexpected export %q", buf.String())
+			}
+
+			series, err := Series(testExchanges[1].Name, "BTC", "USD", 3600, "spot", start, start.Add(time.Hour))
+			if err != nil {
+				t.Fatal(err)
+			}
+			exchangeUUID, err := exchange.UUIDByName(testExchanges[1].Name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			series.ExchangeID = exchangeUUID.String()
+			_, err = DeleteCandles(&series)
+			if err != nil {
+				t.Error(err)
+			}
+			if err = testhelpers.CloseDatabase(dbConn); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestDeleteInRange(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+		seedDB func(includeOHLCVData bool) error
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			seedDB: seedDB,
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = test.seedDB(true)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			start := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+			_, err = DeleteInRange(testExchanges[0].Name, "BTC", "USDT", 86400, "spot", start, start)
+			if !errors.Is(err, errInvalidGapRange) {
+				t.Errorf("received '%v' expected '%v'", err, errInvalidGapRange)
+			}
+
+			d, err := DeleteInRange(testExchanges[0].Name, "BTC", "USDT", 86400, "spot", start, start.AddDate(0, 0, 10))
+			if !errors.Is(err, nil) {
+				t.Fatalf("received '%v' expected '%v'", err, nil)
+			}
+			if d != 10 {
+				t.Errorf("received '%v' expected '%v'", d, 10)
+			}
+
+			series, err := Series(testExchanges[0].Name, "BTC", "USDT", 86400, "spot", start, start.AddDate(1, 0, 0))
+			if !errors.Is(err, nil) {
+				t.Fatalf("received '%v' expected '%v'", err, nil)
+			}
+			if len(series.Candles) != 355 {
+				t.Errorf("received '%v' expected '%v'", len(series.Candles), 355)
+			}
+			if !series.Candles[0].Timestamp.Equal(start.AddDate(0, 0, 10)) {
+				t.Errorf("received '%v' expected '%v'", series.Candles[0].Timestamp, start.AddDate(0, 0, 10))
+			}
+
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestFindGaps(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	gaps := findGaps(nil, time.Hour, start, start.Add(time.Hour))
+	if len(gaps) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(gaps), 1)
+	}
+	gaps = findGaps([]Candle{
+		{Timestamp: start},
+		{Timestamp: start.Add(time.Hour)},
+	}, time.Hour, start, start.Add(time.Hour*2))
+	if len(gaps) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(gaps), 0)
+	}
+}
+
 func seedDB(includeOHLCVData bool) error {
 	err := exchange.InsertMany(testExchanges)
 	if err != nil {
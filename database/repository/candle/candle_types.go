@@ -6,8 +6,9 @@ import (
 )
 
 var (
-	errInvalidInput = errors.New("exchange, base, quote, asset, interval, start & end cannot be empty")
-	errNoCandleData = errors.New("no candle data provided")
+	errInvalidInput    = errors.New("exchange, base, quote, asset, interval, start & end cannot be empty")
+	errNoCandleData    = errors.New("no candle data provided")
+	errInvalidGapRange = errors.New("end time must be after start time")
 	// ErrNoCandleDataFound returns when no candle data is found
 	ErrNoCandleDataFound = errors.New("no candle data found")
 )
@@ -35,3 +36,24 @@ type Candle struct {
 	ValidationJobID  string
 	ValidationIssues string
 }
+
+// Coverage defines the stored candle range for an exchange, pair, asset and
+// interval
+type Coverage struct {
+	Exchange string
+	Base     string
+	Quote    string
+	Asset    string
+	Interval int64
+	Start    time.Time
+	End      time.Time
+	Count    int64
+	// Missing is the number of candles absent between Start and End
+	Missing int64
+}
+
+// Gap defines a range of missing candles, End is exclusive
+type Gap struct {
+	Start time.Time
+	End   time.Time
+}
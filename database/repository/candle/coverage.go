@@ -0,0 +1,118 @@
+package candle
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+)
+
+const coverageQuery = `SELECT exchange.name, candle.base, candle.quote, candle.asset, candle.interval,
+	MIN(candle.timestamp), MAX(candle.timestamp), COUNT(*)
+FROM candle
+INNER JOIN exchange ON candle.exchange_name_id = exchange.id
+GROUP BY exchange.name, candle.base, candle.quote, candle.asset, candle.interval
+ORDER BY exchange.name, candle.base, candle.quote, candle.asset, candle.interval`
+
+// GetCoverage returns the stored candle range for every exchange, pair, asset
+// and interval combination in the database. An empty exchange name returns
+// coverage for all exchanges
+func GetCoverage(exchangeName string) ([]Coverage, error) {
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	rows, err := database.DB.SQL.QueryContext(context.Background(), coverageQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var resp []Coverage
+	for rows.Next() {
+		var c Coverage
+		var interval, start, end string
+		err = rows.Scan(&c.Exchange, &c.Base, &c.Quote, &c.Asset, &interval, &start, &end, &c.Count)
+		if err != nil {
+			return nil, err
+		}
+		if exchangeName != "" && !strings.EqualFold(exchangeName, c.Exchange) {
+			continue
+		}
+		c.Interval, err = strconv.ParseInt(interval, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		c.Start, err = time.Parse(time.RFC3339, start)
+		if err != nil {
+			return nil, err
+		}
+		c.End, err = time.Parse(time.RFC3339, end)
+		if err != nil {
+			return nil, err
+		}
+		c.Start = c.Start.UTC()
+		c.End = c.End.UTC()
+		c.Missing = c.expected() - c.Count
+		if c.Missing < 0 {
+			c.Missing = 0
+		}
+		resp = append(resp, c)
+	}
+	return resp, rows.Err()
+}
+
+// expected returns the number of candles expected between the first and last
+// stored candle inclusive
+func (c *Coverage) expected() int64 {
+	if c.Interval <= 0 {
+		return 0
+	}
+	return int64(c.End.Sub(c.Start)/(time.Duration(c.Interval)*time.Second)) + 1
+}
+
+// FindGaps returns all ranges between start and end which have no stored
+// candles for the supplied exchange, pair, asset and interval. Gap end times
+// are exclusive
+func FindGaps(exchangeName, base, quote string, interval int64, asset string, start, end time.Time) ([]Gap, error) {
+	if exchangeName == "" || base == "" || quote == "" || asset == "" || interval <= 0 {
+		return nil, errInvalidInput
+	}
+	if !end.After(start) {
+		return nil, errInvalidGapRange
+	}
+	duration := time.Duration(interval) * time.Second
+	start = start.UTC().Truncate(duration)
+	end = end.UTC()
+
+	series, err := Series(exchangeName, base, quote, interval, asset, start, end)
+	if err != nil {
+		if errors.Is(err, ErrNoCandleDataFound) {
+			return []Gap{{Start: start, End: end}}, nil
+		}
+		return nil, err
+	}
+	return findGaps(series.Candles, duration, start, end), nil
+}
+
+// findGaps returns missing ranges from candles ordered by timestamp
+func findGaps(candles []Candle, duration time.Duration, start, end time.Time) []Gap {
+	var gaps []Gap
+	expected := start
+	for i := range candles {
+		ts := candles[i].Timestamp.UTC()
+		if ts.Before(expected) {
+			continue
+		}
+		if ts.After(expected) {
+			gaps = append(gaps, Gap{Start: expected, End: ts})
+		}
+		expected = ts.Add(duration)
+	}
+	if expected.Before(end) {
+		gaps = append(gaps, Gap{Start: expected, End: end})
+	}
+	return gaps
+}
@@ -0,0 +1,177 @@
+package candle
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+var (
+	errInvalidCSVRow    = errors.New("invalid candle CSV row")
+	errInvalidCandleRow = errors.New("invalid candle data")
+)
+
+// InsertFromCSV load a CSV list of candle data and insert into database
+func InsertFromCSV(exchangeName, base, quote string, interval int64, asset, file string) (uint64, error) {
+	csvFile, err := os.Open(file)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if errClose := csvFile.Close(); errClose != nil {
+			log.Errorln(log.Global, errClose)
+		}
+	}()
+	return ImportCSV(csvFile, exchangeName, base, quote, interval, asset)
+}
+
+// ImportCSV reads candle data in the format timestamp,volume,open,high,low,close
+// and inserts it into the database. Every row is validated, while duplicate
+// timestamps and candles which are already stored are skipped. It returns the
+// amount of candles inserted
+func ImportCSV(r io.Reader, exchangeName, base, quote string, interval int64, asset string) (uint64, error) {
+	if exchangeName == "" || base == "" || quote == "" || asset == "" || interval <= 0 {
+		return 0, errInvalidInput
+	}
+	exchangeUUID, err := exchange.UUIDByName(exchangeName)
+	if err != nil {
+		return 0, err
+	}
+	tempCandle := &Item{
+		ExchangeID: exchangeUUID.String(),
+		Base:       base,
+		Quote:      quote,
+		Interval:   interval,
+		Asset:      asset,
+	}
+
+	csvData := csv.NewReader(r)
+	seen := make(map[int64]struct{})
+	for row := 1; ; row++ {
+		record, errCSV := csvData.Read()
+		if errCSV != nil {
+			if errCSV == io.EOF {
+				break
+			}
+			return 0, errCSV
+		}
+		c, errParse := parseCSVRow(record)
+		if errParse != nil {
+			return 0, fmt.Errorf("row %d: %w", row, errParse)
+		}
+		if _, ok := seen[c.Timestamp.Unix()]; ok {
+			continue
+		}
+		seen[c.Timestamp.Unix()] = struct{}{}
+		tempCandle.Candles = append(tempCandle.Candles, c)
+	}
+	if len(tempCandle.Candles) == 0 {
+		return 0, errNoCandleData
+	}
+
+	start, end := tempCandle.Candles[0].Timestamp, tempCandle.Candles[0].Timestamp
+	for i := range tempCandle.Candles {
+		if tempCandle.Candles[i].Timestamp.Before(start) {
+			start = tempCandle.Candles[i].Timestamp
+		}
+		if tempCandle.Candles[i].Timestamp.After(end) {
+			end = tempCandle.Candles[i].Timestamp
+		}
+	}
+	existing, err := Series(exchangeName, base, quote, interval, asset, start, end)
+	if err != nil && !errors.Is(err, ErrNoCandleDataFound) {
+		return 0, err
+	}
+	if len(existing.Candles) > 0 {
+		stored := make(map[int64]struct{}, len(existing.Candles))
+		for i := range existing.Candles {
+			stored[existing.Candles[i].Timestamp.Unix()] = struct{}{}
+		}
+		fresh := tempCandle.Candles[:0]
+		for i := range tempCandle.Candles {
+			if _, ok := stored[tempCandle.Candles[i].Timestamp.Unix()]; !ok {
+				fresh = append(fresh, tempCandle.Candles[i])
+			}
+		}
+		tempCandle.Candles = fresh
+		if len(tempCandle.Candles) == 0 {
+			return 0, nil
+		}
+	}
+	return Insert(tempCandle)
+}
+
+// ExportCSV writes stored candles to the writer in the format
+// timestamp,volume,open,high,low,close, which can be reimported with ImportCSV
+// or loaded by the backtester. It returns the amount of candles written
+func ExportCSV(w io.Writer, exchangeName, base, quote string, interval int64, asset string, start, end time.Time) (uint64, error) {
+	series, err := Series(exchangeName, base, quote, interval, asset, start, end)
+	if err != nil {
+		return 0, err
+	}
+	csvWriter := csv.NewWriter(w)
+	for i := range series.Candles {
+		err = csvWriter.Write([]string{
+			strconv.FormatInt(series.Candles[i].Timestamp.Unix(), 10),
+			strconv.FormatFloat(series.Candles[i].Volume, 'f', -1, 64),
+			strconv.FormatFloat(series.Candles[i].Open, 'f', -1, 64),
+			strconv.FormatFloat(series.Candles[i].High, 'f', -1, 64),
+			strconv.FormatFloat(series.Candles[i].Low, 'f', -1, 64),
+			strconv.FormatFloat(series.Candles[i].Close, 'f', -1, 64),
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+	csvWriter.Flush()
+	return uint64(len(series.Candles)), csvWriter.Error()
+}
+
+// parseCSVRow converts and validates a single CSV candle row
+func parseCSVRow(row []string) (Candle, error) {
+	if len(row) < 6 {
+		return Candle{}, fmt.Errorf("%w expected 6 columns received %d", errInvalidCSVRow, len(row))
+	}
+	v, err := strconv.ParseInt(row[0], 10, 64)
+	if err != nil {
+		return Candle{}, err
+	}
+	if v <= 0 {
+		return Candle{}, fmt.Errorf("%w timestamp %v", errInvalidCSVRow, row[0])
+	}
+	values := make([]float64, 5)
+	for i := range values {
+		values[i], err = strconv.ParseFloat(row[i+1], 64)
+		if err != nil {
+			return Candle{}, err
+		}
+		if math.IsNaN(values[i]) || math.IsInf(values[i], 0) {
+			return Candle{}, fmt.Errorf("%w value %v", errInvalidCSVRow, row[i+1])
+		}
+	}
+	c := Candle{
+		Timestamp: time.Unix(v, 0).UTC(),
+		Volume:    values[0],
+		Open:      values[1],
+		High:      values[2],
+		Low:       values[3],
+		Close:     values[4],
+	}
+	switch {
+	case c.Volume < 0:
+		return Candle{}, fmt.Errorf("%w negative volume", errInvalidCandleRow)
+	case c.Open <= 0 || c.High <= 0 || c.Low <= 0 || c.Close <= 0:
+		return Candle{}, fmt.Errorf("%w prices must be positive", errInvalidCandleRow)
+	case c.High < c.Low:
+		return Candle{}, fmt.Errorf("%w high is lower than low", errInvalidCandleRow)
+	}
+	return c, nil
+}
@@ -56,7 +56,7 @@ func (db *DBService) Upsert(jobs ...*DataHistoryJob) error {
 	switch db.driver {
 	case database.DBSQLite3, database.DBSQLite:
 		err = upsertSqlite(ctx, tx, jobs...)
-	case database.DBPostgreSQL:
+	case database.DBPostgreSQL, database.DBTimescaleDB:
 		err = upsertPostgres(ctx, tx, jobs...)
 	default:
 		return database.ErrNoDatabaseProvided
@@ -73,7 +73,7 @@ func (db *DBService) GetByNickName(nickname string) (*DataHistoryJob, error) {
 	switch db.driver {
 	case database.DBSQLite3, database.DBSQLite:
 		return db.getByNicknameSQLite(nickname)
-	case database.DBPostgreSQL:
+	case database.DBPostgreSQL, database.DBTimescaleDB:
 		return db.getByNicknamePostgres(nickname)
 	default:
 		return nil, database.ErrNoDatabaseProvided
@@ -85,7 +85,7 @@ func (db *DBService) GetByID(id string) (*DataHistoryJob, error) {
 	switch db.driver {
 	case database.DBSQLite3, database.DBSQLite:
 		return db.getByIDSQLite(id)
-	case database.DBPostgreSQL:
+	case database.DBPostgreSQL, database.DBTimescaleDB:
 		return db.getByIDPostgres(id)
 	default:
 		return nil, database.ErrNoDatabaseProvided
@@ -97,7 +97,7 @@ func (db *DBService) GetJobsBetween(startDate, endDate time.Time) ([]DataHistory
 	switch db.driver {
 	case database.DBSQLite3, database.DBSQLite:
 		return db.getJobsBetweenSQLite(startDate, endDate)
-	case database.DBPostgreSQL:
+	case database.DBPostgreSQL, database.DBTimescaleDB:
 		return db.getJobsBetweenPostgres(startDate, endDate)
 	default:
 		return nil, database.ErrNoDatabaseProvided
@@ -109,7 +109,7 @@ func (db *DBService) GetAllIncompleteJobsAndResults() ([]DataHistoryJob, error)
 	switch db.driver {
 	case database.DBSQLite3, database.DBSQLite:
 		return db.getAllIncompleteJobsAndResultsSQLite()
-	case database.DBPostgreSQL:
+	case database.DBPostgreSQL, database.DBTimescaleDB:
 		return db.getAllIncompleteJobsAndResultsPostgres()
 	default:
 		return nil, database.ErrNoDatabaseProvided
@@ -121,7 +121,7 @@ func (db *DBService) GetJobAndAllResults(nickname string) (*DataHistoryJob, erro
 	switch db.driver {
 	case database.DBSQLite3, database.DBSQLite:
 		return db.getJobAndAllResultsSQLite(nickname)
-	case database.DBPostgreSQL:
+	case database.DBPostgreSQL, database.DBTimescaleDB:
 		return db.getJobAndAllResultsPostgres(nickname)
 	default:
 		return nil, database.ErrNoDatabaseProvided
@@ -133,7 +133,7 @@ func (db *DBService) GetRelatedUpcomingJobs(nickname string) ([]*DataHistoryJob,
 	switch db.driver {
 	case database.DBSQLite3, database.DBSQLite:
 		return db.getRelatedUpcomingJobsSQLite(nickname)
-	case database.DBPostgreSQL:
+	case database.DBPostgreSQL, database.DBTimescaleDB:
 		return db.getRelatedUpcomingJobsPostgres(nickname)
 	default:
 		return nil, database.ErrNoDatabaseProvided
@@ -146,7 +146,7 @@ func (db *DBService) GetPrerequisiteJob(nickname string) (*DataHistoryJob, error
 	switch db.driver {
 	case database.DBSQLite3, database.DBSQLite:
 		return db.getPrerequisiteJobSQLite(nickname)
-	case database.DBPostgreSQL:
+	case database.DBPostgreSQL, database.DBTimescaleDB:
 		return db.getPrerequisiteJobPostgres(nickname)
 	default:
 		return nil, database.ErrNoDatabaseProvided
@@ -176,7 +176,7 @@ func (db *DBService) SetRelationshipByID(prerequisiteJobID, followingJobID strin
 	switch db.driver {
 	case database.DBSQLite3, database.DBSQLite:
 		err = setRelationshipByIDSQLite(ctx, tx, prerequisiteJobID, followingJobID, status)
-	case database.DBPostgreSQL:
+	case database.DBPostgreSQL, database.DBTimescaleDB:
 		err = setRelationshipByIDPostgres(ctx, tx, prerequisiteJobID, followingJobID, status)
 	default:
 		return database.ErrNoDatabaseProvided
@@ -211,7 +211,7 @@ func (db *DBService) SetRelationshipByNickname(prerequisiteNickname, followingNi
 	switch db.driver {
 	case database.DBSQLite3, database.DBSQLite:
 		err = setRelationshipByNicknameSQLite(ctx, tx, prerequisiteNickname, followingNickname, status)
-	case database.DBPostgreSQL:
+	case database.DBPostgreSQL, database.DBTimescaleDB:
 		err = setRelationshipByNicknamePostgres(ctx, tx, prerequisiteNickname, followingNickname, status)
 	default:
 		return database.ErrNoDatabaseProvided
@@ -58,7 +58,7 @@ func (db *DBService) Upsert(jobs ...*DataHistoryJobResult) error {
 	switch db.driver {
 	case database.DBSQLite3, database.DBSQLite:
 		err = upsertSqlite(ctx, tx, jobs...)
-	case database.DBPostgreSQL:
+	case database.DBPostgreSQL, database.DBTimescaleDB:
 		err = upsertPostgres(ctx, tx, jobs...)
 	default:
 		return database.ErrNoDatabaseProvided
@@ -77,7 +77,7 @@ func (db *DBService) GetByJobID(jobID string) ([]DataHistoryJobResult, error) {
 	switch db.driver {
 	case database.DBSQLite3, database.DBSQLite:
 		job, err = db.getByJobIDSQLite(jobID)
-	case database.DBPostgreSQL:
+	case database.DBPostgreSQL, database.DBTimescaleDB:
 		job, err = db.getByJobIDPostgres(jobID)
 	default:
 		return nil, database.ErrNoDatabaseProvided
@@ -95,7 +95,7 @@ func (db *DBService) GetJobResultsBetween(jobID string, startDate, endDate time.
 	switch db.driver {
 	case database.DBSQLite3, database.DBSQLite:
 		jobs, err = db.getJobResultsBetweenSQLite(jobID, startDate, endDate)
-	case database.DBPostgreSQL:
+	case database.DBPostgreSQL, database.DBTimescaleDB:
 		jobs, err = db.getJobResultsBetweenPostgres(jobID, startDate, endDate)
 	default:
 		return nil, database.ErrNoDatabaseProvided
@@ -0,0 +1,250 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+const (
+	upsertQuery = `INSERT INTO open_order
+	(id, exchange_name_id, order_id, client_order_id, base, quote, asset, side, type, status,
+	price, amount, executed_amount, remaining_amount, date, last_updated)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	ON CONFLICT (id) DO UPDATE SET
+	order_id = excluded.order_id,
+	client_order_id = excluded.client_order_id,
+	status = excluded.status,
+	price = excluded.price,
+	amount = excluded.amount,
+	executed_amount = excluded.executed_amount,
+	remaining_amount = excluded.remaining_amount,
+	last_updated = excluded.last_updated`
+	deleteQuery = `DELETE FROM open_order WHERE id = ?`
+	selectQuery = `SELECT o.id, e.name, o.order_id, o.client_order_id, o.base, o.quote, o.asset,
+	o.side, o.type, o.status, o.price, o.amount, o.executed_amount, o.remaining_amount,
+	o.date, o.last_updated
+	FROM open_order o INNER JOIN exchange e ON e.id = o.exchange_name_id
+	ORDER BY o.date`
+)
+
+// Upsert saves orders to the database, updating the state of orders which
+// are already stored with the same ID
+func Upsert(orders ...Details) (err error) {
+	if database.DB.SQL == nil {
+		return database.ErrDatabaseSupportDisabled
+	}
+	if len(orders) == 0 {
+		return errNoOrders
+	}
+
+	// exchange IDs are resolved before the transaction begins as SQLite only
+	// allows a single open connection
+	exchangeIDs := make(map[string]string)
+	for i := range orders {
+		if orders[i].ID == "" || orders[i].Exchange == "" || orders[i].Base == "" || orders[i].Quote == "" || orders[i].Asset == "" {
+			return errInvalidInput
+		}
+		if orders[i].Date.IsZero() {
+			return errDateUnset
+		}
+		if _, ok := exchangeIDs[orders[i].Exchange]; ok {
+			continue
+		}
+		var exchangeUUID uuid.UUID
+		exchangeUUID, err = exchange.UUIDByName(orders[i].Exchange)
+		if err != nil {
+			return err
+		}
+		exchangeIDs[orders[i].Exchange] = exchangeUUID.String()
+	}
+
+	ctx := context.Background()
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Upsert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	isSQLite := usingSQLite()
+	query := upsertQuery
+	if !isSQLite {
+		query = rebind(upsertQuery)
+	}
+	for i := range orders {
+		lastUpdated := orders[i].LastUpdated
+		if lastUpdated.IsZero() {
+			lastUpdated = orders[i].Date
+		}
+		_, err = tx.ExecContext(ctx, query,
+			orders[i].ID,
+			exchangeIDs[orders[i].Exchange],
+			orders[i].OrderID,
+			orders[i].ClientOrderID,
+			strings.ToUpper(orders[i].Base),
+			strings.ToUpper(orders[i].Quote),
+			strings.ToLower(orders[i].Asset),
+			orders[i].Side,
+			orders[i].Type,
+			orders[i].Status,
+			orders[i].Price,
+			orders[i].Amount,
+			orders[i].ExecutedAmount,
+			orders[i].RemainingAmount,
+			timestampArg(orders[i].Date, isSQLite),
+			timestampArg(lastUpdated, isSQLite))
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// Delete removes orders from the database by ID
+func Delete(ids ...string) (err error) {
+	if database.DB.SQL == nil {
+		return database.ErrDatabaseSupportDisabled
+	}
+	if len(ids) == 0 {
+		return errNoOrders
+	}
+	ctx := context.Background()
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Delete tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	query := deleteQuery
+	if !usingSQLite() {
+		query = rebind(deleteQuery)
+	}
+	for i := range ids {
+		_, err = tx.ExecContext(ctx, query, ids[i])
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// GetAll returns all stored orders ordered by date
+func GetAll() ([]Details, error) {
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	rows, err := database.DB.SQL.QueryContext(context.Background(), selectQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var resp []Details
+	for rows.Next() {
+		var d Details
+		var date, lastUpdated interface{}
+		err = rows.Scan(&d.ID,
+			&d.Exchange,
+			&d.OrderID,
+			&d.ClientOrderID,
+			&d.Base,
+			&d.Quote,
+			&d.Asset,
+			&d.Side,
+			&d.Type,
+			&d.Status,
+			&d.Price,
+			&d.Amount,
+			&d.ExecutedAmount,
+			&d.RemainingAmount,
+			&date,
+			&lastUpdated)
+		if err != nil {
+			return nil, err
+		}
+		d.Date, err = parseTimestamp(date)
+		if err != nil {
+			return nil, err
+		}
+		d.LastUpdated, err = parseTimestamp(lastUpdated)
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, d)
+	}
+	return resp, rows.Err()
+}
+
+func usingSQLite() bool {
+	dialect := repository.GetSQLDialect()
+	return dialect == database.DBSQLite3 || dialect == database.DBSQLite
+}
+
+// timestampArg converts a time to the format stored by the database dialect,
+// SQLite stores timestamps as RFC3339 text
+func timestampArg(t time.Time, isSQLite bool) interface{} {
+	if isSQLite {
+		return t.UTC().Format(time.RFC3339Nano)
+	}
+	return t.UTC()
+}
+
+// parseTimestamp converts a scanned timestamp column to a UTC time, drivers
+// return either a time or its RFC3339 text representation
+func parseTimestamp(v interface{}) (time.Time, error) {
+	switch t := v.(type) {
+	case time.Time:
+		return t.UTC(), nil
+	case string:
+		return parseTimestampText(t)
+	case []byte:
+		return parseTimestampText(string(t))
+	}
+	return time.Time{}, fmt.Errorf("unsupported timestamp type %T", v)
+}
+
+func parseTimestampText(s string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.UTC(), nil
+}
+
+// rebind converts ? placeholders to the numbered placeholders used by
+// PostgreSQL
+func rebind(query string) string {
+	var sb strings.Builder
+	n := 0
+	for i := range query {
+		if query[i] != '?' {
+			sb.WriteByte(query[i])
+			continue
+		}
+		n++
+		sb.WriteString("$" + strconv.Itoa(n))
+	}
+	return sb.String()
+}
@@ -0,0 +1,210 @@
+package order
+
+import (
+	"errors"
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+var (
+	verbose       = false
+	testExchanges = []exchange.Details{
+		{
+			Name: "one",
+		},
+	}
+)
+
+func TestMain(m *testing.M) {
+	if verbose {
+		testhelpers.EnableVerboseTestOutput()
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	t := m.Run()
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		log.Printf("Failed to remove temp db file: %v", err)
+	}
+	os.Exit(t)
+}
+
+func TestOrders(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+		seedDB func() error
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			seedDB: seedDB,
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if test.seedDB != nil {
+				err = test.seedDB()
+				if err != nil {
+					t.Error(err)
+				}
+			}
+
+			orderSQLTester(t)
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func orderSQLTester(t *testing.T) {
+	t.Helper()
+	date := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	var orders []Details
+	for i := 0; i < 3; i++ {
+		id, err := uuid.NewV4()
+		if err != nil {
+			t.Fatal(err)
+		}
+		orders = append(orders, Details{
+			ID:              id.String(),
+			Exchange:        testExchanges[0].Name,
+			OrderID:         "exchange" + id.String(),
+			ClientOrderID:   "client" + id.String(),
+			Base:            "btc",
+			Quote:           "usd",
+			Asset:           "SPOT",
+			Side:            "BUY",
+			Type:            "LIMIT",
+			Status:          "NEW",
+			Price:           100,
+			Amount:          1,
+			RemainingAmount: 1,
+			Date:            date.Add(time.Second * time.Duration(i)),
+		})
+	}
+	err := Upsert(orders...)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	orders[0].Status = "PARTIALLY_FILLED"
+	orders[0].ExecutedAmount = 0.5
+	orders[0].RemainingAmount = 0.5
+	orders[0].LastUpdated = date.Add(time.Minute)
+	err = Upsert(orders[0])
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	resp, err := GetAll()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp) != 3 {
+		t.Fatalf("received '%v' expected '%v'", len(resp), 3)
+	}
+	if resp[0].ID != orders[0].ID ||
+		resp[0].Exchange != testExchanges[0].Name ||
+		resp[0].Base != "BTC" ||
+		resp[0].Asset != "spot" ||
+		resp[0].Status != "PARTIALLY_FILLED" ||
+		resp[0].ExecutedAmount != 0.5 ||
+		!resp[0].Date.Equal(date) ||
+		!resp[0].LastUpdated.Equal(date.Add(time.Minute)) {
+		t.Errorf("unexpected order %+v", resp[0])
+	}
+	if !resp[1].LastUpdated.Equal(resp[1].Date) {
+		t.Error("expected last updated to default to order date")
+	}
+
+	err = Delete(orders[0].ID, orders[1].ID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	resp, err = GetAll()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp) != 1 || resp[0].ID != orders[2].ID {
+		t.Errorf("unexpected orders %+v", resp)
+	}
+
+	err = Upsert(Details{ID: "1", Exchange: testExchanges[0].Name, Base: "BTC", Quote: "USD", Asset: "spot"})
+	if !errors.Is(err, errDateUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errDateUnset)
+	}
+	err = Upsert(Details{Exchange: testExchanges[0].Name})
+	if !errors.Is(err, errInvalidInput) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidInput)
+	}
+	err = Upsert()
+	if !errors.Is(err, errNoOrders) {
+		t.Errorf("received '%v' expected '%v'", err, errNoOrders)
+	}
+	err = Delete()
+	if !errors.Is(err, errNoOrders) {
+		t.Errorf("received '%v' expected '%v'", err, errNoOrders)
+	}
+}
+
+func TestParseTimestamp(t *testing.T) {
+	t.Parallel()
+	expected := time.Date(2020, 1, 1, 0, 0, 0, 1, time.UTC)
+	for _, v := range []interface{}{
+		expected,
+		expected.Format(time.RFC3339Nano),
+		[]byte(expected.Format(time.RFC3339Nano)),
+	} {
+		ts, err := parseTimestamp(v)
+		if !errors.Is(err, nil) {
+			t.Errorf("received '%v' expected '%v'", err, nil)
+		}
+		if !ts.Equal(expected) {
+			t.Errorf("received '%v' expected '%v'", ts, expected)
+		}
+	}
+	_, err := parseTimestamp(1)
+	if err == nil {
+		t.Error("expected error for unsupported timestamp type")
+	}
+}
+
+func seedDB() error {
+	return exchange.InsertMany(testExchanges)
+}
@@ -0,0 +1,33 @@
+package order
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	errInvalidInput = errors.New("id, exchange, base, quote & asset cannot be empty")
+	errNoOrders     = errors.New("no orders provided")
+	errDateUnset    = errors.New("order date unset")
+)
+
+// Details defines a stored open order which the order manager is tracking
+type Details struct {
+	// ID is the order manager's internal order ID
+	ID              string
+	Exchange        string
+	OrderID         string
+	ClientOrderID   string
+	Base            string
+	Quote           string
+	Asset           string
+	Side            string
+	Type            string
+	Status          string
+	Price           float64
+	Amount          float64
+	ExecutedAmount  float64
+	RemainingAmount float64
+	Date            time.Time
+	LastUpdated     time.Time
+}
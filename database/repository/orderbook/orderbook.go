@@ -0,0 +1,208 @@
+package orderbook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+const (
+	insertSQLiteQuery = `INSERT INTO orderbook_snapshot
+	(id, exchange_name_id, base, quote, asset, timestamp, bids, asks)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+	insertPostgresQuery = insertSQLiteQuery + ` ON CONFLICT DO NOTHING`
+	selectQuery         = `SELECT id, timestamp, bids, asks FROM orderbook_snapshot
+	WHERE exchange_name_id = ? AND base = ? AND quote = ? AND asset = ? AND timestamp BETWEEN ? AND ?
+	ORDER BY timestamp`
+)
+
+// Insert saves orderbook snapshots to the database. Snapshots which are
+// already stored for the same exchange, pair, asset and timestamp are ignored
+func Insert(snapshots ...Snapshot) (err error) {
+	if database.DB.SQL == nil {
+		return database.ErrDatabaseSupportDisabled
+	}
+	if len(snapshots) == 0 {
+		return errNoSnapshots
+	}
+
+	// exchange IDs are resolved before the transaction begins as SQLite only
+	// allows a single open connection
+	exchangeIDs := make(map[string]string)
+	for i := range snapshots {
+		if snapshots[i].Exchange == "" || snapshots[i].Base == "" || snapshots[i].Quote == "" || snapshots[i].Asset == "" {
+			return errInvalidInput
+		}
+		if snapshots[i].Timestamp.IsZero() {
+			return errSnapshotTimestampUnset
+		}
+		if _, ok := exchangeIDs[snapshots[i].Exchange]; ok {
+			continue
+		}
+		var exchangeUUID uuid.UUID
+		exchangeUUID, err = exchange.UUIDByName(snapshots[i].Exchange)
+		if err != nil {
+			return err
+		}
+		exchangeIDs[snapshots[i].Exchange] = exchangeUUID.String()
+	}
+
+	ctx := context.Background()
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Insert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	isSQLite := usingSQLite()
+	query := insertSQLiteQuery
+	if !isSQLite {
+		query = rebind(insertPostgresQuery)
+	}
+	for i := range snapshots {
+		var bids, asks []byte
+		bids, err = json.Marshal(snapshots[i].Bids)
+		if err != nil {
+			return err
+		}
+		asks, err = json.Marshal(snapshots[i].Asks)
+		if err != nil {
+			return err
+		}
+		var id uuid.UUID
+		id, err = uuid.NewV4()
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, query,
+			id.String(),
+			exchangeIDs[snapshots[i].Exchange],
+			strings.ToUpper(snapshots[i].Base),
+			strings.ToUpper(snapshots[i].Quote),
+			strings.ToLower(snapshots[i].Asset),
+			timestampArg(snapshots[i].Timestamp, isSQLite),
+			string(bids),
+			string(asks))
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// GetInRange returns all stored orderbook snapshots for the exchange, pair and
+// asset between the start and end dates inclusive, ordered by timestamp
+func GetInRange(exchangeName, base, quote, asset string, start, end time.Time) ([]Snapshot, error) {
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	if exchangeName == "" || base == "" || quote == "" || asset == "" {
+		return nil, errInvalidInput
+	}
+	if !end.After(start) {
+		return nil, errInvalidSnapshotRange
+	}
+	exchangeUUID, err := exchange.UUIDByName(exchangeName)
+	if err != nil {
+		return nil, err
+	}
+	isSQLite := usingSQLite()
+	query := selectQuery
+	if !isSQLite {
+		query = rebind(selectQuery)
+	}
+	rows, err := database.DB.SQL.QueryContext(context.Background(),
+		query,
+		exchangeUUID.String(),
+		strings.ToUpper(base),
+		strings.ToUpper(quote),
+		strings.ToLower(asset),
+		timestampArg(start, isSQLite),
+		timestampArg(end, isSQLite))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var resp []Snapshot
+	for rows.Next() {
+		s := Snapshot{
+			Exchange: exchangeName,
+			Base:     strings.ToUpper(base),
+			Quote:    strings.ToUpper(quote),
+			Asset:    strings.ToLower(asset),
+		}
+		var ts, bids, asks string
+		err = rows.Scan(&s.ID, &ts, &bids, &asks)
+		if err != nil {
+			return nil, err
+		}
+		s.Timestamp, err = time.Parse(time.RFC3339, ts)
+		if err != nil {
+			return nil, err
+		}
+		s.Timestamp = s.Timestamp.UTC()
+		err = json.Unmarshal([]byte(bids), &s.Bids)
+		if err != nil {
+			return nil, err
+		}
+		err = json.Unmarshal([]byte(asks), &s.Asks)
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, s)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(resp) == 0 {
+		return nil, fmt.Errorf("%w %s %s-%s %s", ErrNoSnapshotsFound, exchangeName, base, quote, asset)
+	}
+	return resp, nil
+}
+
+func usingSQLite() bool {
+	dialect := repository.GetSQLDialect()
+	return dialect == database.DBSQLite3 || dialect == database.DBSQLite
+}
+
+// timestampArg converts a time to the format stored by the database dialect,
+// SQLite stores timestamps as RFC3339 text
+func timestampArg(t time.Time, isSQLite bool) interface{} {
+	if isSQLite {
+		return t.UTC().Format(time.RFC3339)
+	}
+	return t.UTC()
+}
+
+// rebind converts ? placeholders to the numbered placeholders used by
+// PostgreSQL
+func rebind(query string) string {
+	var sb strings.Builder
+	n := 0
+	for i := range query {
+		if query[i] != '?' {
+			sb.WriteByte(query[i])
+			continue
+		}
+		n++
+		sb.WriteString("$" + strconv.Itoa(n))
+	}
+	return sb.String()
+}
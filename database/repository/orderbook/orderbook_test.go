@@ -0,0 +1,157 @@
+package orderbook
+
+import (
+	"errors"
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+var (
+	verbose       = false
+	testExchanges = []exchange.Details{
+		{
+			Name: "one",
+		},
+	}
+)
+
+func TestMain(m *testing.M) {
+	if verbose {
+		testhelpers.EnableVerboseTestOutput()
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	t := m.Run()
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		log.Printf("Failed to remove temp db file: %v", err)
+	}
+	os.Exit(t)
+}
+
+func TestSnapshots(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+		seedDB func() error
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			seedDB: seedDB,
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if test.seedDB != nil {
+				err = test.seedDB()
+				if err != nil {
+					t.Error(err)
+				}
+			}
+
+			snapshotSQLTester(t)
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func snapshotSQLTester(t *testing.T) {
+	t.Helper()
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	var snapshots []Snapshot
+	for i := 0; i < 5; i++ {
+		snapshots = append(snapshots, Snapshot{
+			Exchange:  testExchanges[0].Name,
+			Base:      "btc",
+			Quote:     "usd",
+			Asset:     "SPOT",
+			Timestamp: start.Add(time.Second * time.Duration(i)),
+			Bids:      []Level{{Price: 100, Amount: float64(i + 1)}, {Price: 99, Amount: 2}},
+			Asks:      []Level{{Price: 101, Amount: 1}},
+		})
+	}
+	err := Insert(snapshots...)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	// duplicate snapshots are ignored
+	err = Insert(snapshots[0])
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	resp, err := GetInRange(testExchanges[0].Name, "BTC", "USD", "spot", start, start.Add(time.Minute))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp) != 5 {
+		t.Fatalf("received '%v' expected '%v'", len(resp), 5)
+	}
+	if !resp[4].Timestamp.Equal(start.Add(time.Second*4)) || resp[4].Bids[0].Amount != 5 || len(resp[4].Asks) != 1 {
+		t.Errorf("unexpected snapshot %+v", resp[4])
+	}
+
+	_, err = GetInRange(testExchanges[0].Name, "BTC", "USD", "spot", start.Add(time.Hour), start.Add(time.Hour*2))
+	if !errors.Is(err, ErrNoSnapshotsFound) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNoSnapshotsFound)
+	}
+	_, err = GetInRange(testExchanges[0].Name, "BTC", "USD", "spot", start, start)
+	if !errors.Is(err, errInvalidSnapshotRange) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidSnapshotRange)
+	}
+	err = Insert(Snapshot{Exchange: testExchanges[0].Name, Base: "BTC", Quote: "USD", Asset: "spot"})
+	if !errors.Is(err, errSnapshotTimestampUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errSnapshotTimestampUnset)
+	}
+	err = Insert()
+	if !errors.Is(err, errNoSnapshots) {
+		t.Errorf("received '%v' expected '%v'", err, errNoSnapshots)
+	}
+}
+
+func TestRebind(t *testing.T) {
+	t.Parallel()
+	if r := rebind("a = ? AND b = ?"); r != "a = $1 AND b = $2" {
+		t.Errorf("received '%v' expected '%v'", r, "a = $1 AND b = $2")
+	}
+}
+
+func seedDB() error {
+	return exchange.InsertMany(testExchanges)
+}
@@ -0,0 +1,33 @@
+package orderbook
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	errInvalidInput           = errors.New("exchange, base, quote & asset cannot be empty")
+	errNoSnapshots            = errors.New("no orderbook snapshots provided")
+	errInvalidSnapshotRange   = errors.New("end time must be after start time")
+	errSnapshotTimestampUnset = errors.New("orderbook snapshot timestamp unset")
+	// ErrNoSnapshotsFound returns when no orderbook snapshots are found
+	ErrNoSnapshotsFound = errors.New("no orderbook snapshots found")
+)
+
+// Snapshot defines a stored L2 orderbook snapshot
+type Snapshot struct {
+	ID        string
+	Exchange  string
+	Base      string
+	Quote     string
+	Asset     string
+	Timestamp time.Time
+	Bids      []Level
+	Asks      []Level
+}
+
+// Level defines the total amount available at a price level
+type Level struct {
+	Price  float64 `json:"p"`
+	Amount float64 `json:"a"`
+}
@@ -10,7 +10,7 @@ func GetSQLDialect() string {
 	switch cfg.Driver {
 	case "sqlite", "sqlite3":
 		return database.DBSQLite3
-	case "psql", "postgres", "postgresql":
+	case "psql", "postgres", "postgresql", database.DBTimescaleDB:
 		return database.DBPostgreSQL
 	}
 	return "invalid driver"
@@ -15,6 +15,10 @@ func TestGetSQLDialect(t *testing.T) {
 			"postgresql",
 			database.DBPostgreSQL,
 		},
+		{
+			database.DBTimescaleDB,
+			database.DBPostgreSQL,
+		},
 		{
 			"sqlite",
 			database.DBSQLite3,
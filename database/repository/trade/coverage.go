@@ -0,0 +1,91 @@
+package trade
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+const coverageQuery = `SELECT exchange.name, trade.base, trade.quote, trade.asset,
+	MIN(trade.timestamp), MAX(trade.timestamp), COUNT(*)
+FROM trade
+INNER JOIN exchange ON trade.exchange_name_id = exchange.id
+GROUP BY exchange.name, trade.base, trade.quote, trade.asset
+ORDER BY exchange.name, trade.base, trade.quote, trade.asset`
+
+// GetCoverage returns the stored trade range for every exchange, pair and
+// asset combination in the database. An empty exchange name returns coverage
+// for all exchanges
+func GetCoverage(exchangeName string) ([]Coverage, error) {
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	rows, err := database.DB.SQL.QueryContext(context.Background(), coverageQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var resp []Coverage
+	for rows.Next() {
+		var c Coverage
+		var start, end string
+		err = rows.Scan(&c.Exchange, &c.Base, &c.Quote, &c.AssetType, &start, &end, &c.Count)
+		if err != nil {
+			return nil, err
+		}
+		if exchangeName != "" && !strings.EqualFold(exchangeName, c.Exchange) {
+			continue
+		}
+		c.Start, err = time.Parse(time.RFC3339, start)
+		if err != nil {
+			return nil, err
+		}
+		c.End, err = time.Parse(time.RFC3339, end)
+		if err != nil {
+			return nil, err
+		}
+		c.Start = c.Start.UTC()
+		c.End = c.End.UTC()
+		resp = append(resp, c)
+	}
+	return resp, rows.Err()
+}
+
+// DeleteInRange deletes all trades for the exchange, asset and pair from the
+// start date up until the exclusive end date
+func DeleteInRange(exchangeName, assetType, base, quote string, start, end time.Time) (int64, error) {
+	if database.DB.SQL == nil {
+		return 0, database.ErrDatabaseSupportDisabled
+	}
+	if exchangeName == "" || assetType == "" || base == "" || quote == "" {
+		return 0, errInvalidInput
+	}
+	if !end.After(start) {
+		return 0, errInvalidRange
+	}
+	exchangeUUID, err := exchange.UUIDByName(exchangeName)
+	if err != nil {
+		return 0, err
+	}
+	queries := []qm.QueryMod{
+		qm.Where("exchange_name_id = ?", exchangeUUID.String()),
+		qm.Where("asset = ?", strings.ToLower(assetType)),
+		qm.Where("base = ?", strings.ToUpper(base)),
+		qm.Where("quote = ?", strings.ToUpper(quote)),
+	}
+	ctx := context.Background()
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		queries = append(queries, qm.Where("timestamp >= ? AND timestamp < ?", start.UTC().Format(time.RFC3339), end.UTC().Format(time.RFC3339)))
+		return sqlite3.Trades(queries...).DeleteAll(ctx, database.DB.SQL)
+	}
+	queries = append(queries, qm.Where("timestamp >= ? AND timestamp < ?", start.UTC(), end.UTC()))
+	return postgres.Trades(queries...).DeleteAll(ctx, database.DB.SQL)
+}
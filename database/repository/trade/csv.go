@@ -0,0 +1,119 @@
+package trade
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+var (
+	errInvalidCSVRow   = errors.New("invalid trade CSV row")
+	errInvalidTradeRow = errors.New("invalid trade data")
+	errNoTradeData     = errors.New("no trade data provided")
+	errInvalidInput    = errors.New("exchange, asset, base & quote cannot be empty")
+)
+
+// ImportCSV reads trade data in the format timestamp,price,amount,side and
+// inserts it into the database. Every row is validated, while duplicate trades
+// and trades which are already stored are skipped and reported as duplicates
+func ImportCSV(r io.Reader, exchangeName, assetType, base, quote string) (*UpsertResult, error) {
+	if exchangeName == "" || assetType == "" || base == "" || quote == "" {
+		return nil, errInvalidInput
+	}
+	csvData := csv.NewReader(r)
+	var trades []Data
+	for row := 1; ; row++ {
+		record, err := csvData.Read()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		t, err := parseCSVRow(record)
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %w", row, err)
+		}
+		t.Exchange = exchangeName
+		t.AssetType = assetType
+		t.Base = base
+		t.Quote = quote
+		trades = append(trades, t)
+	}
+	if len(trades) == 0 {
+		return nil, errNoTradeData
+	}
+	return Upsert(trades...)
+}
+
+// ExportCSV writes stored trades to the writer in the format
+// timestamp,price,amount,side, which can be reimported with ImportCSV or loaded
+// by the backtester. It returns the amount of trades written
+func ExportCSV(w io.Writer, exchangeName, assetType, base, quote string, start, end time.Time) (uint64, error) {
+	if exchangeName == "" || assetType == "" || base == "" || quote == "" {
+		return 0, errInvalidInput
+	}
+	trades, err := GetInRange(exchangeName, assetType, base, quote, start, end)
+	if err != nil {
+		return 0, err
+	}
+	csvWriter := csv.NewWriter(w)
+	for i := range trades {
+		side := trades[i].Side
+		if side == "" {
+			side = order.AnySide.String()
+		}
+		err = csvWriter.Write([]string{
+			strconv.FormatInt(trades[i].Timestamp.Unix(), 10),
+			strconv.FormatFloat(trades[i].Price, 'f', -1, 64),
+			strconv.FormatFloat(trades[i].Amount, 'f', -1, 64),
+			side,
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+	csvWriter.Flush()
+	return uint64(len(trades)), csvWriter.Error()
+}
+
+// parseCSVRow converts and validates a single CSV trade row
+func parseCSVRow(row []string) (Data, error) {
+	if len(row) < 4 {
+		return Data{}, fmt.Errorf("%w expected 4 columns received %d", errInvalidCSVRow, len(row))
+	}
+	v, err := strconv.ParseInt(row[0], 10, 64)
+	if err != nil {
+		return Data{}, err
+	}
+	if v <= 0 {
+		return Data{}, fmt.Errorf("%w timestamp %v", errInvalidCSVRow, row[0])
+	}
+	price, err := strconv.ParseFloat(row[1], 64)
+	if err != nil {
+		return Data{}, err
+	}
+	amount, err := strconv.ParseFloat(row[2], 64)
+	if err != nil {
+		return Data{}, err
+	}
+	if price <= 0 || amount <= 0 || math.IsInf(price, 0) || math.IsInf(amount, 0) {
+		return Data{}, fmt.Errorf("%w price and amount must be positive", errInvalidTradeRow)
+	}
+	side, err := order.StringToOrderSide(row[3])
+	if err != nil {
+		return Data{}, fmt.Errorf("%w %v", errInvalidTradeRow, err)
+	}
+	return Data{
+		Timestamp: time.Unix(v, 0).UTC(),
+		Price:     price,
+		Amount:    amount,
+		Side:      side.String(),
+	}, nil
+}
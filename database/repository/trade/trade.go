@@ -3,7 +3,6 @@ package trade
 import (
 	"context"
 	"database/sql"
-	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -20,46 +19,160 @@ import (
 	"github.com/thrasher-corp/sqlboiler/queries/qm"
 )
 
-// Insert saves trade data to the database
+// tradeIDConflictColumns are the columns of the uniquetradeid constraint
+// which identify trades with a trade ID
+var tradeIDConflictColumns = []string{"exchange_name_id", "base", "quote", "asset", "tid", "timestamp"}
+
+// Insert saves trade data to the database, skipping any trades which are
+// already stored
 func Insert(trades ...Data) error {
+	_, err := Upsert(trades...)
+	return err
+}
+
+// Upsert idempotently saves trade data to the database. Trades are unique by
+// exchange, asset and pair along with either their trade ID or, when they
+// have none, their timestamp, price and amount. Trades which are already
+// stored, or repeated within the batch, are skipped and counted as duplicates
+func Upsert(trades ...Data) (resp *UpsertResult, err error) {
 	for i := range trades {
 		if trades[i].ExchangeNameID == "" && trades[i].Exchange != "" {
-			exchangeUUID, err := exchange.UUIDByName(trades[i].Exchange)
+			var exchangeUUID uuid.UUID
+			exchangeUUID, err = exchange.UUIDByName(trades[i].Exchange)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			trades[i].ExchangeNameID = exchangeUUID.String()
 		} else if trades[i].ExchangeNameID == "" && trades[i].Exchange == "" {
-			return errors.New("exchange name/uuid not set, cannot insert")
+			return nil, errExchangeUnset
 		}
 	}
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
 
 	ctx := context.Background()
-	ctx = boil.SkipTimestamps(ctx)
-
 	tx, err := database.DB.SQL.BeginTx(ctx, nil)
 	if err != nil {
-		return fmt.Errorf("beginTx %w", err)
+		return nil, fmt.Errorf("beginTx %w", err)
 	}
 	defer func() {
 		if err != nil {
 			errRB := tx.Rollback()
 			if errRB != nil {
-				log.Errorf(log.DatabaseMgr, "Insert tx.Rollback %v", errRB)
+				log.Errorf(log.DatabaseMgr, "Upsert tx.Rollback %v", errRB)
 			}
 		}
 	}()
 
-	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
-		err = insertSQLite(ctx, tx, trades...)
-	} else {
-		err = insertPostgres(ctx, tx, trades...)
+	isSQLite := repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite
+	resp = &UpsertResult{}
+	for i := range trades {
+		if trades[i].ID == "" {
+			var freshUUID uuid.UUID
+			freshUUID, err = uuid.NewV4()
+			if err != nil {
+				return nil, err
+			}
+			trades[i].ID = freshUUID.String()
+		}
+		var inserted bool
+		if isSQLite {
+			inserted, err = upsertSQLite(ctx, tx, &trades[i])
+		} else {
+			inserted, err = upsertPostgres(ctx, tx, &trades[i])
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !inserted {
+			resp.Duplicates++
+			continue
+		}
+		resp.Inserted++
 	}
+
+	err = tx.Commit()
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return resp, nil
+}
 
-	return tx.Commit()
+// duplicateTradeMods returns the query mods matching stored trades which are
+// duplicates of the trade, using the columns of the unique constraint or
+// index which applies to it
+func duplicateTradeMods(t *Data, timestamp interface{}) []qm.QueryMod {
+	mods := []qm.QueryMod{
+		qm.Where("exchange_name_id = ?", t.ExchangeNameID),
+		qm.Where("base = ?", strings.ToUpper(t.Base)),
+		qm.Where("quote = ?", strings.ToUpper(t.Quote)),
+		qm.Where("asset = ?", strings.ToLower(t.AssetType)),
+		qm.Where("timestamp = ?", timestamp),
+	}
+	if t.TID != "" {
+		return append(mods, qm.Where("tid = ?", t.TID))
+	}
+	return append(mods,
+		qm.Where("tid IS NULL"),
+		qm.Where("price = ?", t.Price),
+		qm.Where("amount = ?", t.Amount))
+}
+
+// upsertSQLite inserts a trade unless it is already stored, returning whether
+// it was inserted
+func upsertSQLite(ctx context.Context, tx *sql.Tx, t *Data) (bool, error) {
+	var tempEvent = sqlite3.Trade{
+		ID:             t.ID,
+		ExchangeNameID: t.ExchangeNameID,
+		Base:           strings.ToUpper(t.Base),
+		Quote:          strings.ToUpper(t.Quote),
+		Asset:          strings.ToLower(t.AssetType),
+		Price:          t.Price,
+		Amount:         t.Amount,
+		Timestamp:      t.Timestamp.UTC().Format(time.RFC3339),
+	}
+	if t.Side != "" {
+		tempEvent.Side.SetValid(strings.ToUpper(t.Side))
+	}
+	if t.TID != "" {
+		tempEvent.Tid.SetValid(t.TID)
+	}
+	exists, err := sqlite3.Trades(duplicateTradeMods(t, tempEvent.Timestamp)...).Exists(ctx, tx)
+	if err != nil || exists {
+		return false, err
+	}
+	return true, tempEvent.Insert(ctx, tx, boil.Infer())
+}
+
+// upsertPostgres inserts a trade unless it is already stored, returning
+// whether it was inserted
+func upsertPostgres(ctx context.Context, tx *sql.Tx, t *Data) (bool, error) {
+	var tempEvent = postgres.Trade{
+		ID:             t.ID,
+		ExchangeNameID: t.ExchangeNameID,
+		Base:           strings.ToUpper(t.Base),
+		Quote:          strings.ToUpper(t.Quote),
+		Asset:          strings.ToLower(t.AssetType),
+		Price:          t.Price,
+		Amount:         t.Amount,
+		Timestamp:      t.Timestamp.UTC(),
+	}
+	if t.Side != "" {
+		tempEvent.Side.SetValid(strings.ToUpper(t.Side))
+	}
+	// trades without a trade ID are unique by a partial index which cannot
+	// be named as a conflict target, so any conflict is ignored for them
+	var conflictColumns []string
+	if t.TID != "" {
+		tempEvent.Tid.SetValid(t.TID)
+		conflictColumns = tradeIDConflictColumns
+	}
+	exists, err := postgres.Trades(duplicateTradeMods(t, tempEvent.Timestamp)...).Exists(ctx, tx)
+	if err != nil || exists {
+		return false, err
+	}
+	return true, tempEvent.Upsert(ctx, tx, false, conflictColumns, boil.Infer(), boil.Infer())
 }
 
 // VerifyTradeInIntervals will query for ONE trade within each kline interval and verify if data exists
@@ -145,77 +258,6 @@ func verifyTradeInIntervalsPostgres(ctx context.Context, tx *sql.Tx, exchangeNam
 	return nil
 }
 
-func insertSQLite(ctx context.Context, tx *sql.Tx, trades ...Data) error {
-	for i := range trades {
-		if trades[i].ID == "" {
-			freshUUID, err := uuid.NewV4()
-			if err != nil {
-				return err
-			}
-			trades[i].ID = freshUUID.String()
-		}
-		var tempEvent = sqlite3.Trade{
-			ID:             trades[i].ID,
-			ExchangeNameID: trades[i].ExchangeNameID,
-			Base:           strings.ToUpper(trades[i].Base),
-			Quote:          strings.ToUpper(trades[i].Quote),
-			Asset:          strings.ToLower(trades[i].AssetType),
-			Price:          trades[i].Price,
-			Amount:         trades[i].Amount,
-			Timestamp:      trades[i].Timestamp.UTC().Format(time.RFC3339),
-		}
-		if trades[i].Side != "" {
-			tempEvent.Side.SetValid(strings.ToUpper(trades[i].Side))
-		}
-		if trades[i].TID != "" {
-			tempEvent.Tid.SetValid(trades[i].TID)
-		}
-		err := tempEvent.Insert(ctx, tx, boil.Infer())
-		if err != nil {
-			return err
-		}
-	}
-
-	return nil
-}
-
-func insertPostgres(ctx context.Context, tx *sql.Tx, trades ...Data) error {
-	var err error
-	for i := range trades {
-		if trades[i].ID == "" {
-			var freshUUID uuid.UUID
-			freshUUID, err = uuid.NewV4()
-			if err != nil {
-				return err
-			}
-			trades[i].ID = freshUUID.String()
-		}
-		var tempEvent = postgres.Trade{
-			ExchangeNameID: trades[i].ExchangeNameID,
-			Base:           strings.ToUpper(trades[i].Base),
-			Quote:          strings.ToUpper(trades[i].Quote),
-			Asset:          strings.ToLower(trades[i].AssetType),
-			Price:          trades[i].Price,
-			Amount:         trades[i].Amount,
-			Timestamp:      trades[i].Timestamp.UTC(),
-			ID:             trades[i].ID,
-		}
-		if trades[i].Side != "" {
-			tempEvent.Side.SetValid(strings.ToUpper(trades[i].Side))
-		}
-		if trades[i].TID != "" {
-			tempEvent.Tid.SetValid(trades[i].TID)
-		}
-
-		err = tempEvent.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer())
-		if err != nil {
-			return err
-		}
-	}
-
-	return nil
-}
-
 // GetByUUID returns a trade by its unique ID
 func GetByUUID(uuid string) (td Data, err error) {
 	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
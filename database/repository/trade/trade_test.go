@@ -1,10 +1,13 @@
 package trade
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -135,10 +138,13 @@ func tradeSQLTester(t *testing.T) {
 			TID:       fmt.Sprintf("%v", i),
 		})
 	}
-	err = Insert(trades2...)
+	result, err := Upsert(trades2...)
 	if err != nil {
 		t.Fatal(err)
 	}
+	if result.Inserted != 0 || result.Duplicates != 20 {
+		t.Errorf("expected all trades to be skipped as duplicates, received %+v", result)
+	}
 	resp, err := GetInRange(
 		testExchanges[0].Name,
 		asset.Spot.String(),
@@ -181,6 +187,31 @@ func tradeSQLTester(t *testing.T) {
 		t.Error(err)
 	}
 
+	coverage, err := GetCoverage(testExchanges[0].Name)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(coverage) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(coverage), 1)
+	}
+	if coverage[0].Count != 20 ||
+		!coverage[0].Start.Equal(firstTime.Add(time.Minute)) ||
+		!coverage[0].End.Equal(firstTime.Add(time.Minute*20)) {
+		t.Errorf("unexpected coverage %+v", coverage[0])
+	}
+
+	_, err = DeleteInRange(testExchanges[0].Name, asset.Spot.String(), currency.BTC.String(), currency.USD.String(), firstTime, firstTime)
+	if !errors.Is(err, errInvalidRange) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidRange)
+	}
+	deleted, err := DeleteInRange(testExchanges[0].Name, asset.Spot.String(), currency.BTC.String(), currency.USD.String(), firstTime, firstTime.Add(time.Minute*11))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if deleted != 10 {
+		t.Errorf("received '%v' expected '%v'", deleted, 10)
+	}
+
 	err = DeleteTrades(trades...)
 	if err != nil {
 		t.Error(err)
@@ -205,6 +236,114 @@ func tradeSQLTester(t *testing.T) {
 	}
 }
 
+func TestImportExportCSV(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+		seedDB func() error
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			seedDB: seedDB,
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if test.seedDB != nil {
+				err = test.seedDB()
+				if err != nil {
+					t.Error(err)
+				}
+			}
+
+			_, err = ImportCSV(strings.NewReader(""), "", "", "", "")
+			if !errors.Is(err, errInvalidInput) {
+				t.Errorf("received '%v' expected '%v'", err, errInvalidInput)
+			}
+			_, err = ImportCSV(strings.NewReader("1577836800,-1,1,BUY\n"), testExchanges[1].Name, "spot", "BTC", "USD")
+			if !errors.Is(err, errInvalidTradeRow) {
+				t.Errorf("received '%v' expected '%v'", err, errInvalidTradeRow)
+			}
+
+			data := "1577836800,100,1,BUY\n1577836800,100,1,BUY\n1577836801,101,2,SELL\n"
+			result, err := ImportCSV(strings.NewReader(data), testExchanges[1].Name, "spot", "BTC", "USD")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.Inserted != 2 || result.Duplicates != 1 {
+				t.Errorf("received '%+v' expected '%+v'", result, UpsertResult{Inserted: 2, Duplicates: 1})
+			}
+			result, err = ImportCSV(strings.NewReader(data), testExchanges[1].Name, "spot", "BTC", "USD")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.Inserted != 0 || result.Duplicates != 3 {
+				t.Errorf("received '%+v' expected '%+v'", result, UpsertResult{Duplicates: 3})
+			}
+			// uniqueness is per pair, so the same trades can be stored for
+			// another pair
+			result, err = ImportCSV(strings.NewReader(data), testExchanges[1].Name, "spot", "BTC", "USDT")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.Inserted != 2 {
+				t.Errorf("received '%v' expected '%v'", result.Inserted, 2)
+			}
+
+			start := time.Unix(1577836800, 0)
+			var buf bytes.Buffer
+			count, err := ExportCSV(&buf, testExchanges[1].Name, "spot", "BTC", "USD", start, start.Add(time.Minute))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if count != 2 {
+				t.Errorf("received '%v' expected '%v'", count, 2)
+			}
+			if buf.String() != "1577836800,100,1,BUY\n1577836801,101,2,SELL\n" {
+				t.Errorf("unexpected export %q", buf.String())
+			}
+
+			stored, err := GetInRange(testExchanges[1].Name, "spot", "BTC", "USD", start, start.Add(time.Minute))
+			if err != nil {
+				t.Fatal(err)
+			}
+			otherPair, err := GetInRange(testExchanges[1].Name, "spot", "BTC", "USDT", start, start.Add(time.Minute))
+			if err != nil {
+				t.Fatal(err)
+			}
+			stored = append(stored, otherPair...)
+			err = DeleteTrades(stored...)
+			if err != nil {
+				t.Error(err)
+			}
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
 func seedDB() error {
 	err := exchange.InsertMany(testExchanges)
 	if err != nil {
@@ -1,6 +1,14 @@
 package trade
 
-import "time"
+import (
+	"errors"
+	"time"
+)
+
+var (
+	errInvalidRange  = errors.New("end time must be after start time")
+	errExchangeUnset = errors.New("exchange name/uuid not set, cannot insert")
+)
 
 // Data defines trade data in its simplest
 // db friendly form
@@ -17,3 +25,21 @@ type Data struct {
 	Side           string
 	Timestamp      time.Time
 }
+
+// Coverage defines the stored trade range for an exchange, pair and asset
+type Coverage struct {
+	Exchange  string
+	Base      string
+	Quote     string
+	AssetType string
+	Start     time.Time
+	End       time.Time
+	Count     int64
+}
+
+// UpsertResult details how many trades were stored and how many were skipped
+// as duplicates of stored trades
+type UpsertResult struct {
+	Inserted   uint64
+	Duplicates uint64
+}
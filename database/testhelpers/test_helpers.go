@@ -79,7 +79,7 @@ func ConnectToDatabase(conn *database.Config) (dbConn *database.Instance, err er
 	if err != nil {
 		return nil, err
 	}
-	if conn.Driver == database.DBPostgreSQL {
+	if conn.Driver == database.DBPostgreSQL || conn.Driver == database.DBTimescaleDB {
 		dbConn, err = psqlConn.Connect(conn)
 		if err != nil {
 			return nil, err
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/pprof"
 	"runtime"
@@ -20,6 +21,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
@@ -125,6 +127,7 @@ func (m *apiServerManager) newRouter(isREST bool) *mux.Router {
 			{"AllActiveExchangesAndCurrencies", http.MethodGet, "/exchanges/enabled/latest/all", m.restGetAllActiveTickers},
 			{"GetPortfolio", http.MethodGet, "/portfolio/all", m.restGetPortfolio},
 			{"AllActiveExchangesAndOrderbooks", http.MethodGet, "/exchanges/orderbook/latest/all", m.restGetAllActiveOrderbooks},
+			{"WebsocketMetrics", http.MethodGet, "/metrics", m.restGetWebsocketMetrics},
 		}
 
 		if m.pprofConfig.Enabled {
@@ -291,6 +294,76 @@ func (m *apiServerManager) restGetAllEnabledAccountInfo(w http.ResponseWriter, r
 	}
 }
 
+// restGetWebsocketMetrics returns the websocket message metrics of all
+// exchanges in the Prometheus text exposition format
+func (m *apiServerManager) restGetWebsocketMetrics(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", prometheusContentType)
+	w.WriteHeader(http.StatusOK)
+	err := writeWebsocketMetrics(w, m.exchangeManager)
+	if err != nil {
+		handleError(r.Method, err)
+	}
+}
+
+// writeWebsocketMetrics writes the websocket connection and channel metrics
+// of all exchanges with a websocket as Prometheus metric families
+func writeWebsocketMetrics(w io.Writer, m iExchangeManager) error {
+	exchanges, err := m.GetExchanges()
+	if err != nil {
+		return err
+	}
+	type sample struct {
+		labels  string
+		metrics stream.MessageMetrics
+	}
+	var samples []sample
+	for x := range exchanges {
+		ws, err := exchanges[x].GetWebsocket()
+		if err != nil {
+			continue
+		}
+		snapshot := ws.Metrics.Snapshot()
+		for i := range snapshot.Connections {
+			samples = append(samples, sample{
+				labels:  prometheusLabels(exchanges[x].GetName(), "connection", snapshot.Connections[i].Name),
+				metrics: snapshot.Connections[i],
+			})
+		}
+		for i := range snapshot.Channels {
+			samples = append(samples, sample{
+				labels:  prometheusLabels(exchanges[x].GetName(), "channel", snapshot.Channels[i].Name),
+				metrics: snapshot.Channels[i],
+			})
+		}
+	}
+	for i := range websocketMetricFamilies {
+		_, err = fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n",
+			websocketMetricFamilies[i].name,
+			websocketMetricFamilies[i].help,
+			websocketMetricFamilies[i].name,
+			websocketMetricFamilies[i].metricType)
+		if err != nil {
+			return err
+		}
+		for j := range samples {
+			_, err = fmt.Fprintf(w, "%s{%s} %s\n",
+				websocketMetricFamilies[i].name,
+				samples[j].labels,
+				strconv.FormatFloat(websocketMetricFamilies[i].value(&samples[j].metrics), 'g', -1, 64))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// prometheusLabels formats the labels of a websocket metric sample. Quoting
+// escapes backslashes, double quotes and line feeds as Prometheus requires
+func prometheusLabels(exchangeName, kind, name string) string {
+	return fmt.Sprintf("exchange=%q,kind=%q,name=%q", exchangeName, kind, name)
+}
+
 // getIndex returns an HTML snippet for when a user requests the index URL
 func (m *apiServerManager) getIndex(w http.ResponseWriter, _ *http.Request) {
 	_, err := fmt.Fprint(w, restIndexResponse)
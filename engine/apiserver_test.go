@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"io/ioutil"
@@ -8,9 +9,11 @@ import (
 	"net/http/httptest"
 	"os"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
 )
 
 func TestSetupAPIServerManager(t *testing.T) {
@@ -280,3 +283,35 @@ type fakeBot struct{}
 func (f *fakeBot) SetupExchanges() error {
 	return nil
 }
+
+func TestWriteWebsocketMetrics(t *testing.T) {
+	t.Parallel()
+	man := SetupExchangeManager()
+	bs, err := man.NewExchangeByName("Bitstamp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bs.SetDefaults()
+	man.Add(bs)
+	ws, err := bs.GetWebsocket()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ws.Metrics.RecordMessage(`live_"trades"`, &stream.Response{Raw: []byte("test")})
+
+	var buf bytes.Buffer
+	err = writeWebsocketMetrics(&buf, man)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	for _, expected := range []string{
+		"# TYPE gct_websocket_messages_total counter\n",
+		`gct_websocket_messages_total{exchange="Bitstamp",kind="channel",name="live_\"trades\""} 1` + "\n",
+		`gct_websocket_bytes_total{exchange="Bitstamp",kind="channel",name="live_\"trades\""} 4` + "\n",
+		`gct_websocket_processing_lag_max_seconds{exchange="Bitstamp",kind="channel",name="live_\"trades\""} 0` + "\n",
+	} {
+		if !strings.Contains(buf.String(), expected) {
+			t.Errorf("expected output to contain %q, received %s", expected, buf.String())
+		}
+	}
+}
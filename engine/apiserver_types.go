@@ -10,6 +10,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 )
 
@@ -19,6 +20,7 @@ const (
 	restIndexResponse        = "<html>GoCryptoTrader RESTful interface. For the web GUI, please visit the <a href=https://github.com/thrasher-corp/gocryptotrader/blob/master/web/README.md>web GUI readme.</a></html>"
 	DeprecatedName           = "deprecated_rpc"
 	WebsocketName            = "websocket_rpc"
+	prometheusContentType    = "text/plain; version=0.0.4; charset=utf-8"
 )
 
 var (
@@ -166,3 +168,27 @@ type wsCommandHandler struct {
 	authRequired bool
 	handler      func(client *websocketClient, data interface{}) error
 }
+
+// websocketMetricFamily defines a Prometheus metric family exported from
+// websocket message metrics
+type websocketMetricFamily struct {
+	name       string
+	help       string
+	metricType string
+	value      func(*stream.MessageMetrics) float64
+}
+
+var websocketMetricFamilies = []websocketMetricFamily{
+	{"gct_websocket_messages_total", "Messages received by a websocket connection or handled for a channel.", "counter",
+		func(m *stream.MessageMetrics) float64 { return float64(m.Messages) }},
+	{"gct_websocket_bytes_total", "Payload bytes received by a websocket connection or handled for a channel.", "counter",
+		func(m *stream.MessageMetrics) float64 { return float64(m.Bytes) }},
+	{"gct_websocket_messages_per_second", "Recent websocket message rate.", "gauge",
+		func(m *stream.MessageMetrics) float64 { return m.MessagesPerSecond }},
+	{"gct_websocket_bytes_per_second", "Recent websocket payload byte rate.", "gauge",
+		func(m *stream.MessageMetrics) float64 { return m.BytesPerSecond }},
+	{"gct_websocket_processing_lag_seconds", "Average time from a message being received to it being handled.", "gauge",
+		func(m *stream.MessageMetrics) float64 { return m.AverageLag.Seconds() }},
+	{"gct_websocket_processing_lag_max_seconds", "Maximum time from a message being received to it being handled.", "gauge",
+		func(m *stream.MessageMetrics) float64 { return m.MaxLag.Seconds() }},
+}
@@ -0,0 +1,297 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupArbitrageScanner creates an arbitrage scanner which compares the
+// orderbooks of the exchange manager's exchanges. The webhook publisher is
+// optional and opportunities are not posted to webhooks without it
+func SetupArbitrageScanner(em iExchangeManager, comms iCommsManager, webhooks iWebhookPublisher, cfg *config.ArbitrageScanner) (*ArbitrageScanner, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if comms == nil {
+		return nil, errNilCommunicationsManager
+	}
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	if cfg.CheckInterval <= 0 {
+		return nil, errInvalidArbitrageInterval
+	}
+	if cfg.Amount <= 0 {
+		return nil, errInvalidArbitrageAmount
+	}
+	if len(cfg.Pairs) == 0 {
+		return nil, errNoArbitragePairs
+	}
+	if !cfg.Asset.IsValid() {
+		return nil, fmt.Errorf("%s %w", cfg.Asset, asset.ErrNotSupported)
+	}
+	aggregator, err := SetupOrderbookAggregator(em, cfg.Verbose)
+	if err != nil {
+		return nil, err
+	}
+	return &ArbitrageScanner{
+		shutdown:              make(chan struct{}),
+		checkInterval:         cfg.CheckInterval,
+		asset:                 cfg.Asset,
+		pairs:                 cfg.Pairs,
+		amount:                cfg.Amount,
+		minimumProfitPercent:  cfg.MinimumProfitPercent,
+		includeWithdrawalFees: cfg.IncludeWithdrawalFees,
+		verbose:               cfg.Verbose,
+		exchangeManager:       em,
+		aggregator:            aggregator,
+		commsManager:          comms,
+		webhooks:              webhooks,
+		withdrawalFeeFetcher:  fetchWithdrawalFee,
+		opportunities:         make(map[string]*ArbitrageOpportunity),
+	}, nil
+}
+
+// Start runs the subsystem
+func (a *ArbitrageScanner) Start() error {
+	if a == nil {
+		return ErrNilSubsystem
+	}
+	if !atomic.CompareAndSwapInt32(&a.started, 0, 1) {
+		return ErrSubSystemAlreadyStarted
+	}
+	a.shutdown = make(chan struct{})
+	go a.run()
+	log.Debugf(log.ExchangeSys, "Arbitrage scanner %v", MsgSubSystemStarted)
+	return nil
+}
+
+// IsRunning checks whether the subsystem is running
+func (a *ArbitrageScanner) IsRunning() bool {
+	if a == nil {
+		return false
+	}
+	return atomic.LoadInt32(&a.started) == 1
+}
+
+// Stop stops the subsystem
+func (a *ArbitrageScanner) Stop() error {
+	if a == nil {
+		return ErrNilSubsystem
+	}
+	if !atomic.CompareAndSwapInt32(&a.started, 1, 0) {
+		return ErrSubSystemNotStarted
+	}
+	close(a.shutdown)
+	log.Debugf(log.ExchangeSys, "Arbitrage scanner %v", MsgSubSystemShutdown)
+	return nil
+}
+
+func (a *ArbitrageScanner) run() {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-a.shutdown:
+			return
+		case <-timer.C:
+			ctx, cancel := a.cycleContext()
+			a.scan(ctx)
+			cancel()
+			timer.Reset(a.checkInterval)
+		}
+	}
+}
+
+// cycleContext returns a context which is cancelled when the subsystem is
+// stopped
+func (a *ArbitrageScanner) cycleContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		select {
+		case <-a.shutdown:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
+
+// scan compares every pairing of exchanges for each configured pair and
+// replaces the current opportunities with those found. Opportunities which
+// were not present on the previous scan are reported
+func (a *ArbitrageScanner) scan(ctx context.Context) {
+	found := make(map[string]*ArbitrageOpportunity)
+	for i := range a.pairs {
+		books, _, err := a.aggregator.getExchangeBooks(ctx, &AggregateRequest{
+			Pair:        a.pairs[i],
+			Asset:       a.asset,
+			FeeAdjusted: true,
+			FeeAmount:   a.amount,
+		})
+		if err != nil {
+			if a.verbose {
+				log.Warnf(log.ExchangeSys, "Arbitrage scanner unable to compare %s %s: %v", a.asset, a.pairs[i], err)
+			}
+			continue
+		}
+		for x := range books {
+			for y := range books {
+				if x == y {
+					continue
+				}
+				opp := a.evaluate(ctx, a.pairs[i], &books[x], &books[y])
+				if opp == nil {
+					continue
+				}
+				found[arbitrageKey(opp)] = opp
+			}
+		}
+	}
+
+	var detected []*ArbitrageOpportunity
+	a.m.Lock()
+	for k, opp := range found {
+		if previous, ok := a.opportunities[k]; ok {
+			opp.DetectedAt = previous.DetectedAt
+			continue
+		}
+		detected = append(detected, opp)
+	}
+	a.opportunities = found
+	a.m.Unlock()
+
+	for i := range detected {
+		a.report(detected[i])
+	}
+}
+
+// evaluate returns the opportunity of buying a pair on one exchange and
+// selling it on another, or nil when it is not profitable enough to report
+func (a *ArbitrageScanner) evaluate(ctx context.Context, cp currency.Pair, buy, sell *exchangeBook) *ArbitrageOpportunity {
+	if len(buy.asks) == 0 ||
+		len(sell.bids) == 0 ||
+		buy.asks[0].EffectivePrice >= sell.bids[0].EffectivePrice {
+		return nil
+	}
+	buyAllocs, bought := allocateRouteLevels(buy.asks, a.amount, true)
+	sellAllocs, sold := allocateRouteLevels(sell.bids, a.amount, false)
+	amount := math.Min(bought, sold)
+	if amount <= 0 {
+		return nil
+	}
+	if amount < a.amount {
+		buyAllocs, _ = allocateRouteLevels(buy.asks, amount, true)
+		sellAllocs, _ = allocateRouteLevels(sell.bids, amount, false)
+	}
+
+	opp := &ArbitrageOpportunity{
+		Pair:         cp,
+		Asset:        a.asset,
+		BuyExchange:  buy.exchange,
+		SellExchange: sell.exchange,
+		Amount:       amount,
+		BuyPrice:     buyAllocs[0].AveragePrice,
+		SellPrice:    sellAllocs[0].AveragePrice,
+		TradingFees:  buyAllocs[0].Fee + sellAllocs[0].Fee,
+		Cost:         buyAllocs[0].Total,
+		Proceeds:     sellAllocs[0].Total,
+	}
+	if a.includeWithdrawalFees {
+		exch, err := a.exchangeManager.GetExchangeByName(buy.exchange)
+		if err == nil {
+			var fee float64
+			fee, err = a.withdrawalFeeFetcher(ctx, exch, cp, amount)
+			opp.WithdrawalFee = fee * opp.SellPrice
+		}
+		if err != nil && a.verbose {
+			log.Warnf(log.ExchangeSys, "Arbitrage scanner unable to retrieve %s %s withdrawal fee, withdrawal fees will not be considered: %v", buy.exchange, cp.Base, err)
+		}
+	}
+	opp.Profit = opp.Proceeds - opp.Cost - opp.WithdrawalFee
+	opp.SpreadPercent = (opp.SellPrice - opp.BuyPrice) / opp.BuyPrice * 100
+	opp.ProfitPercent = opp.Profit / opp.Cost * 100
+	if opp.Profit <= 0 || opp.ProfitPercent < a.minimumProfitPercent {
+		return nil
+	}
+	opp.DetectedAt = time.Now()
+	opp.UpdatedAt = opp.DetectedAt
+	return opp
+}
+
+// report sends an event and webhook for a newly detected opportunity
+func (a *ArbitrageScanner) report(opp *ArbitrageOpportunity) {
+	msg := fmt.Sprintf("%s %s %v buy on %s at %v and sell on %s at %v for a profit of %v (%.4f%%)",
+		opp.Asset, opp.Pair, opp.Amount, opp.BuyExchange, opp.BuyPrice, opp.SellExchange, opp.SellPrice, opp.Profit, opp.ProfitPercent)
+	if a.verbose {
+		log.Infoln(log.ExchangeSys, "Arbitrage scanner: "+msg)
+	}
+	a.commsManager.PushEvent(base.Event{
+		Type:    "arbitrage",
+		Message: msg,
+	})
+	if a.webhooks == nil {
+		return
+	}
+	err := a.webhooks.Publish(WebhookEventArbitrageOpportunity, opp.BuyExchange, *opp)
+	if err != nil &&
+		!errors.Is(err, ErrNilSubsystem) &&
+		!errors.Is(err, ErrSubSystemNotStarted) {
+		log.Errorf(log.ExchangeSys, "Arbitrage scanner unable to publish webhook: %v", err)
+	}
+}
+
+// arbitrageKey returns the key an opportunity is tracked under between scans
+func arbitrageKey(opp *ArbitrageOpportunity) string {
+	return strings.ToLower(opp.BuyExchange + "|" + opp.SellExchange + "|" + opp.Pair.String())
+}
+
+// GetOpportunities returns the opportunities found by the latest scan ordered
+// from the most to least profitable, optionally filtered to those buying or
+// selling on an exchange
+func (a *ArbitrageScanner) GetOpportunities(exchName string) ([]ArbitrageOpportunity, error) {
+	if a == nil {
+		return nil, fmt.Errorf("arbitrage scanner %w", ErrNilSubsystem)
+	}
+	if !a.IsRunning() {
+		return nil, fmt.Errorf("arbitrage scanner %w", ErrSubSystemNotStarted)
+	}
+	a.m.RLock()
+	defer a.m.RUnlock()
+	opportunities := make([]ArbitrageOpportunity, 0, len(a.opportunities))
+	for _, opp := range a.opportunities {
+		if exchName != "" &&
+			!strings.EqualFold(opp.BuyExchange, exchName) &&
+			!strings.EqualFold(opp.SellExchange, exchName) {
+			continue
+		}
+		opportunities = append(opportunities, *opp)
+	}
+	sort.Slice(opportunities, func(i, j int) bool {
+		return opportunities[i].ProfitPercent > opportunities[j].ProfitPercent
+	})
+	return opportunities, nil
+}
+
+// fetchWithdrawalFee returns an exchange's fee for withdrawing an amount of a
+// pair's base currency, denominated in the base currency
+func fetchWithdrawalFee(ctx context.Context, exch exchange.IBotExchange, cp currency.Pair, amount float64) (float64, error) {
+	return exch.GetFeeByType(ctx, &exchange.FeeBuilder{
+		FeeType: exchange.CryptocurrencyWithdrawalFee,
+		Pair:    cp,
+		Amount:  amount,
+	})
+}
@@ -0,0 +1,197 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+var asConfig = &config.ArbitrageScanner{
+	CheckInterval:         time.Minute,
+	Asset:                 asset.Spot,
+	Pairs:                 currency.Pairs{currency.NewPair(currency.BTC, currency.USDT)},
+	Amount:                1,
+	IncludeWithdrawalFees: true,
+}
+
+// setupTestArbitrageScanner returns a scanner where buying on low and selling
+// on high is profitable after low's 1% taker fee
+func setupTestArbitrageScanner(t *testing.T, comms iCommsManager, webhooks iWebhookPublisher) *ArbitrageScanner {
+	t.Helper()
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	em := SetupExchangeManager()
+	em.Add(&routerExchange{name: "low", enabled: true, pairs: currency.Pairs{cp}})
+	em.Add(&routerExchange{name: "high", enabled: true, pairs: currency.Pairs{cp}})
+	a, err := SetupArbitrageScanner(em, comms, webhooks, asConfig)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	books := map[string]*orderbook.Base{
+		"low": {
+			Asks: orderbook.Items{{Price: 100, Amount: 1}},
+			Bids: orderbook.Items{{Price: 99, Amount: 1}},
+		},
+		"high": {
+			Asks: orderbook.Items{{Price: 106, Amount: 1}},
+			Bids: orderbook.Items{{Price: 105, Amount: 0.5}},
+		},
+	}
+	a.aggregator.orderbookFetcher = func(exch string, _ currency.Pair, _ asset.Item) (*orderbook.Base, error) {
+		return books[exch], nil
+	}
+	a.aggregator.feeFetcher = func(_ context.Context, exch exchange.IBotExchange, _ currency.Pair, _, _ float64) (float64, error) {
+		if exch.GetName() == "low" {
+			return 0.01, nil
+		}
+		return 0, nil
+	}
+	a.withdrawalFeeFetcher = func(context.Context, exchange.IBotExchange, currency.Pair, float64) (float64, error) {
+		return 0.01, nil
+	}
+	return a
+}
+
+func TestSetupArbitrageScanner(t *testing.T) {
+	t.Parallel()
+	em := SetupExchangeManager()
+	comms := &dmComms{}
+	_, err := SetupArbitrageScanner(nil, comms, nil, asConfig)
+	if !errors.Is(err, errNilExchangeManager) {
+		t.Errorf("received '%v' expected '%v'", err, errNilExchangeManager)
+	}
+	_, err = SetupArbitrageScanner(em, nil, nil, asConfig)
+	if !errors.Is(err, errNilCommunicationsManager) {
+		t.Errorf("received '%v' expected '%v'", err, errNilCommunicationsManager)
+	}
+	_, err = SetupArbitrageScanner(em, comms, nil, nil)
+	if !errors.Is(err, errNilConfig) {
+		t.Errorf("received '%v' expected '%v'", err, errNilConfig)
+	}
+	_, err = SetupArbitrageScanner(em, comms, nil, &config.ArbitrageScanner{})
+	if !errors.Is(err, errInvalidArbitrageInterval) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidArbitrageInterval)
+	}
+	_, err = SetupArbitrageScanner(em, comms, nil, &config.ArbitrageScanner{CheckInterval: time.Minute})
+	if !errors.Is(err, errInvalidArbitrageAmount) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidArbitrageAmount)
+	}
+	_, err = SetupArbitrageScanner(em, comms, nil, &config.ArbitrageScanner{CheckInterval: time.Minute, Amount: 1})
+	if !errors.Is(err, errNoArbitragePairs) {
+		t.Errorf("received '%v' expected '%v'", err, errNoArbitragePairs)
+	}
+	_, err = SetupArbitrageScanner(em, comms, nil, &config.ArbitrageScanner{CheckInterval: time.Minute, Amount: 1, Pairs: asConfig.Pairs})
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v' expected '%v'", err, asset.ErrNotSupported)
+	}
+	a, err := SetupArbitrageScanner(em, comms, nil, asConfig)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if a.aggregator == nil || a.withdrawalFeeFetcher == nil {
+		t.Error("expected aggregator and fetchers to be set")
+	}
+}
+
+func TestArbitrageScannerStartStop(t *testing.T) {
+	t.Parallel()
+	var a *ArbitrageScanner
+	if err := a.Start(); !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+	if err := a.Stop(); !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+	if a.IsRunning() {
+		t.Error("expected not running")
+	}
+	a = setupTestArbitrageScanner(t, &dmComms{}, nil)
+	if err := a.Start(); !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if err := a.Start(); !errors.Is(err, ErrSubSystemAlreadyStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemAlreadyStarted)
+	}
+	if !a.IsRunning() {
+		t.Error("expected running")
+	}
+	if err := a.Stop(); !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if err := a.Stop(); !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemNotStarted)
+	}
+}
+
+func TestArbitrageScannerScan(t *testing.T) {
+	t.Parallel()
+	comms := &dmComms{}
+	webhooks := &dmPublisher{}
+	a := setupTestArbitrageScanner(t, comms, webhooks)
+	_, err := a.GetOpportunities("")
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemNotStarted)
+	}
+	a.started = 1
+
+	a.scan(context.Background())
+	opps, err := a.GetOpportunities("")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(opps) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(opps), 1)
+	}
+	// high's bid only fills half the amount. Buying 0.5 on low costs 50.5
+	// after fees, selling on high returns 52.5 and withdrawing 0.01 BTC costs
+	// 1.05 at the sell price
+	opp := opps[0]
+	if opp.BuyExchange != "low" || opp.SellExchange != "high" || opp.Amount != 0.5 {
+		t.Errorf("unexpected opportunity %+v", opp)
+	}
+	if opp.Cost != 50.5 || opp.Proceeds != 52.5 || opp.WithdrawalFee != 1.05 {
+		t.Errorf("unexpected opportunity %+v", opp)
+	}
+	if opp.Profit != 52.5-50.5-1.05 || opp.SpreadPercent != 5 {
+		t.Errorf("unexpected opportunity %+v", opp)
+	}
+	if len(comms.events) != 1 || len(webhooks.events) != 1 || webhooks.events[0] != WebhookEventArbitrageOpportunity {
+		t.Errorf("expected a single event and webhook, received %v %v", comms.events, webhooks.events)
+	}
+
+	// an opportunity which persists is not reported again
+	a.scan(context.Background())
+	opps, err = a.GetOpportunities("HIGH")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(opps) != 1 || !opps[0].DetectedAt.Equal(opp.DetectedAt) {
+		t.Errorf("unexpected opportunities %+v", opps)
+	}
+	if len(comms.events) != 1 {
+		t.Errorf("received '%v' expected '%v'", len(comms.events), 1)
+	}
+	opps, err = a.GetOpportunities("other")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(opps) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(opps), 0)
+	}
+
+	a.minimumProfitPercent = 5
+	a.scan(context.Background())
+	opps, err = a.GetOpportunities("")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(opps) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(opps), 0)
+	}
+}
@@ -0,0 +1,67 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+const arbitrageScannerName = "arbitrage_scanner"
+
+var (
+	errInvalidArbitrageInterval = errors.New("arbitrage scanner check interval must be greater than zero")
+	errInvalidArbitrageAmount   = errors.New("arbitrage scanner amount must be greater than zero")
+	errNoArbitragePairs         = errors.New("arbitrage scanner has no pairs configured")
+)
+
+// ArbitrageScanner compares the fee adjusted orderbooks of the configured
+// pairs across all enabled exchanges, reporting opportunities to buy on one
+// exchange and sell on another which remain profitable after trading fees and,
+// optionally, the cost of withdrawing the bought currency to the selling
+// exchange. New opportunities are pushed as events and posted to webhooks
+type ArbitrageScanner struct {
+	started               int32
+	shutdown              chan struct{}
+	checkInterval         time.Duration
+	asset                 asset.Item
+	pairs                 currency.Pairs
+	amount                float64
+	minimumProfitPercent  float64
+	includeWithdrawalFees bool
+	verbose               bool
+	exchangeManager       iExchangeManager
+	aggregator            *OrderbookAggregator
+	commsManager          iCommsManager
+	webhooks              iWebhookPublisher
+	withdrawalFeeFetcher  func(context.Context, exchange.IBotExchange, currency.Pair, float64) (float64, error)
+	opportunities         map[string]*ArbitrageOpportunity
+	m                     sync.RWMutex
+}
+
+// ArbitrageOpportunity holds a profitable spread between buying a pair on one
+// exchange and selling it on another. Prices are volume weighted averages
+// across the orderbook levels required to fill the amount and fees are
+// denominated in the quote currency
+type ArbitrageOpportunity struct {
+	Pair          currency.Pair `json:"pair"`
+	Asset         asset.Item    `json:"asset"`
+	BuyExchange   string        `json:"buyExchange"`
+	SellExchange  string        `json:"sellExchange"`
+	Amount        float64       `json:"amount"`
+	BuyPrice      float64       `json:"buyPrice"`
+	SellPrice     float64       `json:"sellPrice"`
+	TradingFees   float64       `json:"tradingFees"`
+	WithdrawalFee float64       `json:"withdrawalFee"`
+	Cost          float64       `json:"cost"`
+	Proceeds      float64       `json:"proceeds"`
+	Profit        float64       `json:"profit"`
+	SpreadPercent float64       `json:"spreadPercent"`
+	ProfitPercent float64       `json:"profitPercent"`
+	DetectedAt    time.Time     `json:"detectedAt"`
+	UpdatedAt     time.Time     `json:"updatedAt"`
+}
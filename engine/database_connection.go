@@ -9,6 +9,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database"
 	dbpsql "github.com/thrasher-corp/gocryptotrader/database/drivers/postgres"
 	dbsqlite3 "github.com/thrasher-corp/gocryptotrader/database/drivers/sqlite3"
+	dbtimescale "github.com/thrasher-corp/gocryptotrader/database/drivers/timescaledb"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
@@ -92,6 +93,13 @@ func (m *DatabaseConnectionManager) Start(wg *sync.WaitGroup) (err error) {
 				m.cfg.Database,
 				m.cfg.Driver)
 			m.dbConn, err = dbpsql.Connect(&m.cfg)
+		case database.DBTimescaleDB:
+			log.Debugf(log.DatabaseMgr,
+				"Attempting to establish database connection to host %s/%s utilising %s driver\n",
+				m.cfg.Host,
+				m.cfg.Database,
+				m.cfg.Driver)
+			m.dbConn, err = dbtimescale.Connect(&m.cfg)
 		case database.DBSQLite,
 			database.DBSQLite3:
 			log.Debugf(log.DatabaseMgr,
@@ -21,6 +21,7 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
@@ -65,6 +66,10 @@ func SetupDataHistoryManager(em iExchangeManager, dcm iDatabaseConnectionManager
 		maxJobsPerCycle:            cfg.MaxJobsPerCycle,
 		verbose:                    cfg.Verbose,
 		maxResultInsertions:        cfg.MaxResultInsertions,
+		maxJobsPerExchange:         cfg.MaxJobsPerExchange,
+		exchangeRequestDelay:       cfg.ExchangeRequestDelay,
+		nextRequest:                make(map[string]time.Time),
+		updated:                    make(chan struct{}),
 		tradeLoader:                trade.GetTradesInRange,
 		tradeSaver:                 trade.SaveTradesToDatabase,
 		candleLoader:               kline.LoadFromDatabase,
@@ -104,6 +109,7 @@ func (m *DataHistoryManager) Stop() error {
 		return ErrSubSystemNotStarted
 	}
 	close(m.shutdown)
+	m.notify()
 	log.Debugf(log.DataHistory, "Data history manager %v", MsgSubSystemShutdown)
 	return nil
 }
@@ -262,11 +268,26 @@ func (m *DataHistoryManager) runJobs() error {
 	}
 
 	log.Infof(log.DataHistory, "processing data history jobs")
-	for i := 0; (i < int(m.maxJobsPerCycle) || m.maxJobsPerCycle == -1) && i < len(validJobs); i++ {
+	var jobsRun int64
+	exchangeJobs := make(map[string]int64)
+	for i := range validJobs {
+		if m.maxJobsPerCycle != -1 && jobsRun >= m.maxJobsPerCycle {
+			break
+		}
+		exchangeName := strings.ToLower(validJobs[i].requestExchange())
+		if m.maxJobsPerExchange > 0 && exchangeJobs[exchangeName] >= m.maxJobsPerExchange {
+			if m.verbose {
+				log.Debugf(log.DataHistory, "skipping data history job %v, %v has reached its job limit for this cycle", validJobs[i].Nickname, exchangeName)
+			}
+			continue
+		}
+		exchangeJobs[exchangeName]++
+		jobsRun++
 		err := m.runJob(validJobs[i])
 		if err != nil {
 			log.Error(log.DataHistory, err)
 		}
+		m.notify()
 		if m.verbose {
 			log.Debugf(log.DataHistory, "completed run of data history job %v", validJobs[i].Nickname)
 		}
@@ -299,11 +320,7 @@ func (m *DataHistoryManager) runJob(job *DataHistoryJob) error {
 			job.EndDate.Format(common.SimpleTimeFormatWithTimezone),
 		)
 	}
-	exchangeName := job.Exchange
-	if job.DataType == dataHistoryCandleValidationSecondarySourceType {
-		exchangeName = job.SecondaryExchangeSource
-	}
-	exch, err := m.exchangeManager.GetExchangeByName(exchangeName)
+	exch, err := m.exchangeManager.GetExchangeByName(job.requestExchange())
 	if err != nil {
 		return fmt.Errorf("%w, cannot process job %s for %s %s",
 			err,
@@ -339,6 +356,47 @@ func (m *DataHistoryManager) runJob(job *DataHistoryJob) error {
 	return nil
 }
 
+// requestExchange returns the name of the exchange a job sends its API
+// requests to
+func (j *DataHistoryJob) requestExchange() string {
+	if j.DataType == dataHistoryCandleValidationSecondarySourceType {
+		return j.SecondaryExchangeSource
+	}
+	return j.Exchange
+}
+
+// waitForExchange blocks until the exchange request delay has passed since
+// the previous data history API request to the exchange
+func (m *DataHistoryManager) waitForExchange(exchangeName string) error {
+	if m.exchangeRequestDelay <= 0 {
+		return nil
+	}
+	name := strings.ToLower(exchangeName)
+	m.requestMtx.Lock()
+	if m.nextRequest == nil {
+		m.nextRequest = make(map[string]time.Time)
+	}
+	next := m.nextRequest[name]
+	if now := time.Now(); next.Before(now) {
+		next = now
+	}
+	m.nextRequest[name] = next.Add(m.exchangeRequestDelay)
+	m.requestMtx.Unlock()
+
+	wait := time.Until(next)
+	if wait <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-m.shutdown:
+		return fmt.Errorf("data history manager %w", ErrSubSystemNotStarted)
+	}
+}
+
 // runDataJob will fetch data from an API endpoint or convert existing database data
 // into a new candle type
 func (m *DataHistoryManager) runDataJob(job *DataHistoryJob, exch exchange.IBotExchange) error {
@@ -700,7 +758,10 @@ func (m *DataHistoryManager) processCandleData(job *DataHistoryJob, exch exchang
 		Status:            dataHistoryStatusComplete,
 		Date:              time.Now(),
 	}
-	candles, err := exch.GetHistoricCandlesExtended(context.TODO(),
+	if err = m.waitForExchange(exch.GetName()); err != nil {
+		return nil, err
+	}
+	candles, err := exch.GetHistoricCandlesExtended(request.WithConsumer(context.TODO(), request.DataHistory),
 		job.Pair,
 		job.Asset,
 		startRange,
@@ -750,7 +811,10 @@ func (m *DataHistoryManager) processTradeData(job *DataHistoryJob, exch exchange
 		Status:            dataHistoryStatusComplete,
 		Date:              time.Now(),
 	}
-	trades, err := exch.GetHistoricTrades(context.TODO(),
+	if err = m.waitForExchange(exch.GetName()); err != nil {
+		return nil, err
+	}
+	trades, err := exch.GetHistoricTrades(request.WithConsumer(context.TODO(), request.DataHistory),
 		job.Pair,
 		job.Asset,
 		startRange,
@@ -903,7 +967,10 @@ func (m *DataHistoryManager) validateCandles(job *DataHistoryJob, exch exchange.
 		Date:              time.Now(),
 	}
 
-	apiCandles, err := exch.GetHistoricCandlesExtended(context.TODO(),
+	if err = m.waitForExchange(exch.GetName()); err != nil {
+		return nil, err
+	}
+	apiCandles, err := exch.GetHistoricCandlesExtended(request.WithConsumer(context.TODO(), request.DataHistory),
 		job.Pair,
 		job.Asset,
 		startRange,
@@ -1461,6 +1528,89 @@ func (m *DataHistoryManager) GenerateJobSummary(nickname string) (*DataHistoryJo
 	}, nil
 }
 
+// GetJobProgress returns how many of a job's intervals have been processed
+func (m *DataHistoryManager) GetJobProgress(nickname string) (*DataHistoryJobProgress, error) {
+	if m == nil {
+		return nil, ErrNilSubsystem
+	}
+	if !m.IsRunning() {
+		return nil, ErrSubSystemNotStarted
+	}
+	job, err := m.GetByNickname(nickname, true)
+	if err != nil {
+		return nil, fmt.Errorf("job: %v %w", nickname, err)
+	}
+	err = m.compareJobsToData(job)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &DataHistoryJobProgress{
+		Nickname:       job.Nickname,
+		Status:         job.Status,
+		DataType:       job.DataType,
+		TotalIntervals: int64(len(job.rangeHolder.Ranges)),
+	}
+	for i := range job.rangeHolder.Ranges {
+		complete := true
+		for j := range job.rangeHolder.Ranges[i].Intervals {
+			if !job.rangeHolder.Ranges[i].Intervals[j].HasData {
+				complete = false
+				break
+			}
+		}
+		issues := false
+		results := job.Results[job.rangeHolder.Ranges[i].Start.Time]
+		for j := range results {
+			switch results[j].Status {
+			case dataHistoryStatusComplete:
+				complete = true
+			case dataHistoryIntervalIssuesFound:
+				issues = true
+			case dataHistoryStatusFailed:
+				resp.FailedAttempts++
+			}
+			if results[j].Date.After(resp.LastRun) {
+				resp.LastRun = results[j].Date
+			}
+		}
+		switch {
+		case issues:
+			resp.IssueIntervals++
+		case complete:
+			resp.CompletedIntervals++
+		}
+	}
+	if resp.TotalIntervals > 0 {
+		resp.PercentComplete = float64(resp.CompletedIntervals+resp.IssueIntervals) / float64(resp.TotalIntervals) * 100
+	}
+	return resp, nil
+}
+
+// Updated returns a channel which is closed when the next job run completes
+// or the subsystem is stopped
+func (m *DataHistoryManager) Updated() (<-chan struct{}, error) {
+	if m == nil {
+		return nil, ErrNilSubsystem
+	}
+	m.updatedMtx.Lock()
+	defer m.updatedMtx.Unlock()
+	if m.updated == nil {
+		m.updated = make(chan struct{})
+	}
+	return m.updated, nil
+}
+
+// notify wakes any streams waiting for job progress
+func (m *DataHistoryManager) notify() {
+	m.updatedMtx.Lock()
+	if m.updated != nil {
+		close(m.updated)
+	}
+	m.updated = make(chan struct{})
+	m.updatedMtx.Unlock()
+}
+
 // ----------------------------Lovely-converters----------------------------
 func (m *DataHistoryManager) convertDBModelToJob(dbModel *datahistoryjob.DataHistoryJob) (*DataHistoryJob, error) {
 	if !m.IsRunning() {
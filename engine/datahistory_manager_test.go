@@ -819,6 +819,135 @@ func TestGenerateJobSummaryTest(t *testing.T) {
 	}
 }
 
+func TestGetJobProgress(t *testing.T) {
+	t.Parallel()
+	m, j := createDHM(t)
+	j.Results = []*datahistoryjobresult.DataHistoryJobResult{
+		{
+			ID:                jobID,
+			JobID:             jobID,
+			IntervalStartDate: startDate,
+			Status:            int64(dataHistoryStatusComplete),
+			Date:              endDate,
+		},
+		{
+			ID:                jobID,
+			JobID:             jobID,
+			IntervalStartDate: startDate.Add(time.Hour * 3),
+			Status:            int64(dataHistoryIntervalIssuesFound),
+			Date:              startDate,
+		},
+		{
+			ID:                jobID,
+			JobID:             jobID,
+			IntervalStartDate: startDate.Add(time.Hour * 6),
+			Status:            int64(dataHistoryStatusFailed),
+			Date:              startDate,
+		},
+	}
+	progress, err := m.GetJobProgress("TestGetJobProgress")
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	// a year of hourly candles in requests of three candles
+	if progress.TotalIntervals != 2928 {
+		t.Errorf("received '%v' expected '%v'", progress.TotalIntervals, 2928)
+	}
+	if progress.CompletedIntervals != 1 {
+		t.Errorf("received '%v' expected '%v'", progress.CompletedIntervals, 1)
+	}
+	if progress.IssueIntervals != 1 {
+		t.Errorf("received '%v' expected '%v'", progress.IssueIntervals, 1)
+	}
+	if progress.FailedAttempts != 1 {
+		t.Errorf("received '%v' expected '%v'", progress.FailedAttempts, 1)
+	}
+	if expected := 2.0 / 2928 * 100; progress.PercentComplete != expected {
+		t.Errorf("received '%v' expected '%v'", progress.PercentComplete, expected)
+	}
+	if !progress.LastRun.Equal(endDate) {
+		t.Errorf("received '%v' expected '%v'", progress.LastRun, endDate)
+	}
+
+	atomic.StoreInt32(&m.started, 0)
+	_, err = m.GetJobProgress("TestGetJobProgress")
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("error '%v', expected '%v'", err, ErrSubSystemNotStarted)
+	}
+
+	m = nil
+	_, err = m.GetJobProgress("TestGetJobProgress")
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("error '%v', expected '%v'", err, ErrNilSubsystem)
+	}
+}
+
+func TestDataHistoryManagerUpdated(t *testing.T) {
+	t.Parallel()
+	m, _ := createDHM(t)
+	updated, err := m.Updated()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	select {
+	case <-updated:
+		t.Fatal("expected no update before a job run")
+	default:
+	}
+	m.notify()
+	select {
+	case <-updated:
+	default:
+		t.Error("expected notify to wake waiting streams")
+	}
+
+	m = nil
+	_, err = m.Updated()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("error '%v', expected '%v'", err, ErrNilSubsystem)
+	}
+}
+
+func TestWaitForExchange(t *testing.T) {
+	t.Parallel()
+	m, _ := createDHM(t)
+	err := m.waitForExchange(testExchange)
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+	if len(m.nextRequest) != 0 {
+		t.Error("expected no throttling without a request delay")
+	}
+
+	m.exchangeRequestDelay = time.Millisecond * 50
+	start := time.Now()
+	for i := 0; i < 2; i++ {
+		err = m.waitForExchange(testExchange)
+		if !errors.Is(err, nil) {
+			t.Fatalf("error '%v', expected '%v'", err, nil)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < m.exchangeRequestDelay {
+		t.Errorf("expected second request to wait at least %v, waited %v", m.exchangeRequestDelay, elapsed)
+	}
+	start = time.Now()
+	err = m.waitForExchange("Binance")
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+	if elapsed := time.Since(start); elapsed >= m.exchangeRequestDelay {
+		t.Errorf("expected other exchanges not to be throttled, waited %v", elapsed)
+	}
+
+	m.exchangeRequestDelay = time.Hour
+	m.shutdown = make(chan struct{})
+	close(m.shutdown)
+	err = m.waitForExchange(testExchange)
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("error '%v', expected '%v'", err, ErrSubSystemNotStarted)
+	}
+}
+
 func TestRunJobs(t *testing.T) {
 	t.Parallel()
 	m, _ := createDHM(t)
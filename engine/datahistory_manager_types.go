@@ -2,6 +2,7 @@ package engine
 
 import (
 	"errors"
+	"sync"
 	"time"
 
 	"github.com/gofrs/uuid"
@@ -131,11 +132,21 @@ type DataHistoryManager struct {
 	jobResultDB                datahistoryjobresult.IDBService
 	maxJobsPerCycle            int64
 	maxResultInsertions        int64
+	maxJobsPerExchange         int64
+	exchangeRequestDelay       time.Duration
 	verbose                    bool
 	candleLoader               func(string, currency.Pair, asset.Item, kline.Interval, time.Time, time.Time) (kline.Item, error)
 	tradeLoader                func(string, string, string, string, time.Time, time.Time) ([]trade.Data, error)
 	tradeSaver                 func(...trade.Data) error
 	candleSaver                func(*kline.Item, bool) (uint64, error)
+	// nextRequest holds the earliest time each exchange can next be sent a
+	// data history API request
+	nextRequest map[string]time.Time
+	requestMtx  sync.Mutex
+	// updated is closed and replaced after every job run so streams can wait
+	// for progress
+	updated    chan struct{}
+	updatedMtx sync.Mutex
 }
 
 // DataHistoryJob used to gather candle/trade history and save
@@ -180,6 +191,20 @@ type DataHistoryJobResult struct {
 	Date              time.Time
 }
 
+// DataHistoryJobProgress details how many of a job's intervals have been
+// processed
+type DataHistoryJobProgress struct {
+	Nickname           string
+	Status             dataHistoryStatus
+	DataType           dataHistoryDataType
+	TotalIntervals     int64
+	CompletedIntervals int64
+	IssueIntervals     int64
+	FailedAttempts     int64
+	PercentComplete    float64
+	LastRun            time.Time
+}
+
 // DataHistoryJobSummary is a human readable summary of the job
 // for quickly understanding the status of a given job
 type DataHistoryJobSummary struct {
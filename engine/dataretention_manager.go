@@ -0,0 +1,372 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
+	tradesql "github.com/thrasher-corp/gocryptotrader/database/repository/trade"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupDataRetentionManager creates a data retention manager subsystem
+func SetupDataRetentionManager(dcm iDatabaseConnectionManager, cfg *config.DataRetentionManager) (*DataRetentionManager, error) {
+	if dcm == nil {
+		return nil, errNilDatabaseConnectionManager
+	}
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	db := dcm.GetInstance()
+	if db == nil {
+		return nil, database.ErrNilInstance
+	}
+	if cfg.CheckInterval <= 0 {
+		cfg.CheckInterval = defaultDataRetentionCheckInterval
+	}
+	m := &DataRetentionManager{
+		databaseConnectionInstance: db,
+		shutdown:                   make(chan struct{}),
+		checkInterval:              cfg.CheckInterval,
+		verbose:                    cfg.Verbose,
+		candleCoverage:             candle.GetCoverage,
+		tradeCoverage:              tradesql.GetCoverage,
+		candleLoader:               kline.LoadFromDatabase,
+		tradeLoader:                trade.GetTradesInRange,
+		candleSaver:                kline.StoreInDatabase,
+		candleDeleter:              candle.DeleteInRange,
+		tradeDeleter:               tradesql.DeleteInRange,
+	}
+	for i := range cfg.Policies {
+		if err := m.addPolicy(&cfg.Policies[i]); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// addPolicy validates and adds a configured policy to the manager
+func (m *DataRetentionManager) addPolicy(cfg *config.DataRetentionPolicy) error {
+	p := dataRetentionPolicy{
+		dataType:     strings.ToLower(cfg.DataType),
+		exchange:     strings.ToLower(cfg.Exchange),
+		retainFor:    cfg.RetainFor,
+		downsampleTo: cfg.DownsampleTo,
+	}
+	switch p.dataType {
+	case dataRetentionCandles:
+		p.interval = cfg.Interval
+		if p.interval <= 0 {
+			return fmt.Errorf("%w %s %v", errInvalidDataRetentionPolicy, p.dataType, kline.ErrUnsetInterval)
+		}
+		if p.downsampleTo > 0 {
+			if p.downsampleTo.Duration() <= p.interval.Duration() {
+				return fmt.Errorf("%w %s %s %v", errInvalidDataRetentionPolicy, p.dataType, p.interval, kline.ErrCanOnlyDownscaleCandles)
+			}
+			if p.downsampleTo.Duration()%p.interval.Duration() != 0 {
+				return fmt.Errorf("%w %s %s %v", errInvalidDataRetentionPolicy, p.dataType, p.interval, kline.ErrWholeNumberScaling)
+			}
+		}
+	case dataRetentionTrades:
+	default:
+		return fmt.Errorf("%w data type %q must be %s or %s", errInvalidDataRetentionPolicy, cfg.DataType, dataRetentionCandles, dataRetentionTrades)
+	}
+	if p.retainFor <= 0 {
+		return fmt.Errorf("%w %s retain for must be greater than zero", errInvalidDataRetentionPolicy, p.dataType)
+	}
+	if p.downsampleTo < 0 {
+		return fmt.Errorf("%w %s downsample interval cannot be negative", errInvalidDataRetentionPolicy, p.dataType)
+	}
+	for i := range m.policies {
+		if m.policies[i].dataType == p.dataType &&
+			m.policies[i].exchange == p.exchange &&
+			m.policies[i].interval == p.interval {
+			return fmt.Errorf("%w %s %s %s", errDataRetentionPolicyAlreadyExists, p.dataType, p.exchange, p.interval)
+		}
+	}
+	m.policies = append(m.policies, p)
+	return nil
+}
+
+// Start runs the subsystem
+func (m *DataRetentionManager) Start() error {
+	if m == nil {
+		return ErrNilSubsystem
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return ErrSubSystemAlreadyStarted
+	}
+	m.shutdown = make(chan struct{})
+	go m.run()
+	log.Debugf(log.DataHistory, "Data retention manager %v", MsgSubSystemStarted)
+	return nil
+}
+
+// IsRunning checks whether the subsystem is running
+func (m *DataRetentionManager) IsRunning() bool {
+	if m == nil {
+		return false
+	}
+	return atomic.LoadInt32(&m.started) == 1
+}
+
+// Stop stops the subsystem
+func (m *DataRetentionManager) Stop() error {
+	if m == nil {
+		return ErrNilSubsystem
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 1, 0) {
+		return ErrSubSystemNotStarted
+	}
+	close(m.shutdown)
+	log.Debugf(log.DataHistory, "Data retention manager %v", MsgSubSystemShutdown)
+	return nil
+}
+
+func (m *DataRetentionManager) run() {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-timer.C:
+			if m.databaseConnectionInstance.IsConnected() {
+				if err := m.applyPolicies(time.Now()); err != nil {
+					log.Error(log.DataHistory, err)
+				}
+			}
+			timer.Reset(m.checkInterval)
+		}
+	}
+}
+
+// applyPolicies applies every policy to the stored data, a failing policy
+// does not prevent the remaining policies from being applied
+func (m *DataRetentionManager) applyPolicies(now time.Time) error {
+	if !m.IsRunning() {
+		return ErrSubSystemNotStarted
+	}
+	if !atomic.CompareAndSwapInt32(&m.processing, 0, 1) {
+		return fmt.Errorf("cannot apply data retention policies, %w", errAlreadyRunning)
+	}
+	defer atomic.StoreInt32(&m.processing, 0)
+
+	for i := range m.policies {
+		if !m.IsRunning() {
+			return nil
+		}
+		var err error
+		switch m.policies[i].dataType {
+		case dataRetentionCandles:
+			err = m.applyCandlePolicy(&m.policies[i], now)
+		case dataRetentionTrades:
+			err = m.applyTradePolicy(&m.policies[i], now)
+		}
+		if err != nil {
+			log.Errorf(log.DataHistory, "data retention %s %s %s: %v",
+				m.policies[i].dataType,
+				m.policies[i].exchange,
+				m.policies[i].interval,
+				err)
+		}
+	}
+	return nil
+}
+
+// applyCandlePolicy downsamples, when configured, and deletes stored candles
+// of the policy interval which are older than the retention period
+func (m *DataRetentionManager) applyCandlePolicy(p *dataRetentionPolicy, now time.Time) error {
+	cutoff := p.cutoff(now)
+	coverage, err := m.candleCoverage(p.exchange)
+	if err != nil {
+		return err
+	}
+	interval := int64(p.interval.Duration().Seconds())
+	for i := range coverage {
+		if coverage[i].Interval != interval || !coverage[i].Start.Before(cutoff) {
+			continue
+		}
+		var result dataRetentionResult
+		pair := currency.NewPair(currency.NewCode(coverage[i].Base), currency.NewCode(coverage[i].Quote))
+		a := asset.Item(coverage[i].Asset)
+		if p.downsampleTo > 0 {
+			result.downsampled, err = m.downsampleCandles(p, coverage[i].Exchange, pair, a, coverage[i].Start, cutoff)
+			if err != nil {
+				return err
+			}
+		}
+		result.deleted, err = m.candleDeleter(coverage[i].Exchange,
+			coverage[i].Base,
+			coverage[i].Quote,
+			interval,
+			coverage[i].Asset,
+			coverage[i].Start,
+			cutoff)
+		if err != nil {
+			return err
+		}
+		m.logResult(p, coverage[i].Exchange, pair, a, &result)
+	}
+	return nil
+}
+
+// applyTradePolicy downsamples, when configured, and deletes stored trades
+// which are older than the retention period
+func (m *DataRetentionManager) applyTradePolicy(p *dataRetentionPolicy, now time.Time) error {
+	cutoff := p.cutoff(now)
+	coverage, err := m.tradeCoverage(p.exchange)
+	if err != nil {
+		return err
+	}
+	for i := range coverage {
+		if !coverage[i].Start.Before(cutoff) {
+			continue
+		}
+		var result dataRetentionResult
+		pair := currency.NewPair(currency.NewCode(coverage[i].Base), currency.NewCode(coverage[i].Quote))
+		a := asset.Item(coverage[i].AssetType)
+		if p.downsampleTo > 0 {
+			result.downsampled, err = m.downsampleTrades(p, coverage[i].Exchange, pair, a, coverage[i].Start, cutoff)
+			if err != nil {
+				return err
+			}
+		}
+		result.deleted, err = m.tradeDeleter(coverage[i].Exchange,
+			coverage[i].AssetType,
+			coverage[i].Base,
+			coverage[i].Quote,
+			coverage[i].Start,
+			cutoff)
+		if err != nil {
+			return err
+		}
+		m.logResult(p, coverage[i].Exchange, pair, a, &result)
+	}
+	return nil
+}
+
+// downsampleCandles aggregates stored candles between the start and exclusive
+// end into candles of the policy downsample interval. Downsampled candles
+// which are already stored are left untouched
+func (m *DataRetentionManager) downsampleCandles(p *dataRetentionPolicy, exchangeName string, pair currency.Pair, a asset.Item, start, end time.Time) (uint64, error) {
+	var saved uint64
+	batch := p.downsampleTo.Duration() * time.Duration(dataRetentionCandleBatchSize)
+	for batchStart := start.Truncate(p.downsampleTo.Duration()); batchStart.Before(end); batchStart = batchStart.Add(batch) {
+		batchEnd := batchStart.Add(batch)
+		if batchEnd.After(end) {
+			batchEnd = end
+		}
+		candles, err := m.candleLoader(exchangeName, pair, a, p.interval, batchStart, batchEnd.Add(-time.Nanosecond))
+		if err != nil {
+			if errors.Is(err, candle.ErrNoCandleDataFound) {
+				continue
+			}
+			return saved, err
+		}
+		downsampled, err := candles.Resample(p.downsampleTo, false)
+		if err != nil {
+			return saved, err
+		}
+		inserted, err := m.saveMissingCandles(downsampled, batchStart, batchEnd)
+		if err != nil {
+			return saved, err
+		}
+		saved += inserted
+	}
+	return saved, nil
+}
+
+// downsampleTrades converts stored trades between the start and exclusive end
+// into candles of the policy downsample interval. Candles which are already
+// stored are left untouched
+func (m *DataRetentionManager) downsampleTrades(p *dataRetentionPolicy, exchangeName string, pair currency.Pair, a asset.Item, start, end time.Time) (uint64, error) {
+	var saved uint64
+	batch := p.downsampleTo.Duration() * time.Duration(dataRetentionTradeBatchSize)
+	for batchStart := start.Truncate(p.downsampleTo.Duration()); batchStart.Before(end); batchStart = batchStart.Add(batch) {
+		batchEnd := batchStart.Add(batch)
+		if batchEnd.After(end) {
+			batchEnd = end
+		}
+		trades, err := m.tradeLoader(exchangeName,
+			a.String(),
+			pair.Base.Upper().String(),
+			pair.Quote.Upper().String(),
+			batchStart,
+			batchEnd.Add(-time.Nanosecond))
+		if err != nil {
+			return saved, err
+		}
+		if len(trades) == 0 {
+			continue
+		}
+		candles, err := trade.ConvertTradesToCandles(p.downsampleTo, trades...)
+		if err != nil {
+			return saved, err
+		}
+		candles.Exchange = exchangeName
+		candles.Pair = pair
+		candles.Asset = a
+		candles.SortCandlesByTimestamp(false)
+		inserted, err := m.saveMissingCandles(&candles, batchStart, batchEnd)
+		if err != nil {
+			return saved, err
+		}
+		saved += inserted
+	}
+	return saved, nil
+}
+
+// saveMissingCandles stores candles which are not already stored between the
+// start and exclusive end
+func (m *DataRetentionManager) saveMissingCandles(candles *kline.Item, start, end time.Time) (uint64, error) {
+	existing, err := m.candleLoader(candles.Exchange, candles.Pair, candles.Asset, candles.Interval, start, end.Add(-time.Nanosecond))
+	if err != nil && !errors.Is(err, candle.ErrNoCandleDataFound) {
+		return 0, err
+	}
+	stored := make(map[int64]struct{}, len(existing.Candles))
+	for i := range existing.Candles {
+		stored[existing.Candles[i].Time.Unix()] = struct{}{}
+	}
+	missing := candles.Candles[:0]
+	for i := range candles.Candles {
+		if _, ok := stored[candles.Candles[i].Time.Unix()]; !ok {
+			missing = append(missing, candles.Candles[i])
+		}
+	}
+	if len(missing) == 0 {
+		return 0, nil
+	}
+	candles.Candles = missing
+	return m.candleSaver(candles, false)
+}
+
+// logResult logs the outcome of applying a policy to a stored series when
+// verbose logging is enabled
+func (m *DataRetentionManager) logResult(p *dataRetentionPolicy, exchangeName string, pair currency.Pair, a asset.Item, result *dataRetentionResult) {
+	if !m.verbose {
+		return
+	}
+	log.Debugf(log.DataHistory, "data retention %s %s %s %s %s downsampled %d candles to %s and deleted %d",
+		p.dataType, exchangeName, a, pair, p.interval, result.downsampled, p.downsampleTo, result.deleted)
+}
+
+// cutoff returns the time before which data is no longer retained. When
+// downsampling the cutoff is aligned to the downsample interval so only
+// complete downsampled candles are generated
+func (p *dataRetentionPolicy) cutoff(now time.Time) time.Time {
+	cutoff := now.UTC().Add(-p.retainFor)
+	if p.downsampleTo > 0 {
+		cutoff = cutoff.Truncate(p.downsampleTo.Duration())
+	}
+	return cutoff
+}
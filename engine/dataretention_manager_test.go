@@ -0,0 +1,305 @@
+package engine
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
+	tradesql "github.com/thrasher-corp/gocryptotrader/database/repository/trade"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+var errDataRetentionTest = errors.New("data retention test error")
+
+// drmDeletion records the range a fake deleter was called with
+type drmDeletion struct {
+	start, end time.Time
+}
+
+// createDRM creates a data retention manager with a stored series starting at
+// the supplied time. One minute candles and trades exist every minute, one
+// hour candles exist only at the start
+func createDRM(t *testing.T, seriesStart time.Time) (*DataRetentionManager, *[]kline.Item, *[]drmDeletion) {
+	t.Helper()
+	var saved []kline.Item
+	var deleted []drmDeletion
+	return &DataRetentionManager{
+		started:       1,
+		shutdown:      make(chan struct{}),
+		checkInterval: time.Hour,
+		candleCoverage: func(string) ([]candle.Coverage, error) {
+			return []candle.Coverage{
+				{Exchange: testExchange, Base: "BTC", Quote: "USD", Asset: "spot", Interval: 60, Start: seriesStart},
+				{Exchange: testExchange, Base: "BTC", Quote: "USD", Asset: "spot", Interval: 3600, Start: seriesStart},
+			}, nil
+		},
+		tradeCoverage: func(string) ([]tradesql.Coverage, error) {
+			return []tradesql.Coverage{
+				{Exchange: testExchange, Base: "BTC", Quote: "USD", AssetType: "spot", Start: seriesStart},
+			}, nil
+		},
+		candleLoader: func(e string, p currency.Pair, a asset.Item, i kline.Interval, start, end time.Time) (kline.Item, error) {
+			resp := kline.Item{Exchange: e, Pair: p, Asset: a, Interval: i}
+			if i == kline.OneHour {
+				if !seriesStart.Before(start) && !seriesStart.After(end) {
+					resp.Candles = append(resp.Candles, kline.Candle{Time: seriesStart})
+				}
+				return resp, nil
+			}
+			if start.Before(seriesStart) {
+				start = seriesStart
+			}
+			for ts := start; !ts.After(end); ts = ts.Add(i.Duration()) {
+				resp.Candles = append(resp.Candles, kline.Candle{Time: ts, Open: 1, High: 2, Low: 1, Close: 1, Volume: 1})
+			}
+			if len(resp.Candles) == 0 {
+				return resp, candle.ErrNoCandleDataFound
+			}
+			return resp, nil
+		},
+		tradeLoader: func(e, a, _, _ string, start, end time.Time) ([]trade.Data, error) {
+			var resp []trade.Data
+			if start.Before(seriesStart) {
+				start = seriesStart
+			}
+			for ts := start; !ts.After(end); ts = ts.Add(time.Minute) {
+				resp = append(resp, trade.Data{
+					Exchange:     e,
+					CurrencyPair: currency.NewPair(currency.BTC, currency.USD),
+					AssetType:    asset.Item(a),
+					Price:        1,
+					Amount:       1,
+					Timestamp:    ts,
+				})
+			}
+			return resp, nil
+		},
+		candleSaver: func(k *kline.Item, _ bool) (uint64, error) {
+			saved = append(saved, *k)
+			return uint64(len(k.Candles)), nil
+		},
+		candleDeleter: func(_, _, _ string, _ int64, _ string, start, end time.Time) (int64, error) {
+			deleted = append(deleted, drmDeletion{start: start, end: end})
+			return 1, nil
+		},
+		tradeDeleter: func(_, _, _, _ string, start, end time.Time) (int64, error) {
+			deleted = append(deleted, drmDeletion{start: start, end: end})
+			return 1, nil
+		},
+	}, &saved, &deleted
+}
+
+func TestSetupDataRetentionManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupDataRetentionManager(nil, nil)
+	if !errors.Is(err, errNilDatabaseConnectionManager) {
+		t.Errorf("received '%v' expected '%v'", err, errNilDatabaseConnectionManager)
+	}
+	_, err = SetupDataRetentionManager(&DatabaseConnectionManager{}, nil)
+	if !errors.Is(err, errNilConfig) {
+		t.Errorf("received '%v' expected '%v'", err, errNilConfig)
+	}
+	_, err = SetupDataRetentionManager(&DatabaseConnectionManager{}, &config.DataRetentionManager{})
+	if !errors.Is(err, database.ErrNilInstance) {
+		t.Errorf("received '%v' expected '%v'", err, database.ErrNilInstance)
+	}
+
+	dbInst := &database.Instance{}
+	err = dbInst.SetConfig(&database.Config{Enabled: true})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = dbInst.SetSQLiteConnection(&sql.DB{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	dbCM := &DatabaseConnectionManager{dbConn: dbInst, started: 1}
+
+	invalid := []config.DataRetentionPolicy{
+		{DataType: "orderbooks", RetainFor: time.Hour},
+		{DataType: dataRetentionCandles, RetainFor: time.Hour},
+		{DataType: dataRetentionCandles, Interval: kline.OneMin},
+		{DataType: dataRetentionCandles, Interval: kline.OneHour, RetainFor: time.Hour, DownsampleTo: kline.OneMin},
+		{DataType: dataRetentionCandles, Interval: kline.FifteenMin, RetainFor: time.Hour, DownsampleTo: kline.Interval(time.Minute * 20)},
+	}
+	for i := range invalid {
+		_, err = SetupDataRetentionManager(dbCM, &config.DataRetentionManager{Policies: invalid[i : i+1]})
+		if !errors.Is(err, errInvalidDataRetentionPolicy) {
+			t.Errorf("policy %d received '%v' expected '%v'", i, err, errInvalidDataRetentionPolicy)
+		}
+	}
+
+	policy := config.DataRetentionPolicy{
+		DataType:     "Candles",
+		Interval:     kline.OneMin,
+		RetainFor:    time.Hour * 24 * 90,
+		DownsampleTo: kline.OneHour,
+	}
+	cfg := &config.DataRetentionManager{
+		Policies: []config.DataRetentionPolicy{policy, policy},
+	}
+	_, err = SetupDataRetentionManager(dbCM, cfg)
+	if !errors.Is(err, errDataRetentionPolicyAlreadyExists) {
+		t.Errorf("received '%v' expected '%v'", err, errDataRetentionPolicyAlreadyExists)
+	}
+
+	cfg.Policies = []config.DataRetentionPolicy{policy, {DataType: dataRetentionTrades, RetainFor: time.Hour}}
+	m, err := SetupDataRetentionManager(dbCM, cfg)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(m.policies) != 2 {
+		t.Errorf("received '%v' expected '%v'", len(m.policies), 2)
+	}
+	if m.checkInterval != defaultDataRetentionCheckInterval {
+		t.Errorf("received '%v' expected '%v'", m.checkInterval, defaultDataRetentionCheckInterval)
+	}
+}
+
+func TestDataRetentionManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *DataRetentionManager
+	if err := m.Start(); !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+	if err := m.Stop(); !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+	if m.IsRunning() {
+		t.Error("expected not running")
+	}
+
+	m = &DataRetentionManager{databaseConnectionInstance: &database.Instance{}, checkInterval: time.Hour}
+	if err := m.applyPolicies(time.Now()); !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemNotStarted)
+	}
+	if err := m.Start(); !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if err := m.Start(); !errors.Is(err, ErrSubSystemAlreadyStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemAlreadyStarted)
+	}
+	if !m.IsRunning() {
+		t.Error("expected running")
+	}
+	if err := m.Stop(); !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if err := m.Stop(); !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemNotStarted)
+	}
+}
+
+func TestDataRetentionCandlePolicy(t *testing.T) {
+	t.Parallel()
+	seriesStart := time.Date(2021, 1, 8, 22, 0, 0, 0, time.UTC)
+	m, saved, deleted := createDRM(t, seriesStart)
+	m.policies = []dataRetentionPolicy{{
+		dataType:     dataRetentionCandles,
+		interval:     kline.OneMin,
+		retainFor:    time.Hour * 24,
+		downsampleTo: kline.OneHour,
+	}}
+	now := time.Date(2021, 1, 10, 0, 30, 0, 0, time.UTC)
+	err := m.applyPolicies(now)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	// the 22:00 hourly candle is already stored so only 23:00 is downsampled
+	if len(*saved) != 1 || len((*saved)[0].Candles) != 1 {
+		t.Fatalf("unexpected saved candles %+v", *saved)
+	}
+	c := (*saved)[0].Candles[0]
+	if !c.Time.Equal(seriesStart.Add(time.Hour)) || c.Volume != 60 || c.High != 2 {
+		t.Errorf("unexpected downsampled candle %+v", c)
+	}
+	if (*saved)[0].Interval != kline.OneHour {
+		t.Errorf("received '%v' expected '%v'", (*saved)[0].Interval, kline.OneHour)
+	}
+
+	// only the one minute series is pruned, up until the aligned cutoff
+	cutoff := time.Date(2021, 1, 9, 0, 0, 0, 0, time.UTC)
+	if len(*deleted) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(*deleted), 1)
+	}
+	if !(*deleted)[0].start.Equal(seriesStart) || !(*deleted)[0].end.Equal(cutoff) {
+		t.Errorf("unexpected deletion range %+v", (*deleted)[0])
+	}
+
+	// data within the retention period is untouched
+	m, saved, deleted = createDRM(t, now.Add(-time.Hour))
+	m.policies = []dataRetentionPolicy{{
+		dataType:  dataRetentionCandles,
+		interval:  kline.OneMin,
+		retainFor: time.Hour * 24,
+	}}
+	err = m.applyPolicies(now)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(*saved) != 0 || len(*deleted) != 0 {
+		t.Errorf("received '%v' '%v' expected '%v'", len(*saved), len(*deleted), 0)
+	}
+}
+
+func TestDataRetentionTradePolicy(t *testing.T) {
+	t.Parallel()
+	seriesStart := time.Date(2021, 1, 8, 22, 0, 0, 0, time.UTC)
+	m, saved, deleted := createDRM(t, seriesStart)
+	m.policies = []dataRetentionPolicy{{
+		dataType:     dataRetentionTrades,
+		retainFor:    time.Hour * 24,
+		downsampleTo: kline.OneMin,
+	}}
+	m.candleLoader = func(string, currency.Pair, asset.Item, kline.Interval, time.Time, time.Time) (kline.Item, error) {
+		return kline.Item{}, candle.ErrNoCandleDataFound
+	}
+	now := time.Date(2021, 1, 9, 23, 0, 30, 0, time.UTC)
+	err := m.applyPolicies(now)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	cutoff := time.Date(2021, 1, 8, 23, 0, 0, 0, time.UTC)
+	var candles int
+	for i := range *saved {
+		candles += len((*saved)[i].Candles)
+		if (*saved)[i].Exchange != testExchange || (*saved)[i].Interval != kline.OneMin {
+			t.Errorf("unexpected saved item %+v", (*saved)[i])
+		}
+	}
+	if candles != 60 {
+		t.Errorf("received '%v' expected '%v'", candles, 60)
+	}
+	if len(*deleted) != 1 || !(*deleted)[0].end.Equal(cutoff) {
+		t.Errorf("unexpected deletions %+v", *deleted)
+	}
+
+	m.tradeDeleter = func(_, _, _, _ string, _, _ time.Time) (int64, error) {
+		return 0, errDataRetentionTest
+	}
+	if err = m.applyTradePolicy(&m.policies[0], now); !errors.Is(err, errDataRetentionTest) {
+		t.Errorf("received '%v' expected '%v'", err, errDataRetentionTest)
+	}
+}
+
+func TestDataRetentionPolicyCutoff(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2021, 1, 10, 0, 30, 0, 0, time.UTC)
+	p := dataRetentionPolicy{retainFor: time.Hour}
+	if c := p.cutoff(now); !c.Equal(now.Add(-time.Hour)) {
+		t.Errorf("received '%v' expected '%v'", c, now.Add(-time.Hour))
+	}
+	p.downsampleTo = kline.OneHour
+	expected := time.Date(2021, 1, 9, 23, 0, 0, 0, time.UTC)
+	if c := p.cutoff(now); !c.Equal(expected) {
+		t.Errorf("received '%v' expected '%v'", c, expected)
+	}
+}
@@ -0,0 +1,71 @@
+package engine
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
+	tradesql "github.com/thrasher-corp/gocryptotrader/database/repository/trade"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+const (
+	dataRetentionManagerName = "data_retention_manager"
+
+	dataRetentionCandles = "candles"
+	dataRetentionTrades  = "trades"
+)
+
+var (
+	errInvalidDataRetentionPolicy       = errors.New("invalid data retention policy")
+	errDataRetentionPolicyAlreadyExists = errors.New("data retention policy already exists")
+
+	defaultDataRetentionCheckInterval = time.Hour
+	// dataRetentionCandleBatchSize is the amount of downsampled candles
+	// generated from stored candles at a time
+	dataRetentionCandleBatchSize = 500
+	// dataRetentionTradeBatchSize is the amount of downsampled candles
+	// generated from stored trades at a time, it is kept small as trades are
+	// far denser than candles
+	dataRetentionTradeBatchSize = 24
+)
+
+// DataRetentionManager periodically deletes candle and trade data which has
+// exceeded its configured retention period, optionally downsampling it to
+// coarser candles first so long running deployments do not grow unbounded
+type DataRetentionManager struct {
+	databaseConnectionInstance database.IDatabase
+	started                    int32
+	processing                 int32
+	shutdown                   chan struct{}
+	checkInterval              time.Duration
+	verbose                    bool
+	policies                   []dataRetentionPolicy
+	candleCoverage             func(string) ([]candle.Coverage, error)
+	tradeCoverage              func(string) ([]tradesql.Coverage, error)
+	candleLoader               func(string, currency.Pair, asset.Item, kline.Interval, time.Time, time.Time) (kline.Item, error)
+	tradeLoader                func(string, string, string, string, time.Time, time.Time) ([]trade.Data, error)
+	candleSaver                func(*kline.Item, bool) (uint64, error)
+	candleDeleter              func(string, string, string, int64, string, time.Time, time.Time) (int64, error)
+	tradeDeleter               func(string, string, string, string, time.Time, time.Time) (int64, error)
+}
+
+// dataRetentionPolicy holds a validated retention policy
+type dataRetentionPolicy struct {
+	dataType     string
+	exchange     string
+	interval     kline.Interval
+	retainFor    time.Duration
+	downsampleTo kline.Interval
+}
+
+// dataRetentionResult holds the outcome of applying a policy to a stored
+// series
+type dataRetentionResult struct {
+	downsampled uint64
+	deleted     int64
+}
@@ -0,0 +1,396 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupDataSyncManager creates a data sync manager subsystem
+func SetupDataSyncManager(em iExchangeManager, dcm iDatabaseConnectionManager, cfg *config.DataSyncManager) (*DataSyncManager, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if dcm == nil {
+		return nil, errNilDatabaseConnectionManager
+	}
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	if cfg.CheckInterval <= 0 {
+		cfg.CheckInterval = defaultDataSyncCheckInterval
+	}
+	if cfg.MaxRequestsPerCycle <= 0 {
+		cfg.MaxRequestsPerCycle = defaultDataSyncMaxRequestsPerCycle
+	}
+	db := dcm.GetInstance()
+	if db == nil {
+		return nil, database.ErrNilInstance
+	}
+	m := &DataSyncManager{
+		exchangeManager:            em,
+		databaseConnectionInstance: db,
+		shutdown:                   make(chan struct{}),
+		checkInterval:              cfg.CheckInterval,
+		requestDelay:               cfg.RequestDelay,
+		maxRequestsPerCycle:        cfg.MaxRequestsPerCycle,
+		verbose:                    cfg.Verbose,
+		gapFinder:                  candle.FindGaps,
+		candleSaver:                kline.StoreInDatabase,
+		tradeSaver:                 trade.SaveTradesToDatabase,
+	}
+	for i := range cfg.Items {
+		if err := m.addItem(&cfg.Items[i]); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// addItem validates and adds a configured item to the manager
+func (m *DataSyncManager) addItem(cfg *config.DataSyncItem) error {
+	switch {
+	case cfg.Exchange == "":
+		return fmt.Errorf("%w exchange name unset", errInvalidDataSyncItem)
+	case cfg.Pair.IsEmpty():
+		return fmt.Errorf("%w %s currency pair unset", errInvalidDataSyncItem, cfg.Exchange)
+	case !cfg.Asset.IsValid():
+		return fmt.Errorf("%w %s %s asset %v", errInvalidDataSyncItem, cfg.Exchange, cfg.Pair, asset.ErrNotSupported)
+	case cfg.Interval <= 0:
+		return fmt.Errorf("%w %s %s %v", errInvalidDataSyncItem, cfg.Exchange, cfg.Pair, kline.ErrUnsetInterval)
+	case cfg.BackfillStart.After(time.Now()):
+		return fmt.Errorf("%w %s %s backfill start %v", errInvalidDataSyncItem, cfg.Exchange, cfg.Pair, common.ErrStartAfterTimeNow)
+	}
+	for i := range m.items {
+		if strings.EqualFold(m.items[i].exchange, cfg.Exchange) &&
+			m.items[i].asset == cfg.Asset &&
+			m.items[i].pair.Equal(cfg.Pair) &&
+			m.items[i].interval == cfg.Interval {
+			return fmt.Errorf("%w %s %s %s %s", errDataSyncItemAlreadyExists, cfg.Exchange, cfg.Asset, cfg.Pair, cfg.Interval)
+		}
+	}
+	m.items = append(m.items, &dataSyncItem{
+		exchange:      strings.ToLower(cfg.Exchange),
+		asset:         cfg.Asset,
+		pair:          cfg.Pair,
+		interval:      cfg.Interval,
+		syncTrades:    cfg.SyncTrades,
+		backfillStart: cfg.BackfillStart.UTC(),
+	})
+	return nil
+}
+
+// Start runs the subsystem
+func (m *DataSyncManager) Start() error {
+	if m == nil {
+		return ErrNilSubsystem
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return ErrSubSystemAlreadyStarted
+	}
+	m.shutdown = make(chan struct{})
+	go m.run()
+	log.Debugf(log.DataHistory, "Data sync manager %v", MsgSubSystemStarted)
+	return nil
+}
+
+// IsRunning checks whether the subsystem is running
+func (m *DataSyncManager) IsRunning() bool {
+	if m == nil {
+		return false
+	}
+	return atomic.LoadInt32(&m.started) == 1
+}
+
+// Stop stops the subsystem
+func (m *DataSyncManager) Stop() error {
+	if m == nil {
+		return ErrNilSubsystem
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 1, 0) {
+		return ErrSubSystemNotStarted
+	}
+	close(m.shutdown)
+	log.Debugf(log.DataHistory, "Data sync manager %v", MsgSubSystemShutdown)
+	return nil
+}
+
+// GetStatus returns the sync progress of all configured items
+func (m *DataSyncManager) GetStatus() ([]DataSyncStatus, error) {
+	if m == nil {
+		return nil, ErrNilSubsystem
+	}
+	m.m.RLock()
+	defer m.m.RUnlock()
+	resp := make([]DataSyncStatus, len(m.items))
+	for i := range m.items {
+		resp[i] = DataSyncStatus{
+			Exchange:           m.items[i].exchange,
+			Asset:              m.items[i].asset,
+			Pair:               m.items[i].pair,
+			Interval:           m.items[i].interval,
+			SyncTrades:         m.items[i].syncTrades,
+			BackfillStart:      m.items[i].backfillStart,
+			CandlesSyncedUntil: m.items[i].candlesSyncedUntil,
+			TradesSyncedUntil:  m.items[i].tradesSyncedUntil,
+			CandlesInserted:    m.items[i].candlesInserted,
+			TradesInserted:     m.items[i].tradesInserted,
+			LastSync:           m.items[i].lastSync,
+			NextAttempt:        m.items[i].nextAttempt,
+			Failures:           m.items[i].failures,
+		}
+		if m.items[i].lastError != nil {
+			resp[i].LastError = m.items[i].lastError.Error()
+		}
+	}
+	return resp, nil
+}
+
+func (m *DataSyncManager) run() {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-timer.C:
+			if m.databaseConnectionInstance.IsConnected() {
+				if err := m.syncItems(); err != nil {
+					log.Error(log.DataHistory, err)
+				}
+			}
+			timer.Reset(m.checkInterval)
+		}
+	}
+}
+
+// syncItems runs a sync cycle for every item which is not backing off from a
+// previous failure
+func (m *DataSyncManager) syncItems() error {
+	if !m.IsRunning() {
+		return ErrSubSystemNotStarted
+	}
+	if !atomic.CompareAndSwapInt32(&m.processing, 0, 1) {
+		return fmt.Errorf("cannot sync data, %w", errAlreadyRunning)
+	}
+	defer atomic.StoreInt32(&m.processing, 0)
+
+	for i := range m.items {
+		if !m.IsRunning() {
+			return nil
+		}
+		now := time.Now()
+		if now.Before(m.items[i].nextAttempt) {
+			continue
+		}
+		err := m.syncItem(m.items[i], now)
+		m.recordResult(m.items[i], err)
+		if err != nil {
+			log.Errorf(log.DataHistory, "data sync %s %s %s %s: %v",
+				m.items[i].exchange,
+				m.items[i].asset,
+				m.items[i].pair,
+				m.items[i].interval,
+				err)
+		}
+	}
+	return nil
+}
+
+// syncItem retrieves any missing candles, and trades when enabled, for the
+// item up until the last candle closed by the supplied time. Requests are
+// limited per cycle so large backfills are spread out and do not starve other
+// items
+func (m *DataSyncManager) syncItem(item *dataSyncItem, now time.Time) error {
+	exch, err := m.exchangeManager.GetExchangeByName(item.exchange)
+	if err != nil {
+		return err
+	}
+	end := now.UTC().Truncate(item.interval.Duration())
+	requests := int64(0)
+	err = m.syncCandles(item, exch, end, &requests)
+	if err != nil {
+		return err
+	}
+	if item.syncTrades {
+		return m.syncTrades(item, exch, end, &requests)
+	}
+	return nil
+}
+
+// syncCandles fills gaps in stored candles from the last synced candle, or
+// the backfill start when unsynced
+func (m *DataSyncManager) syncCandles(item *dataSyncItem, exch exchange.IBotExchange, end time.Time, requests *int64) error {
+	requestSize := defaultDataSyncRequestSizeLimit
+	if limit := exch.GetBase().Features.Enabled.Kline.ResultLimit; limit > 0 {
+		requestSize = int64(limit)
+	}
+	start := m.syncStart(item.candlesSyncedUntil, item, end, requestSize)
+	if !start.Before(end) {
+		return nil
+	}
+	gaps, err := m.gapFinder(item.exchange,
+		item.pair.Base.Upper().String(),
+		item.pair.Quote.Upper().String(),
+		int64(item.interval.Duration().Seconds()),
+		item.asset.String(),
+		start,
+		end)
+	if err != nil {
+		return err
+	}
+	for i := range gaps {
+		ranges, err := kline.CalculateCandleDateRanges(gaps[i].Start, gaps[i].End, item.interval, uint32(requestSize))
+		if err != nil {
+			return err
+		}
+		for j := range ranges.Ranges {
+			rangeStart := ranges.Ranges[j].Start.Time
+			rangeEnd := ranges.Ranges[j].End.Time
+			if rangeEnd.After(gaps[i].End) {
+				rangeEnd = gaps[i].End
+			}
+			if !rangeStart.Before(rangeEnd) {
+				continue
+			}
+			if !m.nextRequest(requests) {
+				return nil
+			}
+			candles, err := exch.GetHistoricCandlesExtended(request.WithConsumer(context.TODO(), request.DataHistory),
+				item.pair,
+				item.asset,
+				rangeStart,
+				rangeEnd,
+				item.interval)
+			if err != nil {
+				return fmt.Errorf("could not get candles %v-%v: %w", rangeStart, rangeEnd, err)
+			}
+			candles.Candles = candlesWithin(candles.Candles, rangeStart, rangeEnd)
+			var inserted uint64
+			if len(candles.Candles) > 0 {
+				candles.Exchange = item.exchange
+				inserted, err = m.candleSaver(&candles, false)
+				if err != nil {
+					return fmt.Errorf("could not save candles %v-%v: %w", rangeStart, rangeEnd, err)
+				}
+			}
+			m.m.Lock()
+			item.candlesSyncedUntil = rangeEnd
+			item.candlesInserted += inserted
+			m.m.Unlock()
+			if m.verbose {
+				log.Debugf(log.DataHistory, "data sync %s %s %s %s saved %d candles %v-%v",
+					item.exchange, item.asset, item.pair, item.interval, inserted, rangeStart, rangeEnd)
+			}
+		}
+	}
+	m.m.Lock()
+	item.candlesSyncedUntil = end
+	m.m.Unlock()
+	return nil
+}
+
+// syncTrades retrieves trades one interval at a time from the last synced
+// trade window, or the backfill start when unsynced
+func (m *DataSyncManager) syncTrades(item *dataSyncItem, exch exchange.IBotExchange, end time.Time, requests *int64) error {
+	start := m.syncStart(item.tradesSyncedUntil, item, end, 1)
+	for windowStart := start; windowStart.Before(end); windowStart = windowStart.Add(item.interval.Duration()) {
+		windowEnd := windowStart.Add(item.interval.Duration())
+		if !m.nextRequest(requests) {
+			return nil
+		}
+		trades, err := exch.GetHistoricTrades(request.WithConsumer(context.TODO(), request.DataHistory), item.pair, item.asset, windowStart, windowEnd)
+		if err != nil {
+			return fmt.Errorf("could not get trades %v-%v: %w", windowStart, windowEnd, err)
+		}
+		if len(trades) > 0 {
+			if err = m.tradeSaver(trades...); err != nil {
+				return fmt.Errorf("could not save trades %v-%v: %w", windowStart, windowEnd, err)
+			}
+		}
+		m.m.Lock()
+		item.tradesSyncedUntil = windowEnd
+		item.tradesInserted += uint64(len(trades))
+		m.m.Unlock()
+	}
+	return nil
+}
+
+// syncStart determines where syncing resumes from. Without any progress or a
+// backfill start only the most recent request worth of data is retrieved
+func (m *DataSyncManager) syncStart(syncedUntil time.Time, item *dataSyncItem, end time.Time, requestSize int64) time.Time {
+	switch {
+	case !syncedUntil.IsZero():
+		return syncedUntil
+	case !item.backfillStart.IsZero():
+		return item.backfillStart.Truncate(item.interval.Duration())
+	default:
+		return end.Add(-item.interval.Duration() * time.Duration(requestSize))
+	}
+}
+
+// nextRequest enforces the per cycle request limit and the configured delay
+// between requests so syncing stays within exchange rate limits. It returns
+// false when no more requests can be made this cycle
+func (m *DataSyncManager) nextRequest(requests *int64) bool {
+	if *requests >= m.maxRequestsPerCycle {
+		return false
+	}
+	if *requests > 0 && m.requestDelay > 0 {
+		select {
+		case <-m.shutdown:
+			return false
+		case <-time.After(m.requestDelay):
+		}
+	}
+	*requests++
+	return true
+}
+
+// recordResult updates the item sync status, failed items are backed off
+// exponentially to avoid hammering an exchange which is rejecting requests
+func (m *DataSyncManager) recordResult(item *dataSyncItem, err error) {
+	m.m.Lock()
+	defer m.m.Unlock()
+	item.lastSync = time.Now()
+	item.lastError = err
+	if err == nil {
+		item.failures = 0
+		item.nextAttempt = time.Time{}
+		return
+	}
+	item.failures++
+	backoff := m.checkInterval
+	for i := int64(1); i < item.failures && backoff < maxDataSyncBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxDataSyncBackoff {
+		backoff = maxDataSyncBackoff
+	}
+	item.nextAttempt = item.lastSync.Add(backoff)
+}
+
+// candlesWithin returns candles which fall within the start and exclusive end
+func candlesWithin(candles []kline.Candle, start, end time.Time) []kline.Candle {
+	resp := make([]kline.Candle, 0, len(candles))
+	for i := range candles {
+		if candles[i].Time.Before(start) || !candles[i].Time.Before(end) {
+			continue
+		}
+		resp = append(resp, candles[i])
+	}
+	return resp
+}
@@ -0,0 +1,328 @@
+package engine
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+var errDataSyncTest = errors.New("data sync test error")
+
+// dsmExchangeManager aka datasyncmanager fake exchange manager
+type dsmExchangeManager struct {
+	exch exchange.IBotExchange
+}
+
+func (f *dsmExchangeManager) GetExchanges() ([]exchange.IBotExchange, error) {
+	if f.exch == nil {
+		return nil, errDataSyncTest
+	}
+	return []exchange.IBotExchange{f.exch}, nil
+}
+
+func (f *dsmExchangeManager) GetExchangeByName(_ string) (exchange.IBotExchange, error) {
+	if f.exch == nil {
+		return nil, errDataSyncTest
+	}
+	return f.exch, nil
+}
+
+// dsmExchange aka datasyncmanager fake exchange returns a candle for every
+// interval and a single trade for every request
+type dsmExchange struct {
+	exchange.IBotExchange
+	base exchange.Base
+}
+
+func (f *dsmExchange) GetBase() *exchange.Base {
+	return &f.base
+}
+
+func (f *dsmExchange) GetHistoricCandlesExtended(_ context.Context, p currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+	resp := kline.Item{
+		Exchange: testExchange,
+		Pair:     p,
+		Asset:    a,
+		Interval: interval,
+	}
+	for t := start; t.Before(end); t = t.Add(interval.Duration()) {
+		resp.Candles = append(resp.Candles, kline.Candle{Time: t, Open: 1, High: 1, Low: 1, Close: 1, Volume: 1})
+	}
+	return resp, nil
+}
+
+func (f *dsmExchange) GetHistoricTrades(_ context.Context, p currency.Pair, a asset.Item, start, _ time.Time) ([]trade.Data, error) {
+	return []trade.Data{{
+		Exchange:     testExchange,
+		CurrencyPair: p,
+		AssetType:    a,
+		Side:         order.Buy,
+		Price:        1337,
+		Amount:       1,
+		Timestamp:    start,
+	}}, nil
+}
+
+func createDSM(t *testing.T, maxRequests int64) (*DataSyncManager, *dataSyncItem) {
+	t.Helper()
+	item := &dataSyncItem{
+		exchange:   testExchange,
+		asset:      asset.Spot,
+		pair:       currency.NewPair(currency.BTC, currency.USD),
+		interval:   kline.OneHour,
+		syncTrades: true,
+	}
+	return &DataSyncManager{
+		exchangeManager:     &dsmExchangeManager{exch: &dsmExchange{}},
+		shutdown:            make(chan struct{}),
+		checkInterval:       time.Minute,
+		maxRequestsPerCycle: maxRequests,
+		items:               []*dataSyncItem{item},
+		gapFinder: func(_, _, _ string, _ int64, _ string, start, end time.Time) ([]candle.Gap, error) {
+			return []candle.Gap{{Start: start, End: end}}, nil
+		},
+		candleSaver: func(k *kline.Item, _ bool) (uint64, error) {
+			return uint64(len(k.Candles)), nil
+		},
+		tradeSaver: func(...trade.Data) error {
+			return nil
+		},
+	}, item
+}
+
+func TestSetupDataSyncManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupDataSyncManager(nil, nil, nil)
+	if !errors.Is(err, errNilExchangeManager) {
+		t.Errorf("received '%v' expected '%v'", err, errNilExchangeManager)
+	}
+	_, err = SetupDataSyncManager(SetupExchangeManager(), nil, nil)
+	if !errors.Is(err, errNilDatabaseConnectionManager) {
+		t.Errorf("received '%v' expected '%v'", err, errNilDatabaseConnectionManager)
+	}
+	_, err = SetupDataSyncManager(SetupExchangeManager(), &DatabaseConnectionManager{}, nil)
+	if !errors.Is(err, errNilConfig) {
+		t.Errorf("received '%v' expected '%v'", err, errNilConfig)
+	}
+	_, err = SetupDataSyncManager(SetupExchangeManager(), &DatabaseConnectionManager{}, &config.DataSyncManager{})
+	if !errors.Is(err, database.ErrNilInstance) {
+		t.Errorf("received '%v' expected '%v'", err, database.ErrNilInstance)
+	}
+
+	dbInst := &database.Instance{}
+	err = dbInst.SetConfig(&database.Config{Enabled: true})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = dbInst.SetSQLiteConnection(&sql.DB{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	dbCM := &DatabaseConnectionManager{dbConn: dbInst, started: 1}
+
+	cfg := &config.DataSyncManager{
+		Items: []config.DataSyncItem{{Exchange: testExchange}},
+	}
+	_, err = SetupDataSyncManager(SetupExchangeManager(), dbCM, cfg)
+	if !errors.Is(err, errInvalidDataSyncItem) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidDataSyncItem)
+	}
+
+	item := config.DataSyncItem{
+		Exchange: testExchange,
+		Asset:    asset.Spot,
+		Pair:     currency.NewPair(currency.BTC, currency.USD),
+		Interval: kline.OneHour,
+	}
+	cfg.Items = []config.DataSyncItem{item, item}
+	_, err = SetupDataSyncManager(SetupExchangeManager(), dbCM, cfg)
+	if !errors.Is(err, errDataSyncItemAlreadyExists) {
+		t.Errorf("received '%v' expected '%v'", err, errDataSyncItemAlreadyExists)
+	}
+
+	cfg.Items = []config.DataSyncItem{item}
+	m, err := SetupDataSyncManager(SetupExchangeManager(), dbCM, cfg)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(m.items) != 1 {
+		t.Errorf("received '%v' expected '%v'", len(m.items), 1)
+	}
+	if m.checkInterval != defaultDataSyncCheckInterval {
+		t.Errorf("received '%v' expected '%v'", m.checkInterval, defaultDataSyncCheckInterval)
+	}
+}
+
+func TestDataSyncManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *DataSyncManager
+	if err := m.Start(); !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+	if err := m.Stop(); !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+	if _, err := m.GetStatus(); !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+
+	dbInst := &database.Instance{}
+	m, _ = createDSM(t, 1)
+	m.databaseConnectionInstance = dbInst
+	if err := m.Start(); !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if err := m.Start(); !errors.Is(err, ErrSubSystemAlreadyStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemAlreadyStarted)
+	}
+	if !m.IsRunning() {
+		t.Error("expected running")
+	}
+	if err := m.Stop(); !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if err := m.Stop(); !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemNotStarted)
+	}
+}
+
+func TestDataSyncSyncItem(t *testing.T) {
+	t.Parallel()
+	m, item := createDSM(t, 100)
+	now := time.Date(2021, 1, 2, 0, 30, 0, 0, time.UTC)
+	item.backfillStart = now.Add(-time.Hour * 10)
+	err := m.syncItem(item, now)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	end := now.Truncate(time.Hour)
+	if !item.candlesSyncedUntil.Equal(end) {
+		t.Errorf("received '%v' expected '%v'", item.candlesSyncedUntil, end)
+	}
+	if item.candlesInserted != 10 {
+		t.Errorf("received '%v' expected '%v'", item.candlesInserted, 10)
+	}
+	if !item.tradesSyncedUntil.Equal(end) {
+		t.Errorf("received '%v' expected '%v'", item.tradesSyncedUntil, end)
+	}
+	if item.tradesInserted != 10 {
+		t.Errorf("received '%v' expected '%v'", item.tradesInserted, 10)
+	}
+
+	// subsequent syncs only retrieve newly closed candles
+	err = m.syncItem(item, now.Add(time.Hour))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if item.candlesInserted != 11 || item.tradesInserted != 11 {
+		t.Errorf("unexpected inserted amounts %v %v", item.candlesInserted, item.tradesInserted)
+	}
+
+	m.gapFinder = func(string, string, string, int64, string, time.Time, time.Time) ([]candle.Gap, error) {
+		return nil, errDataSyncTest
+	}
+	err = m.syncItem(item, now.Add(time.Hour*2))
+	if !errors.Is(err, errDataSyncTest) {
+		t.Errorf("received '%v' expected '%v'", err, errDataSyncTest)
+	}
+
+	m.exchangeManager = &dsmExchangeManager{}
+	err = m.syncItem(item, now)
+	if !errors.Is(err, errDataSyncTest) {
+		t.Errorf("received '%v' expected '%v'", err, errDataSyncTest)
+	}
+}
+
+func TestDataSyncRequestLimit(t *testing.T) {
+	t.Parallel()
+	m, item := createDSM(t, 3)
+	m.exchangeManager = &dsmExchangeManager{exch: &dsmExchange{
+		base: exchange.Base{Features: exchange.Features{Enabled: exchange.FeaturesEnabled{
+			Kline: kline.ExchangeCapabilitiesEnabled{ResultLimit: 2},
+		}}},
+	}}
+	now := time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)
+	item.backfillStart = now.Add(-time.Hour * 10)
+	err := m.syncItem(item, now)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	expected := item.backfillStart.Add(time.Hour * 6)
+	if !item.candlesSyncedUntil.Equal(expected) {
+		t.Errorf("received '%v' expected '%v'", item.candlesSyncedUntil, expected)
+	}
+	if !item.tradesSyncedUntil.IsZero() {
+		t.Errorf("received '%v' expected no trade progress", item.tradesSyncedUntil)
+	}
+
+	err = m.syncItem(item, now)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !item.candlesSyncedUntil.Equal(now) {
+		t.Errorf("received '%v' expected '%v'", item.candlesSyncedUntil, now)
+	}
+	if item.candlesInserted != 10 {
+		t.Errorf("received '%v' expected '%v'", item.candlesInserted, 10)
+	}
+}
+
+func TestDataSyncRecordResult(t *testing.T) {
+	t.Parallel()
+	m, item := createDSM(t, 1)
+	m.recordResult(item, errDataSyncTest)
+	m.recordResult(item, errDataSyncTest)
+	if item.failures != 2 {
+		t.Errorf("received '%v' expected '%v'", item.failures, 2)
+	}
+	if backoff := item.nextAttempt.Sub(item.lastSync); backoff != m.checkInterval*2 {
+		t.Errorf("received '%v' expected '%v'", backoff, m.checkInterval*2)
+	}
+	status, err := m.GetStatus()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(status) != 1 || status[0].LastError != errDataSyncTest.Error() {
+		t.Errorf("unexpected status %+v", status)
+	}
+
+	for i := 0; i < 20; i++ {
+		m.recordResult(item, errDataSyncTest)
+	}
+	if backoff := item.nextAttempt.Sub(item.lastSync); backoff != maxDataSyncBackoff {
+		t.Errorf("received '%v' expected '%v'", backoff, maxDataSyncBackoff)
+	}
+
+	m.recordResult(item, nil)
+	if item.failures != 0 || !item.nextAttempt.IsZero() || item.lastError != nil {
+		t.Errorf("expected item status to be reset")
+	}
+}
+
+func TestDataSyncSyncItems(t *testing.T) {
+	t.Parallel()
+	m, item := createDSM(t, 1)
+	if err := m.syncItems(); !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemNotStarted)
+	}
+	m.started = 1
+	m.exchangeManager = &dsmExchangeManager{}
+	if err := m.syncItems(); !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if item.failures != 1 || !errors.Is(item.lastError, errDataSyncTest) {
+		t.Errorf("expected item failure to be recorded")
+	}
+}
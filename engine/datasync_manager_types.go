@@ -0,0 +1,84 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+const dataSyncManagerName = "data_sync_manager"
+
+var (
+	errInvalidDataSyncItem       = errors.New("invalid data sync item")
+	errDataSyncItemAlreadyExists = errors.New("data sync item already exists")
+
+	defaultDataSyncCheckInterval             = time.Minute
+	defaultDataSyncMaxRequestsPerCycle int64 = 10
+	defaultDataSyncRequestSizeLimit    int64 = 500
+	// maxDataSyncBackoff caps the delay applied to an item after repeated
+	// failures, such as when an exchange is rate limiting requests
+	maxDataSyncBackoff = time.Hour
+)
+
+// DataSyncManager keeps configured exchange, asset, pair and interval candle
+// and trade history continuously up to date in the database
+type DataSyncManager struct {
+	exchangeManager            iExchangeManager
+	databaseConnectionInstance database.IDatabase
+	started                    int32
+	processing                 int32
+	shutdown                   chan struct{}
+	checkInterval              time.Duration
+	requestDelay               time.Duration
+	maxRequestsPerCycle        int64
+	verbose                    bool
+	items                      []*dataSyncItem
+	m                          sync.RWMutex
+	gapFinder                  func(string, string, string, int64, string, time.Time, time.Time) ([]candle.Gap, error)
+	candleSaver                func(*kline.Item, bool) (uint64, error)
+	tradeSaver                 func(...trade.Data) error
+}
+
+// dataSyncItem holds the sync progress of a single configured item
+type dataSyncItem struct {
+	exchange      string
+	asset         asset.Item
+	pair          currency.Pair
+	interval      kline.Interval
+	syncTrades    bool
+	backfillStart time.Time
+
+	candlesSyncedUntil time.Time
+	tradesSyncedUntil  time.Time
+	candlesInserted    uint64
+	tradesInserted     uint64
+	lastSync           time.Time
+	nextAttempt        time.Time
+	failures           int64
+	lastError          error
+}
+
+// DataSyncStatus is a snapshot of the sync progress of a configured item
+type DataSyncStatus struct {
+	Exchange           string
+	Asset              asset.Item
+	Pair               currency.Pair
+	Interval           kline.Interval
+	SyncTrades         bool
+	BackfillStart      time.Time
+	CandlesSyncedUntil time.Time
+	TradesSyncedUntil  time.Time
+	CandlesInserted    uint64
+	TradesInserted     uint64
+	LastSync           time.Time
+	NextAttempt        time.Time
+	Failures           int64
+	LastError          string
+}
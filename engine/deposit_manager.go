@@ -0,0 +1,377 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupDepositManager creates a deposit manager which refreshes the deposit
+// address store and tracks inbound deposits. The webhook publisher is optional
+// and deposit confirmations are not posted to webhooks without it
+func SetupDepositManager(em iExchangeManager, dam *DepositAddressManager, comms iCommsManager, webhooks iWebhookPublisher, cfg *config.DepositManager) (*DepositManager, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if dam == nil {
+		return nil, errNilDepositAddressManager
+	}
+	if comms == nil {
+		return nil, errNilCommunicationsManager
+	}
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	if cfg.CheckInterval <= 0 || cfg.AddressRefreshInterval <= 0 {
+		return nil, errInvalidDepositInterval
+	}
+	return &DepositManager{
+		shutdown:               make(chan struct{}),
+		checkInterval:          cfg.CheckInterval,
+		addressRefreshInterval: cfg.AddressRefreshInterval,
+		currencies:             cfg.Currencies,
+		verbose:                cfg.Verbose,
+		exchangeManager:        em,
+		addressManager:         dam,
+		commsManager:           comms,
+		webhooks:               webhooks,
+		fundingFetcher:         fetchFundingHistory,
+		addressFetcher:         getExchangeDepositAddresses,
+		deposits:               make(map[string]*Deposit),
+		seeded:                 make(map[string]bool),
+	}, nil
+}
+
+// Start runs the subsystem
+func (d *DepositManager) Start() error {
+	if d == nil {
+		return ErrNilSubsystem
+	}
+	if !atomic.CompareAndSwapInt32(&d.started, 0, 1) {
+		return ErrSubSystemAlreadyStarted
+	}
+	d.shutdown = make(chan struct{})
+	go d.run()
+	log.Debugf(log.ExchangeSys, "Deposit manager %v", MsgSubSystemStarted)
+	return nil
+}
+
+// IsRunning checks whether the subsystem is running
+func (d *DepositManager) IsRunning() bool {
+	if d == nil {
+		return false
+	}
+	return atomic.LoadInt32(&d.started) == 1
+}
+
+// Stop stops the subsystem
+func (d *DepositManager) Stop() error {
+	if d == nil {
+		return ErrNilSubsystem
+	}
+	if !atomic.CompareAndSwapInt32(&d.started, 1, 0) {
+		return ErrSubSystemNotStarted
+	}
+	close(d.shutdown)
+	log.Debugf(log.ExchangeSys, "Deposit manager %v", MsgSubSystemShutdown)
+	return nil
+}
+
+func (d *DepositManager) run() {
+	addressTimer := time.NewTimer(0)
+	defer addressTimer.Stop()
+	checkTimer := time.NewTimer(0)
+	defer checkTimer.Stop()
+	for {
+		select {
+		case <-d.shutdown:
+			return
+		case <-addressTimer.C:
+			ctx, cancel := d.cycleContext()
+			d.refreshAddresses(ctx)
+			cancel()
+			addressTimer.Reset(d.addressRefreshInterval)
+		case <-checkTimer.C:
+			ctx, cancel := d.cycleContext()
+			d.checkDeposits(ctx)
+			cancel()
+			checkTimer.Reset(d.checkInterval)
+		}
+	}
+}
+
+// cycleContext returns a context which is cancelled when the subsystem is
+// stopped
+func (d *DepositManager) cycleContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		select {
+		case <-d.shutdown:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
+
+// getExchanges returns the enabled exchanges which support authenticated REST
+// requests
+func (d *DepositManager) getExchanges() []exchange.IBotExchange {
+	exchanges, err := d.exchangeManager.GetExchanges()
+	if err != nil {
+		log.Errorf(log.ExchangeSys, "Deposit manager unable to get exchanges: %v", err)
+		return nil
+	}
+	authenticated := exchanges[:0:0]
+	for i := range exchanges {
+		if !exchanges[i].IsEnabled() ||
+			!exchanges[i].GetAuthenticatedAPISupport(exchange.RestAuthentication) {
+			continue
+		}
+		authenticated = append(authenticated, exchanges[i])
+	}
+	return authenticated
+}
+
+// refreshAddresses fetches the deposit addresses of each authenticated
+// exchange and stores them in the deposit address manager
+func (d *DepositManager) refreshAddresses(ctx context.Context) {
+	exchanges := d.getExchanges()
+	result := make(map[string]map[string][]deposit.Address, len(exchanges))
+	for i := range exchanges {
+		currencies := d.currencies
+		if len(currencies) == 0 {
+			var err error
+			currencies, err = getEnabledCryptocurrencies(exchanges[i])
+			if err != nil {
+				log.Errorf(log.ExchangeSys, "Deposit manager unable to get %s cryptocurrencies: %v", exchanges[i].GetName(), err)
+				continue
+			}
+		}
+		if len(currencies) == 0 {
+			continue
+		}
+		result[exchanges[i].GetName()] = d.addressFetcher(ctx, exchanges[i], currencies)
+	}
+	if len(result) == 0 {
+		return
+	}
+	err := d.addressManager.Sync(result)
+	if err != nil {
+		log.Errorf(log.ExchangeSys, "Deposit manager unable to store deposit addresses: %v", err)
+		return
+	}
+	if d.verbose {
+		log.Debugf(log.ExchangeSys, "Deposit manager refreshed deposit addresses for %d exchanges", len(result))
+	}
+}
+
+// getEnabledCryptocurrencies returns the cryptocurrencies of an exchange's
+// enabled spot pairs
+func getEnabledCryptocurrencies(exch exchange.IBotExchange) ([]string, error) {
+	pairs, err := exch.GetEnabledPairs(asset.Spot)
+	if err != nil {
+		return nil, err
+	}
+	var currencies []string
+	for i := range pairs {
+		if pairs[i].Base.IsCryptocurrency() &&
+			!common.StringDataCompareInsensitive(currencies, pairs[i].Base.String()) {
+			currencies = append(currencies, pairs[i].Base.String())
+		}
+		if pairs[i].Quote.IsCryptocurrency() &&
+			!common.StringDataCompareInsensitive(currencies, pairs[i].Quote.String()) {
+			currencies = append(currencies, pairs[i].Quote.String())
+		}
+	}
+	return currencies, nil
+}
+
+// checkDeposits polls the funding history of each authenticated exchange for
+// new deposits and deposits which have changed status
+func (d *DepositManager) checkDeposits(ctx context.Context) {
+	exchanges := d.getExchanges()
+	for i := range exchanges {
+		history, err := d.fundingFetcher(ctx, exchanges[i])
+		if err != nil {
+			if d.verbose &&
+				!errors.Is(err, common.ErrFunctionNotSupported) &&
+				!errors.Is(err, common.ErrNotYetImplemented) {
+				log.Warnf(log.ExchangeSys, "Deposit manager unable to get %s funding history: %v", exchanges[i].GetName(), err)
+			}
+			continue
+		}
+		d.processFundingHistory(exchanges[i].GetName(), history)
+	}
+}
+
+// processFundingHistory updates the tracked deposits from an exchange's
+// funding history. The first funding history processed for an exchange is
+// recorded without sending events so existing deposits are not reported on
+// startup
+func (d *DepositManager) processFundingHistory(exchName string, history []exchange.FundHistory) {
+	addresses, _ := d.addressManager.GetDepositAddressesByExchange(exchName)
+	exchKey := strings.ToLower(exchName)
+	d.m.Lock()
+	defer d.m.Unlock()
+	seeded := d.seeded[exchKey]
+	d.seeded[exchKey] = true
+	for i := range history {
+		if !strings.Contains(strings.ToLower(history[i].TransferType), "deposit") {
+			continue
+		}
+		id := history[i].TransferID
+		if id == "" {
+			id = history[i].CryptoTxID
+		}
+		if id == "" {
+			id = history[i].Timestamp.String() + history[i].Currency
+		}
+		status := depositStatus(history[i].Status)
+		key := exchKey + id
+		dep, ok := d.deposits[key]
+		if !ok {
+			address := history[i].CryptoToAddress
+			if address == "" {
+				address = history[i].CryptoFromAddress
+			}
+			dep = &Deposit{
+				Exchange:      exchName,
+				TransferID:    history[i].TransferID,
+				Status:        status,
+				ExchangeState: history[i].Status,
+				Currency:      history[i].Currency,
+				Amount:        history[i].Amount,
+				Fee:           history[i].Fee,
+				CryptoAddress: address,
+				CryptoTxID:    history[i].CryptoTxID,
+				CryptoChain:   history[i].CryptoChain,
+				KnownAddress:  isKnownDepositAddress(addresses, history[i].CryptoToAddress, history[i].CryptoFromAddress),
+				Timestamp:     history[i].Timestamp,
+				DetectedAt:    time.Now(),
+			}
+			if status == DepositStatusConfirmed {
+				dep.ConfirmedAt = dep.DetectedAt
+			}
+			d.deposits[key] = dep
+			if !seeded {
+				continue
+			}
+			d.pushEvent(fmt.Sprintf("%s %s deposit of %v detected with status %s",
+				exchName, dep.Currency, dep.Amount, dep.ExchangeState))
+			if status == DepositStatusConfirmed {
+				d.confirm(dep)
+			}
+			continue
+		}
+		dep.ExchangeState = history[i].Status
+		if dep.Status == status {
+			continue
+		}
+		previous := dep.Status
+		dep.Status = status
+		if previous != DepositStatusPending {
+			continue
+		}
+		switch status {
+		case DepositStatusConfirmed:
+			dep.ConfirmedAt = time.Now()
+			d.confirm(dep)
+		case DepositStatusFailed:
+			d.pushEvent(fmt.Sprintf("%s %s deposit of %v failed with status %s",
+				exchName, dep.Currency, dep.Amount, dep.ExchangeState))
+		}
+	}
+}
+
+// confirm sends an event and webhook for a confirmed deposit
+func (d *DepositManager) confirm(dep *Deposit) {
+	d.pushEvent(fmt.Sprintf("%s %s deposit of %v confirmed",
+		dep.Exchange, dep.Currency, dep.Amount))
+	if d.webhooks == nil {
+		return
+	}
+	err := d.webhooks.Publish(WebhookEventDepositConfirmed, dep.Exchange, *dep)
+	if err != nil &&
+		!errors.Is(err, ErrNilSubsystem) &&
+		!errors.Is(err, ErrSubSystemNotStarted) {
+		log.Errorf(log.ExchangeSys, "Deposit manager unable to publish webhook: %v", err)
+	}
+}
+
+func (d *DepositManager) pushEvent(msg string) {
+	if d.verbose {
+		log.Infoln(log.ExchangeSys, "Deposit manager: "+msg)
+	}
+	d.commsManager.PushEvent(base.Event{
+		Type:    "deposit",
+		Message: msg,
+	})
+}
+
+// depositStatus maps an exchange's transfer status to a deposit status
+func depositStatus(status string) string {
+	status = strings.ToLower(status)
+	switch {
+	case common.StringDataCompare(webhookCompletedStatuses, status):
+		return DepositStatusConfirmed
+	case common.StringDataCompare(transferFailedStatuses, status):
+		return DepositStatusFailed
+	default:
+		return DepositStatusPending
+	}
+}
+
+// isKnownDepositAddress returns whether any of the supplied addresses are in
+// the exchange's stored deposit addresses
+func isKnownDepositAddress(stored map[string][]deposit.Address, addresses ...string) bool {
+	for _, addrs := range stored {
+		for i := range addrs {
+			if addrs[i].Address == "" {
+				continue
+			}
+			for j := range addresses {
+				if addrs[i].Address == addresses[j] {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// GetDeposits returns the tracked deposits ordered from newest to oldest,
+// optionally filtered by exchange
+func (d *DepositManager) GetDeposits(exchName string) ([]Deposit, error) {
+	if d == nil {
+		return nil, fmt.Errorf("deposit manager %w", ErrNilSubsystem)
+	}
+	if !d.IsRunning() {
+		return nil, fmt.Errorf("deposit manager %w", ErrSubSystemNotStarted)
+	}
+	d.m.RLock()
+	defer d.m.RUnlock()
+	deposits := make([]Deposit, 0, len(d.deposits))
+	for _, dep := range d.deposits {
+		if exchName != "" && !strings.EqualFold(dep.Exchange, exchName) {
+			continue
+		}
+		deposits = append(deposits, *dep)
+	}
+	sort.Slice(deposits, func(i, j int) bool {
+		return deposits[i].Timestamp.After(deposits[j].Timestamp)
+	})
+	return deposits, nil
+}
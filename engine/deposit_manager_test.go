@@ -0,0 +1,220 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
+)
+
+// dmComms records the events pushed by the deposit manager
+type dmComms struct {
+	m      sync.Mutex
+	events []base.Event
+}
+
+func (c *dmComms) PushEvent(evt base.Event) {
+	c.m.Lock()
+	c.events = append(c.events, evt)
+	c.m.Unlock()
+}
+
+// dmPublisher records the webhooks published by the deposit manager
+type dmPublisher struct {
+	events []string
+	data   []interface{}
+}
+
+func (p *dmPublisher) Publish(eventType, _ string, data interface{}) error {
+	p.events = append(p.events, eventType)
+	p.data = append(p.data, data)
+	return nil
+}
+
+var dmConfig = &config.DepositManager{
+	CheckInterval:          time.Minute,
+	AddressRefreshInterval: time.Hour,
+	Currencies:             []string{"BTC"},
+}
+
+func TestSetupDepositManager(t *testing.T) {
+	t.Parallel()
+	em := SetupExchangeManager()
+	dam := SetupDepositAddressManager()
+	comms := &dmComms{}
+	_, err := SetupDepositManager(nil, dam, comms, nil, dmConfig)
+	if !errors.Is(err, errNilExchangeManager) {
+		t.Errorf("received '%v' expected '%v'", err, errNilExchangeManager)
+	}
+	_, err = SetupDepositManager(em, nil, comms, nil, dmConfig)
+	if !errors.Is(err, errNilDepositAddressManager) {
+		t.Errorf("received '%v' expected '%v'", err, errNilDepositAddressManager)
+	}
+	_, err = SetupDepositManager(em, dam, nil, nil, dmConfig)
+	if !errors.Is(err, errNilCommunicationsManager) {
+		t.Errorf("received '%v' expected '%v'", err, errNilCommunicationsManager)
+	}
+	_, err = SetupDepositManager(em, dam, comms, nil, nil)
+	if !errors.Is(err, errNilConfig) {
+		t.Errorf("received '%v' expected '%v'", err, errNilConfig)
+	}
+	_, err = SetupDepositManager(em, dam, comms, nil, &config.DepositManager{CheckInterval: time.Minute})
+	if !errors.Is(err, errInvalidDepositInterval) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidDepositInterval)
+	}
+	d, err := SetupDepositManager(em, dam, comms, nil, dmConfig)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if d == nil {
+		t.Error("expected deposit manager")
+	}
+}
+
+func TestDepositManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var d *DepositManager
+	if err := d.Start(); !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+	if err := d.Stop(); !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+	if d.IsRunning() {
+		t.Error("expected not running")
+	}
+	if _, err := d.GetDeposits(""); !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+
+	d, err := SetupDepositManager(SetupExchangeManager(), SetupDepositAddressManager(), &dmComms{}, nil, dmConfig)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if _, err = d.GetDeposits(""); !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemNotStarted)
+	}
+	if err = d.Start(); !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if err = d.Start(); !errors.Is(err, ErrSubSystemAlreadyStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemAlreadyStarted)
+	}
+	if !d.IsRunning() {
+		t.Error("expected running")
+	}
+	if err = d.Stop(); !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if err = d.Stop(); !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemNotStarted)
+	}
+}
+
+func TestDepositManagerRefreshAddresses(t *testing.T) {
+	t.Parallel()
+	em := SetupExchangeManager()
+	em.Add(&whExchange{})
+	dam := SetupDepositAddressManager()
+	d, err := SetupDepositManager(em, dam, &dmComms{}, nil, dmConfig)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	var requested []string
+	d.addressFetcher = func(_ context.Context, _ exchange.IBotExchange, currencies []string) map[string][]deposit.Address {
+		requested = currencies
+		return map[string][]deposit.Address{"BTC": {{Address: "bc1address", Chain: "BTC"}}}
+	}
+	d.refreshAddresses(context.Background())
+	if len(requested) != 1 || requested[0] != "BTC" {
+		t.Errorf("unexpected requested currencies %v", requested)
+	}
+	addr, err := dam.GetDepositAddressesByExchange("webhookexchange")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(addr["BTC"]) != 1 || addr["BTC"][0].Address != "bc1address" {
+		t.Errorf("unexpected addresses %v", addr)
+	}
+}
+
+func TestDepositManagerCheckDeposits(t *testing.T) {
+	t.Parallel()
+	em := SetupExchangeManager()
+	em.Add(&whExchange{})
+	dam := SetupDepositAddressManager()
+	err := dam.Sync(map[string]map[string][]deposit.Address{
+		"webhookexchange": {"BTC": {{Address: "bc1address"}}},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	comms := &dmComms{}
+	webhooks := &dmPublisher{}
+	d, err := SetupDepositManager(em, dam, comms, webhooks, dmConfig)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	history := []exchange.FundHistory{
+		{TransferID: "d1", TransferType: "Deposit", Status: "Completed", Currency: "BTC", Amount: 1},
+		{TransferID: "w1", TransferType: "withdrawal", Status: "Pending", Currency: "BTC", Amount: 1},
+	}
+	d.fundingFetcher = func(context.Context, exchange.IBotExchange) ([]exchange.FundHistory, error) {
+		return history, nil
+	}
+
+	// existing deposits are recorded without events
+	d.checkDeposits(context.Background())
+	if len(comms.events) != 0 || len(webhooks.events) != 0 {
+		t.Fatalf("unexpected events %v %v", comms.events, webhooks.events)
+	}
+
+	history = append(history,
+		exchange.FundHistory{TransferID: "d2", TransferType: "deposit", Status: "Pending", Currency: "BTC", Amount: 2, CryptoToAddress: "bc1address"},
+		exchange.FundHistory{TransferID: "d3", TransferType: "deposit", Status: "Pending", Currency: "BTC", Amount: 3})
+	d.checkDeposits(context.Background())
+	if len(comms.events) != 2 || len(webhooks.events) != 0 {
+		t.Fatalf("unexpected events %v %v", comms.events, webhooks.events)
+	}
+
+	history[2].Status = "Success"
+	history[3].Status = "Rejected"
+	d.checkDeposits(context.Background())
+	if len(comms.events) != 4 {
+		t.Fatalf("unexpected events %v", comms.events)
+	}
+	if len(webhooks.events) != 1 || webhooks.events[0] != WebhookEventDepositConfirmed {
+		t.Fatalf("unexpected webhooks %v", webhooks.events)
+	}
+	dep, ok := webhooks.data[0].(Deposit)
+	if !ok || dep.TransferID != "d2" || !dep.KnownAddress || dep.ConfirmedAt.IsZero() {
+		t.Errorf("unexpected deposit %+v", webhooks.data[0])
+	}
+
+	d.started = 1
+	deposits, err := d.GetDeposits("webhookexchange")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(deposits) != 3 {
+		t.Fatalf("received '%v' expected '%v'", len(deposits), 3)
+	}
+	statuses := make(map[string]string)
+	for i := range deposits {
+		statuses[deposits[i].TransferID] = deposits[i].Status
+	}
+	if statuses["d1"] != DepositStatusConfirmed ||
+		statuses["d2"] != DepositStatusConfirmed ||
+		statuses["d3"] != DepositStatusFailed {
+		t.Errorf("unexpected statuses %v", statuses)
+	}
+	if deposits, _ = d.GetDeposits("bitstamp"); len(deposits) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(deposits), 0)
+	}
+}
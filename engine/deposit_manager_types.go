@@ -0,0 +1,82 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
+)
+
+const depositManagerName = "deposit_manager"
+
+// Deposit statuses tracked by the deposit manager
+const (
+	DepositStatusPending   = "pending"
+	DepositStatusConfirmed = "confirmed"
+	DepositStatusFailed    = "failed"
+)
+
+var (
+	errNilDepositAddressManager = errors.New("cannot start with nil deposit address manager")
+	errInvalidDepositInterval   = errors.New("deposit manager intervals must be greater than zero")
+
+	// transferFailedStatuses are the lower case transfer statuses which
+	// exchanges use to indicate a deposit will not be credited or a
+	// withdrawal will not be sent
+	transferFailedStatuses = []string{
+		"failed",
+		"failure",
+		"rejected",
+		"cancelled",
+		"canceled",
+		"expired",
+	}
+)
+
+// iWebhookPublisher limits exposure of accessible functions to the webhook
+// manager so that subsystems can send their own events
+type iWebhookPublisher interface {
+	Publish(eventType, exchangeName string, data interface{}) error
+}
+
+// DepositManager keeps the deposit address store populated for each enabled
+// exchange and watches authenticated exchanges' funding history for inbound
+// deposits, pushing an event when a deposit is detected and when it confirms
+type DepositManager struct {
+	started                int32
+	shutdown               chan struct{}
+	checkInterval          time.Duration
+	addressRefreshInterval time.Duration
+	currencies             []string
+	verbose                bool
+	exchangeManager        iExchangeManager
+	addressManager         *DepositAddressManager
+	commsManager           iCommsManager
+	webhooks               iWebhookPublisher
+	fundingFetcher         func(context.Context, exchange.IBotExchange) ([]exchange.FundHistory, error)
+	addressFetcher         func(context.Context, exchange.IBotExchange, []string) map[string][]deposit.Address
+	deposits               map[string]*Deposit
+	seeded                 map[string]bool
+	m                      sync.RWMutex
+}
+
+// Deposit holds the details of an inbound deposit and its confirmation status
+type Deposit struct {
+	Exchange      string    `json:"exchange"`
+	TransferID    string    `json:"transferID"`
+	Status        string    `json:"status"`
+	ExchangeState string    `json:"exchangeState"`
+	Currency      string    `json:"currency"`
+	Amount        float64   `json:"amount"`
+	Fee           float64   `json:"fee"`
+	CryptoAddress string    `json:"cryptoAddress,omitempty"`
+	CryptoTxID    string    `json:"cryptoTxID,omitempty"`
+	CryptoChain   string    `json:"cryptoChain,omitempty"`
+	KnownAddress  bool      `json:"knownAddress"`
+	Timestamp     time.Time `json:"timestamp"`
+	DetectedAt    time.Time `json:"detectedAt"`
+	ConfirmedAt   time.Time `json:"confirmedAt,omitempty"`
+}
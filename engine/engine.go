@@ -46,6 +46,18 @@ type Engine struct {
 	websocketRoutineManager *websocketRoutineManager
 	WithdrawManager         *WithdrawManager
 	dataHistoryManager      *DataHistoryManager
+	dataSyncManager         *DataSyncManager
+	orderbookRecorder       *OrderbookRecorder
+	portfolioAnalytics      *PortfolioAnalyticsManager
+	positionsManager        *PositionsManager
+	OrderRouter             *OrderRouter
+	OrderbookAggregator     *OrderbookAggregator
+	webhookManager          *WebhookManager
+	depositManager          *DepositManager
+	arbitrageScanner        *ArbitrageScanner
+	triangularArbitrage     *TriangularArbitrageDetector
+	dataRetentionManager    *DataRetentionManager
+	rateLimitBudgetManager  *RateLimitBudgetManager
 	currencyStateManager    *CurrencyStateManager
 	Settings                Settings
 	uptime                  time.Time
@@ -146,6 +158,14 @@ func validateSettings(b *Engine, s *Settings, flagSet map[string]bool) {
 
 	b.Settings.EnableDataHistoryManager = (flagSet["datahistorymanager"] && b.Settings.EnableDatabaseManager) || b.Config.DataHistoryManager.Enabled
 
+	b.Settings.EnableDataSyncManager = (flagSet["datasyncmanager"] && b.Settings.EnableDatabaseManager) || b.Config.DataSyncManager.Enabled
+
+	b.Settings.EnableOrderbookRecorder = (flagSet["orderbookrecorder"] && b.Settings.EnableDatabaseManager) || b.Config.OrderbookRecorder.Enabled
+
+	b.Settings.EnableDataRetentionManager = (flagSet["dataretentionmanager"] && b.Settings.EnableDatabaseManager) || b.Config.DataRetentionManager.Enabled
+
+	b.Settings.EnableRateLimitBudgetManager = (flagSet["ratelimitbudgetmanager"] && b.Settings.EnableRateLimitBudgetManager) || b.Config.RateLimitBudget.Enabled
+
 	b.Settings.EnableCurrencyStateManager = (flagSet["currencystatemanager"] &&
 		b.Settings.EnableCurrencyStateManager) ||
 		b.Config.CurrencyStateManager.Enabled != nil &&
@@ -159,6 +179,39 @@ func validateSettings(b *Engine, s *Settings, flagSet map[string]bool) {
 		b.Settings.PortfolioManagerDelay = PortfolioSleepDelay
 	}
 
+	b.Settings.EnablePortfolioAnalytics = b.Settings.EnablePortfolioAnalytics && b.Settings.EnableOrderManager
+
+	if b.Settings.EnablePortfolioAnalytics &&
+		b.Settings.PortfolioAnalyticsDelay <= 0 {
+		b.Settings.PortfolioAnalyticsDelay = PortfolioAnalyticsSleepDelay
+	}
+
+	b.Settings.EnablePositionsManager = b.Settings.EnablePositionsManager && b.Settings.EnableOrderManager
+
+	if b.Settings.EnablePositionsManager {
+		if b.Settings.PositionsManagerDelay <= 0 {
+			b.Settings.PositionsManagerDelay = PositionsManagerSleepDelay
+		}
+		if b.Settings.MarginRatioAlert <= 0 {
+			b.Settings.MarginRatioAlert = DefaultMarginRatioAlert
+		}
+	}
+
+	b.Settings.EnableOrderRouter = b.Settings.EnableOrderRouter && b.Settings.EnableOrderManager
+
+	b.Settings.EnablePaperTrading = (flagSet["papertrading"] && b.Settings.EnablePaperTrading) || b.Config.PaperTrading.Enabled
+
+	b.Settings.EnableOrderPersistence = ((flagSet["orderpersistence"] && b.Settings.EnableOrderPersistence) || b.Config.OrderPersistence.Enabled) &&
+		b.Settings.EnableOrderManager && b.Settings.EnableDatabaseManager
+
+	b.Settings.EnableWebhookManager = (flagSet["webhookmanager"] && b.Settings.EnableWebhookManager) || b.Config.WebhookManager.Enabled
+
+	b.Settings.EnableDepositManager = (flagSet["depositmanager"] && b.Settings.EnableDepositManager) || b.Config.DepositManager.Enabled
+
+	b.Settings.EnableArbitrageScanner = (flagSet["arbitragescanner"] && b.Settings.EnableArbitrageScanner) || b.Config.ArbitrageScanner.Enabled
+
+	b.Settings.EnableTriangularArbitrage = (flagSet["triangulararbitrage"] && b.Settings.EnableTriangularArbitrage) || b.Config.TriangularArbitrage.Enabled
+
 	if !flagSet["grpc"] {
 		b.Settings.EnableGRPC = b.Config.RemoteControl.GRPC.Enabled
 	}
@@ -247,6 +300,10 @@ func PrintSettings(s *Settings) {
 	gctlog.Debugf(gctlog.Global, "\t Enable coinmarketcap analaysis: %v", s.EnableCoinmarketcapAnalysis)
 	gctlog.Debugf(gctlog.Global, "\t Enable portfolio manager: %v", s.EnablePortfolioManager)
 	gctlog.Debugf(gctlog.Global, "\t Enable data history manager: %v", s.EnableDataHistoryManager)
+	gctlog.Debugf(gctlog.Global, "\t Enable data sync manager: %v", s.EnableDataSyncManager)
+	gctlog.Debugf(gctlog.Global, "\t Enable orderbook recorder: %v", s.EnableOrderbookRecorder)
+	gctlog.Debugf(gctlog.Global, "\t Enable data retention manager: %v", s.EnableDataRetentionManager)
+	gctlog.Debugf(gctlog.Global, "\t Enable rate limit budget manager: %v", s.EnableRateLimitBudgetManager)
 	gctlog.Debugf(gctlog.Global, "\t Enable currency state manager: %v", s.EnableCurrencyStateManager)
 	gctlog.Debugf(gctlog.Global, "\t Portfolio manager sleep delay: %v\n", s.PortfolioManagerDelay)
 	gctlog.Debugf(gctlog.Global, "\t Enable gPRC: %v", s.EnableGRPC)
@@ -257,6 +314,18 @@ func PrintSettings(s *Settings) {
 	gctlog.Debugf(gctlog.Global, "\t Enable event manager: %v", s.EnableEventManager)
 	gctlog.Debugf(gctlog.Global, "\t Event manager sleep delay: %v", s.EventManagerDelay)
 	gctlog.Debugf(gctlog.Global, "\t Enable order manager: %v", s.EnableOrderManager)
+	gctlog.Debugf(gctlog.Global, "\t Enable paper trading: %v", s.EnablePaperTrading)
+	gctlog.Debugf(gctlog.Global, "\t Enable order persistence: %v", s.EnableOrderPersistence)
+	gctlog.Debugf(gctlog.Global, "\t Enable portfolio analytics: %v", s.EnablePortfolioAnalytics)
+	gctlog.Debugf(gctlog.Global, "\t Portfolio analytics sleep delay: %v", s.PortfolioAnalyticsDelay)
+	gctlog.Debugf(gctlog.Global, "\t Enable positions manager: %v", s.EnablePositionsManager)
+	gctlog.Debugf(gctlog.Global, "\t Positions manager sleep delay: %v", s.PositionsManagerDelay)
+	gctlog.Debugf(gctlog.Global, "\t Margin ratio alert: %v", s.MarginRatioAlert)
+	gctlog.Debugf(gctlog.Global, "\t Enable order router: %v", s.EnableOrderRouter)
+	gctlog.Debugf(gctlog.Global, "\t Enable webhook manager: %v", s.EnableWebhookManager)
+	gctlog.Debugf(gctlog.Global, "\t Enable deposit manager: %v", s.EnableDepositManager)
+	gctlog.Debugf(gctlog.Global, "\t Enable arbitrage scanner: %v", s.EnableArbitrageScanner)
+	gctlog.Debugf(gctlog.Global, "\t Enable triangular arbitrage detector: %v", s.EnableTriangularArbitrage)
 	gctlog.Debugf(gctlog.Global, "\t Enable exchange sync manager: %v", s.EnableExchangeSyncManager)
 	gctlog.Debugf(gctlog.Global, "\t Enable deposit address manager: %v\n", s.EnableDepositAddressManager)
 	gctlog.Debugf(gctlog.Global, "\t Enable websocket routine: %v\n", s.EnableWebsocketRoutine)
@@ -384,12 +453,29 @@ func (bot *Engine) Start() error {
 		bot.Config.PurgeExchangeAPICredentials()
 	}
 
+	if bot.Settings.EnableRateLimitBudgetManager {
+		bot.rateLimitBudgetManager, err = SetupRateLimitBudgetManager(&bot.Config.RateLimitBudget)
+		if err != nil {
+			gctlog.Errorf(gctlog.Global, "rate limit budget manager unable to setup: %s", err)
+		} else {
+			err = bot.rateLimitBudgetManager.Start()
+			if err != nil {
+				gctlog.Errorf(gctlog.Global, "rate limit budget manager unable to start: %s", err)
+			}
+		}
+	}
+
 	gctlog.Debugln(gctlog.Global, "Setting up exchanges..")
 	err = bot.SetupExchanges()
 	if err != nil {
 		return err
 	}
 
+	bot.OrderbookAggregator, err = SetupOrderbookAggregator(bot.ExchangeManager, bot.Settings.Verbose)
+	if err != nil {
+		gctlog.Errorf(gctlog.Global, "Orderbook aggregator unable to setup: %s", err)
+	}
+
 	if bot.Settings.EnableCommsRelayer {
 		bot.CommunicationsManager, err = SetupCommunicationManager(&bot.Config.Communications)
 		if err != nil {
@@ -422,6 +508,7 @@ func (bot *Engine) Start() error {
 				FiatDisplayCurrency:    bot.Config.Currency.FiatDisplayCurrency,
 				CurrencyDelay:          bot.Config.Currency.CurrencyFileUpdateDuration,
 				FxRateDelay:            bot.Config.Currency.ForeignExchangeUpdateDuration,
+				FxRateCacheTTL:         bot.Config.Currency.ForeignExchangeCacheDuration,
 			},
 			bot.Settings.DataDir)
 		if err != nil {
@@ -461,11 +548,81 @@ func (bot *Engine) Start() error {
 		}
 	}
 
+	if bot.Settings.EnableDataSyncManager {
+		if bot.dataSyncManager == nil {
+			bot.dataSyncManager, err = SetupDataSyncManager(bot.ExchangeManager, bot.DatabaseManager, &bot.Config.DataSyncManager)
+			if err != nil {
+				gctlog.Errorf(gctlog.Global, "data sync manager unable to setup: %s", err)
+			} else {
+				err = bot.dataSyncManager.Start()
+				if err != nil {
+					gctlog.Errorf(gctlog.Global, "data sync manager unable to start: %s", err)
+				}
+			}
+		}
+	}
+
+	if bot.Settings.EnableOrderbookRecorder {
+		if bot.orderbookRecorder == nil {
+			bot.orderbookRecorder, err = SetupOrderbookRecorder(bot.ExchangeManager, bot.DatabaseManager, &bot.Config.OrderbookRecorder)
+			if err != nil {
+				gctlog.Errorf(gctlog.Global, "orderbook recorder unable to setup: %s", err)
+			} else {
+				err = bot.orderbookRecorder.Start()
+				if err != nil {
+					gctlog.Errorf(gctlog.Global, "orderbook recorder unable to start: %s", err)
+				}
+			}
+		}
+	}
+
+	if bot.Settings.EnableDataRetentionManager {
+		if bot.dataRetentionManager == nil {
+			bot.dataRetentionManager, err = SetupDataRetentionManager(bot.DatabaseManager, &bot.Config.DataRetentionManager)
+			if err != nil {
+				gctlog.Errorf(gctlog.Global, "data retention manager unable to setup: %s", err)
+			} else {
+				err = bot.dataRetentionManager.Start()
+				if err != nil {
+					gctlog.Errorf(gctlog.Global, "data retention manager unable to start: %s", err)
+				}
+			}
+		}
+	}
+
 	bot.WithdrawManager, err = SetupWithdrawManager(bot.ExchangeManager, bot.portfolioManager, bot.Settings.EnableDryRun)
 	if err != nil {
 		return err
 	}
 
+	addressBookPath := bot.Config.WithdrawManager.AddressBookPath
+	if addressBookPath == "" {
+		addressBookPath = filepath.Join(bot.Settings.DataDir, "addressbook.json")
+	}
+	err = bot.WithdrawManager.EnableAddressBook(addressBookPath, bot.Config.WithdrawManager.EnforceAddressBook)
+	if err != nil {
+		return err
+	}
+	if bot.Config.WithdrawManager.RequireApproval {
+		err = bot.WithdrawManager.EnableApprovals(bot.Config.WithdrawManager.ApprovalOTPSecret,
+			bot.Config.WithdrawManager.ApprovalTimeout)
+		if err != nil {
+			return err
+		}
+	}
+	if bot.Config.WithdrawManager.StatusCheckInterval > 0 {
+		err = bot.WithdrawManager.EnableStatusTracking(bot.CommunicationsManager,
+			bot.Config.WithdrawManager.StatusCheckInterval)
+		if err != nil {
+			gctlog.Errorf(gctlog.Global, "Withdraw manager unable to setup status tracking: %s", err)
+		} else {
+			err = bot.WithdrawManager.Start()
+			if err != nil {
+				gctlog.Errorf(gctlog.Global, "Withdraw manager unable to start status tracking: %s", err)
+			}
+		}
+	}
+
 	if bot.Settings.EnableDeprecatedRPC || bot.Settings.EnableWebsocketRPC {
 		var filePath string
 		filePath, err = config.GetAndMigrateDefaultPath(bot.Settings.ConfigFile)
@@ -510,9 +667,138 @@ func (bot *Engine) Start() error {
 		if err != nil {
 			gctlog.Errorf(gctlog.Global, "Order manager unable to setup: %s", err)
 		} else {
-			err = bot.OrderManager.Start()
+			bot.OrderManager.SetDepthRejection(bot.Config.OrderManager.RejectInsufficientDepth)
+			if bot.Settings.EnablePaperTrading {
+				err = bot.OrderManager.EnablePaperTrading(bot.Config.PaperTrading.Balances)
+			}
+			if err != nil {
+				// Never fall back to submitting real orders when paper
+				// trading was requested
+				gctlog.Errorf(gctlog.Global, "Order manager unable to enable paper trading and will not be started: %s", err)
+			} else {
+				if bot.Settings.EnableOrderPersistence {
+					err = bot.OrderManager.EnableOrderPersistence(bot.DatabaseManager)
+					if err != nil {
+						gctlog.Errorf(gctlog.Global, "Order manager unable to enable order persistence: %s", err)
+					}
+				}
+				err = bot.OrderManager.Start()
+				if err != nil {
+					gctlog.Errorf(gctlog.Global, "Order manager unable to start: %s", err)
+				}
+			}
+		}
+	}
+
+	if bot.Settings.EnablePortfolioAnalytics {
+		bot.portfolioAnalytics, err = SetupPortfolioAnalyticsManager(
+			bot.OrderManager,
+			bot.Settings.PortfolioAnalyticsDelay,
+			bot.Settings.Verbose)
+		if err != nil {
+			gctlog.Errorf(gctlog.Global, "Portfolio analytics manager unable to setup: %s", err)
+		} else {
+			err = bot.portfolioAnalytics.Start()
+			if err != nil {
+				gctlog.Errorf(gctlog.Global, "Portfolio analytics manager unable to start: %s", err)
+			}
+		}
+	}
+
+	if bot.Settings.EnableOrderRouter {
+		bot.OrderRouter, err = SetupOrderRouter(
+			bot.ExchangeManager,
+			bot.OrderManager,
+			bot.Settings.Verbose)
+		if err != nil {
+			gctlog.Errorf(gctlog.Global, "Order router unable to setup: %s", err)
+		} else {
+			err = bot.OrderRouter.Start()
+			if err != nil {
+				gctlog.Errorf(gctlog.Global, "Order router unable to start: %s", err)
+			}
+		}
+	}
+
+	if bot.Settings.EnableWebhookManager {
+		bot.webhookManager, err = SetupWebhookManager(
+			bot.ExchangeManager,
+			bot.OrderManager,
+			&bot.Config.WebhookManager)
+		if err != nil {
+			gctlog.Errorf(gctlog.Global, "Webhook manager unable to setup: %s", err)
+		} else {
+			err = bot.webhookManager.Start()
+			if err != nil {
+				gctlog.Errorf(gctlog.Global, "Webhook manager unable to start: %s", err)
+			}
+		}
+	}
+
+	if bot.Settings.EnableDepositManager {
+		if bot.DepositAddressManager == nil {
+			bot.DepositAddressManager = SetupDepositAddressManager()
+		}
+		bot.depositManager, err = SetupDepositManager(
+			bot.ExchangeManager,
+			bot.DepositAddressManager,
+			bot.CommunicationsManager,
+			bot.webhookManager,
+			&bot.Config.DepositManager)
+		if err != nil {
+			gctlog.Errorf(gctlog.Global, "Deposit manager unable to setup: %s", err)
+		} else {
+			err = bot.depositManager.Start()
+			if err != nil {
+				gctlog.Errorf(gctlog.Global, "Deposit manager unable to start: %s", err)
+			}
+		}
+	}
+
+	if bot.Settings.EnableArbitrageScanner {
+		bot.arbitrageScanner, err = SetupArbitrageScanner(
+			bot.ExchangeManager,
+			bot.CommunicationsManager,
+			bot.webhookManager,
+			&bot.Config.ArbitrageScanner)
+		if err != nil {
+			gctlog.Errorf(gctlog.Global, "Arbitrage scanner unable to setup: %s", err)
+		} else {
+			err = bot.arbitrageScanner.Start()
 			if err != nil {
-				gctlog.Errorf(gctlog.Global, "Order manager unable to start: %s", err)
+				gctlog.Errorf(gctlog.Global, "Arbitrage scanner unable to start: %s", err)
+			}
+		}
+	}
+
+	if bot.Settings.EnablePositionsManager {
+		bot.positionsManager, err = SetupPositionsManager(
+			bot.ExchangeManager,
+			bot.OrderManager,
+			bot.webhookManager,
+			bot.Settings.PositionsManagerDelay,
+			bot.Settings.MarginRatioAlert,
+			bot.Settings.Verbose)
+		if err != nil {
+			gctlog.Errorf(gctlog.Global, "Positions manager unable to setup: %s", err)
+		} else {
+			err = bot.positionsManager.Start()
+			if err != nil {
+				gctlog.Errorf(gctlog.Global, "Positions manager unable to start: %s", err)
+			}
+		}
+	}
+
+	if bot.Settings.EnableTriangularArbitrage {
+		bot.triangularArbitrage, err = SetupTriangularArbitrageDetector(
+			bot.ExchangeManager,
+			&bot.Config.TriangularArbitrage)
+		if err != nil {
+			gctlog.Errorf(gctlog.Global, "Triangular arbitrage detector unable to setup: %s", err)
+		} else {
+			err = bot.triangularArbitrage.Start()
+			if err != nil {
+				gctlog.Errorf(gctlog.Global, "Triangular arbitrage detector unable to start: %s", err)
 			}
 		}
 	}
@@ -527,6 +813,7 @@ func (bot *Engine) Start() error {
 			Verbose:              bot.Settings.Verbose,
 			SyncTimeoutREST:      bot.Settings.SyncTimeoutREST,
 			SyncTimeoutWebsocket: bot.Settings.SyncTimeoutWebsocket,
+			PairSettings:         bot.Config.CurrencyPairSyncer.Pairs,
 		}
 
 		bot.currencyPairSyncer, err = setupSyncManager(
@@ -618,6 +905,46 @@ func (bot *Engine) Stop() {
 			gctlog.Errorf(gctlog.Global, "GCTScript manager unable to stop. Error: %v", err)
 		}
 	}
+	if bot.WithdrawManager.IsRunning() {
+		if err := bot.WithdrawManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Withdraw manager unable to stop. Error: %v", err)
+		}
+	}
+	if bot.triangularArbitrage.IsRunning() {
+		if err := bot.triangularArbitrage.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Triangular arbitrage detector unable to stop. Error: %v", err)
+		}
+	}
+	if bot.positionsManager.IsRunning() {
+		if err := bot.positionsManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Positions manager unable to stop. Error: %v", err)
+		}
+	}
+	if bot.arbitrageScanner.IsRunning() {
+		if err := bot.arbitrageScanner.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Arbitrage scanner unable to stop. Error: %v", err)
+		}
+	}
+	if bot.depositManager.IsRunning() {
+		if err := bot.depositManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Deposit manager unable to stop. Error: %v", err)
+		}
+	}
+	if bot.webhookManager.IsRunning() {
+		if err := bot.webhookManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Webhook manager unable to stop. Error: %v", err)
+		}
+	}
+	if bot.OrderRouter.IsRunning() {
+		if err := bot.OrderRouter.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Order router unable to stop. Error: %v", err)
+		}
+	}
+	if bot.portfolioAnalytics.IsRunning() {
+		if err := bot.portfolioAnalytics.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Portfolio analytics manager unable to stop. Error: %v", err)
+		}
+	}
 	if bot.OrderManager.IsRunning() {
 		if err := bot.OrderManager.Stop(); err != nil {
 			gctlog.Errorf(gctlog.Global, "Order manager unable to stop. Error: %v", err)
@@ -663,6 +990,26 @@ func (bot *Engine) Stop() {
 			gctlog.Errorf(gctlog.DataHistory, "data history manager unable to stop. Error: %v", err)
 		}
 	}
+	if bot.dataSyncManager.IsRunning() {
+		if err := bot.dataSyncManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.DataHistory, "data sync manager unable to stop. Error: %v", err)
+		}
+	}
+	if bot.orderbookRecorder.IsRunning() {
+		if err := bot.orderbookRecorder.Stop(); err != nil {
+			gctlog.Errorf(gctlog.DataHistory, "orderbook recorder unable to stop. Error: %v", err)
+		}
+	}
+	if bot.dataRetentionManager.IsRunning() {
+		if err := bot.dataRetentionManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.DataHistory, "data retention manager unable to stop. Error: %v", err)
+		}
+	}
+	if bot.rateLimitBudgetManager.IsRunning() {
+		if err := bot.rateLimitBudgetManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "rate limit budget manager unable to stop. Error: %v", err)
+		}
+	}
 	if bot.DatabaseManager.IsRunning() {
 		if err := bot.DatabaseManager.Stop(); err != nil {
 			gctlog.Errorf(gctlog.Global, "Database manager unable to stop. Error: %v", err)
@@ -15,30 +15,46 @@ type Settings struct {
 	CheckParamInteraction bool
 
 	// Core Settings
-	EnableDryRun                bool
-	EnableAllExchanges          bool
-	EnableAllPairs              bool
-	EnableCoinmarketcapAnalysis bool
-	EnablePortfolioManager      bool
-	EnableDataHistoryManager    bool
-	PortfolioManagerDelay       time.Duration
-	EnableGRPC                  bool
-	EnableGRPCProxy             bool
-	EnableWebsocketRPC          bool
-	EnableDeprecatedRPC         bool
-	EnableCommsRelayer          bool
-	EnableExchangeSyncManager   bool
-	EnableDepositAddressManager bool
-	EnableEventManager          bool
-	EnableOrderManager          bool
-	EnableConnectivityMonitor   bool
-	EnableDatabaseManager       bool
-	EnableGCTScriptManager      bool
-	EnableNTPClient             bool
-	EnableWebsocketRoutine      bool
-	EnableCurrencyStateManager  bool
-	EventManagerDelay           time.Duration
-	Verbose                     bool
+	EnableDryRun                 bool
+	EnableAllExchanges           bool
+	EnableAllPairs               bool
+	EnableCoinmarketcapAnalysis  bool
+	EnablePortfolioManager       bool
+	EnableDataHistoryManager     bool
+	EnableDataSyncManager        bool
+	EnableOrderbookRecorder      bool
+	EnableDataRetentionManager   bool
+	EnableRateLimitBudgetManager bool
+	PortfolioManagerDelay        time.Duration
+	EnablePortfolioAnalytics     bool
+	PortfolioAnalyticsDelay      time.Duration
+	EnablePositionsManager       bool
+	PositionsManagerDelay        time.Duration
+	MarginRatioAlert             float64
+	EnableOrderRouter            bool
+	EnableWebhookManager         bool
+	EnableDepositManager         bool
+	EnableArbitrageScanner       bool
+	EnableTriangularArbitrage    bool
+	EnableGRPC                   bool
+	EnableGRPCProxy              bool
+	EnableWebsocketRPC           bool
+	EnableDeprecatedRPC          bool
+	EnableCommsRelayer           bool
+	EnableExchangeSyncManager    bool
+	EnableDepositAddressManager  bool
+	EnableEventManager           bool
+	EnableOrderManager           bool
+	EnablePaperTrading           bool
+	EnableOrderPersistence       bool
+	EnableConnectivityMonitor    bool
+	EnableDatabaseManager        bool
+	EnableGCTScriptManager       bool
+	EnableNTPClient              bool
+	EnableWebsocketRoutine       bool
+	EnableCurrencyStateManager   bool
+	EventManagerDelay            time.Duration
+	Verbose                      bool
 
 	// Exchange syncer settings
 	EnableTickerSyncing    bool
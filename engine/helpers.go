@@ -13,8 +13,10 @@ import (
 	"io/ioutil"
 	"math/big"
 	"net"
+	"net/http"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -29,6 +31,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stats"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
@@ -42,8 +45,13 @@ var (
 	errCertTypeInvalid     = errors.New("gRPC TLS certificate type is invalid")
 	errSubsystemNotFound   = errors.New("subsystem not found")
 	errGRPCManagementFault = errors.New("cannot manage GRPC subsystem via GRPC. Please manually change your config")
+	errRESTEndpointUnset   = errors.New("no REST endpoint set")
 )
 
+// exchangeHealthProbeTimeout is how long an exchange's REST API has to respond
+// to a health check
+const exchangeHealthProbeTimeout = time.Second * 10
+
 // GetSubsystemsStatus returns the status of various subsystems
 func (bot *Engine) GetSubsystemsStatus() map[string]bool {
 	return map[string]bool{
@@ -61,6 +69,17 @@ func (bot *Engine) GetSubsystemsStatus() map[string]bool {
 		WebsocketName:                 bot.Settings.EnableWebsocketRPC,
 		dispatch.Name:                 dispatch.IsRunning(),
 		dataHistoryManagerName:        bot.dataHistoryManager.IsRunning(),
+		dataSyncManagerName:           bot.dataSyncManager.IsRunning(),
+		orderbookRecorderName:         bot.orderbookRecorder.IsRunning(),
+		dataRetentionManagerName:      bot.dataRetentionManager.IsRunning(),
+		rateLimitBudgetManagerName:    bot.rateLimitBudgetManager.IsRunning(),
+		portfolioAnalyticsManagerName: bot.portfolioAnalytics.IsRunning(),
+		positionsManagerName:          bot.positionsManager.IsRunning(),
+		orderRouterName:               bot.OrderRouter.IsRunning(),
+		webhookManagerName:            bot.webhookManager.IsRunning(),
+		depositManagerName:            bot.depositManager.IsRunning(),
+		arbitrageScannerName:          bot.arbitrageScanner.IsRunning(),
+		triangularArbitrageName:       bot.triangularArbitrage.IsRunning(),
 		CurrencyStateManagementName:   bot.currencyStateManager.IsRunning(),
 	}
 }
@@ -193,6 +212,7 @@ func (bot *Engine) SetSubsystem(subSystemName string, enable bool) error {
 					Verbose:              bot.Settings.Verbose,
 					SyncTimeoutREST:      bot.Settings.SyncTimeoutREST,
 					SyncTimeoutWebsocket: bot.Settings.SyncTimeoutWebsocket,
+					PairSettings:         bot.Config.CurrencyPairSyncer.Pairs,
 				}
 				bot.currencyPairSyncer, err = setupSyncManager(
 					exchangeSyncCfg,
@@ -256,6 +276,165 @@ func (bot *Engine) SetSubsystem(subSystemName string, enable bool) error {
 			return bot.dataHistoryManager.Start()
 		}
 		return bot.dataHistoryManager.Stop()
+	case dataSyncManagerName:
+		if enable {
+			if bot.dataSyncManager == nil {
+				bot.dataSyncManager, err = SetupDataSyncManager(bot.ExchangeManager, bot.DatabaseManager, &bot.Config.DataSyncManager)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.dataSyncManager.Start()
+		}
+		return bot.dataSyncManager.Stop()
+	case orderbookRecorderName:
+		if enable {
+			if bot.orderbookRecorder == nil {
+				bot.orderbookRecorder, err = SetupOrderbookRecorder(bot.ExchangeManager, bot.DatabaseManager, &bot.Config.OrderbookRecorder)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.orderbookRecorder.Start()
+		}
+		return bot.orderbookRecorder.Stop()
+	case dataRetentionManagerName:
+		if enable {
+			if bot.dataRetentionManager == nil {
+				bot.dataRetentionManager, err = SetupDataRetentionManager(bot.DatabaseManager, &bot.Config.DataRetentionManager)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.dataRetentionManager.Start()
+		}
+		return bot.dataRetentionManager.Stop()
+	case rateLimitBudgetManagerName:
+		if enable {
+			if bot.rateLimitBudgetManager == nil {
+				bot.rateLimitBudgetManager, err = SetupRateLimitBudgetManager(&bot.Config.RateLimitBudget)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.rateLimitBudgetManager.Start()
+		}
+		return bot.rateLimitBudgetManager.Stop()
+	case portfolioAnalyticsManagerName:
+		if enable {
+			if !bot.OrderManager.IsRunning() {
+				return fmt.Errorf("%s %w", OrderManagerName, ErrSubSystemNotStarted)
+			}
+			if bot.portfolioAnalytics == nil {
+				bot.portfolioAnalytics, err = SetupPortfolioAnalyticsManager(
+					bot.OrderManager,
+					bot.Settings.PortfolioAnalyticsDelay,
+					bot.Settings.Verbose)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.portfolioAnalytics.Start()
+		}
+		return bot.portfolioAnalytics.Stop()
+	case positionsManagerName:
+		if enable {
+			if !bot.OrderManager.IsRunning() {
+				return fmt.Errorf("%s %w", OrderManagerName, ErrSubSystemNotStarted)
+			}
+			if bot.positionsManager == nil {
+				bot.positionsManager, err = SetupPositionsManager(
+					bot.ExchangeManager,
+					bot.OrderManager,
+					bot.webhookManager,
+					bot.Settings.PositionsManagerDelay,
+					bot.Settings.MarginRatioAlert,
+					bot.Settings.Verbose)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.positionsManager.Start()
+		}
+		return bot.positionsManager.Stop()
+	case orderRouterName:
+		if enable {
+			if !bot.OrderManager.IsRunning() {
+				return fmt.Errorf("%s %w", OrderManagerName, ErrSubSystemNotStarted)
+			}
+			if bot.OrderRouter == nil {
+				bot.OrderRouter, err = SetupOrderRouter(
+					bot.ExchangeManager,
+					bot.OrderManager,
+					bot.Settings.Verbose)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.OrderRouter.Start()
+		}
+		return bot.OrderRouter.Stop()
+	case webhookManagerName:
+		if enable {
+			if bot.webhookManager == nil {
+				bot.webhookManager, err = SetupWebhookManager(
+					bot.ExchangeManager,
+					bot.OrderManager,
+					&bot.Config.WebhookManager)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.webhookManager.Start()
+		}
+		return bot.webhookManager.Stop()
+	case depositManagerName:
+		if enable {
+			if bot.depositManager == nil {
+				if bot.DepositAddressManager == nil {
+					bot.DepositAddressManager = SetupDepositAddressManager()
+				}
+				bot.depositManager, err = SetupDepositManager(
+					bot.ExchangeManager,
+					bot.DepositAddressManager,
+					bot.CommunicationsManager,
+					bot.webhookManager,
+					&bot.Config.DepositManager)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.depositManager.Start()
+		}
+		return bot.depositManager.Stop()
+	case arbitrageScannerName:
+		if enable {
+			if bot.arbitrageScanner == nil {
+				bot.arbitrageScanner, err = SetupArbitrageScanner(
+					bot.ExchangeManager,
+					bot.CommunicationsManager,
+					bot.webhookManager,
+					&bot.Config.ArbitrageScanner)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.arbitrageScanner.Start()
+		}
+		return bot.arbitrageScanner.Stop()
+	case triangularArbitrageName:
+		if enable {
+			if bot.triangularArbitrage == nil {
+				bot.triangularArbitrage, err = SetupTriangularArbitrageDetector(
+					bot.ExchangeManager,
+					&bot.Config.TriangularArbitrage)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.triangularArbitrage.Start()
+		}
+		return bot.triangularArbitrage.Stop()
 	case vm.Name:
 		if enable {
 			if bot.gctScriptManager == nil {
@@ -726,76 +905,85 @@ func (bot *Engine) GetAllExchangeCryptocurrencyDepositAddresses() map[string]map
 				log.Errorf(log.ExchangeSys, "%s failed to get cryptocurrency deposit addresses. Err: %s\n", exchName, err)
 				return
 			}
-			supportsMultiChain := exchanges[x].GetBase().Features.Supports.RESTCapabilities.MultiChainDeposits
-			requiresChainSet := exchanges[x].GetBase().Features.Supports.RESTCapabilities.MultiChainDepositRequiresChainSet
-			cryptoAddr := make(map[string][]deposit.Address)
-			for y := range cryptoCurrencies {
-				cryptocurrency := cryptoCurrencies[y]
-				isSingular := false
-				var depositAddrs []deposit.Address
-				if supportsMultiChain {
-					availChains, err := exchanges[x].GetAvailableTransferChains(context.TODO(), currency.NewCode(cryptocurrency))
+			cryptoAddr := getExchangeDepositAddresses(context.TODO(), exchanges[x], cryptoCurrencies)
+			m.Lock()
+			result[exchName] = cryptoAddr
+			m.Unlock()
+		}(x)
+	}
+	depositSyncer.Wait()
+	if len(result) > 0 {
+		log.Infoln(log.Global, "Deposit addresses synced")
+	}
+	return result
+}
+
+// getExchangeDepositAddresses fetches the deposit addresses for each of the
+// supplied cryptocurrencies from an exchange, including an address for every
+// available transfer chain when the exchange supports multichain deposits
+func getExchangeDepositAddresses(ctx context.Context, exch exchange.IBotExchange, cryptoCurrencies []string) map[string][]deposit.Address {
+	exchName := exch.GetName()
+	supportsMultiChain := exch.GetBase().Features.Supports.RESTCapabilities.MultiChainDeposits
+	requiresChainSet := exch.GetBase().Features.Supports.RESTCapabilities.MultiChainDepositRequiresChainSet
+	cryptoAddr := make(map[string][]deposit.Address)
+	for y := range cryptoCurrencies {
+		cryptocurrency := cryptoCurrencies[y]
+		isSingular := false
+		var depositAddrs []deposit.Address
+		if supportsMultiChain {
+			availChains, err := exch.GetAvailableTransferChains(ctx, currency.NewCode(cryptocurrency))
+			if err != nil {
+				log.Errorf(log.Global, "%s failed to get cryptocurrency available transfer chains. Err: %s\n", exchName, err)
+				continue
+			}
+			if len(availChains) > 0 {
+				// store the default non-chain specified address for a specified crypto
+				chainContainsItself := common.StringDataCompareInsensitive(availChains, cryptocurrency)
+				if !chainContainsItself && !requiresChainSet {
+					depositAddr, err := exch.GetDepositAddress(ctx, currency.NewCode(cryptocurrency), "", "")
 					if err != nil {
-						log.Errorf(log.Global, "%s failed to get cryptocurrency available transfer chains. Err: %s\n", exchName, err)
+						log.Errorf(log.Global, "%s failed to get cryptocurrency deposit address for %s. Err: %s\n",
+							exchName,
+							cryptocurrency,
+							err)
 						continue
 					}
-					if len(availChains) > 0 {
-						// store the default non-chain specified address for a specified crypto
-						chainContainsItself := common.StringDataCompareInsensitive(availChains, cryptocurrency)
-						if !chainContainsItself && !requiresChainSet {
-							depositAddr, err := exchanges[x].GetDepositAddress(context.TODO(), currency.NewCode(cryptocurrency), "", "")
-							if err != nil {
-								log.Errorf(log.Global, "%s failed to get cryptocurrency deposit address for %s. Err: %s\n",
-									exchName,
-									cryptocurrency,
-									err)
-								continue
-							}
-							depositAddr.Chain = cryptocurrency
-							depositAddrs = append(depositAddrs, *depositAddr)
-						}
-						for z := range availChains {
-							depositAddr, err := exchanges[x].GetDepositAddress(context.TODO(), currency.NewCode(cryptocurrency), "", availChains[z])
-							if err != nil {
-								log.Errorf(log.Global, "%s failed to get cryptocurrency deposit address for %s [chain %s]. Err: %s\n",
-									exchName,
-									cryptocurrency,
-									availChains[z],
-									err)
-								continue
-							}
-							depositAddr.Chain = availChains[z]
-							depositAddrs = append(depositAddrs, *depositAddr)
-						}
-					} else {
-						// cryptocurrency doesn't support multichain transfers
-						isSingular = true
-					}
+					depositAddr.Chain = cryptocurrency
+					depositAddrs = append(depositAddrs, *depositAddr)
 				}
-
-				if !supportsMultiChain || isSingular {
-					depositAddr, err := exchanges[x].GetDepositAddress(context.TODO(), currency.NewCode(cryptocurrency), "", "")
+				for z := range availChains {
+					depositAddr, err := exch.GetDepositAddress(ctx, currency.NewCode(cryptocurrency), "", availChains[z])
 					if err != nil {
-						log.Errorf(log.Global, "%s failed to get cryptocurrency deposit address for %s. Err: %s\n",
+						log.Errorf(log.Global, "%s failed to get cryptocurrency deposit address for %s [chain %s]. Err: %s\n",
 							exchName,
 							cryptocurrency,
+							availChains[z],
 							err)
 						continue
 					}
+					depositAddr.Chain = availChains[z]
 					depositAddrs = append(depositAddrs, *depositAddr)
 				}
-				cryptoAddr[cryptocurrency] = depositAddrs
+			} else {
+				// cryptocurrency doesn't support multichain transfers
+				isSingular = true
 			}
-			m.Lock()
-			result[exchName] = cryptoAddr
-			m.Unlock()
-		}(x)
-	}
-	depositSyncer.Wait()
-	if len(result) > 0 {
-		log.Infoln(log.Global, "Deposit addresses synced")
+		}
+
+		if !supportsMultiChain || isSingular {
+			depositAddr, err := exch.GetDepositAddress(ctx, currency.NewCode(cryptocurrency), "", "")
+			if err != nil {
+				log.Errorf(log.Global, "%s failed to get cryptocurrency deposit address for %s. Err: %s\n",
+					exchName,
+					cryptocurrency,
+					err)
+				continue
+			}
+			depositAddrs = append(depositAddrs, *depositAddr)
+		}
+		cryptoAddr[cryptocurrency] = depositAddrs
 	}
-	return result
+	return cryptoAddr
 }
 
 // GetExchangeNames returns a list of enabled or disabled exchanges
@@ -867,7 +1055,9 @@ func verifyCert(pemData []byte) error {
 	return nil
 }
 
-func checkCerts(certDir string) error {
+// CheckCerts verifies the gRPC TLS certificate and key files exist in the
+// supplied directory and have not expired, regenerating them if required
+func CheckCerts(certDir string) error {
 	certFile := filepath.Join(certDir, "cert.pem")
 	keyFile := filepath.Join(certDir, "key.pem")
 
@@ -967,3 +1157,377 @@ func genCert(targetDir string) error {
 	log.Infof(log.Global, "gRPC TLS key.pem and cert.pem files written to %s\n", targetDir)
 	return nil
 }
+
+// CapabilityGaps holds the unified exchange wrapper functions which an
+// exchange has not implemented or does not support
+type CapabilityGaps struct {
+	Exchange          string
+	Checked           int
+	NotYetImplemented []string
+	NotSupported      []string
+}
+
+// capabilityCheck calls a unified exchange wrapper function so its support
+// can be determined from the returned error
+type capabilityCheck struct {
+	Function string
+	Call     func(context.Context, exchange.IBotExchange, asset.Item) error
+}
+
+// capabilityChecks are the unified exchange wrapper functions reported on by
+// GetCapabilityGaps. Requests which would change exchange state are passed
+// nil parameters so they are always rejected before being sent
+var capabilityChecks = []capabilityCheck{
+	{"FetchTicker", func(ctx context.Context, e exchange.IBotExchange, a asset.Item) error {
+		_, err := e.FetchTicker(ctx, currency.NewPair(currency.BTC, currency.USD), a)
+		return err
+	}},
+	{"UpdateTickers", func(ctx context.Context, e exchange.IBotExchange, a asset.Item) error {
+		return e.UpdateTickers(ctx, a)
+	}},
+	{"FetchOrderbook", func(ctx context.Context, e exchange.IBotExchange, a asset.Item) error {
+		_, err := e.FetchOrderbook(ctx, currency.NewPair(currency.BTC, currency.USD), a)
+		return err
+	}},
+	{"FetchTradablePairs", func(ctx context.Context, e exchange.IBotExchange, a asset.Item) error {
+		_, err := e.FetchTradablePairs(ctx, a)
+		return err
+	}},
+	{"UpdateAccountInfo", func(ctx context.Context, e exchange.IBotExchange, a asset.Item) error {
+		_, err := e.UpdateAccountInfo(ctx, a)
+		return err
+	}},
+	{"GetRecentTrades", func(ctx context.Context, e exchange.IBotExchange, a asset.Item) error {
+		_, err := e.GetRecentTrades(ctx, currency.NewPair(currency.BTC, currency.USD), a)
+		return err
+	}},
+	{"GetHistoricTrades", func(ctx context.Context, e exchange.IBotExchange, a asset.Item) error {
+		_, err := e.GetHistoricTrades(ctx, currency.NewPair(currency.BTC, currency.USD), a, time.Now().Add(-time.Hour), time.Now())
+		return err
+	}},
+	{"GetHistoricCandles", func(ctx context.Context, e exchange.IBotExchange, a asset.Item) error {
+		_, err := e.GetHistoricCandles(ctx, currency.NewPair(currency.BTC, currency.USD), a, time.Now().Add(-time.Hour*24), time.Now(), kline.OneHour)
+		return err
+	}},
+	{"GetHistoricCandlesExtended", func(ctx context.Context, e exchange.IBotExchange, a asset.Item) error {
+		_, err := e.GetHistoricCandlesExtended(ctx, currency.NewPair(currency.BTC, currency.USD), a, time.Now().Add(-time.Hour*24), time.Now(), kline.OneHour)
+		return err
+	}},
+	{"GetFundingHistory", func(ctx context.Context, e exchange.IBotExchange, _ asset.Item) error {
+		_, err := e.GetFundingHistory(ctx)
+		return err
+	}},
+	{"GetWithdrawalsHistory", func(ctx context.Context, e exchange.IBotExchange, _ asset.Item) error {
+		_, err := e.GetWithdrawalsHistory(ctx, currency.BTC)
+		return err
+	}},
+	{"GetWithdrawalStatus", func(ctx context.Context, e exchange.IBotExchange, _ asset.Item) error {
+		_, err := e.GetWithdrawalStatus(ctx, currency.BTC, "1")
+		return err
+	}},
+	{"GetDepositStatus", func(ctx context.Context, e exchange.IBotExchange, _ asset.Item) error {
+		_, err := e.GetDepositStatus(ctx, currency.BTC, "1")
+		return err
+	}},
+	{"GetDepositAddress", func(ctx context.Context, e exchange.IBotExchange, _ asset.Item) error {
+		_, err := e.GetDepositAddress(ctx, currency.BTC, "", "")
+		return err
+	}},
+	{"GetAvailableTransferChains", func(ctx context.Context, e exchange.IBotExchange, _ asset.Item) error {
+		_, err := e.GetAvailableTransferChains(ctx, currency.BTC)
+		return err
+	}},
+	{"SubmitOrder", func(ctx context.Context, e exchange.IBotExchange, _ asset.Item) error {
+		_, err := e.SubmitOrder(ctx, nil)
+		return err
+	}},
+	{"ModifyOrder", func(ctx context.Context, e exchange.IBotExchange, _ asset.Item) error {
+		_, err := e.ModifyOrder(ctx, nil)
+		return err
+	}},
+	{"CancelOrder", func(ctx context.Context, e exchange.IBotExchange, _ asset.Item) error {
+		return e.CancelOrder(ctx, nil)
+	}},
+	{"CancelBatchOrders", func(ctx context.Context, e exchange.IBotExchange, _ asset.Item) error {
+		_, err := e.CancelBatchOrders(ctx, nil)
+		return err
+	}},
+	{"CancelAllOrders", func(ctx context.Context, e exchange.IBotExchange, _ asset.Item) error {
+		_, err := e.CancelAllOrders(ctx, nil)
+		return err
+	}},
+	{"GetOrderInfo", func(ctx context.Context, e exchange.IBotExchange, a asset.Item) error {
+		_, err := e.GetOrderInfo(ctx, "1", currency.NewPair(currency.BTC, currency.USD), a)
+		return err
+	}},
+	{"GetActiveOrders", func(ctx context.Context, e exchange.IBotExchange, _ asset.Item) error {
+		_, err := e.GetActiveOrders(ctx, nil)
+		return err
+	}},
+	{"GetOrderHistory", func(ctx context.Context, e exchange.IBotExchange, _ asset.Item) error {
+		_, err := e.GetOrderHistory(ctx, nil)
+		return err
+	}},
+	{"GetOrderFills", func(ctx context.Context, e exchange.IBotExchange, _ asset.Item) error {
+		_, err := e.GetOrderFills(ctx, nil)
+		return err
+	}},
+	{"WithdrawCryptocurrencyFunds", func(ctx context.Context, e exchange.IBotExchange, _ asset.Item) error {
+		_, err := e.WithdrawCryptocurrencyFunds(ctx, nil)
+		return err
+	}},
+	{"WithdrawFiatFunds", func(ctx context.Context, e exchange.IBotExchange, _ asset.Item) error {
+		_, err := e.WithdrawFiatFunds(ctx, nil)
+		return err
+	}},
+	{"WithdrawFiatFundsToInternationalBank", func(ctx context.Context, e exchange.IBotExchange, _ asset.Item) error {
+		_, err := e.WithdrawFiatFundsToInternationalBank(ctx, nil)
+		return err
+	}},
+	{"GetFeeByType", func(ctx context.Context, e exchange.IBotExchange, _ asset.Item) error {
+		_, err := e.GetFeeByType(ctx, &exchange.FeeBuilder{})
+		return err
+	}},
+	{"UpdateOrderExecutionLimits", func(ctx context.Context, e exchange.IBotExchange, a asset.Item) error {
+		return e.UpdateOrderExecutionLimits(ctx, a)
+	}},
+	{"UpdateCurrencyStates", func(ctx context.Context, e exchange.IBotExchange, a asset.Item) error {
+		return e.UpdateCurrencyStates(ctx, a)
+	}},
+	{"GetServerTime", func(ctx context.Context, e exchange.IBotExchange, a asset.Item) error {
+		_, err := e.GetServerTime(ctx, a)
+		return err
+	}},
+	{"GetSystemStatus", func(ctx context.Context, e exchange.IBotExchange, a asset.Item) error {
+		_, err := e.GetSystemStatus(ctx, a)
+		return err
+	}},
+}
+
+// GetCapabilityGaps reports the unified exchange wrapper functions which are
+// not yet implemented or not supported by each loaded exchange, or only the
+// supplied exchange when set
+func (bot *Engine) GetCapabilityGaps(exchName string) ([]CapabilityGaps, error) {
+	var exchanges []exchange.IBotExchange
+	if exchName != "" {
+		exch, err := bot.GetExchangeByName(exchName)
+		if err != nil {
+			return nil, err
+		}
+		exchanges = append(exchanges, exch)
+	} else {
+		exchanges = bot.GetExchanges()
+	}
+	gaps := make([]CapabilityGaps, len(exchanges))
+	for i := range exchanges {
+		gaps[i] = exchangeCapabilityGaps(exchanges[i])
+	}
+	sort.Slice(gaps, func(i, j int) bool {
+		return gaps[i].Exchange < gaps[j].Exchange
+	})
+	return gaps, nil
+}
+
+// exchangeCapabilityGaps calls each unified wrapper function with a cancelled
+// context so that no requests are sent to the exchange. Functions which
+// return before a request is attempted report whether they are implemented
+func exchangeCapabilityGaps(exch exchange.IBotExchange) CapabilityGaps {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	a := asset.Spot
+	if !exch.SupportsAsset(a) {
+		if assets := exch.GetAssetTypes(false); len(assets) > 0 {
+			a = assets[0]
+		}
+	}
+	gaps := CapabilityGaps{
+		Exchange: exch.GetName(),
+		Checked:  len(capabilityChecks),
+	}
+	for i := range capabilityChecks {
+		err := callCapabilityCheck(ctx, exch, a, &capabilityChecks[i])
+		switch {
+		case errors.Is(err, common.ErrNotYetImplemented):
+			gaps.NotYetImplemented = append(gaps.NotYetImplemented, capabilityChecks[i].Function)
+		case errors.Is(err, common.ErrFunctionNotSupported):
+			gaps.NotSupported = append(gaps.NotSupported, capabilityChecks[i].Function)
+		}
+	}
+	return gaps
+}
+
+// callCapabilityCheck calls a capability check, recovering from wrappers
+// which do not handle nil parameters
+func callCapabilityCheck(ctx context.Context, exch exchange.IBotExchange, a asset.Item, check *capabilityCheck) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%s %s panicked: %v", exch.GetName(), check.Function, r)
+		}
+	}()
+	return check.Call(ctx, exch, a)
+}
+
+// ExchangeHealth holds the operational status of an exchange. RateLimit is
+// only set when the rate limit budget manager is running with a budget for the
+// exchange
+type ExchangeHealth struct {
+	Exchange               string
+	RESTURL                string
+	RESTReachable          bool
+	RESTStatusCode         int
+	RESTLatency            time.Duration
+	RESTError              string
+	WebsocketSupported     bool
+	WebsocketEnabled       bool
+	WebsocketConnected     bool
+	WebsocketConnecting    bool
+	WebsocketAuthenticated bool
+	AuthenticatedSupport   bool
+	CredentialsSet         bool
+	CredentialsVerified    bool
+	CredentialsError       string
+	RateLimit              *RateLimitHeadroom
+	LastTickerUpdate       time.Time
+	LastOrderbookUpdate    time.Time
+}
+
+// restHealthURLs are the REST endpoints probed for reachability in order of
+// preference, allowing exchanges without a spot API to be checked
+var restHealthURLs = []exchange.URL{
+	exchange.RestSpot,
+	exchange.RestFutures,
+	exchange.RestUSDTMargined,
+	exchange.RestCoinMargined,
+	exchange.RestSwap,
+}
+
+// GetExchangeHealth reports the operational status of each loaded exchange,
+// or only the supplied exchange when set. Credentials are verified with an
+// account info request when verifyCredentials is set, otherwise only whether
+// they are set is reported
+func (bot *Engine) GetExchangeHealth(ctx context.Context, exchName string, verifyCredentials bool) ([]ExchangeHealth, error) {
+	var exchanges []exchange.IBotExchange
+	if exchName != "" {
+		exch, err := bot.GetExchangeByName(exchName)
+		if err != nil {
+			return nil, err
+		}
+		exchanges = append(exchanges, exch)
+	} else {
+		exchanges = bot.GetExchanges()
+	}
+	health := make([]ExchangeHealth, len(exchanges))
+	var wg sync.WaitGroup
+	wg.Add(len(exchanges))
+	for i := range exchanges {
+		go func(i int) {
+			defer wg.Done()
+			health[i] = bot.exchangeHealth(ctx, exchanges[i], verifyCredentials)
+		}(i)
+	}
+	wg.Wait()
+	sort.Slice(health, func(i, j int) bool {
+		return health[i].Exchange < health[j].Exchange
+	})
+	return health, nil
+}
+
+// exchangeHealth gathers the operational status of an exchange
+func (bot *Engine) exchangeHealth(ctx context.Context, exch exchange.IBotExchange, verifyCredentials bool) ExchangeHealth {
+	b := exch.GetBase()
+	h := ExchangeHealth{
+		Exchange:             exch.GetName(),
+		WebsocketSupported:   exch.SupportsWebsocket(),
+		WebsocketEnabled:     exch.IsWebsocketEnabled(),
+		AuthenticatedSupport: b.API.AuthenticatedSupport,
+		CredentialsSet:       b.AllowAuthenticatedRequest(),
+	}
+	probeRESTHealth(ctx, b, &h)
+	if w, err := exch.GetWebsocket(); err == nil {
+		h.WebsocketConnected = w.IsConnected()
+		h.WebsocketConnecting = w.IsConnecting()
+		h.WebsocketAuthenticated = w.CanUseAuthenticatedEndpoints()
+	}
+	assets := exch.GetAssetTypes(true)
+	if verifyCredentials && h.CredentialsSet {
+		verifyAssets := assets
+		if len(verifyAssets) == 0 {
+			verifyAssets = exch.GetAssetTypes(false)
+		}
+		if len(verifyAssets) > 0 {
+			verifyExchangeCredentials(ctx, exch, verifyAssets[0], &h)
+		}
+	}
+	if bot.rateLimitBudgetManager.IsRunning() {
+		// exchanges without a budget are limited by their own rate limiter
+		// alone, which does not expose its headroom
+		if headroom, err := bot.rateLimitBudgetManager.GetHeadroom(h.Exchange); err == nil {
+			h.RateLimit = headroom
+		}
+	}
+	for i := range assets {
+		pairs, err := exch.GetEnabledPairs(assets[i])
+		if err != nil {
+			continue
+		}
+		for j := range pairs {
+			if t, err := ticker.GetTicker(h.Exchange, pairs[j], assets[i]); err == nil && t.LastUpdated.After(h.LastTickerUpdate) {
+				h.LastTickerUpdate = t.LastUpdated
+			}
+			if ob, err := orderbook.Get(h.Exchange, pairs[j], assets[i]); err == nil && ob.LastUpdated.After(h.LastOrderbookUpdate) {
+				h.LastOrderbookUpdate = ob.LastUpdated
+			}
+		}
+	}
+	return h
+}
+
+// verifyExchangeCredentials requests account info to confirm the exchange
+// accepts the configured credentials
+func verifyExchangeCredentials(ctx context.Context, exch exchange.IBotExchange, a asset.Item, h *ExchangeHealth) {
+	_, err := exch.UpdateAccountInfo(ctx, a)
+	if err != nil {
+		h.CredentialsError = err.Error()
+		return
+	}
+	h.CredentialsVerified = true
+}
+
+// probeRESTHealth sends an unauthenticated request to the exchange's REST API.
+// Any response is treated as reachable, as a base URL need not serve a
+// successful response
+func probeRESTHealth(ctx context.Context, b *exchange.Base, h *ExchangeHealth) {
+	if b.API.Endpoints == nil {
+		h.RESTError = errRESTEndpointUnset.Error()
+		return
+	}
+	for i := range restHealthURLs {
+		if u, err := b.API.Endpoints.GetURL(restHealthURLs[i]); err == nil && u != "" {
+			h.RESTURL = u
+			break
+		}
+	}
+	if h.RESTURL == "" {
+		h.RESTError = errRESTEndpointUnset.Error()
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, exchangeHealthProbeTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, h.RESTURL, nil)
+	if err != nil {
+		h.RESTError = err.Error()
+		return
+	}
+	start := time.Now()
+	resp, err := b.GetHTTPClient().Do(req)
+	h.RESTLatency = time.Since(start)
+	if err != nil {
+		h.RESTError = err.Error()
+		return
+	}
+	h.RESTReachable = true
+	h.RESTStatusCode = resp.StatusCode
+	if err = resp.Body.Close(); err != nil {
+		log.Errorf(log.ExchangeSys, "%s REST health check could not close response body: %v", h.Exchange, err)
+	}
+}
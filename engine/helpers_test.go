@@ -11,6 +11,8 @@ import (
 	"errors"
 	"math/big"
 	"net"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"testing"
@@ -28,6 +30,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stats"
@@ -98,8 +101,8 @@ func CreateTestBot(t *testing.T) *Engine {
 
 func TestGetSubsystemsStatus(t *testing.T) {
 	m := (&Engine{}).GetSubsystemsStatus()
-	if len(m) != 15 {
-		t.Fatalf("subsystem count is wrong expecting: %d but received: %d", 14, len(m))
+	if len(m) != 26 {
+		t.Fatalf("subsystem count is wrong expecting: %d but received: %d", 26, len(m))
 	}
 }
 
@@ -205,6 +208,72 @@ func TestSetSubsystem(t *testing.T) { // nolint // TO-DO: Fix race t.Parallel()
 			EnableError:  database.ErrNilInstance,
 			DisableError: ErrNilSubsystem,
 		},
+		{
+			Subsystem:    dataSyncManagerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  database.ErrNilInstance,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    orderbookRecorderName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  database.ErrNilInstance,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    dataRetentionManagerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  database.ErrNilInstance,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    rateLimitBudgetManagerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  nil,
+			DisableError: nil,
+		},
+		{
+			Subsystem:    portfolioAnalyticsManagerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  ErrSubSystemNotStarted,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    positionsManagerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  ErrSubSystemNotStarted,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    orderRouterName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  ErrSubSystemNotStarted,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    webhookManagerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  errNoWebhookEndpoints,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    depositManagerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  errInvalidDepositInterval,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    arbitrageScannerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  errInvalidArbitrageInterval,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    triangularArbitrageName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  errInvalidTriangularInterval,
+			DisableError: ErrNilSubsystem,
+		},
 		{
 			Subsystem:    vm.Name,
 			Engine:       &Engine{Config: &config.Config{}},
@@ -1322,7 +1391,7 @@ func TestCheckAndGenCerts(t *testing.T) {
 	}
 
 	defer cleanup()
-	if err := checkCerts(tempDir); err != nil {
+	if err := CheckCerts(tempDir); err != nil {
 		t.Fatal(err)
 	}
 
@@ -1331,11 +1400,11 @@ func TestCheckAndGenCerts(t *testing.T) {
 	if err := os.Remove(certFile); err != nil {
 		t.Fatal(err)
 	}
-	if err := checkCerts(tempDir); err != nil {
+	if err := CheckCerts(tempDir); err != nil {
 		t.Fatal(err)
 	}
 
-	// Now call checkCerts to test an expired cert
+	// Now call CheckCerts to test an expired cert
 	certData, err := mockCert("", time.Now().Add(-time.Hour))
 	if err != nil {
 		t.Fatal(err)
@@ -1344,7 +1413,122 @@ func TestCheckAndGenCerts(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	if err = checkCerts(tempDir); err != nil {
+	if err = CheckCerts(tempDir); err != nil {
 		t.Fatal(err)
 	}
 }
+
+// capExchange only implements the functions needed to report its capability
+// gaps, any other unified function called panics
+type capExchange struct {
+	exchange.IBotExchange
+}
+
+func (c *capExchange) GetName() string {
+	return "capexchange"
+}
+
+func (c *capExchange) SupportsAsset(asset.Item) bool {
+	return true
+}
+
+func (c *capExchange) GetFundingHistory(context.Context) ([]exchange.FundHistory, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+func (c *capExchange) GetOrderFills(context.Context, *order.GetFillsRequest) ([]order.Fill, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+func (c *capExchange) SubmitOrder(context.Context, *order.Submit) (order.SubmitResponse, error) {
+	return order.SubmitResponse{}, order.ErrSubmissionIsNil
+}
+
+func TestGetCapabilityGaps(t *testing.T) {
+	t.Parallel()
+	em := SetupExchangeManager()
+	em.Add(&capExchange{})
+	bot := &Engine{ExchangeManager: em}
+	_, err := bot.GetCapabilityGaps("bitstamp")
+	if !errors.Is(err, ErrExchangeNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, ErrExchangeNotFound)
+	}
+	gaps, err := bot.GetCapabilityGaps("")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(gaps) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(gaps), 1)
+	}
+	if gaps[0].Checked != len(capabilityChecks) {
+		t.Errorf("received '%v' expected '%v'", gaps[0].Checked, len(capabilityChecks))
+	}
+	if len(gaps[0].NotSupported) != 1 || gaps[0].NotSupported[0] != "GetFundingHistory" {
+		t.Errorf("received '%v' expected '%v'", gaps[0].NotSupported, []string{"GetFundingHistory"})
+	}
+	if len(gaps[0].NotYetImplemented) != 1 || gaps[0].NotYetImplemented[0] != "GetOrderFills" {
+		t.Errorf("received '%v' expected '%v'", gaps[0].NotYetImplemented, []string{"GetOrderFills"})
+	}
+}
+
+func TestGetExchangeHealth(t *testing.T) {
+	t.Parallel()
+	bot := CreateTestBot(t)
+	_, err := bot.GetExchangeHealth(context.Background(), "binance", false)
+	if !errors.Is(err, ErrExchangeNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, ErrExchangeNotFound)
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+	exch, err := bot.GetExchangeByName(testExchange)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	b := exch.GetBase()
+	err = b.API.Endpoints.SetRunning(exchange.RestSpot.String(), srv.URL)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = ticker.ProcessTicker(&ticker.Price{
+		ExchangeName: testExchange,
+		Pair:         currency.NewPair(currency.BTC, currency.USD),
+		AssetType:    asset.Spot,
+		Last:         1337,
+		LastUpdated:  time.Now(),
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	health, err := bot.GetExchangeHealth(context.Background(), "", true)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(health) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(health), 1)
+	}
+	h := health[0]
+	if !h.RESTReachable || h.RESTStatusCode != http.StatusNotFound || h.RESTURL != srv.URL {
+		t.Errorf("received '%+v' expected reachable REST API, any response is reachable", h)
+	}
+	if h.CredentialsSet || h.CredentialsVerified {
+		t.Errorf("received '%+v' expected no credentials", h)
+	}
+	if h.RateLimit != nil {
+		t.Errorf("received '%+v' expected no rate limit headroom without a budget", h.RateLimit)
+	}
+	if h.LastTickerUpdate.IsZero() {
+		t.Error("expected last ticker update to be set")
+	}
+
+	srv.Close()
+	health, err = bot.GetExchangeHealth(context.Background(), testExchange, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if health[0].RESTReachable || health[0].RESTError == "" {
+		t.Errorf("received '%+v' expected unreachable REST API", health[0])
+	}
+}
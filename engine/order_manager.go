@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -16,6 +17,9 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
@@ -92,13 +96,15 @@ func (m *OrderManager) gracefulShutdown() {
 			log.Errorf(log.OrderMgr, "Order manager cannot get exchanges: %v", err)
 			return
 		}
-		m.CancelAllOrders(context.TODO(), exchanges)
+		m.CancelAllOrders(request.WithConsumer(context.TODO(), request.OrderManagement), exchanges)
 	}
+	m.persistOrders()
 }
 
 // run will periodically process orders
 func (m *OrderManager) run() {
 	log.Debugln(log.OrderMgr, "Order manager started.")
+	m.restorePersistedOrders()
 	m.processOrders()
 	tick := time.NewTicker(orderManagerDelay)
 	m.orderStore.wg.Add(1)
@@ -115,6 +121,7 @@ func (m *OrderManager) run() {
 			return
 		case <-tick.C:
 			go m.processOrders()
+			go m.processConditionalOrders()
 		}
 	}
 }
@@ -183,8 +190,17 @@ func (m *OrderManager) Cancel(ctx context.Context, cancel *order.Cancel) error {
 		return err
 	}
 	if cancel.ID == "" {
-		err = errors.New("order id is empty")
-		return err
+		if cancel.ClientOrderID == "" {
+			err = errors.New("order id is empty")
+			return err
+		}
+		var od *order.Detail
+		od, err = m.orderStore.getByExchangeAndClientOrderID(cancel.Exchange, cancel.ClientOrderID)
+		if err != nil {
+			err = fmt.Errorf("%v - Failed to retrieve order with client order ID %v: %w", cancel.Exchange, cancel.ClientOrderID, err)
+			return err
+		}
+		cancel.ID = od.ID
 	}
 
 	exch, err := m.orderStore.exchangeManager.GetExchangeByName(cancel.Exchange)
@@ -200,10 +216,25 @@ func (m *OrderManager) Cancel(ctx context.Context, cancel *order.Cancel) error {
 	log.Debugf(log.OrderMgr, "Order manager: Cancelling order ID %v [%+v]",
 		cancel.ID, cancel)
 
-	err = exch.CancelOrder(ctx, cancel)
-	if err != nil {
-		err = fmt.Errorf("%v - Failed to cancel order: %w", cancel.Exchange, err)
-		return err
+	if m.paperTrader != nil {
+		// Paper orders are only held locally so there is nothing to cancel
+		// on the exchange
+		if !strings.HasPrefix(cancel.ID, paperOrderIDPrefix) {
+			err = fmt.Errorf("%w, received order ID %v", errNotPaperOrder, cancel.ID)
+			return err
+		}
+	} else {
+		var status exchange.SystemStatus
+		status, err = exchange.CheckSystemStatus(ctx, exch, cancel.AssetType)
+		if err == nil && !status.AllowsOrderCancellation() {
+			err = fmt.Errorf("%v - Cannot cancel orders while %s: %w", cancel.Exchange, status, exchange.ErrSystemUnavailable)
+			return err
+		}
+		err = exch.CancelOrder(ctx, cancel)
+		if err != nil {
+			err = fmt.Errorf("%v - Failed to cancel order: %w", cancel.Exchange, err)
+			return err
+		}
 	}
 	var od *order.Detail
 	od, err = m.orderStore.getByExchangeAndID(cancel.Exchange, cancel.ID)
@@ -211,6 +242,10 @@ func (m *OrderManager) Cancel(ctx context.Context, cancel *order.Cancel) error {
 		err = fmt.Errorf("%v - Failed to retrieve order %v to update cancelled status: %w", cancel.Exchange, cancel.ID, err)
 		return err
 	}
+	if m.paperTrader != nil && !od.IsActive() {
+		err = fmt.Errorf("%v - Failed to cancel paper order %v with status %v", cancel.Exchange, cancel.ID, od.Status)
+		return err
+	}
 
 	od.Status = order.Cancelled
 	msg := fmt.Sprintf("Order manager: Exchange %s order ID=%v cancelled.",
@@ -238,6 +273,14 @@ func (m *OrderManager) GetOrderInfo(ctx context.Context, exchangeName, orderID s
 		return order.Detail{}, ErrOrderIDCannotBeEmpty
 	}
 
+	if m.paperTrader != nil && strings.HasPrefix(orderID, paperOrderIDPrefix) {
+		od, err := m.orderStore.getByExchangeAndID(exchangeName, orderID)
+		if err != nil {
+			return order.Detail{}, err
+		}
+		return od.Copy(), nil
+	}
+
 	exch, err := m.orderStore.exchangeManager.GetExchangeByName(exchangeName)
 	if err != nil {
 		return order.Detail{}, err
@@ -290,6 +333,51 @@ func (m *OrderManager) validate(newOrder *order.Submit) error {
 	return nil
 }
 
+// SetDepthRejection sets whether market orders larger than the locally held
+// orderbook's depth are rejected. When disabled they are submitted with a
+// warning
+func (m *OrderManager) SetDepthRejection(reject bool) {
+	m.cfg.RejectInsufficientDepth = reject
+}
+
+// checkOrderbookDepth simulates a market order against the exchange's locally
+// held orderbook. Orders its depth cannot fill are rejected when depth
+// rejection is enabled, otherwise a warning is logged. Orders are not checked
+// when no orderbook is held for the pair
+func (m *OrderManager) checkOrderbookDepth(s *order.Submit) error {
+	ob, err := orderbook.Get(s.Exchange, s.Pair, s.AssetType)
+	if err != nil {
+		if m.verbose {
+			log.Debugf(log.OrderMgr, "Order manager: exchange %s %s %s orderbook unavailable, depth not checked: %v",
+				s.Exchange, s.Pair, s.AssetType, err)
+		}
+		return nil
+	}
+	sim, err := ob.SimulateMarketOrder(s.Amount, s.Side)
+	if err != nil {
+		return fmt.Errorf("order manager: exchange %s %s %s: %w", s.Exchange, s.Pair, s.AssetType, err)
+	}
+	if !sim.SufficientDepth {
+		if m.cfg.RejectInsufficientDepth {
+			return fmt.Errorf("order manager: exchange %s %s %s orderbook can fill %v of %v: %w",
+				s.Exchange,
+				s.Pair,
+				s.AssetType,
+				sim.FilledAmount,
+				sim.Amount,
+				errInsufficientOrderbookDepth)
+		}
+		log.Warnf(log.OrderMgr, "Order manager: exchange %s %s %s orderbook can fill %v of %v, order may fill beyond the expected price",
+			s.Exchange, s.Pair, s.AssetType, sim.FilledAmount, sim.Amount)
+		return nil
+	}
+	if m.verbose {
+		log.Debugf(log.OrderMgr, "Order manager: exchange %s %s %s market order expected to fill at an average price of %v with %.4f%% slippage",
+			s.Exchange, s.Pair, s.AssetType, sim.AveragePrice, sim.SlippagePercentage)
+	}
+	return nil
+}
+
 // Modify depends on the order.Modify.ID and order.Modify.Exchange fields to uniquely
 // identify an order to modify.
 func (m *OrderManager) Modify(ctx context.Context, mod *order.Modify) (*order.ModifyResponse, error) {
@@ -299,6 +387,9 @@ func (m *OrderManager) Modify(ctx context.Context, mod *order.Modify) (*order.Mo
 	if atomic.LoadInt32(&m.started) == 0 {
 		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
 	}
+	if m.paperTrader != nil {
+		return nil, fmt.Errorf("order modification %w", errPaperTradingUnsupported)
+	}
 
 	// Fetch details from locally managed order store.
 	det, err := m.orderStore.getByExchangeAndID(mod.Exchange, mod.ID)
@@ -322,12 +413,15 @@ func (m *OrderManager) Modify(ctx context.Context, mod *order.Modify) (*order.Mo
 		mod.Price = det.Price
 	}
 
-	// Get exchange instance and submit order modification request.
+	// Get exchange instance and submit order modification request. Orders
+	// are amended in place where the exchange supports it, otherwise they
+	// are cancelled and replaced.
 	exch, err := m.orderStore.exchangeManager.GetExchangeByName(mod.Exchange)
 	if err != nil {
 		return nil, err
 	}
-	res, err := exch.ModifyOrder(ctx, mod)
+	current := det.Copy()
+	res, err := exchange.ModifyOrder(ctx, exch, &current, mod, m.submitReplacement)
 	if err != nil {
 		message := fmt.Sprintf(
 			"Order manager: Exchange %s order ID=%v: failed to modify",
@@ -345,7 +439,22 @@ func (m *OrderManager) Modify(ctx context.Context, mod *order.Modify) (*order.Mo
 	//
 	// XXX: This comes with a race condition, because [request -> changes] are not
 	// atomic.
-	err = m.orderStore.modifyExisting(mod.ID, &res)
+	if res.CancelReplaced {
+		// the replacement is tracked by Submit, so the original order is
+		// only marked as cancelled
+		err = m.orderStore.modifyExisting(mod.ID, &order.Modify{
+			ImmediateOrCancel: res.ImmediateOrCancel,
+			HiddenOrder:       res.HiddenOrder,
+			FillOrKill:        res.FillOrKill,
+			PostOnly:          res.PostOnly,
+			Exchange:          res.Exchange,
+			ExecutedAmount:    res.ExecutedAmount,
+			Status:            order.Cancelled,
+			LastUpdated:       time.Now(),
+		})
+	} else {
+		err = m.orderStore.modifyExisting(mod.ID, &res)
+	}
 
 	// Notify observers.
 	var message string
@@ -358,7 +467,21 @@ func (m *OrderManager) Modify(ctx context.Context, mod *order.Modify) (*order.Mo
 		Type:    "order",
 		Message: fmt.Sprintf(message, mod.Exchange, res.ID),
 	})
-	return &order.ModifyResponse{OrderID: res.ID}, err
+	return &order.ModifyResponse{
+		OrderID:                res.ID,
+		QueuePriorityPreserved: res.QueuePriorityPreserved,
+		CancelReplaced:         res.CancelReplaced,
+	}, err
+}
+
+// submitReplacement submits the replacement of a cancel-replaced order
+// through Submit so that it is checked and tracked like any other order
+func (m *OrderManager) submitReplacement(ctx context.Context, s *order.Submit) (order.SubmitResponse, error) {
+	resp, err := m.Submit(ctx, s)
+	if err != nil {
+		return order.SubmitResponse{}, err
+	}
+	return resp.SubmitResponse, nil
 }
 
 // Submit will take in an order struct, send it to the exchange and
@@ -379,6 +502,12 @@ func (m *OrderManager) Submit(ctx context.Context, newOrder *order.Submit) (*Ord
 	if err != nil {
 		return nil, err
 	}
+	if generatesClientOrderIDs(exch) {
+		err = newOrder.EnsureClientOrderID()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	// Checks for exchange min max limits for order amounts before order
 	// execution can occur
@@ -404,6 +533,40 @@ func (m *OrderManager) Submit(ctx context.Context, newOrder *order.Submit) (*Ord
 			err)
 	}
 
+	// Market orders larger than the locally held orderbook's depth would fill
+	// far from the expected price
+	if newOrder.Type == order.Market {
+		err = m.checkOrderbookDepth(newOrder)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Reserving the client order ID rejects duplicate submissions until the
+	// order is tracked by the order store
+	err = m.orderStore.reserveClientOrderID(newOrder.Exchange, newOrder.ClientOrderID)
+	if err != nil {
+		return nil, fmt.Errorf("order manager: exchange %s client order ID %s: %w",
+			newOrder.Exchange,
+			newOrder.ClientOrderID,
+			err)
+	}
+	defer m.orderStore.releaseClientOrderID(newOrder.Exchange, newOrder.ClientOrderID)
+
+	if m.paperTrader != nil {
+		return m.submitPaperOrder(ctx, exch, newOrder)
+	}
+
+	// Orders are not sent while the exchange announces maintenance or only
+	// accepts cancellations
+	status, err := exchange.CheckSystemStatus(ctx, exch, newOrder.AssetType)
+	if err == nil && !status.AllowsOrderSubmission() {
+		return nil, fmt.Errorf("order manager: exchange %s cannot submit orders while %s: %w",
+			newOrder.Exchange,
+			status,
+			exchange.ErrSystemUnavailable)
+	}
+
 	result, err := exch.SubmitOrder(ctx, newOrder)
 	if err != nil {
 		return nil, err
@@ -412,6 +575,14 @@ func (m *OrderManager) Submit(ctx context.Context, newOrder *order.Submit) (*Ord
 	return m.processSubmittedOrder(newOrder, result)
 }
 
+// generatesClientOrderIDs returns if the exchange accepts the UUID client
+// order IDs generated for submissions without one. Other exchanges define
+// their own client order ID formats, so only caller supplied IDs are sent
+func generatesClientOrderIDs(exch exchange.IBotExchange) bool {
+	b := exch.GetBase()
+	return b != nil && b.GetSupportedFeatures().RESTCapabilities.ClientOrderID
+}
+
 // SubmitFakeOrder runs through the same process as order submission
 // but does not touch live endpoints
 func (m *OrderManager) SubmitFakeOrder(newOrder *order.Submit, resultingOrder order.SubmitResponse, checkExchangeLimits bool) (*OrderSubmitResponse, error) {
@@ -430,6 +601,12 @@ func (m *OrderManager) SubmitFakeOrder(newOrder *order.Submit, resultingOrder or
 	if err != nil {
 		return nil, err
 	}
+	if generatesClientOrderIDs(exch) {
+		err = newOrder.EnsureClientOrderID()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if checkExchangeLimits {
 		// Checks for exchange min max limits for order amounts before order
@@ -536,7 +713,7 @@ func (m *OrderManager) processSubmittedOrder(newOrder *order.Submit, result orde
 	if result.FullyMatched {
 		status = order.Filled
 	}
-	err = m.orderStore.add(&order.Detail{
+	det := &order.Detail{
 		ImmediateOrCancel: newOrder.ImmediateOrCancel,
 		HiddenOrder:       newOrder.HiddenOrder,
 		FillOrKill:        newOrder.FillOrKill,
@@ -565,10 +742,13 @@ func (m *OrderManager) processSubmittedOrder(newOrder *order.Submit, result orde
 		LastUpdated:       time.Now(),
 		Pair:              newOrder.Pair,
 		Leverage:          newOrder.Leverage,
-	})
+	}
+	persist := det.Copy()
+	err = m.orderStore.add(det)
 	if err != nil {
 		return nil, fmt.Errorf("unable to add %v order %v to orderStore: %s", newOrder.Exchange, result.OrderID, err)
 	}
+	m.persistOrderAsync(&persist)
 
 	return &OrderSubmitResponse{
 		SubmitResponse: order.SubmitResponse{
@@ -576,11 +756,13 @@ func (m *OrderManager) processSubmittedOrder(newOrder *order.Submit, result orde
 			OrderID:       result.OrderID,
 		},
 		InternalOrderID: id.String(),
+		ClientOrderID:   newOrder.ClientOrderID,
 	}, nil
 }
 
 // processOrders iterates over all exchange orders via API
-// and adds them to the internal order store
+// and adds them to the internal order store. When paper trading, exchanges
+// are not queried and resting paper orders are filled instead
 func (m *OrderManager) processOrders() {
 	if !atomic.CompareAndSwapInt32(&m.processingOrders, 0, 1) {
 		return
@@ -588,6 +770,10 @@ func (m *OrderManager) processOrders() {
 	defer func() {
 		atomic.StoreInt32(&m.processingOrders, 0)
 	}()
+	if m.paperTrader != nil {
+		m.processPaperOrders()
+		return
+	}
 	exchanges, err := m.orderStore.exchangeManager.GetExchanges()
 	if err != nil {
 		log.Errorf(log.OrderMgr, "Order manager cannot get exchanges: %v", err)
@@ -604,6 +790,17 @@ func (m *OrderManager) processOrders() {
 
 		supportedAssets := exchanges[i].GetAssetTypes(true)
 		for y := range supportedAssets {
+			status, err := exchange.CheckSystemStatus(request.WithConsumer(context.TODO(), request.OrderManagement), exchanges[i], supportedAssets[y])
+			if err == nil && !status.AllowsMarketData() {
+				if m.verbose {
+					log.Debugf(log.OrderMgr,
+						"Order manager: %s asset type %s system status is %s, skipping...",
+						exchanges[i].GetName(),
+						supportedAssets[y],
+						status)
+				}
+				continue
+			}
 			pairs, err := exchanges[i].GetEnabledPairs(supportedAssets[y])
 			if err != nil {
 				log.Errorf(log.OrderMgr,
@@ -640,7 +837,7 @@ func (m *OrderManager) processOrders() {
 				Pairs:     pairs,
 				AssetType: supportedAssets[y],
 			}
-			result, err := exchanges[i].GetActiveOrders(context.TODO(), &req)
+			result, err := exchanges[i].GetActiveOrders(request.WithConsumer(context.TODO(), request.OrderManagement), &req)
 			if err != nil {
 				log.Errorf(log.OrderMgr,
 					"Order manager: Unable to get active orders for %s and asset type %s: %s",
@@ -668,6 +865,7 @@ func (m *OrderManager) processOrders() {
 		}
 	}
 	wg.Wait()
+	m.persistOrders()
 }
 
 func (m *OrderManager) processMatchingOrders(exch exchange.IBotExchange, orders []order.Detail, requiresProcessing map[string]bool, wg *sync.WaitGroup) {
@@ -694,7 +892,7 @@ func (m *OrderManager) FetchAndUpdateExchangeOrder(exch exchange.IBotExchange, o
 	if ord == nil {
 		return errors.New("order manager: Order is nil")
 	}
-	fetchedOrder, err := exch.GetOrderInfo(context.TODO(), ord.ID, ord.Pair, assetType)
+	fetchedOrder, err := exch.GetOrderInfo(request.WithConsumer(context.TODO(), request.OrderManagement), ord.ID, ord.Pair, assetType)
 	if err != nil {
 		ord.Status = order.UnknownStatus
 		return err
@@ -743,6 +941,25 @@ func (m *OrderManager) GetByExchangeAndID(exchangeName, id string) (*order.Detai
 	return &cpy, nil
 }
 
+// GetByExchangeAndClientOrderID returns a copy of the most recently tracked
+// order for an exchange which was submitted with the client order ID
+func (m *OrderManager) GetByExchangeAndClientOrderID(exchangeName, clientOrderID string) (*order.Detail, error) {
+	if m == nil {
+		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+
+	o, err := m.orderStore.getByExchangeAndClientOrderID(exchangeName, clientOrderID)
+	if err != nil {
+		return nil, err
+	}
+	var cpy order.Detail
+	cpy.UpdateOrderFromDetail(o)
+	return &cpy, nil
+}
+
 // UpdateExistingOrder will update an existing order in the orderstore
 func (m *OrderManager) UpdateExistingOrder(od *order.Detail) error {
 	if m == nil {
@@ -801,6 +1018,291 @@ func (m *OrderManager) UpsertOrder(od *order.Detail) (resp *OrderUpsertResponse,
 	return upsertResponse, nil
 }
 
+// AddConditionalOrder stores an order which is submitted once the price of its
+// pair crosses the trigger price. Prices are monitored from the exchange's
+// ticker stream and checked against the latest ticker or orderbook on every
+// order manager cycle. If the price has already crossed the trigger price the
+// order is submitted immediately
+func (m *OrderManager) AddConditionalOrder(c *ConditionalOrder) (*ConditionalOrder, error) {
+	if m == nil {
+		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	if c == nil {
+		return nil, errNilConditionalOrder
+	}
+	if c.Condition != TriggerAbove && c.Condition != TriggerBelow {
+		return nil, fmt.Errorf("%w, received '%v'", errInvalidTriggerCondition, c.Condition)
+	}
+	if c.TriggerPrice <= 0 {
+		return nil, errInvalidTriggerPrice
+	}
+	if c.Order.Type != order.Market && c.Order.Type != order.Limit {
+		return nil, fmt.Errorf("%w, received '%v'", errInvalidConditionalOrderType, c.Order.Type)
+	}
+	err := m.validate(&c.Order)
+	if err != nil {
+		return nil, err
+	}
+	exch, err := m.orderStore.exchangeManager.GetExchangeByName(c.Order.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+
+	stored := &ConditionalOrder{
+		ID:           id.String(),
+		Condition:    c.Condition,
+		TriggerPrice: c.TriggerPrice,
+		Order:        c.Order,
+		Status:       ConditionalOrderPending,
+		Created:      time.Now(),
+	}
+	stored.Order.Exchange = exch.GetName()
+
+	m.conditionalM.Lock()
+	if m.conditionals == nil {
+		m.conditionals = make(map[string]*ConditionalOrder)
+	}
+	m.conditionals[stored.ID] = stored
+	resp := *stored
+	err = m.watchConditionalOrders(stored.Order.Exchange)
+	m.conditionalM.Unlock()
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Order manager unable to stream %s tickers for conditional orders, falling back to polling: %v",
+			stored.Order.Exchange, err)
+	}
+
+	if m.verbose {
+		log.Debugf(log.OrderMgr, "Order manager added conditional order %s to %s %s %s %s when price is %s %v",
+			stored.ID,
+			stored.Order.Side,
+			stored.Order.Exchange,
+			stored.Order.AssetType,
+			stored.Order.Pair,
+			stored.Condition,
+			stored.TriggerPrice)
+	}
+
+	price, err := getConditionalOrderPrice(stored.Order.Exchange, stored.Order.Pair, stored.Order.AssetType)
+	if err == nil {
+		m.checkConditionalOrders(stored.Order.Exchange, stored.Order.Pair, stored.Order.AssetType, price)
+	}
+	return &resp, nil
+}
+
+// GetConditionalOrders returns all conditional orders ordered by creation
+// time, optionally filtered by exchange
+func (m *OrderManager) GetConditionalOrders(exchangeName string) ([]ConditionalOrder, error) {
+	if m == nil {
+		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	m.conditionalM.Lock()
+	resp := make([]ConditionalOrder, 0, len(m.conditionals))
+	for _, c := range m.conditionals {
+		if exchangeName != "" && !strings.EqualFold(c.Order.Exchange, exchangeName) {
+			continue
+		}
+		resp = append(resp, *c)
+	}
+	m.conditionalM.Unlock()
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].Created.Before(resp[j].Created)
+	})
+	return resp, nil
+}
+
+// CancelConditionalOrder cancels a pending conditional order so that it will
+// not be submitted
+func (m *OrderManager) CancelConditionalOrder(id string) error {
+	if m == nil {
+		return fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	m.conditionalM.Lock()
+	defer m.conditionalM.Unlock()
+	c, ok := m.conditionals[id]
+	if !ok {
+		return fmt.Errorf("%w %s", errConditionalOrderNotFound, id)
+	}
+	if c.Status != ConditionalOrderPending {
+		return fmt.Errorf("%w, %s is %s", errConditionalOrderNotPending, id, c.Status)
+	}
+	c.Status = ConditionalOrderCancelled
+	return nil
+}
+
+// watchConditionalOrders subscribes to an exchange's ticker changes if it is
+// not already being watched, the conditional lock must be held by the caller
+func (m *OrderManager) watchConditionalOrders(exchangeName string) error {
+	if m.conditionalWatchers == nil {
+		m.conditionalWatchers = make(map[string]*ticker.ChangeSubscription)
+	}
+	key := strings.ToLower(exchangeName)
+	if _, ok := m.conditionalWatchers[key]; ok {
+		return nil
+	}
+	sub, err := ticker.SubscribeTickerChanges(exchangeName, currency.Pair{}, "")
+	if err != nil {
+		return err
+	}
+	m.conditionalWatchers[key] = sub
+	go m.processConditionalTickers(key, sub, m.shutdown)
+	return nil
+}
+
+// processConditionalTickers checks conditional orders against every ticker
+// update until the order manager is shutdown
+func (m *OrderManager) processConditionalTickers(key string, sub *ticker.ChangeSubscription, shutdown <-chan struct{}) {
+	defer func() {
+		m.conditionalM.Lock()
+		if m.conditionalWatchers[key] == sub {
+			delete(m.conditionalWatchers, key)
+		}
+		m.conditionalM.Unlock()
+		if err := sub.Unsubscribe(); err != nil {
+			log.Errorf(log.OrderMgr, "Order manager unable to unsubscribe from %s tickers: %v", key, err)
+		}
+	}()
+	for {
+		select {
+		case <-shutdown:
+			return
+		case p, ok := <-sub.Updates():
+			if !ok {
+				return
+			}
+			price := tickerPrice(p)
+			if price > 0 {
+				m.checkConditionalOrders(p.ExchangeName, p.Pair, p.AssetType, price)
+			}
+		}
+	}
+}
+
+// processConditionalOrders checks all pending conditional orders against the
+// latest stored ticker or orderbook price so that exchanges without a ticker
+// stream are still monitored
+func (m *OrderManager) processConditionalOrders() {
+	type item struct {
+		exchange string
+		pair     currency.Pair
+		asset    asset.Item
+	}
+	var items []item
+	m.conditionalM.Lock()
+	for _, c := range m.conditionals {
+		if c.Status != ConditionalOrderPending {
+			continue
+		}
+		i := item{c.Order.Exchange, c.Order.Pair, c.Order.AssetType}
+		var found bool
+		for j := range items {
+			if items[j].exchange == i.exchange && items[j].asset == i.asset && items[j].pair.Equal(i.pair) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			items = append(items, i)
+		}
+	}
+	m.conditionalM.Unlock()
+	for i := range items {
+		price, err := getConditionalOrderPrice(items[i].exchange, items[i].pair, items[i].asset)
+		if err != nil {
+			if m.verbose {
+				log.Debugf(log.OrderMgr, "Order manager unable to check conditional orders for %s %s %s: %v",
+					items[i].exchange, items[i].asset, items[i].pair, err)
+			}
+			continue
+		}
+		m.checkConditionalOrders(items[i].exchange, items[i].pair, items[i].asset, price)
+	}
+}
+
+// checkConditionalOrders submits any pending conditional orders for the
+// exchange, pair and asset whose trigger price has been crossed
+func (m *OrderManager) checkConditionalOrders(exchangeName string, cp currency.Pair, a asset.Item, price float64) {
+	var triggered []*ConditionalOrder
+	m.conditionalM.Lock()
+	for _, c := range m.conditionals {
+		if c.Status != ConditionalOrderPending ||
+			c.Order.AssetType != a ||
+			!c.Order.Pair.Equal(cp) ||
+			!strings.EqualFold(c.Order.Exchange, exchangeName) {
+			continue
+		}
+		if (c.Condition == TriggerAbove && price >= c.TriggerPrice) ||
+			(c.Condition == TriggerBelow && price <= c.TriggerPrice) {
+			// the status is set before releasing the lock so that concurrent
+			// price updates cannot submit the order twice
+			c.Status = ConditionalOrderTriggered
+			c.TriggeredPrice = price
+			c.Triggered = time.Now()
+			triggered = append(triggered, c)
+		}
+	}
+	submissions := make([]order.Submit, len(triggered))
+	for i := range triggered {
+		submissions[i] = triggered[i].Order
+	}
+	m.conditionalM.Unlock()
+
+	for i := range triggered {
+		log.Infof(log.OrderMgr, "Order manager conditional order %s triggered at %v, submitting %s %s order",
+			triggered[i].ID, price, submissions[i].Side, submissions[i].Type)
+		resp, err := m.Submit(request.WithConsumer(context.TODO(), request.OrderManagement), &submissions[i])
+		m.conditionalM.Lock()
+		if err != nil {
+			triggered[i].Status = ConditionalOrderFailed
+			triggered[i].Error = err.Error()
+			log.Errorf(log.OrderMgr, "Order manager conditional order %s failed to submit: %v", triggered[i].ID, err)
+		} else {
+			triggered[i].OrderID = resp.OrderID
+		}
+		m.conditionalM.Unlock()
+	}
+}
+
+// getConditionalOrderPrice returns the latest price of a pair from the ticker
+// store, falling back to the mid price of the orderbook
+func getConditionalOrderPrice(exchangeName string, cp currency.Pair, a asset.Item) (float64, error) {
+	t, err := ticker.GetTicker(exchangeName, cp, a)
+	if err == nil {
+		if price := tickerPrice(t); price > 0 {
+			return price, nil
+		}
+	}
+	ob, err := orderbook.Get(exchangeName, cp, a)
+	if err == nil && len(ob.Bids) > 0 && len(ob.Asks) > 0 {
+		return (ob.Bids[0].Price + ob.Asks[0].Price) / 2, nil
+	}
+	return 0, fmt.Errorf("%w for %s %s %s", errNoConditionalOrderPriceFound, exchangeName, a, cp)
+}
+
+// tickerPrice returns the last price of a ticker, falling back to the mid
+// price when no last price is set
+func tickerPrice(p *ticker.Price) float64 {
+	if p.Last > 0 {
+		return p.Last
+	}
+	if p.Bid > 0 && p.Ask > 0 {
+		return (p.Bid + p.Ask) / 2
+	}
+	return 0
+}
+
 // get returns all orders for all exchanges
 // should not be exported as it can have large impact if used improperly
 func (s *store) get() map[string][]*order.Detail {
@@ -827,6 +1329,70 @@ func (s *store) getByExchangeAndID(exchange, id string) (*order.Detail, error) {
 	return nil, ErrOrderNotFound
 }
 
+// getByExchangeAndClientOrderID returns the most recently tracked order by
+// exchange and client order id
+func (s *store) getByExchangeAndClientOrderID(exchange, clientOrderID string) (*order.Detail, error) {
+	if clientOrderID == "" {
+		return nil, ErrOrderIDCannotBeEmpty
+	}
+	s.m.RLock()
+	defer s.m.RUnlock()
+	r, ok := s.Orders[strings.ToLower(exchange)]
+	if !ok {
+		return nil, ErrExchangeNotFound
+	}
+	for x := len(r) - 1; x >= 0; x-- {
+		if r[x].ClientOrderID == clientOrderID {
+			return r[x], nil
+		}
+	}
+	return nil, ErrOrderNotFound
+}
+
+// reserveClientOrderID marks a client order ID as in flight for an exchange.
+// An error is returned if the ID is already in flight or is held by an active
+// order, as exchanges will either reject the submission or it is a duplicate
+func (s *store) reserveClientOrderID(exchange, clientOrderID string) error {
+	if clientOrderID == "" {
+		return nil
+	}
+	lName := strings.ToLower(exchange)
+	s.m.Lock()
+	defer s.m.Unlock()
+	if _, ok := s.inFlight[lName][clientOrderID]; ok {
+		return ErrDuplicateClientOrderID
+	}
+	r := s.Orders[lName]
+	for x := range r {
+		if r[x].ClientOrderID == clientOrderID && r[x].IsActive() {
+			return ErrDuplicateClientOrderID
+		}
+	}
+	if s.inFlight == nil {
+		s.inFlight = make(map[string]map[string]struct{})
+	}
+	if s.inFlight[lName] == nil {
+		s.inFlight[lName] = make(map[string]struct{})
+	}
+	s.inFlight[lName][clientOrderID] = struct{}{}
+	return nil
+}
+
+// releaseClientOrderID removes an in flight client order ID reservation
+func (s *store) releaseClientOrderID(exchange, clientOrderID string) {
+	s.m.Lock()
+	delete(s.inFlight[strings.ToLower(exchange)], clientOrderID)
+	s.m.Unlock()
+}
+
+// matchByClientOrderID correlates an order update with a tracked order by
+// client order ID when either has not yet been assigned an exchange order ID
+func matchByClientOrderID(tracked, update *order.Detail) bool {
+	return update.ClientOrderID != "" &&
+		tracked.ClientOrderID == update.ClientOrderID &&
+		(tracked.ID == "" || update.ID == "")
+}
+
 // updateExisting checks if an order exists in the orderstore
 // and then updates it
 func (s *store) updateExisting(od *order.Detail) error {
@@ -837,7 +1403,7 @@ func (s *store) updateExisting(od *order.Detail) error {
 		return ErrExchangeNotFound
 	}
 	for x := range r {
-		if r[x].ID == od.ID {
+		if r[x].ID == od.ID || matchByClientOrderID(r[x], od) {
 			r[x].UpdateOrderFromDetail(od)
 			return nil
 		}
@@ -888,7 +1454,7 @@ func (s *store) upsert(od *order.Detail) (resp *OrderUpsertResponse, err error)
 		return resp, nil
 	}
 	for x := range r {
-		if r[x].ID == od.ID {
+		if r[x].ID == od.ID || matchByClientOrderID(r[x], od) {
 			r[x].UpdateOrderFromDetail(od)
 			resp = &OrderUpsertResponse{
 				OrderDetails: r[x].Copy(),
@@ -7,13 +7,16 @@ import (
 	"testing"
 	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/convert"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 )
 
 // omfExchange aka ordermanager fake exchange overrides exchange functions
@@ -88,6 +91,24 @@ func (f omfExchange) ModifyOrder(ctx context.Context, action *order.Modify) (ord
 	return ans, nil
 }
 
+// omfMaintenanceExchange is an ordermanager fake exchange whose system
+// status reports maintenance
+type omfMaintenanceExchange struct {
+	omfExchange
+}
+
+// GetSystemStatus overrides testExchange's system status function to report
+// maintenance with no API calls required
+func (f omfMaintenanceExchange) GetSystemStatus(_ context.Context, _ asset.Item) (*exchange.SystemStatusResponse, error) {
+	return &exchange.SystemStatusResponse{Status: exchange.SystemStatusMaintenance}, nil
+}
+
+// CanTradePair overrides testExchange's currency state check so that the
+// exchange does not need to be set up
+func (f omfMaintenanceExchange) CanTradePair(_ currency.Pair, _ asset.Item) error {
+	return nil
+}
+
 func TestSetupOrderManager(t *testing.T) {
 	_, err := SetupOrderManager(nil, nil, nil, false)
 	if !errors.Is(err, errNilExchangeManager) {
@@ -201,6 +222,10 @@ func OrdersSetup(t *testing.T) *OrderManager {
 		t.Fatal(err)
 	}
 
+	exch.GetBase().Features.Supports.OrderAmendment = order.AmendCapabilities{
+		Price:  true,
+		Amount: true,
+	}
 	fakeExchange := omfExchange{
 		IBotExchange: exch,
 	}
@@ -666,6 +691,58 @@ func TestSubmit(t *testing.T) {
 	}
 }
 
+func TestSubmitAndCancelDuringMaintenance(t *testing.T) {
+	var wg sync.WaitGroup
+	em := SetupExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exch.SetDefaults()
+	em.Add(omfMaintenanceExchange{omfExchange{IBotExchange: exch}})
+	m, err := SetupOrderManager(em, &CommunicationManager{}, &wg, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	m.started = 1
+
+	pair, err := currency.NewPairFromString("BTCUSD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = m.Submit(context.Background(), &order.Submit{
+		Exchange:  testExchange,
+		Pair:      pair,
+		AssetType: asset.Spot,
+		Side:      order.Buy,
+		Type:      order.Market,
+		Amount:    1,
+	})
+	if !errors.Is(err, exchange.ErrSystemUnavailable) {
+		t.Errorf("error '%v', expected '%v'", err, exchange.ErrSystemUnavailable)
+	}
+
+	err = m.orderStore.add(&order.Detail{
+		Exchange:  testExchange,
+		ID:        "TestSubmitAndCancelDuringMaintenance",
+		Pair:      pair,
+		AssetType: asset.Spot,
+		Status:    order.New,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	err = m.Cancel(context.Background(), &order.Cancel{
+		Exchange:  testExchange,
+		ID:        "TestSubmitAndCancelDuringMaintenance",
+		Pair:      pair,
+		AssetType: asset.Spot,
+	})
+	if !errors.Is(err, exchange.ErrSystemUnavailable) {
+		t.Errorf("error '%v', expected '%v'", err, exchange.ErrSystemUnavailable)
+	}
+}
+
 func TestOrderManager_Modify(t *testing.T) {
 	pair := currency.Pair{
 		Base:  currency.NewCode("XXXXX"),
@@ -1115,6 +1192,42 @@ func TestFetchAndUpdateExchangeOrder(t *testing.T) {
 	}
 }
 
+func TestCheckOrderbookDepth(t *testing.T) {
+	t.Parallel()
+	m := &OrderManager{}
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	s := &order.Submit{Exchange: "depthchecker", Pair: p, AssetType: asset.Spot, Side: order.Buy, Type: order.Market, Amount: 2}
+	err := m.checkOrderbookDepth(s)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	book := &orderbook.Base{
+		Exchange: "depthchecker",
+		Pair:     p,
+		Asset:    asset.Spot,
+		Bids:     orderbook.Items{{Price: 99, Amount: 1}},
+		Asks:     orderbook.Items{{Price: 100, Amount: 1}, {Price: 101, Amount: 2}},
+	}
+	err = book.Process()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = m.checkOrderbookDepth(s)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	s.Side = order.Sell
+	err = m.checkOrderbookDepth(s)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	m.SetDepthRejection(true)
+	err = m.checkOrderbookDepth(s)
+	if !errors.Is(err, errInsufficientOrderbookDepth) {
+		t.Errorf("received '%v' expected '%v'", err, errInsufficientOrderbookDepth)
+	}
+}
+
 func Test_getActiveOrders(t *testing.T) {
 	m := OrdersSetup(t)
 	var err error
@@ -1152,3 +1265,374 @@ func Test_getActiveOrders(t *testing.T) {
 		t.Errorf("Test_getActiveOrders - Expected 0 results, got: %d", len(res))
 	}
 }
+
+// coExchange aka conditional order fake exchange accepts market orders and
+// rejects limit orders without any API calls
+type coExchange struct {
+	exchange.IBotExchange
+	base exchange.Base
+}
+
+func (c *coExchange) GetBase() *exchange.Base {
+	return &c.base
+}
+
+func (c *coExchange) GetSystemStatus(context.Context, asset.Item) (*exchange.SystemStatusResponse, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+func (c *coExchange) GetName() string {
+	return "conditionalexchange"
+}
+
+func (c *coExchange) CheckOrderExecutionLimits(asset.Item, currency.Pair, float64, float64, order.Type) error {
+	return nil
+}
+
+func (c *coExchange) CanTradePair(currency.Pair, asset.Item) error {
+	return nil
+}
+
+func (c *coExchange) SubmitOrder(_ context.Context, s *order.Submit) (order.SubmitResponse, error) {
+	if s.Type == order.Limit {
+		return order.SubmitResponse{}, errors.New("limit orders rejected")
+	}
+	return order.SubmitResponse{IsOrderPlaced: true, OrderID: "triggered"}, nil
+}
+
+func TestConditionalOrders(t *testing.T) {
+	t.Parallel()
+	em := SetupExchangeManager()
+	fake := &coExchange{}
+	em.Add(fake)
+	var wg sync.WaitGroup
+	m, err := SetupOrderManager(em, &CommunicationManager{}, &wg, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = m.AddConditionalOrder(nil)
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemNotStarted)
+	}
+	m.started = 1
+
+	cp := currency.NewPair(currency.XRP, currency.USD)
+	_, err = m.AddConditionalOrder(nil)
+	if !errors.Is(err, errNilConditionalOrder) {
+		t.Errorf("received '%v' expected '%v'", err, errNilConditionalOrder)
+	}
+	c := &ConditionalOrder{}
+	_, err = m.AddConditionalOrder(c)
+	if !errors.Is(err, errInvalidTriggerCondition) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidTriggerCondition)
+	}
+	c.Condition = TriggerBelow
+	_, err = m.AddConditionalOrder(c)
+	if !errors.Is(err, errInvalidTriggerPrice) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidTriggerPrice)
+	}
+	c.TriggerPrice = 100
+	c.Order.Type = order.Stop
+	_, err = m.AddConditionalOrder(c)
+	if !errors.Is(err, errInvalidConditionalOrderType) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidConditionalOrderType)
+	}
+	c.Order = order.Submit{
+		Exchange:  fake.GetName(),
+		Pair:      cp,
+		AssetType: asset.Spot,
+		Side:      order.Sell,
+		Type:      order.Market,
+		Amount:    1,
+	}
+	stop, err := m.AddConditionalOrder(c)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if stop.ID == "" || stop.Status != ConditionalOrderPending {
+		t.Errorf("unexpected conditional order %+v", stop)
+	}
+
+	c.Condition = TriggerAbove
+	c.TriggerPrice = 200
+	c.Order.Side = order.Buy
+	takeProfit, err := m.AddConditionalOrder(c)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = m.CancelConditionalOrder(takeProfit.ID)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	err = m.CancelConditionalOrder(takeProfit.ID)
+	if !errors.Is(err, errConditionalOrderNotPending) {
+		t.Errorf("received '%v' expected '%v'", err, errConditionalOrderNotPending)
+	}
+	err = m.CancelConditionalOrder("bad")
+	if !errors.Is(err, errConditionalOrderNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, errConditionalOrderNotFound)
+	}
+
+	c.Order.Type = order.Limit
+	c.Order.Price = 210
+	rejected, err := m.AddConditionalOrder(c)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	// neither order triggers between the trigger prices
+	m.checkConditionalOrders(fake.GetName(), cp, asset.Spot, 150)
+	err = ticker.ProcessTicker(&ticker.Price{
+		ExchangeName: fake.GetName(),
+		Pair:         cp,
+		AssetType:    asset.Spot,
+		Last:         90,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	var orders []ConditionalOrder
+	for i := 0; i < 100; i++ {
+		orders, err = m.GetConditionalOrders(fake.GetName())
+		if !errors.Is(err, nil) {
+			t.Fatalf("received '%v' expected '%v'", err, nil)
+		}
+		if orders[0].OrderID != "" {
+			break
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+	if len(orders) != 3 || orders[0].ID != stop.ID {
+		t.Fatalf("unexpected conditional orders %+v", orders)
+	}
+	if orders[0].Status != ConditionalOrderTriggered || orders[0].TriggeredPrice != 90 || orders[0].OrderID != "triggered" {
+		t.Errorf("unexpected conditional order %+v", orders[0])
+	}
+	if orders[1].Status != ConditionalOrderCancelled {
+		t.Errorf("received '%v' expected '%v'", orders[1].Status, ConditionalOrderCancelled)
+	}
+	if orders[2].Status != ConditionalOrderPending {
+		t.Errorf("received '%v' expected '%v'", orders[2].Status, ConditionalOrderPending)
+	}
+
+	m.checkConditionalOrders(fake.GetName(), cp, asset.Spot, 250)
+	orders, err = m.GetConditionalOrders("")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if orders[2].ID != rejected.ID || orders[2].Status != ConditionalOrderFailed || orders[2].Error == "" {
+		t.Errorf("unexpected conditional order %+v", orders[2])
+	}
+
+	orders, err = m.GetConditionalOrders("bad")
+	if !errors.Is(err, nil) || len(orders) != 0 {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", err, len(orders), nil, 0)
+	}
+	err = m.Stop()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+}
+
+func TestClientOrderIDs(t *testing.T) {
+	t.Parallel()
+	em := SetupExchangeManager()
+	fake := &coExchange{}
+	fake.base.Features.Supports.RESTCapabilities.ClientOrderID = true
+	em.Add(fake)
+	var wg sync.WaitGroup
+	m, err := SetupOrderManager(em, &CommunicationManager{}, &wg, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = m.GetByExchangeAndClientOrderID(fake.GetName(), "")
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemNotStarted)
+	}
+	m.started = 1
+
+	s := &order.Submit{
+		Exchange:  fake.GetName(),
+		Pair:      currency.NewPair(currency.BTC, currency.USD),
+		AssetType: asset.Spot,
+		Side:      order.Buy,
+		Type:      order.Market,
+		Amount:    1,
+	}
+	resp, err := m.Submit(context.Background(), s)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if resp.ClientOrderID == "" || resp.ClientOrderID != s.ClientOrderID {
+		t.Fatal("expected generated client order ID to be returned")
+	}
+
+	o, err := m.GetByExchangeAndClientOrderID(fake.GetName(), resp.ClientOrderID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if o.ID != resp.OrderID || o.InternalOrderID != resp.InternalOrderID {
+		t.Error("expected submitted order to be found by client order ID")
+	}
+	_, err = m.GetByExchangeAndClientOrderID(fake.GetName(), "")
+	if !errors.Is(err, ErrOrderIDCannotBeEmpty) {
+		t.Errorf("received '%v' expected '%v'", err, ErrOrderIDCannotBeEmpty)
+	}
+	_, err = m.GetByExchangeAndClientOrderID(fake.GetName(), "unknown")
+	if !errors.Is(err, ErrOrderNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, ErrOrderNotFound)
+	}
+
+	_, err = m.Submit(context.Background(), s)
+	if !errors.Is(err, ErrDuplicateClientOrderID) {
+		t.Errorf("received '%v' expected '%v'", err, ErrDuplicateClientOrderID)
+	}
+
+	err = m.orderStore.reserveClientOrderID(fake.GetName(), "inflight")
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	err = m.orderStore.reserveClientOrderID(fake.GetName(), "inflight")
+	if !errors.Is(err, ErrDuplicateClientOrderID) {
+		t.Errorf("received '%v' expected '%v'", err, ErrDuplicateClientOrderID)
+	}
+	m.orderStore.releaseClientOrderID(fake.GetName(), "inflight")
+	err = m.orderStore.reserveClientOrderID(fake.GetName(), "inflight")
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+
+	// Websocket updates without an exchange order ID are correlated by
+	// client order ID
+	upsert, err := m.UpsertOrder(&order.Detail{
+		Exchange:      fake.GetName(),
+		ClientOrderID: resp.ClientOrderID,
+		Status:        order.Filled,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if upsert.IsNewOrder || upsert.OrderDetails.ID != resp.OrderID {
+		t.Error("expected update to be correlated by client order ID")
+	}
+
+	// The client order ID may be reused once the order is no longer active,
+	// the fake exchange reuses its order ID so the store rejects it instead
+	_, err = m.Submit(context.Background(), s)
+	if err == nil || errors.Is(err, ErrDuplicateClientOrderID) {
+		t.Errorf("received '%v' expected order already exists", err)
+	}
+
+	err = m.Cancel(context.Background(), &order.Cancel{
+		Exchange:      fake.GetName(),
+		ClientOrderID: "unknown",
+	})
+	if !errors.Is(err, ErrOrderNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, ErrOrderNotFound)
+	}
+
+	// Client order IDs are not generated for exchanges without support for
+	// them, though supplied IDs are still checked for duplicates
+	fake.base.Features.Supports.RESTCapabilities.ClientOrderID = false
+	s = &order.Submit{
+		Exchange:  fake.GetName(),
+		Pair:      currency.NewPair(currency.BTC, currency.USD),
+		AssetType: asset.Spot,
+		Side:      order.Buy,
+		Type:      order.Market,
+		Amount:    1,
+	}
+	_, err = m.Submit(context.Background(), s)
+	if err == nil || errors.Is(err, ErrDuplicateClientOrderID) {
+		t.Errorf("received '%v' expected order already exists", err)
+	}
+	if s.ClientOrderID != "" {
+		t.Errorf("received '%v' expected no generated client order ID", s.ClientOrderID)
+	}
+	s.ClientOrderID = "inflight"
+	_, err = m.Submit(context.Background(), s)
+	if !errors.Is(err, ErrDuplicateClientOrderID) {
+		t.Errorf("received '%v' expected '%v'", err, ErrDuplicateClientOrderID)
+	}
+}
+
+// crExchange aka cancel-replace fake exchange cannot amend orders, so they
+// are cancelled and replaced without any API calls
+type crExchange struct {
+	coExchange
+	executed float64
+}
+
+func (c *crExchange) GetName() string {
+	return "cancelreplaceexchange"
+}
+
+func (c *crExchange) CancelOrder(context.Context, *order.Cancel) error {
+	return nil
+}
+
+func (c *crExchange) GetOrderInfo(_ context.Context, id string, _ currency.Pair, _ asset.Item) (order.Detail, error) {
+	return order.Detail{ID: id, ExecutedAmount: c.executed, Status: order.Cancelled}, nil
+}
+
+func (c *crExchange) SubmitOrder(context.Context, *order.Submit) (order.SubmitResponse, error) {
+	return order.SubmitResponse{IsOrderPlaced: true, OrderID: "replacement"}, nil
+}
+
+func TestModifyCancelReplace(t *testing.T) {
+	t.Parallel()
+	em := SetupExchangeManager()
+	fake := &crExchange{executed: 2}
+	em.Add(fake)
+	var wg sync.WaitGroup
+	m, err := SetupOrderManager(em, &CommunicationManager{}, &wg, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	m.started = 1
+	err = m.orderStore.add(&order.Detail{
+		PostOnly:       true,
+		Exchange:       fake.GetName(),
+		ID:             "original",
+		Price:          8,
+		Amount:         5,
+		ExecutedAmount: 1,
+		Type:           order.Limit,
+		Side:           order.Buy,
+		Status:         order.Active,
+		AssetType:      asset.Spot,
+		Pair:           currency.NewPair(currency.BTC, currency.USD),
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	resp, err := m.Modify(context.Background(), &order.Modify{
+		Exchange:  fake.GetName(),
+		ID:        "original",
+		Price:     9,
+		AssetType: asset.Spot,
+		Pair:      currency.NewPair(currency.BTC, currency.USD),
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !resp.CancelReplaced || resp.OrderID != "replacement" {
+		t.Errorf("received '%+v' expected cancel-replace", resp)
+	}
+
+	original, err := m.orderStore.getByExchangeAndID(fake.GetName(), "original")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if original.Status != order.Cancelled || original.ExecutedAmount != 2 || !original.PostOnly || original.Price != 8 {
+		t.Errorf("received '%+v' expected the original order to be cancelled", original)
+	}
+	replacement, err := m.orderStore.getByExchangeAndID(fake.GetName(), "replacement")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if replacement.Price != 9 || replacement.Amount != 3 || !replacement.PostOnly || replacement.InternalOrderID == original.InternalOrderID {
+		t.Errorf("received '%+v' expected the replacement to be tracked by submit", replacement)
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 )
 
 // OrderManagerName is an exported subsystem name
@@ -14,15 +15,47 @@ const OrderManagerName = "orders"
 
 // vars for the fund manager package
 var (
-	orderManagerDelay = time.Second * 10
+	orderManagerDelay             = time.Second * 10
+	errInsufficientOrderbookDepth = errors.New("insufficient orderbook depth")
 	// ErrOrdersAlreadyExists occurs when the order already exists in the manager
 	ErrOrdersAlreadyExists = errors.New("order already exists")
 	// ErrOrderNotFound occurs when an order is not found in the orderstore
-	ErrOrderNotFound            = errors.New("order does not exist")
+	ErrOrderNotFound = errors.New("order does not exist")
+	// ErrDuplicateClientOrderID occurs when an order is submitted with the
+	// client order ID of an active or in flight order
+	ErrDuplicateClientOrderID   = errors.New("duplicate client order ID")
 	errNilCommunicationsManager = errors.New("cannot start with nil communications manager")
 	// ErrOrderIDCannotBeEmpty occurs when an order does not have an ID
-	ErrOrderIDCannotBeEmpty = errors.New("orderID cannot be empty")
-	errNilOrder             = errors.New("nil order received")
+	ErrOrderIDCannotBeEmpty         = errors.New("orderID cannot be empty")
+	errNilOrder                     = errors.New("nil order received")
+	errConditionalOrderNotFound     = errors.New("conditional order does not exist")
+	errInvalidTriggerCondition      = errors.New("trigger condition must be ABOVE or BELOW")
+	errInvalidTriggerPrice          = errors.New("trigger price must be greater than zero")
+	errInvalidConditionalOrderType  = errors.New("conditional orders must be MARKET or LIMIT")
+	errConditionalOrderNotPending   = errors.New("conditional order is no longer pending")
+	errNilConditionalOrder          = errors.New("nil conditional order received")
+	errNoConditionalOrderPriceFound = errors.New("no ticker or orderbook price found")
+)
+
+// ConditionalOrderCondition defines which direction the price must cross the
+// trigger price for a conditional order to be submitted
+type ConditionalOrderCondition string
+
+// Conditional order conditions
+const (
+	TriggerAbove ConditionalOrderCondition = "ABOVE"
+	TriggerBelow ConditionalOrderCondition = "BELOW"
+)
+
+// ConditionalOrderStatus defines the state of a conditional order
+type ConditionalOrderStatus string
+
+// Conditional order statuses
+const (
+	ConditionalOrderPending   ConditionalOrderStatus = "PENDING"
+	ConditionalOrderTriggered ConditionalOrderStatus = "TRIGGERED"
+	ConditionalOrderFailed    ConditionalOrderStatus = "FAILED"
+	ConditionalOrderCancelled ConditionalOrderStatus = "CANCELLED"
 )
 
 type orderManagerConfig struct {
@@ -33,6 +66,9 @@ type orderManagerConfig struct {
 	AllowedPairs           currency.Pairs
 	AllowedExchanges       []string
 	OrderSubmissionRetries int64
+	// RejectInsufficientDepth rejects market orders larger than the locally
+	// held orderbook's depth instead of logging a warning
+	RejectInsufficientDepth bool
 }
 
 // store holds all orders by exchange
@@ -42,6 +78,9 @@ type store struct {
 	commsManager    iCommsManager
 	exchangeManager iExchangeManager
 	wg              *sync.WaitGroup
+	// inFlight holds the client order IDs of submissions awaiting an
+	// exchange response keyed by lower case exchange name
+	inFlight map[string]map[string]struct{}
 }
 
 // OrderManager processes and stores orders across enabled exchanges
@@ -52,12 +91,37 @@ type OrderManager struct {
 	orderStore       store
 	cfg              orderManagerConfig
 	verbose          bool
+	paperTrader      *paperTrader
+	persistence      *orderPersistence
+
+	conditionalM        sync.Mutex
+	conditionals        map[string]*ConditionalOrder
+	conditionalWatchers map[string]*ticker.ChangeSubscription
+}
+
+// ConditionalOrder is an order held client side by the order manager which is
+// submitted once the price of its pair crosses the trigger price. This allows
+// stop orders to be used on exchanges which do not support them natively
+type ConditionalOrder struct {
+	ID           string
+	Condition    ConditionalOrderCondition
+	TriggerPrice float64
+	// Order is submitted via the order manager when triggered
+	Order          order.Submit
+	Status         ConditionalOrderStatus
+	TriggeredPrice float64
+	OrderID        string
+	Error          string
+	Created        time.Time
+	Triggered      time.Time
 }
 
-// OrderSubmitResponse contains the order response along with an internal order ID
+// OrderSubmitResponse contains the order response along with an internal order
+// ID and the client order ID the order was submitted with
 type OrderSubmitResponse struct {
 	order.SubmitResponse
 	InternalOrderID string
+	ClientOrderID   string
 }
 
 // OrderUpsertResponse contains a copy of the resulting order details and a bool
@@ -0,0 +1,224 @@
+package engine
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	dborder "github.com/thrasher-corp/gocryptotrader/database/repository/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// EnableOrderPersistence stores the order manager's active orders in the
+// database. Persisted orders are restored when the order manager starts and
+// are then reconciled against the exchange's open orders, so that working
+// orders are not lost or placed again after a restart
+func (m *OrderManager) EnableOrderPersistence(dcm iDatabaseConnectionManager) error {
+	if m == nil {
+		return fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 1 {
+		return fmt.Errorf("order manager %w", ErrSubSystemAlreadyStarted)
+	}
+	if m.paperTrader != nil {
+		return errPaperTradingPersistence
+	}
+	if dcm == nil {
+		return errNilDatabaseConnectionManager
+	}
+	db := dcm.GetInstance()
+	if db == nil {
+		return database.ErrNilInstance
+	}
+	m.persistence = &orderPersistence{
+		databaseConnectionInstance: db,
+		persisted:                  make(map[string]time.Time),
+		saver:                      dborder.Upsert,
+		deleter:                    dborder.Delete,
+		loader:                     dborder.GetAll,
+	}
+	return nil
+}
+
+// IsPersistingOrders returns whether active orders are stored in the database
+func (m *OrderManager) IsPersistingOrders() bool {
+	return m != nil && m.persistence != nil
+}
+
+// restorePersistedOrders adds orders stored in the database to the order
+// store. They are reconciled against the exchange when orders are processed
+func (m *OrderManager) restorePersistedOrders() {
+	p := m.persistence
+	if p == nil || !p.databaseConnectionInstance.IsConnected() {
+		return
+	}
+	p.m.Lock()
+	defer p.m.Unlock()
+	stored, err := p.loader()
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Order manager unable to load persisted orders: %v", err)
+		return
+	}
+	var restored int
+	for i := range stored {
+		od, err := persistedToOrder(&stored[i])
+		if err != nil {
+			log.Errorf(log.OrderMgr, "Order manager unable to restore persisted %s order %s: %v",
+				stored[i].Exchange, stored[i].OrderID, err)
+			continue
+		}
+		exch, err := m.orderStore.exchangeManager.GetExchangeByName(od.Exchange)
+		if err != nil {
+			log.Errorf(log.OrderMgr, "Order manager unable to restore persisted %s order %s: %v",
+				stored[i].Exchange, stored[i].OrderID, err)
+			continue
+		}
+		od.Exchange = exch.GetName()
+		err = m.orderStore.add(od)
+		if err != nil {
+			log.Errorf(log.OrderMgr, "Order manager unable to restore persisted %s order %s: %v",
+				stored[i].Exchange, stored[i].OrderID, err)
+			continue
+		}
+		p.persisted[od.InternalOrderID] = od.LastUpdated
+		restored++
+	}
+	if restored > 0 {
+		log.Infof(log.OrderMgr, "Order manager restored %d persisted order(s)", restored)
+	}
+}
+
+// persistOrders saves active orders which have changed since they were last
+// stored and removes orders which are no longer active from the database
+func (m *OrderManager) persistOrders() {
+	p := m.persistence
+	if p == nil || !p.databaseConnectionInstance.IsConnected() {
+		return
+	}
+	// orders being saved in the background are waited on so that the
+	// database is reconciled against every saved order
+	p.wg.Wait()
+	p.m.Lock()
+	defer p.m.Unlock()
+	active := m.orderStore.getActiveOrders(nil)
+	current := make(map[string]time.Time, len(active))
+	var changed []dborder.Details
+	for i := range active {
+		if active[i].InternalOrderID == "" || active[i].Pair.IsEmpty() || !active[i].AssetType.IsValid() {
+			if m.verbose {
+				log.Debugf(log.OrderMgr, "Order manager cannot persist %s order %s with missing pair or asset",
+					active[i].Exchange, active[i].ID)
+			}
+			continue
+		}
+		current[active[i].InternalOrderID] = active[i].LastUpdated
+		if lastUpdated, ok := p.persisted[active[i].InternalOrderID]; ok && lastUpdated.Equal(active[i].LastUpdated) {
+			continue
+		}
+		changed = append(changed, orderToPersisted(&active[i]))
+	}
+	if len(changed) > 0 {
+		if err := p.saver(changed...); err != nil {
+			log.Errorf(log.OrderMgr, "Order manager unable to persist orders: %v", err)
+			return
+		}
+	}
+	var inactive []string
+	for id := range p.persisted {
+		if _, ok := current[id]; !ok {
+			inactive = append(inactive, id)
+		}
+	}
+	if len(inactive) > 0 {
+		if err := p.deleter(inactive...); err != nil {
+			log.Errorf(log.OrderMgr, "Order manager unable to remove inactive persisted orders: %v", err)
+			return
+		}
+	}
+	p.persisted = current
+}
+
+// persistOrderAsync saves a single changed order, such as a newly submitted
+// order, in the background so that submission does not wait on the database
+// or scan the order store. Orders which are missed are saved on the next tick
+func (m *OrderManager) persistOrderAsync(od *order.Detail) {
+	p := m.persistence
+	if p == nil || !p.databaseConnectionInstance.IsConnected() {
+		return
+	}
+	if od.InternalOrderID == "" || od.Pair.IsEmpty() || !od.AssetType.IsValid() || !od.IsActive() {
+		return
+	}
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		p.m.Lock()
+		defer p.m.Unlock()
+		if err := p.saver(orderToPersisted(od)); err != nil {
+			log.Errorf(log.OrderMgr, "Order manager unable to persist %s order %s: %v", od.Exchange, od.ID, err)
+			return
+		}
+		p.persisted[od.InternalOrderID] = od.LastUpdated
+	}()
+}
+
+// orderToPersisted converts a tracked order to its database representation
+func orderToPersisted(d *order.Detail) dborder.Details {
+	date := d.Date
+	if date.IsZero() {
+		date = d.LastUpdated
+	}
+	if date.IsZero() {
+		date = time.Now()
+	}
+	return dborder.Details{
+		ID:              d.InternalOrderID,
+		Exchange:        d.Exchange,
+		OrderID:         d.ID,
+		ClientOrderID:   d.ClientOrderID,
+		Base:            d.Pair.Base.String(),
+		Quote:           d.Pair.Quote.String(),
+		Asset:           d.AssetType.String(),
+		Side:            d.Side.String(),
+		Type:            d.Type.String(),
+		Status:          d.Status.String(),
+		Price:           d.Price,
+		Amount:          d.Amount,
+		ExecutedAmount:  d.ExecutedAmount,
+		RemainingAmount: d.RemainingAmount,
+		Date:            date,
+		LastUpdated:     d.LastUpdated,
+	}
+}
+
+// persistedToOrder converts a stored order to an order which can be tracked
+// by the order store
+func persistedToOrder(d *dborder.Details) (*order.Detail, error) {
+	a, err := asset.New(d.Asset)
+	if err != nil {
+		return nil, err
+	}
+	// Order sides, types and statuses are stored in their string form so are
+	// restored without conversion
+	return &order.Detail{
+		Exchange:        d.Exchange,
+		InternalOrderID: d.ID,
+		ID:              d.OrderID,
+		ClientOrderID:   d.ClientOrderID,
+		Pair:            currency.NewPair(currency.NewCode(d.Base), currency.NewCode(d.Quote)),
+		AssetType:       a,
+		Side:            order.Side(d.Side),
+		Type:            order.Type(d.Type),
+		Status:          order.Status(d.Status),
+		Price:           d.Price,
+		Amount:          d.Amount,
+		ExecutedAmount:  d.ExecutedAmount,
+		RemainingAmount: d.RemainingAmount,
+		Date:            d.Date,
+		LastUpdated:     d.LastUpdated,
+	}, nil
+}
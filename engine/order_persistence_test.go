@@ -0,0 +1,197 @@
+package engine
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	dborder "github.com/thrasher-corp/gocryptotrader/database/repository/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func TestEnableOrderPersistence(t *testing.T) {
+	t.Parallel()
+	var m *OrderManager
+	err := m.EnableOrderPersistence(nil)
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+	if m.IsPersistingOrders() {
+		t.Error("expected nil order manager not to persist orders")
+	}
+
+	m = &OrderManager{started: 1}
+	err = m.EnableOrderPersistence(nil)
+	if !errors.Is(err, ErrSubSystemAlreadyStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemAlreadyStarted)
+	}
+
+	m.started = 0
+	m.paperTrader = &paperTrader{}
+	err = m.EnableOrderPersistence(nil)
+	if !errors.Is(err, errPaperTradingPersistence) {
+		t.Errorf("received '%v' expected '%v'", err, errPaperTradingPersistence)
+	}
+
+	m.paperTrader = nil
+	err = m.EnableOrderPersistence(nil)
+	if !errors.Is(err, errNilDatabaseConnectionManager) {
+		t.Errorf("received '%v' expected '%v'", err, errNilDatabaseConnectionManager)
+	}
+	err = m.EnableOrderPersistence(&DatabaseConnectionManager{})
+	if !errors.Is(err, database.ErrNilInstance) {
+		t.Errorf("received '%v' expected '%v'", err, database.ErrNilInstance)
+	}
+
+	dbInst := &database.Instance{}
+	err = dbInst.SetConfig(&database.Config{Enabled: true})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = dbInst.SetSQLiteConnection(&sql.DB{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = m.EnableOrderPersistence(&DatabaseConnectionManager{dbConn: dbInst, started: 1})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !m.IsPersistingOrders() {
+		t.Error("expected order manager to persist orders")
+	}
+}
+
+func TestOrderPersistence(t *testing.T) {
+	t.Parallel()
+	em := SetupExchangeManager()
+	fake := &coExchange{}
+	em.Add(fake)
+	var wg sync.WaitGroup
+	m, err := SetupOrderManager(em, &CommunicationManager{}, &wg, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	m.started = 1
+
+	dbInst := &database.Instance{}
+	dbInst.SetConnected(true)
+	date := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	var saved []dborder.Details
+	var deleted []string
+	m.persistence = &orderPersistence{
+		databaseConnectionInstance: dbInst,
+		persisted:                  make(map[string]time.Time),
+		saver: func(d ...dborder.Details) error {
+			saved = append(saved, d...)
+			return nil
+		},
+		deleter: func(ids ...string) error {
+			deleted = append(deleted, ids...)
+			return nil
+		},
+		loader: func() ([]dborder.Details, error) {
+			return []dborder.Details{
+				{
+					ID:            "restored",
+					Exchange:      "ConditionalExchange",
+					OrderID:       "1337",
+					ClientOrderID: "client1337",
+					Base:          "BTC",
+					Quote:         "USD",
+					Asset:         "spot",
+					Side:          order.Buy.String(),
+					Type:          order.Limit.String(),
+					Status:        order.New.String(),
+					Price:         100,
+					Amount:        1,
+					Date:          date,
+					LastUpdated:   date,
+				},
+				{ID: "unknownexchange", Exchange: "unknown", Asset: "spot"},
+				{ID: "invalidasset", Exchange: fake.GetName(), Asset: "invalid"},
+			}, nil
+		},
+	}
+
+	m.restorePersistedOrders()
+	od, err := m.orderStore.getByExchangeAndClientOrderID(fake.GetName(), "client1337")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if od.InternalOrderID != "restored" || od.ID != "1337" || od.Exchange != fake.GetName() ||
+		!od.Pair.Equal(currency.NewPair(currency.BTC, currency.USD)) || od.AssetType != asset.Spot ||
+		od.Side != order.Buy || od.Type != order.Limit || od.Status != order.New || !od.Date.Equal(date) {
+		t.Errorf("unexpected restored order %+v", od)
+	}
+	if len(m.persistence.persisted) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(m.persistence.persisted), 1)
+	}
+
+	// Restored orders prevent their client order ID being submitted again
+	_, err = m.Submit(context.Background(), &order.Submit{
+		Exchange:      fake.GetName(),
+		ClientOrderID: "client1337",
+		Pair:          currency.NewPair(currency.BTC, currency.USD),
+		AssetType:     asset.Spot,
+		Side:          order.Buy,
+		Type:          order.Market,
+		Amount:        1,
+	})
+	if !errors.Is(err, ErrDuplicateClientOrderID) {
+		t.Errorf("received '%v' expected '%v'", err, ErrDuplicateClientOrderID)
+	}
+
+	m.persistOrders()
+	if len(saved) != 0 || len(deleted) != 0 {
+		t.Error("expected unchanged orders not to be persisted")
+	}
+
+	resp, err := m.Submit(context.Background(), &order.Submit{
+		Exchange:  fake.GetName(),
+		Pair:      currency.NewPair(currency.BTC, currency.USD),
+		AssetType: asset.Spot,
+		Side:      order.Buy,
+		Type:      order.Market,
+		Amount:    1,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	m.persistence.wg.Wait()
+	if len(saved) != 1 || saved[0].ID != resp.InternalOrderID || saved[0].ClientOrderID != resp.ClientOrderID {
+		t.Fatalf("expected submitted order to be persisted, received %+v", saved)
+	}
+	if _, ok := m.persistence.persisted[resp.InternalOrderID]; !ok || len(m.persistence.persisted) != 2 {
+		t.Errorf("expected only the submitted order to be recorded, received %v", m.persistence.persisted)
+	}
+
+	err = m.orderStore.updateExisting(&order.Detail{
+		Exchange:    fake.GetName(),
+		ID:          "1337",
+		Status:      order.Cancelled,
+		LastUpdated: time.Now(),
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	m.persistOrders()
+	if len(deleted) != 1 || deleted[0] != "restored" {
+		t.Errorf("expected inactive order to be removed, received %v", deleted)
+	}
+	if len(saved) != 1 {
+		t.Errorf("received '%v' expected '%v'", len(saved), 1)
+	}
+
+	dbInst.SetConnected(false)
+	m.orderStore.Orders = make(map[string][]*order.Detail)
+	m.restorePersistedOrders()
+	if len(m.orderStore.Orders) != 0 {
+		t.Error("expected orders not to be restored without a database connection")
+	}
+}
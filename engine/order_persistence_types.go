@@ -0,0 +1,27 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	dborder "github.com/thrasher-corp/gocryptotrader/database/repository/order"
+)
+
+var errPaperTradingPersistence = errors.New("paper orders cannot be persisted")
+
+// orderPersistence stores the order manager's active orders in the database
+// so that working orders survive a restart
+type orderPersistence struct {
+	databaseConnectionInstance database.IDatabase
+	m                          sync.Mutex
+	// wg tracks orders being saved in the background
+	wg sync.WaitGroup
+	// persisted holds the last updated time of each order stored in the
+	// database keyed by internal order ID
+	persisted map[string]time.Time
+	saver     func(...dborder.Details) error
+	deleter   func(...string) error
+	loader    func() ([]dborder.Details, error)
+}
@@ -0,0 +1,279 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupOrderRouter creates an order router which reads orderbooks of the
+// exchange manager's exchanges and submits orders via the order manager
+func SetupOrderRouter(em iExchangeManager, om iOrderSubmitter, verbose bool) (*OrderRouter, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if om == nil {
+		return nil, errNilOrderManager
+	}
+	aggregator, err := SetupOrderbookAggregator(em, verbose)
+	if err != nil {
+		return nil, err
+	}
+	return &OrderRouter{
+		verbose:      verbose,
+		orderManager: om,
+		aggregator:   aggregator,
+	}, nil
+}
+
+// Start runs the subsystem
+func (r *OrderRouter) Start() error {
+	if r == nil {
+		return ErrNilSubsystem
+	}
+	if !atomic.CompareAndSwapInt32(&r.started, 0, 1) {
+		return ErrSubSystemAlreadyStarted
+	}
+	log.Debugf(log.OrderMgr, "Order router %v", MsgSubSystemStarted)
+	return nil
+}
+
+// IsRunning checks whether the subsystem is running
+func (r *OrderRouter) IsRunning() bool {
+	if r == nil {
+		return false
+	}
+	return atomic.LoadInt32(&r.started) == 1
+}
+
+// Stop stops the subsystem
+func (r *OrderRouter) Stop() error {
+	if r == nil {
+		return ErrNilSubsystem
+	}
+	if !atomic.CompareAndSwapInt32(&r.started, 1, 0) {
+		return ErrSubSystemNotStarted
+	}
+	log.Debugf(log.OrderMgr, "Order router %v", MsgSubSystemShutdown)
+	return nil
+}
+
+// RouteOrder determines the best execution route for an order by walking the
+// fee adjusted orderbooks of every eligible exchange. When the request allows
+// splitting, levels from all exchanges are consumed best price first,
+// otherwise the single exchange which can fill the whole amount at the best
+// total is chosen. When the request is set to execute, a market order is
+// submitted for each allocation
+func (r *OrderRouter) RouteOrder(ctx context.Context, req *RouteRequest) (*RoutePlan, error) {
+	if !r.IsRunning() {
+		return nil, fmt.Errorf("%s %w", orderRouterName, ErrSubSystemNotStarted)
+	}
+	if req == nil {
+		return nil, errNilRouteRequest
+	}
+	if req.Pair.IsEmpty() {
+		return nil, errCurrencyPairUnset
+	}
+	if !req.Asset.IsValid() {
+		return nil, fmt.Errorf("%s %w", req.Asset, asset.ErrNotSupported)
+	}
+	var buy bool
+	switch req.Side {
+	case order.Buy, order.Bid:
+		buy = true
+	case order.Sell, order.Ask:
+	default:
+		return nil, fmt.Errorf("%w, received '%v'", errInvalidRouteSide, req.Side)
+	}
+	if req.Amount <= 0 {
+		return nil, errInvalidRouteAmount
+	}
+
+	books, err := r.getRoutableLevels(ctx, req, buy)
+	if err != nil {
+		return nil, err
+	}
+
+	var allocations []RouteAllocation
+	if req.AllowSplit {
+		var levels []AggregatedLevel
+		for i := range books {
+			levels = append(levels, books[i]...)
+		}
+		sortAggregatedLevels(levels, buy)
+		var filled float64
+		allocations, filled = allocateRouteLevels(levels, req.Amount, buy)
+		if filled < req.Amount {
+			return nil, fmt.Errorf("%w, %v of %v %s available", errInsufficientLiquidity, filled, req.Amount, req.Pair.Base)
+		}
+	} else {
+		var best float64
+		for i := range books {
+			alloc, filled := allocateRouteLevels(books[i], req.Amount, buy)
+			if filled < req.Amount {
+				continue
+			}
+			if allocations == nil ||
+				(buy && alloc[0].Total < best) ||
+				(!buy && alloc[0].Total > best) {
+				allocations, best = alloc, alloc[0].Total
+			}
+		}
+		if allocations == nil {
+			return nil, fmt.Errorf("%w on any single exchange, %v %s requested", errInsufficientLiquidity, req.Amount, req.Pair.Base)
+		}
+	}
+
+	plan := &RoutePlan{
+		Pair:        req.Pair,
+		Asset:       req.Asset,
+		Side:        req.Side,
+		Amount:      req.Amount,
+		Split:       len(allocations) > 1,
+		Allocations: allocations,
+	}
+	var notional float64
+	for i := range allocations {
+		notional += allocations[i].AveragePrice * allocations[i].Amount
+		plan.Fees += allocations[i].Fee
+		plan.Total += allocations[i].Total
+	}
+	plan.AveragePrice = notional / req.Amount
+	if r.verbose {
+		log.Debugf(log.OrderMgr, "Order router routed %s %v %s %s across %d exchange(s) at an average price of %v",
+			req.Side, req.Amount, req.Asset, req.Pair, len(allocations), plan.AveragePrice)
+	}
+
+	if !req.Execute {
+		return plan, nil
+	}
+	var failed bool
+	for i := range plan.Allocations {
+		resp, err := r.orderManager.Submit(ctx, &order.Submit{
+			Exchange:  plan.Allocations[i].Exchange,
+			Pair:      req.Pair,
+			AssetType: req.Asset,
+			Side:      req.Side,
+			Type:      order.Market,
+			Amount:    plan.Allocations[i].Amount,
+			Price:     plan.Allocations[i].AveragePrice,
+		})
+		if err != nil {
+			plan.Allocations[i].Error = err
+			failed = true
+			log.Errorf(log.OrderMgr, "Order router unable to submit %s %v %s %s order to %s: %v",
+				req.Side, plan.Allocations[i].Amount, req.Asset, req.Pair, plan.Allocations[i].Exchange, err)
+			continue
+		}
+		plan.Allocations[i].OrderID = resp.OrderID
+	}
+	if failed {
+		return plan, errRouteAllocationsFailed
+	}
+	return plan, nil
+}
+
+// getRoutableLevels returns the fee adjusted orderbook levels of every enabled
+// exchange which supports the requested pair, grouped by exchange. Asks are
+// returned for buys and bids for sells
+func (r *OrderRouter) getRoutableLevels(ctx context.Context, req *RouteRequest, buy bool) ([][]AggregatedLevel, error) {
+	books, _, err := r.aggregator.getExchangeBooks(ctx, &AggregateRequest{
+		Pair:        req.Pair,
+		Asset:       req.Asset,
+		Exchanges:   req.Exchanges,
+		FeeAdjusted: true,
+		FeeAmount:   req.Amount,
+	})
+	if err != nil {
+		if errors.Is(err, errNoAggregatableOrderbooks) {
+			return nil, fmt.Errorf("%w %s %s", errNoRoutableExchanges, req.Asset, req.Pair)
+		}
+		return nil, err
+	}
+	var levels [][]AggregatedLevel
+	for i := range books {
+		side := books[i].asks
+		if !buy {
+			side = books[i].bids
+		}
+		if len(side) == 0 {
+			continue
+		}
+		levels = append(levels, side)
+	}
+	if len(levels) == 0 {
+		return nil, fmt.Errorf("%w %s %s", errNoRoutableExchanges, req.Asset, req.Pair)
+	}
+	return levels, nil
+}
+
+// allocateRouteLevels consumes the sorted levels until the amount is filled
+// and returns the allocation for each exchange used along with the amount
+// filled
+func allocateRouteLevels(levels []AggregatedLevel, amount float64, buy bool) ([]RouteAllocation, float64) {
+	var allocations []RouteAllocation
+	remaining := amount
+	for i := range levels {
+		if remaining <= 0 {
+			break
+		}
+		take := levels[i].Amount
+		if take > remaining {
+			take = remaining
+		}
+		remaining -= take
+
+		var alloc *RouteAllocation
+		for j := range allocations {
+			if allocations[j].Exchange == levels[i].Exchange {
+				alloc = &allocations[j]
+				break
+			}
+		}
+		if alloc == nil {
+			allocations = append(allocations, RouteAllocation{
+				Exchange: levels[i].Exchange,
+				FeeRate:  levels[i].FeeRate,
+			})
+			alloc = &allocations[len(allocations)-1]
+		}
+		notional := take * levels[i].Price
+		fee := notional * levels[i].FeeRate
+		alloc.AveragePrice = (alloc.AveragePrice*alloc.Amount + notional) / (alloc.Amount + take)
+		alloc.Amount += take
+		alloc.WorstPrice = levels[i].Price
+		alloc.Fee += fee
+		if buy {
+			alloc.Total += notional + fee
+		} else {
+			alloc.Total += notional - fee
+		}
+	}
+	return allocations, amount - remaining
+}
+
+// fetchTakerFeeRate returns an exchange's taker fee for an order as a rate of
+// its notional value
+func fetchTakerFeeRate(ctx context.Context, exch exchange.IBotExchange, cp currency.Pair, price, amount float64) (float64, error) {
+	fee, err := exch.GetFeeByType(ctx, &exchange.FeeBuilder{
+		FeeType:       exchange.CryptocurrencyTradeFee,
+		Pair:          cp,
+		PurchasePrice: price,
+		Amount:        amount,
+	})
+	if err != nil {
+		return 0, err
+	}
+	notional := price * amount
+	if notional == 0 {
+		return 0, nil
+	}
+	return fee / notional, nil
+}
@@ -0,0 +1,258 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+var errRouterTest = errors.New("router test error")
+
+// routerExchange is a fake exchange which supports the enabled pairs it holds
+type routerExchange struct {
+	exchange.IBotExchange
+	name    string
+	enabled bool
+	pairs   currency.Pairs
+}
+
+func (r *routerExchange) GetName() string {
+	return r.name
+}
+
+func (r *routerExchange) IsEnabled() bool {
+	return r.enabled
+}
+
+func (r *routerExchange) GetEnabledPairs(asset.Item) (currency.Pairs, error) {
+	return r.pairs, nil
+}
+
+// routerOrders is a fake order manager which records submitted orders
+type routerOrders struct {
+	submitted []*order.Submit
+	failOn    string
+}
+
+func (r *routerOrders) Submit(_ context.Context, s *order.Submit) (*OrderSubmitResponse, error) {
+	if s.Exchange == r.failOn {
+		return nil, errRouterTest
+	}
+	r.submitted = append(r.submitted, s)
+	return &OrderSubmitResponse{SubmitResponse: order.SubmitResponse{OrderID: s.Exchange + "order"}}, nil
+}
+
+func setupTestOrderRouter(t *testing.T, om *routerOrders) *OrderRouter {
+	t.Helper()
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	em := SetupExchangeManager()
+	em.Add(&routerExchange{name: "cheap", enabled: true, pairs: currency.Pairs{cp}})
+	em.Add(&routerExchange{name: "deep", enabled: true, pairs: currency.Pairs{cp}})
+	em.Add(&routerExchange{name: "disabled", pairs: currency.Pairs{cp}})
+	em.Add(&routerExchange{name: "unsupported", enabled: true})
+	r, err := SetupOrderRouter(em, om, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	books := map[string]*orderbook.Base{
+		"cheap": {
+			Asks: orderbook.Items{{Price: 100, Amount: 1}, {Price: 110, Amount: 1}},
+			Bids: orderbook.Items{{Price: 99, Amount: 1}},
+		},
+		"deep": {
+			Asks: orderbook.Items{{Price: 102, Amount: 10}},
+			Bids: orderbook.Items{{Price: 98, Amount: 10}},
+		},
+		"disabled": {
+			Asks: orderbook.Items{{Price: 1, Amount: 100}},
+		},
+	}
+	r.aggregator.orderbookFetcher = func(exch string, _ currency.Pair, _ asset.Item) (*orderbook.Base, error) {
+		ob, ok := books[exch]
+		if !ok {
+			return nil, errRouterTest
+		}
+		return ob, nil
+	}
+	r.aggregator.feeFetcher = func(_ context.Context, exch exchange.IBotExchange, _ currency.Pair, _, _ float64) (float64, error) {
+		if exch.GetName() == "deep" {
+			return 0, nil
+		}
+		return 0.01, nil
+	}
+	r.started = 1
+	return r
+}
+
+func TestSetupOrderRouter(t *testing.T) {
+	t.Parallel()
+	_, err := SetupOrderRouter(nil, nil, false)
+	if !errors.Is(err, errNilExchangeManager) {
+		t.Errorf("received '%v' expected '%v'", err, errNilExchangeManager)
+	}
+	_, err = SetupOrderRouter(SetupExchangeManager(), nil, false)
+	if !errors.Is(err, errNilOrderManager) {
+		t.Errorf("received '%v' expected '%v'", err, errNilOrderManager)
+	}
+	r, err := SetupOrderRouter(SetupExchangeManager(), &routerOrders{}, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if r.aggregator == nil {
+		t.Error("expected aggregator to be set")
+	}
+}
+
+func TestOrderRouterStartStop(t *testing.T) {
+	t.Parallel()
+	var r *OrderRouter
+	if err := r.Start(); !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+	if err := r.Stop(); !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+	if r.IsRunning() {
+		t.Error("expected not running")
+	}
+	r, err := SetupOrderRouter(SetupExchangeManager(), &routerOrders{}, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if err = r.Start(); !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if err = r.Start(); !errors.Is(err, ErrSubSystemAlreadyStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemAlreadyStarted)
+	}
+	if !r.IsRunning() {
+		t.Error("expected running")
+	}
+	if err = r.Stop(); !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if err = r.Stop(); !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemNotStarted)
+	}
+}
+
+func TestOrderRouterValidation(t *testing.T) {
+	t.Parallel()
+	r := setupTestOrderRouter(t, &routerOrders{})
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	for _, tc := range []struct {
+		req *RouteRequest
+		err error
+	}{
+		{nil, errNilRouteRequest},
+		{&RouteRequest{}, errCurrencyPairUnset},
+		{&RouteRequest{Pair: cp}, asset.ErrNotSupported},
+		{&RouteRequest{Pair: cp, Asset: asset.Spot}, errInvalidRouteSide},
+		{&RouteRequest{Pair: cp, Asset: asset.Spot, Side: order.Buy}, errInvalidRouteAmount},
+		{&RouteRequest{Pair: cp, Asset: asset.Spot, Side: order.Buy, Amount: 1, Exchanges: []string{"disabled"}}, errNoRoutableExchanges},
+		{&RouteRequest{Pair: cp, Asset: asset.Spot, Side: order.Buy, Amount: 100}, errInsufficientLiquidity},
+		{&RouteRequest{Pair: cp, Asset: asset.Spot, Side: order.Buy, Amount: 100, AllowSplit: true}, errInsufficientLiquidity},
+	} {
+		_, err := r.RouteOrder(context.Background(), tc.req)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("received '%v' expected '%v'", err, tc.err)
+		}
+	}
+
+	r.started = 0
+	_, err := r.RouteOrder(context.Background(), &RouteRequest{})
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemNotStarted)
+	}
+}
+
+func TestOrderRouterRouteOrder(t *testing.T) {
+	t.Parallel()
+	om := &routerOrders{}
+	r := setupTestOrderRouter(t, om)
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+
+	// cheap's best ask of 100 costs 101 after its 1% fee but its second level
+	// makes a single fill more expensive than deep's fee free ask of 102
+	plan, err := r.RouteOrder(context.Background(), &RouteRequest{
+		Pair:   cp,
+		Asset:  asset.Spot,
+		Side:   order.Buy,
+		Amount: 2,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if plan.Split || len(plan.Allocations) != 1 || plan.Allocations[0].Exchange != "deep" {
+		t.Fatalf("unexpected plan %+v", plan)
+	}
+	if plan.Total != 204 || plan.AveragePrice != 102 {
+		t.Errorf("unexpected plan %+v", plan)
+	}
+	if len(om.submitted) != 0 {
+		t.Error("expected no orders to be submitted")
+	}
+
+	plan, err = r.RouteOrder(context.Background(), &RouteRequest{
+		Pair:       cp,
+		Asset:      asset.Spot,
+		Side:       order.Buy,
+		Amount:     2,
+		AllowSplit: true,
+		Execute:    true,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !plan.Split || len(plan.Allocations) != 2 {
+		t.Fatalf("unexpected plan %+v", plan)
+	}
+	if plan.Allocations[0].Exchange != "cheap" || plan.Allocations[0].Amount != 1 || plan.Allocations[0].Fee != 1 {
+		t.Errorf("unexpected allocation %+v", plan.Allocations[0])
+	}
+	if plan.Allocations[1].Exchange != "deep" || plan.Allocations[1].Amount != 1 {
+		t.Errorf("unexpected allocation %+v", plan.Allocations[1])
+	}
+	if plan.Total != 203 || plan.Fees != 1 {
+		t.Errorf("unexpected plan %+v", plan)
+	}
+	if len(om.submitted) != 2 || om.submitted[0].Type != order.Market || plan.Allocations[0].OrderID != "cheaporder" {
+		t.Errorf("unexpected submitted orders %v", om.submitted)
+	}
+
+	plan, err = r.RouteOrder(context.Background(), &RouteRequest{
+		Pair:   cp,
+		Asset:  asset.Spot,
+		Side:   order.Sell,
+		Amount: 1,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	// cheap's bid of 99 returns 98.01 after fees which beats deep's bid of 98
+	if plan.Allocations[0].Exchange != "cheap" || plan.Total != 99-0.99 {
+		t.Errorf("unexpected plan %+v", plan)
+	}
+
+	om.failOn = "deep"
+	plan, err = r.RouteOrder(context.Background(), &RouteRequest{
+		Pair:       cp,
+		Asset:      asset.Spot,
+		Side:       order.Buy,
+		Amount:     2,
+		AllowSplit: true,
+		Execute:    true,
+	})
+	if !errors.Is(err, errRouteAllocationsFailed) {
+		t.Fatalf("received '%v' expected '%v'", err, errRouteAllocationsFailed)
+	}
+	if !errors.Is(plan.Allocations[1].Error, errRouterTest) || plan.Allocations[0].OrderID == "" {
+		t.Errorf("unexpected allocations %+v", plan.Allocations)
+	}
+}
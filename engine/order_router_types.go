@@ -0,0 +1,81 @@
+package engine
+
+import (
+	"context"
+	"errors"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+const orderRouterName = "order_router"
+
+var (
+	errNilRouteRequest        = errors.New("route request cannot be nil")
+	errInvalidRouteSide       = errors.New("route side must be buy or sell")
+	errInvalidRouteAmount     = errors.New("route amount must be greater than zero")
+	errNoRoutableExchanges    = errors.New("no enabled exchanges with orderbooks for pair")
+	errInsufficientLiquidity  = errors.New("insufficient orderbook liquidity to fill amount")
+	errRouteAllocationsFailed = errors.New("one or more route allocations failed to submit")
+)
+
+// iOrderSubmitter limits the order router to submitting orders via the order
+// manager
+type iOrderSubmitter interface {
+	Submit(context.Context, *order.Submit) (*OrderSubmitResponse, error)
+}
+
+// OrderRouter compares the orderbooks and taker fees of all enabled exchanges
+// which support a pair and routes an order to the exchange, or combination of
+// exchanges, which offers the best execution
+type OrderRouter struct {
+	started      int32
+	verbose      bool
+	orderManager iOrderSubmitter
+	aggregator   *OrderbookAggregator
+}
+
+// RouteRequest defines an order to be routed
+type RouteRequest struct {
+	Pair  currency.Pair
+	Asset asset.Item
+	Side  order.Side
+	// Amount is denominated in the base currency
+	Amount float64
+	// Exchanges optionally restricts routing to the named exchanges
+	Exchanges []string
+	// AllowSplit allows the order to be split across exchanges, otherwise
+	// the whole amount is routed to a single exchange
+	AllowSplit bool
+	// Execute submits market orders for each allocation via the order
+	// manager, otherwise only the route is returned
+	Execute bool
+}
+
+// RoutePlan holds the best execution route for an order
+type RoutePlan struct {
+	Pair         currency.Pair
+	Asset        asset.Item
+	Side         order.Side
+	Amount       float64
+	AveragePrice float64
+	Fees         float64
+	// Total is the cost of a buy or the proceeds of a sell after fees
+	Total       float64
+	Split       bool
+	Allocations []RouteAllocation
+}
+
+// RouteAllocation holds the portion of a route allocated to an exchange
+type RouteAllocation struct {
+	Exchange     string
+	Amount       float64
+	AveragePrice float64
+	WorstPrice   float64
+	FeeRate      float64
+	Fee          float64
+	Total        float64
+	OrderID      string
+	Error        error
+}
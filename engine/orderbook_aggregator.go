@@ -0,0 +1,186 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// exchangeBook holds the levels of a single exchange's orderbook
+type exchangeBook struct {
+	exchange string
+	bids     []AggregatedLevel
+	asks     []AggregatedLevel
+}
+
+// SetupOrderbookAggregator creates an orderbook aggregator which reads the
+// orderbooks of the exchange manager's exchanges
+func SetupOrderbookAggregator(em iExchangeManager, verbose bool) (*OrderbookAggregator, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	return &OrderbookAggregator{
+		verbose:          verbose,
+		exchangeManager:  em,
+		orderbookFetcher: orderbook.Get,
+		feeFetcher:       fetchTakerFeeRate,
+	}, nil
+}
+
+// Aggregate merges the orderbooks of every enabled exchange which supports the
+// requested pair into a single book. Bids and asks are sorted by their
+// effective price so the first level of each side is the best available across
+// all exchanges
+func (a *OrderbookAggregator) Aggregate(ctx context.Context, req *AggregateRequest) (*AggregatedOrderbook, error) {
+	if a == nil {
+		return nil, fmt.Errorf("orderbook aggregator %w", ErrNilSubsystem)
+	}
+	if req == nil {
+		return nil, errNilAggregateRequest
+	}
+	if req.Pair.IsEmpty() {
+		return nil, errCurrencyPairUnset
+	}
+	if !req.Asset.IsValid() {
+		return nil, fmt.Errorf("%s %w", req.Asset, asset.ErrNotSupported)
+	}
+	if req.Depth < 0 {
+		return nil, errInvalidAggregateDepth
+	}
+
+	books, lastUpdated, err := a.getExchangeBooks(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	agg := &AggregatedOrderbook{
+		Pair:        req.Pair,
+		Asset:       req.Asset,
+		FeeAdjusted: req.FeeAdjusted,
+		LastUpdated: lastUpdated,
+	}
+	for i := range books {
+		agg.Exchanges = append(agg.Exchanges, books[i].exchange)
+		agg.Bids = append(agg.Bids, books[i].bids...)
+		agg.Asks = append(agg.Asks, books[i].asks...)
+	}
+	sortAggregatedLevels(agg.Bids, false)
+	sortAggregatedLevels(agg.Asks, true)
+	if req.Depth > 0 {
+		if len(agg.Bids) > req.Depth {
+			agg.Bids = agg.Bids[:req.Depth]
+		}
+		if len(agg.Asks) > req.Depth {
+			agg.Asks = agg.Asks[:req.Depth]
+		}
+	}
+	if a.verbose {
+		log.Debugf(log.OrderBook, "Orderbook aggregator merged %s %s across %d exchange(s), %d bids %d asks",
+			req.Asset, req.Pair, len(agg.Exchanges), len(agg.Bids), len(agg.Asks))
+	}
+	return agg, nil
+}
+
+// getExchangeBooks returns the sorted levels of every enabled exchange which
+// supports the requested pair along with the most recent update time
+func (a *OrderbookAggregator) getExchangeBooks(ctx context.Context, req *AggregateRequest) ([]exchangeBook, time.Time, error) {
+	exchanges, err := a.exchangeManager.GetExchanges()
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	feeAmount := req.FeeAmount
+	if feeAmount <= 0 {
+		feeAmount = 1
+	}
+	var books []exchangeBook
+	var lastUpdated time.Time
+	for _, exch := range exchanges {
+		if !exch.IsEnabled() {
+			continue
+		}
+		exchName := exch.GetName()
+		if len(req.Exchanges) > 0 && !common.StringDataCompareInsensitive(req.Exchanges, exchName) {
+			continue
+		}
+		pairs, err := exch.GetEnabledPairs(req.Asset)
+		if err != nil || !pairs.Contains(req.Pair, true) {
+			continue
+		}
+		ob, err := a.orderbookFetcher(exchName, req.Pair, req.Asset)
+		if err != nil {
+			if a.verbose {
+				log.Warnf(log.OrderBook, "Orderbook aggregator skipping %s %s %s: %v", exchName, req.Asset, req.Pair, err)
+			}
+			continue
+		}
+		if len(ob.Bids) == 0 && len(ob.Asks) == 0 {
+			continue
+		}
+
+		var feeRate float64
+		if req.FeeAdjusted {
+			price := ob.Bids
+			if len(ob.Asks) > 0 {
+				price = ob.Asks
+			}
+			feeRate, err = a.feeFetcher(ctx, exch, req.Pair, price[0].Price, feeAmount)
+			if err != nil {
+				log.Warnf(log.OrderBook, "Orderbook aggregator unable to retrieve %s taker fee, fees will not be considered: %v", exchName, err)
+				feeRate = 0
+			}
+		}
+		books = append(books, exchangeBook{
+			exchange: exchName,
+			bids:     toAggregatedLevels(exchName, ob.Bids, feeRate, false),
+			asks:     toAggregatedLevels(exchName, ob.Asks, feeRate, true),
+		})
+		if ob.LastUpdated.After(lastUpdated) {
+			lastUpdated = ob.LastUpdated
+		}
+	}
+	if len(books) == 0 {
+		return nil, time.Time{}, fmt.Errorf("%w %s %s", errNoAggregatableOrderbooks, req.Asset, req.Pair)
+	}
+	return books, lastUpdated, nil
+}
+
+// toAggregatedLevels converts orderbook items to sorted levels, discarding
+// any without a price or amount. Asks are made more expensive and bids less
+// valuable by the fee rate
+func toAggregatedLevels(exchName string, items orderbook.Items, feeRate float64, ask bool) []AggregatedLevel {
+	levels := make([]AggregatedLevel, 0, len(items))
+	for i := range items {
+		if items[i].Price <= 0 || items[i].Amount <= 0 {
+			continue
+		}
+		effectivePrice := items[i].Price * (1 + feeRate)
+		if !ask {
+			effectivePrice = items[i].Price * (1 - feeRate)
+		}
+		levels = append(levels, AggregatedLevel{
+			Exchange:       exchName,
+			Price:          items[i].Price,
+			EffectivePrice: effectivePrice,
+			Amount:         items[i].Amount,
+			FeeRate:        feeRate,
+		})
+	}
+	sortAggregatedLevels(levels, ask)
+	return levels
+}
+
+// sortAggregatedLevels orders levels from the best to worst effective price,
+// ascending for asks and descending for bids
+func sortAggregatedLevels(levels []AggregatedLevel, ask bool) {
+	sort.SliceStable(levels, func(i, j int) bool {
+		if ask {
+			return levels[i].EffectivePrice < levels[j].EffectivePrice
+		}
+		return levels[i].EffectivePrice > levels[j].EffectivePrice
+	})
+}
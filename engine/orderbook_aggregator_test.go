@@ -0,0 +1,105 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+func TestSetupOrderbookAggregator(t *testing.T) {
+	t.Parallel()
+	_, err := SetupOrderbookAggregator(nil, false)
+	if !errors.Is(err, errNilExchangeManager) {
+		t.Errorf("received '%v' expected '%v'", err, errNilExchangeManager)
+	}
+	a, err := SetupOrderbookAggregator(SetupExchangeManager(), false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if a.orderbookFetcher == nil || a.feeFetcher == nil {
+		t.Error("expected fetchers to be set")
+	}
+}
+
+func TestAggregateValidation(t *testing.T) {
+	t.Parallel()
+	var a *OrderbookAggregator
+	_, err := a.Aggregate(context.Background(), nil)
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+	a = setupTestOrderRouter(t, &routerOrders{}).aggregator
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	for _, tc := range []struct {
+		req *AggregateRequest
+		err error
+	}{
+		{nil, errNilAggregateRequest},
+		{&AggregateRequest{}, errCurrencyPairUnset},
+		{&AggregateRequest{Pair: cp}, asset.ErrNotSupported},
+		{&AggregateRequest{Pair: cp, Asset: asset.Spot, Depth: -1}, errInvalidAggregateDepth},
+		{&AggregateRequest{Pair: cp, Asset: asset.Spot, Exchanges: []string{"disabled"}}, errNoAggregatableOrderbooks},
+		{&AggregateRequest{Pair: currency.NewPair(currency.ETH, currency.USDT), Asset: asset.Spot}, errNoAggregatableOrderbooks},
+	} {
+		_, err = a.Aggregate(context.Background(), tc.req)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("received '%v' expected '%v'", err, tc.err)
+		}
+	}
+}
+
+func TestAggregate(t *testing.T) {
+	t.Parallel()
+	a := setupTestOrderRouter(t, &routerOrders{}).aggregator
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+
+	agg, err := a.Aggregate(context.Background(), &AggregateRequest{Pair: cp, Asset: asset.Spot})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(agg.Exchanges) != 2 || len(agg.Bids) != 2 || len(agg.Asks) != 3 {
+		t.Fatalf("unexpected orderbook %+v", agg)
+	}
+	if agg.Asks[0].Exchange != "cheap" || agg.Asks[1].Exchange != "deep" || agg.Asks[2].Price != 110 {
+		t.Errorf("unexpected asks %+v", agg.Asks)
+	}
+	if agg.Bids[0].Price != 99 || agg.Bids[0].EffectivePrice != 99 || agg.Bids[1].Exchange != "deep" {
+		t.Errorf("unexpected bids %+v", agg.Bids)
+	}
+
+	// cheap's 1% fee pushes its best bid of 99 to 98.01 which still beats
+	// deep's fee free bid of 98
+	agg, err = a.Aggregate(context.Background(), &AggregateRequest{
+		Pair:        cp,
+		Asset:       asset.Spot,
+		FeeAdjusted: true,
+		Depth:       1,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(agg.Bids) != 1 || len(agg.Asks) != 1 {
+		t.Fatalf("unexpected orderbook %+v", agg)
+	}
+	if agg.Bids[0].Exchange != "cheap" || agg.Bids[0].EffectivePrice != 99*0.99 || agg.Bids[0].FeeRate != 0.01 {
+		t.Errorf("unexpected bid %+v", agg.Bids[0])
+	}
+	if agg.Asks[0].Exchange != "cheap" || agg.Asks[0].EffectivePrice != 101 {
+		t.Errorf("unexpected ask %+v", agg.Asks[0])
+	}
+
+	agg, err = a.Aggregate(context.Background(), &AggregateRequest{
+		Pair:      cp,
+		Asset:     asset.Spot,
+		Exchanges: []string{"DEEP"},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(agg.Exchanges) != 1 || agg.Exchanges[0] != "deep" {
+		t.Errorf("unexpected exchanges %v", agg.Exchanges)
+	}
+}
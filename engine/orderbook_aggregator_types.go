@@ -0,0 +1,68 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+var (
+	errNilAggregateRequest      = errors.New("aggregate request cannot be nil")
+	errInvalidAggregateDepth    = errors.New("aggregate depth cannot be negative")
+	errNoAggregatableOrderbooks = errors.New("no enabled exchanges with orderbooks for pair")
+)
+
+// OrderbookAggregator merges the orderbooks of a pair across all enabled
+// exchanges into a single consolidated view, optionally adjusting each level's
+// price by the exchange's taker fee
+type OrderbookAggregator struct {
+	verbose          bool
+	exchangeManager  iExchangeManager
+	orderbookFetcher func(string, currency.Pair, asset.Item) (*orderbook.Base, error)
+	feeFetcher       func(context.Context, exchange.IBotExchange, currency.Pair, float64, float64) (float64, error)
+}
+
+// AggregateRequest defines the consolidated orderbook to build
+type AggregateRequest struct {
+	Pair  currency.Pair
+	Asset asset.Item
+	// Exchanges optionally restricts the view to the named exchanges
+	Exchanges []string
+	// FeeAdjusted retrieves each exchange's taker fee and scores levels by
+	// their price after fees
+	FeeAdjusted bool
+	// FeeAmount is the base currency amount used when retrieving taker fees,
+	// defaulting to one unit
+	FeeAmount float64
+	// Depth optionally limits the number of levels returned per side
+	Depth int
+}
+
+// AggregatedOrderbook is the consolidated orderbook of a pair across exchanges.
+// Levels are sorted from best to worst by effective price and retain the
+// exchange they belong to
+type AggregatedOrderbook struct {
+	Pair        currency.Pair
+	Asset       asset.Item
+	FeeAdjusted bool
+	Exchanges   []string
+	Bids        []AggregatedLevel
+	Asks        []AggregatedLevel
+	LastUpdated time.Time
+}
+
+// AggregatedLevel holds an orderbook level of a single exchange
+type AggregatedLevel struct {
+	Exchange string
+	Price    float64
+	// EffectivePrice is the price after the taker fee, which is the same as
+	// the price when the view is not fee adjusted
+	EffectivePrice float64
+	Amount         float64
+	FeeRate        float64
+}
@@ -0,0 +1,229 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	dborderbook "github.com/thrasher-corp/gocryptotrader/database/repository/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupOrderbookRecorder creates an orderbook recorder subsystem
+func SetupOrderbookRecorder(em iExchangeManager, dcm iDatabaseConnectionManager, cfg *config.OrderbookRecorder) (*OrderbookRecorder, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if dcm == nil {
+		return nil, errNilDatabaseConnectionManager
+	}
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	db := dcm.GetInstance()
+	if db == nil {
+		return nil, database.ErrNilInstance
+	}
+	if cfg.Interval <= 0 {
+		cfg.Interval = defaultOrderbookRecorderInterval
+	}
+	if cfg.Depth <= 0 {
+		cfg.Depth = defaultOrderbookRecorderDepth
+	}
+	r := &OrderbookRecorder{
+		exchangeManager:            em,
+		databaseConnectionInstance: db,
+		shutdown:                   make(chan struct{}),
+		verbose:                    cfg.Verbose,
+		snapshotSaver:              dborderbook.Insert,
+	}
+	for i := range cfg.Items {
+		if err := r.addItem(&cfg.Items[i], cfg.Interval, cfg.Depth); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// addItem validates and adds a configured item to the recorder, applying the
+// default interval and depth when they are not set for the item
+func (r *OrderbookRecorder) addItem(cfg *config.OrderbookRecorderItem, interval time.Duration, depth int) error {
+	switch {
+	case cfg.Exchange == "":
+		return fmt.Errorf("%w exchange name unset", errInvalidOrderbookRecorderItem)
+	case cfg.Pair.IsEmpty():
+		return fmt.Errorf("%w %s currency pair unset", errInvalidOrderbookRecorderItem, cfg.Exchange)
+	case !cfg.Asset.IsValid():
+		return fmt.Errorf("%w %s %s asset %v", errInvalidOrderbookRecorderItem, cfg.Exchange, cfg.Pair, asset.ErrNotSupported)
+	}
+	if cfg.Interval > 0 {
+		interval = cfg.Interval
+	}
+	if interval < minimumOrderbookRecorderInterval {
+		return fmt.Errorf("%w %s %s interval %v below minimum %v",
+			errInvalidOrderbookRecorderItem, cfg.Exchange, cfg.Pair, interval, minimumOrderbookRecorderInterval)
+	}
+	if cfg.Depth > 0 {
+		depth = cfg.Depth
+	}
+	for i := range r.items {
+		if strings.EqualFold(r.items[i].exchange, cfg.Exchange) &&
+			r.items[i].asset == cfg.Asset &&
+			r.items[i].pair.Equal(cfg.Pair) {
+			return fmt.Errorf("%w %s %s %s", errOrderbookRecorderItemAlreadyExists, cfg.Exchange, cfg.Asset, cfg.Pair)
+		}
+	}
+	r.items = append(r.items, &orderbookRecorderItem{
+		exchange: strings.ToLower(cfg.Exchange),
+		asset:    cfg.Asset,
+		pair:     cfg.Pair,
+		interval: interval,
+		depth:    depth,
+	})
+	return nil
+}
+
+// Start runs the subsystem
+func (r *OrderbookRecorder) Start() error {
+	if r == nil {
+		return ErrNilSubsystem
+	}
+	if !atomic.CompareAndSwapInt32(&r.started, 0, 1) {
+		return ErrSubSystemAlreadyStarted
+	}
+	r.shutdown = make(chan struct{})
+	go r.run()
+	log.Debugf(log.DataHistory, "Orderbook recorder %v", MsgSubSystemStarted)
+	return nil
+}
+
+// IsRunning checks whether the subsystem is running
+func (r *OrderbookRecorder) IsRunning() bool {
+	if r == nil {
+		return false
+	}
+	return atomic.LoadInt32(&r.started) == 1
+}
+
+// Stop stops the subsystem
+func (r *OrderbookRecorder) Stop() error {
+	if r == nil {
+		return ErrNilSubsystem
+	}
+	if !atomic.CompareAndSwapInt32(&r.started, 1, 0) {
+		return ErrSubSystemNotStarted
+	}
+	close(r.shutdown)
+	log.Debugf(log.DataHistory, "Orderbook recorder %v", MsgSubSystemShutdown)
+	return nil
+}
+
+func (r *OrderbookRecorder) run() {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-r.shutdown:
+			return
+		case <-timer.C:
+			now := time.Now()
+			if r.databaseConnectionInstance.IsConnected() {
+				if err := r.record(now); err != nil {
+					log.Error(log.DataHistory, err)
+				}
+			}
+			timer.Reset(r.untilNextRecord(now))
+		}
+	}
+}
+
+// record stores snapshots for every item which is due to be recorded at the
+// supplied time
+func (r *OrderbookRecorder) record(now time.Time) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	var snapshots []dborderbook.Snapshot
+	for i := range r.items {
+		if now.Before(r.items[i].nextRecord) {
+			continue
+		}
+		r.items[i].nextRecord = now.Truncate(r.items[i].interval).Add(r.items[i].interval)
+		snapshot, err := r.snapshot(r.items[i], now)
+		if err != nil {
+			log.Errorf(log.DataHistory, "orderbook recorder %s %s %s: %v",
+				r.items[i].exchange,
+				r.items[i].asset,
+				r.items[i].pair,
+				err)
+			continue
+		}
+		snapshots = append(snapshots, *snapshot)
+	}
+	if len(snapshots) == 0 {
+		return nil
+	}
+	if err := r.snapshotSaver(snapshots...); err != nil {
+		return fmt.Errorf("could not save orderbook snapshots: %w", err)
+	}
+	if r.verbose {
+		log.Debugf(log.DataHistory, "orderbook recorder saved %d snapshots", len(snapshots))
+	}
+	return nil
+}
+
+// snapshot retrieves the orderbook for an item and converts it to a database
+// snapshot limited to the item depth
+func (r *OrderbookRecorder) snapshot(item *orderbookRecorderItem, now time.Time) (*dborderbook.Snapshot, error) {
+	exch, err := r.exchangeManager.GetExchangeByName(item.exchange)
+	if err != nil {
+		return nil, err
+	}
+	ob, err := exch.FetchOrderbook(context.TODO(), item.pair, item.asset)
+	if err != nil {
+		return nil, err
+	}
+	return &dborderbook.Snapshot{
+		Exchange:  item.exchange,
+		Base:      item.pair.Base.Upper().String(),
+		Quote:     item.pair.Quote.Upper().String(),
+		Asset:     item.asset.String(),
+		Timestamp: now.UTC().Truncate(minimumOrderbookRecorderInterval),
+		Bids:      levelsToDepth(ob.Bids, item.depth),
+		Asks:      levelsToDepth(ob.Asks, item.depth),
+	}, nil
+}
+
+// untilNextRecord returns the duration until the next item is due
+func (r *OrderbookRecorder) untilNextRecord(now time.Time) time.Duration {
+	r.m.Lock()
+	defer r.m.Unlock()
+	var next time.Time
+	for i := range r.items {
+		if next.IsZero() || r.items[i].nextRecord.Before(next) {
+			next = r.items[i].nextRecord
+		}
+	}
+	if wait := next.Sub(now); wait > 0 {
+		return wait
+	}
+	return minimumOrderbookRecorderInterval
+}
+
+// levelsToDepth converts orderbook items to snapshot levels limited to the
+// supplied depth
+func levelsToDepth(items orderbook.Items, depth int) []dborderbook.Level {
+	if depth > 0 && len(items) > depth {
+		items = items[:depth]
+	}
+	resp := make([]dborderbook.Level, len(items))
+	for i := range items {
+		resp[i] = dborderbook.Level{Price: items[i].Price, Amount: items[i].Amount}
+	}
+	return resp
+}
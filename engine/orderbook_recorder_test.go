@@ -0,0 +1,205 @@
+package engine
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	dborderbook "github.com/thrasher-corp/gocryptotrader/database/repository/orderbook"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// obrExchange aka orderbook recorder fake exchange returns an orderbook with
+// five levels on each side
+type obrExchange struct {
+	exchange.IBotExchange
+}
+
+func (f *obrExchange) FetchOrderbook(_ context.Context, p currency.Pair, a asset.Item) (*orderbook.Base, error) {
+	resp := &orderbook.Base{Exchange: testExchange, Pair: p, Asset: a}
+	for i := 0; i < 5; i++ {
+		resp.Bids = append(resp.Bids, orderbook.Item{Price: float64(100 - i), Amount: 1})
+		resp.Asks = append(resp.Asks, orderbook.Item{Price: float64(101 + i), Amount: 1})
+	}
+	return resp, nil
+}
+
+func TestSetupOrderbookRecorder(t *testing.T) {
+	t.Parallel()
+	_, err := SetupOrderbookRecorder(nil, nil, nil)
+	if !errors.Is(err, errNilExchangeManager) {
+		t.Errorf("received '%v' expected '%v'", err, errNilExchangeManager)
+	}
+	_, err = SetupOrderbookRecorder(SetupExchangeManager(), nil, nil)
+	if !errors.Is(err, errNilDatabaseConnectionManager) {
+		t.Errorf("received '%v' expected '%v'", err, errNilDatabaseConnectionManager)
+	}
+	_, err = SetupOrderbookRecorder(SetupExchangeManager(), &DatabaseConnectionManager{}, nil)
+	if !errors.Is(err, errNilConfig) {
+		t.Errorf("received '%v' expected '%v'", err, errNilConfig)
+	}
+	_, err = SetupOrderbookRecorder(SetupExchangeManager(), &DatabaseConnectionManager{}, &config.OrderbookRecorder{})
+	if !errors.Is(err, database.ErrNilInstance) {
+		t.Errorf("received '%v' expected '%v'", err, database.ErrNilInstance)
+	}
+
+	dbInst := &database.Instance{}
+	err = dbInst.SetConfig(&database.Config{Enabled: true})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = dbInst.SetSQLiteConnection(&sql.DB{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	dbCM := &DatabaseConnectionManager{dbConn: dbInst, started: 1}
+
+	cfg := &config.OrderbookRecorder{
+		Items: []config.OrderbookRecorderItem{{Exchange: testExchange}},
+	}
+	_, err = SetupOrderbookRecorder(SetupExchangeManager(), dbCM, cfg)
+	if !errors.Is(err, errInvalidOrderbookRecorderItem) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidOrderbookRecorderItem)
+	}
+
+	item := config.OrderbookRecorderItem{
+		Exchange: testExchange,
+		Asset:    asset.Spot,
+		Pair:     currency.NewPair(currency.BTC, currency.USD),
+		Interval: time.Millisecond,
+	}
+	cfg.Items = []config.OrderbookRecorderItem{item}
+	_, err = SetupOrderbookRecorder(SetupExchangeManager(), dbCM, cfg)
+	if !errors.Is(err, errInvalidOrderbookRecorderItem) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidOrderbookRecorderItem)
+	}
+
+	item.Interval = 0
+	cfg.Items = []config.OrderbookRecorderItem{item, item}
+	_, err = SetupOrderbookRecorder(SetupExchangeManager(), dbCM, cfg)
+	if !errors.Is(err, errOrderbookRecorderItemAlreadyExists) {
+		t.Errorf("received '%v' expected '%v'", err, errOrderbookRecorderItemAlreadyExists)
+	}
+
+	item.Depth = 5
+	cfg.Items = []config.OrderbookRecorderItem{item}
+	r, err := SetupOrderbookRecorder(SetupExchangeManager(), dbCM, cfg)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(r.items) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(r.items), 1)
+	}
+	if r.items[0].interval != defaultOrderbookRecorderInterval {
+		t.Errorf("received '%v' expected '%v'", r.items[0].interval, defaultOrderbookRecorderInterval)
+	}
+	if r.items[0].depth != 5 {
+		t.Errorf("received '%v' expected '%v'", r.items[0].depth, 5)
+	}
+}
+
+func TestOrderbookRecorderStartStop(t *testing.T) {
+	t.Parallel()
+	var r *OrderbookRecorder
+	if err := r.Start(); !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+	if err := r.Stop(); !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+	if r.IsRunning() {
+		t.Error("expected not running")
+	}
+
+	r = &OrderbookRecorder{databaseConnectionInstance: &database.Instance{}}
+	if err := r.Start(); !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if err := r.Start(); !errors.Is(err, ErrSubSystemAlreadyStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemAlreadyStarted)
+	}
+	if !r.IsRunning() {
+		t.Error("expected running")
+	}
+	if err := r.Stop(); !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if err := r.Stop(); !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemNotStarted)
+	}
+}
+
+func TestOrderbookRecorderRecord(t *testing.T) {
+	t.Parallel()
+	var saved []dborderbook.Snapshot
+	r := &OrderbookRecorder{
+		exchangeManager: &dsmExchangeManager{exch: &obrExchange{}},
+		items: []*orderbookRecorderItem{{
+			exchange: testExchange,
+			asset:    asset.Spot,
+			pair:     currency.NewPair(currency.BTC, currency.USD),
+			interval: time.Minute,
+			depth:    3,
+		}},
+		snapshotSaver: func(s ...dborderbook.Snapshot) error {
+			saved = append(saved, s...)
+			return nil
+		},
+	}
+	now := time.Date(2021, 1, 1, 0, 0, 30, 500, time.UTC)
+	err := r.record(now)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(saved) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(saved), 1)
+	}
+	if len(saved[0].Bids) != 3 || len(saved[0].Asks) != 3 {
+		t.Errorf("received '%v' '%v' expected '%v'", len(saved[0].Bids), len(saved[0].Asks), 3)
+	}
+	if !saved[0].Timestamp.Equal(now.Truncate(time.Second)) {
+		t.Errorf("received '%v' expected '%v'", saved[0].Timestamp, now.Truncate(time.Second))
+	}
+	next := time.Date(2021, 1, 1, 0, 1, 0, 0, time.UTC)
+	if !r.items[0].nextRecord.Equal(next) {
+		t.Errorf("received '%v' expected '%v'", r.items[0].nextRecord, next)
+	}
+	if wait := r.untilNextRecord(now); wait != next.Sub(now) {
+		t.Errorf("received '%v' expected '%v'", wait, next.Sub(now))
+	}
+
+	// items are not recorded again until they are due
+	err = r.record(now.Add(time.Second))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(saved) != 1 {
+		t.Errorf("received '%v' expected '%v'", len(saved), 1)
+	}
+
+	r.snapshotSaver = func(...dborderbook.Snapshot) error {
+		return errDataSyncTest
+	}
+	err = r.record(next)
+	if !errors.Is(err, errDataSyncTest) {
+		t.Errorf("received '%v' expected '%v'", err, errDataSyncTest)
+	}
+}
+
+func TestLevelsToDepth(t *testing.T) {
+	t.Parallel()
+	items := orderbook.Items{{Price: 1, Amount: 2}, {Price: 3, Amount: 4}}
+	if resp := levelsToDepth(items, 1); len(resp) != 1 || resp[0].Price != 1 || resp[0].Amount != 2 {
+		t.Errorf("unexpected levels %+v", resp)
+	}
+	if resp := levelsToDepth(items, 0); len(resp) != 2 {
+		t.Errorf("received '%v' expected '%v'", len(resp), 2)
+	}
+}
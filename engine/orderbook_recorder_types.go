@@ -0,0 +1,48 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	dborderbook "github.com/thrasher-corp/gocryptotrader/database/repository/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+const orderbookRecorderName = "orderbook_recorder"
+
+var (
+	errInvalidOrderbookRecorderItem       = errors.New("invalid orderbook recorder item")
+	errOrderbookRecorderItemAlreadyExists = errors.New("orderbook recorder item already exists")
+
+	defaultOrderbookRecorderInterval = time.Minute
+	defaultOrderbookRecorderDepth    = 20
+	// minimumOrderbookRecorderInterval matches the second precision that
+	// snapshots are stored at
+	minimumOrderbookRecorderInterval = time.Second
+)
+
+// OrderbookRecorder periodically stores L2 orderbook snapshots for configured
+// exchange, asset and pairs to the database
+type OrderbookRecorder struct {
+	exchangeManager            iExchangeManager
+	databaseConnectionInstance database.IDatabase
+	started                    int32
+	shutdown                   chan struct{}
+	verbose                    bool
+	items                      []*orderbookRecorderItem
+	m                          sync.Mutex
+	snapshotSaver              func(...dborderbook.Snapshot) error
+}
+
+// orderbookRecorderItem holds the recording settings of a configured item
+type orderbookRecorderItem struct {
+	exchange   string
+	asset      asset.Item
+	pair       currency.Pair
+	interval   time.Duration
+	depth      int
+	nextRecord time.Time
+}
@@ -0,0 +1,321 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// newPaperTrader creates a paper trader seeded with the starting balances
+func newPaperTrader(balances []config.PaperTradingBalance) (*paperTrader, error) {
+	p := &paperTrader{
+		balances:         make(map[string]map[*currency.Item]*PaperBalance),
+		orderbookFetcher: orderbook.Get,
+		feeFetcher:       fetchTakerFeeRate,
+	}
+	for i := range balances {
+		if balances[i].Exchange == "" ||
+			balances[i].Currency.IsEmpty() ||
+			balances[i].Amount < 0 {
+			return nil, fmt.Errorf("%w, received '%s' '%s' '%v'",
+				errInvalidPaperBalance,
+				balances[i].Exchange,
+				balances[i].Currency,
+				balances[i].Amount)
+		}
+		p.getBalance(balances[i].Exchange, balances[i].Currency).Amount += balances[i].Amount
+	}
+	return p, nil
+}
+
+// getBalance returns the balance of a currency on an exchange, creating an
+// empty balance when it does not exist. The lock must be held by the caller
+// once the paper trader is in use
+func (p *paperTrader) getBalance(exchangeName string, code currency.Code) *PaperBalance {
+	name := strings.ToLower(exchangeName)
+	exchBalances, ok := p.balances[name]
+	if !ok {
+		exchBalances = make(map[*currency.Item]*PaperBalance)
+		p.balances[name] = exchBalances
+	}
+	balance, ok := exchBalances[code.Item]
+	if !ok {
+		balance = &PaperBalance{
+			Exchange: name,
+			Currency: code.Upper(),
+		}
+		exchBalances[code.Item] = balance
+	}
+	return balance
+}
+
+// getBalances returns a copy of the virtual balances of an exchange, or of
+// all exchanges when no exchange name is supplied
+func (p *paperTrader) getBalances(exchangeName string) []PaperBalance {
+	p.m.Lock()
+	defer p.m.Unlock()
+	var balances []PaperBalance
+	for name, exchBalances := range p.balances {
+		if exchangeName != "" && !strings.EqualFold(name, exchangeName) {
+			continue
+		}
+		for _, balance := range exchBalances {
+			balances = append(balances, *balance)
+		}
+	}
+	sort.Slice(balances, func(i, j int) bool {
+		if balances[i].Exchange != balances[j].Exchange {
+			return balances[i].Exchange < balances[j].Exchange
+		}
+		return balances[i].Currency.String() < balances[j].Currency.String()
+	})
+	return balances
+}
+
+// fill matches up to the amount of an order against the exchange's latest
+// orderbook, taking liquidity from the asks for buys and bids for sells. Limit
+// orders only match levels at or better than their price. The fill is applied
+// to the virtual balances and rejected when they cannot cover it
+func (p *paperTrader) fill(ctx context.Context, exch exchange.IBotExchange, s *order.Submit, amount float64) (*paperFill, error) {
+	exchName := exch.GetName()
+	ob, err := p.orderbookFetcher(exchName, s.Pair, s.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	buy := s.Side == order.Buy || s.Side == order.Bid
+	items := ob.Asks
+	if !buy {
+		items = ob.Bids
+	}
+	var limit float64
+	if s.Type != order.Market {
+		limit = s.Price
+	}
+	f := &paperFill{}
+	f.amount, f.notional = matchPaperOrder(items, amount, limit, buy)
+	switch {
+	case s.PostOnly && f.amount > 0:
+		return nil, errPaperPostOnlyWouldMatch
+	case s.FillOrKill && f.amount < amount:
+		return nil, errPaperFillOrKillNotFilled
+	case f.amount == 0:
+		return f, nil
+	}
+
+	feeRate, err := p.feeFetcher(ctx, exch, s.Pair, f.notional/f.amount, f.amount)
+	if err != nil {
+		log.Warnf(log.OrderMgr, "Order manager: Unable to retrieve %s taker fee, paper fill will not include fees: %v", exchName, err)
+		feeRate = 0
+	}
+	f.fee = f.notional * feeRate
+
+	p.m.Lock()
+	defer p.m.Unlock()
+	base := p.getBalance(exchName, s.Pair.Base)
+	quote := p.getBalance(exchName, s.Pair.Quote)
+	if buy {
+		if quote.Amount < f.notional+f.fee {
+			return nil, fmt.Errorf("%w, %v %s required but %v available",
+				errInsufficientPaperBalance, f.notional+f.fee, quote.Currency, quote.Amount)
+		}
+		quote.Amount -= f.notional + f.fee
+		base.Amount += f.amount
+		return f, nil
+	}
+	if base.Amount < f.amount {
+		return nil, fmt.Errorf("%w, %v %s required but %v available",
+			errInsufficientPaperBalance, f.amount, base.Currency, base.Amount)
+	}
+	base.Amount -= f.amount
+	quote.Amount += f.notional - f.fee
+	return f, nil
+}
+
+// matchPaperOrder walks the sorted orderbook levels until the amount is
+// filled or the limit price is exceeded and returns the amount filled and its
+// notional value. A limit of zero matches any price
+func matchPaperOrder(items orderbook.Items, amount, limit float64, buy bool) (filled, notional float64) {
+	for i := range items {
+		if filled >= amount {
+			break
+		}
+		if items[i].Price <= 0 || items[i].Amount <= 0 {
+			continue
+		}
+		if limit > 0 && ((buy && items[i].Price > limit) || (!buy && items[i].Price < limit)) {
+			break
+		}
+		take := items[i].Amount
+		if take > amount-filled {
+			take = amount - filled
+		}
+		filled += take
+		notional += take * items[i].Price
+	}
+	return filled, notional
+}
+
+// EnablePaperTrading switches the order manager to simulate fills against
+// live orderbooks and track virtual balances instead of submitting, modifying
+// or cancelling orders on exchanges. It must be called before the order
+// manager is started
+func (m *OrderManager) EnablePaperTrading(balances []config.PaperTradingBalance) error {
+	if m == nil {
+		return fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 1 {
+		return fmt.Errorf("order manager %w", ErrSubSystemAlreadyStarted)
+	}
+	p, err := newPaperTrader(balances)
+	if err != nil {
+		return err
+	}
+	m.paperTrader = p
+	log.Warnln(log.OrderMgr, "Order manager: Paper trading enabled, orders will be simulated and not submitted to exchanges")
+	return nil
+}
+
+// IsPaperTrading returns whether orders are being simulated rather than
+// submitted to exchanges
+func (m *OrderManager) IsPaperTrading() bool {
+	return m != nil && m.paperTrader != nil
+}
+
+// GetPaperBalances returns the virtual balances of an exchange, or of all
+// exchanges when no exchange name is supplied
+func (m *OrderManager) GetPaperBalances(exchangeName string) ([]PaperBalance, error) {
+	if m == nil {
+		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	if m.paperTrader == nil {
+		return nil, errPaperTradingNotEnabled
+	}
+	return m.paperTrader.getBalances(exchangeName), nil
+}
+
+// submitPaperOrder fills as much of an order as the orderbook allows and adds
+// it to the order store. The unfilled amount of market and immediate or
+// cancel orders is cancelled, while the remainder of a limit order rests until
+// it can be filled
+func (m *OrderManager) submitPaperOrder(ctx context.Context, exch exchange.IBotExchange, newOrder *order.Submit) (*OrderSubmitResponse, error) {
+	f, err := m.paperTrader.fill(ctx, exch, newOrder, newOrder.Amount)
+	if err != nil {
+		return nil, fmt.Errorf("order manager: exchange %s unable to fill paper order: %w",
+			newOrder.Exchange,
+			err)
+	}
+	if newOrder.Type == order.Market && f.amount == 0 {
+		return nil, fmt.Errorf("order manager: exchange %s %s %s %w",
+			newOrder.Exchange,
+			newOrder.AssetType,
+			newOrder.Pair,
+			errNoPaperLiquidity)
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := m.processSubmittedOrder(newOrder, order.SubmitResponse{
+		IsOrderPlaced: true,
+		OrderID:       paperOrderIDPrefix + id.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	closeRemainder := newOrder.Type == order.Market || newOrder.ImmediateOrCancel
+	err = m.applyPaperFill(newOrder.Exchange, resp.OrderID, f, closeRemainder)
+	if err != nil {
+		return nil, err
+	}
+	resp.FullyMatched = f.amount >= newOrder.Amount
+	return resp, nil
+}
+
+// applyPaperFill updates a paper order in the order store with a fill and
+// records it as a trade
+func (m *OrderManager) applyPaperFill(exchangeName, id string, f *paperFill, closeRemainder bool) error {
+	od, err := m.orderStore.getByExchangeAndID(exchangeName, id)
+	if err != nil {
+		return err
+	}
+	m.orderStore.m.Lock()
+	defer m.orderStore.m.Unlock()
+	now := time.Now()
+	if f.amount > 0 {
+		od.AverageExecutedPrice = (od.AverageExecutedPrice*od.ExecutedAmount + f.notional) / (od.ExecutedAmount + f.amount)
+		od.ExecutedAmount += f.amount
+		od.Fee += f.fee
+		od.Trades = append(od.Trades, order.TradeHistory{
+			Price:     f.notional / f.amount,
+			Amount:    f.amount,
+			Fee:       f.fee,
+			Exchange:  od.Exchange,
+			TID:       fmt.Sprintf("%s-%d", id, len(od.Trades)+1),
+			Type:      od.Type,
+			Side:      od.Side,
+			Timestamp: now,
+			Total:     f.notional,
+		})
+	}
+	od.RemainingAmount = od.Amount - od.ExecutedAmount
+	switch {
+	case od.RemainingAmount <= 0:
+		od.RemainingAmount = 0
+		od.Status = order.Filled
+	case closeRemainder:
+		od.Status = order.Cancelled
+	case od.ExecutedAmount > 0:
+		od.Status = order.PartiallyFilled
+	}
+	od.LastUpdated = now
+	return nil
+}
+
+// processPaperOrders attempts to fill the remainder of resting paper orders
+// against the latest orderbooks
+func (m *OrderManager) processPaperOrders() {
+	orders := m.orderStore.getActiveOrders(nil)
+	for i := range orders {
+		if !strings.HasPrefix(orders[i].ID, paperOrderIDPrefix) {
+			continue
+		}
+		exch, err := m.orderStore.exchangeManager.GetExchangeByName(orders[i].Exchange)
+		if err != nil {
+			log.Errorf(log.OrderMgr, "Order manager: Unable to process paper order ID=%v: %v", orders[i].ID, err)
+			continue
+		}
+		f, err := m.paperTrader.fill(context.TODO(), exch, &order.Submit{
+			Exchange:  orders[i].Exchange,
+			Pair:      orders[i].Pair,
+			AssetType: orders[i].AssetType,
+			Side:      orders[i].Side,
+			Type:      orders[i].Type,
+			Price:     orders[i].Price,
+		}, orders[i].Amount-orders[i].ExecutedAmount)
+		if err != nil {
+			log.Errorf(log.OrderMgr, "Order manager: Unable to fill %s paper order ID=%v: %v", orders[i].Exchange, orders[i].ID, err)
+			continue
+		}
+		if f.amount == 0 {
+			continue
+		}
+		err = m.applyPaperFill(orders[i].Exchange, orders[i].ID, f, false)
+		if err != nil {
+			log.Errorf(log.OrderMgr, "Order manager: Unable to update %s paper order ID=%v: %v", orders[i].Exchange, orders[i].ID, err)
+		}
+	}
+}
@@ -0,0 +1,258 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// ptExchange aka paper trading fake exchange only implements the functions
+// used before an order is submitted, any calls to submit, cancel or modify
+// orders on the exchange will panic
+type ptExchange struct {
+	exchange.IBotExchange
+	base exchange.Base
+}
+
+func (p *ptExchange) GetBase() *exchange.Base {
+	return &p.base
+}
+
+func (p *ptExchange) GetName() string {
+	return "paperexchange"
+}
+
+func (p *ptExchange) CheckOrderExecutionLimits(asset.Item, currency.Pair, float64, float64, order.Type) error {
+	return nil
+}
+
+func (p *ptExchange) CanTradePair(currency.Pair, asset.Item) error {
+	return nil
+}
+
+func TestNewPaperTrader(t *testing.T) {
+	t.Parallel()
+	_, err := newPaperTrader([]config.PaperTradingBalance{{Currency: currency.BTC, Amount: 1}})
+	if !errors.Is(err, errInvalidPaperBalance) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidPaperBalance)
+	}
+	_, err = newPaperTrader([]config.PaperTradingBalance{{Exchange: "a", Currency: currency.BTC, Amount: -1}})
+	if !errors.Is(err, errInvalidPaperBalance) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidPaperBalance)
+	}
+
+	p, err := newPaperTrader([]config.PaperTradingBalance{
+		{Exchange: "B", Currency: currency.USDT, Amount: 5},
+		{Exchange: "a", Currency: currency.USDT, Amount: 1},
+		{Exchange: "a", Currency: currency.BTC, Amount: 2},
+		{Exchange: "A", Currency: currency.BTC, Amount: 3},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	balances := p.getBalances("")
+	if len(balances) != 3 {
+		t.Fatalf("received '%v' expected '%v'", len(balances), 3)
+	}
+	if balances[0].Exchange != "a" || !balances[0].Currency.Match(currency.BTC) || balances[0].Amount != 5 {
+		t.Errorf("unexpected balance %+v", balances[0])
+	}
+	if balances[2].Exchange != "b" || balances[2].Amount != 5 {
+		t.Errorf("unexpected balance %+v", balances[2])
+	}
+	if balances = p.getBalances("B"); len(balances) != 1 {
+		t.Errorf("received '%v' expected '%v'", len(balances), 1)
+	}
+}
+
+func TestMatchPaperOrder(t *testing.T) {
+	t.Parallel()
+	asks := orderbook.Items{{Price: 100, Amount: 1}, {Price: 110, Amount: 1}}
+	filled, notional := matchPaperOrder(asks, 1.5, 0, true)
+	if filled != 1.5 || notional != 155 {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", filled, notional, 1.5, 155)
+	}
+	filled, notional = matchPaperOrder(asks, 1.5, 105, true)
+	if filled != 1 || notional != 100 {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", filled, notional, 1, 100)
+	}
+	bids := orderbook.Items{{Price: 99, Amount: 1}, {Price: 90, Amount: 2}}
+	filled, notional = matchPaperOrder(bids, 5, 95, false)
+	if filled != 1 || notional != 99 {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", filled, notional, 1, 99)
+	}
+}
+
+func TestPaperTrading(t *testing.T) {
+	t.Parallel()
+	em := SetupExchangeManager()
+	em.Add(&ptExchange{})
+	var wg sync.WaitGroup
+	m, err := SetupOrderManager(em, &CommunicationManager{}, &wg, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	var nilManager *OrderManager
+	err = nilManager.EnablePaperTrading(nil)
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+	err = m.EnablePaperTrading([]config.PaperTradingBalance{{}})
+	if !errors.Is(err, errInvalidPaperBalance) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidPaperBalance)
+	}
+	m.started = 1
+	_, err = m.GetPaperBalances("")
+	if !errors.Is(err, errPaperTradingNotEnabled) {
+		t.Errorf("received '%v' expected '%v'", err, errPaperTradingNotEnabled)
+	}
+	err = m.EnablePaperTrading(nil)
+	if !errors.Is(err, ErrSubSystemAlreadyStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemAlreadyStarted)
+	}
+	m.started = 0
+	err = m.EnablePaperTrading([]config.PaperTradingBalance{
+		{Exchange: "paperexchange", Currency: currency.USDT, Amount: 1000},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	m.started = 1
+	if !m.IsPaperTrading() {
+		t.Error("expected paper trading")
+	}
+
+	book := &orderbook.Base{
+		Asks: orderbook.Items{{Price: 100, Amount: 1}, {Price: 110, Amount: 1}},
+		Bids: orderbook.Items{{Price: 99, Amount: 1}, {Price: 90, Amount: 2}},
+	}
+	m.paperTrader.orderbookFetcher = func(string, currency.Pair, asset.Item) (*orderbook.Base, error) {
+		return book, nil
+	}
+	m.paperTrader.feeFetcher = func(context.Context, exchange.IBotExchange, currency.Pair, float64, float64) (float64, error) {
+		return 0.01, nil
+	}
+
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	submit := func(side order.Side, orderType order.Type, amount, price float64) (*OrderSubmitResponse, error) {
+		return m.Submit(context.Background(), &order.Submit{
+			Exchange:  "paperexchange",
+			Pair:      cp,
+			AssetType: asset.Spot,
+			Side:      side,
+			Type:      orderType,
+			Amount:    amount,
+			Price:     price,
+		})
+	}
+
+	resp, err := submit(order.Buy, order.Market, 1.5, 0)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !resp.FullyMatched {
+		t.Error("expected market order to be fully matched")
+	}
+	od, err := m.GetOrderInfo(context.Background(), "paperexchange", resp.OrderID, cp, asset.Spot)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if od.Status != order.Filled || od.ExecutedAmount != 1.5 || od.AverageExecutedPrice != 155/1.5 || len(od.Trades) != 1 {
+		t.Errorf("unexpected order %+v", od)
+	}
+	balances, err := m.GetPaperBalances("paperexchange")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(balances) != 2 || balances[0].Amount != 1.5 || balances[1].Amount != 1000-(155+155*0.01) {
+		t.Errorf("unexpected balances %+v", balances)
+	}
+
+	resp, err = submit(order.Buy, order.Limit, 1, 95)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if resp.FullyMatched {
+		t.Error("expected limit order to rest")
+	}
+	m.processOrders()
+	od, err = m.GetOrderInfo(context.Background(), "paperexchange", resp.OrderID, cp, asset.Spot)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if od.Status != order.New || od.ExecutedAmount != 0 {
+		t.Errorf("unexpected order %+v", od)
+	}
+
+	book.Asks = orderbook.Items{{Price: 94, Amount: 0.5}}
+	m.processOrders()
+	od, err = m.GetOrderInfo(context.Background(), "paperexchange", resp.OrderID, cp, asset.Spot)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if od.Status != order.PartiallyFilled || od.ExecutedAmount != 0.5 || od.RemainingAmount != 0.5 {
+		t.Errorf("unexpected order %+v", od)
+	}
+
+	err = m.Cancel(context.Background(), &order.Cancel{Exchange: "paperexchange", ID: resp.OrderID})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = m.Cancel(context.Background(), &order.Cancel{Exchange: "paperexchange", ID: resp.OrderID})
+	if err == nil {
+		t.Error("expected error cancelling an inactive paper order")
+	}
+	err = m.Cancel(context.Background(), &order.Cancel{Exchange: "paperexchange", ID: "realorder"})
+	if !errors.Is(err, errNotPaperOrder) {
+		t.Errorf("received '%v' expected '%v'", err, errNotPaperOrder)
+	}
+	_, err = m.Modify(context.Background(), &order.Modify{Exchange: "paperexchange", ID: resp.OrderID})
+	if !errors.Is(err, errPaperTradingUnsupported) {
+		t.Errorf("received '%v' expected '%v'", err, errPaperTradingUnsupported)
+	}
+
+	_, err = submit(order.Sell, order.Market, 3, 0)
+	if !errors.Is(err, errInsufficientPaperBalance) {
+		t.Errorf("received '%v' expected '%v'", err, errInsufficientPaperBalance)
+	}
+	_, err = m.Submit(context.Background(), &order.Submit{
+		Exchange:  "paperexchange",
+		Pair:      cp,
+		AssetType: asset.Spot,
+		Side:      order.Sell,
+		Type:      order.Limit,
+		Amount:    1,
+		Price:     99,
+		PostOnly:  true,
+	})
+	if !errors.Is(err, errPaperPostOnlyWouldMatch) {
+		t.Errorf("received '%v' expected '%v'", err, errPaperPostOnlyWouldMatch)
+	}
+	_, err = m.Submit(context.Background(), &order.Submit{
+		Exchange:   "paperexchange",
+		Pair:       cp,
+		AssetType:  asset.Spot,
+		Side:       order.Sell,
+		Type:       order.Limit,
+		Amount:     5,
+		Price:      90,
+		FillOrKill: true,
+	})
+	if !errors.Is(err, errPaperFillOrKillNotFilled) {
+		t.Errorf("received '%v' expected '%v'", err, errPaperFillOrKillNotFilled)
+	}
+
+	book.Asks = nil
+	_, err = submit(order.Buy, order.Market, 1, 0)
+	if !errors.Is(err, errNoPaperLiquidity) {
+		t.Errorf("received '%v' expected '%v'", err, errNoPaperLiquidity)
+	}
+}
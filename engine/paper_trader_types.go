@@ -0,0 +1,51 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// paperOrderIDPrefix identifies orders which were filled by the paper trader
+// rather than submitted to an exchange
+const paperOrderIDPrefix = "paper-"
+
+var (
+	errPaperTradingNotEnabled   = errors.New("paper trading is not enabled")
+	errInsufficientPaperBalance = errors.New("insufficient paper trading balance")
+	errNoPaperLiquidity         = errors.New("no orderbook liquidity to fill paper order")
+	errPaperFillOrKillNotFilled = errors.New("fill or kill paper order cannot be completely filled")
+	errPaperPostOnlyWouldMatch  = errors.New("post only paper order would match the orderbook")
+	errPaperTradingUnsupported  = errors.New("not supported while paper trading")
+	errInvalidPaperBalance      = errors.New("paper trading balance requires an exchange, currency and a non negative amount")
+	errNotPaperOrder            = errors.New("only paper orders can be cancelled while paper trading")
+)
+
+// paperTrader simulates order fills against the latest orderbooks of an
+// exchange and tracks the resulting virtual balances. No orders are sent to
+// exchanges while it is in use
+type paperTrader struct {
+	m                sync.Mutex
+	balances         map[string]map[*currency.Item]*PaperBalance
+	orderbookFetcher func(string, currency.Pair, asset.Item) (*orderbook.Base, error)
+	feeFetcher       func(context.Context, exchange.IBotExchange, currency.Pair, float64, float64) (float64, error)
+}
+
+// PaperBalance holds the virtual balance of a currency on an exchange
+type PaperBalance struct {
+	Exchange string
+	Currency currency.Code
+	Amount   float64
+}
+
+// paperFill holds the result of matching a paper order against an orderbook
+type paperFill struct {
+	amount   float64
+	notional float64
+	fee      float64
+}
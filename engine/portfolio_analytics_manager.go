@@ -0,0 +1,350 @@
+package engine
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupPortfolioAnalyticsManager creates a portfolio analytics manager which
+// reads fills from the supplied order manager
+func SetupPortfolioAnalyticsManager(om iOrderSnapshotter, delay time.Duration, verbose bool) (*PortfolioAnalyticsManager, error) {
+	if om == nil {
+		return nil, errNilOrderManager
+	}
+	if delay <= 0 {
+		delay = PortfolioAnalyticsSleepDelay
+	}
+	return &PortfolioAnalyticsManager{
+		shutdown:     make(chan struct{}),
+		delay:        delay,
+		verbose:      verbose,
+		orderManager: om,
+		priceFetcher: fetchLastPrice,
+		positions:    make(map[string]*pnlPosition),
+		fills:        make(map[string]*pnlFill),
+	}, nil
+}
+
+// Start runs the subsystem
+func (p *PortfolioAnalyticsManager) Start() error {
+	if p == nil {
+		return ErrNilSubsystem
+	}
+	if !atomic.CompareAndSwapInt32(&p.started, 0, 1) {
+		return ErrSubSystemAlreadyStarted
+	}
+	p.shutdown = make(chan struct{})
+	go p.run()
+	log.Debugf(log.OrderMgr, "Portfolio analytics manager %v", MsgSubSystemStarted)
+	return nil
+}
+
+// IsRunning checks whether the subsystem is running
+func (p *PortfolioAnalyticsManager) IsRunning() bool {
+	if p == nil {
+		return false
+	}
+	return atomic.LoadInt32(&p.started) == 1
+}
+
+// Stop stops the subsystem
+func (p *PortfolioAnalyticsManager) Stop() error {
+	if p == nil {
+		return ErrNilSubsystem
+	}
+	if !atomic.CompareAndSwapInt32(&p.started, 1, 0) {
+		return ErrSubSystemNotStarted
+	}
+	close(p.shutdown)
+	log.Debugf(log.OrderMgr, "Portfolio analytics manager %v", MsgSubSystemShutdown)
+	return nil
+}
+
+func (p *PortfolioAnalyticsManager) run() {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-p.shutdown:
+			return
+		case <-timer.C:
+			p.update()
+			timer.Reset(p.delay)
+		}
+	}
+}
+
+// update applies any new fills from the order manager and refreshes the last
+// price of every open position
+func (p *PortfolioAnalyticsManager) update() {
+	orders, _ := p.orderManager.GetOrdersSnapshot(order.AnyStatus)
+	p.m.Lock()
+	defer p.m.Unlock()
+	for i := range orders {
+		p.processOrder(&orders[i])
+	}
+	for _, pos := range p.positions {
+		if pos.quantity == 0 {
+			continue
+		}
+		price, err := p.priceFetcher(pos.exchange, pos.pair, pos.asset)
+		if err != nil {
+			if p.verbose {
+				log.Warnf(log.OrderMgr, "Portfolio analytics manager unable to price %s %s %s: %v",
+					pos.exchange, pos.asset, pos.pair, err)
+			}
+			continue
+		}
+		pos.lastPrice = price
+	}
+}
+
+// processOrder applies the portion of an order's executed amount which has not
+// yet been applied to its position, the lock must be held by the caller
+func (p *PortfolioAnalyticsManager) processOrder(d *order.Detail) {
+	amount, price := applyOrderFill(p.fills, p.positions, d)
+	if amount > 0 && p.verbose {
+		log.Debugf(log.OrderMgr, "Portfolio analytics manager applied %s %s %s %s fill of %v at %v",
+			d.Exchange, d.AssetType, d.Pair, d.Side, amount, price)
+	}
+}
+
+// applyOrderFill applies the portion of an order's executed amount which has
+// not yet been recorded in fills to its position, returning the amount and
+// price applied. Nothing is applied when the order has no new executions
+func applyOrderFill(fills map[string]*pnlFill, positions map[string]*pnlPosition, d *order.Detail) (amount, fillPrice float64) {
+	var buy bool
+	switch d.Side {
+	case order.Buy, order.Bid:
+		buy = true
+	case order.Sell, order.Ask:
+	default:
+		return 0, 0
+	}
+	executed := d.ExecutedAmount
+	if executed == 0 && d.Status == order.Filled {
+		executed = d.Amount
+	}
+	price := d.AverageExecutedPrice
+	if price == 0 {
+		price = d.Price
+	}
+	if executed <= 0 || price <= 0 || d.Pair.IsEmpty() {
+		return 0, 0
+	}
+
+	exch := strings.ToLower(d.Exchange)
+	fillKey := exch + "|" + d.ID
+	if d.ID == "" {
+		fillKey = exch + "|" + d.InternalOrderID
+	}
+	fill, ok := fills[fillKey]
+	if !ok {
+		fill = &pnlFill{}
+		fills[fillKey] = fill
+	}
+	amount = executed - fill.executed
+	if amount <= 0 {
+		return 0, 0
+	}
+	cost := executed * price
+	fillPrice = (cost - fill.cost) / amount
+	if fillPrice <= 0 {
+		fillPrice = price
+	}
+	fee := d.Fee - fill.fee
+	if fee < 0 {
+		fee = 0
+	}
+	fill.executed, fill.cost, fill.fee = executed, cost, d.Fee
+
+	posKey := positionKey(exch, d.AssetType, d.Pair)
+	pos, ok := positions[posKey]
+	if !ok {
+		pos = &pnlPosition{
+			exchange: exch,
+			asset:    d.AssetType,
+			pair:     d.Pair.Upper(),
+		}
+		positions[posKey] = pos
+	}
+	pos.applyFill(buy, amount, fillPrice, fee)
+	pos.lastUpdated = d.LastUpdated
+	if pos.lastUpdated.IsZero() {
+		pos.lastUpdated = time.Now()
+	}
+	if pos.lastPrice == 0 {
+		pos.lastPrice = fillPrice
+	}
+	return amount, fillPrice
+}
+
+// applyFill updates the position with a fill using average cost accounting.
+// Fills which reduce the position realise profit or loss against the average
+// entry price and fills which cross zero open a new position at the fill price
+func (pos *pnlPosition) applyFill(buy bool, amount, price, fee float64) {
+	signed := amount
+	if !buy {
+		signed = -amount
+	}
+	pos.trades++
+	pos.fees += fee
+	pos.volume += amount * price
+
+	if pos.quantity == 0 || (pos.quantity > 0) == buy {
+		quantity := pos.quantity + signed
+		pos.averagePrice = (math.Abs(pos.quantity)*pos.averagePrice + amount*price) / math.Abs(quantity)
+		pos.quantity = quantity
+		return
+	}
+
+	closing := math.Min(amount, math.Abs(pos.quantity))
+	pnl := (price - pos.averagePrice) * closing
+	if pos.quantity < 0 {
+		pnl = -pnl
+	}
+	pos.realisedPnL += pnl
+	switch {
+	case pnl > 0:
+		pos.winningTrades++
+	case pnl < 0:
+		pos.losingTrades++
+	}
+	pos.quantity += signed
+	switch {
+	case amount > closing:
+		pos.averagePrice = price
+	case pos.quantity == 0:
+		pos.averagePrice = 0
+	}
+}
+
+// unrealisedPnL returns the profit or loss of the open quantity against the
+// last price
+func (pos *pnlPosition) unrealisedPnL() float64 {
+	if pos.quantity == 0 || pos.lastPrice == 0 {
+		return 0
+	}
+	return (pos.lastPrice - pos.averagePrice) * pos.quantity
+}
+
+// GetPositions returns the profit and loss of all tracked positions, optionally
+// filtered by exchange, asset and pair
+func (p *PortfolioAnalyticsManager) GetPositions(exchangeName string, a asset.Item, cp currency.Pair) ([]PositionPnL, error) {
+	if !p.IsRunning() {
+		return nil, fmt.Errorf("%s %w", portfolioAnalyticsManagerName, ErrSubSystemNotStarted)
+	}
+	p.m.Lock()
+	defer p.m.Unlock()
+	var resp []PositionPnL
+	for _, pos := range p.positions {
+		if exchangeName != "" && !strings.EqualFold(pos.exchange, exchangeName) {
+			continue
+		}
+		if a != "" && pos.asset != a {
+			continue
+		}
+		if !cp.IsEmpty() && !pos.pair.Equal(cp) {
+			continue
+		}
+		unrealised := pos.unrealisedPnL()
+		resp = append(resp, PositionPnL{
+			Exchange:          pos.exchange,
+			Asset:             pos.asset,
+			Pair:              pos.pair,
+			Quantity:          pos.quantity,
+			AverageEntryPrice: pos.averagePrice,
+			LastPrice:         pos.lastPrice,
+			RealisedPnL:       pos.realisedPnL,
+			UnrealisedPnL:     unrealised,
+			Fees:              pos.fees,
+			NetPnL:            pos.realisedPnL + unrealised - pos.fees,
+			Volume:            pos.volume,
+			Trades:            pos.trades,
+			WinningTrades:     pos.winningTrades,
+			LosingTrades:      pos.losingTrades,
+			LastUpdated:       pos.lastUpdated,
+		})
+	}
+	if len(resp) == 0 {
+		return nil, errNoPositionsFound
+	}
+	sort.Slice(resp, func(i, j int) bool {
+		return positionKey(resp[i].Exchange, resp[i].Asset, resp[i].Pair) <
+			positionKey(resp[j].Exchange, resp[j].Asset, resp[j].Pair)
+	})
+	return resp, nil
+}
+
+// GetPerformance returns the profit and loss of all positions aggregated by
+// exchange and quote currency, optionally filtered by exchange
+func (p *PortfolioAnalyticsManager) GetPerformance(exchangeName string) ([]PerformanceSummary, error) {
+	positions, err := p.GetPositions(exchangeName, "", currency.Pair{})
+	if err != nil {
+		return nil, err
+	}
+	var resp []PerformanceSummary
+	for i := range positions {
+		var summary *PerformanceSummary
+		for j := range resp {
+			if resp[j].Exchange == positions[i].Exchange &&
+				resp[j].Quote.Match(positions[i].Pair.Quote) {
+				summary = &resp[j]
+				break
+			}
+		}
+		if summary == nil {
+			resp = append(resp, PerformanceSummary{
+				Exchange: positions[i].Exchange,
+				Quote:    positions[i].Pair.Quote,
+			})
+			summary = &resp[len(resp)-1]
+		}
+		summary.Positions++
+		summary.RealisedPnL += positions[i].RealisedPnL
+		summary.UnrealisedPnL += positions[i].UnrealisedPnL
+		summary.Fees += positions[i].Fees
+		summary.NetPnL += positions[i].NetPnL
+		summary.Volume += positions[i].Volume
+		summary.Trades += positions[i].Trades
+		summary.WinningTrades += positions[i].WinningTrades
+		summary.LosingTrades += positions[i].LosingTrades
+	}
+	for i := range resp {
+		if closed := resp[i].WinningTrades + resp[i].LosingTrades; closed > 0 {
+			resp[i].WinRate = float64(resp[i].WinningTrades) / float64(closed) * 100
+		}
+	}
+	return resp, nil
+}
+
+// positionKey returns a key which uniquely identifies a position
+func positionKey(exchangeName string, a asset.Item, cp currency.Pair) string {
+	return exchangeName + "|" + a.String() + "|" + cp.Base.Upper().String() + "|" + cp.Quote.Upper().String()
+}
+
+// fetchLastPrice returns the last traded price of a pair from the ticker
+// store, falling back to the mid price when no last price has been recorded
+func fetchLastPrice(exchangeName string, cp currency.Pair, a asset.Item) (float64, error) {
+	t, err := ticker.GetTicker(exchangeName, cp, a)
+	if err != nil {
+		return 0, err
+	}
+	if t.Last > 0 {
+		return t.Last, nil
+	}
+	if t.Bid > 0 && t.Ask > 0 {
+		return (t.Bid + t.Ask) / 2, nil
+	}
+	return 0, fmt.Errorf("%s %s %s ticker has no price", exchangeName, a, cp)
+}
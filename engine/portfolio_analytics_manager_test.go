@@ -0,0 +1,267 @@
+package engine
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// pamOrders aka portfolio analytics manager fake order manager returns the
+// orders it holds
+type pamOrders struct {
+	orders []order.Detail
+}
+
+func (f *pamOrders) GetOrdersSnapshot(order.Status) ([]order.Detail, time.Time) {
+	return f.orders, time.Now()
+}
+
+func setupTestPortfolioAnalytics(t *testing.T, orders *pamOrders, price float64) *PortfolioAnalyticsManager {
+	t.Helper()
+	p, err := SetupPortfolioAnalyticsManager(orders, time.Hour, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	p.priceFetcher = func(string, currency.Pair, asset.Item) (float64, error) {
+		return price, nil
+	}
+	p.started = 1
+	return p
+}
+
+func TestSetupPortfolioAnalyticsManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupPortfolioAnalyticsManager(nil, 0, false)
+	if !errors.Is(err, errNilOrderManager) {
+		t.Errorf("received '%v' expected '%v'", err, errNilOrderManager)
+	}
+	p, err := SetupPortfolioAnalyticsManager(&pamOrders{}, 0, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if p.delay != PortfolioAnalyticsSleepDelay {
+		t.Errorf("received '%v' expected '%v'", p.delay, PortfolioAnalyticsSleepDelay)
+	}
+}
+
+func TestPortfolioAnalyticsManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var p *PortfolioAnalyticsManager
+	if err := p.Start(); !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+	if err := p.Stop(); !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+	if p.IsRunning() {
+		t.Error("expected not running")
+	}
+
+	p, err := SetupPortfolioAnalyticsManager(&pamOrders{}, time.Hour, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if err = p.Start(); !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if err = p.Start(); !errors.Is(err, ErrSubSystemAlreadyStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemAlreadyStarted)
+	}
+	if !p.IsRunning() {
+		t.Error("expected running")
+	}
+	if err = p.Stop(); !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if err = p.Stop(); !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemNotStarted)
+	}
+}
+
+func TestPnLPositionApplyFill(t *testing.T) {
+	t.Parallel()
+	pos := &pnlPosition{}
+	pos.applyFill(true, 1, 100, 0.1)
+	pos.applyFill(true, 1, 200, 0.1)
+	if pos.quantity != 2 || pos.averagePrice != 150 {
+		t.Fatalf("received quantity '%v' price '%v' expected '%v' '%v'", pos.quantity, pos.averagePrice, 2, 150)
+	}
+
+	pos.applyFill(false, 1, 170, 0.1)
+	if pos.realisedPnL != 20 || pos.quantity != 1 || pos.averagePrice != 150 {
+		t.Fatalf("unexpected position %+v", pos)
+	}
+	if pos.winningTrades != 1 {
+		t.Errorf("received '%v' expected '%v'", pos.winningTrades, 1)
+	}
+
+	// sell through zero to open a short position at the fill price
+	pos.applyFill(false, 3, 140, 0)
+	if pos.realisedPnL != 10 || pos.quantity != -2 || pos.averagePrice != 140 {
+		t.Fatalf("unexpected position %+v", pos)
+	}
+	if pos.losingTrades != 1 {
+		t.Errorf("received '%v' expected '%v'", pos.losingTrades, 1)
+	}
+
+	pos.lastPrice = 130
+	if unrealised := pos.unrealisedPnL(); unrealised != 20 {
+		t.Errorf("received '%v' expected '%v'", unrealised, 20)
+	}
+
+	pos.applyFill(true, 2, 120, 0)
+	if pos.realisedPnL != 50 || pos.quantity != 0 || pos.averagePrice != 0 {
+		t.Fatalf("unexpected position %+v", pos)
+	}
+	if pos.trades != 5 {
+		t.Errorf("received '%v' expected '%v'", pos.trades, 5)
+	}
+}
+
+func TestPortfolioAnalyticsGetPositions(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	orders := &pamOrders{
+		orders: []order.Detail{
+			{
+				Exchange:             testExchange,
+				ID:                   "1",
+				Side:                 order.Buy,
+				Status:               order.PartiallyFilled,
+				AssetType:            asset.Spot,
+				Pair:                 cp,
+				Amount:               2,
+				ExecutedAmount:       1,
+				AverageExecutedPrice: 100,
+				Fee:                  1,
+			},
+			{
+				Exchange:  testExchange,
+				ID:        "2",
+				Side:      order.Sell,
+				Status:    order.New,
+				AssetType: asset.Spot,
+				Pair:      cp,
+				Amount:    1,
+				Price:     500,
+			},
+			{
+				Exchange:  testExchange,
+				ID:        "3",
+				Side:      order.AnySide,
+				Status:    order.Filled,
+				AssetType: asset.Spot,
+				Pair:      cp,
+				Amount:    1,
+				Price:     500,
+			},
+		},
+	}
+	p := setupTestPortfolioAnalytics(t, orders, 110)
+
+	_, err := p.GetPositions("", "", currency.Pair{})
+	if !errors.Is(err, errNoPositionsFound) {
+		t.Errorf("received '%v' expected '%v'", err, errNoPositionsFound)
+	}
+
+	p.update()
+	positions, err := p.GetPositions(testExchange, asset.Spot, cp)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(positions) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(positions), 1)
+	}
+	if positions[0].Quantity != 1 || positions[0].UnrealisedPnL != 10 || positions[0].NetPnL != 9 {
+		t.Errorf("unexpected position %+v", positions[0])
+	}
+
+	// the same executed amount must not be applied twice
+	p.update()
+	orders.orders[0].ExecutedAmount = 2
+	orders.orders[0].AverageExecutedPrice = 110
+	orders.orders[0].Fee = 2
+	orders.orders[0].Status = order.Filled
+	orders.orders[1].Status = order.Filled
+	p.update()
+	positions, err = p.GetPositions(testExchange, asset.Spot, cp)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	// the second fill of the buy order was executed at 120 to average 110
+	if positions[0].Quantity != 1 || positions[0].AverageEntryPrice != 110 {
+		t.Errorf("unexpected position %+v", positions[0])
+	}
+	if positions[0].RealisedPnL != 390 || positions[0].Fees != 2 || positions[0].Trades != 3 {
+		t.Errorf("unexpected position %+v", positions[0])
+	}
+
+	_, err = p.GetPositions("bad", "", currency.Pair{})
+	if !errors.Is(err, errNoPositionsFound) {
+		t.Errorf("received '%v' expected '%v'", err, errNoPositionsFound)
+	}
+
+	p.started = 0
+	_, err = p.GetPositions("", "", currency.Pair{})
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemNotStarted)
+	}
+}
+
+func TestPortfolioAnalyticsGetPerformance(t *testing.T) {
+	t.Parallel()
+	orders := &pamOrders{}
+	for i, cp := range []currency.Pair{
+		currency.NewPair(currency.BTC, currency.USDT),
+		currency.NewPair(currency.ETH, currency.USDT),
+		currency.NewPair(currency.ETH, currency.BTC),
+	} {
+		orders.orders = append(orders.orders, order.Detail{
+			Exchange:  testExchange,
+			ID:        cp.String() + "buy",
+			Side:      order.Buy,
+			Status:    order.Filled,
+			AssetType: asset.Spot,
+			Pair:      cp,
+			Amount:    1,
+			Price:     100,
+		}, order.Detail{
+			Exchange:  testExchange,
+			ID:        cp.String() + "sell",
+			Side:      order.Sell,
+			Status:    order.Filled,
+			AssetType: asset.Spot,
+			Pair:      cp,
+			Amount:    1,
+			Price:     float64(90 + i*10),
+		})
+	}
+	p := setupTestPortfolioAnalytics(t, orders, 100)
+	p.update()
+
+	summaries, err := p.GetPerformance("")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(summaries) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(summaries), 2)
+	}
+	for i := range summaries {
+		switch {
+		case summaries[i].Quote.Match(currency.USDT):
+			if summaries[i].Positions != 2 || summaries[i].RealisedPnL != -10 || summaries[i].WinRate != 0 {
+				t.Errorf("unexpected summary %+v", summaries[i])
+			}
+		case summaries[i].Quote.Match(currency.BTC):
+			if summaries[i].Positions != 1 || summaries[i].RealisedPnL != 10 || summaries[i].WinRate != 100 {
+				t.Errorf("unexpected summary %+v", summaries[i])
+			}
+		default:
+			t.Errorf("unexpected quote %v", summaries[i].Quote)
+		}
+	}
+}
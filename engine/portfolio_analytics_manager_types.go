@@ -0,0 +1,106 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+const (
+	portfolioAnalyticsManagerName = "portfolio_analytics_manager"
+
+	// PortfolioAnalyticsSleepDelay is the default delay between processing
+	// fills and refreshing prices
+	PortfolioAnalyticsSleepDelay = time.Second * 10
+)
+
+var errNoPositionsFound = errors.New("no positions found")
+
+// iOrderSnapshotter limits the portfolio analytics manager to reading orders
+// from the order manager
+type iOrderSnapshotter interface {
+	GetOrdersSnapshot(order.Status) ([]order.Detail, time.Time)
+}
+
+// PortfolioAnalyticsManager tracks realised and unrealised profit and loss for
+// every exchange, asset and pair traded through the order manager. Fills are
+// derived from changes in an order's executed amount and positions are valued
+// against the latest ticker price. Fees are assumed to be denominated in the
+// quote currency
+type PortfolioAnalyticsManager struct {
+	started      int32
+	shutdown     chan struct{}
+	delay        time.Duration
+	verbose      bool
+	orderManager iOrderSnapshotter
+	priceFetcher func(string, currency.Pair, asset.Item) (float64, error)
+	positions    map[string]*pnlPosition
+	fills        map[string]*pnlFill
+	m            sync.Mutex
+}
+
+// pnlPosition holds the running position for an exchange, asset and pair
+// using average cost accounting
+type pnlPosition struct {
+	exchange      string
+	asset         asset.Item
+	pair          currency.Pair
+	quantity      float64
+	averagePrice  float64
+	lastPrice     float64
+	realisedPnL   float64
+	fees          float64
+	volume        float64
+	trades        int64
+	winningTrades int64
+	losingTrades  int64
+	lastUpdated   time.Time
+}
+
+// pnlFill holds the executed amounts of an order which have already been
+// applied to a position
+type pnlFill struct {
+	executed float64
+	cost     float64
+	fee      float64
+}
+
+// PositionPnL holds the profit and loss of a position
+type PositionPnL struct {
+	Exchange          string
+	Asset             asset.Item
+	Pair              currency.Pair
+	Quantity          float64
+	AverageEntryPrice float64
+	LastPrice         float64
+	RealisedPnL       float64
+	UnrealisedPnL     float64
+	Fees              float64
+	NetPnL            float64
+	Volume            float64
+	Trades            int64
+	WinningTrades     int64
+	LosingTrades      int64
+	LastUpdated       time.Time
+}
+
+// PerformanceSummary aggregates the profit and loss of all positions on an
+// exchange which share a quote currency
+type PerformanceSummary struct {
+	Exchange      string
+	Quote         currency.Code
+	Positions     int64
+	RealisedPnL   float64
+	UnrealisedPnL float64
+	Fees          float64
+	NetPnL        float64
+	Volume        float64
+	Trades        int64
+	WinningTrades int64
+	LosingTrades  int64
+	WinRate       float64
+}
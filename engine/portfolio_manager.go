@@ -45,6 +45,10 @@ func setupPortfolioManager(e *ExchangeManager, portfolioManagerDelay time.Durati
 	if cfg == nil {
 		cfg = &portfolio.Base{Addresses: []portfolio.Address{}}
 	}
+	err := cfg.SetupProviders()
+	if err != nil {
+		return nil, err
+	}
 	m := &portfolioManager{
 		portfolioManagerDelay: portfolioManagerDelay,
 		exchangeManager:       e,
@@ -0,0 +1,372 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupPositionsManager creates a positions manager which polls the positions
+// of the exchange manager's exchanges and derives positions from the fills of
+// the supplied order manager. The webhook publisher is optional and position
+// events are not posted to webhooks without it
+func SetupPositionsManager(em iExchangeManager, om iOrderSnapshotter, webhooks iWebhookPublisher, delay time.Duration, marginRatioAlert float64, verbose bool) (*PositionsManager, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if om == nil {
+		return nil, errNilOrderManager
+	}
+	if marginRatioAlert > 1 {
+		return nil, fmt.Errorf("%w, received %v", errInvalidMarginRatioAlert, marginRatioAlert)
+	}
+	if marginRatioAlert <= 0 {
+		marginRatioAlert = DefaultMarginRatioAlert
+	}
+	if delay <= 0 {
+		delay = PositionsManagerSleepDelay
+	}
+	return &PositionsManager{
+		shutdown:         make(chan struct{}),
+		delay:            delay,
+		marginRatioAlert: marginRatioAlert,
+		verbose:          verbose,
+		exchangeManager:  em,
+		orderManager:     om,
+		webhooks:         webhooks,
+		priceFetcher:     fetchLastPrice,
+		derived:          make(map[string]*pnlPosition),
+		fills:            make(map[string]*pnlFill),
+		reported:         make(map[string]*reportedPositions),
+		positions:        make(map[string]*DerivativePosition),
+		alerted:          make(map[string]bool),
+	}, nil
+}
+
+// Start runs the subsystem
+func (p *PositionsManager) Start() error {
+	if p == nil {
+		return ErrNilSubsystem
+	}
+	if !atomic.CompareAndSwapInt32(&p.started, 0, 1) {
+		return ErrSubSystemAlreadyStarted
+	}
+	p.shutdown = make(chan struct{})
+	go p.run()
+	log.Debugf(log.OrderMgr, "Positions manager %v", MsgSubSystemStarted)
+	return nil
+}
+
+// IsRunning checks whether the subsystem is running
+func (p *PositionsManager) IsRunning() bool {
+	if p == nil {
+		return false
+	}
+	return atomic.LoadInt32(&p.started) == 1
+}
+
+// Stop stops the subsystem
+func (p *PositionsManager) Stop() error {
+	if p == nil {
+		return ErrNilSubsystem
+	}
+	if !atomic.CompareAndSwapInt32(&p.started, 1, 0) {
+		return ErrSubSystemNotStarted
+	}
+	close(p.shutdown)
+	log.Debugf(log.OrderMgr, "Positions manager %v", MsgSubSystemShutdown)
+	return nil
+}
+
+func (p *PositionsManager) run() {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-p.shutdown:
+			return
+		case <-timer.C:
+			ctx, cancel := p.cycleContext()
+			p.update(ctx)
+			cancel()
+			timer.Reset(p.delay)
+		}
+	}
+}
+
+// cycleContext returns a context which is cancelled when the subsystem is
+// stopped
+func (p *PositionsManager) cycleContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		select {
+		case <-p.shutdown:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
+
+// update applies new derivative fills from the order manager, polls the
+// positions of authenticated exchanges and replaces the live positions,
+// publishing any changes
+func (p *PositionsManager) update(ctx context.Context) {
+	orders, _ := p.orderManager.GetOrdersSnapshot(order.AnyStatus)
+	reported := p.fetchExchangePositions(ctx)
+
+	p.m.Lock()
+	defer p.m.Unlock()
+	for i := range orders {
+		if !orders[i].AssetType.IsFutures() {
+			continue
+		}
+		amount, price := applyOrderFill(p.fills, p.derived, &orders[i])
+		if amount > 0 && p.verbose {
+			log.Debugf(log.OrderMgr, "Positions manager applied %s %s %s %s fill of %v at %v",
+				orders[i].Exchange, orders[i].AssetType, orders[i].Pair, orders[i].Side, amount, price)
+		}
+	}
+	// positions which could not be retrieved are kept for a few cycles to
+	// ride out transient errors, then dropped so that exchanges and assets
+	// which are no longer polled are not reported indefinitely
+	now := time.Now()
+	for k, v := range reported {
+		p.reported[k] = &reportedPositions{positions: v, fetched: now}
+	}
+	for k, v := range p.reported {
+		if now.Sub(v.fetched) > p.delay*reportedPositionsExpiryCycles {
+			delete(p.reported, k)
+		}
+	}
+
+	positions := make(map[string]*DerivativePosition)
+	for _, held := range p.reported {
+		for i := range held.positions {
+			pos := p.fromExchangePosition(&held.positions[i])
+			positions[positionKey(pos.Exchange, pos.Asset, pos.Pair)] = pos
+		}
+	}
+	for key, held := range p.derived {
+		if held.quantity == 0 {
+			continue
+		}
+		if _, ok := p.reported[held.exchange+"|"+held.asset.String()]; ok {
+			// the exchange's own positions are authoritative
+			continue
+		}
+		positions[key] = p.fromDerivedPosition(held)
+	}
+	p.publishChanges(positions)
+	p.positions = positions
+}
+
+// fetchExchangePositions returns the positions of each authenticated
+// exchange's enabled futures assets keyed by exchange and asset. Assets which
+// could not be retrieved are omitted
+func (p *PositionsManager) fetchExchangePositions(ctx context.Context) map[string][]order.Position {
+	exchanges, err := p.exchangeManager.GetExchanges()
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Positions manager unable to get exchanges: %v", err)
+		return nil
+	}
+	resp := make(map[string][]order.Position)
+	for i := range exchanges {
+		if !exchanges[i].IsEnabled() ||
+			!exchanges[i].GetAuthenticatedAPISupport(exchange.RestAuthentication) {
+			continue
+		}
+		exch := strings.ToLower(exchanges[i].GetName())
+		assets := exchanges[i].GetAssetTypes(true)
+		for j := range assets {
+			if !assets[j].IsFutures() {
+				continue
+			}
+			held, err := exchanges[i].GetDerivativePositions(ctx, assets[j])
+			if err != nil {
+				if !errors.Is(err, common.ErrFunctionNotSupported) &&
+					!errors.Is(err, common.ErrNotYetImplemented) &&
+					!errors.Is(err, asset.ErrNotSupported) &&
+					ctx.Err() == nil {
+					log.Errorf(log.OrderMgr, "Positions manager unable to get %s %s positions: %v", exch, assets[j], err)
+				}
+				continue
+			}
+			resp[exch+"|"+assets[j].String()] = held
+		}
+	}
+	return resp
+}
+
+// fromExchangePosition converts a position reported by an exchange, valuing it
+// against the ticker price when the exchange does not supply a mark price
+func (p *PositionsManager) fromExchangePosition(held *order.Position) *DerivativePosition {
+	pos := &DerivativePosition{
+		Exchange:          strings.ToLower(held.Exchange),
+		Asset:             held.AssetType,
+		Pair:              held.Pair.Upper(),
+		Side:              held.Side,
+		Amount:            held.Amount,
+		EntryPrice:        held.EntryPrice,
+		MarkPrice:         held.MarkPrice,
+		LiquidationPrice:  held.LiquidationPrice,
+		Leverage:          held.Leverage,
+		Margin:            held.Margin,
+		MaintenanceMargin: held.MaintenanceMargin,
+		UnrealisedPnL:     held.UnrealisedPnL,
+		RealisedPnL:       held.RealisedPnL,
+		Source:            PositionSourceExchange,
+		LastUpdated:       held.LastUpdated,
+	}
+	if pos.MarkPrice == 0 {
+		pos.MarkPrice = p.fetchPrice(pos.Exchange, pos.Pair, pos.Asset)
+	}
+	pos.MarginRatio = marginRatio(pos.Margin, pos.MaintenanceMargin, pos.UnrealisedPnL)
+	return pos
+}
+
+// fromDerivedPosition converts a position derived from fills, refreshing its
+// last price from the ticker
+func (p *PositionsManager) fromDerivedPosition(held *pnlPosition) *DerivativePosition {
+	if price := p.fetchPrice(held.exchange, held.pair, held.asset); price > 0 {
+		held.lastPrice = price
+	}
+	side := order.Long
+	amount := held.quantity
+	if amount < 0 {
+		side = order.Short
+		amount = -amount
+	}
+	return &DerivativePosition{
+		Exchange:      held.exchange,
+		Asset:         held.asset,
+		Pair:          held.pair,
+		Side:          side,
+		Amount:        amount,
+		EntryPrice:    held.averagePrice,
+		MarkPrice:     held.lastPrice,
+		UnrealisedPnL: held.unrealisedPnL(),
+		RealisedPnL:   held.realisedPnL,
+		Source:        PositionSourceFills,
+		LastUpdated:   held.lastUpdated,
+	}
+}
+
+// fetchPrice returns the ticker price of a pair, or zero when it is not
+// available
+func (p *PositionsManager) fetchPrice(exchangeName string, cp currency.Pair, a asset.Item) float64 {
+	price, err := p.priceFetcher(exchangeName, cp, a)
+	if err != nil {
+		if p.verbose {
+			log.Warnf(log.OrderMgr, "Positions manager unable to price %s %s %s: %v",
+				exchangeName, a, cp, err)
+		}
+		return 0
+	}
+	return price
+}
+
+// publishChanges posts position updates for positions which have been opened,
+// changed or closed since the last update and margin ratio alerts for
+// positions which have crossed the alert threshold, the lock must be held by
+// the caller
+func (p *PositionsManager) publishChanges(positions map[string]*DerivativePosition) {
+	for key, pos := range positions {
+		prev, ok := p.positions[key]
+		if !ok || prev.Side != pos.Side || prev.Amount != pos.Amount || prev.EntryPrice != pos.EntryPrice {
+			p.publish(WebhookEventPositionUpdate, pos)
+		}
+		switch {
+		case pos.MarginRatio >= p.marginRatioAlert && !p.alerted[key]:
+			p.alerted[key] = true
+			log.Warnf(log.OrderMgr, "Positions manager %s %s %s margin ratio %.4f has reached %v",
+				pos.Exchange, pos.Asset, pos.Pair, pos.MarginRatio, p.marginRatioAlert)
+			p.publish(WebhookEventMarginRatioAlert, pos)
+		case pos.MarginRatio < p.marginRatioAlert:
+			delete(p.alerted, key)
+		}
+	}
+	for key, prev := range p.positions {
+		if _, ok := positions[key]; ok {
+			continue
+		}
+		closed := *prev
+		closed.Amount = 0
+		closed.MarginRatio = 0
+		closed.LastUpdated = time.Now()
+		delete(p.alerted, key)
+		p.publish(WebhookEventPositionUpdate, &closed)
+	}
+}
+
+// publish posts a position event to webhooks when a publisher is set
+func (p *PositionsManager) publish(eventType string, pos *DerivativePosition) {
+	if p.verbose {
+		log.Debugf(log.OrderMgr, "Positions manager %s %s %s %s %s %v",
+			eventType, pos.Exchange, pos.Asset, pos.Pair, pos.Side, pos.Amount)
+	}
+	if p.webhooks == nil {
+		return
+	}
+	err := p.webhooks.Publish(eventType, pos.Exchange, *pos)
+	if err != nil &&
+		!errors.Is(err, ErrNilSubsystem) &&
+		!errors.Is(err, ErrSubSystemNotStarted) {
+		log.Errorf(log.OrderMgr, "Positions manager unable to publish webhook: %v", err)
+	}
+}
+
+// GetPositions returns the live derivative positions, optionally filtered by
+// exchange, asset and pair
+func (p *PositionsManager) GetPositions(exchangeName string, a asset.Item, cp currency.Pair) ([]DerivativePosition, error) {
+	if !p.IsRunning() {
+		return nil, fmt.Errorf("%s %w", positionsManagerName, ErrSubSystemNotStarted)
+	}
+	p.m.RLock()
+	defer p.m.RUnlock()
+	var resp []DerivativePosition
+	for _, pos := range p.positions {
+		if exchangeName != "" && !strings.EqualFold(pos.Exchange, exchangeName) {
+			continue
+		}
+		if a != "" && pos.Asset != a {
+			continue
+		}
+		if !cp.IsEmpty() && !pos.Pair.Equal(cp) {
+			continue
+		}
+		resp = append(resp, *pos)
+	}
+	if len(resp) == 0 {
+		return nil, errNoPositionsFound
+	}
+	sort.Slice(resp, func(i, j int) bool {
+		return positionKey(resp[i].Exchange, resp[i].Asset, resp[i].Pair) <
+			positionKey(resp[j].Exchange, resp[j].Asset, resp[j].Pair)
+	})
+	return resp, nil
+}
+
+// marginRatio returns the proportion of a position's equity consumed by its
+// maintenance margin, positions without equity remaining return one
+func marginRatio(margin, maintenanceMargin, unrealisedPnL float64) float64 {
+	if maintenanceMargin <= 0 {
+		return 0
+	}
+	equity := margin + unrealisedPnL
+	if equity <= 0 {
+		return 1
+	}
+	return maintenanceMargin / equity
+}
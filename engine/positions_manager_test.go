@@ -0,0 +1,236 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// pmExchange aka positions manager fake exchange reports the positions it
+// holds for futures
+type pmExchange struct {
+	exchange.IBotExchange
+	positions []order.Position
+	err       error
+}
+
+func (f *pmExchange) GetName() string {
+	return "pmexchange"
+}
+
+func (f *pmExchange) IsEnabled() bool {
+	return true
+}
+
+func (f *pmExchange) GetAuthenticatedAPISupport(uint8) bool {
+	return true
+}
+
+func (f *pmExchange) GetAssetTypes(bool) asset.Items {
+	return asset.Items{asset.Spot, asset.Futures, asset.PerpetualSwap}
+}
+
+func (f *pmExchange) GetDerivativePositions(_ context.Context, a asset.Item) ([]order.Position, error) {
+	if a != asset.Futures {
+		return nil, common.ErrFunctionNotSupported
+	}
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.positions, nil
+}
+
+// pmPublisher records the webhooks published by the positions manager
+type pmPublisher struct {
+	events []string
+}
+
+func (p *pmPublisher) Publish(eventType, _ string, _ interface{}) error {
+	p.events = append(p.events, eventType)
+	return nil
+}
+
+func setupTestPositionsManager(t *testing.T, exch *pmExchange, orders *pamOrders, webhooks iWebhookPublisher) *PositionsManager {
+	t.Helper()
+	em := SetupExchangeManager()
+	em.Add(exch)
+	p, err := SetupPositionsManager(em, orders, webhooks, time.Hour, 0, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	p.priceFetcher = func(string, currency.Pair, asset.Item) (float64, error) {
+		return 150, nil
+	}
+	p.started = 1
+	return p
+}
+
+func TestSetupPositionsManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupPositionsManager(nil, &pamOrders{}, nil, 0, 0, false)
+	if !errors.Is(err, errNilExchangeManager) {
+		t.Errorf("received '%v' expected '%v'", err, errNilExchangeManager)
+	}
+	_, err = SetupPositionsManager(SetupExchangeManager(), nil, nil, 0, 0, false)
+	if !errors.Is(err, errNilOrderManager) {
+		t.Errorf("received '%v' expected '%v'", err, errNilOrderManager)
+	}
+	_, err = SetupPositionsManager(SetupExchangeManager(), &pamOrders{}, nil, 0, 1.5, false)
+	if !errors.Is(err, errInvalidMarginRatioAlert) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidMarginRatioAlert)
+	}
+	p, err := SetupPositionsManager(SetupExchangeManager(), &pamOrders{}, nil, 0, 0, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if p.delay != PositionsManagerSleepDelay {
+		t.Errorf("received '%v' expected '%v'", p.delay, PositionsManagerSleepDelay)
+	}
+	if p.marginRatioAlert != DefaultMarginRatioAlert {
+		t.Errorf("received '%v' expected '%v'", p.marginRatioAlert, DefaultMarginRatioAlert)
+	}
+}
+
+func TestPositionsManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var p *PositionsManager
+	if err := p.Start(); !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+	if err := p.Stop(); !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+	if p.IsRunning() {
+		t.Error("expected not running")
+	}
+
+	p, err := SetupPositionsManager(SetupExchangeManager(), &pamOrders{}, nil, time.Hour, 0, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if err = p.Start(); !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if err = p.Start(); !errors.Is(err, ErrSubSystemAlreadyStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemAlreadyStarted)
+	}
+	if !p.IsRunning() {
+		t.Error("expected running")
+	}
+	if err = p.Stop(); !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if err = p.Stop(); !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemNotStarted)
+	}
+}
+
+func TestPositionsManagerUpdate(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewPair(currency.BTC, currency.USD)
+	orders := &pamOrders{
+		orders: []order.Detail{
+			{Exchange: testExchange, ID: "1", Side: order.Sell, Status: order.Filled, AssetType: asset.Futures, Pair: cp, Amount: 2, Price: 100},
+			{Exchange: testExchange, ID: "2", Side: order.Buy, Status: order.Filled, AssetType: asset.Spot, Pair: cp, Amount: 1, Price: 100},
+			{Exchange: "pmexchange", ID: "3", Side: order.Buy, Status: order.Filled, AssetType: asset.Futures, Pair: cp, Amount: 5, Price: 100},
+		},
+	}
+	exch := &pmExchange{
+		positions: []order.Position{{
+			Exchange:          "pmexchange",
+			AssetType:         asset.Futures,
+			Pair:              cp,
+			Side:              order.Long,
+			Amount:            1,
+			EntryPrice:        120,
+			Margin:            10,
+			MaintenanceMargin: 9,
+		}},
+	}
+	webhooks := &pmPublisher{}
+	p := setupTestPositionsManager(t, exch, orders, webhooks)
+	p.update(context.Background())
+
+	positions, err := p.GetPositions("", "", currency.Pair{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(positions) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(positions), 2)
+	}
+	derived, reported := positions[0], positions[1]
+	if reported.Source != PositionSourceExchange || reported.Amount != 1 || reported.MarkPrice != 150 || reported.MarginRatio != 0.9 {
+		t.Errorf("unexpected exchange position %+v", reported)
+	}
+	if derived.Source != PositionSourceFills || derived.Side != order.Short || derived.Amount != 2 || derived.UnrealisedPnL != -100 {
+		t.Errorf("unexpected derived position %+v", derived)
+	}
+	expected := []string{WebhookEventPositionUpdate, WebhookEventMarginRatioAlert, WebhookEventPositionUpdate}
+	if len(webhooks.events) != len(expected) {
+		t.Fatalf("received '%v' expected '%v'", webhooks.events, expected)
+	}
+
+	p.update(context.Background())
+	if len(webhooks.events) != len(expected) {
+		t.Errorf("received '%v' expected no new events", webhooks.events)
+	}
+
+	exch.positions = nil
+	p.update(context.Background())
+	if len(webhooks.events) != len(expected)+1 || webhooks.events[len(expected)] != WebhookEventPositionUpdate {
+		t.Errorf("received '%v' expected a closing position update", webhooks.events)
+	}
+	_, err = p.GetPositions("pmexchange", "", currency.Pair{})
+	if !errors.Is(err, errNoPositionsFound) {
+		t.Errorf("received '%v' expected '%v'", err, errNoPositionsFound)
+	}
+
+	// positions which cannot be retrieved are kept until they expire
+	exch.positions = []order.Position{{Exchange: "pmexchange", AssetType: asset.Futures, Pair: cp, Side: order.Long, Amount: 1}}
+	p.update(context.Background())
+	exch.err = errors.New("unavailable")
+	p.update(context.Background())
+	_, err = p.GetPositions("pmexchange", "", currency.Pair{})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	p.reported["pmexchange|"+asset.Futures.String()].fetched = time.Now().Add(-p.delay * (reportedPositionsExpiryCycles + 1))
+	p.update(context.Background())
+	if len(p.reported) != 0 {
+		t.Errorf("received '%v' expected expired positions to be removed", len(p.reported))
+	}
+	// the position derived from fills is used once the exchange's expires
+	positions, err = p.GetPositions("pmexchange", "", currency.Pair{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(positions) != 1 || positions[0].Source != PositionSourceFills {
+		t.Errorf("received '%+v' expected a position derived from fills", positions)
+	}
+
+	p.started = 0
+	_, err = p.GetPositions("", "", currency.Pair{})
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemNotStarted)
+	}
+}
+
+func TestMarginRatio(t *testing.T) {
+	t.Parallel()
+	if r := marginRatio(10, 0, 0); r != 0 {
+		t.Errorf("received '%v' expected '%v'", r, 0)
+	}
+	if r := marginRatio(10, 5, 10); r != 0.25 {
+		t.Errorf("received '%v' expected '%v'", r, 0.25)
+	}
+	if r := marginRatio(10, 5, -10); r != 1 {
+		t.Errorf("received '%v' expected '%v'", r, 1)
+	}
+}
@@ -0,0 +1,89 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+const (
+	positionsManagerName = "positions_manager"
+
+	// PositionsManagerSleepDelay is the default delay between polling
+	// exchange positions and revaluing positions derived from fills
+	PositionsManagerSleepDelay = time.Second * 15
+	// DefaultMarginRatioAlert is the default margin ratio at which a margin
+	// ratio alert is published
+	DefaultMarginRatioAlert = 0.8
+
+	// reportedPositionsExpiryCycles is the number of polling cycles an
+	// exchange's last reported positions are kept for after they can no
+	// longer be retrieved
+	reportedPositionsExpiryCycles = 3
+)
+
+// Position sources
+const (
+	PositionSourceExchange = "exchange"
+	PositionSourceFills    = "fills"
+)
+
+var errInvalidMarginRatioAlert = errors.New("margin ratio alert must not be greater than one")
+
+// PositionsManager maintains the live derivative positions of every exchange
+// and futures asset. Positions reported by an exchange's position endpoint
+// take precedence, otherwise positions are derived from the fills of orders
+// in the order manager and valued against the latest ticker price. Position
+// changes and margin ratios which cross the alert threshold are posted to
+// webhooks
+type PositionsManager struct {
+	started          int32
+	shutdown         chan struct{}
+	delay            time.Duration
+	marginRatioAlert float64
+	verbose          bool
+	exchangeManager  iExchangeManager
+	orderManager     iOrderSnapshotter
+	webhooks         iWebhookPublisher
+	priceFetcher     func(string, currency.Pair, asset.Item) (float64, error)
+	derived          map[string]*pnlPosition
+	fills            map[string]*pnlFill
+	reported         map[string]*reportedPositions
+	positions        map[string]*DerivativePosition
+	alerted          map[string]bool
+	m                sync.RWMutex
+}
+
+// reportedPositions holds the positions an exchange reported for an asset and
+// when they were retrieved
+type reportedPositions struct {
+	positions []order.Position
+	fetched   time.Time
+}
+
+// DerivativePosition holds a live derivative position. The margin ratio is the
+// proportion of the position's equity consumed by its maintenance margin, the
+// position is liquidated as it reaches one. Margin is unknown for positions
+// derived from fills so their margin ratio is zero
+type DerivativePosition struct {
+	Exchange          string        `json:"exchange"`
+	Asset             asset.Item    `json:"asset"`
+	Pair              currency.Pair `json:"pair"`
+	Side              order.Side    `json:"side"`
+	Amount            float64       `json:"amount"`
+	EntryPrice        float64       `json:"entryPrice"`
+	MarkPrice         float64       `json:"markPrice"`
+	LiquidationPrice  float64       `json:"liquidationPrice"`
+	Leverage          float64       `json:"leverage"`
+	Margin            float64       `json:"margin"`
+	MaintenanceMargin float64       `json:"maintenanceMargin"`
+	MarginRatio       float64       `json:"marginRatio"`
+	UnrealisedPnL     float64       `json:"unrealisedPnL"`
+	RealisedPnL       float64       `json:"realisedPnL"`
+	Source            string        `json:"source"`
+	LastUpdated       time.Time     `json:"lastUpdated"`
+}
@@ -0,0 +1,252 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupRateLimitBudgetManager creates a rate limit budget manager subsystem
+func SetupRateLimitBudgetManager(cfg *config.RateLimitBudgetManager) (*RateLimitBudgetManager, error) {
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	m := &RateLimitBudgetManager{
+		verbose: cfg.Verbose,
+		budgets: make(map[string]*exchangeBudget),
+	}
+	for i := range cfg.Budgets {
+		if err := m.addBudget(&cfg.Budgets[i]); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// addBudget validates and adds an exchange budget to the manager
+func (m *RateLimitBudgetManager) addBudget(cfg *config.RateLimitBudget) error {
+	if cfg.Exchange == "" {
+		return fmt.Errorf("%w %v", errInvalidRateLimitBudget, errExchangeNameIsEmpty)
+	}
+	if cfg.Requests <= 0 || cfg.Interval <= 0 {
+		return fmt.Errorf("%w %s requests and interval must be greater than zero", errInvalidRateLimitBudget, cfg.Exchange)
+	}
+	if cfg.BackgroundShare <= 0 || cfg.BackgroundShare > 1 {
+		return fmt.Errorf("%w %s background share must be greater than zero and no more than one", errInvalidRateLimitBudget, cfg.Exchange)
+	}
+	name := strings.ToLower(cfg.Exchange)
+	if _, ok := m.budgets[name]; ok {
+		return fmt.Errorf("%w %s", errRateLimitBudgetAlreadyExists, cfg.Exchange)
+	}
+	backgroundRequests := int(float64(cfg.Requests) * cfg.BackgroundShare)
+	if backgroundRequests < 1 {
+		backgroundRequests = 1
+	}
+	m.budgets[name] = &exchangeBudget{
+		exchange:   cfg.Exchange,
+		total:      request.NewRateLimit(cfg.Interval, cfg.Requests),
+		background: request.NewRateLimit(cfg.Interval, backgroundRequests),
+		usage:      make(map[request.Consumer]*budgetUsage),
+	}
+	return nil
+}
+
+// Start runs the subsystem and begins arbitrating outbound requests
+func (m *RateLimitBudgetManager) Start() error {
+	if m == nil {
+		return ErrNilSubsystem
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return ErrSubSystemAlreadyStarted
+	}
+	request.SetBudgetArbiter(m)
+	log.Debugf(log.RequestSys, "Rate limit budget manager %v", MsgSubSystemStarted)
+	return nil
+}
+
+// IsRunning checks whether the subsystem is running
+func (m *RateLimitBudgetManager) IsRunning() bool {
+	if m == nil {
+		return false
+	}
+	return atomic.LoadInt32(&m.started) == 1
+}
+
+// Stop stops the subsystem, outbound requests are no longer arbitrated
+func (m *RateLimitBudgetManager) Stop() error {
+	if m == nil {
+		return ErrNilSubsystem
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 1, 0) {
+		return ErrSubSystemNotStarted
+	}
+	request.SetBudgetArbiter(nil)
+	log.Debugf(log.RequestSys, "Rate limit budget manager %v", MsgSubSystemShutdown)
+	return nil
+}
+
+// Acquire blocks until the consumer is allowed to send a request to the
+// exchange. Exchanges without a configured budget, or requests made while the
+// manager is stopped, are not arbitrated
+func (m *RateLimitBudgetManager) Acquire(ctx context.Context, exchangeName string, c request.Consumer) error {
+	if m == nil {
+		return ErrNilSubsystem
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil
+	}
+	b, ok := m.budgets[strings.ToLower(exchangeName)]
+	if !ok {
+		return nil
+	}
+	start := time.Now()
+	if c.IsBackground() {
+		if err := b.background.Wait(ctx); err != nil {
+			return err
+		}
+		if err := b.yield(ctx); err != nil {
+			return err
+		}
+	} else {
+		b.queueInteractive()
+		defer b.dequeueInteractive()
+	}
+	if err := b.total.Wait(ctx); err != nil {
+		return err
+	}
+	waited := time.Since(start)
+	b.record(c, waited)
+	if m.verbose {
+		log.Debugf(log.RequestSys, "Rate limit budget manager %s request granted to %s after %v",
+			b.exchange,
+			c,
+			waited)
+	}
+	return nil
+}
+
+// GetUsage returns the budget used by each consumer on every configured
+// exchange
+func (m *RateLimitBudgetManager) GetUsage() ([]RateLimitBudgetUsage, error) {
+	if m == nil {
+		return nil, ErrNilSubsystem
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, ErrSubSystemNotStarted
+	}
+	var resp []RateLimitBudgetUsage
+	for _, b := range m.budgets {
+		b.m.Lock()
+		for c, u := range b.usage {
+			resp = append(resp, RateLimitBudgetUsage{
+				Exchange:      b.exchange,
+				Consumer:      c.String(),
+				Requests:      u.requests,
+				TotalWaitTime: u.waited,
+			})
+		}
+		b.m.Unlock()
+	}
+	sort.Slice(resp, func(i, j int) bool {
+		if resp[i].Exchange != resp[j].Exchange {
+			return resp[i].Exchange < resp[j].Exchange
+		}
+		return resp[i].Consumer < resp[j].Consumer
+	})
+	return resp, nil
+}
+
+// GetHeadroom returns the budget available for an exchange. Exchanges without
+// a configured budget return errRateLimitBudgetNotFound
+func (m *RateLimitBudgetManager) GetHeadroom(exchangeName string) (*RateLimitHeadroom, error) {
+	if m == nil {
+		return nil, ErrNilSubsystem
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, ErrSubSystemNotStarted
+	}
+	b, ok := m.budgets[strings.ToLower(exchangeName)]
+	if !ok {
+		return nil, fmt.Errorf("%w %s", errRateLimitBudgetNotFound, exchangeName)
+	}
+	// reserving and cancelling leaves the budget untouched while revealing
+	// how long the next request would wait
+	now := time.Now()
+	r := b.total.ReserveN(now, 1)
+	next := r.DelayFrom(now)
+	r.CancelAt(now)
+	return &RateLimitHeadroom{
+		Exchange:                    b.exchange,
+		RequestsPerSecond:           float64(b.total.Limit()),
+		BackgroundRequestsPerSecond: float64(b.background.Limit()),
+		InteractiveWaiting:          b.queuedInteractive(),
+		NextRequestIn:               next,
+	}, nil
+}
+
+// yield waits until no interactive requests are queued for the exchange
+func (b *exchangeBudget) yield(ctx context.Context) error {
+	for {
+		b.m.Lock()
+		if b.interactiveWaiting == 0 {
+			b.m.Unlock()
+			return nil
+		}
+		done := b.interactiveDone
+		b.m.Unlock()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-done:
+		}
+	}
+}
+
+// queueInteractive records a queued interactive request, background requests
+// yield until it is dequeued
+func (b *exchangeBudget) queueInteractive() {
+	b.m.Lock()
+	defer b.m.Unlock()
+	if b.interactiveWaiting == 0 {
+		b.interactiveDone = make(chan struct{})
+	}
+	b.interactiveWaiting++
+}
+
+// dequeueInteractive removes a queued interactive request, releasing yielding
+// background requests once none remain
+func (b *exchangeBudget) dequeueInteractive() {
+	b.m.Lock()
+	defer b.m.Unlock()
+	b.interactiveWaiting--
+	if b.interactiveWaiting == 0 {
+		close(b.interactiveDone)
+	}
+}
+
+// queuedInteractive returns the number of queued interactive requests
+func (b *exchangeBudget) queuedInteractive() int32 {
+	b.m.Lock()
+	defer b.m.Unlock()
+	return b.interactiveWaiting
+}
+
+// record stores the request granted to a consumer
+func (b *exchangeBudget) record(c request.Consumer, waited time.Duration) {
+	b.m.Lock()
+	defer b.m.Unlock()
+	u, ok := b.usage[c]
+	if !ok {
+		u = &budgetUsage{}
+		b.usage[c] = u
+	}
+	u.requests++
+	u.waited += waited
+}
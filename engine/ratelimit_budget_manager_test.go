@@ -0,0 +1,244 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+)
+
+func TestSetupRateLimitBudgetManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupRateLimitBudgetManager(nil)
+	if !errors.Is(err, errNilConfig) {
+		t.Errorf("received '%v' expected '%v'", err, errNilConfig)
+	}
+
+	invalid := []config.RateLimitBudget{
+		{Requests: 10, Interval: time.Second, BackgroundShare: 0.5},
+		{Exchange: "Binance", Interval: time.Second, BackgroundShare: 0.5},
+		{Exchange: "Binance", Requests: 10, BackgroundShare: 0.5},
+		{Exchange: "Binance", Requests: 10, Interval: time.Second},
+		{Exchange: "Binance", Requests: 10, Interval: time.Second, BackgroundShare: 1.5},
+	}
+	for i := range invalid {
+		_, err = SetupRateLimitBudgetManager(&config.RateLimitBudgetManager{Budgets: invalid[i : i+1]})
+		if !errors.Is(err, errInvalidRateLimitBudget) {
+			t.Errorf("budget %d received '%v' expected '%v'", i, err, errInvalidRateLimitBudget)
+		}
+	}
+
+	budget := config.RateLimitBudget{Exchange: "Binance", Requests: 1200, Interval: time.Minute, BackgroundShare: 0.5}
+	_, err = SetupRateLimitBudgetManager(&config.RateLimitBudgetManager{
+		Budgets: []config.RateLimitBudget{budget, budget},
+	})
+	if !errors.Is(err, errRateLimitBudgetAlreadyExists) {
+		t.Errorf("received '%v' expected '%v'", err, errRateLimitBudgetAlreadyExists)
+	}
+
+	m, err := SetupRateLimitBudgetManager(&config.RateLimitBudgetManager{
+		Budgets: []config.RateLimitBudget{budget},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if _, ok := m.budgets["binance"]; !ok {
+		t.Error("expected binance budget")
+	}
+}
+
+func TestRateLimitBudgetManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *RateLimitBudgetManager
+	if err := m.Start(); !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+	if err := m.Stop(); !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+	if m.IsRunning() {
+		t.Error("expected not running")
+	}
+
+	m, err := SetupRateLimitBudgetManager(&config.RateLimitBudgetManager{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if err = m.Start(); !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if err = m.Start(); !errors.Is(err, ErrSubSystemAlreadyStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemAlreadyStarted)
+	}
+	if !m.IsRunning() {
+		t.Error("expected running")
+	}
+	if err = m.Stop(); !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if err = m.Stop(); !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemNotStarted)
+	}
+}
+
+func TestRateLimitBudgetManagerAcquire(t *testing.T) {
+	t.Parallel()
+	var m *RateLimitBudgetManager
+	err := m.Acquire(context.Background(), "binance", request.UserRPC)
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+
+	m, err = SetupRateLimitBudgetManager(&config.RateLimitBudgetManager{
+		Budgets: []config.RateLimitBudget{
+			{Exchange: "Binance", Requests: 100, Interval: time.Second, BackgroundShare: 0.5},
+		},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	// not started requests are not arbitrated
+	err = m.Acquire(context.Background(), "binance", request.UserRPC)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = m.GetUsage()
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemNotStarted)
+	}
+
+	// started is set directly so the manager is not set as the global arbiter
+	atomic.StoreInt32(&m.started, 1)
+	err = m.Acquire(context.Background(), "Binance", request.UserRPC)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	err = m.Acquire(context.Background(), "binance", request.CurrencyPairSyncer)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	err = m.Acquire(context.Background(), "bitstamp", request.CurrencyPairSyncer)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+
+	usage, err := m.GetUsage()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(usage) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(usage), 2)
+	}
+	if usage[0].Consumer != request.CurrencyPairSyncer.String() || usage[0].Requests != 1 {
+		t.Errorf("unexpected usage %+v", usage[0])
+	}
+	if usage[1].Consumer != request.UserRPC.String() || usage[1].Requests != 1 {
+		t.Errorf("unexpected usage %+v", usage[1])
+	}
+}
+
+func TestRateLimitBudgetBackgroundYield(t *testing.T) {
+	t.Parallel()
+	m, err := SetupRateLimitBudgetManager(&config.RateLimitBudgetManager{
+		Budgets: []config.RateLimitBudget{
+			{Exchange: "Binance", Requests: 1000, Interval: time.Second, BackgroundShare: 0.5},
+		},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	atomic.StoreInt32(&m.started, 1)
+
+	// simulate a queued interactive request, background and untagged
+	// requests must wait
+	b := m.budgets["binance"]
+	b.queueInteractive()
+	for _, c := range []request.Consumer{request.DataHistory, request.UnsetConsumer} {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*30)
+		err = m.Acquire(ctx, "binance", c)
+		cancel()
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("%v received '%v' expected '%v'", c, err, context.DeadlineExceeded)
+		}
+	}
+
+	err = m.Acquire(context.Background(), "binance", request.OrderManagement)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+
+	// background requests are released once the interactive request is
+	// dequeued
+	errs := make(chan error, 1)
+	go func() {
+		errs <- m.Acquire(context.Background(), "binance", request.DataHistory)
+	}()
+	b.dequeueInteractive()
+	select {
+	case err = <-errs:
+		if !errors.Is(err, nil) {
+			t.Errorf("received '%v' expected '%v'", err, nil)
+		}
+	case <-time.After(time.Second):
+		t.Error("expected background request to be released")
+	}
+	if waiting := b.queuedInteractive(); waiting != 0 {
+		t.Errorf("received '%v' expected '%v'", waiting, 0)
+	}
+}
+
+func TestRateLimitBudgetManagerGetHeadroom(t *testing.T) {
+	t.Parallel()
+	var m *RateLimitBudgetManager
+	_, err := m.GetHeadroom("binance")
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+	m, err = SetupRateLimitBudgetManager(&config.RateLimitBudgetManager{
+		Budgets: []config.RateLimitBudget{
+			{Exchange: "Binance", Requests: 10, Interval: time.Second, BackgroundShare: 0.5},
+		},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = m.GetHeadroom("binance")
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemNotStarted)
+	}
+	atomic.StoreInt32(&m.started, 1)
+	_, err = m.GetHeadroom("bitstamp")
+	if !errors.Is(err, errRateLimitBudgetNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, errRateLimitBudgetNotFound)
+	}
+	h, err := m.GetHeadroom("BINANCE")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if h.Exchange != "Binance" || h.RequestsPerSecond != 10 || h.BackgroundRequestsPerSecond != 5 || h.NextRequestIn != 0 {
+		t.Errorf("unexpected headroom %+v", h)
+	}
+	err = m.Acquire(context.Background(), "binance", request.UserRPC)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	h, err = m.GetHeadroom("binance")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if h.NextRequestIn <= 0 {
+		t.Errorf("received '%v' expected a wait once the budget is used", h.NextRequestIn)
+	}
+	// checking headroom does not consume the budget
+	h2, err := m.GetHeadroom("binance")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if h2.NextRequestIn > h.NextRequestIn {
+		t.Errorf("received '%v' expected no more than '%v'", h2.NextRequestIn, h.NextRequestIn)
+	}
+}
@@ -0,0 +1,69 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+	"golang.org/x/time/rate"
+)
+
+const rateLimitBudgetManagerName = "rate_limit_budget_manager"
+
+var (
+	errInvalidRateLimitBudget       = errors.New("invalid rate limit budget")
+	errRateLimitBudgetAlreadyExists = errors.New("rate limit budget already exists")
+	errRateLimitBudgetNotFound      = errors.New("rate limit budget not found")
+)
+
+// RateLimitBudgetManager arbitrates each configured exchange's REST rate limit
+// budget between engine subsystems. Background subsystems such as the currency
+// pair syncer and data history manager are restricted to a share of the budget
+// and yield to queued user RPC and order management requests so that
+// interactive order placement cannot be starved
+type RateLimitBudgetManager struct {
+	started int32
+	verbose bool
+	budgets map[string]*exchangeBudget
+}
+
+// exchangeBudget holds the shared and background rate limits for an exchange
+type exchangeBudget struct {
+	exchange   string
+	total      *rate.Limiter
+	background *rate.Limiter
+	m          sync.Mutex
+	usage      map[request.Consumer]*budgetUsage
+	// interactiveWaiting is the number of queued interactive requests,
+	// interactiveDone is closed once none are queued so that background
+	// requests can stop yielding
+	interactiveWaiting int32
+	interactiveDone    chan struct{}
+}
+
+// budgetUsage tracks the requests granted to a consumer
+type budgetUsage struct {
+	requests int64
+	waited   time.Duration
+}
+
+// RateLimitBudgetUsage is a snapshot of the budget a consumer has used on an
+// exchange
+type RateLimitBudgetUsage struct {
+	Exchange      string
+	Consumer      string
+	Requests      int64
+	TotalWaitTime time.Duration
+}
+
+// RateLimitHeadroom is a snapshot of how much of an exchange's budget is
+// available. NextRequestIn is how long an interactive request would wait for
+// the budget, zero when a request can be sent immediately
+type RateLimitHeadroom struct {
+	Exchange                    string
+	RequestsPerSecond           float64
+	BackgroundRequestsPerSecond float64
+	InteractiveWaiting          int32
+	NextRequestIn               time.Duration
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
 	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
+	candleDB "github.com/thrasher-corp/gocryptotrader/database/repository/candle"
 	exchangeDB "github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
@@ -35,6 +36,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/gctrpc"
@@ -105,14 +108,14 @@ func (s *RPCServer) authenticateClient(ctx context.Context) (context.Context, er
 		return ctx, fmt.Errorf("username/password mismatch")
 	}
 
-	return ctx, nil
+	return request.WithConsumer(ctx, request.UserRPC), nil
 }
 
 // StartRPCServer starts a gRPC server with TLS auth
 func StartRPCServer(engine *Engine) {
 	targetDir := utils.GetTLSDir(engine.Settings.DataDir)
-	if err := checkCerts(targetDir); err != nil {
-		log.Errorf(log.GRPCSys, "gRPC checkCerts failed. err: %s\n", err)
+	if err := CheckCerts(targetDir); err != nil {
+		log.Errorf(log.GRPCSys, "gRPC CheckCerts failed. err: %s\n", err)
 		return
 	}
 	log.Debugf(log.GRPCSys, "gRPC server support enabled. Starting gRPC server on https://%v.\n", engine.Config.RemoteControl.GRPC.ListenAddress)
@@ -342,6 +345,109 @@ func (s *RPCServer) GetExchangeInfo(_ context.Context, r *gctrpc.GenericExchange
 	return resp, nil
 }
 
+// GetCapabilityGaps returns the unified exchange wrapper functions which are
+// not yet implemented or not supported by each loaded exchange
+func (s *RPCServer) GetCapabilityGaps(_ context.Context, r *gctrpc.GetCapabilityGapsRequest) (*gctrpc.GetCapabilityGapsResponse, error) {
+	gaps, err := s.Engine.GetCapabilityGaps(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetCapabilityGapsResponse{
+		Exchanges: make([]*gctrpc.ExchangeCapabilityGaps, len(gaps)),
+	}
+	for i := range gaps {
+		resp.Exchanges[i] = &gctrpc.ExchangeCapabilityGaps{
+			Exchange:          gaps[i].Exchange,
+			FunctionsChecked:  int64(gaps[i].Checked),
+			NotYetImplemented: gaps[i].NotYetImplemented,
+			NotSupported:      gaps[i].NotSupported,
+		}
+	}
+	return resp, nil
+}
+
+// GetExchangeHealth returns the operational status of each loaded exchange, or
+// only the requested exchange when set
+func (s *RPCServer) GetExchangeHealth(ctx context.Context, r *gctrpc.GetExchangeHealthRequest) (*gctrpc.GetExchangeHealthResponse, error) {
+	health, err := s.Engine.GetExchangeHealth(ctx, r.Exchange, r.VerifyCredentials)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetExchangeHealthResponse{
+		Exchanges: make([]*gctrpc.ExchangeHealth, len(health)),
+	}
+	for i := range health {
+		resp.Exchanges[i] = &gctrpc.ExchangeHealth{
+			Exchange:                health[i].Exchange,
+			RestUrl:                 health[i].RESTURL,
+			RestReachable:           health[i].RESTReachable,
+			RestStatusCode:          int64(health[i].RESTStatusCode),
+			RestLatencyMilliseconds: health[i].RESTLatency.Milliseconds(),
+			RestError:               health[i].RESTError,
+			WebsocketSupported:      health[i].WebsocketSupported,
+			WebsocketEnabled:        health[i].WebsocketEnabled,
+			WebsocketConnected:      health[i].WebsocketConnected,
+			WebsocketConnecting:     health[i].WebsocketConnecting,
+			WebsocketAuthenticated:  health[i].WebsocketAuthenticated,
+			AuthenticatedSupport:    health[i].AuthenticatedSupport,
+			CredentialsSet:          health[i].CredentialsSet,
+			CredentialsVerified:     health[i].CredentialsVerified,
+			CredentialsError:        health[i].CredentialsError,
+		}
+		if rl := health[i].RateLimit; rl != nil {
+			resp.Exchanges[i].RateLimit = &gctrpc.RateLimitHeadroom{
+				RequestsPerSecond:           rl.RequestsPerSecond,
+				BackgroundRequestsPerSecond: rl.BackgroundRequestsPerSecond,
+				InteractiveWaiting:          rl.InteractiveWaiting,
+				NextRequestInMilliseconds:   rl.NextRequestIn.Milliseconds(),
+			}
+		}
+		if !health[i].LastTickerUpdate.IsZero() {
+			resp.Exchanges[i].LastTickerUpdate = health[i].LastTickerUpdate.Format(common.SimpleTimeFormatWithTimezone)
+		}
+		if !health[i].LastOrderbookUpdate.IsZero() {
+			resp.Exchanges[i].LastOrderbookUpdate = health[i].LastOrderbookUpdate.Format(common.SimpleTimeFormatWithTimezone)
+		}
+	}
+	return resp, nil
+}
+
+// GetExchangeClockSkew returns how far an exchange server clock is ahead of
+// the local clock, measuring it against the exchange server time first when
+// sync is set
+func (s *RPCServer) GetExchangeClockSkew(ctx context.Context, r *gctrpc.GetExchangeClockSkewRequest) (*gctrpc.GetExchangeClockSkewResponse, error) {
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	b := exch.GetBase()
+	if r.Sync {
+		a := asset.Spot
+		if r.Asset != "" {
+			a, err = asset.New(r.Asset)
+			if err != nil {
+				return nil, err
+			}
+		}
+		_, err = b.Requester.MeasureClockSkew(ctx, func(ctx context.Context) (time.Time, error) {
+			return exch.GetServerTime(ctx, a)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	skew, lastSync := b.Requester.GetClockSkew()
+	resp := &gctrpc.GetExchangeClockSkewResponse{
+		Exchange:              exch.GetName(),
+		ClockSkew:             skew.String(),
+		ClockSkewMilliseconds: skew.Milliseconds(),
+	}
+	if !lastSync.IsZero() {
+		resp.LastSync = lastSync.Format(common.SimpleTimeFormatWithTimezone)
+	}
+	return resp, nil
+}
+
 // GetTicker returns the ticker for a specified exchange, currency pair and
 // asset type
 func (s *RPCServer) GetTicker(ctx context.Context, r *gctrpc.GetTickerRequest) (*gctrpc.TickerResponse, error) {
@@ -1222,6 +1328,367 @@ func (s *RPCServer) SubmitOrder(ctx context.Context, r *gctrpc.SubmitOrderReques
 	}, err
 }
 
+// AddConditionalOrder adds an order to the order manager which is submitted
+// once the price of its pair crosses the trigger price
+func (s *RPCServer) AddConditionalOrder(_ context.Context, r *gctrpc.AddConditionalOrderRequest) (*gctrpc.AddConditionalOrderResponse, error) {
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	if r.Pair == nil {
+		return nil, errCurrencyPairUnset
+	}
+	side, err := order.StringToOrderSide(r.Side)
+	if err != nil {
+		return nil, err
+	}
+	oType, err := order.StringToOrderType(r.OrderType)
+	if err != nil {
+		return nil, err
+	}
+	p := currency.Pair{
+		Delimiter: r.Pair.Delimiter,
+		Base:      currency.NewCode(r.Pair.Base),
+		Quote:     currency.NewCode(r.Pair.Quote),
+	}
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	err = checkParams(r.Exchange, exch, a, p)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.OrderManager.AddConditionalOrder(&ConditionalOrder{
+		Condition:    ConditionalOrderCondition(strings.ToUpper(r.Condition)),
+		TriggerPrice: r.TriggerPrice,
+		Order: order.Submit{
+			Exchange:      r.Exchange,
+			Pair:          p,
+			AssetType:     a,
+			Side:          side,
+			Type:          oType,
+			Amount:        r.Amount,
+			Price:         r.Price,
+			ClientID:      r.ClientId,
+			ClientOrderID: r.ClientId,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.AddConditionalOrderResponse{
+		ConditionalOrder: conditionalOrderToRPC(resp),
+	}, nil
+}
+
+// GetConditionalOrders returns the conditional orders held by the order
+// manager, optionally filtered by exchange
+func (s *RPCServer) GetConditionalOrders(_ context.Context, r *gctrpc.GetConditionalOrdersRequest) (*gctrpc.GetConditionalOrdersResponse, error) {
+	orders, err := s.OrderManager.GetConditionalOrders(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetConditionalOrdersResponse{}
+	for i := range orders {
+		resp.ConditionalOrders = append(resp.ConditionalOrders, conditionalOrderToRPC(&orders[i]))
+	}
+	return resp, nil
+}
+
+// CancelConditionalOrder cancels a pending conditional order
+func (s *RPCServer) CancelConditionalOrder(_ context.Context, r *gctrpc.CancelConditionalOrderRequest) (*gctrpc.GenericResponse, error) {
+	err := s.OrderManager.CancelConditionalOrder(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess}, nil
+}
+
+// GetPaperBalances returns the virtual balances tracked by the order manager
+// while paper trading, optionally filtered by exchange
+func (s *RPCServer) GetPaperBalances(_ context.Context, r *gctrpc.GetPaperBalancesRequest) (*gctrpc.GetPaperBalancesResponse, error) {
+	if r.Exchange != "" {
+		if _, err := s.GetExchangeByName(r.Exchange); err != nil {
+			return nil, err
+		}
+	}
+	balances, err := s.OrderManager.GetPaperBalances(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetPaperBalancesResponse{
+		Balances: make([]*gctrpc.PaperBalance, len(balances)),
+	}
+	for i := range balances {
+		resp.Balances[i] = &gctrpc.PaperBalance{
+			Exchange: balances[i].Exchange,
+			Currency: balances[i].Currency.String(),
+			Amount:   balances[i].Amount,
+		}
+	}
+	return resp, nil
+}
+
+// conditionalOrderToRPC converts a conditional order to its RPC equivalent
+func conditionalOrderToRPC(c *ConditionalOrder) *gctrpc.ConditionalOrder {
+	resp := &gctrpc.ConditionalOrder{
+		Id:       c.ID,
+		Exchange: c.Order.Exchange,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: c.Order.Pair.Delimiter,
+			Base:      c.Order.Pair.Base.String(),
+			Quote:     c.Order.Pair.Quote.String(),
+		},
+		AssetType:      c.Order.AssetType.String(),
+		Condition:      string(c.Condition),
+		TriggerPrice:   c.TriggerPrice,
+		Side:           c.Order.Side.String(),
+		OrderType:      c.Order.Type.String(),
+		Amount:         c.Order.Amount,
+		Price:          c.Order.Price,
+		Status:         string(c.Status),
+		TriggeredPrice: c.TriggeredPrice,
+		OrderId:        c.OrderID,
+		Error:          c.Error,
+		Created:        c.Created.Format(common.SimpleTimeFormatWithTimezone),
+	}
+	if !c.Triggered.IsZero() {
+		resp.Triggered = c.Triggered.Format(common.SimpleTimeFormatWithTimezone)
+	}
+	return resp
+}
+
+// GetConsolidatedOrderbook merges the orderbooks of a pair across enabled
+// exchanges into a single book, optionally adjusted by each exchange's taker
+// fee
+func (s *RPCServer) GetConsolidatedOrderbook(ctx context.Context, r *gctrpc.GetConsolidatedOrderbookRequest) (*gctrpc.GetConsolidatedOrderbookResponse, error) {
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	if r.Pair == nil {
+		return nil, errCurrencyPairUnset
+	}
+	p := currency.Pair{
+		Delimiter: r.Pair.Delimiter,
+		Base:      currency.NewCode(r.Pair.Base),
+		Quote:     currency.NewCode(r.Pair.Quote),
+	}
+
+	agg, err := s.OrderbookAggregator.Aggregate(ctx, &AggregateRequest{
+		Pair:        p,
+		Asset:       a,
+		Exchanges:   r.Exchanges,
+		FeeAdjusted: r.FeeAdjusted,
+		FeeAmount:   r.FeeAmount,
+		Depth:       int(r.Depth),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &gctrpc.GetConsolidatedOrderbookResponse{
+		Pair:        r.Pair,
+		AssetType:   a.String(),
+		FeeAdjusted: agg.FeeAdjusted,
+		Exchanges:   agg.Exchanges,
+		Bids:        consolidatedLevelsToRPC(agg.Bids),
+		Asks:        consolidatedLevelsToRPC(agg.Asks),
+		LastUpdated: s.unixTimestamp(agg.LastUpdated),
+	}, nil
+}
+
+// consolidatedLevelsToRPC converts aggregated orderbook levels to their RPC
+// representation
+func consolidatedLevelsToRPC(levels []AggregatedLevel) []*gctrpc.ConsolidatedOrderbookLevel {
+	resp := make([]*gctrpc.ConsolidatedOrderbookLevel, len(levels))
+	for i := range levels {
+		resp[i] = &gctrpc.ConsolidatedOrderbookLevel{
+			Exchange:       levels[i].Exchange,
+			Price:          levels[i].Price,
+			EffectivePrice: levels[i].EffectivePrice,
+			Amount:         levels[i].Amount,
+			FeeRate:        levels[i].FeeRate,
+		}
+	}
+	return resp
+}
+
+// GetArbitrageOpportunities returns the arbitrage opportunities found by the
+// arbitrage scanner's latest scan, optionally filtered by exchange
+func (s *RPCServer) GetArbitrageOpportunities(_ context.Context, r *gctrpc.GetArbitrageOpportunitiesRequest) (*gctrpc.GetArbitrageOpportunitiesResponse, error) {
+	opportunities, err := s.arbitrageScanner.GetOpportunities(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetArbitrageOpportunitiesResponse{
+		Opportunities: make([]*gctrpc.ArbitrageOpportunity, len(opportunities)),
+	}
+	for i := range opportunities {
+		resp.Opportunities[i] = &gctrpc.ArbitrageOpportunity{
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: opportunities[i].Pair.Delimiter,
+				Base:      opportunities[i].Pair.Base.String(),
+				Quote:     opportunities[i].Pair.Quote.String(),
+			},
+			AssetType:     opportunities[i].Asset.String(),
+			BuyExchange:   opportunities[i].BuyExchange,
+			SellExchange:  opportunities[i].SellExchange,
+			Amount:        opportunities[i].Amount,
+			BuyPrice:      opportunities[i].BuyPrice,
+			SellPrice:     opportunities[i].SellPrice,
+			TradingFees:   opportunities[i].TradingFees,
+			WithdrawalFee: opportunities[i].WithdrawalFee,
+			Cost:          opportunities[i].Cost,
+			Proceeds:      opportunities[i].Proceeds,
+			Profit:        opportunities[i].Profit,
+			SpreadPercent: opportunities[i].SpreadPercent,
+			ProfitPercent: opportunities[i].ProfitPercent,
+			DetectedAt:    opportunities[i].DetectedAt.Format(common.SimpleTimeFormatWithTimezone),
+			UpdatedAt:     opportunities[i].UpdatedAt.Format(common.SimpleTimeFormatWithTimezone),
+		}
+	}
+	return resp, nil
+}
+
+// GetTriangularArbitrage returns the triangular arbitrage opportunities found
+// by the triangular arbitrage detector's latest scan, optionally filtered by
+// exchange
+func (s *RPCServer) GetTriangularArbitrage(_ context.Context, r *gctrpc.GetTriangularArbitrageRequest) (*gctrpc.GetTriangularArbitrageResponse, error) {
+	opps, err := s.triangularArbitrage.GetOpportunities(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	return triangularOpportunitiesToRPC(opps), nil
+}
+
+// GetTriangularArbitrageStream streams the triangular arbitrage opportunities
+// found by the triangular arbitrage detector after every scan, optionally
+// filtered by exchange
+func (s *RPCServer) GetTriangularArbitrageStream(r *gctrpc.GetTriangularArbitrageRequest, stream gctrpc.GoCryptoTrader_GetTriangularArbitrageStreamServer) error {
+	for {
+		updated, err := s.triangularArbitrage.Updated()
+		if err != nil {
+			return err
+		}
+		opps, err := s.triangularArbitrage.GetOpportunities(r.Exchange)
+		if err != nil {
+			return err
+		}
+		err = stream.Send(triangularOpportunitiesToRPC(opps))
+		if err != nil {
+			return err
+		}
+		select {
+		case <-updated:
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+// triangularOpportunitiesToRPC converts triangular arbitrage opportunities to
+// their RPC representation
+func triangularOpportunitiesToRPC(opps []TriangularOpportunity) *gctrpc.GetTriangularArbitrageResponse {
+	resp := &gctrpc.GetTriangularArbitrageResponse{
+		Opportunities: make([]*gctrpc.TriangularArbitrageOpportunity, len(opps)),
+	}
+	for i := range opps {
+		opp := &gctrpc.TriangularArbitrageOpportunity{
+			Exchange:       opps[i].Exchange,
+			StartCurrency:  opps[i].StartCurrency,
+			Rate:           opps[i].Rate,
+			ProfitPercent:  opps[i].ProfitPercent,
+			MaxStartAmount: opps[i].MaxStartAmount,
+			DetectedAt:     opps[i].DetectedAt.Format(common.SimpleTimeFormatWithTimezone),
+			UpdatedAt:      opps[i].UpdatedAt.Format(common.SimpleTimeFormatWithTimezone),
+		}
+		for j := range opps[i].Legs {
+			opp.Legs = append(opp.Legs, &gctrpc.TriangularArbitrageLeg{
+				Pair: &gctrpc.CurrencyPair{
+					Delimiter: opps[i].Legs[j].Pair.Delimiter,
+					Base:      opps[i].Legs[j].Pair.Base.String(),
+					Quote:     opps[i].Legs[j].Pair.Quote.String(),
+				},
+				Side:     opps[i].Legs[j].Side.String(),
+				From:     opps[i].Legs[j].From,
+				To:       opps[i].Legs[j].To,
+				Price:    opps[i].Legs[j].Price,
+				FeeRate:  opps[i].Legs[j].FeeRate,
+				Rate:     opps[i].Legs[j].Rate,
+				Capacity: opps[i].Legs[j].Capacity,
+			})
+		}
+		resp.Opportunities[i] = opp
+	}
+	return resp
+}
+
+// RouteOrder determines the best execution route for an order across enabled
+// exchanges and optionally submits it. Allocations which fail to submit are
+// returned with their error so partially executed routes are visible
+func (s *RPCServer) RouteOrder(ctx context.Context, r *gctrpc.RouteOrderRequest) (*gctrpc.RouteOrderResponse, error) {
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	if r.Pair == nil {
+		return nil, errCurrencyPairUnset
+	}
+	side, err := order.StringToOrderSide(r.Side)
+	if err != nil {
+		return nil, err
+	}
+	p := currency.Pair{
+		Delimiter: r.Pair.Delimiter,
+		Base:      currency.NewCode(r.Pair.Base),
+		Quote:     currency.NewCode(r.Pair.Quote),
+	}
+
+	plan, err := s.OrderRouter.RouteOrder(ctx, &RouteRequest{
+		Pair:       p,
+		Asset:      a,
+		Side:       side,
+		Amount:     r.Amount,
+		Exchanges:  r.Exchanges,
+		AllowSplit: r.AllowSplit,
+		Execute:    r.Execute,
+	})
+	if err != nil && (plan == nil || !errors.Is(err, errRouteAllocationsFailed)) {
+		return nil, err
+	}
+
+	resp := &gctrpc.RouteOrderResponse{
+		Pair:         r.Pair,
+		AssetType:    a.String(),
+		Side:         plan.Side.String(),
+		Amount:       plan.Amount,
+		AveragePrice: plan.AveragePrice,
+		Fees:         plan.Fees,
+		Total:        plan.Total,
+		Split:        plan.Split,
+	}
+	for i := range plan.Allocations {
+		alloc := &gctrpc.RouteAllocation{
+			Exchange:     plan.Allocations[i].Exchange,
+			Amount:       plan.Allocations[i].Amount,
+			AveragePrice: plan.Allocations[i].AveragePrice,
+			WorstPrice:   plan.Allocations[i].WorstPrice,
+			FeeRate:      plan.Allocations[i].FeeRate,
+			Fee:          plan.Allocations[i].Fee,
+			Total:        plan.Allocations[i].Total,
+			OrderId:      plan.Allocations[i].OrderID,
+		}
+		if plan.Allocations[i].Error != nil {
+			alloc.Error = plan.Allocations[i].Error.Error()
+		}
+		resp.Allocations = append(resp.Allocations, alloc)
+	}
+	return resp, nil
+}
+
 // SimulateOrder simulates an order specified by exchange, currency pair and asset
 // type
 func (s *RPCServer) SimulateOrder(ctx context.Context, r *gctrpc.SimulateOrderRequest) (*gctrpc.SimulateOrderResponse, error) {
@@ -1410,6 +1877,10 @@ func (s *RPCServer) CancelBatchOrders(ctx context.Context, r *gctrpc.CancelBatch
 		})
 	}
 
+	if s.OrderManager.IsPaperTrading() {
+		return nil, fmt.Errorf("cancelling batch orders %w", errPaperTradingUnsupported)
+	}
+
 	// TODO: Change to order manager
 	_, err = exch.CancelBatchOrders(ctx, request)
 	if err != nil {
@@ -1430,6 +1901,10 @@ func (s *RPCServer) CancelAllOrders(ctx context.Context, r *gctrpc.CancelAllOrde
 		return nil, err
 	}
 
+	if s.OrderManager.IsPaperTrading() {
+		return nil, fmt.Errorf("cancelling all orders %w", errPaperTradingUnsupported)
+	}
+
 	// TODO: Change to order manager
 	resp, err := exch.CancelAllOrders(ctx, nil)
 	if err != nil {
@@ -1477,7 +1952,9 @@ func (s *RPCServer) ModifyOrder(ctx context.Context, r *gctrpc.ModifyOrderReques
 		return nil, err
 	}
 	return &gctrpc.ModifyOrderResponse{
-		ModifiedOrderId: resp.OrderID,
+		ModifiedOrderId:        resp.OrderID,
+		QueuePriorityPreserved: resp.QueuePriorityPreserved,
+		CancelReplaced:         resp.CancelReplaced,
 	}, nil
 }
 
@@ -1642,34 +2119,11 @@ func (s *RPCServer) WithdrawCryptocurrencyFunds(ctx context.Context, r *gctrpc.W
 		},
 	}
 
-	exchCfg, err := s.Config.GetExchangeConfig(r.Exchange)
+	err = s.setWithdrawalCredentials(request)
 	if err != nil {
 		return nil, err
 	}
 
-	if exchCfg.API.Credentials.OTPSecret != "" {
-		code, errOTP := totp.GenerateCode(exchCfg.API.Credentials.OTPSecret, time.Now())
-		if errOTP != nil {
-			return nil, errOTP
-		}
-
-		codeNum, errOTP := strconv.ParseInt(code, 10, 64)
-		if errOTP != nil {
-			return nil, errOTP
-		}
-		request.OneTimePassword = codeNum
-	}
-
-	if exchCfg.API.Credentials.PIN != "" {
-		pinCode, errPin := strconv.ParseInt(exchCfg.API.Credentials.PIN, 10, 64)
-		if err != nil {
-			return nil, errPin
-		}
-		request.PIN = pinCode
-	}
-
-	request.TradePassword = exchCfg.API.Credentials.TradePassword
-
 	resp, err := s.Engine.WithdrawManager.SubmitWithdrawal(ctx, request)
 	if err != nil {
 		return nil, err
@@ -1751,6 +2205,192 @@ func (s *RPCServer) WithdrawFiatFunds(ctx context.Context, r *gctrpc.WithdrawFia
 	}, nil
 }
 
+// setWithdrawalCredentials sets the one time password, PIN and trade password
+// stored in an exchange's config on a withdrawal request
+func (s *RPCServer) setWithdrawalCredentials(request *withdraw.Request) error {
+	exchCfg, err := s.Config.GetExchangeConfig(request.Exchange)
+	if err != nil {
+		return err
+	}
+
+	if exchCfg.API.Credentials.OTPSecret != "" {
+		code, err := totp.GenerateCode(exchCfg.API.Credentials.OTPSecret, time.Now())
+		if err != nil {
+			return err
+		}
+
+		codeNum, err := strconv.ParseInt(code, 10, 64)
+		if err != nil {
+			return err
+		}
+		request.OneTimePassword = codeNum
+	}
+
+	if exchCfg.API.Credentials.PIN != "" {
+		pinCode, err := strconv.ParseInt(exchCfg.API.Credentials.PIN, 10, 64)
+		if err != nil {
+			return err
+		}
+		request.PIN = pinCode
+	}
+
+	request.TradePassword = exchCfg.API.Credentials.TradePassword
+	return nil
+}
+
+// AddWithdrawalAddress adds a labelled address to the withdrawal address book
+func (s *RPCServer) AddWithdrawalAddress(_ context.Context, r *gctrpc.AddWithdrawalAddressRequest) (*gctrpc.WithdrawalAddress, error) {
+	if r.Exchange != "" {
+		if _, err := s.GetExchangeByName(r.Exchange); err != nil {
+			return nil, err
+		}
+	}
+	book, err := s.WithdrawManager.GetAddressBook()
+	if err != nil {
+		return nil, err
+	}
+	entry, err := book.Add(withdraw.AddressBookEntry{
+		Label:       r.Label,
+		Exchange:    r.Exchange,
+		Currency:    currency.NewCode(r.Currency),
+		Address:     r.Address,
+		AddressTag:  r.AddressTag,
+		Chain:       r.Chain,
+		Whitelisted: r.Whitelisted,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return withdrawalAddressToRPC(&entry), nil
+}
+
+// RemoveWithdrawalAddress removes a labelled address from the withdrawal
+// address book
+func (s *RPCServer) RemoveWithdrawalAddress(_ context.Context, r *gctrpc.RemoveWithdrawalAddressRequest) (*gctrpc.GenericResponse, error) {
+	book, err := s.WithdrawManager.GetAddressBook()
+	if err != nil {
+		return nil, err
+	}
+	err = book.Remove(r.Label)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess}, nil
+}
+
+// WhitelistWithdrawalAddress sets whether a labelled address can receive
+// withdrawals
+func (s *RPCServer) WhitelistWithdrawalAddress(_ context.Context, r *gctrpc.WhitelistWithdrawalAddressRequest) (*gctrpc.WithdrawalAddress, error) {
+	book, err := s.WithdrawManager.GetAddressBook()
+	if err != nil {
+		return nil, err
+	}
+	entry, err := book.SetWhitelisted(r.Label, r.Whitelisted)
+	if err != nil {
+		return nil, err
+	}
+	return withdrawalAddressToRPC(&entry), nil
+}
+
+// RenameWithdrawalAddress changes the label of an address in the withdrawal
+// address book
+func (s *RPCServer) RenameWithdrawalAddress(_ context.Context, r *gctrpc.RenameWithdrawalAddressRequest) (*gctrpc.WithdrawalAddress, error) {
+	book, err := s.WithdrawManager.GetAddressBook()
+	if err != nil {
+		return nil, err
+	}
+	entry, err := book.Relabel(r.Label, r.NewLabel)
+	if err != nil {
+		return nil, err
+	}
+	return withdrawalAddressToRPC(&entry), nil
+}
+
+// GetWithdrawalAddresses returns the withdrawal address book entries which
+// can be withdrawn to from an exchange, or all entries when no exchange is
+// supplied
+func (s *RPCServer) GetWithdrawalAddresses(_ context.Context, r *gctrpc.GetWithdrawalAddressesRequest) (*gctrpc.GetWithdrawalAddressesResponse, error) {
+	book, err := s.WithdrawManager.GetAddressBook()
+	if err != nil {
+		return nil, err
+	}
+	entries := book.GetEntries(r.Exchange)
+	resp := &gctrpc.GetWithdrawalAddressesResponse{
+		Addresses: make([]*gctrpc.WithdrawalAddress, len(entries)),
+	}
+	for i := range entries {
+		resp.Addresses[i] = withdrawalAddressToRPC(&entries[i])
+	}
+	return resp, nil
+}
+
+// GetPendingWithdrawals returns the cryptocurrency withdrawals awaiting
+// approval
+func (s *RPCServer) GetPendingWithdrawals(_ context.Context, _ *gctrpc.GetPendingWithdrawalsRequest) (*gctrpc.GetPendingWithdrawalsResponse, error) {
+	pending, err := s.WithdrawManager.GetPendingWithdrawals()
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetPendingWithdrawalsResponse{
+		Withdrawals: make([]*gctrpc.PendingWithdrawal, len(pending)),
+	}
+	for i := range pending {
+		resp.Withdrawals[i] = &gctrpc.PendingWithdrawal{
+			Id:          pending[i].ID.String(),
+			Exchange:    pending[i].Request.Exchange,
+			Currency:    pending[i].Request.Currency.String(),
+			Amount:      pending[i].Request.Amount,
+			Fee:         pending[i].Request.Crypto.FeeAmount,
+			Address:     pending[i].Request.Crypto.Address,
+			AddressTag:  pending[i].Request.Crypto.AddressTag,
+			Chain:       pending[i].Request.Crypto.Chain,
+			Label:       pending[i].Label,
+			Description: pending[i].Request.Description,
+			CreatedAt:   timestamppb.New(pending[i].CreatedAt),
+			ExpiresAt:   timestamppb.New(pending[i].ExpiresAt),
+		}
+	}
+	return resp, nil
+}
+
+// ApproveWithdrawal approves a pending cryptocurrency withdrawal and submits
+// it to the exchange
+func (s *RPCServer) ApproveWithdrawal(ctx context.Context, r *gctrpc.ApproveWithdrawalRequest) (*gctrpc.WithdrawResponse, error) {
+	resp, err := s.WithdrawManager.ApproveWithdrawal(ctx, r.Id, r.Code, s.setWithdrawalCredentials)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.WithdrawResponse{
+		Id:     resp.ID.String(),
+		Status: resp.Exchange.Status,
+	}, nil
+}
+
+// RejectWithdrawal removes a pending cryptocurrency withdrawal without
+// submitting it
+func (s *RPCServer) RejectWithdrawal(_ context.Context, r *gctrpc.RejectWithdrawalRequest) (*gctrpc.GenericResponse, error) {
+	err := s.WithdrawManager.RejectWithdrawal(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess}, nil
+}
+
+// withdrawalAddressToRPC converts an address book entry to its RPC equivalent
+func withdrawalAddressToRPC(e *withdraw.AddressBookEntry) *gctrpc.WithdrawalAddress {
+	return &gctrpc.WithdrawalAddress{
+		Label:       e.Label,
+		Exchange:    e.Exchange,
+		Currency:    e.Currency.String(),
+		Address:     e.Address,
+		AddressTag:  e.AddressTag,
+		Chain:       e.Chain,
+		Whitelisted: e.Whitelisted,
+		CreatedAt:   timestamppb.New(e.CreatedAt),
+		UpdatedAt:   timestamppb.New(e.UpdatedAt),
+	}
+}
+
 // WithdrawalEventByID returns previous withdrawal request details
 func (s *RPCServer) WithdrawalEventByID(_ context.Context, r *gctrpc.WithdrawalEventByIDRequest) (*gctrpc.WithdrawalEventByIDResponse, error) {
 	if !s.Config.Database.Enabled {
@@ -2065,19 +2705,129 @@ func (s *RPCServer) GetOrderbookStream(r *gctrpc.GetOrderbookStreamRequest, stre
 				Price:  base.Asks[i].Price,
 				Id:     base.Asks[i].ID}
 		}
-		err := stream.Send(&gctrpc.OrderbookResponse{
-			Pair:      &gctrpc.CurrencyPair{Base: r.Pair.Base, Quote: r.Pair.Quote},
-			Bids:      bids,
-			Asks:      asks,
-			AssetType: r.AssetType,
-		})
-		if err != nil {
+		err := stream.Send(&gctrpc.OrderbookResponse{
+			Pair:      &gctrpc.CurrencyPair{Base: r.Pair.Base, Quote: r.Pair.Quote},
+			Bids:      bids,
+			Asks:      asks,
+			AssetType: r.AssetType,
+		})
+		if err != nil {
+			return err
+		}
+		<-depth.Wait(stream.Context().Done())
+		if err = stream.Context().Err(); err != nil {
+			return err
+		}
+	}
+}
+
+// GetOrderbookDeltaStream streams the requested orderbook as an initial
+// snapshot followed by only the price levels which have changed since the
+// previous message. Levels which have been removed are sent with a zero amount
+func (s *RPCServer) GetOrderbookDeltaStream(r *gctrpc.GetOrderbookStreamRequest, stream gctrpc.GoCryptoTrader_GetOrderbookDeltaStreamServer) error {
+	if r.Exchange == "" {
+		return errExchangeNameUnset
+	}
+	if r.Pair == nil || r.Pair.String() == "" {
+		return errCurrencyPairUnset
+	}
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return err
+	}
+
+	p := currency.Pair{
+		Delimiter: r.Pair.Delimiter,
+		Base:      currency.NewCode(r.Pair.Base),
+		Quote:     currency.NewCode(r.Pair.Quote),
+	}
+
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return err
+	}
+
+	err = checkParams(r.Exchange, exch, a, p)
+	if err != nil {
+		return err
+	}
+
+	depth, err := orderbook.GetDepth(r.Exchange, p, a)
+	if err != nil {
+		return err
+	}
+
+	var previous *orderbook.Base
+	for {
+		current := depth.Retrieve()
+		resp := &gctrpc.OrderbookDeltaResponse{
+			Pair:         &gctrpc.CurrencyPair{Base: r.Pair.Base, Quote: r.Pair.Quote, Delimiter: r.Pair.Delimiter},
+			AssetType:    r.AssetType,
+			LastUpdated:  s.unixTimestamp(current.LastUpdated),
+			LastUpdateId: current.LastUpdateID,
+		}
+		if previous == nil {
+			resp.Snapshot = true
+			resp.Bids = orderbookDelta(nil, current.Bids)
+			resp.Asks = orderbookDelta(nil, current.Asks)
+		} else {
+			resp.Bids = orderbookDelta(previous.Bids, current.Bids)
+			resp.Asks = orderbookDelta(previous.Asks, current.Asks)
+		}
+		if resp.Snapshot || len(resp.Bids) > 0 || len(resp.Asks) > 0 {
+			err = stream.Send(resp)
+			if err != nil {
+				return err
+			}
+		}
+		previous = current
+		<-depth.Wait(stream.Context().Done())
+		if err = stream.Context().Err(); err != nil {
 			return err
 		}
-		<-depth.Wait(nil)
 	}
 }
 
+// orderbookDelta returns the levels of an orderbook side which have been
+// added or have changed in amount between two retrievals. Levels which are no
+// longer present are returned with a zero amount
+func orderbookDelta(prev, curr orderbook.Items) []*gctrpc.OrderbookItem {
+	type level struct {
+		price float64
+		id    int64
+	}
+	previous := make(map[level]float64, len(prev))
+	for i := range prev {
+		previous[level{prev[i].Price, prev[i].ID}] = prev[i].Amount
+	}
+	var delta []*gctrpc.OrderbookItem
+	for i := range curr {
+		key := level{curr[i].Price, curr[i].ID}
+		amount, ok := previous[key]
+		delete(previous, key)
+		if ok && amount == curr[i].Amount {
+			continue
+		}
+		delta = append(delta, &gctrpc.OrderbookItem{
+			Amount: curr[i].Amount,
+			Price:  curr[i].Price,
+			Id:     curr[i].ID,
+		})
+	}
+	for i := range prev {
+		key := level{prev[i].Price, prev[i].ID}
+		if _, ok := previous[key]; !ok {
+			continue
+		}
+		delete(previous, key)
+		delta = append(delta, &gctrpc.OrderbookItem{
+			Price: prev[i].Price,
+			Id:    prev[i].ID,
+		})
+	}
+	return delta
+}
+
 // GetExchangeOrderbookStream streams all orderbooks associated with an exchange
 func (s *RPCServer) GetExchangeOrderbookStream(r *gctrpc.GetExchangeOrderbookStreamRequest, stream gctrpc.GoCryptoTrader_GetExchangeOrderbookStreamServer) error {
 	if r.Exchange == "" {
@@ -2101,7 +2851,13 @@ func (s *RPCServer) GetExchangeOrderbookStream(r *gctrpc.GetExchangeOrderbookStr
 	}()
 
 	for {
-		data, ok := <-pipe.C
+		var data interface{}
+		var ok bool
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case data, ok = <-pipe.C:
+		}
 		if !ok {
 			return errDispatchSystem
 		}
@@ -2184,7 +2940,13 @@ func (s *RPCServer) GetTickerStream(r *gctrpc.GetTickerStreamRequest, stream gct
 	}()
 
 	for {
-		data, ok := <-pipe.C
+		var data interface{}
+		var ok bool
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case data, ok = <-pipe.C:
+		}
 		if !ok {
 			return errDispatchSystem
 		}
@@ -2242,7 +3004,13 @@ func (s *RPCServer) GetExchangeTickerStream(r *gctrpc.GetExchangeTickerStreamReq
 	}()
 
 	for {
-		data, ok := <-pipe.C
+		var data interface{}
+		var ok bool
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case data, ok = <-pipe.C:
+		}
 		if !ok {
 			return errDispatchSystem
 		}
@@ -3011,6 +3779,45 @@ func (s *RPCServer) WebsocketGetSubscriptions(_ context.Context, r *gctrpc.Webso
 	return payload, nil
 }
 
+// WebsocketGetMetrics returns the message rates, payload bytes and processing
+// lag of an exchange's websocket connections and channels
+func (s *RPCServer) WebsocketGetMetrics(_ context.Context, r *gctrpc.WebsocketGetMetricsRequest) (*gctrpc.WebsocketGetMetricsResponse, error) {
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+
+	w, err := exch.GetWebsocket()
+	if err != nil {
+		return nil, fmt.Errorf("websocket not supported for exchange %s", r.Exchange)
+	}
+
+	metrics := w.Metrics.Snapshot()
+	return &gctrpc.WebsocketGetMetricsResponse{
+		Exchange:    exch.GetName(),
+		Connections: websocketMetricsToRPC(metrics.Connections),
+		Channels:    websocketMetricsToRPC(metrics.Channels),
+	}, nil
+}
+
+func websocketMetricsToRPC(metrics []stream.MessageMetrics) []*gctrpc.WebsocketMetric {
+	resp := make([]*gctrpc.WebsocketMetric, len(metrics))
+	for i := range metrics {
+		resp[i] = &gctrpc.WebsocketMetric{
+			Name:              metrics[i].Name,
+			Messages:          metrics[i].Messages,
+			Bytes:             metrics[i].Bytes,
+			MessagesPerSecond: metrics[i].MessagesPerSecond,
+			BytesPerSecond:    metrics[i].BytesPerSecond,
+			LastMessage:       metrics[i].LastMessage.Format(common.SimpleTimeFormatWithTimezone),
+			LastLag:           metrics[i].LastLag.String(),
+			AverageLag:        metrics[i].AverageLag.String(),
+			MaxLag:            metrics[i].MaxLag.String(),
+		}
+	}
+	return resp
+}
+
 // WebsocketSetProxy sets client websocket connection proxy
 func (s *RPCServer) WebsocketSetProxy(_ context.Context, r *gctrpc.WebsocketSetProxyRequest) (*gctrpc.GenericResponse, error) {
 	exch, err := s.GetExchangeByName(r.Exchange)
@@ -3204,6 +4011,98 @@ func (s *RPCServer) ConvertTradesToCandles(_ context.Context, r *gctrpc.ConvertT
 	return resp, nil
 }
 
+// GetTradeFootprint aggregates saved trades into a volume profile and per
+// interval buy/sell footprints for strategies using order flow features
+func (s *RPCServer) GetTradeFootprint(_ context.Context, r *gctrpc.GetTradeFootprintRequest) (*gctrpc.GetTradeFootprintResponse, error) {
+	if r.End == "" || r.Start == "" || r.Exchange == "" || r.Pair == nil || r.AssetType == "" || r.Pair.String() == "" || r.TimeInterval <= 0 {
+		return nil, errInvalidArguments
+	}
+	start, err := time.Parse(common.SimpleTimeFormat, r.Start)
+	if err != nil {
+		return nil, fmt.Errorf("%w cannot parse start time %v", errInvalidTimes, err)
+	}
+	end, err := time.Parse(common.SimpleTimeFormat, r.End)
+	if err != nil {
+		return nil, fmt.Errorf("%w cannot parse end time %v", errInvalidTimes, err)
+	}
+	err = common.StartEndTimeCheck(start, end)
+	if err != nil {
+		return nil, err
+	}
+	p := currency.Pair{
+		Delimiter: r.Pair.Delimiter,
+		Base:      currency.NewCode(r.Pair.Base),
+		Quote:     currency.NewCode(r.Pair.Quote),
+	}
+
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkParams(r.Exchange, exch, a, p)
+	if err != nil {
+		return nil, err
+	}
+
+	trades, err := trade.GetTradesInRange(r.Exchange, r.AssetType, r.Pair.Base, r.Pair.Quote, start, end)
+	if err != nil {
+		return nil, err
+	}
+	if len(trades) == 0 {
+		return nil, errNoTrades
+	}
+	profile, err := trade.GetVolumeProfile(r.PriceBucketSize, trades...)
+	if err != nil {
+		return nil, err
+	}
+	interval := kline.Interval(r.TimeInterval)
+	footprints, err := trade.ConvertTradesToFootprints(interval, r.PriceBucketSize, trades...)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gctrpc.GetTradeFootprintResponse{
+		Exchange: r.Exchange,
+		Pair:     r.Pair,
+		Asset:    r.AssetType,
+		Interval: interval.String(),
+		Profile:  volumeProfileToRPC(profile),
+	}
+	for i := range footprints {
+		resp.Footprints = append(resp.Footprints, &gctrpc.TradeFootprint{
+			Time:       footprints[i].Time.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone),
+			BuyVolume:  footprints[i].BuyVolume,
+			SellVolume: footprints[i].SellVolume,
+			Delta:      footprints[i].Delta,
+			Profile:    volumeProfileToRPC(&footprints[i].Profile),
+		})
+	}
+	return resp, nil
+}
+
+func volumeProfileToRPC(profile *trade.VolumeProfile) *gctrpc.TradeVolumeProfile {
+	resp := &gctrpc.TradeVolumeProfile{
+		PointOfControl: profile.PointOfControl,
+		TotalVolume:    profile.TotalVolume,
+		Levels:         make([]*gctrpc.TradeVolumeLevel, len(profile.Levels)),
+	}
+	for i := range profile.Levels {
+		resp.Levels[i] = &gctrpc.TradeVolumeLevel{
+			Price:      profile.Levels[i].Price,
+			BuyVolume:  profile.Levels[i].BuyVolume,
+			SellVolume: profile.Levels[i].SellVolume,
+			Volume:     profile.Levels[i].Volume,
+		}
+	}
+	return resp
+}
+
 // FindMissingSavedCandleIntervals is used to help determine what candle data is missing
 func (s *RPCServer) FindMissingSavedCandleIntervals(_ context.Context, r *gctrpc.FindMissingCandlePeriodsRequest) (*gctrpc.FindMissingIntervalsResponse, error) {
 	if r.End == "" || r.Start == "" || r.ExchangeName == "" || r.Pair == nil || r.AssetType == "" || r.Pair.String() == "" || r.Interval <= 0 {
@@ -3398,6 +4297,318 @@ func (s *RPCServer) FindMissingSavedTradeIntervals(_ context.Context, r *gctrpc.
 	return resp, nil
 }
 
+// GetSavedCandleCoverage returns the stored candle ranges for every exchange,
+// pair, asset and interval along with the number of missing candles. Missing
+// periods are returned when gaps are requested
+func (s *RPCServer) GetSavedCandleCoverage(_ context.Context, r *gctrpc.GetSavedCandleCoverageRequest) (*gctrpc.GetSavedCandleCoverageResponse, error) {
+	coverage, err := candleDB.GetCoverage(r.ExchangeName)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetSavedCandleCoverageResponse{}
+	for i := range coverage {
+		interval := time.Duration(coverage[i].Interval) * time.Second
+		item := &gctrpc.SavedCandleCoverage{
+			ExchangeName: coverage[i].Exchange,
+			AssetType:    coverage[i].Asset,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: currency.DashDelimiter,
+				Base:      coverage[i].Base,
+				Quote:     coverage[i].Quote,
+			},
+			Interval:     int64(interval),
+			Start:        coverage[i].Start.Format(common.SimpleTimeFormatWithTimezone),
+			End:          coverage[i].End.Format(common.SimpleTimeFormatWithTimezone),
+			Count:        coverage[i].Count,
+			MissingCount: coverage[i].Missing,
+		}
+		if r.IncludeGaps && coverage[i].Missing > 0 {
+			var gaps []candleDB.Gap
+			gaps, err = candleDB.FindGaps(coverage[i].Exchange,
+				coverage[i].Base,
+				coverage[i].Quote,
+				coverage[i].Interval,
+				coverage[i].Asset,
+				coverage[i].Start,
+				coverage[i].End.Add(interval))
+			if err != nil {
+				return nil, err
+			}
+			for j := range gaps {
+				item.MissingPeriods = append(item.MissingPeriods,
+					gaps[j].Start.Format(common.SimpleTimeFormatWithTimezone)+
+						" - "+
+						gaps[j].End.Format(common.SimpleTimeFormatWithTimezone))
+			}
+		}
+		resp.Coverage = append(resp.Coverage, item)
+	}
+	return resp, nil
+}
+
+// GetDataSyncStatus returns the sync progress of every item configured in the
+// data sync manager
+func (s *RPCServer) GetDataSyncStatus(_ context.Context, _ *gctrpc.GetDataSyncStatusRequest) (*gctrpc.GetDataSyncStatusResponse, error) {
+	status, err := s.dataSyncManager.GetStatus()
+	if err != nil {
+		return nil, err
+	}
+	formatTime := func(t time.Time) string {
+		if t.IsZero() {
+			return ""
+		}
+		return t.Format(common.SimpleTimeFormatWithTimezone)
+	}
+	resp := &gctrpc.GetDataSyncStatusResponse{}
+	for i := range status {
+		resp.Items = append(resp.Items, &gctrpc.DataSyncStatus{
+			Exchange: status[i].Exchange,
+			Asset:    status[i].Asset.String(),
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: status[i].Pair.Delimiter,
+				Base:      status[i].Pair.Base.String(),
+				Quote:     status[i].Pair.Quote.String(),
+			},
+			Interval:           int64(status[i].Interval),
+			SyncTrades:         status[i].SyncTrades,
+			BackfillStart:      formatTime(status[i].BackfillStart),
+			CandlesSyncedUntil: formatTime(status[i].CandlesSyncedUntil),
+			TradesSyncedUntil:  formatTime(status[i].TradesSyncedUntil),
+			CandlesInserted:    status[i].CandlesInserted,
+			TradesInserted:     status[i].TradesInserted,
+			LastSync:           formatTime(status[i].LastSync),
+			NextAttempt:        formatTime(status[i].NextAttempt),
+			Failures:           status[i].Failures,
+			LastError:          status[i].LastError,
+		})
+	}
+	return resp, nil
+}
+
+// GetPortfolioPnL returns the profit and loss of positions tracked by the
+// portfolio analytics manager, optionally filtered by exchange, asset and pair
+func (s *RPCServer) GetPortfolioPnL(_ context.Context, r *gctrpc.GetPortfolioPnLRequest) (*gctrpc.GetPortfolioPnLResponse, error) {
+	var a asset.Item
+	if r.Asset != "" {
+		var err error
+		a, err = asset.New(r.Asset)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var cp currency.Pair
+	if r.Pair != nil && (r.Pair.Base != "" || r.Pair.Quote != "") {
+		var err error
+		cp, err = currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
+		if err != nil {
+			return nil, err
+		}
+	}
+	positions, err := s.portfolioAnalytics.GetPositions(r.Exchange, a, cp)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetPortfolioPnLResponse{}
+	for i := range positions {
+		resp.Positions = append(resp.Positions, &gctrpc.PositionPnL{
+			Exchange: positions[i].Exchange,
+			Asset:    positions[i].Asset.String(),
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: positions[i].Pair.Delimiter,
+				Base:      positions[i].Pair.Base.String(),
+				Quote:     positions[i].Pair.Quote.String(),
+			},
+			Quantity:          positions[i].Quantity,
+			AverageEntryPrice: positions[i].AverageEntryPrice,
+			LastPrice:         positions[i].LastPrice,
+			RealisedPnl:       positions[i].RealisedPnL,
+			UnrealisedPnl:     positions[i].UnrealisedPnL,
+			Fees:              positions[i].Fees,
+			NetPnl:            positions[i].NetPnL,
+			Volume:            positions[i].Volume,
+			Trades:            positions[i].Trades,
+			WinningTrades:     positions[i].WinningTrades,
+			LosingTrades:      positions[i].LosingTrades,
+			LastUpdated:       positions[i].LastUpdated.Format(common.SimpleTimeFormatWithTimezone),
+		})
+	}
+	return resp, nil
+}
+
+// GetPortfolioPerformance returns the profit and loss of positions tracked by
+// the portfolio analytics manager aggregated by exchange and quote currency
+func (s *RPCServer) GetPortfolioPerformance(_ context.Context, r *gctrpc.GetPortfolioPerformanceRequest) (*gctrpc.GetPortfolioPerformanceResponse, error) {
+	summaries, err := s.portfolioAnalytics.GetPerformance(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetPortfolioPerformanceResponse{}
+	for i := range summaries {
+		resp.Performance = append(resp.Performance, &gctrpc.PortfolioPerformance{
+			Exchange:      summaries[i].Exchange,
+			Quote:         summaries[i].Quote.String(),
+			Positions:     summaries[i].Positions,
+			RealisedPnl:   summaries[i].RealisedPnL,
+			UnrealisedPnl: summaries[i].UnrealisedPnL,
+			Fees:          summaries[i].Fees,
+			NetPnl:        summaries[i].NetPnL,
+			Volume:        summaries[i].Volume,
+			Trades:        summaries[i].Trades,
+			WinningTrades: summaries[i].WinningTrades,
+			LosingTrades:  summaries[i].LosingTrades,
+			WinRate:       summaries[i].WinRate,
+		})
+	}
+	return resp, nil
+}
+
+// GetDerivativePositions returns the live derivative positions tracked by the
+// positions manager, optionally filtered by exchange, asset and pair
+func (s *RPCServer) GetDerivativePositions(_ context.Context, r *gctrpc.GetDerivativePositionsRequest) (*gctrpc.GetDerivativePositionsResponse, error) {
+	var a asset.Item
+	if r.Asset != "" {
+		var err error
+		a, err = asset.New(r.Asset)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var cp currency.Pair
+	if r.Pair != nil && (r.Pair.Base != "" || r.Pair.Quote != "") {
+		var err error
+		cp, err = currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
+		if err != nil {
+			return nil, err
+		}
+	}
+	positions, err := s.positionsManager.GetPositions(r.Exchange, a, cp)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetDerivativePositionsResponse{}
+	for i := range positions {
+		resp.Positions = append(resp.Positions, &gctrpc.DerivativePosition{
+			Exchange: positions[i].Exchange,
+			Asset:    positions[i].Asset.String(),
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: positions[i].Pair.Delimiter,
+				Base:      positions[i].Pair.Base.String(),
+				Quote:     positions[i].Pair.Quote.String(),
+			},
+			Side:              positions[i].Side.String(),
+			Amount:            positions[i].Amount,
+			EntryPrice:        positions[i].EntryPrice,
+			MarkPrice:         positions[i].MarkPrice,
+			LiquidationPrice:  positions[i].LiquidationPrice,
+			Leverage:          positions[i].Leverage,
+			Margin:            positions[i].Margin,
+			MaintenanceMargin: positions[i].MaintenanceMargin,
+			MarginRatio:       positions[i].MarginRatio,
+			UnrealisedPnl:     positions[i].UnrealisedPnL,
+			RealisedPnl:       positions[i].RealisedPnL,
+			Source:            positions[i].Source,
+			LastUpdated:       positions[i].LastUpdated.Format(common.SimpleTimeFormatWithTimezone),
+		})
+	}
+	return resp, nil
+}
+
+// GetLeverage returns the leverage and margin mode of a derivatives asset and
+// currency pair
+func (s *RPCServer) GetLeverage(ctx context.Context, r *gctrpc.GetLeverageRequest) (*gctrpc.GetLeverageResponse, error) {
+	if r == nil {
+		return nil, errInvalidArguments
+	}
+	exch, a, cp, err := s.getLeverageParams(r.Exchange, r.Asset, r.Pair)
+	if err != nil {
+		return nil, err
+	}
+	leverage, err := exch.GetLeverage(ctx, a, cp)
+	if err != nil {
+		return nil, err
+	}
+	mode, err := exch.GetMarginMode(ctx, a, cp)
+	if err != nil && !errors.Is(err, common.ErrFunctionNotSupported) {
+		return nil, err
+	}
+	return &gctrpc.GetLeverageResponse{
+		Exchange:   exch.GetName(),
+		Asset:      a.String(),
+		Pair:       r.Pair,
+		Leverage:   leverage,
+		MarginMode: mode.String(),
+	}, nil
+}
+
+// SetLeverage sets the leverage and or margin mode of a derivatives asset and
+// currency pair. The margin mode is set first as some exchanges reset the
+// leverage when it changes
+func (s *RPCServer) SetLeverage(ctx context.Context, r *gctrpc.SetLeverageRequest) (*gctrpc.GenericResponse, error) {
+	if r == nil {
+		return nil, errInvalidArguments
+	}
+	if r.Leverage <= 0 && r.MarginMode == "" {
+		return nil, fmt.Errorf("%w, leverage or margin mode must be set", errInvalidArguments)
+	}
+	var mode exchange.MarginMode
+	if r.MarginMode != "" {
+		var err error
+		mode, err = exchange.StringToMarginMode(r.MarginMode)
+		if err != nil {
+			return nil, err
+		}
+	}
+	exch, a, cp, err := s.getLeverageParams(r.Exchange, r.Asset, r.Pair)
+	if err != nil {
+		return nil, err
+	}
+	if mode != exchange.MarginModeUnknown {
+		err = exch.SetMarginMode(ctx, a, cp, mode)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if r.Leverage > 0 {
+		err = exch.SetLeverage(ctx, a, cp, r.Leverage)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &gctrpc.GenericResponse{
+		Status: MsgStatusSuccess,
+		Data:   fmt.Sprintf("%s %s %s leverage settings updated", exch.GetName(), a, cp),
+	}, nil
+}
+
+// getLeverageParams validates and returns the exchange, asset and currency
+// pair of a leverage request
+func (s *RPCServer) getLeverageParams(exchName, assetType string, p *gctrpc.CurrencyPair) (exchange.IBotExchange, asset.Item, currency.Pair, error) {
+	if p == nil {
+		return nil, "", currency.Pair{}, errCurrencyPairUnset
+	}
+	a, err := asset.New(assetType)
+	if err != nil {
+		return nil, "", currency.Pair{}, err
+	}
+	if !a.IsFutures() {
+		return nil, "", currency.Pair{}, fmt.Errorf("%w, %s is not a derivatives asset", errInvalidArguments, a)
+	}
+	cp, err := currency.NewPairFromStrings(p.Base, p.Quote)
+	if err != nil {
+		return nil, "", currency.Pair{}, err
+	}
+	cp.Delimiter = p.Delimiter
+	exch, err := s.GetExchangeByName(exchName)
+	if err != nil {
+		return nil, "", currency.Pair{}, err
+	}
+	err = checkParams(exchName, exch, a, cp)
+	if err != nil {
+		return nil, "", currency.Pair{}, err
+	}
+	return exch, a, cp, nil
+}
+
 // SetExchangeTradeProcessing allows the setting of exchange trade processing
 func (s *RPCServer) SetExchangeTradeProcessing(_ context.Context, r *gctrpc.SetExchangeTradeProcessingRequest) (*gctrpc.GenericResponse, error) {
 	exch, err := s.GetExchangeByName(r.Exchange)
@@ -4001,6 +5212,74 @@ func (s *RPCServer) GetDataHistoryJobSummary(_ context.Context, r *gctrpc.GetDat
 	}, nil
 }
 
+// GetDataHistoryJobProgress returns how many of a data history job's intervals
+// have been processed
+func (s *RPCServer) GetDataHistoryJobProgress(_ context.Context, r *gctrpc.GetDataHistoryJobDetailsRequest) (*gctrpc.DataHistoryJobProgress, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	if r.Nickname == "" {
+		return nil, fmt.Errorf("get job progress %w", errNicknameUnset)
+	}
+	progress, err := s.dataHistoryManager.GetJobProgress(r.Nickname)
+	if err != nil {
+		return nil, err
+	}
+	return dataHistoryJobProgressToRPC(progress), nil
+}
+
+// GetDataHistoryJobProgressStream streams a data history job's progress after
+// every run of data history jobs until the job is no longer active or paused
+func (s *RPCServer) GetDataHistoryJobProgressStream(r *gctrpc.GetDataHistoryJobDetailsRequest, stream gctrpc.GoCryptoTrader_GetDataHistoryJobProgressStreamServer) error {
+	if r == nil {
+		return errNilRequestData
+	}
+	if r.Nickname == "" {
+		return fmt.Errorf("get job progress %w", errNicknameUnset)
+	}
+	for {
+		updated, err := s.dataHistoryManager.Updated()
+		if err != nil {
+			return err
+		}
+		progress, err := s.dataHistoryManager.GetJobProgress(r.Nickname)
+		if err != nil {
+			return err
+		}
+		err = stream.Send(dataHistoryJobProgressToRPC(progress))
+		if err != nil {
+			return err
+		}
+		if progress.Status != dataHistoryStatusActive && progress.Status != dataHistoryStatusPaused {
+			return nil
+		}
+		select {
+		case <-updated:
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+// dataHistoryJobProgressToRPC converts data history job progress to its RPC
+// representation
+func dataHistoryJobProgressToRPC(p *DataHistoryJobProgress) *gctrpc.DataHistoryJobProgress {
+	resp := &gctrpc.DataHistoryJobProgress{
+		Nickname:           p.Nickname,
+		Status:             p.Status.String(),
+		DataType:           p.DataType.String(),
+		TotalIntervals:     p.TotalIntervals,
+		CompletedIntervals: p.CompletedIntervals,
+		IssueIntervals:     p.IssueIntervals,
+		FailedAttempts:     p.FailedAttempts,
+		PercentComplete:    p.PercentComplete,
+	}
+	if !p.LastRun.IsZero() {
+		resp.LastRun = p.LastRun.Format(common.SimpleTimeFormatWithTimezone)
+	}
+	return resp
+}
+
 // unixTimestamp returns given time in either unix seconds or unix nanoseconds, depending
 // on the remoteControl/gRPC/timeInNanoSeconds boolean configuration.
 func (s *RPCServer) unixTimestamp(x time.Time) int64 {
@@ -6,11 +6,14 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"reflect"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -31,12 +34,15 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/gctrpc"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 	"github.com/thrasher-corp/goose"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -125,6 +131,10 @@ func (f fExchange) UpdateAccountInfo(ctx context.Context, a asset.Item) (account
 }
 
 // GetCurrencyStateSnapshot overrides interface function
+func (f fExchange) GetServerTime(_ context.Context, _ asset.Item) (time.Time, error) {
+	return time.Now().Add(time.Minute), nil
+}
+
 func (f fExchange) GetCurrencyStateSnapshot() ([]currencystate.Snapshot, error) {
 	return []currencystate.Snapshot{
 		{
@@ -178,6 +188,9 @@ func RPCTestSetup(t *testing.T) *Engine {
 	}
 	dbm.dbConn.DataPath = tempDir
 	engerino.DatabaseManager = dbm
+	// each test has a fresh database, so exchange IDs cached by previous
+	// tests no longer exist
+	dbexchange.ResetExchangeCache()
 	var wg sync.WaitGroup
 	err = dbm.Start(&wg)
 	if err != nil {
@@ -331,6 +344,58 @@ func TestGetSavedTrades(t *testing.T) {
 	}
 }
 
+func TestGetTradeFootprint(t *testing.T) {
+	engerino := RPCTestSetup(t)
+	defer CleanRPCTest(t, engerino)
+	s := RPCServer{Engine: engerino}
+	_, err := s.GetTradeFootprint(context.Background(), &gctrpc.GetTradeFootprintRequest{})
+	if !errors.Is(err, errInvalidArguments) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidArguments)
+	}
+	req := &gctrpc.GetTradeFootprintRequest{
+		Exchange: testExchange,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: currency.DashDelimiter,
+			Base:      currency.BTC.String(),
+			Quote:     currency.USD.String(),
+		},
+		AssetType:       asset.Spot.String(),
+		Start:           time.Date(2020, 0, 0, 0, 0, 0, 0, time.UTC).Format(common.SimpleTimeFormat),
+		End:             time.Date(2020, 0, 0, 1, 0, 0, 0, time.UTC).Format(common.SimpleTimeFormat),
+		TimeInterval:    int64(kline.FifteenMin.Duration()),
+		PriceBucketSize: 10,
+	}
+	_, err = s.GetTradeFootprint(context.Background(), req)
+	if !errors.Is(err, errNoTrades) {
+		t.Errorf("received '%v' expected '%v'", err, errNoTrades)
+	}
+	for i, side := range []order.Side{order.Buy, order.Sell} {
+		err = sqltrade.Insert(sqltrade.Data{
+			Timestamp: time.Date(2020, 0, 0, 0, 30, i, 0, time.UTC),
+			Exchange:  testExchange,
+			Base:      currency.BTC.String(),
+			Quote:     currency.USD.String(),
+			AssetType: asset.Spot.String(),
+			Price:     1337,
+			Amount:    2,
+			Side:      side.String(),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	resp, err := s.GetTradeFootprint(context.Background(), req)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp.Footprints) != 1 || resp.Footprints[0].Delta != 0 {
+		t.Errorf("unexpected footprints %v", resp.Footprints)
+	}
+	if resp.Profile.PointOfControl != 1330 || resp.Profile.TotalVolume != 4 {
+		t.Errorf("unexpected profile %v", resp.Profile)
+	}
+}
+
 func TestConvertTradesToCandles(t *testing.T) {
 	engerino := RPCTestSetup(t)
 	defer CleanRPCTest(t, engerino)
@@ -727,6 +792,84 @@ func TestFindMissingSavedTradeIntervals(t *testing.T) {
 	}
 }
 
+func TestGetSavedCandleCoverage(t *testing.T) {
+	engerino := RPCTestSetup(t)
+	defer CleanRPCTest(t, engerino)
+	s := RPCServer{Engine: engerino}
+	cp := currency.NewPair(currency.BTC, currency.USD)
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, err := kline.StoreInDatabase(&kline.Item{
+		Exchange: testExchange,
+		Pair:     cp,
+		Asset:    asset.Spot,
+		Interval: kline.OneHour,
+		Candles: []kline.Candle{
+			{Time: start, Open: 1, High: 1, Low: 1, Close: 1, Volume: 1},
+			{Time: start.Add(time.Hour * 3), Open: 1, High: 1, Low: 1, Close: 1, Volume: 1},
+		},
+	}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := s.GetSavedCandleCoverage(context.Background(), &gctrpc.GetSavedCandleCoverageRequest{
+		ExchangeName: testExchange,
+		IncludeGaps:  true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Coverage) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(resp.Coverage), 1)
+	}
+	if resp.Coverage[0].Count != 2 || resp.Coverage[0].MissingCount != 2 {
+		t.Errorf("unexpected coverage %v", resp.Coverage[0])
+	}
+	if resp.Coverage[0].Interval != int64(kline.OneHour.Duration()) {
+		t.Errorf("received '%v' expected '%v'", resp.Coverage[0].Interval, int64(kline.OneHour.Duration()))
+	}
+	if len(resp.Coverage[0].MissingPeriods) != 1 {
+		t.Errorf("received '%v' expected '%v'", len(resp.Coverage[0].MissingPeriods), 1)
+	}
+}
+
+func TestGetDataSyncStatus(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	_, err := s.GetDataSyncStatus(context.Background(), &gctrpc.GetDataSyncStatusRequest{})
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+
+	synced := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.dataSyncManager = &DataSyncManager{
+		items: []*dataSyncItem{{
+			exchange:           testExchange,
+			asset:              asset.Spot,
+			pair:               currency.NewPair(currency.BTC, currency.USD),
+			interval:           kline.OneHour,
+			candlesSyncedUntil: synced,
+			candlesInserted:    5,
+			lastError:          errDataSyncTest,
+		}},
+	}
+	resp, err := s.GetDataSyncStatus(context.Background(), &gctrpc.GetDataSyncStatusRequest{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp.Items) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(resp.Items), 1)
+	}
+	if resp.Items[0].CandlesSyncedUntil != synced.Format(common.SimpleTimeFormatWithTimezone) {
+		t.Errorf("received '%v' expected '%v'", resp.Items[0].CandlesSyncedUntil, synced.Format(common.SimpleTimeFormatWithTimezone))
+	}
+	if resp.Items[0].TradesSyncedUntil != "" {
+		t.Errorf("received '%v' expected no trade sync time", resp.Items[0].TradesSyncedUntil)
+	}
+	if resp.Items[0].CandlesInserted != 5 || resp.Items[0].LastError != errDataSyncTest.Error() {
+		t.Errorf("unexpected status %v", resp.Items[0])
+	}
+}
+
 func TestFindMissingSavedCandleIntervals(t *testing.T) {
 	engerino := RPCTestSetup(t)
 	defer CleanRPCTest(t, engerino)
@@ -958,6 +1101,133 @@ func TestGetHistoricTrades(t *testing.T) {
 	}
 }
 
+func TestGetExchangeClockSkew(t *testing.T) {
+	t.Parallel()
+	em := SetupExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exch.SetDefaults()
+	b := exch.GetBase()
+	b.Name = fakeExchangeName
+	b.Enabled = true
+	em.Add(fExchange{IBotExchange: exch})
+	s := RPCServer{Engine: &Engine{ExchangeManager: em}}
+
+	_, err = s.GetExchangeClockSkew(context.Background(), &gctrpc.GetExchangeClockSkewRequest{Exchange: "bad"})
+	if !errors.Is(err, ErrExchangeNotFound) {
+		t.Errorf("received '%v', expected '%v'", err, ErrExchangeNotFound)
+	}
+	resp, err := s.GetExchangeClockSkew(context.Background(), &gctrpc.GetExchangeClockSkewRequest{Exchange: fakeExchangeName})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if resp.ClockSkewMilliseconds != 0 || resp.LastSync != "" {
+		t.Errorf("expected unmeasured clock skew, received %v", resp)
+	}
+	_, err = s.GetExchangeClockSkew(context.Background(), &gctrpc.GetExchangeClockSkewRequest{Exchange: fakeExchangeName, Asset: "bad", Sync: true})
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v', expected '%v'", err, asset.ErrNotSupported)
+	}
+	resp, err = s.GetExchangeClockSkew(context.Background(), &gctrpc.GetExchangeClockSkewRequest{Exchange: fakeExchangeName, Sync: true})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if resp.ClockSkewMilliseconds < 59000 || resp.ClockSkewMilliseconds > 61000 || resp.LastSync == "" {
+		t.Errorf("expected a measured clock skew of a minute, received %v", resp)
+	}
+}
+
+func TestRPCServerGetExchangeHealth(t *testing.T) {
+	t.Parallel()
+	em := SetupExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exch.SetDefaults()
+	b := exch.GetBase()
+	b.Name = fakeExchangeName
+	b.Enabled = true
+	b.SkipAuthCheck = true
+	b.API.AuthenticatedSupport = true
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+	err = b.API.Endpoints.SetRunning(exchange.RestSpot.String(), srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	em.Add(fExchange{IBotExchange: exch})
+	m, err := SetupRateLimitBudgetManager(&config.RateLimitBudgetManager{
+		Budgets: []config.RateLimitBudget{
+			{Exchange: fakeExchangeName, Requests: 10, Interval: time.Second, BackgroundShare: 0.5},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// started is set directly so the manager is not set as the global arbiter
+	atomic.StoreInt32(&m.started, 1)
+	s := RPCServer{Engine: &Engine{ExchangeManager: em, rateLimitBudgetManager: m}}
+
+	_, err = s.GetExchangeHealth(context.Background(), &gctrpc.GetExchangeHealthRequest{Exchange: "bad"})
+	if !errors.Is(err, ErrExchangeNotFound) {
+		t.Errorf("received '%v', expected '%v'", err, ErrExchangeNotFound)
+	}
+	resp, err := s.GetExchangeHealth(context.Background(), &gctrpc.GetExchangeHealthRequest{VerifyCredentials: true})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if len(resp.Exchanges) != 1 {
+		t.Fatalf("received '%v', expected '%v'", len(resp.Exchanges), 1)
+	}
+	h := resp.Exchanges[0]
+	if !h.RestReachable || h.RestStatusCode != http.StatusOK || h.RestUrl != srv.URL {
+		t.Errorf("expected reachable REST API, received %v", h)
+	}
+	if !h.CredentialsSet || !h.CredentialsVerified || h.CredentialsError != "" {
+		t.Errorf("expected verified credentials, received %v", h)
+	}
+	if h.RateLimit == nil || h.RateLimit.RequestsPerSecond != 10 {
+		t.Errorf("expected rate limit headroom, received %v", h.RateLimit)
+	}
+}
+
+func TestWebsocketGetMetrics(t *testing.T) {
+	t.Parallel()
+	em := SetupExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exch.SetDefaults()
+	em.Add(exch)
+	s := RPCServer{Engine: &Engine{ExchangeManager: em}}
+
+	_, err = s.WebsocketGetMetrics(context.Background(), &gctrpc.WebsocketGetMetricsRequest{Exchange: "bad"})
+	if !errors.Is(err, ErrExchangeNotFound) {
+		t.Errorf("received '%v', expected '%v'", err, ErrExchangeNotFound)
+	}
+	ws, err := exch.GetWebsocket()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ws.Metrics.RecordMessage("trades", &stream.Response{Raw: []byte("test"), Received: time.Now()})
+	resp, err := s.WebsocketGetMetrics(context.Background(), &gctrpc.WebsocketGetMetricsRequest{Exchange: testExchange})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if len(resp.Connections) != 0 || len(resp.Channels) != 1 {
+		t.Fatalf("unexpected metrics %v", resp)
+	}
+	if resp.Channels[0].Name != "trades" || resp.Channels[0].Messages != 1 || resp.Channels[0].Bytes != 4 {
+		t.Errorf("unexpected channel metrics %v", resp.Channels[0])
+	}
+}
+
 func TestGetAccountInfo(t *testing.T) {
 	t.Parallel()
 	em := SetupExchangeManager()
@@ -1660,6 +1930,36 @@ func TestGetDataHistoryJobSummary(t *testing.T) {
 	}
 }
 
+func TestGetDataHistoryJobProgress(t *testing.T) {
+	t.Parallel()
+	m, _ := createDHM(t)
+	s := RPCServer{Engine: &Engine{dataHistoryManager: m}}
+
+	_, err := s.GetDataHistoryJobProgress(context.Background(), nil)
+	if !errors.Is(err, errNilRequestData) {
+		t.Errorf("received %v, expected %v", err, errNilRequestData)
+	}
+
+	_, err = s.GetDataHistoryJobProgress(context.Background(), &gctrpc.GetDataHistoryJobDetailsRequest{})
+	if !errors.Is(err, errNicknameUnset) {
+		t.Errorf("received %v, expected %v", err, errNicknameUnset)
+	}
+
+	resp, err := s.GetDataHistoryJobProgress(context.Background(), &gctrpc.GetDataHistoryJobDetailsRequest{Nickname: "TestGetDataHistoryJobProgress"})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received %v, expected %v", err, nil)
+	}
+	if resp.Nickname != "TestGetDataHistoryJobProgress" {
+		t.Errorf("received %v, expected %v", resp.Nickname, "TestGetDataHistoryJobProgress")
+	}
+	if resp.Status != dataHistoryStatusActive.String() {
+		t.Errorf("received %v, expected %v", resp.Status, dataHistoryStatusActive.String())
+	}
+	if resp.TotalIntervals == 0 {
+		t.Error("expected job intervals")
+	}
+}
+
 func TestGetManagedOrders(t *testing.T) {
 	exchName := "Binance"
 	engerino := &Engine{}
@@ -1864,6 +2164,487 @@ func TestRPCServer_GetTicker_LastUpdatedNanos(t *testing.T) {
 	}
 }
 
+func TestOrderbookDelta(t *testing.T) {
+	t.Parallel()
+	delta := orderbookDelta(nil, nil)
+	if len(delta) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(delta), 0)
+	}
+
+	prev := orderbook.Items{{Price: 100, Amount: 1}, {Price: 99, Amount: 2}, {Price: 98, Amount: 3}}
+	delta = orderbookDelta(nil, prev)
+	if len(delta) != 3 {
+		t.Fatalf("received '%v' expected '%v'", len(delta), 3)
+	}
+
+	curr := orderbook.Items{{Price: 100, Amount: 1}, {Price: 99, Amount: 5}, {Price: 97, Amount: 4}}
+	delta = orderbookDelta(prev, curr)
+	expected := []*gctrpc.OrderbookItem{
+		{Price: 99, Amount: 5},
+		{Price: 97, Amount: 4},
+		{Price: 98},
+	}
+	if len(delta) != len(expected) {
+		t.Fatalf("received '%v' expected '%v'", len(delta), len(expected))
+	}
+	for i := range expected {
+		if delta[i].Price != expected[i].Price || delta[i].Amount != expected[i].Amount {
+			t.Errorf("received '%v' expected '%v'", delta[i], expected[i])
+		}
+	}
+
+	delta = orderbookDelta(curr, curr)
+	if len(delta) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(delta), 0)
+	}
+}
+
+type orderbookDeltaStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *gctrpc.OrderbookDeltaResponse
+}
+
+func (o *orderbookDeltaStream) Context() context.Context {
+	return o.ctx
+}
+
+func (o *orderbookDeltaStream) Send(r *gctrpc.OrderbookDeltaResponse) error {
+	o.sent <- r
+	return nil
+}
+
+func TestGetOrderbookDeltaStream(t *testing.T) {
+	t.Parallel()
+	pair := currency.NewPairWithDelimiter("DELTA", "STREAM", "-")
+	server := RPCServer{Engine: RPCTestSetup(t)}
+	exch, err := server.GetExchangeByName(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := exch.GetBase()
+	b.CurrencyPairs.Pairs[asset.Spot].Available = append(b.CurrencyPairs.Pairs[asset.Spot].Available, pair)
+	b.CurrencyPairs.Pairs[asset.Spot].Enabled = append(b.CurrencyPairs.Pairs[asset.Spot].Enabled, pair)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := &orderbookDeltaStream{ctx: ctx, sent: make(chan *gctrpc.OrderbookDeltaResponse, 10)}
+	err = server.GetOrderbookDeltaStream(&gctrpc.GetOrderbookStreamRequest{}, stream)
+	if !errors.Is(err, errExchangeNameUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errExchangeNameUnset)
+	}
+	err = server.GetOrderbookDeltaStream(&gctrpc.GetOrderbookStreamRequest{Exchange: testExchange}, stream)
+	if !errors.Is(err, errCurrencyPairUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errCurrencyPairUnset)
+	}
+
+	book := &orderbook.Base{
+		Exchange: testExchange,
+		Pair:     pair,
+		Asset:    asset.Spot,
+		Bids:     orderbook.Items{{Price: 100, Amount: 1}, {Price: 99, Amount: 1}},
+		Asks:     orderbook.Items{{Price: 101, Amount: 1}},
+	}
+	err = book.Process()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := &gctrpc.GetOrderbookStreamRequest{
+		Exchange:  testExchange,
+		Pair:      &gctrpc.CurrencyPair{Base: pair.Base.String(), Quote: pair.Quote.String(), Delimiter: pair.Delimiter},
+		AssetType: asset.Spot.String(),
+	}
+	errs := make(chan error, 1)
+	go func() {
+		errs <- server.GetOrderbookDeltaStream(req, stream)
+	}()
+
+	snapshot := <-stream.sent
+	if !snapshot.Snapshot || len(snapshot.Bids) != 2 || len(snapshot.Asks) != 1 {
+		t.Fatalf("unexpected snapshot %v", snapshot)
+	}
+
+	book.Bids = orderbook.Items{{Price: 100, Amount: 2}}
+	var delta *gctrpc.OrderbookDeltaResponse
+	for delta == nil {
+		err = book.Process()
+		if err != nil {
+			t.Fatal(err)
+		}
+		select {
+		case delta = <-stream.sent:
+		case <-time.After(time.Millisecond * 50):
+		}
+	}
+	if delta.Snapshot || len(delta.Bids) != 2 || len(delta.Asks) != 0 {
+		t.Fatalf("unexpected delta %v", delta)
+	}
+	if delta.Bids[0].Price != 100 || delta.Bids[0].Amount != 2 ||
+		delta.Bids[1].Price != 99 || delta.Bids[1].Amount != 0 {
+		t.Errorf("unexpected delta bids %v", delta.Bids)
+	}
+
+	cancel()
+	err = <-errs
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("received '%v' expected '%v'", err, context.Canceled)
+	}
+}
+
+func TestGetPortfolioPnL(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	_, err := s.GetPortfolioPnL(context.Background(), &gctrpc.GetPortfolioPnLRequest{})
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemNotStarted)
+	}
+
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	s.portfolioAnalytics = setupTestPortfolioAnalytics(t, &pamOrders{
+		orders: []order.Detail{{
+			Exchange:  testExchange,
+			ID:        "1",
+			Side:      order.Buy,
+			Status:    order.Filled,
+			AssetType: asset.Spot,
+			Pair:      cp,
+			Amount:    2,
+			Price:     100,
+		}},
+	}, 150)
+	s.portfolioAnalytics.update()
+
+	_, err = s.GetPortfolioPnL(context.Background(), &gctrpc.GetPortfolioPnLRequest{Asset: "bad"})
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v' expected '%v'", err, asset.ErrNotSupported)
+	}
+	resp, err := s.GetPortfolioPnL(context.Background(), &gctrpc.GetPortfolioPnLRequest{
+		Exchange: testExchange,
+		Asset:    asset.Spot.String(),
+		Pair:     &gctrpc.CurrencyPair{Base: cp.Base.String(), Quote: cp.Quote.String()},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp.Positions) != 1 || resp.Positions[0].UnrealisedPnl != 100 {
+		t.Errorf("unexpected response %v", resp.Positions)
+	}
+}
+
+func TestGetPortfolioPerformance(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	_, err := s.GetPortfolioPerformance(context.Background(), &gctrpc.GetPortfolioPerformanceRequest{})
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemNotStarted)
+	}
+
+	s.portfolioAnalytics = setupTestPortfolioAnalytics(t, &pamOrders{
+		orders: []order.Detail{{
+			Exchange:  testExchange,
+			ID:        "1",
+			Side:      order.Buy,
+			Status:    order.Filled,
+			AssetType: asset.Spot,
+			Pair:      currency.NewPair(currency.BTC, currency.USDT),
+			Amount:    2,
+			Price:     100,
+		}},
+	}, 150)
+	s.portfolioAnalytics.update()
+	resp, err := s.GetPortfolioPerformance(context.Background(), &gctrpc.GetPortfolioPerformanceRequest{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp.Performance) != 1 || resp.Performance[0].Quote != currency.USDT.String() {
+		t.Errorf("unexpected response %v", resp.Performance)
+	}
+}
+
+func TestGetDerivativePositions(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	_, err := s.GetDerivativePositions(context.Background(), &gctrpc.GetDerivativePositionsRequest{})
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemNotStarted)
+	}
+
+	cp := currency.NewPair(currency.BTC, currency.USD)
+	s.positionsManager = setupTestPositionsManager(t, &pmExchange{}, &pamOrders{
+		orders: []order.Detail{{
+			Exchange:  testExchange,
+			ID:        "1",
+			Side:      order.Buy,
+			Status:    order.Filled,
+			AssetType: asset.Futures,
+			Pair:      cp,
+			Amount:    2,
+			Price:     100,
+		}},
+	}, nil)
+	s.positionsManager.update(context.Background())
+
+	_, err = s.GetDerivativePositions(context.Background(), &gctrpc.GetDerivativePositionsRequest{Asset: "bad"})
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v' expected '%v'", err, asset.ErrNotSupported)
+	}
+	resp, err := s.GetDerivativePositions(context.Background(), &gctrpc.GetDerivativePositionsRequest{
+		Exchange: testExchange,
+		Asset:    asset.Futures.String(),
+		Pair:     &gctrpc.CurrencyPair{Base: cp.Base.String(), Quote: cp.Quote.String()},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp.Positions) != 1 || resp.Positions[0].Side != order.Long.String() || resp.Positions[0].UnrealisedPnl != 100 {
+		t.Errorf("unexpected response %v", resp.Positions)
+	}
+}
+
+func TestGetLeverage(t *testing.T) {
+	t.Parallel()
+	em := SetupExchangeManager()
+	s := RPCServer{Engine: &Engine{ExchangeManager: em}}
+	_, err := s.GetLeverage(context.Background(), nil)
+	if !errors.Is(err, errInvalidArguments) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidArguments)
+	}
+	_, err = s.GetLeverage(context.Background(), &gctrpc.GetLeverageRequest{Exchange: testExchange, Asset: asset.Futures.String()})
+	if !errors.Is(err, errCurrencyPairUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errCurrencyPairUnset)
+	}
+	pair := &gctrpc.CurrencyPair{Base: currency.BTC.String(), Quote: currency.USD.String()}
+	_, err = s.GetLeverage(context.Background(), &gctrpc.GetLeverageRequest{Exchange: testExchange, Asset: asset.Spot.String(), Pair: pair})
+	if !errors.Is(err, errInvalidArguments) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidArguments)
+	}
+	_, err = s.GetLeverage(context.Background(), &gctrpc.GetLeverageRequest{Exchange: testExchange, Asset: asset.Futures.String(), Pair: pair})
+	if !errors.Is(err, ErrExchangeNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, ErrExchangeNotFound)
+	}
+}
+
+func TestSetLeverage(t *testing.T) {
+	t.Parallel()
+	em := SetupExchangeManager()
+	s := RPCServer{Engine: &Engine{ExchangeManager: em}}
+	_, err := s.SetLeverage(context.Background(), nil)
+	if !errors.Is(err, errInvalidArguments) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidArguments)
+	}
+	pair := &gctrpc.CurrencyPair{Base: currency.BTC.String(), Quote: currency.USD.String()}
+	_, err = s.SetLeverage(context.Background(), &gctrpc.SetLeverageRequest{Exchange: testExchange, Asset: asset.Futures.String(), Pair: pair})
+	if !errors.Is(err, errInvalidArguments) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidArguments)
+	}
+	_, err = s.SetLeverage(context.Background(), &gctrpc.SetLeverageRequest{Exchange: testExchange, Asset: asset.Futures.String(), Pair: pair, MarginMode: "portfolio"})
+	if !errors.Is(err, exchange.ErrInvalidMarginMode) {
+		t.Errorf("received '%v' expected '%v'", err, exchange.ErrInvalidMarginMode)
+	}
+	_, err = s.SetLeverage(context.Background(), &gctrpc.SetLeverageRequest{Exchange: testExchange, Asset: asset.Futures.String(), Leverage: 5})
+	if !errors.Is(err, errCurrencyPairUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errCurrencyPairUnset)
+	}
+	_, err = s.SetLeverage(context.Background(), &gctrpc.SetLeverageRequest{Exchange: testExchange, Asset: asset.Futures.String(), Pair: pair, Leverage: 5})
+	if !errors.Is(err, ErrExchangeNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, ErrExchangeNotFound)
+	}
+}
+
+func TestGetConsolidatedOrderbook(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{Config: &config.Config{}}}
+	_, err := s.GetConsolidatedOrderbook(context.Background(), &gctrpc.GetConsolidatedOrderbookRequest{AssetType: "bad"})
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v' expected '%v'", err, asset.ErrNotSupported)
+	}
+	_, err = s.GetConsolidatedOrderbook(context.Background(), &gctrpc.GetConsolidatedOrderbookRequest{AssetType: asset.Spot.String()})
+	if !errors.Is(err, errCurrencyPairUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errCurrencyPairUnset)
+	}
+	req := &gctrpc.GetConsolidatedOrderbookRequest{
+		AssetType:   asset.Spot.String(),
+		Pair:        &gctrpc.CurrencyPair{Base: currency.BTC.String(), Quote: currency.USDT.String()},
+		FeeAdjusted: true,
+	}
+	_, err = s.GetConsolidatedOrderbook(context.Background(), req)
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+
+	s.OrderbookAggregator = setupTestOrderRouter(t, &routerOrders{}).aggregator
+	resp, err := s.GetConsolidatedOrderbook(context.Background(), req)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp.Exchanges) != 2 || len(resp.Bids) != 2 || len(resp.Asks) != 3 {
+		t.Fatalf("unexpected response %v", resp)
+	}
+	if resp.Asks[0].Exchange != "cheap" || resp.Asks[0].EffectivePrice != 101 {
+		t.Errorf("unexpected ask %v", resp.Asks[0])
+	}
+}
+
+func TestGetArbitrageOpportunities(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	_, err := s.GetArbitrageOpportunities(context.Background(), &gctrpc.GetArbitrageOpportunitiesRequest{})
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+	s.arbitrageScanner = setupTestArbitrageScanner(t, &dmComms{}, nil)
+	s.arbitrageScanner.started = 1
+	s.arbitrageScanner.scan(context.Background())
+	resp, err := s.GetArbitrageOpportunities(context.Background(), &gctrpc.GetArbitrageOpportunitiesRequest{Exchange: "low"})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp.Opportunities) != 1 || resp.Opportunities[0].SellExchange != "high" || resp.Opportunities[0].Pair.Base != "BTC" {
+		t.Errorf("unexpected response %v", resp)
+	}
+}
+
+type triangularArbitrageStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *gctrpc.GetTriangularArbitrageResponse
+}
+
+func (s *triangularArbitrageStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *triangularArbitrageStream) Send(r *gctrpc.GetTriangularArbitrageResponse) error {
+	s.sent <- r
+	return nil
+}
+
+func TestGetTriangularArbitrage(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	_, err := s.GetTriangularArbitrage(context.Background(), &gctrpc.GetTriangularArbitrageRequest{})
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+	s.triangularArbitrage = setupTestTriangularArbitrage(t)
+	s.triangularArbitrage.started = 1
+	s.triangularArbitrage.scan(context.Background())
+	resp, err := s.GetTriangularArbitrage(context.Background(), &gctrpc.GetTriangularArbitrageRequest{Exchange: "tri"})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp.Opportunities) != 1 || len(resp.Opportunities[0].Legs) != 3 || resp.Opportunities[0].Legs[0].Side != order.Buy.String() {
+		t.Errorf("unexpected response %v", resp)
+	}
+}
+
+func TestGetTriangularArbitrageStream(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := &triangularArbitrageStream{ctx: ctx, sent: make(chan *gctrpc.GetTriangularArbitrageResponse, 10)}
+	err := s.GetTriangularArbitrageStream(&gctrpc.GetTriangularArbitrageRequest{}, stream)
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+
+	s.triangularArbitrage = setupTestTriangularArbitrage(t)
+	s.triangularArbitrage.started = 1
+	errs := make(chan error, 1)
+	go func() {
+		errs <- s.GetTriangularArbitrageStream(&gctrpc.GetTriangularArbitrageRequest{}, stream)
+	}()
+	if resp := <-stream.sent; len(resp.Opportunities) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(resp.Opportunities), 0)
+	}
+	s.triangularArbitrage.scan(context.Background())
+	if resp := <-stream.sent; len(resp.Opportunities) != 1 {
+		t.Errorf("received '%v' expected '%v'", len(resp.Opportunities), 1)
+	}
+
+	err = s.triangularArbitrage.Stop()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if err = <-errs; !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemNotStarted)
+	}
+}
+
+func TestRouteOrder(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	_, err := s.RouteOrder(context.Background(), &gctrpc.RouteOrderRequest{AssetType: "bad"})
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v' expected '%v'", err, asset.ErrNotSupported)
+	}
+	_, err = s.RouteOrder(context.Background(), &gctrpc.RouteOrderRequest{AssetType: asset.Spot.String()})
+	if !errors.Is(err, errCurrencyPairUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errCurrencyPairUnset)
+	}
+	req := &gctrpc.RouteOrderRequest{
+		AssetType: asset.Spot.String(),
+		Pair:      &gctrpc.CurrencyPair{Base: currency.BTC.String(), Quote: currency.USDT.String()},
+		Side:      order.Buy.String(),
+		Amount:    2,
+		Execute:   true,
+	}
+	_, err = s.RouteOrder(context.Background(), req)
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemNotStarted)
+	}
+
+	s.OrderRouter = setupTestOrderRouter(t, &routerOrders{failOn: "deep"})
+	resp, err := s.RouteOrder(context.Background(), req)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp.Allocations) != 1 || resp.Allocations[0].Error != errRouterTest.Error() {
+		t.Errorf("unexpected allocations %v", resp.Allocations)
+	}
+}
+
+func TestConditionalOrderRPCs(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	_, err := s.AddConditionalOrder(context.Background(), &gctrpc.AddConditionalOrderRequest{AssetType: "bad"})
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v' expected '%v'", err, asset.ErrNotSupported)
+	}
+	_, err = s.AddConditionalOrder(context.Background(), &gctrpc.AddConditionalOrderRequest{AssetType: asset.Spot.String()})
+	if !errors.Is(err, errCurrencyPairUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errCurrencyPairUnset)
+	}
+	_, err = s.GetConditionalOrders(context.Background(), &gctrpc.GetConditionalOrdersRequest{})
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+	_, err = s.CancelConditionalOrder(context.Background(), &gctrpc.CancelConditionalOrderRequest{})
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+
+	var wg sync.WaitGroup
+	s.OrderManager, err = SetupOrderManager(SetupExchangeManager(), &CommunicationManager{}, &wg, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	s.OrderManager.started = 1
+	resp, err := s.GetConditionalOrders(context.Background(), &gctrpc.GetConditionalOrdersRequest{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp.ConditionalOrders) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(resp.ConditionalOrders), 0)
+	}
+	_, err = s.CancelConditionalOrder(context.Background(), &gctrpc.CancelConditionalOrderRequest{Id: "bad"})
+	if !errors.Is(err, errConditionalOrderNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, errConditionalOrderNotFound)
+	}
+}
+
 func TestUpdateDataHistoryJobPrerequisite(t *testing.T) {
 	t.Parallel()
 	m, _ := createDHM(t)
@@ -1977,3 +2758,88 @@ func TestCurrencyStateTradingPair(t *testing.T) {
 		t.Fatalf("received: %v, but expected: %v", err, nil)
 	}
 }
+
+func TestGetPaperBalances(t *testing.T) {
+	t.Parallel()
+	em := SetupExchangeManager()
+	s := RPCServer{Engine: &Engine{ExchangeManager: em}}
+	_, err := s.GetPaperBalances(context.Background(), &gctrpc.GetPaperBalancesRequest{})
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+	_, err = s.GetPaperBalances(context.Background(), &gctrpc.GetPaperBalancesRequest{Exchange: "bad"})
+	if !errors.Is(err, ErrExchangeNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, ErrExchangeNotFound)
+	}
+
+	var wg sync.WaitGroup
+	s.OrderManager, err = SetupOrderManager(em, &CommunicationManager{}, &wg, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = s.OrderManager.EnablePaperTrading([]config.PaperTradingBalance{
+		{Exchange: testExchange, Currency: currency.USDT, Amount: 1000},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	s.OrderManager.started = 1
+	resp, err := s.GetPaperBalances(context.Background(), &gctrpc.GetPaperBalancesRequest{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp.Balances) != 1 || resp.Balances[0].Currency != "USDT" || resp.Balances[0].Amount != 1000 {
+		t.Errorf("unexpected balances %v", resp.Balances)
+	}
+}
+
+func TestWithdrawalAddressBookRPC(t *testing.T) {
+	t.Parallel()
+	em := SetupExchangeManager()
+	m, err := SetupWithdrawManager(em, nil, true)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	s := RPCServer{Engine: &Engine{ExchangeManager: em, WithdrawManager: m}}
+	_, err = s.GetWithdrawalAddresses(context.Background(), &gctrpc.GetWithdrawalAddressesRequest{})
+	if !errors.Is(err, errAddressBookNotEnabled) {
+		t.Errorf("received '%v' expected '%v'", err, errAddressBookNotEnabled)
+	}
+	err = m.EnableAddressBook("", true)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = s.AddWithdrawalAddress(context.Background(), &gctrpc.AddWithdrawalAddressRequest{Label: "cold", Exchange: "bad"})
+	if !errors.Is(err, ErrExchangeNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, ErrExchangeNotFound)
+	}
+	_, err = s.AddWithdrawalAddress(context.Background(), &gctrpc.AddWithdrawalAddressRequest{
+		Label:    "cold",
+		Currency: "btc",
+		Address:  "1337",
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	address, err := s.WhitelistWithdrawalAddress(context.Background(), &gctrpc.WhitelistWithdrawalAddressRequest{
+		Label:       "cold",
+		Whitelisted: true,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !address.Whitelisted || address.Currency != "BTC" {
+		t.Errorf("unexpected address %v", address)
+	}
+	resp, err := s.GetWithdrawalAddresses(context.Background(), &gctrpc.GetWithdrawalAddressesRequest{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp.Addresses) != 1 || resp.Addresses[0].Label != "cold" {
+		t.Errorf("unexpected addresses %v", resp.Addresses)
+	}
+	_, err = s.GetPendingWithdrawals(context.Background(), &gctrpc.GetPendingWithdrawalsRequest{})
+	if !errors.Is(err, errWithdrawalApprovalsDisabled) {
+		t.Errorf("received '%v' expected '%v'", err, errWithdrawalApprovalsDisabled)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"sync/atomic"
@@ -12,8 +13,10 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stats"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/log"
@@ -39,6 +42,7 @@ var (
 	errNoSyncItemsEnabled         = errors.New("no sync items enabled")
 	errUnknownSyncItem            = errors.New("unknown sync item")
 	errSyncPairNotFound           = errors.New("exchange currency pair syncer not found")
+	errInvalidSyncInterval        = errors.New("invalid pair sync interval")
 )
 
 // setupSyncManager starts a new CurrencyPairSyncer
@@ -73,14 +77,27 @@ func setupSyncManager(c *Config, exchangeManager iExchangeManager, remoteConfig
 	}
 
 	s.tickerBatchLastRequested = make(map[string]time.Time)
+	s.pairSettings = make(map[pairSettingKey]config.CurrencyPairSyncItem)
+	for i := range c.PairSettings {
+		if c.PairSettings[i].Interval < 0 {
+			return nil, fmt.Errorf("%s %s %s %w",
+				c.PairSettings[i].Exchange,
+				c.PairSettings[i].Asset,
+				c.PairSettings[i].Pair,
+				errInvalidSyncInterval)
+		}
+		s.pairSettings[newPairSettingKey(c.PairSettings[i].Exchange,
+			c.PairSettings[i].Pair,
+			c.PairSettings[i].Asset)] = c.PairSettings[i]
+	}
 
 	log.Debugf(log.SyncMgr,
 		"Exchange currency pair syncer config: continuous: %v ticker: %v"+
 			" orderbook: %v trades: %v workers: %v verbose: %v timeout REST: %v"+
-			" timeout Websocket: %v",
+			" timeout Websocket: %v pair settings: %v",
 		s.config.SyncContinuously, s.config.SyncTicker, s.config.SyncOrderbook,
 		s.config.SyncTrades, s.config.NumWorkers, s.config.Verbose, s.config.SyncTimeoutREST,
-		s.config.SyncTimeoutWebsocket)
+		s.config.SyncTimeoutWebsocket, len(s.pairSettings))
 	s.inService.Add(1)
 	return s, nil
 }
@@ -155,6 +172,7 @@ func (m *syncManager) Start() error {
 					err)
 				continue
 			}
+			enabledPairs = m.sortByPriority(exchangeName, enabledPairs, assetTypes[y])
 			for i := range enabledPairs {
 				if m.exists(exchangeName, enabledPairs[i], assetTypes[y]) {
 					continue
@@ -165,6 +183,7 @@ func (m *syncManager) Start() error {
 					Exchange:  exchangeName,
 					Pair:      enabledPairs[i],
 				}
+				m.applyPairSettings(c)
 				sBase := syncBase{
 					IsUsingREST:      usingREST || !wsAssetSupported,
 					IsUsingWebsocket: usingWebsocket && wsAssetSupported,
@@ -310,6 +329,52 @@ func (m *syncManager) add(c *currencyPairSyncAgent) {
 	m.currencyPairs = append(m.currencyPairs, *c)
 }
 
+// newPairSettingKey returns a key to look up an individual pair's sync
+// settings
+func newPairSettingKey(exchangeName string, p currency.Pair, a asset.Item) pairSettingKey {
+	return pairSettingKey{
+		Exchange: strings.ToLower(exchangeName),
+		Asset:    a,
+		Base:     p.Base.Item,
+		Quote:    p.Quote.Item,
+	}
+}
+
+// applyPairSettings sets the configured priority and interval for a sync
+// agent
+func (m *syncManager) applyPairSettings(c *currencyPairSyncAgent) {
+	setting, ok := m.pairSettings[newPairSettingKey(c.Exchange, c.Pair, c.AssetType)]
+	if !ok {
+		return
+	}
+	c.Priority = setting.Priority
+	c.Interval = setting.Interval
+}
+
+// sortByPriority returns a copy of the pairs ordered so that pairs with the
+// highest configured priority are synced first
+func (m *syncManager) sortByPriority(exchangeName string, pairs currency.Pairs, a asset.Item) currency.Pairs {
+	if len(m.pairSettings) == 0 {
+		return pairs
+	}
+	sorted := make(currency.Pairs, len(pairs))
+	copy(sorted, pairs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return m.pairSettings[newPairSettingKey(exchangeName, sorted[i], a)].Priority >
+			m.pairSettings[newPairSettingKey(exchangeName, sorted[j], a)].Priority
+	})
+	return sorted
+}
+
+// restTimeout returns the duration after which a sync agent's REST data is
+// refreshed
+func (m *syncManager) restTimeout(c *currencyPairSyncAgent) time.Duration {
+	if c.Interval > 0 {
+		return c.Interval
+	}
+	return m.config.SyncTimeoutREST
+}
+
 func (m *syncManager) isProcessing(exchangeName string, p currency.Pair, a asset.Item, syncType int) bool {
 	m.mux.Lock()
 	defer m.mux.Unlock()
@@ -456,6 +521,7 @@ func (m *syncManager) worker() {
 	}
 	defer cleanup()
 
+	ctx := request.WithConsumer(context.Background(), request.CurrencyPairSyncer)
 	for atomic.LoadInt32(&m.started) != 0 {
 		exchanges, err := m.exchangeManager.GetExchanges()
 		if err != nil {
@@ -489,6 +555,17 @@ func (m *syncManager) worker() {
 
 			assetTypes := exchanges[x].GetAssetTypes(true)
 			for y := range assetTypes {
+				status, err := exchange.CheckSystemStatus(ctx, exchanges[x], assetTypes[y])
+				if err == nil && !status.AllowsMarketData() {
+					if m.config.Verbose {
+						log.Debugf(log.SyncMgr,
+							"%s %s system status is %s, syncing is paused",
+							exchangeName,
+							assetTypes[y],
+							status)
+					}
+					continue
+				}
 				wsAssetSupported := exchanges[x].IsAssetWebsocketSupported(assetTypes[y])
 				enabledPairs, err := exchanges[x].GetEnabledPairs(assetTypes[y])
 				if err != nil {
@@ -498,6 +575,7 @@ func (m *syncManager) worker() {
 						err)
 					continue
 				}
+				enabledPairs = m.sortByPriority(exchangeName, enabledPairs, assetTypes[y])
 				for i := range enabledPairs {
 					if atomic.LoadInt32(&m.started) == 0 {
 						return
@@ -511,6 +589,7 @@ func (m *syncManager) worker() {
 								Exchange:  exchangeName,
 								Pair:      enabledPairs[i],
 							}
+							m.applyPairSettings(c)
 
 							sBase := syncBase{
 								IsUsingREST:      usingREST || !wsAssetSupported,
@@ -545,7 +624,7 @@ func (m *syncManager) worker() {
 					if m.config.SyncOrderbook {
 						if !m.isProcessing(exchangeName, c.Pair, c.AssetType, SyncItemOrderbook) {
 							if c.Orderbook.LastUpdated.IsZero() ||
-								(time.Since(c.Orderbook.LastUpdated) > m.config.SyncTimeoutREST && c.Orderbook.IsUsingREST) ||
+								(time.Since(c.Orderbook.LastUpdated) > m.restTimeout(c) && c.Orderbook.IsUsingREST) ||
 								(time.Since(c.Orderbook.LastUpdated) > m.config.SyncTimeoutWebsocket && c.Orderbook.IsUsingWebsocket) {
 								if c.Orderbook.IsUsingWebsocket {
 									if time.Since(c.Created) < m.config.SyncTimeoutWebsocket {
@@ -568,7 +647,7 @@ func (m *syncManager) worker() {
 								}
 
 								m.setProcessing(c.Exchange, c.Pair, c.AssetType, SyncItemOrderbook, true)
-								result, err := exchanges[x].UpdateOrderbook(context.TODO(),
+								result, err := exchanges[x].UpdateOrderbook(ctx,
 									c.Pair,
 									c.AssetType)
 								m.PrintOrderbookSummary(result, "REST", err)
@@ -589,7 +668,7 @@ func (m *syncManager) worker() {
 						if m.config.SyncTicker {
 							if !m.isProcessing(exchangeName, c.Pair, c.AssetType, SyncItemTicker) {
 								if c.Ticker.LastUpdated.IsZero() ||
-									(time.Since(c.Ticker.LastUpdated) > m.config.SyncTimeoutREST && c.Ticker.IsUsingREST) ||
+									(time.Since(c.Ticker.LastUpdated) > m.restTimeout(c) && c.Ticker.IsUsingREST) ||
 									(time.Since(c.Ticker.LastUpdated) > m.config.SyncTimeoutWebsocket && c.Ticker.IsUsingWebsocket) {
 									if c.Ticker.IsUsingWebsocket {
 										if time.Since(c.Created) < m.config.SyncTimeoutWebsocket {
@@ -625,14 +704,14 @@ func (m *syncManager) worker() {
 											}
 											m.mux.Unlock()
 
-											if batchLastDone.IsZero() || time.Since(batchLastDone) > m.config.SyncTimeoutREST {
+											if batchLastDone.IsZero() || time.Since(batchLastDone) > m.restTimeout(c) {
 												m.mux.Lock()
 												if m.config.Verbose {
 													log.Debugf(log.SyncMgr, "Initialising %s REST ticker batching", exchangeName)
 												}
-												err = exchanges[x].UpdateTickers(context.TODO(), c.AssetType)
+												err = exchanges[x].UpdateTickers(ctx, c.AssetType)
 												if err == nil {
-													result, err = exchanges[x].FetchTicker(context.TODO(), c.Pair, c.AssetType)
+													result, err = exchanges[x].FetchTicker(ctx, c.Pair, c.AssetType)
 												}
 												m.tickerBatchLastRequested[exchangeName] = time.Now()
 												m.mux.Unlock()
@@ -640,12 +719,12 @@ func (m *syncManager) worker() {
 												if m.config.Verbose {
 													log.Debugf(log.SyncMgr, "%s Using recent batching cache", exchangeName)
 												}
-												result, err = exchanges[x].FetchTicker(context.TODO(),
+												result, err = exchanges[x].FetchTicker(ctx,
 													c.Pair,
 													c.AssetType)
 											}
 										} else {
-											result, err = exchanges[x].UpdateTicker(context.TODO(),
+											result, err = exchanges[x].UpdateTicker(ctx,
 												c.Pair,
 												c.AssetType)
 										}
@@ -668,7 +747,7 @@ func (m *syncManager) worker() {
 
 						if m.config.SyncTrades {
 							if !m.isProcessing(exchangeName, c.Pair, c.AssetType, SyncItemTrade) {
-								if c.Trade.LastUpdated.IsZero() || time.Since(c.Trade.LastUpdated) > m.config.SyncTimeoutREST {
+								if c.Trade.LastUpdated.IsZero() || time.Since(c.Trade.LastUpdated) > m.restTimeout(c) {
 									m.setProcessing(c.Exchange, c.Pair, c.AssetType, SyncItemTrade, true)
 									err := m.Update(c.Exchange, c.Pair, c.AssetType, SyncItemTrade, nil)
 									if err != nil {
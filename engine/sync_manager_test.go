@@ -4,10 +4,12 @@ import (
 	"errors"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 )
@@ -38,6 +40,62 @@ func TestSetupSyncManager(t *testing.T) {
 	}
 }
 
+func TestSetupSyncManagerPairSettings(t *testing.T) {
+	t.Parallel()
+	btcusdt := currency.NewPair(currency.BTC, currency.USDT)
+	_, err := setupSyncManager(&Config{
+		SyncTrades: true,
+		PairSettings: []config.CurrencyPairSyncItem{
+			{Exchange: "Binance", Asset: asset.Spot, Pair: btcusdt, Interval: -time.Second},
+		},
+	}, &ExchangeManager{}, &config.RemoteControlConfig{}, false)
+	if !errors.Is(err, errInvalidSyncInterval) {
+		t.Errorf("error '%v', expected '%v'", err, errInvalidSyncInterval)
+	}
+
+	m, err := setupSyncManager(&Config{
+		SyncTrades:      true,
+		SyncTimeoutREST: time.Minute,
+		PairSettings: []config.CurrencyPairSyncItem{
+			{Exchange: "Binance", Asset: asset.Spot, Pair: btcusdt, Priority: 10, Interval: time.Second},
+		},
+	}, &ExchangeManager{}, &config.RemoteControlConfig{}, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+
+	c := &currencyPairSyncAgent{Exchange: "binance", AssetType: asset.Spot, Pair: btcusdt}
+	m.applyPairSettings(c)
+	if c.Priority != 10 {
+		t.Errorf("received '%v', expected '%v'", c.Priority, 10)
+	}
+	if timeout := m.restTimeout(c); timeout != time.Second {
+		t.Errorf("received '%v', expected '%v'", timeout, time.Second)
+	}
+
+	c = &currencyPairSyncAgent{Exchange: "binance", AssetType: asset.Spot, Pair: currency.NewPair(currency.LTC, currency.USDT)}
+	m.applyPairSettings(c)
+	if timeout := m.restTimeout(c); timeout != time.Minute {
+		t.Errorf("received '%v', expected '%v'", timeout, time.Minute)
+	}
+
+	pairs := currency.Pairs{
+		currency.NewPair(currency.LTC, currency.USDT),
+		currency.NewPair(currency.ETH, currency.USDT),
+		btcusdt,
+	}
+	sorted := m.sortByPriority("Binance", pairs, asset.Spot)
+	if !sorted[0].Equal(btcusdt) {
+		t.Errorf("received '%v', expected '%v'", sorted[0], btcusdt)
+	}
+	if !sorted[1].Equal(pairs[0]) {
+		t.Errorf("received '%v', expected '%v'", sorted[1], pairs[0])
+	}
+	if !pairs[2].Equal(btcusdt) {
+		t.Error("expected original pairs to be unchanged")
+	}
+}
+
 func TestSyncManagerStart(t *testing.T) {
 	t.Parallel()
 	m, err := setupSyncManager(&Config{SyncTrades: true}, &ExchangeManager{}, &config.RemoteControlConfig{}, true)
@@ -25,6 +25,8 @@ type currencyPairSyncAgent struct {
 	Exchange  string
 	AssetType asset.Item
 	Pair      currency.Pair
+	Priority  int
+	Interval  time.Duration
 	Ticker    syncBase
 	Orderbook syncBase
 	Trade     syncBase
@@ -40,6 +42,15 @@ type Config struct {
 	SyncTimeoutWebsocket time.Duration
 	NumWorkers           int
 	Verbose              bool
+	PairSettings         []config.CurrencyPairSyncItem
+}
+
+// pairSettingKey is used to look up individual pair sync settings
+type pairSettingKey struct {
+	Exchange string
+	Asset    asset.Item
+	Base     *currency.Item
+	Quote    *currency.Item
 }
 
 // syncManager stores the exchange currency pair syncer object
@@ -58,6 +69,7 @@ type syncManager struct {
 
 	currencyPairs            []currencyPairSyncAgent
 	tickerBatchLastRequested map[string]time.Time
+	pairSettings             map[pairSettingKey]config.CurrencyPairSyncItem
 
 	remoteConfig    *config.RemoteControlConfig
 	config          Config
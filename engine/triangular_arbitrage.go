@@ -0,0 +1,348 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// triangularQuote holds the top of a pair's orderbook and its taker fee
+type triangularQuote struct {
+	bid, bidAmount float64
+	ask, askAmount float64
+	feeRate        float64
+}
+
+// SetupTriangularArbitrageDetector creates a triangular arbitrage detector
+// which searches the spot pairs of the exchange manager's exchanges
+func SetupTriangularArbitrageDetector(em iExchangeManager, cfg *config.TriangularArbitrage) (*TriangularArbitrageDetector, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	if cfg.CheckInterval <= 0 {
+		return nil, errInvalidTriangularInterval
+	}
+	return &TriangularArbitrageDetector{
+		shutdown:             make(chan struct{}),
+		checkInterval:        cfg.CheckInterval,
+		exchanges:            cfg.Exchanges,
+		startCurrencies:      cfg.StartCurrencies,
+		minimumProfitPercent: cfg.MinimumProfitPercent,
+		verbose:              cfg.Verbose,
+		exchangeManager:      em,
+		orderbookFetcher:     orderbook.Get,
+		feeFetcher:           fetchTakerFeeRate,
+		opportunities:        make(map[string][]TriangularOpportunity),
+		updated:              make(chan struct{}),
+	}, nil
+}
+
+// Start runs the subsystem
+func (t *TriangularArbitrageDetector) Start() error {
+	if t == nil {
+		return ErrNilSubsystem
+	}
+	if !atomic.CompareAndSwapInt32(&t.started, 0, 1) {
+		return ErrSubSystemAlreadyStarted
+	}
+	t.shutdown = make(chan struct{})
+	go t.run()
+	log.Debugf(log.ExchangeSys, "Triangular arbitrage detector %v", MsgSubSystemStarted)
+	return nil
+}
+
+// IsRunning checks whether the subsystem is running
+func (t *TriangularArbitrageDetector) IsRunning() bool {
+	if t == nil {
+		return false
+	}
+	return atomic.LoadInt32(&t.started) == 1
+}
+
+// Stop stops the subsystem and wakes any streams waiting for an update so
+// they can return
+func (t *TriangularArbitrageDetector) Stop() error {
+	if t == nil {
+		return ErrNilSubsystem
+	}
+	if !atomic.CompareAndSwapInt32(&t.started, 1, 0) {
+		return ErrSubSystemNotStarted
+	}
+	close(t.shutdown)
+	t.notify()
+	log.Debugf(log.ExchangeSys, "Triangular arbitrage detector %v", MsgSubSystemShutdown)
+	return nil
+}
+
+func (t *TriangularArbitrageDetector) run() {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-t.shutdown:
+			return
+		case <-timer.C:
+			ctx, cancel := t.cycleContext()
+			t.scan(ctx)
+			cancel()
+			timer.Reset(t.checkInterval)
+		}
+	}
+}
+
+// cycleContext returns a context which is cancelled when the subsystem is
+// stopped
+func (t *TriangularArbitrageDetector) cycleContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		select {
+		case <-t.shutdown:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
+
+// scan searches each eligible exchange for opportunities, replaces the stored
+// opportunities and notifies any waiting streams
+func (t *TriangularArbitrageDetector) scan(ctx context.Context) {
+	exchanges, err := t.exchangeManager.GetExchanges()
+	if err != nil {
+		log.Errorf(log.ExchangeSys, "Triangular arbitrage detector unable to get exchanges: %v", err)
+		return
+	}
+	found := make(map[string][]TriangularOpportunity)
+	for _, exch := range exchanges {
+		exchName := exch.GetName()
+		if !exch.IsEnabled() ||
+			(len(t.exchanges) > 0 && !common.StringDataCompareInsensitive(t.exchanges, exchName)) {
+			continue
+		}
+		pairs, err := exch.GetEnabledPairs(asset.Spot)
+		if err != nil {
+			if t.verbose {
+				log.Warnf(log.ExchangeSys, "Triangular arbitrage detector unable to get %s spot pairs: %v", exchName, err)
+			}
+			continue
+		}
+		found[strings.ToLower(exchName)] = t.scanExchange(ctx, exch, pairs)
+	}
+
+	t.m.Lock()
+	for exchKey, opps := range found {
+		previous := make(map[string]time.Time, len(t.opportunities[exchKey]))
+		for i := range t.opportunities[exchKey] {
+			previous[triangularKey(&t.opportunities[exchKey][i])] = t.opportunities[exchKey][i].DetectedAt
+		}
+		for i := range opps {
+			if detected, ok := previous[triangularKey(&opps[i])]; ok {
+				opps[i].DetectedAt = detected
+			} else if t.verbose {
+				log.Infof(log.ExchangeSys, "Triangular arbitrage detector found %s %s cycle for %.4f%% profit",
+					opps[i].Exchange, triangularKey(&opps[i]), opps[i].ProfitPercent)
+			}
+		}
+	}
+	t.opportunities = found
+	t.m.Unlock()
+	t.notify()
+}
+
+// notify wakes any streams waiting for an update
+func (t *TriangularArbitrageDetector) notify() {
+	t.m.Lock()
+	close(t.updated)
+	t.updated = make(chan struct{})
+	t.m.Unlock()
+}
+
+// scanExchange returns the profitable cycles of an exchange's pairs ordered
+// from the most to least profitable
+func (t *TriangularArbitrageDetector) scanExchange(ctx context.Context, exch exchange.IBotExchange, pairs currency.Pairs) []TriangularOpportunity {
+	graph := currency.NewPairGraph(pairs)
+	quotes := make(map[string]*triangularQuote)
+	var opps []TriangularOpportunity
+	for _, cycle := range graph.Triangles() {
+		for _, path := range [][]currency.Code{
+			{cycle[0], cycle[1], cycle[2], cycle[0]},
+			{cycle[0], cycle[2], cycle[1], cycle[0]},
+		} {
+			path = t.rotateToStart(path)
+			if path == nil {
+				continue
+			}
+			opp, ok := t.evaluate(ctx, exch, graph, quotes, path)
+			if !ok {
+				continue
+			}
+			opps = append(opps, opp)
+		}
+	}
+	sort.Slice(opps, func(i, j int) bool {
+		return opps[i].ProfitPercent > opps[j].ProfitPercent
+	})
+	return opps
+}
+
+// rotateToStart rotates a closed path so it begins and ends in the first of
+// the configured start currencies it contains. Nil is returned when start
+// currencies are configured and the path contains none of them
+func (t *TriangularArbitrageDetector) rotateToStart(path []currency.Code) []currency.Code {
+	if len(t.startCurrencies) == 0 {
+		return path
+	}
+	for i := range t.startCurrencies {
+		for j := 0; j < len(path)-1; j++ {
+			if !strings.EqualFold(path[j].String(), t.startCurrencies[i]) {
+				continue
+			}
+			rotated := append(append([]currency.Code{}, path[j:len(path)-1]...), path[:j]...)
+			return append(rotated, rotated[0])
+		}
+	}
+	return nil
+}
+
+// evaluate prices a closed path of currency conversions and returns the
+// opportunity when it is profitable enough to report
+func (t *TriangularArbitrageDetector) evaluate(ctx context.Context, exch exchange.IBotExchange, graph *currency.PairGraph, quotes map[string]*triangularQuote, path []currency.Code) (TriangularOpportunity, bool) {
+	opp := TriangularOpportunity{
+		Exchange:       exch.GetName(),
+		StartCurrency:  path[0].String(),
+		Rate:           1,
+		MaxStartAmount: math.Inf(1),
+	}
+	for i := 0; i < len(path)-1; i++ {
+		cp, ok := graph.GetPair(path[i], path[i+1])
+		if !ok {
+			return opp, false
+		}
+		q := t.getQuote(ctx, exch, quotes, cp)
+		if q == nil {
+			return opp, false
+		}
+		leg := TriangularLeg{
+			Pair:    cp,
+			From:    path[i].String(),
+			To:      path[i+1].String(),
+			FeeRate: q.feeRate,
+		}
+		if cp.Base.Match(path[i]) {
+			leg.Side = order.Sell
+			leg.Price = q.bid
+			leg.Rate = q.bid * (1 - q.feeRate)
+			leg.Capacity = q.bidAmount
+		} else {
+			leg.Side = order.Buy
+			leg.Price = q.ask
+			leg.Rate = (1 - q.feeRate) / q.ask
+			leg.Capacity = q.askAmount * q.ask
+		}
+		opp.MaxStartAmount = math.Min(opp.MaxStartAmount, leg.Capacity/opp.Rate)
+		opp.Rate *= leg.Rate
+		opp.Legs = append(opp.Legs, leg)
+	}
+	opp.ProfitPercent = (opp.Rate - 1) * 100
+	if opp.Rate <= 1 || opp.ProfitPercent < t.minimumProfitPercent {
+		return opp, false
+	}
+	opp.DetectedAt = time.Now()
+	opp.UpdatedAt = opp.DetectedAt
+	return opp, true
+}
+
+// getQuote returns the top of a pair's orderbook and its taker fee, fetching
+// each pair at most once per scan. Nil is returned when the pair has no
+// usable orderbook
+func (t *TriangularArbitrageDetector) getQuote(ctx context.Context, exch exchange.IBotExchange, quotes map[string]*triangularQuote, cp currency.Pair) *triangularQuote {
+	key := cp.String()
+	if q, ok := quotes[key]; ok {
+		return q
+	}
+	quotes[key] = nil
+	ob, err := t.orderbookFetcher(exch.GetName(), cp, asset.Spot)
+	if err != nil {
+		return nil
+	}
+	if len(ob.Bids) == 0 || len(ob.Asks) == 0 ||
+		ob.Bids[0].Price <= 0 || ob.Bids[0].Amount <= 0 ||
+		ob.Asks[0].Price <= 0 || ob.Asks[0].Amount <= 0 {
+		return nil
+	}
+	q := &triangularQuote{
+		bid:       ob.Bids[0].Price,
+		bidAmount: ob.Bids[0].Amount,
+		ask:       ob.Asks[0].Price,
+		askAmount: ob.Asks[0].Amount,
+	}
+	q.feeRate, err = t.feeFetcher(ctx, exch, cp, (q.bid+q.ask)/2, 1)
+	if err != nil {
+		if t.verbose {
+			log.Warnf(log.ExchangeSys, "Triangular arbitrage detector unable to retrieve %s %s taker fee, fees will not be considered: %v", exch.GetName(), cp, err)
+		}
+		q.feeRate = 0
+	}
+	quotes[key] = q
+	return q
+}
+
+// triangularKey returns the key an opportunity is tracked under between scans
+func triangularKey(opp *TriangularOpportunity) string {
+	path := make([]string, 0, len(opp.Legs)+1)
+	for i := range opp.Legs {
+		path = append(path, opp.Legs[i].From)
+	}
+	return strings.Join(append(path, opp.StartCurrency), "-")
+}
+
+// GetOpportunities returns the opportunities found by the latest scan ordered
+// from the most to least profitable, optionally filtered by exchange
+func (t *TriangularArbitrageDetector) GetOpportunities(exchName string) ([]TriangularOpportunity, error) {
+	if t == nil {
+		return nil, fmt.Errorf("triangular arbitrage detector %w", ErrNilSubsystem)
+	}
+	if !t.IsRunning() {
+		return nil, fmt.Errorf("triangular arbitrage detector %w", ErrSubSystemNotStarted)
+	}
+	t.m.RLock()
+	defer t.m.RUnlock()
+	var opps []TriangularOpportunity
+	for exchKey := range t.opportunities {
+		if exchName != "" && !strings.EqualFold(exchKey, exchName) {
+			continue
+		}
+		opps = append(opps, t.opportunities[exchKey]...)
+	}
+	sort.SliceStable(opps, func(i, j int) bool {
+		return opps[i].ProfitPercent > opps[j].ProfitPercent
+	})
+	return opps, nil
+}
+
+// Updated returns a channel which is closed when the next scan completes or
+// the subsystem is stopped
+func (t *TriangularArbitrageDetector) Updated() (<-chan struct{}, error) {
+	if t == nil {
+		return nil, fmt.Errorf("triangular arbitrage detector %w", ErrNilSubsystem)
+	}
+	t.m.RLock()
+	defer t.m.RUnlock()
+	return t.updated, nil
+}
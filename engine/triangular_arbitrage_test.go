@@ -0,0 +1,197 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+var taConfig = &config.TriangularArbitrage{
+	CheckInterval: time.Minute,
+}
+
+// setupTestTriangularArbitrage returns a detector where buying BTC with USDT,
+// buying ETH with BTC and selling ETH for USDT returns more USDT than spent
+func setupTestTriangularArbitrage(t *testing.T) *TriangularArbitrageDetector {
+	t.Helper()
+	pairs := currency.Pairs{
+		currency.NewPair(currency.BTC, currency.USDT),
+		currency.NewPair(currency.ETH, currency.USDT),
+		currency.NewPair(currency.ETH, currency.BTC),
+		currency.NewPair(currency.XRP, currency.USDT),
+	}
+	em := SetupExchangeManager()
+	em.Add(&routerExchange{name: "tri", enabled: true, pairs: pairs})
+	em.Add(&routerExchange{name: "disabled", pairs: pairs})
+	d, err := SetupTriangularArbitrageDetector(em, taConfig)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	books := map[string]*orderbook.Base{
+		"BTCUSDT": {
+			Bids: orderbook.Items{{Price: 100, Amount: 1}},
+			Asks: orderbook.Items{{Price: 101, Amount: 1}},
+		},
+		"ETHUSDT": {
+			Bids: orderbook.Items{{Price: 11, Amount: 2}},
+			Asks: orderbook.Items{{Price: 11.1, Amount: 2}},
+		},
+		"ETHBTC": {
+			Bids: orderbook.Items{{Price: 0.079, Amount: 5}},
+			Asks: orderbook.Items{{Price: 0.08, Amount: 5}},
+		},
+	}
+	d.orderbookFetcher = func(_ string, cp currency.Pair, _ asset.Item) (*orderbook.Base, error) {
+		ob, ok := books[cp.String()]
+		if !ok {
+			return nil, errRouterTest
+		}
+		return ob, nil
+	}
+	d.feeFetcher = func(context.Context, exchange.IBotExchange, currency.Pair, float64, float64) (float64, error) {
+		return 0, nil
+	}
+	return d
+}
+
+func TestSetupTriangularArbitrageDetector(t *testing.T) {
+	t.Parallel()
+	_, err := SetupTriangularArbitrageDetector(nil, taConfig)
+	if !errors.Is(err, errNilExchangeManager) {
+		t.Errorf("received '%v' expected '%v'", err, errNilExchangeManager)
+	}
+	_, err = SetupTriangularArbitrageDetector(SetupExchangeManager(), nil)
+	if !errors.Is(err, errNilConfig) {
+		t.Errorf("received '%v' expected '%v'", err, errNilConfig)
+	}
+	_, err = SetupTriangularArbitrageDetector(SetupExchangeManager(), &config.TriangularArbitrage{})
+	if !errors.Is(err, errInvalidTriangularInterval) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidTriangularInterval)
+	}
+	d, err := SetupTriangularArbitrageDetector(SetupExchangeManager(), taConfig)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if d.orderbookFetcher == nil || d.feeFetcher == nil {
+		t.Error("expected fetchers to be set")
+	}
+}
+
+func TestTriangularArbitrageStartStop(t *testing.T) {
+	t.Parallel()
+	var d *TriangularArbitrageDetector
+	if err := d.Start(); !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+	if err := d.Stop(); !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+	if _, err := d.Updated(); !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+	d = setupTestTriangularArbitrage(t)
+	if err := d.Start(); !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if err := d.Start(); !errors.Is(err, ErrSubSystemAlreadyStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemAlreadyStarted)
+	}
+	if !d.IsRunning() {
+		t.Error("expected running")
+	}
+	updated, err := d.Updated()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if err = d.Stop(); !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	select {
+	case <-updated:
+	default:
+		t.Error("expected stopping to notify waiting streams")
+	}
+	if err = d.Stop(); !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemNotStarted)
+	}
+}
+
+func TestTriangularArbitrageScan(t *testing.T) {
+	t.Parallel()
+	d := setupTestTriangularArbitrage(t)
+	_, err := d.GetOpportunities("")
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemNotStarted)
+	}
+	d.started = 1
+
+	updated, err := d.Updated()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	d.scan(context.Background())
+	select {
+	case <-updated:
+	default:
+		t.Error("expected scan to notify waiting streams")
+	}
+	opps, err := d.GetOpportunities("TRI")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(opps) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(opps), 1)
+	}
+	opp := opps[0]
+	if opp.StartCurrency != "BTC" || triangularKey(&opp) != "BTC-ETH-USDT-BTC" {
+		t.Errorf("unexpected cycle %v", triangularKey(&opp))
+	}
+	if len(opp.Legs) != 3 || opp.Legs[0].Side != order.Buy || opp.Legs[1].Side != order.Sell || opp.Legs[2].Side != order.Buy {
+		t.Errorf("unexpected legs %+v", opp.Legs)
+	}
+	if expected := 11 / 0.08 / 101; opp.Rate != expected {
+		t.Errorf("received '%v' expected '%v'", opp.Rate, expected)
+	}
+
+	d.startCurrencies = []string{"usdt"}
+	d.scan(context.Background())
+	opps, err = d.GetOpportunities("")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(opps) != 1 || triangularKey(&opps[0]) != "USDT-BTC-ETH-USDT" {
+		t.Fatalf("unexpected opportunities %+v", opps)
+	}
+	// ETH-USDT's bid of 2 ETH limits the cycle to 2 ETH worth of USDT
+	if expected := 2 / (1.0 / 101 / 0.08); opps[0].MaxStartAmount != expected {
+		t.Errorf("received '%v' expected '%v'", opps[0].MaxStartAmount, expected)
+	}
+	detected := opps[0].DetectedAt
+
+	d.scan(context.Background())
+	opps, err = d.GetOpportunities("")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(opps) != 1 || !opps[0].DetectedAt.Equal(detected) {
+		t.Errorf("expected detection time to persist between scans %+v", opps)
+	}
+
+	d.minimumProfitPercent = 50
+	d.scan(context.Background())
+	opps, err = d.GetOpportunities("")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(opps) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(opps), 0)
+	}
+}
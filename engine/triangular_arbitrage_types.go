@@ -0,0 +1,72 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+const triangularArbitrageName = "triangular_arbitrage"
+
+var errInvalidTriangularInterval = errors.New("triangular arbitrage check interval must be greater than zero")
+
+// TriangularArbitrageDetector searches the spot pairs of each exchange for
+// cycles of three trades which return more of the starting currency than they
+// spend after taker fees. Each scan builds a currency graph from an exchange's
+// enabled pairs and prices every cycle from the top of its orderbooks
+type TriangularArbitrageDetector struct {
+	started              int32
+	shutdown             chan struct{}
+	checkInterval        time.Duration
+	exchanges            []string
+	startCurrencies      []string
+	minimumProfitPercent float64
+	verbose              bool
+	exchangeManager      iExchangeManager
+	orderbookFetcher     func(string, currency.Pair, asset.Item) (*orderbook.Base, error)
+	feeFetcher           func(context.Context, exchange.IBotExchange, currency.Pair, float64, float64) (float64, error)
+	opportunities        map[string][]TriangularOpportunity
+	// updated is closed and replaced after every scan so streams can wait for
+	// new results
+	updated chan struct{}
+	m       sync.RWMutex
+}
+
+// TriangularOpportunity holds a profitable cycle of trades on a single
+// exchange. Rate is the amount of the start currency returned per unit spent
+// and MaxStartAmount is the most which can be cycled before the liquidity at
+// the top of any leg's orderbook is exhausted
+type TriangularOpportunity struct {
+	Exchange       string          `json:"exchange"`
+	StartCurrency  string          `json:"startCurrency"`
+	Legs           []TriangularLeg `json:"legs"`
+	Rate           float64         `json:"rate"`
+	ProfitPercent  float64         `json:"profitPercent"`
+	MaxStartAmount float64         `json:"maxStartAmount"`
+	DetectedAt     time.Time       `json:"detectedAt"`
+	UpdatedAt      time.Time       `json:"updatedAt"`
+}
+
+// TriangularLeg holds a single trade of a triangular cycle, converting the
+// from currency to the to currency by buying or selling the pair
+type TriangularLeg struct {
+	Pair    currency.Pair `json:"pair"`
+	Side    order.Side    `json:"side"`
+	From    string        `json:"from"`
+	To      string        `json:"to"`
+	Price   float64       `json:"price"`
+	FeeRate float64       `json:"feeRate"`
+	// Rate is the amount of the to currency received per unit of the from
+	// currency after fees
+	Rate float64 `json:"rate"`
+	// Capacity is the amount of the from currency the top of the orderbook
+	// can convert
+	Capacity float64 `json:"capacity"`
+}
@@ -0,0 +1,457 @@
+package engine
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/crypto"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupWebhookManager creates a webhook manager which posts events to the
+// configured endpoints. The order manager is optional and order fill events
+// are not sent without it
+func SetupWebhookManager(em iExchangeManager, om iOrderSnapshotter, cfg *config.WebhookManager) (*WebhookManager, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	if len(cfg.Endpoints) == 0 {
+		return nil, errNoWebhookEndpoints
+	}
+	w := &WebhookManager{
+		shutdown:        make(chan struct{}),
+		delay:           cfg.Delay,
+		maxRetries:      cfg.MaxRetries,
+		verbose:         cfg.Verbose,
+		exchangeManager: em,
+		orderManager:    om,
+		client:          common.NewHTTPClientWithTimeout(cfg.Timeout),
+		holdingsFetcher: account.GetHoldings,
+		fundingFetcher:  fetchFundingHistory,
+		fills:           make(map[string]*webhookFill),
+		balances:        make(map[string]float64),
+		seenHoldings:    make(map[string]bool),
+		transfers:       make(map[string]string),
+		seenTransfers:   make(map[string]bool),
+	}
+	if w.delay <= 0 {
+		w.delay = defaultWebhookManagerDelay
+	}
+	if w.maxRetries < 0 {
+		w.maxRetries = 0
+	}
+	for i := range cfg.Endpoints {
+		ep, err := newWebhookEndpoint(&cfg.Endpoints[i])
+		if err != nil {
+			return nil, err
+		}
+		w.endpoints = append(w.endpoints, ep)
+	}
+	return w, nil
+}
+
+// newWebhookEndpoint validates a configured endpoint
+func newWebhookEndpoint(cfg *config.WebhookEndpoint) (*webhookEndpoint, error) {
+	u, err := url.Parse(cfg.URL)
+	if err != nil {
+		return nil, fmt.Errorf("%w %s: %v", errInvalidWebhookEndpoint, cfg.Name, err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("%w %s: url must be an absolute http or https url", errInvalidWebhookEndpoint, cfg.Name)
+	}
+	ep := &webhookEndpoint{
+		name:   cfg.Name,
+		url:    cfg.URL,
+		secret: cfg.Secret,
+		events: make(map[string]bool),
+	}
+	if ep.name == "" {
+		ep.name = u.Host
+	}
+	if len(cfg.Events) == 0 {
+		for i := range webhookEvents {
+			ep.events[webhookEvents[i]] = true
+		}
+		return ep, nil
+	}
+	for i := range cfg.Events {
+		event := strings.ToLower(cfg.Events[i])
+		if !common.StringDataCompare(webhookEvents, event) {
+			return nil, fmt.Errorf("%w %s for endpoint %s, supported events: %s",
+				errUnknownWebhookEvent, cfg.Events[i], ep.name, strings.Join(webhookEvents, ", "))
+		}
+		ep.events[event] = true
+	}
+	return ep, nil
+}
+
+// Start runs the subsystem
+func (w *WebhookManager) Start() error {
+	if w == nil {
+		return ErrNilSubsystem
+	}
+	if !atomic.CompareAndSwapInt32(&w.started, 0, 1) {
+		return ErrSubSystemAlreadyStarted
+	}
+	w.shutdown = make(chan struct{})
+	go w.run()
+	log.Debugf(log.CommunicationMgr, "Webhook manager %v", MsgSubSystemStarted)
+	return nil
+}
+
+// IsRunning checks whether the subsystem is running
+func (w *WebhookManager) IsRunning() bool {
+	if w == nil {
+		return false
+	}
+	return atomic.LoadInt32(&w.started) == 1
+}
+
+// Stop stops the subsystem
+func (w *WebhookManager) Stop() error {
+	if w == nil {
+		return ErrNilSubsystem
+	}
+	if !atomic.CompareAndSwapInt32(&w.started, 1, 0) {
+		return ErrSubSystemNotStarted
+	}
+	close(w.shutdown)
+	log.Debugf(log.CommunicationMgr, "Webhook manager %v", MsgSubSystemShutdown)
+	return nil
+}
+
+func (w *WebhookManager) run() {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-w.shutdown:
+			return
+		case <-timer.C:
+			ctx, cancel := context.WithCancel(context.Background())
+			go func() {
+				select {
+				case <-w.shutdown:
+					cancel()
+				case <-ctx.Done():
+				}
+			}()
+			w.dispatch(ctx, w.collect(ctx))
+			cancel()
+			timer.Reset(w.delay)
+		}
+	}
+}
+
+// Publish queues an event produced by another subsystem to be sent to
+// subscribed endpoints on the next cycle
+func (w *WebhookManager) Publish(eventType, exchangeName string, data interface{}) error {
+	if w == nil {
+		return ErrNilSubsystem
+	}
+	if atomic.LoadInt32(&w.started) == 0 {
+		return ErrSubSystemNotStarted
+	}
+	if !common.StringDataCompare(webhookEvents, eventType) {
+		return fmt.Errorf("%w %s", errUnknownWebhookEvent, eventType)
+	}
+	w.m.Lock()
+	w.published = append(w.published, newWebhookEvent(eventType, exchangeName, data))
+	w.m.Unlock()
+	return nil
+}
+
+// collect returns the events which have occurred since the last cycle
+func (w *WebhookManager) collect(ctx context.Context) []WebhookEvent {
+	w.m.Lock()
+	defer w.m.Unlock()
+	events := w.published
+	w.published = nil
+	events = append(events, w.collectFills()...)
+	exchanges, err := w.exchangeManager.GetExchanges()
+	if err != nil {
+		log.Errorf(log.CommunicationMgr, "Webhook manager cannot get exchanges: %v", err)
+		return events
+	}
+	for i := range exchanges {
+		if !exchanges[i].IsEnabled() {
+			continue
+		}
+		events = append(events, w.collectBalanceChanges(exchanges[i])...)
+		events = append(events, w.collectTransfers(ctx, exchanges[i])...)
+	}
+	return events
+}
+
+// collectFills returns an order fill event for each order whose executed
+// amount has increased since it was last seen
+func (w *WebhookManager) collectFills() []WebhookEvent {
+	if w.orderManager == nil {
+		return nil
+	}
+	orders, _ := w.orderManager.GetOrdersSnapshot("")
+	var events []WebhookEvent
+	for i := range orders {
+		key := strings.ToLower(orders[i].Exchange) + orders[i].ID
+		seen, ok := w.fills[key]
+		if !ok {
+			seen = &webhookFill{}
+			w.fills[key] = seen
+		}
+		if orders[i].ExecutedAmount <= seen.executed {
+			continue
+		}
+		price := orders[i].AverageExecutedPrice
+		if price == 0 {
+			price = orders[i].Price
+		}
+		cost := orders[i].ExecutedAmount * price
+		fillAmount := orders[i].ExecutedAmount - seen.executed
+		fillPrice := (cost - seen.cost) / fillAmount
+		seen.executed, seen.cost = orders[i].ExecutedAmount, cost
+		events = append(events, newWebhookEvent(WebhookEventOrderFill, orders[i].Exchange, WebhookOrderFill{
+			OrderID:              orders[i].ID,
+			ClientOrderID:        orders[i].ClientOrderID,
+			Asset:                orders[i].AssetType.String(),
+			Pair:                 orders[i].Pair.String(),
+			Side:                 orders[i].Side.String(),
+			OrderType:            orders[i].Type.String(),
+			Status:               orders[i].Status.String(),
+			FillAmount:           fillAmount,
+			FillPrice:            fillPrice,
+			Amount:               orders[i].Amount,
+			ExecutedAmount:       orders[i].ExecutedAmount,
+			RemainingAmount:      orders[i].RemainingAmount,
+			AverageExecutedPrice: orders[i].AverageExecutedPrice,
+			Fee:                  orders[i].Fee,
+		}))
+	}
+	return events
+}
+
+// collectBalanceChanges returns a balance change event for each currency whose
+// total has changed in the exchange's stored account holdings. The first
+// holdings seen for an exchange and asset are recorded without sending events
+func (w *WebhookManager) collectBalanceChanges(exch exchange.IBotExchange) []WebhookEvent {
+	exchName := exch.GetName()
+	var events []WebhookEvent
+	assets := exch.GetAssetTypes(true)
+	for x := range assets {
+		holdings, err := w.holdingsFetcher(exchName, assets[x])
+		if err != nil {
+			continue
+		}
+		holdingsKey := strings.ToLower(exchName) + assets[x].String()
+		seeded := w.seenHoldings[holdingsKey]
+		w.seenHoldings[holdingsKey] = true
+		for y := range holdings.Accounts {
+			for z := range holdings.Accounts[y].Currencies {
+				balance := &holdings.Accounts[y].Currencies[z]
+				key := holdingsKey + holdings.Accounts[y].ID + balance.CurrencyName.Upper().String()
+				previous, ok := w.balances[key]
+				w.balances[key] = balance.TotalValue
+				if !seeded || (ok && previous == balance.TotalValue) || (!ok && balance.TotalValue == 0) {
+					continue
+				}
+				events = append(events, newWebhookEvent(WebhookEventBalanceChange, exchName, WebhookBalanceChange{
+					Account:  holdings.Accounts[y].ID,
+					Asset:    assets[x].String(),
+					Currency: balance.CurrencyName.Upper().String(),
+					Previous: previous,
+					Current:  balance.TotalValue,
+					Change:   balance.TotalValue - previous,
+					Hold:     balance.Hold,
+				}))
+			}
+		}
+	}
+	return events
+}
+
+// collectTransfers returns a deposit event for each newly detected deposit
+// and a withdrawal event for each withdrawal which has completed in the
+// exchange's funding history. The first funding history retrieved for an
+// exchange is recorded without sending events
+func (w *WebhookManager) collectTransfers(ctx context.Context, exch exchange.IBotExchange) []WebhookEvent {
+	if !exch.GetAuthenticatedAPISupport(exchange.RestAuthentication) {
+		return nil
+	}
+	exchName := exch.GetName()
+	history, err := w.fundingFetcher(ctx, exch)
+	if err != nil {
+		if w.verbose &&
+			!errors.Is(err, common.ErrFunctionNotSupported) &&
+			!errors.Is(err, common.ErrNotYetImplemented) {
+			log.Warnf(log.CommunicationMgr, "Webhook manager unable to get %s funding history: %v", exchName, err)
+		}
+		return nil
+	}
+	exchKey := strings.ToLower(exchName)
+	seeded := w.seenTransfers[exchKey]
+	w.seenTransfers[exchKey] = true
+	var events []WebhookEvent
+	for i := range history {
+		transferType := strings.ToLower(history[i].TransferType)
+		var eventType string
+		switch {
+		case strings.Contains(transferType, "deposit"):
+			eventType = WebhookEventDeposit
+		case strings.Contains(transferType, "withdraw"):
+			eventType = WebhookEventWithdrawalComplete
+		default:
+			continue
+		}
+		id := history[i].TransferID
+		if id == "" {
+			id = history[i].CryptoTxID
+		}
+		if id == "" {
+			id = history[i].Timestamp.String() + history[i].Currency
+		}
+		key := exchKey + transferType + id
+		previous, ok := w.transfers[key]
+		status := strings.ToLower(history[i].Status)
+		w.transfers[key] = status
+		if !seeded {
+			continue
+		}
+		if eventType == WebhookEventDeposit && ok {
+			continue
+		}
+		if eventType == WebhookEventWithdrawalComplete &&
+			(!common.StringDataCompare(webhookCompletedStatuses, status) ||
+				(ok && common.StringDataCompare(webhookCompletedStatuses, previous))) {
+			continue
+		}
+		address := history[i].CryptoToAddress
+		if eventType == WebhookEventDeposit {
+			address = history[i].CryptoFromAddress
+		}
+		events = append(events, newWebhookEvent(eventType, exchName, WebhookTransfer{
+			TransferID:    history[i].TransferID,
+			TransferType:  history[i].TransferType,
+			Status:        history[i].Status,
+			Currency:      history[i].Currency,
+			Amount:        history[i].Amount,
+			Fee:           history[i].Fee,
+			Description:   history[i].Description,
+			CryptoAddress: address,
+			CryptoTxID:    history[i].CryptoTxID,
+			CryptoChain:   history[i].CryptoChain,
+			Timestamp:     history[i].Timestamp,
+		}))
+	}
+	return events
+}
+
+// newWebhookEvent wraps event data with its type and a unique ID
+func newWebhookEvent(eventType, exchangeName string, data interface{}) WebhookEvent {
+	id, err := uuid.NewV4()
+	if err != nil {
+		log.Warnf(log.CommunicationMgr, "Webhook manager unable to generate event ID: %v", err)
+	}
+	return WebhookEvent{
+		ID:        id.String(),
+		Type:      eventType,
+		Exchange:  exchangeName,
+		Timestamp: time.Now(),
+		Data:      data,
+	}
+}
+
+// dispatch sends each event to every endpoint subscribed to its type
+func (w *WebhookManager) dispatch(ctx context.Context, events []WebhookEvent) {
+	for i := range events {
+		payload, err := json.Marshal(events[i])
+		if err != nil {
+			log.Errorf(log.CommunicationMgr, "Webhook manager unable to marshal %s event: %v", events[i].Type, err)
+			continue
+		}
+		for j := range w.endpoints {
+			if !w.endpoints[j].events[events[i].Type] {
+				continue
+			}
+			err = w.post(ctx, w.endpoints[j], events[i].Type, payload)
+			if err != nil {
+				log.Errorf(log.CommunicationMgr, "Webhook manager: %v", err)
+				continue
+			}
+			if w.verbose {
+				log.Debugf(log.CommunicationMgr, "Webhook manager sent %s %s event %s to %s",
+					events[i].Exchange, events[i].Type, events[i].ID, w.endpoints[j].name)
+			}
+		}
+	}
+}
+
+// post sends a payload to an endpoint, retrying failed attempts with an
+// increasing delay
+func (w *WebhookManager) post(ctx context.Context, ep *webhookEndpoint, eventType string, payload []byte) error {
+	var err error
+	for attempt := 0; attempt <= w.maxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("%w to %s: %v", errWebhookDeliveryFailed, ep.name, ctx.Err())
+			case <-time.After(webhookRetryDelay * time.Duration(attempt)):
+			}
+		}
+		err = w.send(ctx, ep, eventType, payload)
+		if err == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w to %s after %d attempt(s): %v", errWebhookDeliveryFailed, ep.name, w.maxRetries+1, err)
+}
+
+// send posts a payload to an endpoint, signing it with a HMAC-SHA256 of the
+// endpoint's secret when one is set
+func (w *WebhookManager) send(ctx context.Context, ep *webhookEndpoint, eventType string, payload []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, ep.url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookEventHeader, eventType)
+	if ep.secret != "" {
+		var sig []byte
+		sig, err = crypto.GetHMAC(crypto.HashSHA256, payload, []byte(ep.secret))
+		if err != nil {
+			return err
+		}
+		req.Header.Set(WebhookSignatureHeader, "sha256="+crypto.HexEncodeToString(sig))
+	}
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected response status %s", resp.Status)
+	}
+	return nil
+}
+
+// fetchFundingHistory returns an exchange's deposit and withdrawal history
+func fetchFundingHistory(ctx context.Context, exch exchange.IBotExchange) ([]exchange.FundHistory, error) {
+	return exch.GetFundingHistory(ctx)
+}
@@ -0,0 +1,334 @@
+package engine
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/common/crypto"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// whExchange aka webhook fake exchange is an enabled and authenticated
+// exchange which only supports spot
+type whExchange struct {
+	exchange.IBotExchange
+}
+
+func (w *whExchange) GetName() string {
+	return "webhookexchange"
+}
+
+func (w *whExchange) IsEnabled() bool {
+	return true
+}
+
+func (w *whExchange) GetAssetTypes(bool) asset.Items {
+	return asset.Items{asset.Spot}
+}
+
+func (w *whExchange) GetAuthenticatedAPISupport(uint8) bool {
+	return true
+}
+
+// whReceiver records the webhook requests it receives
+type whReceiver struct {
+	m        sync.Mutex
+	requests []*http.Request
+	bodies   [][]byte
+	status   int
+}
+
+func (r *whReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := ioutil.ReadAll(req.Body)
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.requests = append(r.requests, req)
+	r.bodies = append(r.bodies, body)
+	if r.status != 0 {
+		w.WriteHeader(r.status)
+	}
+}
+
+func TestSetupWebhookManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupWebhookManager(nil, nil, nil)
+	if !errors.Is(err, errNilExchangeManager) {
+		t.Errorf("received '%v' expected '%v'", err, errNilExchangeManager)
+	}
+	em := SetupExchangeManager()
+	_, err = SetupWebhookManager(em, nil, nil)
+	if !errors.Is(err, errNilConfig) {
+		t.Errorf("received '%v' expected '%v'", err, errNilConfig)
+	}
+	_, err = SetupWebhookManager(em, nil, &config.WebhookManager{})
+	if !errors.Is(err, errNoWebhookEndpoints) {
+		t.Errorf("received '%v' expected '%v'", err, errNoWebhookEndpoints)
+	}
+	for _, u := range []string{"ftp://localhost", "/relative", "://bad"} {
+		_, err = SetupWebhookManager(em, nil, &config.WebhookManager{
+			Endpoints: []config.WebhookEndpoint{{URL: u}},
+		})
+		if !errors.Is(err, errInvalidWebhookEndpoint) {
+			t.Errorf("received '%v' expected '%v'", err, errInvalidWebhookEndpoint)
+		}
+	}
+	_, err = SetupWebhookManager(em, nil, &config.WebhookManager{
+		Endpoints: []config.WebhookEndpoint{{URL: "https://localhost", Events: []string{"bad"}}},
+	})
+	if !errors.Is(err, errUnknownWebhookEvent) {
+		t.Errorf("received '%v' expected '%v'", err, errUnknownWebhookEvent)
+	}
+
+	w, err := SetupWebhookManager(em, nil, &config.WebhookManager{
+		Endpoints: []config.WebhookEndpoint{
+			{URL: "https://localhost"},
+			{Name: "deposits", URL: "http://localhost", Events: []string{"DEPOSIT"}},
+		},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if w.delay != defaultWebhookManagerDelay {
+		t.Errorf("received '%v' expected '%v'", w.delay, defaultWebhookManagerDelay)
+	}
+	if w.endpoints[0].name != "localhost" || len(w.endpoints[0].events) != len(webhookEvents) {
+		t.Errorf("unexpected endpoint %+v", w.endpoints[0])
+	}
+	if len(w.endpoints[1].events) != 1 || !w.endpoints[1].events[WebhookEventDeposit] {
+		t.Errorf("unexpected endpoint %+v", w.endpoints[1])
+	}
+}
+
+func TestWebhookManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var w *WebhookManager
+	if err := w.Start(); !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+	if err := w.Stop(); !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+	if w.IsRunning() {
+		t.Error("expected not running")
+	}
+	w, err := SetupWebhookManager(SetupExchangeManager(), nil, &config.WebhookManager{
+		Endpoints: []config.WebhookEndpoint{{URL: "http://localhost"}},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if err = w.Start(); !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if err = w.Start(); !errors.Is(err, ErrSubSystemAlreadyStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemAlreadyStarted)
+	}
+	if !w.IsRunning() {
+		t.Error("expected running")
+	}
+	if err = w.Stop(); !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if err = w.Stop(); !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemNotStarted)
+	}
+}
+
+func TestWebhookManagerPublish(t *testing.T) {
+	t.Parallel()
+	var w *WebhookManager
+	err := w.Publish(WebhookEventDepositConfirmed, "webhookexchange", nil)
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+	w, err = SetupWebhookManager(SetupExchangeManager(), nil, &config.WebhookManager{
+		Endpoints: []config.WebhookEndpoint{{URL: "http://localhost"}},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = w.Publish(WebhookEventDepositConfirmed, "webhookexchange", nil)
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemNotStarted)
+	}
+	w.started = 1
+	err = w.Publish("meow", "webhookexchange", nil)
+	if !errors.Is(err, errUnknownWebhookEvent) {
+		t.Errorf("received '%v' expected '%v'", err, errUnknownWebhookEvent)
+	}
+	err = w.Publish(WebhookEventDepositConfirmed, "webhookexchange", "data")
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	events := w.collect(context.Background())
+	if len(events) != 1 || events[0].Type != WebhookEventDepositConfirmed || events[0].Data != "data" {
+		t.Fatalf("unexpected events %+v", events)
+	}
+	if events = w.collect(context.Background()); len(events) != 0 {
+		t.Errorf("unexpected events %+v", events)
+	}
+}
+
+func TestWebhookManagerCollect(t *testing.T) {
+	t.Parallel()
+	em := SetupExchangeManager()
+	em.Add(&whExchange{})
+	orders := &pamOrders{
+		orders: []order.Detail{{
+			Exchange:             "webhookexchange",
+			ID:                   "1",
+			Side:                 order.Buy,
+			Type:                 order.Limit,
+			Status:               order.PartiallyFilled,
+			AssetType:            asset.Spot,
+			Pair:                 currency.NewPair(currency.BTC, currency.USDT),
+			Amount:               2,
+			ExecutedAmount:       1,
+			AverageExecutedPrice: 100,
+		}},
+	}
+	w, err := SetupWebhookManager(em, orders, &config.WebhookManager{
+		Endpoints: []config.WebhookEndpoint{{URL: "http://localhost"}},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	holdings := account.Holdings{
+		Exchange: "webhookexchange",
+		Accounts: []account.SubAccount{{
+			AssetType:  asset.Spot,
+			Currencies: []account.Balance{{CurrencyName: currency.USDT, TotalValue: 1000}},
+		}},
+	}
+	w.holdingsFetcher = func(string, asset.Item) (account.Holdings, error) {
+		return holdings, nil
+	}
+	history := []exchange.FundHistory{
+		{TransferID: "d1", TransferType: "Deposit", Status: "Completed", Currency: "BTC", Amount: 1},
+		{TransferID: "w1", TransferType: "withdrawal", Status: "Pending", Currency: "BTC", Amount: 1},
+	}
+	w.fundingFetcher = func(context.Context, exchange.IBotExchange) ([]exchange.FundHistory, error) {
+		return history, nil
+	}
+
+	// existing holdings and funding history are recorded without events
+	events := w.collect(context.Background())
+	if len(events) != 1 || events[0].Type != WebhookEventOrderFill {
+		t.Fatalf("unexpected events %+v", events)
+	}
+	fill, ok := events[0].Data.(WebhookOrderFill)
+	if !ok || fill.FillAmount != 1 || fill.FillPrice != 100 {
+		t.Errorf("unexpected fill %+v", events[0].Data)
+	}
+	if events = w.collect(context.Background()); len(events) != 0 {
+		t.Fatalf("unexpected events %+v", events)
+	}
+
+	orders.orders[0].ExecutedAmount = 2
+	orders.orders[0].AverageExecutedPrice = 110
+	orders.orders[0].Status = order.Filled
+	holdings.Accounts[0].Currencies = []account.Balance{
+		{CurrencyName: currency.USDT, TotalValue: 880},
+		{CurrencyName: currency.BTC, TotalValue: 2},
+	}
+	history = append(history, exchange.FundHistory{TransferID: "d2", TransferType: "deposit", Status: "Pending", Currency: "BTC", Amount: 2})
+	history[1].Status = "Completed"
+	events = w.collect(context.Background())
+	counts := make(map[string]int)
+	for i := range events {
+		counts[events[i].Type]++
+		switch data := events[i].Data.(type) {
+		case WebhookOrderFill:
+			if data.FillAmount != 1 || data.FillPrice != 120 {
+				t.Errorf("unexpected fill %+v", data)
+			}
+		case WebhookBalanceChange:
+			if (data.Currency == "USDT" && data.Change != -120) || (data.Currency == "BTC" && data.Change != 2) {
+				t.Errorf("unexpected balance change %+v", data)
+			}
+		case WebhookTransfer:
+			if data.TransferID != "d2" && data.TransferID != "w1" {
+				t.Errorf("unexpected transfer %+v", data)
+			}
+		}
+	}
+	if counts[WebhookEventOrderFill] != 1 ||
+		counts[WebhookEventBalanceChange] != 2 ||
+		counts[WebhookEventDeposit] != 1 ||
+		counts[WebhookEventWithdrawalComplete] != 1 {
+		t.Errorf("unexpected event counts %v", counts)
+	}
+	if events = w.collect(context.Background()); len(events) != 0 {
+		t.Errorf("unexpected events %+v", events)
+	}
+}
+
+func TestWebhookManagerDispatch(t *testing.T) {
+	t.Parallel()
+	all := &whReceiver{}
+	allServer := httptest.NewServer(all)
+	defer allServer.Close()
+	deposits := &whReceiver{}
+	depositServer := httptest.NewServer(deposits)
+	defer depositServer.Close()
+	failing := &whReceiver{status: http.StatusInternalServerError}
+	failingServer := httptest.NewServer(failing)
+	defer failingServer.Close()
+
+	w, err := SetupWebhookManager(SetupExchangeManager(), nil, &config.WebhookManager{
+		Timeout: defaultWebhookManagerDelay,
+		Endpoints: []config.WebhookEndpoint{
+			{Name: "all", URL: allServer.URL, Secret: "shh"},
+			{Name: "deposits", URL: depositServer.URL, Events: []string{WebhookEventDeposit}},
+			{Name: "failing", URL: failingServer.URL, Events: []string{WebhookEventOrderFill}},
+		},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	w.dispatch(context.Background(), []WebhookEvent{
+		newWebhookEvent(WebhookEventOrderFill, "webhookexchange", WebhookOrderFill{OrderID: "1"}),
+		newWebhookEvent(WebhookEventDeposit, "webhookexchange", WebhookTransfer{TransferID: "d1"}),
+	})
+
+	if len(all.bodies) != 2 || len(deposits.bodies) != 1 || len(failing.bodies) != 1 {
+		t.Fatalf("received '%v' '%v' '%v' expected '%v' '%v' '%v'",
+			len(all.bodies), len(deposits.bodies), len(failing.bodies), 2, 1, 1)
+	}
+	var evt WebhookEvent
+	if err = json.Unmarshal(all.bodies[0], &evt); err != nil {
+		t.Fatal(err)
+	}
+	if evt.Type != WebhookEventOrderFill || evt.Exchange != "webhookexchange" || evt.ID == "" {
+		t.Errorf("unexpected event %+v", evt)
+	}
+	if all.requests[0].Header.Get(WebhookEventHeader) != WebhookEventOrderFill {
+		t.Errorf("received '%v' expected '%v'", all.requests[0].Header.Get(WebhookEventHeader), WebhookEventOrderFill)
+	}
+	sig, err := crypto.GetHMAC(crypto.HashSHA256, all.bodies[0], []byte("shh"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "sha256=" + crypto.HexEncodeToString(sig); all.requests[0].Header.Get(WebhookSignatureHeader) != expected {
+		t.Errorf("received '%v' expected '%v'", all.requests[0].Header.Get(WebhookSignatureHeader), expected)
+	}
+	if deposits.requests[0].Header.Get(WebhookSignatureHeader) != "" {
+		t.Error("expected unsigned payload without a secret")
+	}
+
+	err = w.post(context.Background(), w.endpoints[2], WebhookEventOrderFill, []byte("{}"))
+	if !errors.Is(err, errWebhookDeliveryFailed) {
+		t.Errorf("received '%v' expected '%v'", err, errWebhookDeliveryFailed)
+	}
+}
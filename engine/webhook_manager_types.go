@@ -0,0 +1,161 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+const webhookManagerName = "webhook_manager"
+
+// Webhook event types which endpoints can subscribe to
+const (
+	WebhookEventOrderFill            = "order_fill"
+	WebhookEventBalanceChange        = "balance_change"
+	WebhookEventDeposit              = "deposit"
+	WebhookEventWithdrawalComplete   = "withdrawal_complete"
+	WebhookEventDepositConfirmed     = "deposit_confirmed"
+	WebhookEventArbitrageOpportunity = "arbitrage_opportunity"
+	WebhookEventPositionUpdate       = "position_update"
+	WebhookEventMarginRatioAlert     = "margin_ratio_alert"
+)
+
+var (
+	errNoWebhookEndpoints     = errors.New("no webhook endpoints configured")
+	errInvalidWebhookEndpoint = errors.New("invalid webhook endpoint")
+	errUnknownWebhookEvent    = errors.New("unknown webhook event")
+	errWebhookDeliveryFailed  = errors.New("webhook delivery failed")
+
+	webhookEvents = []string{
+		WebhookEventOrderFill,
+		WebhookEventBalanceChange,
+		WebhookEventDeposit,
+		WebhookEventWithdrawalComplete,
+		WebhookEventDepositConfirmed,
+		WebhookEventArbitrageOpportunity,
+		WebhookEventPositionUpdate,
+		WebhookEventMarginRatioAlert,
+	}
+	// webhookCompletedStatuses are the lower case transfer statuses which
+	// exchanges use to indicate a withdrawal has completed
+	webhookCompletedStatuses = []string{
+		"complete",
+		"completed",
+		"success",
+		"successful",
+		"succeeded",
+		"done",
+		"finished",
+		"confirmed",
+		"processed",
+	}
+	defaultWebhookManagerDelay = time.Second * 30
+	webhookRetryDelay          = time.Second
+)
+
+// Webhook request headers
+const (
+	WebhookEventHeader     = "X-GCT-Event"
+	WebhookSignatureHeader = "X-GCT-Signature"
+)
+
+// WebhookManager posts structured JSON events to configured endpoints when
+// orders are filled, balances change, deposits are detected and withdrawals
+// complete, allowing external systems to react to account activity without
+// polling. Activity is derived each cycle by comparing the order manager's
+// orders, the stored account holdings and the funding history of
+// authenticated exchanges against what was seen previously
+type WebhookManager struct {
+	started         int32
+	shutdown        chan struct{}
+	delay           time.Duration
+	maxRetries      int
+	verbose         bool
+	exchangeManager iExchangeManager
+	orderManager    iOrderSnapshotter
+	endpoints       []*webhookEndpoint
+	client          *http.Client
+	holdingsFetcher func(string, asset.Item) (account.Holdings, error)
+	fundingFetcher  func(context.Context, exchange.IBotExchange) ([]exchange.FundHistory, error)
+	fills           map[string]*webhookFill
+	balances        map[string]float64
+	seenHoldings    map[string]bool
+	transfers       map[string]string
+	seenTransfers   map[string]bool
+	published       []WebhookEvent
+	m               sync.Mutex
+}
+
+// webhookEndpoint holds a validated endpoint and the events it receives
+type webhookEndpoint struct {
+	name   string
+	url    string
+	secret string
+	events map[string]bool
+}
+
+// webhookFill holds the executed amount and cost of an order which have
+// already been sent
+type webhookFill struct {
+	executed float64
+	cost     float64
+}
+
+// WebhookEvent is the JSON payload posted to webhook endpoints
+type WebhookEvent struct {
+	ID        string      `json:"id"`
+	Type      string      `json:"type"`
+	Exchange  string      `json:"exchange"`
+	Timestamp time.Time   `json:"timestamp"`
+	Data      interface{} `json:"data"`
+}
+
+// WebhookOrderFill holds the details of an order fill
+type WebhookOrderFill struct {
+	OrderID              string  `json:"orderID"`
+	ClientOrderID        string  `json:"clientOrderID,omitempty"`
+	Asset                string  `json:"asset"`
+	Pair                 string  `json:"pair"`
+	Side                 string  `json:"side"`
+	OrderType            string  `json:"orderType"`
+	Status               string  `json:"status"`
+	FillAmount           float64 `json:"fillAmount"`
+	FillPrice            float64 `json:"fillPrice"`
+	Amount               float64 `json:"amount"`
+	ExecutedAmount       float64 `json:"executedAmount"`
+	RemainingAmount      float64 `json:"remainingAmount"`
+	AverageExecutedPrice float64 `json:"averageExecutedPrice"`
+	Fee                  float64 `json:"fee"`
+}
+
+// WebhookBalanceChange holds the details of a balance change
+type WebhookBalanceChange struct {
+	Account  string  `json:"account,omitempty"`
+	Asset    string  `json:"asset"`
+	Currency string  `json:"currency"`
+	Previous float64 `json:"previous"`
+	Current  float64 `json:"current"`
+	Change   float64 `json:"change"`
+	Hold     float64 `json:"hold"`
+}
+
+// WebhookTransfer holds the details of a deposit or withdrawal
+type WebhookTransfer struct {
+	TransferID    string    `json:"transferID"`
+	TransferType  string    `json:"transferType"`
+	Status        string    `json:"status"`
+	Currency      string    `json:"currency"`
+	Amount        float64   `json:"amount"`
+	Fee           float64   `json:"fee"`
+	Description   string    `json:"description,omitempty"`
+	CryptoAddress string    `json:"cryptoAddress,omitempty"`
+	CryptoTxID    string    `json:"cryptoTxID,omitempty"`
+	CryptoChain   string    `json:"cryptoChain,omitempty"`
+	Timestamp     time.Time `json:"timestamp"`
+}
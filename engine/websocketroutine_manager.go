@@ -9,6 +9,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
@@ -197,6 +198,18 @@ func (m *websocketRoutineManager) WebsocketDataHandler(exchName string, data int
 				d.AssetType,
 				d)
 		}
+	case []kline.CandleUpdate:
+		if m.verbose {
+			for i := range d {
+				log.Infof(log.WebsocketMgr, "%s websocket %s %s %s candle closed: %v %+v",
+					exchName,
+					m.FormatCurrency(d[i].Pair),
+					d[i].Asset,
+					d[i].Interval,
+					d[i].Closed,
+					d[i].Candle)
+			}
+		}
 	case *orderbook.Base:
 		if m.syncer.IsRunning() {
 			err := m.syncer.Update(exchName,
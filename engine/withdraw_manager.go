@@ -4,9 +4,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
 	"time"
 
+	"github.com/gofrs/uuid"
+	"github.com/pquerna/otp/totp"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/currency"
 	dbwithdraw "github.com/thrasher-corp/gocryptotrader/database/repository/withdraw"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
 	"github.com/thrasher-corp/gocryptotrader/log"
@@ -40,6 +49,26 @@ func (m *WithdrawManager) SubmitWithdrawal(ctx context.Context, req *withdraw.Re
 		return nil, err
 	}
 
+	// Determines if the currency can be withdrawn from the exchange
+	errF := exch.CanWithdraw(req.Currency, asset.Spot)
+	if errF != nil && !errors.Is(errF, currencystate.ErrCurrencyStateNotFound) { // Suppress not found error
+		return nil, errF
+	}
+
+	label, err := m.checkAddressBook(req)
+	if err != nil {
+		return nil, err
+	}
+	if m.requireApproval && req.Type == withdraw.Crypto {
+		return m.queueWithdrawal(req, label)
+	}
+	return m.submit(ctx, exch, req)
+}
+
+// submit sends a withdrawal request to the exchange, or simulates it when dry
+// run is enabled, and records the outcome
+func (m *WithdrawManager) submit(ctx context.Context, exch exchange.IBotExchange, req *withdraw.Request) (*withdraw.Response, error) {
+	var err error
 	resp := &withdraw.Response{
 		Exchange: withdraw.ExchangeResponse{
 			Name: req.Exchange,
@@ -47,12 +76,6 @@ func (m *WithdrawManager) SubmitWithdrawal(ctx context.Context, req *withdraw.Re
 		RequestDetails: *req,
 	}
 
-	// Determines if the currency can be withdrawn from the exchange
-	errF := exch.CanWithdraw(req.Currency, asset.Spot)
-	if errF != nil && !errors.Is(errF, currencystate.ErrCurrencyStateNotFound) { // Suppress not found error
-		return nil, errF
-	}
-
 	if m.isDryRun {
 		log.Warnln(log.Global, "Dry run enabled, no withdrawal request will be submitted or have an event created")
 		resp.ID = withdraw.DryRunID
@@ -60,7 +83,7 @@ func (m *WithdrawManager) SubmitWithdrawal(ctx context.Context, req *withdraw.Re
 		resp.Exchange.ID = withdraw.DryRunID.String()
 	} else {
 		var ret *withdraw.ExchangeResponse
-		if req.Type == withdraw.Crypto {
+		if req.Type == withdraw.Crypto && !m.enforceAddressBook {
 			if !m.portfolioManager.IsWhiteListed(req.Crypto.Address) {
 				return nil, withdraw.ErrStrAddressNotWhiteListed
 			}
@@ -89,6 +112,9 @@ func (m *WithdrawManager) SubmitWithdrawal(ctx context.Context, req *withdraw.Re
 	dbwithdraw.Event(resp)
 	if err == nil {
 		withdraw.Cache.Add(resp.ID, resp)
+		if !m.isDryRun {
+			m.track(resp)
+		}
 	}
 	return resp, err
 }
@@ -148,3 +174,423 @@ func (m *WithdrawManager) WithdrawalEventByExchangeID(exchange, id string) (*wit
 
 	return dbwithdraw.GetEventByExchangeID(exchange, id)
 }
+
+// EnableAddressBook loads the withdrawal address book persisted to the
+// supplied path. When enforced, cryptocurrency withdrawals are only permitted
+// to whitelisted address book entries instead of whitelisted portfolio
+// addresses
+func (m *WithdrawManager) EnableAddressBook(path string, enforce bool) error {
+	if m == nil {
+		return ErrNilSubsystem
+	}
+	book, err := withdraw.NewAddressBook(path)
+	if err != nil {
+		return err
+	}
+	m.addressBook = book
+	m.enforceAddressBook = enforce
+	return nil
+}
+
+// GetAddressBook returns the withdrawal address book
+func (m *WithdrawManager) GetAddressBook() (*withdraw.AddressBook, error) {
+	if m == nil {
+		return nil, ErrNilSubsystem
+	}
+	if m.addressBook == nil {
+		return nil, errAddressBookNotEnabled
+	}
+	return m.addressBook, nil
+}
+
+// EnableApprovals holds cryptocurrency withdrawals until they are approved
+// or the timeout elapses. When an OTP secret is supplied, approvals require a
+// valid one time password generated from it
+func (m *WithdrawManager) EnableApprovals(otpSecret string, timeout time.Duration) error {
+	if m == nil {
+		return ErrNilSubsystem
+	}
+	if timeout <= 0 {
+		return errInvalidApprovalTimeout
+	}
+	if otpSecret != "" {
+		_, err := totp.GenerateCode(otpSecret, time.Now())
+		if err != nil {
+			return fmt.Errorf("invalid withdrawal approval OTP secret: %w", err)
+		}
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	m.requireApproval = true
+	m.approvalOTPSecret = otpSecret
+	m.approvalTimeout = timeout
+	m.pending = make(map[uuid.UUID]*PendingWithdrawal)
+	return nil
+}
+
+// GetPendingWithdrawals returns the withdrawals awaiting approval, oldest
+// first
+func (m *WithdrawManager) GetPendingWithdrawals() ([]PendingWithdrawal, error) {
+	if m == nil {
+		return nil, ErrNilSubsystem
+	}
+	if !m.requireApproval {
+		return nil, errWithdrawalApprovalsDisabled
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	now := time.Now()
+	pending := make([]PendingWithdrawal, 0, len(m.pending))
+	for id, pw := range m.pending {
+		if now.After(pw.ExpiresAt) {
+			delete(m.pending, id)
+			continue
+		}
+		pending = append(pending, *pw)
+	}
+	sort.Slice(pending, func(i, j int) bool {
+		return pending[i].CreatedAt.Before(pending[j].CreatedAt)
+	})
+	return pending, nil
+}
+
+// ApproveWithdrawal submits a pending withdrawal to the exchange. The
+// withdrawal is checked against the address book again in case the address
+// has since been removed from the whitelist. Refresh, when supplied, is called
+// with the request before it is submitted so that time sensitive exchange
+// credentials such as one time passwords can be regenerated
+func (m *WithdrawManager) ApproveWithdrawal(ctx context.Context, id, code string, refresh func(*withdraw.Request) error) (*withdraw.Response, error) {
+	if m == nil {
+		return nil, ErrNilSubsystem
+	}
+	pw, err := m.takePendingWithdrawal(id, code, true)
+	if err != nil {
+		return nil, err
+	}
+	req := pw.Request
+	exch, err := m.exchangeManager.GetExchangeByName(req.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	_, err = m.checkAddressBook(&req)
+	if err != nil {
+		return nil, err
+	}
+	if refresh != nil {
+		err = refresh(&req)
+		if err != nil {
+			return nil, err
+		}
+	}
+	log.Infof(log.Global, "Withdrawal %s of %v %s from %s to %s approved",
+		pw.ID, req.Amount, req.Currency, req.Exchange, req.Crypto.Address)
+	return m.submit(ctx, exch, &req)
+}
+
+// RejectWithdrawal removes a pending withdrawal without submitting it
+func (m *WithdrawManager) RejectWithdrawal(id string) error {
+	if m == nil {
+		return ErrNilSubsystem
+	}
+	pw, err := m.takePendingWithdrawal(id, "", false)
+	if err != nil {
+		return err
+	}
+	log.Infof(log.Global, "Withdrawal %s of %v %s from %s rejected",
+		pw.ID, pw.Request.Amount, pw.Request.Currency, pw.Request.Exchange)
+	return nil
+}
+
+// checkAddressBook ensures a cryptocurrency withdrawal is to a whitelisted
+// address book entry when the address book is enforced and returns the
+// entry's label
+func (m *WithdrawManager) checkAddressBook(req *withdraw.Request) (string, error) {
+	if !m.enforceAddressBook || req.Type != withdraw.Crypto {
+		return "", nil
+	}
+	entry, err := m.addressBook.CheckWithdrawal(req)
+	if err != nil {
+		return "", err
+	}
+	return entry.Label, nil
+}
+
+// queueWithdrawal holds a withdrawal until it is approved
+func (m *WithdrawManager) queueWithdrawal(req *withdraw.Request, label string) (*withdraw.Response, error) {
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	pw := &PendingWithdrawal{
+		ID:        id,
+		Request:   *req,
+		Label:     label,
+		CreatedAt: now,
+		ExpiresAt: now.Add(m.approvalTimeout),
+	}
+	m.m.Lock()
+	m.pending[id] = pw
+	m.m.Unlock()
+	log.Warnf(log.Global, "Withdrawal %s of %v %s from %s to %s requires approval before %s",
+		id, req.Amount, req.Currency, req.Exchange, req.Crypto.Address, pw.ExpiresAt.Format(time.RFC3339))
+	return &withdraw.Response{
+		ID: id,
+		Exchange: withdraw.ExchangeResponse{
+			Name:   req.Exchange,
+			Status: WithdrawalPendingApprovalStatus,
+		},
+		RequestDetails: *req,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}, nil
+}
+
+// takePendingWithdrawal removes and returns a pending withdrawal, verifying
+// the approval code when approving
+func (m *WithdrawManager) takePendingWithdrawal(id, code string, approve bool) (*PendingWithdrawal, error) {
+	if !m.requireApproval {
+		return nil, errWithdrawalApprovalsDisabled
+	}
+	uid, err := uuid.FromString(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w %s: %v", errPendingWithdrawalNotFound, id, err)
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	pw, ok := m.pending[uid]
+	if !ok {
+		return nil, fmt.Errorf("%w %s", errPendingWithdrawalNotFound, id)
+	}
+	if time.Now().After(pw.ExpiresAt) {
+		delete(m.pending, uid)
+		return nil, fmt.Errorf("%w %s", errPendingWithdrawalExpired, id)
+	}
+	if approve && m.approvalOTPSecret != "" && !totp.Validate(code, m.approvalOTPSecret) {
+		return nil, errInvalidApprovalCode
+	}
+	delete(m.pending, uid)
+	return pw, nil
+}
+
+// EnableStatusTracking polls the exchange status of submitted withdrawals at
+// the supplied interval once started, pushing an event when a withdrawal
+// completes or fails
+func (m *WithdrawManager) EnableStatusTracking(comms iCommsManager, interval time.Duration) error {
+	if m == nil {
+		return ErrNilSubsystem
+	}
+	if comms == nil {
+		return errNilCommunicationsManager
+	}
+	if interval <= 0 {
+		return errInvalidStatusCheckInterval
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	m.trackStatus = true
+	m.statusInterval = interval
+	m.commsManager = comms
+	m.tracked = make(map[uuid.UUID]*TrackedWithdrawal)
+	return nil
+}
+
+// Start runs withdrawal status tracking
+func (m *WithdrawManager) Start() error {
+	if m == nil {
+		return ErrNilSubsystem
+	}
+	if !m.trackStatus {
+		return errWithdrawalTrackingDisabled
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return ErrSubSystemAlreadyStarted
+	}
+	m.shutdown = make(chan struct{})
+	go m.run()
+	log.Debugf(log.ExchangeSys, "Withdraw manager status tracking %v", MsgSubSystemStarted)
+	return nil
+}
+
+// IsRunning checks whether withdrawal status tracking is running
+func (m *WithdrawManager) IsRunning() bool {
+	if m == nil {
+		return false
+	}
+	return atomic.LoadInt32(&m.started) == 1
+}
+
+// Stop stops withdrawal status tracking
+func (m *WithdrawManager) Stop() error {
+	if m == nil {
+		return ErrNilSubsystem
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 1, 0) {
+		return ErrSubSystemNotStarted
+	}
+	close(m.shutdown)
+	log.Debugf(log.ExchangeSys, "Withdraw manager status tracking %v", MsgSubSystemShutdown)
+	return nil
+}
+
+func (m *WithdrawManager) run() {
+	timer := time.NewTimer(m.statusInterval)
+	defer timer.Stop()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-timer.C:
+			ctx, cancel := context.WithCancel(context.Background())
+			go func() {
+				select {
+				case <-m.shutdown:
+					cancel()
+				case <-ctx.Done():
+				}
+			}()
+			m.checkWithdrawals(ctx)
+			cancel()
+			timer.Reset(m.statusInterval)
+		}
+	}
+}
+
+// track starts tracking the exchange status of a submitted withdrawal
+func (m *WithdrawManager) track(resp *withdraw.Response) {
+	if resp.Exchange.ID == "" {
+		return
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	if !m.trackStatus {
+		return
+	}
+	now := time.Now()
+	m.tracked[resp.ID] = &TrackedWithdrawal{
+		ID:            resp.ID,
+		Exchange:      resp.Exchange.Name,
+		ExchangeID:    resp.Exchange.ID,
+		Currency:      resp.RequestDetails.Currency.String(),
+		Amount:        resp.RequestDetails.Amount,
+		Status:        WithdrawalStatusPending,
+		ExchangeState: resp.Exchange.Status,
+		SubmittedAt:   now,
+		UpdatedAt:     now,
+	}
+}
+
+// checkWithdrawals polls the exchange status of each pending withdrawal.
+// Withdrawals on exchanges which cannot report a withdrawal's status are no
+// longer tracked
+func (m *WithdrawManager) checkWithdrawals(ctx context.Context) {
+	m.m.Lock()
+	pending := make([]TrackedWithdrawal, 0, len(m.tracked))
+	for _, tw := range m.tracked {
+		if tw.Status == WithdrawalStatusPending {
+			pending = append(pending, *tw)
+		}
+	}
+	m.m.Unlock()
+	for i := range pending {
+		exch, err := m.exchangeManager.GetExchangeByName(pending[i].Exchange)
+		if err != nil {
+			log.Errorf(log.ExchangeSys, "Withdraw manager unable to get exchange %s: %v", pending[i].Exchange, err)
+			continue
+		}
+		status, err := exch.GetWithdrawalStatus(ctx, currency.NewCode(pending[i].Currency), pending[i].ExchangeID)
+		switch {
+		case errors.Is(err, common.ErrNotYetImplemented), errors.Is(err, common.ErrFunctionNotSupported):
+			log.Warnf(log.ExchangeSys, "Withdraw manager cannot track withdrawal %s, %s does not support withdrawal status",
+				pending[i].ID, pending[i].Exchange)
+			m.m.Lock()
+			delete(m.tracked, pending[i].ID)
+			m.m.Unlock()
+		case errors.Is(err, exchange.ErrTransferNotFound):
+			// The exchange may not have listed the withdrawal yet
+		case err != nil:
+			log.Errorf(log.ExchangeSys, "Withdraw manager unable to get %s withdrawal %s status: %v",
+				pending[i].Exchange, pending[i].ID, err)
+		default:
+			m.updateWithdrawal(pending[i].ID, status)
+		}
+	}
+}
+
+// updateWithdrawal records the exchange status of a tracked withdrawal and
+// pushes an event when it completes or fails
+func (m *WithdrawManager) updateWithdrawal(id uuid.UUID, status *exchange.WithdrawalHistory) {
+	m.m.Lock()
+	defer m.m.Unlock()
+	tw, ok := m.tracked[id]
+	if !ok {
+		return
+	}
+	tw.ExchangeState = status.Status
+	if status.CryptoTxID != "" {
+		tw.CryptoTxID = status.CryptoTxID
+	}
+	if status.Fee != 0 {
+		tw.Fee = status.Fee
+	}
+	tw.UpdatedAt = time.Now()
+	newStatus := withdrawalStatus(status.Status)
+	if newStatus == tw.Status {
+		return
+	}
+	tw.Status = newStatus
+	var msg string
+	switch newStatus {
+	case WithdrawalStatusCompleted:
+		tw.CompletedAt = tw.UpdatedAt
+		msg = fmt.Sprintf("%s %s withdrawal of %v completed", tw.Exchange, tw.Currency, tw.Amount)
+		if tw.CryptoTxID != "" {
+			msg += " with transaction ID " + tw.CryptoTxID
+		}
+	case WithdrawalStatusFailed:
+		msg = fmt.Sprintf("%s %s withdrawal of %v failed with status %s",
+			tw.Exchange, tw.Currency, tw.Amount, tw.ExchangeState)
+	}
+	log.Infoln(log.ExchangeSys, "Withdraw manager: "+msg)
+	m.commsManager.PushEvent(base.Event{
+		Type:    "withdraw",
+		Message: msg,
+	})
+}
+
+// withdrawalStatus maps an exchange's transfer status to a withdrawal status
+func withdrawalStatus(status string) string {
+	status = strings.ToLower(status)
+	switch {
+	case common.StringDataCompare(webhookCompletedStatuses, status):
+		return WithdrawalStatusCompleted
+	case common.StringDataCompare(transferFailedStatuses, status):
+		return WithdrawalStatusFailed
+	default:
+		return WithdrawalStatusPending
+	}
+}
+
+// GetTrackedWithdrawals returns the withdrawals whose exchange status is
+// tracked ordered from newest to oldest, optionally filtered by exchange
+func (m *WithdrawManager) GetTrackedWithdrawals(exchName string) ([]TrackedWithdrawal, error) {
+	if m == nil {
+		return nil, ErrNilSubsystem
+	}
+	if !m.trackStatus {
+		return nil, errWithdrawalTrackingDisabled
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	tracked := make([]TrackedWithdrawal, 0, len(m.tracked))
+	for _, tw := range m.tracked {
+		if exchName != "" && !strings.EqualFold(tw.Exchange, exchName) {
+			continue
+		}
+		tracked = append(tracked, *tw)
+	}
+	sort.Slice(tracked, func(i, j int) bool {
+		return tracked[i].SubmittedAt.After(tracked[j].SubmittedAt)
+	})
+	return tracked, nil
+}
@@ -7,9 +7,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/pquerna/otp/totp"
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/binance"
 	"github.com/thrasher-corp/gocryptotrader/portfolio"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
@@ -220,3 +223,307 @@ func TestWithdrawalEventByExchangeID(t *testing.T) {
 			ErrExchangeNotFound)
 	}
 }
+
+// wmExchange aka withdraw manager fake exchange only implements the functions
+// used before a withdrawal is submitted, any calls to withdraw funds on the
+// exchange will panic
+type wmExchange struct {
+	exchange.IBotExchange
+}
+
+func (w *wmExchange) GetName() string {
+	return exchangeName
+}
+
+func (w *wmExchange) CanWithdraw(currency.Code, asset.Item) error {
+	return nil
+}
+
+func wmFakeTestHelper(t *testing.T) *WithdrawManager {
+	t.Helper()
+	em := SetupExchangeManager()
+	em.Add(&wmExchange{})
+	pm, err := setupPortfolioManager(em, 0, &portfolio.Base{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := SetupWithdrawManager(em, pm, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+// wmDisconnectDatabase clears the shared database connection for the duration
+// of the test so withdrawals keep their dry run ID rather than an ID assigned
+// when their event is stored. Tests using it must not run in parallel
+func wmDisconnectDatabase(t *testing.T) {
+	t.Helper()
+	sql := database.DB.SQL
+	database.DB.SQL = nil
+	t.Cleanup(func() {
+		database.DB.SQL = sql
+	})
+}
+
+func TestWithdrawalAddressBook(t *testing.T) {
+	wmDisconnectDatabase(t)
+	m := wmFakeTestHelper(t)
+	_, err := m.GetAddressBook()
+	if !errors.Is(err, errAddressBookNotEnabled) {
+		t.Errorf("received '%v' expected '%v'", err, errAddressBookNotEnabled)
+	}
+	err = m.EnableAddressBook("", true)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	book, err := m.GetAddressBook()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	req := &withdraw.Request{
+		Exchange: exchangeName,
+		Currency: currency.BTC,
+		Amount:   1,
+		Type:     withdraw.Crypto,
+		Crypto:   withdraw.CryptoRequest{Address: "1337"},
+	}
+	_, err = m.SubmitWithdrawal(context.Background(), req)
+	if !errors.Is(err, withdraw.ErrAddressNotInAddressBook) {
+		t.Errorf("received '%v' expected '%v'", err, withdraw.ErrAddressNotInAddressBook)
+	}
+	_, err = book.Add(withdraw.AddressBookEntry{Label: "cold", Exchange: exchangeName, Currency: currency.BTC, Address: "1337"})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = m.SubmitWithdrawal(context.Background(), req)
+	if !errors.Is(err, withdraw.ErrStrAddressNotWhiteListed) {
+		t.Errorf("received '%v' expected '%v'", err, withdraw.ErrStrAddressNotWhiteListed)
+	}
+	_, err = book.SetWhitelisted("cold", true)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	resp, err := m.SubmitWithdrawal(context.Background(), req)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if resp.ID != withdraw.DryRunID {
+		t.Errorf("received '%v' expected '%v'", resp.ID, withdraw.DryRunID)
+	}
+}
+
+func TestWithdrawalApprovals(t *testing.T) {
+	wmDisconnectDatabase(t)
+	m := wmFakeTestHelper(t)
+	_, err := m.GetPendingWithdrawals()
+	if !errors.Is(err, errWithdrawalApprovalsDisabled) {
+		t.Errorf("received '%v' expected '%v'", err, errWithdrawalApprovalsDisabled)
+	}
+	err = m.EnableApprovals("", 0)
+	if !errors.Is(err, errInvalidApprovalTimeout) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidApprovalTimeout)
+	}
+	err = m.EnableApprovals("not base32!", time.Minute)
+	if err == nil {
+		t.Error("expected error for an invalid OTP secret")
+	}
+	const secret = "JBSWY3DPEHPK3PXP"
+	err = m.EnableApprovals(secret, time.Minute)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	req := &withdraw.Request{
+		Exchange: exchangeName,
+		Currency: currency.BTC,
+		Amount:   1,
+		Type:     withdraw.Crypto,
+		Crypto:   withdraw.CryptoRequest{Address: "1337"},
+	}
+	resp, err := m.SubmitWithdrawal(context.Background(), req)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if resp.Exchange.Status != WithdrawalPendingApprovalStatus {
+		t.Errorf("received '%v' expected '%v'", resp.Exchange.Status, WithdrawalPendingApprovalStatus)
+	}
+	pending, err := m.GetPendingWithdrawals()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(pending) != 1 || pending[0].ID != resp.ID {
+		t.Fatalf("unexpected pending withdrawals %+v", pending)
+	}
+
+	_, err = m.ApproveWithdrawal(context.Background(), resp.ID.String(), "000000", nil)
+	if !errors.Is(err, errInvalidApprovalCode) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidApprovalCode)
+	}
+	code, err := totp.GenerateCode(secret, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var refreshed bool
+	approved, err := m.ApproveWithdrawal(context.Background(), resp.ID.String(), code, func(*withdraw.Request) error {
+		refreshed = true
+		return nil
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !refreshed {
+		t.Error("expected request to be refreshed before submission")
+	}
+	if approved.ID != withdraw.DryRunID {
+		t.Errorf("received '%v' expected '%v'", approved.ID, withdraw.DryRunID)
+	}
+	_, err = m.ApproveWithdrawal(context.Background(), resp.ID.String(), code, nil)
+	if !errors.Is(err, errPendingWithdrawalNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, errPendingWithdrawalNotFound)
+	}
+
+	resp, err = m.SubmitWithdrawal(context.Background(), req)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = m.RejectWithdrawal(resp.ID.String())
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = m.RejectWithdrawal(resp.ID.String())
+	if !errors.Is(err, errPendingWithdrawalNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, errPendingWithdrawalNotFound)
+	}
+
+	resp, err = m.SubmitWithdrawal(context.Background(), req)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	m.pending[resp.ID].ExpiresAt = time.Now().Add(-time.Second)
+	_, err = m.ApproveWithdrawal(context.Background(), resp.ID.String(), code, nil)
+	if !errors.Is(err, errPendingWithdrawalExpired) {
+		t.Errorf("received '%v' expected '%v'", err, errPendingWithdrawalExpired)
+	}
+}
+
+// wmStatusExchange reports a fixed withdrawal status
+type wmStatusExchange struct {
+	wmExchange
+	status *exchange.WithdrawalHistory
+	err    error
+}
+
+func (w *wmStatusExchange) GetWithdrawalStatus(context.Context, currency.Code, string) (*exchange.WithdrawalHistory, error) {
+	return w.status, w.err
+}
+
+func TestWithdrawalStatusTracking(t *testing.T) {
+	t.Parallel()
+	exch := &wmStatusExchange{err: exchange.ErrTransferNotFound}
+	em := SetupExchangeManager()
+	em.Add(exch)
+	m, err := SetupWithdrawManager(em, nil, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if err = m.Start(); !errors.Is(err, errWithdrawalTrackingDisabled) {
+		t.Errorf("received '%v' expected '%v'", err, errWithdrawalTrackingDisabled)
+	}
+	if _, err = m.GetTrackedWithdrawals(""); !errors.Is(err, errWithdrawalTrackingDisabled) {
+		t.Errorf("received '%v' expected '%v'", err, errWithdrawalTrackingDisabled)
+	}
+	comms := &dmComms{}
+	if err = m.EnableStatusTracking(nil, time.Minute); !errors.Is(err, errNilCommunicationsManager) {
+		t.Errorf("received '%v' expected '%v'", err, errNilCommunicationsManager)
+	}
+	if err = m.EnableStatusTracking(comms, 0); !errors.Is(err, errInvalidStatusCheckInterval) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidStatusCheckInterval)
+	}
+	if err = m.EnableStatusTracking(comms, time.Minute); !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	resp := &withdraw.Response{
+		ID: withdraw.DryRunID,
+		Exchange: withdraw.ExchangeResponse{
+			Name:   exchangeName,
+			ID:     "1337",
+			Status: "processing",
+		},
+		RequestDetails: withdraw.Request{
+			Currency: currency.BTC,
+			Amount:   1,
+		},
+	}
+	m.track(resp)
+	m.checkWithdrawals(context.Background())
+	tracked, err := m.GetTrackedWithdrawals(exchangeName)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(tracked) != 1 || tracked[0].Status != WithdrawalStatusPending {
+		t.Fatalf("expected pending withdrawal to remain tracked, received %+v", tracked)
+	}
+
+	exch.err = nil
+	exch.status = &exchange.WithdrawalHistory{Status: "Completed", CryptoTxID: "0xabc"}
+	m.checkWithdrawals(context.Background())
+	tracked, err = m.GetTrackedWithdrawals("")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if tracked[0].Status != WithdrawalStatusCompleted {
+		t.Errorf("received '%v' expected '%v'", tracked[0].Status, WithdrawalStatusCompleted)
+	}
+	if tracked[0].CryptoTxID != "0xabc" {
+		t.Errorf("received '%v' expected '%v'", tracked[0].CryptoTxID, "0xabc")
+	}
+	if tracked[0].CompletedAt.IsZero() {
+		t.Error("expected completion time to be set")
+	}
+	if len(comms.events) != 1 {
+		t.Errorf("received '%v' events expected '%v'", len(comms.events), 1)
+	}
+
+	m.track(resp)
+	exch.err = common.ErrNotYetImplemented
+	m.checkWithdrawals(context.Background())
+	tracked, err = m.GetTrackedWithdrawals("")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(tracked) != 0 {
+		t.Errorf("expected unsupported withdrawal to no longer be tracked, received %+v", tracked)
+	}
+
+	if err = m.Start(); !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !m.IsRunning() {
+		t.Error("expected status tracking to be running")
+	}
+	if err = m.Stop(); !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if err = m.Stop(); !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemNotStarted)
+	}
+}
+
+func TestWithdrawalStatus(t *testing.T) {
+	t.Parallel()
+	for status, expected := range map[string]string{
+		"Success":    WithdrawalStatusCompleted,
+		"completed":  WithdrawalStatusCompleted,
+		"rejected":   WithdrawalStatusFailed,
+		"cancelled":  WithdrawalStatusFailed,
+		"processing": WithdrawalStatusPending,
+		"":           WithdrawalStatusPending,
+	} {
+		if received := withdrawalStatus(status); received != expected {
+			t.Errorf("%s: received '%v' expected '%v'", status, received, expected)
+		}
+	}
+}
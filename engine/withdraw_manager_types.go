@@ -2,17 +2,84 @@ package engine
 
 import (
 	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+// WithdrawalPendingApprovalStatus is the status of a withdrawal which has
+// been requested but not yet approved
+const WithdrawalPendingApprovalStatus = "pending approval"
+
+// Withdrawal statuses tracked by the withdraw manager
+const (
+	WithdrawalStatusPending   = "pending"
+	WithdrawalStatusCompleted = "completed"
+	WithdrawalStatusFailed    = "failed"
 )
 
 var (
 	// ErrWithdrawRequestNotFound message to display when no record is found
 	ErrWithdrawRequestNotFound = errors.New("request not found")
+
+	errAddressBookNotEnabled       = errors.New("withdrawal address book not enabled")
+	errWithdrawalApprovalsDisabled = errors.New("withdrawal approvals not enabled")
+	errPendingWithdrawalNotFound   = errors.New("pending withdrawal not found")
+	errPendingWithdrawalExpired    = errors.New("pending withdrawal has expired")
+	errInvalidApprovalCode         = errors.New("invalid withdrawal approval code")
+	errInvalidApprovalTimeout      = errors.New("withdrawal approval timeout must be greater than zero")
+	errWithdrawalTrackingDisabled  = errors.New("withdrawal status tracking not enabled")
+	errInvalidStatusCheckInterval  = errors.New("withdrawal status check interval must be greater than zero")
 )
 
 // WithdrawManager is responsible for performing withdrawal requests and
-// saving them to the database
+// saving them to the database. Cryptocurrency withdrawals can optionally be
+// restricted to whitelisted address book entries and held until approved.
+// When status tracking is enabled, submitted withdrawals are polled on their
+// exchange until they complete or fail
 type WithdrawManager struct {
-	exchangeManager  iExchangeManager
-	portfolioManager iPortfolioManager
-	isDryRun         bool
+	started            int32
+	shutdown           chan struct{}
+	exchangeManager    iExchangeManager
+	portfolioManager   iPortfolioManager
+	isDryRun           bool
+	addressBook        *withdraw.AddressBook
+	enforceAddressBook bool
+	requireApproval    bool
+	approvalOTPSecret  string
+	approvalTimeout    time.Duration
+	pending            map[uuid.UUID]*PendingWithdrawal
+	trackStatus        bool
+	statusInterval     time.Duration
+	commsManager       iCommsManager
+	tracked            map[uuid.UUID]*TrackedWithdrawal
+	m                  sync.Mutex
+}
+
+// PendingWithdrawal is a cryptocurrency withdrawal request which will not be
+// submitted to the exchange until it is approved
+type PendingWithdrawal struct {
+	ID        uuid.UUID
+	Request   withdraw.Request
+	Label     string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// TrackedWithdrawal holds the exchange status of a submitted withdrawal
+type TrackedWithdrawal struct {
+	ID            uuid.UUID `json:"id"`
+	Exchange      string    `json:"exchange"`
+	ExchangeID    string    `json:"exchangeID"`
+	Currency      string    `json:"currency"`
+	Amount        float64   `json:"amount"`
+	Fee           float64   `json:"fee"`
+	Status        string    `json:"status"`
+	ExchangeState string    `json:"exchangeState"`
+	CryptoTxID    string    `json:"cryptoTxID,omitempty"`
+	SubmittedAt   time.Time `json:"submittedAt"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+	CompletedAt   time.Time `json:"completedAt,omitempty"`
 }
@@ -1,11 +1,12 @@
 package account
 
 import (
-	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/dispatch"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 )
@@ -25,30 +26,33 @@ func SubscribeToExchangeAccount(exchange string) (dispatch.Pipe, error) {
 	if !ok {
 		service.Unlock()
 		return dispatch.Pipe{},
-			fmt.Errorf("%s exchange account holdings not found", exchange)
+			fmt.Errorf("%s %w", exchange, errExchangeHoldingsNotFound)
 	}
 
 	defer service.Unlock()
 	return service.mux.Subscribe(acc.ID)
 }
 
-// Process processes new account holdings updates
+// Process processes new account holdings updates. Each sub-account replaces
+// the stored balances of its asset type and ID, leaving the balances of other
+// asset types and sub-accounts untouched
 func Process(h *Holdings) error {
 	if h == nil {
-		return errors.New("cannot be nil")
+		return errHoldingsIsNil
 	}
 
 	if h.Exchange == "" {
-		return errors.New("exchange name unset")
+		return errExchangeNameUnset
 	}
 
 	return service.Update(h)
 }
 
-// GetHoldings returns full holdings for an exchange
+// GetHoldings returns the holdings of all sub-accounts of an asset type for an
+// exchange
 func GetHoldings(exch string, assetType asset.Item) (Holdings, error) {
 	if exch == "" {
-		return Holdings{}, errors.New("exchange name unset")
+		return Holdings{}, errExchangeNameUnset
 	}
 
 	exch = strings.ToLower(exch)
@@ -59,39 +63,121 @@ func GetHoldings(exch string, assetType asset.Item) (Holdings, error) {
 
 	service.Lock()
 	defer service.Unlock()
-	h, ok := service.accounts[exch]
+	acc, ok := service.accounts[exch]
 	if !ok {
-		return Holdings{}, errors.New("exchange account holdings not found")
+		return Holdings{}, errExchangeHoldingsNotFound
 	}
-	for y := range h.h.Accounts {
-		if h.h.Accounts[y].AssetType == assetType {
-			return *h.h, nil
+	subAccounts, ok := acc.subAccounts[assetType]
+	if !ok {
+		return Holdings{}, fmt.Errorf("%w for %v %s", errAssetHoldingsNotFound, assetType, exch)
+	}
+	return Holdings{
+		Exchange: acc.exchange,
+		Accounts: sortSubAccounts(subAccounts),
+	}, nil
+}
+
+// GetSubAccount returns the balances of a sub-account of an asset type for an
+// exchange
+func GetSubAccount(exch, subAccountID string, assetType asset.Item) (SubAccount, error) {
+	h, err := GetHoldings(exch, assetType)
+	if err != nil {
+		return SubAccount{}, err
+	}
+	for x := range h.Accounts {
+		if h.Accounts[x].ID == subAccountID {
+			return h.Accounts[x], nil
+		}
+	}
+	return SubAccount{}, fmt.Errorf("%w '%s' for %v %s", errSubAccountNotFound, subAccountID, assetType, exch)
+}
+
+// GetBalance returns the balance of a currency held by a sub-account of an
+// asset type for an exchange
+func GetBalance(exch, subAccountID string, assetType asset.Item, c currency.Code) (Balance, error) {
+	sub, err := GetSubAccount(exch, subAccountID, assetType)
+	if err != nil {
+		return Balance{}, err
+	}
+	for x := range sub.Currencies {
+		if sub.Currencies[x].CurrencyName.Match(c) {
+			return sub.Currencies[x], nil
 		}
 	}
-	return Holdings{}, fmt.Errorf("%v holdings data not found for %s", assetType, exch)
+	return Balance{}, fmt.Errorf("%w %s in sub-account '%s' for %v %s", errCurrencyBalanceNotFound, c, subAccountID, assetType, exch)
 }
 
 // Update updates holdings with new account info
 func (s *Service) Update(a *Holdings) error {
 	exch := strings.ToLower(a.Exchange)
 	s.Lock()
+	defer s.Unlock()
 	acc, ok := s.accounts[exch]
 	if !ok {
 		id, err := s.mux.GetID()
 		if err != nil {
-			s.Unlock()
 			return err
 		}
-
-		s.accounts[exch] = &Account{h: a, ID: id}
-		s.Unlock()
+		acc = &Account{
+			exchange:    a.Exchange,
+			subAccounts: make(map[asset.Item]map[string]*SubAccount),
+			ID:          id,
+		}
+		s.accounts[exch] = acc
+		acc.load(a.Accounts)
 		return nil
 	}
 
-	acc.h.Accounts = a.Accounts
-	defer s.Unlock()
+	acc.load(a.Accounts)
+	return s.mux.Publish([]uuid.UUID{acc.ID}, acc.holdings())
+}
+
+// load stores copies of the sub-accounts by asset type and ID
+func (a *Account) load(subAccounts []SubAccount) {
+	for x := range subAccounts {
+		assetAccounts, ok := a.subAccounts[subAccounts[x].AssetType]
+		if !ok {
+			assetAccounts = make(map[string]*SubAccount)
+			a.subAccounts[subAccounts[x].AssetType] = assetAccounts
+		}
+		balances := make([]Balance, len(subAccounts[x].Currencies))
+		copy(balances, subAccounts[x].Currencies)
+		assetAccounts[subAccounts[x].ID] = &SubAccount{
+			ID:         subAccounts[x].ID,
+			AssetType:  subAccounts[x].AssetType,
+			Currencies: balances,
+		}
+	}
+}
+
+// holdings returns the holdings of all asset types and sub-accounts
+func (a *Account) holdings() *Holdings {
+	h := &Holdings{Exchange: a.exchange}
+	for _, subAccounts := range a.subAccounts {
+		h.Accounts = append(h.Accounts, sortSubAccounts(subAccounts)...)
+	}
+	sort.SliceStable(h.Accounts, func(i, j int) bool {
+		return h.Accounts[i].AssetType < h.Accounts[j].AssetType
+	})
+	return h
+}
 
-	return s.mux.Publish([]uuid.UUID{acc.ID}, acc.h)
+// sortSubAccounts returns copies of the sub-accounts ordered by ID
+func sortSubAccounts(m map[string]*SubAccount) []SubAccount {
+	subAccounts := make([]SubAccount, 0, len(m))
+	for _, sub := range m {
+		balances := make([]Balance, len(sub.Currencies))
+		copy(balances, sub.Currencies)
+		subAccounts = append(subAccounts, SubAccount{
+			ID:         sub.ID,
+			AssetType:  sub.AssetType,
+			Currencies: balances,
+		})
+	}
+	sort.Slice(subAccounts, func(i, j int) bool {
+		return subAccounts[i].ID < subAccounts[j].ID
+	})
+	return subAccounts
 }
 
 // Available returns the amount you can use immediately.  E.g. if you have $100, but $20
@@ -1,6 +1,7 @@
 package account
 
 import (
+	"errors"
 	"sync"
 	"testing"
 	"time"
@@ -160,3 +161,71 @@ func TestBalance_Available(t *testing.T) {
 		t.Errorf("have %f, want 0", have)
 	}
 }
+
+func TestSegregatedHoldings(t *testing.T) {
+	t.Parallel()
+	err := Process(&Holdings{
+		Exchange: "Segregated",
+		Accounts: []SubAccount{
+			{ID: "main", AssetType: asset.Spot, Currencies: []Balance{{CurrencyName: currency.BTC, TotalValue: 1}}},
+			{ID: "sub", AssetType: asset.Spot, Currencies: []Balance{{CurrencyName: currency.BTC, TotalValue: 2}}},
+		},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = Process(&Holdings{
+		Exchange: "Segregated",
+		Accounts: []SubAccount{
+			{ID: "main", AssetType: asset.PerpetualSwap, Currencies: []Balance{{CurrencyName: currency.USDT, TotalValue: 50, Hold: 10}}},
+			{ID: "sub", AssetType: asset.Spot, Currencies: []Balance{{CurrencyName: currency.BTC, TotalValue: 3}}},
+		},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	h, err := GetHoldings("segregated", asset.Spot)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(h.Accounts) != 2 || h.Accounts[0].ID != "main" || h.Accounts[1].ID != "sub" {
+		t.Fatalf("unexpected spot holdings %+v", h.Accounts)
+	}
+	if h.Accounts[0].Currencies[0].TotalValue != 1 || h.Accounts[1].Currencies[0].TotalValue != 3 {
+		t.Errorf("unexpected spot balances %+v", h.Accounts)
+	}
+
+	h, err = GetHoldings("segregated", asset.PerpetualSwap)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(h.Accounts) != 1 || h.Accounts[0].AssetType != asset.PerpetualSwap {
+		t.Errorf("unexpected swap holdings %+v", h.Accounts)
+	}
+
+	_, err = GetHoldings("segregated", asset.Margin)
+	if !errors.Is(err, errAssetHoldingsNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, errAssetHoldingsNotFound)
+	}
+
+	b, err := GetBalance("segregated", "main", asset.PerpetualSwap, currency.USDT)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if b.Available() != 40 {
+		t.Errorf("received '%v' expected '%v'", b.Available(), 40)
+	}
+	_, err = GetBalance("segregated", "main", asset.Spot, currency.USDT)
+	if !errors.Is(err, errCurrencyBalanceNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, errCurrencyBalanceNotFound)
+	}
+	_, err = GetSubAccount("segregated", "missing", asset.Spot)
+	if !errors.Is(err, errSubAccountNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, errSubAccountNotFound)
+	}
+	_, err = GetSubAccount("missing", "main", asset.Spot)
+	if !errors.Is(err, errExchangeHoldingsNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, errExchangeHoldingsNotFound)
+	}
+}
@@ -1,6 +1,7 @@
 package account
 
 import (
+	"errors"
 	"sync"
 
 	"github.com/gofrs/uuid"
@@ -12,6 +13,13 @@ import (
 // Vars for the ticker package
 var (
 	service *Service
+
+	errHoldingsIsNil            = errors.New("holdings cannot be nil")
+	errExchangeNameUnset        = errors.New("exchange name unset")
+	errExchangeHoldingsNotFound = errors.New("exchange account holdings not found")
+	errAssetHoldingsNotFound    = errors.New("asset holdings data not found")
+	errSubAccountNotFound       = errors.New("sub-account not found")
+	errCurrencyBalanceNotFound  = errors.New("currency balance not found")
 )
 
 // Service holds ticker information for each individual exchange
@@ -21,10 +29,13 @@ type Service struct {
 	sync.Mutex
 }
 
-// Account holds a stream ID and a pointer to the exchange holdings
+// Account holds a stream ID and the exchange holdings keyed by asset type and
+// sub-account ID so balances of each asset type and sub-account are kept
+// separate
 type Account struct {
-	h  *Holdings
-	ID uuid.UUID
+	exchange    string
+	subAccounts map[asset.Item]map[string]*SubAccount
+	ID          uuid.UUID
 }
 
 // Holdings is a generic type to hold each exchange's holdings for all enabled
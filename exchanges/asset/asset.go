@@ -99,6 +99,22 @@ func (a Item) IsValid() bool {
 	return false
 }
 
+// IsFutures returns whether the asset type is a derivative contract which
+// holds a position rather than a balance
+func (a Item) IsFutures() bool {
+	switch a {
+	case PerpetualContract,
+		PerpetualSwap,
+		Futures,
+		UpsideProfitContract,
+		DownsideProfitContract,
+		CoinMarginedFutures,
+		USDTMarginedFutures:
+		return true
+	}
+	return false
+}
+
 // New takes an input matches to relevant package assets
 func New(input string) (Item, error) {
 	input = strings.ToLower(input)
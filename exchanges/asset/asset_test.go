@@ -61,6 +61,15 @@ func TestIsValid(t *testing.T) {
 	}
 }
 
+func TestIsFutures(t *testing.T) {
+	if Spot.IsFutures() || Margin.IsFutures() || Index.IsFutures() {
+		t.Error("TestIsFutures returned an unexpected result")
+	}
+	if !Futures.IsFutures() || !PerpetualSwap.IsFutures() || !USDTMarginedFutures.IsFutures() {
+		t.Error("TestIsFutures returned an unexpected result")
+	}
+}
+
 func TestNew(t *testing.T) {
 	if _, err := New("Spota"); err == nil {
 		t.Fatal("TestNew returned an unexpected result")
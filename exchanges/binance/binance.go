@@ -38,6 +38,7 @@ const (
 
 	// Public endpoints
 	exchangeInfo      = "/api/v3/exchangeInfo"
+	serverTime        = "/api/v3/time"
 	orderBookDepth    = "/api/v3/depth"
 	recentTrades      = "/api/v3/trades"
 	aggregatedTrades  = "/api/v3/aggTrades"
@@ -49,18 +50,19 @@ const (
 	userAccountStream = "/api/v3/userDataStream"
 	perpExchangeInfo  = "/fapi/v1/exchangeInfo"
 	historicalTrades  = "/api/v3/historicalTrades"
+	systemStatus      = "/sapi/v1/system/status"
 
 	// Authenticated endpoints
 	newOrderTest      = "/api/v3/order/test"
 	orderEndpoint     = "/api/v3/order"
 	openOrders        = "/api/v3/openOrders"
 	allOrders         = "/api/v3/allOrders"
+	myTrades          = "/api/v3/myTrades"
 	accountInfo       = "/api/v3/account"
 	marginAccountInfo = "/sapi/v1/margin/account"
 
 	// Withdraw API endpoints
 	accountStatus                          = "/wapi/v3/accountStatus.html"
-	systemStatus                           = "/wapi/v3/systemStatus.html"
 	dustLog                                = "/wapi/v3/userAssetDribbletLog.html"
 	tradeFee                               = "/wapi/v3/tradeFee.html"
 	assetDetail                            = "/wapi/v3/assetDetail.html"
@@ -114,6 +116,24 @@ func (b *Binance) GetExchangeInfo(ctx context.Context) (ExchangeInfo, error) {
 		exchange.RestSpotSupplementary, exchangeInfo, spotExchangeInfo, &resp)
 }
 
+// GetSpotServerTime returns the spot API server time
+func (b *Binance) GetSpotServerTime(ctx context.Context) (time.Time, error) {
+	var data struct {
+		ServerTime int64 `json:"serverTime"`
+	}
+	err := b.SendHTTPRequest(ctx, exchange.RestSpotSupplementary, serverTime, spotDefaultRate, &data)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.UnixMilli(data.ServerTime), nil
+}
+
+// GetSpotSystemStatus returns whether the platform is under system maintenance
+func (b *Binance) GetSpotSystemStatus(ctx context.Context) (SystemStatus, error) {
+	var resp SystemStatus
+	return resp, b.SendHTTPRequest(ctx, exchange.RestSpotSupplementary, systemStatus, spotDefaultRate, &resp)
+}
+
 // GetOrderBook returns full orderbook information
 //
 // OrderBookDataRequestParams contains the following members
@@ -667,6 +687,40 @@ func (b *Binance) AllOrders(ctx context.Context, symbol currency.Pair, orderID,
 	return resp, nil
 }
 
+// AccountTradeList returns the trades executed by the account for a symbol,
+// optionally for a single order
+// limit optional param, default 500; max 1000
+func (b *Binance) AccountTradeList(ctx context.Context, symbol currency.Pair, orderID string, startTime, endTime time.Time, limit int64) ([]AccountTrade, error) {
+	var resp []AccountTrade
+
+	params := url.Values{}
+	symbolValue, err := b.FormatSymbol(symbol, asset.Spot)
+	if err != nil {
+		return resp, err
+	}
+	params.Set("symbol", symbolValue)
+	if orderID != "" {
+		params.Set("orderId", orderID)
+	}
+	if !startTime.IsZero() && !endTime.IsZero() {
+		if startTime.After(endTime) {
+			return resp, errors.New("startTime cannot be after endTime")
+		}
+		params.Set("startTime", timeString(startTime))
+		params.Set("endTime", timeString(endTime))
+	}
+	if limit > 0 {
+		params.Set("limit", strconv.FormatInt(limit, 10))
+	}
+	return resp, b.SendAuthHTTPRequest(ctx,
+		exchange.RestSpotSupplementary,
+		http.MethodGet,
+		myTrades,
+		params,
+		spotAccountTradeListRate,
+		&resp)
+}
+
 // QueryOrder returns information on a past order
 func (b *Binance) QueryOrder(ctx context.Context, symbol currency.Pair, origClientOrderID string, orderID int64) (QueryOrderData, error) {
 	var resp QueryOrderData
@@ -802,7 +856,7 @@ func (b *Binance) SendAuthHTTPRequest(ctx context.Context, ePath exchange.URL, m
 	interim := json.RawMessage{}
 	err = b.SendPayload(ctx, f, func() (*request.Item, error) {
 		fullPath := endpointPath + path
-		params.Set("timestamp", strconv.FormatInt(time.Now().Unix()*1000, 10))
+		params.Set("timestamp", strconv.FormatInt(b.Requester.Now().UnixMilli(), 10))
 		signature := params.Encode()
 		var hmacSigned []byte
 		hmacSigned, err = crypto.GetHMAC(crypto.HashSHA256,
@@ -56,6 +56,36 @@ func TestUServerTime(t *testing.T) {
 	}
 }
 
+func TestGetServerTime(t *testing.T) {
+	t.Parallel()
+	st, err := b.GetServerTime(context.Background(), asset.Spot)
+	if err != nil {
+		t.Error(err)
+	}
+	if err == nil && st.IsZero() {
+		t.Error("expected a server time")
+	}
+	_, err = b.GetServerTime(context.Background(), asset.CoinMarginedFutures)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v' expected '%v'", err, asset.ErrNotSupported)
+	}
+}
+
+func TestGetSystemStatus(t *testing.T) {
+	t.Parallel()
+	s, err := b.GetSystemStatus(context.Background(), asset.Spot)
+	if err != nil {
+		t.Error(err)
+	}
+	if err == nil && s.Status == exchange.SystemStatusUnknown {
+		t.Error("expected a system status")
+	}
+	_, err = b.GetSystemStatus(context.Background(), asset.CoinMarginedFutures)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v' expected '%v'", err, asset.ErrNotSupported)
+	}
+}
+
 func TestParseSAPITime(t *testing.T) {
 	t.Parallel()
 	tm, err := time.Parse(binanceSAPITimeLayout, "2021-05-27 03:56:46")
@@ -529,6 +559,76 @@ func TestUChangeInitialMarginType(t *testing.T) {
 	}
 }
 
+func TestSetLeverage(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	err := b.SetLeverage(context.Background(), asset.USDTMarginedFutures, cp, 2.5)
+	if !errors.Is(err, exchange.ErrInvalidLeverage) {
+		t.Errorf("received '%v' expected '%v'", err, exchange.ErrInvalidLeverage)
+	}
+	err = b.SetLeverage(context.Background(), asset.Spot, cp, 2)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v' expected '%v'", err, asset.ErrNotSupported)
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("skipping test: api keys not set or canManipulateRealOrders set to false")
+	}
+	err = b.SetLeverage(context.Background(), asset.USDTMarginedFutures, cp, 2)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetLeverage(t *testing.T) {
+	t.Parallel()
+	_, err := b.GetLeverage(context.Background(), asset.Spot, currency.NewPair(currency.BTC, currency.USDT))
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v' expected '%v'", err, asset.ErrNotSupported)
+	}
+	if !areTestAPIKeysSet() {
+		t.Skip("skipping test: api keys not set")
+	}
+	_, err = b.GetLeverage(context.Background(), asset.USDTMarginedFutures, currency.NewPair(currency.BTC, currency.USDT))
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSetMarginMode(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	err := b.SetMarginMode(context.Background(), asset.USDTMarginedFutures, cp, exchange.MarginModeUnknown)
+	if !errors.Is(err, exchange.ErrInvalidMarginMode) {
+		t.Errorf("received '%v' expected '%v'", err, exchange.ErrInvalidMarginMode)
+	}
+	err = b.SetMarginMode(context.Background(), asset.Spot, cp, exchange.MarginModeIsolated)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v' expected '%v'", err, asset.ErrNotSupported)
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("skipping test: api keys not set or canManipulateRealOrders set to false")
+	}
+	err = b.SetMarginMode(context.Background(), asset.USDTMarginedFutures, cp, exchange.MarginModeIsolated)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetMarginMode(t *testing.T) {
+	t.Parallel()
+	_, err := b.GetMarginMode(context.Background(), asset.Spot, currency.NewPair(currency.BTC, currency.USDT))
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v' expected '%v'", err, asset.ErrNotSupported)
+	}
+	if !areTestAPIKeysSet() {
+		t.Skip("skipping test: api keys not set")
+	}
+	_, err = b.GetMarginMode(context.Background(), asset.USDTMarginedFutures, currency.NewPair(currency.BTC, currency.USDT))
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestUModifyIsolatedPositionMarginReq(t *testing.T) {
 	t.Parallel()
 	if !areTestAPIKeysSet() || !canManipulateRealOrders {
@@ -1343,6 +1443,49 @@ func TestAllOrders(t *testing.T) {
 	}
 }
 
+func TestAccountTradeList(t *testing.T) {
+	t.Parallel()
+	_, err := b.AccountTradeList(context.Background(), currency.NewPair(currency.BTC, currency.USDT), "", time.Time{}, time.Time{}, 0)
+	switch {
+	case areTestAPIKeysSet() && err != nil:
+		t.Error("AccountTradeList() error", err)
+	case !areTestAPIKeysSet() && err == nil && !mockTests:
+		t.Error("AccountTradeList() expecting an error when no keys are set")
+	case mockTests && err != nil:
+		t.Error("Mock AccountTradeList() error", err)
+	}
+}
+
+func TestGetOrderFills(t *testing.T) {
+	t.Parallel()
+	_, err := b.GetOrderFills(context.Background(), &order.GetFillsRequest{
+		OrderID:   "100234",
+		AssetType: asset.Spot,
+	})
+	if !errors.Is(err, order.ErrPairIsEmpty) {
+		t.Errorf("received '%v' expected '%v'", err, order.ErrPairIsEmpty)
+	}
+	fills, err := b.GetOrderFills(context.Background(), &order.GetFillsRequest{
+		Pair:      currency.NewPair(currency.BTC, currency.USDT),
+		AssetType: asset.Spot,
+	})
+	switch {
+	case areTestAPIKeysSet() && err != nil:
+		t.Error("GetOrderFills() error", err)
+	case !areTestAPIKeysSet() && err == nil && !mockTests:
+		t.Error("GetOrderFills() expecting an error when no keys are set")
+	case mockTests && err != nil:
+		t.Error("Mock GetOrderFills() error", err)
+	case mockTests:
+		if len(fills) != 1 {
+			t.Fatalf("received '%v' expected '%v'", len(fills), 1)
+		}
+		if fills[0].Side != order.Buy || fills[0].IsMaker || fills[0].FeeAsset != "BNB" || fills[0].Amount != 12 {
+			t.Errorf("unexpected fill %+v", fills[0])
+		}
+	}
+}
+
 // TestGetFeeByTypeOfflineTradeFee logic test
 func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 	t.Parallel()
@@ -2022,6 +2165,34 @@ func TestWithdrawHistory(t *testing.T) {
 	}
 }
 
+func TestGetWithdrawalStatus(t *testing.T) {
+	t.Parallel()
+	if areTestAPIKeysSet() && !canManipulateRealOrders && !mockTests {
+		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
+	}
+	_, err := b.GetWithdrawalStatus(context.Background(), currency.ETH, "1337")
+	switch {
+	case areTestAPIKeysSet() && err != nil && !errors.Is(err, exchange.ErrTransferNotFound):
+		t.Error(err)
+	case !areTestAPIKeysSet() && err == nil && !mockTests:
+		t.Error("expecting an error when no keys are set")
+	}
+}
+
+func TestGetDepositStatus(t *testing.T) {
+	t.Parallel()
+	if areTestAPIKeysSet() && !canManipulateRealOrders && !mockTests {
+		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
+	}
+	_, err := b.GetDepositStatus(context.Background(), currency.ETH, "0x1337")
+	switch {
+	case areTestAPIKeysSet() && err != nil && !errors.Is(err, exchange.ErrTransferNotFound):
+		t.Error(err)
+	case !areTestAPIKeysSet() && err == nil && !mockTests:
+		t.Error("expecting an error when no keys are set")
+	}
+}
+
 func TestWithdrawFiat(t *testing.T) {
 	t.Parallel()
 	_, err := b.WithdrawFiatFunds(context.Background(),
@@ -2637,3 +2808,17 @@ func TestWsOutboundAccountPosition(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestWsStreamChannel(t *testing.T) {
+	t.Parallel()
+	for raw, expected := range map[string]string{
+		`{"stream":"btcusdt@depth@100ms","data":{}}`: "depth",
+		`{"stream":"btcusdt@kline_1m","data":{}}`:    "kline_1m",
+		`{"stream":"listenkey","data":{}}`:           "userData",
+		`{"result":null,"id":1}`:                     "response",
+	} {
+		if channel := wsStreamChannel([]byte(raw)); channel != expected {
+			t.Errorf("received '%v' expected '%v'", channel, expected)
+		}
+	}
+}
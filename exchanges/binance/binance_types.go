@@ -21,6 +21,18 @@ const (
 	Completed
 )
 
+// Platform system statuses returned by GetSpotSystemStatus
+const (
+	systemStatusNormal      = 0
+	systemStatusMaintenance = 1
+)
+
+// SystemStatus holds whether the platform is under system maintenance
+type SystemStatus struct {
+	Status  int64  `json:"status"`
+	Message string `json:"msg"`
+}
+
 // ExchangeInfo holds the full exchange information type
 type ExchangeInfo struct {
 	Code       int       `json:"code"`
@@ -424,6 +436,23 @@ type QueryOrderData struct {
 	UpdateTime          time.Time `json:"updateTime"`
 }
 
+// AccountTrade holds an individual trade executed by the account
+type AccountTrade struct {
+	Symbol          string    `json:"symbol"`
+	ID              int64     `json:"id"`
+	OrderID         int64     `json:"orderId"`
+	OrderListID     int64     `json:"orderListId"`
+	Price           float64   `json:"price,string"`
+	Quantity        float64   `json:"qty,string"`
+	QuoteQuantity   float64   `json:"quoteQty,string"`
+	Commission      float64   `json:"commission,string"`
+	CommissionAsset string    `json:"commissionAsset"`
+	Time            time.Time `json:"time"`
+	IsBuyer         bool      `json:"isBuyer"`
+	IsMaker         bool      `json:"isMaker"`
+	IsBestMatch     bool      `json:"isBestMatch"`
+}
+
 // Balance holds query order data
 type Balance struct {
 	Asset  string `json:"asset"`
@@ -698,6 +727,24 @@ type DepositHistory struct {
 	ConfirmTimes  string  `json:"confirmTimes"`
 }
 
+// withdrawalStatuses maps withdrawal status codes to their descriptions
+var withdrawalStatuses = map[int64]string{
+	0: "email sent",
+	1: "cancelled",
+	2: "awaiting approval",
+	3: "rejected",
+	4: "processing",
+	5: "failure",
+	6: "completed",
+}
+
+// depositStatuses maps deposit status codes to their descriptions
+var depositStatuses = map[uint8]string{
+	0: "pending",
+	1: "success",
+	6: "credited but cannot withdraw",
+}
+
 // WithdrawResponse contains status of withdrawal request
 type WithdrawResponse struct {
 	ID string `json:"id"`
@@ -1,6 +1,7 @@
 package binance
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -154,9 +155,30 @@ func (b *Binance) wsReadData() {
 		if err != nil {
 			b.Websocket.DataHandler <- err
 		}
+		b.Websocket.Metrics.RecordMessage(wsStreamChannel(resp.Raw), &resp)
 	}
 }
 
+// wsStreamChannel returns the stream type of a combined stream message for
+// websocket metrics, without unmarshalling the payload. User data streams are
+// named by listen key so are grouped together
+func wsStreamChannel(respRaw []byte) string {
+	const streamKey = `"stream":"`
+	i := bytes.Index(respRaw, []byte(streamKey))
+	if i == -1 {
+		return "response"
+	}
+	name := respRaw[i+len(streamKey):]
+	if end := bytes.IndexByte(name, '"'); end != -1 {
+		name = name[:end]
+	}
+	parts := bytes.Split(name, []byte("@"))
+	if len(parts) < 2 {
+		return "userData"
+	}
+	return string(parts[1])
+}
+
 func (b *Binance) wsHandleData(respRaw []byte) error {
 	var multiStreamData map[string]interface{}
 	err := json.Unmarshal(respRaw, &multiStreamData)
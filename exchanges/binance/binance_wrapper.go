@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"sort"
 	"strconv"
 	"strings"
@@ -132,6 +133,7 @@ func (b *Binance) SetDefaults() {
 				CancelOrders:          true,
 				CancelOrder:           true,
 				SubmitOrder:           true,
+				ClientOrderID:         true,
 				DepositHistory:        true,
 				WithdrawalHistory:     true,
 				TradeFetching:         true,
@@ -187,7 +189,10 @@ func (b *Binance) SetDefaults() {
 
 	b.Requester = request.New(b.Name,
 		common.NewHTTPClientWithTimeout(exchange.DefaultHTTPTimeout),
-		request.WithLimiter(SetRateLimit()))
+		request.WithLimiter(SetRateLimit()),
+		request.WithServerTime(func(ctx context.Context) (time.Time, error) {
+			return b.GetServerTime(ctx, asset.Spot)
+		}))
 	b.API.Endpoints = b.NewEndpoints()
 	err = b.API.Endpoints.SetDefaultEndpoints(map[exchange.URL]string{
 		exchange.RestSpot:              spotAPIURL,
@@ -798,6 +803,71 @@ func (b *Binance) GetWithdrawalsHistory(ctx context.Context, c currency.Code) (r
 	return resp, nil
 }
 
+// GetWithdrawalStatus returns the current state of a withdrawal by its
+// withdrawal ID, client withdrawal ID or transaction ID
+func (b *Binance) GetWithdrawalStatus(ctx context.Context, c currency.Code, id string) (*exchange.WithdrawalHistory, error) {
+	w, err := b.WithdrawHistory(ctx, c, "", time.Time{}, time.Time{}, 0, 1000)
+	if err != nil {
+		return nil, err
+	}
+	for i := range w {
+		if w[i].ID != id && w[i].WithdrawOrderID != id && w[i].TransactionID != id {
+			continue
+		}
+		tm, err := time.Parse(binanceSAPITimeLayout, w[i].ApplyTime)
+		if err != nil {
+			return nil, err
+		}
+		status, ok := withdrawalStatuses[w[i].Status]
+		if !ok {
+			status = strconv.FormatInt(w[i].Status, 10)
+		}
+		return &exchange.WithdrawalHistory{
+			Status:          status,
+			TransferID:      w[i].ID,
+			Currency:        w[i].Coin,
+			Amount:          w[i].Amount,
+			Fee:             w[i].TransactionFee,
+			CryptoToAddress: w[i].Address,
+			CryptoTxID:      w[i].TransactionID,
+			CryptoChain:     w[i].Network,
+			Timestamp:       tm,
+		}, nil
+	}
+	return nil, fmt.Errorf("%w: withdrawal %s", exchange.ErrTransferNotFound, id)
+}
+
+// GetDepositStatus returns the current state of a deposit by its transaction
+// ID
+func (b *Binance) GetDepositStatus(ctx context.Context, c currency.Code, id string) (*exchange.FundHistory, error) {
+	d, err := b.DepositHistory(ctx, c, "", time.Time{}, time.Time{}, 0, 1000)
+	if err != nil {
+		return nil, err
+	}
+	for i := range d {
+		if d[i].TransactionID != id {
+			continue
+		}
+		status, ok := depositStatuses[d[i].Status]
+		if !ok {
+			status = strconv.FormatUint(uint64(d[i].Status), 10)
+		}
+		return &exchange.FundHistory{
+			ExchangeName:    b.Name,
+			Status:          status,
+			TransferID:      d[i].TransactionID,
+			Timestamp:       time.UnixMilli(int64(d[i].InsertTime)),
+			Currency:        d[i].Coin,
+			Amount:          d[i].Amount,
+			TransferType:    "deposit",
+			CryptoToAddress: d[i].Address,
+			CryptoTxID:      d[i].TransactionID,
+			CryptoChain:     d[i].Network,
+		}, nil
+	}
+	return nil, fmt.Errorf("%w: deposit %s", exchange.ErrTransferNotFound, id)
+}
+
 // GetRecentTrades returns the most recent trades for a currency and asset
 func (b *Binance) GetRecentTrades(ctx context.Context, p currency.Pair, assetType asset.Item) ([]trade.Data, error) {
 	var resp []trade.Data
@@ -1575,6 +1645,111 @@ func (b *Binance) GetOrderHistory(ctx context.Context, req *order.GetOrdersReque
 	return orders, nil
 }
 
+// GetOrderFills returns the individual fills of an order or pair
+func (b *Binance) GetOrderFills(ctx context.Context, req *order.GetFillsRequest) ([]order.Fill, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if req.Pair.IsEmpty() {
+		return nil, fmt.Errorf("%w, binance requires a pair", order.ErrPairIsEmpty)
+	}
+	var fills []order.Fill
+	switch req.AssetType {
+	case asset.Spot:
+		resp, err := b.AccountTradeList(ctx, req.Pair, req.OrderID, req.StartTime, req.EndTime, 1000)
+		if err != nil {
+			return nil, err
+		}
+		fills = make([]order.Fill, len(resp))
+		for i := range resp {
+			side := order.Sell
+			if resp[i].IsBuyer {
+				side = order.Buy
+			}
+			fills[i] = order.Fill{
+				Exchange:  b.Name,
+				OrderID:   strconv.FormatInt(resp[i].OrderID, 10),
+				TradeID:   strconv.FormatInt(resp[i].ID, 10),
+				Pair:      req.Pair,
+				AssetType: asset.Spot,
+				Side:      side,
+				Price:     resp[i].Price,
+				Amount:    resp[i].Quantity,
+				Fee:       resp[i].Commission,
+				FeeAsset:  resp[i].CommissionAsset,
+				IsMaker:   resp[i].IsMaker,
+				Timestamp: resp[i].Time,
+			}
+		}
+	case asset.USDTMarginedFutures:
+		resp, err := b.UAccountTradesHistory(ctx, req.Pair, "", 1000, time.Time{}, time.Time{})
+		if err != nil {
+			return nil, err
+		}
+		for i := range resp {
+			orderID := strconv.FormatInt(resp[i].OrderID, 10)
+			if req.OrderID != "" && req.OrderID != orderID {
+				continue
+			}
+			side, err := order.StringToOrderSide(resp[i].Side)
+			if err != nil {
+				return nil, err
+			}
+			fills = append(fills, order.Fill{
+				Exchange:  b.Name,
+				OrderID:   orderID,
+				TradeID:   strconv.FormatInt(resp[i].ID, 10),
+				Pair:      req.Pair,
+				AssetType: asset.USDTMarginedFutures,
+				Side:      side,
+				Price:     resp[i].Price,
+				Amount:    resp[i].Qty,
+				Fee:       resp[i].Commission,
+				FeeAsset:  resp[i].CommissionAsset,
+				IsMaker:   resp[i].Maker,
+				Timestamp: time.UnixMilli(resp[i].Time),
+			})
+		}
+	case asset.CoinMarginedFutures:
+		resp, err := b.FuturesTradeHistory(ctx, req.Pair, "", time.Time{}, time.Time{}, 1000, 0)
+		if err != nil {
+			return nil, err
+		}
+		for i := range resp {
+			orderID := strconv.FormatInt(resp[i].OrderID, 10)
+			if req.OrderID != "" && req.OrderID != orderID {
+				continue
+			}
+			side, err := order.StringToOrderSide(resp[i].Side)
+			if err != nil {
+				return nil, err
+			}
+			price, err := strconv.ParseFloat(resp[i].Price, 64)
+			if err != nil {
+				return nil, err
+			}
+			fills = append(fills, order.Fill{
+				Exchange:  b.Name,
+				OrderID:   orderID,
+				TradeID:   strconv.FormatInt(resp[i].ID, 10),
+				Pair:      req.Pair,
+				AssetType: asset.CoinMarginedFutures,
+				Side:      side,
+				Price:     price,
+				Amount:    resp[i].Qty,
+				Fee:       resp[i].Commission,
+				FeeAsset:  resp[i].CommissionAsset,
+				IsMaker:   resp[i].Maker,
+				Timestamp: time.UnixMilli(resp[i].Timestamp),
+			})
+		}
+	default:
+		return nil, fmt.Errorf("%s %w", req.AssetType, asset.ErrNotSupported)
+	}
+	order.FilterFillsByTimeRange(&fills, req.StartTime, req.EndTime)
+	return fills, nil
+}
+
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
 func (b *Binance) ValidateCredentials(ctx context.Context, assetType asset.Item) error {
@@ -1598,6 +1773,130 @@ func (b *Binance) FormatExchangeKlineInterval(interval kline.Interval) string {
 	}
 }
 
+// GetServerTime returns the current exchange server time.
+func (b *Binance) GetServerTime(ctx context.Context, a asset.Item) (time.Time, error) {
+	switch a {
+	case asset.Spot, asset.Margin:
+		return b.GetSpotServerTime(ctx)
+	case asset.USDTMarginedFutures:
+		return b.UServerTime(ctx)
+	}
+	return time.Time{}, fmt.Errorf("%s %w", a, asset.ErrNotSupported)
+}
+
+// GetSystemStatus returns the exchange's platform status
+func (b *Binance) GetSystemStatus(ctx context.Context, a asset.Item) (*exchange.SystemStatusResponse, error) {
+	if a != asset.Spot && a != asset.Margin {
+		return nil, fmt.Errorf("%s %w", a, asset.ErrNotSupported)
+	}
+	resp, err := b.GetSpotSystemStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	status := exchange.SystemStatusUnknown
+	switch resp.Status {
+	case systemStatusNormal:
+		status = exchange.SystemStatusOperational
+	case systemStatusMaintenance:
+		status = exchange.SystemStatusMaintenance
+	}
+	return &exchange.SystemStatusResponse{
+		Status:      status,
+		Message:     resp.Message,
+		LastUpdated: time.Now(),
+	}, nil
+}
+
+// SetLeverage sets the initial leverage of a futures pair. Binance only
+// accepts whole number leverage
+func (b *Binance) SetLeverage(ctx context.Context, a asset.Item, cp currency.Pair, leverage float64) error {
+	if leverage <= 0 || leverage != math.Trunc(leverage) {
+		return fmt.Errorf("%w, received %v, must be a whole number", exchange.ErrInvalidLeverage, leverage)
+	}
+	switch a {
+	case asset.USDTMarginedFutures:
+		_, err := b.UChangeInitialLeverageRequest(ctx, cp, int64(leverage))
+		return err
+	case asset.CoinMarginedFutures:
+		_, err := b.FuturesChangeInitialLeverage(ctx, cp, int64(leverage))
+		return err
+	default:
+		return fmt.Errorf("%s %w", a, asset.ErrNotSupported)
+	}
+}
+
+// GetLeverage returns the initial leverage of a futures pair
+func (b *Binance) GetLeverage(ctx context.Context, a asset.Item, cp currency.Pair) (float64, error) {
+	leverage, _, err := b.getFuturesPositionSettings(ctx, a, cp)
+	return leverage, err
+}
+
+// SetMarginMode sets the margin mode of a futures pair
+func (b *Binance) SetMarginMode(ctx context.Context, a asset.Item, cp currency.Pair, mode exchange.MarginMode) error {
+	var marginType string
+	switch mode {
+	case exchange.MarginModeCross:
+		marginType = "CROSSED"
+	case exchange.MarginModeIsolated:
+		marginType = "ISOLATED"
+	default:
+		return fmt.Errorf("%w %s", exchange.ErrInvalidMarginMode, mode)
+	}
+	switch a {
+	case asset.USDTMarginedFutures:
+		return b.UChangeInitialMarginType(ctx, cp, marginType)
+	case asset.CoinMarginedFutures:
+		_, err := b.FuturesChangeMarginType(ctx, cp, marginType)
+		return err
+	default:
+		return fmt.Errorf("%s %w", a, asset.ErrNotSupported)
+	}
+}
+
+// GetMarginMode returns the margin mode of a futures pair
+func (b *Binance) GetMarginMode(ctx context.Context, a asset.Item, cp currency.Pair) (exchange.MarginMode, error) {
+	_, marginType, err := b.getFuturesPositionSettings(ctx, a, cp)
+	if err != nil {
+		return exchange.MarginModeUnknown, err
+	}
+	return exchange.StringToMarginMode(marginType)
+}
+
+// getFuturesPositionSettings returns the leverage and margin type of a futures
+// pair from its position information
+func (b *Binance) getFuturesPositionSettings(ctx context.Context, a asset.Item, cp currency.Pair) (leverage float64, marginType string, err error) {
+	if a != asset.USDTMarginedFutures && a != asset.CoinMarginedFutures {
+		return 0, "", fmt.Errorf("%s %w", a, asset.ErrNotSupported)
+	}
+	symbol, err := b.FormatSymbol(cp, a)
+	if err != nil {
+		return 0, "", err
+	}
+	if a == asset.USDTMarginedFutures {
+		var resp []UPositionInformationV2
+		resp, err = b.UPositionsInfoV2(ctx, cp)
+		if err != nil {
+			return 0, "", err
+		}
+		for i := range resp {
+			if resp[i].Symbol == symbol {
+				return resp[i].Leverage, resp[i].MarginType, nil
+			}
+		}
+		return 0, "", fmt.Errorf("%w %s", errPositionNotFound, symbol)
+	}
+	resp, err := b.FuturesPositionsInfo(ctx, "", "")
+	if err != nil {
+		return 0, "", err
+	}
+	for i := range resp {
+		if resp[i].Symbol == symbol {
+			return float64(resp[i].Leverage), resp[i].MarginType, nil
+		}
+	}
+	return 0, "", fmt.Errorf("%w %s", errPositionNotFound, symbol)
+}
+
 // GetHistoricCandles returns candles between a time period for a set time interval
 func (b *Binance) GetHistoricCandles(ctx context.Context, pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
 	if err := b.ValidateKline(pair, a, interval); err != nil {
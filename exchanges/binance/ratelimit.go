@@ -46,6 +46,7 @@ const (
 	spotOrderQueryRate
 	spotAllOrdersRate
 	spotAccountInformationRate
+	spotAccountTradeListRate
 	uFuturesDefaultRate
 	uFuturesHistoricalTradesRate
 	uFuturesSymbolOrdersRate
@@ -131,7 +132,8 @@ func (r *RateLimit) Limit(ctx context.Context, f request.EndpointLimit) error {
 		limiter, tokens = r.SpotOrdersRate, 2
 	case spotOpenOrdersSpecificRate:
 		limiter, tokens = r.SpotOrdersRate, 3
-	case spotAllOrdersRate:
+	case spotAllOrdersRate,
+		spotAccountTradeListRate:
 		limiter, tokens = r.SpotOrdersRate, 10
 	case spotOpenOrdersAllRate:
 		limiter, tokens = r.SpotOrdersRate, 40
@@ -190,6 +190,22 @@ func (a *HistoricalTrade) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// UnmarshalJSON deserialises the JSON info, including the timestamp
+func (a *AccountTrade) UnmarshalJSON(data []byte) error {
+	type Alias AccountTrade
+	aux := &struct {
+		Time binanceTime `json:"time"`
+		*Alias
+	}{
+		Alias: (*Alias)(a),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	a.Time = aux.Time.Time()
+	return nil
+}
+
 // UnmarshalJSON deserialises the JSON info, including the timestamp
 func (a *QueryOrderData) UnmarshalJSON(data []byte) error {
 	type Alias QueryOrderData
@@ -1,8 +1,13 @@
 package binance
 
-import "time"
+import (
+	"errors"
+	"time"
+)
 
 var (
+	errPositionNotFound = errors.New("futures position not found")
+
 	validFuturesIntervals = []string{
 		"1m", "3m", "5m", "15m", "30m",
 		"1h", "2h", "4h", "6h", "8h",
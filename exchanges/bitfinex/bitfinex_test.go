@@ -173,6 +173,17 @@ func TestGetPlatformStatus(t *testing.T) {
 	}
 }
 
+func TestGetSystemStatus(t *testing.T) {
+	t.Parallel()
+	s, err := b.GetSystemStatus(context.Background(), asset.Spot)
+	if err != nil {
+		t.Error(err)
+	}
+	if err == nil && s.Status != exchange.SystemStatusOperational && s.Status != exchange.SystemStatusCancelOnly {
+		t.Errorf("received '%v' expected an operational or cancel only status", s.Status)
+	}
+}
+
 func TestGetTickerBatch(t *testing.T) {
 	t.Parallel()
 	_, err := b.GetTickerBatch(context.Background())
@@ -141,6 +141,10 @@ func (b *Bitfinex) SetDefaults() {
 				DateRanges: true,
 				Intervals:  true,
 			},
+			OrderAmendment: order.AmendCapabilities{
+				Price:  true,
+				Amount: true,
+			},
 		},
 		Enabled: exchange.FeaturesEnabled{
 			AutoPairUpdates: true,
@@ -1022,6 +1026,23 @@ func (b *Bitfinex) ValidateCredentials(ctx context.Context, assetType asset.Item
 	return b.CheckTransientError(err)
 }
 
+// GetSystemStatus returns the exchange's platform status. Bitfinex allows
+// orders to be cancelled while in maintenance mode
+func (b *Bitfinex) GetSystemStatus(ctx context.Context, _ asset.Item) (*exchange.SystemStatusResponse, error) {
+	resp, err := b.GetPlatformStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	status := exchange.SystemStatusOperational
+	if resp == bitfinexMaintenanceMode {
+		status = exchange.SystemStatusCancelOnly
+	}
+	return &exchange.SystemStatusResponse{
+		Status:      status,
+		LastUpdated: time.Now(),
+	}, nil
+}
+
 // FormatExchangeKlineInterval returns Interval to exchange formatted string
 func (b *Bitfinex) FormatExchangeKlineInterval(in kline.Interval) string {
 	switch in {
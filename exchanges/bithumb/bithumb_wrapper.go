@@ -111,6 +111,10 @@ func (b *Bithumb) SetDefaults() {
 			Kline: kline.ExchangeCapabilitiesSupported{
 				Intervals: true,
 			},
+			OrderAmendment: order.AmendCapabilities{
+				Price:  true,
+				Amount: true,
+			},
 		},
 		Enabled: exchange.FeaturesEnabled{
 			AutoPairUpdates: true,
@@ -105,6 +105,8 @@ const (
 	ContractUpsideProfit
 )
 
+var errPositionNotFound = errors.New("position not found")
+
 // GetAnnouncement returns the general announcements from Bitmex
 func (b *Bitmex) GetAnnouncement(ctx context.Context) ([]Announcement, error) {
 	var announcement []Announcement
@@ -2,6 +2,7 @@ package bitmex
 
 import (
 	"context"
+	"errors"
 	"log"
 	"net/http"
 	"os"
@@ -406,6 +407,76 @@ func TestGetPositions(t *testing.T) {
 	}
 }
 
+func TestGetDerivativePositions(t *testing.T) {
+	t.Parallel()
+	_, err := b.GetDerivativePositions(context.Background(), asset.Index)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v' expected '%v'", err, asset.ErrNotSupported)
+	}
+	_, err = b.GetDerivativePositions(context.Background(), asset.PerpetualContract)
+	if areTestAPIKeysSet() && err != nil {
+		t.Error(err)
+	} else if !areTestAPIKeysSet() && err == nil {
+		t.Error("GetDerivativePositions() Expected error")
+	}
+}
+
+func TestSetLeverage(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewPair(currency.XBT, currency.USD)
+	err := b.SetLeverage(context.Background(), asset.PerpetualContract, cp, 0)
+	if !errors.Is(err, exchange.ErrInvalidLeverage) {
+		t.Errorf("received '%v' expected '%v'", err, exchange.ErrInvalidLeverage)
+	}
+	err = b.SetLeverage(context.Background(), asset.Index, cp, 2)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v' expected '%v'", err, asset.ErrNotSupported)
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("API keys not set or canManipulateRealOrders set to false, skipping test")
+	}
+	err = b.SetLeverage(context.Background(), asset.PerpetualContract, cp, 2)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetLeverage(t *testing.T) {
+	t.Parallel()
+	_, err := b.GetLeverage(context.Background(), asset.PerpetualContract, currency.NewPair(currency.XBT, currency.USD))
+	if areTestAPIKeysSet() && err != nil {
+		t.Error(err)
+	} else if !areTestAPIKeysSet() && err == nil {
+		t.Error("GetLeverage() Expected error")
+	}
+}
+
+func TestSetMarginMode(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewPair(currency.XBT, currency.USD)
+	err := b.SetMarginMode(context.Background(), asset.PerpetualContract, cp, exchange.MarginModeUnknown)
+	if !errors.Is(err, exchange.ErrInvalidMarginMode) {
+		t.Errorf("received '%v' expected '%v'", err, exchange.ErrInvalidMarginMode)
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("API keys not set or canManipulateRealOrders set to false, skipping test")
+	}
+	err = b.SetMarginMode(context.Background(), asset.PerpetualContract, cp, exchange.MarginModeCross)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetMarginMode(t *testing.T) {
+	t.Parallel()
+	_, err := b.GetMarginMode(context.Background(), asset.PerpetualContract, currency.NewPair(currency.XBT, currency.USD))
+	if areTestAPIKeysSet() && err != nil {
+		t.Error(err)
+	} else if !areTestAPIKeysSet() && err == nil {
+		t.Error("GetMarginMode() Expected error")
+	}
+}
+
 func TestIsolatePosition(t *testing.T) {
 	t.Parallel()
 	_, err := b.IsolatePosition(context.Background(),
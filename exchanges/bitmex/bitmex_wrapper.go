@@ -118,6 +118,11 @@ func (b *Bitmex) SetDefaults() {
 				exchange.WithdrawCryptoWithEmail |
 				exchange.WithdrawCryptoWith2FA |
 				exchange.NoFiatWithdrawals,
+			OrderAmendment: order.AmendCapabilities{
+				Price:                  true,
+				Amount:                 true,
+				PreservesQueuePriority: true,
+			},
 		},
 		Enabled: exchange.FeaturesEnabled{
 			AutoPairUpdates: true,
@@ -716,6 +721,151 @@ func (b *Bitmex) WithdrawFiatFundsToInternationalBank(_ context.Context, _ *with
 	return nil, common.ErrFunctionNotSupported
 }
 
+// GetDerivativePositions returns the open positions held on the account for
+// the asset type. Margin and profit and loss amounts held in XBt are converted
+// to XBT
+func (b *Bitmex) GetDerivativePositions(ctx context.Context, a asset.Item) ([]order.Position, error) {
+	if a != asset.PerpetualContract && a != asset.Futures {
+		return nil, fmt.Errorf("%s %w", a, asset.ErrNotSupported)
+	}
+	pairs, err := b.GetAvailablePairs(a)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := b.GetPositions(ctx, PositionGetParams{Filter: "{\"isOpen\":true}"})
+	if err != nil {
+		return nil, err
+	}
+	var positions []order.Position
+	for i := range resp {
+		if !resp[i].IsOpen || resp[i].CurrentQty == 0 {
+			continue
+		}
+		var p currency.Pair
+		p, err = currency.NewPairFromString(resp[i].Symbol)
+		if err != nil {
+			return nil, err
+		}
+		if !pairs.Contains(p, false) {
+			continue
+		}
+		scale := 1.0
+		if resp[i].Currency == "XBt" {
+			scale = constSatoshiBTC
+		}
+		side := order.Long
+		if resp[i].CurrentQty < 0 {
+			side = order.Short
+		}
+		positions = append(positions, order.Position{
+			Exchange:          b.Name,
+			AssetType:         a,
+			Pair:              p,
+			Side:              side,
+			Amount:            math.Abs(float64(resp[i].CurrentQty)),
+			EntryPrice:        resp[i].AvgEntryPrice,
+			MarkPrice:         resp[i].MarkPrice,
+			LiquidationPrice:  resp[i].LiquidationPrice,
+			Leverage:          resp[i].Leverage,
+			Margin:            float64(resp[i].PosMargin) * scale,
+			MaintenanceMargin: float64(resp[i].MaintMargin) * scale,
+			UnrealisedPnL:     float64(resp[i].UnrealisedPnl) * scale,
+			RealisedPnL:       float64(resp[i].RealisedPnl) * scale,
+			LastUpdated:       resp[i].Timestamp,
+		})
+	}
+	return positions, nil
+}
+
+// SetLeverage sets the leverage of a contract's position. BitMEX applies fixed
+// leverage to isolated margin positions only, so setting leverage also
+// isolates the position
+func (b *Bitmex) SetLeverage(ctx context.Context, a asset.Item, cp currency.Pair, leverage float64) error {
+	if leverage <= 0 {
+		return fmt.Errorf("%w, received %v", exchange.ErrInvalidLeverage, leverage)
+	}
+	symbol, err := b.getContractSymbol(a, cp)
+	if err != nil {
+		return err
+	}
+	_, err = b.LeveragePosition(ctx, PositionUpdateLeverageParams{
+		Symbol:   symbol,
+		Leverage: leverage,
+	})
+	return err
+}
+
+// GetLeverage returns the leverage of a contract's position
+func (b *Bitmex) GetLeverage(ctx context.Context, a asset.Item, cp currency.Pair) (float64, error) {
+	pos, err := b.getContractPosition(ctx, a, cp)
+	if err != nil {
+		return 0, err
+	}
+	return pos.Leverage, nil
+}
+
+// SetMarginMode sets whether a contract's position uses cross or isolated
+// margin
+func (b *Bitmex) SetMarginMode(ctx context.Context, a asset.Item, cp currency.Pair, mode exchange.MarginMode) error {
+	if mode != exchange.MarginModeCross && mode != exchange.MarginModeIsolated {
+		return fmt.Errorf("%w %s", exchange.ErrInvalidMarginMode, mode)
+	}
+	symbol, err := b.getContractSymbol(a, cp)
+	if err != nil {
+		return err
+	}
+	_, err = b.IsolatePosition(ctx, PositionIsolateMarginParams{
+		Symbol:  symbol,
+		Enabled: mode == exchange.MarginModeIsolated,
+	})
+	return err
+}
+
+// GetMarginMode returns whether a contract's position uses cross or isolated
+// margin
+func (b *Bitmex) GetMarginMode(ctx context.Context, a asset.Item, cp currency.Pair) (exchange.MarginMode, error) {
+	pos, err := b.getContractPosition(ctx, a, cp)
+	if err != nil {
+		return exchange.MarginModeUnknown, err
+	}
+	if pos.CrossMargin {
+		return exchange.MarginModeCross, nil
+	}
+	return exchange.MarginModeIsolated, nil
+}
+
+// getContractSymbol returns the exchange symbol of a perpetual or futures
+// contract
+func (b *Bitmex) getContractSymbol(a asset.Item, cp currency.Pair) (string, error) {
+	if a != asset.PerpetualContract && a != asset.Futures {
+		return "", fmt.Errorf("%s %w", a, asset.ErrNotSupported)
+	}
+	fPair, err := b.FormatExchangeCurrency(cp, a)
+	if err != nil {
+		return "", err
+	}
+	return fPair.String(), nil
+}
+
+// getContractPosition returns the position record of a contract, which BitMEX
+// retains once a contract has been traded or its leverage set
+func (b *Bitmex) getContractPosition(ctx context.Context, a asset.Item, cp currency.Pair) (*Position, error) {
+	symbol, err := b.getContractSymbol(a, cp)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := b.GetPositions(ctx, PositionGetParams{Filter: "{\"symbol\":\"" + symbol + "\"}"})
+	if err != nil {
+		return nil, err
+	}
+	for i := range resp {
+		if resp[i].Symbol == symbol {
+			return &resp[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w %s", errPositionNotFound, symbol)
+}
+
 // GetFeeByType returns an estimate of fee based on type of transaction
 func (b *Bitmex) GetFeeByType(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	if !b.AllowAuthenticatedRequest() && // Todo check connection status
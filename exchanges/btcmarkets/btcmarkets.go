@@ -244,8 +244,8 @@ func (b *BTCMarkets) GetMultipleOrderbooks(ctx context.Context, marketIDs []stri
 	return orderbooks, nil
 }
 
-// GetServerTime gets time from btcmarkets
-func (b *BTCMarkets) GetServerTime(ctx context.Context) (time.Time, error) {
+// GetCurrentServerTime gets time from btcmarkets
+func (b *BTCMarkets) GetCurrentServerTime(ctx context.Context) (time.Time, error) {
 	var resp TimeResp
 	return resp.Time, b.SendHTTPRequest(ctx, btcMarketsAPIURL+btcMarketsAPIVersion+btcMarketsGetTime,
 		&resp)
@@ -716,7 +716,7 @@ func (b *BTCMarkets) SendAuthenticatedRequest(ctx context.Context, method, path
 	}
 
 	newRequest := func() (*request.Item, error) {
-		strTime := strconv.FormatInt(time.Now().UnixMilli(), 10)
+		strTime := strconv.FormatInt(b.Requester.Now().UnixMilli(), 10)
 		var body io.Reader
 		var payload, hmac []byte
 		switch data.(type) {
@@ -163,8 +163,8 @@ func (b *BTSE) GetPrice(ctx context.Context, symbol string) (Price, error) {
 	return p, b.SendHTTPRequest(ctx, exchange.RestSpot, http.MethodGet, path, &p, true, queryFunc)
 }
 
-// GetServerTime returns the exchanges server time
-func (b *BTSE) GetServerTime(ctx context.Context) (*ServerTime, error) {
+// GetCurrentServerTime returns the exchanges server time
+func (b *BTSE) GetCurrentServerTime(ctx context.Context) (*ServerTime, error) {
 	var s ServerTime
 	return &s, b.SendHTTPRequest(ctx, exchange.RestSpot, http.MethodGet, btseTime, &s, true, queryFunc)
 }
@@ -477,7 +477,7 @@ func (b *BTSE) SendAuthenticatedHTTPRequest(ctx context.Context, ep exchange.URL
 
 		var hmac []byte
 		var body io.Reader
-		nonce := strconv.FormatInt(time.Now().UnixMilli(), 10)
+		nonce := strconv.FormatInt(b.Requester.Now().UnixMilli(), 10)
 		headers := map[string]string{
 			"btse-api":   b.API.Credentials.Key,
 			"btse-nonce": nonce,
@@ -276,12 +276,23 @@ func TestUpdateTickers(t *testing.T) {
 	}
 }
 
+func TestGetCurrentServerTime(t *testing.T) {
+	t.Parallel()
+	_, err := b.GetCurrentServerTime(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestGetServerTime(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetServerTime(context.Background())
+	st, err := b.GetServerTime(context.Background(), asset.Spot)
 	if err != nil {
 		t.Error(err)
 	}
+	if err == nil && st.IsZero() {
+		t.Error("expected a server time")
+	}
 }
 
 func TestGetWalletInformation(t *testing.T) {
@@ -10,7 +10,6 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
@@ -229,8 +228,8 @@ func (c *CoinbasePro) GetCurrencies(ctx context.Context) ([]Currency, error) {
 	return currencies, c.SendHTTPRequest(ctx, exchange.RestSpot, coinbaseproCurrencies, &currencies)
 }
 
-// GetServerTime returns the API server time
-func (c *CoinbasePro) GetServerTime(ctx context.Context) (ServerTime, error) {
+// GetCurrentServerTime returns the API server time
+func (c *CoinbasePro) GetCurrentServerTime(ctx context.Context) (ServerTime, error) {
 	serverTime := ServerTime{}
 
 	return serverTime, c.SendHTTPRequest(ctx, exchange.RestSpot, coinbaseproTime, &serverTime)
@@ -719,7 +718,7 @@ func (c *CoinbasePro) SendAuthenticatedHTTPRequest(ctx context.Context, ep excha
 			}
 		}
 
-		n := strconv.FormatInt(time.Now().Unix(), 10)
+		n := strconv.FormatInt(c.Requester.Now().Unix(), 10)
 		message := n + method + "/" + path + string(payload)
 
 		hmac, err := crypto.GetHMAC(crypto.HashSHA256,
@@ -117,10 +117,21 @@ func TestGetCurrencies(t *testing.T) {
 	}
 }
 
+func TestGetCurrentServerTime(t *testing.T) {
+	_, err := c.GetCurrentServerTime(context.Background())
+	if err != nil {
+		t.Error("GetCurrentServerTime() error", err)
+	}
+}
+
 func TestGetServerTime(t *testing.T) {
-	_, err := c.GetServerTime(context.Background())
+	t.Parallel()
+	st, err := c.GetServerTime(context.Background(), asset.Spot)
 	if err != nil {
-		t.Error("GetServerTime() error", err)
+		t.Error(err)
+	}
+	if err == nil && st.IsZero() {
+		t.Error("expected a server time")
 	}
 }
 
@@ -445,6 +456,18 @@ func TestGetOrderHistory(t *testing.T) {
 
 // Any tests below this line have the ability to impact your orders on the exchange. Enable canManipulateRealOrders to run them
 // ----------------------------------------------------------------------------------------------------------------------------
+func TestGetOrderFills(t *testing.T) {
+	_, err := c.GetOrderFills(context.Background(), &order.GetFillsRequest{
+		AssetType: asset.Spot,
+		Pair:      testPair,
+	})
+	if areTestAPIKeysSet() && err != nil {
+		t.Errorf("Could not get order fills: %s", err)
+	} else if !areTestAPIKeysSet() && err == nil {
+		t.Error("Expecting an error when no keys are set")
+	}
+}
+
 func areTestAPIKeysSet() bool {
 	return c.ValidateAPICredentials()
 }
@@ -108,6 +108,7 @@ type AccountHolds struct {
 // placement and information collation
 type GeneralizedOrderResponse struct {
 	ID             string    `json:"id"`
+	ClientOID      string    `json:"client_oid"`
 	Price          float64   `json:"price,string"`
 	Size           float64   `json:"size,string"`
 	ProductID      string    `json:"product_id"`
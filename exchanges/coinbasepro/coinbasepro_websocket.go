@@ -184,6 +184,7 @@ func (c *CoinbasePro) wsHandleData(respRaw []byte) error {
 				ID:              wsOrder.OrderID,
 				AccountID:       wsOrder.ProfileID,
 				ClientID:        c.API.Credentials.ClientID,
+				ClientOrderID:   wsOrder.ClientOID,
 				Type:            oType,
 				Side:            oSide,
 				Status:          oStatus,
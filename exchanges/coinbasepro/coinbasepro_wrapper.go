@@ -85,6 +85,7 @@ func (c *CoinbasePro) SetDefaults() {
 				CancelOrders:      true,
 				CancelOrder:       true,
 				SubmitOrder:       true,
+				ClientOrderID:     true,
 				DepositHistory:    true,
 				WithdrawalHistory: true,
 				UserTradeHistory:  true,
@@ -132,7 +133,10 @@ func (c *CoinbasePro) SetDefaults() {
 
 	c.Requester = request.New(c.Name,
 		common.NewHTTPClientWithTimeout(exchange.DefaultHTTPTimeout),
-		request.WithLimiter(SetRateLimit()))
+		request.WithLimiter(SetRateLimit()),
+		request.WithServerTime(func(ctx context.Context) (time.Time, error) {
+			return c.GetServerTime(ctx, asset.Spot)
+		}))
 	c.API.Endpoints = c.NewEndpoints()
 	err = c.API.Endpoints.SetDefaultEndpoints(map[exchange.URL]string{
 		exchange.RestSpot:      coinbaseproAPIURL,
@@ -521,7 +525,7 @@ func (c *CoinbasePro) SubmitOrder(ctx context.Context, s *order.Submit) (order.S
 	switch s.Type {
 	case order.Market:
 		response, err = c.PlaceMarketOrder(ctx,
-			"",
+			s.ClientOrderID,
 			s.Amount,
 			s.Amount,
 			s.Side.Lower(),
@@ -529,7 +533,7 @@ func (c *CoinbasePro) SubmitOrder(ctx context.Context, s *order.Submit) (order.S
 			"")
 	case order.Limit:
 		response, err = c.PlaceLimitOrder(ctx,
-			"",
+			s.ClientOrderID,
 			s.Price,
 			s.Amount,
 			s.Side.Lower(),
@@ -608,6 +612,7 @@ func (c *CoinbasePro) GetOrderInfo(ctx context.Context, orderID string, pair cur
 	response := order.Detail{
 		Exchange:        c.GetName(),
 		ID:              genOrderDetail.ID,
+		ClientOrderID:   genOrderDetail.ClientOID,
 		Pair:            p,
 		Side:            ss,
 		Type:            tt,
@@ -763,6 +768,7 @@ func (c *CoinbasePro) GetActiveOrders(ctx context.Context, req *order.GetOrdersR
 		orderType := order.Type(strings.ToUpper(respOrders[i].Type))
 		orders = append(orders, order.Detail{
 			ID:             respOrders[i].ID,
+			ClientOrderID:  respOrders[i].ClientOID,
 			Amount:         respOrders[i].Size,
 			ExecutedAmount: respOrders[i].FilledSize,
 			Type:           orderType,
@@ -831,6 +837,7 @@ func (c *CoinbasePro) GetOrderHistory(ctx context.Context, req *order.GetOrdersR
 		orderType := order.Type(strings.ToUpper(respOrders[i].Type))
 		detail := order.Detail{
 			ID:              respOrders[i].ID,
+			ClientOrderID:   respOrders[i].ClientOID,
 			Amount:          respOrders[i].Size,
 			ExecutedAmount:  respOrders[i].FilledSize,
 			RemainingAmount: respOrders[i].Size - respOrders[i].FilledSize,
@@ -857,6 +864,59 @@ func (c *CoinbasePro) GetOrderHistory(ctx context.Context, req *order.GetOrdersR
 	return orders, nil
 }
 
+// GetOrderFills returns the individual fills of an order or pair
+func (c *CoinbasePro) GetOrderFills(ctx context.Context, req *order.GetFillsRequest) ([]order.Fill, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if req.AssetType != asset.Spot {
+		return nil, fmt.Errorf("%s %w", req.AssetType, asset.ErrNotSupported)
+	}
+	var productID string
+	if !req.Pair.IsEmpty() {
+		fpair, err := c.FormatExchangeCurrency(req.Pair, asset.Spot)
+		if err != nil {
+			return nil, err
+		}
+		productID = fpair.String()
+	}
+	resp, err := c.GetFills(ctx, req.OrderID, productID)
+	if err != nil {
+		return nil, err
+	}
+	format, err := c.GetPairFormat(asset.Spot, false)
+	if err != nil {
+		return nil, err
+	}
+	fills := make([]order.Fill, len(resp))
+	for i := range resp {
+		pair, err := currency.NewPairDelimiter(resp[i].ProductID, format.Delimiter)
+		if err != nil {
+			return nil, err
+		}
+		side, err := order.StringToOrderSide(resp[i].Side)
+		if err != nil {
+			return nil, err
+		}
+		fills[i] = order.Fill{
+			Exchange:  c.Name,
+			OrderID:   resp[i].OrderID,
+			TradeID:   strconv.FormatInt(resp[i].TradeID, 10),
+			Pair:      pair,
+			AssetType: asset.Spot,
+			Side:      side,
+			Price:     resp[i].Price,
+			Amount:    resp[i].Size,
+			Fee:       resp[i].Fee,
+			FeeAsset:  pair.Quote.String(),
+			IsMaker:   resp[i].Liquidity == "M",
+			Timestamp: resp[i].CreatedAt,
+		}
+	}
+	order.FilterFillsByTimeRange(&fills, req.StartTime, req.EndTime)
+	return fills, nil
+}
+
 // checkInterval checks allowable interval
 func checkInterval(i time.Duration) (int64, error) {
 	switch i.Seconds() {
@@ -876,6 +936,15 @@ func checkInterval(i time.Duration) (int64, error) {
 	return 0, fmt.Errorf("interval not allowed %v", i.Seconds())
 }
 
+// GetServerTime returns the current exchange server time.
+func (c *CoinbasePro) GetServerTime(ctx context.Context, _ asset.Item) (time.Time, error) {
+	resp, err := c.GetCurrentServerTime(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return resp.ISO, nil
+}
+
 // GetHistoricCandles returns a set of candle between two time periods for a
 // designated time period
 func (c *CoinbasePro) GetHistoricCandles(ctx context.Context, p currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
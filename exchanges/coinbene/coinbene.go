@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -17,6 +18,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 )
@@ -1282,3 +1284,49 @@ func (c *Coinbene) Withdraw(ctx context.Context, curr currency.Code, address, ta
 	}
 	return &resp.Data, nil
 }
+
+// FetchSpotExchangeLimits fetches spot order execution limits
+func (c *Coinbene) FetchSpotExchangeLimits(ctx context.Context) ([]order.MinMaxLevel, error) {
+	pairs, err := c.GetAllPairs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limits := make([]order.MinMaxLevel, 0, len(pairs))
+	for x := range pairs {
+		var cp currency.Pair
+		cp, err = currency.NewPairFromStrings(pairs[x].BaseAsset,
+			pairs[x].QuoteAsset)
+		if err != nil {
+			return nil, err
+		}
+		limits = append(limits, order.MinMaxLevel{
+			Pair:       cp,
+			Asset:      asset.Spot,
+			StepPrice:  math.Pow10(-int(pairs[x].PricePrecision)),
+			MinAmount:  pairs[x].MinAmount,
+			StepAmount: math.Pow10(-int(pairs[x].AmountPrecision)),
+		})
+	}
+	return limits, nil
+}
+
+// FetchSwapExchangeLimits fetches perpetual swap order execution limits
+func (c *Coinbene) FetchSwapExchangeLimits(ctx context.Context) ([]order.MinMaxLevel, error) {
+	instruments, err := c.GetSwapInstruments(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limits := make([]order.MinMaxLevel, 0, len(instruments))
+	for x := range instruments {
+		limits = append(limits, order.MinMaxLevel{
+			Pair:      instruments[x].InstrumentID,
+			Asset:     asset.PerpetualSwap,
+			StepPrice: instruments[x].MinimumPriceChange,
+			MinAmount: instruments[x].MinimumAmount,
+			MaxAmount: instruments[x].MaximumAmount,
+		})
+	}
+	return limits, nil
+}
@@ -2,6 +2,7 @@ package coinbene
 
 import (
 	"context"
+	"errors"
 	"log"
 	"os"
 	"testing"
@@ -308,6 +309,10 @@ func TestGetAccountInfo(t *testing.T) {
 	if err != nil {
 		t.Error(err)
 	}
+	_, err = c.UpdateAccountInfo(context.Background(), asset.PerpetualSwap)
+	if err != nil {
+		t.Error(err)
+	}
 }
 
 func TestUpdateOrderbook(t *testing.T) {
@@ -874,3 +879,32 @@ func TestGetAvailableTransferCurrencies(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestUpdateOrderExecutionLimits(t *testing.T) {
+	t.Parallel()
+	err := c.UpdateOrderExecutionLimits(context.Background(), asset.Futures)
+	if err == nil {
+		t.Fatal("expected error for unhandled asset type")
+	}
+
+	err = c.UpdateOrderExecutionLimits(context.Background(), asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	limit, err := c.GetOrderExecutionLimits(asset.Spot, cp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = limit.Conforms(33000, 0, order.Limit)
+	if !errors.Is(err, order.ErrAmountBelowMin) {
+		t.Fatalf("expected error %v but received %v",
+			order.ErrAmountBelowMin,
+			err)
+	}
+
+	err = c.UpdateOrderExecutionLimits(context.Background(), asset.PerpetualSwap)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
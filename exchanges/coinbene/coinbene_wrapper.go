@@ -147,6 +147,7 @@ func (c *Coinbene) SetDefaults() {
 					kline.ThreeDay.Word():   true,
 					kline.OneWeek.Word():    true,
 				},
+				ResultLimit: 500,
 			},
 		},
 	}
@@ -227,6 +228,17 @@ func (c *Coinbene) Run() {
 		c.PrintEnabledPairs()
 	}
 
+	a := c.GetAssetTypes(true)
+	for x := range a {
+		err := c.UpdateOrderExecutionLimits(context.TODO(), a[x])
+		if err != nil {
+			log.Errorf(log.ExchangeSys,
+				"%s failed to set exchange order execution limits. Err: %v",
+				c.Name,
+				err)
+		}
+	}
+
 	if !c.GetEnabledFeatures().AutoPairUpdates {
 		return
 	}
@@ -476,30 +488,47 @@ func (c *Coinbene) UpdateOrderbook(ctx context.Context, p currency.Pair, assetTy
 	return orderbook.Get(c.Name, p, assetType)
 }
 
-// UpdateAccountInfo retrieves balances for all enabled currencies for the
-// Coinbene exchange
+// UpdateAccountInfo retrieves balances for all enabled currencies of the spot
+// or swap account for the Coinbene exchange
 func (c *Coinbene) UpdateAccountInfo(ctx context.Context, assetType asset.Item) (account.Holdings, error) {
 	var info account.Holdings
-	balance, err := c.GetAccountBalances(ctx)
-	if err != nil {
-		return info, err
+	if !c.SupportsAsset(assetType) {
+		return info, fmt.Errorf("%s does not support asset type %s", c.Name, assetType)
 	}
-	var acc account.SubAccount
-	for key := range balance {
-		c := currency.NewCode(balance[key].Asset)
-		hold := balance[key].Reserved
-		available := balance[key].Available
+	acc := account.SubAccount{AssetType: assetType}
+	switch assetType {
+	case asset.Spot:
+		balance, err := c.GetAccountBalances(ctx)
+		if err != nil {
+			return info, err
+		}
+		for key := range balance {
+			hold := balance[key].Reserved
+			available := balance[key].Available
+			acc.Currencies = append(acc.Currencies,
+				account.Balance{
+					CurrencyName: currency.NewCode(balance[key].Asset),
+					TotalValue:   hold + available,
+					Hold:         hold,
+				})
+		}
+	case asset.PerpetualSwap:
+		balance, err := c.GetSwapAccountInfo(ctx)
+		if err != nil {
+			return info, err
+		}
+		// Swap contracts are margined in USDT
 		acc.Currencies = append(acc.Currencies,
 			account.Balance{
-				CurrencyName: c,
-				TotalValue:   hold + available,
-				Hold:         hold,
+				CurrencyName: currency.USDT,
+				TotalValue:   balance.AvailableBalance + balance.FrozenBalance,
+				Hold:         balance.FrozenBalance,
 			})
 	}
 	info.Accounts = append(info.Accounts, acc)
 	info.Exchange = c.Name
 
-	err = account.Process(&info)
+	err := account.Process(&info)
 	if err != nil {
 		return account.Holdings{}, err
 	}
@@ -908,97 +937,150 @@ func (c *Coinbene) GetHistoricCandles(ctx context.Context, pair currency.Pair, a
 		return kline.Item{}, err
 	}
 
+	candles, err := c.getKlines(ctx, formattedPair.String(), a, start, end, interval)
+	if err != nil {
+		return kline.Item{}, err
+	}
+
+	ret := kline.Item{
+		Exchange: c.Name,
+		Pair:     pair,
+		Interval: interval,
+		Asset:    a,
+		Candles:  candles,
+	}
+	ret.SortCandlesByTimestamp(false)
+	return ret, nil
+}
+
+// GetHistoricCandlesExtended returns candles between a time period for a set time interval
+func (c *Coinbene) GetHistoricCandlesExtended(ctx context.Context, pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
+	if err := c.ValidateKline(pair, a, interval); err != nil {
+		return kline.Item{}, err
+	}
+
+	formattedPair, err := c.FormatExchangeCurrency(pair, asset.PerpetualSwap)
+	if err != nil {
+		return kline.Item{}, err
+	}
+
+	dates, err := kline.CalculateCandleDateRanges(start, end, interval, c.Features.Enabled.Kline.ResultLimit)
+	if err != nil {
+		return kline.Item{}, err
+	}
+
+	ret := kline.Item{
+		Exchange: c.Name,
+		Pair:     pair,
+		Interval: interval,
+		Asset:    a,
+	}
+	for x := range dates.Ranges {
+		var candles []kline.Candle
+		candles, err = c.getKlines(ctx,
+			formattedPair.String(),
+			a,
+			dates.Ranges[x].Start.Time,
+			dates.Ranges[x].End.Time,
+			interval)
+		if err != nil {
+			return kline.Item{}, err
+		}
+		ret.Candles = append(ret.Candles, candles...)
+	}
+	dates.SetHasDataFromCandles(ret.Candles)
+	summary := dates.DataSummary(false)
+	if len(summary) > 0 {
+		log.Warnf(log.ExchangeSys, "%v - %v", c.Name, summary)
+	}
+	ret.RemoveDuplicates()
+	ret.RemoveOutsideRange(start, end)
+	ret.SortCandlesByTimestamp(false)
+	return ret, nil
+}
+
+// getKlines fetches and converts the spot or perpetual swap candles between
+// the supplied times
+func (c *Coinbene) getKlines(ctx context.Context, symbol string, a asset.Item, start, end time.Time, interval kline.Interval) ([]kline.Candle, error) {
 	var candles CandleResponse
+	var err error
 	if a == asset.PerpetualSwap {
 		candles, err = c.GetSwapKlines(ctx,
-			formattedPair.String(),
+			symbol,
 			start, end,
 			c.FormatExchangeKlineInterval(interval))
 	} else {
 		candles, err = c.GetKlines(ctx,
-			formattedPair.String(),
+			symbol,
 			start, end,
 			c.FormatExchangeKlineInterval(interval))
 	}
 	if err != nil {
-		return kline.Item{}, err
-	}
-
-	ret := kline.Item{
-		Exchange: c.Name,
-		Pair:     pair,
-		Interval: interval,
-		Asset:    a,
+		return nil, err
 	}
 
+	resp := make([]kline.Candle, 0, len(candles.Data))
 	for x := range candles.Data {
 		if len(candles.Data[x]) < 6 {
-			return kline.Item{}, errors.New("unexpected candle data length")
+			return nil, errors.New("unexpected candle data length")
 		}
 		var tempCandle kline.Candle
 		tempTime, ok := candles.Data[x][0].(string)
 		if !ok {
-			return kline.Item{}, errors.New("timestamp conversion failed")
+			return nil, errors.New("timestamp conversion failed")
 		}
 		timestamp, err := time.Parse(time.RFC3339, tempTime)
 		if err != nil {
-			return kline.Item{}, err
+			return nil, err
 		}
 		tempCandle.Time = timestamp
 		open, ok := candles.Data[x][1].(string)
 		if !ok {
-			return kline.Item{}, errors.New("open conversion failed")
+			return nil, errors.New("open conversion failed")
 		}
 		tempCandle.Open, err = strconv.ParseFloat(open, 64)
 		if err != nil {
-			return kline.Item{}, err
+			return nil, err
 		}
 		high, ok := candles.Data[x][2].(string)
 		if !ok {
-			return kline.Item{}, errors.New("high conversion failed")
+			return nil, errors.New("high conversion failed")
 		}
 		tempCandle.High, err = strconv.ParseFloat(high, 64)
 		if err != nil {
-			return kline.Item{}, err
+			return nil, err
 		}
 
 		low, ok := candles.Data[x][3].(string)
 		if !ok {
-			return kline.Item{}, errors.New("low conversion failed")
+			return nil, errors.New("low conversion failed")
 		}
 		tempCandle.Low, err = strconv.ParseFloat(low, 64)
 		if err != nil {
-			return kline.Item{}, err
+			return nil, err
 		}
 
 		closeTemp, ok := candles.Data[x][4].(string)
 		if !ok {
-			return kline.Item{}, errors.New("close conversion failed")
+			return nil, errors.New("close conversion failed")
 		}
 		tempCandle.Close, err = strconv.ParseFloat(closeTemp, 64)
 		if err != nil {
-			return kline.Item{}, err
+			return nil, err
 		}
 
 		vol, ok := candles.Data[x][5].(string)
 		if !ok {
-			return kline.Item{}, errors.New("vol conversion failed")
+			return nil, errors.New("vol conversion failed")
 		}
 		tempCandle.Volume, err = strconv.ParseFloat(vol, 64)
 		if err != nil {
-			return kline.Item{}, err
+			return nil, err
 		}
 
-		ret.Candles = append(ret.Candles, tempCandle)
+		resp = append(resp, tempCandle)
 	}
-
-	ret.SortCandlesByTimestamp(false)
-	return ret, nil
-}
-
-// GetHistoricCandlesExtended returns candles between a time period for a set time interval
-func (c *Coinbene) GetHistoricCandlesExtended(ctx context.Context, pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
-	return c.GetHistoricCandles(ctx, pair, a, start, end, interval)
+	return resp, nil
 }
 
 // GetAvailableTransferChains returns the available transfer blockchains for the specific
@@ -1017,3 +1099,21 @@ func (c *Coinbene) GetAvailableTransferChains(ctx context.Context, cryptocurrenc
 	}
 	return availableChains, nil
 }
+
+// UpdateOrderExecutionLimits sets exchange executions for a required asset type
+func (c *Coinbene) UpdateOrderExecutionLimits(ctx context.Context, a asset.Item) error {
+	var limits []order.MinMaxLevel
+	var err error
+	switch a {
+	case asset.Spot:
+		limits, err = c.FetchSpotExchangeLimits(ctx)
+	case asset.PerpetualSwap:
+		limits, err = c.FetchSwapExchangeLimits(ctx)
+	default:
+		err = fmt.Errorf("unhandled asset type %s", a)
+	}
+	if err != nil {
+		return fmt.Errorf("cannot update exchange execution limits: %w", err)
+	}
+	return c.LoadLimits(limits)
+}
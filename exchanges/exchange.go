@@ -19,6 +19,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
@@ -42,11 +44,33 @@ const (
 var (
 	// ErrAuthenticatedRequestWithoutCredentialsSet error message for authenticated request without credentials set
 	ErrAuthenticatedRequestWithoutCredentialsSet = errors.New("authenticated HTTP request called but not supported due to unset/default API keys")
+	// ErrTransferNotFound is returned when a deposit or withdrawal cannot be
+	// found by its transfer or transaction ID
+	ErrTransferNotFound = errors.New("transfer not found")
+	// ErrSystemUnavailable is returned when an exchange's system status does
+	// not allow an activity, such as submitting orders during maintenance
+	ErrSystemUnavailable = errors.New("exchange system status does not allow activity")
+	// ErrOrderReplacementFailed is returned when an order was cancelled to be
+	// modified but its replacement could not be submitted
+	ErrOrderReplacementFailed = errors.New("order cancelled but its replacement could not be submitted")
+
+	// ErrInvalidLeverage is returned when leverage is set to zero or less
+	ErrInvalidLeverage = errors.New("leverage must be greater than zero")
+	// ErrInvalidMarginMode is returned when a margin mode is not recognised
+	// or not supported by the exchange
+	ErrInvalidMarginMode = errors.New("invalid margin mode")
 
 	errEndpointStringNotFound = errors.New("endpoint string not found")
 	errTransportNotSet        = errors.New("transport not set, cannot set timeout")
+	errExchangeBaseNotSet     = errors.New("exchange base not set")
+	errNilOrderDetail         = errors.New("order detail is nil")
+	errAmountBelowExecuted    = errors.New("order amount cannot be reduced below its executed amount")
 )
 
+// SystemStatusCacheDuration is how long CheckSystemStatus reuses a status
+// before requesting it from the exchange again
+var SystemStatusCacheDuration = time.Minute
+
 func (b *Base) checkAndInitRequester() {
 	if b.Requester == nil {
 		b.Requester = request.New(b.Name,
@@ -628,8 +652,12 @@ func (b *Base) SetupDefaults(exch *config.Exchange) error {
 			b.Name)
 	}
 	b.CanVerifyOrderbook = !exch.Orderbook.VerificationBypass
+	err = orderbook.SetMaxDepth(b.Name, exch.Orderbook.MaxDepth)
+	if err != nil {
+		return err
+	}
 	b.States = currencystate.NewCurrencyStates()
-	return err
+	return nil
 }
 
 // AllowAuthenticatedRequest checks to see if the required fields have been set
@@ -1150,6 +1178,217 @@ func (b *Base) AuthenticateWebsocket(_ context.Context) error {
 	return common.ErrFunctionNotSupported
 }
 
+// GetServerTime returns the current exchange server time
+func (b *Base) GetServerTime(_ context.Context, _ asset.Item) (time.Time, error) {
+	return time.Time{}, common.ErrFunctionNotSupported
+}
+
+// GetSystemStatus returns the exchange's platform status
+func (b *Base) GetSystemStatus(_ context.Context, _ asset.Item) (*SystemStatusResponse, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// CheckSystemStatus returns the exchange's platform status for the asset,
+// reusing the status for SystemStatusCacheDuration so that activity can be
+// gated on it without consuming the exchange's rate limit. An announced
+// maintenance window in progress is returned as SystemStatusMaintenance
+func CheckSystemStatus(ctx context.Context, exch IBotExchange, a asset.Item) (SystemStatus, error) {
+	b := exch.GetBase()
+	if b == nil {
+		return SystemStatusUnknown, errExchangeBaseNotSet
+	}
+	b.systemStatusMtx.Lock()
+	c, ok := b.systemStatuses[a]
+	b.systemStatusMtx.Unlock()
+	if ok && time.Since(c.checked) < SystemStatusCacheDuration {
+		return c.status, c.err
+	}
+	// the status is fetched without holding the lock so that a slow request
+	// does not hold up other checks of the exchange
+	resp, err := exch.GetSystemStatus(ctx, a)
+	if ctx.Err() != nil {
+		// a cancelled request says nothing about the exchange
+		return SystemStatusUnknown, err
+	}
+	c = &cachedSystemStatus{err: err, checked: time.Now()}
+	if err == nil {
+		c.status = resp.StatusAt(c.checked)
+	}
+	b.systemStatusMtx.Lock()
+	if b.systemStatuses == nil {
+		b.systemStatuses = make(map[asset.Item]*cachedSystemStatus)
+	}
+	b.systemStatuses[a] = c
+	b.systemStatusMtx.Unlock()
+	return c.status, c.err
+}
+
+// ModifyOrder changes the price or amount of an order using the exchange's
+// native amendment when its OrderAmendment capabilities support the change.
+// Otherwise the order is cancelled and a replacement for its remaining amount
+// is submitted with the same details, giving it a new ID. Exchanges which
+// report amendment as unsupported at request time are also cancel-replaced.
+// Modifications which change neither the price nor the amount are passed to
+// the exchange as is. Replacements are submitted with submit when set, or
+// directly to the exchange otherwise
+func ModifyOrder(ctx context.Context, exch IBotExchange, current *order.Detail, m *order.Modify, submit OrderSubmitter) (order.Modify, error) {
+	if current == nil {
+		return order.Modify{}, errNilOrderDetail
+	}
+	err := m.Validate()
+	if err != nil {
+		return order.Modify{}, err
+	}
+	b := exch.GetBase()
+	if b == nil {
+		return order.Modify{}, errExchangeBaseNotSet
+	}
+	priceChanged := m.Price > 0 && m.Price != current.Price
+	amountChanged := m.Amount > 0 && m.Amount != current.Amount
+	if !priceChanged && !amountChanged {
+		// other fields can only be amended by the exchange
+		return exch.ModifyOrder(ctx, m)
+	}
+
+	caps := b.Features.Supports.OrderAmendment
+	if caps.Amends(priceChanged, amountChanged) {
+		var resp order.Modify
+		resp, err = exch.ModifyOrder(ctx, m)
+		if err == nil {
+			resp.QueuePriorityPreserved = caps.KeepsQueuePriority(priceChanged, m.Amount > current.Amount)
+			return resp, nil
+		}
+		if !errors.Is(err, common.ErrFunctionNotSupported) && !errors.Is(err, common.ErrNotYetImplemented) {
+			return order.Modify{}, err
+		}
+	}
+	return cancelReplaceOrder(ctx, exch, current, m, submit)
+}
+
+// cancelReplaceOrder modifies an order by cancelling it and submitting a
+// replacement for its remaining amount. The order is fetched once cancelled
+// so that fills made since it was last updated are not resubmitted
+func cancelReplaceOrder(ctx context.Context, exch IBotExchange, current *order.Detail, m *order.Modify, submit OrderSubmitter) (order.Modify, error) {
+	price, amount := current.Price, current.Amount
+	if m.Price > 0 {
+		price = m.Price
+	}
+	if m.Amount > 0 {
+		amount = m.Amount
+	}
+	if amount <= current.ExecutedAmount {
+		return order.Modify{}, fmt.Errorf("%w, amount %v executed %v", errAmountBelowExecuted, amount, current.ExecutedAmount)
+	}
+	err := exch.CancelOrder(ctx, &order.Cancel{
+		Exchange:      current.Exchange,
+		ID:            current.ID,
+		ClientOrderID: current.ClientOrderID,
+		Type:          current.Type,
+		Side:          current.Side,
+		AssetType:     current.AssetType,
+		Pair:          current.Pair,
+	})
+	if err != nil {
+		return order.Modify{}, err
+	}
+	executed := current.ExecutedAmount
+	cancelled, err := exch.GetOrderInfo(ctx, current.ID, current.Pair, current.AssetType)
+	switch {
+	case err == nil:
+		executed = cancelled.ExecutedAmount
+	case !errors.Is(err, common.ErrFunctionNotSupported) && !errors.Is(err, common.ErrNotYetImplemented):
+		return order.Modify{}, fmt.Errorf("%s order %s %w: %v", current.Exchange, current.ID, ErrOrderReplacementFailed, err)
+	}
+	if amount <= executed {
+		return order.Modify{}, fmt.Errorf("%s order %s %w: %v, amount %v executed %v",
+			current.Exchange, current.ID, ErrOrderReplacementFailed, errAmountBelowExecuted, amount, executed)
+	}
+	if submit == nil {
+		submit = exch.SubmitOrder
+	}
+	resp, err := submit(ctx, &order.Submit{
+		ImmediateOrCancel: current.ImmediateOrCancel,
+		HiddenOrder:       current.HiddenOrder,
+		FillOrKill:        current.FillOrKill,
+		PostOnly:          current.PostOnly,
+		Leverage:          current.Leverage,
+		Price:             price,
+		Amount:            amount - executed,
+		TriggerPrice:      current.TriggerPrice,
+		Exchange:          current.Exchange,
+		AccountID:         current.AccountID,
+		Type:              current.Type,
+		Side:              current.Side,
+		AssetType:         current.AssetType,
+		Pair:              current.Pair,
+	})
+	if err != nil {
+		return order.Modify{}, fmt.Errorf("%s order %s %w: %v", current.Exchange, current.ID, ErrOrderReplacementFailed, err)
+	}
+	return order.Modify{
+		ImmediateOrCancel: current.ImmediateOrCancel,
+		HiddenOrder:       current.HiddenOrder,
+		FillOrKill:        current.FillOrKill,
+		PostOnly:          current.PostOnly,
+		Exchange:          current.Exchange,
+		ID:                resp.OrderID,
+		Price:             price,
+		Amount:            amount,
+		ExecutedAmount:    executed,
+		RemainingAmount:   amount - executed,
+		Type:              current.Type,
+		Side:              current.Side,
+		AssetType:         current.AssetType,
+		Pair:              current.Pair,
+		CancelReplaced:    true,
+	}, nil
+}
+
+// StatusAt returns the platform status at the time, taking any announced
+// maintenance window into account
+func (s *SystemStatusResponse) StatusAt(t time.Time) SystemStatus {
+	if !s.MaintenanceStart.IsZero() && !t.Before(s.MaintenanceStart) &&
+		(s.MaintenanceEnd.IsZero() || t.Before(s.MaintenanceEnd)) {
+		return SystemStatusMaintenance
+	}
+	return s.Status
+}
+
+// String returns the name of the system status
+func (s SystemStatus) String() string {
+	switch s {
+	case SystemStatusOperational:
+		return "operational"
+	case SystemStatusMaintenance:
+		return "maintenance"
+	case SystemStatusCancelOnly:
+		return "cancel_only"
+	case SystemStatusPostOnly:
+		return "post_only"
+	default:
+		return "unknown"
+	}
+}
+
+// AllowsMarketData returns whether market data can be requested from the
+// exchange
+func (s SystemStatus) AllowsMarketData() bool {
+	return s != SystemStatusMaintenance
+}
+
+// AllowsOrderSubmission returns whether orders can be submitted to the
+// exchange. Post only platforms accept orders which are rejected by the
+// exchange if they would take liquidity
+func (s SystemStatus) AllowsOrderSubmission() bool {
+	return s != SystemStatusMaintenance && s != SystemStatusCancelOnly
+}
+
+// AllowsOrderCancellation returns whether orders can be cancelled on the
+// exchange
+func (s SystemStatus) AllowsOrderCancellation() bool {
+	return s != SystemStatusMaintenance
+}
+
 // KlineIntervalEnabled returns if requested interval is enabled on exchange
 func (b *Base) klineIntervalEnabled(in kline.Interval) bool {
 	return b.Features.Enabled.Kline.Intervals[in.Word()]
@@ -1442,8 +1681,106 @@ func (b *Base) UpdateCurrencyStates(ctx context.Context, a asset.Item) error {
 	return common.ErrNotYetImplemented
 }
 
+// GetOrderFills returns the individual fills of an order or pair, this is
+// overridable
+func (b *Base) GetOrderFills(_ context.Context, _ *order.GetFillsRequest) ([]order.Fill, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// GetDerivativePositions returns the open positions held on the exchange for
+// a derivative asset type, this is overridable
+func (b *Base) GetDerivativePositions(_ context.Context, _ asset.Item) ([]order.Position, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// SetLeverage sets the leverage used for a derivative pair, this is
+// overridable
+func (b *Base) SetLeverage(_ context.Context, _ asset.Item, _ currency.Pair, _ float64) error {
+	return common.ErrFunctionNotSupported
+}
+
+// GetLeverage returns the leverage used for a derivative pair, this is
+// overridable
+func (b *Base) GetLeverage(_ context.Context, _ asset.Item, _ currency.Pair) (float64, error) {
+	return 0, common.ErrFunctionNotSupported
+}
+
+// SetMarginMode sets the margin mode used for a derivative pair, this is
+// overridable
+func (b *Base) SetMarginMode(_ context.Context, _ asset.Item, _ currency.Pair, _ MarginMode) error {
+	return common.ErrFunctionNotSupported
+}
+
+// GetMarginMode returns the margin mode used for a derivative pair, this is
+// overridable
+func (b *Base) GetMarginMode(_ context.Context, _ asset.Item, _ currency.Pair) (MarginMode, error) {
+	return MarginModeUnknown, common.ErrFunctionNotSupported
+}
+
+// String implements the stringer interface
+func (m MarginMode) String() string {
+	return string(m)
+}
+
+// StringToMarginMode converts a case insensitive margin mode to a MarginMode
+func StringToMarginMode(mode string) (MarginMode, error) {
+	switch strings.ToLower(mode) {
+	case "cross", "crossed":
+		return MarginModeCross, nil
+	case "isolated":
+		return MarginModeIsolated, nil
+	default:
+		return MarginModeUnknown, fmt.Errorf("%w %s", ErrInvalidMarginMode, mode)
+	}
+}
+
 // GetAvailableTransferChains returns a list of supported transfer chains based
 // on the supplied cryptocurrency
 func (b *Base) GetAvailableTransferChains(_ context.Context, _ currency.Code) ([]string, error) {
 	return nil, common.ErrFunctionNotSupported
 }
+
+// GetWithdrawalStatus returns the current state of a withdrawal by its
+// exchange transfer ID or transaction ID, this is overridable
+func (b *Base) GetWithdrawalStatus(_ context.Context, _ currency.Code, _ string) (*WithdrawalHistory, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// GetDepositStatus returns the current state of a deposit by its exchange
+// transfer ID or transaction ID, this is overridable
+func (b *Base) GetDepositStatus(_ context.Context, _ currency.Code, _ string) (*FundHistory, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// FindWithdrawal returns the withdrawal which matches the supplied exchange
+// transfer ID or transaction ID
+func FindWithdrawal(history []WithdrawalHistory, id string) (*WithdrawalHistory, error) {
+	if id == "" {
+		return nil, fmt.Errorf("%w: empty ID", ErrTransferNotFound)
+	}
+	for i := range history {
+		if history[i].TransferID == id || history[i].CryptoTxID == id {
+			return &history[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: withdrawal %s", ErrTransferNotFound, id)
+}
+
+// FindDeposit returns the deposit which matches the supplied exchange
+// transfer ID or transaction ID. Transfers which are typed as anything other
+// than a deposit are ignored
+func FindDeposit(history []FundHistory, id string) (*FundHistory, error) {
+	if id == "" {
+		return nil, fmt.Errorf("%w: empty ID", ErrTransferNotFound)
+	}
+	for i := range history {
+		if history[i].TransferType != "" &&
+			!strings.Contains(strings.ToLower(history[i].TransferType), "deposit") {
+			continue
+		}
+		if history[i].TransferID == id || history[i].CryptoTxID == id {
+			return &history[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: deposit %s", ErrTransferNotFound, id)
+}
@@ -16,6 +16,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
@@ -1291,6 +1293,26 @@ func TestSetupDefaults(t *testing.T) {
 		t.Error("HTTP timeout should be set to 30s")
 	}
 
+	// Test orderbook max depth is applied to the exchange's books
+	cfg.Orderbook.MaxDepth = 1
+	err = b.SetupDefaults(&cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	depth, err := orderbook.DeployDepth(b.Name, currency.NewPair(currency.BTC, currency.USD), asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if depth.GetMaxDepth() != 1 {
+		t.Errorf("received '%v' expected '%v'", depth.GetMaxDepth(), 1)
+	}
+	cfg.Orderbook.MaxDepth = -1
+	err = b.SetupDefaults(&cfg)
+	if !errors.Is(err, orderbook.ErrInvalidMaxDepth) {
+		t.Errorf("received '%v' expected '%v'", err, orderbook.ErrInvalidMaxDepth)
+	}
+	cfg.Orderbook.MaxDepth = 0
+
 	// Test asset types
 	p, err := currency.NewPairDelimiter(defaultTestCurrencyPair, "-")
 	if err != nil {
@@ -2461,3 +2483,467 @@ func TestGetAvailableTransferChains(t *testing.T) {
 		t.Errorf("received: %v, expected: %v", err, common.ErrFunctionNotSupported)
 	}
 }
+
+func TestGetOrderFills(t *testing.T) {
+	t.Parallel()
+	var b Base
+	if _, err := b.GetOrderFills(context.Background(), nil); !errors.Is(err, common.ErrNotYetImplemented) {
+		t.Errorf("received: %v, expected: %v", err, common.ErrNotYetImplemented)
+	}
+}
+
+func TestGetDerivativePositions(t *testing.T) {
+	t.Parallel()
+	var b Base
+	if _, err := b.GetDerivativePositions(context.Background(), asset.Futures); !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Errorf("received: %v, expected: %v", err, common.ErrFunctionNotSupported)
+	}
+}
+
+func TestLeverageAndMarginMode(t *testing.T) {
+	t.Parallel()
+	var b Base
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	if err := b.SetLeverage(context.Background(), asset.Futures, cp, 2); !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Errorf("received: %v, expected: %v", err, common.ErrFunctionNotSupported)
+	}
+	if _, err := b.GetLeverage(context.Background(), asset.Futures, cp); !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Errorf("received: %v, expected: %v", err, common.ErrFunctionNotSupported)
+	}
+	if err := b.SetMarginMode(context.Background(), asset.Futures, cp, MarginModeCross); !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Errorf("received: %v, expected: %v", err, common.ErrFunctionNotSupported)
+	}
+	if _, err := b.GetMarginMode(context.Background(), asset.Futures, cp); !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Errorf("received: %v, expected: %v", err, common.ErrFunctionNotSupported)
+	}
+}
+
+func TestStringToMarginMode(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		in       string
+		expected MarginMode
+		err      error
+	}{
+		{"cross", MarginModeCross, nil},
+		{"CROSSED", MarginModeCross, nil},
+		{"Isolated", MarginModeIsolated, nil},
+		{"portfolio", MarginModeUnknown, ErrInvalidMarginMode},
+	} {
+		mode, err := StringToMarginMode(tc.in)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("received: %v, expected: %v", err, tc.err)
+		}
+		if mode != tc.expected {
+			t.Errorf("received: %v, expected: %v", mode, tc.expected)
+		}
+	}
+}
+
+func TestGetTransferStatus(t *testing.T) {
+	t.Parallel()
+	var b Base
+	if _, err := b.GetWithdrawalStatus(context.Background(), currency.BTC, "1"); !errors.Is(err, common.ErrNotYetImplemented) {
+		t.Errorf("received: %v, expected: %v", err, common.ErrNotYetImplemented)
+	}
+	if _, err := b.GetDepositStatus(context.Background(), currency.BTC, "1"); !errors.Is(err, common.ErrNotYetImplemented) {
+		t.Errorf("received: %v, expected: %v", err, common.ErrNotYetImplemented)
+	}
+}
+
+func TestFindWithdrawal(t *testing.T) {
+	t.Parallel()
+	history := []WithdrawalHistory{
+		{TransferID: "1", CryptoTxID: "0xabc", Status: "pending"},
+		{TransferID: "2", CryptoTxID: "0xdef", Status: "completed"},
+	}
+	if _, err := FindWithdrawal(history, ""); !errors.Is(err, ErrTransferNotFound) {
+		t.Errorf("received: %v, expected: %v", err, ErrTransferNotFound)
+	}
+	if _, err := FindWithdrawal(history, "3"); !errors.Is(err, ErrTransferNotFound) {
+		t.Errorf("received: %v, expected: %v", err, ErrTransferNotFound)
+	}
+	w, err := FindWithdrawal(history, "2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w.Status != "completed" {
+		t.Errorf("received: %v, expected: %v", w.Status, "completed")
+	}
+	w, err = FindWithdrawal(history, "0xabc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w.TransferID != "1" {
+		t.Errorf("received: %v, expected: %v", w.TransferID, "1")
+	}
+}
+
+func TestFindDeposit(t *testing.T) {
+	t.Parallel()
+	history := []FundHistory{
+		{TransferID: "1", TransferType: "withdrawal", Status: "completed"},
+		{TransferID: "1", TransferType: "deposit", Status: "pending"},
+		{TransferID: "2", CryptoTxID: "0xdef", Status: "confirmed"},
+	}
+	if _, err := FindDeposit(history, "3"); !errors.Is(err, ErrTransferNotFound) {
+		t.Errorf("received: %v, expected: %v", err, ErrTransferNotFound)
+	}
+	d, err := FindDeposit(history, "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Status != "pending" {
+		t.Errorf("received: %v, expected: %v", d.Status, "pending")
+	}
+	d, err = FindDeposit(history, "0xdef")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.TransferID != "2" {
+		t.Errorf("received: %v, expected: %v", d.TransferID, "2")
+	}
+}
+
+type systemStatusExchange struct {
+	IBotExchange
+	base     *Base
+	resp     *SystemStatusResponse
+	err      error
+	requests int
+}
+
+func (s *systemStatusExchange) GetBase() *Base { return s.base }
+
+func (s *systemStatusExchange) GetSystemStatus(context.Context, asset.Item) (*SystemStatusResponse, error) {
+	s.requests++
+	return s.resp, s.err
+}
+
+func TestGetSystemStatus(t *testing.T) {
+	t.Parallel()
+	var b Base
+	_, err := b.GetSystemStatus(context.Background(), asset.Spot)
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrFunctionNotSupported)
+	}
+}
+
+func TestCheckSystemStatus(t *testing.T) {
+	t.Parallel()
+	_, err := CheckSystemStatus(context.Background(), &systemStatusExchange{}, asset.Spot)
+	if !errors.Is(err, errExchangeBaseNotSet) {
+		t.Errorf("received '%v' expected '%v'", err, errExchangeBaseNotSet)
+	}
+
+	e := &systemStatusExchange{
+		base: &Base{},
+		resp: &SystemStatusResponse{Status: SystemStatusOperational},
+	}
+	status, err := CheckSystemStatus(context.Background(), e, asset.Spot)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if status != SystemStatusOperational {
+		t.Errorf("received '%v' expected '%v'", status, SystemStatusOperational)
+	}
+	e.resp = &SystemStatusResponse{Status: SystemStatusMaintenance}
+	status, err = CheckSystemStatus(context.Background(), e, asset.Spot)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if status != SystemStatusOperational || e.requests != 1 {
+		t.Errorf("received '%v' after '%v' requests expected cached status", status, e.requests)
+	}
+	status, err = CheckSystemStatus(context.Background(), e, asset.Margin)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if status != SystemStatusMaintenance {
+		t.Errorf("received '%v' expected '%v'", status, SystemStatusMaintenance)
+	}
+
+	e.resp, e.err = nil, common.ErrFunctionNotSupported
+	_, err = CheckSystemStatus(context.Background(), e, asset.Futures)
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrFunctionNotSupported)
+	}
+	_, err = CheckSystemStatus(context.Background(), e, asset.Futures)
+	if !errors.Is(err, common.ErrFunctionNotSupported) || e.requests != 3 {
+		t.Errorf("received '%v' after '%v' requests expected cached error", err, e.requests)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	e.err = context.Canceled
+	_, err = CheckSystemStatus(ctx, e, asset.PerpetualSwap)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("received '%v' expected '%v'", err, context.Canceled)
+	}
+	e.resp, e.err = &SystemStatusResponse{Status: SystemStatusPostOnly}, nil
+	status, err = CheckSystemStatus(context.Background(), e, asset.PerpetualSwap)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if status != SystemStatusPostOnly {
+		t.Errorf("received '%v' expected cancelled request not to be cached", status)
+	}
+}
+
+func TestSystemStatusAt(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	s := &SystemStatusResponse{Status: SystemStatusOperational}
+	if status := s.StatusAt(now); status != SystemStatusOperational {
+		t.Errorf("received '%v' expected '%v'", status, SystemStatusOperational)
+	}
+	s.MaintenanceStart = now.Add(time.Hour)
+	s.MaintenanceEnd = now.Add(time.Hour * 2)
+	if status := s.StatusAt(now); status != SystemStatusOperational {
+		t.Errorf("received '%v' expected '%v' before maintenance", status, SystemStatusOperational)
+	}
+	if status := s.StatusAt(now.Add(time.Hour)); status != SystemStatusMaintenance {
+		t.Errorf("received '%v' expected '%v' during maintenance", status, SystemStatusMaintenance)
+	}
+	if status := s.StatusAt(now.Add(time.Hour * 2)); status != SystemStatusOperational {
+		t.Errorf("received '%v' expected '%v' after maintenance", status, SystemStatusOperational)
+	}
+	s.MaintenanceEnd = time.Time{}
+	if status := s.StatusAt(now.Add(time.Hour * 24)); status != SystemStatusMaintenance {
+		t.Errorf("received '%v' expected '%v' without an end", status, SystemStatusMaintenance)
+	}
+}
+
+func TestSystemStatus(t *testing.T) {
+	t.Parallel()
+	for _, tt := range []struct {
+		status                     SystemStatus
+		name                       string
+		marketData, submit, cancel bool
+	}{
+		{SystemStatusUnknown, "unknown", true, true, true},
+		{SystemStatusOperational, "operational", true, true, true},
+		{SystemStatusMaintenance, "maintenance", false, false, false},
+		{SystemStatusCancelOnly, "cancel_only", true, false, true},
+		{SystemStatusPostOnly, "post_only", true, true, true},
+	} {
+		if tt.status.String() != tt.name {
+			t.Errorf("received '%v' expected '%v'", tt.status.String(), tt.name)
+		}
+		if tt.status.AllowsMarketData() != tt.marketData ||
+			tt.status.AllowsOrderSubmission() != tt.submit ||
+			tt.status.AllowsOrderCancellation() != tt.cancel {
+			t.Errorf("received unexpected activity allowed for '%v'", tt.status)
+		}
+	}
+}
+
+type amendExchange struct {
+	IBotExchange
+	base      *Base
+	modifyErr error
+	submitErr error
+	modified  int
+	cancelled int
+	submitted *order.Submit
+	executed  float64
+	infoErr   error
+}
+
+func (a *amendExchange) GetBase() *Base { return a.base }
+
+func (a *amendExchange) ModifyOrder(_ context.Context, m *order.Modify) (order.Modify, error) {
+	a.modified++
+	if a.modifyErr != nil {
+		return order.Modify{}, a.modifyErr
+	}
+	return order.Modify{ID: m.ID, Price: m.Price, Amount: m.Amount}, nil
+}
+
+func (a *amendExchange) CancelOrder(context.Context, *order.Cancel) error {
+	a.cancelled++
+	return nil
+}
+
+func (a *amendExchange) GetOrderInfo(_ context.Context, id string, _ currency.Pair, _ asset.Item) (order.Detail, error) {
+	if a.infoErr != nil {
+		return order.Detail{}, a.infoErr
+	}
+	return order.Detail{ID: id, ExecutedAmount: a.executed}, nil
+}
+
+func (a *amendExchange) SubmitOrder(_ context.Context, s *order.Submit) (order.SubmitResponse, error) {
+	a.submitted = s
+	if a.submitErr != nil {
+		return order.SubmitResponse{}, a.submitErr
+	}
+	return order.SubmitResponse{IsOrderPlaced: true, OrderID: "replacement"}, nil
+}
+
+func TestModifyOrder(t *testing.T) {
+	t.Parallel()
+	current := &order.Detail{
+		Exchange:       "test",
+		ID:             "1337",
+		Price:          10,
+		Amount:         5,
+		ExecutedAmount: 1,
+		Type:           order.Limit,
+		Side:           order.Buy,
+		AssetType:      asset.Spot,
+		Pair:           currency.NewPair(currency.BTC, currency.USD),
+	}
+	mod := func(price, amount float64) *order.Modify {
+		return &order.Modify{
+			Exchange:  "test",
+			ID:        "1337",
+			Price:     price,
+			Amount:    amount,
+			AssetType: asset.Spot,
+			Pair:      currency.NewPair(currency.BTC, currency.USD),
+		}
+	}
+
+	e := &amendExchange{base: &Base{}, executed: 1}
+	_, err := ModifyOrder(context.Background(), e, nil, mod(11, 0), nil)
+	if !errors.Is(err, errNilOrderDetail) {
+		t.Errorf("received '%v' expected '%v'", err, errNilOrderDetail)
+	}
+	_, err = ModifyOrder(context.Background(), e, current, nil, nil)
+	if !errors.Is(err, order.ErrModifyOrderIsNil) {
+		t.Errorf("received '%v' expected '%v'", err, order.ErrModifyOrderIsNil)
+	}
+
+	e.base.Features.Supports.OrderAmendment = order.AmendCapabilities{
+		Price:                  true,
+		Amount:                 true,
+		PreservesQueuePriority: true,
+	}
+	resp, err := ModifyOrder(context.Background(), e, current, mod(0, 4), nil)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if resp.ID != "1337" || resp.CancelReplaced || !resp.QueuePriorityPreserved {
+		t.Errorf("received '%+v' expected amendment in place keeping queue priority", resp)
+	}
+	resp, err = ModifyOrder(context.Background(), e, current, mod(11, 0), nil)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if resp.CancelReplaced || resp.QueuePriorityPreserved {
+		t.Errorf("received '%+v' expected amendment in place losing queue priority", resp)
+	}
+	resp, err = ModifyOrder(context.Background(), e, current, mod(10, 5), nil)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if resp.CancelReplaced || e.modified != 3 {
+		t.Errorf("received '%+v' expected unchanged price and amount to be passed to the exchange", resp)
+	}
+
+	e.modifyErr = errors.New("rejected")
+	_, err = ModifyOrder(context.Background(), e, current, mod(11, 0), nil)
+	if !errors.Is(err, e.modifyErr) || e.cancelled != 0 {
+		t.Errorf("received '%v' expected '%v' without the order being cancelled", err, e.modifyErr)
+	}
+
+	e.modifyErr = common.ErrFunctionNotSupported
+	resp, err = ModifyOrder(context.Background(), e, current, mod(11, 0), nil)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !resp.CancelReplaced || resp.ID != "replacement" || e.cancelled != 1 {
+		t.Errorf("received '%+v' expected cancel-replace", resp)
+	}
+
+	e.base.Features.Supports.OrderAmendment = order.AmendCapabilities{Price: true}
+	e.modifyErr = nil
+	resp, err = ModifyOrder(context.Background(), e, current, mod(11, 3), nil)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !resp.CancelReplaced || resp.QueuePriorityPreserved || e.modified != 5 {
+		t.Errorf("received '%+v' expected cancel-replace without a native amendment", resp)
+	}
+	if e.submitted.Price != 11 || e.submitted.Amount != 2 || e.submitted.Side != order.Buy || e.submitted.Type != order.Limit {
+		t.Errorf("received '%+v' expected replacement for the remaining amount", e.submitted)
+	}
+	if resp.Amount != 3 || resp.RemainingAmount != 2 {
+		t.Errorf("received '%+v' expected amount and remaining amount", resp)
+	}
+
+	_, err = ModifyOrder(context.Background(), e, current, mod(0, 1), nil)
+	if !errors.Is(err, errAmountBelowExecuted) {
+		t.Errorf("received '%v' expected '%v'", err, errAmountBelowExecuted)
+	}
+
+	e.submitErr = errors.New("rejected")
+	_, err = ModifyOrder(context.Background(), e, current, mod(12, 4), nil)
+	if !errors.Is(err, ErrOrderReplacementFailed) {
+		t.Errorf("received '%v' expected '%v'", err, ErrOrderReplacementFailed)
+	}
+}
+
+func TestCancelReplaceOrder(t *testing.T) {
+	t.Parallel()
+	current := &order.Detail{
+		PostOnly:       true,
+		HiddenOrder:    true,
+		Exchange:       "test",
+		ID:             "1337",
+		Price:          10,
+		Amount:         5,
+		ExecutedAmount: 1,
+		Type:           order.Limit,
+		Side:           order.Buy,
+		AssetType:      asset.Spot,
+		Pair:           currency.NewPair(currency.BTC, currency.USD),
+	}
+	m := &order.Modify{
+		Exchange:  "test",
+		ID:        "1337",
+		Price:     11,
+		AssetType: asset.Spot,
+		Pair:      currency.NewPair(currency.BTC, currency.USD),
+	}
+	e := &amendExchange{base: &Base{}, executed: 2}
+	var submitted *order.Submit
+	submit := func(_ context.Context, s *order.Submit) (order.SubmitResponse, error) {
+		submitted = s
+		return order.SubmitResponse{IsOrderPlaced: true, OrderID: "managed"}, nil
+	}
+	resp, err := ModifyOrder(context.Background(), e, current, m, submit)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if e.submitted != nil || submitted == nil || resp.ID != "managed" {
+		t.Fatalf("received '%+v' expected replacement through the submitter", resp)
+	}
+	if submitted.Amount != 3 || resp.ExecutedAmount != 2 || resp.RemainingAmount != 3 {
+		t.Errorf("received '%+v' expected the fetched executed amount to be used", resp)
+	}
+	if !resp.PostOnly || !resp.HiddenOrder || resp.ImmediateOrCancel {
+		t.Errorf("received '%+v' expected order flags to be kept", resp)
+	}
+
+	e.executed = 5
+	_, err = ModifyOrder(context.Background(), e, current, m, submit)
+	if !errors.Is(err, ErrOrderReplacementFailed) || submitted.Amount != 3 {
+		t.Errorf("received '%v' expected '%v' without a replacement", err, ErrOrderReplacementFailed)
+	}
+
+	e.infoErr = errors.New("unavailable")
+	_, err = ModifyOrder(context.Background(), e, current, m, submit)
+	if !errors.Is(err, ErrOrderReplacementFailed) {
+		t.Errorf("received '%v' expected '%v'", err, ErrOrderReplacementFailed)
+	}
+
+	e.infoErr = common.ErrFunctionNotSupported
+	resp, err = ModifyOrder(context.Background(), e, current, m, submit)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if resp.ExecutedAmount != 1 || submitted.Amount != 4 {
+		t.Errorf("received '%+v' expected the cached executed amount to be used", resp)
+	}
+}
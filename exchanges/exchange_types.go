@@ -1,6 +1,7 @@
 package exchange
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -171,6 +172,7 @@ type FeaturesSupported struct {
 	WebsocketCapabilities protocol.Features
 	WithdrawPermissions   uint32
 	Kline                 kline.ExchangeCapabilitiesSupported
+	OrderAmendment        order.AmendCapabilities
 }
 
 // Endpoints stores running url endpoints for exchanges
@@ -237,6 +239,9 @@ type Base struct {
 
 	AssetWebsocketSupport
 	*currencystate.States
+
+	systemStatusMtx sync.Mutex
+	systemStatuses  map[asset.Item]*cachedSystemStatus
 }
 
 // url lookup consts
@@ -295,3 +300,59 @@ type AssetWebsocketSupport struct {
 	unsupported map[asset.Item]bool
 	m           sync.RWMutex
 }
+
+// SystemStatus is an exchange's platform status, mapped from the exchange's
+// own status or maintenance API
+type SystemStatus uint8
+
+// System statuses returned by GetSystemStatus
+const (
+	// SystemStatusUnknown is returned when the status could not be
+	// determined, activity is not restricted
+	SystemStatusUnknown SystemStatus = iota
+	// SystemStatusOperational is a platform which is fully available
+	SystemStatusOperational
+	// SystemStatusMaintenance is a platform which is unavailable
+	SystemStatusMaintenance
+	// SystemStatusCancelOnly is a platform which only accepts order
+	// cancellations
+	SystemStatusCancelOnly
+	// SystemStatusPostOnly is a platform which only accepts orders which add
+	// liquidity and order cancellations
+	SystemStatusPostOnly
+)
+
+// SystemStatusResponse holds an exchange's platform status. MaintenanceStart
+// and MaintenanceEnd are set when the exchange announces a maintenance window
+type SystemStatusResponse struct {
+	Status           SystemStatus
+	Message          string
+	MaintenanceStart time.Time
+	MaintenanceEnd   time.Time
+	LastUpdated      time.Time
+}
+
+// MarginMode defines how collateral is shared between an account's
+// derivative positions
+type MarginMode string
+
+// Margin modes
+const (
+	MarginModeUnknown MarginMode = ""
+	// MarginModeCross shares the account's collateral between positions
+	MarginModeCross MarginMode = "cross"
+	// MarginModeIsolated limits each position's losses to the margin assigned
+	// to it
+	MarginModeIsolated MarginMode = "isolated"
+)
+
+// cachedSystemStatus is a system status returned by CheckSystemStatus
+type cachedSystemStatus struct {
+	status  SystemStatus
+	err     error
+	checked time.Time
+}
+
+// OrderSubmitter submits an order, used by ModifyOrder to place the
+// replacement of a cancel-replaced order
+type OrderSubmitter func(ctx context.Context, s *order.Submit) (order.SubmitResponse, error)
@@ -252,6 +252,74 @@ func TestGetPositions(t *testing.T) {
 	}
 }
 
+func TestGetDerivativePositions(t *testing.T) {
+	t.Parallel()
+	_, err := f.GetDerivativePositions(context.Background(), asset.Spot)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v' expected '%v'", err, asset.ErrNotSupported)
+	}
+	if !areTestAPIKeysSet() {
+		t.Skip("API keys required but not set, skipping test")
+	}
+	_, err = f.GetDerivativePositions(context.Background(), asset.Futures)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSetLeverage(t *testing.T) {
+	t.Parallel()
+	err := f.SetLeverage(context.Background(), asset.Spot, currency.Pair{}, 2)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v' expected '%v'", err, asset.ErrNotSupported)
+	}
+	err = f.SetLeverage(context.Background(), asset.Futures, currency.Pair{}, 0)
+	if !errors.Is(err, exchange.ErrInvalidLeverage) {
+		t.Errorf("received '%v' expected '%v'", err, exchange.ErrInvalidLeverage)
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("skipping test, either api keys or canManipulateRealOrders isnt set")
+	}
+	err = f.SetLeverage(context.Background(), asset.Futures, currency.Pair{}, 5)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetLeverage(t *testing.T) {
+	t.Parallel()
+	_, err := f.GetLeverage(context.Background(), asset.Spot, currency.Pair{})
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v' expected '%v'", err, asset.ErrNotSupported)
+	}
+	if !areTestAPIKeysSet() {
+		t.Skip("API keys required but not set, skipping test")
+	}
+	_, err = f.GetLeverage(context.Background(), asset.Futures, currency.Pair{})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMarginMode(t *testing.T) {
+	t.Parallel()
+	err := f.SetMarginMode(context.Background(), asset.Futures, currency.Pair{}, exchange.MarginModeIsolated)
+	if !errors.Is(err, exchange.ErrInvalidMarginMode) {
+		t.Errorf("received '%v' expected '%v'", err, exchange.ErrInvalidMarginMode)
+	}
+	err = f.SetMarginMode(context.Background(), asset.Futures, currency.Pair{}, exchange.MarginModeCross)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	mode, err := f.GetMarginMode(context.Background(), asset.Futures, currency.Pair{})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if mode != exchange.MarginModeCross {
+		t.Errorf("received '%v' expected '%v'", mode, exchange.MarginModeCross)
+	}
+}
+
 func TestGetBalances(t *testing.T) {
 	t.Parallel()
 	if !areTestAPIKeysSet() {
@@ -1046,6 +1114,24 @@ func TestGetOrderHistory(t *testing.T) {
 	}
 }
 
+func TestGetOrderFills(t *testing.T) {
+	t.Parallel()
+	_, err := f.GetOrderFills(context.Background(), &order.GetFillsRequest{AssetType: asset.Spot})
+	if !errors.Is(err, order.ErrPairIsEmpty) {
+		t.Errorf("received '%v' expected '%v'", err, order.ErrPairIsEmpty)
+	}
+	if !areTestAPIKeysSet() {
+		t.Skip("API keys required but not set, skipping test")
+	}
+	_, err = f.GetOrderFills(context.Background(), &order.GetFillsRequest{
+		Pair:      currency.NewPairWithDelimiter(currency.BTC.String(), currency.USDT.String(), "/"),
+		AssetType: asset.Spot,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestUpdateAccountHoldings(t *testing.T) {
 	t.Parallel()
 	if !areTestAPIKeysSet() {
@@ -1202,6 +1288,21 @@ func TestGetFundingHistory(t *testing.T) {
 	}
 }
 
+func TestGetTransferStatus(t *testing.T) {
+	t.Parallel()
+	if !areTestAPIKeysSet() {
+		t.Skip("API keys required but not set, skipping test")
+	}
+	_, err := f.GetWithdrawalStatus(context.Background(), currency.BTC, "1337")
+	if err != nil && !errors.Is(err, exchange.ErrTransferNotFound) {
+		t.Error(err)
+	}
+	_, err = f.GetDepositStatus(context.Background(), currency.BTC, "1337")
+	if err != nil && !errors.Is(err, exchange.ErrTransferNotFound) {
+		t.Error(err)
+	}
+}
+
 func TestGetHistoricCandles(t *testing.T) {
 	t.Parallel()
 	currencyPair, err := currency.NewPairFromString("BTC/USD")
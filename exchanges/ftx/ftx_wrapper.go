@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"sort"
 	"strconv"
 	"strings"
@@ -107,6 +108,7 @@ func (f *FTX) SetDefaults() {
 				CancelOrders:          true,
 				CancelOrder:           true,
 				SubmitOrder:           true,
+				ClientOrderID:         true,
 				TradeFee:              true,
 				FiatDepositFee:        true,
 				FiatWithdrawalFee:     true,
@@ -129,6 +131,10 @@ func (f *FTX) SetDefaults() {
 				DateRanges: true,
 				Intervals:  true,
 			},
+			OrderAmendment: order.AmendCapabilities{
+				Price:  true,
+				Amount: true,
+			},
 		},
 		Enabled: exchange.FeaturesEnabled{
 			AutoPairUpdates: true,
@@ -527,6 +533,64 @@ func (f *FTX) GetWithdrawalsHistory(ctx context.Context, c currency.Code) (resp
 	return nil, common.ErrNotYetImplemented
 }
 
+// GetWithdrawalStatus returns the current state of a withdrawal by its ID or
+// transaction ID
+func (f *FTX) GetWithdrawalStatus(ctx context.Context, c currency.Code, id string) (*exchange.WithdrawalHistory, error) {
+	withdrawalData, err := f.FetchWithdrawalHistory(ctx)
+	if err != nil {
+		return nil, err
+	}
+	history := make([]exchange.WithdrawalHistory, 0, len(withdrawalData))
+	for i := range withdrawalData {
+		if !c.IsEmpty() && !c.Match(currency.NewCode(withdrawalData[i].Coin)) {
+			continue
+		}
+		history = append(history, exchange.WithdrawalHistory{
+			Status:          withdrawalData[i].Status,
+			TransferID:      strconv.FormatInt(withdrawalData[i].ID, 10),
+			Description:     withdrawalData[i].Notes,
+			Timestamp:       withdrawalData[i].Time,
+			Currency:        withdrawalData[i].Coin,
+			Amount:          withdrawalData[i].Size,
+			Fee:             withdrawalData[i].Fee,
+			TransferType:    "withdrawal",
+			CryptoToAddress: withdrawalData[i].Address,
+			CryptoTxID:      withdrawalData[i].TXID,
+			CryptoChain:     withdrawalData[i].Method,
+		})
+	}
+	return exchange.FindWithdrawal(history, id)
+}
+
+// GetDepositStatus returns the current state of a deposit by its ID or
+// transaction ID
+func (f *FTX) GetDepositStatus(ctx context.Context, c currency.Code, id string) (*exchange.FundHistory, error) {
+	depositData, err := f.FetchDepositHistory(ctx)
+	if err != nil {
+		return nil, err
+	}
+	history := make([]exchange.FundHistory, 0, len(depositData))
+	for i := range depositData {
+		if !c.IsEmpty() && !c.Match(currency.NewCode(depositData[i].Coin)) {
+			continue
+		}
+		history = append(history, exchange.FundHistory{
+			ExchangeName:    f.Name,
+			Status:          depositData[i].Status,
+			TransferID:      strconv.FormatInt(depositData[i].ID, 10),
+			Timestamp:       depositData[i].Time,
+			Currency:        depositData[i].Coin,
+			Amount:          depositData[i].Size,
+			Fee:             depositData[i].Fee,
+			TransferType:    "deposit",
+			CryptoToAddress: depositData[i].Address.Address,
+			CryptoTxID:      depositData[i].TxID,
+			CryptoChain:     depositData[i].Address.Method,
+		})
+	}
+	return exchange.FindDeposit(history, id)
+}
+
 // GetRecentTrades returns the most recent trades for a currency and asset
 func (f *FTX) GetRecentTrades(ctx context.Context, p currency.Pair, assetType asset.Item) ([]trade.Data, error) {
 	return f.GetHistoricTrades(ctx, p, assetType, time.Now().Add(-time.Minute*15), time.Now())
@@ -1090,6 +1154,150 @@ func (f *FTX) GetOrderHistory(ctx context.Context, getOrdersRequest *order.GetOr
 	return resp, nil
 }
 
+// GetOrderFills returns the individual fills of an order or pair
+func (f *FTX) GetOrderFills(ctx context.Context, req *order.GetFillsRequest) ([]order.Fill, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	var market string
+	if !req.Pair.IsEmpty() {
+		formattedPair, err := f.FormatExchangeCurrency(req.Pair, req.AssetType)
+		if err != nil {
+			return nil, err
+		}
+		market = formattedPair.String()
+	}
+	resp, err := f.GetFills(ctx, market, "", req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	fills := make([]order.Fill, 0, len(resp))
+	for i := range resp {
+		orderID := strconv.FormatInt(resp[i].OrderID, 10)
+		if req.OrderID != "" && req.OrderID != orderID {
+			continue
+		}
+		var p currency.Pair
+		p, err = currency.NewPairFromString(resp[i].Market)
+		if err != nil {
+			return nil, err
+		}
+		var side order.Side
+		side, err = order.StringToOrderSide(resp[i].Side)
+		if err != nil {
+			return nil, err
+		}
+		fills = append(fills, order.Fill{
+			Exchange:  f.Name,
+			OrderID:   orderID,
+			TradeID:   strconv.FormatInt(resp[i].TradeID, 10),
+			Pair:      p,
+			AssetType: req.AssetType,
+			Side:      side,
+			Price:     resp[i].Price,
+			Amount:    resp[i].Size,
+			Fee:       resp[i].Fee,
+			FeeAsset:  resp[i].FeeCurrency,
+			IsMaker:   resp[i].Liquidity == "maker",
+			Timestamp: resp[i].Time,
+		})
+	}
+	order.FilterFillsByTimeRange(&fills, req.StartTime, req.EndTime)
+	return fills, nil
+}
+
+// GetDerivativePositions returns the open futures positions held on the
+// account
+func (f *FTX) GetDerivativePositions(ctx context.Context, a asset.Item) ([]order.Position, error) {
+	if a != asset.Futures {
+		return nil, fmt.Errorf("%s %w", a, asset.ErrNotSupported)
+	}
+	resp, err := f.GetPositions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	positions := make([]order.Position, 0, len(resp))
+	for i := range resp {
+		if resp[i].Size == 0 {
+			continue
+		}
+		var p currency.Pair
+		p, err = currency.NewPairFromString(resp[i].Future)
+		if err != nil {
+			return nil, err
+		}
+		side := order.Long
+		if strings.EqualFold(resp[i].Side, order.Sell.String()) {
+			side = order.Short
+		}
+		var leverage float64
+		if resp[i].InitialMarginRequirement > 0 {
+			leverage = 1 / resp[i].InitialMarginRequirement
+		}
+		positions = append(positions, order.Position{
+			Exchange:          f.Name,
+			AssetType:         a,
+			Pair:              p,
+			Side:              side,
+			Amount:            resp[i].Size,
+			EntryPrice:        resp[i].EntryPrice,
+			LiquidationPrice:  resp[i].EstimatedLiquidationPrice,
+			Leverage:          leverage,
+			Margin:            resp[i].CollateralUsed,
+			MaintenanceMargin: math.Abs(resp[i].Cost) * resp[i].MaintenanceMarginRequirement,
+			UnrealisedPnL:     resp[i].UnrealizedPnL,
+			RealisedPnL:       resp[i].RealizedPnL,
+			LastUpdated:       time.Now(),
+		})
+	}
+	return positions, nil
+}
+
+// SetLeverage sets the account's futures leverage, FTX applies leverage to the
+// whole account rather than to each pair
+func (f *FTX) SetLeverage(ctx context.Context, a asset.Item, _ currency.Pair, leverage float64) error {
+	if a != asset.Futures {
+		return fmt.Errorf("%s %w", a, asset.ErrNotSupported)
+	}
+	if leverage <= 0 {
+		return fmt.Errorf("%w, received %v", exchange.ErrInvalidLeverage, leverage)
+	}
+	return f.ChangeAccountLeverage(ctx, leverage)
+}
+
+// GetLeverage returns the account's futures leverage
+func (f *FTX) GetLeverage(ctx context.Context, a asset.Item, _ currency.Pair) (float64, error) {
+	if a != asset.Futures {
+		return 0, fmt.Errorf("%s %w", a, asset.ErrNotSupported)
+	}
+	info, err := f.GetAccountInfo(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return info.Leverage, nil
+}
+
+// SetMarginMode validates the margin mode of a futures pair, FTX only supports
+// cross margin
+func (f *FTX) SetMarginMode(_ context.Context, a asset.Item, _ currency.Pair, mode exchange.MarginMode) error {
+	if a != asset.Futures {
+		return fmt.Errorf("%s %w", a, asset.ErrNotSupported)
+	}
+	if mode != exchange.MarginModeCross {
+		return fmt.Errorf("%w %s, only cross margin is supported", exchange.ErrInvalidMarginMode, mode)
+	}
+	return nil
+}
+
+// GetMarginMode returns the margin mode of a futures pair, FTX only supports
+// cross margin
+func (f *FTX) GetMarginMode(_ context.Context, a asset.Item, _ currency.Pair) (exchange.MarginMode, error) {
+	if a != asset.Futures {
+		return exchange.MarginModeUnknown, fmt.Errorf("%s %w", a, asset.ErrNotSupported)
+	}
+	return exchange.MarginModeCross, nil
+}
+
 // GetFeeByType returns an estimate of fee based on the type of transaction
 func (f *FTX) GetFeeByType(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	return f.GetFee(ctx, feeBuilder)
@@ -10,7 +10,6 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
@@ -65,6 +64,7 @@ const (
 	huobiStatusError                 = "error"
 	huobiMarginRates                 = "/margin/loan-info"
 	huobiCurrenciesReference         = "/v2/reference/currencies"
+	huobiMarketStatus                = "/v2/market-status"
 )
 
 // HUOBI is the overarching type across this package
@@ -307,6 +307,19 @@ func (h *HUOBI) GetCurrencies(ctx context.Context) ([]string, error) {
 	return result.Currencies, err
 }
 
+// GetMarketStatus returns the status of the spot market along with any halt
+// in progress
+func (h *HUOBI) GetMarketStatus(ctx context.Context) (*MarketStatus, error) {
+	resp := struct {
+		Data MarketStatus `json:"data"`
+	}{}
+	err := h.SendHTTPRequest(ctx, exchange.RestSpot, huobiMarketStatus, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
 // GetCurrenciesIncludingChains returns currency and chain data
 func (h *HUOBI) GetCurrenciesIncludingChains(ctx context.Context, curr currency.Code) ([]CurrenciesChainData, error) {
 	resp := struct {
@@ -875,7 +888,7 @@ func (h *HUOBI) SendAuthenticatedHTTPRequest(ctx context.Context, ep exchange.UR
 		values.Set("AccessKeyId", h.API.Credentials.Key)
 		values.Set("SignatureMethod", "HmacSHA256")
 		values.Set("SignatureVersion", "2")
-		values.Set("Timestamp", time.Now().UTC().Format("2006-01-02T15:04:05"))
+		values.Set("Timestamp", h.Requester.Now().UTC().Format("2006-01-02T15:04:05"))
 
 		if isVersion2API {
 			endpoint = "/v" + huobiAPIVersion2 + endpoint
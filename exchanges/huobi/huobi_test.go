@@ -2,6 +2,7 @@ package huobi
 
 import (
 	"context"
+	"errors"
 	"log"
 	"os"
 	"strconv"
@@ -788,6 +789,27 @@ func TestUpdateAccountInfo(t *testing.T) {
 	}
 }
 
+func TestGetOrderFills(t *testing.T) {
+	t.Parallel()
+	_, err := h.GetOrderFills(context.Background(), &order.GetFillsRequest{
+		OrderID:   "1337",
+		AssetType: asset.CoinMarginedFutures,
+	})
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v' expected '%v'", err, asset.ErrNotSupported)
+	}
+	if !areTestAPIKeysSet() {
+		t.Skip("skipping test: api keys not set")
+	}
+	_, err = h.GetOrderFills(context.Background(), &order.GetFillsRequest{
+		Pair:      currency.NewPair(currency.BTC, currency.USDT),
+		AssetType: asset.Spot,
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestGetOrderHistory(t *testing.T) {
 	t.Parallel()
 	if !areTestAPIKeysSet() {
@@ -1742,6 +1764,32 @@ func TestGetTimestamp(t *testing.T) {
 	}
 }
 
+func TestGetServerTime(t *testing.T) {
+	t.Parallel()
+	st, err := h.GetServerTime(context.Background(), asset.Spot)
+	if err != nil {
+		t.Error(err)
+	}
+	if err == nil && st.IsZero() {
+		t.Error("expected a server time")
+	}
+}
+
+func TestGetSystemStatus(t *testing.T) {
+	t.Parallel()
+	s, err := h.GetSystemStatus(context.Background(), asset.Spot)
+	if err != nil {
+		t.Error(err)
+	}
+	if err == nil && s.Status == exchange.SystemStatusUnknown {
+		t.Error("expected a system status")
+	}
+	_, err = h.GetSystemStatus(context.Background(), asset.Futures)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v' expected '%v'", err, asset.ErrNotSupported)
+	}
+}
+
 func TestGetAccounts(t *testing.T) {
 	t.Parallel()
 	if !h.ValidateAPICredentials() || !canManipulateRealOrders {
@@ -466,6 +466,29 @@ type MarginRatesData struct {
 	} `json:"data"`
 }
 
+// Market statuses returned by GetMarketStatus
+const (
+	marketStatusNormal     = 1
+	marketStatusHalted     = 2
+	marketStatusCancelOnly = 3
+)
+
+// Market halt reasons returned by GetMarketStatus
+const (
+	haltReasonEmergencyMaintenance = 2
+	haltReasonScheduledMaintenance = 3
+)
+
+// MarketStatus stores the status of the spot market. The halt fields are
+// only set while the market is halted or cancel only
+type MarketStatus struct {
+	MarketStatus    int64  `json:"marketStatus"`
+	HaltStartTime   int64  `json:"haltStartTime"`
+	HaltEndTime     int64  `json:"haltEndTime"`
+	HaltReason      int64  `json:"haltReason"`
+	AffectedSymbols string `json:"affectedSymbols"`
+}
+
 // ResponseV2 stores the Huobi generic response info
 type ResponseV2 struct {
 	Code    int32  `json:"code"`
@@ -684,6 +707,8 @@ type OrderMatchInfo struct {
 	Price        string `json:"price"`
 	FilledAmount string `json:"filled-amount"`
 	FilledFees   string `json:"filled-fees"`
+	FeeCurrency  string `json:"fee-currency"`
+	Role         string `json:"role"`
 	CreatedAt    int64  `json:"created-at"`
 }
 
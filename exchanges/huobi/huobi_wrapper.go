@@ -160,7 +160,10 @@ func (h *HUOBI) SetDefaults() {
 
 	h.Requester = request.New(h.Name,
 		common.NewHTTPClientWithTimeout(exchange.DefaultHTTPTimeout),
-		request.WithLimiter(SetRateLimit()))
+		request.WithLimiter(SetRateLimit()),
+		request.WithServerTime(func(ctx context.Context) (time.Time, error) {
+			return h.GetServerTime(ctx, asset.Spot)
+		}))
 	h.API.Endpoints = h.NewEndpoints()
 	err = h.API.Endpoints.SetDefaultEndpoints(map[exchange.URL]string{
 		exchange.RestSpot:         huobiAPIURL,
@@ -1674,6 +1677,85 @@ func setOrderSideStatusAndType(orderState, requestType string, orderDetail *orde
 	}
 }
 
+// GetOrderFills returns the individual fills of an order or pair
+func (h *HUOBI) GetOrderFills(ctx context.Context, req *order.GetFillsRequest) ([]order.Fill, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if req.AssetType != asset.Spot {
+		return nil, fmt.Errorf("%s %w", req.AssetType, asset.ErrNotSupported)
+	}
+	var resp []OrderMatchInfo
+	if req.OrderID != "" {
+		orderID, err := strconv.ParseInt(req.OrderID, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		resp, err = h.GetOrderMatchResults(ctx, orderID)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		var err error
+		resp, err = h.GetOrdersMatch(ctx, req.Pair, "", "", "", "", "", "")
+		if err != nil {
+			return nil, err
+		}
+	}
+	avail, err := h.GetAvailablePairs(asset.Spot)
+	if err != nil {
+		return nil, err
+	}
+	format, err := h.GetPairFormat(asset.Spot, true)
+	if err != nil {
+		return nil, err
+	}
+	fills := make([]order.Fill, 0, len(resp))
+	for i := range resp {
+		p := req.Pair
+		if p.IsEmpty() {
+			p, err = currency.NewPairFromFormattedPairs(resp[i].Symbol, avail, format)
+			if err != nil {
+				return nil, err
+			}
+		}
+		// order types are prefixed with the side e.g. buy-limit
+		side, err := order.StringToOrderSide(strings.Split(resp[i].Type, "-")[0])
+		if err != nil {
+			return nil, err
+		}
+		var price, amount, fee float64
+		price, err = strconv.ParseFloat(resp[i].Price, 64)
+		if err != nil {
+			return nil, err
+		}
+		amount, err = strconv.ParseFloat(resp[i].FilledAmount, 64)
+		if err != nil {
+			return nil, err
+		}
+		fee, err = strconv.ParseFloat(resp[i].FilledFees, 64)
+		if err != nil {
+			return nil, err
+		}
+		fills = append(fills, order.Fill{
+			Exchange:  h.Name,
+			OrderID:   strconv.Itoa(resp[i].OrderID),
+			TradeID:   strconv.Itoa(resp[i].MatchID),
+			Pair:      p,
+			AssetType: asset.Spot,
+			Side:      side,
+			Price:     price,
+			Amount:    amount,
+			Fee:       fee,
+			FeeAsset:  strings.ToUpper(resp[i].FeeCurrency),
+			IsMaker:   resp[i].Role == "maker",
+			Timestamp: time.UnixMilli(resp[i].CreatedAt),
+		})
+	}
+	order.FilterFillsByTimeRange(&fills, req.StartTime, req.EndTime)
+	return fills, nil
+}
+
 // AuthenticateWebsocket sends an authentication message to the websocket
 func (h *HUOBI) AuthenticateWebsocket(_ context.Context) error {
 	return h.wsLogin()
@@ -1705,6 +1787,54 @@ func (h *HUOBI) FormatExchangeKlineInterval(in kline.Interval) string {
 	return ""
 }
 
+// GetServerTime returns the current exchange server time.
+func (h *HUOBI) GetServerTime(ctx context.Context, _ asset.Item) (time.Time, error) {
+	ts, err := h.GetTimestamp(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.UnixMilli(ts), nil
+}
+
+// GetSystemStatus returns the exchange's platform status
+func (h *HUOBI) GetSystemStatus(ctx context.Context, a asset.Item) (*exchange.SystemStatusResponse, error) {
+	if a != asset.Spot {
+		return nil, fmt.Errorf("%s %w", a, asset.ErrNotSupported)
+	}
+	resp, err := h.GetMarketStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s := &exchange.SystemStatusResponse{
+		Status:      exchange.SystemStatusUnknown,
+		LastUpdated: time.Now(),
+	}
+	switch resp.MarketStatus {
+	case marketStatusNormal:
+		s.Status = exchange.SystemStatusOperational
+	case marketStatusHalted:
+		s.Status = exchange.SystemStatusMaintenance
+		if resp.HaltStartTime > 0 {
+			s.MaintenanceStart = time.UnixMilli(resp.HaltStartTime)
+		}
+		if resp.HaltEndTime > 0 {
+			s.MaintenanceEnd = time.UnixMilli(resp.HaltEndTime)
+		}
+	case marketStatusCancelOnly:
+		s.Status = exchange.SystemStatusCancelOnly
+	}
+	switch resp.HaltReason {
+	case haltReasonEmergencyMaintenance:
+		s.Message = "emergency maintenance"
+	case haltReasonScheduledMaintenance:
+		s.Message = "scheduled maintenance"
+	}
+	if resp.AffectedSymbols != "" {
+		s.Message = strings.TrimSpace(s.Message + " affecting " + resp.AffectedSymbols)
+	}
+	return s, nil
+}
+
 // GetHistoricCandles returns candles between a time period for a set time interval
 func (h *HUOBI) GetHistoricCandles(ctx context.Context, pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
 	if err := h.ValidateKline(pair, a, interval); err != nil {
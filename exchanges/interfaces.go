@@ -63,7 +63,15 @@ type IBotExchange interface {
 	GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, accountID, chain string) (*deposit.Address, error)
 	GetAvailableTransferChains(ctx context.Context, cryptocurrency currency.Code) ([]string, error)
 	GetOrderHistory(ctx context.Context, getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error)
+	GetOrderFills(ctx context.Context, getFillsRequest *order.GetFillsRequest) ([]order.Fill, error)
+	GetDerivativePositions(ctx context.Context, a asset.Item) ([]order.Position, error)
+	SetLeverage(ctx context.Context, a asset.Item, cp currency.Pair, leverage float64) error
+	GetLeverage(ctx context.Context, a asset.Item, cp currency.Pair) (float64, error)
+	SetMarginMode(ctx context.Context, a asset.Item, cp currency.Pair, mode MarginMode) error
+	GetMarginMode(ctx context.Context, a asset.Item, cp currency.Pair) (MarginMode, error)
 	GetWithdrawalsHistory(ctx context.Context, code currency.Code) ([]WithdrawalHistory, error)
+	GetWithdrawalStatus(ctx context.Context, code currency.Code, id string) (*WithdrawalHistory, error)
+	GetDepositStatus(ctx context.Context, code currency.Code, id string) (*FundHistory, error)
 	GetActiveOrders(ctx context.Context, getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error)
 	WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error)
 	WithdrawFiatFunds(ctx context.Context, withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error)
@@ -80,6 +88,8 @@ type IBotExchange interface {
 	GetHistoricCandlesExtended(ctx context.Context, p currency.Pair, a asset.Item, timeStart, timeEnd time.Time, interval kline.Interval) (kline.Item, error)
 	DisableRateLimiter() error
 	EnableRateLimiter() error
+	GetServerTime(ctx context.Context, a asset.Item) (time.Time, error)
+	GetSystemStatus(ctx context.Context, a asset.Item) (*SystemStatusResponse, error)
 
 	GetWebsocket() (*stream.Websocket, error)
 	IsWebsocketEnabled() bool
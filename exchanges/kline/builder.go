@@ -0,0 +1,181 @@
+package kline
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+var (
+	errBuilderTradeOutOfOrder = errors.New("trade timestamp is before the current candle")
+	errBuilderInvalidTrade    = errors.New("trade price, amount or timestamp is unset")
+	errBuilderNil             = errors.New("candle builder is nil")
+	errExchangeNameUnset      = errors.New("exchange name unset")
+	errCurrencyPairUnset      = errors.New("currency pair unset")
+)
+
+// Builder constructs candles for an arbitrary interval from a stream of
+// trades so that live strategies are not limited to exchange pushed kline
+// channels
+type Builder struct {
+	exchange string
+	pair     currency.Pair
+	asset    asset.Item
+	interval Interval
+
+	current    Candle
+	hasCurrent bool
+	m          sync.Mutex
+}
+
+// CandleUpdate defines an in-progress or closed candle emitted by a Builder
+type CandleUpdate struct {
+	Exchange string
+	Pair     currency.Pair
+	Asset    asset.Item
+	Interval Interval
+	Candle   Candle
+	Closed   bool
+}
+
+// NewBuilder returns a candle builder for the supplied exchange, pair, asset
+// and interval
+func NewBuilder(exchange string, p currency.Pair, a asset.Item, interval Interval) (*Builder, error) {
+	if exchange == "" {
+		return nil, errExchangeNameUnset
+	}
+	if p.IsEmpty() {
+		return nil, errCurrencyPairUnset
+	}
+	if !a.IsValid() {
+		return nil, fmt.Errorf("%v %w", a, asset.ErrNotSupported)
+	}
+	if interval <= 0 {
+		return nil, ErrUnsetInterval
+	}
+	return &Builder{
+		exchange: exchange,
+		pair:     p,
+		asset:    a,
+		interval: interval,
+	}, nil
+}
+
+// Matches returns whether the builder is responsible for the supplied
+// exchange, pair and asset
+func (b *Builder) Matches(exchange string, p currency.Pair, a asset.Item) bool {
+	return b != nil &&
+		strings.EqualFold(b.exchange, exchange) &&
+		b.pair.Equal(p) &&
+		b.asset == a
+}
+
+// Process applies a trade to the builder. It returns any candles closed by the
+// trade, including empty candles for intervals which had no trades, followed
+// by the current in-progress candle
+func (b *Builder) Process(price, amount float64, tt time.Time) ([]CandleUpdate, error) {
+	if b == nil {
+		return nil, errBuilderNil
+	}
+	if price <= 0 || amount == 0 || tt.IsZero() {
+		return nil, errBuilderInvalidTrade
+	}
+	if amount < 0 {
+		amount *= -1
+	}
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	start := tt.Truncate(b.interval.Duration()).UTC()
+	var resp []CandleUpdate
+	switch {
+	case !b.hasCurrent:
+		b.current = Candle{Time: start, Open: price, High: price, Low: price}
+		b.hasCurrent = true
+	case start.Before(b.current.Time):
+		return nil, fmt.Errorf("%w %v %v", errBuilderTradeOutOfOrder, tt, b.current.Time)
+	case start.After(b.current.Time):
+		resp = b.closeUntil(start)
+		b.current = Candle{Time: start, Open: price, High: price, Low: price}
+	}
+	if price > b.current.High {
+		b.current.High = price
+	}
+	if price < b.current.Low {
+		b.current.Low = price
+	}
+	b.current.Close = price
+	b.current.Volume += amount
+	return append(resp, b.update(b.current, false)), nil
+}
+
+// Flush closes the current candle and any empty candles up until the interval
+// containing the supplied time. This allows candles to be closed when no new
+// trades have been received
+func (b *Builder) Flush(tt time.Time) []CandleUpdate {
+	if b == nil {
+		return nil
+	}
+	b.m.Lock()
+	defer b.m.Unlock()
+	if !b.hasCurrent {
+		return nil
+	}
+	start := tt.Truncate(b.interval.Duration()).UTC()
+	if !start.After(b.current.Time) {
+		return nil
+	}
+	resp := b.closeUntil(start)
+	lastClose := b.current.Close
+	b.current = Candle{
+		Time:  start,
+		Open:  lastClose,
+		High:  lastClose,
+		Low:   lastClose,
+		Close: lastClose,
+	}
+	return resp
+}
+
+// Current returns the in-progress candle
+func (b *Builder) Current() (Candle, bool) {
+	if b == nil {
+		return Candle{}, false
+	}
+	b.m.Lock()
+	defer b.m.Unlock()
+	return b.current, b.hasCurrent
+}
+
+// closeUntil closes the current candle and generates empty candles carrying
+// the last close price for every interval prior to the supplied start time
+func (b *Builder) closeUntil(start time.Time) []CandleUpdate {
+	resp := []CandleUpdate{b.update(b.current, true)}
+	lastClose := b.current.Close
+	for t := b.current.Time.Add(b.interval.Duration()); t.Before(start); t = t.Add(b.interval.Duration()) {
+		resp = append(resp, b.update(Candle{
+			Time:  t,
+			Open:  lastClose,
+			High:  lastClose,
+			Low:   lastClose,
+			Close: lastClose,
+		}, true))
+	}
+	return resp
+}
+
+func (b *Builder) update(c Candle, closed bool) CandleUpdate {
+	return CandleUpdate{
+		Exchange: b.exchange,
+		Pair:     b.pair,
+		Asset:    b.asset,
+		Interval: b.interval,
+		Candle:   c,
+		Closed:   closed,
+	}
+}
@@ -0,0 +1,123 @@
+package kline
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+func TestNewBuilder(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	_, err := NewBuilder("", p, asset.Spot, OneMin)
+	if !errors.Is(err, errExchangeNameUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errExchangeNameUnset)
+	}
+	_, err = NewBuilder("test", currency.Pair{}, asset.Spot, OneMin)
+	if !errors.Is(err, errCurrencyPairUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errCurrencyPairUnset)
+	}
+	_, err = NewBuilder("test", p, asset.Item("bad"), OneMin)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v' expected '%v'", err, asset.ErrNotSupported)
+	}
+	_, err = NewBuilder("test", p, asset.Spot, 0)
+	if !errors.Is(err, ErrUnsetInterval) {
+		t.Errorf("received '%v' expected '%v'", err, ErrUnsetInterval)
+	}
+	b, err := NewBuilder("test", p, asset.Spot, OneMin)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !b.Matches("TEST", p, asset.Spot) {
+		t.Error("expected builder to match")
+	}
+	if b.Matches("test", p, asset.Futures) {
+		t.Error("expected builder not to match")
+	}
+}
+
+func TestBuilderProcess(t *testing.T) {
+	t.Parallel()
+	var b *Builder
+	_, err := b.Process(1, 1, time.Now())
+	if !errors.Is(err, errBuilderNil) {
+		t.Errorf("received '%v' expected '%v'", err, errBuilderNil)
+	}
+	b, err = NewBuilder("test", currency.NewPair(currency.BTC, currency.USDT), asset.Spot, OneMin)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = b.Process(0, 1, time.Now())
+	if !errors.Is(err, errBuilderInvalidTrade) {
+		t.Errorf("received '%v' expected '%v'", err, errBuilderInvalidTrade)
+	}
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	updates, err := b.Process(10, 1, start.Add(time.Second))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(updates) != 1 || updates[0].Closed {
+		t.Fatalf("expected single in-progress update, received %+v", updates)
+	}
+	_, err = b.Process(12, -2, start.Add(time.Second*30))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	c, ok := b.Current()
+	if !ok || c.High != 12 || c.Low != 10 || c.Close != 12 || c.Volume != 3 {
+		t.Errorf("unexpected current candle %+v", c)
+	}
+
+	updates, err = b.Process(11, 1, start.Add(time.Minute*3))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(updates) != 4 {
+		t.Fatalf("received '%v' expected '%v'", len(updates), 4)
+	}
+	if !updates[0].Closed || updates[0].Candle.Close != 12 {
+		t.Errorf("unexpected closed candle %+v", updates[0])
+	}
+	if !updates[1].Closed || updates[1].Candle.Volume != 0 || updates[1].Candle.Open != 12 {
+		t.Errorf("unexpected empty candle %+v", updates[1])
+	}
+	if updates[3].Closed || !updates[3].Candle.Time.Equal(start.Add(time.Minute*3)) {
+		t.Errorf("unexpected in-progress candle %+v", updates[3])
+	}
+
+	_, err = b.Process(11, 1, start)
+	if !errors.Is(err, errBuilderTradeOutOfOrder) {
+		t.Errorf("received '%v' expected '%v'", err, errBuilderTradeOutOfOrder)
+	}
+}
+
+func TestBuilderFlush(t *testing.T) {
+	t.Parallel()
+	b, err := NewBuilder("test", currency.NewPair(currency.BTC, currency.USDT), asset.Spot, OneMin)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	if resp := b.Flush(start); resp != nil {
+		t.Error("expected nil response without trades")
+	}
+	_, err = b.Process(10, 1, start)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if resp := b.Flush(start.Add(time.Second)); resp != nil {
+		t.Error("expected nil response within current interval")
+	}
+	resp := b.Flush(start.Add(time.Minute * 2))
+	if len(resp) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(resp), 2)
+	}
+	c, _ := b.Current()
+	if c.Open != 10 || c.Volume != 0 || !c.Time.Equal(start.Add(time.Minute*2)) {
+		t.Errorf("unexpected current candle %+v", c)
+	}
+}
@@ -0,0 +1,187 @@
+package kline
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// ErrCandleIntegrity returns when candle data fails validation
+var ErrCandleIntegrity = errors.New("candle data failed integrity validation")
+
+// IntegrityIssue is a bitmask of the candle integrity issues which can be
+// detected by Validate and fixed by Repair
+type IntegrityIssue uint8
+
+// Candle integrity issues
+const (
+	// UnorderedCandles are candles with an earlier timestamp than the candle
+	// before them
+	UnorderedCandles IntegrityIssue = 1 << iota
+	// DuplicateCandles are candles which share a timestamp with another
+	// candle
+	DuplicateCandles
+	// MisalignedCandles are candles whose timestamp is not on an interval
+	// boundary. Only intervals which divide evenly into a day are checked
+	MisalignedCandles
+	// InconsistentCandles are candles with a negative value or a high and low
+	// which do not contain the candle's other prices
+	InconsistentCandles
+	// AllIntegrityIssues selects every integrity issue
+	AllIntegrityIssues = UnorderedCandles | DuplicateCandles | MisalignedCandles | InconsistentCandles
+)
+
+// IntegrityReport counts the candles affected by each integrity issue
+type IntegrityReport struct {
+	Unordered    int
+	Duplicates   int
+	Misaligned   int
+	Inconsistent int
+}
+
+// Issues returns the issues found in the report
+func (r *IntegrityReport) Issues() IntegrityIssue {
+	var issues IntegrityIssue
+	if r.Unordered > 0 {
+		issues |= UnorderedCandles
+	}
+	if r.Duplicates > 0 {
+		issues |= DuplicateCandles
+	}
+	if r.Misaligned > 0 {
+		issues |= MisalignedCandles
+	}
+	if r.Inconsistent > 0 {
+		issues |= InconsistentCandles
+	}
+	return issues
+}
+
+// String returns a summary of the issues found in the report
+func (r *IntegrityReport) String() string {
+	var issues []string
+	if r.Unordered > 0 {
+		issues = append(issues, fmt.Sprintf("%d unordered", r.Unordered))
+	}
+	if r.Duplicates > 0 {
+		issues = append(issues, fmt.Sprintf("%d duplicate", r.Duplicates))
+	}
+	if r.Misaligned > 0 {
+		issues = append(issues, fmt.Sprintf("%d misaligned", r.Misaligned))
+	}
+	if r.Inconsistent > 0 {
+		issues = append(issues, fmt.Sprintf("%d inconsistent", r.Inconsistent))
+	}
+	if len(issues) == 0 {
+		return "no issues"
+	}
+	return strings.Join(issues, ", ") + " candles"
+}
+
+// CheckIntegrity counts the candles affected by each integrity issue without
+// modifying the kline item
+func (k *Item) CheckIntegrity() (*IntegrityReport, error) {
+	if k == nil {
+		return nil, errNilKline
+	}
+	resp := &IntegrityReport{}
+	seen := make(map[int64]struct{}, len(k.Candles))
+	checkAlignment := k.isAlignable()
+	for i := range k.Candles {
+		if i > 0 && k.Candles[i].Time.Before(k.Candles[i-1].Time) {
+			resp.Unordered++
+		}
+		if _, ok := seen[k.Candles[i].Time.UnixNano()]; ok {
+			resp.Duplicates++
+		}
+		seen[k.Candles[i].Time.UnixNano()] = struct{}{}
+		if checkAlignment && !k.Candles[i].Time.Truncate(k.Interval.Duration()).Equal(k.Candles[i].Time) {
+			resp.Misaligned++
+		}
+		if !k.Candles[i].isConsistent() {
+			resp.Inconsistent++
+		}
+	}
+	return resp, nil
+}
+
+// Validate ensures candles are in order, unique, aligned to the interval and
+// have consistent prices
+func (k *Item) Validate() error {
+	report, err := k.CheckIntegrity()
+	if err != nil {
+		return err
+	}
+	if report.Issues() != 0 {
+		return fmt.Errorf("%w %v %v %v %v %v",
+			ErrCandleIntegrity,
+			k.Exchange, k.Pair, k.Asset, k.Interval,
+			report)
+	}
+	return nil
+}
+
+// Repair applies the fixes for the selected integrity issues:
+// misaligned candles are truncated to their interval boundary, unordered
+// candles are sorted, duplicate candles are reduced to the last one supplied
+// and inconsistent candles have their high and low widened to contain their
+// other prices, or are removed when they have a negative value. Removing
+// duplicates also sorts candles
+func (k *Item) Repair(fixes IntegrityIssue) error {
+	if k == nil {
+		return errNilKline
+	}
+	if fixes&MisalignedCandles != 0 && k.isAlignable() {
+		for i := range k.Candles {
+			k.Candles[i].Time = k.Candles[i].Time.Truncate(k.Interval.Duration())
+		}
+	}
+	if fixes&(UnorderedCandles|DuplicateCandles) != 0 {
+		sort.SliceStable(k.Candles, func(i, j int) bool {
+			return k.Candles[i].Time.Before(k.Candles[j].Time)
+		})
+	}
+	if fixes&DuplicateCandles != 0 {
+		unique := k.Candles[:0]
+		for i := range k.Candles {
+			if len(unique) > 0 && unique[len(unique)-1].Time.Equal(k.Candles[i].Time) {
+				unique[len(unique)-1] = k.Candles[i]
+				continue
+			}
+			unique = append(unique, k.Candles[i])
+		}
+		k.Candles = unique
+	}
+	if fixes&InconsistentCandles != 0 {
+		consistent := k.Candles[:0]
+		for i := range k.Candles {
+			c := k.Candles[i]
+			if c.Open < 0 || c.High < 0 || c.Low < 0 || c.Close < 0 || c.Volume < 0 {
+				continue
+			}
+			c.High, c.Low = math.Max(math.Max(c.Open, c.Close), math.Max(c.High, c.Low)),
+				math.Min(math.Min(c.Open, c.Close), math.Min(c.High, c.Low))
+			consistent = append(consistent, c)
+		}
+		k.Candles = consistent
+	}
+	return nil
+}
+
+// isAlignable returns whether candle timestamps can be checked against the
+// interval, which is only possible when it divides evenly into a day
+func (k *Item) isAlignable() bool {
+	return k.Interval > 0 && k.Interval <= OneDay && OneDay.Duration()%k.Interval.Duration() == 0
+}
+
+// isConsistent returns whether a candle has no negative values and a high and
+// low which contain its other prices
+func (c *Candle) isConsistent() bool {
+	if c.Open < 0 || c.High < 0 || c.Low < 0 || c.Close < 0 || c.Volume < 0 {
+		return false
+	}
+	return c.High >= c.Open && c.High >= c.Close && c.High >= c.Low &&
+		c.Low <= c.Open && c.Low <= c.Close
+}
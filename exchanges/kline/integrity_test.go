@@ -0,0 +1,107 @@
+package kline
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func integrityTestItem() *Item {
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	return &Item{
+		Interval: OneHour,
+		Candles: []Candle{
+			{Time: start, Open: 1, High: 2, Low: 1, Close: 2, Volume: 1},
+			{Time: start.Add(time.Hour * 2), Open: 2, High: 3, Low: 2, Close: 3, Volume: 1},
+			{Time: start.Add(time.Hour), Open: 2, High: 2, Low: 2, Close: 2, Volume: 1},
+			{Time: start.Add(time.Hour), Open: 2, High: 4, Low: 1, Close: 3, Volume: 2},
+			{Time: start.Add(time.Hour*3 + time.Minute), Open: 3, High: 3, Low: 3, Close: 3, Volume: 1},
+			{Time: start.Add(time.Hour * 4), Open: 3, High: 2, Low: 4, Close: 3, Volume: 1},
+			{Time: start.Add(time.Hour * 5), Open: -1, High: 1, Low: 1, Close: 1, Volume: 1},
+			{Time: start.Add(time.Hour * 6)},
+		},
+	}
+}
+
+func TestCheckIntegrity(t *testing.T) {
+	t.Parallel()
+	var k *Item
+	_, err := k.CheckIntegrity()
+	if !errors.Is(err, errNilKline) {
+		t.Errorf("received '%v' expected '%v'", err, errNilKline)
+	}
+	if err = k.Validate(); !errors.Is(err, errNilKline) {
+		t.Errorf("received '%v' expected '%v'", err, errNilKline)
+	}
+
+	k = integrityTestItem()
+	report, err := k.CheckIntegrity()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	expected := IntegrityReport{Unordered: 1, Duplicates: 1, Misaligned: 1, Inconsistent: 2}
+	if *report != expected {
+		t.Errorf("received '%+v' expected '%+v'", *report, expected)
+	}
+	if report.Issues() != AllIntegrityIssues {
+		t.Errorf("received '%v' expected '%v'", report.Issues(), AllIntegrityIssues)
+	}
+	if err = k.Validate(); !errors.Is(err, ErrCandleIntegrity) {
+		t.Errorf("received '%v' expected '%v'", err, ErrCandleIntegrity)
+	}
+
+	// candles of intervals which do not divide into a day are not checked
+	// for alignment
+	k.Interval = OneWeek
+	report, err = k.CheckIntegrity()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if report.Misaligned != 0 {
+		t.Errorf("received '%v' expected '%v'", report.Misaligned, 0)
+	}
+}
+
+func TestRepair(t *testing.T) {
+	t.Parallel()
+	var k *Item
+	if err := k.Repair(AllIntegrityIssues); !errors.Is(err, errNilKline) {
+		t.Errorf("received '%v' expected '%v'", err, errNilKline)
+	}
+
+	k = integrityTestItem()
+	err := k.Repair(UnorderedCandles | DuplicateCandles)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	report, err := k.CheckIntegrity()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if report.Issues() != MisalignedCandles|InconsistentCandles {
+		t.Errorf("unexpected remaining issues %v", report)
+	}
+	if len(k.Candles) != 7 {
+		t.Fatalf("received '%v' expected '%v'", len(k.Candles), 7)
+	}
+	if k.Candles[1].High != 4 {
+		t.Errorf("expected the last duplicate to be kept, received %+v", k.Candles[1])
+	}
+
+	err = k.Repair(AllIntegrityIssues)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if err = k.Validate(); !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if len(k.Candles) != 6 {
+		t.Fatalf("received '%v' expected '%v'", len(k.Candles), 6)
+	}
+	if !k.Candles[3].Time.Equal(k.Candles[0].Time.Add(time.Hour * 3)) {
+		t.Errorf("expected misaligned candle to be truncated, received %v", k.Candles[3].Time)
+	}
+	if k.Candles[4].High != 4 || k.Candles[4].Low != 2 {
+		t.Errorf("expected high and low to contain prices, received %+v", k.Candles[4])
+	}
+}
@@ -392,6 +392,140 @@ func ConvertToNewInterval(item *Item, newInterval Interval) (*Item, error) {
 	return responseCandle, nil
 }
 
+// Resample scales the kline item candles to any coarser interval which is a
+// whole multiple of the current interval. Candles are bucketed by their start
+// time truncated to the new interval so gaps in the source data do not shift
+// bucket boundaries. When dropIncomplete is set, buckets which do not contain
+// every expected source candle are excluded
+func (k *Item) Resample(newInterval Interval, dropIncomplete bool) (*Item, error) {
+	if k == nil {
+		return nil, errNilKline
+	}
+	if newInterval <= 0 || k.Interval <= 0 {
+		return nil, ErrUnsetInterval
+	}
+	if newInterval.Duration() <= k.Interval.Duration() {
+		return nil, ErrCanOnlyDownscaleCandles
+	}
+	if newInterval.Duration()%k.Interval.Duration() != 0 {
+		return nil, ErrWholeNumberScaling
+	}
+
+	candles := make([]Candle, len(k.Candles))
+	copy(candles, k.Candles)
+	sort.Sort(ByDate(candles))
+
+	expected := int(newInterval / k.Interval)
+	resp := &Item{
+		Exchange: k.Exchange,
+		Pair:     k.Pair,
+		Asset:    k.Asset,
+		Interval: newInterval,
+	}
+	var count int
+	for i := range candles {
+		bucket := candles[i].Time.Truncate(newInterval.Duration())
+		if len(resp.Candles) == 0 || !resp.Candles[len(resp.Candles)-1].Time.Equal(bucket) {
+			if dropIncomplete && len(resp.Candles) > 0 && count != expected {
+				resp.Candles = resp.Candles[:len(resp.Candles)-1]
+			}
+			resp.Candles = append(resp.Candles, Candle{
+				Time:   bucket,
+				Open:   candles[i].Open,
+				High:   candles[i].High,
+				Low:    candles[i].Low,
+				Close:  candles[i].Close,
+				Volume: candles[i].Volume,
+			})
+			count = 1
+			continue
+		}
+		c := &resp.Candles[len(resp.Candles)-1]
+		if candles[i].High > c.High {
+			c.High = candles[i].High
+		}
+		if candles[i].Low < c.Low {
+			c.Low = candles[i].Low
+		}
+		c.Close = candles[i].Close
+		c.Volume += candles[i].Volume
+		count++
+	}
+	if dropIncomplete && len(resp.Candles) > 0 && count != expected {
+		resp.Candles = resp.Candles[:len(resp.Candles)-1]
+	}
+	return resp, nil
+}
+
+// Merge combines the candles of another kline item with matching exchange,
+// pair, asset and interval into this item. Where candle times overlap the
+// incoming candle takes precedence as it is considered the most recent data
+func (k *Item) Merge(incoming *Item) error {
+	if k == nil || incoming == nil {
+		return errNilKline
+	}
+	if !strings.EqualFold(k.Exchange, incoming.Exchange) ||
+		!k.Pair.Equal(incoming.Pair) ||
+		k.Asset != incoming.Asset ||
+		k.Interval != incoming.Interval {
+		return fmt.Errorf("%w %v %v %v %v and %v %v %v %v",
+			errItemMismatch,
+			k.Exchange, k.Pair, k.Asset, k.Interval,
+			incoming.Exchange, incoming.Pair, incoming.Asset, incoming.Interval)
+	}
+	merged := make(map[int64]Candle, len(k.Candles)+len(incoming.Candles))
+	for i := range k.Candles {
+		merged[k.Candles[i].Time.UnixNano()] = k.Candles[i]
+	}
+	for i := range incoming.Candles {
+		merged[incoming.Candles[i].Time.UnixNano()] = incoming.Candles[i]
+	}
+	candles := make([]Candle, 0, len(merged))
+	for _, c := range merged {
+		candles = append(candles, c)
+	}
+	k.Candles = candles
+	k.SortCandlesByTimestamp(false)
+	return nil
+}
+
+// GetMissingIntervals returns the start time of every interval which has no
+// candle between the first and last candle of the kline item
+func (k *Item) GetMissingIntervals() []time.Time {
+	if k == nil || k.Interval <= 0 || len(k.Candles) < 2 {
+		return nil
+	}
+	candles := make([]Candle, len(k.Candles))
+	copy(candles, k.Candles)
+	sort.Sort(ByDate(candles))
+	var missing []time.Time
+	for i := 1; i < len(candles); i++ {
+		for t := candles[i-1].Time.Add(k.Interval.Duration()); t.Before(candles[i].Time); t = t.Add(k.Interval.Duration()) {
+			missing = append(missing, t)
+		}
+	}
+	return missing
+}
+
+// ValidateContinuity ensures there are no gaps between candles in the kline
+// item
+func (k *Item) ValidateContinuity() error {
+	if k == nil {
+		return errNilKline
+	}
+	if k.Interval <= 0 {
+		return ErrUnsetInterval
+	}
+	missing := k.GetMissingIntervals()
+	if len(missing) > 0 {
+		return fmt.Errorf("%w %v %v %v %v missing %d candles starting %v",
+			ErrCandleDataNotContinuous,
+			k.Exchange, k.Pair, k.Asset, k.Interval,
+			len(missing), missing[0])
+	}
+	return nil
+}
+
 // CalculateCandleDateRanges will calculate the expected candle data in intervals in a date range
 // If an API is limited in the amount of candles it can make in a request, it will automatically separate
 // ranges into the limit
@@ -56,6 +56,9 @@ func LoadFromDatabase(exchange string, pair currency.Pair, a asset.Item, interva
 			ValidationIssues: retCandle.Candles[x].ValidationIssues,
 		})
 	}
+	if err = ret.Validate(); err != nil {
+		log.Warnln(log.Global, err)
+	}
 	return ret, nil
 }
 
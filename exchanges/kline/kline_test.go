@@ -981,3 +981,131 @@ func TestConvertToNewInterval(t *testing.T) {
 		t.Error("expected one candle")
 	}
 }
+
+func TestResample(t *testing.T) {
+	t.Parallel()
+	var k *Item
+	_, err := k.Resample(OneHour, false)
+	if !errors.Is(err, errNilKline) {
+		t.Errorf("received '%v' expected '%v'", err, errNilKline)
+	}
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	k = &Item{
+		Exchange: "test",
+		Pair:     currency.NewPair(currency.BTC, currency.USDT),
+		Asset:    asset.Spot,
+		Interval: FifteenMin,
+	}
+	_, err = k.Resample(FiveMin, false)
+	if !errors.Is(err, ErrCanOnlyDownscaleCandles) {
+		t.Errorf("received '%v' expected '%v'", err, ErrCanOnlyDownscaleCandles)
+	}
+	_, err = k.Resample(Interval(40*time.Minute), false)
+	if !errors.Is(err, ErrWholeNumberScaling) {
+		t.Errorf("received '%v' expected '%v'", err, ErrWholeNumberScaling)
+	}
+	for i := 0; i < 7; i++ {
+		k.Candles = append(k.Candles, Candle{
+			Time:   start.Add(time.Duration(i) * FifteenMin.Duration()),
+			Open:   float64(i + 1),
+			High:   float64(i + 2),
+			Low:    float64(i),
+			Close:  float64(i + 1),
+			Volume: 1,
+		})
+	}
+	resp, err := k.Resample(OneHour, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp.Candles) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(resp.Candles), 2)
+	}
+	if resp.Candles[0].Open != 1 || resp.Candles[0].High != 5 || resp.Candles[0].Low != 0 || resp.Candles[0].Close != 4 || resp.Candles[0].Volume != 4 {
+		t.Errorf("unexpected candle %+v", resp.Candles[0])
+	}
+	if !resp.Candles[1].Time.Equal(start.Add(time.Hour)) {
+		t.Errorf("received '%v' expected '%v'", resp.Candles[1].Time, start.Add(time.Hour))
+	}
+	resp, err = k.Resample(OneHour, true)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp.Candles) != 1 {
+		t.Errorf("received '%v' expected '%v'", len(resp.Candles), 1)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	k := &Item{
+		Exchange: "test",
+		Pair:     currency.NewPair(currency.BTC, currency.USDT),
+		Asset:    asset.Spot,
+		Interval: OneHour,
+		Candles: []Candle{
+			{Time: start, Close: 1},
+			{Time: start.Add(time.Hour), Close: 1},
+		},
+	}
+	err := k.Merge(nil)
+	if !errors.Is(err, errNilKline) {
+		t.Errorf("received '%v' expected '%v'", err, errNilKline)
+	}
+	err = k.Merge(&Item{Exchange: "test", Pair: k.Pair, Asset: asset.Spot, Interval: OneDay})
+	if !errors.Is(err, errItemMismatch) {
+		t.Errorf("received '%v' expected '%v'", err, errItemMismatch)
+	}
+	err = k.Merge(&Item{
+		Exchange: "TEST",
+		Pair:     k.Pair,
+		Asset:    asset.Spot,
+		Interval: OneHour,
+		Candles: []Candle{
+			{Time: start.Add(2 * time.Hour), Close: 2},
+			{Time: start.Add(time.Hour), Close: 2},
+		},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(k.Candles) != 3 {
+		t.Fatalf("received '%v' expected '%v'", len(k.Candles), 3)
+	}
+	if k.Candles[1].Close != 2 {
+		t.Errorf("received '%v' expected '%v'", k.Candles[1].Close, 2)
+	}
+	if !k.Candles[2].Time.Equal(start.Add(2 * time.Hour)) {
+		t.Error("expected candles to be sorted")
+	}
+}
+
+func TestValidateContinuity(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	k := &Item{
+		Interval: OneHour,
+		Candles: []Candle{
+			{Time: start},
+			{Time: start.Add(time.Hour)},
+		},
+	}
+	err := k.ValidateContinuity()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	k.Candles = append(k.Candles, Candle{Time: start.Add(4 * time.Hour)})
+	err = k.ValidateContinuity()
+	if !errors.Is(err, ErrCandleDataNotContinuous) {
+		t.Errorf("received '%v' expected '%v'", err, ErrCandleDataNotContinuous)
+	}
+	if missing := k.GetMissingIntervals(); len(missing) != 2 {
+		t.Errorf("received '%v' expected '%v'", len(missing), 2)
+	}
+	k.Interval = 0
+	err = k.ValidateContinuity()
+	if !errors.Is(err, ErrUnsetInterval) {
+		t.Errorf("received '%v' expected '%v'", err, ErrUnsetInterval)
+	}
+}
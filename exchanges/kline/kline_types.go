@@ -48,7 +48,10 @@ var (
 	ErrCanOnlyDownscaleCandles = errors.New("interval must be a longer duration to scale")
 	// ErrWholeNumberScaling returns when old interval data cannot neatly fit into new interval size
 	ErrWholeNumberScaling = errors.New("new interval must scale properly into new candle")
-	errNilKline           = errors.New("kline item is nil")
+	// ErrCandleDataNotContinuous returns when candle data contains gaps
+	ErrCandleDataNotContinuous = errors.New("candle data is not continuous")
+	errNilKline                = errors.New("kline item is nil")
+	errItemMismatch            = errors.New("kline items do not match")
 
 	// SupportedIntervals is a list of all supported intervals
 	SupportedIntervals = []Interval{
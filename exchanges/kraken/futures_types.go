@@ -359,6 +359,21 @@ type FuturesCancelOrderData struct {
 	ServerTime string `json:"serverTime"`
 }
 
+// FuturesEditOrderData stores the status of an edited futures order
+type FuturesEditOrderData struct {
+	EditStatus struct {
+		Status       string `json:"status"`
+		OrderID      string `json:"orderId"`
+		ReceivedTime string `json:"receivedTime"`
+		OrderEvents  []struct {
+			Old      FuturesOrderData `json:"old"`
+			New      FuturesOrderData `json:"new"`
+			DataType string           `json:"type"`
+		} `json:"orderEvents"`
+	} `json:"editStatus"`
+	ServerTime string `json:"serverTime"`
+}
+
 // FuturesFillsData stores fills data
 type FuturesFillsData struct {
 	Fills []struct {
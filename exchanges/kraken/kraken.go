@@ -37,8 +37,8 @@ type Kraken struct {
 	wsRequestMtx sync.Mutex
 }
 
-// GetServerTime returns current server time
-func (k *Kraken) GetServerTime(ctx context.Context) (TimeResponse, error) {
+// GetCurrentServerTime returns current server time
+func (k *Kraken) GetCurrentServerTime(ctx context.Context) (TimeResponse, error) {
 	path := fmt.Sprintf("/%s/public/%s", krakenAPIVersion, krakenServerTime)
 
 	var response struct {
@@ -53,6 +53,23 @@ func (k *Kraken) GetServerTime(ctx context.Context) (TimeResponse, error) {
 	return response.Result, GetError(response.Error)
 }
 
+// GetCurrentSystemStatus returns the current system status of the trading
+// platform
+func (k *Kraken) GetCurrentSystemStatus(ctx context.Context) (SystemStatusResponse, error) {
+	path := fmt.Sprintf("/%s/public/%s", krakenAPIVersion, krakenSystemStatus)
+
+	var response struct {
+		Error  []string             `json:"error"`
+		Result SystemStatusResponse `json:"result"`
+	}
+
+	if err := k.SendHTTPRequest(ctx, exchange.RestSpot, path, &response); err != nil {
+		return response.Result, err
+	}
+
+	return response.Result, GetError(response.Error)
+}
+
 // SeedAssets seeds Kraken's asset list and stores it in the
 // asset translator
 func (k *Kraken) SeedAssets(ctx context.Context) error {
@@ -95,8 +95,8 @@ func (k *Kraken) FuturesBatchOrder(ctx context.Context, data []PlaceBatchOrderDa
 }
 
 // FuturesEditOrder edits a futures order
-func (k *Kraken) FuturesEditOrder(ctx context.Context, orderID, clientOrderID string, size, limitPrice, stopPrice float64) (FuturesAccountsData, error) {
-	var resp FuturesAccountsData
+func (k *Kraken) FuturesEditOrder(ctx context.Context, orderID, clientOrderID string, size, limitPrice, stopPrice float64) (FuturesEditOrderData, error) {
+	var resp FuturesEditOrderData
 	params := url.Values{}
 	if orderID != "" {
 		params.Set("orderId", orderID)
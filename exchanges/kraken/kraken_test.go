@@ -60,12 +60,46 @@ func TestMain(m *testing.M) {
 	os.Exit(m.Run())
 }
 
-// TestGetServerTime API endpoint test
+// TestGetCurrentServerTime API endpoint test
+func TestGetCurrentServerTime(t *testing.T) {
+	t.Parallel()
+	_, err := k.GetCurrentServerTime(context.Background())
+	if err != nil {
+		t.Error("GetCurrentServerTime() error", err)
+	}
+}
+
 func TestGetServerTime(t *testing.T) {
 	t.Parallel()
-	_, err := k.GetServerTime(context.Background())
+	st, err := k.GetServerTime(context.Background(), asset.Spot)
 	if err != nil {
-		t.Error("GetServerTime() error", err)
+		t.Error(err)
+	}
+	if err == nil && st.IsZero() {
+		t.Error("expected a server time")
+	}
+}
+
+func TestGetCurrentSystemStatus(t *testing.T) {
+	t.Parallel()
+	_, err := k.GetCurrentSystemStatus(context.Background())
+	if err != nil {
+		t.Error("GetCurrentSystemStatus() error", err)
+	}
+}
+
+func TestGetSystemStatus(t *testing.T) {
+	t.Parallel()
+	s, err := k.GetSystemStatus(context.Background(), asset.Spot)
+	if err != nil {
+		t.Error(err)
+	}
+	if err == nil && s.Status == exchange.SystemStatusUnknown {
+		t.Error("expected a system status")
+	}
+	_, err = k.GetSystemStatus(context.Background(), asset.Futures)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v' expected '%v'", err, asset.ErrNotSupported)
 	}
 }
 
@@ -860,6 +894,19 @@ func TestGetOrderHistory(t *testing.T) {
 	}
 }
 
+func TestGetOrderFills(t *testing.T) {
+	t.Parallel()
+	_, err := k.GetOrderFills(context.Background(), &order.GetFillsRequest{
+		AssetType: asset.Spot,
+		Pair:      currency.NewPair(currency.XBT, currency.USD),
+	})
+	if areTestAPIKeysSet() && err != nil {
+		t.Errorf("Could not get order fills: %s", err)
+	} else if !areTestAPIKeysSet() && err == nil {
+		t.Error("Expecting an error when no keys are set")
+	}
+}
+
 // TestGetOrderHistory wrapper test
 func TestGetOrderInfo(t *testing.T) {
 	t.Parallel()
@@ -1025,6 +1072,14 @@ func TestModifyOrder(t *testing.T) {
 	if err == nil {
 		t.Error("ModifyOrder() Expected error")
 	}
+	_, err = k.ModifyOrder(context.Background(), &order.Modify{
+		ID:        "1337",
+		AssetType: asset.Spot,
+		Pair:      currency.NewPair(currency.XBT, currency.USD),
+	})
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrFunctionNotSupported)
+	}
 }
 
 // TestWithdraw wrapper test
@@ -1137,6 +1192,18 @@ func TestGetDepositAddress(t *testing.T) {
 }
 
 // TestWithdrawStatus wrapper test
+func TestGetWithdrawalStatus(t *testing.T) {
+	t.Parallel()
+	_, err := k.GetWithdrawalStatus(context.Background(), currency.BTC, "1337")
+	if areTestAPIKeysSet() {
+		if err != nil && !errors.Is(err, exchange.ErrTransferNotFound) {
+			t.Error("GetWithdrawalStatus() error", err)
+		}
+	} else if err == nil {
+		t.Error("GetWithdrawalStatus() error can not be nil")
+	}
+}
+
 func TestWithdrawStatus(t *testing.T) {
 	t.Parallel()
 	if areTestAPIKeysSet() {
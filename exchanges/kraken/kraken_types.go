@@ -11,6 +11,7 @@ import (
 const (
 	krakenAPIVersion       = "0"
 	krakenServerTime       = "Time"
+	krakenSystemStatus     = "SystemStatus"
 	krakenAssets           = "Assets"
 	krakenAssetPairs       = "AssetPairs?"
 	krakenTicker           = "Ticker"
@@ -66,7 +67,14 @@ const (
 	krakenRequestRate  = 1
 
 	// Status consts
-	statusOpen = "open"
+	statusOpen              = "open"
+	futuresEditStatusEdited = "edited"
+
+	// System status consts
+	systemStatusOnline      = "online"
+	systemStatusMaintenance = "maintenance"
+	systemStatusCancelOnly  = "cancel_only"
+	systemStatusPostOnly    = "post_only"
 
 	krakenFormat = "2006-01-02T15:04:05.000Z"
 )
@@ -75,6 +83,13 @@ var (
 	assetTranslator assetTranslatorStore
 )
 
+// SystemStatusResponse holds the current system status of the trading
+// platform
+type SystemStatusResponse struct {
+	Status    string    `json:"status"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
 // GenericResponse stores general response data for functions that only return success
 type GenericResponse struct {
 	Timestamp string `json:"timestamp"`
@@ -297,6 +312,7 @@ type TradeInfo struct {
 	Volume                     float64  `json:"vol,string"`
 	Margin                     float64  `json:"margin,string"`
 	Misc                       string   `json:"misc"`
+	Maker                      bool     `json:"maker"`
 	PosTxID                    string   `json:"postxid"`
 	ClosedPositionAveragePrice float64  `json:"cprice,string"`
 	ClosedPositionFee          float64  `json:"cfee,string"`
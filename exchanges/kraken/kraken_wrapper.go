@@ -151,6 +151,10 @@ func (k *Kraken) SetDefaults() {
 				DateRanges: true,
 				Intervals:  true,
 			},
+			OrderAmendment: order.AmendCapabilities{
+				Price:  true,
+				Amount: true,
+			},
 		},
 		Enabled: exchange.FeaturesEnabled{
 			AutoPairUpdates: true,
@@ -651,6 +655,16 @@ func (k *Kraken) GetWithdrawalsHistory(ctx context.Context, c currency.Code) (re
 	return
 }
 
+// GetWithdrawalStatus returns the current state of a withdrawal by its
+// reference ID or transaction ID
+func (k *Kraken) GetWithdrawalStatus(ctx context.Context, c currency.Code, id string) (*exchange.WithdrawalHistory, error) {
+	history, err := k.GetWithdrawalsHistory(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	return exchange.FindWithdrawal(history, id)
+}
+
 // GetRecentTrades returns the most recent trades for a currency and asset
 func (k *Kraken) GetRecentTrades(ctx context.Context, p currency.Pair, assetType asset.Item) ([]trade.Data, error) {
 	var err error
@@ -770,7 +784,35 @@ func (k *Kraken) SubmitOrder(ctx context.Context, s *order.Submit) (order.Submit
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
 func (k *Kraken) ModifyOrder(ctx context.Context, action *order.Modify) (order.Modify, error) {
-	return order.Modify{}, common.ErrFunctionNotSupported
+	if err := action.Validate(); err != nil {
+		return order.Modify{}, err
+	}
+	if action.AssetType != asset.Futures {
+		return order.Modify{}, fmt.Errorf("%s %w", action.AssetType, common.ErrFunctionNotSupported)
+	}
+	resp, err := k.FuturesEditOrder(ctx,
+		action.ID,
+		action.ClientOrderID,
+		action.Amount,
+		action.Price,
+		action.TriggerPrice)
+	if err != nil {
+		return order.Modify{}, err
+	}
+	if resp.EditStatus.Status != futuresEditStatusEdited {
+		return order.Modify{}, fmt.Errorf("%s order %s not edited: %s", k.Name, action.ID, resp.EditStatus.Status)
+	}
+	return order.Modify{
+		Exchange:      action.Exchange,
+		AssetType:     action.AssetType,
+		Pair:          action.Pair,
+		ID:            action.ID,
+		ClientOrderID: action.ClientOrderID,
+
+		Price:        action.Price,
+		Amount:       action.Amount,
+		TriggerPrice: action.TriggerPrice,
+	}, nil
 }
 
 // CancelOrder cancels an order by its corresponding ID number
@@ -1398,6 +1440,76 @@ func (k *Kraken) GetOrderHistory(ctx context.Context, getOrdersRequest *order.Ge
 	return orders, nil
 }
 
+// GetOrderFills returns the individual fills of an order or pair
+func (k *Kraken) GetOrderFills(ctx context.Context, req *order.GetFillsRequest) ([]order.Fill, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if req.AssetType != asset.Spot {
+		return nil, fmt.Errorf("%s %w", req.AssetType, asset.ErrNotSupported)
+	}
+	opts := GetTradesHistoryOptions{}
+	if !req.StartTime.IsZero() {
+		opts.Start = strconv.FormatInt(req.StartTime.Unix(), 10)
+	}
+	if !req.EndTime.IsZero() {
+		opts.End = strconv.FormatInt(req.EndTime.Unix(), 10)
+	}
+	avail, err := k.GetAvailablePairs(asset.Spot)
+	if err != nil {
+		return nil, err
+	}
+	format, err := k.GetPairFormat(asset.Spot, true)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := k.GetTradesHistory(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	fills := make([]order.Fill, 0, len(resp.Trades))
+	for tradeID, trade := range resp.Trades {
+		if req.OrderID != "" && req.OrderID != trade.OrderTxID {
+			continue
+		}
+		symbol := assetTranslator.LookupAltname(trade.Pair)
+		if symbol == "" {
+			symbol = trade.Pair
+		}
+		var p currency.Pair
+		p, err = currency.NewPairFromFormattedPairs(symbol, avail, format)
+		if err != nil {
+			return nil, err
+		}
+		if !req.Pair.IsEmpty() && !req.Pair.Equal(p) {
+			continue
+		}
+		var side order.Side
+		side, err = order.StringToOrderSide(trade.Type)
+		if err != nil {
+			return nil, err
+		}
+		fills = append(fills, order.Fill{
+			Exchange:  k.Name,
+			OrderID:   trade.OrderTxID,
+			TradeID:   tradeID,
+			Pair:      p,
+			AssetType: asset.Spot,
+			Side:      side,
+			Price:     trade.Price,
+			Amount:    trade.Volume,
+			Fee:       trade.Fee,
+			FeeAsset:  p.Quote.String(),
+			IsMaker:   trade.Maker,
+			Timestamp: convert.TimeFromUnixTimestampDecimal(trade.Time),
+		})
+	}
+	sort.Slice(fills, func(i, j int) bool {
+		return fills[i].Timestamp.Before(fills[j].Timestamp)
+	})
+	return fills, nil
+}
+
 // AuthenticateWebsocket sends an authentication message to the websocket
 func (k *Kraken) AuthenticateWebsocket(ctx context.Context) error {
 	resp, err := k.GetWebsocketToken(ctx)
@@ -1419,6 +1531,42 @@ func (k *Kraken) FormatExchangeKlineInterval(in kline.Interval) string {
 	return strconv.FormatFloat(in.Duration().Minutes(), 'f', -1, 64)
 }
 
+// GetServerTime returns the current exchange server time.
+func (k *Kraken) GetServerTime(ctx context.Context, _ asset.Item) (time.Time, error) {
+	resp, err := k.GetCurrentServerTime(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(resp.Unixtime, 0), nil
+}
+
+// GetSystemStatus returns the exchange's platform status
+func (k *Kraken) GetSystemStatus(ctx context.Context, a asset.Item) (*exchange.SystemStatusResponse, error) {
+	if a != asset.Spot {
+		return nil, fmt.Errorf("%s %w", a, asset.ErrNotSupported)
+	}
+	resp, err := k.GetCurrentSystemStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	status := exchange.SystemStatusUnknown
+	switch resp.Status {
+	case systemStatusOnline:
+		status = exchange.SystemStatusOperational
+	case systemStatusMaintenance:
+		status = exchange.SystemStatusMaintenance
+	case systemStatusCancelOnly:
+		status = exchange.SystemStatusCancelOnly
+	case systemStatusPostOnly:
+		status = exchange.SystemStatusPostOnly
+	}
+	return &exchange.SystemStatusResponse{
+		Status:      status,
+		Message:     resp.Status,
+		LastUpdated: resp.Timestamp,
+	}, nil
+}
+
 // GetHistoricCandles returns candles between a time period for a set time interval
 func (k *Kraken) GetHistoricCandles(ctx context.Context, pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
 	if err := k.ValidateKline(pair, a, interval); err != nil {
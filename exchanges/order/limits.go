@@ -361,6 +361,34 @@ func (l *Limits) Conforms(price, amount float64, orderType Type) error {
 	return nil
 }
 
+// GetMinMaxLevel returns the loaded limit values, allowing them to be
+// compared against values set elsewhere
+func (l *Limits) GetMinMaxLevel() MinMaxLevel {
+	if l == nil {
+		return MinMaxLevel{}
+	}
+	l.m.RLock()
+	defer l.m.RUnlock()
+	return MinMaxLevel{
+		MinPrice:            l.minPrice,
+		MaxPrice:            l.maxPrice,
+		StepPrice:           l.stepIncrementSizePrice,
+		MultiplierUp:        l.multiplierUp,
+		MultiplierDown:      l.multiplierDown,
+		AveragePriceMinutes: l.averagePriceMinutes,
+		MinAmount:           l.minAmount,
+		MaxAmount:           l.maxAmount,
+		StepAmount:          l.stepIncrementSizeAmount,
+		MinNotional:         l.minNotional,
+		MaxIcebergParts:     l.maxIcebergParts,
+		MarketMinQty:        l.marketMinQty,
+		MarketMaxQty:        l.marketMaxQty,
+		MarketStepSize:      l.marketStepIncrementSize,
+		MaxTotalOrders:      l.maxTotalOrders,
+		MaxAlgoOrders:       l.maxAlgoOrders,
+	}
+}
+
 // ConformToDecimalAmount (POC) conforms amount to its amount interval
 func (l *Limits) ConformToDecimalAmount(amount decimal.Decimal) decimal.Decimal {
 	if l == nil {
@@ -381,6 +409,30 @@ func (l *Limits) ConformToDecimalAmount(amount decimal.Decimal) decimal.Decimal
 	return amount.Sub(mod)
 }
 
+// ConformToDecimalPrice conforms price to its price tick interval, rounding
+// buy prices up and all other prices down so the conformed price is never
+// more favourable than the price supplied
+func (l *Limits) ConformToDecimalPrice(price decimal.Decimal, side Side) decimal.Decimal {
+	if l == nil {
+		return price
+	}
+	l.m.RLock()
+	defer l.m.RUnlock()
+	dStep := decimal.NewFromFloat(l.stepIncrementSizePrice)
+	if dStep.IsZero() {
+		return price
+	}
+	mod := price.Mod(dStep)
+	if mod.IsZero() {
+		return price
+	}
+	floor := price.Sub(mod)
+	if side == Buy || side == Bid {
+		return floor.Add(dStep)
+	}
+	return floor
+}
+
 // ConformToAmount (POC) conforms amount to its amount interval
 func (l *Limits) ConformToAmount(amount float64) float64 {
 	if l == nil {
@@ -315,6 +315,55 @@ func TestConforms(t *testing.T) {
 	}
 }
 
+func TestConformToDecimalPrice(t *testing.T) {
+	t.Parallel()
+	var tt *Limits
+	if !tt.ConformToDecimalPrice(decimal.NewFromFloat(1.001), Buy).Equal(decimal.NewFromFloat(1.001)) {
+		t.Fatal("value should not be changed")
+	}
+
+	tt = &Limits{}
+	val := tt.ConformToDecimalPrice(decimal.NewFromFloat(1.001), Buy)
+	if !val.Equal(decimal.NewFromFloat(1.001)) {
+		t.Fatal("unexpected price", val)
+	}
+
+	tt.stepIncrementSizePrice = 0.01
+	val = tt.ConformToDecimalPrice(decimal.NewFromFloat(1.01), Buy)
+	if !val.Equal(decimal.NewFromFloat(1.01)) {
+		t.Error("unexpected price", val)
+	}
+	val = tt.ConformToDecimalPrice(decimal.NewFromFloat(1.001), Buy)
+	if !val.Equal(decimal.NewFromFloat(1.01)) {
+		t.Error("unexpected price", val)
+	}
+	val = tt.ConformToDecimalPrice(decimal.NewFromFloat(1.009), Sell)
+	if !val.Equal(decimal.NewFromInt(1)) {
+		t.Error("unexpected price", val)
+	}
+}
+
+func TestGetMinMaxLevel(t *testing.T) {
+	t.Parallel()
+	var tt *Limits
+	if level := tt.GetMinMaxLevel(); level != (MinMaxLevel{}) {
+		t.Fatal("expected empty level for nil limits")
+	}
+	tt = &Limits{
+		minAmount:               0.001,
+		stepIncrementSizeAmount: 0.0001,
+		stepIncrementSizePrice:  0.01,
+		minNotional:             10,
+	}
+	level := tt.GetMinMaxLevel()
+	if level.MinAmount != 0.001 ||
+		level.StepAmount != 0.0001 ||
+		level.StepPrice != 0.01 ||
+		level.MinNotional != 10 {
+		t.Fatalf("unexpected level %+v", level)
+	}
+}
+
 func TestConformToDecimalAmount(t *testing.T) {
 	t.Parallel()
 	var tt *Limits
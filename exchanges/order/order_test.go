@@ -327,6 +327,31 @@ func TestFilterOrdersByTimeRange(t *testing.T) {
 	}
 }
 
+func TestFilterFillsByTimeRange(t *testing.T) {
+	t.Parallel()
+	fills := []Fill{
+		{Timestamp: time.Unix(100, 0)},
+		{Timestamp: time.Unix(110, 0)},
+		{Timestamp: time.Unix(111, 0)},
+	}
+	FilterFillsByTimeRange(&fills, time.Time{}, time.Time{})
+	if len(fills) != 3 {
+		t.Errorf("Fills failed to be filtered. Expected %v, received %v", 3, len(fills))
+	}
+	FilterFillsByTimeRange(&fills, time.Time{}, time.Unix(110, 0))
+	if len(fills) != 2 {
+		t.Errorf("Fills failed to be filtered. Expected %v, received %v", 2, len(fills))
+	}
+	FilterFillsByTimeRange(&fills, time.Unix(101, 0), time.Time{})
+	if len(fills) != 1 {
+		t.Errorf("Fills failed to be filtered. Expected %v, received %v", 1, len(fills))
+	}
+	FilterFillsByTimeRange(&fills, time.Unix(200, 0), time.Unix(300, 0))
+	if len(fills) != 0 {
+		t.Errorf("Fills failed to be filtered. Expected %v, received %v", 0, len(fills))
+	}
+}
+
 func TestFilterOrdersByCurrencies(t *testing.T) {
 	t.Parallel()
 
@@ -552,6 +577,10 @@ var stringsToOrderSide = []struct {
 	{"ask", Ask, nil},
 	{"ASK", Ask, nil},
 	{"aSk", Ask, nil},
+	{"long", Long, nil},
+	{"LONG", Long, nil},
+	{"short", Short, nil},
+	{"SHORT", Short, nil},
 	{"any", AnySide, nil},
 	{"ANY", AnySide, nil},
 	{"aNy", AnySide, nil},
@@ -690,6 +719,33 @@ func TestStringToOrderStatus(t *testing.T) {
 	}
 }
 
+func TestAmendCapabilities(t *testing.T) {
+	t.Parallel()
+	var none AmendCapabilities
+	if none.Amends(true, false) || none.Amends(false, true) {
+		t.Error("expected no amendments without capabilities")
+	}
+	a := AmendCapabilities{Price: true, PreservesQueuePriority: true}
+	if !a.Amends(true, false) {
+		t.Error("expected price amendment")
+	}
+	if a.Amends(true, true) || a.Amends(false, true) {
+		t.Error("expected amount changes to not be amended")
+	}
+	if a.Amends(false, false) {
+		t.Error("expected no amendment without changes")
+	}
+	if !a.KeepsQueuePriority(false, false) {
+		t.Error("expected amount reduction to keep queue priority")
+	}
+	if a.KeepsQueuePriority(true, false) || a.KeepsQueuePriority(false, true) {
+		t.Error("expected price change or amount increase to lose queue priority")
+	}
+	if none.KeepsQueuePriority(false, false) {
+		t.Error("expected queue priority to be lost without support")
+	}
+}
+
 func TestUpdateOrderFromModify(t *testing.T) {
 	var leet = "1337"
 	od := Detail{
@@ -748,6 +804,7 @@ func TestUpdateOrderFromModify(t *testing.T) {
 		ID:                "1",
 		AccountID:         "1",
 		ClientID:          "1",
+		ClientOrderID:     "1",
 		WalletAddress:     "1",
 		Type:              "1",
 		Side:              "1",
@@ -813,6 +870,9 @@ func TestUpdateOrderFromModify(t *testing.T) {
 	if od.ClientID != "1" {
 		t.Error("Failed to update")
 	}
+	if od.ClientOrderID != "1" {
+		t.Error("Failed to update")
+	}
 	if od.WalletAddress != "1" {
 		t.Error("Failed to update")
 	}
@@ -940,6 +1000,7 @@ func TestUpdateOrderFromDetail(t *testing.T) {
 		ID:                "1",
 		AccountID:         "1",
 		ClientID:          "1",
+		ClientOrderID:     "1",
 		WalletAddress:     "1",
 		Type:              "1",
 		Side:              "1",
@@ -1005,6 +1066,9 @@ func TestUpdateOrderFromDetail(t *testing.T) {
 	if od.ClientID != "1" {
 		t.Error("Failed to update")
 	}
+	if od.ClientOrderID != "1" {
+		t.Error("Failed to update")
+	}
 	if od.WalletAddress != "1" {
 		t.Error("Failed to update")
 	}
@@ -1159,6 +1223,33 @@ func TestValidationOnOrderTypes(t *testing.T) {
 		t.Fatal("should output an error since assetType isn't provided")
 	}
 
+	var getFills *GetFillsRequest
+	if !errors.Is(getFills.Validate(), ErrGetFillsRequestIsNil) {
+		t.Fatal("unexpected error")
+	}
+
+	getFills = new(GetFillsRequest)
+	if getFills.Validate() == nil {
+		t.Fatal("should error since assetType hasn't been provided")
+	}
+
+	getFills.AssetType = asset.Spot
+	if !errors.Is(getFills.Validate(), ErrPairIsEmpty) {
+		t.Fatal("should error since neither an order ID or pair has been provided")
+	}
+
+	getFills.OrderID = "1337"
+	getFills.StartTime = time.Now()
+	getFills.EndTime = getFills.StartTime.Add(-time.Hour)
+	if !errors.Is(getFills.Validate(), ErrInvalidTimeRange) {
+		t.Fatal("should error since start time is after end time")
+	}
+
+	getFills.EndTime = time.Time{}
+	if getFills.Validate() != nil {
+		t.Fatal("should return nil")
+	}
+
 	var modifyOrder *Modify
 	if modifyOrder.Validate() != ErrModifyOrderIsNil {
 		t.Fatal("unexpected error")
@@ -1433,6 +1524,51 @@ func TestGenerateInternalOrderID(t *testing.T) {
 	}
 }
 
+func TestGenerateClientOrderID(t *testing.T) {
+	t.Parallel()
+	id, err := GenerateClientOrderID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = uuid.FromString(id); err != nil {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	another, err := GenerateClientOrderID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id == another {
+		t.Error("expected unique client order IDs")
+	}
+}
+
+func TestEnsureClientOrderID(t *testing.T) {
+	t.Parallel()
+	var s *Submit
+	err := s.EnsureClientOrderID()
+	if !errors.Is(err, ErrSubmissionIsNil) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubmissionIsNil)
+	}
+
+	s = &Submit{ClientOrderID: "1337"}
+	err = s.EnsureClientOrderID()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if s.ClientOrderID != "1337" {
+		t.Error("provided client order ID should not be replaced")
+	}
+
+	s.ClientOrderID = ""
+	err = s.EnsureClientOrderID()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if s.ClientOrderID == "" {
+		t.Error("expected client order ID to be generated")
+	}
+}
+
 func TestDetail_Copy(t *testing.T) {
 	d := []Detail{
 		{
@@ -21,6 +21,8 @@ var (
 	ErrAmountIsInvalid            = errors.New("order amount is equal or less than zero")
 	ErrPriceMustBeSetIfLimitOrder = errors.New("order price must be set if limit order type is desired")
 	ErrOrderIDNotSet              = errors.New("order id or client order id is not set")
+	ErrGetFillsRequestIsNil       = errors.New("get fills request is nil")
+	ErrInvalidTimeRange           = errors.New("start time cannot be after end time")
 )
 
 // Submit contains all properties of an order that may be required
@@ -107,11 +109,30 @@ type Modify struct {
 	LastUpdated       time.Time
 	Pair              currency.Pair
 	Trades            []TradeHistory
+	// QueuePriorityPreserved is set on a modification which kept the
+	// order's place in the orderbook queue
+	QueuePriorityPreserved bool
+	// CancelReplaced is set on a modification made by cancelling the order
+	// and submitting a replacement, giving the order a new ID
+	CancelReplaced bool
 }
 
 // ModifyResponse is an order modifying return type
 type ModifyResponse struct {
-	OrderID string
+	OrderID                string
+	QueuePriorityPreserved bool
+	CancelReplaced         bool
+}
+
+// AmendCapabilities describes which fields of an order an exchange can amend
+// in place. Changes to any other field are made by cancelling the order and
+// submitting a replacement
+type AmendCapabilities struct {
+	Price  bool
+	Amount bool
+	// PreservesQueuePriority is whether an amendment reducing an order's
+	// amount at the same price keeps its place in the orderbook queue
+	PreservesQueuePriority bool
 }
 
 // Detail contains all properties of an order
@@ -236,6 +257,53 @@ type GetOrdersRequest struct {
 	AssetType asset.Item
 }
 
+// GetFillsRequest used for GetOrderFills wrapper functions. Exchanges differ
+// in which filters are required, most require either an order ID or a pair
+type GetFillsRequest struct {
+	OrderID   string
+	Pair      currency.Pair
+	AssetType asset.Item
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+// Fill holds an individual execution of an order, allowing the exact price,
+// amount and fee of each part of an order to be tracked
+type Fill struct {
+	Exchange  string
+	OrderID   string
+	TradeID   string
+	Pair      currency.Pair
+	AssetType asset.Item
+	Side      Side
+	Price     float64
+	Amount    float64
+	Fee       float64
+	FeeAsset  string
+	IsMaker   bool
+	Timestamp time.Time
+}
+
+// Position holds an open derivative position as reported by an exchange.
+// Margin and profit and loss amounts are denominated in the position's
+// settlement currency
+type Position struct {
+	Exchange          string
+	AssetType         asset.Item
+	Pair              currency.Pair
+	Side              Side
+	Amount            float64
+	EntryPrice        float64
+	MarkPrice         float64
+	LiquidationPrice  float64
+	Leverage          float64
+	Margin            float64
+	MaintenanceMargin float64
+	UnrealisedPnL     float64
+	RealisedPnL       float64
+	LastUpdated       time.Time
+}
+
 // Status defines order status types
 type Status string
 
@@ -294,6 +362,8 @@ const (
 	Sell        Side = "SELL"
 	Bid         Side = "BID"
 	Ask         Side = "ASK"
+	Long        Side = "LONG"
+	Short       Side = "SHORT"
 	UnknownSide Side = "UNKNOWN"
 )
 
@@ -124,6 +124,10 @@ func (d *Detail) UpdateOrderFromDetail(m *Detail) {
 		d.ClientID = m.ClientID
 		updated = true
 	}
+	if m.ClientOrderID != "" && m.ClientOrderID != d.ClientOrderID {
+		d.ClientOrderID = m.ClientOrderID
+		updated = true
+	}
 	if m.WalletAddress != "" && m.WalletAddress != d.WalletAddress {
 		d.WalletAddress = m.WalletAddress
 		updated = true
@@ -214,6 +218,21 @@ func (d *Detail) UpdateOrderFromDetail(m *Detail) {
 	}
 }
 
+// Amends returns whether the price and amount changes can be amended in place
+func (a AmendCapabilities) Amends(priceChanged, amountChanged bool) bool {
+	if !priceChanged && !amountChanged {
+		return false
+	}
+	return (!priceChanged || a.Price) && (!amountChanged || a.Amount)
+}
+
+// KeepsQueuePriority returns whether an amendment in place keeps the order's
+// place in the orderbook queue, which is lost when its price changes or its
+// amount increases
+func (a AmendCapabilities) KeepsQueuePriority(priceChanged, amountIncreased bool) bool {
+	return a.PreservesQueuePriority && !priceChanged && !amountIncreased
+}
+
 // UpdateOrderFromModify Will update an order detail (used in order management)
 // by comparing passed in and existing values
 func (d *Detail) UpdateOrderFromModify(m *Modify) {
@@ -286,6 +305,10 @@ func (d *Detail) UpdateOrderFromModify(m *Modify) {
 		d.ClientID = m.ClientID
 		updated = true
 	}
+	if m.ClientOrderID != "" && m.ClientOrderID != d.ClientOrderID {
+		d.ClientOrderID = m.ClientOrderID
+		updated = true
+	}
 	if m.WalletAddress != "" && m.WalletAddress != d.WalletAddress {
 		d.WalletAddress = m.WalletAddress
 		updated = true
@@ -443,6 +466,34 @@ func (d *Detail) GenerateInternalOrderID() {
 	}
 }
 
+// GenerateClientOrderID returns a new V4 UUID client order ID which can be
+// used to correlate an order across REST submissions, websocket updates and
+// restarts
+func GenerateClientOrderID() (string, error) {
+	id, err := uuid.NewV4()
+	if err != nil {
+		return "", err
+	}
+	return id.String(), nil
+}
+
+// EnsureClientOrderID sets a generated client order ID on the submission if
+// one has not been provided
+func (s *Submit) EnsureClientOrderID() error {
+	if s == nil {
+		return ErrSubmissionIsNil
+	}
+	if s.ClientOrderID != "" {
+		return nil
+	}
+	id, err := GenerateClientOrderID()
+	if err != nil {
+		return err
+	}
+	s.ClientOrderID = id
+	return nil
+}
+
 // Copy will return a copy of Detail
 func (d *Detail) Copy() Detail {
 	c := *d
@@ -573,6 +624,23 @@ func FilterOrdersByTimeRange(orders *[]Detail, startTime, endTime time.Time) {
 	*orders = filteredOrders
 }
 
+// FilterFillsByTimeRange removes any fills outside of the time range, a zero
+// start or end time leaves that side of the range open
+func FilterFillsByTimeRange(fills *[]Fill, startTime, endTime time.Time) {
+	if startTime.IsZero() && endTime.IsZero() {
+		return
+	}
+	filtered := (*fills)[:0]
+	for i := range *fills {
+		if (!startTime.IsZero() && (*fills)[i].Timestamp.Before(startTime)) ||
+			(!endTime.IsZero() && (*fills)[i].Timestamp.After(endTime)) {
+			continue
+		}
+		filtered = append(filtered, (*fills)[i])
+	}
+	*fills = filtered
+}
+
 // FilterOrdersByCurrencies removes any order details that do not match the
 // provided currency list. It is forgiving in that the provided currencies can
 // match quote or base currencies
@@ -714,6 +782,10 @@ func StringToOrderSide(side string) (Side, error) {
 		return Bid, nil
 	case strings.EqualFold(side, Ask.String()):
 		return Ask, nil
+	case strings.EqualFold(side, Long.String()):
+		return Long, nil
+	case strings.EqualFold(side, Short.String()):
+		return Short, nil
 	case strings.EqualFold(side, AnySide.String()):
 		return AnySide, nil
 	default:
@@ -896,6 +968,23 @@ func (g *GetOrdersRequest) Validate(opt ...validate.Checker) error {
 	return nil
 }
 
+// Validate checks internal struct requirements
+func (g *GetFillsRequest) Validate() error {
+	if g == nil {
+		return ErrGetFillsRequestIsNil
+	}
+	if !g.AssetType.IsValid() {
+		return fmt.Errorf("assetType %v not supported", g.AssetType)
+	}
+	if g.OrderID == "" && g.Pair.IsEmpty() {
+		return fmt.Errorf("%w, an order ID or pair must be set", ErrPairIsEmpty)
+	}
+	if !g.StartTime.IsZero() && !g.EndTime.IsZero() && g.StartTime.After(g.EndTime) {
+		return ErrInvalidTimeRange
+	}
+	return nil
+}
+
 // Validate checks internal struct requirements
 func (m *Modify) Validate(opt ...validate.Checker) error {
 	if m == nil {
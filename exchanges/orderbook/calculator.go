@@ -6,9 +6,12 @@ import (
 	"sort"
 
 	math "github.com/thrasher-corp/gocryptotrader/common/math"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
+var errInvalidOrderSide = errors.New("invalid order side")
+
 // WhaleBombResult returns the whale bomb result
 type WhaleBombResult struct {
 	Amount               float64
@@ -261,3 +264,84 @@ func (elem Items) FindNominalAmount(amount float64) (aggNominalAmount, remaining
 	}
 	return aggNominalAmount, remainingAmount
 }
+
+// MarketOrderSimulation defines the expected outcome of a market order walking
+// the current orderbook depth
+type MarketOrderSimulation struct {
+	// Amount is the base amount requested
+	Amount float64
+	// FilledAmount is the base amount that can be filled by current depth
+	FilledAmount float64
+	// Cost is the total quote amount spent or received for the filled amount
+	Cost float64
+	// ReferencePrice is the top of book price before the order is matched
+	ReferencePrice float64
+	// AveragePrice is the volume weighted average execution price
+	AveragePrice float64
+	// WorstPrice is the last level price touched by the order
+	WorstPrice float64
+	// SlippagePercentage is the percentage difference between the average
+	// price and the reference price, always positive when price moves against
+	// the order
+	SlippagePercentage float64
+	// LevelsConsumed is the amount of price levels touched by the order
+	LevelsConsumed int
+	// SufficientDepth defines if the orderbook could fill the entire amount
+	SufficientDepth bool
+}
+
+// SimulateMarketOrder walks the orderbook levels for a market order of the
+// supplied base size and returns the volume weighted average execution price,
+// expected slippage against top of book and if there is enough depth to fill
+// the full amount. Buy and Bid sides consume asks, Sell and Ask sides consume
+// bids.
+func (b *Base) SimulateMarketOrder(size float64, side order.Side) (*MarketOrderSimulation, error) {
+	if size <= 0 {
+		return nil, errAmountInvalid
+	}
+	var levels Items
+	var buying bool
+	switch side {
+	case order.Buy, order.Bid:
+		levels, buying = b.Asks, true
+	case order.Sell, order.Ask:
+		levels = b.Bids
+	default:
+		return nil, fmt.Errorf("%w %v", errInvalidOrderSide, side)
+	}
+	if len(levels) == 0 {
+		return nil, fmt.Errorf("%w for %v on exchange %v", errNotEnoughLiquidity, b.Pair, b.Exchange)
+	}
+
+	sim := &MarketOrderSimulation{
+		Amount:         size,
+		ReferencePrice: levels[0].Price,
+	}
+	remaining := size
+	for x := range levels {
+		if remaining <= 0 {
+			break
+		}
+		fill := levels[x].Amount
+		if remaining < fill {
+			fill = remaining
+		}
+		sim.Cost += fill * levels[x].Price
+		sim.FilledAmount += fill
+		sim.WorstPrice = levels[x].Price
+		sim.LevelsConsumed++
+		remaining -= fill
+	}
+	sim.SufficientDepth = remaining <= 0
+	if sim.FilledAmount > 0 {
+		sim.AveragePrice = sim.Cost / sim.FilledAmount
+	}
+	if sim.ReferencePrice > 0 {
+		if buying {
+			sim.SlippagePercentage = (sim.AveragePrice - sim.ReferencePrice) / sim.ReferencePrice * 100
+		} else {
+			sim.SlippagePercentage = (sim.ReferencePrice - sim.AveragePrice) / sim.ReferencePrice * 100
+		}
+	}
+	return sim, nil
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
 
 func testSetup() Base {
@@ -154,3 +155,53 @@ func TestFindNominalAmount(t *testing.T) {
 		t.Errorf("invalid return")
 	}
 }
+
+func TestSimulateMarketOrder(t *testing.T) {
+	t.Parallel()
+	b := testSetup()
+	_, err := b.SimulateMarketOrder(0, order.Buy)
+	if !errors.Is(err, errAmountInvalid) {
+		t.Errorf("received '%v', expected '%v'", err, errAmountInvalid)
+	}
+	_, err = b.SimulateMarketOrder(1, order.AnySide)
+	if !errors.Is(err, errInvalidOrderSide) {
+		t.Errorf("received '%v', expected '%v'", err, errInvalidOrderSide)
+	}
+
+	sim, err := b.SimulateMarketOrder(2, order.Buy)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if !sim.SufficientDepth {
+		t.Error("expected sufficient depth")
+	}
+	if sim.AveragePrice != 7000.5 {
+		t.Errorf("received '%v', expected '%v'", sim.AveragePrice, 7000.5)
+	}
+	if sim.WorstPrice != 7001 || sim.LevelsConsumed != 2 {
+		t.Errorf("received '%v' '%v', expected '%v' '%v'", sim.WorstPrice, sim.LevelsConsumed, 7001, 2)
+	}
+	if sim.SlippagePercentage <= 0 {
+		t.Error("expected positive slippage")
+	}
+
+	sim, err = b.SimulateMarketOrder(4, order.Sell)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if sim.SufficientDepth {
+		t.Error("expected insufficient depth")
+	}
+	if sim.FilledAmount != 3 {
+		t.Errorf("received '%v', expected '%v'", sim.FilledAmount, 3)
+	}
+	if sim.SlippagePercentage <= 0 {
+		t.Error("expected positive slippage")
+	}
+
+	b.Bids = nil
+	_, err = b.SimulateMarketOrder(1, order.Sell)
+	if !errors.Is(err, errNotEnoughLiquidity) {
+		t.Errorf("received '%v', expected '%v'", err, errNotEnoughLiquidity)
+	}
+}
@@ -24,7 +24,10 @@ type Depth struct {
 	id  uuid.UUID
 
 	options
-	m sync.Mutex
+	// maxDepth caps the price levels retained on each side, zero retains all
+	// levels
+	maxDepth int
+	m        sync.Mutex
 }
 
 // NewDepth returns a new depth item
@@ -101,6 +104,7 @@ func (d *Depth) LoadSnapshot(bids, asks []Item, lastUpdateID int64, lastUpdated
 	d.restSnapshot = updateByREST
 	d.bids.load(bids, d.stack)
 	d.asks.load(asks, d.stack)
+	d.truncate()
 	d.Alert()
 	d.m.Unlock()
 }
@@ -117,12 +121,16 @@ func (d *Depth) Flush() {
 }
 
 // UpdateBidAskByPrice updates the bid and ask spread by supplied updates, this
-// will trim total length of depth level to a specified supplied number
+// will trim total length of depth level to a specified supplied number or the
+// depth's max depth, whichever is smaller
 func (d *Depth) UpdateBidAskByPrice(bidUpdts, askUpdts Items, maxDepth int, lastUpdateID int64, lastUpdated time.Time) {
 	if len(bidUpdts) == 0 && len(askUpdts) == 0 {
 		return
 	}
 	d.m.Lock()
+	if d.maxDepth != 0 && (maxDepth == 0 || d.maxDepth < maxDepth) {
+		maxDepth = d.maxDepth
+	}
 	d.lastUpdateID = lastUpdateID
 	d.lastUpdated = lastUpdated
 	tn := getNow()
@@ -254,6 +262,40 @@ func (d *Depth) AssignOptions(b *Base) {
 	d.m.Unlock()
 }
 
+// SetMaxDepth sets the price levels retained on each side of the depth and
+// truncates the levels beyond it, zero retains all levels
+func (d *Depth) SetMaxDepth(maxDepth int) error {
+	if maxDepth < 0 {
+		return ErrInvalidMaxDepth
+	}
+	d.m.Lock()
+	d.maxDepth = maxDepth
+	d.truncate()
+	d.m.Unlock()
+	return nil
+}
+
+// GetMaxDepth returns the price levels retained on each side of the depth
+func (d *Depth) GetMaxDepth() int {
+	d.m.Lock()
+	defer d.m.Unlock()
+	return d.maxDepth
+}
+
+// truncate removes the price levels beyond the max depth, levels are returned
+// to the stack for reuse
+func (d *Depth) truncate() {
+	if d.maxDepth == 0 {
+		return
+	}
+	if d.bids.length > d.maxDepth {
+		d.bids.cleanup(d.maxDepth, d.stack)
+	}
+	if d.asks.length > d.maxDepth {
+		d.asks.cleanup(d.maxDepth, d.stack)
+	}
+}
+
 // GetName returns name of exchange
 func (d *Depth) GetName() string {
 	d.m.Lock()
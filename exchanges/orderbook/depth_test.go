@@ -152,6 +152,58 @@ func TestUpdateBidAskByPrice(t *testing.T) {
 	}
 }
 
+func TestSetMaxDepth(t *testing.T) {
+	d := newDepth(id)
+	err := d.SetMaxDepth(-1)
+	if !errors.Is(err, ErrInvalidMaxDepth) {
+		t.Fatalf("received '%v' expected '%v'", err, ErrInvalidMaxDepth)
+	}
+	d.LoadSnapshot(Items{{Price: 3, Amount: 1}, {Price: 2, Amount: 1}, {Price: 1, Amount: 1}},
+		Items{{Price: 4, Amount: 1}, {Price: 5, Amount: 1}, {Price: 6, Amount: 1}},
+		0, time.Time{}, false)
+	err = d.SetMaxDepth(2)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if d.GetMaxDepth() != 2 {
+		t.Fatalf("received '%v' expected '%v'", d.GetMaxDepth(), 2)
+	}
+	if d.GetBidLength() != 2 || d.GetAskLength() != 2 {
+		t.Fatalf("expected book to be truncated, received bids %v asks %v", d.GetBidLength(), d.GetAskLength())
+	}
+	book := d.Retrieve()
+	if book.Bids[1].Price != 2 || book.Asks[1].Price != 5 {
+		t.Fatal("expected the levels furthest from the spread to be removed")
+	}
+
+	d.LoadSnapshot(Items{{Price: 3, Amount: 1}, {Price: 2, Amount: 1}, {Price: 1, Amount: 1}},
+		Items{{Price: 4, Amount: 1}, {Price: 5, Amount: 1}, {Price: 6, Amount: 1}},
+		0, time.Time{}, false)
+	if d.GetBidLength() != 2 || d.GetAskLength() != 2 {
+		t.Fatalf("expected snapshot to be truncated, received bids %v asks %v", d.GetBidLength(), d.GetAskLength())
+	}
+
+	// the smaller of the supplied and retained depth applies
+	d.UpdateBidAskByPrice(Items{{Price: 3.5, Amount: 1}}, Items{{Price: 3.6, Amount: 1}}, 10, 0, time.Time{})
+	book = d.Retrieve()
+	if len(book.Bids) != 2 || book.Bids[0].Price != 3.5 || len(book.Asks) != 2 || book.Asks[1].Price != 4 {
+		t.Fatalf("unexpected book after update %+v", book)
+	}
+	d.UpdateBidAskByPrice(Items{{Price: 3.7, Amount: 1}}, nil, 1, 0, time.Time{})
+	if d.GetBidLength() != 1 {
+		t.Fatalf("received '%v' expected '%v'", d.GetBidLength(), 1)
+	}
+
+	err = d.SetMaxDepth(0)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	d.LoadSnapshot(Items{{Price: 3, Amount: 1}, {Price: 2, Amount: 1}, {Price: 1, Amount: 1}}, nil, 0, time.Time{}, false)
+	if d.GetBidLength() != 3 {
+		t.Fatalf("received '%v' expected '%v'", d.GetBidLength(), 3)
+	}
+}
+
 func TestDeleteBidAskByID(t *testing.T) {
 	d := newDepth(id)
 	d.LoadSnapshot(Items{{Price: 1337, Amount: 1, ID: 1}}, Items{{Price: 1337, Amount: 10, ID: 2}}, 0, time.Time{}, false)
@@ -43,6 +43,52 @@ func SubscribeToExchangeOrderbooks(exchange string) (dispatch.Pipe, error) {
 	return service.Mux.Subscribe(exch.ID)
 }
 
+// SetMaxDepth sets the price levels retained on each side of an exchange's
+// orderbooks, truncating existing books. Zero retains all levels
+func SetMaxDepth(exchange string, maxDepth int) error {
+	return service.SetMaxDepth(exchange, maxDepth)
+}
+
+// SetMaxDepth sets the price levels retained on each side of an exchange's
+// orderbooks, truncating existing books and applying to books deployed later
+func (s *Service) SetMaxDepth(exchange string, maxDepth int) error {
+	if exchange == "" {
+		return errExchangeNameUnset
+	}
+	if maxDepth < 0 {
+		return fmt.Errorf("%s %w", exchange, ErrInvalidMaxDepth)
+	}
+	name := strings.ToLower(exchange)
+	s.Lock()
+	defer s.Unlock()
+	m1, ok := s.books[name]
+	if !ok {
+		if maxDepth == 0 {
+			return nil
+		}
+		id, err := s.Mux.GetID()
+		if err != nil {
+			return err
+		}
+		m1 = Exchange{
+			m:  make(map[asset.Item]map[*currency.Item]map[*currency.Item]*Depth),
+			ID: id,
+		}
+	}
+	m1.maxDepth = maxDepth
+	s.books[name] = m1
+	for _, m2 := range m1.m {
+		for _, m3 := range m2 {
+			for _, book := range m3 {
+				if err := book.SetMaxDepth(maxDepth); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
 // Update stores orderbook data
 func (s *Service) Update(b *Base) error {
 	name := strings.ToLower(b.Exchange)
@@ -76,6 +122,7 @@ func (s *Service) Update(b *Base) error {
 	book, ok := m3[b.Pair.Quote.Item]
 	if !ok {
 		book = newDepth(m1.ID)
+		book.maxDepth = m1.maxDepth
 		book.AssignOptions(b)
 		m3[b.Pair.Quote.Item] = book
 	}
@@ -123,6 +170,7 @@ func (s *Service) DeployDepth(exchange string, p currency.Pair, a asset.Item) (*
 	book, ok := m3[p.Quote.Item]
 	if !ok {
 		book = newDepth(m1.ID)
+		book.maxDepth = m1.maxDepth
 		book.exchange = exchange
 		book.pair = p
 		book.asset = a
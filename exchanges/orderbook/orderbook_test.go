@@ -320,6 +320,63 @@ func TestDeployDepth(t *testing.T) {
 	}
 }
 
+func TestServiceSetMaxDepth(t *testing.T) {
+	c, err := currency.NewPairFromStrings("BTC", "USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = SetMaxDepth("", 1)
+	if !errors.Is(err, errExchangeNameUnset) {
+		t.Fatalf("received '%v' expected '%v'", err, errExchangeNameUnset)
+	}
+	err = SetMaxDepth("MaxDepth", -1)
+	if !errors.Is(err, ErrInvalidMaxDepth) {
+		t.Fatalf("received '%v' expected '%v'", err, ErrInvalidMaxDepth)
+	}
+	d, err := DeployDepth("MaxDepth", c, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.LoadSnapshot(Items{{Price: 2, Amount: 1}, {Price: 1, Amount: 1}}, Items{{Price: 3, Amount: 1}}, 0, time.Time{}, false)
+	err = SetMaxDepth("maxdepth", 1)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if d.GetBidLength() != 1 {
+		t.Fatalf("expected existing book to be truncated, received %v", d.GetBidLength())
+	}
+
+	err = (&Base{
+		Exchange: "MaxDepth",
+		Pair:     currency.NewPair(currency.ETH, currency.USD),
+		Asset:    asset.Spot,
+		Bids:     Items{{Price: 2, Amount: 1}, {Price: 1, Amount: 1}},
+		Asks:     Items{{Price: 3, Amount: 1}, {Price: 4, Amount: 1}},
+	}).Process()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	book, err := Get("MaxDepth", currency.NewPair(currency.ETH, currency.USD), asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(book.Bids) != 1 || len(book.Asks) != 1 {
+		t.Fatalf("expected new book to be truncated, received bids %v asks %v", len(book.Bids), len(book.Asks))
+	}
+
+	// exchanges without books are not stored when retaining all levels
+	err = SetMaxDepth("NoMaxDepth", 0)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	service.Lock()
+	_, ok := service.books["nomaxdepth"]
+	service.Unlock()
+	if ok {
+		t.Fatal("unexpected exchange entry")
+	}
+}
+
 func TestCreateNewOrderbook(t *testing.T) {
 	c, err := currency.NewPairFromStrings("BTC", "USD")
 	if err != nil {
@@ -18,6 +18,9 @@ const (
 	bookLengthIssue    = "Potential book issue for exchange %s pair %s asset %s length Bids %d length Asks %d"
 )
 
+// ErrInvalidMaxDepth returns when a negative orderbook max depth is set
+var ErrInvalidMaxDepth = errors.New("max depth cannot be negative")
+
 // Vars for the orderbook package
 var (
 	errExchangeNameUnset   = errors.New("orderbook exchange name not set")
@@ -51,6 +54,9 @@ type Service struct {
 type Exchange struct {
 	m  map[asset.Item]map[*currency.Item]map[*currency.Item]*Depth
 	ID uuid.UUID
+	// maxDepth caps the price levels retained on each side of the exchange's
+	// depths, zero retains all levels
+	maxDepth int
 }
 
 // Item stores the amount and price values
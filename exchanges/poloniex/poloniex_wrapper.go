@@ -117,6 +117,10 @@ func (p *Poloniex) SetDefaults() {
 			Kline: kline.ExchangeCapabilitiesSupported{
 				Intervals: true,
 			},
+			OrderAmendment: order.AmendCapabilities{
+				Price:  true,
+				Amount: true,
+			},
 		},
 		Enabled: exchange.FeaturesEnabled{
 			AutoPairUpdates: true,
@@ -129,6 +133,7 @@ func (p *Poloniex) SetDefaults() {
 					kline.FourHour.Word():   true,
 					kline.OneDay.Word():     true,
 				},
+				ResultLimit: 500,
 			},
 		},
 	}
@@ -971,7 +976,66 @@ func (p *Poloniex) GetHistoricCandles(ctx context.Context, pair currency.Pair, a
 
 // GetHistoricCandlesExtended returns candles between a time period for a set time interval
 func (p *Poloniex) GetHistoricCandlesExtended(ctx context.Context, pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
-	return p.GetHistoricCandles(ctx, pair, a, start, end, interval)
+	if err := p.ValidateKline(pair, a, interval); err != nil {
+		return kline.Item{}, err
+	}
+
+	formattedPair, err := p.FormatExchangeCurrency(pair, a)
+	if err != nil {
+		return kline.Item{}, err
+	}
+
+	dates, err := kline.CalculateCandleDateRanges(start, end, interval, p.Features.Enabled.Kline.ResultLimit)
+	if err != nil {
+		return kline.Item{}, err
+	}
+
+	ret := kline.Item{
+		Exchange: p.Name,
+		Interval: interval,
+		Pair:     pair,
+		Asset:    a,
+	}
+
+	for x := range dates.Ranges {
+		// the first and last ranges keep the requested bounds, with the start
+		// truncated to the interval as poloniex returns a non-complete candle
+		// if the time does not match
+		rangeStart, rangeEnd := dates.Ranges[x].Start.Time, dates.Ranges[x].End.Time
+		if x == 0 {
+			rangeStart = start.Truncate(interval.Duration())
+		}
+		if x == len(dates.Ranges)-1 {
+			rangeEnd = end
+		}
+		var candles []ChartData
+		candles, err = p.GetChartData(ctx,
+			formattedPair.String(),
+			rangeStart, rangeEnd,
+			p.FormatExchangeKlineInterval(interval))
+		if err != nil {
+			return kline.Item{}, err
+		}
+
+		for i := range candles {
+			ret.Candles = append(ret.Candles, kline.Candle{
+				Time:   time.Unix(candles[i].Date, 0),
+				Open:   candles[i].Open,
+				High:   candles[i].High,
+				Low:    candles[i].Low,
+				Close:  candles[i].Close,
+				Volume: candles[i].Volume,
+			})
+		}
+	}
+	dates.SetHasDataFromCandles(ret.Candles)
+	summary := dates.DataSummary(false)
+	if len(summary) > 0 {
+		log.Warnf(log.ExchangeSys, "%v - %v", p.Name, summary)
+	}
+	ret.RemoveDuplicates()
+	ret.SortCandlesByTimestamp(false)
+	return ret, nil
 }
 
 // GetAvailableTransferChains returns the available transfer blockchains for the specific
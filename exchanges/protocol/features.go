@@ -44,4 +44,7 @@ type Features struct {
 	MultiChainDeposits                bool `json:"multiChainDeposits,omitempty"`
 	MultiChainWithdrawals             bool `json:"multiChainWithdrawals,omitempty"`
 	MultiChainDepositRequiresChainSet bool `json:"multiChainDepositRequiresChainSet,omitempty"`
+	// ClientOrderID defines if generated UUID client order IDs are accepted
+	// when submitting orders for every supported asset
+	ClientOrderID bool `json:"clientOrderID,omitempty"`
 }
@@ -0,0 +1,96 @@
+package request
+
+import (
+	"context"
+	"sync"
+)
+
+// Consumer defines the subsystem an outbound request is made on behalf of,
+// this allows a budget arbiter to prioritise requests between subsystems which
+// share an exchange's rate limit
+type Consumer uint8
+
+// Consumers which can be attached to a request context
+const (
+	UnsetConsumer Consumer = iota
+	UserRPC
+	OrderManagement
+	DataHistory
+	CurrencyPairSyncer
+)
+
+// BudgetArbiter arbitrates an exchange's REST rate limit budget between
+// consumers. Acquire blocks until the consumer may send a request to the
+// exchange or the context is cancelled
+type BudgetArbiter interface {
+	Acquire(ctx context.Context, exchange string, c Consumer) error
+}
+
+type consumerKey struct{}
+
+var (
+	budgetArbiter    BudgetArbiter
+	budgetArbiterMtx sync.RWMutex
+)
+
+// String returns the consumer name
+func (c Consumer) String() string {
+	switch c {
+	case UserRPC:
+		return "user_rpc"
+	case OrderManagement:
+		return "order_management"
+	case DataHistory:
+		return "data_history"
+	case CurrencyPairSyncer:
+		return "currency_pair_syncer"
+	default:
+		return "unset"
+	}
+}
+
+// IsBackground returns whether the consumer is a background process which
+// should yield to interactive requests. Only user RPC and order management
+// requests are interactive, so untagged requests cannot preempt tagged
+// background subsystems
+func (c Consumer) IsBackground() bool {
+	return c != UserRPC && c != OrderManagement
+}
+
+// WithConsumer returns a copy of the parent context which tags outbound
+// requests as being made on behalf of the supplied consumer
+func WithConsumer(ctx context.Context, c Consumer) context.Context {
+	return context.WithValue(ctx, consumerKey{}, c)
+}
+
+// ConsumerFromContext returns the consumer a context has been tagged with,
+// untagged contexts return UnsetConsumer
+func ConsumerFromContext(ctx context.Context) Consumer {
+	if ctx == nil {
+		return UnsetConsumer
+	}
+	c, ok := ctx.Value(consumerKey{}).(Consumer)
+	if !ok {
+		return UnsetConsumer
+	}
+	return c
+}
+
+// SetBudgetArbiter sets the arbiter consulted by all requesters before an
+// outbound request is rate limited, a nil arbiter disables arbitration
+func SetBudgetArbiter(a BudgetArbiter) {
+	budgetArbiterMtx.Lock()
+	budgetArbiter = a
+	budgetArbiterMtx.Unlock()
+}
+
+// acquireBudget waits for the budget arbiter, if set, to allow the request
+func (r *Requester) acquireBudget(ctx context.Context) error {
+	budgetArbiterMtx.RLock()
+	a := budgetArbiter
+	budgetArbiterMtx.RUnlock()
+	if a == nil {
+		return nil
+	}
+	return a.Acquire(ctx, r.Name, ConsumerFromContext(ctx))
+}
@@ -0,0 +1,79 @@
+package request
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+var errBudgetExhausted = errors.New("budget exhausted")
+
+type testArbiter struct {
+	exchange string
+	consumer Consumer
+}
+
+func (a *testArbiter) Acquire(_ context.Context, exchange string, c Consumer) error {
+	if exchange != "budgettest" {
+		return nil
+	}
+	a.exchange = exchange
+	a.consumer = c
+	if c.IsBackground() {
+		return errBudgetExhausted
+	}
+	return nil
+}
+
+func TestConsumerFromContext(t *testing.T) {
+	t.Parallel()
+	if c := ConsumerFromContext(context.Background()); c != UnsetConsumer {
+		t.Errorf("received '%v', expected '%v'", c, UnsetConsumer)
+	}
+	ctx := WithConsumer(context.Background(), DataHistory)
+	if c := ConsumerFromContext(ctx); c != DataHistory {
+		t.Errorf("received '%v', expected '%v'", c, DataHistory)
+	}
+	if c := ConsumerFromContext(nil); c != UnsetConsumer { // nolint:staticcheck // testing nil context
+		t.Errorf("received '%v', expected '%v'", c, UnsetConsumer)
+	}
+}
+
+func TestConsumerIsBackground(t *testing.T) {
+	t.Parallel()
+	if UserRPC.IsBackground() || OrderManagement.IsBackground() {
+		t.Error("expected interactive consumer")
+	}
+	if !DataHistory.IsBackground() || !CurrencyPairSyncer.IsBackground() || !UnsetConsumer.IsBackground() {
+		t.Error("expected background consumer")
+	}
+	if CurrencyPairSyncer.String() != "currency_pair_syncer" {
+		t.Errorf("received '%v', expected '%v'", CurrencyPairSyncer.String(), "currency_pair_syncer")
+	}
+}
+
+func TestAcquireBudget(t *testing.T) {
+	r := New("budgettest", new(http.Client))
+	err := r.acquireBudget(WithConsumer(context.Background(), CurrencyPairSyncer))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+
+	a := &testArbiter{}
+	SetBudgetArbiter(a)
+	defer SetBudgetArbiter(nil)
+
+	err = r.acquireBudget(WithConsumer(context.Background(), UserRPC))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if a.exchange != "budgettest" || a.consumer != UserRPC {
+		t.Errorf("unexpected arbiter values %v %v", a.exchange, a.consumer)
+	}
+
+	err = r.acquireBudget(WithConsumer(context.Background(), CurrencyPairSyncer))
+	if !errors.Is(err, errBudgetExhausted) {
+		t.Fatalf("received '%v', expected '%v'", err, errBudgetExhausted)
+	}
+}
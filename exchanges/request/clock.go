@@ -0,0 +1,105 @@
+package request
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+var (
+	errServerTimeUnset = errors.New("server time function unset")
+	errNilServerTime   = errors.New("server time is unset")
+)
+
+// Now returns the local time corrected by the measured clock skew. It should
+// be used when signing requests which are rejected outside of a receive window
+func (r *Requester) Now() time.Time {
+	if r == nil {
+		return time.Now()
+	}
+	return time.Now().Add(time.Duration(atomic.LoadInt64(&r.clockSkew)))
+}
+
+// GetClockSkew returns how far the exchange server clock is ahead of the local
+// clock and when it was last measured. A zero time means the skew has not
+// been measured
+func (r *Requester) GetClockSkew() (time.Duration, time.Time) {
+	if r == nil {
+		return 0, time.Time{}
+	}
+	var lastSync time.Time
+	if ls := atomic.LoadInt64(&r.lastClockSync); ls > 0 {
+		lastSync = time.Unix(0, ls)
+	}
+	return time.Duration(atomic.LoadInt64(&r.clockSkew)), lastSync
+}
+
+// SetClockSkew manually sets how far the exchange server clock is ahead of the
+// local clock
+func (r *Requester) SetClockSkew(skew time.Duration) error {
+	if r == nil {
+		return errRequestSystemIsNil
+	}
+	atomic.StoreInt64(&r.clockSkew, int64(skew))
+	atomic.StoreInt64(&r.lastClockSync, time.Now().UnixNano())
+	return nil
+}
+
+// SyncClock measures and stores the clock skew using the server time function
+// set by WithServerTime
+func (r *Requester) SyncClock(ctx context.Context) (time.Duration, error) {
+	if r == nil {
+		return 0, errRequestSystemIsNil
+	}
+	if r.serverTime == nil {
+		return 0, errServerTimeUnset
+	}
+	return r.MeasureClockSkew(ctx, r.serverTime)
+}
+
+// MeasureClockSkew fetches the server time and stores the difference between
+// it and the local time at the midpoint of the request, to account for
+// latency
+func (r *Requester) MeasureClockSkew(ctx context.Context, serverTime ServerTime) (time.Duration, error) {
+	if r == nil {
+		return 0, errRequestSystemIsNil
+	}
+	if serverTime == nil {
+		return 0, errServerTimeUnset
+	}
+	start := time.Now()
+	st, err := serverTime(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if st.IsZero() {
+		return 0, errNilServerTime
+	}
+	end := time.Now()
+	skew := st.Sub(start.Add(end.Sub(start) / 2))
+	return skew, r.SetClockSkew(skew)
+}
+
+// syncClockIfDue measures the clock skew when a server time function is set
+// and the sync interval has elapsed. Failures are logged so the pending
+// request can continue with the previous measurement
+func (r *Requester) syncClockIfDue(ctx context.Context) {
+	if r.serverTime == nil ||
+		time.Now().UnixNano() < atomic.LoadInt64(&r.nextClockSync) ||
+		!atomic.CompareAndSwapInt32(&r.clockSyncing, 0, 1) {
+		return
+	}
+	defer atomic.StoreInt32(&r.clockSyncing, 0)
+	// Set before syncing so the server time request itself, and requests
+	// following a failure, do not trigger another measurement
+	atomic.StoreInt64(&r.nextClockSync, time.Now().Add(clockSyncInterval).UnixNano())
+	skew, err := r.SyncClock(ctx)
+	if err != nil {
+		log.Warnf(log.RequestSys, "%s failed to measure clock skew: %v", r.Name, err)
+		return
+	}
+	log.Debugf(log.RequestSys, "%s clock skew measured at %s", r.Name, skew)
+}
@@ -0,0 +1,110 @@
+package request
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+var errServerDown = errors.New("server down")
+
+func TestMeasureClockSkew(t *testing.T) {
+	t.Parallel()
+	var r *Requester
+	_, err := r.MeasureClockSkew(context.Background(), nil)
+	if !errors.Is(err, errRequestSystemIsNil) {
+		t.Errorf("received '%v', expected '%v'", err, errRequestSystemIsNil)
+	}
+	if skew, lastSync := r.GetClockSkew(); skew != 0 || !lastSync.IsZero() {
+		t.Errorf("received '%v' '%v', expected no skew", skew, lastSync)
+	}
+
+	r = New("clocktest", new(http.Client))
+	_, err = r.MeasureClockSkew(context.Background(), nil)
+	if !errors.Is(err, errServerTimeUnset) {
+		t.Errorf("received '%v', expected '%v'", err, errServerTimeUnset)
+	}
+	_, err = r.MeasureClockSkew(context.Background(), func(context.Context) (time.Time, error) {
+		return time.Time{}, nil
+	})
+	if !errors.Is(err, errNilServerTime) {
+		t.Errorf("received '%v', expected '%v'", err, errNilServerTime)
+	}
+	_, err = r.MeasureClockSkew(context.Background(), func(context.Context) (time.Time, error) {
+		return time.Time{}, errServerDown
+	})
+	if !errors.Is(err, errServerDown) {
+		t.Errorf("received '%v', expected '%v'", err, errServerDown)
+	}
+
+	skew, err := r.MeasureClockSkew(context.Background(), func(context.Context) (time.Time, error) {
+		return time.Now().Add(-time.Hour), nil
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if skew > -time.Hour+time.Second || skew < -time.Hour-time.Second {
+		t.Errorf("received '%v', expected '%v'", skew, -time.Hour)
+	}
+	stored, lastSync := r.GetClockSkew()
+	if stored != skew || lastSync.IsZero() {
+		t.Errorf("received '%v' '%v', expected '%v'", stored, lastSync, skew)
+	}
+	if time.Until(r.Now()) > -time.Hour+time.Second {
+		t.Errorf("expected corrected time to be an hour behind, received %v", r.Now())
+	}
+}
+
+func TestSyncClockIfDue(t *testing.T) {
+	t.Parallel()
+	r := New("clocktest", new(http.Client))
+	if _, err := r.SyncClock(context.Background()); !errors.Is(err, errServerTimeUnset) {
+		t.Errorf("received '%v', expected '%v'", err, errServerTimeUnset)
+	}
+	var calls int
+	r = New("clocktest", new(http.Client), WithServerTime(func(context.Context) (time.Time, error) {
+		calls++
+		return time.Time{}, errServerDown
+	}))
+	r.syncClockIfDue(context.Background())
+	r.syncClockIfDue(context.Background())
+	if calls != 1 {
+		t.Errorf("received '%v', expected '%v'", calls, 1)
+	}
+	if _, lastSync := r.GetClockSkew(); !lastSync.IsZero() {
+		t.Errorf("expected failed measurement not to be stored, received %v", lastSync)
+	}
+}
+
+type countingLimiter struct {
+	calls int
+}
+
+func (c *countingLimiter) Limit(context.Context, EndpointLimit) error {
+	c.calls++
+	return nil
+}
+
+func TestSyncClockBeforeRateLimit(t *testing.T) {
+	t.Parallel()
+	l := &countingLimiter{}
+	limited := -1
+	r := New("clocktest", new(http.Client), WithLimiter(l), WithServerTime(func(context.Context) (time.Time, error) {
+		limited = l.calls
+		return time.Now(), nil
+	}))
+	err := r.SendPayload(context.Background(), Unset, func() (*Item, error) {
+		return &Item{Method: http.MethodGet, Path: testURL}, nil
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if limited != 0 {
+		t.Errorf("received '%v' rate limit reservations before the clock sync, expected '%v'", limited, 0)
+	}
+	if l.calls != 1 {
+		t.Errorf("received '%v', expected '%v'", l.calls, 1)
+	}
+}
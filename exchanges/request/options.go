@@ -20,3 +20,11 @@ func WithRetryPolicy(p RetryPolicy) RequesterOption {
 		r.retryPolicy = p
 	}
 }
+
+// WithServerTime configures the server time function a Requester uses to
+// periodically measure and correct local clock skew.
+func WithServerTime(s ServerTime) RequesterOption {
+	return func(r *Requester) {
+		r.serverTime = s
+	}
+}
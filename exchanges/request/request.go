@@ -123,8 +123,20 @@ func (r *Requester) doRequest(ctx context.Context, endpoint EndpointLimit, newRe
 		default:
 		}
 
+		// Measure clock skew before the request is generated so signed
+		// timestamps use the corrected time. This is done before acquiring
+		// the budget and rate limit so the server time request does not
+		// queue behind the reservation made for this request
+		r.syncClockIfDue(ctx)
+
+		// Wait for the subsystem budget before consuming exchange rate limits
+		err := r.acquireBudget(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to acquire rate limit budget: %w", err)
+		}
+
 		// Initiate a rate limit reservation and sleep on requested endpoint
-		err := r.InitiateRateLimit(ctx, endpoint)
+		err = r.InitiateRateLimit(ctx, endpoint)
 		if err != nil {
 			return fmt.Errorf("failed to rate limit HTTP request: %w", err)
 		}
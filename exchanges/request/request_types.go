@@ -1,6 +1,7 @@
 package request
 
 import (
+	"context"
 	"io"
 	"net/http"
 	"time"
@@ -16,6 +17,7 @@ const (
 	DefaultMutexLockTimeout       = 50 * time.Millisecond
 	drainBodyLimit                = 100000
 	proxyTLSTimeout               = 15 * time.Second
+	clockSyncInterval             = 30 * time.Minute
 	userAgent                     = "User-Agent"
 )
 
@@ -38,6 +40,11 @@ type Requester struct {
 	backoff            Backoff
 	retryPolicy        RetryPolicy
 	timedLock          *timedmutex.TimedMutex
+	serverTime         ServerTime
+	clockSkew          int64 // nanoseconds
+	lastClockSync      int64 // unix nanoseconds
+	nextClockSync      int64 // unix nanoseconds
+	clockSyncing       int32
 }
 
 // Item is a temp item for requests
@@ -72,3 +79,7 @@ type RequesterOption func(*Requester)
 // being outside of receive window if application rate limiting reduces outbound
 // requests.
 type Generate func() (*Item, error)
+
+// ServerTime defines a closure which returns the current time of the exchange
+// server, used to measure the clock skew of the local machine
+type ServerTime func(ctx context.Context) (time.Time, error)
@@ -55,6 +55,15 @@ func (w *Orderbook) Setup(cfg *config.Exchange, sortBuffer, sortBufferByUpdateID
 		orderbookPublishPeriod = *cfg.Orderbook.PublishPeriod
 	}
 	w.publishPeriod = orderbookPublishPeriod
+
+	// Levels removed beyond the max depth can still be referenced by ID in
+	// later updates, which would then fail, so full depth is retained
+	if updateEntriesByID && cfg.Orderbook.MaxDepth > 0 {
+		log.Warnf(log.ExchangeSys,
+			"%s orderbook max depth is not supported for books updated by ID, retaining full depth.",
+			cfg.Name)
+		return orderbook.SetMaxDepth(cfg.Name, 0)
+	}
 	return nil
 }
 
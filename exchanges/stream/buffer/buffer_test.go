@@ -747,6 +747,24 @@ func TestSetup(t *testing.T) {
 		w.exchangeName != "test" {
 		t.Errorf("Setup incorrectly loaded %s", w.exchangeName)
 	}
+
+	exchangeConfig.Name = "maxDepthByID"
+	err = orderbook.SetMaxDepth(exchangeConfig.Name, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	depth, err := orderbook.DeployDepth(exchangeConfig.Name, cp, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exchangeConfig.Orderbook.MaxDepth = 5
+	err = w.Setup(exchangeConfig, false, false, true, make(chan interface{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if depth.GetMaxDepth() != 0 {
+		t.Errorf("expected full depth to be retained for books updated by ID, received %v", depth.GetMaxDepth())
+	}
 }
 
 func TestValidate(t *testing.T) {
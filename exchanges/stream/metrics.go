@@ -0,0 +1,203 @@
+package stream
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Metric names for websocket connections
+const (
+	UnauthenticatedConnection = "unauthenticated"
+	AuthenticatedConnection   = "authenticated"
+
+	metricsRateWindow = 10 * time.Second
+)
+
+// Metrics tracks message rates, payload bytes and processing lag for a
+// websocket's connections and channels. Connection metrics are recorded for
+// every message read and count bytes as received over the wire, channel
+// metrics are recorded by the exchange once it has identified and processed a
+// message and count decompressed payload bytes
+type Metrics struct {
+	mtx         sync.Mutex
+	connections map[string]*metric
+	channels    map[string]*metric
+}
+
+// metric holds the running totals for a connection or channel
+type metric struct {
+	messages       uint64
+	bytes          uint64
+	lastMessage    time.Time
+	windowStart    time.Time
+	windowMessages uint64
+	windowBytes    uint64
+	messageRate    float64
+	byteRate       float64
+	rateMeasured   bool
+	lastLag        time.Duration
+	maxLag         time.Duration
+	totalLag       time.Duration
+	lagSamples     uint64
+}
+
+// MessageMetrics is a snapshot of the throughput of a connection or channel.
+// Rates are measured over the last complete window of metricsRateWindow
+type MessageMetrics struct {
+	Name              string
+	Messages          uint64
+	Bytes             uint64
+	MessagesPerSecond float64
+	BytesPerSecond    float64
+	LastMessage       time.Time
+	LastLag           time.Duration
+	AverageLag        time.Duration
+	MaxLag            time.Duration
+}
+
+// MetricsSnapshot holds the metrics of all connections and channels sorted by
+// name
+type MetricsSnapshot struct {
+	Connections []MessageMetrics
+	Channels    []MessageMetrics
+}
+
+// RecordMessage records a message which has been handled for a channel. The
+// processing lag is measured from when the response was received
+func (m *Metrics) RecordMessage(channel string, resp *Response) {
+	if m == nil || resp == nil {
+		return
+	}
+	now := time.Now()
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if m.channels == nil {
+		m.channels = make(map[string]*metric)
+	}
+	c, ok := m.channels[channel]
+	if !ok {
+		c = &metric{}
+		m.channels[channel] = c
+	}
+	c.record(len(resp.Raw), now)
+	if !resp.Received.IsZero() {
+		c.recordLag(now.Sub(resp.Received))
+	}
+}
+
+// recordConnection records a message read from a connection
+func (m *Metrics) recordConnection(name string, payloadBytes int, received time.Time) {
+	if m == nil {
+		return
+	}
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if m.connections == nil {
+		m.connections = make(map[string]*metric)
+	}
+	c, ok := m.connections[name]
+	if !ok {
+		c = &metric{}
+		m.connections[name] = c
+	}
+	c.record(payloadBytes, received)
+}
+
+// Snapshot returns the current metrics of all connections and channels
+func (m *Metrics) Snapshot() MetricsSnapshot {
+	if m == nil {
+		return MetricsSnapshot{}
+	}
+	now := time.Now()
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	return MetricsSnapshot{
+		Connections: snapshotMetrics(m.connections, now),
+		Channels:    snapshotMetrics(m.channels, now),
+	}
+}
+
+// Reset clears all recorded metrics
+func (m *Metrics) Reset() {
+	if m == nil {
+		return
+	}
+	m.mtx.Lock()
+	m.connections = nil
+	m.channels = nil
+	m.mtx.Unlock()
+}
+
+func snapshotMetrics(metrics map[string]*metric, now time.Time) []MessageMetrics {
+	if len(metrics) == 0 {
+		return nil
+	}
+	resp := make([]MessageMetrics, 0, len(metrics))
+	for name, c := range metrics {
+		messageRate, byteRate := c.rates(now)
+		s := MessageMetrics{
+			Name:              name,
+			Messages:          c.messages,
+			Bytes:             c.bytes,
+			MessagesPerSecond: messageRate,
+			BytesPerSecond:    byteRate,
+			LastMessage:       c.lastMessage,
+			LastLag:           c.lastLag,
+			MaxLag:            c.maxLag,
+		}
+		if c.lagSamples > 0 {
+			s.AverageLag = c.totalLag / time.Duration(c.lagSamples)
+		}
+		resp = append(resp, s)
+	}
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].Name < resp[j].Name
+	})
+	return resp
+}
+
+// record adds a message to the totals, closing the rate window once it has
+// elapsed
+func (c *metric) record(payloadBytes int, now time.Time) {
+	if c.windowStart.IsZero() {
+		c.windowStart = now
+	}
+	if elapsed := now.Sub(c.windowStart); elapsed >= metricsRateWindow {
+		c.messageRate = float64(c.windowMessages) / elapsed.Seconds()
+		c.byteRate = float64(c.windowBytes) / elapsed.Seconds()
+		c.rateMeasured = true
+		c.windowStart = now
+		c.windowMessages = 0
+		c.windowBytes = 0
+	}
+	c.messages++
+	c.bytes += uint64(payloadBytes)
+	c.windowMessages++
+	c.windowBytes += uint64(payloadBytes)
+	c.lastMessage = now
+}
+
+func (c *metric) recordLag(lag time.Duration) {
+	c.lastLag = lag
+	if lag > c.maxLag {
+		c.maxLag = lag
+	}
+	c.totalLag += lag
+	c.lagSamples++
+}
+
+// rates returns the rates of the last complete window. When the current
+// window has elapsed without a new message closing it, or no window has
+// completed, the current window's rates are returned so that idle channels
+// decay to zero
+func (c *metric) rates(now time.Time) (messageRate, byteRate float64) {
+	elapsed := now.Sub(c.windowStart)
+	if c.rateMeasured && elapsed < metricsRateWindow {
+		return c.messageRate, c.byteRate
+	}
+	if elapsed <= 0 {
+		return 0, 0
+	}
+	return float64(c.windowMessages) / elapsed.Seconds(), float64(c.windowBytes) / elapsed.Seconds()
+}
@@ -0,0 +1,70 @@
+package stream
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMetricsRecordMessage(t *testing.T) {
+	t.Parallel()
+	var m *Metrics
+	m.RecordMessage("ticker", &Response{Raw: []byte("test")})
+	if s := m.Snapshot(); len(s.Channels) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(s.Channels), 0)
+	}
+
+	m = &Metrics{}
+	m.RecordMessage("ticker", nil)
+	m.RecordMessage("trades", &Response{Raw: []byte("test")})
+	m.RecordMessage("depth", &Response{Raw: []byte("test"), Received: time.Now().Add(-time.Second)})
+	m.RecordMessage("depth", &Response{Raw: []byte("tests"), Received: time.Now()})
+	s := m.Snapshot()
+	if len(s.Channels) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(s.Channels), 2)
+	}
+	if s.Channels[0].Name != "depth" || s.Channels[1].Name != "trades" {
+		t.Errorf("expected channels to be sorted by name, received %v", s.Channels)
+	}
+	depth := s.Channels[0]
+	if depth.Messages != 2 || depth.Bytes != 9 {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", depth.Messages, depth.Bytes, 2, 9)
+	}
+	if depth.MaxLag < time.Second || depth.LastLag >= time.Second || depth.AverageLag < time.Second/2 {
+		t.Errorf("unexpected lag %+v", depth)
+	}
+	if s.Channels[1].MaxLag != 0 {
+		t.Errorf("expected responses without a received time to not record lag, received %v", s.Channels[1].MaxLag)
+	}
+
+	m.Reset()
+	if s = m.Snapshot(); len(s.Channels) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(s.Channels), 0)
+	}
+}
+
+func TestMetricsRates(t *testing.T) {
+	t.Parallel()
+	m := &Metrics{}
+	start := time.Now().Add(-metricsRateWindow * 2)
+	for i := 0; i < 10; i++ {
+		m.recordConnection(UnauthenticatedConnection, 100, start)
+	}
+	// closes the first window, its rate is used until the next one elapses
+	m.recordConnection(UnauthenticatedConnection, 100, start.Add(metricsRateWindow*3/2))
+	c := m.connections[UnauthenticatedConnection]
+	messageRate, byteRate := c.rates(start.Add(metricsRateWindow * 2))
+	expected := 10 / (metricsRateWindow * 3 / 2).Seconds()
+	if expectedBytes := 1000 / (metricsRateWindow * 3 / 2).Seconds(); messageRate != expected || byteRate != expectedBytes {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", messageRate, byteRate, expected, expectedBytes)
+	}
+	// once the current window elapses without being closed, the rate decays
+	messageRate, _ = c.rates(start.Add(metricsRateWindow * 5))
+	if expected = 1 / (metricsRateWindow * 7 / 2).Seconds(); messageRate != expected {
+		t.Errorf("received '%v' expected '%v'", messageRate, expected)
+	}
+
+	s := m.Snapshot()
+	if len(s.Connections) != 1 || s.Connections[0].Messages != 11 || s.Connections[0].Bytes != 1100 {
+		t.Errorf("unexpected connection metrics %+v", s.Connections)
+	}
+}
@@ -27,8 +27,9 @@ type Connection interface {
 
 // Response defines generalised data from the stream connection
 type Response struct {
-	Type int
-	Raw  []byte
+	Type     int
+	Raw      []byte
+	Received time.Time
 }
 
 // ChannelSubscription container for streaming subscriptions
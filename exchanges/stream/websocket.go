@@ -201,9 +201,12 @@ func (w *Websocket) SetupNewConnection(c ConnectionSetup) error {
 		Wg:                w.Wg,
 		Match:             w.Match,
 		RateLimit:         c.RateLimit,
+		metrics:           &w.Metrics,
+		metricsName:       UnauthenticatedConnection,
 	}
 
 	if c.Authenticated {
+		newConn.metricsName = AuthenticatedConnection
 		w.AuthConn = newConn
 	} else {
 		w.Conn = newConn
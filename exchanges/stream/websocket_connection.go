@@ -219,6 +219,8 @@ func (w *WebsocketConnection) ReadMessage() Response {
 		}
 		return Response{}
 	}
+	received := time.Now()
+	w.metrics.recordConnection(w.metricsName, len(resp), received)
 
 	select {
 	case w.Traffic <- struct{}{}:
@@ -245,7 +247,7 @@ func (w *WebsocketConnection) ReadMessage() Response {
 			w.ExchangeName,
 			string(standardMessage))
 	}
-	return Response{Raw: standardMessage, Type: mType}
+	return Response{Raw: standardMessage, Type: mType, Received: received}
 }
 
 // parseBinaryResponse parses a websocket binary response into a usable byte array
@@ -80,6 +80,9 @@ type Websocket struct {
 	// Fills is a notifier of occurring fills
 	Fills fill.Fills
 
+	// Metrics tracks message throughput per connection and channel
+	Metrics Metrics
+
 	// trafficAlert monitors if there is a halt in traffic throughput
 	TrafficAlert chan struct{}
 	// ReadMessageErrors will received all errors from ws.ReadMessage() and
@@ -135,4 +138,7 @@ type WebsocketConnection struct {
 	ResponseMaxLimit  time.Duration
 	Traffic           chan struct{}
 	readMessageErrors chan error
+
+	metrics     *Metrics
+	metricsName string
 }
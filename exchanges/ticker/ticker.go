@@ -16,12 +16,15 @@ var (
 	errInvalidTicker       = errors.New("invalid ticker")
 	errTickerNotFound      = errors.New("ticker not found")
 	errExchangeNameIsEmpty = errors.New("exchange name is empty")
+	errSubscriptionIsNil   = errors.New("ticker change subscription is nil")
+	errAlreadyUnsubscribed = errors.New("ticker change subscription already unsubscribed")
 )
 
 func init() {
 	service = new(Service)
 	service.Tickers = make(map[string]map[*currency.Item]map[*currency.Item]map[asset.Item]*Ticker)
 	service.Exchange = make(map[string]uuid.UUID)
+	service.subscriptions = make(map[string][]*ChangeSubscription)
 	service.mux = dispatch.GetNewMux()
 }
 
@@ -56,6 +59,87 @@ func SubscribeToExchangeTickers(exchange string) (dispatch.Pipe, error) {
 	return service.mux.Subscribe(id)
 }
 
+// SubscribeTickerChanges returns a channel based subscription which is
+// notified every time a new price is stored for the exchange. An empty pair or
+// asset will match all pairs or assets respectively. Unlike SubscribeTicker
+// the ticker does not need to exist prior to subscribing
+func SubscribeTickerChanges(exchange string, p currency.Pair, a asset.Item) (*ChangeSubscription, error) {
+	if exchange == "" {
+		return nil, errExchangeNameIsEmpty
+	}
+	if a != "" && !a.IsValid() {
+		return nil, fmt.Errorf("%s %w", a, asset.ErrNotSupported)
+	}
+	sub := &ChangeSubscription{
+		exchange: strings.ToLower(exchange),
+		pair:     p,
+		asset:    a,
+		ch:       make(chan *Price, 1),
+	}
+	service.Lock()
+	service.subscriptions[sub.exchange] = append(service.subscriptions[sub.exchange], sub)
+	service.Unlock()
+	return sub, nil
+}
+
+// Updates returns the channel which receives ticker price updates, the
+// channel is closed when the subscription is unsubscribed
+func (c *ChangeSubscription) Updates() <-chan *Price {
+	return c.ch
+}
+
+// Unsubscribe stops ticker change notifications and closes the update channel
+func (c *ChangeSubscription) Unsubscribe() error {
+	if c == nil {
+		return errSubscriptionIsNil
+	}
+	service.Lock()
+	subs := service.subscriptions[c.exchange]
+	for x := range subs {
+		if subs[x] == c {
+			service.subscriptions[c.exchange] = append(subs[:x:x], subs[x+1:]...)
+			break
+		}
+	}
+	service.Unlock()
+
+	c.m.Lock()
+	defer c.m.Unlock()
+	if c.closed {
+		return errAlreadyUnsubscribed
+	}
+	c.closed = true
+	close(c.ch)
+	return nil
+}
+
+// matches determines if the price update is relevant to the subscription
+func (c *ChangeSubscription) matches(p *Price) bool {
+	return (c.pair.IsEmpty() || c.pair.Equal(p.Pair)) &&
+		(c.asset == "" || c.asset == p.AssetType)
+}
+
+// notify pushes a copy of the price to the subscriber, replacing any pending
+// price which has not yet been consumed so the subscriber never blocks the
+// ticker service
+func (c *ChangeSubscription) notify(p Price) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	if c.closed {
+		return
+	}
+	select {
+	case c.ch <- &p:
+		return
+	default:
+	}
+	select {
+	case <-c.ch:
+	default:
+	}
+	c.ch <- &p
+}
+
 // GetTicker checks and returns a requested ticker if it exists
 func GetTicker(exchange string, p currency.Pair, a asset.Item) (*Price, error) {
 	exchange = strings.ToLower(exchange)
@@ -166,6 +250,7 @@ func (s *Service) update(p *Price) error {
 		m2[p.Pair.Quote.Item] = m3
 	}
 
+	subs := s.getSubscriptions(name, p)
 	t, ok := m3[p.AssetType]
 	if !ok || t == nil {
 		newTicker := &Ticker{}
@@ -176,6 +261,7 @@ func (s *Service) update(p *Price) error {
 		}
 		m3[p.AssetType] = newTicker
 		s.Unlock()
+		notifySubscriptions(subs, p)
 		return nil
 	}
 
@@ -183,9 +269,29 @@ func (s *Service) update(p *Price) error {
 	// nolint: gocritic
 	ids := append(t.Assoc, t.Main)
 	s.Unlock()
+	notifySubscriptions(subs, p)
 	return s.mux.Publish(ids, p)
 }
 
+// getSubscriptions returns the change subscriptions matching the price
+// update, the service lock must be held by the caller
+func (s *Service) getSubscriptions(exch string, p *Price) []*ChangeSubscription {
+	var subs []*ChangeSubscription
+	for _, sub := range s.subscriptions[exch] {
+		if sub.matches(p) {
+			subs = append(subs, sub)
+		}
+	}
+	return subs
+}
+
+// notifySubscriptions pushes the stored price to change subscribers
+func notifySubscriptions(subs []*ChangeSubscription, p *Price) {
+	for x := range subs {
+		subs[x].notify(*p)
+	}
+}
+
 // setItemID retrieves and sets dispatch mux publish IDs
 func (s *Service) setItemID(t *Ticker, p *Price, exch string) error {
 	ids, err := s.getAssociations(exch)
@@ -416,3 +416,69 @@ func TestGetAssociation(t *testing.T) {
 
 	service.mux = cpyMux
 }
+
+func TestSubscribeTickerChanges(t *testing.T) {
+	t.Parallel()
+	_, err := SubscribeTickerChanges("", currency.Pair{}, "")
+	if !errors.Is(err, errExchangeNameIsEmpty) {
+		t.Errorf("received '%v' expected '%v'", err, errExchangeNameIsEmpty)
+	}
+	_, err = SubscribeTickerChanges("subscribeme", currency.Pair{}, "bad")
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v' expected '%v'", err, asset.ErrNotSupported)
+	}
+
+	p := currency.NewPair(currency.BTC, currency.USD)
+	pairSub, err := SubscribeTickerChanges("SubscribeMe", p, asset.Spot)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	allSub, err := SubscribeTickerChanges("subscribeme", currency.Pair{}, "")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	// first update creates the ticker and must still notify
+	err = ProcessTicker(&Price{Pair: p, ExchangeName: "subscribeme", AssetType: asset.Spot, Last: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// conflated update replaces the unconsumed price
+	err = ProcessTicker(&Price{Pair: p, ExchangeName: "subscribeme", AssetType: asset.Spot, Last: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if price := <-pairSub.Updates(); price.Last != 2 {
+		t.Errorf("received '%v' expected '%v'", price.Last, 2)
+	}
+
+	err = ProcessTicker(&Price{Pair: currency.NewPair(currency.LTC, currency.USD), ExchangeName: "subscribeme", AssetType: asset.Spot, Last: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-pairSub.Updates():
+		t.Error("unexpected update for different pair")
+	default:
+	}
+	if price := <-allSub.Updates(); price.Last != 3 {
+		t.Errorf("received '%v' expected '%v'", price.Last, 3)
+	}
+
+	err = pairSub.Unsubscribe()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	err = pairSub.Unsubscribe()
+	if !errors.Is(err, errAlreadyUnsubscribed) {
+		t.Errorf("received '%v' expected '%v'", err, errAlreadyUnsubscribed)
+	}
+	if _, ok := <-pairSub.Updates(); ok {
+		t.Error("expected closed channel")
+	}
+	var nilSub *ChangeSubscription
+	err = nilSub.Unsubscribe()
+	if !errors.Is(err, errSubscriptionIsNil) {
+		t.Errorf("received '%v' expected '%v'", err, errSubscriptionIsNil)
+	}
+}
@@ -25,9 +25,10 @@ var (
 
 // Service holds ticker information for each individual exchange
 type Service struct {
-	Tickers  map[string]map[*currency.Item]map[*currency.Item]map[asset.Item]*Ticker
-	Exchange map[string]uuid.UUID
-	mux      *dispatch.Mux
+	Tickers       map[string]map[*currency.Item]map[*currency.Item]map[asset.Item]*Ticker
+	Exchange      map[string]uuid.UUID
+	subscriptions map[string][]*ChangeSubscription
+	mux           *dispatch.Mux
 	sync.Mutex
 }
 
@@ -63,3 +64,15 @@ type Ticker struct {
 	Main  uuid.UUID
 	Assoc []uuid.UUID
 }
+
+// ChangeSubscription receives a push notification every time ProcessTicker
+// stores a new price matching its exchange, and optionally pair and asset.
+// Only the most recent price is retained when the consumer falls behind
+type ChangeSubscription struct {
+	exchange string
+	pair     currency.Pair
+	asset    asset.Item
+	ch       chan *Price
+	m        sync.Mutex
+	closed   bool
+}
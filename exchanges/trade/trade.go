@@ -3,6 +3,7 @@ package trade
 import (
 	"errors"
 	"fmt"
+	"math"
 	"sort"
 	"sync"
 	"sync/atomic"
@@ -47,6 +48,10 @@ func (t *Trade) Update(save bool, data ...Data) error {
 		t.dataHandler <- data
 	}
 
+	if updates := t.updateCandleBuilders(data...); len(updates) > 0 {
+		t.dataHandler <- updates
+	}
+
 	if save {
 		if err := AddTradesToBuffer(t.exchangeName, data...); err != nil {
 			return err
@@ -56,6 +61,66 @@ func (t *Trade) Update(save bool, data ...Data) error {
 	return nil
 }
 
+// AddCandleBuilder registers a candle builder which will be fed every trade
+// processed by Update. Any in-progress and closed candles generated are sent
+// through the data channel
+func (t *Trade) AddCandleBuilder(b *kline.Builder) error {
+	if b == nil {
+		return errNilCandleBuilder
+	}
+	t.builderMtx.Lock()
+	t.candleBuilders = append(t.candleBuilders, b)
+	t.builderMtx.Unlock()
+	return nil
+}
+
+// updateCandleBuilders applies trades to any matching candle builders
+func (t *Trade) updateCandleBuilders(data ...Data) []kline.CandleUpdate {
+	t.builderMtx.Lock()
+	defer t.builderMtx.Unlock()
+	if len(t.candleBuilders) == 0 {
+		return nil
+	}
+	var updates []kline.CandleUpdate
+	for x := range t.candleBuilders {
+		resp, err := UpdateCandleBuilder(t.candleBuilders[x], data...)
+		if err != nil {
+			log.Errorf(log.Trade, "%s candle builder: %v", t.exchangeName, err)
+		}
+		updates = append(updates, resp...)
+	}
+	return updates
+}
+
+// UpdateCandleBuilder applies any trades matching the builder exchange, pair
+// and asset in timestamp order and returns the resulting candle updates
+func UpdateCandleBuilder(b *kline.Builder, data ...Data) ([]kline.CandleUpdate, error) {
+	if b == nil {
+		return nil, errNilCandleBuilder
+	}
+	var matched []Data
+	for i := range data {
+		if b.Matches(data[i].Exchange, data[i].CurrencyPair, data[i].AssetType) {
+			matched = append(matched, data[i])
+		}
+	}
+	sort.Sort(ByDate(matched))
+	var updates []kline.CandleUpdate
+	var errs common.Errors
+	for i := range matched {
+		resp, err := b.Process(matched[i].Price, matched[i].Amount, matched[i].Timestamp)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		updates = append(updates, resp...)
+	}
+	if len(errs) > 0 {
+		return updates, errs
+	}
+	return updates, nil
+}
+
 // AddTradesToBuffer will push trade data onto the buffer
 func AddTradesToBuffer(exchangeName string, data ...Data) error {
 	cfg := database.DB.GetConfig()
@@ -291,6 +356,88 @@ func classifyOHLCV(t time.Time, datas ...Data) (c kline.Candle) {
 	return c
 }
 
+// GetVolumeProfile aggregates trade volume per price level. Prices are grouped
+// into buckets of the supplied size, a bucket size of zero groups trades by
+// their exact price
+func GetVolumeProfile(bucketSize float64, trades ...Data) (*VolumeProfile, error) {
+	if len(trades) == 0 {
+		return nil, ErrNoTradesSupplied
+	}
+	if bucketSize < 0 {
+		return nil, errInvalidBucketSize
+	}
+	levels := make(map[float64]*VolumeLevel)
+	resp := &VolumeProfile{}
+	for i := range trades {
+		price, amount := math.Abs(trades[i].Price), math.Abs(trades[i].Amount)
+		if bucketSize > 0 {
+			price = math.Floor(price/bucketSize) * bucketSize
+		}
+		level, ok := levels[price]
+		if !ok {
+			level = &VolumeLevel{Price: price}
+			levels[price] = level
+		}
+		switch trades[i].Side {
+		case order.Buy, order.Bid:
+			level.BuyVolume += amount
+		case order.Sell, order.Ask:
+			level.SellVolume += amount
+		}
+		level.Volume += amount
+		resp.TotalVolume += amount
+	}
+	resp.Levels = make([]VolumeLevel, 0, len(levels))
+	for _, v := range levels {
+		resp.Levels = append(resp.Levels, *v)
+	}
+	sort.Slice(resp.Levels, func(i, j int) bool {
+		return resp.Levels[i].Price < resp.Levels[j].Price
+	})
+	var highest float64
+	for i := range resp.Levels {
+		if resp.Levels[i].Volume > highest {
+			highest = resp.Levels[i].Volume
+			resp.PointOfControl = resp.Levels[i].Price
+		}
+	}
+	return resp, nil
+}
+
+// ConvertTradesToFootprints groups trades into candle intervals and returns
+// the buy and sell delta along with the volume profile for each interval
+func ConvertTradesToFootprints(interval kline.Interval, bucketSize float64, trades ...Data) ([]Footprint, error) {
+	if len(trades) == 0 {
+		return nil, ErrNoTradesSupplied
+	}
+	if interval <= 0 {
+		return nil, kline.ErrUnsetInterval
+	}
+	groupedData := groupTradesToInterval(interval, trades...)
+	resp := make([]Footprint, 0, len(groupedData))
+	for k, v := range groupedData {
+		profile, err := GetVolumeProfile(bucketSize, v...)
+		if err != nil {
+			return nil, err
+		}
+		fp := Footprint{
+			Time:     time.Unix(k, 0).UTC(),
+			Interval: interval,
+			Profile:  *profile,
+		}
+		for i := range profile.Levels {
+			fp.BuyVolume += profile.Levels[i].BuyVolume
+			fp.SellVolume += profile.Levels[i].SellVolume
+		}
+		fp.Delta = fp.BuyVolume - fp.SellVolume
+		resp = append(resp, fp)
+	}
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].Time.Before(resp[j].Time)
+	})
+	return resp, nil
+}
+
 // FilterTradesByTime removes any trades that are not between the start
 // and end times
 func FilterTradesByTime(trades []Data, startTime, endTime time.Time) []Data {
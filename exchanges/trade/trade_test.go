@@ -1,6 +1,7 @@
 package trade
 
 import (
+	"errors"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -251,3 +252,132 @@ func TestGetTradesInRange(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestUpdateCandleBuilder(t *testing.T) {
+	t.Parallel()
+	_, err := UpdateCandleBuilder(nil)
+	if !errors.Is(err, errNilCandleBuilder) {
+		t.Errorf("received '%v' expected '%v'", err, errNilCandleBuilder)
+	}
+	cp := currency.NewPair(currency.BTC, currency.USD)
+	b, err := kline.NewBuilder("test", cp, asset.Spot, kline.OneMin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	updates, err := UpdateCandleBuilder(b,
+		Data{Exchange: "test", CurrencyPair: cp, AssetType: asset.Spot, Price: 2, Amount: 1, Timestamp: tt.Add(time.Minute)},
+		Data{Exchange: "test", CurrencyPair: cp, AssetType: asset.Spot, Price: 1, Amount: 1, Timestamp: tt},
+		Data{Exchange: "test", CurrencyPair: cp, AssetType: asset.Futures, Price: 3, Amount: 1, Timestamp: tt},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(updates) != 3 {
+		t.Fatalf("received '%v' expected '%v'", len(updates), 3)
+	}
+	if !updates[1].Closed || updates[1].Candle.Close != 1 {
+		t.Errorf("unexpected closed candle %+v", updates[1])
+	}
+}
+
+func TestTradeCandleBuilders(t *testing.T) {
+	t.Parallel()
+	var tr Trade
+	c := make(chan interface{}, 1)
+	tr.Setup("test", false, c)
+	if err := tr.AddCandleBuilder(nil); !errors.Is(err, errNilCandleBuilder) {
+		t.Errorf("received '%v' expected '%v'", err, errNilCandleBuilder)
+	}
+	cp := currency.NewPair(currency.BTC, currency.USD)
+	b, err := kline.NewBuilder("test", cp, asset.Spot, kline.OneMin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = tr.AddCandleBuilder(b); err != nil {
+		t.Fatal(err)
+	}
+	err = tr.Update(false, Data{Exchange: "test", CurrencyPair: cp, AssetType: asset.Spot, Price: 1, Amount: 1, Timestamp: time.Now()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	updates, ok := (<-c).([]kline.CandleUpdate)
+	if !ok || len(updates) != 1 {
+		t.Errorf("unexpected data handler response %v", updates)
+	}
+}
+
+func TestGetVolumeProfile(t *testing.T) {
+	t.Parallel()
+	_, err := GetVolumeProfile(0)
+	if !errors.Is(err, ErrNoTradesSupplied) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNoTradesSupplied)
+	}
+	trades := []Data{
+		{Price: 100.5, Amount: 1, Side: order.Buy},
+		{Price: 100.7, Amount: 2, Side: order.Sell},
+		{Price: 101.2, Amount: 1, Side: order.Buy},
+		{Price: 101.3, Amount: 1},
+	}
+	_, err = GetVolumeProfile(-1, trades...)
+	if !errors.Is(err, errInvalidBucketSize) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidBucketSize)
+	}
+	profile, err := GetVolumeProfile(1, trades...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(profile.Levels) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(profile.Levels), 2)
+	}
+	if profile.Levels[0].Price != 100 || profile.Levels[0].BuyVolume != 1 || profile.Levels[0].SellVolume != 2 {
+		t.Errorf("unexpected level %+v", profile.Levels[0])
+	}
+	if profile.Levels[1].Volume != 2 || profile.Levels[1].BuyVolume != 1 {
+		t.Errorf("unexpected level %+v", profile.Levels[1])
+	}
+	if profile.PointOfControl != 100 {
+		t.Errorf("received '%v' expected '%v'", profile.PointOfControl, 100)
+	}
+	if profile.TotalVolume != 5 {
+		t.Errorf("received '%v' expected '%v'", profile.TotalVolume, 5)
+	}
+	profile, err = GetVolumeProfile(0, trades...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(profile.Levels) != 4 {
+		t.Errorf("received '%v' expected '%v'", len(profile.Levels), 4)
+	}
+}
+
+func TestConvertTradesToFootprints(t *testing.T) {
+	t.Parallel()
+	_, err := ConvertTradesToFootprints(kline.OneMin, 0)
+	if !errors.Is(err, ErrNoTradesSupplied) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNoTradesSupplied)
+	}
+	tt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	trades := []Data{
+		{Price: 100, Amount: 1, Side: order.Buy, Timestamp: tt.Add(time.Minute)},
+		{Price: 100, Amount: 3, Side: order.Buy, Timestamp: tt},
+		{Price: 101, Amount: 1, Side: order.Sell, Timestamp: tt.Add(time.Second)},
+	}
+	_, err = ConvertTradesToFootprints(0, 0, trades...)
+	if !errors.Is(err, kline.ErrUnsetInterval) {
+		t.Errorf("received '%v' expected '%v'", err, kline.ErrUnsetInterval)
+	}
+	fp, err := ConvertTradesToFootprints(kline.OneMin, 0, trades...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fp) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(fp), 2)
+	}
+	if !fp[0].Time.Equal(tt) || fp[0].Delta != 2 || fp[0].BuyVolume != 3 || fp[0].SellVolume != 1 {
+		t.Errorf("unexpected footprint %+v", fp[0])
+	}
+	if fp[1].Delta != 1 {
+		t.Errorf("received '%v' expected '%v'", fp[1].Delta, 1)
+	}
+}
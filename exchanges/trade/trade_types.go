@@ -8,6 +8,7 @@ import (
 	"github.com/gofrs/uuid"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
 
@@ -22,6 +23,9 @@ var (
 	BufferProcessorIntervalTime = DefaultProcessorIntervalTime
 	// ErrNoTradesSupplied is returned when an attempt is made to process trades, but is an empty slice
 	ErrNoTradesSupplied = errors.New("no trades supplied")
+
+	errNilCandleBuilder  = errors.New("candle builder is nil")
+	errInvalidBucketSize = errors.New("price bucket size cannot be negative")
 )
 
 // Trade used to hold data and methods related to trade dissemination and
@@ -30,6 +34,8 @@ type Trade struct {
 	exchangeName     string
 	dataHandler      chan interface{}
 	tradeFeedEnabled bool
+	candleBuilders   []*kline.Builder
+	builderMtx       sync.Mutex
 }
 
 // Data defines trade data
@@ -68,3 +74,33 @@ func (b ByDate) Less(i, j int) bool {
 func (b ByDate) Swap(i, j int) {
 	b[i], b[j] = b[j], b[i]
 }
+
+// VolumeLevel holds the traded volume at a price level
+type VolumeLevel struct {
+	Price      float64
+	BuyVolume  float64
+	SellVolume float64
+	// Volume is the total volume traded at the price level and includes
+	// trades without a known side
+	Volume float64
+}
+
+// VolumeProfile holds the traded volume per price level for a set of trades
+type VolumeProfile struct {
+	// Levels are sorted by price ascending
+	Levels []VolumeLevel
+	// PointOfControl is the price level with the highest traded volume
+	PointOfControl float64
+	TotalVolume    float64
+}
+
+// Footprint holds buy and sell order flow for a candle interval
+type Footprint struct {
+	Time       time.Time
+	Interval   kline.Interval
+	BuyVolume  float64
+	SellVolume float64
+	// Delta is buy volume minus sell volume
+	Delta   float64
+	Profile VolumeProfile
+}
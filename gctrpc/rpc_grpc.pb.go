@@ -27,6 +27,9 @@ type GoCryptoTraderClient interface {
 	GetExchanges(ctx context.Context, in *GetExchangesRequest, opts ...grpc.CallOption) (*GetExchangesResponse, error)
 	DisableExchange(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 	GetExchangeInfo(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GetExchangeInfoResponse, error)
+	GetCapabilityGaps(ctx context.Context, in *GetCapabilityGapsRequest, opts ...grpc.CallOption) (*GetCapabilityGapsResponse, error)
+	GetExchangeHealth(ctx context.Context, in *GetExchangeHealthRequest, opts ...grpc.CallOption) (*GetExchangeHealthResponse, error)
+	GetExchangeClockSkew(ctx context.Context, in *GetExchangeClockSkewRequest, opts ...grpc.CallOption) (*GetExchangeClockSkewResponse, error)
 	GetExchangeOTPCode(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GetExchangeOTPResponse, error)
 	GetExchangeOTPCodes(ctx context.Context, in *GetExchangeOTPsRequest, opts ...grpc.CallOption) (*GetExchangeOTPsResponse, error)
 	EnableExchange(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GenericResponse, error)
@@ -40,6 +43,11 @@ type GoCryptoTraderClient interface {
 	GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*GetConfigResponse, error)
 	GetPortfolio(ctx context.Context, in *GetPortfolioRequest, opts ...grpc.CallOption) (*GetPortfolioResponse, error)
 	GetPortfolioSummary(ctx context.Context, in *GetPortfolioSummaryRequest, opts ...grpc.CallOption) (*GetPortfolioSummaryResponse, error)
+	GetPortfolioPnL(ctx context.Context, in *GetPortfolioPnLRequest, opts ...grpc.CallOption) (*GetPortfolioPnLResponse, error)
+	GetPortfolioPerformance(ctx context.Context, in *GetPortfolioPerformanceRequest, opts ...grpc.CallOption) (*GetPortfolioPerformanceResponse, error)
+	GetDerivativePositions(ctx context.Context, in *GetDerivativePositionsRequest, opts ...grpc.CallOption) (*GetDerivativePositionsResponse, error)
+	GetLeverage(ctx context.Context, in *GetLeverageRequest, opts ...grpc.CallOption) (*GetLeverageResponse, error)
+	SetLeverage(ctx context.Context, in *SetLeverageRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 	AddPortfolioAddress(ctx context.Context, in *AddPortfolioAddressRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 	RemovePortfolioAddress(ctx context.Context, in *RemovePortfolioAddressRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 	GetForexProviders(ctx context.Context, in *GetForexProvidersRequest, opts ...grpc.CallOption) (*GetForexProvidersResponse, error)
@@ -47,6 +55,15 @@ type GoCryptoTraderClient interface {
 	GetOrders(ctx context.Context, in *GetOrdersRequest, opts ...grpc.CallOption) (*GetOrdersResponse, error)
 	GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*OrderDetails, error)
 	SubmitOrder(ctx context.Context, in *SubmitOrderRequest, opts ...grpc.CallOption) (*SubmitOrderResponse, error)
+	AddConditionalOrder(ctx context.Context, in *AddConditionalOrderRequest, opts ...grpc.CallOption) (*AddConditionalOrderResponse, error)
+	GetConditionalOrders(ctx context.Context, in *GetConditionalOrdersRequest, opts ...grpc.CallOption) (*GetConditionalOrdersResponse, error)
+	CancelConditionalOrder(ctx context.Context, in *CancelConditionalOrderRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	GetPaperBalances(ctx context.Context, in *GetPaperBalancesRequest, opts ...grpc.CallOption) (*GetPaperBalancesResponse, error)
+	GetConsolidatedOrderbook(ctx context.Context, in *GetConsolidatedOrderbookRequest, opts ...grpc.CallOption) (*GetConsolidatedOrderbookResponse, error)
+	GetArbitrageOpportunities(ctx context.Context, in *GetArbitrageOpportunitiesRequest, opts ...grpc.CallOption) (*GetArbitrageOpportunitiesResponse, error)
+	GetTriangularArbitrage(ctx context.Context, in *GetTriangularArbitrageRequest, opts ...grpc.CallOption) (*GetTriangularArbitrageResponse, error)
+	GetTriangularArbitrageStream(ctx context.Context, in *GetTriangularArbitrageRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetTriangularArbitrageStreamClient, error)
+	RouteOrder(ctx context.Context, in *RouteOrderRequest, opts ...grpc.CallOption) (*RouteOrderResponse, error)
 	SimulateOrder(ctx context.Context, in *SimulateOrderRequest, opts ...grpc.CallOption) (*SimulateOrderResponse, error)
 	WhaleBomb(ctx context.Context, in *WhaleBombRequest, opts ...grpc.CallOption) (*SimulateOrderResponse, error)
 	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*GenericResponse, error)
@@ -60,6 +77,14 @@ type GoCryptoTraderClient interface {
 	GetAvailableTransferChains(ctx context.Context, in *GetAvailableTransferChainsRequest, opts ...grpc.CallOption) (*GetAvailableTransferChainsResponse, error)
 	WithdrawFiatFunds(ctx context.Context, in *WithdrawFiatRequest, opts ...grpc.CallOption) (*WithdrawResponse, error)
 	WithdrawCryptocurrencyFunds(ctx context.Context, in *WithdrawCryptoRequest, opts ...grpc.CallOption) (*WithdrawResponse, error)
+	AddWithdrawalAddress(ctx context.Context, in *AddWithdrawalAddressRequest, opts ...grpc.CallOption) (*WithdrawalAddress, error)
+	RemoveWithdrawalAddress(ctx context.Context, in *RemoveWithdrawalAddressRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	WhitelistWithdrawalAddress(ctx context.Context, in *WhitelistWithdrawalAddressRequest, opts ...grpc.CallOption) (*WithdrawalAddress, error)
+	RenameWithdrawalAddress(ctx context.Context, in *RenameWithdrawalAddressRequest, opts ...grpc.CallOption) (*WithdrawalAddress, error)
+	GetWithdrawalAddresses(ctx context.Context, in *GetWithdrawalAddressesRequest, opts ...grpc.CallOption) (*GetWithdrawalAddressesResponse, error)
+	GetPendingWithdrawals(ctx context.Context, in *GetPendingWithdrawalsRequest, opts ...grpc.CallOption) (*GetPendingWithdrawalsResponse, error)
+	ApproveWithdrawal(ctx context.Context, in *ApproveWithdrawalRequest, opts ...grpc.CallOption) (*WithdrawResponse, error)
+	RejectWithdrawal(ctx context.Context, in *RejectWithdrawalRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 	WithdrawalEventByID(ctx context.Context, in *WithdrawalEventByIDRequest, opts ...grpc.CallOption) (*WithdrawalEventByIDResponse, error)
 	WithdrawalEventsByExchange(ctx context.Context, in *WithdrawalEventsByExchangeRequest, opts ...grpc.CallOption) (*WithdrawalEventsByExchangeResponse, error)
 	WithdrawalEventsByDate(ctx context.Context, in *WithdrawalEventsByDateRequest, opts ...grpc.CallOption) (*WithdrawalEventsByExchangeResponse, error)
@@ -68,6 +93,7 @@ type GoCryptoTraderClient interface {
 	GetExchangePairs(ctx context.Context, in *GetExchangePairsRequest, opts ...grpc.CallOption) (*GetExchangePairsResponse, error)
 	SetExchangePair(ctx context.Context, in *SetExchangePairRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 	GetOrderbookStream(ctx context.Context, in *GetOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetOrderbookStreamClient, error)
+	GetOrderbookDeltaStream(ctx context.Context, in *GetOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetOrderbookDeltaStreamClient, error)
 	GetExchangeOrderbookStream(ctx context.Context, in *GetExchangeOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetExchangeOrderbookStreamClient, error)
 	GetTickerStream(ctx context.Context, in *GetTickerStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetTickerStreamClient, error)
 	GetExchangeTickerStream(ctx context.Context, in *GetExchangeTickerStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetExchangeTickerStreamClient, error)
@@ -89,20 +115,26 @@ type GoCryptoTraderClient interface {
 	WebsocketGetInfo(ctx context.Context, in *WebsocketGetInfoRequest, opts ...grpc.CallOption) (*WebsocketGetInfoResponse, error)
 	WebsocketSetEnabled(ctx context.Context, in *WebsocketSetEnabledRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 	WebsocketGetSubscriptions(ctx context.Context, in *WebsocketGetSubscriptionsRequest, opts ...grpc.CallOption) (*WebsocketGetSubscriptionsResponse, error)
+	WebsocketGetMetrics(ctx context.Context, in *WebsocketGetMetricsRequest, opts ...grpc.CallOption) (*WebsocketGetMetricsResponse, error)
 	WebsocketSetProxy(ctx context.Context, in *WebsocketSetProxyRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 	WebsocketSetURL(ctx context.Context, in *WebsocketSetURLRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 	GetRecentTrades(ctx context.Context, in *GetSavedTradesRequest, opts ...grpc.CallOption) (*SavedTradesResponse, error)
 	GetHistoricTrades(ctx context.Context, in *GetSavedTradesRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetHistoricTradesClient, error)
 	GetSavedTrades(ctx context.Context, in *GetSavedTradesRequest, opts ...grpc.CallOption) (*SavedTradesResponse, error)
 	ConvertTradesToCandles(ctx context.Context, in *ConvertTradesToCandlesRequest, opts ...grpc.CallOption) (*GetHistoricCandlesResponse, error)
+	GetTradeFootprint(ctx context.Context, in *GetTradeFootprintRequest, opts ...grpc.CallOption) (*GetTradeFootprintResponse, error)
 	FindMissingSavedCandleIntervals(ctx context.Context, in *FindMissingCandlePeriodsRequest, opts ...grpc.CallOption) (*FindMissingIntervalsResponse, error)
 	FindMissingSavedTradeIntervals(ctx context.Context, in *FindMissingTradePeriodsRequest, opts ...grpc.CallOption) (*FindMissingIntervalsResponse, error)
+	GetSavedCandleCoverage(ctx context.Context, in *GetSavedCandleCoverageRequest, opts ...grpc.CallOption) (*GetSavedCandleCoverageResponse, error)
+	GetDataSyncStatus(ctx context.Context, in *GetDataSyncStatusRequest, opts ...grpc.CallOption) (*GetDataSyncStatusResponse, error)
 	SetExchangeTradeProcessing(ctx context.Context, in *SetExchangeTradeProcessingRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 	UpsertDataHistoryJob(ctx context.Context, in *UpsertDataHistoryJobRequest, opts ...grpc.CallOption) (*UpsertDataHistoryJobResponse, error)
 	GetDataHistoryJobDetails(ctx context.Context, in *GetDataHistoryJobDetailsRequest, opts ...grpc.CallOption) (*DataHistoryJob, error)
 	GetActiveDataHistoryJobs(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*DataHistoryJobs, error)
 	GetDataHistoryJobsBetween(ctx context.Context, in *GetDataHistoryJobsBetweenRequest, opts ...grpc.CallOption) (*DataHistoryJobs, error)
 	GetDataHistoryJobSummary(ctx context.Context, in *GetDataHistoryJobDetailsRequest, opts ...grpc.CallOption) (*DataHistoryJob, error)
+	GetDataHistoryJobProgress(ctx context.Context, in *GetDataHistoryJobDetailsRequest, opts ...grpc.CallOption) (*DataHistoryJobProgress, error)
+	GetDataHistoryJobProgressStream(ctx context.Context, in *GetDataHistoryJobDetailsRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetDataHistoryJobProgressStreamClient, error)
 	SetDataHistoryJobStatus(ctx context.Context, in *SetDataHistoryJobStatusRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 	UpdateDataHistoryJobPrerequisite(ctx context.Context, in *UpdateDataHistoryJobPrerequisiteRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 	GetManagedOrders(ctx context.Context, in *GetOrdersRequest, opts ...grpc.CallOption) (*GetOrdersResponse, error)
@@ -203,6 +235,33 @@ func (c *goCryptoTraderClient) GetExchangeInfo(ctx context.Context, in *GenericE
 	return out, nil
 }
 
+func (c *goCryptoTraderClient) GetCapabilityGaps(ctx context.Context, in *GetCapabilityGapsRequest, opts ...grpc.CallOption) (*GetCapabilityGapsResponse, error) {
+	out := new(GetCapabilityGapsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetCapabilityGaps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetExchangeHealth(ctx context.Context, in *GetExchangeHealthRequest, opts ...grpc.CallOption) (*GetExchangeHealthResponse, error) {
+	out := new(GetExchangeHealthResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExchangeHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetExchangeClockSkew(ctx context.Context, in *GetExchangeClockSkewRequest, opts ...grpc.CallOption) (*GetExchangeClockSkewResponse, error) {
+	out := new(GetExchangeClockSkewResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExchangeClockSkew", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goCryptoTraderClient) GetExchangeOTPCode(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GetExchangeOTPResponse, error) {
 	out := new(GetExchangeOTPResponse)
 	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExchangeOTPCode", in, out, opts...)
@@ -343,6 +402,51 @@ func (c *goCryptoTraderClient) GetPortfolioSummary(ctx context.Context, in *GetP
 	return out, nil
 }
 
+func (c *goCryptoTraderClient) GetPortfolioPnL(ctx context.Context, in *GetPortfolioPnLRequest, opts ...grpc.CallOption) (*GetPortfolioPnLResponse, error) {
+	out := new(GetPortfolioPnLResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetPortfolioPnL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetPortfolioPerformance(ctx context.Context, in *GetPortfolioPerformanceRequest, opts ...grpc.CallOption) (*GetPortfolioPerformanceResponse, error) {
+	out := new(GetPortfolioPerformanceResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetPortfolioPerformance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetDerivativePositions(ctx context.Context, in *GetDerivativePositionsRequest, opts ...grpc.CallOption) (*GetDerivativePositionsResponse, error) {
+	out := new(GetDerivativePositionsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetDerivativePositions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetLeverage(ctx context.Context, in *GetLeverageRequest, opts ...grpc.CallOption) (*GetLeverageResponse, error) {
+	out := new(GetLeverageResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetLeverage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) SetLeverage(ctx context.Context, in *SetLeverageRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SetLeverage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goCryptoTraderClient) AddPortfolioAddress(ctx context.Context, in *AddPortfolioAddressRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
 	out := new(GenericResponse)
 	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/AddPortfolioAddress", in, out, opts...)
@@ -406,6 +510,110 @@ func (c *goCryptoTraderClient) SubmitOrder(ctx context.Context, in *SubmitOrderR
 	return out, nil
 }
 
+func (c *goCryptoTraderClient) AddConditionalOrder(ctx context.Context, in *AddConditionalOrderRequest, opts ...grpc.CallOption) (*AddConditionalOrderResponse, error) {
+	out := new(AddConditionalOrderResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/AddConditionalOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetConditionalOrders(ctx context.Context, in *GetConditionalOrdersRequest, opts ...grpc.CallOption) (*GetConditionalOrdersResponse, error) {
+	out := new(GetConditionalOrdersResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetConditionalOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) CancelConditionalOrder(ctx context.Context, in *CancelConditionalOrderRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/CancelConditionalOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetPaperBalances(ctx context.Context, in *GetPaperBalancesRequest, opts ...grpc.CallOption) (*GetPaperBalancesResponse, error) {
+	out := new(GetPaperBalancesResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetPaperBalances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetConsolidatedOrderbook(ctx context.Context, in *GetConsolidatedOrderbookRequest, opts ...grpc.CallOption) (*GetConsolidatedOrderbookResponse, error) {
+	out := new(GetConsolidatedOrderbookResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetConsolidatedOrderbook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetArbitrageOpportunities(ctx context.Context, in *GetArbitrageOpportunitiesRequest, opts ...grpc.CallOption) (*GetArbitrageOpportunitiesResponse, error) {
+	out := new(GetArbitrageOpportunitiesResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetArbitrageOpportunities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetTriangularArbitrage(ctx context.Context, in *GetTriangularArbitrageRequest, opts ...grpc.CallOption) (*GetTriangularArbitrageResponse, error) {
+	out := new(GetTriangularArbitrageResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetTriangularArbitrage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetTriangularArbitrageStream(ctx context.Context, in *GetTriangularArbitrageRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetTriangularArbitrageStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTrader_ServiceDesc.Streams[1], "/gctrpc.GoCryptoTrader/GetTriangularArbitrageStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderGetTriangularArbitrageStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoCryptoTrader_GetTriangularArbitrageStreamClient interface {
+	Recv() (*GetTriangularArbitrageResponse, error)
+	grpc.ClientStream
+}
+
+type goCryptoTraderGetTriangularArbitrageStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *goCryptoTraderGetTriangularArbitrageStreamClient) Recv() (*GetTriangularArbitrageResponse, error) {
+	m := new(GetTriangularArbitrageResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goCryptoTraderClient) RouteOrder(ctx context.Context, in *RouteOrderRequest, opts ...grpc.CallOption) (*RouteOrderResponse, error) {
+	out := new(RouteOrderResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/RouteOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goCryptoTraderClient) SimulateOrder(ctx context.Context, in *SimulateOrderRequest, opts ...grpc.CallOption) (*SimulateOrderResponse, error) {
 	out := new(SimulateOrderResponse)
 	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SimulateOrder", in, out, opts...)
@@ -523,6 +731,78 @@ func (c *goCryptoTraderClient) WithdrawCryptocurrencyFunds(ctx context.Context,
 	return out, nil
 }
 
+func (c *goCryptoTraderClient) AddWithdrawalAddress(ctx context.Context, in *AddWithdrawalAddressRequest, opts ...grpc.CallOption) (*WithdrawalAddress, error) {
+	out := new(WithdrawalAddress)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/AddWithdrawalAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) RemoveWithdrawalAddress(ctx context.Context, in *RemoveWithdrawalAddressRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/RemoveWithdrawalAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) WhitelistWithdrawalAddress(ctx context.Context, in *WhitelistWithdrawalAddressRequest, opts ...grpc.CallOption) (*WithdrawalAddress, error) {
+	out := new(WithdrawalAddress)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/WhitelistWithdrawalAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) RenameWithdrawalAddress(ctx context.Context, in *RenameWithdrawalAddressRequest, opts ...grpc.CallOption) (*WithdrawalAddress, error) {
+	out := new(WithdrawalAddress)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/RenameWithdrawalAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetWithdrawalAddresses(ctx context.Context, in *GetWithdrawalAddressesRequest, opts ...grpc.CallOption) (*GetWithdrawalAddressesResponse, error) {
+	out := new(GetWithdrawalAddressesResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetWithdrawalAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetPendingWithdrawals(ctx context.Context, in *GetPendingWithdrawalsRequest, opts ...grpc.CallOption) (*GetPendingWithdrawalsResponse, error) {
+	out := new(GetPendingWithdrawalsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetPendingWithdrawals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) ApproveWithdrawal(ctx context.Context, in *ApproveWithdrawalRequest, opts ...grpc.CallOption) (*WithdrawResponse, error) {
+	out := new(WithdrawResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/ApproveWithdrawal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) RejectWithdrawal(ctx context.Context, in *RejectWithdrawalRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/RejectWithdrawal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goCryptoTraderClient) WithdrawalEventByID(ctx context.Context, in *WithdrawalEventByIDRequest, opts ...grpc.CallOption) (*WithdrawalEventByIDResponse, error) {
 	out := new(WithdrawalEventByIDResponse)
 	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/WithdrawalEventByID", in, out, opts...)
@@ -587,7 +867,7 @@ func (c *goCryptoTraderClient) SetExchangePair(ctx context.Context, in *SetExcha
 }
 
 func (c *goCryptoTraderClient) GetOrderbookStream(ctx context.Context, in *GetOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetOrderbookStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &GoCryptoTrader_ServiceDesc.Streams[1], "/gctrpc.GoCryptoTrader/GetOrderbookStream", opts...)
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTrader_ServiceDesc.Streams[2], "/gctrpc.GoCryptoTrader/GetOrderbookStream", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -618,8 +898,40 @@ func (x *goCryptoTraderGetOrderbookStreamClient) Recv() (*OrderbookResponse, err
 	return m, nil
 }
 
+func (c *goCryptoTraderClient) GetOrderbookDeltaStream(ctx context.Context, in *GetOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetOrderbookDeltaStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTrader_ServiceDesc.Streams[3], "/gctrpc.GoCryptoTrader/GetOrderbookDeltaStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderGetOrderbookDeltaStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoCryptoTrader_GetOrderbookDeltaStreamClient interface {
+	Recv() (*OrderbookDeltaResponse, error)
+	grpc.ClientStream
+}
+
+type goCryptoTraderGetOrderbookDeltaStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *goCryptoTraderGetOrderbookDeltaStreamClient) Recv() (*OrderbookDeltaResponse, error) {
+	m := new(OrderbookDeltaResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *goCryptoTraderClient) GetExchangeOrderbookStream(ctx context.Context, in *GetExchangeOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetExchangeOrderbookStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &GoCryptoTrader_ServiceDesc.Streams[2], "/gctrpc.GoCryptoTrader/GetExchangeOrderbookStream", opts...)
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTrader_ServiceDesc.Streams[4], "/gctrpc.GoCryptoTrader/GetExchangeOrderbookStream", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -651,7 +963,7 @@ func (x *goCryptoTraderGetExchangeOrderbookStreamClient) Recv() (*OrderbookRespo
 }
 
 func (c *goCryptoTraderClient) GetTickerStream(ctx context.Context, in *GetTickerStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetTickerStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &GoCryptoTrader_ServiceDesc.Streams[3], "/gctrpc.GoCryptoTrader/GetTickerStream", opts...)
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTrader_ServiceDesc.Streams[5], "/gctrpc.GoCryptoTrader/GetTickerStream", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -683,7 +995,7 @@ func (x *goCryptoTraderGetTickerStreamClient) Recv() (*TickerResponse, error) {
 }
 
 func (c *goCryptoTraderClient) GetExchangeTickerStream(ctx context.Context, in *GetExchangeTickerStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetExchangeTickerStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &GoCryptoTrader_ServiceDesc.Streams[4], "/gctrpc.GoCryptoTrader/GetExchangeTickerStream", opts...)
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTrader_ServiceDesc.Streams[6], "/gctrpc.GoCryptoTrader/GetExchangeTickerStream", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -876,6 +1188,15 @@ func (c *goCryptoTraderClient) WebsocketGetSubscriptions(ctx context.Context, in
 	return out, nil
 }
 
+func (c *goCryptoTraderClient) WebsocketGetMetrics(ctx context.Context, in *WebsocketGetMetricsRequest, opts ...grpc.CallOption) (*WebsocketGetMetricsResponse, error) {
+	out := new(WebsocketGetMetricsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/WebsocketGetMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goCryptoTraderClient) WebsocketSetProxy(ctx context.Context, in *WebsocketSetProxyRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
 	out := new(GenericResponse)
 	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/WebsocketSetProxy", in, out, opts...)
@@ -904,7 +1225,7 @@ func (c *goCryptoTraderClient) GetRecentTrades(ctx context.Context, in *GetSaved
 }
 
 func (c *goCryptoTraderClient) GetHistoricTrades(ctx context.Context, in *GetSavedTradesRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetHistoricTradesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &GoCryptoTrader_ServiceDesc.Streams[5], "/gctrpc.GoCryptoTrader/GetHistoricTrades", opts...)
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTrader_ServiceDesc.Streams[7], "/gctrpc.GoCryptoTrader/GetHistoricTrades", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -953,6 +1274,15 @@ func (c *goCryptoTraderClient) ConvertTradesToCandles(ctx context.Context, in *C
 	return out, nil
 }
 
+func (c *goCryptoTraderClient) GetTradeFootprint(ctx context.Context, in *GetTradeFootprintRequest, opts ...grpc.CallOption) (*GetTradeFootprintResponse, error) {
+	out := new(GetTradeFootprintResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetTradeFootprint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goCryptoTraderClient) FindMissingSavedCandleIntervals(ctx context.Context, in *FindMissingCandlePeriodsRequest, opts ...grpc.CallOption) (*FindMissingIntervalsResponse, error) {
 	out := new(FindMissingIntervalsResponse)
 	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/FindMissingSavedCandleIntervals", in, out, opts...)
@@ -971,6 +1301,24 @@ func (c *goCryptoTraderClient) FindMissingSavedTradeIntervals(ctx context.Contex
 	return out, nil
 }
 
+func (c *goCryptoTraderClient) GetSavedCandleCoverage(ctx context.Context, in *GetSavedCandleCoverageRequest, opts ...grpc.CallOption) (*GetSavedCandleCoverageResponse, error) {
+	out := new(GetSavedCandleCoverageResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetSavedCandleCoverage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetDataSyncStatus(ctx context.Context, in *GetDataSyncStatusRequest, opts ...grpc.CallOption) (*GetDataSyncStatusResponse, error) {
+	out := new(GetDataSyncStatusResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetDataSyncStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goCryptoTraderClient) SetExchangeTradeProcessing(ctx context.Context, in *SetExchangeTradeProcessingRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
 	out := new(GenericResponse)
 	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SetExchangeTradeProcessing", in, out, opts...)
@@ -1025,6 +1373,47 @@ func (c *goCryptoTraderClient) GetDataHistoryJobSummary(ctx context.Context, in
 	return out, nil
 }
 
+func (c *goCryptoTraderClient) GetDataHistoryJobProgress(ctx context.Context, in *GetDataHistoryJobDetailsRequest, opts ...grpc.CallOption) (*DataHistoryJobProgress, error) {
+	out := new(DataHistoryJobProgress)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetDataHistoryJobProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetDataHistoryJobProgressStream(ctx context.Context, in *GetDataHistoryJobDetailsRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetDataHistoryJobProgressStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTrader_ServiceDesc.Streams[8], "/gctrpc.GoCryptoTrader/GetDataHistoryJobProgressStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderGetDataHistoryJobProgressStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoCryptoTrader_GetDataHistoryJobProgressStreamClient interface {
+	Recv() (*DataHistoryJobProgress, error)
+	grpc.ClientStream
+}
+
+type goCryptoTraderGetDataHistoryJobProgressStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *goCryptoTraderGetDataHistoryJobProgressStreamClient) Recv() (*DataHistoryJobProgress, error) {
+	m := new(DataHistoryJobProgress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *goCryptoTraderClient) SetDataHistoryJobStatus(ctx context.Context, in *SetDataHistoryJobStatusRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
 	out := new(GenericResponse)
 	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SetDataHistoryJobStatus", in, out, opts...)
@@ -1119,6 +1508,9 @@ type GoCryptoTraderServer interface {
 	GetExchanges(context.Context, *GetExchangesRequest) (*GetExchangesResponse, error)
 	DisableExchange(context.Context, *GenericExchangeNameRequest) (*GenericResponse, error)
 	GetExchangeInfo(context.Context, *GenericExchangeNameRequest) (*GetExchangeInfoResponse, error)
+	GetCapabilityGaps(context.Context, *GetCapabilityGapsRequest) (*GetCapabilityGapsResponse, error)
+	GetExchangeHealth(context.Context, *GetExchangeHealthRequest) (*GetExchangeHealthResponse, error)
+	GetExchangeClockSkew(context.Context, *GetExchangeClockSkewRequest) (*GetExchangeClockSkewResponse, error)
 	GetExchangeOTPCode(context.Context, *GenericExchangeNameRequest) (*GetExchangeOTPResponse, error)
 	GetExchangeOTPCodes(context.Context, *GetExchangeOTPsRequest) (*GetExchangeOTPsResponse, error)
 	EnableExchange(context.Context, *GenericExchangeNameRequest) (*GenericResponse, error)
@@ -1132,6 +1524,11 @@ type GoCryptoTraderServer interface {
 	GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error)
 	GetPortfolio(context.Context, *GetPortfolioRequest) (*GetPortfolioResponse, error)
 	GetPortfolioSummary(context.Context, *GetPortfolioSummaryRequest) (*GetPortfolioSummaryResponse, error)
+	GetPortfolioPnL(context.Context, *GetPortfolioPnLRequest) (*GetPortfolioPnLResponse, error)
+	GetPortfolioPerformance(context.Context, *GetPortfolioPerformanceRequest) (*GetPortfolioPerformanceResponse, error)
+	GetDerivativePositions(context.Context, *GetDerivativePositionsRequest) (*GetDerivativePositionsResponse, error)
+	GetLeverage(context.Context, *GetLeverageRequest) (*GetLeverageResponse, error)
+	SetLeverage(context.Context, *SetLeverageRequest) (*GenericResponse, error)
 	AddPortfolioAddress(context.Context, *AddPortfolioAddressRequest) (*GenericResponse, error)
 	RemovePortfolioAddress(context.Context, *RemovePortfolioAddressRequest) (*GenericResponse, error)
 	GetForexProviders(context.Context, *GetForexProvidersRequest) (*GetForexProvidersResponse, error)
@@ -1139,6 +1536,15 @@ type GoCryptoTraderServer interface {
 	GetOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error)
 	GetOrder(context.Context, *GetOrderRequest) (*OrderDetails, error)
 	SubmitOrder(context.Context, *SubmitOrderRequest) (*SubmitOrderResponse, error)
+	AddConditionalOrder(context.Context, *AddConditionalOrderRequest) (*AddConditionalOrderResponse, error)
+	GetConditionalOrders(context.Context, *GetConditionalOrdersRequest) (*GetConditionalOrdersResponse, error)
+	CancelConditionalOrder(context.Context, *CancelConditionalOrderRequest) (*GenericResponse, error)
+	GetPaperBalances(context.Context, *GetPaperBalancesRequest) (*GetPaperBalancesResponse, error)
+	GetConsolidatedOrderbook(context.Context, *GetConsolidatedOrderbookRequest) (*GetConsolidatedOrderbookResponse, error)
+	GetArbitrageOpportunities(context.Context, *GetArbitrageOpportunitiesRequest) (*GetArbitrageOpportunitiesResponse, error)
+	GetTriangularArbitrage(context.Context, *GetTriangularArbitrageRequest) (*GetTriangularArbitrageResponse, error)
+	GetTriangularArbitrageStream(*GetTriangularArbitrageRequest, GoCryptoTrader_GetTriangularArbitrageStreamServer) error
+	RouteOrder(context.Context, *RouteOrderRequest) (*RouteOrderResponse, error)
 	SimulateOrder(context.Context, *SimulateOrderRequest) (*SimulateOrderResponse, error)
 	WhaleBomb(context.Context, *WhaleBombRequest) (*SimulateOrderResponse, error)
 	CancelOrder(context.Context, *CancelOrderRequest) (*GenericResponse, error)
@@ -1152,6 +1558,14 @@ type GoCryptoTraderServer interface {
 	GetAvailableTransferChains(context.Context, *GetAvailableTransferChainsRequest) (*GetAvailableTransferChainsResponse, error)
 	WithdrawFiatFunds(context.Context, *WithdrawFiatRequest) (*WithdrawResponse, error)
 	WithdrawCryptocurrencyFunds(context.Context, *WithdrawCryptoRequest) (*WithdrawResponse, error)
+	AddWithdrawalAddress(context.Context, *AddWithdrawalAddressRequest) (*WithdrawalAddress, error)
+	RemoveWithdrawalAddress(context.Context, *RemoveWithdrawalAddressRequest) (*GenericResponse, error)
+	WhitelistWithdrawalAddress(context.Context, *WhitelistWithdrawalAddressRequest) (*WithdrawalAddress, error)
+	RenameWithdrawalAddress(context.Context, *RenameWithdrawalAddressRequest) (*WithdrawalAddress, error)
+	GetWithdrawalAddresses(context.Context, *GetWithdrawalAddressesRequest) (*GetWithdrawalAddressesResponse, error)
+	GetPendingWithdrawals(context.Context, *GetPendingWithdrawalsRequest) (*GetPendingWithdrawalsResponse, error)
+	ApproveWithdrawal(context.Context, *ApproveWithdrawalRequest) (*WithdrawResponse, error)
+	RejectWithdrawal(context.Context, *RejectWithdrawalRequest) (*GenericResponse, error)
 	WithdrawalEventByID(context.Context, *WithdrawalEventByIDRequest) (*WithdrawalEventByIDResponse, error)
 	WithdrawalEventsByExchange(context.Context, *WithdrawalEventsByExchangeRequest) (*WithdrawalEventsByExchangeResponse, error)
 	WithdrawalEventsByDate(context.Context, *WithdrawalEventsByDateRequest) (*WithdrawalEventsByExchangeResponse, error)
@@ -1160,6 +1574,7 @@ type GoCryptoTraderServer interface {
 	GetExchangePairs(context.Context, *GetExchangePairsRequest) (*GetExchangePairsResponse, error)
 	SetExchangePair(context.Context, *SetExchangePairRequest) (*GenericResponse, error)
 	GetOrderbookStream(*GetOrderbookStreamRequest, GoCryptoTrader_GetOrderbookStreamServer) error
+	GetOrderbookDeltaStream(*GetOrderbookStreamRequest, GoCryptoTrader_GetOrderbookDeltaStreamServer) error
 	GetExchangeOrderbookStream(*GetExchangeOrderbookStreamRequest, GoCryptoTrader_GetExchangeOrderbookStreamServer) error
 	GetTickerStream(*GetTickerStreamRequest, GoCryptoTrader_GetTickerStreamServer) error
 	GetExchangeTickerStream(*GetExchangeTickerStreamRequest, GoCryptoTrader_GetExchangeTickerStreamServer) error
@@ -1181,20 +1596,26 @@ type GoCryptoTraderServer interface {
 	WebsocketGetInfo(context.Context, *WebsocketGetInfoRequest) (*WebsocketGetInfoResponse, error)
 	WebsocketSetEnabled(context.Context, *WebsocketSetEnabledRequest) (*GenericResponse, error)
 	WebsocketGetSubscriptions(context.Context, *WebsocketGetSubscriptionsRequest) (*WebsocketGetSubscriptionsResponse, error)
+	WebsocketGetMetrics(context.Context, *WebsocketGetMetricsRequest) (*WebsocketGetMetricsResponse, error)
 	WebsocketSetProxy(context.Context, *WebsocketSetProxyRequest) (*GenericResponse, error)
 	WebsocketSetURL(context.Context, *WebsocketSetURLRequest) (*GenericResponse, error)
 	GetRecentTrades(context.Context, *GetSavedTradesRequest) (*SavedTradesResponse, error)
 	GetHistoricTrades(*GetSavedTradesRequest, GoCryptoTrader_GetHistoricTradesServer) error
 	GetSavedTrades(context.Context, *GetSavedTradesRequest) (*SavedTradesResponse, error)
 	ConvertTradesToCandles(context.Context, *ConvertTradesToCandlesRequest) (*GetHistoricCandlesResponse, error)
+	GetTradeFootprint(context.Context, *GetTradeFootprintRequest) (*GetTradeFootprintResponse, error)
 	FindMissingSavedCandleIntervals(context.Context, *FindMissingCandlePeriodsRequest) (*FindMissingIntervalsResponse, error)
 	FindMissingSavedTradeIntervals(context.Context, *FindMissingTradePeriodsRequest) (*FindMissingIntervalsResponse, error)
+	GetSavedCandleCoverage(context.Context, *GetSavedCandleCoverageRequest) (*GetSavedCandleCoverageResponse, error)
+	GetDataSyncStatus(context.Context, *GetDataSyncStatusRequest) (*GetDataSyncStatusResponse, error)
 	SetExchangeTradeProcessing(context.Context, *SetExchangeTradeProcessingRequest) (*GenericResponse, error)
 	UpsertDataHistoryJob(context.Context, *UpsertDataHistoryJobRequest) (*UpsertDataHistoryJobResponse, error)
 	GetDataHistoryJobDetails(context.Context, *GetDataHistoryJobDetailsRequest) (*DataHistoryJob, error)
 	GetActiveDataHistoryJobs(context.Context, *GetInfoRequest) (*DataHistoryJobs, error)
 	GetDataHistoryJobsBetween(context.Context, *GetDataHistoryJobsBetweenRequest) (*DataHistoryJobs, error)
 	GetDataHistoryJobSummary(context.Context, *GetDataHistoryJobDetailsRequest) (*DataHistoryJob, error)
+	GetDataHistoryJobProgress(context.Context, *GetDataHistoryJobDetailsRequest) (*DataHistoryJobProgress, error)
+	GetDataHistoryJobProgressStream(*GetDataHistoryJobDetailsRequest, GoCryptoTrader_GetDataHistoryJobProgressStreamServer) error
 	SetDataHistoryJobStatus(context.Context, *SetDataHistoryJobStatusRequest) (*GenericResponse, error)
 	UpdateDataHistoryJobPrerequisite(context.Context, *UpdateDataHistoryJobPrerequisiteRequest) (*GenericResponse, error)
 	GetManagedOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error)
@@ -1238,6 +1659,15 @@ func (UnimplementedGoCryptoTraderServer) DisableExchange(context.Context, *Gener
 func (UnimplementedGoCryptoTraderServer) GetExchangeInfo(context.Context, *GenericExchangeNameRequest) (*GetExchangeInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeInfo not implemented")
 }
+func (UnimplementedGoCryptoTraderServer) GetCapabilityGaps(context.Context, *GetCapabilityGapsRequest) (*GetCapabilityGapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCapabilityGaps not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetExchangeHealth(context.Context, *GetExchangeHealthRequest) (*GetExchangeHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeHealth not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetExchangeClockSkew(context.Context, *GetExchangeClockSkewRequest) (*GetExchangeClockSkewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeClockSkew not implemented")
+}
 func (UnimplementedGoCryptoTraderServer) GetExchangeOTPCode(context.Context, *GenericExchangeNameRequest) (*GetExchangeOTPResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeOTPCode not implemented")
 }
@@ -1277,8 +1707,23 @@ func (UnimplementedGoCryptoTraderServer) GetPortfolio(context.Context, *GetPortf
 func (UnimplementedGoCryptoTraderServer) GetPortfolioSummary(context.Context, *GetPortfolioSummaryRequest) (*GetPortfolioSummaryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolioSummary not implemented")
 }
-func (UnimplementedGoCryptoTraderServer) AddPortfolioAddress(context.Context, *AddPortfolioAddressRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddPortfolioAddress not implemented")
+func (UnimplementedGoCryptoTraderServer) GetPortfolioPnL(context.Context, *GetPortfolioPnLRequest) (*GetPortfolioPnLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolioPnL not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetPortfolioPerformance(context.Context, *GetPortfolioPerformanceRequest) (*GetPortfolioPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolioPerformance not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetDerivativePositions(context.Context, *GetDerivativePositionsRequest) (*GetDerivativePositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDerivativePositions not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetLeverage(context.Context, *GetLeverageRequest) (*GetLeverageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeverage not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) SetLeverage(context.Context, *SetLeverageRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLeverage not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) AddPortfolioAddress(context.Context, *AddPortfolioAddressRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPortfolioAddress not implemented")
 }
 func (UnimplementedGoCryptoTraderServer) RemovePortfolioAddress(context.Context, *RemovePortfolioAddressRequest) (*GenericResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemovePortfolioAddress not implemented")
@@ -1298,6 +1743,33 @@ func (UnimplementedGoCryptoTraderServer) GetOrder(context.Context, *GetOrderRequ
 func (UnimplementedGoCryptoTraderServer) SubmitOrder(context.Context, *SubmitOrderRequest) (*SubmitOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitOrder not implemented")
 }
+func (UnimplementedGoCryptoTraderServer) AddConditionalOrder(context.Context, *AddConditionalOrderRequest) (*AddConditionalOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddConditionalOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetConditionalOrders(context.Context, *GetConditionalOrdersRequest) (*GetConditionalOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConditionalOrders not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) CancelConditionalOrder(context.Context, *CancelConditionalOrderRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelConditionalOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetPaperBalances(context.Context, *GetPaperBalancesRequest) (*GetPaperBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPaperBalances not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetConsolidatedOrderbook(context.Context, *GetConsolidatedOrderbookRequest) (*GetConsolidatedOrderbookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConsolidatedOrderbook not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetArbitrageOpportunities(context.Context, *GetArbitrageOpportunitiesRequest) (*GetArbitrageOpportunitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArbitrageOpportunities not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetTriangularArbitrage(context.Context, *GetTriangularArbitrageRequest) (*GetTriangularArbitrageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTriangularArbitrage not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetTriangularArbitrageStream(*GetTriangularArbitrageRequest, GoCryptoTrader_GetTriangularArbitrageStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetTriangularArbitrageStream not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) RouteOrder(context.Context, *RouteOrderRequest) (*RouteOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RouteOrder not implemented")
+}
 func (UnimplementedGoCryptoTraderServer) SimulateOrder(context.Context, *SimulateOrderRequest) (*SimulateOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SimulateOrder not implemented")
 }
@@ -1337,6 +1809,30 @@ func (UnimplementedGoCryptoTraderServer) WithdrawFiatFunds(context.Context, *Wit
 func (UnimplementedGoCryptoTraderServer) WithdrawCryptocurrencyFunds(context.Context, *WithdrawCryptoRequest) (*WithdrawResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawCryptocurrencyFunds not implemented")
 }
+func (UnimplementedGoCryptoTraderServer) AddWithdrawalAddress(context.Context, *AddWithdrawalAddressRequest) (*WithdrawalAddress, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWithdrawalAddress not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) RemoveWithdrawalAddress(context.Context, *RemoveWithdrawalAddressRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWithdrawalAddress not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) WhitelistWithdrawalAddress(context.Context, *WhitelistWithdrawalAddressRequest) (*WithdrawalAddress, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WhitelistWithdrawalAddress not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) RenameWithdrawalAddress(context.Context, *RenameWithdrawalAddressRequest) (*WithdrawalAddress, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameWithdrawalAddress not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetWithdrawalAddresses(context.Context, *GetWithdrawalAddressesRequest) (*GetWithdrawalAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWithdrawalAddresses not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetPendingWithdrawals(context.Context, *GetPendingWithdrawalsRequest) (*GetPendingWithdrawalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingWithdrawals not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) ApproveWithdrawal(context.Context, *ApproveWithdrawalRequest) (*WithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveWithdrawal not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) RejectWithdrawal(context.Context, *RejectWithdrawalRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectWithdrawal not implemented")
+}
 func (UnimplementedGoCryptoTraderServer) WithdrawalEventByID(context.Context, *WithdrawalEventByIDRequest) (*WithdrawalEventByIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalEventByID not implemented")
 }
@@ -1361,6 +1857,9 @@ func (UnimplementedGoCryptoTraderServer) SetExchangePair(context.Context, *SetEx
 func (UnimplementedGoCryptoTraderServer) GetOrderbookStream(*GetOrderbookStreamRequest, GoCryptoTrader_GetOrderbookStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetOrderbookStream not implemented")
 }
+func (UnimplementedGoCryptoTraderServer) GetOrderbookDeltaStream(*GetOrderbookStreamRequest, GoCryptoTrader_GetOrderbookDeltaStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetOrderbookDeltaStream not implemented")
+}
 func (UnimplementedGoCryptoTraderServer) GetExchangeOrderbookStream(*GetExchangeOrderbookStreamRequest, GoCryptoTrader_GetExchangeOrderbookStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetExchangeOrderbookStream not implemented")
 }
@@ -1424,6 +1923,9 @@ func (UnimplementedGoCryptoTraderServer) WebsocketSetEnabled(context.Context, *W
 func (UnimplementedGoCryptoTraderServer) WebsocketGetSubscriptions(context.Context, *WebsocketGetSubscriptionsRequest) (*WebsocketGetSubscriptionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WebsocketGetSubscriptions not implemented")
 }
+func (UnimplementedGoCryptoTraderServer) WebsocketGetMetrics(context.Context, *WebsocketGetMetricsRequest) (*WebsocketGetMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WebsocketGetMetrics not implemented")
+}
 func (UnimplementedGoCryptoTraderServer) WebsocketSetProxy(context.Context, *WebsocketSetProxyRequest) (*GenericResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WebsocketSetProxy not implemented")
 }
@@ -1442,12 +1944,21 @@ func (UnimplementedGoCryptoTraderServer) GetSavedTrades(context.Context, *GetSav
 func (UnimplementedGoCryptoTraderServer) ConvertTradesToCandles(context.Context, *ConvertTradesToCandlesRequest) (*GetHistoricCandlesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConvertTradesToCandles not implemented")
 }
+func (UnimplementedGoCryptoTraderServer) GetTradeFootprint(context.Context, *GetTradeFootprintRequest) (*GetTradeFootprintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTradeFootprint not implemented")
+}
 func (UnimplementedGoCryptoTraderServer) FindMissingSavedCandleIntervals(context.Context, *FindMissingCandlePeriodsRequest) (*FindMissingIntervalsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindMissingSavedCandleIntervals not implemented")
 }
 func (UnimplementedGoCryptoTraderServer) FindMissingSavedTradeIntervals(context.Context, *FindMissingTradePeriodsRequest) (*FindMissingIntervalsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindMissingSavedTradeIntervals not implemented")
 }
+func (UnimplementedGoCryptoTraderServer) GetSavedCandleCoverage(context.Context, *GetSavedCandleCoverageRequest) (*GetSavedCandleCoverageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSavedCandleCoverage not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetDataSyncStatus(context.Context, *GetDataSyncStatusRequest) (*GetDataSyncStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataSyncStatus not implemented")
+}
 func (UnimplementedGoCryptoTraderServer) SetExchangeTradeProcessing(context.Context, *SetExchangeTradeProcessingRequest) (*GenericResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetExchangeTradeProcessing not implemented")
 }
@@ -1466,6 +1977,12 @@ func (UnimplementedGoCryptoTraderServer) GetDataHistoryJobsBetween(context.Conte
 func (UnimplementedGoCryptoTraderServer) GetDataHistoryJobSummary(context.Context, *GetDataHistoryJobDetailsRequest) (*DataHistoryJob, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDataHistoryJobSummary not implemented")
 }
+func (UnimplementedGoCryptoTraderServer) GetDataHistoryJobProgress(context.Context, *GetDataHistoryJobDetailsRequest) (*DataHistoryJobProgress, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataHistoryJobProgress not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetDataHistoryJobProgressStream(*GetDataHistoryJobDetailsRequest, GoCryptoTrader_GetDataHistoryJobProgressStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetDataHistoryJobProgressStream not implemented")
+}
 func (UnimplementedGoCryptoTraderServer) SetDataHistoryJobStatus(context.Context, *SetDataHistoryJobStatusRequest) (*GenericResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetDataHistoryJobStatus not implemented")
 }
@@ -1668,6 +2185,60 @@ func _GoCryptoTrader_GetExchangeInfo_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GoCryptoTrader_GetCapabilityGaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCapabilityGapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetCapabilityGaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetCapabilityGaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetCapabilityGaps(ctx, req.(*GetCapabilityGapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetExchangeHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExchangeHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetExchangeHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangeHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetExchangeHealth(ctx, req.(*GetExchangeHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetExchangeClockSkew_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExchangeClockSkewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetExchangeClockSkew(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangeClockSkew",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetExchangeClockSkew(ctx, req.(*GetExchangeClockSkewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GoCryptoTrader_GetExchangeOTPCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GenericExchangeNameRequest)
 	if err := dec(in); err != nil {
@@ -1905,6 +2476,96 @@ func _GoCryptoTrader_GetPortfolioSummary_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GoCryptoTrader_GetPortfolioPnL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortfolioPnLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetPortfolioPnL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetPortfolioPnL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetPortfolioPnL(ctx, req.(*GetPortfolioPnLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetPortfolioPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortfolioPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetPortfolioPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetPortfolioPerformance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetPortfolioPerformance(ctx, req.(*GetPortfolioPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetDerivativePositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDerivativePositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetDerivativePositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetDerivativePositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetDerivativePositions(ctx, req.(*GetDerivativePositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetLeverage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeverageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetLeverage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetLeverage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetLeverage(ctx, req.(*GetLeverageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_SetLeverage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLeverageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).SetLeverage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/SetLeverage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).SetLeverage(ctx, req.(*SetLeverageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GoCryptoTrader_AddPortfolioAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddPortfolioAddressRequest)
 	if err := dec(in); err != nil {
@@ -2023,244 +2684,553 @@ func _GoCryptoTrader_SubmitOrder_Handler(srv interface{}, ctx context.Context, d
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/SubmitOrder",
+		FullMethod: "/gctrpc.GoCryptoTrader/SubmitOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).SubmitOrder(ctx, req.(*SubmitOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_AddConditionalOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddConditionalOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).AddConditionalOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/AddConditionalOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).AddConditionalOrder(ctx, req.(*AddConditionalOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetConditionalOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConditionalOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetConditionalOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetConditionalOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetConditionalOrders(ctx, req.(*GetConditionalOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_CancelConditionalOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelConditionalOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).CancelConditionalOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/CancelConditionalOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).CancelConditionalOrder(ctx, req.(*CancelConditionalOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetPaperBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPaperBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetPaperBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetPaperBalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetPaperBalances(ctx, req.(*GetPaperBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetConsolidatedOrderbook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConsolidatedOrderbookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetConsolidatedOrderbook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetConsolidatedOrderbook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetConsolidatedOrderbook(ctx, req.(*GetConsolidatedOrderbookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetArbitrageOpportunities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArbitrageOpportunitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetArbitrageOpportunities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetArbitrageOpportunities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetArbitrageOpportunities(ctx, req.(*GetArbitrageOpportunitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetTriangularArbitrage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTriangularArbitrageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetTriangularArbitrage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetTriangularArbitrage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetTriangularArbitrage(ctx, req.(*GetTriangularArbitrageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetTriangularArbitrageStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetTriangularArbitrageRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetTriangularArbitrageStream(m, &goCryptoTraderGetTriangularArbitrageStreamServer{stream})
+}
+
+type GoCryptoTrader_GetTriangularArbitrageStreamServer interface {
+	Send(*GetTriangularArbitrageResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetTriangularArbitrageStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetTriangularArbitrageStreamServer) Send(m *GetTriangularArbitrageResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_RouteOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RouteOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).RouteOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/RouteOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).RouteOrder(ctx, req.(*RouteOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_SimulateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).SimulateOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/SimulateOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).SimulateOrder(ctx, req.(*SimulateOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_WhaleBomb_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WhaleBombRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).WhaleBomb(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/WhaleBomb",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).WhaleBomb(ctx, req.(*WhaleBombRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/CancelOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).CancelOrder(ctx, req.(*CancelOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_CancelBatchOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelBatchOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).CancelBatchOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/CancelBatchOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).CancelBatchOrders(ctx, req.(*CancelBatchOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_CancelAllOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelAllOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).CancelAllOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/CancelAllOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).CancelAllOrders(ctx, req.(*CancelAllOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetEvents(ctx, req.(*GetEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_AddEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).AddEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/AddEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).AddEvent(ctx, req.(*AddEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_RemoveEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).RemoveEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/RemoveEvent",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).SubmitOrder(ctx, req.(*SubmitOrderRequest))
+		return srv.(GoCryptoTraderServer).RemoveEvent(ctx, req.(*RemoveEventRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_SimulateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SimulateOrderRequest)
+func _GoCryptoTrader_GetCryptocurrencyDepositAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCryptocurrencyDepositAddressesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).SimulateOrder(ctx, in)
+		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddresses(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/SimulateOrder",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetCryptocurrencyDepositAddresses",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).SimulateOrder(ctx, req.(*SimulateOrderRequest))
+		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddresses(ctx, req.(*GetCryptocurrencyDepositAddressesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_WhaleBomb_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WhaleBombRequest)
+func _GoCryptoTrader_GetCryptocurrencyDepositAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCryptocurrencyDepositAddressRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).WhaleBomb(ctx, in)
+		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddress(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/WhaleBomb",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetCryptocurrencyDepositAddress",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).WhaleBomb(ctx, req.(*WhaleBombRequest))
+		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddress(ctx, req.(*GetCryptocurrencyDepositAddressRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelOrderRequest)
+func _GoCryptoTrader_GetAvailableTransferChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAvailableTransferChainsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).CancelOrder(ctx, in)
+		return srv.(GoCryptoTraderServer).GetAvailableTransferChains(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/CancelOrder",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetAvailableTransferChains",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).CancelOrder(ctx, req.(*CancelOrderRequest))
+		return srv.(GoCryptoTraderServer).GetAvailableTransferChains(ctx, req.(*GetAvailableTransferChainsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_CancelBatchOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelBatchOrdersRequest)
+func _GoCryptoTrader_WithdrawFiatFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawFiatRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).CancelBatchOrders(ctx, in)
+		return srv.(GoCryptoTraderServer).WithdrawFiatFunds(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/CancelBatchOrders",
+		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawFiatFunds",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).CancelBatchOrders(ctx, req.(*CancelBatchOrdersRequest))
+		return srv.(GoCryptoTraderServer).WithdrawFiatFunds(ctx, req.(*WithdrawFiatRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_CancelAllOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelAllOrdersRequest)
+func _GoCryptoTrader_WithdrawCryptocurrencyFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawCryptoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).CancelAllOrders(ctx, in)
+		return srv.(GoCryptoTraderServer).WithdrawCryptocurrencyFunds(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/CancelAllOrders",
+		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawCryptocurrencyFunds",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).CancelAllOrders(ctx, req.(*CancelAllOrdersRequest))
+		return srv.(GoCryptoTraderServer).WithdrawCryptocurrencyFunds(ctx, req.(*WithdrawCryptoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetEventsRequest)
+func _GoCryptoTrader_AddWithdrawalAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddWithdrawalAddressRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetEvents(ctx, in)
+		return srv.(GoCryptoTraderServer).AddWithdrawalAddress(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetEvents",
+		FullMethod: "/gctrpc.GoCryptoTrader/AddWithdrawalAddress",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetEvents(ctx, req.(*GetEventsRequest))
+		return srv.(GoCryptoTraderServer).AddWithdrawalAddress(ctx, req.(*AddWithdrawalAddressRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_AddEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddEventRequest)
+func _GoCryptoTrader_RemoveWithdrawalAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveWithdrawalAddressRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).AddEvent(ctx, in)
+		return srv.(GoCryptoTraderServer).RemoveWithdrawalAddress(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/AddEvent",
+		FullMethod: "/gctrpc.GoCryptoTrader/RemoveWithdrawalAddress",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).AddEvent(ctx, req.(*AddEventRequest))
+		return srv.(GoCryptoTraderServer).RemoveWithdrawalAddress(ctx, req.(*RemoveWithdrawalAddressRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_RemoveEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveEventRequest)
+func _GoCryptoTrader_WhitelistWithdrawalAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WhitelistWithdrawalAddressRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).RemoveEvent(ctx, in)
+		return srv.(GoCryptoTraderServer).WhitelistWithdrawalAddress(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/RemoveEvent",
+		FullMethod: "/gctrpc.GoCryptoTrader/WhitelistWithdrawalAddress",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).RemoveEvent(ctx, req.(*RemoveEventRequest))
+		return srv.(GoCryptoTraderServer).WhitelistWithdrawalAddress(ctx, req.(*WhitelistWithdrawalAddressRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetCryptocurrencyDepositAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCryptocurrencyDepositAddressesRequest)
+func _GoCryptoTrader_RenameWithdrawalAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameWithdrawalAddressRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddresses(ctx, in)
+		return srv.(GoCryptoTraderServer).RenameWithdrawalAddress(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetCryptocurrencyDepositAddresses",
+		FullMethod: "/gctrpc.GoCryptoTrader/RenameWithdrawalAddress",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddresses(ctx, req.(*GetCryptocurrencyDepositAddressesRequest))
+		return srv.(GoCryptoTraderServer).RenameWithdrawalAddress(ctx, req.(*RenameWithdrawalAddressRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetCryptocurrencyDepositAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCryptocurrencyDepositAddressRequest)
+func _GoCryptoTrader_GetWithdrawalAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWithdrawalAddressesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddress(ctx, in)
+		return srv.(GoCryptoTraderServer).GetWithdrawalAddresses(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetCryptocurrencyDepositAddress",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetWithdrawalAddresses",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddress(ctx, req.(*GetCryptocurrencyDepositAddressRequest))
+		return srv.(GoCryptoTraderServer).GetWithdrawalAddresses(ctx, req.(*GetWithdrawalAddressesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetAvailableTransferChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAvailableTransferChainsRequest)
+func _GoCryptoTrader_GetPendingWithdrawals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPendingWithdrawalsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetAvailableTransferChains(ctx, in)
+		return srv.(GoCryptoTraderServer).GetPendingWithdrawals(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetAvailableTransferChains",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetPendingWithdrawals",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetAvailableTransferChains(ctx, req.(*GetAvailableTransferChainsRequest))
+		return srv.(GoCryptoTraderServer).GetPendingWithdrawals(ctx, req.(*GetPendingWithdrawalsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_WithdrawFiatFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WithdrawFiatRequest)
+func _GoCryptoTrader_ApproveWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveWithdrawalRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).WithdrawFiatFunds(ctx, in)
+		return srv.(GoCryptoTraderServer).ApproveWithdrawal(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawFiatFunds",
+		FullMethod: "/gctrpc.GoCryptoTrader/ApproveWithdrawal",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).WithdrawFiatFunds(ctx, req.(*WithdrawFiatRequest))
+		return srv.(GoCryptoTraderServer).ApproveWithdrawal(ctx, req.(*ApproveWithdrawalRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_WithdrawCryptocurrencyFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WithdrawCryptoRequest)
+func _GoCryptoTrader_RejectWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectWithdrawalRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).WithdrawCryptocurrencyFunds(ctx, in)
+		return srv.(GoCryptoTraderServer).RejectWithdrawal(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawCryptocurrencyFunds",
+		FullMethod: "/gctrpc.GoCryptoTrader/RejectWithdrawal",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).WithdrawCryptocurrencyFunds(ctx, req.(*WithdrawCryptoRequest))
+		return srv.(GoCryptoTraderServer).RejectWithdrawal(ctx, req.(*RejectWithdrawalRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2412,6 +3382,27 @@ func (x *goCryptoTraderGetOrderbookStreamServer) Send(m *OrderbookResponse) erro
 	return x.ServerStream.SendMsg(m)
 }
 
+func _GoCryptoTrader_GetOrderbookDeltaStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetOrderbookStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetOrderbookDeltaStream(m, &goCryptoTraderGetOrderbookDeltaStreamServer{stream})
+}
+
+type GoCryptoTrader_GetOrderbookDeltaStreamServer interface {
+	Send(*OrderbookDeltaResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetOrderbookDeltaStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetOrderbookDeltaStreamServer) Send(m *OrderbookDeltaResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _GoCryptoTrader_GetExchangeOrderbookStream_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(GetExchangeOrderbookStreamRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -2799,6 +3790,24 @@ func _GoCryptoTrader_WebsocketGetSubscriptions_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GoCryptoTrader_WebsocketGetMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebsocketGetMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).WebsocketGetMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/WebsocketGetMetrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).WebsocketGetMetrics(ctx, req.(*WebsocketGetMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GoCryptoTrader_WebsocketSetProxy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(WebsocketSetProxyRequest)
 	if err := dec(in); err != nil {
@@ -2910,6 +3919,24 @@ func _GoCryptoTrader_ConvertTradesToCandles_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GoCryptoTrader_GetTradeFootprint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTradeFootprintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetTradeFootprint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetTradeFootprint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetTradeFootprint(ctx, req.(*GetTradeFootprintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GoCryptoTrader_FindMissingSavedCandleIntervals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FindMissingCandlePeriodsRequest)
 	if err := dec(in); err != nil {
@@ -2946,6 +3973,42 @@ func _GoCryptoTrader_FindMissingSavedTradeIntervals_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GoCryptoTrader_GetSavedCandleCoverage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSavedCandleCoverageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetSavedCandleCoverage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetSavedCandleCoverage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetSavedCandleCoverage(ctx, req.(*GetSavedCandleCoverageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetDataSyncStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataSyncStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetDataSyncStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetDataSyncStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetDataSyncStatus(ctx, req.(*GetDataSyncStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GoCryptoTrader_SetExchangeTradeProcessing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetExchangeTradeProcessingRequest)
 	if err := dec(in); err != nil {
@@ -3054,6 +4117,45 @@ func _GoCryptoTrader_GetDataHistoryJobSummary_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GoCryptoTrader_GetDataHistoryJobProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataHistoryJobDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetDataHistoryJobProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetDataHistoryJobProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetDataHistoryJobProgress(ctx, req.(*GetDataHistoryJobDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetDataHistoryJobProgressStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetDataHistoryJobDetailsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetDataHistoryJobProgressStream(m, &goCryptoTraderGetDataHistoryJobProgressStreamServer{stream})
+}
+
+type GoCryptoTrader_GetDataHistoryJobProgressStreamServer interface {
+	Send(*DataHistoryJobProgress) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetDataHistoryJobProgressStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetDataHistoryJobProgressStreamServer) Send(m *DataHistoryJobProgress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _GoCryptoTrader_SetDataHistoryJobStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetDataHistoryJobStatusRequest)
 	if err := dec(in); err != nil {
@@ -3259,6 +4361,18 @@ var GoCryptoTrader_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetExchangeInfo",
 			Handler:    _GoCryptoTrader_GetExchangeInfo_Handler,
 		},
+		{
+			MethodName: "GetCapabilityGaps",
+			Handler:    _GoCryptoTrader_GetCapabilityGaps_Handler,
+		},
+		{
+			MethodName: "GetExchangeHealth",
+			Handler:    _GoCryptoTrader_GetExchangeHealth_Handler,
+		},
+		{
+			MethodName: "GetExchangeClockSkew",
+			Handler:    _GoCryptoTrader_GetExchangeClockSkew_Handler,
+		},
 		{
 			MethodName: "GetExchangeOTPCode",
 			Handler:    _GoCryptoTrader_GetExchangeOTPCode_Handler,
@@ -3307,6 +4421,26 @@ var GoCryptoTrader_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPortfolioSummary",
 			Handler:    _GoCryptoTrader_GetPortfolioSummary_Handler,
 		},
+		{
+			MethodName: "GetPortfolioPnL",
+			Handler:    _GoCryptoTrader_GetPortfolioPnL_Handler,
+		},
+		{
+			MethodName: "GetPortfolioPerformance",
+			Handler:    _GoCryptoTrader_GetPortfolioPerformance_Handler,
+		},
+		{
+			MethodName: "GetDerivativePositions",
+			Handler:    _GoCryptoTrader_GetDerivativePositions_Handler,
+		},
+		{
+			MethodName: "GetLeverage",
+			Handler:    _GoCryptoTrader_GetLeverage_Handler,
+		},
+		{
+			MethodName: "SetLeverage",
+			Handler:    _GoCryptoTrader_SetLeverage_Handler,
+		},
 		{
 			MethodName: "AddPortfolioAddress",
 			Handler:    _GoCryptoTrader_AddPortfolioAddress_Handler,
@@ -3335,6 +4469,38 @@ var GoCryptoTrader_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitOrder",
 			Handler:    _GoCryptoTrader_SubmitOrder_Handler,
 		},
+		{
+			MethodName: "AddConditionalOrder",
+			Handler:    _GoCryptoTrader_AddConditionalOrder_Handler,
+		},
+		{
+			MethodName: "GetConditionalOrders",
+			Handler:    _GoCryptoTrader_GetConditionalOrders_Handler,
+		},
+		{
+			MethodName: "CancelConditionalOrder",
+			Handler:    _GoCryptoTrader_CancelConditionalOrder_Handler,
+		},
+		{
+			MethodName: "GetPaperBalances",
+			Handler:    _GoCryptoTrader_GetPaperBalances_Handler,
+		},
+		{
+			MethodName: "GetConsolidatedOrderbook",
+			Handler:    _GoCryptoTrader_GetConsolidatedOrderbook_Handler,
+		},
+		{
+			MethodName: "GetArbitrageOpportunities",
+			Handler:    _GoCryptoTrader_GetArbitrageOpportunities_Handler,
+		},
+		{
+			MethodName: "GetTriangularArbitrage",
+			Handler:    _GoCryptoTrader_GetTriangularArbitrage_Handler,
+		},
+		{
+			MethodName: "RouteOrder",
+			Handler:    _GoCryptoTrader_RouteOrder_Handler,
+		},
 		{
 			MethodName: "SimulateOrder",
 			Handler:    _GoCryptoTrader_SimulateOrder_Handler,
@@ -3387,6 +4553,38 @@ var GoCryptoTrader_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "WithdrawCryptocurrencyFunds",
 			Handler:    _GoCryptoTrader_WithdrawCryptocurrencyFunds_Handler,
 		},
+		{
+			MethodName: "AddWithdrawalAddress",
+			Handler:    _GoCryptoTrader_AddWithdrawalAddress_Handler,
+		},
+		{
+			MethodName: "RemoveWithdrawalAddress",
+			Handler:    _GoCryptoTrader_RemoveWithdrawalAddress_Handler,
+		},
+		{
+			MethodName: "WhitelistWithdrawalAddress",
+			Handler:    _GoCryptoTrader_WhitelistWithdrawalAddress_Handler,
+		},
+		{
+			MethodName: "RenameWithdrawalAddress",
+			Handler:    _GoCryptoTrader_RenameWithdrawalAddress_Handler,
+		},
+		{
+			MethodName: "GetWithdrawalAddresses",
+			Handler:    _GoCryptoTrader_GetWithdrawalAddresses_Handler,
+		},
+		{
+			MethodName: "GetPendingWithdrawals",
+			Handler:    _GoCryptoTrader_GetPendingWithdrawals_Handler,
+		},
+		{
+			MethodName: "ApproveWithdrawal",
+			Handler:    _GoCryptoTrader_ApproveWithdrawal_Handler,
+		},
+		{
+			MethodName: "RejectWithdrawal",
+			Handler:    _GoCryptoTrader_RejectWithdrawal_Handler,
+		},
 		{
 			MethodName: "WithdrawalEventByID",
 			Handler:    _GoCryptoTrader_WithdrawalEventByID_Handler,
@@ -3487,6 +4685,10 @@ var GoCryptoTrader_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "WebsocketGetSubscriptions",
 			Handler:    _GoCryptoTrader_WebsocketGetSubscriptions_Handler,
 		},
+		{
+			MethodName: "WebsocketGetMetrics",
+			Handler:    _GoCryptoTrader_WebsocketGetMetrics_Handler,
+		},
 		{
 			MethodName: "WebsocketSetProxy",
 			Handler:    _GoCryptoTrader_WebsocketSetProxy_Handler,
@@ -3507,6 +4709,10 @@ var GoCryptoTrader_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ConvertTradesToCandles",
 			Handler:    _GoCryptoTrader_ConvertTradesToCandles_Handler,
 		},
+		{
+			MethodName: "GetTradeFootprint",
+			Handler:    _GoCryptoTrader_GetTradeFootprint_Handler,
+		},
 		{
 			MethodName: "FindMissingSavedCandleIntervals",
 			Handler:    _GoCryptoTrader_FindMissingSavedCandleIntervals_Handler,
@@ -3515,6 +4721,14 @@ var GoCryptoTrader_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FindMissingSavedTradeIntervals",
 			Handler:    _GoCryptoTrader_FindMissingSavedTradeIntervals_Handler,
 		},
+		{
+			MethodName: "GetSavedCandleCoverage",
+			Handler:    _GoCryptoTrader_GetSavedCandleCoverage_Handler,
+		},
+		{
+			MethodName: "GetDataSyncStatus",
+			Handler:    _GoCryptoTrader_GetDataSyncStatus_Handler,
+		},
 		{
 			MethodName: "SetExchangeTradeProcessing",
 			Handler:    _GoCryptoTrader_SetExchangeTradeProcessing_Handler,
@@ -3539,6 +4753,10 @@ var GoCryptoTrader_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDataHistoryJobSummary",
 			Handler:    _GoCryptoTrader_GetDataHistoryJobSummary_Handler,
 		},
+		{
+			MethodName: "GetDataHistoryJobProgress",
+			Handler:    _GoCryptoTrader_GetDataHistoryJobProgress_Handler,
+		},
 		{
 			MethodName: "SetDataHistoryJobStatus",
 			Handler:    _GoCryptoTrader_SetDataHistoryJobStatus_Handler,
@@ -3582,11 +4800,21 @@ var GoCryptoTrader_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _GoCryptoTrader_GetAccountInfoStream_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetTriangularArbitrageStream",
+			Handler:       _GoCryptoTrader_GetTriangularArbitrageStream_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "GetOrderbookStream",
 			Handler:       _GoCryptoTrader_GetOrderbookStream_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetOrderbookDeltaStream",
+			Handler:       _GoCryptoTrader_GetOrderbookDeltaStream_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "GetExchangeOrderbookStream",
 			Handler:       _GoCryptoTrader_GetExchangeOrderbookStream_Handler,
@@ -3607,6 +4835,11 @@ var GoCryptoTrader_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _GoCryptoTrader_GetHistoricTrades_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetDataHistoryJobProgressStream",
+			Handler:       _GoCryptoTrader_GetDataHistoryJobProgressStream_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "rpc.proto",
 }
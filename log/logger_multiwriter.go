@@ -14,20 +14,16 @@ func (mw *multiWriter) Add(writer io.Writer) {
 // Remove removes existing writer from multiwriter slice
 func (mw *multiWriter) Remove(writer io.Writer) {
 	mw.mu.Lock()
-
-	var removeIDs []int
+	writers := mw.writers[:0]
 	for i := range mw.writers {
-		if mw.writers[i] == writer {
-			removeIDs = append(removeIDs, i)
+		if mw.writers[i] != writer {
+			writers = append(writers, mw.writers[i])
 		}
 	}
-
-	for x := range removeIDs {
-		mw.writers[x] = mw.writers[len(mw.writers)-1]
-		mw.writers[len(mw.writers)-1] = nil
-		mw.writers = mw.writers[:len(mw.writers)-1]
+	for i := len(writers); i < len(mw.writers); i++ {
+		mw.writers[i] = nil
 	}
-
+	mw.writers = writers
 	mw.mu.Unlock()
 }
 
@@ -85,6 +85,41 @@ func SetupSubLoggers(s []SubLoggerConfig) {
 	}
 }
 
+// AddWriter adds a writer to the output of every sub logger, allowing logs to
+// be copied to another destination such as a file
+func AddWriter(w io.Writer) {
+	RWM.Lock()
+	defer RWM.Unlock()
+	added := make(map[*multiWriter]bool)
+	for x := range subLoggers {
+		mw, ok := subLoggers[x].output.(*multiWriter)
+		if !ok {
+			mw = &multiWriter{}
+			if subLoggers[x].output != nil {
+				mw.Add(subLoggers[x].output)
+			}
+			subLoggers[x].output = mw
+		}
+		if added[mw] {
+			continue
+		}
+		mw.Add(w)
+		added[mw] = true
+	}
+}
+
+// RemoveWriter removes a writer added by AddWriter from the output of every
+// sub logger
+func RemoveWriter(w io.Writer) {
+	RWM.Lock()
+	defer RWM.Unlock()
+	for x := range subLoggers {
+		if mw, ok := subLoggers[x].output.(*multiWriter); ok {
+			mw.Remove(w)
+		}
+	}
+}
+
 // SetupGlobalLogger setup the global loggers with the default global config values
 func SetupGlobalLogger() {
 	RWM.Lock()
@@ -101,6 +101,24 @@ func TestRemoveWriter(t *testing.T) {
 	if len(m.writers) != total-2 {
 		t.Errorf("expected m.Writers to be %v got %v", total-2, len(m.writers))
 	}
+	if m.writers[0] != ioutil.Discard {
+		t.Error("expected remaining writer to be ioutil.Discard")
+	}
+}
+
+func TestAddRemoveWriterToSubLoggers(t *testing.T) {
+	var b bytes.Buffer
+	AddWriter(&b)
+	Info(Global, "copied")
+	if !strings.Contains(b.String(), "copied") {
+		t.Errorf("expected log to be copied to added writer, received %q", b.String())
+	}
+	RemoveWriter(&b)
+	b.Reset()
+	Info(Global, "not copied")
+	if b.Len() != 0 {
+		t.Errorf("expected no log after writer removed, received %q", b.String())
+	}
 }
 
 func TestLevel(t *testing.T) {
@@ -36,7 +36,21 @@ func main() {
 	flag.BoolVar(&settings.EnableAllPairs, "enableallpairs", false, "enables all pairs for enabled exchanges")
 	flag.BoolVar(&settings.EnablePortfolioManager, "portfoliomanager", true, "enables the portfolio manager")
 	flag.BoolVar(&settings.EnableDataHistoryManager, "datahistorymanager", false, "enables the data history manager")
+	flag.BoolVar(&settings.EnableDataSyncManager, "datasyncmanager", false, "enables the data sync manager")
+	flag.BoolVar(&settings.EnableOrderbookRecorder, "orderbookrecorder", false, "enables the orderbook recorder")
+	flag.BoolVar(&settings.EnableDataRetentionManager, "dataretentionmanager", false, "enables the data retention manager")
+	flag.BoolVar(&settings.EnableRateLimitBudgetManager, "ratelimitbudgetmanager", false, "enables the rate limit budget manager")
 	flag.DurationVar(&settings.PortfolioManagerDelay, "portfoliomanagerdelay", time.Duration(0), "sets the portfolio managers sleep delay between updates")
+	flag.BoolVar(&settings.EnablePortfolioAnalytics, "portfolioanalytics", false, "enables the portfolio analytics manager to track profit and loss of orders, requires the order manager")
+	flag.DurationVar(&settings.PortfolioAnalyticsDelay, "portfolioanalyticsdelay", time.Duration(0), "sets the portfolio analytics managers sleep delay between updates")
+	flag.BoolVar(&settings.EnablePositionsManager, "positionsmanager", false, "enables the positions manager to track derivative positions, margin and profit and loss, requires the order manager")
+	flag.DurationVar(&settings.PositionsManagerDelay, "positionsmanagerdelay", time.Duration(0), "sets the positions managers sleep delay between updates")
+	flag.Float64Var(&settings.MarginRatioAlert, "marginratioalert", engine.DefaultMarginRatioAlert, "sets the margin ratio at which the positions manager alerts that a position is approaching liquidation")
+	flag.BoolVar(&settings.EnableOrderRouter, "orderrouter", false, "enables the order router to route orders to the exchanges offering the best execution, requires the order manager")
+	flag.BoolVar(&settings.EnableWebhookManager, "webhookmanager", false, "enables the webhook manager to post account activity to the configured endpoints")
+	flag.BoolVar(&settings.EnableDepositManager, "depositmanager", false, "enables the deposit manager to refresh deposit addresses and track inbound deposits")
+	flag.BoolVar(&settings.EnableArbitrageScanner, "arbitragescanner", false, "enables the arbitrage scanner to compare orderbooks across exchanges for arbitrage opportunities")
+	flag.BoolVar(&settings.EnableTriangularArbitrage, "triangulararbitrage", false, "enables the triangular arbitrage detector to search each exchange's spot pairs for profitable cycles")
 	flag.BoolVar(&settings.EnableGRPC, "grpc", true, "enables the grpc server")
 	flag.BoolVar(&settings.EnableGRPCProxy, "grpcproxy", false, "enables the grpc proxy server")
 	flag.BoolVar(&settings.EnableWebsocketRPC, "websocketrpc", true, "enables the websocket RPC server")
@@ -48,6 +62,8 @@ func main() {
 	flag.BoolVar(&settings.EnableCoinmarketcapAnalysis, "coinmarketcap", false, "overrides config and runs currency analysis")
 	flag.BoolVar(&settings.EnableEventManager, "eventmanager", true, "enables the event manager")
 	flag.BoolVar(&settings.EnableOrderManager, "ordermanager", true, "enables the order manager")
+	flag.BoolVar(&settings.EnablePaperTrading, "papertrading", false, "simulates order fills against live orderbooks using virtual balances instead of submitting orders to exchanges, requires the order manager")
+	flag.BoolVar(&settings.EnableOrderPersistence, "orderpersistence", false, "stores the order manager's active orders in the database so they are restored and reconciled against exchanges after a restart, requires the order manager and database manager")
 	flag.BoolVar(&settings.EnableDepositAddressManager, "depositaddressmanager", true, "enables the deposit address manager")
 	flag.BoolVar(&settings.EnableConnectivityMonitor, "connectivitymonitor", true, "enables the connectivity monitor")
 	flag.BoolVar(&settings.EnableDatabaseManager, "databasemanager", true, "enables database manager")
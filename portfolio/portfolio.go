@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"sort"
 	"strings"
 	"time"
 
@@ -254,6 +255,14 @@ func (b *Base) UpdatePortfolio(addresses []string, coinType currency.Code) error
 		return nil
 	}
 
+	addresses, err := b.updateProviderBalances(context.TODO(), addresses, coinType)
+	if err != nil {
+		return err
+	}
+	if len(addresses) == 0 {
+		return nil
+	}
+
 	switch coinType {
 	case currency.ETH:
 		for x := range addresses {
@@ -393,6 +402,7 @@ func (b *Base) GetPortfolioSummary() Summary {
 		coins := Coin{Coin: x, Balance: y}
 		portfolioOutput.Totals = append(portfolioOutput.Totals, coins)
 	}
+	portfolioOutput.CashEquivalents = getCashEquivalents(totalCoins)
 
 	for x, y := range personalHoldings {
 		coins := Coin{
@@ -443,6 +453,7 @@ func (b *Base) GetPortfolioSummary() Summary {
 		if !strings.EqualFold(b.Addresses[i].Description, ExchangeAddress) {
 			coinSummary := OfflineCoinSummary{
 				Address: b.Addresses[i].Address,
+				Chain:   b.Addresses[i].Chain,
 				Balance: b.Addresses[i].Balance,
 				Percentage: getPercentageSpecific(b.Addresses[i].Balance, b.Addresses[i].CoinType,
 					totalCoins),
@@ -461,6 +472,26 @@ func (b *Base) GetPortfolioSummary() Summary {
 	return portfolioOutput
 }
 
+// getCashEquivalents aggregates fiat currency and stablecoin balances by the
+// fiat currency they represent
+func getCashEquivalents(totals map[currency.Code]float64) []Coin {
+	grouped := make(map[currency.Code]float64)
+	for x, y := range totals {
+		if !x.IsStablecoin() && !x.IsFiatCurrency() {
+			continue
+		}
+		grouped[x.GetCashEquivalent()] += y
+	}
+	resp := make([]Coin, 0, len(grouped))
+	for x, y := range grouped {
+		resp = append(resp, Coin{Coin: x, Balance: y})
+	}
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].Coin.String() < resp[j].Coin.String()
+	})
+	return resp
+}
+
 // GetPortfolioGroupedCoin returns portfolio base information grouped by coin
 func (b *Base) GetPortfolioGroupedCoin() map[currency.Code][]string {
 	result := make(map[currency.Code][]string)
@@ -638,3 +638,19 @@ func seedPortFolioForTest(t *testing.T) *Base {
 	}
 	return &b
 }
+
+func TestGetCashEquivalents(t *testing.T) {
+	t.Parallel()
+	resp := getCashEquivalents(map[currency.Code]float64{
+		currency.USDT: 10,
+		currency.USDC: 5,
+		currency.EURT: 1,
+		currency.BTC:  1,
+	})
+	if len(resp) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(resp), 2)
+	}
+	if !resp[1].Coin.Match(currency.USD) || resp[1].Balance != 15 {
+		t.Errorf("unexpected cash equivalent %+v", resp[1])
+	}
+}
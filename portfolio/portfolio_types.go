@@ -6,10 +6,14 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 )
 
-// Base holds the portfolio base addresses
+// Base holds the portfolio base addresses and the providers used to retrieve
+// their on-chain balances
 type Base struct {
-	Addresses []Address `json:"addresses"`
+	Addresses []Address        `json:"addresses"`
+	Providers []ProviderConfig `json:"providers,omitempty"`
 	Verbose   bool
+
+	providers []Provider
 }
 
 // Address sub type holding address information for portfolio
@@ -17,6 +21,7 @@ type Address struct {
 	Address            string
 	AddressTag         string
 	CoinType           currency.Code
+	Chain              string `json:",omitempty"`
 	Balance            float64
 	Description        string
 	WhiteListed        bool
@@ -107,6 +112,7 @@ type Coin struct {
 // relative to the total amount.
 type OfflineCoinSummary struct {
 	Address    string  `json:"address"`
+	Chain      string  `json:"chain,omitempty"`
 	Balance    float64 `json:"balance"`
 	Percentage float64 `json:"percentage,omitempty"`
 }
@@ -125,6 +131,9 @@ type Summary struct {
 	OfflineSummary map[currency.Code][]OfflineCoinSummary         `json:"offline_summary"`
 	Online         []Coin                                         `json:"coins_online"`
 	OnlineSummary  map[string]map[currency.Code]OnlineCoinSummary `json:"online_summary"`
+	// CashEquivalents groups fiat and stablecoin totals by their fiat
+	// currency, e.g. USDT and USDC balances are included in USD
+	CashEquivalents []Coin `json:"cash_equivalents,omitempty"`
 }
 
 // XRPScanAccount defines the return type for account data
@@ -0,0 +1,327 @@
+package portfolio
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+var evmAddress = regexp.MustCompile("^0x[0-9a-fA-F]{40}$")
+
+// NewProvider returns the provider of the configured type
+func NewProvider(cfg *ProviderConfig) (Provider, error) {
+	if cfg == nil {
+		return nil, errInvalidProviderConfig
+	}
+	switch strings.ToLower(cfg.Type) {
+	case ProviderTypeEVM:
+		return NewEVMProvider(cfg)
+	case ProviderTypeEsplora:
+		return NewEsploraProvider(cfg)
+	default:
+		return nil, fmt.Errorf("%w '%s' for provider %s", errUnknownProviderType, cfg.Type, cfg.Name)
+	}
+}
+
+// NewEVMProvider returns a provider which retrieves balances from an EVM
+// compatible JSON-RPC endpoint
+func NewEVMProvider(cfg *ProviderConfig) (*EVMProvider, error) {
+	err := validateProviderConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	for i := range cfg.Tokens {
+		if cfg.Tokens[i].Currency.IsEmpty() ||
+			!evmAddress.MatchString(cfg.Tokens[i].Contract) ||
+			cfg.Tokens[i].Decimals < 0 {
+			return nil, fmt.Errorf("%w, provider %s token '%s' contract '%s'",
+				errInvalidProviderConfig,
+				cfg.Name,
+				cfg.Tokens[i].Currency,
+				cfg.Tokens[i].Contract)
+		}
+	}
+	decimals := cfg.Decimals
+	if decimals <= 0 {
+		decimals = defaultEVMDecimals
+	}
+	return &EVMProvider{
+		name:     cfg.Name,
+		chain:    cfg.Chain,
+		url:      cfg.URL,
+		native:   cfg.Currency,
+		decimals: decimals,
+		tokens:   cfg.Tokens,
+		verbose:  cfg.Verbose,
+	}, nil
+}
+
+// GetName returns the name of the provider
+func (e *EVMProvider) GetName() string {
+	return e.name
+}
+
+// Supports returns whether the currency is the chain's native currency or a
+// configured token
+func (e *EVMProvider) Supports(coinType currency.Code, chain string) bool {
+	if chain != "" && !strings.EqualFold(chain, e.chain) {
+		return false
+	}
+	if coinType.Match(e.native) {
+		return true
+	}
+	_, ok := e.getToken(coinType)
+	return ok
+}
+
+// GetBalance returns the native or token balance of an address
+func (e *EVMProvider) GetBalance(ctx context.Context, address string, coinType currency.Code) (float64, error) {
+	if !evmAddress.MatchString(address) {
+		return 0, fmt.Errorf("%w %s for chain %s", errInvalidAddress, address, e.chain)
+	}
+	var result string
+	if coinType.Match(e.native) {
+		err := e.call(ctx, "eth_getBalance", []interface{}{address, "latest"}, &result)
+		if err != nil {
+			return 0, err
+		}
+		return parseHexBalance(result, e.decimals)
+	}
+	token, ok := e.getToken(coinType)
+	if !ok {
+		return 0, fmt.Errorf("%w %s %s", errCurrencyNotSupported, e.name, coinType)
+	}
+	err := e.call(ctx, "eth_call", []interface{}{
+		evmCall{
+			To:   token.Contract,
+			Data: erc20BalanceOfSelector + strings.Repeat("0", 24) + strings.ToLower(address[2:]),
+		},
+		"latest",
+	}, &result)
+	if err != nil {
+		return 0, err
+	}
+	return parseHexBalance(result, token.Decimals)
+}
+
+// getToken returns the configured token of a currency
+func (e *EVMProvider) getToken(coinType currency.Code) (TokenConfig, bool) {
+	for i := range e.tokens {
+		if e.tokens[i].Currency.Match(coinType) {
+			return e.tokens[i], true
+		}
+	}
+	return TokenConfig{}, false
+}
+
+// call sends a JSON-RPC request to the endpoint and decodes its result
+func (e *EVMProvider) call(ctx context.Context, method string, params []interface{}, result interface{}) error {
+	payload, err := json.Marshal(&evmRPCRequest{
+		JSONRPC: "2.0",
+		ID:      1,
+		Method:  method,
+		Params:  params,
+	})
+	if err != nil {
+		return err
+	}
+	contents, err := common.SendHTTPRequest(ctx,
+		http.MethodPost,
+		e.url,
+		map[string]string{"Content-Type": "application/json"},
+		bytes.NewReader(payload),
+		e.verbose)
+	if err != nil {
+		return err
+	}
+	var resp evmRPCResponse
+	err = json.Unmarshal(contents, &resp)
+	if err != nil {
+		return err
+	}
+	if resp.Error != nil {
+		return fmt.Errorf("%s %s error %d: %s", e.name, method, resp.Error.Code, resp.Error.Message)
+	}
+	return json.Unmarshal(resp.Result, result)
+}
+
+// NewEsploraProvider returns a provider which retrieves balances from an
+// Esplora compatible REST API
+func NewEsploraProvider(cfg *ProviderConfig) (*EsploraProvider, error) {
+	err := validateProviderConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	decimals := cfg.Decimals
+	if decimals <= 0 {
+		decimals = defaultEsploraDecimals
+	}
+	return &EsploraProvider{
+		name:     cfg.Name,
+		chain:    cfg.Chain,
+		url:      strings.TrimSuffix(cfg.URL, "/"),
+		native:   cfg.Currency,
+		decimals: decimals,
+		verbose:  cfg.Verbose,
+	}, nil
+}
+
+// GetName returns the name of the provider
+func (e *EsploraProvider) GetName() string {
+	return e.name
+}
+
+// Supports returns whether the currency is the chain's native currency
+func (e *EsploraProvider) Supports(coinType currency.Code, chain string) bool {
+	return (chain == "" || strings.EqualFold(chain, e.chain)) && coinType.Match(e.native)
+}
+
+// GetBalance returns the confirmed balance of an address
+func (e *EsploraProvider) GetBalance(ctx context.Context, address string, coinType currency.Code) (float64, error) {
+	if !coinType.Match(e.native) {
+		return 0, fmt.Errorf("%w %s %s", errCurrencyNotSupported, e.name, coinType)
+	}
+	if address == "" || strings.ContainsAny(address, "/?#") {
+		return 0, fmt.Errorf("%w '%s' for chain %s", errInvalidAddress, address, e.chain)
+	}
+	contents, err := common.SendHTTPRequest(ctx,
+		http.MethodGet,
+		e.url+"/address/"+address,
+		nil,
+		nil,
+		e.verbose)
+	if err != nil {
+		return 0, err
+	}
+	var resp esploraAddress
+	err = json.Unmarshal(contents, &resp)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w, %s", e.name, err, contents)
+	}
+	balance := big.NewInt(resp.ChainStats.FundedTXOSum - resp.ChainStats.SpentTXOSum)
+	return scaleBalance(balance, e.decimals), nil
+}
+
+// SetupProviders creates the configured providers, replacing any which have
+// already been registered
+func (b *Base) SetupProviders() error {
+	b.providers = nil
+	for i := range b.Providers {
+		p, err := NewProvider(&b.Providers[i])
+		if err != nil {
+			return err
+		}
+		err = b.RegisterProvider(p)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RegisterProvider adds a provider used to retrieve on-chain balances.
+// Providers take precedence over the built in lookups and are matched in the
+// order they are registered
+func (b *Base) RegisterProvider(p Provider) error {
+	if p == nil {
+		return errNilProvider
+	}
+	for i := range b.providers {
+		if strings.EqualFold(b.providers[i].GetName(), p.GetName()) {
+			return fmt.Errorf("%w: %s", errProviderExists, p.GetName())
+		}
+	}
+	b.providers = append(b.providers, p)
+	return nil
+}
+
+// getProvider returns the first registered provider which supports the
+// currency on the chain
+func (b *Base) getProvider(coinType currency.Code, chain string) Provider {
+	for i := range b.providers {
+		if b.providers[i].Supports(coinType, chain) {
+			return b.providers[i]
+		}
+	}
+	return nil
+}
+
+// updateProviderBalances updates the balances of the personal addresses of a
+// currency which are supported by a provider and returns the addresses which
+// are not, so they can be checked with the built in lookups. Addresses on a
+// chain without a provider return an error
+func (b *Base) updateProviderBalances(ctx context.Context, addresses []string, coinType currency.Code) ([]string, error) {
+	handled := make(map[string]bool)
+	for i := range b.Addresses {
+		if !b.Addresses[i].CoinType.Match(coinType) ||
+			strings.EqualFold(b.Addresses[i].Description, ExchangeAddress) ||
+			!common.StringDataCompare(addresses, b.Addresses[i].Address) {
+			continue
+		}
+		p := b.getProvider(coinType, b.Addresses[i].Chain)
+		if p == nil {
+			if b.Addresses[i].Chain != "" {
+				return nil, fmt.Errorf("%w %s %s", errNoProviderForChain, b.Addresses[i].Chain, coinType)
+			}
+			continue
+		}
+		balance, err := p.GetBalance(ctx, b.Addresses[i].Address, coinType)
+		if err != nil {
+			return nil, fmt.Errorf("%s provider: %w", p.GetName(), err)
+		}
+		b.Addresses[i].Balance = balance
+		handled[b.Addresses[i].Address] = true
+	}
+	remaining := make([]string, 0, len(addresses))
+	for x := range addresses {
+		if !handled[addresses[x]] {
+			remaining = append(remaining, addresses[x])
+		}
+	}
+	return remaining, nil
+}
+
+// validateProviderConfig checks the fields common to all providers
+func validateProviderConfig(cfg *ProviderConfig) error {
+	if cfg == nil {
+		return errInvalidProviderConfig
+	}
+	if cfg.Name == "" || cfg.Currency.IsEmpty() || cfg.Decimals < 0 {
+		return fmt.Errorf("%w, provider '%s' requires a name and currency", errInvalidProviderConfig, cfg.Name)
+	}
+	u, err := url.Parse(cfg.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w, provider %s URL '%s' must be http or https", errInvalidProviderConfig, cfg.Name, cfg.URL)
+	}
+	return nil
+}
+
+// parseHexBalance converts a hex encoded integer amount into a balance
+func parseHexBalance(s string, decimals int) (float64, error) {
+	s = strings.TrimPrefix(s, "0x")
+	if s == "" {
+		return 0, nil
+	}
+	v, ok := new(big.Int).SetString(s, 16)
+	if !ok {
+		return 0, fmt.Errorf("%w '%s'", errInvalidRPCResult, s)
+	}
+	return scaleBalance(v, decimals), nil
+}
+
+// scaleBalance converts an integer amount of the smallest unit of a currency
+// into a balance
+func scaleBalance(v *big.Int, decimals int) float64 {
+	divisor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+	balance, _ := new(big.Float).Quo(new(big.Float).SetInt(v), new(big.Float).SetInt(divisor)).Float64()
+	return balance
+}
@@ -0,0 +1,209 @@
+package portfolio
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+const (
+	testEVMAddress  = "0x00000000219ab540356cBB839Cbe05303d7705Fa"
+	testEVMContract = "0xdAC17F958D2ee523a2206206994597C13D831ec7"
+)
+
+var testMATIC = currency.NewCode("MATIC")
+
+// newTestEVMServer returns a JSON-RPC server which returns 1.5 of the native
+// currency and 250 of any token with 6 decimals
+func newTestEVMServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req evmRPCRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Error(err)
+			return
+		}
+		var result string
+		switch req.Method {
+		case "eth_getBalance":
+			result = "0x14d1120d7b160000"
+		case "eth_call":
+			call, ok := req.Params[0].(map[string]interface{})
+			if !ok || !strings.HasPrefix(call["data"].(string), erc20BalanceOfSelector) {
+				t.Errorf("unexpected eth_call params %v", req.Params)
+			}
+			result = "0x000000000000000000000000000000000000000000000000000000000ee6b280"
+		default:
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"error":{"code":-32601,"message":"method not found"}}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": 1, "result": result})
+	}))
+}
+
+func TestNewProvider(t *testing.T) {
+	t.Parallel()
+	_, err := NewProvider(nil)
+	if !errors.Is(err, errInvalidProviderConfig) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidProviderConfig)
+	}
+	_, err = NewProvider(&ProviderConfig{Name: "a", Type: "bad"})
+	if !errors.Is(err, errUnknownProviderType) {
+		t.Errorf("received '%v' expected '%v'", err, errUnknownProviderType)
+	}
+	_, err = NewProvider(&ProviderConfig{Name: "a", Type: ProviderTypeEVM, Currency: currency.ETH, URL: "ftp://bad"})
+	if !errors.Is(err, errInvalidProviderConfig) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidProviderConfig)
+	}
+	_, err = NewProvider(&ProviderConfig{
+		Name:     "a",
+		Type:     ProviderTypeEVM,
+		Currency: currency.ETH,
+		URL:      "https://rpc.example.com",
+		Tokens:   []TokenConfig{{Currency: currency.USDT, Contract: "bad"}},
+	})
+	if !errors.Is(err, errInvalidProviderConfig) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidProviderConfig)
+	}
+	p, err := NewProvider(&ProviderConfig{Name: "a", Type: "EsPlOrA", Currency: currency.BTC, URL: "https://blockstream.info/api/"})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if _, ok := p.(*EsploraProvider); !ok {
+		t.Errorf("unexpected provider type %T", p)
+	}
+}
+
+func TestEVMProvider(t *testing.T) {
+	t.Parallel()
+	s := newTestEVMServer(t)
+	defer s.Close()
+	p, err := NewEVMProvider(&ProviderConfig{
+		Name:     "polygon",
+		Chain:    "polygon",
+		URL:      s.URL,
+		Currency: testMATIC,
+		Tokens:   []TokenConfig{{Currency: currency.USDT, Contract: testEVMContract, Decimals: 6}},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !p.Supports(testMATIC, "") || !p.Supports(currency.USDT, "POLYGON") {
+		t.Error("expected native currency and token to be supported")
+	}
+	if p.Supports(currency.USDT, "ethereum") || p.Supports(currency.BTC, "polygon") {
+		t.Error("expected other chains and currencies to be unsupported")
+	}
+
+	balance, err := p.GetBalance(context.Background(), testEVMAddress, testMATIC)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if balance != 1.5 {
+		t.Errorf("received '%v' expected '%v'", balance, 1.5)
+	}
+	balance, err = p.GetBalance(context.Background(), testEVMAddress, currency.USDT)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if balance != 250 {
+		t.Errorf("received '%v' expected '%v'", balance, 250)
+	}
+	_, err = p.GetBalance(context.Background(), "bad", testMATIC)
+	if !errors.Is(err, errInvalidAddress) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidAddress)
+	}
+	_, err = p.GetBalance(context.Background(), testEVMAddress, currency.BTC)
+	if !errors.Is(err, errCurrencyNotSupported) {
+		t.Errorf("received '%v' expected '%v'", err, errCurrencyNotSupported)
+	}
+}
+
+func TestEsploraProvider(t *testing.T) {
+	t.Parallel()
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/address/bc1qtest" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(`{"chain_stats":{"funded_txo_sum":300000000,"spent_txo_sum":50000000}}`))
+	}))
+	defer s.Close()
+	p, err := NewEsploraProvider(&ProviderConfig{Name: "btc", Chain: "bitcoin", URL: s.URL + "/", Currency: currency.BTC})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	balance, err := p.GetBalance(context.Background(), "bc1qtest", currency.BTC)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if balance != 2.5 {
+		t.Errorf("received '%v' expected '%v'", balance, 2.5)
+	}
+	_, err = p.GetBalance(context.Background(), "bc1q/../test", currency.BTC)
+	if !errors.Is(err, errInvalidAddress) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidAddress)
+	}
+	_, err = p.GetBalance(context.Background(), "bc1qtest", currency.LTC)
+	if !errors.Is(err, errCurrencyNotSupported) {
+		t.Errorf("received '%v' expected '%v'", err, errCurrencyNotSupported)
+	}
+}
+
+func TestUpdatePortfolioProviders(t *testing.T) {
+	t.Parallel()
+	s := newTestEVMServer(t)
+	defer s.Close()
+	b := Base{
+		Addresses: []Address{
+			{Address: testEVMAddress, CoinType: currency.USDT, Chain: "polygon", Description: PersonalAddress},
+			{Address: testEVMAddress, CoinType: testMATIC, Chain: "polygon", Description: PersonalAddress},
+		},
+		Providers: []ProviderConfig{{
+			Name:     "polygon",
+			Type:     ProviderTypeEVM,
+			Chain:    "polygon",
+			URL:      s.URL,
+			Currency: testMATIC,
+			Tokens:   []TokenConfig{{Currency: currency.USDT, Contract: testEVMContract, Decimals: 6}},
+		}},
+	}
+	err := b.SetupProviders()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = b.RegisterProvider(&EVMProvider{name: "POLYGON"})
+	if !errors.Is(err, errProviderExists) {
+		t.Errorf("received '%v' expected '%v'", err, errProviderExists)
+	}
+	err = b.RegisterProvider(nil)
+	if !errors.Is(err, errNilProvider) {
+		t.Errorf("received '%v' expected '%v'", err, errNilProvider)
+	}
+
+	for coin, addresses := range b.GetPortfolioGroupedCoin() {
+		err = b.UpdatePortfolio(addresses, coin)
+		if !errors.Is(err, nil) {
+			t.Fatalf("received '%v' expected '%v'", err, nil)
+		}
+	}
+	if b.Addresses[0].Balance != 250 || b.Addresses[1].Balance != 1.5 {
+		t.Errorf("unexpected addresses %+v", b.Addresses)
+	}
+	summary := b.GetPortfolioSummary()
+	if len(summary.OfflineSummary[currency.USDT]) != 1 || summary.OfflineSummary[currency.USDT][0].Chain != "polygon" {
+		t.Errorf("unexpected offline summary %+v", summary.OfflineSummary)
+	}
+
+	b.Addresses = append(b.Addresses, Address{Address: testEVMAddress, CoinType: currency.USDT, Chain: "arbitrum", Description: PersonalAddress})
+	err = b.UpdatePortfolio([]string{testEVMAddress}, currency.USDT)
+	if !errors.Is(err, errNoProviderForChain) {
+		t.Errorf("received '%v' expected '%v'", err, errNoProviderForChain)
+	}
+}
@@ -0,0 +1,124 @@
+package portfolio
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+// Provider types which can be configured
+const (
+	ProviderTypeEVM     = "evm"
+	ProviderTypeEsplora = "esplora"
+)
+
+const (
+	defaultEVMDecimals     = 18
+	defaultEsploraDecimals = 8
+	// erc20BalanceOfSelector is the function selector of the ERC20
+	// balanceOf(address) method
+	erc20BalanceOfSelector = "0x70a08231"
+)
+
+var (
+	errInvalidProviderConfig = errors.New("invalid provider config")
+	errUnknownProviderType   = errors.New("unknown provider type")
+	errNilProvider           = errors.New("provider is nil")
+	errProviderExists        = errors.New("provider already registered")
+	errNoProviderForChain    = errors.New("no provider configured for chain")
+	errCurrencyNotSupported  = errors.New("currency not supported by provider")
+	errInvalidAddress        = errors.New("invalid address")
+	errInvalidRPCResult      = errors.New("invalid RPC result")
+)
+
+// Provider retrieves the on-chain balances of addresses. Providers are
+// matched to portfolio addresses by their currency and chain, allowing cold
+// wallet balances on chains which are not supported by the built in lookups
+// to be tracked
+type Provider interface {
+	// GetName returns the unique name of the provider
+	GetName() string
+	// Supports returns whether the provider can retrieve balances of the
+	// currency on the chain. An empty chain matches any chain
+	Supports(coinType currency.Code, chain string) bool
+	// GetBalance returns the balance of the currency held by the address
+	GetBalance(ctx context.Context, address string, coinType currency.Code) (float64, error)
+}
+
+// ProviderConfig defines an on-chain balance provider. The currency is the
+// native currency of the chain, with tokens defining any additional
+// currencies which can be tracked such as ERC20 tokens on EVM chains
+type ProviderConfig struct {
+	Name     string        `json:"name"`
+	Type     string        `json:"type"`
+	Chain    string        `json:"chain"`
+	URL      string        `json:"url"`
+	Currency currency.Code `json:"currency"`
+	Decimals int           `json:"decimals,omitempty"`
+	Tokens   []TokenConfig `json:"tokens,omitempty"`
+	Verbose  bool          `json:"verbose,omitempty"`
+}
+
+// TokenConfig defines a token contract tracked by a provider
+type TokenConfig struct {
+	Currency currency.Code `json:"currency"`
+	Contract string        `json:"contract"`
+	Decimals int           `json:"decimals"`
+}
+
+// EVMProvider retrieves native and ERC20 token balances from an EVM
+// compatible JSON-RPC endpoint, such as those of Ethereum, Polygon, BNB Smart
+// Chain and Arbitrum
+type EVMProvider struct {
+	name     string
+	chain    string
+	url      string
+	native   currency.Code
+	decimals int
+	tokens   []TokenConfig
+	verbose  bool
+}
+
+// evmRPCRequest is a JSON-RPC request to an EVM endpoint
+type evmRPCRequest struct {
+	JSONRPC string        `json:"jsonrpc"`
+	ID      int64         `json:"id"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+}
+
+// evmRPCResponse is a JSON-RPC response from an EVM endpoint
+type evmRPCResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// evmCall holds the parameters of an eth_call request
+type evmCall struct {
+	To   string `json:"to"`
+	Data string `json:"data"`
+}
+
+// EsploraProvider retrieves balances of UTXO based chains from an Esplora
+// compatible REST API, such as those of Blockstream and mempool.space
+type EsploraProvider struct {
+	name     string
+	chain    string
+	url      string
+	native   currency.Code
+	decimals int
+	verbose  bool
+}
+
+// esploraAddress holds the address statistics returned by Esplora
+type esploraAddress struct {
+	ChainStats struct {
+		FundedTXOSum int64 `json:"funded_txo_sum"`
+		SpentTXOSum  int64 `json:"spent_txo_sum"`
+	} `json:"chain_stats"`
+}
@@ -0,0 +1,277 @@
+package withdraw
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+)
+
+// NewAddressBook returns an address book persisted to the supplied path,
+// loading any entries already saved there. An empty path returns an address
+// book which is only held in memory
+func NewAddressBook(path string) (*AddressBook, error) {
+	a := &AddressBook{
+		path:    path,
+		entries: make(map[string]AddressBookEntry),
+	}
+	if path == "" || !file.Exists(path) {
+		return a, nil
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var entries []AddressBookEntry
+	err = json.Unmarshal(data, &entries)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load address book %s: %w", path, err)
+	}
+	for i := range entries {
+		err = entries[i].validate()
+		if err != nil {
+			return nil, fmt.Errorf("unable to load address book %s: %w", path, err)
+		}
+		a.entries[strings.ToLower(entries[i].Label)] = entries[i]
+	}
+	return a, nil
+}
+
+// Add adds a labelled address to the address book
+func (a *AddressBook) Add(entry AddressBookEntry) (AddressBookEntry, error) {
+	if a == nil {
+		return AddressBookEntry{}, errAddressBookNil
+	}
+	err := entry.validate()
+	if err != nil {
+		return AddressBookEntry{}, err
+	}
+	a.m.Lock()
+	defer a.m.Unlock()
+	key := strings.ToLower(entry.Label)
+	if _, ok := a.entries[key]; ok {
+		return AddressBookEntry{}, fmt.Errorf("%w: label %s", ErrAddressBookEntryExists, entry.Label)
+	}
+	for _, e := range a.entries {
+		if strings.EqualFold(e.Exchange, entry.Exchange) &&
+			e.Currency.Match(entry.Currency) &&
+			e.Address == entry.Address &&
+			e.AddressTag == entry.AddressTag &&
+			strings.EqualFold(e.Chain, entry.Chain) {
+			return AddressBookEntry{}, fmt.Errorf("%w: address %s labelled %s", ErrAddressBookEntryExists, entry.Address, e.Label)
+		}
+	}
+	entry.Currency = entry.Currency.Upper()
+	entry.CreatedAt = time.Now()
+	entry.UpdatedAt = entry.CreatedAt
+	err = a.update(key, &entry)
+	if err != nil {
+		return AddressBookEntry{}, err
+	}
+	return entry, nil
+}
+
+// Remove removes a labelled address from the address book
+func (a *AddressBook) Remove(label string) error {
+	if a == nil {
+		return errAddressBookNil
+	}
+	a.m.Lock()
+	defer a.m.Unlock()
+	key := strings.ToLower(label)
+	if _, ok := a.entries[key]; !ok {
+		return fmt.Errorf("%w: %s", ErrAddressBookEntryNotFound, label)
+	}
+	return a.update(key, nil)
+}
+
+// SetWhitelisted sets whether a labelled address can receive withdrawals
+func (a *AddressBook) SetWhitelisted(label string, whitelisted bool) (AddressBookEntry, error) {
+	if a == nil {
+		return AddressBookEntry{}, errAddressBookNil
+	}
+	a.m.Lock()
+	defer a.m.Unlock()
+	key := strings.ToLower(label)
+	entry, ok := a.entries[key]
+	if !ok {
+		return AddressBookEntry{}, fmt.Errorf("%w: %s", ErrAddressBookEntryNotFound, label)
+	}
+	entry.Whitelisted = whitelisted
+	entry.UpdatedAt = time.Now()
+	err := a.update(key, &entry)
+	if err != nil {
+		return AddressBookEntry{}, err
+	}
+	return entry, nil
+}
+
+// Relabel changes the label of an address
+func (a *AddressBook) Relabel(label, newLabel string) (AddressBookEntry, error) {
+	if a == nil {
+		return AddressBookEntry{}, errAddressBookNil
+	}
+	if newLabel == "" {
+		return AddressBookEntry{}, errAddressBookLabelUnset
+	}
+	a.m.Lock()
+	defer a.m.Unlock()
+	key := strings.ToLower(label)
+	entry, ok := a.entries[key]
+	if !ok {
+		return AddressBookEntry{}, fmt.Errorf("%w: %s", ErrAddressBookEntryNotFound, label)
+	}
+	newKey := strings.ToLower(newLabel)
+	if _, ok = a.entries[newKey]; ok && newKey != key {
+		return AddressBookEntry{}, fmt.Errorf("%w: label %s", ErrAddressBookEntryExists, newLabel)
+	}
+	original := entry
+	delete(a.entries, key)
+	entry.Label = newLabel
+	entry.UpdatedAt = time.Now()
+	err := a.update(newKey, &entry)
+	if err != nil {
+		a.entries[key] = original
+		return AddressBookEntry{}, err
+	}
+	return entry, nil
+}
+
+// Get returns a labelled address
+func (a *AddressBook) Get(label string) (AddressBookEntry, error) {
+	if a == nil {
+		return AddressBookEntry{}, errAddressBookNil
+	}
+	a.m.RLock()
+	defer a.m.RUnlock()
+	entry, ok := a.entries[strings.ToLower(label)]
+	if !ok {
+		return AddressBookEntry{}, fmt.Errorf("%w: %s", ErrAddressBookEntryNotFound, label)
+	}
+	return entry, nil
+}
+
+// GetEntries returns the addresses which can be withdrawn to from an
+// exchange, or all addresses when no exchange name is supplied, sorted by
+// label
+func (a *AddressBook) GetEntries(exchangeName string) []AddressBookEntry {
+	if a == nil {
+		return nil
+	}
+	a.m.RLock()
+	defer a.m.RUnlock()
+	entries := make([]AddressBookEntry, 0, len(a.entries))
+	for _, e := range a.entries {
+		if exchangeName != "" && e.Exchange != "" && !strings.EqualFold(e.Exchange, exchangeName) {
+			continue
+		}
+		entries = append(entries, e)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return strings.ToLower(entries[i].Label) < strings.ToLower(entries[j].Label)
+	})
+	return entries
+}
+
+// CheckWithdrawal ensures a cryptocurrency withdrawal request is to an address
+// in the address book which is whitelisted and returns the matching entry
+func (a *AddressBook) CheckWithdrawal(r *Request) (AddressBookEntry, error) {
+	if a == nil {
+		return AddressBookEntry{}, errAddressBookNil
+	}
+	if r == nil {
+		return AddressBookEntry{}, ErrRequestCannotBeNil
+	}
+	if r.Type != Crypto {
+		return AddressBookEntry{}, fmt.Errorf("%w, address book only supports cryptocurrency withdrawals", ErrInvalidRequest)
+	}
+	a.m.RLock()
+	defer a.m.RUnlock()
+	var found bool
+	for _, e := range a.entries {
+		if !e.matches(r) {
+			continue
+		}
+		if e.Whitelisted {
+			return e, nil
+		}
+		found = true
+	}
+	if found {
+		return AddressBookEntry{}, fmt.Errorf("%s %w", r.Crypto.Address, ErrStrAddressNotWhiteListed)
+	}
+	return AddressBookEntry{}, fmt.Errorf("%s %s %w", r.Currency, r.Crypto.Address, ErrAddressNotInAddressBook)
+}
+
+// update sets or, when the entry is nil, removes an entry and persists the
+// address book, reverting the change when it cannot be saved. The lock must be
+// held by the caller
+func (a *AddressBook) update(key string, entry *AddressBookEntry) error {
+	previous, existed := a.entries[key]
+	if entry == nil {
+		delete(a.entries, key)
+	} else {
+		a.entries[key] = *entry
+	}
+	err := a.save()
+	if err == nil {
+		return nil
+	}
+	if existed {
+		a.entries[key] = previous
+	} else {
+		delete(a.entries, key)
+	}
+	return err
+}
+
+// save writes the address book to its path. The lock must be held by the
+// caller
+func (a *AddressBook) save() error {
+	if a.path == "" {
+		return nil
+	}
+	entries := make([]AddressBookEntry, 0, len(a.entries))
+	for _, e := range a.entries {
+		entries = append(entries, e)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return strings.ToLower(entries[i].Label) < strings.ToLower(entries[j].Label)
+	})
+	data, err := json.MarshalIndent(entries, "", " ")
+	if err != nil {
+		return err
+	}
+	err = file.Write(a.path, data)
+	if err != nil {
+		return fmt.Errorf("unable to save address book %s: %w", a.path, err)
+	}
+	return nil
+}
+
+// validate checks the entry has the details required to match withdrawals
+func (e *AddressBookEntry) validate() error {
+	switch {
+	case e.Label == "":
+		return errAddressBookLabelUnset
+	case e.Address == "":
+		return fmt.Errorf("%s: %s", e.Label, ErrStrAddressNotSet)
+	case e.Currency.IsEmpty():
+		return fmt.Errorf("%s: %s", e.Label, ErrStrNoCurrencySet)
+	}
+	return nil
+}
+
+// matches returns whether the entry is for the exchange, currency, address
+// and chain of a withdrawal request
+func (e *AddressBookEntry) matches(r *Request) bool {
+	return (e.Exchange == "" || strings.EqualFold(e.Exchange, r.Exchange)) &&
+		e.Currency.Match(r.Currency) &&
+		e.Address == r.Crypto.Address &&
+		e.AddressTag == r.Crypto.AddressTag &&
+		(e.Chain == "" || strings.EqualFold(e.Chain, r.Crypto.Chain))
+}
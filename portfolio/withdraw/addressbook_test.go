@@ -0,0 +1,168 @@
+package withdraw
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+func TestNewAddressBook(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "addressbook")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "addressbook.json")
+
+	a, err := NewAddressBook(path)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = a.Add(AddressBookEntry{Label: "cold", Currency: currency.BTC, Address: testBTCAddress, Whitelisted: true})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	a, err = NewAddressBook(path)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	entry, err := a.Get("COLD")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if entry.Address != testBTCAddress || !entry.Whitelisted || !entry.Currency.Match(currency.BTC) {
+		t.Errorf("unexpected entry %+v", entry)
+	}
+
+	err = ioutil.WriteFile(path, []byte(`[{"label":"","address":"a","currency":"BTC"}]`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = NewAddressBook(path)
+	if !errors.Is(err, errAddressBookLabelUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errAddressBookLabelUnset)
+	}
+}
+
+func TestAddressBookEntries(t *testing.T) {
+	t.Parallel()
+	a, err := NewAddressBook("")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = a.Add(AddressBookEntry{Currency: currency.BTC, Address: testBTCAddress})
+	if !errors.Is(err, errAddressBookLabelUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errAddressBookLabelUnset)
+	}
+	_, err = a.Add(AddressBookEntry{Label: "b", Exchange: "Binance", Currency: currency.BTC, Address: testBTCAddress})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = a.Add(AddressBookEntry{Label: "B", Currency: currency.ETH, Address: "eth"})
+	if !errors.Is(err, ErrAddressBookEntryExists) {
+		t.Errorf("received '%v' expected '%v'", err, ErrAddressBookEntryExists)
+	}
+	_, err = a.Add(AddressBookEntry{Label: "c", Exchange: "binance", Currency: currency.BTC, Address: testBTCAddress})
+	if !errors.Is(err, ErrAddressBookEntryExists) {
+		t.Errorf("received '%v' expected '%v'", err, ErrAddressBookEntryExists)
+	}
+	_, err = a.Add(AddressBookEntry{Label: "a", Exchange: "Kraken", Currency: currency.ETH, Address: "eth"})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	if entries := a.GetEntries(""); len(entries) != 2 || entries[0].Label != "a" {
+		t.Errorf("unexpected entries %+v", entries)
+	}
+	if entries := a.GetEntries("binance"); len(entries) != 1 || entries[0].Label != "b" {
+		t.Errorf("unexpected entries %+v", entries)
+	}
+
+	_, err = a.Relabel("b", "a")
+	if !errors.Is(err, ErrAddressBookEntryExists) {
+		t.Errorf("received '%v' expected '%v'", err, ErrAddressBookEntryExists)
+	}
+	entry, err := a.Relabel("b", "hot wallet")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if entry.Label != "hot wallet" {
+		t.Errorf("received '%v' expected '%v'", entry.Label, "hot wallet")
+	}
+	_, err = a.Get("b")
+	if !errors.Is(err, ErrAddressBookEntryNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, ErrAddressBookEntryNotFound)
+	}
+
+	err = a.Remove("a")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = a.Remove("a")
+	if !errors.Is(err, ErrAddressBookEntryNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, ErrAddressBookEntryNotFound)
+	}
+
+	var nilBook *AddressBook
+	_, err = nilBook.Add(AddressBookEntry{})
+	if !errors.Is(err, errAddressBookNil) {
+		t.Errorf("received '%v' expected '%v'", err, errAddressBookNil)
+	}
+}
+
+func TestAddressBookCheckWithdrawal(t *testing.T) {
+	t.Parallel()
+	a, err := NewAddressBook("")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	req := &Request{
+		Exchange: "Binance",
+		Currency: currency.BTC,
+		Amount:   1,
+		Type:     Crypto,
+		Crypto:   CryptoRequest{Address: testBTCAddress, Chain: "btc"},
+	}
+	_, err = a.CheckWithdrawal(req)
+	if !errors.Is(err, ErrAddressNotInAddressBook) {
+		t.Errorf("received '%v' expected '%v'", err, ErrAddressNotInAddressBook)
+	}
+
+	_, err = a.Add(AddressBookEntry{Label: "cold", Currency: currency.BTC, Address: testBTCAddress, Chain: "BTC"})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = a.CheckWithdrawal(req)
+	if !errors.Is(err, ErrStrAddressNotWhiteListed) {
+		t.Errorf("received '%v' expected '%v'", err, ErrStrAddressNotWhiteListed)
+	}
+
+	_, err = a.SetWhitelisted("cold", true)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	entry, err := a.CheckWithdrawal(req)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if entry.Label != "cold" {
+		t.Errorf("received '%v' expected '%v'", entry.Label, "cold")
+	}
+
+	req.Crypto.AddressTag = "memo"
+	_, err = a.CheckWithdrawal(req)
+	if !errors.Is(err, ErrAddressNotInAddressBook) {
+		t.Errorf("received '%v' expected '%v'", err, ErrAddressNotInAddressBook)
+	}
+
+	_, err = a.CheckWithdrawal(&Request{Type: Fiat})
+	if !errors.Is(err, ErrInvalidRequest) {
+		t.Errorf("received '%v' expected '%v'", err, ErrInvalidRequest)
+	}
+}
@@ -0,0 +1,48 @@
+package withdraw
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+var (
+	// ErrAddressBookEntryNotFound occurs when a label does not exist in the
+	// address book
+	ErrAddressBookEntryNotFound = errors.New("address book entry not found")
+	// ErrAddressNotInAddressBook occurs when a withdrawal is requested to an
+	// address which has not been added to the address book
+	ErrAddressNotInAddressBook = errors.New("address is not in the address book")
+	// ErrAddressBookEntryExists occurs when a label or address has already
+	// been added to the address book
+	ErrAddressBookEntryExists = errors.New("address book entry already exists")
+
+	errAddressBookLabelUnset = errors.New("address book label unset")
+	errAddressBookNil        = errors.New("address book is nil")
+)
+
+// AddressBook holds labelled cryptocurrency withdrawal addresses and whether
+// each is whitelisted to receive withdrawals. When a path is set, changes are
+// persisted to it as JSON
+type AddressBook struct {
+	path    string
+	entries map[string]AddressBookEntry
+	m       sync.RWMutex
+}
+
+// AddressBookEntry is a labelled withdrawal address. An empty exchange allows
+// the address to be withdrawn to from any exchange and an empty chain matches
+// any chain
+type AddressBookEntry struct {
+	Label       string        `json:"label"`
+	Exchange    string        `json:"exchange,omitempty"`
+	Currency    currency.Code `json:"currency"`
+	Address     string        `json:"address"`
+	AddressTag  string        `json:"addressTag,omitempty"`
+	Chain       string        `json:"chain,omitempty"`
+	Whitelisted bool          `json:"whitelisted"`
+	CreatedAt   time.Time     `json:"createdAt"`
+	UpdatedAt   time.Time     `json:"updatedAt"`
+}